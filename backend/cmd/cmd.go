@@ -4,7 +4,10 @@ import (
 	"context"
 	"log"
 	"strings"
+	// 組織のタイムゾーン設定を検証するため、タイムゾーンデータを持たないイメージでも動くよう埋め込む
+	_ "time/tzdata"
 
+	"github.com/shibayama-club/keyhub/cmd/audit"
 	"github.com/shibayama-club/keyhub/cmd/serve"
 	"github.com/shibayama-club/keyhub/internal/domain/logger"
 	"github.com/spf13/cobra"
@@ -36,6 +39,7 @@ func main() {
 
 	cmd.AddCommand(serve.ServeApp())
 	cmd.AddCommand(serve.ServeConsole())
+	cmd.AddCommand(audit.Audit())
 	flags := cmd.PersistentFlags()
 	flags.BoolVar(&debug, "debug", false, "Enable debug mode")
 	flags.StringVar(&configFile, "config", "", "Path to config file")
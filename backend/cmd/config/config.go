@@ -2,6 +2,7 @@ package config
 
 import (
 	"context"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/spf13/cobra"
@@ -19,10 +20,31 @@ type (
 		Debug    bool   `mapstructure:"debug"`
 	}
 
+	ConsoleJWTKeyConfig struct {
+		ID             string `mapstructure:"id"`
+		Secret         string `mapstructure:"secret"`
+		PrivateKeyFile string `mapstructure:"private_key_file"`
+	}
+
+	ConsoleJWTConfig struct {
+		SigningKeyID string                `mapstructure:"signing_key_id"`
+		Issuer       string                `mapstructure:"issuer"`
+		Audience     string                `mapstructure:"audience"`
+		Keys         []ConsoleJWTKeyConfig `mapstructure:"keys"`
+	}
+
+	// ConsoleConfig の PlatformAdminToken は組織を作成・管理するプラットフォーム管理者の認証トークン。
+	// 空の場合はプラットフォーム管理APIを無効にする
 	ConsoleConfig struct {
-		OrganizationId  string `mapstructure:"organization_id"`
-		OrganizationKey string `mapstructure:"organization_key"`
-		JWTSecret       string `mapstructure:"jwt_secret"`
+		PlatformAdminToken string           `mapstructure:"platform_admin_token"`
+		JWTSecret          string           `mapstructure:"jwt_secret"`
+		JWT                ConsoleJWTConfig `mapstructure:"jwt"`
+	}
+
+	// AppConfig の BaseDomain はアプリのフロントエンドを組織ごとのサブドメインで配信する場合のドメイン。
+	// 設定すると acme.<base_domain> からのログインを組織 acme へのログインとして扱う
+	AppConfig struct {
+		BaseDomain string `mapstructure:"base_domain"`
 	}
 
 	GoogleAuthConfig struct {
@@ -31,8 +53,88 @@ type (
 		RedirectURI  string `mapstructure:"redirect_uri"`
 	}
 
+	// WebAuthnConfig はパスキー認証のRelying Party設定。
+	// RPID はフロントエンドのドメイン（スキームとポートを除く）、RPOrigins は許可するオリジン
+	WebAuthnConfig struct {
+		RPID          string   `mapstructure:"rp_id"`
+		RPDisplayName string   `mapstructure:"rp_display_name"`
+		RPOrigins     []string `mapstructure:"rp_origins"`
+	}
+
 	AuthConfig struct {
-		Google GoogleAuthConfig `mapstructure:"google"`
+		Google   GoogleAuthConfig `mapstructure:"google"`
+		WebAuthn WebAuthnConfig   `mapstructure:"webauthn"`
+	}
+
+	// SessionLifetimeConfig はセッションの有効期限設定。
+	// IdleTimeout は最終利用からの有効期間で、利用のたびにスライディングで延長される。
+	// AbsoluteTimeout はログインからの最大有効期間で、延長してもこれを超えない。
+	SessionLifetimeConfig struct {
+		IdleTimeout     time.Duration `mapstructure:"idle_timeout"`
+		AbsoluteTimeout time.Duration `mapstructure:"absolute_timeout"`
+	}
+
+	SessionConfig struct {
+		App     SessionLifetimeConfig `mapstructure:"app"`
+		Console SessionLifetimeConfig `mapstructure:"console"`
+	}
+
+	// RateLimitConfig はログインや参加コード照会のレート制限設定。
+	// Store は "memory"（インスタンスごと）または "postgres"（複数インスタンスで共有）
+	RateLimitConfig struct {
+		Store string `mapstructure:"store"`
+	}
+
+	// OutboxConfig はドメインイベントを配送するディスパッチャーの設定。
+	// MaxAttempts 回失敗したイベントは dead として配送をあきらめる
+	OutboxConfig struct {
+		PollInterval time.Duration `mapstructure:"poll_interval"`
+		BatchSize    int32         `mapstructure:"batch_size"`
+		MaxAttempts  int32         `mapstructure:"max_attempts"`
+	}
+
+	// WebhookConfig の AllowedNetworks は内部ネットワークでも送信を許可するアドレス（CIDR または IP）。
+	// Webhookはループバックやプライベートアドレスへは送らないため、ローカル開発の受信スタブを使う場合だけ指定する
+	WebhookConfig struct {
+		AllowedNetworks []string `mapstructure:"allowed_networks"`
+	}
+
+	// SMTPConfig はメール通知を送るSMTPサーバーの設定。Host が空の場合はメール通知を無効にする。
+	// サーバーがSTARTTLSに対応していれば暗号化し、Username を指定するとPLAIN認証を行う
+	SMTPConfig struct {
+		Host     string `mapstructure:"host"`
+		Port     int    `mapstructure:"port"`
+		Username string `mapstructure:"username"`
+		Password string `mapstructure:"password"`
+		From     string `mapstructure:"from"`
+	}
+
+	// NotificationConfig はユーザーへのメール通知の設定。
+	// ReminderInterval ごとに、AssignmentExpiryNotice 以内に期限を迎える部屋の割り当てを探して知らせる
+	NotificationConfig struct {
+		SMTP                   SMTPConfig    `mapstructure:"smtp"`
+		ReminderInterval       time.Duration `mapstructure:"reminder_interval"`
+		AssignmentExpiryNotice time.Duration `mapstructure:"assignment_expiry_notice"`
+	}
+
+	// AuditCheckpointConfig は監査ログのチェックポイントの設定。
+	// File はチェックポイントを追記するJSON Linesファイル、Interval は定期作成の間隔（0なら1回だけ作成する）
+	AuditCheckpointConfig struct {
+		File           string        `mapstructure:"file"`
+		KeyID          string        `mapstructure:"key_id"`
+		PrivateKeyFile string        `mapstructure:"private_key_file"`
+		PublicKeyFile  string        `mapstructure:"public_key_file"`
+		Interval       time.Duration `mapstructure:"interval"`
+	}
+
+	AuditConfig struct {
+		Checkpoint AuditCheckpointConfig `mapstructure:"checkpoint"`
+	}
+
+	// PaginationConfig の TokenSecret は一覧のページトークンの署名に使う鍵（32バイト以上）。
+	// 空の場合は起動のたびに作る鍵を使うため、複数インスタンスや再起動をまたいでトークンを使えない
+	PaginationConfig struct {
+		TokenSecret string `mapstructure:"token_secret"`
 	}
 
 	FrontendURLConfig struct {
@@ -40,16 +142,27 @@ type (
 		Console string `mapstructure:"console"`
 	}
 
+	// Config の TrustedProxies は X-Forwarded-For を信頼するリバースプロキシのアドレス（CIDR または IP）。
+	// 空の場合は転送元のヘッダーを読まず、接続元アドレスをクライアントのアドレスとして扱う
 	Config struct {
-		Port        int               `mapstructure:"port"`
-		Env         string            `mapstructure:"env"`
-		FrontendURL FrontendURLConfig `mapstructure:"frontend_url"`
-		Postgres    DBConfig          `mapstructure:"postgres"`
-		Sentry      struct {
+		Port           int               `mapstructure:"port"`
+		TrustedProxies []string          `mapstructure:"trusted_proxies"`
+		Env            string            `mapstructure:"env"`
+		FrontendURL    FrontendURLConfig `mapstructure:"frontend_url"`
+		Postgres       DBConfig          `mapstructure:"postgres"`
+		Sentry         struct {
 			DSN string `mapstructure:"dsn"`
 		} `mapstructure:"sentry"`
-		Console ConsoleConfig `mapstructure:"console"`
-		Auth    AuthConfig    `mapstructure:"auth"`
+		App          AppConfig          `mapstructure:"app"`
+		Console      ConsoleConfig      `mapstructure:"console"`
+		Auth         AuthConfig         `mapstructure:"auth"`
+		Session      SessionConfig      `mapstructure:"session"`
+		RateLimit    RateLimitConfig    `mapstructure:"rate_limit"`
+		Audit        AuditConfig        `mapstructure:"audit"`
+		Outbox       OutboxConfig       `mapstructure:"outbox"`
+		Webhook      WebhookConfig      `mapstructure:"webhook"`
+		Notification NotificationConfig `mapstructure:"notification"`
+		Pagination   PaginationConfig   `mapstructure:"pagination"`
 	}
 )
 
@@ -63,11 +176,44 @@ func ConfigFlags(flags *pflag.FlagSet) {
 	flags.String("postgres.password", "", "DB password")
 	flags.String("postgres.database", "", "DB name")
 	flags.String("sentry.dsn", "", "Sentry DSN")
-	flags.String("console.organization_id", "", "Organization ID(uuid)")
-	flags.String("console.organization_key", "", "Organization Key")
+	flags.StringSlice("trusted_proxies", nil, "CIDRs of reverse proxies whose X-Forwarded-For is trusted (headers ignored if empty)")
+	flags.String("app.base_domain", "", "Base domain for per-organization app subdomains (disabled if empty)")
+	flags.String("console.platform_admin_token", "", "Platform admin token for managing organizations (disabled if empty)")
 	flags.String("console.jwt_secret", "", "JWT Secret for console authentication")
+	flags.String("console.jwt.signing_key_id", "", "Key ID (kid) used to sign console JWTs")
+	flags.String("console.jwt.issuer", "", "Issuer (iss) of console JWTs")
+	flags.String("console.jwt.audience", "", "Audience (aud) of console JWTs")
 	flags.String("auth.google.client_id", "", "Google OAuth Client ID")
 	flags.String("auth.google.client_secret", "", "Google OAuth Client Secret")
+	flags.String("auth.webauthn.rp_id", "localhost", "WebAuthn Relying Party ID (frontend domain)")
+	flags.String("auth.webauthn.rp_display_name", "KeyHub", "WebAuthn Relying Party display name")
+	flags.StringSlice("auth.webauthn.rp_origins", nil, "WebAuthn allowed origins (defaults to frontend_url.app)")
+	flags.Duration("session.app.idle_timeout", 24*time.Hour, "App session idle timeout (extended on use)")
+	flags.Duration("session.app.absolute_timeout", 7*24*time.Hour, "App session absolute timeout since login")
+	flags.Duration("session.console.idle_timeout", 2*time.Hour, "Console session idle timeout (extended on use)")
+	flags.Duration("session.console.absolute_timeout", 24*time.Hour, "Console session absolute timeout since login")
+	flags.String("rate_limit.store", "memory", "Rate limit store (memory, postgres)")
+	flags.Duration("outbox.poll_interval", time.Second, "Interval between polls for pending domain events")
+	flags.Int32("outbox.batch_size", 100, "Maximum number of domain events dispatched per poll")
+	flags.Int32("outbox.max_attempts", 10, "Delivery attempts before a domain event is dead-lettered")
+	flags.StringSlice("webhook.allowed_networks", nil, "CIDRs that webhooks may be sent to despite being loopback or private (for local receivers)")
+	flags.String("notification.smtp.host", "", "SMTP host for email notifications (disabled if empty)")
+	flags.Int("notification.smtp.port", 587, "SMTP port")
+	flags.String("notification.smtp.username", "", "SMTP username (no authentication if empty)")
+	flags.String("notification.smtp.password", "", "SMTP password")
+	flags.String("notification.smtp.from", "KeyHub <noreply@localhost>", "From address of email notifications")
+	flags.Duration("notification.reminder_interval", 10*time.Minute, "Interval between checks for upcoming reminders")
+	flags.Duration("notification.assignment_expiry_notice", 72*time.Hour, "How long before a room assignment expires to notify tenant members")
+	flags.String("pagination.token_secret", "", "Secret for signing list page tokens (at least 32 bytes, random per process if empty)")
+}
+
+// AuditFlags は監査ログのコマンドだけが使う設定のフラグ
+func AuditFlags(flags *pflag.FlagSet) {
+	flags.String("audit.checkpoint.file", "", "Path to the JSON Lines file that audit checkpoints are appended to")
+	flags.String("audit.checkpoint.key_id", "", "Key ID recorded with audit checkpoints")
+	flags.String("audit.checkpoint.private_key_file", "", "Path to the Ed25519 private key (PKCS#8 PEM) that signs audit checkpoints")
+	flags.String("audit.checkpoint.public_key_file", "", "Path to the Ed25519 public key (PKIX PEM) that verifies audit checkpoints")
+	flags.Duration("audit.checkpoint.interval", 0, "Interval between audit checkpoints (runs once if zero)")
 }
 
 func ParseConfig[T any](cmd *cobra.Command, args []string) error {
@@ -14,11 +14,15 @@ import (
 	"github.com/shibayama-club/keyhub/cmd/config"
 	"github.com/shibayama-club/keyhub/internal/domain/healthcheck"
 	"github.com/shibayama-club/keyhub/internal/infrastructure/auth/google"
+	"github.com/shibayama-club/keyhub/internal/infrastructure/auth/passkey"
 	"github.com/shibayama-club/keyhub/internal/infrastructure/sqlc"
 	appv1 "github.com/shibayama-club/keyhub/internal/interface/app/v1"
 	"github.com/shibayama-club/keyhub/internal/interface/app/v1/interceptor"
+	"github.com/shibayama-club/keyhub/internal/interface/audit"
+	"github.com/shibayama-club/keyhub/internal/interface/clientinfo"
 	"github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/app/v1/appv1connect"
 	"github.com/shibayama-club/keyhub/internal/interface/health"
+	"github.com/shibayama-club/keyhub/internal/interface/ratelimit"
 	"github.com/shibayama-club/keyhub/internal/interface/sentry"
 	"github.com/shibayama-club/keyhub/internal/usecase/app"
 	"github.com/spf13/cobra"
@@ -64,8 +68,14 @@ func SetupApp(ctx context.Context, cfg config.Config) (*echo.Echo, error) {
 		return nil, errors.Wrap(err, "failed to initialize Sentry")
 	}
 
+	trustedProxies, err := clientinfo.ParseTrustedProxies(cfg.TrustedProxies)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse trusted proxies")
+	}
+
 	e := echo.New()
 	e.Use(
+		echo.WrapMiddleware(trustedProxies.Middleware),
 		middleware.Recover(),
 		slogecho.New(slog.Default()),
 		middleware.CORSWithConfig(middleware.CORSConfig{
@@ -81,8 +91,11 @@ func SetupApp(ctx context.Context, cfg config.Config) (*echo.Echo, error) {
 				"X-Grpc-Web",
 				"X-User-Agent",
 				"Cookie",
+				"Authorization",
+				interceptor.HeaderCSRFToken,
+				audit.HeaderRequestID,
 			},
-			ExposeHeaders:    []string{"Content-Length", "Content-Type"},
+			ExposeHeaders:    []string{"Content-Length", "Content-Type", ratelimit.HeaderRetryAfter, audit.HeaderRequestID},
 			AllowCredentials: true,
 			MaxAge:           3600,
 		}),
@@ -106,38 +119,77 @@ func SetupApp(ctx context.Context, cfg config.Config) (*echo.Echo, error) {
 		return nil, errors.Wrap(err, "failed to create OAuth service")
 	}
 
-	appUseCase, err := app.NewUseCase(ctx, repo, cfg, oauthService)
+	// 許可するオリジンを指定しない場合はアプリのフロントエンドのみ許可する
+	rpOrigins := cfg.Auth.WebAuthn.RPOrigins
+	if len(rpOrigins) == 0 {
+		rpOrigins = []string{cfg.FrontendURL.App}
+	}
+	passkeyService, err := passkey.NewService(passkey.Config{
+		RPID:          cfg.Auth.WebAuthn.RPID,
+		RPDisplayName: cfg.Auth.WebAuthn.RPDisplayName,
+		RPOrigins:     rpOrigins,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create passkey service")
+	}
+
+	pageTokens, err := newPageTokenCodec(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	appUseCase, err := app.NewUseCase(ctx, repo, cfg, oauthService, passkeyService, pageTokens)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create app use case")
 	}
 
-	appHandler := appv1.NewHandler(appUseCase, cfg.Env, cfg.FrontendURL.App)
+	appHandler := appv1.NewHandler(appUseCase, cfg.Env, cfg.FrontendURL.App, cfg.App.BaseDomain)
 
 	e.GET("/auth/google/login", appHandler.GoogleLogin)
 	e.GET("/auth/google/callback", appHandler.GoogleCallback)
 
 	enableDetailedErrors := cfg.Env != "production"
 	sentryInterceptor := sentry.NewErrorInterceptor(enableDetailedErrors)
-	authInterceptor := interceptor.NewAuthInterceptor(appUseCase)
+	rateLimitStore, err := newRateLimitStore(cfg.RateLimit, repo)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create rate limit store")
+	}
+	// 認証の失敗は認証より外側で数える。参加コードの制限は認証済みのユーザーIDを使うため認証の内側に置く
+	authFailureInterceptor := interceptor.NewAuthFailureInterceptor(rateLimitStore)
+	rateLimitInterceptor := interceptor.NewRateLimitInterceptor(rateLimitStore)
+	authInterceptor := interceptor.NewAuthInterceptor(appUseCase, cfg.Env)
+	auditInterceptor := audit.NewInterceptor(appUseCase)
 
 	authPath, authHandler := appv1connect.NewAuthServiceHandler(
 		appHandler,
-		connect.WithInterceptors(sentryInterceptor, authInterceptor),
+		connect.WithInterceptors(sentryInterceptor, authFailureInterceptor, authInterceptor, auditInterceptor, rateLimitInterceptor),
 	)
 	e.Any(authPath+"*", echo.WrapHandler(authHandler))
 
 	tenantPath, tenantHandler := appv1connect.NewTenantServiceHandler(
 		appHandler,
-		connect.WithInterceptors(sentryInterceptor, authInterceptor),
+		connect.WithInterceptors(sentryInterceptor, authFailureInterceptor, authInterceptor, auditInterceptor, rateLimitInterceptor),
 	)
 	e.Any(tenantPath+"*", echo.WrapHandler(tenantHandler))
 
 	roomPath, roomHandler := appv1connect.NewRoomServiceHandler(
 		appHandler,
-		connect.WithInterceptors(sentryInterceptor, authInterceptor),
+		connect.WithInterceptors(sentryInterceptor, authFailureInterceptor, authInterceptor, auditInterceptor, rateLimitInterceptor),
 	)
 	e.Any(roomPath+"*", echo.WrapHandler(roomHandler))
 
+	apiTokenPath, apiTokenHandler := appv1connect.NewApiTokenServiceHandler(
+		appHandler,
+		connect.WithInterceptors(sentryInterceptor, authFailureInterceptor, authInterceptor, auditInterceptor, rateLimitInterceptor),
+	)
+	e.Any(apiTokenPath+"*", echo.WrapHandler(apiTokenHandler))
+
+	notificationPath, notificationHandler := appv1connect.NewNotificationServiceHandler(
+		appHandler,
+		connect.WithInterceptors(sentryInterceptor, authFailureInterceptor, authInterceptor, auditInterceptor, rateLimitInterceptor),
+	)
+	e.Any(notificationPath+"*", echo.WrapHandler(notificationHandler))
+
 	healthHandler := health.NewHealthCheck(healthCheckers...)
 	e.GET("/keyhub.app.v1.HealthService/Check", healthHandler.Check)
 
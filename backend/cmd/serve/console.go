@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"os"
 
 	"connectrpc.com/connect"
 	"github.com/cockroachdb/errors"
@@ -15,11 +16,17 @@ import (
 	"github.com/shibayama-club/keyhub/cmd/config"
 	"github.com/shibayama-club/keyhub/internal/domain/healthcheck"
 	consoleauth "github.com/shibayama-club/keyhub/internal/infrastructure/auth/console"
+	"github.com/shibayama-club/keyhub/internal/infrastructure/jwt"
+	"github.com/shibayama-club/keyhub/internal/infrastructure/pgnotify"
 	"github.com/shibayama-club/keyhub/internal/infrastructure/sqlc"
+	"github.com/shibayama-club/keyhub/internal/infrastructure/webhook"
+	"github.com/shibayama-club/keyhub/internal/interface/audit"
+	"github.com/shibayama-club/keyhub/internal/interface/clientinfo"
 	consolev1 "github.com/shibayama-club/keyhub/internal/interface/console/v1"
 	"github.com/shibayama-club/keyhub/internal/interface/console/v1/interceptor"
 	"github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1/consolev1connect"
 	"github.com/shibayama-club/keyhub/internal/interface/health"
+	"github.com/shibayama-club/keyhub/internal/interface/ratelimit"
 	"github.com/shibayama-club/keyhub/internal/interface/sentry"
 	"github.com/shibayama-club/keyhub/internal/usecase/console"
 	"github.com/spf13/cobra"
@@ -66,14 +73,22 @@ func SetupConsole(ctx context.Context, cfg config.Config) (*echo.Echo, error) {
 		return nil, errors.Wrap(err, "failed to initialize Sentry")
 	}
 
+	trustedProxies, err := clientinfo.ParseTrustedProxies(cfg.TrustedProxies)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse trusted proxies")
+	}
+
 	e := echo.New()
 	e.Use(
+		echo.WrapMiddleware(trustedProxies.Middleware),
 		middleware.Recover(),
 		slogecho.New(slog.Default()),
 		middleware.CORSWithConfig(middleware.CORSConfig{
 			AllowOrigins: []string{cfg.FrontendURL.Console},
 			AllowMethods: []string{http.MethodGet, http.MethodPost, http.MethodOptions},
 			AllowHeaders: []string{"*"},
+			// スライディング更新で再発行したトークンとレート制限の待ち時間をフロントエンドから読めるようにする
+			ExposeHeaders: []string{interceptor.HeaderSessionToken, interceptor.HeaderSessionExpiresIn, ratelimit.HeaderRetryAfter, audit.HeaderRequestID},
 		}),
 	)
 
@@ -86,59 +101,207 @@ func SetupConsole(ctx context.Context, cfg config.Config) (*echo.Echo, error) {
 	repo := sqlc.NewRepository(pool)
 	healthCheckers = append(healthCheckers, healthcheck.NewHealthCheckFunc("repository", repo.Ping))
 
-	jwtSecret := cfg.Console.JWTSecret
-	if jwtSecret == "" {
-		jwtSecret = console.DEFAULT_JWT_SECRET
+	jwtKeys, err := newConsoleJWTKeySet(cfg.Console)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load console JWT keys")
 	}
-	consoleAuth, err := consoleauth.NewAuthService(jwtSecret)
+	consoleAuth, err := consoleauth.NewAuthService(jwtKeys, cfg.Console.JWT.Issuer, cfg.Console.JWT.Audience)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create console auth service")
 	}
 
-	consoleUseCase, err := console.NewUseCase(ctx, repo, cfg, consoleAuth)
+	webhookSender, err := webhook.NewHTTPSender(cfg.Webhook.AllowedNetworks)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create webhook sender")
+	}
+
+	// WatchKeys の購読者に、どのインスタンスで行われた鍵の変更も届ける
+	keyWatcher := pgnotify.NewKeyWatcher(pool)
+	go keyWatcher.Run(ctx)
+
+	pageTokens, err := newPageTokenCodec(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	consoleUseCase, err := console.NewUseCase(ctx, repo, cfg, consoleAuth, webhookSender, keyWatcher, pageTokens)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create console use case")
 	}
 
+	// バックグラウンド処理はConsoleサーバーだけが起動する。Appサーバーはイベントを記録するだけで配送しない
+	if err := startOutboxDispatcher(ctx, cfg, repo, webhookSender); err != nil {
+		return nil, err
+	}
+
 	enableDetailedErrors := cfg.Env != "production"
 	sentryInterceptor := sentry.NewErrorInterceptor(enableDetailedErrors)
-	authInterceptor := interceptor.NewAuthInterceptor(consoleUseCase)
-
-	consoleHandler, err := consolev1.NewHandler(consoleUseCase, jwtSecret)
+	rateLimitStore, err := newRateLimitStore(cfg.RateLimit, repo)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to create console handler")
+		return nil, errors.Wrap(err, "failed to create rate limit store")
 	}
+	// レート制限は認証済みの情報を使わないため、認証の失敗とあわせて認証より外側で数える
+	authFailureInterceptor := interceptor.NewAuthFailureInterceptor(rateLimitStore)
+	rateLimitInterceptor := interceptor.NewRateLimitInterceptor(rateLimitStore)
+	authInterceptor := interceptor.NewAuthInterceptor(consoleUseCase)
+	auditInterceptor := audit.NewInterceptor(consoleUseCase)
+	permissionInterceptor := interceptor.NewPermissionInterceptor()
+
+	consoleHandler := consolev1.NewHandler(consoleUseCase, consoleAuth)
+
+	e.GET("/.well-known/jwks.json", consoleHandler.JWKS)
 
 	// ConsoleAuthServiceをConnectRPCに登録
 	authPath, authHandler := consolev1connect.NewConsoleAuthServiceHandler(
 		consoleHandler,
-		connect.WithInterceptors(sentryInterceptor, authInterceptor),
+		connect.WithInterceptors(sentryInterceptor, authFailureInterceptor, rateLimitInterceptor, authInterceptor, auditInterceptor, permissionInterceptor),
 	)
 	e.Any(authPath+"*", echo.WrapHandler(authHandler))
 
 	// ConsoleServiceをConnectRPCに登録
 	servicePath, serviceHandler := consolev1connect.NewConsoleServiceHandler(
 		consoleHandler,
-		connect.WithInterceptors(sentryInterceptor, authInterceptor),
+		connect.WithInterceptors(sentryInterceptor, authFailureInterceptor, rateLimitInterceptor, authInterceptor, auditInterceptor, permissionInterceptor),
 	)
 	e.Any(servicePath+"*", echo.WrapHandler(serviceHandler))
 
 	// ConsoleRoomServiceをConnectRPCに登録
 	roomPath, roomHandler := consolev1connect.NewConsoleRoomServiceHandler(
 		consoleHandler,
-		connect.WithInterceptors(sentryInterceptor, authInterceptor),
+		connect.WithInterceptors(sentryInterceptor, authFailureInterceptor, rateLimitInterceptor, authInterceptor, auditInterceptor, permissionInterceptor),
 	)
 	e.Any(roomPath+"*", echo.WrapHandler(roomHandler))
 
+	// ConsoleBuildingServiceをConnectRPCに登録
+	buildingPath, buildingHandler := consolev1connect.NewConsoleBuildingServiceHandler(
+		consoleHandler,
+		connect.WithInterceptors(sentryInterceptor, authFailureInterceptor, rateLimitInterceptor, authInterceptor, auditInterceptor, permissionInterceptor),
+	)
+	e.Any(buildingPath+"*", echo.WrapHandler(buildingHandler))
+
 	// ConsoleKeyServiceをConnectRPCに登録
 	keyPath, keyHandler := consolev1connect.NewConsoleKeyServiceHandler(
 		consoleHandler,
-		connect.WithInterceptors(sentryInterceptor, authInterceptor),
+		connect.WithInterceptors(sentryInterceptor, authFailureInterceptor, rateLimitInterceptor, authInterceptor, auditInterceptor, permissionInterceptor),
 	)
 	e.Any(keyPath+"*", echo.WrapHandler(keyHandler))
 
+	// ConsoleApiTokenServiceをConnectRPCに登録
+	apiTokenPath, apiTokenHandler := consolev1connect.NewConsoleApiTokenServiceHandler(
+		consoleHandler,
+		connect.WithInterceptors(sentryInterceptor, authFailureInterceptor, rateLimitInterceptor, authInterceptor, auditInterceptor, permissionInterceptor),
+	)
+	e.Any(apiTokenPath+"*", echo.WrapHandler(apiTokenHandler))
+
+	// ConsoleTenantGroupServiceをConnectRPCに登録
+	tenantGroupPath, tenantGroupHandler := consolev1connect.NewConsoleTenantGroupServiceHandler(
+		consoleHandler,
+		connect.WithInterceptors(sentryInterceptor, authFailureInterceptor, rateLimitInterceptor, authInterceptor, auditInterceptor, permissionInterceptor),
+	)
+	e.Any(tenantGroupPath+"*", echo.WrapHandler(tenantGroupHandler))
+
+	// ConsoleOperatorServiceをConnectRPCに登録
+	operatorPath, operatorHandler := consolev1connect.NewConsoleOperatorServiceHandler(
+		consoleHandler,
+		connect.WithInterceptors(sentryInterceptor, authFailureInterceptor, rateLimitInterceptor, authInterceptor, auditInterceptor, permissionInterceptor),
+	)
+	e.Any(operatorPath+"*", echo.WrapHandler(operatorHandler))
+
+	// ConsoleAuditServiceをConnectRPCに登録
+	auditPath, auditHandler := consolev1connect.NewConsoleAuditServiceHandler(
+		consoleHandler,
+		connect.WithInterceptors(sentryInterceptor, authFailureInterceptor, rateLimitInterceptor, authInterceptor, auditInterceptor, permissionInterceptor),
+	)
+	e.Any(auditPath+"*", echo.WrapHandler(auditHandler))
+
+	// ConsoleSearchServiceをConnectRPCに登録
+	searchPath, searchHandler := consolev1connect.NewConsoleSearchServiceHandler(
+		consoleHandler,
+		connect.WithInterceptors(sentryInterceptor, authFailureInterceptor, rateLimitInterceptor, authInterceptor, auditInterceptor, permissionInterceptor),
+	)
+	e.Any(searchPath+"*", echo.WrapHandler(searchHandler))
+
+	// ConsoleWebhookServiceをConnectRPCに登録
+	webhookPath, webhookHandler := consolev1connect.NewConsoleWebhookServiceHandler(
+		consoleHandler,
+		connect.WithInterceptors(sentryInterceptor, authFailureInterceptor, rateLimitInterceptor, authInterceptor, auditInterceptor, permissionInterceptor),
+	)
+	e.Any(webhookPath+"*", echo.WrapHandler(webhookHandler))
+
+	// ConsolePlatformServiceをConnectRPCに登録
+	platformPath, platformHandler := consolev1connect.NewConsolePlatformServiceHandler(
+		consoleHandler,
+		connect.WithInterceptors(sentryInterceptor, authFailureInterceptor, rateLimitInterceptor, authInterceptor, auditInterceptor, permissionInterceptor),
+	)
+	e.Any(platformPath+"*", echo.WrapHandler(platformHandler))
+
 	healthHandler := health.NewHealthCheck(healthCheckers...)
 	e.GET("/keyhub.console.v1.HealthService/Check", healthHandler.Check)
 
 	return e, nil
 }
+
+// legacyJWTKeyID は console.jwt_secret から作成するHS256鍵のkid
+const legacyJWTKeyID = "default"
+
+// newConsoleJWTKeySet は設定からコンソールJWTの鍵セットを組み立てる。
+// console.jwt.keys が未設定の場合は従来通り console.jwt_secret のみで署名する。
+// 設定済みの場合も console.jwt_secret があれば、kidや iss / aud を持たない発行済みトークンの検証用に残す。
+func newConsoleJWTKeySet(cfg config.ConsoleConfig) (*jwt.KeySet, error) {
+	keys := make([]*jwt.Key, 0, len(cfg.JWT.Keys)+1)
+	for _, keyCfg := range cfg.JWT.Keys {
+		if keyCfg.ID == "" {
+			return nil, errors.New("console.jwt.keys[].id is required")
+		}
+
+		var (
+			key *jwt.Key
+			err error
+		)
+		switch {
+		case keyCfg.Secret != "":
+			key, err = jwt.NewHMACKey(keyCfg.ID, keyCfg.Secret)
+		case keyCfg.PrivateKeyFile != "":
+			pemBytes, readErr := os.ReadFile(keyCfg.PrivateKeyFile)
+			if readErr != nil {
+				return nil, errors.Wrapf(readErr, "failed to read key file for kid %q", keyCfg.ID)
+			}
+			key, err = jwt.ParsePEMKey(keyCfg.ID, pemBytes)
+		default:
+			return nil, errors.Newf("console.jwt.keys[%s] requires secret or private_key_file", keyCfg.ID)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to load key %q", keyCfg.ID)
+		}
+		keys = append(keys, key)
+	}
+
+	legacySecret := cfg.JWTSecret
+	if legacySecret == "" && len(keys) == 0 {
+		legacySecret = console.DEFAULT_JWT_SECRET
+	}
+	if legacySecret != "" {
+		legacyKey, err := jwt.NewHMACKey(legacyJWTKeyID, legacySecret)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load console.jwt_secret")
+		}
+		keys = append(keys, legacyKey)
+	}
+
+	signingKeyID := cfg.JWT.SigningKeyID
+	if signingKeyID == "" {
+		signingKeyID = keys[0].ID()
+	}
+
+	keySet, err := jwt.NewKeySet(signingKeyID, keys...)
+	if err != nil {
+		return nil, err
+	}
+	// iss / aud を付ける前に console.jwt_secret で発行したトークンは、失効するまで再ログインなしで使えるようにする
+	if legacySecret != "" {
+		if err := keySet.SetLegacyKey(legacyJWTKeyID); err != nil {
+			return nil, err
+		}
+	}
+	return keySet, nil
+}
@@ -0,0 +1,44 @@
+package serve
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/cockroachdb/errors"
+	"github.com/shibayama-club/keyhub/cmd/config"
+	"github.com/shibayama-club/keyhub/internal/domain/event"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+	domainwebhook "github.com/shibayama-club/keyhub/internal/domain/webhook"
+	"github.com/shibayama-club/keyhub/internal/infrastructure/smtp"
+	"github.com/shibayama-club/keyhub/internal/usecase/notification"
+	"github.com/shibayama-club/keyhub/internal/usecase/outbox"
+	"github.com/shibayama-club/keyhub/internal/usecase/webhook"
+)
+
+// startOutboxDispatcher はドメインイベントのディスパッチャーをバックグラウンドで起動する。
+// Consoleサーバーだけが起動し、Appサーバーが記録したイベントもConsoleサーバーのいずれかのインスタンスが配送する。
+// SMTPサーバーを設定している場合は、メール通知とその定期確認もあわせて起動する
+func startOutboxDispatcher(ctx context.Context, cfg config.Config, repo repository.Repository, webhookSender domainwebhook.Sender) error {
+	handlers := []event.Handler{
+		webhook.NewHandler(repo, webhookSender),
+	}
+
+	if cfg.Notification.SMTP.Host != "" {
+		mailer, err := smtp.NewMailer(cfg.Notification.SMTP)
+		if err != nil {
+			return errors.Wrap(err, "failed to create smtp mailer")
+		}
+		notifier, err := notification.NewNotifier(repo, mailer, cfg.FrontendURL.App)
+		if err != nil {
+			return errors.Wrap(err, "failed to create notifier")
+		}
+		handlers = append(handlers, notifier)
+
+		go notification.NewReminder(notifier, cfg.Notification).Run(ctx)
+	} else {
+		slog.Info("email notifications are disabled because notification.smtp.host is empty")
+	}
+
+	go outbox.NewDispatcher(repo, cfg.Outbox, handlers...).Run(ctx)
+	return nil
+}
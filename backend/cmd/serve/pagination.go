@@ -0,0 +1,28 @@
+package serve
+
+import (
+	"log/slog"
+
+	"github.com/cockroachdb/errors"
+	"github.com/shibayama-club/keyhub/cmd/config"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+)
+
+// newPageTokenCodec は一覧のページトークンを署名する鍵を設定から作る。
+// 鍵を設定していない場合、production では起動せず、それ以外では起動のたびに作る鍵を使う
+func newPageTokenCodec(cfg config.Config) (model.PageTokenCodec, error) {
+	secret := cfg.Pagination.TokenSecret
+	if secret == "" {
+		if cfg.Env == "production" {
+			return model.PageTokenCodec{}, errors.New("pagination.token_secret is required in production")
+		}
+		slog.Warn("pagination.token_secret is not set; page tokens are only valid within this process")
+		return model.NewRandomPageTokenCodec(), nil
+	}
+
+	codec, err := model.NewPageTokenCodec([]byte(secret))
+	if err != nil {
+		return model.PageTokenCodec{}, errors.Wrap(err, "invalid pagination.token_secret")
+	}
+	return codec, nil
+}
@@ -0,0 +1,20 @@
+package serve
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/shibayama-club/keyhub/cmd/config"
+	domainratelimit "github.com/shibayama-club/keyhub/internal/domain/ratelimit"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+	"github.com/shibayama-club/keyhub/internal/infrastructure/ratelimit"
+)
+
+func newRateLimitStore(cfg config.RateLimitConfig, repo repository.Repository) (domainratelimit.Store, error) {
+	switch cfg.Store {
+	case "", "memory":
+		return ratelimit.NewMemoryStore(), nil
+	case "postgres":
+		return ratelimit.NewPostgresStore(repo), nil
+	default:
+		return nil, errors.Newf("unknown rate limit store: %s", cfg.Store)
+	}
+}
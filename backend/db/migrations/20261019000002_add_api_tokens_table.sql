@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query - API Tokens Table';
+
+CREATE TABLE api_tokens (
+    id UUID NOT NULL DEFAULT UUID_GENERATE_V4(),
+    user_id UUID,
+    organization_id UUID,
+    name TEXT NOT NULL,
+    token_prefix TEXT NOT NULL,
+    token_hash TEXT NOT NULL,
+    scopes TEXT[] NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    last_used_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT api_tokens_token_hash_key UNIQUE (token_hash),
+    CONSTRAINT api_tokens_owner_check CHECK ((user_id IS NULL) <> (organization_id IS NULL))
+);
+
+GRANT SELECT,INSERT,UPDATE,DELETE ON TABLE api_tokens TO keyhub;
+
+CREATE INDEX idx_api_tokens_user ON api_tokens(user_id);
+CREATE INDEX idx_api_tokens_organization ON api_tokens(organization_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query - api_tokens table rollback';
+
+DROP INDEX IF EXISTS idx_api_tokens_organization;
+DROP INDEX IF EXISTS idx_api_tokens_user;
+
+DROP TABLE IF EXISTS api_tokens;
+-- +goose StatementEnd
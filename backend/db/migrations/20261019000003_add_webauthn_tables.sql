@@ -0,0 +1,54 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query - WebAuthn Credentials and Ceremonies Tables';
+
+CREATE TABLE webauthn_credentials (
+    id UUID NOT NULL DEFAULT UUID_GENERATE_V4(),
+    user_id UUID NOT NULL,
+    name TEXT NOT NULL,
+    credential_id BYTEA NOT NULL,
+    public_key BYTEA NOT NULL,
+    attestation_type TEXT NOT NULL,
+    aaguid BYTEA NOT NULL,
+    sign_count BIGINT NOT NULL DEFAULT 0,
+    transports TEXT[] NOT NULL DEFAULT '{}',
+    backup_eligible BOOLEAN NOT NULL DEFAULT FALSE,
+    backup_state BOOLEAN NOT NULL DEFAULT FALSE,
+    last_used_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT webauthn_credentials_credential_id_key UNIQUE (credential_id)
+);
+
+GRANT SELECT,INSERT,UPDATE,DELETE ON TABLE webauthn_credentials TO keyhub;
+
+CREATE INDEX idx_webauthn_credentials_user ON webauthn_credentials(user_id);
+
+-- 登録・認証セレモニーのチャレンジを一時的に保持する。Finish 時に削除して再利用を防ぐ
+CREATE TABLE webauthn_ceremonies (
+    id TEXT NOT NULL,
+    ceremony_type TEXT NOT NULL CHECK (ceremony_type IN ('registration', 'login')),
+    user_id UUID,
+    session_data JSONB NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+GRANT SELECT,INSERT,UPDATE,DELETE ON TABLE webauthn_ceremonies TO keyhub;
+
+CREATE INDEX idx_webauthn_ceremonies_expires ON webauthn_ceremonies(expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query - webauthn tables rollback';
+
+DROP INDEX IF EXISTS idx_webauthn_ceremonies_expires;
+DROP TABLE IF EXISTS webauthn_ceremonies;
+
+DROP INDEX IF EXISTS idx_webauthn_credentials_user;
+DROP TABLE IF EXISTS webauthn_credentials;
+-- +goose StatementEnd
@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query - Rate Limit Tables';
+
+-- 複数インスタンスでレート制限を共有するためのトークンバケット（rate_limit.store = postgres の場合のみ使用）
+CREATE TABLE rate_limit_buckets (
+    key TEXT NOT NULL,
+    tokens DOUBLE PRECISION NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    idle_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (key)
+);
+
+GRANT SELECT,INSERT,UPDATE,DELETE ON TABLE rate_limit_buckets TO keyhub;
+
+CREATE INDEX idx_rate_limit_buckets_idle ON rate_limit_buckets(idle_at);
+
+-- 連続した失敗回数とロックアウト
+CREATE TABLE rate_limit_failures (
+    key TEXT NOT NULL,
+    failure_count INTEGER NOT NULL DEFAULT 0,
+    window_started_at TIMESTAMPTZ NOT NULL,
+    locked_until TIMESTAMPTZ,
+    idle_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (key)
+);
+
+GRANT SELECT,INSERT,UPDATE,DELETE ON TABLE rate_limit_failures TO keyhub;
+
+CREATE INDEX idx_rate_limit_failures_idle ON rate_limit_failures(idle_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query - rate limit tables rollback';
+
+DROP INDEX IF EXISTS idx_rate_limit_failures_idle;
+DROP TABLE IF EXISTS rate_limit_failures;
+
+DROP INDEX IF EXISTS idx_rate_limit_buckets_idle;
+DROP TABLE IF EXISTS rate_limit_buckets;
+-- +goose StatementEnd
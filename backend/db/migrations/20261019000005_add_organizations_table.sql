@@ -0,0 +1,63 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query - Organizations Table';
+
+CREATE TABLE organizations (
+    id UUID NOT NULL DEFAULT UUID_GENERATE_V4(),
+    name TEXT NOT NULL,
+    slug TEXT NOT NULL,
+    settings JSONB NOT NULL DEFAULT '{}',
+    -- Consoleログインに使うキーのハッシュ。NULLの場合はキーを発行するまでログインできない
+    key_hash TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    CONSTRAINT organizations_slug_key UNIQUE (slug),
+    CONSTRAINT organizations_key_hash_key UNIQUE (key_hash)
+);
+
+GRANT SELECT,INSERT,UPDATE,DELETE ON TABLE organizations TO keyhub;
+
+-- 既存データが参照している組織を登録する。キーは未発行のため、プラットフォーム管理者が発行するまでログインできない
+INSERT INTO organizations (id, name, slug)
+SELECT organization_id, organization_id::TEXT, organization_id::TEXT
+FROM (
+    SELECT organization_id FROM tenants
+    UNION SELECT organization_id FROM rooms
+    UNION SELECT organization_id FROM keys
+    UNION SELECT organization_id FROM console_sessions
+    UNION SELECT organization_id FROM api_tokens WHERE organization_id IS NOT NULL
+) AS existing;
+
+ALTER TABLE tenants ALTER COLUMN organization_id DROP DEFAULT;
+ALTER TABLE rooms ALTER COLUMN organization_id DROP DEFAULT;
+ALTER TABLE keys ALTER COLUMN organization_id DROP DEFAULT;
+
+ALTER TABLE tenants ADD CONSTRAINT tenants_organization_id_fkey
+    FOREIGN KEY (organization_id) REFERENCES organizations(id);
+ALTER TABLE rooms ADD CONSTRAINT rooms_organization_id_fkey
+    FOREIGN KEY (organization_id) REFERENCES organizations(id);
+ALTER TABLE keys ADD CONSTRAINT keys_organization_id_fkey
+    FOREIGN KEY (organization_id) REFERENCES organizations(id);
+ALTER TABLE console_sessions ADD CONSTRAINT console_sessions_organization_id_fkey
+    FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE;
+ALTER TABLE api_tokens ADD CONSTRAINT api_tokens_organization_id_fkey
+    FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query - organizations table rollback';
+
+ALTER TABLE api_tokens DROP CONSTRAINT IF EXISTS api_tokens_organization_id_fkey;
+ALTER TABLE console_sessions DROP CONSTRAINT IF EXISTS console_sessions_organization_id_fkey;
+ALTER TABLE keys DROP CONSTRAINT IF EXISTS keys_organization_id_fkey;
+ALTER TABLE rooms DROP CONSTRAINT IF EXISTS rooms_organization_id_fkey;
+ALTER TABLE tenants DROP CONSTRAINT IF EXISTS tenants_organization_id_fkey;
+
+ALTER TABLE keys ALTER COLUMN organization_id SET DEFAULT '550e8400-e29b-41d4-a716-446655440000';
+ALTER TABLE rooms ALTER COLUMN organization_id SET DEFAULT '550e8400-e29b-41d4-a716-446655440000';
+ALTER TABLE tenants ALTER COLUMN organization_id SET DEFAULT '550e8400-e29b-41d4-a716-446655440000';
+
+DROP TABLE IF EXISTS organizations;
+-- +goose StatementEnd
@@ -0,0 +1,83 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query - Scope App Sessions, API Tokens and Join Codes to Organization';
+
+-- 組織が1つだけの環境では既存のセッション・APIトークンをその組織に割り当てる。
+-- 複数ある場合は所属を決められないため削除し、再ログイン・再発行してもらう
+ALTER TABLE sessions ADD COLUMN organization_id UUID;
+UPDATE sessions SET organization_id = (SELECT id FROM organizations)
+WHERE (SELECT COUNT(*) FROM organizations) = 1;
+DELETE FROM sessions WHERE organization_id IS NULL;
+ALTER TABLE sessions ALTER COLUMN organization_id SET NOT NULL;
+ALTER TABLE sessions ADD CONSTRAINT sessions_organization_id_fkey
+    FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE;
+
+-- ログイン開始時に選んだ組織をコールバックまで引き継ぐ。有効期限が短いため既存の状態は破棄する
+DELETE FROM oauth_states;
+ALTER TABLE oauth_states ADD COLUMN organization_id UUID NOT NULL;
+ALTER TABLE oauth_states ADD CONSTRAINT oauth_states_organization_id_fkey
+    FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE;
+
+-- ユーザーのAPIトークンも発行したセッションの組織に属する。user_id が NULL のものは組織のトークン
+UPDATE api_tokens SET organization_id = (SELECT id FROM organizations)
+WHERE organization_id IS NULL AND (SELECT COUNT(*) FROM organizations) = 1;
+DELETE FROM api_tokens WHERE organization_id IS NULL;
+ALTER TABLE api_tokens DROP CONSTRAINT api_tokens_owner_check;
+ALTER TABLE api_tokens ALTER COLUMN organization_id SET NOT NULL;
+
+-- 参加コードは組織ごとに一意にし、他の組織のテナントを参加コードで引けないようにする
+ALTER TABLE tenant_join_codes ADD COLUMN organization_id UUID;
+UPDATE tenant_join_codes tjc SET organization_id = t.organization_id
+FROM tenants t WHERE tjc.tenant_id = t.id;
+ALTER TABLE tenant_join_codes ALTER COLUMN organization_id SET NOT NULL;
+ALTER TABLE tenant_join_codes ADD CONSTRAINT tenant_join_codes_organization_id_fkey
+    FOREIGN KEY (organization_id) REFERENCES organizations(id);
+ALTER TABLE tenant_join_codes DROP CONSTRAINT tenant_join_codes_code_key;
+ALTER TABLE tenant_join_codes ADD CONSTRAINT tenant_join_codes_organization_id_code_key
+    UNIQUE (organization_id, code);
+
+DROP POLICY IF EXISTS tenant_join_codes_org_isolation ON tenant_join_codes;
+CREATE POLICY tenant_join_codes_org_isolation ON tenant_join_codes
+    FOR ALL
+    TO keyhub
+    USING (
+        current_organization_id() IS NULL
+        OR organization_id = current_organization_id()
+    );
+
+CREATE INDEX idx_sessions_organization ON sessions(organization_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query - organization scope rollback';
+
+DROP INDEX IF EXISTS idx_sessions_organization;
+
+DROP POLICY IF EXISTS tenant_join_codes_org_isolation ON tenant_join_codes;
+CREATE POLICY tenant_join_codes_org_isolation ON tenant_join_codes
+    FOR ALL
+    TO keyhub
+    USING (
+        current_organization_id() IS NULL
+        OR tenant_id IN (
+            SELECT id FROM tenants WHERE organization_id = current_organization_id()
+        )
+    );
+
+ALTER TABLE tenant_join_codes DROP CONSTRAINT IF EXISTS tenant_join_codes_organization_id_code_key;
+ALTER TABLE tenant_join_codes ADD CONSTRAINT tenant_join_codes_code_key UNIQUE (code);
+ALTER TABLE tenant_join_codes DROP CONSTRAINT IF EXISTS tenant_join_codes_organization_id_fkey;
+ALTER TABLE tenant_join_codes DROP COLUMN IF EXISTS organization_id;
+
+ALTER TABLE api_tokens ALTER COLUMN organization_id DROP NOT NULL;
+UPDATE api_tokens SET organization_id = NULL WHERE user_id IS NOT NULL;
+ALTER TABLE api_tokens ADD CONSTRAINT api_tokens_owner_check
+    CHECK ((user_id IS NULL) <> (organization_id IS NULL));
+
+ALTER TABLE oauth_states DROP CONSTRAINT IF EXISTS oauth_states_organization_id_fkey;
+ALTER TABLE oauth_states DROP COLUMN IF EXISTS organization_id;
+
+ALTER TABLE sessions DROP CONSTRAINT IF EXISTS sessions_organization_id_fkey;
+ALTER TABLE sessions DROP COLUMN IF EXISTS organization_id;
+-- +goose StatementEnd
@@ -0,0 +1,92 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query - Tenant Groups Table';
+
+-- テナント内の研究室やチームなどの小単位。parent_group_id で入れ子にでき、
+-- 子グループのメンバーは親グループに割り当てられた部屋も利用できる
+CREATE TABLE tenant_groups (
+    id UUID NOT NULL DEFAULT UUID_GENERATE_V4(),
+    tenant_id UUID NOT NULL,
+    organization_id UUID NOT NULL,
+    parent_group_id UUID,
+    name TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    FOREIGN KEY (tenant_id) REFERENCES tenants(id) ON DELETE CASCADE,
+    FOREIGN KEY (organization_id) REFERENCES organizations(id),
+    FOREIGN KEY (parent_group_id) REFERENCES tenant_groups(id) ON DELETE CASCADE,
+    CONSTRAINT tenant_groups_tenant_id_name_key UNIQUE (tenant_id, name)
+);
+
+GRANT SELECT,INSERT,UPDATE,DELETE ON TABLE tenant_groups TO keyhub;
+
+CREATE INDEX idx_tenant_groups_tenant ON tenant_groups(tenant_id);
+CREATE INDEX idx_tenant_groups_parent ON tenant_groups(parent_group_id);
+
+ALTER TABLE tenant_groups ENABLE ROW LEVEL SECURITY;
+ALTER TABLE tenant_groups FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY tenant_groups_org_isolation ON tenant_groups
+    FOR ALL
+    TO keyhub
+    USING (
+        current_organization_id() IS NULL
+        OR organization_id = current_organization_id()
+    );
+
+CREATE TRIGGER refresh_tenant_groups_updated_at
+BEFORE UPDATE ON tenant_groups
+FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TABLE tenant_group_memberships (
+    group_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (group_id, user_id),
+    FOREIGN KEY (group_id) REFERENCES tenant_groups(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+GRANT SELECT,INSERT,UPDATE,DELETE ON TABLE tenant_group_memberships TO keyhub;
+
+CREATE INDEX idx_tenant_group_memberships_user ON tenant_group_memberships(user_id);
+
+ALTER TABLE tenant_group_memberships ENABLE ROW LEVEL SECURITY;
+ALTER TABLE tenant_group_memberships FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY tenant_group_memberships_org_isolation ON tenant_group_memberships
+    FOR ALL
+    TO keyhub
+    USING (
+        current_organization_id() IS NULL
+        OR group_id IN (
+            SELECT id FROM tenant_groups WHERE organization_id = current_organization_id()
+        )
+    );
+
+-- group_id を指定した割り当てはそのグループ（子グループを含む）のメンバーだけが利用できる。
+-- key_loan_group_id を指定した場合、鍵を借りられるのはそのグループのメンバーだけになる
+ALTER TABLE room_assignments ADD COLUMN group_id UUID REFERENCES tenant_groups(id);
+ALTER TABLE room_assignments ADD COLUMN key_loan_group_id UUID REFERENCES tenant_groups(id);
+
+CREATE INDEX idx_room_assignments_tenant ON room_assignments(tenant_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query - tenant groups table rollback';
+
+DROP INDEX IF EXISTS idx_room_assignments_tenant;
+
+ALTER TABLE room_assignments DROP COLUMN IF EXISTS key_loan_group_id;
+ALTER TABLE room_assignments DROP COLUMN IF EXISTS group_id;
+
+DROP POLICY IF EXISTS tenant_group_memberships_org_isolation ON tenant_group_memberships;
+DROP TABLE IF EXISTS tenant_group_memberships;
+
+DROP TRIGGER IF EXISTS refresh_tenant_groups_updated_at ON tenant_groups;
+DROP POLICY IF EXISTS tenant_groups_org_isolation ON tenant_groups;
+DROP TABLE IF EXISTS tenant_groups;
+-- +goose StatementEnd
@@ -0,0 +1,56 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query - Console Operators Table';
+
+-- 組織のキーを共有せずにコンソールを使うための管理者アカウント。ロールで操作できる範囲を制限する
+CREATE TABLE console_operators (
+    id UUID NOT NULL DEFAULT UUID_GENERATE_V4(),
+    organization_id UUID NOT NULL,
+    name TEXT NOT NULL,
+    role TEXT NOT NULL,
+    key_hash TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    revoked_at TIMESTAMPTZ,
+    PRIMARY KEY (id),
+    FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE,
+    CONSTRAINT console_operators_key_hash_key UNIQUE (key_hash),
+    CONSTRAINT console_operators_role_check CHECK (role IN ('admin', 'operator', 'auditor'))
+);
+
+GRANT SELECT,INSERT,UPDATE,DELETE ON TABLE console_operators TO keyhub;
+
+CREATE INDEX idx_console_operators_organization ON console_operators(organization_id);
+
+ALTER TABLE console_operators ENABLE ROW LEVEL SECURITY;
+ALTER TABLE console_operators FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY console_operators_org_isolation ON console_operators
+    FOR ALL
+    TO keyhub
+    USING (
+        current_organization_id() IS NULL
+        OR organization_id = current_organization_id()
+    );
+
+-- 既存のセッションは組織のキーでログインしたものなので owner とする
+ALTER TABLE console_sessions ADD COLUMN role TEXT NOT NULL DEFAULT 'owner';
+ALTER TABLE console_sessions ALTER COLUMN role DROP DEFAULT;
+ALTER TABLE console_sessions ADD CONSTRAINT console_sessions_role_check
+    CHECK (role IN ('owner', 'admin', 'operator', 'auditor'));
+ALTER TABLE console_sessions ADD COLUMN operator_id UUID REFERENCES console_operators(id) ON DELETE CASCADE;
+
+CREATE INDEX idx_console_sessions_operator ON console_sessions(operator_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query - console operators table rollback';
+
+DROP INDEX IF EXISTS idx_console_sessions_operator;
+ALTER TABLE console_sessions DROP COLUMN IF EXISTS operator_id;
+ALTER TABLE console_sessions DROP CONSTRAINT IF EXISTS console_sessions_role_check;
+ALTER TABLE console_sessions DROP COLUMN IF EXISTS role;
+
+DROP POLICY IF EXISTS console_operators_org_isolation ON console_operators;
+DROP TABLE IF EXISTS console_operators;
+-- +goose StatementEnd
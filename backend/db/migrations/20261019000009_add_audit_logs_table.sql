@@ -0,0 +1,57 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query - Audit Logs Table';
+
+-- 更新系RPCの呼び出し履歴。アプリケーションからは追記と参照のみ許可し、変更・削除はできない。
+-- 組織を削除しても記録を残すため organizations への外部キーは張らない
+CREATE TABLE audit_logs (
+    id UUID NOT NULL DEFAULT UUID_GENERATE_V4(),
+    organization_id UUID NOT NULL,
+    actor_type TEXT NOT NULL,
+    actor_id TEXT NOT NULL DEFAULT '',
+    procedure TEXT NOT NULL,
+    target_ids JSONB NOT NULL DEFAULT '{}'::jsonb,
+    result_code TEXT NOT NULL,
+    request_id TEXT NOT NULL,
+    ip_address TEXT NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    CONSTRAINT audit_logs_actor_type_check
+        CHECK (actor_type IN ('user', 'console_owner', 'console_operator', 'api_token', 'anonymous'))
+);
+
+GRANT SELECT,INSERT ON TABLE audit_logs TO keyhub;
+
+-- 検索は組織ごとに新しい順で、カーソルは (created_at, id) で表す
+CREATE INDEX idx_audit_logs_organization ON audit_logs(organization_id, created_at DESC, id DESC);
+CREATE INDEX idx_audit_logs_actor ON audit_logs(organization_id, actor_id, created_at DESC);
+
+ALTER TABLE audit_logs ENABLE ROW LEVEL SECURITY;
+ALTER TABLE audit_logs FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY audit_logs_org_isolation_select ON audit_logs
+    FOR SELECT
+    TO keyhub
+    USING (
+        current_organization_id() IS NULL
+        OR organization_id = current_organization_id()
+    );
+
+CREATE POLICY audit_logs_org_isolation_insert ON audit_logs
+    FOR INSERT
+    TO keyhub
+    WITH CHECK (
+        current_organization_id() IS NULL
+        OR organization_id = current_organization_id()
+    );
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query - audit logs table rollback';
+
+DROP POLICY IF EXISTS audit_logs_org_isolation_insert ON audit_logs;
+DROP POLICY IF EXISTS audit_logs_org_isolation_select ON audit_logs;
+DROP TABLE IF EXISTS audit_logs;
+-- +goose StatementEnd
@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query - Audit Log Hash Chain';
+
+-- 組織ごとに連番と直前の記録のハッシュを持たせ、記録の改ざん・削除を検出できるようにする
+ALTER TABLE audit_logs ADD COLUMN seq BIGINT;
+ALTER TABLE audit_logs ADD COLUMN prev_hash TEXT NOT NULL DEFAULT '';
+ALTER TABLE audit_logs ADD COLUMN hash TEXT NOT NULL DEFAULT '';
+
+-- 既存の記録には連番だけを振る。ハッシュは空のままとし、検証では連鎖の開始前の記録として扱う
+UPDATE audit_logs a
+SET seq = numbered.seq
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY organization_id ORDER BY created_at, id) AS seq
+    FROM audit_logs
+) numbered
+WHERE a.id = numbered.id;
+
+ALTER TABLE audit_logs ALTER COLUMN seq SET NOT NULL;
+ALTER TABLE audit_logs ALTER COLUMN prev_hash DROP DEFAULT;
+ALTER TABLE audit_logs ALTER COLUMN hash DROP DEFAULT;
+ALTER TABLE audit_logs ADD CONSTRAINT audit_logs_organization_id_seq_key UNIQUE (organization_id, seq);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query - audit log hash chain rollback';
+
+ALTER TABLE audit_logs DROP CONSTRAINT IF EXISTS audit_logs_organization_id_seq_key;
+ALTER TABLE audit_logs DROP COLUMN IF EXISTS hash;
+ALTER TABLE audit_logs DROP COLUMN IF EXISTS prev_hash;
+ALTER TABLE audit_logs DROP COLUMN IF EXISTS seq;
+-- +goose StatementEnd
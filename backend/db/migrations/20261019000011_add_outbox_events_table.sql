@@ -0,0 +1,47 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query - Outbox Events Table';
+
+-- 業務データと同じトランザクションで書き込むドメインイベント。
+-- コミット後にディスパッチャーが取り出して配送し、配送できなかったものは再試行の末に dead とする
+CREATE TABLE outbox_events (
+    id UUID NOT NULL DEFAULT UUID_GENERATE_V4(),
+    organization_id UUID NOT NULL,
+    event_type TEXT NOT NULL,
+    payload JSONB NOT NULL,
+    occurred_at TIMESTAMPTZ NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL,
+    last_error TEXT NOT NULL DEFAULT '',
+    delivered_at TIMESTAMPTZ,
+    PRIMARY KEY (id),
+    FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE,
+    CONSTRAINT outbox_events_status_check CHECK (status IN ('pending', 'delivered', 'dead'))
+);
+
+GRANT SELECT,INSERT,UPDATE,DELETE ON TABLE outbox_events TO keyhub;
+
+-- ディスパッチャーは配送待ちのイベントだけを古い順に取り出す
+CREATE INDEX idx_outbox_events_pending ON outbox_events(next_attempt_at) WHERE status = 'pending';
+CREATE INDEX idx_outbox_events_organization ON outbox_events(organization_id, occurred_at DESC);
+
+ALTER TABLE outbox_events ENABLE ROW LEVEL SECURITY;
+ALTER TABLE outbox_events FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY outbox_events_org_isolation ON outbox_events
+    FOR ALL
+    TO keyhub
+    USING (
+        current_organization_id() IS NULL
+        OR organization_id = current_organization_id()
+    );
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query - outbox events table rollback';
+
+DROP POLICY IF EXISTS outbox_events_org_isolation ON outbox_events;
+DROP TABLE IF EXISTS outbox_events;
+-- +goose StatementEnd
@@ -0,0 +1,77 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query - Webhooks Tables';
+
+-- 組織の外部システムへドメインイベントを送るWebhookの購読。
+-- secret はペイロードの署名に使うためハッシュではなくそのまま保存する
+CREATE TABLE webhook_subscriptions (
+    id UUID NOT NULL DEFAULT UUID_GENERATE_V4(),
+    organization_id UUID NOT NULL,
+    url TEXT NOT NULL,
+    event_types TEXT[] NOT NULL,
+    secret TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE
+);
+
+GRANT SELECT,INSERT,UPDATE,DELETE ON TABLE webhook_subscriptions TO keyhub;
+
+CREATE INDEX idx_webhook_subscriptions_organization ON webhook_subscriptions(organization_id);
+
+ALTER TABLE webhook_subscriptions ENABLE ROW LEVEL SECURITY;
+ALTER TABLE webhook_subscriptions FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY webhook_subscriptions_org_isolation ON webhook_subscriptions
+    FOR ALL
+    TO keyhub
+    USING (
+        current_organization_id() IS NULL
+        OR organization_id = current_organization_id()
+    );
+
+-- Webhookの送信履歴。送信を試みるたびに1行追加する
+CREATE TABLE webhook_deliveries (
+    id UUID NOT NULL DEFAULT UUID_GENERATE_V4(),
+    subscription_id UUID NOT NULL,
+    organization_id UUID NOT NULL,
+    event_id UUID NOT NULL,
+    event_type TEXT NOT NULL,
+    status_code INTEGER NOT NULL DEFAULT 0,
+    error TEXT NOT NULL DEFAULT '',
+    succeeded BOOLEAN NOT NULL,
+    duration_ms INTEGER NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    FOREIGN KEY (subscription_id) REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+    FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE
+);
+
+GRANT SELECT,INSERT,UPDATE,DELETE ON TABLE webhook_deliveries TO keyhub;
+
+CREATE INDEX idx_webhook_deliveries_subscription ON webhook_deliveries(subscription_id, created_at DESC);
+-- 再配送時に送信済みの購読先を飛ばすための検索
+CREATE INDEX idx_webhook_deliveries_event ON webhook_deliveries(event_id, subscription_id) WHERE succeeded;
+
+ALTER TABLE webhook_deliveries ENABLE ROW LEVEL SECURITY;
+ALTER TABLE webhook_deliveries FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY webhook_deliveries_org_isolation ON webhook_deliveries
+    FOR ALL
+    TO keyhub
+    USING (
+        current_organization_id() IS NULL
+        OR organization_id = current_organization_id()
+    );
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query - webhooks tables rollback';
+
+DROP POLICY IF EXISTS webhook_deliveries_org_isolation ON webhook_deliveries;
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP POLICY IF EXISTS webhook_subscriptions_org_isolation ON webhook_subscriptions;
+DROP TABLE IF EXISTS webhook_subscriptions;
+-- +goose StatementEnd
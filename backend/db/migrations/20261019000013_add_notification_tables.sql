@@ -0,0 +1,57 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query - Notification Tables';
+
+-- ユーザーごとの通知設定。行がないユーザーは組織の言語ですべての通知を受け取る。
+-- locale が空の場合も組織の言語に従う
+CREATE TABLE notification_preferences (
+    user_id UUID NOT NULL,
+    locale TEXT NOT NULL DEFAULT '' CHECK (locale IN ('', 'ja', 'en')),
+    disabled_kinds TEXT[] NOT NULL DEFAULT '{}',
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+GRANT SELECT,INSERT,UPDATE,DELETE ON TABLE notification_preferences TO keyhub;
+
+-- 送信したメール通知の記録。dedup_key が同じ通知は1度しか送らない
+CREATE TABLE notification_deliveries (
+    dedup_key TEXT NOT NULL,
+    organization_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    kind TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (dedup_key),
+    FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+GRANT SELECT,INSERT,UPDATE,DELETE ON TABLE notification_deliveries TO keyhub;
+
+CREATE INDEX idx_notification_deliveries_user ON notification_deliveries(user_id, created_at DESC);
+
+ALTER TABLE notification_deliveries ENABLE ROW LEVEL SECURITY;
+ALTER TABLE notification_deliveries FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY notification_deliveries_org_isolation ON notification_deliveries
+    FOR ALL
+    TO keyhub
+    USING (
+        current_organization_id() IS NULL
+        OR organization_id = current_organization_id()
+    );
+
+-- 期限が近い部屋の割り当てを定期的に探すための検索
+CREATE INDEX idx_room_assignments_expires_at ON room_assignments(expires_at) WHERE expires_at IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query - notification tables rollback';
+
+DROP INDEX IF EXISTS idx_room_assignments_expires_at;
+DROP POLICY IF EXISTS notification_deliveries_org_isolation ON notification_deliveries;
+DROP TABLE IF EXISTS notification_deliveries;
+DROP TABLE IF EXISTS notification_preferences;
+-- +goose StatementEnd
@@ -0,0 +1,58 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query - Key Change Notify Trigger';
+
+-- 鍵の追加・更新・削除を key_changes チャネルに通知する。
+-- NOTIFY はトランザクションのコミット時に届くため、ロールバックした変更は通知されない。
+-- 通知は組織をまたいで届くため、受け取った側で organization_id を見て振り分ける
+CREATE OR REPLACE FUNCTION notify_key_change()
+RETURNS TRIGGER AS $$
+DECLARE
+    k keys;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        k := OLD;
+    ELSE
+        k := NEW;
+    END IF;
+
+    -- 通知する項目が変わっていない更新は送らない
+    IF TG_OP = 'UPDATE'
+        AND OLD.status = NEW.status
+        AND OLD.key_number = NEW.key_number
+        AND OLD.room_id = NEW.room_id THEN
+        RETURN NULL;
+    END IF;
+
+    PERFORM pg_notify('key_changes', json_build_object(
+        'operation', CASE TG_OP
+            WHEN 'INSERT' THEN 'created'
+            WHEN 'UPDATE' THEN 'updated'
+            ELSE 'deleted'
+        END,
+        'id', k.id,
+        'room_id', k.room_id,
+        'organization_id', k.organization_id,
+        'key_number', k.key_number,
+        'status', k.status,
+        'created_at', k.created_at,
+        'updated_at', k.updated_at
+    )::TEXT);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+GRANT EXECUTE ON FUNCTION notify_key_change() TO keyhub;
+
+CREATE TRIGGER notify_keys_change
+AFTER INSERT OR UPDATE OR DELETE ON keys
+FOR EACH ROW EXECUTE FUNCTION notify_key_change();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query - key change notify trigger rollback';
+
+DROP TRIGGER IF EXISTS notify_keys_change ON keys;
+DROP FUNCTION IF EXISTS notify_key_change();
+-- +goose StatementEnd
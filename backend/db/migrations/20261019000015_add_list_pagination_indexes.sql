@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query - Add indexes for paginated lists';
+
+-- 一覧の並び順（新しい順・名前順）ごとのキーセットページングに使う。
+-- 行の絞り込みは RLS の organization_id で行われるため、先頭に organization_id を置く
+CREATE INDEX idx_rooms_org_created ON rooms(organization_id, created_at DESC, id DESC);
+CREATE INDEX idx_rooms_org_name ON rooms(organization_id, name, id);
+CREATE INDEX idx_tenants_org_created ON tenants(organization_id, created_at DESC, id DESC);
+CREATE INDEX idx_tenants_org_name ON tenants(organization_id, name, id);
+CREATE INDEX idx_keys_room_created ON keys(room_id, created_at DESC, id DESC);
+CREATE INDEX idx_keys_room_key_number ON keys(room_id, key_number, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query - Remove indexes for paginated lists';
+
+DROP INDEX IF EXISTS idx_keys_room_key_number;
+DROP INDEX IF EXISTS idx_keys_room_created;
+DROP INDEX IF EXISTS idx_tenants_org_name;
+DROP INDEX IF EXISTS idx_tenants_org_created;
+DROP INDEX IF EXISTS idx_rooms_org_name;
+DROP INDEX IF EXISTS idx_rooms_org_created;
+-- +goose StatementEnd
@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query - Add indexes for console search';
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- 部分一致（ILIKE）と類似度による並べ替えに使うトライグラムの索引。
+-- 日本語の名前は単語に分かれないため、部分一致はトライグラムで探す
+CREATE INDEX idx_rooms_name_trgm ON rooms USING gin (name gin_trgm_ops);
+CREATE INDEX idx_rooms_building_name_trgm ON rooms USING gin (building_name gin_trgm_ops);
+CREATE INDEX idx_rooms_description_trgm ON rooms USING gin (description gin_trgm_ops);
+CREATE INDEX idx_keys_key_number_trgm ON keys USING gin (key_number gin_trgm_ops);
+CREATE INDEX idx_tenants_name_trgm ON tenants USING gin (name gin_trgm_ops);
+CREATE INDEX idx_users_email_trgm ON users USING gin (email gin_trgm_ops);
+
+-- 説明文など語で区切られた文章の全文検索に使う索引。クエリの式と同じ式にする
+CREATE INDEX idx_rooms_search_tsv ON rooms USING gin (
+    to_tsvector('simple', name || ' ' || building_name || ' ' || description)
+);
+CREATE INDEX idx_tenants_search_tsv ON tenants USING gin (
+    to_tsvector('simple', name || ' ' || description)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query - Remove indexes for console search';
+
+DROP INDEX IF EXISTS idx_tenants_search_tsv;
+DROP INDEX IF EXISTS idx_rooms_search_tsv;
+DROP INDEX IF EXISTS idx_users_email_trgm;
+DROP INDEX IF EXISTS idx_tenants_name_trgm;
+DROP INDEX IF EXISTS idx_keys_key_number_trgm;
+DROP INDEX IF EXISTS idx_rooms_description_trgm;
+DROP INDEX IF EXISTS idx_rooms_building_name_trgm;
+DROP INDEX IF EXISTS idx_rooms_name_trgm;
+-- +goose StatementEnd
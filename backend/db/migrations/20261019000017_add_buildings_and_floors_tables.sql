@@ -0,0 +1,171 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query - Buildings and Floors Tables';
+
+-- 部屋が置かれる建物と階。これまで部屋ごとの自由入力だった建物名・階を表にして、
+-- 表記揺れで同じ建物が別の建物として扱われないようにする
+CREATE TABLE buildings (
+    id UUID NOT NULL DEFAULT UUID_GENERATE_V4(),
+    organization_id UUID NOT NULL,
+    name TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    FOREIGN KEY (organization_id) REFERENCES organizations(id),
+    CONSTRAINT buildings_organization_id_name_key UNIQUE (organization_id, name)
+);
+
+GRANT SELECT,INSERT,UPDATE,DELETE ON TABLE buildings TO keyhub;
+
+ALTER TABLE buildings ENABLE ROW LEVEL SECURITY;
+ALTER TABLE buildings FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY buildings_org_isolation ON buildings
+    FOR ALL
+    TO keyhub
+    USING (
+        current_organization_id() IS NULL
+        OR organization_id = current_organization_id()
+    );
+
+CREATE TRIGGER refresh_buildings_updated_at
+BEFORE UPDATE ON buildings
+FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- level は建物内での並び順。地下は負の値にする
+CREATE TABLE floors (
+    id UUID NOT NULL DEFAULT UUID_GENERATE_V4(),
+    building_id UUID NOT NULL,
+    organization_id UUID NOT NULL,
+    name TEXT NOT NULL,
+    level INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    FOREIGN KEY (building_id) REFERENCES buildings(id) ON DELETE RESTRICT,
+    FOREIGN KEY (organization_id) REFERENCES organizations(id),
+    CONSTRAINT floors_building_id_name_key UNIQUE (building_id, name)
+);
+
+GRANT SELECT,INSERT,UPDATE,DELETE ON TABLE floors TO keyhub;
+
+ALTER TABLE floors ENABLE ROW LEVEL SECURITY;
+ALTER TABLE floors FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY floors_org_isolation ON floors
+    FOR ALL
+    TO keyhub
+    USING (
+        current_organization_id() IS NULL
+        OR organization_id = current_organization_id()
+    );
+
+CREATE TRIGGER refresh_floors_updated_at
+BEFORE UPDATE ON floors
+FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- 既存の部屋の建物名・階から建物と階を作る。階の level は先頭の数字から推定し、B から始まるものは地下とする
+INSERT INTO buildings (organization_id, name)
+SELECT DISTINCT organization_id, building_name
+FROM rooms;
+
+INSERT INTO floors (building_id, organization_id, name, level)
+SELECT DISTINCT
+    b.id,
+    b.organization_id,
+    r.floor_number,
+    CASE
+        WHEN r.floor_number ~ '^[Bb][0-9]{1,6}' THEN -substring(r.floor_number FROM '^[Bb]([0-9]{1,6})')::INT
+        WHEN r.floor_number ~ '^[0-9]{1,6}' THEN substring(r.floor_number FROM '^([0-9]{1,6})')::INT
+        ELSE 0
+    END
+FROM rooms r
+INNER JOIN buildings b ON b.organization_id = r.organization_id AND b.name = r.building_name;
+
+ALTER TABLE rooms ADD COLUMN floor_id UUID REFERENCES floors(id) ON DELETE RESTRICT;
+
+UPDATE rooms r
+SET floor_id = f.id
+FROM floors f
+INNER JOIN buildings b ON b.id = f.building_id
+WHERE b.organization_id = r.organization_id
+  AND b.name = r.building_name
+  AND f.name = r.floor_number;
+
+ALTER TABLE rooms ALTER COLUMN floor_id SET NOT NULL;
+
+CREATE INDEX idx_rooms_floor ON rooms(floor_id);
+
+-- rooms.building_name と rooms.floor_number は一覧の絞り込み・検索・アプリの表示のために残す写し。
+-- floor_id から埋め、建物や階の名前を変えたときは部屋にも反映する
+CREATE OR REPLACE FUNCTION sync_room_location()
+RETURNS TRIGGER AS $$
+BEGIN
+    SELECT b.name, f.name
+    INTO NEW.building_name, NEW.floor_number
+    FROM floors f
+    INNER JOIN buildings b ON b.id = f.building_id
+    WHERE f.id = NEW.floor_id;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER sync_rooms_location
+BEFORE INSERT OR UPDATE OF floor_id ON rooms
+FOR EACH ROW EXECUTE FUNCTION sync_room_location();
+
+CREATE OR REPLACE FUNCTION propagate_building_name()
+RETURNS TRIGGER AS $$
+BEGIN
+    UPDATE rooms r
+    SET building_name = NEW.name
+    FROM floors f
+    WHERE f.building_id = NEW.id AND r.floor_id = f.id;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER propagate_buildings_name
+AFTER UPDATE OF name ON buildings
+FOR EACH ROW
+WHEN (OLD.name IS DISTINCT FROM NEW.name)
+EXECUTE FUNCTION propagate_building_name();
+
+CREATE OR REPLACE FUNCTION propagate_floor_name()
+RETURNS TRIGGER AS $$
+BEGIN
+    UPDATE rooms SET floor_number = NEW.name WHERE floor_id = NEW.id;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER propagate_floors_name
+AFTER UPDATE OF name ON floors
+FOR EACH ROW
+WHEN (OLD.name IS DISTINCT FROM NEW.name)
+EXECUTE FUNCTION propagate_floor_name();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query - buildings and floors tables rollback';
+
+DROP TRIGGER IF EXISTS propagate_floors_name ON floors;
+DROP FUNCTION IF EXISTS propagate_floor_name();
+DROP TRIGGER IF EXISTS propagate_buildings_name ON buildings;
+DROP FUNCTION IF EXISTS propagate_building_name();
+DROP TRIGGER IF EXISTS sync_rooms_location ON rooms;
+DROP FUNCTION IF EXISTS sync_room_location();
+
+DROP INDEX IF EXISTS idx_rooms_floor;
+ALTER TABLE rooms DROP COLUMN IF EXISTS floor_id;
+
+DROP TRIGGER IF EXISTS refresh_floors_updated_at ON floors;
+DROP POLICY IF EXISTS floors_org_isolation ON floors;
+DROP TABLE IF EXISTS floors;
+
+DROP TRIGGER IF EXISTS refresh_buildings_updated_at ON buildings;
+DROP POLICY IF EXISTS buildings_org_isolation ON buildings;
+DROP TABLE IF EXISTS buildings;
+-- +goose StatementEnd
@@ -0,0 +1,75 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query - Room Attributes';
+
+-- 部屋の収容人数（0 は未設定）・設備・バリアフリー対応と、組織が定義したカスタム項目の値。
+-- custom_fields は room_custom_fields.key をキーにした文字列の値を持つ
+ALTER TABLE rooms ADD COLUMN capacity INT NOT NULL DEFAULT 0;
+ALTER TABLE rooms ADD COLUMN equipment TEXT[] NOT NULL DEFAULT '{}';
+ALTER TABLE rooms ADD COLUMN accessibility TEXT[] NOT NULL DEFAULT '{}';
+ALTER TABLE rooms ADD COLUMN custom_fields JSONB NOT NULL DEFAULT '{}';
+
+ALTER TABLE rooms ADD CONSTRAINT rooms_capacity_check CHECK (capacity >= 0);
+ALTER TABLE rooms ADD CONSTRAINT rooms_accessibility_check
+    CHECK (accessibility <@ ARRAY['wheelchair', 'step_free', 'accessible_restroom', 'hearing_loop']::TEXT[]);
+
+-- 設備・バリアフリー対応・カスタム項目は「指定したものをすべて持つ」（@>）で絞り込む
+CREATE INDEX idx_rooms_equipment ON rooms USING GIN (equipment);
+CREATE INDEX idx_rooms_accessibility ON rooms USING GIN (accessibility);
+CREATE INDEX idx_rooms_custom_fields ON rooms USING GIN (custom_fields jsonb_path_ops);
+
+-- 組織が部屋に追加する項目の定義。値の検証はドメイン層で行う
+CREATE TABLE room_custom_fields (
+    id UUID NOT NULL DEFAULT UUID_GENERATE_V4(),
+    organization_id UUID NOT NULL,
+    key TEXT NOT NULL,
+    label TEXT NOT NULL,
+    field_type TEXT NOT NULL,
+    required BOOLEAN NOT NULL DEFAULT FALSE,
+    options TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    FOREIGN KEY (organization_id) REFERENCES organizations(id),
+    CONSTRAINT room_custom_fields_organization_id_key_key UNIQUE (organization_id, key),
+    CONSTRAINT room_custom_fields_field_type_check CHECK (field_type IN ('text', 'number', 'boolean', 'select'))
+);
+
+GRANT SELECT,INSERT,UPDATE,DELETE ON TABLE room_custom_fields TO keyhub;
+
+ALTER TABLE room_custom_fields ENABLE ROW LEVEL SECURITY;
+ALTER TABLE room_custom_fields FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY room_custom_fields_org_isolation ON room_custom_fields
+    FOR ALL
+    TO keyhub
+    USING (
+        current_organization_id() IS NULL
+        OR organization_id = current_organization_id()
+    );
+
+CREATE TRIGGER refresh_room_custom_fields_updated_at
+BEFORE UPDATE ON room_custom_fields
+FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query - room attributes rollback';
+
+DROP TRIGGER IF EXISTS refresh_room_custom_fields_updated_at ON room_custom_fields;
+DROP POLICY IF EXISTS room_custom_fields_org_isolation ON room_custom_fields;
+DROP TABLE IF EXISTS room_custom_fields;
+
+DROP INDEX IF EXISTS idx_rooms_custom_fields;
+DROP INDEX IF EXISTS idx_rooms_accessibility;
+DROP INDEX IF EXISTS idx_rooms_equipment;
+
+ALTER TABLE rooms DROP CONSTRAINT IF EXISTS rooms_accessibility_check;
+ALTER TABLE rooms DROP CONSTRAINT IF EXISTS rooms_capacity_check;
+
+ALTER TABLE rooms DROP COLUMN IF EXISTS custom_fields;
+ALTER TABLE rooms DROP COLUMN IF EXISTS accessibility;
+ALTER TABLE rooms DROP COLUMN IF EXISTS equipment;
+ALTER TABLE rooms DROP COLUMN IF EXISTS capacity;
+-- +goose StatementEnd
@@ -0,0 +1,83 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query - Room Types and Tenant Types Tables';
+
+-- 組織が追加する部屋タイプ・テナントタイプ。既定のタイプはアプリケーションで定義し、ここには含めない。
+-- rooms.room_type と tenants.tenant_type にはキーを保存し、値の検証はドメイン層で行う
+ALTER TABLE rooms DROP CONSTRAINT IF EXISTS rooms_room_type_check;
+
+CREATE TABLE room_types (
+    id UUID NOT NULL DEFAULT UUID_GENERATE_V4(),
+    organization_id UUID NOT NULL,
+    key TEXT NOT NULL,
+    label TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    FOREIGN KEY (organization_id) REFERENCES organizations(id),
+    CONSTRAINT room_types_organization_id_key_key UNIQUE (organization_id, key)
+);
+
+GRANT SELECT,INSERT,UPDATE,DELETE ON TABLE room_types TO keyhub;
+
+ALTER TABLE room_types ENABLE ROW LEVEL SECURITY;
+ALTER TABLE room_types FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY room_types_org_isolation ON room_types
+    FOR ALL
+    TO keyhub
+    USING (
+        current_organization_id() IS NULL
+        OR organization_id = current_organization_id()
+    );
+
+CREATE TRIGGER refresh_room_types_updated_at
+BEFORE UPDATE ON room_types
+FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TABLE tenant_types (
+    id UUID NOT NULL DEFAULT UUID_GENERATE_V4(),
+    organization_id UUID NOT NULL,
+    key TEXT NOT NULL,
+    label TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    FOREIGN KEY (organization_id) REFERENCES organizations(id),
+    CONSTRAINT tenant_types_organization_id_key_key UNIQUE (organization_id, key)
+);
+
+GRANT SELECT,INSERT,UPDATE,DELETE ON TABLE tenant_types TO keyhub;
+
+ALTER TABLE tenant_types ENABLE ROW LEVEL SECURITY;
+ALTER TABLE tenant_types FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY tenant_types_org_isolation ON tenant_types
+    FOR ALL
+    TO keyhub
+    USING (
+        current_organization_id() IS NULL
+        OR organization_id = current_organization_id()
+    );
+
+CREATE TRIGGER refresh_tenant_types_updated_at
+BEFORE UPDATE ON tenant_types
+FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query - room types and tenant types tables rollback';
+
+DROP TRIGGER IF EXISTS refresh_tenant_types_updated_at ON tenant_types;
+DROP POLICY IF EXISTS tenant_types_org_isolation ON tenant_types;
+DROP TABLE IF EXISTS tenant_types;
+
+DROP TRIGGER IF EXISTS refresh_room_types_updated_at ON room_types;
+DROP POLICY IF EXISTS room_types_org_isolation ON room_types;
+DROP TABLE IF EXISTS room_types;
+
+-- 追加したタイプの部屋が残っている場合に備え、既存の行は検証しない
+ALTER TABLE rooms ADD CONSTRAINT rooms_room_type_check
+    CHECK (room_type IN ('classroom', 'meeting_room', 'laboratory', 'office', 'workshop', 'storage')) NOT VALID;
+-- +goose StatementEnd
@@ -0,0 +1,56 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query - Tenant Archiving';
+
+-- アーカイブしたテナントは閲覧のみできる。NULL ならアーカイブしていない
+ALTER TABLE tenants ADD COLUMN archived_at TIMESTAMPTZ;
+
+-- テナントを削除したときにメンバー・参加コード・グループ・部屋の割り当てが気付かれずに消えないよう、
+-- カスケード削除をやめる。テナントの削除ではユースケースがこれらを明示的に削除してからテナントを削除する
+ALTER TABLE tenant_join_codes DROP CONSTRAINT tenant_join_codes_tenant_id_fkey;
+ALTER TABLE tenant_join_codes
+    ADD CONSTRAINT tenant_join_codes_tenant_id_fkey
+    FOREIGN KEY (tenant_id) REFERENCES tenants(id) ON DELETE RESTRICT;
+
+ALTER TABLE tenant_memberships DROP CONSTRAINT tenant_memberships_tenant_id_fkey;
+ALTER TABLE tenant_memberships
+    ADD CONSTRAINT tenant_memberships_tenant_id_fkey
+    FOREIGN KEY (tenant_id) REFERENCES tenants(id) ON DELETE RESTRICT;
+
+ALTER TABLE tenant_groups DROP CONSTRAINT tenant_groups_tenant_id_fkey;
+ALTER TABLE tenant_groups
+    ADD CONSTRAINT tenant_groups_tenant_id_fkey
+    FOREIGN KEY (tenant_id) REFERENCES tenants(id) ON DELETE RESTRICT;
+
+ALTER TABLE room_assignments DROP CONSTRAINT room_assignments_tenant_id_fkey;
+ALTER TABLE room_assignments
+    ADD CONSTRAINT room_assignments_tenant_id_fkey
+    FOREIGN KEY (tenant_id) REFERENCES tenants(id) ON DELETE RESTRICT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query - tenant archiving rollback';
+
+ALTER TABLE room_assignments DROP CONSTRAINT room_assignments_tenant_id_fkey;
+ALTER TABLE room_assignments
+    ADD CONSTRAINT room_assignments_tenant_id_fkey
+    FOREIGN KEY (tenant_id) REFERENCES tenants(id) ON DELETE CASCADE;
+
+ALTER TABLE tenant_groups DROP CONSTRAINT tenant_groups_tenant_id_fkey;
+ALTER TABLE tenant_groups
+    ADD CONSTRAINT tenant_groups_tenant_id_fkey
+    FOREIGN KEY (tenant_id) REFERENCES tenants(id) ON DELETE CASCADE;
+
+ALTER TABLE tenant_memberships DROP CONSTRAINT tenant_memberships_tenant_id_fkey;
+ALTER TABLE tenant_memberships
+    ADD CONSTRAINT tenant_memberships_tenant_id_fkey
+    FOREIGN KEY (tenant_id) REFERENCES tenants(id) ON DELETE CASCADE;
+
+ALTER TABLE tenant_join_codes DROP CONSTRAINT tenant_join_codes_tenant_id_fkey;
+ALTER TABLE tenant_join_codes
+    ADD CONSTRAINT tenant_join_codes_tenant_id_fkey
+    FOREIGN KEY (tenant_id) REFERENCES tenants(id) ON DELETE CASCADE;
+
+ALTER TABLE tenants DROP COLUMN IF EXISTS archived_at;
+-- +goose StatementEnd
@@ -0,0 +1,44 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query - Version Columns';
+
+-- 楽観的排他制御のための版数。行を更新するたびにトリガーで 1 ずつ増やし、
+-- 更新 API は取得時の版数を WHERE に含めて、他の更新で変わっていたら更新しない
+ALTER TABLE tenants ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE rooms ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE keys ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+
+CREATE OR REPLACE FUNCTION increment_version_column()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.version = OLD.version + 1;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER increment_tenants_version
+BEFORE UPDATE ON tenants
+FOR EACH ROW EXECUTE FUNCTION increment_version_column();
+
+CREATE TRIGGER increment_rooms_version
+BEFORE UPDATE ON rooms
+FOR EACH ROW EXECUTE FUNCTION increment_version_column();
+
+CREATE TRIGGER increment_keys_version
+BEFORE UPDATE ON keys
+FOR EACH ROW EXECUTE FUNCTION increment_version_column();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query - version columns rollback';
+
+DROP TRIGGER IF EXISTS increment_keys_version ON keys;
+DROP TRIGGER IF EXISTS increment_rooms_version ON rooms;
+DROP TRIGGER IF EXISTS increment_tenants_version ON tenants;
+DROP FUNCTION IF EXISTS increment_version_column();
+
+ALTER TABLE keys DROP COLUMN IF EXISTS version;
+ALTER TABLE rooms DROP COLUMN IF EXISTS version;
+ALTER TABLE tenants DROP COLUMN IF EXISTS version;
+-- +goose StatementEnd
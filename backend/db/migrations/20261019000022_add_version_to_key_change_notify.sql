@@ -0,0 +1,84 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query - Version In Key Change Notify';
+
+-- 鍵の変更の通知に版数を含める。購読側は通知の版数をそのまま UpdateKey に渡せる
+CREATE OR REPLACE FUNCTION notify_key_change()
+RETURNS TRIGGER AS $$
+DECLARE
+    k keys;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        k := OLD;
+    ELSE
+        k := NEW;
+    END IF;
+
+    -- 通知する項目が変わっていない更新は送らない
+    IF TG_OP = 'UPDATE'
+        AND OLD.status = NEW.status
+        AND OLD.key_number = NEW.key_number
+        AND OLD.room_id = NEW.room_id THEN
+        RETURN NULL;
+    END IF;
+
+    PERFORM pg_notify('key_changes', json_build_object(
+        'operation', CASE TG_OP
+            WHEN 'INSERT' THEN 'created'
+            WHEN 'UPDATE' THEN 'updated'
+            ELSE 'deleted'
+        END,
+        'id', k.id,
+        'room_id', k.room_id,
+        'organization_id', k.organization_id,
+        'key_number', k.key_number,
+        'status', k.status,
+        'version', k.version,
+        'created_at', k.created_at,
+        'updated_at', k.updated_at
+    )::TEXT);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query - version in key change notify rollback';
+
+CREATE OR REPLACE FUNCTION notify_key_change()
+RETURNS TRIGGER AS $$
+DECLARE
+    k keys;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        k := OLD;
+    ELSE
+        k := NEW;
+    END IF;
+
+    IF TG_OP = 'UPDATE'
+        AND OLD.status = NEW.status
+        AND OLD.key_number = NEW.key_number
+        AND OLD.room_id = NEW.room_id THEN
+        RETURN NULL;
+    END IF;
+
+    PERFORM pg_notify('key_changes', json_build_object(
+        'operation', CASE TG_OP
+            WHEN 'INSERT' THEN 'created'
+            WHEN 'UPDATE' THEN 'updated'
+            ELSE 'deleted'
+        END,
+        'id', k.id,
+        'room_id', k.room_id,
+        'organization_id', k.organization_id,
+        'key_number', k.key_number,
+        'status', k.status,
+        'created_at', k.created_at,
+        'updated_at', k.updated_at
+    )::TEXT);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
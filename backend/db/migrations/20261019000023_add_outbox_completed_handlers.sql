@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query - Outbox Completed Handlers';
+
+-- 処理を終えたハンドラーの名前。一部のハンドラーだけが失敗した場合、再試行では残りのハンドラーだけを呼ぶ
+ALTER TABLE outbox_events ADD COLUMN completed_handlers TEXT[] NOT NULL DEFAULT '{}';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query - outbox completed handlers rollback';
+
+ALTER TABLE outbox_events DROP COLUMN IF EXISTS completed_handlers;
+-- +goose StatementEnd
@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query - Restrict Room Assignment Group Delete';
+
+-- 部屋の割り当てが参照しているグループは削除できないようにする。
+-- テナントの削除では割り当てを先に削除するため、間に割り当てが追加された場合だけ失敗する
+ALTER TABLE room_assignments DROP CONSTRAINT room_assignments_group_id_fkey;
+ALTER TABLE room_assignments
+    ADD CONSTRAINT room_assignments_group_id_fkey
+    FOREIGN KEY (group_id) REFERENCES tenant_groups(id) ON DELETE RESTRICT;
+
+ALTER TABLE room_assignments DROP CONSTRAINT room_assignments_key_loan_group_id_fkey;
+ALTER TABLE room_assignments
+    ADD CONSTRAINT room_assignments_key_loan_group_id_fkey
+    FOREIGN KEY (key_loan_group_id) REFERENCES tenant_groups(id) ON DELETE RESTRICT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query - restrict room assignment group delete rollback';
+
+ALTER TABLE room_assignments DROP CONSTRAINT room_assignments_key_loan_group_id_fkey;
+ALTER TABLE room_assignments
+    ADD CONSTRAINT room_assignments_key_loan_group_id_fkey
+    FOREIGN KEY (key_loan_group_id) REFERENCES tenant_groups(id);
+
+ALTER TABLE room_assignments DROP CONSTRAINT room_assignments_group_id_fkey;
+ALTER TABLE room_assignments
+    ADD CONSTRAINT room_assignments_group_id_fkey
+    FOREIGN KEY (group_id) REFERENCES tenant_groups(id);
+-- +goose StatementEnd
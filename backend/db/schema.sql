@@ -15,6 +15,20 @@ SET xmloption = content;
 SET client_min_messages = warning;
 SET row_security = off;
 
+--
+-- Name: pg_trgm; Type: EXTENSION; Schema: -; Owner: -
+--
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm WITH SCHEMA public;
+
+
+--
+-- Name: EXTENSION pg_trgm; Type: COMMENT; Schema: -; Owner: -
+--
+
+COMMENT ON EXTENSION pg_trgm IS 'text similarity measurement and index searching based on trigrams';
+
+
 --
 -- Name: uuid-ossp; Type: EXTENSION; Schema: -; Owner: -
 --
@@ -64,6 +78,113 @@ CREATE FUNCTION public.current_tenant_id() RETURNS uuid
 $$;
 
 
+--
+-- Name: increment_version_column(); Type: FUNCTION; Schema: public; Owner: -
+--
+
+CREATE FUNCTION public.increment_version_column() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+BEGIN
+    NEW.version = OLD.version + 1;
+    RETURN NEW;
+END;
+$$;
+
+
+--
+-- Name: notify_key_change(); Type: FUNCTION; Schema: public; Owner: -
+--
+
+CREATE FUNCTION public.notify_key_change() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+DECLARE
+    k keys;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        k := OLD;
+    ELSE
+        k := NEW;
+    END IF;
+
+    -- 通知する項目が変わっていない更新は送らない
+    IF TG_OP = 'UPDATE'
+        AND OLD.status = NEW.status
+        AND OLD.key_number = NEW.key_number
+        AND OLD.room_id = NEW.room_id THEN
+        RETURN NULL;
+    END IF;
+
+    PERFORM pg_notify('key_changes', json_build_object(
+        'operation', CASE TG_OP
+            WHEN 'INSERT' THEN 'created'
+            WHEN 'UPDATE' THEN 'updated'
+            ELSE 'deleted'
+        END,
+        'id', k.id,
+        'room_id', k.room_id,
+        'organization_id', k.organization_id,
+        'key_number', k.key_number,
+        'status', k.status,
+        'version', k.version,
+        'created_at', k.created_at,
+        'updated_at', k.updated_at
+    )::TEXT);
+    RETURN NULL;
+END;
+$$;
+
+
+--
+-- Name: propagate_building_name(); Type: FUNCTION; Schema: public; Owner: -
+--
+
+CREATE FUNCTION public.propagate_building_name() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+BEGIN
+    UPDATE rooms r
+    SET building_name = NEW.name
+    FROM floors f
+    WHERE f.building_id = NEW.id AND r.floor_id = f.id;
+    RETURN NULL;
+END;
+$$;
+
+
+--
+-- Name: propagate_floor_name(); Type: FUNCTION; Schema: public; Owner: -
+--
+
+CREATE FUNCTION public.propagate_floor_name() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+BEGIN
+    UPDATE rooms SET floor_number = NEW.name WHERE floor_id = NEW.id;
+    RETURN NULL;
+END;
+$$;
+
+
+--
+-- Name: sync_room_location(); Type: FUNCTION; Schema: public; Owner: -
+--
+
+CREATE FUNCTION public.sync_room_location() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+BEGIN
+    SELECT b.name, f.name
+    INTO NEW.building_name, NEW.floor_number
+    FROM floors f
+    INNER JOIN buildings b ON b.id = f.building_id
+    WHERE f.id = NEW.floor_id;
+    RETURN NEW;
+END;
+$$;
+
+
 --
 -- Name: update_updated_at_column(); Type: FUNCTION; Schema: public; Owner: -
 --
@@ -82,6 +203,84 @@ SET default_tablespace = '';
 
 SET default_table_access_method = heap;
 
+--
+-- Name: api_tokens; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.api_tokens (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    user_id uuid,
+    organization_id uuid NOT NULL,
+    name text NOT NULL,
+    token_prefix text NOT NULL,
+    token_hash text NOT NULL,
+    scopes text[] NOT NULL,
+    expires_at timestamp with time zone NOT NULL,
+    last_used_at timestamp with time zone,
+    revoked_at timestamp with time zone,
+    created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+
+--
+-- Name: audit_logs; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.audit_logs (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    organization_id uuid NOT NULL,
+    actor_type text NOT NULL,
+    actor_id text DEFAULT ''::text NOT NULL,
+    procedure text NOT NULL,
+    target_ids jsonb DEFAULT '{}'::jsonb NOT NULL,
+    result_code text NOT NULL,
+    request_id text NOT NULL,
+    ip_address text DEFAULT ''::text NOT NULL,
+    user_agent text DEFAULT ''::text NOT NULL,
+    created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    seq bigint NOT NULL,
+    prev_hash text NOT NULL,
+    hash text NOT NULL,
+    CONSTRAINT audit_logs_actor_type_check CHECK ((actor_type = ANY (ARRAY['user'::text, 'console_owner'::text, 'console_operator'::text, 'api_token'::text, 'anonymous'::text])))
+);
+
+ALTER TABLE ONLY public.audit_logs FORCE ROW LEVEL SECURITY;
+
+
+--
+-- Name: buildings; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.buildings (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    organization_id uuid NOT NULL,
+    name text NOT NULL,
+    description text DEFAULT ''::text NOT NULL,
+    created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+ALTER TABLE ONLY public.buildings FORCE ROW LEVEL SECURITY;
+
+
+--
+-- Name: console_operators; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.console_operators (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    organization_id uuid NOT NULL,
+    name text NOT NULL,
+    role text NOT NULL,
+    key_hash text NOT NULL,
+    created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    revoked_at timestamp with time zone,
+    CONSTRAINT console_operators_role_check CHECK ((role = ANY (ARRAY['admin'::text, 'operator'::text, 'auditor'::text])))
+);
+
+ALTER TABLE ONLY public.console_operators FORCE ROW LEVEL SECURITY;
+
+
 --
 -- Name: console_sessions; Type: TABLE; Schema: public; Owner: -
 --
@@ -90,9 +289,32 @@ CREATE TABLE public.console_sessions (
     session_id text NOT NULL,
     organization_id uuid NOT NULL,
     created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
-    expires_at timestamp with time zone NOT NULL
+    expires_at timestamp with time zone NOT NULL,
+    user_agent text DEFAULT ''::text NOT NULL,
+    ip_address text DEFAULT ''::text NOT NULL,
+    last_seen_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    role text NOT NULL,
+    operator_id uuid,
+    CONSTRAINT console_sessions_role_check CHECK ((role = ANY (ARRAY['owner'::text, 'admin'::text, 'operator'::text, 'auditor'::text])))
+);
+
+
+--
+-- Name: floors; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.floors (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    building_id uuid NOT NULL,
+    organization_id uuid NOT NULL,
+    name text NOT NULL,
+    level integer DEFAULT 0 NOT NULL,
+    created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
 );
 
+ALTER TABLE ONLY public.floors FORCE ROW LEVEL SECURITY;
+
 
 --
 -- Name: goose_db_version; Type: TABLE; Schema: public; Owner: -
@@ -127,17 +349,46 @@ ALTER TABLE public.goose_db_version ALTER COLUMN id ADD GENERATED BY DEFAULT AS
 CREATE TABLE public.keys (
     id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
     room_id uuid NOT NULL,
-    organization_id uuid DEFAULT '550e8400-e29b-41d4-a716-446655440000'::uuid NOT NULL,
+    organization_id uuid NOT NULL,
     key_number text NOT NULL,
     status text DEFAULT 'available'::text NOT NULL,
     created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
     updated_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    version bigint DEFAULT 1 NOT NULL,
     CONSTRAINT keys_status_check CHECK ((status = ANY (ARRAY['available'::text, 'in_use'::text, 'lost'::text, 'damaged'::text])))
 );
 
 ALTER TABLE ONLY public.keys FORCE ROW LEVEL SECURITY;
 
 
+--
+-- Name: notification_deliveries; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.notification_deliveries (
+    dedup_key text NOT NULL,
+    organization_id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    kind text NOT NULL,
+    created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+ALTER TABLE ONLY public.notification_deliveries FORCE ROW LEVEL SECURITY;
+
+
+--
+-- Name: notification_preferences; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.notification_preferences (
+    user_id uuid NOT NULL,
+    locale text DEFAULT ''::text NOT NULL,
+    disabled_kinds text[] DEFAULT '{}'::text[] NOT NULL,
+    updated_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    CONSTRAINT notification_preferences_locale_check CHECK ((locale = ANY (ARRAY[''::text, 'ja'::text, 'en'::text])))
+);
+
+
 --
 -- Name: oauth_states; Type: TABLE; Schema: public; Owner: -
 --
@@ -147,7 +398,70 @@ CREATE TABLE public.oauth_states (
     code_verifier text NOT NULL,
     nonce text NOT NULL,
     created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
-    consumed_at timestamp with time zone
+    consumed_at timestamp with time zone,
+    organization_id uuid NOT NULL
+);
+
+
+--
+-- Name: organizations; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.organizations (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    name text NOT NULL,
+    slug text NOT NULL,
+    settings jsonb DEFAULT '{}'::jsonb NOT NULL,
+    key_hash text,
+    created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+
+--
+-- Name: outbox_events; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.outbox_events (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    organization_id uuid NOT NULL,
+    event_type text NOT NULL,
+    payload jsonb NOT NULL,
+    occurred_at timestamp with time zone NOT NULL,
+    status text DEFAULT 'pending'::text NOT NULL,
+    attempts integer DEFAULT 0 NOT NULL,
+    next_attempt_at timestamp with time zone NOT NULL,
+    last_error text DEFAULT ''::text NOT NULL,
+    delivered_at timestamp with time zone,
+    completed_handlers text[] DEFAULT '{}'::text[] NOT NULL,
+    CONSTRAINT outbox_events_status_check CHECK ((status = ANY (ARRAY['pending'::text, 'delivered'::text, 'dead'::text])))
+);
+
+ALTER TABLE ONLY public.outbox_events FORCE ROW LEVEL SECURITY;
+
+
+--
+-- Name: rate_limit_buckets; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.rate_limit_buckets (
+    key text NOT NULL,
+    tokens double precision NOT NULL,
+    updated_at timestamp with time zone NOT NULL,
+    idle_at timestamp with time zone NOT NULL
+);
+
+
+--
+-- Name: rate_limit_failures; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.rate_limit_failures (
+    key text NOT NULL,
+    failure_count integer DEFAULT 0 NOT NULL,
+    window_started_at timestamp with time zone NOT NULL,
+    locked_until timestamp with time zone,
+    idle_at timestamp with time zone NOT NULL
 );
 
 
@@ -163,19 +477,57 @@ CREATE TABLE public.room_assignments (
     expires_at timestamp with time zone,
     created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
     updated_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    group_id uuid,
+    key_loan_group_id uuid,
     CONSTRAINT room_assignments_date_check CHECK (((expires_at IS NULL) OR (expires_at > assigned_at)))
 );
 
 ALTER TABLE ONLY public.room_assignments FORCE ROW LEVEL SECURITY;
 
 
+--
+-- Name: room_custom_fields; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.room_custom_fields (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    organization_id uuid NOT NULL,
+    key text NOT NULL,
+    label text NOT NULL,
+    field_type text NOT NULL,
+    required boolean DEFAULT false NOT NULL,
+    options text[] DEFAULT '{}'::text[] NOT NULL,
+    created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    CONSTRAINT room_custom_fields_field_type_check CHECK ((field_type = ANY (ARRAY['text'::text, 'number'::text, 'boolean'::text, 'select'::text])))
+);
+
+ALTER TABLE ONLY public.room_custom_fields FORCE ROW LEVEL SECURITY;
+
+
+--
+-- Name: room_types; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.room_types (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    organization_id uuid NOT NULL,
+    key text NOT NULL,
+    label text NOT NULL,
+    created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+ALTER TABLE ONLY public.room_types FORCE ROW LEVEL SECURITY;
+
+
 --
 -- Name: rooms; Type: TABLE; Schema: public; Owner: -
 --
 
 CREATE TABLE public.rooms (
     id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
-    organization_id uuid DEFAULT '550e8400-e29b-41d4-a716-446655440000'::uuid NOT NULL,
+    organization_id uuid NOT NULL,
     name text NOT NULL,
     building_name text NOT NULL,
     floor_number text NOT NULL,
@@ -183,7 +535,14 @@ CREATE TABLE public.rooms (
     description text DEFAULT ''::text NOT NULL,
     created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
     updated_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
-    CONSTRAINT rooms_room_type_check CHECK ((room_type = ANY (ARRAY['classroom'::text, 'meeting_room'::text, 'laboratory'::text, 'office'::text, 'workshop'::text, 'storage'::text])))
+    floor_id uuid NOT NULL,
+    capacity integer DEFAULT 0 NOT NULL,
+    equipment text[] DEFAULT '{}'::text[] NOT NULL,
+    accessibility text[] DEFAULT '{}'::text[] NOT NULL,
+    custom_fields jsonb DEFAULT '{}'::jsonb NOT NULL,
+    version bigint DEFAULT 1 NOT NULL,
+    CONSTRAINT rooms_accessibility_check CHECK ((accessibility <@ ARRAY['wheelchair'::text, 'step_free'::text, 'accessible_restroom'::text, 'hearing_loop'::text])),
+    CONSTRAINT rooms_capacity_check CHECK ((capacity >= 0))
 );
 
 ALTER TABLE ONLY public.rooms FORCE ROW LEVEL SECURITY;
@@ -200,9 +559,44 @@ CREATE TABLE public.sessions (
     created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
     expires_at timestamp with time zone NOT NULL,
     csrf_token text,
-    revoked boolean DEFAULT false NOT NULL
+    revoked boolean DEFAULT false NOT NULL,
+    user_agent text DEFAULT ''::text NOT NULL,
+    ip_address text DEFAULT ''::text NOT NULL,
+    last_seen_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    organization_id uuid NOT NULL
+);
+
+
+--
+-- Name: tenant_group_memberships; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.tenant_group_memberships (
+    group_id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+ALTER TABLE ONLY public.tenant_group_memberships FORCE ROW LEVEL SECURITY;
+
+
+--
+-- Name: tenant_groups; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.tenant_groups (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    tenant_id uuid NOT NULL,
+    organization_id uuid NOT NULL,
+    parent_group_id uuid,
+    name text NOT NULL,
+    description text DEFAULT ''::text NOT NULL,
+    created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
 );
 
+ALTER TABLE ONLY public.tenant_groups FORCE ROW LEVEL SECURITY;
+
 
 --
 -- Name: tenant_join_codes; Type: TABLE; Schema: public; Owner: -
@@ -215,417 +609,1493 @@ CREATE TABLE public.tenant_join_codes (
     expires_at timestamp with time zone,
     max_uses integer DEFAULT 0 NOT NULL,
     used_count integer DEFAULT 0 NOT NULL,
-    created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+    created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    organization_id uuid NOT NULL
+);
+
+ALTER TABLE ONLY public.tenant_join_codes FORCE ROW LEVEL SECURITY;
+
+
+--
+-- Name: tenant_memberships; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.tenant_memberships (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    tenant_id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    role text DEFAULT 'member'::text NOT NULL,
+    created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    left_at timestamp with time zone,
+    CONSTRAINT tenant_memberships_role_check CHECK ((role = ANY (ARRAY['admin'::text, 'member'::text])))
+);
+
+
+--
+-- Name: tenant_types; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.tenant_types (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    organization_id uuid NOT NULL,
+    key text NOT NULL,
+    label text NOT NULL,
+    created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
 );
 
-ALTER TABLE ONLY public.tenant_join_codes FORCE ROW LEVEL SECURITY;
+ALTER TABLE ONLY public.tenant_types FORCE ROW LEVEL SECURITY;
+
+
+--
+-- Name: tenants; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.tenants (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    organization_id uuid NOT NULL,
+    name text NOT NULL,
+    description text DEFAULT ''::text NOT NULL,
+    tenant_type text NOT NULL,
+    created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    archived_at timestamp with time zone,
+    version bigint DEFAULT 1 NOT NULL
+);
+
+ALTER TABLE ONLY public.tenants FORCE ROW LEVEL SECURITY;
+
+
+--
+-- Name: user_identities; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.user_identities (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    user_id uuid NOT NULL,
+    provider text NOT NULL,
+    provider_sub text NOT NULL,
+    created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+
+--
+-- Name: users; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.users (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    email text NOT NULL,
+    name text NOT NULL,
+    icon text NOT NULL,
+    created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+
+--
+-- Name: webauthn_ceremonies; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.webauthn_ceremonies (
+    id text NOT NULL,
+    ceremony_type text NOT NULL,
+    user_id uuid,
+    session_data jsonb NOT NULL,
+    expires_at timestamp with time zone NOT NULL,
+    created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    CONSTRAINT webauthn_ceremonies_ceremony_type_check CHECK ((ceremony_type = ANY (ARRAY['registration'::text, 'login'::text])))
+);
+
+
+--
+-- Name: webauthn_credentials; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.webauthn_credentials (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    user_id uuid NOT NULL,
+    name text NOT NULL,
+    credential_id bytea NOT NULL,
+    public_key bytea NOT NULL,
+    attestation_type text NOT NULL,
+    aaguid bytea NOT NULL,
+    sign_count bigint DEFAULT 0 NOT NULL,
+    transports text[] DEFAULT '{}'::text[] NOT NULL,
+    backup_eligible boolean DEFAULT false NOT NULL,
+    backup_state boolean DEFAULT false NOT NULL,
+    last_used_at timestamp with time zone,
+    created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+
+--
+-- Name: webhook_deliveries; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.webhook_deliveries (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    subscription_id uuid NOT NULL,
+    organization_id uuid NOT NULL,
+    event_id uuid NOT NULL,
+    event_type text NOT NULL,
+    status_code integer DEFAULT 0 NOT NULL,
+    error text DEFAULT ''::text NOT NULL,
+    succeeded boolean NOT NULL,
+    duration_ms integer NOT NULL,
+    created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+ALTER TABLE ONLY public.webhook_deliveries FORCE ROW LEVEL SECURITY;
+
+
+--
+-- Name: webhook_subscriptions; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.webhook_subscriptions (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    organization_id uuid NOT NULL,
+    url text NOT NULL,
+    event_types text[] NOT NULL,
+    secret text NOT NULL,
+    description text DEFAULT ''::text NOT NULL,
+    created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+ALTER TABLE ONLY public.webhook_subscriptions FORCE ROW LEVEL SECURITY;
+
+
+--
+-- Name: api_tokens api_tokens_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.api_tokens
+    ADD CONSTRAINT api_tokens_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: api_tokens api_tokens_token_hash_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.api_tokens
+    ADD CONSTRAINT api_tokens_token_hash_key UNIQUE (token_hash);
+
+
+--
+-- Name: audit_logs audit_logs_organization_id_seq_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.audit_logs
+    ADD CONSTRAINT audit_logs_organization_id_seq_key UNIQUE (organization_id, seq);
+
+
+--
+-- Name: audit_logs audit_logs_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.audit_logs
+    ADD CONSTRAINT audit_logs_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: buildings buildings_organization_id_name_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.buildings
+    ADD CONSTRAINT buildings_organization_id_name_key UNIQUE (organization_id, name);
+
+
+--
+-- Name: buildings buildings_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.buildings
+    ADD CONSTRAINT buildings_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: console_operators console_operators_key_hash_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.console_operators
+    ADD CONSTRAINT console_operators_key_hash_key UNIQUE (key_hash);
+
+
+--
+-- Name: console_operators console_operators_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.console_operators
+    ADD CONSTRAINT console_operators_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: console_sessions console_sessions_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.console_sessions
+    ADD CONSTRAINT console_sessions_pkey PRIMARY KEY (session_id);
+
+
+--
+-- Name: floors floors_building_id_name_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.floors
+    ADD CONSTRAINT floors_building_id_name_key UNIQUE (building_id, name);
+
+
+--
+-- Name: floors floors_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.floors
+    ADD CONSTRAINT floors_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: goose_db_version goose_db_version_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.goose_db_version
+    ADD CONSTRAINT goose_db_version_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: keys keys_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.keys
+    ADD CONSTRAINT keys_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: notification_deliveries notification_deliveries_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.notification_deliveries
+    ADD CONSTRAINT notification_deliveries_pkey PRIMARY KEY (dedup_key);
+
+
+--
+-- Name: notification_preferences notification_preferences_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.notification_preferences
+    ADD CONSTRAINT notification_preferences_pkey PRIMARY KEY (user_id);
+
+
+--
+-- Name: oauth_states oauth_states_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.oauth_states
+    ADD CONSTRAINT oauth_states_pkey PRIMARY KEY (state);
+
+
+--
+-- Name: organizations organizations_key_hash_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.organizations
+    ADD CONSTRAINT organizations_key_hash_key UNIQUE (key_hash);
+
+
+--
+-- Name: organizations organizations_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.organizations
+    ADD CONSTRAINT organizations_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: organizations organizations_slug_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.organizations
+    ADD CONSTRAINT organizations_slug_key UNIQUE (slug);
+
+
+--
+-- Name: outbox_events outbox_events_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.outbox_events
+    ADD CONSTRAINT outbox_events_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: rate_limit_buckets rate_limit_buckets_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.rate_limit_buckets
+    ADD CONSTRAINT rate_limit_buckets_pkey PRIMARY KEY (key);
+
+
+--
+-- Name: rate_limit_failures rate_limit_failures_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.rate_limit_failures
+    ADD CONSTRAINT rate_limit_failures_pkey PRIMARY KEY (key);
+
+
+--
+-- Name: room_assignments room_assignments_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.room_assignments
+    ADD CONSTRAINT room_assignments_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: room_custom_fields room_custom_fields_organization_id_key_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.room_custom_fields
+    ADD CONSTRAINT room_custom_fields_organization_id_key_key UNIQUE (organization_id, key);
+
+
+--
+-- Name: room_custom_fields room_custom_fields_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.room_custom_fields
+    ADD CONSTRAINT room_custom_fields_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: room_types room_types_organization_id_key_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.room_types
+    ADD CONSTRAINT room_types_organization_id_key_key UNIQUE (organization_id, key);
+
+
+--
+-- Name: room_types room_types_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.room_types
+    ADD CONSTRAINT room_types_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: rooms rooms_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.rooms
+    ADD CONSTRAINT rooms_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: sessions sessions_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.sessions
+    ADD CONSTRAINT sessions_pkey PRIMARY KEY (session_id);
+
+
+--
+-- Name: tenant_group_memberships tenant_group_memberships_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.tenant_group_memberships
+    ADD CONSTRAINT tenant_group_memberships_pkey PRIMARY KEY (group_id, user_id);
+
+
+--
+-- Name: tenant_groups tenant_groups_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.tenant_groups
+    ADD CONSTRAINT tenant_groups_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: tenant_groups tenant_groups_tenant_id_name_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.tenant_groups
+    ADD CONSTRAINT tenant_groups_tenant_id_name_key UNIQUE (tenant_id, name);
+
+
+--
+-- Name: tenant_join_codes tenant_join_codes_organization_id_code_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.tenant_join_codes
+    ADD CONSTRAINT tenant_join_codes_organization_id_code_key UNIQUE (organization_id, code);
+
+
+--
+-- Name: tenant_join_codes tenant_join_codes_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.tenant_join_codes
+    ADD CONSTRAINT tenant_join_codes_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: tenant_memberships tenant_memberships_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.tenant_memberships
+    ADD CONSTRAINT tenant_memberships_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: tenant_memberships tenant_memberships_tenant_id_user_id_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.tenant_memberships
+    ADD CONSTRAINT tenant_memberships_tenant_id_user_id_key UNIQUE (tenant_id, user_id);
+
+
+--
+-- Name: tenant_types tenant_types_organization_id_key_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.tenant_types
+    ADD CONSTRAINT tenant_types_organization_id_key_key UNIQUE (organization_id, key);
+
+
+--
+-- Name: tenant_types tenant_types_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.tenant_types
+    ADD CONSTRAINT tenant_types_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: tenants tenants_name_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.tenants
+    ADD CONSTRAINT tenants_name_key UNIQUE (name);
+
+
+--
+-- Name: tenants tenants_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.tenants
+    ADD CONSTRAINT tenants_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: user_identities user_identities_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.user_identities
+    ADD CONSTRAINT user_identities_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: user_identities user_identities_provider_provider_sub_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.user_identities
+    ADD CONSTRAINT user_identities_provider_provider_sub_key UNIQUE (provider, provider_sub);
+
+
+--
+-- Name: users users_email_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.users
+    ADD CONSTRAINT users_email_key UNIQUE (email);
+
+
+--
+-- Name: users users_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.users
+    ADD CONSTRAINT users_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: webauthn_ceremonies webauthn_ceremonies_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.webauthn_ceremonies
+    ADD CONSTRAINT webauthn_ceremonies_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: webauthn_credentials webauthn_credentials_credential_id_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.webauthn_credentials
+    ADD CONSTRAINT webauthn_credentials_credential_id_key UNIQUE (credential_id);
+
+
+--
+-- Name: webauthn_credentials webauthn_credentials_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.webauthn_credentials
+    ADD CONSTRAINT webauthn_credentials_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: webhook_deliveries webhook_deliveries_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.webhook_deliveries
+    ADD CONSTRAINT webhook_deliveries_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: webhook_subscriptions webhook_subscriptions_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.webhook_subscriptions
+    ADD CONSTRAINT webhook_subscriptions_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: idx_api_tokens_organization; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_api_tokens_organization ON public.api_tokens USING btree (organization_id);
+
+
+--
+-- Name: idx_api_tokens_user; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_api_tokens_user ON public.api_tokens USING btree (user_id);
+
+
+--
+-- Name: idx_audit_logs_actor; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_audit_logs_actor ON public.audit_logs USING btree (organization_id, actor_id, created_at DESC);
+
+
+--
+-- Name: idx_audit_logs_organization; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_audit_logs_organization ON public.audit_logs USING btree (organization_id, created_at DESC, id DESC);
+
+
+--
+-- Name: idx_console_operators_organization; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_console_operators_organization ON public.console_operators USING btree (organization_id);
+
+
+--
+-- Name: idx_console_sessions_expires; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_console_sessions_expires ON public.console_sessions USING btree (expires_at);
+
+
+--
+-- Name: idx_console_sessions_operator; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_console_sessions_operator ON public.console_sessions USING btree (operator_id);
+
+
+--
+-- Name: idx_console_sessions_tenant; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_console_sessions_tenant ON public.console_sessions USING btree (organization_id);
+
+
+--
+-- Name: idx_join_codes_exp; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_join_codes_exp ON public.tenant_join_codes USING btree (expires_at);
+
+
+--
+-- Name: idx_join_codes_tenant; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_join_codes_tenant ON public.tenant_join_codes USING btree (tenant_id);
+
+
+--
+-- Name: idx_keys_key_number_trgm; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_keys_key_number_trgm ON public.keys USING gin (key_number public.gin_trgm_ops);
+
+
+--
+-- Name: idx_keys_room_created; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_keys_room_created ON public.keys USING btree (room_id, created_at DESC, id DESC);
+
+
+--
+-- Name: idx_keys_room_key_number; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_keys_room_key_number ON public.keys USING btree (room_id, key_number, id);
+
+
+--
+-- Name: idx_memberships_tenant_left; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_memberships_tenant_left ON public.tenant_memberships USING btree (tenant_id, left_at) WHERE (left_at IS NULL);
+
+
+--
+-- Name: idx_memberships_user; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_memberships_user ON public.tenant_memberships USING btree (user_id);
+
+
+--
+-- Name: idx_memberships_user_left_tenant; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_memberships_user_left_tenant ON public.tenant_memberships USING btree (user_id, left_at, tenant_id) WHERE (left_at IS NULL);
+
+
+--
+-- Name: idx_notification_deliveries_user; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_notification_deliveries_user ON public.notification_deliveries USING btree (user_id, created_at DESC);
+
+
+--
+-- Name: idx_oauth_states_created; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_oauth_states_created ON public.oauth_states USING btree (created_at);
+
+
+--
+-- Name: idx_outbox_events_organization; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_outbox_events_organization ON public.outbox_events USING btree (organization_id, occurred_at DESC);
+
+
+--
+-- Name: idx_outbox_events_pending; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_outbox_events_pending ON public.outbox_events USING btree (next_attempt_at) WHERE (status = 'pending'::text);
+
+
+--
+-- Name: idx_rate_limit_buckets_idle; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_rate_limit_buckets_idle ON public.rate_limit_buckets USING btree (idle_at);
+
+
+--
+-- Name: idx_rate_limit_failures_idle; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_rate_limit_failures_idle ON public.rate_limit_failures USING btree (idle_at);
+
+
+--
+-- Name: idx_room_assignments_expires_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_room_assignments_expires_at ON public.room_assignments USING btree (expires_at) WHERE (expires_at IS NOT NULL);
+
+
+--
+-- Name: idx_room_assignments_tenant; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_room_assignments_tenant ON public.room_assignments USING btree (tenant_id);
+
+
+--
+-- Name: idx_rooms_accessibility; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_rooms_accessibility ON public.rooms USING gin (accessibility);
+
+
+--
+-- Name: idx_rooms_building_name_trgm; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_rooms_building_name_trgm ON public.rooms USING gin (building_name public.gin_trgm_ops);
+
+
+--
+-- Name: idx_rooms_custom_fields; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_rooms_custom_fields ON public.rooms USING gin (custom_fields jsonb_path_ops);
+
+
+--
+-- Name: idx_rooms_description_trgm; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_rooms_description_trgm ON public.rooms USING gin (description public.gin_trgm_ops);
+
+
+--
+-- Name: idx_rooms_equipment; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_rooms_equipment ON public.rooms USING gin (equipment);
+
+
+--
+-- Name: idx_rooms_floor; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_rooms_floor ON public.rooms USING btree (floor_id);
+
+
+--
+-- Name: idx_rooms_name_trgm; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_rooms_name_trgm ON public.rooms USING gin (name public.gin_trgm_ops);
+
+
+--
+-- Name: idx_rooms_org_created; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_rooms_org_created ON public.rooms USING btree (organization_id, created_at DESC, id DESC);
+
+
+--
+-- Name: idx_rooms_org_name; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_rooms_org_name ON public.rooms USING btree (organization_id, name, id);
+
+
+--
+-- Name: idx_rooms_search_tsv; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_rooms_search_tsv ON public.rooms USING gin (to_tsvector('simple'::regconfig, ((((name || ' '::text) || building_name) || ' '::text) || description)));
+
+
+--
+-- Name: idx_sessions_active_membership; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_sessions_active_membership ON public.sessions USING btree (active_membership_id);
+
+
+--
+-- Name: idx_sessions_expires; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_sessions_expires ON public.sessions USING btree (expires_at);
+
+
+--
+-- Name: idx_sessions_organization; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_sessions_organization ON public.sessions USING btree (organization_id);
+
+
+--
+-- Name: idx_sessions_user; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_sessions_user ON public.sessions USING btree (user_id);
+
+
+--
+-- Name: idx_tenant_group_memberships_user; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_tenant_group_memberships_user ON public.tenant_group_memberships USING btree (user_id);
+
+
+--
+-- Name: idx_tenant_groups_parent; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_tenant_groups_parent ON public.tenant_groups USING btree (parent_group_id);
+
+
+--
+-- Name: idx_tenant_groups_tenant; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_tenant_groups_tenant ON public.tenant_groups USING btree (tenant_id);
+
+
+--
+-- Name: idx_tenants_name_trgm; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_tenants_name_trgm ON public.tenants USING gin (name public.gin_trgm_ops);
+
+
+--
+-- Name: idx_tenants_org_created; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_tenants_org_created ON public.tenants USING btree (organization_id, created_at DESC, id DESC);
+
+
+--
+-- Name: idx_tenants_org_name; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_tenants_org_name ON public.tenants USING btree (organization_id, name, id);
+
+
+--
+-- Name: idx_tenants_organization_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_tenants_organization_id ON public.tenants USING btree (organization_id);
+
+
+--
+-- Name: idx_tenants_search_tsv; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_tenants_search_tsv ON public.tenants USING gin (to_tsvector('simple'::regconfig, ((name || ' '::text) || description)));
+
+
+--
+-- Name: idx_users_email_trgm; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_users_email_trgm ON public.users USING gin (email public.gin_trgm_ops);
+
+
+--
+-- Name: idx_webauthn_ceremonies_expires; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_webauthn_ceremonies_expires ON public.webauthn_ceremonies USING btree (expires_at);
+
+
+--
+-- Name: idx_webauthn_credentials_user; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_webauthn_credentials_user ON public.webauthn_credentials USING btree (user_id);
+
+
+--
+-- Name: idx_webhook_deliveries_event; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_webhook_deliveries_event ON public.webhook_deliveries USING btree (event_id, subscription_id) WHERE succeeded;
+
+
+--
+-- Name: idx_webhook_deliveries_subscription; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_webhook_deliveries_subscription ON public.webhook_deliveries USING btree (subscription_id, created_at DESC);
+
+
+--
+-- Name: idx_webhook_subscriptions_organization; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_webhook_subscriptions_organization ON public.webhook_subscriptions USING btree (organization_id);
+
+
+--
+-- Name: keys increment_keys_version; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER increment_keys_version BEFORE UPDATE ON public.keys FOR EACH ROW EXECUTE FUNCTION public.increment_version_column();
+
+
+--
+-- Name: rooms increment_rooms_version; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER increment_rooms_version BEFORE UPDATE ON public.rooms FOR EACH ROW EXECUTE FUNCTION public.increment_version_column();
+
+
+--
+-- Name: tenants increment_tenants_version; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER increment_tenants_version BEFORE UPDATE ON public.tenants FOR EACH ROW EXECUTE FUNCTION public.increment_version_column();
+
+
+--
+-- Name: keys notify_keys_change; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER notify_keys_change AFTER INSERT OR DELETE OR UPDATE ON public.keys FOR EACH ROW EXECUTE FUNCTION public.notify_key_change();
+
+
+--
+-- Name: buildings propagate_buildings_name; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER propagate_buildings_name AFTER UPDATE OF name ON public.buildings FOR EACH ROW WHEN ((old.name IS DISTINCT FROM new.name)) EXECUTE FUNCTION public.propagate_building_name();
+
+
+--
+-- Name: floors propagate_floors_name; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER propagate_floors_name AFTER UPDATE OF name ON public.floors FOR EACH ROW WHEN ((old.name IS DISTINCT FROM new.name)) EXECUTE FUNCTION public.propagate_floor_name();
+
+
+--
+-- Name: buildings refresh_buildings_updated_at; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER refresh_buildings_updated_at BEFORE UPDATE ON public.buildings FOR EACH ROW EXECUTE FUNCTION public.update_updated_at_column();
+
+
+--
+-- Name: floors refresh_floors_updated_at; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER refresh_floors_updated_at BEFORE UPDATE ON public.floors FOR EACH ROW EXECUTE FUNCTION public.update_updated_at_column();
+
+
+--
+-- Name: keys refresh_keys_updated_at; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER refresh_keys_updated_at BEFORE UPDATE ON public.keys FOR EACH ROW EXECUTE FUNCTION public.update_updated_at_column();
+
+
+--
+-- Name: room_assignments refresh_room_assignments_updated_at; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER refresh_room_assignments_updated_at BEFORE UPDATE ON public.room_assignments FOR EACH ROW EXECUTE FUNCTION public.update_updated_at_column();
+
+
+--
+-- Name: room_custom_fields refresh_room_custom_fields_updated_at; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER refresh_room_custom_fields_updated_at BEFORE UPDATE ON public.room_custom_fields FOR EACH ROW EXECUTE FUNCTION public.update_updated_at_column();
+
+
+--
+-- Name: room_types refresh_room_types_updated_at; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER refresh_room_types_updated_at BEFORE UPDATE ON public.room_types FOR EACH ROW EXECUTE FUNCTION public.update_updated_at_column();
+
+
+--
+-- Name: rooms refresh_rooms_updated_at; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER refresh_rooms_updated_at BEFORE UPDATE ON public.rooms FOR EACH ROW EXECUTE FUNCTION public.update_updated_at_column();
+
+
+--
+-- Name: tenant_groups refresh_tenant_groups_updated_at; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER refresh_tenant_groups_updated_at BEFORE UPDATE ON public.tenant_groups FOR EACH ROW EXECUTE FUNCTION public.update_updated_at_column();
+
+
+--
+-- Name: tenant_types refresh_tenant_types_updated_at; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER refresh_tenant_types_updated_at BEFORE UPDATE ON public.tenant_types FOR EACH ROW EXECUTE FUNCTION public.update_updated_at_column();
+
+
+--
+-- Name: tenants refresh_tenants_updated_at; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER refresh_tenants_updated_at BEFORE UPDATE ON public.tenants FOR EACH ROW EXECUTE FUNCTION public.update_updated_at_column();
+
+
+--
+-- Name: user_identities refresh_user_identities_updated_at; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER refresh_user_identities_updated_at BEFORE UPDATE ON public.user_identities FOR EACH ROW EXECUTE FUNCTION public.update_updated_at_column();
+
+
+--
+-- Name: users refresh_users_updated_at; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER refresh_users_updated_at BEFORE UPDATE ON public.users FOR EACH ROW EXECUTE FUNCTION public.update_updated_at_column();
+
+
+--
+-- Name: rooms sync_rooms_location; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER sync_rooms_location BEFORE INSERT OR UPDATE OF floor_id ON public.rooms FOR EACH ROW EXECUTE FUNCTION public.sync_room_location();
+
+
+--
+-- Name: api_tokens api_tokens_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.api_tokens
+    ADD CONSTRAINT api_tokens_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
+
+
+--
+-- Name: api_tokens api_tokens_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.api_tokens
+    ADD CONSTRAINT api_tokens_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: buildings buildings_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.buildings
+    ADD CONSTRAINT buildings_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id);
+
+
+--
+-- Name: console_operators console_operators_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.console_operators
+    ADD CONSTRAINT console_operators_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
+
+
+--
+-- Name: console_sessions console_sessions_operator_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.console_sessions
+    ADD CONSTRAINT console_sessions_operator_id_fkey FOREIGN KEY (operator_id) REFERENCES public.console_operators(id) ON DELETE CASCADE;
+
+
+--
+-- Name: console_sessions console_sessions_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.console_sessions
+    ADD CONSTRAINT console_sessions_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
+
+
+--
+-- Name: sessions fk_sessions_active_membership; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.sessions
+    ADD CONSTRAINT fk_sessions_active_membership FOREIGN KEY (active_membership_id) REFERENCES public.tenant_memberships(id);
+
+
+--
+-- Name: floors floors_building_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.floors
+    ADD CONSTRAINT floors_building_id_fkey FOREIGN KEY (building_id) REFERENCES public.buildings(id) ON DELETE RESTRICT;
 
 
 --
--- Name: tenant_memberships; Type: TABLE; Schema: public; Owner: -
+-- Name: floors floors_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-CREATE TABLE public.tenant_memberships (
-    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
-    tenant_id uuid NOT NULL,
-    user_id uuid NOT NULL,
-    role text DEFAULT 'member'::text NOT NULL,
-    created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
-    left_at timestamp with time zone,
-    CONSTRAINT tenant_memberships_role_check CHECK ((role = ANY (ARRAY['admin'::text, 'member'::text])))
-);
+ALTER TABLE ONLY public.floors
+    ADD CONSTRAINT floors_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id);
 
 
 --
--- Name: tenants; Type: TABLE; Schema: public; Owner: -
+-- Name: keys keys_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-CREATE TABLE public.tenants (
-    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
-    organization_id uuid DEFAULT '550e8400-e29b-41d4-a716-446655440000'::uuid NOT NULL,
-    name text NOT NULL,
-    description text DEFAULT ''::text NOT NULL,
-    tenant_type text NOT NULL,
-    created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
-    updated_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
-);
-
-ALTER TABLE ONLY public.tenants FORCE ROW LEVEL SECURITY;
+ALTER TABLE ONLY public.keys
+    ADD CONSTRAINT keys_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id);
 
 
 --
--- Name: user_identities; Type: TABLE; Schema: public; Owner: -
+-- Name: keys keys_room_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-CREATE TABLE public.user_identities (
-    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
-    user_id uuid NOT NULL,
-    provider text NOT NULL,
-    provider_sub text NOT NULL,
-    created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
-    updated_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
-);
+ALTER TABLE ONLY public.keys
+    ADD CONSTRAINT keys_room_id_fkey FOREIGN KEY (room_id) REFERENCES public.rooms(id) ON DELETE CASCADE;
 
 
 --
--- Name: users; Type: TABLE; Schema: public; Owner: -
+-- Name: notification_deliveries notification_deliveries_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-CREATE TABLE public.users (
-    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
-    email text NOT NULL,
-    name text NOT NULL,
-    icon text NOT NULL,
-    created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
-    updated_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
-);
+ALTER TABLE ONLY public.notification_deliveries
+    ADD CONSTRAINT notification_deliveries_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
 
 
 --
--- Name: console_sessions console_sessions_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: notification_deliveries notification_deliveries_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.console_sessions
-    ADD CONSTRAINT console_sessions_pkey PRIMARY KEY (session_id);
+ALTER TABLE ONLY public.notification_deliveries
+    ADD CONSTRAINT notification_deliveries_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
 
 
 --
--- Name: goose_db_version goose_db_version_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: notification_preferences notification_preferences_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.goose_db_version
-    ADD CONSTRAINT goose_db_version_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY public.notification_preferences
+    ADD CONSTRAINT notification_preferences_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
 
 
 --
--- Name: keys keys_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: oauth_states oauth_states_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.keys
-    ADD CONSTRAINT keys_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY public.oauth_states
+    ADD CONSTRAINT oauth_states_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
 
 
 --
--- Name: oauth_states oauth_states_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: outbox_events outbox_events_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.oauth_states
-    ADD CONSTRAINT oauth_states_pkey PRIMARY KEY (state);
+ALTER TABLE ONLY public.outbox_events
+    ADD CONSTRAINT outbox_events_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
 
 
 --
--- Name: room_assignments room_assignments_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: room_assignments room_assignments_group_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
 ALTER TABLE ONLY public.room_assignments
-    ADD CONSTRAINT room_assignments_pkey PRIMARY KEY (id);
+    ADD CONSTRAINT room_assignments_group_id_fkey FOREIGN KEY (group_id) REFERENCES public.tenant_groups(id) ON DELETE RESTRICT;
 
 
 --
--- Name: rooms rooms_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: room_assignments room_assignments_key_loan_group_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.rooms
-    ADD CONSTRAINT rooms_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY public.room_assignments
+    ADD CONSTRAINT room_assignments_key_loan_group_id_fkey FOREIGN KEY (key_loan_group_id) REFERENCES public.tenant_groups(id) ON DELETE RESTRICT;
 
 
 --
--- Name: sessions sessions_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: room_assignments room_assignments_room_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.sessions
-    ADD CONSTRAINT sessions_pkey PRIMARY KEY (session_id);
+ALTER TABLE ONLY public.room_assignments
+    ADD CONSTRAINT room_assignments_room_id_fkey FOREIGN KEY (room_id) REFERENCES public.rooms(id) ON DELETE CASCADE;
 
 
 --
--- Name: tenant_join_codes tenant_join_codes_code_key; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: room_assignments room_assignments_tenant_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.tenant_join_codes
-    ADD CONSTRAINT tenant_join_codes_code_key UNIQUE (code);
+ALTER TABLE ONLY public.room_assignments
+    ADD CONSTRAINT room_assignments_tenant_id_fkey FOREIGN KEY (tenant_id) REFERENCES public.tenants(id) ON DELETE RESTRICT;
 
 
 --
--- Name: tenant_join_codes tenant_join_codes_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: room_custom_fields room_custom_fields_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.tenant_join_codes
-    ADD CONSTRAINT tenant_join_codes_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY public.room_custom_fields
+    ADD CONSTRAINT room_custom_fields_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id);
 
 
 --
--- Name: tenant_memberships tenant_memberships_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: room_types room_types_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.tenant_memberships
-    ADD CONSTRAINT tenant_memberships_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY public.room_types
+    ADD CONSTRAINT room_types_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id);
 
 
 --
--- Name: tenant_memberships tenant_memberships_tenant_id_user_id_key; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: rooms rooms_floor_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.tenant_memberships
-    ADD CONSTRAINT tenant_memberships_tenant_id_user_id_key UNIQUE (tenant_id, user_id);
+ALTER TABLE ONLY public.rooms
+    ADD CONSTRAINT rooms_floor_id_fkey FOREIGN KEY (floor_id) REFERENCES public.floors(id) ON DELETE RESTRICT;
 
 
 --
--- Name: tenants tenants_name_key; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: rooms rooms_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.tenants
-    ADD CONSTRAINT tenants_name_key UNIQUE (name);
+ALTER TABLE ONLY public.rooms
+    ADD CONSTRAINT rooms_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id);
 
 
 --
--- Name: tenants tenants_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: sessions sessions_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.tenants
-    ADD CONSTRAINT tenants_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY public.sessions
+    ADD CONSTRAINT sessions_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
 
 
 --
--- Name: user_identities user_identities_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: sessions sessions_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.user_identities
-    ADD CONSTRAINT user_identities_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY public.sessions
+    ADD CONSTRAINT sessions_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
 
 
 --
--- Name: user_identities user_identities_provider_provider_sub_key; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: tenant_group_memberships tenant_group_memberships_group_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.user_identities
-    ADD CONSTRAINT user_identities_provider_provider_sub_key UNIQUE (provider, provider_sub);
+ALTER TABLE ONLY public.tenant_group_memberships
+    ADD CONSTRAINT tenant_group_memberships_group_id_fkey FOREIGN KEY (group_id) REFERENCES public.tenant_groups(id) ON DELETE CASCADE;
 
 
 --
--- Name: users users_email_key; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: tenant_group_memberships tenant_group_memberships_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.users
-    ADD CONSTRAINT users_email_key UNIQUE (email);
+ALTER TABLE ONLY public.tenant_group_memberships
+    ADD CONSTRAINT tenant_group_memberships_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
 
 
 --
--- Name: users users_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: tenant_groups tenant_groups_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.users
-    ADD CONSTRAINT users_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY public.tenant_groups
+    ADD CONSTRAINT tenant_groups_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id);
 
 
 --
--- Name: idx_console_sessions_expires; Type: INDEX; Schema: public; Owner: -
+-- Name: tenant_groups tenant_groups_parent_group_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-CREATE INDEX idx_console_sessions_expires ON public.console_sessions USING btree (expires_at);
+ALTER TABLE ONLY public.tenant_groups
+    ADD CONSTRAINT tenant_groups_parent_group_id_fkey FOREIGN KEY (parent_group_id) REFERENCES public.tenant_groups(id) ON DELETE CASCADE;
 
 
 --
--- Name: idx_console_sessions_tenant; Type: INDEX; Schema: public; Owner: -
+-- Name: tenant_groups tenant_groups_tenant_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-CREATE INDEX idx_console_sessions_tenant ON public.console_sessions USING btree (organization_id);
+ALTER TABLE ONLY public.tenant_groups
+    ADD CONSTRAINT tenant_groups_tenant_id_fkey FOREIGN KEY (tenant_id) REFERENCES public.tenants(id) ON DELETE RESTRICT;
 
 
 --
--- Name: idx_join_codes_exp; Type: INDEX; Schema: public; Owner: -
+-- Name: tenant_join_codes tenant_join_codes_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-CREATE INDEX idx_join_codes_exp ON public.tenant_join_codes USING btree (expires_at);
+ALTER TABLE ONLY public.tenant_join_codes
+    ADD CONSTRAINT tenant_join_codes_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id);
 
 
 --
--- Name: idx_join_codes_tenant; Type: INDEX; Schema: public; Owner: -
+-- Name: tenant_join_codes tenant_join_codes_tenant_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-CREATE INDEX idx_join_codes_tenant ON public.tenant_join_codes USING btree (tenant_id);
+ALTER TABLE ONLY public.tenant_join_codes
+    ADD CONSTRAINT tenant_join_codes_tenant_id_fkey FOREIGN KEY (tenant_id) REFERENCES public.tenants(id) ON DELETE RESTRICT;
 
 
 --
--- Name: idx_memberships_tenant_left; Type: INDEX; Schema: public; Owner: -
+-- Name: tenant_memberships tenant_memberships_tenant_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-CREATE INDEX idx_memberships_tenant_left ON public.tenant_memberships USING btree (tenant_id, left_at) WHERE (left_at IS NULL);
+ALTER TABLE ONLY public.tenant_memberships
+    ADD CONSTRAINT tenant_memberships_tenant_id_fkey FOREIGN KEY (tenant_id) REFERENCES public.tenants(id) ON DELETE RESTRICT;
 
 
 --
--- Name: idx_memberships_user; Type: INDEX; Schema: public; Owner: -
+-- Name: tenant_memberships tenant_memberships_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-CREATE INDEX idx_memberships_user ON public.tenant_memberships USING btree (user_id);
+ALTER TABLE ONLY public.tenant_memberships
+    ADD CONSTRAINT tenant_memberships_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
 
 
 --
--- Name: idx_memberships_user_left_tenant; Type: INDEX; Schema: public; Owner: -
+-- Name: tenant_types tenant_types_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-CREATE INDEX idx_memberships_user_left_tenant ON public.tenant_memberships USING btree (user_id, left_at, tenant_id) WHERE (left_at IS NULL);
+ALTER TABLE ONLY public.tenant_types
+    ADD CONSTRAINT tenant_types_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id);
 
 
 --
--- Name: idx_oauth_states_created; Type: INDEX; Schema: public; Owner: -
+-- Name: tenants tenants_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-CREATE INDEX idx_oauth_states_created ON public.oauth_states USING btree (created_at);
+ALTER TABLE ONLY public.tenants
+    ADD CONSTRAINT tenants_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id);
 
 
 --
--- Name: idx_sessions_active_membership; Type: INDEX; Schema: public; Owner: -
+-- Name: user_identities user_identities_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-CREATE INDEX idx_sessions_active_membership ON public.sessions USING btree (active_membership_id);
+ALTER TABLE ONLY public.user_identities
+    ADD CONSTRAINT user_identities_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
 
 
 --
--- Name: idx_sessions_expires; Type: INDEX; Schema: public; Owner: -
+-- Name: webauthn_ceremonies webauthn_ceremonies_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-CREATE INDEX idx_sessions_expires ON public.sessions USING btree (expires_at);
+ALTER TABLE ONLY public.webauthn_ceremonies
+    ADD CONSTRAINT webauthn_ceremonies_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
 
 
 --
--- Name: idx_sessions_user; Type: INDEX; Schema: public; Owner: -
+-- Name: webauthn_credentials webauthn_credentials_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-CREATE INDEX idx_sessions_user ON public.sessions USING btree (user_id);
+ALTER TABLE ONLY public.webauthn_credentials
+    ADD CONSTRAINT webauthn_credentials_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
 
 
 --
--- Name: idx_tenants_organization_id; Type: INDEX; Schema: public; Owner: -
+-- Name: webhook_deliveries webhook_deliveries_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-CREATE INDEX idx_tenants_organization_id ON public.tenants USING btree (organization_id);
+ALTER TABLE ONLY public.webhook_deliveries
+    ADD CONSTRAINT webhook_deliveries_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
 
 
 --
--- Name: keys refresh_keys_updated_at; Type: TRIGGER; Schema: public; Owner: -
+-- Name: webhook_deliveries webhook_deliveries_subscription_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-CREATE TRIGGER refresh_keys_updated_at BEFORE UPDATE ON public.keys FOR EACH ROW EXECUTE FUNCTION public.update_updated_at_column();
+ALTER TABLE ONLY public.webhook_deliveries
+    ADD CONSTRAINT webhook_deliveries_subscription_id_fkey FOREIGN KEY (subscription_id) REFERENCES public.webhook_subscriptions(id) ON DELETE CASCADE;
 
 
 --
--- Name: room_assignments refresh_room_assignments_updated_at; Type: TRIGGER; Schema: public; Owner: -
+-- Name: webhook_subscriptions webhook_subscriptions_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
 
-CREATE TRIGGER refresh_room_assignments_updated_at BEFORE UPDATE ON public.room_assignments FOR EACH ROW EXECUTE FUNCTION public.update_updated_at_column();
+ALTER TABLE ONLY public.webhook_subscriptions
+    ADD CONSTRAINT webhook_subscriptions_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
 
 
 --
--- Name: rooms refresh_rooms_updated_at; Type: TRIGGER; Schema: public; Owner: -
+-- Name: audit_logs; Type: ROW SECURITY; Schema: public; Owner: -
 --
 
-CREATE TRIGGER refresh_rooms_updated_at BEFORE UPDATE ON public.rooms FOR EACH ROW EXECUTE FUNCTION public.update_updated_at_column();
-
+ALTER TABLE public.audit_logs ENABLE ROW LEVEL SECURITY;
 
 --
--- Name: tenants refresh_tenants_updated_at; Type: TRIGGER; Schema: public; Owner: -
+-- Name: audit_logs audit_logs_org_isolation_insert; Type: POLICY; Schema: public; Owner: -
 --
 
-CREATE TRIGGER refresh_tenants_updated_at BEFORE UPDATE ON public.tenants FOR EACH ROW EXECUTE FUNCTION public.update_updated_at_column();
+CREATE POLICY audit_logs_org_isolation_insert ON public.audit_logs FOR INSERT TO keyhub WITH CHECK (((public.current_organization_id() IS NULL) OR (organization_id = public.current_organization_id())));
 
 
 --
--- Name: user_identities refresh_user_identities_updated_at; Type: TRIGGER; Schema: public; Owner: -
+-- Name: audit_logs audit_logs_org_isolation_select; Type: POLICY; Schema: public; Owner: -
 --
 
-CREATE TRIGGER refresh_user_identities_updated_at BEFORE UPDATE ON public.user_identities FOR EACH ROW EXECUTE FUNCTION public.update_updated_at_column();
+CREATE POLICY audit_logs_org_isolation_select ON public.audit_logs FOR SELECT TO keyhub USING (((public.current_organization_id() IS NULL) OR (organization_id = public.current_organization_id())));
 
 
 --
--- Name: users refresh_users_updated_at; Type: TRIGGER; Schema: public; Owner: -
+-- Name: buildings; Type: ROW SECURITY; Schema: public; Owner: -
 --
 
-CREATE TRIGGER refresh_users_updated_at BEFORE UPDATE ON public.users FOR EACH ROW EXECUTE FUNCTION public.update_updated_at_column();
-
+ALTER TABLE public.buildings ENABLE ROW LEVEL SECURITY;
 
 --
--- Name: sessions fk_sessions_active_membership; Type: FK CONSTRAINT; Schema: public; Owner: -
+-- Name: buildings buildings_org_isolation; Type: POLICY; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.sessions
-    ADD CONSTRAINT fk_sessions_active_membership FOREIGN KEY (active_membership_id) REFERENCES public.tenant_memberships(id);
+CREATE POLICY buildings_org_isolation ON public.buildings TO keyhub USING (((public.current_organization_id() IS NULL) OR (organization_id = public.current_organization_id())));
 
 
 --
--- Name: keys keys_room_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+-- Name: console_operators; Type: ROW SECURITY; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.keys
-    ADD CONSTRAINT keys_room_id_fkey FOREIGN KEY (room_id) REFERENCES public.rooms(id) ON DELETE CASCADE;
-
+ALTER TABLE public.console_operators ENABLE ROW LEVEL SECURITY;
 
 --
--- Name: room_assignments room_assignments_room_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+-- Name: console_operators console_operators_org_isolation; Type: POLICY; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.room_assignments
-    ADD CONSTRAINT room_assignments_room_id_fkey FOREIGN KEY (room_id) REFERENCES public.rooms(id) ON DELETE CASCADE;
+CREATE POLICY console_operators_org_isolation ON public.console_operators TO keyhub USING (((public.current_organization_id() IS NULL) OR (organization_id = public.current_organization_id())));
 
 
 --
--- Name: room_assignments room_assignments_tenant_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+-- Name: floors; Type: ROW SECURITY; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.room_assignments
-    ADD CONSTRAINT room_assignments_tenant_id_fkey FOREIGN KEY (tenant_id) REFERENCES public.tenants(id) ON DELETE CASCADE;
-
+ALTER TABLE public.floors ENABLE ROW LEVEL SECURITY;
 
 --
--- Name: sessions sessions_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+-- Name: floors floors_org_isolation; Type: POLICY; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.sessions
-    ADD CONSTRAINT sessions_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+CREATE POLICY floors_org_isolation ON public.floors TO keyhub USING (((public.current_organization_id() IS NULL) OR (organization_id = public.current_organization_id())));
 
 
 --
--- Name: tenant_join_codes tenant_join_codes_tenant_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+-- Name: keys; Type: ROW SECURITY; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.tenant_join_codes
-    ADD CONSTRAINT tenant_join_codes_tenant_id_fkey FOREIGN KEY (tenant_id) REFERENCES public.tenants(id) ON DELETE CASCADE;
-
+ALTER TABLE public.keys ENABLE ROW LEVEL SECURITY;
 
 --
--- Name: tenant_memberships tenant_memberships_tenant_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+-- Name: keys keys_org_isolation; Type: POLICY; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.tenant_memberships
-    ADD CONSTRAINT tenant_memberships_tenant_id_fkey FOREIGN KEY (tenant_id) REFERENCES public.tenants(id) ON DELETE CASCADE;
+CREATE POLICY keys_org_isolation ON public.keys TO keyhub USING (((public.current_organization_id() IS NULL) OR (organization_id = public.current_organization_id())));
 
 
 --
--- Name: tenant_memberships tenant_memberships_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+-- Name: notification_deliveries; Type: ROW SECURITY; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.tenant_memberships
-    ADD CONSTRAINT tenant_memberships_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
-
+ALTER TABLE public.notification_deliveries ENABLE ROW LEVEL SECURITY;
 
 --
--- Name: user_identities user_identities_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+-- Name: notification_deliveries notification_deliveries_org_isolation; Type: POLICY; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.user_identities
-    ADD CONSTRAINT user_identities_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+CREATE POLICY notification_deliveries_org_isolation ON public.notification_deliveries TO keyhub USING (((public.current_organization_id() IS NULL) OR (organization_id = public.current_organization_id())));
 
 
 --
--- Name: keys; Type: ROW SECURITY; Schema: public; Owner: -
+-- Name: outbox_events; Type: ROW SECURITY; Schema: public; Owner: -
 --
 
-ALTER TABLE public.keys ENABLE ROW LEVEL SECURITY;
+ALTER TABLE public.outbox_events ENABLE ROW LEVEL SECURITY;
 
 --
--- Name: keys keys_org_isolation; Type: POLICY; Schema: public; Owner: -
+-- Name: outbox_events outbox_events_org_isolation; Type: POLICY; Schema: public; Owner: -
 --
 
-CREATE POLICY keys_org_isolation ON public.keys TO keyhub USING (((public.current_organization_id() IS NULL) OR (organization_id = public.current_organization_id())));
+CREATE POLICY outbox_events_org_isolation ON public.outbox_events TO keyhub USING (((public.current_organization_id() IS NULL) OR (organization_id = public.current_organization_id())));
 
 
 --
@@ -643,6 +2113,32 @@ CREATE POLICY room_assignments_org_isolation ON public.room_assignments TO keyhu
   WHERE (tenants.organization_id = public.current_organization_id())))));
 
 
+--
+-- Name: room_custom_fields; Type: ROW SECURITY; Schema: public; Owner: -
+--
+
+ALTER TABLE public.room_custom_fields ENABLE ROW LEVEL SECURITY;
+
+--
+-- Name: room_custom_fields room_custom_fields_org_isolation; Type: POLICY; Schema: public; Owner: -
+--
+
+CREATE POLICY room_custom_fields_org_isolation ON public.room_custom_fields TO keyhub USING (((public.current_organization_id() IS NULL) OR (organization_id = public.current_organization_id())));
+
+
+--
+-- Name: room_types; Type: ROW SECURITY; Schema: public; Owner: -
+--
+
+ALTER TABLE public.room_types ENABLE ROW LEVEL SECURITY;
+
+--
+-- Name: room_types room_types_org_isolation; Type: POLICY; Schema: public; Owner: -
+--
+
+CREATE POLICY room_types_org_isolation ON public.room_types TO keyhub USING (((public.current_organization_id() IS NULL) OR (organization_id = public.current_organization_id())));
+
+
 --
 -- Name: rooms; Type: ROW SECURITY; Schema: public; Owner: -
 --
@@ -656,6 +2152,34 @@ ALTER TABLE public.rooms ENABLE ROW LEVEL SECURITY;
 CREATE POLICY rooms_org_isolation ON public.rooms TO keyhub USING (((public.current_organization_id() IS NULL) OR (organization_id = public.current_organization_id())));
 
 
+--
+-- Name: tenant_group_memberships; Type: ROW SECURITY; Schema: public; Owner: -
+--
+
+ALTER TABLE public.tenant_group_memberships ENABLE ROW LEVEL SECURITY;
+
+--
+-- Name: tenant_group_memberships tenant_group_memberships_org_isolation; Type: POLICY; Schema: public; Owner: -
+--
+
+CREATE POLICY tenant_group_memberships_org_isolation ON public.tenant_group_memberships TO keyhub USING (((public.current_organization_id() IS NULL) OR (group_id IN ( SELECT tenant_groups.id
+   FROM public.tenant_groups
+  WHERE (tenant_groups.organization_id = public.current_organization_id())))));
+
+
+--
+-- Name: tenant_groups; Type: ROW SECURITY; Schema: public; Owner: -
+--
+
+ALTER TABLE public.tenant_groups ENABLE ROW LEVEL SECURITY;
+
+--
+-- Name: tenant_groups tenant_groups_org_isolation; Type: POLICY; Schema: public; Owner: -
+--
+
+CREATE POLICY tenant_groups_org_isolation ON public.tenant_groups TO keyhub USING (((public.current_organization_id() IS NULL) OR (organization_id = public.current_organization_id())));
+
+
 --
 -- Name: tenant_join_codes; Type: ROW SECURITY; Schema: public; Owner: -
 --
@@ -666,9 +2190,20 @@ ALTER TABLE public.tenant_join_codes ENABLE ROW LEVEL SECURITY;
 -- Name: tenant_join_codes tenant_join_codes_org_isolation; Type: POLICY; Schema: public; Owner: -
 --
 
-CREATE POLICY tenant_join_codes_org_isolation ON public.tenant_join_codes TO keyhub USING (((public.current_organization_id() IS NULL) OR (tenant_id IN ( SELECT tenants.id
-   FROM public.tenants
-  WHERE (tenants.organization_id = public.current_organization_id())))));
+CREATE POLICY tenant_join_codes_org_isolation ON public.tenant_join_codes TO keyhub USING (((public.current_organization_id() IS NULL) OR (organization_id = public.current_organization_id())));
+
+
+--
+-- Name: tenant_types; Type: ROW SECURITY; Schema: public; Owner: -
+--
+
+ALTER TABLE public.tenant_types ENABLE ROW LEVEL SECURITY;
+
+--
+-- Name: tenant_types tenant_types_org_isolation; Type: POLICY; Schema: public; Owner: -
+--
+
+CREATE POLICY tenant_types_org_isolation ON public.tenant_types TO keyhub USING (((public.current_organization_id() IS NULL) OR (organization_id = public.current_organization_id())));
 
 
 --
@@ -684,6 +2219,32 @@ ALTER TABLE public.tenants ENABLE ROW LEVEL SECURITY;
 CREATE POLICY tenants_org_isolation ON public.tenants TO keyhub USING (((public.current_organization_id() IS NULL) OR (organization_id = public.current_organization_id())));
 
 
+--
+-- Name: webhook_deliveries; Type: ROW SECURITY; Schema: public; Owner: -
+--
+
+ALTER TABLE public.webhook_deliveries ENABLE ROW LEVEL SECURITY;
+
+--
+-- Name: webhook_deliveries webhook_deliveries_org_isolation; Type: POLICY; Schema: public; Owner: -
+--
+
+CREATE POLICY webhook_deliveries_org_isolation ON public.webhook_deliveries TO keyhub USING (((public.current_organization_id() IS NULL) OR (organization_id = public.current_organization_id())));
+
+
+--
+-- Name: webhook_subscriptions; Type: ROW SECURITY; Schema: public; Owner: -
+--
+
+ALTER TABLE public.webhook_subscriptions ENABLE ROW LEVEL SECURITY;
+
+--
+-- Name: webhook_subscriptions webhook_subscriptions_org_isolation; Type: POLICY; Schema: public; Owner: -
+--
+
+CREATE POLICY webhook_subscriptions_org_isolation ON public.webhook_subscriptions TO keyhub USING (((public.current_organization_id() IS NULL) OR (organization_id = public.current_organization_id())));
+
+
 --
 -- PostgreSQL database dump complete
 --
@@ -2,6 +2,10 @@
 -- +goose StatementBegin
 SELECT 'Seed: Insert tenants';
 
+-- 開発用の組織。Organization Key: org_key_example_12345（key_hash はそのSHA-256）
+INSERT INTO organizations (id, name, slug, settings, key_hash, created_at, updated_at) VALUES
+    ('550e8400-e29b-41d4-a716-446655440000', '開発用組織', 'dev', '{"locale": "ja", "timezone": "Asia/Tokyo"}', 'a3c2a9eab631782bce2b61b1e9d57a5e09cd3054dcbeab898b10bac06ff3aa29', NOW(), NOW());
+
 -- organization_id: 550e8400-e29b-41d4-a716-446655440000 (default)
 -- tenant_type: TENANT_TYPE_TEAM, TENANT_TYPE_DEPARTMENT, TENANT_TYPE_PROJECT, TENANT_TYPE_LABORATORY
 
@@ -26,4 +30,6 @@ DELETE FROM tenants WHERE id IN (
     '10000000-0000-0000-0000-000000000005'
 );
 
+DELETE FROM organizations WHERE id = '550e8400-e29b-41d4-a716-446655440000';
+
 -- +goose StatementEnd
@@ -0,0 +1,62 @@
+-- name: CreateAPIToken :exec
+INSERT INTO api_tokens (
+    id,
+    user_id,
+    organization_id,
+    name,
+    token_prefix,
+    token_hash,
+    scopes,
+    expires_at,
+    created_at
+) VALUES (
+    @id,
+    @user_id,
+    @organization_id,
+    @name,
+    @token_prefix,
+    @token_hash,
+    @scopes,
+    @expires_at,
+    @created_at
+);
+
+-- name: GetAPITokenByHash :one
+SELECT sqlc.embed(t)
+FROM api_tokens t
+WHERE t.token_hash = $1;
+
+-- name: ListAPITokensByUser :many
+SELECT sqlc.embed(t)
+FROM api_tokens t
+WHERE t.user_id = $1
+AND t.revoked_at IS NULL
+ORDER BY t.created_at DESC;
+
+-- name: ListAPITokensByOrganization :many
+SELECT sqlc.embed(t)
+FROM api_tokens t
+WHERE t.organization_id = $1
+AND t.user_id IS NULL
+AND t.revoked_at IS NULL
+ORDER BY t.created_at DESC;
+
+-- name: TouchAPIToken :exec
+UPDATE api_tokens
+SET last_used_at = NOW()
+WHERE id = $1;
+
+-- name: RevokeAPITokenByUser :execrows
+UPDATE api_tokens
+SET revoked_at = NOW()
+WHERE id = $1
+AND user_id = $2
+AND revoked_at IS NULL;
+
+-- name: RevokeAPITokenByOrganization :execrows
+UPDATE api_tokens
+SET revoked_at = NOW()
+WHERE id = $1
+AND organization_id = $2
+AND user_id IS NULL
+AND revoked_at IS NULL;
@@ -5,9 +5,12 @@ INSERT INTO sessions (
     active_membership_id,
     expires_at,
     csrf_token,
-    revoked
+    revoked,
+    user_agent,
+    ip_address,
+    organization_id
 ) VALUES (
-    $1, $2, $3, $4, $5, FALSE
+    $1, $2, $3, $4, $5, FALSE, $6, $7, $8
 );
 
 -- name: GetAppSession :one
@@ -16,11 +19,54 @@ FROM sessions s
 WHERE s.session_id = $1
 AND s.revoked = FALSE;
 
+-- name: ListActiveAppSessionsByUser :many
+SELECT sqlc.embed(s)
+FROM sessions s
+WHERE s.user_id = $1
+AND s.revoked = FALSE
+AND s.expires_at > NOW()
+ORDER BY s.last_seen_at DESC;
+
+-- name: TouchAppSession :exec
+UPDATE sessions
+SET last_seen_at = NOW(),
+    user_agent = $2,
+    ip_address = $3
+WHERE session_id = $1;
+
+-- name: EnsureAppSessionCSRFToken :one
+-- CSRFトークンを持たないセッションにだけ設定し、保存されているトークンを返す。
+-- 同時に呼ばれても先に設定したトークンを返すため、リクエストごとに値が変わらない
+UPDATE sessions
+SET csrf_token = COALESCE(NULLIF(csrf_token, ''), $2)
+WHERE session_id = $1
+RETURNING csrf_token;
+
+-- name: ExtendAppSession :exec
+UPDATE sessions
+SET expires_at = $2
+WHERE session_id = $1
+AND revoked = FALSE;
+
 -- name: RevokeAppSession :exec
 UPDATE sessions
 SET revoked = TRUE
 WHERE session_id = $1;
 
+-- name: RevokeAppSessionByUser :execrows
+UPDATE sessions
+SET revoked = TRUE
+WHERE session_id = $1
+AND user_id = $2
+AND revoked = FALSE;
+
+-- name: RevokeOtherAppSessionsByUser :execrows
+UPDATE sessions
+SET revoked = TRUE
+WHERE user_id = $1
+AND session_id <> $2
+AND revoked = FALSE;
+
 -- name: CleanupExpiredAppSessions :exec
 -- 期限切れまたは無効化されたセッションを物理削除する（バッチ処理用）
 DELETE FROM sessions
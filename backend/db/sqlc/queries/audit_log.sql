@@ -0,0 +1,78 @@
+-- name: CreateAuditLog :exec
+INSERT INTO audit_logs (
+    id,
+    organization_id,
+    actor_type,
+    actor_id,
+    procedure,
+    target_ids,
+    result_code,
+    request_id,
+    ip_address,
+    user_agent,
+    created_at,
+    seq,
+    prev_hash,
+    hash
+) VALUES (
+    @id,
+    @organization_id,
+    @actor_type,
+    @actor_id,
+    @procedure,
+    @target_ids,
+    @result_code,
+    @request_id,
+    @ip_address,
+    @user_agent,
+    @created_at,
+    @seq,
+    @prev_hash,
+    @hash
+);
+
+-- name: LockAuditChain :exec
+-- 同じ組織の記録を同時に追記すると連鎖が分岐するため、トランザクションの終わりまで組織単位で排他する
+SELECT pg_advisory_xact_lock(hashtextextended('audit_logs:' || @organization_id::text, 0));
+
+-- name: GetAuditChainHead :one
+SELECT a.seq, a.hash
+FROM audit_logs a
+WHERE a.organization_id = $1
+ORDER BY a.seq DESC
+LIMIT 1;
+
+-- name: ListAuditLogsBySeq :many
+SELECT sqlc.embed(a)
+FROM audit_logs a
+WHERE a.organization_id = @organization_id
+AND a.seq > @after_seq
+ORDER BY a.seq ASC
+LIMIT @batch_size;
+
+-- name: ListAuditLogOrganizationIDs :many
+SELECT DISTINCT a.organization_id
+FROM audit_logs a
+ORDER BY a.organization_id;
+
+-- name: SearchAuditLogs :many
+-- 新しい順に返す。カーソルを指定した場合はその記録より古いものだけを返す
+SELECT sqlc.embed(a)
+FROM audit_logs a
+WHERE a.organization_id = @organization_id
+AND (sqlc.narg(actor_type)::text IS NULL OR a.actor_type = sqlc.narg(actor_type)::text)
+AND (sqlc.narg(actor_id)::text IS NULL OR a.actor_id = sqlc.narg(actor_id)::text)
+AND (sqlc.narg(procedure)::text IS NULL OR a.procedure = sqlc.narg(procedure)::text)
+AND (sqlc.narg(result_code)::text IS NULL OR a.result_code = sqlc.narg(result_code)::text)
+AND (
+    sqlc.narg(target_id)::text IS NULL
+    OR jsonb_path_exists(a.target_ids, '$.* ? (@ == $id)', jsonb_build_object('id', sqlc.narg(target_id)::text))
+)
+AND (sqlc.narg(since)::timestamptz IS NULL OR a.created_at >= sqlc.narg(since)::timestamptz)
+AND (sqlc.narg(until)::timestamptz IS NULL OR a.created_at < sqlc.narg(until)::timestamptz)
+AND (
+    sqlc.narg(cursor_created_at)::timestamptz IS NULL
+    OR (a.created_at, a.id) < (sqlc.narg(cursor_created_at)::timestamptz, sqlc.narg(cursor_id)::uuid)
+)
+ORDER BY a.created_at DESC, a.id DESC
+LIMIT @page_size;
@@ -0,0 +1,88 @@
+-- name: CreateBuilding :exec
+INSERT INTO buildings(
+    id,
+    organization_id,
+    name,
+    description
+)
+VALUES(
+    @id,
+    @organization_id,
+    @name,
+    @description
+);
+
+-- name: GetBuilding :one
+SELECT sqlc.embed(b)
+FROM buildings b
+WHERE b.id = $1;
+
+-- name: GetBuildingByOrganizationAndName :one
+SELECT sqlc.embed(b)
+FROM buildings b
+WHERE b.organization_id = $1 AND b.name = $2;
+
+-- name: ListBuildings :many
+SELECT sqlc.embed(b)
+FROM buildings b
+ORDER BY b.name, b.id;
+
+-- name: UpdateBuilding :exec
+UPDATE buildings
+SET name = @name, description = @description
+WHERE id = @id;
+
+-- name: DeleteBuilding :execrows
+DELETE FROM buildings
+WHERE id = $1;
+
+-- name: CreateFloor :exec
+INSERT INTO floors(
+    id,
+    building_id,
+    organization_id,
+    name,
+    level
+)
+VALUES(
+    @id,
+    @building_id,
+    @organization_id,
+    @name,
+    @level
+);
+
+-- name: GetFloor :one
+SELECT sqlc.embed(f)
+FROM floors f
+WHERE f.id = $1;
+
+-- name: GetFloorByBuildingAndName :one
+SELECT sqlc.embed(f)
+FROM floors f
+WHERE f.building_id = $1 AND f.name = $2;
+
+-- name: ListFloors :many
+-- 建物ごとに level の昇順（地下から上の階）で返す
+SELECT sqlc.embed(f)
+FROM floors f
+ORDER BY f.building_id, f.level, f.name;
+
+-- name: CountFloorsByBuilding :one
+SELECT COUNT(*)::INT
+FROM floors f
+WHERE f.building_id = $1;
+
+-- name: UpdateFloor :exec
+UPDATE floors
+SET name = @name, level = @level
+WHERE id = @id;
+
+-- name: DeleteFloor :execrows
+DELETE FROM floors
+WHERE id = $1;
+
+-- name: CountRoomsByFloor :one
+SELECT COUNT(*)::INT
+FROM rooms r
+WHERE r.floor_id = $1;
@@ -0,0 +1,36 @@
+-- name: CreateConsoleOperator :exec
+INSERT INTO console_operators (
+    id,
+    organization_id,
+    name,
+    role,
+    key_hash,
+    created_at
+) VALUES (
+    @id,
+    @organization_id,
+    @name,
+    @role,
+    @key_hash,
+    @created_at
+);
+
+-- name: GetConsoleOperatorByKeyHash :one
+SELECT sqlc.embed(o)
+FROM console_operators o
+WHERE o.key_hash = $1
+AND o.revoked_at IS NULL;
+
+-- name: ListConsoleOperatorsByOrganization :many
+SELECT sqlc.embed(o)
+FROM console_operators o
+WHERE o.organization_id = $1
+AND o.revoked_at IS NULL
+ORDER BY o.created_at DESC;
+
+-- name: RevokeConsoleOperator :execrows
+UPDATE console_operators
+SET revoked_at = NOW()
+WHERE id = @id
+AND organization_id = @organization_id
+AND revoked_at IS NULL;
@@ -2,10 +2,14 @@
 INSERT INTO console_sessions (
     session_id,
     organization_id,
+    role,
+    operator_id,
     created_at,
-    expires_at
+    expires_at,
+    user_agent,
+    ip_address
 ) VALUES (
-    $1, $2, NOW(), NOW() + INTERVAL '24 hours'
+    $1, $2, $3, $4, $5, $6, $7, $8
 );
 
 -- name: GetConsoleSession :one
@@ -14,10 +18,47 @@ FROM console_sessions cs
 WHERE cs.session_id = $1
 AND cs.expires_at > NOW();
 
+-- name: ListActiveConsoleSessionsByOrganization :many
+SELECT sqlc.embed(cs)
+FROM console_sessions cs
+WHERE cs.organization_id = $1
+AND cs.expires_at > NOW()
+ORDER BY cs.last_seen_at DESC;
+
+-- name: TouchConsoleSession :exec
+UPDATE console_sessions
+SET last_seen_at = NOW(),
+    user_agent = $2,
+    ip_address = $3
+WHERE session_id = $1;
+
+-- name: ExtendConsoleSession :exec
+UPDATE console_sessions
+SET expires_at = $2
+WHERE session_id = $1;
+
 -- name: DeleteConsoleSession :exec
 DELETE FROM console_sessions
 WHERE session_id = $1;
 
+-- name: DeleteConsoleSessionByOrganization :execrows
+DELETE FROM console_sessions
+WHERE session_id = $1
+AND organization_id = $2;
+
+-- name: DeleteOtherConsoleSessionsByOrganization :execrows
+DELETE FROM console_sessions
+WHERE organization_id = $1
+AND session_id <> $2;
+
+-- name: DeleteConsoleSessionsByOrganization :execrows
+DELETE FROM console_sessions
+WHERE organization_id = $1;
+
+-- name: DeleteConsoleSessionsByOperator :execrows
+DELETE FROM console_sessions
+WHERE operator_id = $1;
+
 -- name: CleanupExpiredConsoleSessions :exec
 DELETE FROM console_sessions
-WHERE expires_at < NOW();
\ No newline at end of file
+WHERE expires_at < NOW();
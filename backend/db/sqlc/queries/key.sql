@@ -14,8 +14,46 @@ VALUES(
     @status
 );
 
+-- name: GetKeyById :one
+SELECT sqlc.embed(k)
+FROM keys k
+WHERE k.id = $1;
+
+-- name: UpdateKeyNumber :one
+-- 取得時から版数が変わっていないときだけ更新し、更新後の版数を返す。版数はトリガーで増やす
+UPDATE keys
+SET key_number = @key_number
+WHERE id = @id
+AND version = @version
+RETURNING version;
+
 -- name: GetKeysByRoom :many
 SELECT sqlc.embed(k)
 FROM keys k
 WHERE k.room_id = $1
 ORDER BY k.created_at DESC;
+
+-- name: ListKeysByRoom :many
+-- order_by が name の場合は鍵番号の昇順、それ以外は新しい順に返す。カーソルを指定した場合はその鍵より後ろだけを返す
+SELECT sqlc.embed(k)
+FROM keys k
+WHERE k.room_id = @room_id
+AND (sqlc.narg(status)::text IS NULL OR k.status = sqlc.narg(status)::text)
+AND (sqlc.narg(key_number_prefix)::text IS NULL OR starts_with(k.key_number, sqlc.narg(key_number_prefix)::text))
+AND (
+    sqlc.narg(cursor_id)::uuid IS NULL
+    OR (@order_by::text = 'name' AND (k.key_number, k.id) > (sqlc.narg(cursor_name)::text, sqlc.narg(cursor_id)::uuid))
+    OR (@order_by::text <> 'name' AND (k.created_at, k.id) < (sqlc.narg(cursor_created_at)::timestamptz, sqlc.narg(cursor_id)::uuid))
+)
+ORDER BY
+    CASE WHEN @order_by::text = 'name' THEN k.key_number END ASC,
+    CASE WHEN @order_by::text = 'name' THEN k.id END ASC,
+    k.created_at DESC,
+    k.id DESC
+LIMIT @page_size;
+
+-- name: GetKeysByOrganization :many
+SELECT sqlc.embed(k)
+FROM keys k
+WHERE k.organization_id = $1
+ORDER BY k.created_at DESC;
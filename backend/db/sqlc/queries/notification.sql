@@ -0,0 +1,55 @@
+-- name: GetNotificationPreferences :one
+SELECT sqlc.embed(p)
+FROM notification_preferences p
+WHERE p.user_id = $1;
+
+-- name: UpsertNotificationPreferences :exec
+INSERT INTO notification_preferences (
+    user_id,
+    locale,
+    disabled_kinds,
+    updated_at
+) VALUES (
+    @user_id,
+    @locale,
+    @disabled_kinds,
+    @updated_at
+)
+ON CONFLICT (user_id)
+DO UPDATE SET
+    locale = EXCLUDED.locale,
+    disabled_kinds = EXCLUDED.disabled_kinds,
+    updated_at = EXCLUDED.updated_at;
+
+-- name: ListTenantNotificationRecipients :many
+SELECT
+    sqlc.embed(u),
+    COALESCE(p.locale, '')::TEXT AS locale,
+    COALESCE(p.disabled_kinds, '{}')::TEXT[] AS disabled_kinds
+FROM tenant_memberships tm
+INNER JOIN users u ON u.id = tm.user_id
+LEFT JOIN notification_preferences p ON p.user_id = u.id
+WHERE tm.tenant_id = @tenant_id
+AND tm.left_at IS NULL
+AND (NOT @admins_only::BOOLEAN OR tm.role = 'admin')
+ORDER BY tm.created_at;
+
+-- name: ClaimNotificationDelivery :execrows
+INSERT INTO notification_deliveries (
+    dedup_key,
+    organization_id,
+    user_id,
+    kind,
+    created_at
+) VALUES (
+    @dedup_key,
+    @organization_id,
+    @user_id,
+    @kind,
+    @created_at
+)
+ON CONFLICT (dedup_key) DO NOTHING;
+
+-- name: DeleteNotificationDelivery :exec
+DELETE FROM notification_deliveries
+WHERE dedup_key = $1;
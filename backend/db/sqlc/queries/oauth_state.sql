@@ -3,9 +3,10 @@ INSERT INTO oauth_states (
     state,
     code_verifier,
     nonce,
+    organization_id,
     created_at
 ) VALUES (
-    $1, $2, $3, NOW()
+    $1, $2, $3, $4, NOW()
 );
 
 -- name: GetOAuthState :one
@@ -0,0 +1,44 @@
+-- name: CreateOrganization :exec
+INSERT INTO organizations (
+    id,
+    name,
+    slug,
+    settings,
+    key_hash,
+    created_at,
+    updated_at
+) VALUES (
+    @id,
+    @name,
+    @slug,
+    @settings,
+    @key_hash,
+    @created_at,
+    @updated_at
+);
+
+-- name: GetOrganization :one
+SELECT sqlc.embed(o)
+FROM organizations o
+WHERE o.id = $1;
+
+-- name: GetOrganizationBySlug :one
+SELECT sqlc.embed(o)
+FROM organizations o
+WHERE o.slug = $1;
+
+-- name: GetOrganizationKeyHash :one
+SELECT key_hash
+FROM organizations
+WHERE id = $1;
+
+-- name: ListOrganizations :many
+SELECT sqlc.embed(o)
+FROM organizations o
+ORDER BY o.created_at;
+
+-- name: UpdateOrganizationKeyHash :execrows
+UPDATE organizations
+SET key_hash = @key_hash,
+    updated_at = NOW()
+WHERE id = @id;
@@ -0,0 +1,46 @@
+-- name: CreateOutboxEvent :exec
+INSERT INTO outbox_events (
+    id,
+    organization_id,
+    event_type,
+    payload,
+    occurred_at,
+    status,
+    attempts,
+    next_attempt_at
+) VALUES (
+    @id,
+    @organization_id,
+    @event_type,
+    @payload,
+    @occurred_at,
+    @status,
+    @attempts,
+    @next_attempt_at
+);
+
+-- 配送期限が来たイベントを取り出し、配送中に他のディスパッチャーが重ねて取り出さないよう
+-- next_attempt_at を lease_until まで先送りする
+-- name: ClaimOutboxEvents :many
+UPDATE outbox_events o
+SET next_attempt_at = @lease_until
+WHERE o.id IN (
+    SELECT p.id
+    FROM outbox_events p
+    WHERE p.status = 'pending'
+      AND p.next_attempt_at <= @now
+    ORDER BY p.next_attempt_at, p.id
+    LIMIT @batch_size
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING sqlc.embed(o);
+
+-- name: UpdateOutboxEvent :exec
+UPDATE outbox_events
+SET status = @status,
+    attempts = @attempts,
+    next_attempt_at = @next_attempt_at,
+    last_error = @last_error,
+    delivered_at = @delivered_at,
+    completed_handlers = @completed_handlers
+WHERE id = @id;
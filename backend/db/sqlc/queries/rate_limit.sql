@@ -0,0 +1,70 @@
+-- name: EnsureRateLimitBucket :exec
+INSERT INTO rate_limit_buckets (
+    key,
+    tokens,
+    updated_at,
+    idle_at
+) VALUES (
+    @key,
+    @tokens,
+    @updated_at,
+    @updated_at
+)
+ON CONFLICT (key) DO NOTHING;
+
+-- name: GetRateLimitBucketForUpdate :one
+SELECT sqlc.embed(b)
+FROM rate_limit_buckets b
+WHERE b.key = $1
+FOR UPDATE;
+
+-- name: SaveRateLimitBucket :exec
+UPDATE rate_limit_buckets
+SET tokens = @tokens,
+    updated_at = @updated_at,
+    idle_at = @idle_at
+WHERE key = @key;
+
+-- name: EnsureRateLimitFailure :exec
+INSERT INTO rate_limit_failures (
+    key,
+    window_started_at,
+    idle_at
+) VALUES (
+    @key,
+    @now,
+    @now
+)
+ON CONFLICT (key) DO NOTHING;
+
+-- name: GetRateLimitFailure :one
+SELECT sqlc.embed(f)
+FROM rate_limit_failures f
+WHERE f.key = $1;
+
+-- name: GetRateLimitFailureForUpdate :one
+SELECT sqlc.embed(f)
+FROM rate_limit_failures f
+WHERE f.key = $1
+FOR UPDATE;
+
+-- name: SaveRateLimitFailure :exec
+UPDATE rate_limit_failures
+SET failure_count = @failure_count,
+    window_started_at = @window_started_at,
+    locked_until = @locked_until,
+    idle_at = @idle_at
+WHERE key = @key;
+
+-- name: DeleteUnlockedRateLimitFailure :exec
+DELETE FROM rate_limit_failures
+WHERE key = $1
+AND (locked_until IS NULL OR locked_until <= NOW());
+
+-- name: DeleteIdleRateLimitBuckets :exec
+DELETE FROM rate_limit_buckets
+WHERE idle_at < $1;
+
+-- name: DeleteIdleRateLimitFailures :exec
+DELETE FROM rate_limit_failures
+WHERE idle_at < $1;
@@ -1,37 +1,98 @@
 -- name: CreateRoom :exec
+-- building_name と floor_number は floor_id の建物と階からトリガーで埋められる
 INSERT INTO rooms(
     id,
     organization_id,
     name,
-    building_name,
-    floor_number,
+    floor_id,
     room_type,
-    description
+    description,
+    capacity,
+    equipment,
+    accessibility,
+    custom_fields
 )
 VALUES(
     @id,
     @organization_id,
     @name,
-    @building_name,
-    @floor_number,
+    @floor_id,
     @room_type,
-    @description
+    @description,
+    @capacity,
+    @equipment,
+    @accessibility,
+    @custom_fields
 );
 
+-- name: UpdateRoomAttributes :one
+-- 取得時から版数が変わっていないときだけ更新し、更新後の版数を返す。版数はトリガーで増やす
+UPDATE rooms
+SET capacity = @capacity,
+    equipment = @equipment,
+    accessibility = @accessibility,
+    custom_fields = @custom_fields
+WHERE id = @id
+AND version = @version
+RETURNING version;
+
 -- name: GetRoomById :one
 SELECT sqlc.embed(r)
 FROM rooms r
 WHERE r.id = $1;
 
--- name: GetAllRooms :many
+-- name: ListRooms :many
+-- order_by が name の場合は名前の昇順、それ以外は新しい順に返す。カーソルを指定した場合はその部屋より後ろだけを返す
 SELECT sqlc.embed(r)
 FROM rooms r
-ORDER BY created_at DESC;
+WHERE (sqlc.narg(building_name)::text IS NULL OR r.building_name = sqlc.narg(building_name)::text)
+AND (sqlc.narg(building_id)::uuid IS NULL OR r.floor_id IN (SELECT f.id FROM floors f WHERE f.building_id = sqlc.narg(building_id)::uuid))
+AND (sqlc.narg(floor_id)::uuid IS NULL OR r.floor_id = sqlc.narg(floor_id)::uuid)
+AND (sqlc.narg(floor_number)::text IS NULL OR r.floor_number = sqlc.narg(floor_number)::text)
+AND (sqlc.narg(room_type)::text IS NULL OR r.room_type = sqlc.narg(room_type)::text)
+AND (sqlc.narg(name_prefix)::text IS NULL OR starts_with(r.name, sqlc.narg(name_prefix)::text))
+AND r.capacity >= @min_capacity::int
+AND r.equipment @> @equipment::text[]
+AND r.accessibility @> @accessibility::text[]
+AND r.custom_fields @> @custom_fields::jsonb
+AND (
+    sqlc.narg(cursor_id)::uuid IS NULL
+    OR (@order_by::text = 'name' AND (r.name, r.id) > (sqlc.narg(cursor_name)::text, sqlc.narg(cursor_id)::uuid))
+    OR (@order_by::text <> 'name' AND (r.created_at, r.id) < (sqlc.narg(cursor_created_at)::timestamptz, sqlc.narg(cursor_id)::uuid))
+)
+ORDER BY
+    CASE WHEN @order_by::text = 'name' THEN r.name END ASC,
+    CASE WHEN @order_by::text = 'name' THEN r.id END ASC,
+    r.created_at DESC,
+    r.id DESC
+LIMIT @page_size;
 
 -- name: GetRoomsByTenant :many
-SELECT sqlc.embed(r)
+-- テナントのメンバーが利用できる部屋を返す。グループに割り当てた部屋は、そのグループか子グループのメンバーにだけ返す
+WITH RECURSIVE user_groups AS (
+    SELECT g.id, g.parent_group_id
+    FROM tenant_groups g
+    INNER JOIN tenant_group_memberships gm ON g.id = gm.group_id
+    WHERE g.tenant_id = @tenant_id AND gm.user_id = @user_id
+    UNION
+    SELECT p.id, p.parent_group_id
+    FROM tenant_groups p
+    INNER JOIN user_groups ug ON p.id = ug.parent_group_id
+)
+SELECT
+    sqlc.embed(r),
+    (ra.key_loan_group_id IS NULL OR ra.key_loan_group_id IN (SELECT id FROM user_groups))::BOOLEAN AS can_borrow_keys
 FROM rooms r
 INNER JOIN room_assignments ra ON r.id = ra.room_id
-WHERE ra.tenant_id = $1
+WHERE ra.tenant_id = @tenant_id
   AND (ra.expires_at IS NULL OR ra.expires_at > NOW())
+  AND EXISTS (
+      SELECT 1 FROM tenant_memberships tm
+      WHERE tm.tenant_id = ra.tenant_id AND tm.user_id = @user_id AND tm.left_at IS NULL
+  )
+  AND (ra.group_id IS NULL OR ra.group_id IN (SELECT id FROM user_groups))
+  AND r.capacity >= @min_capacity::int
+  AND r.equipment @> @equipment::text[]
+  AND r.accessibility @> @accessibility::text[]
+  AND r.custom_fields @> @custom_fields::jsonb
 ORDER BY r.created_at DESC;
@@ -3,6 +3,8 @@ INSERT INTO room_assignments(
     id,
     tenant_id,
     room_id,
+    group_id,
+    key_loan_group_id,
     assigned_at,
     expires_at
 )
@@ -10,6 +12,38 @@ VALUES(
     @id,
     @tenant_id,
     @room_id,
+    @group_id,
+    @key_loan_group_id,
     @assigned_at,
     @expires_at
 );
+
+-- name: ListExpiringRoomAssignments :many
+SELECT
+    sqlc.embed(ra),
+    sqlc.embed(t),
+    sqlc.embed(r)
+FROM room_assignments ra
+INNER JOIN tenants t ON t.id = ra.tenant_id
+INNER JOIN rooms r ON r.id = ra.room_id
+WHERE ra.expires_at > @now
+AND ra.expires_at <= @until
+ORDER BY ra.expires_at;
+
+-- name: GetAssignedRoomIDsByTenant :many
+-- テナントに現在割り当てられている部屋のIDを返す
+SELECT DISTINCT ra.room_id
+FROM room_assignments ra
+WHERE ra.tenant_id = $1
+AND (ra.expires_at IS NULL OR ra.expires_at > NOW());
+
+-- name: EndRoomAssignmentsByTenant :execrows
+-- テナントの有効な割り当ての期限を now にして終了する
+UPDATE room_assignments
+SET expires_at = @now
+WHERE tenant_id = @tenant_id
+AND (expires_at IS NULL OR expires_at > @now);
+
+-- name: DeleteRoomAssignmentsByTenant :exec
+DELETE FROM room_assignments
+WHERE tenant_id = $1;
@@ -0,0 +1,39 @@
+-- name: CreateRoomCustomField :exec
+INSERT INTO room_custom_fields(
+    id,
+    organization_id,
+    key,
+    label,
+    field_type,
+    required,
+    options
+)
+VALUES(
+    @id,
+    @organization_id,
+    @key,
+    @label,
+    @field_type,
+    @required,
+    @options
+);
+
+-- name: GetRoomCustomField :one
+SELECT sqlc.embed(f)
+FROM room_custom_fields f
+WHERE f.id = $1;
+
+-- name: ListRoomCustomFields :many
+SELECT sqlc.embed(f)
+FROM room_custom_fields f
+ORDER BY f.created_at, f.id;
+
+-- name: DeleteRoomCustomField :execrows
+DELETE FROM room_custom_fields
+WHERE id = $1;
+
+-- name: RemoveRoomCustomFieldValues :exec
+-- 削除したカスタム項目の値を組織の部屋から取り除く
+UPDATE rooms
+SET custom_fields = custom_fields - @key::text
+WHERE organization_id = @organization_id AND custom_fields ? @key::text;
@@ -0,0 +1,32 @@
+-- name: CreateRoomType :exec
+INSERT INTO room_types(
+    id,
+    organization_id,
+    key,
+    label
+)
+VALUES(
+    @id,
+    @organization_id,
+    @key,
+    @label
+);
+
+-- name: GetRoomType :one
+SELECT sqlc.embed(t)
+FROM room_types t
+WHERE t.id = $1;
+
+-- name: ListRoomTypes :many
+SELECT sqlc.embed(t)
+FROM room_types t
+ORDER BY t.created_at, t.id;
+
+-- name: DeleteRoomType :execrows
+DELETE FROM room_types
+WHERE id = $1;
+
+-- name: CountRoomsByType :one
+SELECT COUNT(*)::INT
+FROM rooms r
+WHERE r.organization_id = @organization_id AND r.room_type = @room_type;
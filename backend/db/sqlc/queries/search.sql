@@ -0,0 +1,95 @@
+-- name: Search :many
+-- 組織の部屋・鍵・テナント・メンバーを横断して探し、関連度の高い順に返す。
+-- pattern は ILIKE のワイルドカードをエスケープして % で囲んだ検索語。
+-- RLS に加えて organization_id でも絞り込み、他の組織の行を返さない
+SELECT kind, id, title, subtitle, parent_id, score
+FROM (
+    SELECT
+        'room'::text AS kind,
+        r.id,
+        r.name AS title,
+        (r.building_name || ' ' || r.floor_number)::text AS subtitle,
+        NULL::uuid AS parent_id,
+        (
+            GREATEST(
+                similarity(r.name, @query::text),
+                similarity(r.building_name, @query::text) * 0.8,
+                word_similarity(@query::text, r.description) * 0.5
+            )
+            + ts_rank(
+                to_tsvector('simple', r.name || ' ' || r.building_name || ' ' || r.description),
+                plainto_tsquery('simple', @query::text)
+            )
+        )::real AS score
+    FROM rooms r
+    WHERE r.organization_id = @organization_id
+      AND (cardinality(@kinds::text[]) = 0 OR 'room' = ANY(@kinds::text[]))
+      AND (
+          r.name ILIKE @pattern::text
+          OR r.building_name ILIKE @pattern::text
+          OR r.description ILIKE @pattern::text
+          OR to_tsvector('simple', r.name || ' ' || r.building_name || ' ' || r.description) @@ plainto_tsquery('simple', @query::text)
+      )
+
+    UNION ALL
+
+    SELECT
+        'key'::text AS kind,
+        k.id,
+        k.key_number AS title,
+        kr.name AS subtitle,
+        k.room_id AS parent_id,
+        similarity(k.key_number, @query::text)::real AS score
+    FROM keys k
+    INNER JOIN rooms kr ON kr.id = k.room_id
+    WHERE k.organization_id = @organization_id
+      AND (cardinality(@kinds::text[]) = 0 OR 'key' = ANY(@kinds::text[]))
+      AND k.key_number ILIKE @pattern::text
+
+    UNION ALL
+
+    SELECT
+        'tenant'::text AS kind,
+        t.id,
+        t.name AS title,
+        t.description AS subtitle,
+        NULL::uuid AS parent_id,
+        (
+            similarity(t.name, @query::text)
+            + ts_rank(
+                to_tsvector('simple', t.name || ' ' || t.description),
+                plainto_tsquery('simple', @query::text)
+            )
+        )::real AS score
+    FROM tenants t
+    WHERE t.organization_id = @organization_id
+      AND (cardinality(@kinds::text[]) = 0 OR 'tenant' = ANY(@kinds::text[]))
+      AND (
+          t.name ILIKE @pattern::text
+          OR to_tsvector('simple', t.name || ' ' || t.description) @@ plainto_tsquery('simple', @query::text)
+      )
+
+    UNION ALL
+
+    -- 組織のいずれかのテナントに参加中のユーザーだけを返す
+    SELECT
+        'member'::text AS kind,
+        u.id,
+        u.email AS title,
+        u.name AS subtitle,
+        NULL::uuid AS parent_id,
+        similarity(u.email, @query::text)::real AS score
+    FROM users u
+    WHERE (cardinality(@kinds::text[]) = 0 OR 'member' = ANY(@kinds::text[]))
+      AND u.email ILIKE @pattern::text
+      AND EXISTS (
+          SELECT 1
+          FROM tenant_memberships tm
+          INNER JOIN tenants mt ON mt.id = tm.tenant_id
+          WHERE tm.user_id = u.id
+            AND tm.left_at IS NULL
+            AND mt.organization_id = @organization_id
+      )
+) results
+ORDER BY score DESC, title ASC, id ASC
+LIMIT @result_limit;
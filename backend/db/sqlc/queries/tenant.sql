@@ -23,28 +23,79 @@ INNER JOIN tenant_join_codes jc
     ON jc.tenant_id = t.id
 WHERE t.id = $1;
 
--- name: GetAllTenants :many
+-- name: ListTenants :many
+-- order_by が name の場合は名前の昇順、それ以外は新しい順に返す。カーソルを指定した場合はそのテナントより後ろだけを返す
 SELECT sqlc.embed(t)
 FROM tenants t
-ORDER BY created_at DESC;
+WHERE (sqlc.narg(tenant_type)::text IS NULL OR t.tenant_type = sqlc.narg(tenant_type)::text)
+AND (sqlc.narg(name_prefix)::text IS NULL OR starts_with(t.name, sqlc.narg(name_prefix)::text))
+AND (
+    sqlc.narg(cursor_id)::uuid IS NULL
+    OR (@order_by::text = 'name' AND (t.name, t.id) > (sqlc.narg(cursor_name)::text, sqlc.narg(cursor_id)::uuid))
+    OR (@order_by::text <> 'name' AND (t.created_at, t.id) < (sqlc.narg(cursor_created_at)::timestamptz, sqlc.narg(cursor_id)::uuid))
+)
+ORDER BY
+    CASE WHEN @order_by::text = 'name' THEN t.name END ASC,
+    CASE WHEN @order_by::text = 'name' THEN t.id END ASC,
+    t.created_at DESC,
+    t.id DESC
+LIMIT @page_size;
 
--- name: UpdateTenant :exec
+-- name: UpdateTenant :one
+-- 取得時から版数が変わっていない、アーカイブされていないテナントだけを更新し、更新後の版数を返す。版数はトリガーで増やす
 UPDATE tenants
-SET 
+SET
     name = @name,
     description = @description,
     tenant_type = @tenant_type
-WHERE id = $1;
+WHERE id = @id
+AND archived_at IS NULL
+AND version = @version
+RETURNING version;
 
 
--- name: GetTenantsByUserID :many
+-- name: ListTenantsByUserID :many
+-- ユーザーが参加しているテナントを ListTenants と同じ並び順・カーソルで返す
 SELECT
     sqlc.embed(t),
     COUNT(tm_all.id)::INT AS member_count
 FROM tenants t
 INNER JOIN tenant_memberships tm ON t.id = tm.tenant_id
 LEFT JOIN tenant_memberships tm_all ON t.id = tm_all.tenant_id AND tm_all.left_at IS NULL
-WHERE tm.user_id = $1
+WHERE tm.user_id = @user_id
   AND tm.left_at IS NULL
+  AND (sqlc.narg(tenant_type)::text IS NULL OR t.tenant_type = sqlc.narg(tenant_type)::text)
+  AND (sqlc.narg(name_prefix)::text IS NULL OR starts_with(t.name, sqlc.narg(name_prefix)::text))
+  AND (
+      sqlc.narg(cursor_id)::uuid IS NULL
+      OR (@order_by::text = 'name' AND (t.name, t.id) > (sqlc.narg(cursor_name)::text, sqlc.narg(cursor_id)::uuid))
+      OR (@order_by::text <> 'name' AND (t.created_at, t.id) < (sqlc.narg(cursor_created_at)::timestamptz, sqlc.narg(cursor_id)::uuid))
+  )
 GROUP BY t.id
-ORDER BY t.created_at DESC;
\ No newline at end of file
+ORDER BY
+    CASE WHEN @order_by::text = 'name' THEN t.name END ASC,
+    CASE WHEN @order_by::text = 'name' THEN t.id END ASC,
+    t.created_at DESC,
+    t.id DESC
+LIMIT @page_size;
+
+-- name: ArchiveTenant :execrows
+-- アーカイブ済みのテナントは更新しない
+UPDATE tenants
+SET archived_at = @archived_at
+WHERE id = @id AND archived_at IS NULL;
+
+-- name: DeleteTenant :execrows
+DELETE FROM tenants
+WHERE id = $1;
+
+-- name: CountKeysInUseByTenant :one
+-- テナントに割り当てられている部屋の貸し出し中の鍵を数える。
+-- アーカイブしたテナントは、アーカイブで終了した割り当ての部屋も含める
+SELECT COUNT(DISTINCT k.id)::INT
+FROM keys k
+INNER JOIN room_assignments ra ON ra.room_id = k.room_id
+INNER JOIN tenants t ON t.id = ra.tenant_id
+WHERE ra.tenant_id = $1
+AND k.status = 'in_use'
+AND (ra.expires_at IS NULL OR ra.expires_at >= COALESCE(t.archived_at, NOW()));
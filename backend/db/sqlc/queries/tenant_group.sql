@@ -0,0 +1,62 @@
+-- name: CreateTenantGroup :exec
+INSERT INTO tenant_groups(
+    id,
+    tenant_id,
+    organization_id,
+    parent_group_id,
+    name,
+    description
+)
+VALUES(
+    @id,
+    @tenant_id,
+    @organization_id,
+    @parent_group_id,
+    @name,
+    @description
+);
+
+-- name: GetTenantGroup :one
+SELECT sqlc.embed(g)
+FROM tenant_groups g
+WHERE g.id = $1;
+
+-- name: GetTenantGroupByTenantAndName :one
+SELECT sqlc.embed(g)
+FROM tenant_groups g
+WHERE g.tenant_id = $1 AND g.name = $2;
+
+-- name: ListTenantGroupsByTenant :many
+SELECT
+    sqlc.embed(g),
+    (SELECT COUNT(*) FROM tenant_group_memberships gm WHERE gm.group_id = g.id)::INT AS member_count
+FROM tenant_groups g
+WHERE g.tenant_id = $1
+ORDER BY g.created_at;
+
+-- name: AddTenantGroupMember :execrows
+INSERT INTO tenant_group_memberships(
+    group_id,
+    user_id
+)
+VALUES(
+    @group_id,
+    @user_id
+)
+ON CONFLICT (group_id, user_id) DO NOTHING;
+
+-- name: RemoveTenantGroupMember :execrows
+DELETE FROM tenant_group_memberships
+WHERE group_id = @group_id AND user_id = @user_id;
+
+-- name: ListTenantGroupMembers :many
+SELECT sqlc.embed(u)
+FROM users u
+INNER JOIN tenant_group_memberships gm ON u.id = gm.user_id
+WHERE gm.group_id = $1
+ORDER BY gm.created_at;
+
+-- name: DeleteTenantGroupsByTenant :exec
+-- グループのメンバーと子グループも合わせて削除される
+DELETE FROM tenant_groups
+WHERE tenant_id = $1;
@@ -2,6 +2,7 @@
 INSERT INTO tenant_join_codes(
     id,
     tenant_id,
+    organization_id,
     code,
     expires_at,
     max_uses,
@@ -10,6 +11,7 @@ INSERT INTO tenant_join_codes(
 VALUES(
     @id,
     @tenant_id,
+    (SELECT organization_id FROM tenants WHERE id = @tenant_id),
     @code,
     @expires_at,
     @max_uses,
@@ -22,6 +24,8 @@ SELECT
 FROM tenant_join_codes tjc
 INNER JOIN tenants t ON tjc.tenant_id = t.id
 WHERE tjc.code = $1
+    AND tjc.organization_id = $2
+    AND t.archived_at IS NULL
     AND (tjc.expires_at IS NULL OR tjc.expires_at > CURRENT_TIMESTAMP)
     AND (tjc.max_uses = 0 OR tjc.used_count < tjc.max_uses);
 
@@ -31,4 +35,15 @@ SET
     code = @code,
     expires_at = @expires_at,
     max_uses = @max_uses
-WHERE tenant_id = @tenant_id;
\ No newline at end of file
+WHERE tenant_id = @tenant_id;
+
+-- name: DisableTenantJoinCodes :exec
+-- 有効な参加コードの期限を now にして使えなくする
+UPDATE tenant_join_codes
+SET expires_at = @now
+WHERE tenant_id = @tenant_id
+AND (expires_at IS NULL OR expires_at > @now);
+
+-- name: DeleteTenantJoinCodesByTenant :exec
+DELETE FROM tenant_join_codes
+WHERE tenant_id = $1;
@@ -21,3 +21,15 @@ WHERE code = $1;
 SELECT sqlc.embed(tenant_memberships)
 FROM tenant_memberships
 WHERE tenant_id = $1 AND user_id = $2;
+
+-- name: ClearActiveMembershipsByTenant :exec
+-- テナントのメンバーシップを削除する前に、それを参照しているセッションから外す
+UPDATE sessions
+SET active_membership_id = NULL
+WHERE active_membership_id IN (
+    SELECT tm.id FROM tenant_memberships tm WHERE tm.tenant_id = $1
+);
+
+-- name: DeleteTenantMembershipsByTenant :exec
+DELETE FROM tenant_memberships
+WHERE tenant_id = $1;
@@ -0,0 +1,32 @@
+-- name: CreateTenantType :exec
+INSERT INTO tenant_types(
+    id,
+    organization_id,
+    key,
+    label
+)
+VALUES(
+    @id,
+    @organization_id,
+    @key,
+    @label
+);
+
+-- name: GetTenantType :one
+SELECT sqlc.embed(t)
+FROM tenant_types t
+WHERE t.id = $1;
+
+-- name: ListTenantTypes :many
+SELECT sqlc.embed(t)
+FROM tenant_types t
+ORDER BY t.created_at, t.id;
+
+-- name: DeleteTenantType :execrows
+DELETE FROM tenant_types
+WHERE id = $1;
+
+-- name: CountTenantsByType :one
+SELECT COUNT(*)::INT
+FROM tenants t
+WHERE t.organization_id = @organization_id AND t.tenant_type = @tenant_type;
@@ -36,3 +36,8 @@ ON CONFLICT (provider, provider_sub)
 DO UPDATE SET
     user_id = EXCLUDED.user_id,
     updated_at = NOW();
+
+-- name: GetUserIdentityByUser :one
+SELECT sqlc.embed(ui)
+FROM user_identities ui
+WHERE ui.user_id = $1 AND ui.provider = $2;
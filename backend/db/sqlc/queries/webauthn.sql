@@ -0,0 +1,72 @@
+-- name: CreateWebAuthnCredential :exec
+INSERT INTO webauthn_credentials (
+    id,
+    user_id,
+    name,
+    credential_id,
+    public_key,
+    attestation_type,
+    aaguid,
+    sign_count,
+    transports,
+    backup_eligible,
+    backup_state,
+    created_at
+) VALUES (
+    @id,
+    @user_id,
+    @name,
+    @credential_id,
+    @public_key,
+    @attestation_type,
+    @aaguid,
+    @sign_count,
+    @transports,
+    @backup_eligible,
+    @backup_state,
+    @created_at
+);
+
+-- name: ListWebAuthnCredentialsByUser :many
+SELECT sqlc.embed(wc)
+FROM webauthn_credentials wc
+WHERE wc.user_id = $1
+ORDER BY wc.created_at DESC;
+
+-- name: UpdateWebAuthnCredentialUsage :exec
+UPDATE webauthn_credentials
+SET sign_count = @sign_count,
+    backup_state = @backup_state,
+    last_used_at = NOW()
+WHERE id = @id;
+
+-- name: DeleteWebAuthnCredentialByUser :execrows
+DELETE FROM webauthn_credentials
+WHERE id = $1
+AND user_id = $2;
+
+-- name: SaveWebAuthnCeremony :exec
+INSERT INTO webauthn_ceremonies (
+    id,
+    ceremony_type,
+    user_id,
+    session_data,
+    expires_at,
+    created_at
+) VALUES (
+    @id,
+    @ceremony_type,
+    @user_id,
+    @session_data,
+    @expires_at,
+    @created_at
+);
+
+-- name: ConsumeWebAuthnCeremony :one
+DELETE FROM webauthn_ceremonies
+WHERE id = $1
+RETURNING sqlc.embed(webauthn_ceremonies);
+
+-- name: CleanupExpiredWebAuthnCeremonies :exec
+DELETE FROM webauthn_ceremonies
+WHERE expires_at < NOW();
@@ -0,0 +1,84 @@
+-- name: CreateWebhookSubscription :exec
+INSERT INTO webhook_subscriptions (
+    id,
+    organization_id,
+    url,
+    event_types,
+    secret,
+    description,
+    created_at
+) VALUES (
+    @id,
+    @organization_id,
+    @url,
+    @event_types,
+    @secret,
+    @description,
+    @created_at
+);
+
+-- name: GetWebhookSubscription :one
+SELECT sqlc.embed(s)
+FROM webhook_subscriptions s
+WHERE s.id = @id
+AND s.organization_id = @organization_id;
+
+-- name: ListWebhookSubscriptionsByOrganization :many
+SELECT sqlc.embed(s)
+FROM webhook_subscriptions s
+WHERE s.organization_id = $1
+ORDER BY s.created_at DESC;
+
+-- name: ListWebhookSubscriptionsByEventType :many
+SELECT sqlc.embed(s)
+FROM webhook_subscriptions s
+WHERE s.organization_id = @organization_id
+AND @event_type::TEXT = ANY(s.event_types)
+ORDER BY s.created_at;
+
+-- name: DeleteWebhookSubscription :execrows
+DELETE FROM webhook_subscriptions
+WHERE id = @id
+AND organization_id = @organization_id;
+
+-- name: CreateWebhookDelivery :exec
+INSERT INTO webhook_deliveries (
+    id,
+    subscription_id,
+    organization_id,
+    event_id,
+    event_type,
+    status_code,
+    error,
+    succeeded,
+    duration_ms,
+    created_at
+) VALUES (
+    @id,
+    @subscription_id,
+    @organization_id,
+    @event_id,
+    @event_type,
+    @status_code,
+    @error,
+    @succeeded,
+    @duration_ms,
+    @created_at
+);
+
+-- name: HasSucceededWebhookDelivery :one
+SELECT EXISTS (
+    SELECT 1
+    FROM webhook_deliveries d
+    WHERE d.subscription_id = @subscription_id
+    AND d.event_id = @event_id
+    AND d.succeeded
+)::BOOLEAN;
+
+-- name: ListWebhookDeliveries :many
+SELECT sqlc.embed(d)
+FROM webhook_deliveries d
+WHERE d.subscription_id = @subscription_id
+AND d.organization_id = @organization_id
+ORDER BY d.created_at DESC
+LIMIT @page_size;
@@ -0,0 +1,13 @@
+package audit
+
+import "github.com/shibayama-club/keyhub/internal/domain/model"
+
+// CheckpointSigner は監査ログのチェックポイントに署名する
+type CheckpointSigner interface {
+	Sign(checkpoint model.AuditCheckpoint) (model.AuditCheckpoint, error)
+}
+
+// CheckpointVerifier はチェックポイントの署名を検証する
+type CheckpointVerifier interface {
+	Verify(checkpoint model.AuditCheckpoint) error
+}
@@ -8,6 +8,10 @@ var (
 	ErrUnAuthorized  = errors.New("Unauthorized Error")
 	ErrInternal      = errors.New("Internal Error")
 	ErrAlreadyExists = errors.New("Already Exists Error")
+	// ErrPermissionDenied は認証済みだが操作する権限がないことを表す
+	ErrPermissionDenied = errors.New("Permission Denied Error")
+	// ErrConflict は取得してから更新するまでの間に他の更新があり、そのまま上書きできないことを表す
+	ErrConflict = errors.New("Conflict Error")
 )
 
 func IsValidationError(err error) bool {
@@ -29,3 +33,11 @@ func IsInternalError(err error) bool {
 func IsAlreadyExistsError(err error) bool {
 	return errors.Is(err, ErrAlreadyExists)
 }
+
+func IsPermissionDeniedError(err error) bool {
+	return errors.Is(err, ErrPermissionDenied)
+}
+
+func IsConflictError(err error) bool {
+	return errors.Is(err, ErrConflict)
+}
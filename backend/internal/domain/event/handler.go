@@ -0,0 +1,32 @@
+package event
+
+import (
+	"context"
+
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+)
+
+// Handler はoutboxから取り出したドメインイベントを受け取る。
+// エラーを返すとイベントは後で再配送されるため、同じイベントを複数回受け取っても問題ないように実装する
+type Handler interface {
+	// Name はイベントごとに処理を終えたハンドラーを記録するための名前。
+	// 再配送では処理を終えたハンドラーを呼ばないため、一度決めたら変えない
+	Name() string
+	HandleEvent(ctx context.Context, event model.OutboxEvent) error
+}
+
+// HandlerFunc は名前と関数から Handler を作る
+func HandlerFunc(name string, f func(ctx context.Context, event model.OutboxEvent) error) Handler {
+	return handlerFunc{name: name, f: f}
+}
+
+type handlerFunc struct {
+	name string
+	f    func(ctx context.Context, event model.OutboxEvent) error
+}
+
+func (h handlerFunc) Name() string { return h.name }
+
+func (h handlerFunc) HandleEvent(ctx context.Context, event model.OutboxEvent) error {
+	return h.f(ctx, event)
+}
@@ -0,0 +1,20 @@
+package keywatch
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+)
+
+// ErrInterrupted は鍵の変更を取りこぼした可能性があり、購読が打ち切られたことを表す。
+// 購読し直して最新の状態を取得し直す必要がある
+var ErrInterrupted = errors.New("key watch interrupted")
+
+// Watcher は鍵の変更を購読する。
+// 変更はコミットされた順に届き、どのインスタンスで行われた変更も届く
+type Watcher interface {
+	// Watch は組織の鍵の変更を受け取るチャネルを返す。
+	// ctx が終了したときと、変更を取りこぼした可能性があるときにチャネルを閉じる
+	Watch(ctx context.Context, organizationID model.OrganizationID) <-chan model.KeyChange
+}
@@ -0,0 +1,319 @@
+package model
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+)
+
+const (
+	// APITokenPrefixApp はユーザーが発行するApp API用トークンの接頭辞
+	APITokenPrefixApp = "khu_"
+	// APITokenPrefixConsole は組織が発行するConsole API用トークンの接頭辞
+	APITokenPrefixConsole = "khc_"
+
+	// APITokenMaxLifetime はAPIトークンの有効期限の上限
+	APITokenMaxLifetime = 365 * 24 * time.Hour
+)
+
+type APITokenID uuid.UUID
+
+func (id APITokenID) UUID() uuid.UUID {
+	return uuid.UUID(id)
+}
+
+func (id APITokenID) String() string {
+	return uuid.UUID(id).String()
+}
+
+func ParseAPITokenID(value string) (APITokenID, error) {
+	u, err := uuid.Parse(value)
+	if err != nil {
+		return APITokenID{}, errors.WithHint(
+			errors.Wrap(err, "failed to parse API token ID"),
+			"APIトークンIDの形式が正しくありません。",
+		)
+	}
+	return APITokenID(u), nil
+}
+
+type APITokenName string
+
+func (n APITokenName) String() string {
+	return string(n)
+}
+
+func (n APITokenName) Validate() error {
+	if n == "" {
+		return errors.WithHint(
+			errors.New("API token name is required"),
+			"APIトークン名は必須です。",
+		)
+	}
+
+	if utf8.RuneCountInString(string(n)) > 50 {
+		return errors.WithHint(
+			errors.New("API token name must be within 50 characters"),
+			"APIトークン名は50文字以内で入力してください。",
+		)
+	}
+	return nil
+}
+
+func NewAPITokenName(value string) (APITokenName, error) {
+	n := APITokenName(value)
+	if err := n.Validate(); err != nil {
+		return "", err
+	}
+	return n, nil
+}
+
+// APITokenScope はAPIトークンで呼び出せる操作の範囲。"リソース:操作" の形式
+type APITokenScope string
+
+const (
+	APITokenScopeTenantsRead  APITokenScope = "tenants:read"
+	APITokenScopeTenantsWrite APITokenScope = "tenants:write"
+	APITokenScopeRoomsRead    APITokenScope = "rooms:read"
+	APITokenScopeRoomsWrite   APITokenScope = "rooms:write"
+	APITokenScopeKeysRead     APITokenScope = "keys:read"
+	APITokenScopeKeysWrite    APITokenScope = "keys:write"
+)
+
+func (s APITokenScope) String() string {
+	return string(s)
+}
+
+func (s APITokenScope) Validate() error {
+	switch s {
+	case APITokenScopeTenantsRead, APITokenScopeTenantsWrite,
+		APITokenScopeRoomsRead, APITokenScopeRoomsWrite,
+		APITokenScopeKeysRead, APITokenScopeKeysWrite:
+		return nil
+	default:
+		return errors.WithHintf(
+			errors.New("invalid API token scope"),
+			"無効なスコープです: %s", s,
+		)
+	}
+}
+
+// NewAPITokenScopes はスコープ文字列を検証し、重複を取り除いて返す
+func NewAPITokenScopes(values []string) ([]APITokenScope, error) {
+	if len(values) == 0 {
+		return nil, errors.WithHint(
+			errors.New("at least one scope is required"),
+			"スコープを1つ以上指定してください。",
+		)
+	}
+
+	scopes := make([]APITokenScope, 0, len(values))
+	for _, v := range values {
+		s := APITokenScope(v)
+		if err := s.Validate(); err != nil {
+			return nil, err
+		}
+		if !slices.Contains(scopes, s) {
+			scopes = append(scopes, s)
+		}
+	}
+	return scopes, nil
+}
+
+// GenerateAPITokenSecret は接頭辞付きのトークン本体と、一覧表示用の先頭部分を生成する。
+// トークン本体は発行時に一度だけ利用者へ返し、サーバーにはハッシュのみ保存する
+func GenerateAPITokenSecret(prefix string) (token string, displayPrefix string, err error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", errors.Wrap(err, "failed to generate API token")
+	}
+	token = prefix + hex.EncodeToString(b)
+	return token, token[:len(prefix)+8], nil
+}
+
+// HashAPIToken はAPIトークンの保存・照合に使うハッシュを返す。
+// トークン自体が十分なエントロピーを持つため、ソルトなしのSHA-256で照合する
+func HashAPIToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// IsAppAPIToken はApp API用トークンの形式かどうかを判定する
+func IsAppAPIToken(token string) bool {
+	return APITokenOwnerUser.Matches(token)
+}
+
+// IsConsoleAPIToken はConsole API用トークンの形式かどうかを判定する
+func IsConsoleAPIToken(token string) bool {
+	return APITokenOwnerOrganization.Matches(token)
+}
+
+// APITokenOwnerKind はトークンの持ち主の種類。種類ごとに接頭辞と呼び出せるAPIが決まる
+type APITokenOwnerKind string
+
+const (
+	// APITokenOwnerUser はユーザーが App API を呼び出すためのトークン
+	APITokenOwnerUser APITokenOwnerKind = "user"
+	// APITokenOwnerOrganization は組織が Console API を呼び出すためのトークン
+	APITokenOwnerOrganization APITokenOwnerKind = "organization"
+)
+
+func (k APITokenOwnerKind) String() string {
+	return string(k)
+}
+
+// Prefix はこの種類のトークンに付ける接頭辞を返す
+func (k APITokenOwnerKind) Prefix() string {
+	if k == APITokenOwnerUser {
+		return APITokenPrefixApp
+	}
+	return APITokenPrefixConsole
+}
+
+// Matches はトークンがこの種類の形式かどうかを判定する
+func (k APITokenOwnerKind) Matches(token string) bool {
+	return strings.HasPrefix(token, k.Prefix())
+}
+
+// APITokenOwner はトークンを発行・一覧・無効化する主体。
+// App API ではユーザー、Console API では組織がトークンを持つ
+type APITokenOwner struct {
+	Kind APITokenOwnerKind
+	// UserID は Kind が APITokenOwnerUser の場合のみ設定する
+	UserID         UserID
+	OrganizationID OrganizationID
+}
+
+// NewUserAPITokenOwner はユーザーがログイン中の組織で使うトークンの持ち主を作る
+func NewUserAPITokenOwner(userID UserID, organizationID OrganizationID) APITokenOwner {
+	return APITokenOwner{Kind: APITokenOwnerUser, UserID: userID, OrganizationID: organizationID}
+}
+
+func NewOrganizationAPITokenOwner(organizationID OrganizationID) APITokenOwner {
+	return APITokenOwner{Kind: APITokenOwnerOrganization, OrganizationID: organizationID}
+}
+
+// APIToken はブラウザを介さないスクリプトや端末向けの認証情報。
+// すべてのトークンは組織に属し、UserID があればユーザー（App API）、なければ組織（Console API）のトークン
+type APIToken struct {
+	ID             APITokenID
+	UserID         *UserID
+	OrganizationID OrganizationID
+	Name           APITokenName
+	// TokenPrefix は一覧画面でトークンを見分けるための先頭部分。トークン本体は保存しない
+	TokenPrefix string
+	Scopes      []APITokenScope
+	ExpiresAt   time.Time
+	LastUsedAt  *time.Time
+	RevokedAt   *time.Time
+	CreatedAt   time.Time
+}
+
+func (t APIToken) Validate() error {
+	if err := t.OrganizationID.Validate(); err != nil {
+		return errors.Wrap(err, "API token must belong to an organization")
+	}
+
+	if err := t.Name.Validate(); err != nil {
+		return err
+	}
+
+	if len(t.Scopes) == 0 {
+		return errors.WithHint(
+			errors.New("at least one scope is required"),
+			"スコープを1つ以上指定してください。",
+		)
+	}
+
+	if !t.ExpiresAt.After(t.CreatedAt) {
+		return errors.WithHint(
+			errors.New("expires_at must be after created_at"),
+			"有効期限は未来の日時を指定してください。",
+		)
+	}
+
+	if t.ExpiresAt.Sub(t.CreatedAt) > APITokenMaxLifetime {
+		return errors.WithHint(
+			errors.New("API token lifetime exceeds the maximum"),
+			"有効期限は1年以内で指定してください。",
+		)
+	}
+
+	return nil
+}
+
+// OwnerKind はトークンの持ち主の種類を返す
+func (t APIToken) OwnerKind() APITokenOwnerKind {
+	if t.UserID != nil {
+		return APITokenOwnerUser
+	}
+	return APITokenOwnerOrganization
+}
+
+func (t APIToken) IsExpired() bool {
+	return time.Now().After(t.ExpiresAt)
+}
+
+// IsValid はトークンが無効化されておらず、期限切れでもないかを確認する
+func (t APIToken) IsValid() bool {
+	return t.RevokedAt == nil && !t.IsExpired()
+}
+
+func (t APIToken) HasScope(scope APITokenScope) bool {
+	return slices.Contains(t.Scopes, scope)
+}
+
+// ShouldTouch は最終利用日時を更新すべきかを判定する
+func (t APIToken) ShouldTouch() bool {
+	return t.LastUsedAt == nil || time.Since(*t.LastUsedAt) >= SessionTouchInterval
+}
+
+func newAPIToken(
+	userID *UserID,
+	organizationID OrganizationID,
+	name APITokenName,
+	tokenPrefix string,
+	scopes []APITokenScope,
+	expiresAt time.Time,
+) (APIToken, error) {
+	token := APIToken{
+		ID:             APITokenID(uuid.New()),
+		UserID:         userID,
+		OrganizationID: organizationID,
+		Name:           name,
+		TokenPrefix:    tokenPrefix,
+		Scopes:         scopes,
+		ExpiresAt:      expiresAt,
+		CreatedAt:      time.Now(),
+	}
+
+	if err := token.Validate(); err != nil {
+		return APIToken{}, err
+	}
+
+	return token, nil
+}
+
+// NewUserAPIToken はユーザーがログイン中の組織で使うトークンを作る
+func NewUserAPIToken(userID UserID, organizationID OrganizationID, name APITokenName, tokenPrefix string, scopes []APITokenScope, expiresAt time.Time) (APIToken, error) {
+	return newAPIToken(&userID, organizationID, name, tokenPrefix, scopes, expiresAt)
+}
+
+func NewOrganizationAPIToken(organizationID OrganizationID, name APITokenName, tokenPrefix string, scopes []APITokenScope, expiresAt time.Time) (APIToken, error) {
+	return newAPIToken(nil, organizationID, name, tokenPrefix, scopes, expiresAt)
+}
+
+// NewAPIToken は持ち主の種類に応じたトークンを作る
+func NewAPIToken(owner APITokenOwner, name APITokenName, tokenPrefix string, scopes []APITokenScope, expiresAt time.Time) (APIToken, error) {
+	if owner.Kind == APITokenOwnerUser {
+		return NewUserAPIToken(owner.UserID, owner.OrganizationID, name, tokenPrefix, scopes, expiresAt)
+	}
+	return NewOrganizationAPIToken(owner.OrganizationID, name, tokenPrefix, scopes, expiresAt)
+}
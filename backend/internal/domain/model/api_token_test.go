@@ -0,0 +1,90 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAPITokenScopes(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  []string
+		want    []APITokenScope
+		wantErr bool
+	}{
+		{
+			name:   "正常系: 重複したスコープはまとめられる",
+			values: []string{"keys:read", "rooms:write", "keys:read"},
+			want:   []APITokenScope{APITokenScopeKeysRead, APITokenScopeRoomsWrite},
+		},
+		{
+			name:    "異常系: スコープが空",
+			values:  nil,
+			wantErr: true,
+		},
+		{
+			name:    "異常系: 未定義のスコープ",
+			values:  []string{"keys:read", "admin"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewAPITokenScopes(tt.values)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewUserAPIToken(t *testing.T) {
+	userID := UserID(uuid.New())
+	organizationID := OrganizationID(uuid.New())
+	scopes := []APITokenScope{APITokenScopeKeysRead}
+
+	tests := []struct {
+		name           string
+		organizationID OrganizationID
+		expiresAt      time.Time
+		wantErr        bool
+	}{
+		{name: "正常系: 90日後に失効する", organizationID: organizationID, expiresAt: time.Now().Add(90 * 24 * time.Hour)},
+		{name: "異常系: 過去の有効期限", organizationID: organizationID, expiresAt: time.Now().Add(-time.Hour), wantErr: true},
+		{name: "異常系: 有効期限が1年を超える", organizationID: organizationID, expiresAt: time.Now().Add(APITokenMaxLifetime + time.Hour), wantErr: true},
+		{name: "異常系: 組織が未指定", expiresAt: time.Now().Add(90 * 24 * time.Hour), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := NewUserAPIToken(userID, tt.organizationID, "kiosk", "khu_12345678", scopes, tt.expiresAt)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, token.IsValid())
+			assert.True(t, token.HasScope(APITokenScopeKeysRead))
+			assert.False(t, token.HasScope(APITokenScopeKeysWrite))
+		})
+	}
+}
+
+func TestGenerateAPITokenSecret(t *testing.T) {
+	token, displayPrefix, err := GenerateAPITokenSecret(APITokenPrefixConsole)
+	require.NoError(t, err)
+
+	assert.True(t, IsConsoleAPIToken(token))
+	assert.False(t, IsAppAPIToken(token))
+	assert.Len(t, displayPrefix, len(APITokenPrefixConsole)+8)
+	assert.Equal(t, token[:len(displayPrefix)], displayPrefix)
+	assert.NotEqual(t, token, HashAPIToken(token))
+}
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"crypto/subtle"
 	"time"
 
 	"github.com/cockroachdb/errors"
@@ -31,14 +32,18 @@ func NewAppSessionID(value string) (AppSessionID, error) {
 	return id, nil
 }
 
+// AppSession はログインしたユーザーのセッション。ログイン時に選んだ組織のデータにのみアクセスできる
 type AppSession struct {
 	SessionID          AppSessionID
 	UserID             UserID
+	OrganizationID     OrganizationID
 	ActiveMembershipID *uuid.UUID //ここは外部キーで現在このキーがあるテーブル自体が存在しないので一時的にプリミティブ型
 	CreatedAt          time.Time
 	ExpiresAt          time.Time
 	CSRFToken          *string
 	Revoked            bool
+	Client             SessionClient
+	LastSeenAt         time.Time
 }
 
 func (s AppSession) String() string {
@@ -58,6 +63,10 @@ func (s AppSession) Validate() error {
 		)
 	}
 
+	if err := s.OrganizationID.Validate(); err != nil {
+		return err
+	}
+
 	if s.CreatedAt.IsZero() {
 		return errors.WithHint(
 			errors.New("created_at is required"),
@@ -82,6 +91,24 @@ func (s AppSession) Validate() error {
 	return nil
 }
 
+// ShouldTouch は最終アクセス日時やクライアント情報を更新すべきかを判定する
+func (s AppSession) ShouldTouch(client SessionClient) bool {
+	return shouldTouchSession(s.LastSeenAt, s.Client, client)
+}
+
+// HasCSRFToken はセッションにCSRFトークンが発行されているかを確認する
+func (s AppSession) HasCSRFToken() bool {
+	return s.CSRFToken != nil && *s.CSRFToken != ""
+}
+
+// VerifyCSRFToken はリクエストで送られたCSRFトークンがセッションのものと一致するかを確認する
+func (s AppSession) VerifyCSRFToken(token string) bool {
+	if !s.HasCSRFToken() || token == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(*s.CSRFToken), []byte(token)) == 1
+}
+
 // IsExpired はセッションが期限切れかどうかを確認する
 func (s AppSession) IsExpired() bool {
 	return time.Now().After(s.ExpiresAt)
@@ -95,14 +122,20 @@ func (s AppSession) IsValid() bool {
 func NewAppSession(
 	sessionID AppSessionID,
 	userID UserID,
+	organizationID OrganizationID,
 	expiresAt time.Time,
+	client SessionClient,
 ) (AppSession, error) {
+	now := time.Now()
 	session := AppSession{
-		SessionID: sessionID,
-		UserID:    userID,
-		CreatedAt: time.Now(),
-		ExpiresAt: expiresAt,
-		Revoked:   false,
+		SessionID:      sessionID,
+		UserID:         userID,
+		OrganizationID: organizationID,
+		CreatedAt:      now,
+		ExpiresAt:      expiresAt,
+		Revoked:        false,
+		Client:         client,
+		LastSeenAt:     now,
 	}
 
 	if err := session.Validate(); err != nil {
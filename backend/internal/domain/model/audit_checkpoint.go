@@ -0,0 +1,33 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// AuditCheckpoint はある時点の組織の監査ログの最新位置に署名したもの。
+// DBの外に保存しておくと、DBの記録を末尾から削除したり連鎖ごと作り直したりした場合も検出できる
+type AuditCheckpoint struct {
+	OrganizationID OrganizationID
+	Seq            int64
+	Hash           string
+	CreatedAt      time.Time
+	// KeyID は署名に使った鍵の識別子。鍵を入れ替えた後も古いチェックポイントを検証できるようにする
+	KeyID     string
+	Signature []byte
+}
+
+func NewAuditCheckpoint(organizationID OrganizationID, head AuditChainHead, now time.Time) AuditCheckpoint {
+	return AuditCheckpoint{
+		OrganizationID: organizationID,
+		Seq:            head.Seq,
+		Hash:           head.Hash,
+		CreatedAt:      now.UTC().Truncate(time.Second),
+	}
+}
+
+// SigningPayload は署名の対象。署名と鍵の識別子以外の内容を固定の形式で並べる
+func (c AuditCheckpoint) SigningPayload() []byte {
+	return fmt.Appendf(nil, "keyhub-audit-checkpoint/v1\n%s\n%d\n%s\n%s",
+		c.OrganizationID, c.Seq, c.Hash, c.CreatedAt.UTC().Format(time.RFC3339))
+}
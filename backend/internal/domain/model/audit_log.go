@@ -0,0 +1,330 @@
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+)
+
+const (
+	// AuditLogDefaultPageSize は検索で件数を指定しなかった場合の件数
+	AuditLogDefaultPageSize = 50
+	// AuditLogMaxPageSize は1回の検索で返す件数の上限
+	AuditLogMaxPageSize = 200
+
+	// AuditResultCodeOK は手続きが成功した場合の結果コード
+	AuditResultCodeOK = "ok"
+	// AuditProcedureTenantTombstone はテナントを削除したときに残す墓標の手続き名。
+	// 削除したテナントは参照できなくなるため、IDとともに名前とタイプを記録する
+	AuditProcedureTenantTombstone = "tombstone/tenant"
+)
+
+type AuditLogID uuid.UUID
+
+func (id AuditLogID) UUID() uuid.UUID {
+	return uuid.UUID(id)
+}
+
+func (id AuditLogID) String() string {
+	return uuid.UUID(id).String()
+}
+
+// AuditActorType は操作を行った主体の種類
+type AuditActorType string
+
+const (
+	// AuditActorTypeUser はAppにログインしたユーザー（ユーザーのAPIトークンを含む）
+	AuditActorTypeUser AuditActorType = "user"
+	// AuditActorTypeConsoleOwner はOrganization Keyでログインしたコンソール管理者。IDはセッションID
+	AuditActorTypeConsoleOwner AuditActorType = "console_owner"
+	// AuditActorTypeConsoleOperator は管理者のキーでログインしたコンソール管理者。IDは管理者ID
+	AuditActorTypeConsoleOperator AuditActorType = "console_operator"
+	// AuditActorTypeAPIToken は組織のAPIトークン。IDはトークンID
+	AuditActorTypeAPIToken AuditActorType = "api_token"
+	// AuditActorTypeAnonymous はログイン前の呼び出し
+	AuditActorTypeAnonymous AuditActorType = "anonymous"
+)
+
+func (t AuditActorType) String() string {
+	return string(t)
+}
+
+func (t AuditActorType) Validate() error {
+	switch t {
+	case AuditActorTypeUser, AuditActorTypeConsoleOwner, AuditActorTypeConsoleOperator,
+		AuditActorTypeAPIToken, AuditActorTypeAnonymous:
+		return nil
+	default:
+		return errors.WithHintf(
+			errors.New("invalid audit actor type"),
+			"無効な操作者の種類です: %s", t,
+		)
+	}
+}
+
+type AuditActor struct {
+	Type AuditActorType
+	ID   string
+}
+
+// AuditLog は更新系の手続きの呼び出し1回分の記録。記録後に変更・削除はしない
+type AuditLog struct {
+	ID             AuditLogID
+	OrganizationID OrganizationID
+	Actor          AuditActor
+	// Procedure は呼び出された手続き（例: /keyhub.console.v1.ConsoleService/CreateTenant）
+	Procedure string
+	// TargetIDs はリクエストに含まれていた操作対象のID。フィールド名からIDへの対応
+	TargetIDs map[string]string
+	// ResultCode は結果のConnectのコード。成功した場合は "ok"
+	ResultCode string
+	RequestID  string
+	Client     SessionClient
+	CreatedAt  time.Time
+	// Seq は組織ごとの1から始まる連番。欠番があれば記録が削除されている
+	Seq int64
+	// PrevHash は同じ組織の直前の記録の Hash。最初の記録では空
+	PrevHash string
+	// Hash は PrevHash を含む記録の内容のハッシュ。内容を書き換えると一致しなくなる
+	Hash string
+}
+
+func (l AuditLog) Validate() error {
+	if err := l.OrganizationID.Validate(); err != nil {
+		return errors.Wrap(err, "audit log must belong to an organization")
+	}
+
+	if err := l.Actor.Type.Validate(); err != nil {
+		return err
+	}
+
+	if l.Procedure == "" {
+		return errors.New("audit log procedure is required")
+	}
+
+	if l.ResultCode == "" {
+		return errors.New("audit log result code is required")
+	}
+
+	return nil
+}
+
+func NewAuditLog(
+	organizationID OrganizationID,
+	actor AuditActor,
+	procedure string,
+	targetIDs map[string]string,
+	resultCode string,
+	requestID string,
+	client SessionClient,
+) (AuditLog, error) {
+	if targetIDs == nil {
+		targetIDs = map[string]string{}
+	}
+
+	log := AuditLog{
+		ID:             AuditLogID(uuid.New()),
+		OrganizationID: organizationID,
+		Actor:          actor,
+		Procedure:      procedure,
+		TargetIDs:      targetIDs,
+		ResultCode:     resultCode,
+		RequestID:      requestID,
+		Client:         client,
+		// DBに保存すると精度がマイクロ秒になるため、ハッシュが保存前後で変わらないよう切り捨てる
+		CreatedAt: time.Now().Truncate(time.Microsecond),
+	}
+
+	if err := log.Validate(); err != nil {
+		return AuditLog{}, err
+	}
+
+	return log, nil
+}
+
+// NewTenantTombstone は削除するテナントの墓標を作る。テナントの削除と同じトランザクションで記録する
+func NewTenantTombstone(tenant Tenant, actor AuditActor, requestID string, client SessionClient) (AuditLog, error) {
+	return NewAuditLog(
+		tenant.OrganizationID,
+		actor,
+		AuditProcedureTenantTombstone,
+		map[string]string{
+			"tenant_id":   tenant.ID.String(),
+			"tenant_name": tenant.Name.String(),
+			"tenant_type": tenant.Type.String(),
+		},
+		AuditResultCodeOK,
+		requestID,
+		client,
+	)
+}
+
+// auditLogHashInput はハッシュを計算する内容。フィールドの順序とキーの並びを固定するため構造体で表す
+type auditLogHashInput struct {
+	Seq            int64             `json:"seq"`
+	PrevHash       string            `json:"prev_hash"`
+	ID             string            `json:"id"`
+	OrganizationID string            `json:"organization_id"`
+	ActorType      string            `json:"actor_type"`
+	ActorID        string            `json:"actor_id"`
+	Procedure      string            `json:"procedure"`
+	TargetIDs      map[string]string `json:"target_ids"`
+	ResultCode     string            `json:"result_code"`
+	RequestID      string            `json:"request_id"`
+	IPAddress      string            `json:"ip_address"`
+	UserAgent      string            `json:"user_agent"`
+	CreatedAt      string            `json:"created_at"`
+}
+
+// ComputeHash は記録の内容と PrevHash からハッシュを計算する。Hash 自体は含めない
+func (l AuditLog) ComputeHash() string {
+	targetIDs := l.TargetIDs
+	if targetIDs == nil {
+		targetIDs = map[string]string{}
+	}
+
+	// 文字列とマップのみで構成されるため Marshal は失敗しない。マップのキーは並べ替えて出力される
+	b, _ := json.Marshal(auditLogHashInput{
+		Seq:            l.Seq,
+		PrevHash:       l.PrevHash,
+		ID:             l.ID.String(),
+		OrganizationID: l.OrganizationID.String(),
+		ActorType:      l.Actor.Type.String(),
+		ActorID:        l.Actor.ID,
+		Procedure:      l.Procedure,
+		TargetIDs:      targetIDs,
+		ResultCode:     l.ResultCode,
+		RequestID:      l.RequestID,
+		IPAddress:      l.Client.IPAddress,
+		UserAgent:      l.Client.UserAgent,
+		CreatedAt:      l.CreatedAt.UTC().Format(time.RFC3339Nano),
+	})
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// AuditChainHead は組織の監査ログの最新の記録の位置。記録がなければゼロ値
+type AuditChainHead struct {
+	Seq  int64
+	Hash string
+}
+
+// Chain は記録を head の次に連結し、連番とハッシュを設定して返す
+func (l AuditLog) Chain(head AuditChainHead) AuditLog {
+	l.Seq = head.Seq + 1
+	l.PrevHash = head.Hash
+	l.Hash = l.ComputeHash()
+	return l
+}
+
+// AuditChainBreak は連鎖が壊れていた最初の記録
+type AuditChainBreak struct {
+	Seq    int64
+	LogID  AuditLogID
+	Reason string
+}
+
+func (b *AuditChainBreak) Error() string {
+	return fmt.Sprintf("audit chain broken at seq %d (%s): %s", b.Seq, b.LogID, b.Reason)
+}
+
+// AuditChainVerifier は組織の監査ログを連番の順に受け取り、連鎖が壊れていないかを確認する。
+// ハッシュ導入前の記録（Hash が空）は連鎖の開始前にある限り Legacy として数え、検証の対象外とする
+type AuditChainVerifier struct {
+	head    AuditChainHead
+	started bool
+	// Checked はハッシュを検証した記録の数
+	Checked int64
+	// Legacy はハッシュ導入前の記録の数
+	Legacy int64
+}
+
+// Head は最後に確認した記録の位置を返す
+func (v *AuditChainVerifier) Head() AuditChainHead {
+	return v.head
+}
+
+// Next は次の記録を確認する。壊れていた場合は *AuditChainBreak を返し、それ以降の確認は意味を持たない
+func (v *AuditChainVerifier) Next(log AuditLog) error {
+	broken := func(reason string) error {
+		return &AuditChainBreak{Seq: log.Seq, LogID: log.ID, Reason: reason}
+	}
+
+	if log.Seq != v.head.Seq+1 {
+		return broken(fmt.Sprintf("expected seq %d, records may have been deleted", v.head.Seq+1))
+	}
+
+	if log.Hash == "" && !v.started {
+		v.Legacy++
+		v.head = AuditChainHead{Seq: log.Seq}
+		return nil
+	}
+	v.started = true
+
+	if log.PrevHash != v.head.Hash {
+		return broken("prev_hash does not match the previous record")
+	}
+
+	if log.ComputeHash() != log.Hash {
+		return broken("hash does not match the record content, the record may have been edited")
+	}
+
+	v.Checked++
+	v.head = AuditChainHead{Seq: log.Seq, Hash: log.Hash}
+	return nil
+}
+
+// AuditLogCursor は検索結果の続きを取得するための位置。最後に返した記録の作成日時とIDで表す
+type AuditLogCursor struct {
+	CreatedAt time.Time
+	ID        AuditLogID
+}
+
+func NewAuditLogCursor(log AuditLog) AuditLogCursor {
+	return AuditLogCursor{CreatedAt: log.CreatedAt, ID: log.ID}
+}
+
+// Encode はクライアントへ返すページトークンに変換する。中身に意味を持たせないためBase64で包む
+func (c AuditLogCursor) Encode() string {
+	raw := strconv.FormatInt(c.CreatedAt.UnixNano(), 10) + "_" + c.ID.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func ParseAuditLogCursor(token string) (AuditLogCursor, error) {
+	invalid := func(cause error) error {
+		return errors.WithHint(
+			errors.Wrap(cause, "failed to parse audit log page token"),
+			"ページトークンの形式が正しくありません。",
+		)
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return AuditLogCursor{}, invalid(err)
+	}
+
+	nanos, id, ok := strings.Cut(string(raw), "_")
+	if !ok {
+		return AuditLogCursor{}, invalid(errors.New("separator not found"))
+	}
+
+	n, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return AuditLogCursor{}, invalid(err)
+	}
+
+	u, err := uuid.Parse(id)
+	if err != nil {
+		return AuditLogCursor{}, invalid(err)
+	}
+
+	return AuditLogCursor{CreatedAt: time.Unix(0, n), ID: AuditLogID(u)}, nil
+}
@@ -0,0 +1,144 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAuditChain(t *testing.T, orgID OrganizationID, n int) []AuditLog {
+	t.Helper()
+
+	var head AuditChainHead
+	logs := make([]AuditLog, 0, n)
+	for range n {
+		log, err := NewAuditLog(
+			orgID,
+			AuditActor{Type: AuditActorTypeUser, ID: uuid.NewString()},
+			"/keyhub.app.v1.KeyService/BorrowKey",
+			map[string]string{"key_id": uuid.NewString()},
+			"ok",
+			uuid.NewString(),
+			NewSessionClient("test", "127.0.0.1"),
+		)
+		require.NoError(t, err)
+
+		log = log.Chain(head)
+		head = AuditChainHead{Seq: log.Seq, Hash: log.Hash}
+		logs = append(logs, log)
+	}
+	return logs
+}
+
+func TestAuditChainVerifier(t *testing.T) {
+	orgID := OrganizationID(uuid.New())
+
+	tests := []struct {
+		name        string
+		logs        func(t *testing.T) []AuditLog
+		wantBreakAt int64
+		wantChecked int64
+		wantLegacy  int64
+	}{
+		{
+			name: "正常系: 改ざんされていない連鎖",
+			logs: func(t *testing.T) []AuditLog {
+				return newTestAuditChain(t, orgID, 3)
+			},
+			wantChecked: 3,
+		},
+		{
+			name: "正常系: ハッシュ導入前の記録は連鎖の開始前なら検証しない",
+			logs: func(t *testing.T) []AuditLog {
+				logs := newTestAuditChain(t, orgID, 3)
+				logs[0].PrevHash, logs[0].Hash = "", ""
+				logs[1].PrevHash = ""
+				logs[1].Hash = logs[1].ComputeHash()
+				logs[2].PrevHash = logs[1].Hash
+				logs[2].Hash = logs[2].ComputeHash()
+				return logs
+			},
+			wantChecked: 2,
+			wantLegacy:  1,
+		},
+		{
+			name: "異常系: 記録の内容が書き換えられている",
+			logs: func(t *testing.T) []AuditLog {
+				logs := newTestAuditChain(t, orgID, 3)
+				logs[1].ResultCode = "permission_denied"
+				return logs
+			},
+			wantBreakAt: 2,
+			wantChecked: 1,
+		},
+		{
+			name: "異常系: 途中の記録が削除されている",
+			logs: func(t *testing.T) []AuditLog {
+				logs := newTestAuditChain(t, orgID, 3)
+				return append(logs[:1], logs[2])
+			},
+			wantBreakAt: 3,
+			wantChecked: 1,
+		},
+		{
+			name: "異常系: 記録を作り直してハッシュを付け替えても次の記録と繋がらない",
+			logs: func(t *testing.T) []AuditLog {
+				logs := newTestAuditChain(t, orgID, 3)
+				logs[1].Actor.ID = uuid.NewString()
+				logs[1].Hash = logs[1].ComputeHash()
+				return logs
+			},
+			wantBreakAt: 3,
+			wantChecked: 2,
+		},
+		{
+			name: "異常系: 連鎖の開始後にハッシュのない記録がある",
+			logs: func(t *testing.T) []AuditLog {
+				logs := newTestAuditChain(t, orgID, 3)
+				logs[2].Hash = ""
+				return logs
+			},
+			wantBreakAt: 3,
+			wantChecked: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var verifier AuditChainVerifier
+			var gotBreak *AuditChainBreak
+			for _, log := range tt.logs(t) {
+				if err := verifier.Next(log); err != nil {
+					require.ErrorAs(t, err, &gotBreak)
+					break
+				}
+			}
+
+			if tt.wantBreakAt == 0 {
+				assert.Nil(t, gotBreak)
+			} else {
+				require.NotNil(t, gotBreak)
+				assert.Equal(t, tt.wantBreakAt, gotBreak.Seq)
+			}
+			assert.Equal(t, tt.wantChecked, verifier.Checked)
+			assert.Equal(t, tt.wantLegacy, verifier.Legacy)
+		})
+	}
+}
+
+func TestAuditLogComputeHash(t *testing.T) {
+	logs := newTestAuditChain(t, OrganizationID(uuid.New()), 1)
+	log := logs[0]
+
+	// DBから読み込むとタイムゾーンが変わるが、同じ時刻ならハッシュは変わらない
+	reloaded := log
+	reloaded.CreatedAt = log.CreatedAt.In(time.FixedZone("JST", 9*60*60))
+	assert.Equal(t, log.Hash, reloaded.ComputeHash())
+
+	modified := log
+	modified.TargetIDs = map[string]string{"key_id": uuid.NewString()}
+	assert.NotEqual(t, log.Hash, modified.ComputeHash())
+}
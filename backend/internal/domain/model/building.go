@@ -0,0 +1,195 @@
+package model
+
+import (
+	"time"
+	"unicode/utf8"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+)
+
+type BuildingID uuid.UUID
+
+func (id BuildingID) UUID() uuid.UUID {
+	return uuid.UUID(id)
+}
+
+func (id BuildingID) String() string {
+	return uuid.UUID(id).String()
+}
+
+func ParseBuildingID(value string) (BuildingID, error) {
+	u, err := uuid.Parse(value)
+	if err != nil {
+		return BuildingID{}, errors.WithHint(
+			errors.Wrap(err, "failed to parse building ID"),
+			"建物IDの形式が正しくありません。",
+		)
+	}
+	return BuildingID(u), nil
+}
+
+type BuildingDescription string
+
+func (d BuildingDescription) String() string {
+	return string(d)
+}
+
+func (d BuildingDescription) Validate() error {
+	if utf8.RuneCountInString(string(d)) > 300 {
+		return errors.WithHint(
+			errors.New("building description must be 300 characters or less"),
+			"建物の説明は300文字以内で入力してください。",
+		)
+	}
+	return nil
+}
+
+func NewBuildingDescription(value string) (BuildingDescription, error) {
+	d := BuildingDescription(value)
+	if err := d.Validate(); err != nil {
+		return "", err
+	}
+	return d, nil
+}
+
+// Building は部屋が置かれる建物。名前は組織内で一意
+type Building struct {
+	ID             BuildingID
+	OrganizationID OrganizationID
+	Name           BuildingName
+	Description    BuildingDescription
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (b Building) Validate() error {
+	if err := b.Name.Validate(); err != nil {
+		return err
+	}
+
+	if err := b.Description.Validate(); err != nil {
+		return err
+	}
+
+	if err := b.OrganizationID.Validate(); err != nil {
+		return err
+	}
+
+	if b.CreatedAt.IsZero() {
+		return errors.WithHint(
+			errors.New("created_at is required"),
+			"作成日時は必須です。",
+		)
+	}
+
+	if b.UpdatedAt.IsZero() {
+		return errors.WithHint(
+			errors.New("updated_at is required"),
+			"更新日時は必須です。",
+		)
+	}
+
+	return nil
+}
+
+func NewBuilding(
+	organizationID OrganizationID,
+	name BuildingName,
+	description BuildingDescription,
+) (Building, error) {
+	now := time.Now()
+	building := Building{
+		ID:             BuildingID(uuid.New()),
+		OrganizationID: organizationID,
+		Name:           name,
+		Description:    description,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	if err := building.Validate(); err != nil {
+		return Building{}, err
+	}
+
+	return building, nil
+}
+
+type FloorID uuid.UUID
+
+func (id FloorID) UUID() uuid.UUID {
+	return uuid.UUID(id)
+}
+
+func (id FloorID) String() string {
+	return uuid.UUID(id).String()
+}
+
+func ParseFloorID(value string) (FloorID, error) {
+	u, err := uuid.Parse(value)
+	if err != nil {
+		return FloorID{}, errors.WithHint(
+			errors.Wrap(err, "failed to parse floor ID"),
+			"階IDの形式が正しくありません。",
+		)
+	}
+	return FloorID(u), nil
+}
+
+// Floor は建物の階。Name は "3F" や "B1F" などの表示名で、建物内で一意。
+// Level は建物内での並び順で、地下は負の値にする
+type Floor struct {
+	ID             FloorID
+	BuildingID     BuildingID
+	OrganizationID OrganizationID
+	Name           FloorNumber
+	Level          int32
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (f Floor) Validate() error {
+	if err := f.Name.Validate(); err != nil {
+		return err
+	}
+
+	if err := f.OrganizationID.Validate(); err != nil {
+		return err
+	}
+
+	if f.CreatedAt.IsZero() {
+		return errors.WithHint(
+			errors.New("created_at is required"),
+			"作成日時は必須です。",
+		)
+	}
+
+	if f.UpdatedAt.IsZero() {
+		return errors.WithHint(
+			errors.New("updated_at is required"),
+			"更新日時は必須です。",
+		)
+	}
+
+	return nil
+}
+
+// NewFloor は建物に階を作成する
+func NewFloor(building Building, name FloorNumber, level int32) (Floor, error) {
+	now := time.Now()
+	floor := Floor{
+		ID:             FloorID(uuid.New()),
+		BuildingID:     building.ID,
+		OrganizationID: building.OrganizationID,
+		Name:           name,
+		Level:          level,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	if err := floor.Validate(); err != nil {
+		return Floor{}, err
+	}
+
+	return floor, nil
+}
@@ -0,0 +1,71 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBuilding(t *testing.T) {
+	orgID := OrganizationID(uuid.New())
+
+	tests := []struct {
+		name         string
+		buildingName BuildingName
+		description  BuildingDescription
+		wantErr      bool
+	}{
+		{name: "正常系: 建物を作成できる", buildingName: "本館"},
+		{name: "異常系: 名前が空", buildingName: "", wantErr: true},
+		{name: "異常系: 名前が20文字を超える", buildingName: BuildingName(strings.Repeat("館", 21)), wantErr: true},
+		{name: "異常系: 説明が300文字を超える", buildingName: "本館", description: BuildingDescription(strings.Repeat("a", 301)), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			building, err := NewBuilding(orgID, tt.buildingName, tt.description)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, orgID, building.OrganizationID)
+		})
+	}
+}
+
+func TestNewRoom_Floor(t *testing.T) {
+	orgID := OrganizationID(uuid.New())
+	building, err := NewBuilding(orgID, "本館", "")
+	require.NoError(t, err)
+	other, err := NewBuilding(orgID, "西館", "")
+	require.NoError(t, err)
+	floor, err := NewFloor(building, "B1F", -1)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		building Building
+		wantErr  bool
+	}{
+		{name: "正常系: 建物名と階は階から決まる", building: building},
+		{name: "異常系: 階が別の建物のもの", building: other, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			room, err := NewRoom(orgID, "工作室B", tt.building, floor, RoomTypeWorkshop, "", RoomAttributes{})
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, floor.ID, room.FloorID)
+			assert.Equal(t, BuildingName("本館"), room.BuildingName)
+			assert.Equal(t, FloorNumber("B1F"), room.FloorNumber)
+		})
+	}
+}
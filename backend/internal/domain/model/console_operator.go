@@ -0,0 +1,144 @@
+package model
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+)
+
+// ConsoleOperatorKeyPrefix はコンソール管理者のログインキーの接頭辞。Organization Keyと見分けるために使う
+const ConsoleOperatorKeyPrefix = "kop_"
+
+type ConsoleOperatorID uuid.UUID
+
+func (id ConsoleOperatorID) UUID() uuid.UUID {
+	return uuid.UUID(id)
+}
+
+func (id ConsoleOperatorID) String() string {
+	return uuid.UUID(id).String()
+}
+
+func ParseConsoleOperatorID(value string) (ConsoleOperatorID, error) {
+	u, err := uuid.Parse(value)
+	if err != nil {
+		return ConsoleOperatorID{}, errors.WithHint(
+			errors.Wrap(err, "failed to parse console operator ID"),
+			"管理者IDの形式が正しくありません。",
+		)
+	}
+	return ConsoleOperatorID(u), nil
+}
+
+type ConsoleOperatorName string
+
+func (n ConsoleOperatorName) String() string {
+	return string(n)
+}
+
+func (n ConsoleOperatorName) Validate() error {
+	if n == "" {
+		return errors.WithHint(
+			errors.New("console operator name is required"),
+			"管理者名は必須です。",
+		)
+	}
+
+	if utf8.RuneCountInString(string(n)) > 50 {
+		return errors.WithHint(
+			errors.New("console operator name must be within 50 characters"),
+			"管理者名は50文字以内で入力してください。",
+		)
+	}
+	return nil
+}
+
+func NewConsoleOperatorName(value string) (ConsoleOperatorName, error) {
+	n := ConsoleOperatorName(value)
+	if err := n.Validate(); err != nil {
+		return "", err
+	}
+	return n, nil
+}
+
+// ConsoleOperatorKey はコンソール管理者がログインに使うキー。発行時に一度だけ返し、サーバーにはハッシュのみ保存する
+type ConsoleOperatorKey string
+
+func (k ConsoleOperatorKey) String() string {
+	return string(k)
+}
+
+// Hash はキーの保存・照合に使うハッシュを返す。Organization Keyと同じくソルトなしのSHA-256で照合する
+func (k ConsoleOperatorKey) Hash() string {
+	sum := sha256.Sum256([]byte(k))
+	return hex.EncodeToString(sum[:])
+}
+
+func GenerateConsoleOperatorKey() (ConsoleOperatorKey, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "failed to generate console operator key")
+	}
+	return ConsoleOperatorKey(ConsoleOperatorKeyPrefix + hex.EncodeToString(b)), nil
+}
+
+// IsConsoleOperatorKey はコンソール管理者のキーの形式かどうかを判定する
+func IsConsoleOperatorKey(key string) bool {
+	return strings.HasPrefix(key, ConsoleOperatorKeyPrefix)
+}
+
+// ConsoleOperator は組織のキーを共有せずにコンソールへログインするための管理者。
+// owner は組織のキーでログインした場合のみ与え、管理者には割り当てられない
+type ConsoleOperator struct {
+	ID             ConsoleOperatorID
+	OrganizationID OrganizationID
+	Name           ConsoleOperatorName
+	Role           ConsoleRole
+	CreatedAt      time.Time
+	RevokedAt      *time.Time
+}
+
+func (o ConsoleOperator) Validate() error {
+	if err := o.OrganizationID.Validate(); err != nil {
+		return errors.Wrap(err, "console operator must belong to an organization")
+	}
+
+	if err := o.Name.Validate(); err != nil {
+		return err
+	}
+
+	if err := o.Role.Validate(); err != nil {
+		return err
+	}
+
+	if o.Role == ConsoleRoleOwner {
+		return errors.WithHint(
+			errors.New("owner role cannot be assigned to a console operator"),
+			"owner ロールは管理者に割り当てられません。",
+		)
+	}
+
+	return nil
+}
+
+func NewConsoleOperator(organizationID OrganizationID, name ConsoleOperatorName, role ConsoleRole) (ConsoleOperator, error) {
+	operator := ConsoleOperator{
+		ID:             ConsoleOperatorID(uuid.New()),
+		OrganizationID: organizationID,
+		Name:           name,
+		Role:           role,
+		CreatedAt:      time.Now(),
+	}
+
+	if err := operator.Validate(); err != nil {
+		return ConsoleOperator{}, err
+	}
+
+	return operator, nil
+}
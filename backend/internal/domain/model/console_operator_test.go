@@ -0,0 +1,102 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConsoleRoleHasPermission(t *testing.T) {
+	tests := []struct {
+		name       string
+		role       ConsoleRole
+		permission ConsolePermission
+		want       bool
+	}{
+		{
+			name:       "正常系: owner は管理者を管理できる",
+			role:       ConsoleRoleOwner,
+			permission: ConsolePermissionOperatorsManage,
+			want:       true,
+		},
+		{
+			name:       "正常系: admin はテナントを管理できる",
+			role:       ConsoleRoleAdmin,
+			permission: ConsolePermissionTenantsManage,
+			want:       true,
+		},
+		{
+			name:       "異常系: admin は管理者を管理できない",
+			role:       ConsoleRoleAdmin,
+			permission: ConsolePermissionOperatorsManage,
+			want:       false,
+		},
+		{
+			name:       "正常系: operator は鍵を管理できる",
+			role:       ConsoleRoleOperator,
+			permission: ConsolePermissionKeysManage,
+			want:       true,
+		},
+		{
+			name:       "異常系: operator はテナントを管理できない",
+			role:       ConsoleRoleOperator,
+			permission: ConsolePermissionTenantsManage,
+			want:       false,
+		},
+		{
+			name:       "異常系: auditor は部屋を管理できない",
+			role:       ConsoleRoleAuditor,
+			permission: ConsolePermissionRoomsManage,
+			want:       false,
+		},
+		{
+			name:       "異常系: 未知のロールは何もできない",
+			role:       ConsoleRole("guest"),
+			permission: ConsolePermissionAuditRead,
+			want:       false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.role.HasPermission(tt.permission))
+		})
+	}
+}
+
+func TestNewConsoleOperator(t *testing.T) {
+	orgID := OrganizationID(uuid.New())
+
+	tests := []struct {
+		name    string
+		role    ConsoleRole
+		wantErr bool
+	}{
+		{
+			name: "正常系: admin の管理者を作成できる",
+			role: ConsoleRoleAdmin,
+		},
+		{
+			name:    "異常系: owner は管理者に割り当てられない",
+			role:    ConsoleRoleOwner,
+			wantErr: true,
+		},
+		{
+			name:    "異常系: 未知のロール",
+			role:    ConsoleRole("guest"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewConsoleOperator(orgID, ConsoleOperatorName("受付担当"), tt.role)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package model
+
+import (
+	"slices"
+
+	"github.com/cockroachdb/errors"
+)
+
+// ConsolePermission はコンソールで行える操作の種類。"リソース.操作" の形式
+type ConsolePermission string
+
+const (
+	ConsolePermissionTenantsManage   ConsolePermission = "tenants.manage"
+	ConsolePermissionRoomsManage     ConsolePermission = "rooms.manage"
+	ConsolePermissionKeysManage      ConsolePermission = "keys.manage"
+	ConsolePermissionAuditRead       ConsolePermission = "audit.read"
+	ConsolePermissionSessionsManage  ConsolePermission = "sessions.manage"
+	ConsolePermissionAPITokensManage ConsolePermission = "api_tokens.manage"
+	ConsolePermissionOperatorsManage ConsolePermission = "operators.manage"
+	ConsolePermissionWebhooksManage  ConsolePermission = "webhooks.manage"
+)
+
+func (p ConsolePermission) String() string {
+	return string(p)
+}
+
+// ConsoleRole はコンソール管理者に割り当てるロール。ロールごとに許可する権限が決まっている
+type ConsoleRole string
+
+const (
+	// ConsoleRoleOwner は組織のキーでログインした管理者。すべての操作を行える
+	ConsoleRoleOwner    ConsoleRole = "owner"
+	ConsoleRoleAdmin    ConsoleRole = "admin"
+	ConsoleRoleOperator ConsoleRole = "operator"
+	ConsoleRoleAuditor  ConsoleRole = "auditor"
+)
+
+var consoleRolePermissions = map[ConsoleRole][]ConsolePermission{
+	ConsoleRoleOwner: {
+		ConsolePermissionTenantsManage,
+		ConsolePermissionRoomsManage,
+		ConsolePermissionKeysManage,
+		ConsolePermissionAuditRead,
+		ConsolePermissionSessionsManage,
+		ConsolePermissionAPITokensManage,
+		ConsolePermissionOperatorsManage,
+		ConsolePermissionWebhooksManage,
+	},
+	ConsoleRoleAdmin: {
+		ConsolePermissionTenantsManage,
+		ConsolePermissionRoomsManage,
+		ConsolePermissionKeysManage,
+		ConsolePermissionAuditRead,
+		ConsolePermissionSessionsManage,
+	},
+	ConsoleRoleOperator: {
+		ConsolePermissionRoomsManage,
+		ConsolePermissionKeysManage,
+	},
+	ConsoleRoleAuditor: {
+		ConsolePermissionAuditRead,
+	},
+}
+
+func (r ConsoleRole) String() string {
+	return string(r)
+}
+
+func (r ConsoleRole) Validate() error {
+	if _, ok := consoleRolePermissions[r]; !ok {
+		return errors.WithHintf(
+			errors.New("invalid console role"),
+			"無効なロールです: %s", r,
+		)
+	}
+	return nil
+}
+
+func NewConsoleRole(value string) (ConsoleRole, error) {
+	r := ConsoleRole(value)
+	if err := r.Validate(); err != nil {
+		return "", err
+	}
+	return r, nil
+}
+
+// Permissions はロールに許可された権限の一覧を返す
+func (r ConsoleRole) Permissions() []ConsolePermission {
+	return slices.Clone(consoleRolePermissions[r])
+}
+
+func (r ConsoleRole) HasPermission(permission ConsolePermission) bool {
+	return slices.Contains(consoleRolePermissions[r], permission)
+}
@@ -33,8 +33,14 @@ func NewConsoleSessionID(value string) (ConsoleSessionID, error) {
 type ConsoleSession struct {
 	SessionID      ConsoleSessionID
 	OrganizationID OrganizationID
-	CreatedAt      time.Time
-	ExpiresAt      time.Time
+	// Role はセッションで許可する操作の範囲。組織のキーでログインした場合は owner
+	Role ConsoleRole
+	// OperatorID は管理者のキーでログインした場合の管理者。組織のキーでログインした場合は nil
+	OperatorID *ConsoleOperatorID
+	CreatedAt  time.Time
+	ExpiresAt  time.Time
+	Client     SessionClient
+	LastSeenAt time.Time
 }
 
 func (cs ConsoleSession) String() string {
@@ -50,6 +56,10 @@ func (cs ConsoleSession) Validate() error {
 		return err
 	}
 
+	if err := cs.Role.Validate(); err != nil {
+		return err
+	}
+
 	if cs.CreatedAt.IsZero() {
 		return errors.WithHint(
 			errors.New("created_at is required"),
@@ -74,16 +84,26 @@ func (cs ConsoleSession) Validate() error {
 	return nil
 }
 
+// ShouldTouch は最終アクセス日時やクライアント情報を更新すべきかを判定する
+func (cs ConsoleSession) ShouldTouch(client SessionClient) bool {
+	return shouldTouchSession(cs.LastSeenAt, cs.Client, client)
+}
+
 func (cs ConsoleSession) IsExpired() bool {
 	return time.Now().After(cs.ExpiresAt)
 }
 
-func NewConsoleSession(sessionID ConsoleSessionID, organizationID OrganizationID, expiresAt time.Time) (ConsoleSession, error) {
+func NewConsoleSession(sessionID ConsoleSessionID, organizationID OrganizationID, role ConsoleRole, operatorID *ConsoleOperatorID, expiresAt time.Time, client SessionClient) (ConsoleSession, error) {
+	now := time.Now()
 	session := ConsoleSession{
 		SessionID:      sessionID,
 		OrganizationID: organizationID,
-		CreatedAt:      time.Now(),
+		Role:           role,
+		OperatorID:     operatorID,
+		CreatedAt:      now,
 		ExpiresAt:      expiresAt,
+		Client:         client,
+		LastSeenAt:     now,
 	}
 
 	if err := session.Validate(); err != nil {
@@ -0,0 +1,172 @@
+package model
+
+import (
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// DomainEventType はドメインイベントの種類。Webhookなどの購読者にはこの名前で届く
+type DomainEventType string
+
+const (
+	DomainEventTenantCreated  DomainEventType = "tenant.created"
+	DomainEventTenantArchived DomainEventType = "tenant.archived"
+	DomainEventTenantDeleted  DomainEventType = "tenant.deleted"
+	DomainEventMemberJoined   DomainEventType = "tenant.member_joined"
+	DomainEventRoomAssigned   DomainEventType = "room.assigned"
+	DomainEventKeyCreated     DomainEventType = "key.created"
+)
+
+// DomainEventTypes は購読できるイベントの種類の一覧
+func DomainEventTypes() []DomainEventType {
+	return []DomainEventType{
+		DomainEventTenantCreated,
+		DomainEventTenantArchived,
+		DomainEventTenantDeleted,
+		DomainEventMemberJoined,
+		DomainEventRoomAssigned,
+		DomainEventKeyCreated,
+	}
+}
+
+func (t DomainEventType) String() string {
+	return string(t)
+}
+
+func (t DomainEventType) Validate() error {
+	switch t {
+	case DomainEventTenantCreated, DomainEventTenantArchived, DomainEventTenantDeleted, DomainEventMemberJoined, DomainEventRoomAssigned,
+		DomainEventKeyCreated:
+		return nil
+	default:
+		return errors.WithHintf(
+			errors.Newf("invalid domain event type: %s", t),
+			"無効なイベントの種類です: %s", t,
+		)
+	}
+}
+
+// DomainEvent は組織内で起きた業務上の出来事。
+// 実装はそのままJSONにしてイベントのペイロードとするため、公開フィールドにはJSONのタグを付ける
+type DomainEvent interface {
+	EventType() DomainEventType
+	EventOrganizationID() OrganizationID
+}
+
+type TenantCreated struct {
+	organizationID OrganizationID
+	TenantID       string `json:"tenant_id"`
+	Name           string `json:"name"`
+}
+
+func NewTenantCreatedEvent(tenant Tenant) TenantCreated {
+	return TenantCreated{
+		organizationID: tenant.OrganizationID,
+		TenantID:       tenant.ID.String(),
+		Name:           tenant.Name.String(),
+	}
+}
+
+func (e TenantCreated) EventType() DomainEventType          { return DomainEventTenantCreated }
+func (e TenantCreated) EventOrganizationID() OrganizationID { return e.organizationID }
+
+// TenantArchived はテナントがアーカイブされたこと。EndedAssignments は終了した部屋の割り当ての件数
+type TenantArchived struct {
+	organizationID   OrganizationID
+	TenantID         string    `json:"tenant_id"`
+	Name             string    `json:"name"`
+	ArchivedAt       time.Time `json:"archived_at"`
+	EndedAssignments int64     `json:"ended_assignments"`
+}
+
+func NewTenantArchivedEvent(tenant Tenant, endedAssignments int64) TenantArchived {
+	e := TenantArchived{
+		organizationID:   tenant.OrganizationID,
+		TenantID:         tenant.ID.String(),
+		Name:             tenant.Name.String(),
+		EndedAssignments: endedAssignments,
+	}
+	if tenant.ArchivedAt != nil {
+		e.ArchivedAt = *tenant.ArchivedAt
+	}
+	return e
+}
+
+func (e TenantArchived) EventType() DomainEventType          { return DomainEventTenantArchived }
+func (e TenantArchived) EventOrganizationID() OrganizationID { return e.organizationID }
+
+// TenantDeleted はテナントが削除されたこと。削除後は参照できないため名前を含める
+type TenantDeleted struct {
+	organizationID OrganizationID
+	TenantID       string `json:"tenant_id"`
+	Name           string `json:"name"`
+}
+
+func NewTenantDeletedEvent(tenant Tenant) TenantDeleted {
+	return TenantDeleted{
+		organizationID: tenant.OrganizationID,
+		TenantID:       tenant.ID.String(),
+		Name:           tenant.Name.String(),
+	}
+}
+
+func (e TenantDeleted) EventType() DomainEventType          { return DomainEventTenantDeleted }
+func (e TenantDeleted) EventOrganizationID() OrganizationID { return e.organizationID }
+
+type MemberJoined struct {
+	organizationID OrganizationID
+	TenantID       string `json:"tenant_id"`
+	UserID         string `json:"user_id"`
+}
+
+func NewMemberJoinedEvent(organizationID OrganizationID, membership TenantMembership) MemberJoined {
+	return MemberJoined{
+		organizationID: organizationID,
+		TenantID:       membership.TenantID.String(),
+		UserID:         membership.UserID.String(),
+	}
+}
+
+func (e MemberJoined) EventType() DomainEventType          { return DomainEventMemberJoined }
+func (e MemberJoined) EventOrganizationID() OrganizationID { return e.organizationID }
+
+type RoomAssigned struct {
+	organizationID OrganizationID
+	AssignmentID   string     `json:"assignment_id"`
+	TenantID       string     `json:"tenant_id"`
+	RoomID         string     `json:"room_id"`
+	ExpiresAt      *time.Time `json:"expires_at,omitempty"`
+}
+
+func NewRoomAssignedEvent(organizationID OrganizationID, assignment RoomAssignment) RoomAssigned {
+	return RoomAssigned{
+		organizationID: organizationID,
+		AssignmentID:   assignment.ID.String(),
+		TenantID:       assignment.TenantID.String(),
+		RoomID:         assignment.RoomID.String(),
+		ExpiresAt:      assignment.ExpiresAt,
+	}
+}
+
+func (e RoomAssigned) EventType() DomainEventType          { return DomainEventRoomAssigned }
+func (e RoomAssigned) EventOrganizationID() OrganizationID { return e.organizationID }
+
+type KeyCreated struct {
+	organizationID OrganizationID
+	KeyID          string `json:"key_id"`
+	RoomID         string `json:"room_id"`
+	KeyNumber      string `json:"key_number"`
+}
+
+func NewKeyCreatedEvent(key Key) KeyCreated {
+	return KeyCreated{
+		organizationID: key.OrganizationID,
+		KeyID:          key.ID.String(),
+		RoomID:         key.RoomID.String(),
+		KeyNumber:      key.KeyNumber.String(),
+	}
+}
+
+func (e KeyCreated) EventType() DomainEventType          { return DomainEventKeyCreated }
+func (e KeyCreated) EventOrganizationID() OrganizationID { return e.organizationID }
@@ -99,8 +99,10 @@ type Key struct {
 	OrganizationID OrganizationID
 	KeyNumber      KeyNumber
 	Status         KeyStatus
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
+	// Version は更新するたびに増える版数。更新時に取得時の版数と比べて同時編集による上書きを防ぐ
+	Version   int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
 
 func (k Key) Validate() error {
@@ -145,6 +147,7 @@ func NewKey(
 		OrganizationID: organizationID,
 		KeyNumber:      keyNumber,
 		Status:         KeyStatusAvailable,
+		Version:        1,
 		CreatedAt:      now,
 		UpdatedAt:      now,
 	}
@@ -0,0 +1,34 @@
+package model
+
+import "github.com/cockroachdb/errors"
+
+// KeyChangeOperation は鍵に対して行われた変更の種類
+type KeyChangeOperation string
+
+const (
+	KeyChangeOperationCreated KeyChangeOperation = "created"
+	KeyChangeOperationUpdated KeyChangeOperation = "updated"
+	KeyChangeOperationDeleted KeyChangeOperation = "deleted"
+)
+
+func (o KeyChangeOperation) String() string {
+	return string(o)
+}
+
+func (o KeyChangeOperation) Validate() error {
+	switch o {
+	case KeyChangeOperationCreated, KeyChangeOperationUpdated, KeyChangeOperationDeleted:
+		return nil
+	default:
+		return errors.WithHintf(
+			errors.New("invalid key change operation"),
+			"無効な鍵の変更の種類です: %s", o,
+		)
+	}
+}
+
+// KeyChange は鍵の追加・更新・削除。Key は変更後の状態で、削除の場合は削除前の状態
+type KeyChange struct {
+	Operation KeyChangeOperation
+	Key       Key
+}
@@ -0,0 +1,159 @@
+package model
+
+import (
+	"slices"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// NotificationKind はユーザーへ送る通知の種類。ユーザーは種類ごとにメールの受け取りを止められる
+type NotificationKind string
+
+const (
+	// NotificationKindMemberJoined はテナントにメンバーが参加したことを、テナントの管理者に知らせる
+	NotificationKindMemberJoined NotificationKind = "member_joined"
+	// NotificationKindRoomAssigned はテナントに部屋が割り当てられたことを、テナントのメンバーに知らせる
+	NotificationKindRoomAssigned NotificationKind = "room_assigned"
+	// NotificationKindRoomAssignmentExpiring は部屋の割り当ての期限が近いことを、テナントのメンバーに知らせる
+	NotificationKindRoomAssignmentExpiring NotificationKind = "room_assignment_expiring"
+	// NotificationKindTenantArchived はテナントがアーカイブされたことを、テナントのメンバーに知らせる
+	NotificationKindTenantArchived NotificationKind = "tenant_archived"
+)
+
+// NotificationKinds はユーザーが設定できる通知の種類の一覧
+func NotificationKinds() []NotificationKind {
+	return []NotificationKind{
+		NotificationKindMemberJoined,
+		NotificationKindRoomAssigned,
+		NotificationKindRoomAssignmentExpiring,
+		NotificationKindTenantArchived,
+	}
+}
+
+func (k NotificationKind) String() string {
+	return string(k)
+}
+
+func (k NotificationKind) Validate() error {
+	if !slices.Contains(NotificationKinds(), k) {
+		return errors.WithHintf(
+			errors.New("invalid notification kind"),
+			"無効な通知の種類です: %s", k,
+		)
+	}
+	return nil
+}
+
+// NotificationLocale は通知の言語。空の場合は組織の言語設定に従う
+type NotificationLocale string
+
+func (l NotificationLocale) String() string {
+	return string(l)
+}
+
+func (l NotificationLocale) Validate() error {
+	switch l {
+	case "", OrganizationLocaleJa, OrganizationLocaleEn:
+		return nil
+	default:
+		return errors.WithHintf(
+			errors.New("invalid notification locale"),
+			"無効な言語です: %s", l,
+		)
+	}
+}
+
+// NotificationPreferences はユーザーごとの通知設定。
+// 設定を保存していないユーザーは、組織の言語ですべての通知を受け取る
+type NotificationPreferences struct {
+	UserID        UserID
+	Locale        NotificationLocale
+	DisabledKinds []NotificationKind
+	UpdatedAt     time.Time
+}
+
+// DefaultNotificationPreferences は設定を保存していないユーザーの通知設定
+func DefaultNotificationPreferences(userID UserID) NotificationPreferences {
+	return NotificationPreferences{
+		UserID: userID,
+	}
+}
+
+func NewNotificationPreferences(userID UserID, locale string, disabledKinds []string) (NotificationPreferences, error) {
+	preferences := NotificationPreferences{
+		UserID:        userID,
+		Locale:        NotificationLocale(locale),
+		DisabledKinds: make([]NotificationKind, 0, len(disabledKinds)),
+		UpdatedAt:     time.Now(),
+	}
+	for _, k := range disabledKinds {
+		preferences.DisabledKinds = append(preferences.DisabledKinds, NotificationKind(k))
+	}
+	slices.Sort(preferences.DisabledKinds)
+	preferences.DisabledKinds = slices.Compact(preferences.DisabledKinds)
+
+	if err := preferences.Validate(); err != nil {
+		return NotificationPreferences{}, err
+	}
+	return preferences, nil
+}
+
+func (p NotificationPreferences) Validate() error {
+	if err := p.Locale.Validate(); err != nil {
+		return err
+	}
+	for _, k := range p.DisabledKinds {
+		if err := k.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Enabled は指定した種類の通知をメールで受け取るかを返す
+func (p NotificationPreferences) Enabled(kind NotificationKind) bool {
+	return !slices.Contains(p.DisabledKinds, kind)
+}
+
+// ResolveLocale は通知に使う言語を返す。ユーザーが言語を選んでいなければ組織の言語を使う
+func (p NotificationPreferences) ResolveLocale(settings OrganizationSettings) string {
+	if p.Locale != "" {
+		return p.Locale.String()
+	}
+	return settings.WithDefaults().Locale
+}
+
+// NotificationRecipient は通知の宛先となるユーザーとその通知設定
+type NotificationRecipient struct {
+	User        User
+	Preferences NotificationPreferences
+}
+
+// NotificationDelivery はユーザーへの通知の送信記録。
+// DedupKey が同じ通知は1度しか送らないため、イベントの再配送や定期確認の重複でメールが重ならない
+type NotificationDelivery struct {
+	DedupKey       string
+	OrganizationID OrganizationID
+	UserID         UserID
+	Kind           NotificationKind
+	CreatedAt      time.Time
+}
+
+// NewNotificationDelivery の sourceID は通知のもとになったイベントや部屋の割り当てのID
+func NewNotificationDelivery(organizationID OrganizationID, userID UserID, kind NotificationKind, sourceID string) NotificationDelivery {
+	return NotificationDelivery{
+		DedupKey:       kind.String() + ":" + sourceID + ":" + userID.String(),
+		OrganizationID: organizationID,
+		UserID:         userID,
+		Kind:           kind,
+		CreatedAt:      time.Now(),
+	}
+}
+
+// Mail はユーザーへ送るメール。本文はプレーンテキスト
+type Mail struct {
+	To      UserEmail
+	Subject string
+	Body    string
+}
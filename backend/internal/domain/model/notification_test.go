@@ -0,0 +1,71 @@
+package model
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewNotificationPreferences(t *testing.T) {
+	userID := UserID(uuid.New())
+
+	tests := []struct {
+		name          string
+		locale        string
+		disabledKinds []string
+		want          []NotificationKind
+		wantErr       bool
+	}{
+		{
+			name:          "正常系: 重複を取り除いて並べる",
+			locale:        "en",
+			disabledKinds: []string{"room_assigned", "member_joined", "room_assigned"},
+			want:          []NotificationKind{NotificationKindMemberJoined, NotificationKindRoomAssigned},
+		},
+		{
+			name:   "正常系: 言語を空にすると組織の設定に従う",
+			locale: "",
+			want:   []NotificationKind{},
+		},
+		{
+			name:    "異常系: 未対応の言語",
+			locale:  "fr",
+			wantErr: true,
+		},
+		{
+			name:          "異常系: 存在しない通知の種類",
+			disabledKinds: []string{"key_overdue"},
+			wantErr:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewNotificationPreferences(userID, tt.locale, tt.disabledKinds)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.DisabledKinds)
+			for _, k := range NotificationKinds() {
+				assert.Equal(t, !slices.Contains(tt.want, k), got.Enabled(k))
+			}
+		})
+	}
+}
+
+func TestNotificationPreferences_ResolveLocale(t *testing.T) {
+	userID := UserID(uuid.New())
+	en := OrganizationSettings{Locale: OrganizationLocaleEn}
+
+	assert.Equal(t, "en", DefaultNotificationPreferences(userID).ResolveLocale(en), "未設定なら組織の言語")
+	assert.Equal(t, "ja", DefaultNotificationPreferences(userID).ResolveLocale(OrganizationSettings{}), "組織も未設定なら既定の言語")
+
+	preferences, err := NewNotificationPreferences(userID, "ja", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "ja", preferences.ResolveLocale(en), "ユーザーの選んだ言語を優先する")
+}
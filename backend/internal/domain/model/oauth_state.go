@@ -34,8 +34,10 @@ type OAuthState struct {
 	State        OAuthStateValue
 	CodeVerifier string
 	Nonce        string
-	CreatedAt    time.Time
-	ConsumedAt   *time.Time
+	// OrganizationID はログイン開始時に選んだ組織。コールバックで発行するセッションに引き継ぐ
+	OrganizationID OrganizationID
+	CreatedAt      time.Time
+	ConsumedAt     *time.Time
 }
 
 func (s OAuthState) Validate() error {
@@ -57,6 +59,10 @@ func (s OAuthState) Validate() error {
 		)
 	}
 
+	if err := s.OrganizationID.Validate(); err != nil {
+		return err
+	}
+
 	if s.CreatedAt.IsZero() {
 		return errors.WithHint(
 			errors.New("created_at is required"),
@@ -107,13 +113,15 @@ func NewOAuthState(
 	state OAuthStateValue,
 	codeVerifier string,
 	nonce string,
+	organizationID OrganizationID,
 ) (OAuthState, error) {
 	oauthState := OAuthState{
-		State:        state,
-		CodeVerifier: codeVerifier,
-		Nonce:        nonce,
-		CreatedAt:    time.Now(),
-		ConsumedAt:   nil,
+		State:          state,
+		CodeVerifier:   codeVerifier,
+		Nonce:          nonce,
+		OrganizationID: organizationID,
+		CreatedAt:      time.Now(),
+		ConsumedAt:     nil,
 	}
 
 	if err := oauthState.Validate(); err != nil {
@@ -1,12 +1,20 @@
 package model
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"time"
 	"unicode/utf8"
 
 	"github.com/cockroachdb/errors"
 	"github.com/google/uuid"
 )
 
+// OrganizationKeyPrefix はプラットフォーム管理者が発行するOrganization Keyの接頭辞
+const OrganizationKeyPrefix = "kho_"
+
 type OrganizationID uuid.UUID
 
 func (id OrganizationID) UUID() uuid.UUID {
@@ -35,6 +43,17 @@ func NewOrganizationID(id uuid.UUID) (OrganizationID, error) {
 	return orgID, nil
 }
 
+func ParseOrganizationID(value string) (OrganizationID, error) {
+	u, err := uuid.Parse(value)
+	if err != nil {
+		return OrganizationID{}, errors.WithHint(
+			errors.Wrap(err, "failed to parse organization ID"),
+			"組織IDの形式が正しくありません。",
+		)
+	}
+	return NewOrganizationID(u)
+}
+
 type OrganizationKey string
 
 func (k OrganizationKey) String() string {
@@ -49,11 +68,10 @@ func (k OrganizationKey) Validate() error {
 		)
 	}
 
-	length := utf8.RuneCountInString(string(k))
-	if length < 1 || length > 20 {
+	if utf8.RuneCountInString(string(k)) > 100 {
 		return errors.WithHint(
-			errors.New("Please enter an organization key between 1 and 200 characters"),
-			"Organization Keyは1文字以上20文字以内で入力してください。",
+			errors.New("Please enter an organization key within 100 characters"),
+			"Organization Keyは100文字以内で入力してください。",
 		)
 	}
 
@@ -68,7 +86,180 @@ func NewOrganizationKey(value string) (OrganizationKey, error) {
 	return k, nil
 }
 
+// GenerateOrganizationKey は組織のログインに使うキーを生成する。
+// キーは発行時に一度だけプラットフォーム管理者へ返し、サーバーにはハッシュのみ保存する
+func GenerateOrganizationKey() (OrganizationKey, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "failed to generate organization key")
+	}
+	return OrganizationKey(OrganizationKeyPrefix + hex.EncodeToString(b)), nil
+}
+
+// Hash はOrganization Keyの保存・照合に使うハッシュを返す。
+// 発行するキーは十分なエントロピーを持つため、APIトークンと同じくソルトなしのSHA-256で照合する
+func (k OrganizationKey) Hash() string {
+	sum := sha256.Sum256([]byte(k))
+	return hex.EncodeToString(sum[:])
+}
+
+type OrganizationName string
+
+func (n OrganizationName) String() string {
+	return string(n)
+}
+
+func (n OrganizationName) Validate() error {
+	if n == "" {
+		return errors.WithHint(
+			errors.New("organization name is required"),
+			"組織名は必須です。",
+		)
+	}
+
+	if utf8.RuneCountInString(string(n)) > 100 {
+		return errors.WithHint(
+			errors.New("Please enter an organization name within 100 characters"),
+			"組織名は100文字以内で入力してください。",
+		)
+	}
+	return nil
+}
+
+func NewOrganizationName(value string) (OrganizationName, error) {
+	n := OrganizationName(value)
+	if err := n.Validate(); err != nil {
+		return "", err
+	}
+	return n, nil
+}
+
+var organizationSlugPattern = regexp.MustCompile(`^[a-z0-9](?:[a-z0-9-]{1,61}[a-z0-9])$`)
+
+// OrganizationSlug はURLなどで組織を識別する短い名前。英小文字・数字・ハイフンの3〜63文字
+type OrganizationSlug string
+
+func (s OrganizationSlug) String() string {
+	return string(s)
+}
+
+func (s OrganizationSlug) Validate() error {
+	if s == "" {
+		return errors.WithHint(
+			errors.New("organization slug is required"),
+			"組織のスラッグは必須です。",
+		)
+	}
+
+	if !organizationSlugPattern.MatchString(string(s)) {
+		return errors.WithHint(
+			errors.New("invalid organization slug"),
+			"スラッグは英小文字・数字・ハイフンの3〜63文字で、先頭と末尾は英小文字か数字にしてください。",
+		)
+	}
+	return nil
+}
+
+func NewOrganizationSlug(value string) (OrganizationSlug, error) {
+	s := OrganizationSlug(value)
+	if err := s.Validate(); err != nil {
+		return "", err
+	}
+	return s, nil
+}
+
+const (
+	OrganizationLocaleJa = "ja"
+	OrganizationLocaleEn = "en"
+
+	DefaultOrganizationTimezone = "Asia/Tokyo"
+)
+
+// OrganizationSettings は組織ごとの設定。JSONとして保存する
+type OrganizationSettings struct {
+	// Locale は通知などで使う言語
+	Locale string `json:"locale"`
+	// Timezone は日時を表示する際のタイムゾーン（IANA形式）
+	Timezone string `json:"timezone"`
+}
+
+// DefaultOrganizationSettings は設定を省略した場合の値
+func DefaultOrganizationSettings() OrganizationSettings {
+	return OrganizationSettings{
+		Locale:   OrganizationLocaleJa,
+		Timezone: DefaultOrganizationTimezone,
+	}
+}
+
+// WithDefaults は未設定の項目を既定値で埋める
+func (s OrganizationSettings) WithDefaults() OrganizationSettings {
+	defaults := DefaultOrganizationSettings()
+	if s.Locale == "" {
+		s.Locale = defaults.Locale
+	}
+	if s.Timezone == "" {
+		s.Timezone = defaults.Timezone
+	}
+	return s
+}
+
+func (s OrganizationSettings) Validate() error {
+	switch s.Locale {
+	case OrganizationLocaleJa, OrganizationLocaleEn:
+	default:
+		return errors.WithHintf(
+			errors.New("invalid organization locale"),
+			"無効な言語です: %s", s.Locale,
+		)
+	}
+
+	if _, err := time.LoadLocation(s.Timezone); err != nil || s.Timezone == "" {
+		return errors.WithHintf(
+			errors.New("invalid organization timezone"),
+			"無効なタイムゾーンです: %s", s.Timezone,
+		)
+	}
+	return nil
+}
+
+// Organization はKeyHubを利用する大学などの組織。テナント・部屋・鍵はいずれかの組織に属する
 type Organization struct {
-	ID  OrganizationID
-	Key OrganizationKey
+	ID       OrganizationID
+	Name     OrganizationName
+	Slug     OrganizationSlug
+	Settings OrganizationSettings
+	// HasKey はConsoleにログインするためのキーが発行済みかどうか。キー本体は保存しない
+	HasKey    bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewOrganization(name OrganizationName, slug OrganizationSlug, settings OrganizationSettings) (Organization, error) {
+	now := time.Now()
+	org := Organization{
+		ID:        OrganizationID(uuid.New()),
+		Name:      name,
+		Slug:      slug,
+		Settings:  settings.WithDefaults(),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := org.Validate(); err != nil {
+		return Organization{}, err
+	}
+	return org, nil
+}
+
+func (o Organization) Validate() error {
+	if err := o.ID.Validate(); err != nil {
+		return err
+	}
+	if err := o.Name.Validate(); err != nil {
+		return err
+	}
+	if err := o.Slug.Validate(); err != nil {
+		return err
+	}
+	return o.Settings.Validate()
 }
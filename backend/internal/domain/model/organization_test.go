@@ -0,0 +1,76 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrganizationSlugValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		slug    OrganizationSlug
+		wantErr bool
+	}{
+		{name: "正常系: 英小文字と数字とハイフン", slug: "tokyo-univ-2026"},
+		{name: "正常系: 3文字", slug: "abc"},
+		{name: "正常系: UUID形式（移行した組織）", slug: "550e8400-e29b-41d4-a716-446655440000"},
+		{name: "異常系: 空", slug: "", wantErr: true},
+		{name: "異常系: 2文字", slug: "ab", wantErr: true},
+		{name: "異常系: 大文字", slug: "Tokyo", wantErr: true},
+		{name: "異常系: 先頭がハイフン", slug: "-tokyo", wantErr: true},
+		{name: "異常系: 末尾がハイフン", slug: "tokyo-", wantErr: true},
+		{name: "異常系: 64文字", slug: OrganizationSlug(strings.Repeat("a", 64)), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.slug.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestOrganizationSettingsValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings OrganizationSettings
+		wantErr  bool
+	}{
+		{name: "正常系: 既定値", settings: DefaultOrganizationSettings()},
+		{name: "正常系: 英語とUTC", settings: OrganizationSettings{Locale: OrganizationLocaleEn, Timezone: "UTC"}},
+		{name: "正常系: 未設定の項目は既定値で埋める", settings: OrganizationSettings{Locale: OrganizationLocaleEn}.WithDefaults()},
+		{name: "異常系: 未対応の言語", settings: OrganizationSettings{Locale: "fr", Timezone: DefaultOrganizationTimezone}, wantErr: true},
+		{name: "異常系: 存在しないタイムゾーン", settings: OrganizationSettings{Locale: OrganizationLocaleJa, Timezone: "Asia/Nowhere"}, wantErr: true},
+		{name: "異常系: タイムゾーンが空", settings: OrganizationSettings{Locale: OrganizationLocaleJa}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.settings.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGenerateOrganizationKey(t *testing.T) {
+	key, err := GenerateOrganizationKey()
+	require.NoError(t, err)
+	assert.NoError(t, key.Validate())
+	assert.Contains(t, key.String(), OrganizationKeyPrefix)
+
+	other, err := GenerateOrganizationKey()
+	require.NoError(t, err)
+	assert.NotEqual(t, key.Hash(), other.Hash())
+	assert.Equal(t, key.Hash(), OrganizationKey(key.String()).Hash())
+}
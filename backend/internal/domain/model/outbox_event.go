@@ -0,0 +1,146 @@
+package model
+
+import (
+	"encoding/json"
+	"slices"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+)
+
+// maxOutboxLastErrorLength は記録しておく直近の配送エラーの最大文字数
+const maxOutboxLastErrorLength = 1000
+
+type OutboxEventID uuid.UUID
+
+func (id OutboxEventID) UUID() uuid.UUID {
+	return uuid.UUID(id)
+}
+
+func (id OutboxEventID) String() string {
+	return uuid.UUID(id).String()
+}
+
+// OutboxEventStatus はイベントの配送状況
+type OutboxEventStatus string
+
+const (
+	OutboxEventStatusPending   OutboxEventStatus = "pending"
+	OutboxEventStatusDelivered OutboxEventStatus = "delivered"
+	// OutboxEventStatusDead は再試行の上限に達して配送をあきらめたイベント
+	OutboxEventStatusDead OutboxEventStatus = "dead"
+)
+
+func (s OutboxEventStatus) String() string {
+	return string(s)
+}
+
+// OutboxEvent は業務データと同じトランザクションで記録し、コミット後に配送するドメインイベント。
+// 配送はハンドラーごとに少なくとも1回（at-least-once）。処理を終えたハンドラーは記録して再試行では呼ばないが、
+// 記録する前に止まった場合は重ねて呼ぶため、購読者は ID で重複を除く必要がある
+type OutboxEvent struct {
+	ID             OutboxEventID
+	OrganizationID OrganizationID
+	Type           DomainEventType
+	Payload        json.RawMessage
+	OccurredAt     time.Time
+	Status         OutboxEventStatus
+	Attempts       int32
+	NextAttemptAt  time.Time
+	LastError      string
+	DeliveredAt    *time.Time
+	// CompletedHandlers は処理を終えたハンドラーの名前。再試行ではここにあるハンドラーを呼ばない
+	CompletedHandlers []string
+}
+
+func NewOutboxEvent(event DomainEvent) (OutboxEvent, error) {
+	if err := event.EventType().Validate(); err != nil {
+		return OutboxEvent{}, err
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return OutboxEvent{}, errors.Wrap(err, "failed to marshal domain event")
+	}
+
+	now := time.Now().Truncate(time.Microsecond)
+	return OutboxEvent{
+		ID:             OutboxEventID(uuid.New()),
+		OrganizationID: event.EventOrganizationID(),
+		Type:           event.EventType(),
+		Payload:        payload,
+		OccurredAt:     now,
+		Status:         OutboxEventStatusPending,
+		NextAttemptAt:  now,
+	}, nil
+}
+
+// OutboxRetryPolicy は配送に失敗したイベントの再試行の方針。
+// 待ち時間は BaseDelay から試行のたびに倍になり、MaxDelay で頭打ちになる
+type OutboxRetryPolicy struct {
+	MaxAttempts int32
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+func DefaultOutboxRetryPolicy() OutboxRetryPolicy {
+	return OutboxRetryPolicy{
+		MaxAttempts: 10,
+		BaseDelay:   time.Second,
+		MaxDelay:    time.Hour,
+	}
+}
+
+// Backoff は attempts 回目の失敗の後、次に配送するまでの待ち時間
+func (p OutboxRetryPolicy) Backoff(attempts int32) time.Duration {
+	delay := p.BaseDelay
+	for i := int32(1); i < attempts; i++ {
+		delay *= 2
+		if delay >= p.MaxDelay {
+			return p.MaxDelay
+		}
+	}
+	return min(delay, p.MaxDelay)
+}
+
+// HandlerCompleted は name のハンドラーがこのイベントの処理を終えているかを返す
+func (e OutboxEvent) HandlerCompleted(name string) bool {
+	return slices.Contains(e.CompletedHandlers, name)
+}
+
+// CompleteHandler は name のハンドラーが処理を終えたことを記録したイベントを返す
+func (e OutboxEvent) CompleteHandler(name string) OutboxEvent {
+	if e.HandlerCompleted(name) {
+		return e
+	}
+	e.CompletedHandlers = append(slices.Clone(e.CompletedHandlers), name)
+	return e
+}
+
+// Delivered は配送に成功したイベントを返す
+func (e OutboxEvent) Delivered(now time.Time) OutboxEvent {
+	e.Attempts++
+	e.Status = OutboxEventStatusDelivered
+	e.LastError = ""
+	e.DeliveredAt = &now
+	return e
+}
+
+// Failed は配送に失敗したイベントを返す。再試行の上限に達した場合は dead にする
+func (e OutboxEvent) Failed(cause error, now time.Time, policy OutboxRetryPolicy) OutboxEvent {
+	e.Attempts++
+	e.LastError = cause.Error()
+	if utf8.RuneCountInString(e.LastError) > maxOutboxLastErrorLength {
+		e.LastError = string([]rune(e.LastError)[:maxOutboxLastErrorLength])
+	}
+
+	if e.Attempts >= policy.MaxAttempts {
+		e.Status = OutboxEventStatusDead
+		return e
+	}
+
+	e.NextAttemptAt = now.Add(policy.Backoff(e.Attempts))
+	return e
+}
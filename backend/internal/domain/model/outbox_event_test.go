@@ -0,0 +1,82 @@
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutboxRetryPolicyBackoff(t *testing.T) {
+	policy := OutboxRetryPolicy{MaxAttempts: 10, BaseDelay: time.Second, MaxDelay: time.Minute}
+
+	tests := []struct {
+		name     string
+		attempts int32
+		want     time.Duration
+	}{
+		{name: "正常系: 1回目の失敗後は基本の待ち時間", attempts: 1, want: time.Second},
+		{name: "正常系: 失敗のたびに倍になる", attempts: 4, want: 8 * time.Second},
+		{name: "正常系: 上限で頭打ちになる", attempts: 9, want: time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, policy.Backoff(tt.attempts))
+		})
+	}
+}
+
+func TestOutboxEventFailed(t *testing.T) {
+	policy := OutboxRetryPolicy{MaxAttempts: 3, BaseDelay: time.Second, MaxDelay: time.Minute}
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	key := Key{
+		ID:             KeyID(uuid.New()),
+		RoomID:         RoomID(uuid.New()),
+		OrganizationID: OrganizationID(uuid.New()),
+		KeyNumber:      "A-1",
+	}
+
+	event, err := NewOutboxEvent(NewKeyCreatedEvent(key))
+	require.NoError(t, err)
+	assert.Equal(t, key.OrganizationID, event.OrganizationID)
+	assert.Equal(t, DomainEventKeyCreated, event.Type)
+	assert.JSONEq(t, `{"key_id":"`+key.ID.String()+`","room_id":"`+key.RoomID.String()+`","key_number":"A-1"}`, string(event.Payload))
+
+	tests := []struct {
+		name       string
+		attempts   int32
+		wantStatus OutboxEventStatus
+		wantNext   time.Time
+	}{
+		{
+			name:       "正常系: 上限に達するまでは待ってから再試行する",
+			attempts:   1,
+			wantStatus: OutboxEventStatusPending,
+			wantNext:   now.Add(2 * time.Second),
+		},
+		{
+			name:       "正常系: 上限に達したら dead にする",
+			attempts:   2,
+			wantStatus: OutboxEventStatusDead,
+			wantNext:   event.NextAttemptAt,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := event
+			e.Attempts = tt.attempts
+
+			got := e.Failed(errors.New("connection refused"), now, policy)
+			assert.Equal(t, tt.attempts+1, got.Attempts)
+			assert.Equal(t, tt.wantStatus, got.Status)
+			assert.Equal(t, tt.wantNext, got.NextAttemptAt)
+			assert.Equal(t, "connection refused", got.LastError)
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package model
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultPageSize は一覧で件数を指定しなかった場合の件数
+	DefaultPageSize = 50
+	// MaxPageSize は一覧で1回に返す件数の上限
+	MaxPageSize = 200
+	// pageTokenSecretMinLength はページトークンの署名に使う鍵に求める最低バイト数
+	pageTokenSecretMinLength = 32
+)
+
+// NormalizePageSize は指定された件数を既定値と上限に収める
+func NormalizePageSize(size int32) int32 {
+	switch {
+	case size <= 0:
+		return DefaultPageSize
+	case size > MaxPageSize:
+		return MaxPageSize
+	default:
+		return size
+	}
+}
+
+// SplitPage は指定件数より1件多く取得した結果を、返す分と続きがあるかに分ける
+func SplitPage[T any](items []T, pageSize int32) ([]T, bool) {
+	if len(items) > int(pageSize) {
+		return items[:pageSize], true
+	}
+	return items, false
+}
+
+// ListOrder は一覧の並び順
+type ListOrder string
+
+const (
+	// ListOrderNewest は作成日時の新しい順
+	ListOrderNewest ListOrder = "newest"
+	// ListOrderName は名前の昇順。鍵の一覧では鍵番号の昇順
+	ListOrderName ListOrder = "name"
+)
+
+func (o ListOrder) String() string {
+	return string(o)
+}
+
+func (o ListOrder) Validate() error {
+	switch o {
+	case ListOrderNewest, ListOrderName:
+		return nil
+	default:
+		return errors.WithHintf(
+			errors.New("invalid list order"),
+			"無効な並び順です: %s", o,
+		)
+	}
+}
+
+// ParseListOrder は並び順の文字列を変換する。空の場合は新しい順とする
+func ParseListOrder(value string) (ListOrder, error) {
+	if value == "" {
+		return ListOrderNewest, nil
+	}
+	o := ListOrder(value)
+	if err := o.Validate(); err != nil {
+		return "", err
+	}
+	return o, nil
+}
+
+// PageQuery は一覧の種類・並び順・絞り込み条件から、ページトークンの署名に含める文字列を作る。
+// 空文字の条件は含めない
+func PageQuery(list string, order ListOrder, filters map[string]string) string {
+	values := url.Values{"list": {list}, "order": {order.String()}}
+	for key, value := range filters {
+		if value != "" {
+			values.Set(key, value)
+		}
+	}
+	// Encode はキーの順に並べるため、同じ条件からは常に同じ文字列になる
+	return values.Encode()
+}
+
+// PageCursor は一覧の続きを取得するための位置。
+// 最後に返した行の並び順のキー（作成日時か名前）と、キーが同じ行を区別するIDで表す
+type PageCursor struct {
+	CreatedAt time.Time
+	Name      string
+	ID        uuid.UUID
+}
+
+// pageCursorPayload はページトークンに入れる内容
+type pageCursorPayload struct {
+	CreatedAt int64     `json:"t,omitempty"`
+	Name      string    `json:"n,omitempty"`
+	ID        uuid.UUID `json:"i"`
+}
+
+// PageTokenCodec はカーソルとクライアントへ返すページトークンを相互に変換する。
+// トークンには一覧の種類と検索条件を含めて署名するため、改ざんしたトークンや
+// 別の一覧・条件で発行したトークンは受け付けない
+type PageTokenCodec struct {
+	secret []byte
+}
+
+func NewPageTokenCodec(secret []byte) (PageTokenCodec, error) {
+	if len(secret) < pageTokenSecretMinLength {
+		return PageTokenCodec{}, errors.Newf("page token secret must be at least %d bytes", pageTokenSecretMinLength)
+	}
+	return PageTokenCodec{secret: secret}, nil
+}
+
+// NewRandomPageTokenCodec は起動のたびに作る鍵で署名する。
+// 発行したインスタンスでしかトークンを検証できないため、開発環境向け
+func NewRandomPageTokenCodec() PageTokenCodec {
+	secret := make([]byte, pageTokenSecretMinLength)
+	_, _ = rand.Read(secret)
+	return PageTokenCodec{secret: secret}
+}
+
+// Encode の query は一覧の種類と検索条件を表す文字列。同じ一覧の続きを取得する間は同じ値を渡す
+func (c PageTokenCodec) Encode(query string, cursor PageCursor) string {
+	payload := pageCursorPayload{Name: cursor.Name, ID: cursor.ID}
+	if !cursor.CreatedAt.IsZero() {
+		payload.CreatedAt = cursor.CreatedAt.UnixNano()
+	}
+	// 構造体のJSON変換は失敗しない
+	raw, _ := json.Marshal(payload)
+
+	encoded := base64.RawURLEncoding.EncodeToString(raw)
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(c.sign(query, encoded))
+}
+
+func (c PageTokenCodec) Decode(query, token string) (PageCursor, error) {
+	invalid := func(cause error) error {
+		return errors.WithHint(
+			errors.Wrap(cause, "invalid page token"),
+			"ページトークンが無効です。検索条件を変えた場合は最初のページから取得し直してください。",
+		)
+	}
+
+	encoded, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return PageCursor{}, invalid(errors.New("separator not found"))
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil {
+		return PageCursor{}, invalid(err)
+	}
+	if !hmac.Equal(mac, c.sign(query, encoded)) {
+		return PageCursor{}, invalid(errors.New("signature mismatch"))
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return PageCursor{}, invalid(err)
+	}
+	var payload pageCursorPayload
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return PageCursor{}, invalid(err)
+	}
+
+	cursor := PageCursor{Name: payload.Name, ID: payload.ID}
+	if payload.CreatedAt != 0 {
+		cursor.CreatedAt = time.Unix(0, payload.CreatedAt)
+	}
+	return cursor, nil
+}
+
+func (c PageTokenCodec) sign(query, encoded string) []byte {
+	h := hmac.New(sha256.New, c.secret)
+	h.Write([]byte(query))
+	h.Write([]byte{0})
+	h.Write([]byte(encoded))
+	return h.Sum(nil)
+}
@@ -0,0 +1,133 @@
+package model
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageTokenCodec(t *testing.T) {
+	codec, err := NewPageTokenCodec([]byte("0123456789abcdef0123456789abcdef"))
+	require.NoError(t, err)
+
+	query := PageQuery("rooms", ListOrderNewest, map[string]string{"building_name": "本館"})
+	cursor := PageCursor{
+		CreatedAt: time.Date(2026, 10, 19, 9, 30, 0, 123456000, time.UTC),
+		Name:      "会議室A",
+		ID:        uuid.MustParse("550e8400-e29b-41d4-a716-446655440001"),
+	}
+	token := codec.Encode(query, cursor)
+
+	otherCodec, err := NewPageTokenCodec([]byte("fedcba9876543210fedcba9876543210"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		codec   PageTokenCodec
+		query   string
+		token   string
+		wantErr bool
+	}{
+		{
+			name:  "正常系: 発行したトークンからカーソルを復元できる",
+			codec: codec,
+			query: query,
+			token: token,
+		},
+		{
+			name:    "異常系: 条件が異なる一覧では受け付けない",
+			codec:   codec,
+			query:   PageQuery("rooms", ListOrderNewest, map[string]string{"building_name": "別館"}),
+			token:   token,
+			wantErr: true,
+		},
+		{
+			name:    "異常系: 並び順が異なる一覧では受け付けない",
+			codec:   codec,
+			query:   PageQuery("rooms", ListOrderName, map[string]string{"building_name": "本館"}),
+			token:   token,
+			wantErr: true,
+		},
+		{
+			name:    "異常系: 別の鍵で署名したトークンは受け付けない",
+			codec:   otherCodec,
+			query:   query,
+			token:   token,
+			wantErr: true,
+		},
+		{
+			name:    "異常系: 内容を書き換えたトークンは受け付けない",
+			codec:   codec,
+			query:   query,
+			token:   "e30" + token[strings.Index(token, "."):],
+			wantErr: true,
+		},
+		{
+			name:    "異常系: 形式が不正なトークンは受け付けない",
+			codec:   codec,
+			query:   query,
+			token:   "not-a-token",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.codec.Decode(tt.query, tt.token)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, cursor.CreatedAt.Equal(got.CreatedAt))
+			assert.Equal(t, cursor.Name, got.Name)
+			assert.Equal(t, cursor.ID, got.ID)
+		})
+	}
+}
+
+func TestNewPageTokenCodec(t *testing.T) {
+	_, err := NewPageTokenCodec([]byte("short"))
+	assert.Error(t, err)
+}
+
+func TestPageQuery(t *testing.T) {
+	a := PageQuery("tenants", ListOrderName, map[string]string{"tenant_type": "TENANT_TYPE_TEAM", "name_prefix": ""})
+	b := PageQuery("tenants", ListOrderName, map[string]string{"tenant_type": "TENANT_TYPE_TEAM"})
+	assert.Equal(t, a, b, "空の条件は含めない")
+	assert.NotEqual(t, a, PageQuery("my_tenants", ListOrderName, map[string]string{"tenant_type": "TENANT_TYPE_TEAM"}))
+}
+
+func TestNormalizePageSize(t *testing.T) {
+	tests := []struct {
+		name string
+		size int32
+		want int32
+	}{
+		{name: "正常系: 未指定は既定値", size: 0, want: DefaultPageSize},
+		{name: "正常系: 上限以内はそのまま", size: 10, want: 10},
+		{name: "正常系: 上限を超える場合は上限", size: MaxPageSize + 1, want: MaxPageSize},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, NormalizePageSize(tt.size))
+		})
+	}
+}
+
+func TestParseListOrder(t *testing.T) {
+	got, err := ParseListOrder("")
+	require.NoError(t, err)
+	assert.Equal(t, ListOrderNewest, got)
+
+	got, err = ParseListOrder("name")
+	require.NoError(t, err)
+	assert.Equal(t, ListOrderName, got)
+
+	_, err = ParseListOrder("oldest")
+	assert.Error(t, err)
+}
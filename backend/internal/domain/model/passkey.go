@@ -0,0 +1,198 @@
+package model
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+)
+
+const (
+	// UserIdentityProviderWebAuthn はパスキーで登録したユーザーの user_identities.provider。
+	// provider_sub にはユーザーハンドルを base64url で保持する
+	UserIdentityProviderWebAuthn = "webauthn"
+
+	// PasskeyCeremonyTTL は登録・認証セレモニーを開始してから完了するまでの猶予
+	PasskeyCeremonyTTL = 5 * time.Minute
+
+	// passkeyUserHandleLength はユーザーハンドルのバイト数（WebAuthn の上限は64バイト）
+	passkeyUserHandleLength = 32
+)
+
+type PasskeyID uuid.UUID
+
+func (id PasskeyID) UUID() uuid.UUID {
+	return uuid.UUID(id)
+}
+
+func (id PasskeyID) String() string {
+	return uuid.UUID(id).String()
+}
+
+func ParsePasskeyID(value string) (PasskeyID, error) {
+	u, err := uuid.Parse(value)
+	if err != nil {
+		return PasskeyID{}, errors.WithHint(
+			errors.Wrap(err, "failed to parse passkey ID"),
+			"パスキーIDの形式が正しくありません。",
+		)
+	}
+	return PasskeyID(u), nil
+}
+
+type PasskeyName string
+
+func (n PasskeyName) String() string {
+	return string(n)
+}
+
+func (n PasskeyName) Validate() error {
+	if n == "" {
+		return errors.WithHint(
+			errors.New("passkey name is required"),
+			"パスキー名は必須です。",
+		)
+	}
+
+	if utf8.RuneCountInString(string(n)) > 50 {
+		return errors.WithHint(
+			errors.New("passkey name must be within 50 characters"),
+			"パスキー名は50文字以内で入力してください。",
+		)
+	}
+	return nil
+}
+
+func NewPasskeyName(value string) (PasskeyName, error) {
+	n := PasskeyName(value)
+	if err := n.Validate(); err != nil {
+		return "", err
+	}
+	return n, nil
+}
+
+// PasskeyUserHandle はWebAuthnのユーザーハンドル。認証器に保存され、ログイン時にユーザーを特定するために使う。
+// メールアドレスなどの個人情報を含めないよう、ユーザーごとにランダムな値を割り当てる
+type PasskeyUserHandle []byte
+
+func (h PasskeyUserHandle) String() string {
+	return base64.RawURLEncoding.EncodeToString(h)
+}
+
+func GeneratePasskeyUserHandle() (PasskeyUserHandle, error) {
+	b := make([]byte, passkeyUserHandleLength)
+	if _, err := rand.Read(b); err != nil {
+		return nil, errors.Wrap(err, "failed to generate passkey user handle")
+	}
+	return PasskeyUserHandle(b), nil
+}
+
+func ParsePasskeyUserHandle(value string) (PasskeyUserHandle, error) {
+	b, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil || len(b) == 0 {
+		return nil, errors.WithHint(
+			errors.New("invalid passkey user handle"),
+			"パスキーのユーザーハンドルが正しくありません。",
+		)
+	}
+	return PasskeyUserHandle(b), nil
+}
+
+// Passkey はユーザーに紐づくWebAuthnクレデンシャル
+type Passkey struct {
+	ID              PasskeyID
+	UserID          UserID
+	Name            PasskeyName
+	CredentialID    []byte
+	PublicKey       []byte
+	AttestationType string
+	AAGUID          []byte
+	SignCount       uint32
+	Transports      []string
+	BackupEligible  bool
+	BackupState     bool
+	LastUsedAt      *time.Time
+	CreatedAt       time.Time
+}
+
+func (p Passkey) Validate() error {
+	if err := p.Name.Validate(); err != nil {
+		return err
+	}
+
+	if len(p.CredentialID) == 0 || len(p.PublicKey) == 0 {
+		return errors.WithHint(
+			errors.New("credential ID and public key are required"),
+			"パスキーのクレデンシャル情報が不足しています。",
+		)
+	}
+
+	return nil
+}
+
+type PasskeyCeremonyType string
+
+const (
+	PasskeyCeremonyTypeRegistration PasskeyCeremonyType = "registration"
+	PasskeyCeremonyTypeLogin        PasskeyCeremonyType = "login"
+)
+
+func (t PasskeyCeremonyType) String() string {
+	return string(t)
+}
+
+// PasskeyCeremony は開始済みの登録・認証セレモニー。SessionData にはチャレンジなどの検証用データを保持する
+type PasskeyCeremony struct {
+	ID          string
+	Type        PasskeyCeremonyType
+	UserID      *UserID
+	SessionData []byte
+	ExpiresAt   time.Time
+	CreatedAt   time.Time
+}
+
+// IsExpired はセレモニーの有効期限が切れているかどうかを確認する
+func (c PasskeyCeremony) IsExpired() bool {
+	return time.Now().After(c.ExpiresAt)
+}
+
+// IsValidFor はセレモニーが指定した種類・ユーザーのもので、期限内かどうかを確認する。
+// ログインセレモニーはユーザーが未確定のため userID に nil を渡す
+func (c PasskeyCeremony) IsValidFor(ceremonyType PasskeyCeremonyType, userID *UserID) bool {
+	if c.Type != ceremonyType || c.IsExpired() {
+		return false
+	}
+
+	if userID == nil || c.UserID == nil {
+		return userID == nil && c.UserID == nil
+	}
+	return *c.UserID == *userID
+}
+
+func NewPasskeyCeremony(ceremonyType PasskeyCeremonyType, userID *UserID, sessionData []byte) (PasskeyCeremony, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return PasskeyCeremony{}, errors.Wrap(err, "failed to generate passkey ceremony ID")
+	}
+
+	if len(sessionData) == 0 {
+		return PasskeyCeremony{}, errors.WithHint(
+			errors.New("session data is required"),
+			"セレモニーの検証データは必須です。",
+		)
+	}
+
+	now := time.Now()
+	return PasskeyCeremony{
+		ID:          hex.EncodeToString(b),
+		Type:        ceremonyType,
+		UserID:      userID,
+		SessionData: sessionData,
+		ExpiresAt:   now.Add(PasskeyCeremonyTTL),
+		CreatedAt:   now,
+	}, nil
+}
@@ -0,0 +1,58 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPasskeyCeremonyIsValidFor(t *testing.T) {
+	userID := UserID(uuid.New())
+	otherUserID := UserID(uuid.New())
+
+	registration, err := NewPasskeyCeremony(PasskeyCeremonyTypeRegistration, &userID, []byte(`{}`))
+	require.NoError(t, err)
+
+	login, err := NewPasskeyCeremony(PasskeyCeremonyTypeLogin, nil, []byte(`{}`))
+	require.NoError(t, err)
+
+	expired := login
+	expired.ExpiresAt = time.Now().Add(-time.Second)
+
+	tests := []struct {
+		name         string
+		ceremony     PasskeyCeremony
+		ceremonyType PasskeyCeremonyType
+		userID       *UserID
+		want         bool
+	}{
+		{name: "正常系: 本人の登録セレモニー", ceremony: registration, ceremonyType: PasskeyCeremonyTypeRegistration, userID: &userID, want: true},
+		{name: "正常系: ユーザー未確定のログインセレモニー", ceremony: login, ceremonyType: PasskeyCeremonyTypeLogin, want: true},
+		{name: "異常系: 他人の登録セレモニー", ceremony: registration, ceremonyType: PasskeyCeremonyTypeRegistration, userID: &otherUserID},
+		{name: "異常系: 登録セレモニーをログインに使う", ceremony: registration, ceremonyType: PasskeyCeremonyTypeLogin},
+		{name: "異常系: ログインセレモニーを登録に使う", ceremony: login, ceremonyType: PasskeyCeremonyTypeRegistration, userID: &userID},
+		{name: "異常系: 期限切れ", ceremony: expired, ceremonyType: PasskeyCeremonyTypeLogin},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.ceremony.IsValidFor(tt.ceremonyType, tt.userID))
+		})
+	}
+}
+
+func TestPasskeyUserHandle(t *testing.T) {
+	handle, err := GeneratePasskeyUserHandle()
+	require.NoError(t, err)
+	assert.Len(t, handle, passkeyUserHandleLength)
+
+	parsed, err := ParsePasskeyUserHandle(handle.String())
+	require.NoError(t, err)
+	assert.Equal(t, handle, parsed)
+
+	_, err = ParsePasskeyUserHandle("")
+	assert.Error(t, err)
+}
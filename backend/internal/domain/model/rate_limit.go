@@ -0,0 +1,112 @@
+package model
+
+import (
+	"math"
+	"time"
+)
+
+// RateLimit はトークンバケットの設定。Burst 回まで連続で許可し、Period かけて Burst 回分まで回復する
+type RateLimit struct {
+	Burst  int
+	Period time.Duration
+}
+
+// IsZero は制限が設定されていないかどうかを確認する
+func (l RateLimit) IsZero() bool {
+	return l.Burst <= 0 || l.Period <= 0
+}
+
+// refillPerSecond は1秒あたりに回復するトークン数
+func (l RateLimit) refillPerSecond() float64 {
+	return float64(l.Burst) / l.Period.Seconds()
+}
+
+// RateLimitDecision はリクエストを許可するかどうかの判定結果
+type RateLimitDecision struct {
+	Allowed bool
+	// RetryAfter は拒否した場合に次に許可されるまでの待ち時間
+	RetryAfter time.Duration
+}
+
+// RateLimitBucket はキーごとのトークンバケットの状態
+type RateLimitBucket struct {
+	Tokens    float64
+	UpdatedAt time.Time
+}
+
+// NewRateLimitBucket は満タンのバケットを作成する
+func NewRateLimitBucket(limit RateLimit, now time.Time) RateLimitBucket {
+	return RateLimitBucket{
+		Tokens:    float64(limit.Burst),
+		UpdatedAt: now,
+	}
+}
+
+// Take は now 時点までトークンを補充したうえで1つ消費する。
+// トークンが足りない場合は消費せず、1つ回復するまでの時間を返す
+func (b RateLimitBucket) Take(limit RateLimit, now time.Time) (RateLimitBucket, RateLimitDecision) {
+	elapsed := max(now.Sub(b.UpdatedAt), 0)
+	tokens := math.Min(float64(limit.Burst), b.Tokens+elapsed.Seconds()*limit.refillPerSecond())
+
+	if tokens >= 1 {
+		return RateLimitBucket{Tokens: tokens - 1, UpdatedAt: now}, RateLimitDecision{Allowed: true}
+	}
+
+	wait := time.Duration((1 - tokens) / limit.refillPerSecond() * float64(time.Second))
+	return RateLimitBucket{Tokens: tokens, UpdatedAt: now}, RateLimitDecision{RetryAfter: wait}
+}
+
+// IsIdle はバケットが満タンまで回復しており、破棄しても判定に影響しないかどうかを確認する
+func (b RateLimitBucket) IsIdle(limit RateLimit, now time.Time) bool {
+	return now.Sub(b.UpdatedAt) >= limit.Period
+}
+
+// LockoutPolicy は連続した失敗によるロックアウトの設定。
+// Window 内に MaxFailures 回失敗すると Duration の間すべてのリクエストを拒否する
+type LockoutPolicy struct {
+	MaxFailures int
+	Window      time.Duration
+	Duration    time.Duration
+}
+
+// IsZero はロックアウトが設定されていないかどうかを確認する
+func (p LockoutPolicy) IsZero() bool {
+	return p.MaxFailures <= 0 || p.Duration <= 0
+}
+
+// LoginFailures はキーごとの失敗回数とロック状態
+type LoginFailures struct {
+	Count           int
+	WindowStartedAt time.Time
+	LockedUntil     *time.Time
+}
+
+// Record は失敗を1回記録する。Window を過ぎていれば数え直し、上限に達したらロックする
+func (f LoginFailures) Record(policy LockoutPolicy, now time.Time) LoginFailures {
+	if f.Count == 0 || now.Sub(f.WindowStartedAt) > policy.Window {
+		f.Count = 0
+		f.WindowStartedAt = now
+	}
+
+	f.Count++
+	if f.Count >= policy.MaxFailures {
+		lockedUntil := now.Add(policy.Duration)
+		f.LockedUntil = &lockedUntil
+		f.Count = 0
+	}
+
+	return f
+}
+
+// LockedFor はロック中であれば解除までの残り時間を返す。ロックされていなければ0を返す
+func (f LoginFailures) LockedFor(now time.Time) time.Duration {
+	if f.LockedUntil == nil || !now.Before(*f.LockedUntil) {
+		return 0
+	}
+	return f.LockedUntil.Sub(now)
+}
+
+// IsIdle はロックが解除され、失敗の記録も期限切れで破棄してよいかどうかを確認する
+func (f LoginFailures) IsIdle(policy LockoutPolicy, now time.Time) bool {
+	return f.LockedFor(now) == 0 && now.Sub(f.WindowStartedAt) > policy.Window
+}
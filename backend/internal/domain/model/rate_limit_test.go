@@ -0,0 +1,83 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimitBucketTake(t *testing.T) {
+	limit := RateLimit{Burst: 2, Period: 10 * time.Second}
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	bucket := NewRateLimitBucket(limit, now)
+
+	bucket, decision := bucket.Take(limit, now)
+	assert.True(t, decision.Allowed, "1回目は許可")
+
+	bucket, decision = bucket.Take(limit, now)
+	assert.True(t, decision.Allowed, "Burst までは連続で許可")
+
+	bucket, decision = bucket.Take(limit, now)
+	assert.False(t, decision.Allowed, "Burst を超えると拒否")
+	assert.Equal(t, 5*time.Second, decision.RetryAfter, "1トークン回復するまでの時間")
+
+	_, decision = bucket.Take(limit, now.Add(5*time.Second))
+	assert.True(t, decision.Allowed, "回復後は許可")
+}
+
+func TestRateLimitBucketTakeDoesNotExceedBurst(t *testing.T) {
+	limit := RateLimit{Burst: 3, Period: time.Minute}
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	bucket := RateLimitBucket{Tokens: 0, UpdatedAt: now.Add(-time.Hour)}
+	bucket, _ = bucket.Take(limit, now)
+
+	assert.InDelta(t, 2, bucket.Tokens, 1e-9)
+	assert.True(t, bucket.IsIdle(limit, now.Add(time.Minute)))
+}
+
+func TestLoginFailuresRecord(t *testing.T) {
+	policy := LockoutPolicy{MaxFailures: 3, Window: 10 * time.Minute, Duration: 15 * time.Minute}
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		failures   []time.Duration
+		at         time.Duration
+		wantLocked time.Duration
+	}{
+		{
+			name:     "正常系: 上限未満ではロックしない",
+			failures: []time.Duration{0, time.Minute},
+			at:       2 * time.Minute,
+		},
+		{
+			name:       "正常系: Window 内に上限に達するとロックする",
+			failures:   []time.Duration{0, time.Minute, 2 * time.Minute},
+			at:         2 * time.Minute,
+			wantLocked: 15 * time.Minute,
+		},
+		{
+			name:     "正常系: Window を過ぎた失敗は数え直す",
+			failures: []time.Duration{0, time.Minute, 20 * time.Minute},
+			at:       20 * time.Minute,
+		},
+		{
+			name:     "正常系: ロック期間が過ぎると解除される",
+			failures: []time.Duration{0, time.Minute, 2 * time.Minute},
+			at:       17 * time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var f LoginFailures
+			for _, d := range tt.failures {
+				f = f.Record(policy, now.Add(d))
+			}
+			assert.Equal(t, tt.wantLocked, f.LockedFor(now.Add(tt.at)))
+		})
+	}
+}
@@ -138,15 +138,28 @@ func (t RoomType) String() string {
 	return string(t)
 }
 
-func (t RoomType) Validate() error {
+// IsBuiltin は全組織で使える既定の部屋タイプかを返す
+func (t RoomType) IsBuiltin() bool {
 	switch t {
 	case RoomTypeClassroom, RoomTypeMeetingRoom, RoomTypeLaboratory, RoomTypeOffice, RoomTypeWorkshop, RoomTypeStorage:
+		return true
+	default:
+		return false
+	}
+}
+
+// Validate は部屋タイプの形式を確認する。組織が定義したタイプかどうかは NewRoomType で確認する
+func (t RoomType) Validate() error {
+	switch {
+	case t.IsBuiltin():
 		return nil
-	case RoomTypeUnspecified:
+	case t == RoomTypeUnspecified || t == "":
 		return errors.WithHint(
 			errors.New("room type must be specified"),
 			"部屋タイプを指定してください。",
 		)
+	case typeKeyPattern.MatchString(string(t)):
+		return nil
 	default:
 		return errors.WithHintf(
 			errors.New("invalid room type"),
@@ -155,11 +168,18 @@ func (t RoomType) Validate() error {
 	}
 }
 
-func NewRoomType(value string) (RoomType, error) {
+// NewRoomType は既定の部屋タイプか、組織が catalog に定義した部屋タイプを返す
+func NewRoomType(value string, catalog RoomTypeCatalog) (RoomType, error) {
 	t := RoomType(value)
 	if err := t.Validate(); err != nil {
 		return "", err
 	}
+	if !catalog.Contains(t) {
+		return "", errors.WithHintf(
+			errors.Newf("room type %s is not defined", t),
+			"部屋タイプ %s は定義されていません。", t,
+		)
+	}
 	return t, nil
 }
 
@@ -187,16 +207,21 @@ func NewRoomDescription(value string) (RoomDescription, error) {
 	return d, nil
 }
 
+// Room は部屋。BuildingName と FloorNumber は FloorID の建物と階の名前の写し
 type Room struct {
 	ID             RoomID
 	OrganizationID OrganizationID
 	Name           RoomName
+	FloorID        FloorID
 	BuildingName   BuildingName
 	FloorNumber    FloorNumber
 	Type           RoomType
 	Description    RoomDescription
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
+	Attributes     RoomAttributes
+	// Version は更新するたびに増える版数。更新時に取得時の版数と比べて同時編集による上書きを防ぐ
+	Version   int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
 
 func (r Room) Validate() error {
@@ -224,6 +249,10 @@ func (r Room) Validate() error {
 		return err
 	}
 
+	if err := r.Attributes.Validate(); err != nil {
+		return err
+	}
+
 	if r.CreatedAt.IsZero() {
 		return errors.WithHint(
 			errors.New("created_at is required"),
@@ -241,23 +270,35 @@ func (r Room) Validate() error {
 	return nil
 }
 
+// NewRoom は建物の階に部屋を作成する。floor は building の階である必要がある
 func NewRoom(
 	organizationID OrganizationID,
 	name RoomName,
-	buildingName BuildingName,
-	floorNumber FloorNumber,
+	building Building,
+	floor Floor,
 	roomType RoomType,
 	description RoomDescription,
+	attributes RoomAttributes,
 ) (Room, error) {
+	if floor.BuildingID != building.ID {
+		return Room{}, errors.WithHint(
+			errors.New("floor belongs to another building"),
+			"階は指定した建物の階を指定してください。",
+		)
+	}
+
 	now := time.Now()
 	room := Room{
 		ID:             RoomID(uuid.New()),
 		OrganizationID: organizationID,
 		Name:           name,
-		BuildingName:   buildingName,
-		FloorNumber:    floorNumber,
+		FloorID:        floor.ID,
+		BuildingName:   building.Name,
+		FloorNumber:    floor.Name,
 		Type:           roomType,
 		Description:    description,
+		Attributes:     attributes,
+		Version:        1,
 		CreatedAt:      now,
 		UpdatedAt:      now,
 	}
@@ -28,14 +28,19 @@ func ParseRoomAssignmentID(value string) (RoomAssignmentID, error) {
 	return RoomAssignmentID(u), nil
 }
 
+// RoomAssignment はテナントへの部屋の割り当て。
+// GroupID を指定するとそのグループ（子グループを含む）のメンバーだけが部屋を利用でき、
+// KeyLoanGroupID を指定すると鍵を借りられるのはそのグループのメンバーだけになる
 type RoomAssignment struct {
-	ID         RoomAssignmentID
-	TenantID   TenantID
-	RoomID     RoomID
-	AssignedAt time.Time
-	ExpiresAt  *time.Time
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
+	ID             RoomAssignmentID
+	TenantID       TenantID
+	RoomID         RoomID
+	GroupID        *TenantGroupID
+	KeyLoanGroupID *TenantGroupID
+	AssignedAt     time.Time
+	ExpiresAt      *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
 }
 
 func (ra RoomAssignment) Validate() error {
@@ -73,17 +78,21 @@ func (ra RoomAssignment) Validate() error {
 func NewRoomAssignment(
 	tenantID TenantID,
 	roomID RoomID,
+	groupID *TenantGroupID,
+	keyLoanGroupID *TenantGroupID,
 	expiresAt *time.Time,
 ) (RoomAssignment, error) {
 	now := time.Now()
 	assignment := RoomAssignment{
-		ID:         RoomAssignmentID(uuid.New()),
-		TenantID:   tenantID,
-		RoomID:     roomID,
-		AssignedAt: now,
-		ExpiresAt:  expiresAt,
-		CreatedAt:  now,
-		UpdatedAt:  now,
+		ID:             RoomAssignmentID(uuid.New()),
+		TenantID:       tenantID,
+		RoomID:         roomID,
+		GroupID:        groupID,
+		KeyLoanGroupID: keyLoanGroupID,
+		AssignedAt:     now,
+		ExpiresAt:      expiresAt,
+		CreatedAt:      now,
+		UpdatedAt:      now,
 	}
 
 	if err := assignment.Validate(); err != nil {
@@ -0,0 +1,476 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+const (
+	MaxRoomCapacity       = 10000
+	MaxRoomEquipmentCount = 30
+)
+
+// RoomCapacity は部屋の収容人数。0 は未設定
+type RoomCapacity int32
+
+func (c RoomCapacity) Validate() error {
+	if c < 0 || c > MaxRoomCapacity {
+		return errors.WithHint(
+			errors.Newf("room capacity must be between 0 and %d", MaxRoomCapacity),
+			fmt.Sprintf("収容人数は0〜%d人で入力してください。", MaxRoomCapacity),
+		)
+	}
+	return nil
+}
+
+// Equipment は部屋の設備（"プロジェクター"、"ドラフトチャンバー"、"3Dプリンタ" など）
+type Equipment string
+
+func (e Equipment) String() string {
+	return string(e)
+}
+
+func (e Equipment) Validate() error {
+	if e == "" {
+		return errors.WithHint(
+			errors.New("equipment name is required"),
+			"設備名は必須です。",
+		)
+	}
+
+	if utf8.RuneCountInString(string(e)) > 30 {
+		return errors.WithHint(
+			errors.New("equipment name must be within 30 characters"),
+			"設備名は30文字以内で入力してください。",
+		)
+	}
+	return nil
+}
+
+// NewEquipmentList は前後の空白を除いて重複をまとめた設備の一覧を返す
+func NewEquipmentList(values []string) ([]Equipment, error) {
+	equipment := lo.Uniq(lo.Map(values, func(v string, _ int) Equipment {
+		return Equipment(strings.TrimSpace(v))
+	}))
+
+	if len(equipment) > MaxRoomEquipmentCount {
+		return nil, errors.WithHint(
+			errors.Newf("room equipment must be %d items or less", MaxRoomEquipmentCount),
+			fmt.Sprintf("設備は%d件以内で登録してください。", MaxRoomEquipmentCount),
+		)
+	}
+
+	for _, e := range equipment {
+		if err := e.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return equipment, nil
+}
+
+type RoomAccessibility string
+
+const (
+	RoomAccessibilityWheelchair         RoomAccessibility = "wheelchair"
+	RoomAccessibilityStepFree           RoomAccessibility = "step_free"
+	RoomAccessibilityAccessibleRestroom RoomAccessibility = "accessible_restroom"
+	RoomAccessibilityHearingLoop        RoomAccessibility = "hearing_loop"
+)
+
+func (a RoomAccessibility) String() string {
+	return string(a)
+}
+
+func (a RoomAccessibility) Validate() error {
+	switch a {
+	case RoomAccessibilityWheelchair, RoomAccessibilityStepFree, RoomAccessibilityAccessibleRestroom, RoomAccessibilityHearingLoop:
+		return nil
+	default:
+		return errors.WithHint(
+			errors.Newf("invalid room accessibility: %s", a),
+			"バリアフリー対応の種類が正しくありません。",
+		)
+	}
+}
+
+// NewRoomAccessibilityList は重複をまとめたバリアフリー対応の一覧を返す
+func NewRoomAccessibilityList(values []string) ([]RoomAccessibility, error) {
+	accessibility := lo.Uniq(lo.Map(values, func(v string, _ int) RoomAccessibility {
+		return RoomAccessibility(v)
+	}))
+
+	for _, a := range accessibility {
+		if err := a.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return accessibility, nil
+}
+
+// RoomAttributes は部屋の収容人数・設備・バリアフリー対応と、組織が定義したカスタム項目の値
+type RoomAttributes struct {
+	Capacity      RoomCapacity
+	Equipment     []Equipment
+	Accessibility []RoomAccessibility
+	// CustomFields はカスタム項目のキーごとの値。値は RoomCustomField.NormalizeValue で正規化したもの
+	CustomFields map[string]string
+}
+
+func (a RoomAttributes) Validate() error {
+	if err := a.Capacity.Validate(); err != nil {
+		return err
+	}
+
+	if len(a.Equipment) > MaxRoomEquipmentCount {
+		return errors.WithHint(
+			errors.Newf("room equipment must be %d items or less", MaxRoomEquipmentCount),
+			fmt.Sprintf("設備は%d件以内で登録してください。", MaxRoomEquipmentCount),
+		)
+	}
+
+	for _, e := range a.Equipment {
+		if err := e.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, ac := range a.Accessibility {
+		if err := ac.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RoomFilter は部屋の属性による絞り込み条件。ゼロ値の条件は絞り込みに使わない。
+// 設備・バリアフリー対応・カスタム項目は指定したものをすべて持つ部屋に絞り込む
+type RoomFilter struct {
+	MinCapacity   RoomCapacity
+	Equipment     []Equipment
+	Accessibility []RoomAccessibility
+	CustomFields  map[string]string
+}
+
+// NewRoomFilter は収容人数・設備・バリアフリー対応の絞り込み条件を作る。カスタム項目は RoomCustomFieldSchema.NormalizeFilter で正規化して設定する
+func NewRoomFilter(minCapacity int32, equipment []string, accessibility []string) (RoomFilter, error) {
+	filter := RoomFilter{MinCapacity: RoomCapacity(minCapacity)}
+	if err := filter.MinCapacity.Validate(); err != nil {
+		return RoomFilter{}, err
+	}
+
+	var err error
+	if filter.Equipment, err = NewEquipmentList(equipment); err != nil {
+		return RoomFilter{}, err
+	}
+	if filter.Accessibility, err = NewRoomAccessibilityList(accessibility); err != nil {
+		return RoomFilter{}, err
+	}
+	return filter, nil
+}
+
+// PageFilters はページトークンの条件に含める絞り込み条件を返す
+func (f RoomFilter) PageFilters() map[string]string {
+	filters := map[string]string{
+		"equipment":     strings.Join(sortedStrings(f.Equipment), "\x1f"),
+		"accessibility": strings.Join(sortedStrings(f.Accessibility), "\x1f"),
+	}
+	if f.MinCapacity > 0 {
+		filters["min_capacity"] = strconv.Itoa(int(f.MinCapacity))
+	}
+	for key, value := range f.CustomFields {
+		filters["custom_field."+key] = value
+	}
+	return filters
+}
+
+func sortedStrings[T ~string](values []T) []string {
+	s := lo.Map(values, func(v T, _ int) string { return string(v) })
+	slices.Sort(s)
+	return s
+}
+
+type RoomCustomFieldID uuid.UUID
+
+func (id RoomCustomFieldID) UUID() uuid.UUID {
+	return uuid.UUID(id)
+}
+
+func (id RoomCustomFieldID) String() string {
+	return uuid.UUID(id).String()
+}
+
+func ParseRoomCustomFieldID(value string) (RoomCustomFieldID, error) {
+	u, err := uuid.Parse(value)
+	if err != nil {
+		return RoomCustomFieldID{}, errors.WithHint(
+			errors.Wrap(err, "failed to parse room custom field ID"),
+			"カスタム項目IDの形式が正しくありません。",
+		)
+	}
+	return RoomCustomFieldID(u), nil
+}
+
+var roomCustomFieldKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,29}$`)
+
+// RoomCustomFieldKey はカスタム項目を識別するキー。英小文字で始まる英小文字・数字・アンダースコアの30文字以内
+type RoomCustomFieldKey string
+
+func (k RoomCustomFieldKey) String() string {
+	return string(k)
+}
+
+func (k RoomCustomFieldKey) Validate() error {
+	if !roomCustomFieldKeyPattern.MatchString(string(k)) {
+		return errors.WithHint(
+			errors.Newf("invalid room custom field key: %q", string(k)),
+			"カスタム項目のキーは英小文字で始まる英小文字・数字・アンダースコアの30文字以内で入力してください。",
+		)
+	}
+	return nil
+}
+
+type RoomCustomFieldType string
+
+const (
+	RoomCustomFieldTypeText    RoomCustomFieldType = "text"
+	RoomCustomFieldTypeNumber  RoomCustomFieldType = "number"
+	RoomCustomFieldTypeBoolean RoomCustomFieldType = "boolean"
+	RoomCustomFieldTypeSelect  RoomCustomFieldType = "select"
+)
+
+func (t RoomCustomFieldType) String() string {
+	return string(t)
+}
+
+func (t RoomCustomFieldType) Validate() error {
+	switch t {
+	case RoomCustomFieldTypeText, RoomCustomFieldTypeNumber, RoomCustomFieldTypeBoolean, RoomCustomFieldTypeSelect:
+		return nil
+	default:
+		return errors.WithHint(
+			errors.Newf("invalid room custom field type: %s", t),
+			"カスタム項目の種類が正しくありません。",
+		)
+	}
+}
+
+// RoomCustomField は組織が部屋に追加する項目の定義。
+// Type が select の場合、値は Options のいずれかにする
+type RoomCustomField struct {
+	ID             RoomCustomFieldID
+	OrganizationID OrganizationID
+	Key            RoomCustomFieldKey
+	Label          string
+	Type           RoomCustomFieldType
+	Required       bool
+	Options        []string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (f RoomCustomField) Validate() error {
+	if err := f.OrganizationID.Validate(); err != nil {
+		return err
+	}
+
+	if err := f.Key.Validate(); err != nil {
+		return err
+	}
+
+	if f.Label == "" || utf8.RuneCountInString(f.Label) > 50 {
+		return errors.WithHint(
+			errors.New("room custom field label must be 1 to 50 characters"),
+			"カスタム項目の表示名は1〜50文字で入力してください。",
+		)
+	}
+
+	if err := f.Type.Validate(); err != nil {
+		return err
+	}
+
+	if f.Type == RoomCustomFieldTypeSelect {
+		if len(f.Options) == 0 {
+			return errors.WithHint(
+				errors.New("select field requires options"),
+				"選択式のカスタム項目には選択肢を1つ以上指定してください。",
+			)
+		}
+		for _, option := range f.Options {
+			if option == "" || utf8.RuneCountInString(option) > 50 {
+				return errors.WithHint(
+					errors.New("room custom field option must be 1 to 50 characters"),
+					"選択肢は1〜50文字で入力してください。",
+				)
+			}
+		}
+		if len(lo.Uniq(f.Options)) != len(f.Options) {
+			return errors.WithHint(
+				errors.New("room custom field options must be unique"),
+				"選択肢が重複しています。",
+			)
+		}
+	} else if len(f.Options) > 0 {
+		return errors.WithHint(
+			errors.New("options are only allowed for select fields"),
+			"選択肢は選択式のカスタム項目にだけ指定できます。",
+		)
+	}
+
+	if f.CreatedAt.IsZero() {
+		return errors.WithHint(
+			errors.New("created_at is required"),
+			"作成日時は必須です。",
+		)
+	}
+
+	if f.UpdatedAt.IsZero() {
+		return errors.WithHint(
+			errors.New("updated_at is required"),
+			"更新日時は必須です。",
+		)
+	}
+
+	return nil
+}
+
+func NewRoomCustomField(
+	organizationID OrganizationID,
+	key RoomCustomFieldKey,
+	label string,
+	fieldType RoomCustomFieldType,
+	required bool,
+	options []string,
+) (RoomCustomField, error) {
+	now := time.Now()
+	field := RoomCustomField{
+		ID:             RoomCustomFieldID(uuid.New()),
+		OrganizationID: organizationID,
+		Key:            key,
+		Label:          strings.TrimSpace(label),
+		Type:           fieldType,
+		Required:       required,
+		Options:        lo.Map(options, func(o string, _ int) string { return strings.TrimSpace(o) }),
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	if err := field.Validate(); err != nil {
+		return RoomCustomField{}, err
+	}
+
+	return field, nil
+}
+
+// NormalizeValue は値が項目の種類に合うか確認し、保存と絞り込みに使う形にそろえる。
+// 数値は "1.50" を "1.5" のように、真偽値は "true" か "false" にする
+func (f RoomCustomField) NormalizeValue(value string) (string, error) {
+	value = strings.TrimSpace(value)
+
+	switch f.Type {
+	case RoomCustomFieldTypeNumber:
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return "", errors.WithHint(
+				errors.Wrapf(err, "custom field %s must be a number", f.Key),
+				fmt.Sprintf("%sには数値を入力してください。", f.Label),
+			)
+		}
+		return strconv.FormatFloat(n, 'f', -1, 64), nil
+	case RoomCustomFieldTypeBoolean:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return "", errors.WithHint(
+				errors.Wrapf(err, "custom field %s must be a boolean", f.Key),
+				fmt.Sprintf("%sには true か false を指定してください。", f.Label),
+			)
+		}
+		return strconv.FormatBool(b), nil
+	case RoomCustomFieldTypeSelect:
+		if !slices.Contains(f.Options, value) {
+			return "", errors.WithHint(
+				errors.Newf("custom field %s has no option %q", f.Key, value),
+				fmt.Sprintf("%sは選択肢から選んでください。", f.Label),
+			)
+		}
+		return value, nil
+	default:
+		if utf8.RuneCountInString(value) > 200 {
+			return "", errors.WithHint(
+				errors.Newf("custom field %s must be within 200 characters", f.Key),
+				fmt.Sprintf("%sは200文字以内で入力してください。", f.Label),
+			)
+		}
+		return value, nil
+	}
+}
+
+// RoomCustomFieldSchema は組織のカスタム項目の定義の一覧
+type RoomCustomFieldSchema []RoomCustomField
+
+func (s RoomCustomFieldSchema) field(key string) (RoomCustomField, error) {
+	field, ok := lo.Find(s, func(f RoomCustomField) bool {
+		return f.Key.String() == key
+	})
+	if !ok {
+		return RoomCustomField{}, errors.WithHint(
+			errors.Newf("unknown room custom field: %s", key),
+			fmt.Sprintf("カスタム項目 %s は定義されていません。", key),
+		)
+	}
+	return field, nil
+}
+
+// NormalizeValues は部屋に保存するカスタム項目の値を確認して正規化する。
+// 定義にない項目と必須項目の欠けはエラーにし、空の値は未設定として除く
+func (s RoomCustomFieldSchema) NormalizeValues(values map[string]string) (map[string]string, error) {
+	normalized := make(map[string]string, len(values))
+	for key, value := range values {
+		field, err := s.field(key)
+		if err != nil {
+			return nil, err
+		}
+		if strings.TrimSpace(value) == "" {
+			continue
+		}
+		normalized[key], err = field.NormalizeValue(value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, field := range s {
+		if _, ok := normalized[field.Key.String()]; field.Required && !ok {
+			return nil, errors.WithHint(
+				errors.Newf("custom field %s is required", field.Key),
+				fmt.Sprintf("%sは必須です。", field.Label),
+			)
+		}
+	}
+
+	return normalized, nil
+}
+
+// NormalizeFilter は絞り込みに使うカスタム項目の値を確認して正規化する。必須項目の確認はしない
+func (s RoomCustomFieldSchema) NormalizeFilter(values map[string]string) (map[string]string, error) {
+	normalized := make(map[string]string, len(values))
+	for key, value := range values {
+		field, err := s.field(key)
+		if err != nil {
+			return nil, err
+		}
+		normalized[key], err = field.NormalizeValue(value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return normalized, nil
+}
@@ -0,0 +1,151 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRoomCustomField(t *testing.T) {
+	orgID := OrganizationID(uuid.New())
+
+	tests := []struct {
+		name      string
+		key       RoomCustomFieldKey
+		label     string
+		fieldType RoomCustomFieldType
+		options   []string
+		wantErr   bool
+	}{
+		{name: "正常系: 文字列の項目", key: "network_port", label: "ネットワーク口", fieldType: RoomCustomFieldTypeText},
+		{name: "正常系: 選択式の項目", key: "lab_safety_level", label: "安全レベル", fieldType: RoomCustomFieldTypeSelect, options: []string{"BSL1", "BSL2"}},
+		{name: "異常系: キーが大文字で始まる", key: "Network", label: "ネットワーク口", fieldType: RoomCustomFieldTypeText, wantErr: true},
+		{name: "異常系: キーが31文字以上", key: RoomCustomFieldKey("a" + strings.Repeat("b", 30)), label: "長いキー", fieldType: RoomCustomFieldTypeText, wantErr: true},
+		{name: "異常系: 表示名が空", key: "network_port", label: " ", fieldType: RoomCustomFieldTypeText, wantErr: true},
+		{name: "異常系: 種類が不正", key: "network_port", label: "ネットワーク口", fieldType: "date", wantErr: true},
+		{name: "異常系: 選択式に選択肢がない", key: "lab_safety_level", label: "安全レベル", fieldType: RoomCustomFieldTypeSelect, wantErr: true},
+		{name: "異常系: 選択肢が重複している", key: "lab_safety_level", label: "安全レベル", fieldType: RoomCustomFieldTypeSelect, options: []string{"BSL1", "BSL1"}, wantErr: true},
+		{name: "異常系: 選択式以外に選択肢がある", key: "outlets", label: "コンセント数", fieldType: RoomCustomFieldTypeNumber, options: []string{"1"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewRoomCustomField(orgID, tt.key, tt.label, tt.fieldType, false, tt.options)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.key, got.Key)
+			assert.Equal(t, tt.fieldType, got.Type)
+		})
+	}
+}
+
+func TestRoomCustomFieldSchema_NormalizeValues(t *testing.T) {
+	schema := RoomCustomFieldSchema{
+		{Key: "outlets", Label: "コンセント数", Type: RoomCustomFieldTypeNumber},
+		{Key: "has_sink", Label: "流し台", Type: RoomCustomFieldTypeBoolean},
+		{Key: "lab_safety_level", Label: "安全レベル", Type: RoomCustomFieldTypeSelect, Required: true, Options: []string{"BSL1", "BSL2"}},
+		{Key: "note", Label: "備考", Type: RoomCustomFieldTypeText},
+	}
+
+	tests := []struct {
+		name    string
+		values  map[string]string
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name:   "正常系: 数値と真偽値を正規化する",
+			values: map[string]string{"outlets": " 04.0 ", "has_sink": "1", "lab_safety_level": "BSL2"},
+			want:   map[string]string{"outlets": "4", "has_sink": "true", "lab_safety_level": "BSL2"},
+		},
+		{
+			name:   "正常系: 空の値は未設定として除く",
+			values: map[string]string{"note": "", "lab_safety_level": "BSL1"},
+			want:   map[string]string{"lab_safety_level": "BSL1"},
+		},
+		{
+			name:    "異常系: 定義にない項目",
+			values:  map[string]string{"color": "red", "lab_safety_level": "BSL1"},
+			wantErr: true,
+		},
+		{
+			name:    "異常系: 必須項目がない",
+			values:  map[string]string{"outlets": "4"},
+			wantErr: true,
+		},
+		{
+			name:    "異常系: 数値の項目に数値以外",
+			values:  map[string]string{"outlets": "たくさん", "lab_safety_level": "BSL1"},
+			wantErr: true,
+		},
+		{
+			name:    "異常系: 選択肢にない値",
+			values:  map[string]string{"lab_safety_level": "BSL3"},
+			wantErr: true,
+		},
+		{
+			name:    "異常系: 文字列が200文字を超える",
+			values:  map[string]string{"note": strings.Repeat("あ", 201), "lab_safety_level": "BSL1"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := schema.NormalizeValues(tt.values)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("正常系: 絞り込みでは必須項目を確認しない", func(t *testing.T) {
+		got, err := schema.NormalizeFilter(map[string]string{"outlets": "4.50"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"outlets": "4.5"}, got)
+	})
+}
+
+func TestNewRoomFilter(t *testing.T) {
+	tests := []struct {
+		name          string
+		minCapacity   int32
+		equipment     []string
+		accessibility []string
+		wantErr       bool
+	}{
+		{name: "正常系: 条件なし"},
+		{name: "正常系: 設備とバリアフリー対応", minCapacity: 20, equipment: []string{"プロジェクター", " プロジェクター"}, accessibility: []string{"wheelchair"}},
+		{name: "異常系: 収容人数が負", minCapacity: -1, wantErr: true},
+		{name: "異常系: 設備名が空", equipment: []string{" "}, wantErr: true},
+		{name: "異常系: バリアフリー対応の種類が不正", accessibility: []string{"elevator"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewRoomFilter(tt.minCapacity, tt.equipment, tt.accessibility)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, RoomCapacity(tt.minCapacity), got.MinCapacity)
+			assert.LessOrEqual(t, len(got.Equipment), 1, "重複はまとめる")
+		})
+	}
+
+	a, err := NewRoomFilter(0, []string{"プロジェクター", "ホワイトボード"}, nil)
+	require.NoError(t, err)
+	b, err := NewRoomFilter(0, []string{"ホワイトボード", "プロジェクター"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, a.PageFilters(), b.PageFilters(), "設備の順序はページトークンの条件に影響しない")
+}
@@ -0,0 +1,143 @@
+package model
+
+import (
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// typeKeyPattern は組織が追加する部屋タイプ・テナントタイプのキーの形式。英小文字で始まる英小文字・数字・アンダースコアの30文字以内
+var typeKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,29}$`)
+
+func validateTypeLabel(label string) error {
+	if label == "" || utf8.RuneCountInString(label) > 30 {
+		return errors.WithHint(
+			errors.New("type label must be 1 to 30 characters"),
+			"タイプの表示名は1〜30文字で入力してください。",
+		)
+	}
+	return nil
+}
+
+type RoomTypeID uuid.UUID
+
+func (id RoomTypeID) UUID() uuid.UUID {
+	return uuid.UUID(id)
+}
+
+func (id RoomTypeID) String() string {
+	return uuid.UUID(id).String()
+}
+
+func ParseRoomTypeID(value string) (RoomTypeID, error) {
+	u, err := uuid.Parse(value)
+	if err != nil {
+		return RoomTypeID{}, errors.WithHint(
+			errors.Wrap(err, "failed to parse room type ID"),
+			"部屋タイプIDの形式が正しくありません。",
+		)
+	}
+	return RoomTypeID(u), nil
+}
+
+// RoomTypeDefinition は部屋タイプの定義。BuiltIn は全組織で使える既定のタイプで、ID と OrganizationID を持たない
+type RoomTypeDefinition struct {
+	ID             RoomTypeID
+	OrganizationID OrganizationID
+	Key            RoomType
+	Label          string
+	BuiltIn        bool
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (d RoomTypeDefinition) Validate() error {
+	if err := d.OrganizationID.Validate(); err != nil {
+		return err
+	}
+
+	if !typeKeyPattern.MatchString(d.Key.String()) {
+		return errors.WithHint(
+			errors.Newf("invalid room type key: %q", d.Key.String()),
+			"部屋タイプのキーは英小文字で始まる英小文字・数字・アンダースコアの30文字以内で入力してください。",
+		)
+	}
+
+	if d.Key.IsBuiltin() {
+		return errors.WithHintf(
+			errors.Newf("room type %s is built in", d.Key),
+			"%s は既定の部屋タイプのため追加できません。", d.Key,
+		)
+	}
+
+	if err := validateTypeLabel(d.Label); err != nil {
+		return err
+	}
+
+	if d.CreatedAt.IsZero() {
+		return errors.WithHint(
+			errors.New("created_at is required"),
+			"作成日時は必須です。",
+		)
+	}
+
+	if d.UpdatedAt.IsZero() {
+		return errors.WithHint(
+			errors.New("updated_at is required"),
+			"更新日時は必須です。",
+		)
+	}
+
+	return nil
+}
+
+// NewRoomTypeDefinition は組織が追加する部屋タイプを作成する
+func NewRoomTypeDefinition(organizationID OrganizationID, key RoomType, label string) (RoomTypeDefinition, error) {
+	now := time.Now()
+	definition := RoomTypeDefinition{
+		ID:             RoomTypeID(uuid.New()),
+		OrganizationID: organizationID,
+		Key:            key,
+		Label:          strings.TrimSpace(label),
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	if err := definition.Validate(); err != nil {
+		return RoomTypeDefinition{}, err
+	}
+
+	return definition, nil
+}
+
+// BuiltinRoomTypes は全組織で使える既定の部屋タイプを返す
+func BuiltinRoomTypes() []RoomTypeDefinition {
+	return []RoomTypeDefinition{
+		{Key: RoomTypeClassroom, Label: "教室", BuiltIn: true},
+		{Key: RoomTypeMeetingRoom, Label: "会議室", BuiltIn: true},
+		{Key: RoomTypeLaboratory, Label: "実験室", BuiltIn: true},
+		{Key: RoomTypeOffice, Label: "オフィス", BuiltIn: true},
+		{Key: RoomTypeWorkshop, Label: "作業室", BuiltIn: true},
+		{Key: RoomTypeStorage, Label: "倉庫", BuiltIn: true},
+	}
+}
+
+// RoomTypeCatalog は組織が追加した部屋タイプの一覧。既定の部屋タイプは含まない
+type RoomTypeCatalog []RoomTypeDefinition
+
+// Contains は既定の部屋タイプか、組織が追加した部屋タイプかを返す
+func (c RoomTypeCatalog) Contains(t RoomType) bool {
+	return t.IsBuiltin() || lo.ContainsBy(c, func(d RoomTypeDefinition) bool {
+		return d.Key == t
+	})
+}
+
+// All は既定の部屋タイプに続けて組織が追加した部屋タイプを返す
+func (c RoomTypeCatalog) All() []RoomTypeDefinition {
+	return append(BuiltinRoomTypes(), c...)
+}
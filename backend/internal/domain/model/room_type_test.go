@@ -0,0 +1,79 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRoomType(t *testing.T) {
+	serverRoom, err := NewRoomTypeDefinition(OrganizationID(uuid.New()), "server_room", "サーバー室")
+	require.NoError(t, err)
+	catalog := RoomTypeCatalog{serverRoom}
+
+	tests := []struct {
+		name    string
+		value   string
+		catalog RoomTypeCatalog
+		wantErr bool
+	}{
+		{name: "正常系: 既定の部屋タイプ", value: "classroom"},
+		{name: "正常系: 組織が追加した部屋タイプ", value: "server_room", catalog: catalog},
+		{name: "異常系: 組織が追加していない部屋タイプ", value: "server_room", wantErr: true},
+		{name: "異常系: 未指定", value: "", catalog: catalog, wantErr: true},
+		{name: "異常系: キーの形式が不正", value: "Server Room", catalog: catalog, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewRoomType(tt.value, tt.catalog)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, RoomType(tt.value), got)
+		})
+	}
+}
+
+func TestNewRoomTypeDefinition(t *testing.T) {
+	orgID := OrganizationID(uuid.New())
+
+	tests := []struct {
+		name    string
+		key     RoomType
+		label   string
+		wantErr bool
+	}{
+		{name: "正常系: 部屋タイプを追加できる", key: "server_room", label: " サーバー室 "},
+		{name: "異常系: 既定の部屋タイプと同じキー", key: RoomTypeStorage, label: "倉庫", wantErr: true},
+		{name: "異常系: キーが大文字", key: "SERVER_ROOM", label: "サーバー室", wantErr: true},
+		{name: "異常系: 表示名が空", key: "server_room", label: " ", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewRoomTypeDefinition(orgID, tt.key, tt.label)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "サーバー室", got.Label)
+			assert.False(t, got.BuiltIn)
+		})
+	}
+}
+
+func TestRoomTypeCatalog_All(t *testing.T) {
+	serverRoom, err := NewRoomTypeDefinition(OrganizationID(uuid.New()), "server_room", "サーバー室")
+	require.NoError(t, err)
+
+	all := RoomTypeCatalog{serverRoom}.All()
+	require.Len(t, all, len(BuiltinRoomTypes())+1)
+	assert.True(t, all[0].BuiltIn)
+	assert.Equal(t, serverRoom, all[len(all)-1], "組織が追加したタイプは既定のタイプの後に並ぶ")
+}
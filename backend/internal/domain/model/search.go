@@ -0,0 +1,95 @@
+package model
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultSearchLimit は検索で件数を指定しなかった場合の件数
+	DefaultSearchLimit = 20
+	// MaxSearchLimit は検索で1回に返す件数の上限
+	MaxSearchLimit = 100
+	// searchQueryMaxLength は検索語の最大文字数
+	searchQueryMaxLength = 100
+)
+
+// SearchResultType は検索結果の種類
+type SearchResultType string
+
+const (
+	SearchResultTypeRoom   SearchResultType = "room"
+	SearchResultTypeKey    SearchResultType = "key"
+	SearchResultTypeTenant SearchResultType = "tenant"
+	SearchResultTypeMember SearchResultType = "member"
+)
+
+func (t SearchResultType) String() string {
+	return string(t)
+}
+
+func (t SearchResultType) Validate() error {
+	switch t {
+	case SearchResultTypeRoom, SearchResultTypeKey, SearchResultTypeTenant, SearchResultTypeMember:
+		return nil
+	default:
+		return errors.WithHintf(
+			errors.New("invalid search result type"),
+			"無効な検索対象です: %s", t,
+		)
+	}
+}
+
+// SearchQuery は前後の空白を取り除いた検索語
+type SearchQuery string
+
+func (q SearchQuery) String() string {
+	return string(q)
+}
+
+func (q SearchQuery) Validate() error {
+	if q == "" {
+		return errors.WithHint(
+			errors.New("search query is empty"),
+			"検索語を入力してください。",
+		)
+	}
+	if utf8.RuneCountInString(string(q)) > searchQueryMaxLength {
+		return errors.WithHintf(
+			errors.New("search query is too long"),
+			"検索語は%d文字以内で入力してください。", searchQueryMaxLength,
+		)
+	}
+	return nil
+}
+
+func NewSearchQuery(value string) (SearchQuery, error) {
+	q := SearchQuery(strings.TrimSpace(value))
+	if err := q.Validate(); err != nil {
+		return "", err
+	}
+	return q, nil
+}
+
+// likeEscaper は ILIKE のワイルドカードとエスケープ文字を文字どおりに扱うためのもの
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// LikePattern は検索語を部分一致で探す ILIKE のパターンを返す
+func (q SearchQuery) LikePattern() string {
+	return "%" + likeEscaper.Replace(string(q)) + "%"
+}
+
+// SearchResult は検索で見つかった行。Title と Subtitle は一覧に表示する文字列で、
+// 種類ごとに部屋名と建物・階、鍵番号と部屋名、テナント名と説明、メールアドレスと名前を入れる。
+// ParentID は鍵の結果でだけ部屋のIDを持つ
+type SearchResult struct {
+	Type     SearchResultType
+	ID       uuid.UUID
+	Title    string
+	Subtitle string
+	ParentID *uuid.UUID
+	Score    float32
+}
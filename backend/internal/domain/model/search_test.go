@@ -0,0 +1,50 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSearchQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    SearchQuery
+		wantErr bool
+	}{
+		{name: "正常系: 前後の空白を取り除く", value: "  会議室 ", want: "会議室"},
+		{name: "正常系: 100文字までは受け付ける", value: strings.Repeat("鍵", 100), want: SearchQuery(strings.Repeat("鍵", 100))},
+		{name: "異常系: 空白だけの検索語", value: "   ", wantErr: true},
+		{name: "異常系: 100文字を超える検索語", value: strings.Repeat("鍵", 101), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewSearchQuery(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSearchQuery_LikePattern(t *testing.T) {
+	tests := []struct {
+		name  string
+		query SearchQuery
+		want  string
+	}{
+		{name: "正常系: 部分一致のパターンにする", query: "A-101", want: "%A-101%"},
+		{name: "正常系: ワイルドカードを文字どおりに扱う", query: `100%_\`, want: `%100\%\_\\%`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.query.LikePattern())
+		})
+	}
+}
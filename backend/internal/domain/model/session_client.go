@@ -0,0 +1,37 @@
+package model
+
+import (
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// SessionTouchInterval は最終アクセス日時を更新する最小間隔。リクエスト毎の書き込みを避けるために使う
+	SessionTouchInterval = time.Minute
+
+	maxUserAgentLength = 512
+)
+
+// SessionClient はセッションを利用しているクライアント（ブラウザ・端末）の情報
+type SessionClient struct {
+	UserAgent string
+	IPAddress string
+}
+
+func NewSessionClient(userAgent, ipAddress string) SessionClient {
+	if utf8.RuneCountInString(userAgent) > maxUserAgentLength {
+		userAgent = string([]rune(userAgent)[:maxUserAgentLength])
+	}
+	return SessionClient{
+		UserAgent: userAgent,
+		IPAddress: ipAddress,
+	}
+}
+
+// shouldTouchSession は最終アクセス日時やクライアント情報を更新すべきかを判定する
+func shouldTouchSession(lastSeenAt time.Time, current, next SessionClient) bool {
+	if current != next {
+		return true
+	}
+	return time.Since(lastSeenAt) >= SessionTouchInterval
+}
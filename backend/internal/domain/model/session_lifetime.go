@@ -0,0 +1,72 @@
+package model
+
+import (
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// SessionLifetime はセッションのアイドルタイムアウトと絶対タイムアウト
+type SessionLifetime struct {
+	IdleTimeout     time.Duration
+	AbsoluteTimeout time.Duration
+}
+
+func (l SessionLifetime) Validate() error {
+	if l.IdleTimeout <= 0 {
+		return errors.WithHint(
+			errors.New("idle timeout must be positive"),
+			"アイドルタイムアウトは正の値である必要があります。",
+		)
+	}
+
+	if l.AbsoluteTimeout < l.IdleTimeout {
+		return errors.WithHint(
+			errors.New("absolute timeout must not be shorter than idle timeout"),
+			"絶対タイムアウトはアイドルタイムアウト以上である必要があります。",
+		)
+	}
+
+	return nil
+}
+
+func NewSessionLifetime(idleTimeout, absoluteTimeout time.Duration) (SessionLifetime, error) {
+	lifetime := SessionLifetime{
+		IdleTimeout:     idleTimeout,
+		AbsoluteTimeout: absoluteTimeout,
+	}
+
+	if err := lifetime.Validate(); err != nil {
+		return SessionLifetime{}, err
+	}
+
+	return lifetime, nil
+}
+
+// ExpiresAt は createdAt に作成されたセッションの初期有効期限を返す
+func (l SessionLifetime) ExpiresAt(createdAt time.Time) time.Time {
+	return l.cap(createdAt, createdAt.Add(l.IdleTimeout))
+}
+
+// Renew はスライディング更新後の有効期限を返す。
+// 残り時間がアイドルタイムアウトの半分を切ったときだけ延長し、絶対タイムアウトは超えない。
+// 延長しない場合は現在の有効期限と false を返す。
+func (l SessionLifetime) Renew(createdAt, expiresAt, now time.Time) (time.Time, bool) {
+	if expiresAt.Sub(now) > l.IdleTimeout/2 {
+		return expiresAt, false
+	}
+
+	renewed := l.cap(createdAt, now.Add(l.IdleTimeout))
+	if !renewed.After(expiresAt) {
+		return expiresAt, false
+	}
+
+	return renewed, true
+}
+
+func (l SessionLifetime) cap(createdAt, expiresAt time.Time) time.Time {
+	if absolute := createdAt.Add(l.AbsoluteTimeout); expiresAt.After(absolute) {
+		return absolute
+	}
+	return expiresAt
+}
@@ -0,0 +1,85 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionLifetime_Renew(t *testing.T) {
+	lifetime, err := NewSessionLifetime(2*time.Hour, 8*time.Hour)
+	require.NoError(t, err)
+
+	createdAt := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		expiresAt   time.Time
+		now         time.Time
+		wantExpires time.Time
+		wantRenewed bool
+	}{
+		{
+			name:        "正常系: 残り時間が十分あれば延長しない",
+			expiresAt:   createdAt.Add(2 * time.Hour),
+			now:         createdAt.Add(30 * time.Minute),
+			wantExpires: createdAt.Add(2 * time.Hour),
+			wantRenewed: false,
+		},
+		{
+			name:        "正常系: 残り時間がアイドルタイムアウトの半分を切ると延長する",
+			expiresAt:   createdAt.Add(2 * time.Hour),
+			now:         createdAt.Add(90 * time.Minute),
+			wantExpires: createdAt.Add(210 * time.Minute),
+			wantRenewed: true,
+		},
+		{
+			name:        "正常系: 延長後の期限は絶対タイムアウトで打ち切られる",
+			expiresAt:   createdAt.Add(7 * time.Hour),
+			now:         createdAt.Add(6*time.Hour + 30*time.Minute),
+			wantExpires: createdAt.Add(8 * time.Hour),
+			wantRenewed: true,
+		},
+		{
+			name:        "正常系: 絶対タイムアウトに達していればそれ以上延長しない",
+			expiresAt:   createdAt.Add(8 * time.Hour),
+			now:         createdAt.Add(7*time.Hour + 30*time.Minute),
+			wantExpires: createdAt.Add(8 * time.Hour),
+			wantRenewed: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, renewed := lifetime.Renew(createdAt, tt.expiresAt, tt.now)
+			assert.Equal(t, tt.wantRenewed, renewed)
+			assert.Equal(t, tt.wantExpires, got)
+		})
+	}
+}
+
+func TestNewSessionLifetime(t *testing.T) {
+	tests := []struct {
+		name     string
+		idle     time.Duration
+		absolute time.Duration
+		wantErr  bool
+	}{
+		{name: "正常系: アイドルと絶対が同じ", idle: time.Hour, absolute: time.Hour},
+		{name: "異常系: アイドルタイムアウトが0", idle: 0, absolute: time.Hour, wantErr: true},
+		{name: "異常系: 絶対タイムアウトがアイドルより短い", idle: 2 * time.Hour, absolute: time.Hour, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewSessionLifetime(tt.idle, tt.absolute)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
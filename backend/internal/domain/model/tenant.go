@@ -99,15 +99,28 @@ func (t TenantType) String() string {
 	return string(t)
 }
 
-func (t TenantType) Validate() error {
+// IsBuiltin は全組織で使える既定のテナントタイプかを返す
+func (t TenantType) IsBuiltin() bool {
 	switch t {
 	case TenantTypeTeam, TenantTypeDepartment, TenantTypeProject, TenantTypeLaboratory:
+		return true
+	default:
+		return false
+	}
+}
+
+// Validate はテナントタイプの形式を確認する。組織が定義したタイプかどうかは NewTenantType で確認する
+func (t TenantType) Validate() error {
+	switch {
+	case t.IsBuiltin():
 		return nil
-	case TenantTypeUnspecified:
+	case t == TenantTypeUnspecified || t == "":
 		return errors.WithHint(
 			errors.New("tenant type must be specified"),
 			"テナントタイプを指定してください。",
 		)
+	case typeKeyPattern.MatchString(string(t)):
+		return nil
 	default:
 		return errors.WithHintf(
 			errors.New("invalid tenant type"),
@@ -116,11 +129,18 @@ func (t TenantType) Validate() error {
 	}
 }
 
-func NewTenantType(value string) (TenantType, error) {
+// NewTenantType は既定のテナントタイプか、組織が catalog に定義したテナントタイプを返す
+func NewTenantType(value string, catalog TenantTypeCatalog) (TenantType, error) {
 	t := TenantType(value)
 	if err := t.Validate(); err != nil {
 		return "", err
 	}
+	if !catalog.Contains(t) {
+		return "", errors.WithHintf(
+			errors.Newf("tenant type %s is not defined", t),
+			"テナントタイプ %s は定義されていません。", t,
+		)
+	}
 	return t, nil
 }
 
@@ -130,8 +150,40 @@ type Tenant struct {
 	Name           TenantName
 	Description    TenantDescription
 	Type           TenantType
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
+	// ArchivedAt はアーカイブした日時。アーカイブしたテナントは閲覧のみでき、変更できない
+	ArchivedAt *time.Time
+	// Version は更新するたびに増える版数。更新時に取得時の版数と比べて同時編集による上書きを防ぐ
+	Version   int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (t Tenant) IsArchived() bool {
+	return t.ArchivedAt != nil
+}
+
+// EnsureWritable はテナントを変更できることを確認する。アーカイブしたテナントはエラーを返す
+func (t Tenant) EnsureWritable() error {
+	if t.IsArchived() {
+		return errors.WithHint(
+			errors.Newf("tenant %s is archived", t.ID),
+			"アーカイブしたテナントは変更できません。",
+		)
+	}
+	return nil
+}
+
+// Archive はテナントをアーカイブした状態を返す。アーカイブ済みのテナントはエラーを返す
+func (t Tenant) Archive(now time.Time) (Tenant, error) {
+	if t.IsArchived() {
+		return Tenant{}, errors.WithHint(
+			errors.Newf("tenant %s is already archived", t.ID),
+			"このテナントは既にアーカイブしています。",
+		)
+	}
+	t.ArchivedAt = &now
+	t.UpdatedAt = now
+	return t, nil
 }
 
 func (t Tenant) Validate() error {
@@ -181,6 +233,7 @@ func NewTenant(
 		Name:           name,
 		Description:    description,
 		Type:           tenantType,
+		Version:        1,
 		CreatedAt:      now,
 		UpdatedAt:      now,
 	}
@@ -0,0 +1,170 @@
+package model
+
+import (
+	"time"
+	"unicode/utf8"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+)
+
+type TenantGroupID uuid.UUID
+
+func (id TenantGroupID) UUID() uuid.UUID {
+	return uuid.UUID(id)
+}
+
+func (id TenantGroupID) String() string {
+	return uuid.UUID(id).String()
+}
+
+func ParseTenantGroupID(value string) (TenantGroupID, error) {
+	u, err := uuid.Parse(value)
+	if err != nil {
+		return TenantGroupID{}, errors.WithHint(
+			errors.Wrap(err, "failed to parse tenant group ID"),
+			"グループIDの形式が正しくありません。",
+		)
+	}
+	return TenantGroupID(u), nil
+}
+
+type TenantGroupName string
+
+func (n TenantGroupName) String() string {
+	return string(n)
+}
+
+func (n TenantGroupName) Validate() error {
+	if n == "" {
+		return errors.WithHint(
+			errors.New("tenant group name is required"),
+			"グループ名は必須です。",
+		)
+	}
+
+	if utf8.RuneCountInString(string(n)) > 50 {
+		return errors.WithHint(
+			errors.New("tenant group name must be 50 characters or less"),
+			"グループ名は50文字以内で入力してください。",
+		)
+	}
+	return nil
+}
+
+func NewTenantGroupName(value string) (TenantGroupName, error) {
+	n := TenantGroupName(value)
+	if err := n.Validate(); err != nil {
+		return "", err
+	}
+	return n, nil
+}
+
+type TenantGroupDescription string
+
+func (d TenantGroupDescription) String() string {
+	return string(d)
+}
+
+func (d TenantGroupDescription) Validate() error {
+	if utf8.RuneCountInString(string(d)) > 300 {
+		return errors.WithHint(
+			errors.New("tenant group description must be 300 characters or less"),
+			"グループの説明は300文字以内で入力してください。",
+		)
+	}
+	return nil
+}
+
+func NewTenantGroupDescription(value string) (TenantGroupDescription, error) {
+	d := TenantGroupDescription(value)
+	if err := d.Validate(); err != nil {
+		return "", err
+	}
+	return d, nil
+}
+
+// TenantGroup はテナント内の研究室やチームなどの小単位。
+// ParentID を持つグループのメンバーは、親グループに割り当てられた部屋も利用できる
+type TenantGroup struct {
+	ID             TenantGroupID
+	TenantID       TenantID
+	OrganizationID OrganizationID
+	ParentID       *TenantGroupID
+	Name           TenantGroupName
+	Description    TenantGroupDescription
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (g TenantGroup) Validate() error {
+	if err := g.Name.Validate(); err != nil {
+		return err
+	}
+
+	if err := g.Description.Validate(); err != nil {
+		return err
+	}
+
+	if err := g.OrganizationID.Validate(); err != nil {
+		return err
+	}
+
+	if g.ParentID != nil && *g.ParentID == g.ID {
+		return errors.WithHint(
+			errors.New("tenant group cannot be its own parent"),
+			"グループを自身の親にすることはできません。",
+		)
+	}
+
+	if g.CreatedAt.IsZero() {
+		return errors.WithHint(
+			errors.New("created_at is required"),
+			"作成日時は必須です。",
+		)
+	}
+
+	if g.UpdatedAt.IsZero() {
+		return errors.WithHint(
+			errors.New("updated_at is required"),
+			"更新日時は必須です。",
+		)
+	}
+
+	return nil
+}
+
+// NewTenantGroup はテナントにグループを作成する。parent は同じテナントのグループである必要がある
+func NewTenantGroup(
+	tenant Tenant,
+	parent *TenantGroup,
+	name TenantGroupName,
+	description TenantGroupDescription,
+) (TenantGroup, error) {
+	now := time.Now()
+	group := TenantGroup{
+		ID:             TenantGroupID(uuid.New()),
+		TenantID:       tenant.ID,
+		OrganizationID: tenant.OrganizationID,
+		Name:           name,
+		Description:    description,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	if parent != nil {
+		if parent.TenantID != tenant.ID {
+			return TenantGroup{}, errors.WithHint(
+				errors.New("parent group belongs to another tenant"),
+				"親グループは同じテナントのグループを指定してください。",
+			)
+		}
+		group.ParentID = &parent.ID
+	}
+
+	if err := group.Validate(); err != nil {
+		return TenantGroup{}, err
+	}
+
+	return group, nil
+}
@@ -0,0 +1,120 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+type TenantTypeID uuid.UUID
+
+func (id TenantTypeID) UUID() uuid.UUID {
+	return uuid.UUID(id)
+}
+
+func (id TenantTypeID) String() string {
+	return uuid.UUID(id).String()
+}
+
+func ParseTenantTypeID(value string) (TenantTypeID, error) {
+	u, err := uuid.Parse(value)
+	if err != nil {
+		return TenantTypeID{}, errors.WithHint(
+			errors.Wrap(err, "failed to parse tenant type ID"),
+			"テナントタイプIDの形式が正しくありません。",
+		)
+	}
+	return TenantTypeID(u), nil
+}
+
+// TenantTypeDefinition はテナントタイプの定義。BuiltIn は全組織で使える既定のタイプで、ID と OrganizationID を持たない
+type TenantTypeDefinition struct {
+	ID             TenantTypeID
+	OrganizationID OrganizationID
+	Key            TenantType
+	Label          string
+	BuiltIn        bool
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (d TenantTypeDefinition) Validate() error {
+	if err := d.OrganizationID.Validate(); err != nil {
+		return err
+	}
+
+	if !typeKeyPattern.MatchString(d.Key.String()) {
+		return errors.WithHint(
+			errors.Newf("invalid tenant type key: %q", d.Key.String()),
+			"テナントタイプのキーは英小文字で始まる英小文字・数字・アンダースコアの30文字以内で入力してください。",
+		)
+	}
+
+	if err := validateTypeLabel(d.Label); err != nil {
+		return err
+	}
+
+	if d.CreatedAt.IsZero() {
+		return errors.WithHint(
+			errors.New("created_at is required"),
+			"作成日時は必須です。",
+		)
+	}
+
+	if d.UpdatedAt.IsZero() {
+		return errors.WithHint(
+			errors.New("updated_at is required"),
+			"更新日時は必須です。",
+		)
+	}
+
+	return nil
+}
+
+// NewTenantTypeDefinition は組織が追加するテナントタイプを作成する。
+// 既定のテナントタイプ（TENANT_TYPE_TEAM など）は大文字のため、キーが重なることはない
+func NewTenantTypeDefinition(organizationID OrganizationID, key TenantType, label string) (TenantTypeDefinition, error) {
+	now := time.Now()
+	definition := TenantTypeDefinition{
+		ID:             TenantTypeID(uuid.New()),
+		OrganizationID: organizationID,
+		Key:            key,
+		Label:          strings.TrimSpace(label),
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	if err := definition.Validate(); err != nil {
+		return TenantTypeDefinition{}, err
+	}
+
+	return definition, nil
+}
+
+// BuiltinTenantTypes は全組織で使える既定のテナントタイプを返す
+func BuiltinTenantTypes() []TenantTypeDefinition {
+	return []TenantTypeDefinition{
+		{Key: TenantTypeTeam, Label: "チーム", BuiltIn: true},
+		{Key: TenantTypeDepartment, Label: "部署", BuiltIn: true},
+		{Key: TenantTypeProject, Label: "プロジェクト", BuiltIn: true},
+		{Key: TenantTypeLaboratory, Label: "研究室", BuiltIn: true},
+	}
+}
+
+// TenantTypeCatalog は組織が追加したテナントタイプの一覧。既定のテナントタイプは含まない
+type TenantTypeCatalog []TenantTypeDefinition
+
+// Contains は既定のテナントタイプか、組織が追加したテナントタイプかを返す
+func (c TenantTypeCatalog) Contains(t TenantType) bool {
+	return t.IsBuiltin() || lo.ContainsBy(c, func(d TenantTypeDefinition) bool {
+		return d.Key == t
+	})
+}
+
+// All は既定のテナントタイプに続けて組織が追加したテナントタイプを返す
+func (c TenantTypeCatalog) All() []TenantTypeDefinition {
+	return append(BuiltinTenantTypes(), c...)
+}
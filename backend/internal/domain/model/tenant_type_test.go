@@ -0,0 +1,40 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTenantType(t *testing.T) {
+	club, err := NewTenantTypeDefinition(OrganizationID(uuid.New()), "club", "サークル")
+	require.NoError(t, err)
+	catalog := TenantTypeCatalog{club}
+
+	tests := []struct {
+		name    string
+		value   string
+		catalog TenantTypeCatalog
+		wantErr bool
+	}{
+		{name: "正常系: 既定のテナントタイプ", value: TenantTypeTeam.String()},
+		{name: "正常系: 組織が追加したテナントタイプ", value: "club", catalog: catalog},
+		{name: "異常系: 組織が追加していないテナントタイプ", value: "club", wantErr: true},
+		{name: "異常系: 未指定", value: TenantTypeUnspecified.String(), catalog: catalog, wantErr: true},
+		{name: "異常系: キーの形式が不正", value: "TENANT_TYPE_CLUB", catalog: catalog, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewTenantType(tt.value, tt.catalog)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, TenantType(tt.value), got)
+		})
+	}
+}
@@ -19,6 +19,17 @@ func (id UserID) String() string {
 	return uuid.UUID(id).String()
 }
 
+func ParseUserID(value string) (UserID, error) {
+	u, err := uuid.Parse(value)
+	if err != nil {
+		return UserID{}, errors.WithHint(
+			errors.Wrap(err, "failed to parse user ID"),
+			"ユーザーIDの形式が正しくありません。",
+		)
+	}
+	return UserID(u), nil
+}
+
 type UserEmail string
 
 func (e UserEmail) String() string {
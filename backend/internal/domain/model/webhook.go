@@ -0,0 +1,315 @@
+package model
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+)
+
+const (
+	// WebhookSecretPrefix は署名用シークレットの接頭辞
+	WebhookSecretPrefix = "whsec_"
+	// WebhookTestEventType は送信テストで送るイベントの種類。購読の対象にはできない
+	WebhookTestEventType DomainEventType = "webhook.test"
+
+	// WebhookDeliveryDefaultPageSize は送信履歴の件数を指定しなかった場合の件数
+	WebhookDeliveryDefaultPageSize = 50
+	// WebhookDeliveryMaxPageSize は1回に返す送信履歴の上限
+	WebhookDeliveryMaxPageSize = 200
+
+	maxWebhookURLLength         = 2048
+	maxWebhookDescriptionLength = 200
+	maxWebhookErrorLength       = 1000
+)
+
+type WebhookSubscriptionID uuid.UUID
+
+func (id WebhookSubscriptionID) UUID() uuid.UUID {
+	return uuid.UUID(id)
+}
+
+func (id WebhookSubscriptionID) String() string {
+	return uuid.UUID(id).String()
+}
+
+func ParseWebhookSubscriptionID(value string) (WebhookSubscriptionID, error) {
+	u, err := uuid.Parse(value)
+	if err != nil {
+		return WebhookSubscriptionID{}, errors.WithHint(
+			errors.Wrap(err, "failed to parse webhook subscription ID"),
+			"WebhookのIDの形式が正しくありません。",
+		)
+	}
+	return WebhookSubscriptionID(u), nil
+}
+
+// WebhookURL はイベントを送信する先のURL。
+// 社内システムや開発用のスタブサーバーに送れるよう http も許可する
+type WebhookURL string
+
+func (u WebhookURL) String() string {
+	return string(u)
+}
+
+func (u WebhookURL) Validate() error {
+	invalid := errors.WithHint(
+		errors.Newf("invalid webhook URL: %s", u),
+		"WebhookのURLは http:// または https:// で始まる絶対URLで指定してください。",
+	)
+
+	if u == "" || len(u) > maxWebhookURLLength {
+		return invalid
+	}
+
+	parsed, err := url.Parse(string(u))
+	if err != nil {
+		return invalid
+	}
+	if (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" || parsed.User != nil {
+		return invalid
+	}
+
+	return nil
+}
+
+func NewWebhookURL(value string) (WebhookURL, error) {
+	u := WebhookURL(value)
+	if err := u.Validate(); err != nil {
+		return "", err
+	}
+	return u, nil
+}
+
+type WebhookDescription string
+
+func (d WebhookDescription) String() string {
+	return string(d)
+}
+
+func NewWebhookDescription(value string) (WebhookDescription, error) {
+	if utf8.RuneCountInString(value) > maxWebhookDescriptionLength {
+		return "", errors.WithHintf(
+			errors.New("webhook description is too long"),
+			"説明は%d文字以内で入力してください。", maxWebhookDescriptionLength,
+		)
+	}
+	return WebhookDescription(value), nil
+}
+
+// WebhookSecret はペイロードの署名に使う共有シークレット。購読の作成時にのみクライアントへ返す
+type WebhookSecret string
+
+func (s WebhookSecret) String() string {
+	return string(s)
+}
+
+func GenerateWebhookSecret() (WebhookSecret, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "failed to generate webhook secret")
+	}
+	return WebhookSecret(WebhookSecretPrefix + hex.EncodeToString(b)), nil
+}
+
+// Sign は送信時刻と本文から署名ヘッダーの値を作る。
+// 受信側は "<t>.<本文>" のHMAC-SHA256を計算して v1 と比べ、t が古すぎるものは再送攻撃として拒否する
+func (s WebhookSecret) Sign(timestamp time.Time, body []byte) string {
+	t := strconv.FormatInt(timestamp.Unix(), 10)
+
+	mac := hmac.New(sha256.New, []byte(s))
+	mac.Write([]byte(t))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "t=" + t + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+type WebhookSubscription struct {
+	ID             WebhookSubscriptionID
+	OrganizationID OrganizationID
+	URL            WebhookURL
+	EventTypes     []DomainEventType
+	Secret         WebhookSecret
+	Description    WebhookDescription
+	CreatedAt      time.Time
+}
+
+func (s WebhookSubscription) Validate() error {
+	if err := s.OrganizationID.Validate(); err != nil {
+		return err
+	}
+
+	if err := s.URL.Validate(); err != nil {
+		return err
+	}
+
+	if len(s.EventTypes) == 0 {
+		return errors.WithHint(
+			errors.New("webhook event types are required"),
+			"受け取るイベントを1つ以上指定してください。",
+		)
+	}
+	for _, t := range s.EventTypes {
+		if err := t.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Subscribes は購読がそのイベントを受け取るかを返す
+func (s WebhookSubscription) Subscribes(eventType DomainEventType) bool {
+	return slices.Contains(s.EventTypes, eventType)
+}
+
+func NewWebhookSubscription(
+	organizationID OrganizationID,
+	webhookURL WebhookURL,
+	eventTypes []DomainEventType,
+	description WebhookDescription,
+) (WebhookSubscription, error) {
+	secret, err := GenerateWebhookSecret()
+	if err != nil {
+		return WebhookSubscription{}, err
+	}
+
+	eventTypes = slices.Clone(eventTypes)
+	slices.Sort(eventTypes)
+	subscription := WebhookSubscription{
+		ID:             WebhookSubscriptionID(uuid.New()),
+		OrganizationID: organizationID,
+		URL:            webhookURL,
+		EventTypes:     slices.Compact(eventTypes),
+		Secret:         secret,
+		Description:    description,
+		CreatedAt:      time.Now(),
+	}
+
+	if err := subscription.Validate(); err != nil {
+		return WebhookSubscription{}, err
+	}
+
+	return subscription, nil
+}
+
+// WebhookMessage は購読先へ送るイベント。同じイベントの再送では EventID が変わらないため、
+// 受信側はこれで重複を除く
+type WebhookMessage struct {
+	EventID        OutboxEventID
+	Type           DomainEventType
+	OrganizationID OrganizationID
+	OccurredAt     time.Time
+	Data           json.RawMessage
+}
+
+func NewWebhookMessage(event OutboxEvent) WebhookMessage {
+	return WebhookMessage{
+		EventID:        event.ID,
+		Type:           event.Type,
+		OrganizationID: event.OrganizationID,
+		OccurredAt:     event.OccurredAt,
+		Data:           event.Payload,
+	}
+}
+
+// NewWebhookTestMessage は購読先との疎通を確かめるためのイベントを作る
+func NewWebhookTestMessage(subscription WebhookSubscription) WebhookMessage {
+	return WebhookMessage{
+		EventID:        OutboxEventID(uuid.New()),
+		Type:           WebhookTestEventType,
+		OrganizationID: subscription.OrganizationID,
+		OccurredAt:     time.Now(),
+		Data:           json.RawMessage(`{"subscription_id":"` + subscription.ID.String() + `"}`),
+	}
+}
+
+// Body は送信するJSONの本文
+func (m WebhookMessage) Body() ([]byte, error) {
+	data := m.Data
+	if len(data) == 0 {
+		data = json.RawMessage(`{}`)
+	}
+
+	body, err := json.Marshal(struct {
+		ID             string          `json:"id"`
+		Type           string          `json:"type"`
+		OrganizationID string          `json:"organization_id"`
+		OccurredAt     time.Time       `json:"occurred_at"`
+		Data           json.RawMessage `json:"data"`
+	}{
+		ID:             m.EventID.String(),
+		Type:           m.Type.String(),
+		OrganizationID: m.OrganizationID.String(),
+		OccurredAt:     m.OccurredAt.UTC(),
+		Data:           data,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal webhook message")
+	}
+	return body, nil
+}
+
+// WebhookSendResult は購読先への送信の結果。StatusCode は応答がなかった場合は0
+type WebhookSendResult struct {
+	StatusCode int
+	Duration   time.Duration
+}
+
+type WebhookDeliveryID uuid.UUID
+
+func (id WebhookDeliveryID) UUID() uuid.UUID {
+	return uuid.UUID(id)
+}
+
+func (id WebhookDeliveryID) String() string {
+	return uuid.UUID(id).String()
+}
+
+// WebhookDelivery は送信を1回試みた記録
+type WebhookDelivery struct {
+	ID             WebhookDeliveryID
+	SubscriptionID WebhookSubscriptionID
+	OrganizationID OrganizationID
+	EventID        OutboxEventID
+	EventType      DomainEventType
+	StatusCode     int
+	Error          string
+	Succeeded      bool
+	Duration       time.Duration
+	CreatedAt      time.Time
+}
+
+// NewWebhookDelivery は送信の結果から記録を作る。sendErr が nil なら成功とする
+func NewWebhookDelivery(subscription WebhookSubscription, message WebhookMessage, result WebhookSendResult, sendErr error) WebhookDelivery {
+	delivery := WebhookDelivery{
+		ID:             WebhookDeliveryID(uuid.New()),
+		SubscriptionID: subscription.ID,
+		OrganizationID: subscription.OrganizationID,
+		EventID:        message.EventID,
+		EventType:      message.Type,
+		StatusCode:     result.StatusCode,
+		Succeeded:      sendErr == nil,
+		Duration:       result.Duration,
+		CreatedAt:      time.Now(),
+	}
+
+	if sendErr != nil {
+		delivery.Error = sendErr.Error()
+		if utf8.RuneCountInString(delivery.Error) > maxWebhookErrorLength {
+			delivery.Error = string([]rune(delivery.Error)[:maxWebhookErrorLength])
+		}
+	}
+
+	return delivery
+}
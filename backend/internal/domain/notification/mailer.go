@@ -0,0 +1,12 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+)
+
+// Mailer はユーザーへメールを送る。メールサーバーが受け付けなかった場合はエラーを返す
+type Mailer interface {
+	Send(ctx context.Context, mail model.Mail) error
+}
@@ -0,0 +1,21 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+)
+
+// Store はレート制限とロックアウトの状態を保持する。
+// 単一インスタンスではメモリ、複数インスタンスで共有する場合はPostgresの実装を使う
+type Store interface {
+	// Take はキーのトークンを1つ消費し、許可するかどうかを返す
+	Take(ctx context.Context, key string, limit model.RateLimit) (model.RateLimitDecision, error)
+	// LockedFor はキーがロック中であれば解除までの残り時間を返す。ロックされていなければ0を返す
+	LockedFor(ctx context.Context, key string) (time.Duration, error)
+	// RecordFailure は失敗を記録し、ロックした場合は解除までの残り時間を返す
+	RecordFailure(ctx context.Context, key string, policy model.LockoutPolicy) (time.Duration, error)
+	// ResetFailures は成功時に失敗の記録を消す
+	ResetFailures(ctx context.Context, key string) error
+}
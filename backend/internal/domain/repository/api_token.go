@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+)
+
+type CreateAPITokenArg struct {
+	Token     model.APIToken
+	TokenHash string
+}
+
+type APITokenRepository interface {
+	CreateAPIToken(ctx context.Context, arg CreateAPITokenArg) error
+	GetAPITokenByHash(ctx context.Context, tokenHash string) (model.APIToken, error)
+	ListAPITokensByUser(ctx context.Context, userID model.UserID) ([]model.APIToken, error)
+	ListAPITokensByOrganization(ctx context.Context, organizationID model.OrganizationID) ([]model.APIToken, error)
+	TouchAPIToken(ctx context.Context, id model.APITokenID) error
+	RevokeAPITokenByUser(ctx context.Context, userID model.UserID, id model.APITokenID) (int64, error)
+	RevokeAPITokenByOrganization(ctx context.Context, organizationID model.OrganizationID, id model.APITokenID) (int64, error)
+}
@@ -10,11 +10,21 @@ import (
 type AppSessionRepository interface {
 	CreateAppSession(ctx context.Context, arg CreateAppSessionArg) error
 	GetAppSession(ctx context.Context, sessionID model.AppSessionID) (model.AppSession, error)
+	ListActiveAppSessionsByUser(ctx context.Context, userID model.UserID) ([]model.AppSession, error)
+	TouchAppSession(ctx context.Context, sessionID model.AppSessionID, client model.SessionClient) error
+	// EnsureAppSessionCSRFToken はCSRFトークンを持たないセッションに token を設定し、保存されているトークンを返す
+	EnsureAppSessionCSRFToken(ctx context.Context, sessionID model.AppSessionID, token string) (string, error)
+	ExtendAppSession(ctx context.Context, sessionID model.AppSessionID, expiresAt time.Time) error
 	RevokeAppSession(ctx context.Context, sessionID model.AppSessionID) error
+	RevokeAppSessionByUser(ctx context.Context, userID model.UserID, sessionID model.AppSessionID) (int64, error)
+	RevokeOtherAppSessionsByUser(ctx context.Context, userID model.UserID, currentSessionID model.AppSessionID) (int64, error)
 }
 
 type CreateAppSessionArg struct {
-	SessionID model.AppSessionID
-	UserID    model.UserID
-	ExpiresAt time.Time
+	SessionID      model.AppSessionID
+	UserID         model.UserID
+	OrganizationID model.OrganizationID
+	ExpiresAt      time.Time
+	CSRFToken      string
+	Client         model.SessionClient
 }
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+)
+
+// SearchAuditLogsArg は監査ログの検索条件。空文字や nil の条件は絞り込みに使わない
+type SearchAuditLogsArg struct {
+	OrganizationID model.OrganizationID
+	ActorType      model.AuditActorType
+	ActorID        string
+	Procedure      string
+	ResultCode     string
+	TargetID       string
+	Since          *time.Time
+	Until          *time.Time
+	Cursor         *model.AuditLogCursor
+	Limit          int32
+}
+
+type AuditLogRepository interface {
+	CreateAuditLog(ctx context.Context, log model.AuditLog) error
+	SearchAuditLogs(ctx context.Context, arg SearchAuditLogsArg) ([]model.AuditLog, error)
+	// LockAuditChain は組織の監査ログへの追記をトランザクションの終わりまで直列化し、連鎖の末尾を返す。
+	// まだ記録がない組織ではゼロ値を返す
+	LockAuditChain(ctx context.Context, organizationID model.OrganizationID) (model.AuditChainHead, error)
+	ListAuditLogsBySeq(ctx context.Context, organizationID model.OrganizationID, afterSeq int64, limit int32) ([]model.AuditLog, error)
+	ListAuditLogOrganizationIDs(ctx context.Context) ([]model.OrganizationID, error)
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+)
+
+type BuildingRepository interface {
+	CreateBuilding(ctx context.Context, building model.Building) error
+	GetBuilding(ctx context.Context, id model.BuildingID) (model.Building, error)
+	GetBuildingByOrganizationAndName(ctx context.Context, organizationID model.OrganizationID, name model.BuildingName) (model.Building, error)
+	ListBuildings(ctx context.Context) ([]model.Building, error)
+	UpdateBuilding(ctx context.Context, building model.Building) error
+	DeleteBuilding(ctx context.Context, id model.BuildingID) (int64, error)
+	CountFloorsByBuilding(ctx context.Context, id model.BuildingID) (int32, error)
+
+	CreateFloor(ctx context.Context, floor model.Floor) error
+	GetFloor(ctx context.Context, id model.FloorID) (model.Floor, error)
+	GetFloorByBuildingAndName(ctx context.Context, buildingID model.BuildingID, name model.FloorNumber) (model.Floor, error)
+	// ListFloors は組織の全ての階を建物ごとに level の昇順で返す
+	ListFloors(ctx context.Context) ([]model.Floor, error)
+	UpdateFloor(ctx context.Context, floor model.Floor) error
+	DeleteFloor(ctx context.Context, id model.FloorID) (int64, error)
+	CountRoomsByFloor(ctx context.Context, id model.FloorID) (int32, error)
+}
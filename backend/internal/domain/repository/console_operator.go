@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+)
+
+type CreateConsoleOperatorArg struct {
+	Operator model.ConsoleOperator
+	KeyHash  string
+}
+
+type ConsoleOperatorRepository interface {
+	CreateConsoleOperator(ctx context.Context, arg CreateConsoleOperatorArg) error
+	GetConsoleOperatorByKeyHash(ctx context.Context, keyHash string) (model.ConsoleOperator, error)
+	ListConsoleOperatorsByOrganization(ctx context.Context, organizationID model.OrganizationID) ([]model.ConsoleOperator, error)
+	RevokeConsoleOperator(ctx context.Context, organizationID model.OrganizationID, id model.ConsoleOperatorID) (int64, error)
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/shibayama-club/keyhub/internal/domain/model"
 )
@@ -9,10 +10,22 @@ import (
 type CreateConsoleSessionArg struct {
 	SessionID      model.ConsoleSessionID
 	OrganizationID model.OrganizationID
+	Role           model.ConsoleRole
+	OperatorID     *model.ConsoleOperatorID
+	CreatedAt      time.Time
+	ExpiresAt      time.Time
+	Client         model.SessionClient
 }
 
 type ConsoleSessionRepository interface {
 	CreateSession(ctx context.Context, arg CreateConsoleSessionArg) error
 	GetSession(ctx context.Context, sessionID model.ConsoleSessionID) (model.ConsoleSession, error)
+	ListActiveSessionsByOrganization(ctx context.Context, organizationID model.OrganizationID) ([]model.ConsoleSession, error)
+	TouchSession(ctx context.Context, sessionID model.ConsoleSessionID, client model.SessionClient) error
+	ExtendSession(ctx context.Context, sessionID model.ConsoleSessionID, expiresAt time.Time) error
 	DeleteSession(ctx context.Context, sessionID model.ConsoleSessionID) error
+	DeleteSessionByOrganization(ctx context.Context, organizationID model.OrganizationID, sessionID model.ConsoleSessionID) (int64, error)
+	DeleteOtherSessionsByOrganization(ctx context.Context, organizationID model.OrganizationID, currentSessionID model.ConsoleSessionID) (int64, error)
+	DeleteSessionsByOrganization(ctx context.Context, organizationID model.OrganizationID) (int64, error)
+	DeleteSessionsByOperator(ctx context.Context, operatorID model.ConsoleOperatorID) (int64, error)
 }
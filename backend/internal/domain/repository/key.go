@@ -14,7 +14,23 @@ type CreateKeyArg struct {
 	Status         model.KeyStatus
 }
 
+// ListKeysByRoomArg は部屋の鍵の一覧の条件。ゼロ値の条件は絞り込みに使わない。
+// 並び順が名前順の場合は鍵番号の昇順に並べる
+type ListKeysByRoomArg struct {
+	RoomID          model.RoomID
+	Status          model.KeyStatus
+	KeyNumberPrefix string
+	Order           model.ListOrder
+	Cursor          *model.PageCursor
+	Limit           int32
+}
+
 type KeyRepository interface {
 	CreateKey(ctx context.Context, arg CreateKeyArg) error
+	GetKeyByID(ctx context.Context, id model.KeyID) (model.Key, error)
+	// UpdateKeyNumber は更新後の版数を返す。鍵が無いか版数が version から変わっていた場合は更新せず 0 を返す
+	UpdateKeyNumber(ctx context.Context, id model.KeyID, keyNumber model.KeyNumber, version int64) (int64, error)
 	GetKeysByRoom(ctx context.Context, roomID model.RoomID) ([]model.Key, error)
+	ListKeysByRoom(ctx context.Context, arg ListKeysByRoomArg) ([]model.Key, error)
+	GetKeysByOrganization(ctx context.Context, organizationID model.OrganizationID) ([]model.Key, error)
 }
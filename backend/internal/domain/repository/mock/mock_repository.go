@@ -12,6 +12,7 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	model "github.com/shibayama-club/keyhub/internal/domain/model"
 	repository "github.com/shibayama-club/keyhub/internal/domain/repository"
@@ -42,6 +43,66 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddTenantGroupMember mocks base method.
+func (m *MockRepository) AddTenantGroupMember(ctx context.Context, groupID model.TenantGroupID, userID model.UserID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTenantGroupMember", ctx, groupID, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTenantGroupMember indicates an expected call of AddTenantGroupMember.
+func (mr *MockRepositoryMockRecorder) AddTenantGroupMember(ctx, groupID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTenantGroupMember", reflect.TypeOf((*MockRepository)(nil).AddTenantGroupMember), ctx, groupID, userID)
+}
+
+// ArchiveTenant mocks base method.
+func (m *MockRepository) ArchiveTenant(ctx context.Context, id model.TenantID, archivedAt time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveTenant", ctx, id, archivedAt)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveTenant indicates an expected call of ArchiveTenant.
+func (mr *MockRepositoryMockRecorder) ArchiveTenant(ctx, id, archivedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveTenant", reflect.TypeOf((*MockRepository)(nil).ArchiveTenant), ctx, id, archivedAt)
+}
+
+// ClaimNotificationDelivery mocks base method.
+func (m *MockRepository) ClaimNotificationDelivery(ctx context.Context, delivery model.NotificationDelivery) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimNotificationDelivery", ctx, delivery)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimNotificationDelivery indicates an expected call of ClaimNotificationDelivery.
+func (mr *MockRepositoryMockRecorder) ClaimNotificationDelivery(ctx, delivery any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimNotificationDelivery", reflect.TypeOf((*MockRepository)(nil).ClaimNotificationDelivery), ctx, delivery)
+}
+
+// ClaimOutboxEvents mocks base method.
+func (m *MockRepository) ClaimOutboxEvents(ctx context.Context, now, leaseUntil time.Time, limit int32) ([]model.OutboxEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimOutboxEvents", ctx, now, leaseUntil, limit)
+	ret0, _ := ret[0].([]model.OutboxEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimOutboxEvents indicates an expected call of ClaimOutboxEvents.
+func (mr *MockRepositoryMockRecorder) ClaimOutboxEvents(ctx, now, leaseUntil, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimOutboxEvents", reflect.TypeOf((*MockRepository)(nil).ClaimOutboxEvents), ctx, now, leaseUntil, limit)
+}
+
 // ConsumeOAuthState mocks base method.
 func (m *MockRepository) ConsumeOAuthState(ctx context.Context, state string) error {
 	m.ctrl.T.Helper()
@@ -56,6 +117,110 @@ func (mr *MockRepositoryMockRecorder) ConsumeOAuthState(ctx, state any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeOAuthState", reflect.TypeOf((*MockRepository)(nil).ConsumeOAuthState), ctx, state)
 }
 
+// ConsumePasskeyCeremony mocks base method.
+func (m *MockRepository) ConsumePasskeyCeremony(ctx context.Context, id string) (model.PasskeyCeremony, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumePasskeyCeremony", ctx, id)
+	ret0, _ := ret[0].(model.PasskeyCeremony)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumePasskeyCeremony indicates an expected call of ConsumePasskeyCeremony.
+func (mr *MockRepositoryMockRecorder) ConsumePasskeyCeremony(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumePasskeyCeremony", reflect.TypeOf((*MockRepository)(nil).ConsumePasskeyCeremony), ctx, id)
+}
+
+// CountFloorsByBuilding mocks base method.
+func (m *MockRepository) CountFloorsByBuilding(ctx context.Context, id model.BuildingID) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountFloorsByBuilding", ctx, id)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountFloorsByBuilding indicates an expected call of CountFloorsByBuilding.
+func (mr *MockRepositoryMockRecorder) CountFloorsByBuilding(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountFloorsByBuilding", reflect.TypeOf((*MockRepository)(nil).CountFloorsByBuilding), ctx, id)
+}
+
+// CountKeysInUseByTenant mocks base method.
+func (m *MockRepository) CountKeysInUseByTenant(ctx context.Context, id model.TenantID) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountKeysInUseByTenant", ctx, id)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountKeysInUseByTenant indicates an expected call of CountKeysInUseByTenant.
+func (mr *MockRepositoryMockRecorder) CountKeysInUseByTenant(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountKeysInUseByTenant", reflect.TypeOf((*MockRepository)(nil).CountKeysInUseByTenant), ctx, id)
+}
+
+// CountRoomsByFloor mocks base method.
+func (m *MockRepository) CountRoomsByFloor(ctx context.Context, id model.FloorID) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountRoomsByFloor", ctx, id)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountRoomsByFloor indicates an expected call of CountRoomsByFloor.
+func (mr *MockRepositoryMockRecorder) CountRoomsByFloor(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountRoomsByFloor", reflect.TypeOf((*MockRepository)(nil).CountRoomsByFloor), ctx, id)
+}
+
+// CountRoomsByType mocks base method.
+func (m *MockRepository) CountRoomsByType(ctx context.Context, organizationID model.OrganizationID, key model.RoomType) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountRoomsByType", ctx, organizationID, key)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountRoomsByType indicates an expected call of CountRoomsByType.
+func (mr *MockRepositoryMockRecorder) CountRoomsByType(ctx, organizationID, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountRoomsByType", reflect.TypeOf((*MockRepository)(nil).CountRoomsByType), ctx, organizationID, key)
+}
+
+// CountTenantsByType mocks base method.
+func (m *MockRepository) CountTenantsByType(ctx context.Context, organizationID model.OrganizationID, key model.TenantType) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountTenantsByType", ctx, organizationID, key)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountTenantsByType indicates an expected call of CountTenantsByType.
+func (mr *MockRepositoryMockRecorder) CountTenantsByType(ctx, organizationID, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTenantsByType", reflect.TypeOf((*MockRepository)(nil).CountTenantsByType), ctx, organizationID, key)
+}
+
+// CreateAPIToken mocks base method.
+func (m *MockRepository) CreateAPIToken(ctx context.Context, arg repository.CreateAPITokenArg) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIToken", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAPIToken indicates an expected call of CreateAPIToken.
+func (mr *MockRepositoryMockRecorder) CreateAPIToken(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIToken", reflect.TypeOf((*MockRepository)(nil).CreateAPIToken), ctx, arg)
+}
+
 // CreateAppSession mocks base method.
 func (m *MockRepository) CreateAppSession(ctx context.Context, arg repository.CreateAppSessionArg) error {
 	m.ctrl.T.Helper()
@@ -70,6 +235,62 @@ func (mr *MockRepositoryMockRecorder) CreateAppSession(ctx, arg any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAppSession", reflect.TypeOf((*MockRepository)(nil).CreateAppSession), ctx, arg)
 }
 
+// CreateAuditLog mocks base method.
+func (m *MockRepository) CreateAuditLog(ctx context.Context, log model.AuditLog) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAuditLog", ctx, log)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAuditLog indicates an expected call of CreateAuditLog.
+func (mr *MockRepositoryMockRecorder) CreateAuditLog(ctx, log any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuditLog", reflect.TypeOf((*MockRepository)(nil).CreateAuditLog), ctx, log)
+}
+
+// CreateBuilding mocks base method.
+func (m *MockRepository) CreateBuilding(ctx context.Context, building model.Building) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBuilding", ctx, building)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateBuilding indicates an expected call of CreateBuilding.
+func (mr *MockRepositoryMockRecorder) CreateBuilding(ctx, building any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBuilding", reflect.TypeOf((*MockRepository)(nil).CreateBuilding), ctx, building)
+}
+
+// CreateConsoleOperator mocks base method.
+func (m *MockRepository) CreateConsoleOperator(ctx context.Context, arg repository.CreateConsoleOperatorArg) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateConsoleOperator", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateConsoleOperator indicates an expected call of CreateConsoleOperator.
+func (mr *MockRepositoryMockRecorder) CreateConsoleOperator(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateConsoleOperator", reflect.TypeOf((*MockRepository)(nil).CreateConsoleOperator), ctx, arg)
+}
+
+// CreateFloor mocks base method.
+func (m *MockRepository) CreateFloor(ctx context.Context, floor model.Floor) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFloor", ctx, floor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateFloor indicates an expected call of CreateFloor.
+func (mr *MockRepositoryMockRecorder) CreateFloor(ctx, floor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFloor", reflect.TypeOf((*MockRepository)(nil).CreateFloor), ctx, floor)
+}
+
 // CreateKey mocks base method.
 func (m *MockRepository) CreateKey(ctx context.Context, arg repository.CreateKeyArg) error {
 	m.ctrl.T.Helper()
@@ -84,6 +305,48 @@ func (mr *MockRepositoryMockRecorder) CreateKey(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKey", reflect.TypeOf((*MockRepository)(nil).CreateKey), ctx, arg)
 }
 
+// CreateOrganization mocks base method.
+func (m *MockRepository) CreateOrganization(ctx context.Context, arg repository.CreateOrganizationArg) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrganization", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOrganization indicates an expected call of CreateOrganization.
+func (mr *MockRepositoryMockRecorder) CreateOrganization(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganization", reflect.TypeOf((*MockRepository)(nil).CreateOrganization), ctx, arg)
+}
+
+// CreateOutboxEvent mocks base method.
+func (m *MockRepository) CreateOutboxEvent(ctx context.Context, event model.OutboxEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOutboxEvent", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOutboxEvent indicates an expected call of CreateOutboxEvent.
+func (mr *MockRepositoryMockRecorder) CreateOutboxEvent(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOutboxEvent", reflect.TypeOf((*MockRepository)(nil).CreateOutboxEvent), ctx, event)
+}
+
+// CreatePasskey mocks base method.
+func (m *MockRepository) CreatePasskey(ctx context.Context, passkey model.Passkey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePasskey", ctx, passkey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePasskey indicates an expected call of CreatePasskey.
+func (mr *MockRepositoryMockRecorder) CreatePasskey(ctx, passkey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePasskey", reflect.TypeOf((*MockRepository)(nil).CreatePasskey), ctx, passkey)
+}
+
 // CreateRoom mocks base method.
 func (m *MockRepository) CreateRoom(ctx context.Context, arg repository.CreateRoomArg) error {
 	m.ctrl.T.Helper()
@@ -112,6 +375,34 @@ func (mr *MockRepositoryMockRecorder) CreateRoomAssignment(ctx, arg any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoomAssignment", reflect.TypeOf((*MockRepository)(nil).CreateRoomAssignment), ctx, arg)
 }
 
+// CreateRoomCustomField mocks base method.
+func (m *MockRepository) CreateRoomCustomField(ctx context.Context, field model.RoomCustomField) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRoomCustomField", ctx, field)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRoomCustomField indicates an expected call of CreateRoomCustomField.
+func (mr *MockRepositoryMockRecorder) CreateRoomCustomField(ctx, field any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoomCustomField", reflect.TypeOf((*MockRepository)(nil).CreateRoomCustomField), ctx, field)
+}
+
+// CreateRoomType mocks base method.
+func (m *MockRepository) CreateRoomType(ctx context.Context, definition model.RoomTypeDefinition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRoomType", ctx, definition)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRoomType indicates an expected call of CreateRoomType.
+func (mr *MockRepositoryMockRecorder) CreateRoomType(ctx, definition any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoomType", reflect.TypeOf((*MockRepository)(nil).CreateRoomType), ctx, definition)
+}
+
 // CreateSession mocks base method.
 func (m *MockRepository) CreateSession(ctx context.Context, arg repository.CreateConsoleSessionArg) error {
 	m.ctrl.T.Helper()
@@ -140,6 +431,20 @@ func (mr *MockRepositoryMockRecorder) CreateTenant(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTenant", reflect.TypeOf((*MockRepository)(nil).CreateTenant), ctx, arg)
 }
 
+// CreateTenantGroup mocks base method.
+func (m *MockRepository) CreateTenantGroup(ctx context.Context, group model.TenantGroup) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTenantGroup", ctx, group)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTenantGroup indicates an expected call of CreateTenantGroup.
+func (mr *MockRepositoryMockRecorder) CreateTenantGroup(ctx, group any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTenantGroup", reflect.TypeOf((*MockRepository)(nil).CreateTenantGroup), ctx, group)
+}
+
 // CreateTenantJoinCode mocks base method.
 func (m *MockRepository) CreateTenantJoinCode(ctx context.Context, arg repository.CreateTenantJoinCodeArg) error {
 	m.ctrl.T.Helper()
@@ -168,202 +473,3056 @@ func (mr *MockRepositoryMockRecorder) CreateTenantMembership(ctx, membership any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTenantMembership", reflect.TypeOf((*MockRepository)(nil).CreateTenantMembership), ctx, membership)
 }
 
-// DeleteSession mocks base method.
-func (m *MockRepository) DeleteSession(ctx context.Context, sessionID model.ConsoleSessionID) error {
+// CreateTenantType mocks base method.
+func (m *MockRepository) CreateTenantType(ctx context.Context, definition model.TenantTypeDefinition) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteSession", ctx, sessionID)
+	ret := m.ctrl.Call(m, "CreateTenantType", ctx, definition)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteSession indicates an expected call of DeleteSession.
-func (mr *MockRepositoryMockRecorder) DeleteSession(ctx, sessionID any) *gomock.Call {
+// CreateTenantType indicates an expected call of CreateTenantType.
+func (mr *MockRepositoryMockRecorder) CreateTenantType(ctx, definition any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSession", reflect.TypeOf((*MockRepository)(nil).DeleteSession), ctx, sessionID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTenantType", reflect.TypeOf((*MockRepository)(nil).CreateTenantType), ctx, definition)
 }
 
-// GetAllRooms mocks base method.
-func (m *MockRepository) GetAllRooms(ctx context.Context) ([]model.Room, error) {
+// CreateWebhookDelivery mocks base method.
+func (m *MockRepository) CreateWebhookDelivery(ctx context.Context, delivery model.WebhookDelivery) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllRooms", ctx)
-	ret0, _ := ret[0].([]model.Room)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "CreateWebhookDelivery", ctx, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetAllRooms indicates an expected call of GetAllRooms.
-func (mr *MockRepositoryMockRecorder) GetAllRooms(ctx any) *gomock.Call {
+// CreateWebhookDelivery indicates an expected call of CreateWebhookDelivery.
+func (mr *MockRepositoryMockRecorder) CreateWebhookDelivery(ctx, delivery any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllRooms", reflect.TypeOf((*MockRepository)(nil).GetAllRooms), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhookDelivery", reflect.TypeOf((*MockRepository)(nil).CreateWebhookDelivery), ctx, delivery)
 }
 
-// GetAllTenants mocks base method.
-func (m *MockRepository) GetAllTenants(ctx context.Context) ([]model.Tenant, error) {
+// CreateWebhookSubscription mocks base method.
+func (m *MockRepository) CreateWebhookSubscription(ctx context.Context, subscription model.WebhookSubscription) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllTenants", ctx)
-	ret0, _ := ret[0].([]model.Tenant)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "CreateWebhookSubscription", ctx, subscription)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetAllTenants indicates an expected call of GetAllTenants.
-func (mr *MockRepositoryMockRecorder) GetAllTenants(ctx any) *gomock.Call {
+// CreateWebhookSubscription indicates an expected call of CreateWebhookSubscription.
+func (mr *MockRepositoryMockRecorder) CreateWebhookSubscription(ctx, subscription any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllTenants", reflect.TypeOf((*MockRepository)(nil).GetAllTenants), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhookSubscription", reflect.TypeOf((*MockRepository)(nil).CreateWebhookSubscription), ctx, subscription)
 }
 
-// GetAppSession mocks base method.
-func (m *MockRepository) GetAppSession(ctx context.Context, sessionID model.AppSessionID) (model.AppSession, error) {
+// DeleteBuilding mocks base method.
+func (m *MockRepository) DeleteBuilding(ctx context.Context, id model.BuildingID) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAppSession", ctx, sessionID)
-	ret0, _ := ret[0].(model.AppSession)
+	ret := m.ctrl.Call(m, "DeleteBuilding", ctx, id)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAppSession indicates an expected call of GetAppSession.
-func (mr *MockRepositoryMockRecorder) GetAppSession(ctx, sessionID any) *gomock.Call {
+// DeleteBuilding indicates an expected call of DeleteBuilding.
+func (mr *MockRepositoryMockRecorder) DeleteBuilding(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppSession", reflect.TypeOf((*MockRepository)(nil).GetAppSession), ctx, sessionID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBuilding", reflect.TypeOf((*MockRepository)(nil).DeleteBuilding), ctx, id)
 }
 
-// GetKeysByRoom mocks base method.
-func (m *MockRepository) GetKeysByRoom(ctx context.Context, roomID model.RoomID) ([]model.Key, error) {
+// DeleteFloor mocks base method.
+func (m *MockRepository) DeleteFloor(ctx context.Context, id model.FloorID) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetKeysByRoom", ctx, roomID)
-	ret0, _ := ret[0].([]model.Key)
+	ret := m.ctrl.Call(m, "DeleteFloor", ctx, id)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetKeysByRoom indicates an expected call of GetKeysByRoom.
-func (mr *MockRepositoryMockRecorder) GetKeysByRoom(ctx, roomID any) *gomock.Call {
+// DeleteFloor indicates an expected call of DeleteFloor.
+func (mr *MockRepositoryMockRecorder) DeleteFloor(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKeysByRoom", reflect.TypeOf((*MockRepository)(nil).GetKeysByRoom), ctx, roomID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFloor", reflect.TypeOf((*MockRepository)(nil).DeleteFloor), ctx, id)
 }
 
-// GetOAuthState mocks base method.
-func (m *MockRepository) GetOAuthState(ctx context.Context, state string) (model.OAuthState, error) {
+// DeleteIdleRateLimits mocks base method.
+func (m *MockRepository) DeleteIdleRateLimits(ctx context.Context, before time.Time) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOAuthState", ctx, state)
-	ret0, _ := ret[0].(model.OAuthState)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteIdleRateLimits", ctx, before)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetOAuthState indicates an expected call of GetOAuthState.
-func (mr *MockRepositoryMockRecorder) GetOAuthState(ctx, state any) *gomock.Call {
+// DeleteIdleRateLimits indicates an expected call of DeleteIdleRateLimits.
+func (mr *MockRepositoryMockRecorder) DeleteIdleRateLimits(ctx, before any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOAuthState", reflect.TypeOf((*MockRepository)(nil).GetOAuthState), ctx, state)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIdleRateLimits", reflect.TypeOf((*MockRepository)(nil).DeleteIdleRateLimits), ctx, before)
 }
 
-// GetRoomByID mocks base method.
-func (m *MockRepository) GetRoomByID(ctx context.Context, id model.RoomID) (model.Room, error) {
+// DeleteLoginFailures mocks base method.
+func (m *MockRepository) DeleteLoginFailures(ctx context.Context, key string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRoomByID", ctx, id)
-	ret0, _ := ret[0].(model.Room)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteLoginFailures", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetRoomByID indicates an expected call of GetRoomByID.
-func (mr *MockRepositoryMockRecorder) GetRoomByID(ctx, id any) *gomock.Call {
+// DeleteLoginFailures indicates an expected call of DeleteLoginFailures.
+func (mr *MockRepositoryMockRecorder) DeleteLoginFailures(ctx, key any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoomByID", reflect.TypeOf((*MockRepository)(nil).GetRoomByID), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLoginFailures", reflect.TypeOf((*MockRepository)(nil).DeleteLoginFailures), ctx, key)
 }
 
-// GetRoomsByTenant mocks base method.
-func (m *MockRepository) GetRoomsByTenant(ctx context.Context, tenantID model.TenantID) ([]model.Room, error) {
+// DeleteNotificationDelivery mocks base method.
+func (m *MockRepository) DeleteNotificationDelivery(ctx context.Context, dedupKey string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRoomsByTenant", ctx, tenantID)
-	ret0, _ := ret[0].([]model.Room)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteNotificationDelivery", ctx, dedupKey)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetRoomsByTenant indicates an expected call of GetRoomsByTenant.
-func (mr *MockRepositoryMockRecorder) GetRoomsByTenant(ctx, tenantID any) *gomock.Call {
+// DeleteNotificationDelivery indicates an expected call of DeleteNotificationDelivery.
+func (mr *MockRepositoryMockRecorder) DeleteNotificationDelivery(ctx, dedupKey any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoomsByTenant", reflect.TypeOf((*MockRepository)(nil).GetRoomsByTenant), ctx, tenantID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotificationDelivery", reflect.TypeOf((*MockRepository)(nil).DeleteNotificationDelivery), ctx, dedupKey)
 }
 
-// GetSession mocks base method.
-func (m *MockRepository) GetSession(ctx context.Context, sessionID model.ConsoleSessionID) (model.ConsoleSession, error) {
+// DeleteOtherSessionsByOrganization mocks base method.
+func (m *MockRepository) DeleteOtherSessionsByOrganization(ctx context.Context, organizationID model.OrganizationID, currentSessionID model.ConsoleSessionID) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSession", ctx, sessionID)
-	ret0, _ := ret[0].(model.ConsoleSession)
+	ret := m.ctrl.Call(m, "DeleteOtherSessionsByOrganization", ctx, organizationID, currentSessionID)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSession indicates an expected call of GetSession.
-func (mr *MockRepositoryMockRecorder) GetSession(ctx, sessionID any) *gomock.Call {
+// DeleteOtherSessionsByOrganization indicates an expected call of DeleteOtherSessionsByOrganization.
+func (mr *MockRepositoryMockRecorder) DeleteOtherSessionsByOrganization(ctx, organizationID, currentSessionID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockRepository)(nil).GetSession), ctx, sessionID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOtherSessionsByOrganization", reflect.TypeOf((*MockRepository)(nil).DeleteOtherSessionsByOrganization), ctx, organizationID, currentSessionID)
 }
 
-// GetTenantByID mocks base method.
-func (m *MockRepository) GetTenantByID(ctx context.Context, id model.TenantID) (repository.TenantWithJoinCode, error) {
+// DeletePasskeyByUser mocks base method.
+func (m *MockRepository) DeletePasskeyByUser(ctx context.Context, userID model.UserID, id model.PasskeyID) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTenantByID", ctx, id)
-	ret0, _ := ret[0].(repository.TenantWithJoinCode)
+	ret := m.ctrl.Call(m, "DeletePasskeyByUser", ctx, userID, id)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTenantByID indicates an expected call of GetTenantByID.
-func (mr *MockRepositoryMockRecorder) GetTenantByID(ctx, id any) *gomock.Call {
+// DeletePasskeyByUser indicates an expected call of DeletePasskeyByUser.
+func (mr *MockRepositoryMockRecorder) DeletePasskeyByUser(ctx, userID, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenantByID", reflect.TypeOf((*MockRepository)(nil).GetTenantByID), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePasskeyByUser", reflect.TypeOf((*MockRepository)(nil).DeletePasskeyByUser), ctx, userID, id)
 }
 
-// GetTenantByJoinCode mocks base method.
-func (m *MockRepository) GetTenantByJoinCode(ctx context.Context, code model.TenantJoinCode) (model.Tenant, error) {
+// DeleteRoomAssignmentsByTenant mocks base method.
+func (m *MockRepository) DeleteRoomAssignmentsByTenant(ctx context.Context, tenantID model.TenantID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTenantByJoinCode", ctx, code)
-	ret0, _ := ret[0].(model.Tenant)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteRoomAssignmentsByTenant", ctx, tenantID)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetTenantByJoinCode indicates an expected call of GetTenantByJoinCode.
-func (mr *MockRepositoryMockRecorder) GetTenantByJoinCode(ctx, code any) *gomock.Call {
+// DeleteRoomAssignmentsByTenant indicates an expected call of DeleteRoomAssignmentsByTenant.
+func (mr *MockRepositoryMockRecorder) DeleteRoomAssignmentsByTenant(ctx, tenantID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenantByJoinCode", reflect.TypeOf((*MockRepository)(nil).GetTenantByJoinCode), ctx, code)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoomAssignmentsByTenant", reflect.TypeOf((*MockRepository)(nil).DeleteRoomAssignmentsByTenant), ctx, tenantID)
 }
 
-// GetTenantMembershipByTenantAndUser mocks base method.
-func (m *MockRepository) GetTenantMembershipByTenantAndUser(ctx context.Context, tenantID model.TenantID, userID model.UserID) (model.TenantMembership, error) {
+// DeleteRoomCustomField mocks base method.
+func (m *MockRepository) DeleteRoomCustomField(ctx context.Context, id model.RoomCustomFieldID) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTenantMembershipByTenantAndUser", ctx, tenantID, userID)
-	ret0, _ := ret[0].(model.TenantMembership)
+	ret := m.ctrl.Call(m, "DeleteRoomCustomField", ctx, id)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTenantMembershipByTenantAndUser indicates an expected call of GetTenantMembershipByTenantAndUser.
-func (mr *MockRepositoryMockRecorder) GetTenantMembershipByTenantAndUser(ctx, tenantID, userID any) *gomock.Call {
+// DeleteRoomCustomField indicates an expected call of DeleteRoomCustomField.
+func (mr *MockRepositoryMockRecorder) DeleteRoomCustomField(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenantMembershipByTenantAndUser", reflect.TypeOf((*MockRepository)(nil).GetTenantMembershipByTenantAndUser), ctx, tenantID, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoomCustomField", reflect.TypeOf((*MockRepository)(nil).DeleteRoomCustomField), ctx, id)
 }
 
-// GetTenantsByUserID mocks base method.
-func (m *MockRepository) GetTenantsByUserID(ctx context.Context, userID model.UserID) ([]repository.TenantWithMemberCount, error) {
+// DeleteRoomType mocks base method.
+func (m *MockRepository) DeleteRoomType(ctx context.Context, id model.RoomTypeID) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTenantsByUserID", ctx, userID)
-	ret0, _ := ret[0].([]repository.TenantWithMemberCount)
+	ret := m.ctrl.Call(m, "DeleteRoomType", ctx, id)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTenantsByUserID indicates an expected call of GetTenantsByUserID.
-func (mr *MockRepositoryMockRecorder) GetTenantsByUserID(ctx, userID any) *gomock.Call {
+// DeleteRoomType indicates an expected call of DeleteRoomType.
+func (mr *MockRepositoryMockRecorder) DeleteRoomType(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenantsByUserID", reflect.TypeOf((*MockRepository)(nil).GetTenantsByUserID), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoomType", reflect.TypeOf((*MockRepository)(nil).DeleteRoomType), ctx, id)
+}
+
+// DeleteSession mocks base method.
+func (m *MockRepository) DeleteSession(ctx context.Context, sessionID model.ConsoleSessionID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSession", ctx, sessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSession indicates an expected call of DeleteSession.
+func (mr *MockRepositoryMockRecorder) DeleteSession(ctx, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSession", reflect.TypeOf((*MockRepository)(nil).DeleteSession), ctx, sessionID)
+}
+
+// DeleteSessionByOrganization mocks base method.
+func (m *MockRepository) DeleteSessionByOrganization(ctx context.Context, organizationID model.OrganizationID, sessionID model.ConsoleSessionID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSessionByOrganization", ctx, organizationID, sessionID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSessionByOrganization indicates an expected call of DeleteSessionByOrganization.
+func (mr *MockRepositoryMockRecorder) DeleteSessionByOrganization(ctx, organizationID, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSessionByOrganization", reflect.TypeOf((*MockRepository)(nil).DeleteSessionByOrganization), ctx, organizationID, sessionID)
+}
+
+// DeleteSessionsByOperator mocks base method.
+func (m *MockRepository) DeleteSessionsByOperator(ctx context.Context, operatorID model.ConsoleOperatorID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSessionsByOperator", ctx, operatorID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSessionsByOperator indicates an expected call of DeleteSessionsByOperator.
+func (mr *MockRepositoryMockRecorder) DeleteSessionsByOperator(ctx, operatorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSessionsByOperator", reflect.TypeOf((*MockRepository)(nil).DeleteSessionsByOperator), ctx, operatorID)
+}
+
+// DeleteSessionsByOrganization mocks base method.
+func (m *MockRepository) DeleteSessionsByOrganization(ctx context.Context, organizationID model.OrganizationID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSessionsByOrganization", ctx, organizationID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSessionsByOrganization indicates an expected call of DeleteSessionsByOrganization.
+func (mr *MockRepositoryMockRecorder) DeleteSessionsByOrganization(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSessionsByOrganization", reflect.TypeOf((*MockRepository)(nil).DeleteSessionsByOrganization), ctx, organizationID)
+}
+
+// DeleteTenant mocks base method.
+func (m *MockRepository) DeleteTenant(ctx context.Context, id model.TenantID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTenant", ctx, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTenant indicates an expected call of DeleteTenant.
+func (mr *MockRepositoryMockRecorder) DeleteTenant(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTenant", reflect.TypeOf((*MockRepository)(nil).DeleteTenant), ctx, id)
+}
+
+// DeleteTenantGroupsByTenant mocks base method.
+func (m *MockRepository) DeleteTenantGroupsByTenant(ctx context.Context, tenantID model.TenantID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTenantGroupsByTenant", ctx, tenantID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTenantGroupsByTenant indicates an expected call of DeleteTenantGroupsByTenant.
+func (mr *MockRepositoryMockRecorder) DeleteTenantGroupsByTenant(ctx, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTenantGroupsByTenant", reflect.TypeOf((*MockRepository)(nil).DeleteTenantGroupsByTenant), ctx, tenantID)
+}
+
+// DeleteTenantJoinCodesByTenant mocks base method.
+func (m *MockRepository) DeleteTenantJoinCodesByTenant(ctx context.Context, tenantID model.TenantID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTenantJoinCodesByTenant", ctx, tenantID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTenantJoinCodesByTenant indicates an expected call of DeleteTenantJoinCodesByTenant.
+func (mr *MockRepositoryMockRecorder) DeleteTenantJoinCodesByTenant(ctx, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTenantJoinCodesByTenant", reflect.TypeOf((*MockRepository)(nil).DeleteTenantJoinCodesByTenant), ctx, tenantID)
+}
+
+// DeleteTenantMembershipsByTenant mocks base method.
+func (m *MockRepository) DeleteTenantMembershipsByTenant(ctx context.Context, tenantID model.TenantID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTenantMembershipsByTenant", ctx, tenantID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTenantMembershipsByTenant indicates an expected call of DeleteTenantMembershipsByTenant.
+func (mr *MockRepositoryMockRecorder) DeleteTenantMembershipsByTenant(ctx, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTenantMembershipsByTenant", reflect.TypeOf((*MockRepository)(nil).DeleteTenantMembershipsByTenant), ctx, tenantID)
+}
+
+// DeleteTenantType mocks base method.
+func (m *MockRepository) DeleteTenantType(ctx context.Context, id model.TenantTypeID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTenantType", ctx, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTenantType indicates an expected call of DeleteTenantType.
+func (mr *MockRepositoryMockRecorder) DeleteTenantType(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTenantType", reflect.TypeOf((*MockRepository)(nil).DeleteTenantType), ctx, id)
+}
+
+// DeleteWebhookSubscription mocks base method.
+func (m *MockRepository) DeleteWebhookSubscription(ctx context.Context, organizationID model.OrganizationID, id model.WebhookSubscriptionID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhookSubscription", ctx, organizationID, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWebhookSubscription indicates an expected call of DeleteWebhookSubscription.
+func (mr *MockRepositoryMockRecorder) DeleteWebhookSubscription(ctx, organizationID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhookSubscription", reflect.TypeOf((*MockRepository)(nil).DeleteWebhookSubscription), ctx, organizationID, id)
+}
+
+// DisableTenantJoinCodes mocks base method.
+func (m *MockRepository) DisableTenantJoinCodes(ctx context.Context, tenantID model.TenantID, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableTenantJoinCodes", ctx, tenantID, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableTenantJoinCodes indicates an expected call of DisableTenantJoinCodes.
+func (mr *MockRepositoryMockRecorder) DisableTenantJoinCodes(ctx, tenantID, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableTenantJoinCodes", reflect.TypeOf((*MockRepository)(nil).DisableTenantJoinCodes), ctx, tenantID, now)
+}
+
+// EndRoomAssignmentsByTenant mocks base method.
+func (m *MockRepository) EndRoomAssignmentsByTenant(ctx context.Context, tenantID model.TenantID, now time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndRoomAssignmentsByTenant", ctx, tenantID, now)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EndRoomAssignmentsByTenant indicates an expected call of EndRoomAssignmentsByTenant.
+func (mr *MockRepositoryMockRecorder) EndRoomAssignmentsByTenant(ctx, tenantID, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndRoomAssignmentsByTenant", reflect.TypeOf((*MockRepository)(nil).EndRoomAssignmentsByTenant), ctx, tenantID, now)
+}
+
+// EnsureAppSessionCSRFToken mocks base method.
+func (m *MockRepository) EnsureAppSessionCSRFToken(ctx context.Context, sessionID model.AppSessionID, token string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureAppSessionCSRFToken", ctx, sessionID, token)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureAppSessionCSRFToken indicates an expected call of EnsureAppSessionCSRFToken.
+func (mr *MockRepositoryMockRecorder) EnsureAppSessionCSRFToken(ctx, sessionID, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureAppSessionCSRFToken", reflect.TypeOf((*MockRepository)(nil).EnsureAppSessionCSRFToken), ctx, sessionID, token)
+}
+
+// ExtendAppSession mocks base method.
+func (m *MockRepository) ExtendAppSession(ctx context.Context, sessionID model.AppSessionID, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtendAppSession", ctx, sessionID, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExtendAppSession indicates an expected call of ExtendAppSession.
+func (mr *MockRepositoryMockRecorder) ExtendAppSession(ctx, sessionID, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtendAppSession", reflect.TypeOf((*MockRepository)(nil).ExtendAppSession), ctx, sessionID, expiresAt)
+}
+
+// ExtendSession mocks base method.
+func (m *MockRepository) ExtendSession(ctx context.Context, sessionID model.ConsoleSessionID, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtendSession", ctx, sessionID, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExtendSession indicates an expected call of ExtendSession.
+func (mr *MockRepositoryMockRecorder) ExtendSession(ctx, sessionID, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtendSession", reflect.TypeOf((*MockRepository)(nil).ExtendSession), ctx, sessionID, expiresAt)
+}
+
+// GetAPITokenByHash mocks base method.
+func (m *MockRepository) GetAPITokenByHash(ctx context.Context, tokenHash string) (model.APIToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAPITokenByHash", ctx, tokenHash)
+	ret0, _ := ret[0].(model.APIToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAPITokenByHash indicates an expected call of GetAPITokenByHash.
+func (mr *MockRepositoryMockRecorder) GetAPITokenByHash(ctx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAPITokenByHash", reflect.TypeOf((*MockRepository)(nil).GetAPITokenByHash), ctx, tokenHash)
+}
+
+// GetAppSession mocks base method.
+func (m *MockRepository) GetAppSession(ctx context.Context, sessionID model.AppSessionID) (model.AppSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAppSession", ctx, sessionID)
+	ret0, _ := ret[0].(model.AppSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAppSession indicates an expected call of GetAppSession.
+func (mr *MockRepositoryMockRecorder) GetAppSession(ctx, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppSession", reflect.TypeOf((*MockRepository)(nil).GetAppSession), ctx, sessionID)
+}
+
+// GetAssignedRoomIDsByTenant mocks base method.
+func (m *MockRepository) GetAssignedRoomIDsByTenant(ctx context.Context, tenantID model.TenantID) ([]model.RoomID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssignedRoomIDsByTenant", ctx, tenantID)
+	ret0, _ := ret[0].([]model.RoomID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAssignedRoomIDsByTenant indicates an expected call of GetAssignedRoomIDsByTenant.
+func (mr *MockRepositoryMockRecorder) GetAssignedRoomIDsByTenant(ctx, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssignedRoomIDsByTenant", reflect.TypeOf((*MockRepository)(nil).GetAssignedRoomIDsByTenant), ctx, tenantID)
+}
+
+// GetBuilding mocks base method.
+func (m *MockRepository) GetBuilding(ctx context.Context, id model.BuildingID) (model.Building, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBuilding", ctx, id)
+	ret0, _ := ret[0].(model.Building)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBuilding indicates an expected call of GetBuilding.
+func (mr *MockRepositoryMockRecorder) GetBuilding(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBuilding", reflect.TypeOf((*MockRepository)(nil).GetBuilding), ctx, id)
+}
+
+// GetBuildingByOrganizationAndName mocks base method.
+func (m *MockRepository) GetBuildingByOrganizationAndName(ctx context.Context, organizationID model.OrganizationID, name model.BuildingName) (model.Building, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBuildingByOrganizationAndName", ctx, organizationID, name)
+	ret0, _ := ret[0].(model.Building)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBuildingByOrganizationAndName indicates an expected call of GetBuildingByOrganizationAndName.
+func (mr *MockRepositoryMockRecorder) GetBuildingByOrganizationAndName(ctx, organizationID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBuildingByOrganizationAndName", reflect.TypeOf((*MockRepository)(nil).GetBuildingByOrganizationAndName), ctx, organizationID, name)
+}
+
+// GetConsoleOperatorByKeyHash mocks base method.
+func (m *MockRepository) GetConsoleOperatorByKeyHash(ctx context.Context, keyHash string) (model.ConsoleOperator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConsoleOperatorByKeyHash", ctx, keyHash)
+	ret0, _ := ret[0].(model.ConsoleOperator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConsoleOperatorByKeyHash indicates an expected call of GetConsoleOperatorByKeyHash.
+func (mr *MockRepositoryMockRecorder) GetConsoleOperatorByKeyHash(ctx, keyHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConsoleOperatorByKeyHash", reflect.TypeOf((*MockRepository)(nil).GetConsoleOperatorByKeyHash), ctx, keyHash)
+}
+
+// GetFloor mocks base method.
+func (m *MockRepository) GetFloor(ctx context.Context, id model.FloorID) (model.Floor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFloor", ctx, id)
+	ret0, _ := ret[0].(model.Floor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFloor indicates an expected call of GetFloor.
+func (mr *MockRepositoryMockRecorder) GetFloor(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFloor", reflect.TypeOf((*MockRepository)(nil).GetFloor), ctx, id)
+}
+
+// GetFloorByBuildingAndName mocks base method.
+func (m *MockRepository) GetFloorByBuildingAndName(ctx context.Context, buildingID model.BuildingID, name model.FloorNumber) (model.Floor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFloorByBuildingAndName", ctx, buildingID, name)
+	ret0, _ := ret[0].(model.Floor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFloorByBuildingAndName indicates an expected call of GetFloorByBuildingAndName.
+func (mr *MockRepositoryMockRecorder) GetFloorByBuildingAndName(ctx, buildingID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFloorByBuildingAndName", reflect.TypeOf((*MockRepository)(nil).GetFloorByBuildingAndName), ctx, buildingID, name)
+}
+
+// GetKeyByID mocks base method.
+func (m *MockRepository) GetKeyByID(ctx context.Context, id model.KeyID) (model.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKeyByID", ctx, id)
+	ret0, _ := ret[0].(model.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKeyByID indicates an expected call of GetKeyByID.
+func (mr *MockRepositoryMockRecorder) GetKeyByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKeyByID", reflect.TypeOf((*MockRepository)(nil).GetKeyByID), ctx, id)
+}
+
+// GetKeysByOrganization mocks base method.
+func (m *MockRepository) GetKeysByOrganization(ctx context.Context, organizationID model.OrganizationID) ([]model.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKeysByOrganization", ctx, organizationID)
+	ret0, _ := ret[0].([]model.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKeysByOrganization indicates an expected call of GetKeysByOrganization.
+func (mr *MockRepositoryMockRecorder) GetKeysByOrganization(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKeysByOrganization", reflect.TypeOf((*MockRepository)(nil).GetKeysByOrganization), ctx, organizationID)
+}
+
+// GetKeysByRoom mocks base method.
+func (m *MockRepository) GetKeysByRoom(ctx context.Context, roomID model.RoomID) ([]model.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKeysByRoom", ctx, roomID)
+	ret0, _ := ret[0].([]model.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKeysByRoom indicates an expected call of GetKeysByRoom.
+func (mr *MockRepositoryMockRecorder) GetKeysByRoom(ctx, roomID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKeysByRoom", reflect.TypeOf((*MockRepository)(nil).GetKeysByRoom), ctx, roomID)
+}
+
+// GetLoginFailures mocks base method.
+func (m *MockRepository) GetLoginFailures(ctx context.Context, key string) (model.LoginFailures, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoginFailures", ctx, key)
+	ret0, _ := ret[0].(model.LoginFailures)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoginFailures indicates an expected call of GetLoginFailures.
+func (mr *MockRepositoryMockRecorder) GetLoginFailures(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoginFailures", reflect.TypeOf((*MockRepository)(nil).GetLoginFailures), ctx, key)
+}
+
+// GetNotificationPreferences mocks base method.
+func (m *MockRepository) GetNotificationPreferences(ctx context.Context, userID model.UserID) (model.NotificationPreferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationPreferences", ctx, userID)
+	ret0, _ := ret[0].(model.NotificationPreferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationPreferences indicates an expected call of GetNotificationPreferences.
+func (mr *MockRepositoryMockRecorder) GetNotificationPreferences(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationPreferences", reflect.TypeOf((*MockRepository)(nil).GetNotificationPreferences), ctx, userID)
+}
+
+// GetOAuthState mocks base method.
+func (m *MockRepository) GetOAuthState(ctx context.Context, state string) (model.OAuthState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOAuthState", ctx, state)
+	ret0, _ := ret[0].(model.OAuthState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOAuthState indicates an expected call of GetOAuthState.
+func (mr *MockRepositoryMockRecorder) GetOAuthState(ctx, state any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOAuthState", reflect.TypeOf((*MockRepository)(nil).GetOAuthState), ctx, state)
+}
+
+// GetOrganization mocks base method.
+func (m *MockRepository) GetOrganization(ctx context.Context, id model.OrganizationID) (model.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganization", ctx, id)
+	ret0, _ := ret[0].(model.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganization indicates an expected call of GetOrganization.
+func (mr *MockRepositoryMockRecorder) GetOrganization(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganization", reflect.TypeOf((*MockRepository)(nil).GetOrganization), ctx, id)
+}
+
+// GetOrganizationBySlug mocks base method.
+func (m *MockRepository) GetOrganizationBySlug(ctx context.Context, slug model.OrganizationSlug) (model.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationBySlug", ctx, slug)
+	ret0, _ := ret[0].(model.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationBySlug indicates an expected call of GetOrganizationBySlug.
+func (mr *MockRepositoryMockRecorder) GetOrganizationBySlug(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationBySlug", reflect.TypeOf((*MockRepository)(nil).GetOrganizationBySlug), ctx, slug)
+}
+
+// GetOrganizationKeyHash mocks base method.
+func (m *MockRepository) GetOrganizationKeyHash(ctx context.Context, id model.OrganizationID) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationKeyHash", ctx, id)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationKeyHash indicates an expected call of GetOrganizationKeyHash.
+func (mr *MockRepositoryMockRecorder) GetOrganizationKeyHash(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationKeyHash", reflect.TypeOf((*MockRepository)(nil).GetOrganizationKeyHash), ctx, id)
+}
+
+// GetRoomByID mocks base method.
+func (m *MockRepository) GetRoomByID(ctx context.Context, id model.RoomID) (model.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoomByID", ctx, id)
+	ret0, _ := ret[0].(model.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoomByID indicates an expected call of GetRoomByID.
+func (mr *MockRepositoryMockRecorder) GetRoomByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoomByID", reflect.TypeOf((*MockRepository)(nil).GetRoomByID), ctx, id)
+}
+
+// GetRoomCustomField mocks base method.
+func (m *MockRepository) GetRoomCustomField(ctx context.Context, id model.RoomCustomFieldID) (model.RoomCustomField, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoomCustomField", ctx, id)
+	ret0, _ := ret[0].(model.RoomCustomField)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoomCustomField indicates an expected call of GetRoomCustomField.
+func (mr *MockRepositoryMockRecorder) GetRoomCustomField(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoomCustomField", reflect.TypeOf((*MockRepository)(nil).GetRoomCustomField), ctx, id)
+}
+
+// GetRoomType mocks base method.
+func (m *MockRepository) GetRoomType(ctx context.Context, id model.RoomTypeID) (model.RoomTypeDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoomType", ctx, id)
+	ret0, _ := ret[0].(model.RoomTypeDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoomType indicates an expected call of GetRoomType.
+func (mr *MockRepositoryMockRecorder) GetRoomType(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoomType", reflect.TypeOf((*MockRepository)(nil).GetRoomType), ctx, id)
+}
+
+// GetRoomsByTenant mocks base method.
+func (m *MockRepository) GetRoomsByTenant(ctx context.Context, tenantID model.TenantID, userID model.UserID, filter model.RoomFilter) ([]repository.AccessibleRoom, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoomsByTenant", ctx, tenantID, userID, filter)
+	ret0, _ := ret[0].([]repository.AccessibleRoom)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoomsByTenant indicates an expected call of GetRoomsByTenant.
+func (mr *MockRepositoryMockRecorder) GetRoomsByTenant(ctx, tenantID, userID, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoomsByTenant", reflect.TypeOf((*MockRepository)(nil).GetRoomsByTenant), ctx, tenantID, userID, filter)
+}
+
+// GetSession mocks base method.
+func (m *MockRepository) GetSession(ctx context.Context, sessionID model.ConsoleSessionID) (model.ConsoleSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSession", ctx, sessionID)
+	ret0, _ := ret[0].(model.ConsoleSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSession indicates an expected call of GetSession.
+func (mr *MockRepositoryMockRecorder) GetSession(ctx, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockRepository)(nil).GetSession), ctx, sessionID)
+}
+
+// GetTenantByID mocks base method.
+func (m *MockRepository) GetTenantByID(ctx context.Context, id model.TenantID) (repository.TenantWithJoinCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTenantByID", ctx, id)
+	ret0, _ := ret[0].(repository.TenantWithJoinCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTenantByID indicates an expected call of GetTenantByID.
+func (mr *MockRepositoryMockRecorder) GetTenantByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenantByID", reflect.TypeOf((*MockRepository)(nil).GetTenantByID), ctx, id)
+}
+
+// GetTenantByJoinCode mocks base method.
+func (m *MockRepository) GetTenantByJoinCode(ctx context.Context, organizationID model.OrganizationID, code model.TenantJoinCode) (model.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTenantByJoinCode", ctx, organizationID, code)
+	ret0, _ := ret[0].(model.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTenantByJoinCode indicates an expected call of GetTenantByJoinCode.
+func (mr *MockRepositoryMockRecorder) GetTenantByJoinCode(ctx, organizationID, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenantByJoinCode", reflect.TypeOf((*MockRepository)(nil).GetTenantByJoinCode), ctx, organizationID, code)
+}
+
+// GetTenantGroup mocks base method.
+func (m *MockRepository) GetTenantGroup(ctx context.Context, id model.TenantGroupID) (model.TenantGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTenantGroup", ctx, id)
+	ret0, _ := ret[0].(model.TenantGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTenantGroup indicates an expected call of GetTenantGroup.
+func (mr *MockRepositoryMockRecorder) GetTenantGroup(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenantGroup", reflect.TypeOf((*MockRepository)(nil).GetTenantGroup), ctx, id)
+}
+
+// GetTenantGroupByTenantAndName mocks base method.
+func (m *MockRepository) GetTenantGroupByTenantAndName(ctx context.Context, tenantID model.TenantID, name model.TenantGroupName) (model.TenantGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTenantGroupByTenantAndName", ctx, tenantID, name)
+	ret0, _ := ret[0].(model.TenantGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTenantGroupByTenantAndName indicates an expected call of GetTenantGroupByTenantAndName.
+func (mr *MockRepositoryMockRecorder) GetTenantGroupByTenantAndName(ctx, tenantID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenantGroupByTenantAndName", reflect.TypeOf((*MockRepository)(nil).GetTenantGroupByTenantAndName), ctx, tenantID, name)
+}
+
+// GetTenantMembershipByTenantAndUser mocks base method.
+func (m *MockRepository) GetTenantMembershipByTenantAndUser(ctx context.Context, tenantID model.TenantID, userID model.UserID) (model.TenantMembership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTenantMembershipByTenantAndUser", ctx, tenantID, userID)
+	ret0, _ := ret[0].(model.TenantMembership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTenantMembershipByTenantAndUser indicates an expected call of GetTenantMembershipByTenantAndUser.
+func (mr *MockRepositoryMockRecorder) GetTenantMembershipByTenantAndUser(ctx, tenantID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenantMembershipByTenantAndUser", reflect.TypeOf((*MockRepository)(nil).GetTenantMembershipByTenantAndUser), ctx, tenantID, userID)
+}
+
+// GetTenantType mocks base method.
+func (m *MockRepository) GetTenantType(ctx context.Context, id model.TenantTypeID) (model.TenantTypeDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTenantType", ctx, id)
+	ret0, _ := ret[0].(model.TenantTypeDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTenantType indicates an expected call of GetTenantType.
+func (mr *MockRepositoryMockRecorder) GetTenantType(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenantType", reflect.TypeOf((*MockRepository)(nil).GetTenantType), ctx, id)
+}
+
+// GetUser mocks base method.
+func (m *MockRepository) GetUser(ctx context.Context, userID model.UserID) (model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", ctx, userID)
+	ret0, _ := ret[0].(model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockRepositoryMockRecorder) GetUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockRepository)(nil).GetUser), ctx, userID)
+}
+
+// GetUserByProviderIdentity mocks base method.
+func (m *MockRepository) GetUserByProviderIdentity(ctx context.Context, provider, providerSub string) (model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByProviderIdentity", ctx, provider, providerSub)
+	ret0, _ := ret[0].(model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByProviderIdentity indicates an expected call of GetUserByProviderIdentity.
+func (mr *MockRepositoryMockRecorder) GetUserByProviderIdentity(ctx, provider, providerSub any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByProviderIdentity", reflect.TypeOf((*MockRepository)(nil).GetUserByProviderIdentity), ctx, provider, providerSub)
+}
+
+// GetUserProviderSub mocks base method.
+func (m *MockRepository) GetUserProviderSub(ctx context.Context, userID model.UserID, provider string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserProviderSub", ctx, userID, provider)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserProviderSub indicates an expected call of GetUserProviderSub.
+func (mr *MockRepositoryMockRecorder) GetUserProviderSub(ctx, userID, provider any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserProviderSub", reflect.TypeOf((*MockRepository)(nil).GetUserProviderSub), ctx, userID, provider)
+}
+
+// GetWebhookSubscription mocks base method.
+func (m *MockRepository) GetWebhookSubscription(ctx context.Context, organizationID model.OrganizationID, id model.WebhookSubscriptionID) (model.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookSubscription", ctx, organizationID, id)
+	ret0, _ := ret[0].(model.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookSubscription indicates an expected call of GetWebhookSubscription.
+func (mr *MockRepositoryMockRecorder) GetWebhookSubscription(ctx, organizationID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookSubscription", reflect.TypeOf((*MockRepository)(nil).GetWebhookSubscription), ctx, organizationID, id)
+}
+
+// HasSucceededWebhookDelivery mocks base method.
+func (m *MockRepository) HasSucceededWebhookDelivery(ctx context.Context, subscriptionID model.WebhookSubscriptionID, eventID model.OutboxEventID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasSucceededWebhookDelivery", ctx, subscriptionID, eventID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasSucceededWebhookDelivery indicates an expected call of HasSucceededWebhookDelivery.
+func (mr *MockRepositoryMockRecorder) HasSucceededWebhookDelivery(ctx, subscriptionID, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasSucceededWebhookDelivery", reflect.TypeOf((*MockRepository)(nil).HasSucceededWebhookDelivery), ctx, subscriptionID, eventID)
+}
+
+// IncrementJoinCodeUsedCount mocks base method.
+func (m *MockRepository) IncrementJoinCodeUsedCount(ctx context.Context, code model.TenantJoinCode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementJoinCodeUsedCount", ctx, code)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncrementJoinCodeUsedCount indicates an expected call of IncrementJoinCodeUsedCount.
+func (mr *MockRepositoryMockRecorder) IncrementJoinCodeUsedCount(ctx, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementJoinCodeUsedCount", reflect.TypeOf((*MockRepository)(nil).IncrementJoinCodeUsedCount), ctx, code)
+}
+
+// ListAPITokensByOrganization mocks base method.
+func (m *MockRepository) ListAPITokensByOrganization(ctx context.Context, organizationID model.OrganizationID) ([]model.APIToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAPITokensByOrganization", ctx, organizationID)
+	ret0, _ := ret[0].([]model.APIToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAPITokensByOrganization indicates an expected call of ListAPITokensByOrganization.
+func (mr *MockRepositoryMockRecorder) ListAPITokensByOrganization(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPITokensByOrganization", reflect.TypeOf((*MockRepository)(nil).ListAPITokensByOrganization), ctx, organizationID)
+}
+
+// ListAPITokensByUser mocks base method.
+func (m *MockRepository) ListAPITokensByUser(ctx context.Context, userID model.UserID) ([]model.APIToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAPITokensByUser", ctx, userID)
+	ret0, _ := ret[0].([]model.APIToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAPITokensByUser indicates an expected call of ListAPITokensByUser.
+func (mr *MockRepositoryMockRecorder) ListAPITokensByUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPITokensByUser", reflect.TypeOf((*MockRepository)(nil).ListAPITokensByUser), ctx, userID)
+}
+
+// ListActiveAppSessionsByUser mocks base method.
+func (m *MockRepository) ListActiveAppSessionsByUser(ctx context.Context, userID model.UserID) ([]model.AppSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveAppSessionsByUser", ctx, userID)
+	ret0, _ := ret[0].([]model.AppSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveAppSessionsByUser indicates an expected call of ListActiveAppSessionsByUser.
+func (mr *MockRepositoryMockRecorder) ListActiveAppSessionsByUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveAppSessionsByUser", reflect.TypeOf((*MockRepository)(nil).ListActiveAppSessionsByUser), ctx, userID)
+}
+
+// ListActiveSessionsByOrganization mocks base method.
+func (m *MockRepository) ListActiveSessionsByOrganization(ctx context.Context, organizationID model.OrganizationID) ([]model.ConsoleSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveSessionsByOrganization", ctx, organizationID)
+	ret0, _ := ret[0].([]model.ConsoleSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveSessionsByOrganization indicates an expected call of ListActiveSessionsByOrganization.
+func (mr *MockRepositoryMockRecorder) ListActiveSessionsByOrganization(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveSessionsByOrganization", reflect.TypeOf((*MockRepository)(nil).ListActiveSessionsByOrganization), ctx, organizationID)
+}
+
+// ListAuditLogOrganizationIDs mocks base method.
+func (m *MockRepository) ListAuditLogOrganizationIDs(ctx context.Context) ([]model.OrganizationID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditLogOrganizationIDs", ctx)
+	ret0, _ := ret[0].([]model.OrganizationID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditLogOrganizationIDs indicates an expected call of ListAuditLogOrganizationIDs.
+func (mr *MockRepositoryMockRecorder) ListAuditLogOrganizationIDs(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditLogOrganizationIDs", reflect.TypeOf((*MockRepository)(nil).ListAuditLogOrganizationIDs), ctx)
+}
+
+// ListAuditLogsBySeq mocks base method.
+func (m *MockRepository) ListAuditLogsBySeq(ctx context.Context, organizationID model.OrganizationID, afterSeq int64, limit int32) ([]model.AuditLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditLogsBySeq", ctx, organizationID, afterSeq, limit)
+	ret0, _ := ret[0].([]model.AuditLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditLogsBySeq indicates an expected call of ListAuditLogsBySeq.
+func (mr *MockRepositoryMockRecorder) ListAuditLogsBySeq(ctx, organizationID, afterSeq, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditLogsBySeq", reflect.TypeOf((*MockRepository)(nil).ListAuditLogsBySeq), ctx, organizationID, afterSeq, limit)
+}
+
+// ListBuildings mocks base method.
+func (m *MockRepository) ListBuildings(ctx context.Context) ([]model.Building, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBuildings", ctx)
+	ret0, _ := ret[0].([]model.Building)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBuildings indicates an expected call of ListBuildings.
+func (mr *MockRepositoryMockRecorder) ListBuildings(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBuildings", reflect.TypeOf((*MockRepository)(nil).ListBuildings), ctx)
+}
+
+// ListConsoleOperatorsByOrganization mocks base method.
+func (m *MockRepository) ListConsoleOperatorsByOrganization(ctx context.Context, organizationID model.OrganizationID) ([]model.ConsoleOperator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListConsoleOperatorsByOrganization", ctx, organizationID)
+	ret0, _ := ret[0].([]model.ConsoleOperator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConsoleOperatorsByOrganization indicates an expected call of ListConsoleOperatorsByOrganization.
+func (mr *MockRepositoryMockRecorder) ListConsoleOperatorsByOrganization(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConsoleOperatorsByOrganization", reflect.TypeOf((*MockRepository)(nil).ListConsoleOperatorsByOrganization), ctx, organizationID)
+}
+
+// ListExpiringRoomAssignments mocks base method.
+func (m *MockRepository) ListExpiringRoomAssignments(ctx context.Context, now, until time.Time) ([]repository.ExpiringRoomAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExpiringRoomAssignments", ctx, now, until)
+	ret0, _ := ret[0].([]repository.ExpiringRoomAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExpiringRoomAssignments indicates an expected call of ListExpiringRoomAssignments.
+func (mr *MockRepositoryMockRecorder) ListExpiringRoomAssignments(ctx, now, until any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExpiringRoomAssignments", reflect.TypeOf((*MockRepository)(nil).ListExpiringRoomAssignments), ctx, now, until)
+}
+
+// ListFloors mocks base method.
+func (m *MockRepository) ListFloors(ctx context.Context) ([]model.Floor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFloors", ctx)
+	ret0, _ := ret[0].([]model.Floor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFloors indicates an expected call of ListFloors.
+func (mr *MockRepositoryMockRecorder) ListFloors(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFloors", reflect.TypeOf((*MockRepository)(nil).ListFloors), ctx)
+}
+
+// ListKeysByRoom mocks base method.
+func (m *MockRepository) ListKeysByRoom(ctx context.Context, arg repository.ListKeysByRoomArg) ([]model.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListKeysByRoom", ctx, arg)
+	ret0, _ := ret[0].([]model.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListKeysByRoom indicates an expected call of ListKeysByRoom.
+func (mr *MockRepositoryMockRecorder) ListKeysByRoom(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKeysByRoom", reflect.TypeOf((*MockRepository)(nil).ListKeysByRoom), ctx, arg)
+}
+
+// ListOrganizations mocks base method.
+func (m *MockRepository) ListOrganizations(ctx context.Context) ([]model.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrganizations", ctx)
+	ret0, _ := ret[0].([]model.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrganizations indicates an expected call of ListOrganizations.
+func (mr *MockRepositoryMockRecorder) ListOrganizations(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrganizations", reflect.TypeOf((*MockRepository)(nil).ListOrganizations), ctx)
+}
+
+// ListPasskeysByUser mocks base method.
+func (m *MockRepository) ListPasskeysByUser(ctx context.Context, userID model.UserID) ([]model.Passkey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPasskeysByUser", ctx, userID)
+	ret0, _ := ret[0].([]model.Passkey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPasskeysByUser indicates an expected call of ListPasskeysByUser.
+func (mr *MockRepositoryMockRecorder) ListPasskeysByUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPasskeysByUser", reflect.TypeOf((*MockRepository)(nil).ListPasskeysByUser), ctx, userID)
+}
+
+// ListRoomCustomFields mocks base method.
+func (m *MockRepository) ListRoomCustomFields(ctx context.Context) (model.RoomCustomFieldSchema, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoomCustomFields", ctx)
+	ret0, _ := ret[0].(model.RoomCustomFieldSchema)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoomCustomFields indicates an expected call of ListRoomCustomFields.
+func (mr *MockRepositoryMockRecorder) ListRoomCustomFields(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoomCustomFields", reflect.TypeOf((*MockRepository)(nil).ListRoomCustomFields), ctx)
+}
+
+// ListRoomTypes mocks base method.
+func (m *MockRepository) ListRoomTypes(ctx context.Context) (model.RoomTypeCatalog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoomTypes", ctx)
+	ret0, _ := ret[0].(model.RoomTypeCatalog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoomTypes indicates an expected call of ListRoomTypes.
+func (mr *MockRepositoryMockRecorder) ListRoomTypes(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoomTypes", reflect.TypeOf((*MockRepository)(nil).ListRoomTypes), ctx)
+}
+
+// ListRooms mocks base method.
+func (m *MockRepository) ListRooms(ctx context.Context, arg repository.ListRoomsArg) ([]model.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRooms", ctx, arg)
+	ret0, _ := ret[0].([]model.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRooms indicates an expected call of ListRooms.
+func (mr *MockRepositoryMockRecorder) ListRooms(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRooms", reflect.TypeOf((*MockRepository)(nil).ListRooms), ctx, arg)
+}
+
+// ListTenantGroupMembers mocks base method.
+func (m *MockRepository) ListTenantGroupMembers(ctx context.Context, groupID model.TenantGroupID) ([]model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTenantGroupMembers", ctx, groupID)
+	ret0, _ := ret[0].([]model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTenantGroupMembers indicates an expected call of ListTenantGroupMembers.
+func (mr *MockRepositoryMockRecorder) ListTenantGroupMembers(ctx, groupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTenantGroupMembers", reflect.TypeOf((*MockRepository)(nil).ListTenantGroupMembers), ctx, groupID)
+}
+
+// ListTenantGroupsByTenant mocks base method.
+func (m *MockRepository) ListTenantGroupsByTenant(ctx context.Context, tenantID model.TenantID) ([]repository.TenantGroupWithMemberCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTenantGroupsByTenant", ctx, tenantID)
+	ret0, _ := ret[0].([]repository.TenantGroupWithMemberCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTenantGroupsByTenant indicates an expected call of ListTenantGroupsByTenant.
+func (mr *MockRepositoryMockRecorder) ListTenantGroupsByTenant(ctx, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTenantGroupsByTenant", reflect.TypeOf((*MockRepository)(nil).ListTenantGroupsByTenant), ctx, tenantID)
+}
+
+// ListTenantNotificationRecipients mocks base method.
+func (m *MockRepository) ListTenantNotificationRecipients(ctx context.Context, tenantID model.TenantID, adminsOnly bool) ([]model.NotificationRecipient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTenantNotificationRecipients", ctx, tenantID, adminsOnly)
+	ret0, _ := ret[0].([]model.NotificationRecipient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTenantNotificationRecipients indicates an expected call of ListTenantNotificationRecipients.
+func (mr *MockRepositoryMockRecorder) ListTenantNotificationRecipients(ctx, tenantID, adminsOnly any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTenantNotificationRecipients", reflect.TypeOf((*MockRepository)(nil).ListTenantNotificationRecipients), ctx, tenantID, adminsOnly)
+}
+
+// ListTenantTypes mocks base method.
+func (m *MockRepository) ListTenantTypes(ctx context.Context) (model.TenantTypeCatalog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTenantTypes", ctx)
+	ret0, _ := ret[0].(model.TenantTypeCatalog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTenantTypes indicates an expected call of ListTenantTypes.
+func (mr *MockRepositoryMockRecorder) ListTenantTypes(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTenantTypes", reflect.TypeOf((*MockRepository)(nil).ListTenantTypes), ctx)
+}
+
+// ListTenants mocks base method.
+func (m *MockRepository) ListTenants(ctx context.Context, arg repository.ListTenantsArg) ([]model.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTenants", ctx, arg)
+	ret0, _ := ret[0].([]model.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTenants indicates an expected call of ListTenants.
+func (mr *MockRepositoryMockRecorder) ListTenants(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTenants", reflect.TypeOf((*MockRepository)(nil).ListTenants), ctx, arg)
+}
+
+// ListTenantsByUserID mocks base method.
+func (m *MockRepository) ListTenantsByUserID(ctx context.Context, userID model.UserID, arg repository.ListTenantsArg) ([]repository.TenantWithMemberCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTenantsByUserID", ctx, userID, arg)
+	ret0, _ := ret[0].([]repository.TenantWithMemberCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTenantsByUserID indicates an expected call of ListTenantsByUserID.
+func (mr *MockRepositoryMockRecorder) ListTenantsByUserID(ctx, userID, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTenantsByUserID", reflect.TypeOf((*MockRepository)(nil).ListTenantsByUserID), ctx, userID, arg)
+}
+
+// ListWebhookDeliveries mocks base method.
+func (m *MockRepository) ListWebhookDeliveries(ctx context.Context, organizationID model.OrganizationID, subscriptionID model.WebhookSubscriptionID, limit int32) ([]model.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhookDeliveries", ctx, organizationID, subscriptionID, limit)
+	ret0, _ := ret[0].([]model.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhookDeliveries indicates an expected call of ListWebhookDeliveries.
+func (mr *MockRepositoryMockRecorder) ListWebhookDeliveries(ctx, organizationID, subscriptionID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookDeliveries", reflect.TypeOf((*MockRepository)(nil).ListWebhookDeliveries), ctx, organizationID, subscriptionID, limit)
+}
+
+// ListWebhookSubscriptionsByEventType mocks base method.
+func (m *MockRepository) ListWebhookSubscriptionsByEventType(ctx context.Context, organizationID model.OrganizationID, eventType model.DomainEventType) ([]model.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhookSubscriptionsByEventType", ctx, organizationID, eventType)
+	ret0, _ := ret[0].([]model.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhookSubscriptionsByEventType indicates an expected call of ListWebhookSubscriptionsByEventType.
+func (mr *MockRepositoryMockRecorder) ListWebhookSubscriptionsByEventType(ctx, organizationID, eventType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookSubscriptionsByEventType", reflect.TypeOf((*MockRepository)(nil).ListWebhookSubscriptionsByEventType), ctx, organizationID, eventType)
+}
+
+// ListWebhookSubscriptionsByOrganization mocks base method.
+func (m *MockRepository) ListWebhookSubscriptionsByOrganization(ctx context.Context, organizationID model.OrganizationID) ([]model.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhookSubscriptionsByOrganization", ctx, organizationID)
+	ret0, _ := ret[0].([]model.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhookSubscriptionsByOrganization indicates an expected call of ListWebhookSubscriptionsByOrganization.
+func (mr *MockRepositoryMockRecorder) ListWebhookSubscriptionsByOrganization(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookSubscriptionsByOrganization", reflect.TypeOf((*MockRepository)(nil).ListWebhookSubscriptionsByOrganization), ctx, organizationID)
+}
+
+// LockAuditChain mocks base method.
+func (m *MockRepository) LockAuditChain(ctx context.Context, organizationID model.OrganizationID) (model.AuditChainHead, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockAuditChain", ctx, organizationID)
+	ret0, _ := ret[0].(model.AuditChainHead)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockAuditChain indicates an expected call of LockAuditChain.
+func (mr *MockRepositoryMockRecorder) LockAuditChain(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockAuditChain", reflect.TypeOf((*MockRepository)(nil).LockAuditChain), ctx, organizationID)
+}
+
+// LockLoginFailures mocks base method.
+func (m *MockRepository) LockLoginFailures(ctx context.Context, key string, now time.Time) (model.LoginFailures, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockLoginFailures", ctx, key, now)
+	ret0, _ := ret[0].(model.LoginFailures)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockLoginFailures indicates an expected call of LockLoginFailures.
+func (mr *MockRepositoryMockRecorder) LockLoginFailures(ctx, key, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockLoginFailures", reflect.TypeOf((*MockRepository)(nil).LockLoginFailures), ctx, key, now)
+}
+
+// LockRateLimitBucket mocks base method.
+func (m *MockRepository) LockRateLimitBucket(ctx context.Context, key string, initial model.RateLimitBucket) (model.RateLimitBucket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockRateLimitBucket", ctx, key, initial)
+	ret0, _ := ret[0].(model.RateLimitBucket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockRateLimitBucket indicates an expected call of LockRateLimitBucket.
+func (mr *MockRepositoryMockRecorder) LockRateLimitBucket(ctx, key, initial any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockRateLimitBucket", reflect.TypeOf((*MockRepository)(nil).LockRateLimitBucket), ctx, key, initial)
+}
+
+// RemoveRoomCustomFieldValues mocks base method.
+func (m *MockRepository) RemoveRoomCustomFieldValues(ctx context.Context, organizationID model.OrganizationID, key model.RoomCustomFieldKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveRoomCustomFieldValues", ctx, organizationID, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveRoomCustomFieldValues indicates an expected call of RemoveRoomCustomFieldValues.
+func (mr *MockRepositoryMockRecorder) RemoveRoomCustomFieldValues(ctx, organizationID, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRoomCustomFieldValues", reflect.TypeOf((*MockRepository)(nil).RemoveRoomCustomFieldValues), ctx, organizationID, key)
+}
+
+// RemoveTenantGroupMember mocks base method.
+func (m *MockRepository) RemoveTenantGroupMember(ctx context.Context, groupID model.TenantGroupID, userID model.UserID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTenantGroupMember", ctx, groupID, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveTenantGroupMember indicates an expected call of RemoveTenantGroupMember.
+func (mr *MockRepositoryMockRecorder) RemoveTenantGroupMember(ctx, groupID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTenantGroupMember", reflect.TypeOf((*MockRepository)(nil).RemoveTenantGroupMember), ctx, groupID, userID)
+}
+
+// RevokeAPITokenByOrganization mocks base method.
+func (m *MockRepository) RevokeAPITokenByOrganization(ctx context.Context, organizationID model.OrganizationID, id model.APITokenID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAPITokenByOrganization", ctx, organizationID, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAPITokenByOrganization indicates an expected call of RevokeAPITokenByOrganization.
+func (mr *MockRepositoryMockRecorder) RevokeAPITokenByOrganization(ctx, organizationID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPITokenByOrganization", reflect.TypeOf((*MockRepository)(nil).RevokeAPITokenByOrganization), ctx, organizationID, id)
+}
+
+// RevokeAPITokenByUser mocks base method.
+func (m *MockRepository) RevokeAPITokenByUser(ctx context.Context, userID model.UserID, id model.APITokenID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAPITokenByUser", ctx, userID, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAPITokenByUser indicates an expected call of RevokeAPITokenByUser.
+func (mr *MockRepositoryMockRecorder) RevokeAPITokenByUser(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPITokenByUser", reflect.TypeOf((*MockRepository)(nil).RevokeAPITokenByUser), ctx, userID, id)
+}
+
+// RevokeAppSession mocks base method.
+func (m *MockRepository) RevokeAppSession(ctx context.Context, sessionID model.AppSessionID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAppSession", ctx, sessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAppSession indicates an expected call of RevokeAppSession.
+func (mr *MockRepositoryMockRecorder) RevokeAppSession(ctx, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAppSession", reflect.TypeOf((*MockRepository)(nil).RevokeAppSession), ctx, sessionID)
+}
+
+// RevokeAppSessionByUser mocks base method.
+func (m *MockRepository) RevokeAppSessionByUser(ctx context.Context, userID model.UserID, sessionID model.AppSessionID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAppSessionByUser", ctx, userID, sessionID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAppSessionByUser indicates an expected call of RevokeAppSessionByUser.
+func (mr *MockRepositoryMockRecorder) RevokeAppSessionByUser(ctx, userID, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAppSessionByUser", reflect.TypeOf((*MockRepository)(nil).RevokeAppSessionByUser), ctx, userID, sessionID)
+}
+
+// RevokeConsoleOperator mocks base method.
+func (m *MockRepository) RevokeConsoleOperator(ctx context.Context, organizationID model.OrganizationID, id model.ConsoleOperatorID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeConsoleOperator", ctx, organizationID, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeConsoleOperator indicates an expected call of RevokeConsoleOperator.
+func (mr *MockRepositoryMockRecorder) RevokeConsoleOperator(ctx, organizationID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeConsoleOperator", reflect.TypeOf((*MockRepository)(nil).RevokeConsoleOperator), ctx, organizationID, id)
+}
+
+// RevokeOtherAppSessionsByUser mocks base method.
+func (m *MockRepository) RevokeOtherAppSessionsByUser(ctx context.Context, userID model.UserID, currentSessionID model.AppSessionID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeOtherAppSessionsByUser", ctx, userID, currentSessionID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeOtherAppSessionsByUser indicates an expected call of RevokeOtherAppSessionsByUser.
+func (mr *MockRepositoryMockRecorder) RevokeOtherAppSessionsByUser(ctx, userID, currentSessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeOtherAppSessionsByUser", reflect.TypeOf((*MockRepository)(nil).RevokeOtherAppSessionsByUser), ctx, userID, currentSessionID)
+}
+
+// SaveLoginFailures mocks base method.
+func (m *MockRepository) SaveLoginFailures(ctx context.Context, arg repository.SaveLoginFailuresArg) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveLoginFailures", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveLoginFailures indicates an expected call of SaveLoginFailures.
+func (mr *MockRepositoryMockRecorder) SaveLoginFailures(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveLoginFailures", reflect.TypeOf((*MockRepository)(nil).SaveLoginFailures), ctx, arg)
+}
+
+// SaveOAuthState mocks base method.
+func (m *MockRepository) SaveOAuthState(ctx context.Context, oauthState model.OAuthState) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveOAuthState", ctx, oauthState)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveOAuthState indicates an expected call of SaveOAuthState.
+func (mr *MockRepositoryMockRecorder) SaveOAuthState(ctx, oauthState any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveOAuthState", reflect.TypeOf((*MockRepository)(nil).SaveOAuthState), ctx, oauthState)
+}
+
+// SavePasskeyCeremony mocks base method.
+func (m *MockRepository) SavePasskeyCeremony(ctx context.Context, ceremony model.PasskeyCeremony) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePasskeyCeremony", ctx, ceremony)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SavePasskeyCeremony indicates an expected call of SavePasskeyCeremony.
+func (mr *MockRepositoryMockRecorder) SavePasskeyCeremony(ctx, ceremony any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePasskeyCeremony", reflect.TypeOf((*MockRepository)(nil).SavePasskeyCeremony), ctx, ceremony)
+}
+
+// SaveRateLimitBucket mocks base method.
+func (m *MockRepository) SaveRateLimitBucket(ctx context.Context, arg repository.SaveRateLimitBucketArg) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRateLimitBucket", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveRateLimitBucket indicates an expected call of SaveRateLimitBucket.
+func (mr *MockRepositoryMockRecorder) SaveRateLimitBucket(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRateLimitBucket", reflect.TypeOf((*MockRepository)(nil).SaveRateLimitBucket), ctx, arg)
+}
+
+// Search mocks base method.
+func (m *MockRepository) Search(ctx context.Context, arg repository.SearchArg) ([]model.SearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, arg)
+	ret0, _ := ret[0].([]model.SearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockRepositoryMockRecorder) Search(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockRepository)(nil).Search), ctx, arg)
+}
+
+// SearchAuditLogs mocks base method.
+func (m *MockRepository) SearchAuditLogs(ctx context.Context, arg repository.SearchAuditLogsArg) ([]model.AuditLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchAuditLogs", ctx, arg)
+	ret0, _ := ret[0].([]model.AuditLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchAuditLogs indicates an expected call of SearchAuditLogs.
+func (mr *MockRepositoryMockRecorder) SearchAuditLogs(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAuditLogs", reflect.TypeOf((*MockRepository)(nil).SearchAuditLogs), ctx, arg)
+}
+
+// TouchAPIToken mocks base method.
+func (m *MockRepository) TouchAPIToken(ctx context.Context, id model.APITokenID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchAPIToken", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchAPIToken indicates an expected call of TouchAPIToken.
+func (mr *MockRepositoryMockRecorder) TouchAPIToken(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchAPIToken", reflect.TypeOf((*MockRepository)(nil).TouchAPIToken), ctx, id)
+}
+
+// TouchAppSession mocks base method.
+func (m *MockRepository) TouchAppSession(ctx context.Context, sessionID model.AppSessionID, client model.SessionClient) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchAppSession", ctx, sessionID, client)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchAppSession indicates an expected call of TouchAppSession.
+func (mr *MockRepositoryMockRecorder) TouchAppSession(ctx, sessionID, client any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchAppSession", reflect.TypeOf((*MockRepository)(nil).TouchAppSession), ctx, sessionID, client)
+}
+
+// TouchSession mocks base method.
+func (m *MockRepository) TouchSession(ctx context.Context, sessionID model.ConsoleSessionID, client model.SessionClient) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchSession", ctx, sessionID, client)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchSession indicates an expected call of TouchSession.
+func (mr *MockRepositoryMockRecorder) TouchSession(ctx, sessionID, client any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchSession", reflect.TypeOf((*MockRepository)(nil).TouchSession), ctx, sessionID, client)
+}
+
+// UpdateBuilding mocks base method.
+func (m *MockRepository) UpdateBuilding(ctx context.Context, building model.Building) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBuilding", ctx, building)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateBuilding indicates an expected call of UpdateBuilding.
+func (mr *MockRepositoryMockRecorder) UpdateBuilding(ctx, building any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBuilding", reflect.TypeOf((*MockRepository)(nil).UpdateBuilding), ctx, building)
+}
+
+// UpdateFloor mocks base method.
+func (m *MockRepository) UpdateFloor(ctx context.Context, floor model.Floor) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFloor", ctx, floor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFloor indicates an expected call of UpdateFloor.
+func (mr *MockRepositoryMockRecorder) UpdateFloor(ctx, floor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFloor", reflect.TypeOf((*MockRepository)(nil).UpdateFloor), ctx, floor)
+}
+
+// UpdateKeyNumber mocks base method.
+func (m *MockRepository) UpdateKeyNumber(ctx context.Context, id model.KeyID, keyNumber model.KeyNumber, version int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateKeyNumber", ctx, id, keyNumber, version)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateKeyNumber indicates an expected call of UpdateKeyNumber.
+func (mr *MockRepositoryMockRecorder) UpdateKeyNumber(ctx, id, keyNumber, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateKeyNumber", reflect.TypeOf((*MockRepository)(nil).UpdateKeyNumber), ctx, id, keyNumber, version)
+}
+
+// UpdateOrganizationKeyHash mocks base method.
+func (m *MockRepository) UpdateOrganizationKeyHash(ctx context.Context, id model.OrganizationID, keyHash string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOrganizationKeyHash", ctx, id, keyHash)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOrganizationKeyHash indicates an expected call of UpdateOrganizationKeyHash.
+func (mr *MockRepositoryMockRecorder) UpdateOrganizationKeyHash(ctx, id, keyHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrganizationKeyHash", reflect.TypeOf((*MockRepository)(nil).UpdateOrganizationKeyHash), ctx, id, keyHash)
+}
+
+// UpdateOutboxEvent mocks base method.
+func (m *MockRepository) UpdateOutboxEvent(ctx context.Context, event model.OutboxEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOutboxEvent", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOutboxEvent indicates an expected call of UpdateOutboxEvent.
+func (mr *MockRepositoryMockRecorder) UpdateOutboxEvent(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOutboxEvent", reflect.TypeOf((*MockRepository)(nil).UpdateOutboxEvent), ctx, event)
+}
+
+// UpdatePasskeyUsage mocks base method.
+func (m *MockRepository) UpdatePasskeyUsage(ctx context.Context, arg repository.UpdatePasskeyUsageArg) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePasskeyUsage", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePasskeyUsage indicates an expected call of UpdatePasskeyUsage.
+func (mr *MockRepositoryMockRecorder) UpdatePasskeyUsage(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePasskeyUsage", reflect.TypeOf((*MockRepository)(nil).UpdatePasskeyUsage), ctx, arg)
+}
+
+// UpdateRoomAttributes mocks base method.
+func (m *MockRepository) UpdateRoomAttributes(ctx context.Context, id model.RoomID, attributes model.RoomAttributes, version int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRoomAttributes", ctx, id, attributes, version)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRoomAttributes indicates an expected call of UpdateRoomAttributes.
+func (mr *MockRepositoryMockRecorder) UpdateRoomAttributes(ctx, id, attributes, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoomAttributes", reflect.TypeOf((*MockRepository)(nil).UpdateRoomAttributes), ctx, id, attributes, version)
+}
+
+// UpdateTenant mocks base method.
+func (m *MockRepository) UpdateTenant(ctx context.Context, arg repository.UpdateTenantArg) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTenant", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTenant indicates an expected call of UpdateTenant.
+func (mr *MockRepositoryMockRecorder) UpdateTenant(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTenant", reflect.TypeOf((*MockRepository)(nil).UpdateTenant), ctx, arg)
+}
+
+// UpdateTenantJoinCodeByTenantId mocks base method.
+func (m *MockRepository) UpdateTenantJoinCodeByTenantId(ctx context.Context, arg repository.UpdateTenantJoinCodeArg) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTenantJoinCodeByTenantId", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTenantJoinCodeByTenantId indicates an expected call of UpdateTenantJoinCodeByTenantId.
+func (mr *MockRepositoryMockRecorder) UpdateTenantJoinCodeByTenantId(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTenantJoinCodeByTenantId", reflect.TypeOf((*MockRepository)(nil).UpdateTenantJoinCodeByTenantId), ctx, arg)
+}
+
+// UpsertNotificationPreferences mocks base method.
+func (m *MockRepository) UpsertNotificationPreferences(ctx context.Context, preferences model.NotificationPreferences) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertNotificationPreferences", ctx, preferences)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertNotificationPreferences indicates an expected call of UpsertNotificationPreferences.
+func (mr *MockRepositoryMockRecorder) UpsertNotificationPreferences(ctx, preferences any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertNotificationPreferences", reflect.TypeOf((*MockRepository)(nil).UpsertNotificationPreferences), ctx, preferences)
+}
+
+// UpsertUser mocks base method.
+func (m *MockRepository) UpsertUser(ctx context.Context, arg repository.UpsertUserArg) (model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertUser", ctx, arg)
+	ret0, _ := ret[0].(model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertUser indicates an expected call of UpsertUser.
+func (mr *MockRepositoryMockRecorder) UpsertUser(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertUser", reflect.TypeOf((*MockRepository)(nil).UpsertUser), ctx, arg)
+}
+
+// UpsertUserIdentity mocks base method.
+func (m *MockRepository) UpsertUserIdentity(ctx context.Context, arg repository.UpsertUserIdentityArg) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertUserIdentity", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertUserIdentity indicates an expected call of UpsertUserIdentity.
+func (mr *MockRepositoryMockRecorder) UpsertUserIdentity(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertUserIdentity", reflect.TypeOf((*MockRepository)(nil).UpsertUserIdentity), ctx, arg)
+}
+
+// WithTransaction mocks base method.
+func (m *MockRepository) WithTransaction(ctx context.Context, fn func(context.Context, repository.Transaction) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTransaction", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithTransaction indicates an expected call of WithTransaction.
+func (mr *MockRepositoryMockRecorder) WithTransaction(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTransaction", reflect.TypeOf((*MockRepository)(nil).WithTransaction), ctx, fn)
+}
+
+// MockTransaction is a mock of Transaction interface.
+type MockTransaction struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionMockRecorder
+	isgomock struct{}
+}
+
+// MockTransactionMockRecorder is the mock recorder for MockTransaction.
+type MockTransactionMockRecorder struct {
+	mock *MockTransaction
+}
+
+// NewMockTransaction creates a new mock instance.
+func NewMockTransaction(ctrl *gomock.Controller) *MockTransaction {
+	mock := &MockTransaction{ctrl: ctrl}
+	mock.recorder = &MockTransactionMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransaction) EXPECT() *MockTransactionMockRecorder {
+	return m.recorder
+}
+
+// AddTenantGroupMember mocks base method.
+func (m *MockTransaction) AddTenantGroupMember(ctx context.Context, groupID model.TenantGroupID, userID model.UserID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTenantGroupMember", ctx, groupID, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTenantGroupMember indicates an expected call of AddTenantGroupMember.
+func (mr *MockTransactionMockRecorder) AddTenantGroupMember(ctx, groupID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTenantGroupMember", reflect.TypeOf((*MockTransaction)(nil).AddTenantGroupMember), ctx, groupID, userID)
+}
+
+// ArchiveTenant mocks base method.
+func (m *MockTransaction) ArchiveTenant(ctx context.Context, id model.TenantID, archivedAt time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveTenant", ctx, id, archivedAt)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveTenant indicates an expected call of ArchiveTenant.
+func (mr *MockTransactionMockRecorder) ArchiveTenant(ctx, id, archivedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveTenant", reflect.TypeOf((*MockTransaction)(nil).ArchiveTenant), ctx, id, archivedAt)
+}
+
+// ClaimNotificationDelivery mocks base method.
+func (m *MockTransaction) ClaimNotificationDelivery(ctx context.Context, delivery model.NotificationDelivery) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimNotificationDelivery", ctx, delivery)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimNotificationDelivery indicates an expected call of ClaimNotificationDelivery.
+func (mr *MockTransactionMockRecorder) ClaimNotificationDelivery(ctx, delivery any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimNotificationDelivery", reflect.TypeOf((*MockTransaction)(nil).ClaimNotificationDelivery), ctx, delivery)
+}
+
+// ClaimOutboxEvents mocks base method.
+func (m *MockTransaction) ClaimOutboxEvents(ctx context.Context, now, leaseUntil time.Time, limit int32) ([]model.OutboxEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimOutboxEvents", ctx, now, leaseUntil, limit)
+	ret0, _ := ret[0].([]model.OutboxEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimOutboxEvents indicates an expected call of ClaimOutboxEvents.
+func (mr *MockTransactionMockRecorder) ClaimOutboxEvents(ctx, now, leaseUntil, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimOutboxEvents", reflect.TypeOf((*MockTransaction)(nil).ClaimOutboxEvents), ctx, now, leaseUntil, limit)
+}
+
+// ConsumeOAuthState mocks base method.
+func (m *MockTransaction) ConsumeOAuthState(ctx context.Context, state string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeOAuthState", ctx, state)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConsumeOAuthState indicates an expected call of ConsumeOAuthState.
+func (mr *MockTransactionMockRecorder) ConsumeOAuthState(ctx, state any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeOAuthState", reflect.TypeOf((*MockTransaction)(nil).ConsumeOAuthState), ctx, state)
+}
+
+// ConsumePasskeyCeremony mocks base method.
+func (m *MockTransaction) ConsumePasskeyCeremony(ctx context.Context, id string) (model.PasskeyCeremony, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumePasskeyCeremony", ctx, id)
+	ret0, _ := ret[0].(model.PasskeyCeremony)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumePasskeyCeremony indicates an expected call of ConsumePasskeyCeremony.
+func (mr *MockTransactionMockRecorder) ConsumePasskeyCeremony(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumePasskeyCeremony", reflect.TypeOf((*MockTransaction)(nil).ConsumePasskeyCeremony), ctx, id)
+}
+
+// CountFloorsByBuilding mocks base method.
+func (m *MockTransaction) CountFloorsByBuilding(ctx context.Context, id model.BuildingID) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountFloorsByBuilding", ctx, id)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountFloorsByBuilding indicates an expected call of CountFloorsByBuilding.
+func (mr *MockTransactionMockRecorder) CountFloorsByBuilding(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountFloorsByBuilding", reflect.TypeOf((*MockTransaction)(nil).CountFloorsByBuilding), ctx, id)
+}
+
+// CountKeysInUseByTenant mocks base method.
+func (m *MockTransaction) CountKeysInUseByTenant(ctx context.Context, id model.TenantID) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountKeysInUseByTenant", ctx, id)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountKeysInUseByTenant indicates an expected call of CountKeysInUseByTenant.
+func (mr *MockTransactionMockRecorder) CountKeysInUseByTenant(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountKeysInUseByTenant", reflect.TypeOf((*MockTransaction)(nil).CountKeysInUseByTenant), ctx, id)
+}
+
+// CountRoomsByFloor mocks base method.
+func (m *MockTransaction) CountRoomsByFloor(ctx context.Context, id model.FloorID) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountRoomsByFloor", ctx, id)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountRoomsByFloor indicates an expected call of CountRoomsByFloor.
+func (mr *MockTransactionMockRecorder) CountRoomsByFloor(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountRoomsByFloor", reflect.TypeOf((*MockTransaction)(nil).CountRoomsByFloor), ctx, id)
+}
+
+// CountRoomsByType mocks base method.
+func (m *MockTransaction) CountRoomsByType(ctx context.Context, organizationID model.OrganizationID, key model.RoomType) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountRoomsByType", ctx, organizationID, key)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountRoomsByType indicates an expected call of CountRoomsByType.
+func (mr *MockTransactionMockRecorder) CountRoomsByType(ctx, organizationID, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountRoomsByType", reflect.TypeOf((*MockTransaction)(nil).CountRoomsByType), ctx, organizationID, key)
+}
+
+// CountTenantsByType mocks base method.
+func (m *MockTransaction) CountTenantsByType(ctx context.Context, organizationID model.OrganizationID, key model.TenantType) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountTenantsByType", ctx, organizationID, key)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountTenantsByType indicates an expected call of CountTenantsByType.
+func (mr *MockTransactionMockRecorder) CountTenantsByType(ctx, organizationID, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTenantsByType", reflect.TypeOf((*MockTransaction)(nil).CountTenantsByType), ctx, organizationID, key)
+}
+
+// CreateAPIToken mocks base method.
+func (m *MockTransaction) CreateAPIToken(ctx context.Context, arg repository.CreateAPITokenArg) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIToken", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAPIToken indicates an expected call of CreateAPIToken.
+func (mr *MockTransactionMockRecorder) CreateAPIToken(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIToken", reflect.TypeOf((*MockTransaction)(nil).CreateAPIToken), ctx, arg)
+}
+
+// CreateAppSession mocks base method.
+func (m *MockTransaction) CreateAppSession(ctx context.Context, arg repository.CreateAppSessionArg) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAppSession", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAppSession indicates an expected call of CreateAppSession.
+func (mr *MockTransactionMockRecorder) CreateAppSession(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAppSession", reflect.TypeOf((*MockTransaction)(nil).CreateAppSession), ctx, arg)
+}
+
+// CreateAuditLog mocks base method.
+func (m *MockTransaction) CreateAuditLog(ctx context.Context, log model.AuditLog) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAuditLog", ctx, log)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAuditLog indicates an expected call of CreateAuditLog.
+func (mr *MockTransactionMockRecorder) CreateAuditLog(ctx, log any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuditLog", reflect.TypeOf((*MockTransaction)(nil).CreateAuditLog), ctx, log)
+}
+
+// CreateBuilding mocks base method.
+func (m *MockTransaction) CreateBuilding(ctx context.Context, building model.Building) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBuilding", ctx, building)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateBuilding indicates an expected call of CreateBuilding.
+func (mr *MockTransactionMockRecorder) CreateBuilding(ctx, building any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBuilding", reflect.TypeOf((*MockTransaction)(nil).CreateBuilding), ctx, building)
+}
+
+// CreateConsoleOperator mocks base method.
+func (m *MockTransaction) CreateConsoleOperator(ctx context.Context, arg repository.CreateConsoleOperatorArg) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateConsoleOperator", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateConsoleOperator indicates an expected call of CreateConsoleOperator.
+func (mr *MockTransactionMockRecorder) CreateConsoleOperator(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateConsoleOperator", reflect.TypeOf((*MockTransaction)(nil).CreateConsoleOperator), ctx, arg)
+}
+
+// CreateFloor mocks base method.
+func (m *MockTransaction) CreateFloor(ctx context.Context, floor model.Floor) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFloor", ctx, floor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateFloor indicates an expected call of CreateFloor.
+func (mr *MockTransactionMockRecorder) CreateFloor(ctx, floor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFloor", reflect.TypeOf((*MockTransaction)(nil).CreateFloor), ctx, floor)
+}
+
+// CreateKey mocks base method.
+func (m *MockTransaction) CreateKey(ctx context.Context, arg repository.CreateKeyArg) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateKey", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateKey indicates an expected call of CreateKey.
+func (mr *MockTransactionMockRecorder) CreateKey(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKey", reflect.TypeOf((*MockTransaction)(nil).CreateKey), ctx, arg)
+}
+
+// CreateOrganization mocks base method.
+func (m *MockTransaction) CreateOrganization(ctx context.Context, arg repository.CreateOrganizationArg) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrganization", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOrganization indicates an expected call of CreateOrganization.
+func (mr *MockTransactionMockRecorder) CreateOrganization(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganization", reflect.TypeOf((*MockTransaction)(nil).CreateOrganization), ctx, arg)
+}
+
+// CreateOutboxEvent mocks base method.
+func (m *MockTransaction) CreateOutboxEvent(ctx context.Context, event model.OutboxEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOutboxEvent", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOutboxEvent indicates an expected call of CreateOutboxEvent.
+func (mr *MockTransactionMockRecorder) CreateOutboxEvent(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOutboxEvent", reflect.TypeOf((*MockTransaction)(nil).CreateOutboxEvent), ctx, event)
+}
+
+// CreatePasskey mocks base method.
+func (m *MockTransaction) CreatePasskey(ctx context.Context, passkey model.Passkey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePasskey", ctx, passkey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePasskey indicates an expected call of CreatePasskey.
+func (mr *MockTransactionMockRecorder) CreatePasskey(ctx, passkey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePasskey", reflect.TypeOf((*MockTransaction)(nil).CreatePasskey), ctx, passkey)
+}
+
+// CreateRoom mocks base method.
+func (m *MockTransaction) CreateRoom(ctx context.Context, arg repository.CreateRoomArg) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRoom", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRoom indicates an expected call of CreateRoom.
+func (mr *MockTransactionMockRecorder) CreateRoom(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoom", reflect.TypeOf((*MockTransaction)(nil).CreateRoom), ctx, arg)
+}
+
+// CreateRoomAssignment mocks base method.
+func (m *MockTransaction) CreateRoomAssignment(ctx context.Context, arg repository.CreateRoomAssignmentArg) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRoomAssignment", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRoomAssignment indicates an expected call of CreateRoomAssignment.
+func (mr *MockTransactionMockRecorder) CreateRoomAssignment(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoomAssignment", reflect.TypeOf((*MockTransaction)(nil).CreateRoomAssignment), ctx, arg)
+}
+
+// CreateRoomCustomField mocks base method.
+func (m *MockTransaction) CreateRoomCustomField(ctx context.Context, field model.RoomCustomField) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRoomCustomField", ctx, field)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRoomCustomField indicates an expected call of CreateRoomCustomField.
+func (mr *MockTransactionMockRecorder) CreateRoomCustomField(ctx, field any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoomCustomField", reflect.TypeOf((*MockTransaction)(nil).CreateRoomCustomField), ctx, field)
+}
+
+// CreateRoomType mocks base method.
+func (m *MockTransaction) CreateRoomType(ctx context.Context, definition model.RoomTypeDefinition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRoomType", ctx, definition)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRoomType indicates an expected call of CreateRoomType.
+func (mr *MockTransactionMockRecorder) CreateRoomType(ctx, definition any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoomType", reflect.TypeOf((*MockTransaction)(nil).CreateRoomType), ctx, definition)
+}
+
+// CreateSession mocks base method.
+func (m *MockTransaction) CreateSession(ctx context.Context, arg repository.CreateConsoleSessionArg) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockTransactionMockRecorder) CreateSession(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockTransaction)(nil).CreateSession), ctx, arg)
+}
+
+// CreateTenant mocks base method.
+func (m *MockTransaction) CreateTenant(ctx context.Context, arg repository.CreateTenantArg) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTenant", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTenant indicates an expected call of CreateTenant.
+func (mr *MockTransactionMockRecorder) CreateTenant(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTenant", reflect.TypeOf((*MockTransaction)(nil).CreateTenant), ctx, arg)
+}
+
+// CreateTenantGroup mocks base method.
+func (m *MockTransaction) CreateTenantGroup(ctx context.Context, group model.TenantGroup) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTenantGroup", ctx, group)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTenantGroup indicates an expected call of CreateTenantGroup.
+func (mr *MockTransactionMockRecorder) CreateTenantGroup(ctx, group any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTenantGroup", reflect.TypeOf((*MockTransaction)(nil).CreateTenantGroup), ctx, group)
+}
+
+// CreateTenantJoinCode mocks base method.
+func (m *MockTransaction) CreateTenantJoinCode(ctx context.Context, arg repository.CreateTenantJoinCodeArg) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTenantJoinCode", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTenantJoinCode indicates an expected call of CreateTenantJoinCode.
+func (mr *MockTransactionMockRecorder) CreateTenantJoinCode(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTenantJoinCode", reflect.TypeOf((*MockTransaction)(nil).CreateTenantJoinCode), ctx, arg)
+}
+
+// CreateTenantMembership mocks base method.
+func (m *MockTransaction) CreateTenantMembership(ctx context.Context, membership model.TenantMembership) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTenantMembership", ctx, membership)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTenantMembership indicates an expected call of CreateTenantMembership.
+func (mr *MockTransactionMockRecorder) CreateTenantMembership(ctx, membership any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTenantMembership", reflect.TypeOf((*MockTransaction)(nil).CreateTenantMembership), ctx, membership)
+}
+
+// CreateTenantType mocks base method.
+func (m *MockTransaction) CreateTenantType(ctx context.Context, definition model.TenantTypeDefinition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTenantType", ctx, definition)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTenantType indicates an expected call of CreateTenantType.
+func (mr *MockTransactionMockRecorder) CreateTenantType(ctx, definition any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTenantType", reflect.TypeOf((*MockTransaction)(nil).CreateTenantType), ctx, definition)
+}
+
+// CreateWebhookDelivery mocks base method.
+func (m *MockTransaction) CreateWebhookDelivery(ctx context.Context, delivery model.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhookDelivery", ctx, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWebhookDelivery indicates an expected call of CreateWebhookDelivery.
+func (mr *MockTransactionMockRecorder) CreateWebhookDelivery(ctx, delivery any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhookDelivery", reflect.TypeOf((*MockTransaction)(nil).CreateWebhookDelivery), ctx, delivery)
+}
+
+// CreateWebhookSubscription mocks base method.
+func (m *MockTransaction) CreateWebhookSubscription(ctx context.Context, subscription model.WebhookSubscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhookSubscription", ctx, subscription)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWebhookSubscription indicates an expected call of CreateWebhookSubscription.
+func (mr *MockTransactionMockRecorder) CreateWebhookSubscription(ctx, subscription any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhookSubscription", reflect.TypeOf((*MockTransaction)(nil).CreateWebhookSubscription), ctx, subscription)
+}
+
+// DeleteBuilding mocks base method.
+func (m *MockTransaction) DeleteBuilding(ctx context.Context, id model.BuildingID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBuilding", ctx, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBuilding indicates an expected call of DeleteBuilding.
+func (mr *MockTransactionMockRecorder) DeleteBuilding(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBuilding", reflect.TypeOf((*MockTransaction)(nil).DeleteBuilding), ctx, id)
+}
+
+// DeleteFloor mocks base method.
+func (m *MockTransaction) DeleteFloor(ctx context.Context, id model.FloorID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFloor", ctx, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFloor indicates an expected call of DeleteFloor.
+func (mr *MockTransactionMockRecorder) DeleteFloor(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFloor", reflect.TypeOf((*MockTransaction)(nil).DeleteFloor), ctx, id)
+}
+
+// DeleteIdleRateLimits mocks base method.
+func (m *MockTransaction) DeleteIdleRateLimits(ctx context.Context, before time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIdleRateLimits", ctx, before)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIdleRateLimits indicates an expected call of DeleteIdleRateLimits.
+func (mr *MockTransactionMockRecorder) DeleteIdleRateLimits(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIdleRateLimits", reflect.TypeOf((*MockTransaction)(nil).DeleteIdleRateLimits), ctx, before)
+}
+
+// DeleteLoginFailures mocks base method.
+func (m *MockTransaction) DeleteLoginFailures(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLoginFailures", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLoginFailures indicates an expected call of DeleteLoginFailures.
+func (mr *MockTransactionMockRecorder) DeleteLoginFailures(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLoginFailures", reflect.TypeOf((*MockTransaction)(nil).DeleteLoginFailures), ctx, key)
+}
+
+// DeleteNotificationDelivery mocks base method.
+func (m *MockTransaction) DeleteNotificationDelivery(ctx context.Context, dedupKey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNotificationDelivery", ctx, dedupKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNotificationDelivery indicates an expected call of DeleteNotificationDelivery.
+func (mr *MockTransactionMockRecorder) DeleteNotificationDelivery(ctx, dedupKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotificationDelivery", reflect.TypeOf((*MockTransaction)(nil).DeleteNotificationDelivery), ctx, dedupKey)
+}
+
+// DeleteOtherSessionsByOrganization mocks base method.
+func (m *MockTransaction) DeleteOtherSessionsByOrganization(ctx context.Context, organizationID model.OrganizationID, currentSessionID model.ConsoleSessionID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOtherSessionsByOrganization", ctx, organizationID, currentSessionID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOtherSessionsByOrganization indicates an expected call of DeleteOtherSessionsByOrganization.
+func (mr *MockTransactionMockRecorder) DeleteOtherSessionsByOrganization(ctx, organizationID, currentSessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOtherSessionsByOrganization", reflect.TypeOf((*MockTransaction)(nil).DeleteOtherSessionsByOrganization), ctx, organizationID, currentSessionID)
+}
+
+// DeletePasskeyByUser mocks base method.
+func (m *MockTransaction) DeletePasskeyByUser(ctx context.Context, userID model.UserID, id model.PasskeyID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePasskeyByUser", ctx, userID, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePasskeyByUser indicates an expected call of DeletePasskeyByUser.
+func (mr *MockTransactionMockRecorder) DeletePasskeyByUser(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePasskeyByUser", reflect.TypeOf((*MockTransaction)(nil).DeletePasskeyByUser), ctx, userID, id)
+}
+
+// DeleteRoomAssignmentsByTenant mocks base method.
+func (m *MockTransaction) DeleteRoomAssignmentsByTenant(ctx context.Context, tenantID model.TenantID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRoomAssignmentsByTenant", ctx, tenantID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRoomAssignmentsByTenant indicates an expected call of DeleteRoomAssignmentsByTenant.
+func (mr *MockTransactionMockRecorder) DeleteRoomAssignmentsByTenant(ctx, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoomAssignmentsByTenant", reflect.TypeOf((*MockTransaction)(nil).DeleteRoomAssignmentsByTenant), ctx, tenantID)
+}
+
+// DeleteRoomCustomField mocks base method.
+func (m *MockTransaction) DeleteRoomCustomField(ctx context.Context, id model.RoomCustomFieldID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRoomCustomField", ctx, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRoomCustomField indicates an expected call of DeleteRoomCustomField.
+func (mr *MockTransactionMockRecorder) DeleteRoomCustomField(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoomCustomField", reflect.TypeOf((*MockTransaction)(nil).DeleteRoomCustomField), ctx, id)
+}
+
+// DeleteRoomType mocks base method.
+func (m *MockTransaction) DeleteRoomType(ctx context.Context, id model.RoomTypeID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRoomType", ctx, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRoomType indicates an expected call of DeleteRoomType.
+func (mr *MockTransactionMockRecorder) DeleteRoomType(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoomType", reflect.TypeOf((*MockTransaction)(nil).DeleteRoomType), ctx, id)
+}
+
+// DeleteSession mocks base method.
+func (m *MockTransaction) DeleteSession(ctx context.Context, sessionID model.ConsoleSessionID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSession", ctx, sessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSession indicates an expected call of DeleteSession.
+func (mr *MockTransactionMockRecorder) DeleteSession(ctx, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSession", reflect.TypeOf((*MockTransaction)(nil).DeleteSession), ctx, sessionID)
+}
+
+// DeleteSessionByOrganization mocks base method.
+func (m *MockTransaction) DeleteSessionByOrganization(ctx context.Context, organizationID model.OrganizationID, sessionID model.ConsoleSessionID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSessionByOrganization", ctx, organizationID, sessionID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSessionByOrganization indicates an expected call of DeleteSessionByOrganization.
+func (mr *MockTransactionMockRecorder) DeleteSessionByOrganization(ctx, organizationID, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSessionByOrganization", reflect.TypeOf((*MockTransaction)(nil).DeleteSessionByOrganization), ctx, organizationID, sessionID)
+}
+
+// DeleteSessionsByOperator mocks base method.
+func (m *MockTransaction) DeleteSessionsByOperator(ctx context.Context, operatorID model.ConsoleOperatorID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSessionsByOperator", ctx, operatorID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSessionsByOperator indicates an expected call of DeleteSessionsByOperator.
+func (mr *MockTransactionMockRecorder) DeleteSessionsByOperator(ctx, operatorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSessionsByOperator", reflect.TypeOf((*MockTransaction)(nil).DeleteSessionsByOperator), ctx, operatorID)
+}
+
+// DeleteSessionsByOrganization mocks base method.
+func (m *MockTransaction) DeleteSessionsByOrganization(ctx context.Context, organizationID model.OrganizationID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSessionsByOrganization", ctx, organizationID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSessionsByOrganization indicates an expected call of DeleteSessionsByOrganization.
+func (mr *MockTransactionMockRecorder) DeleteSessionsByOrganization(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSessionsByOrganization", reflect.TypeOf((*MockTransaction)(nil).DeleteSessionsByOrganization), ctx, organizationID)
+}
+
+// DeleteTenant mocks base method.
+func (m *MockTransaction) DeleteTenant(ctx context.Context, id model.TenantID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTenant", ctx, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTenant indicates an expected call of DeleteTenant.
+func (mr *MockTransactionMockRecorder) DeleteTenant(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTenant", reflect.TypeOf((*MockTransaction)(nil).DeleteTenant), ctx, id)
+}
+
+// DeleteTenantGroupsByTenant mocks base method.
+func (m *MockTransaction) DeleteTenantGroupsByTenant(ctx context.Context, tenantID model.TenantID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTenantGroupsByTenant", ctx, tenantID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTenantGroupsByTenant indicates an expected call of DeleteTenantGroupsByTenant.
+func (mr *MockTransactionMockRecorder) DeleteTenantGroupsByTenant(ctx, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTenantGroupsByTenant", reflect.TypeOf((*MockTransaction)(nil).DeleteTenantGroupsByTenant), ctx, tenantID)
+}
+
+// DeleteTenantJoinCodesByTenant mocks base method.
+func (m *MockTransaction) DeleteTenantJoinCodesByTenant(ctx context.Context, tenantID model.TenantID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTenantJoinCodesByTenant", ctx, tenantID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTenantJoinCodesByTenant indicates an expected call of DeleteTenantJoinCodesByTenant.
+func (mr *MockTransactionMockRecorder) DeleteTenantJoinCodesByTenant(ctx, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTenantJoinCodesByTenant", reflect.TypeOf((*MockTransaction)(nil).DeleteTenantJoinCodesByTenant), ctx, tenantID)
+}
+
+// DeleteTenantMembershipsByTenant mocks base method.
+func (m *MockTransaction) DeleteTenantMembershipsByTenant(ctx context.Context, tenantID model.TenantID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTenantMembershipsByTenant", ctx, tenantID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTenantMembershipsByTenant indicates an expected call of DeleteTenantMembershipsByTenant.
+func (mr *MockTransactionMockRecorder) DeleteTenantMembershipsByTenant(ctx, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTenantMembershipsByTenant", reflect.TypeOf((*MockTransaction)(nil).DeleteTenantMembershipsByTenant), ctx, tenantID)
+}
+
+// DeleteTenantType mocks base method.
+func (m *MockTransaction) DeleteTenantType(ctx context.Context, id model.TenantTypeID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTenantType", ctx, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTenantType indicates an expected call of DeleteTenantType.
+func (mr *MockTransactionMockRecorder) DeleteTenantType(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTenantType", reflect.TypeOf((*MockTransaction)(nil).DeleteTenantType), ctx, id)
+}
+
+// DeleteWebhookSubscription mocks base method.
+func (m *MockTransaction) DeleteWebhookSubscription(ctx context.Context, organizationID model.OrganizationID, id model.WebhookSubscriptionID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhookSubscription", ctx, organizationID, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWebhookSubscription indicates an expected call of DeleteWebhookSubscription.
+func (mr *MockTransactionMockRecorder) DeleteWebhookSubscription(ctx, organizationID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhookSubscription", reflect.TypeOf((*MockTransaction)(nil).DeleteWebhookSubscription), ctx, organizationID, id)
+}
+
+// DisableTenantJoinCodes mocks base method.
+func (m *MockTransaction) DisableTenantJoinCodes(ctx context.Context, tenantID model.TenantID, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableTenantJoinCodes", ctx, tenantID, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableTenantJoinCodes indicates an expected call of DisableTenantJoinCodes.
+func (mr *MockTransactionMockRecorder) DisableTenantJoinCodes(ctx, tenantID, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableTenantJoinCodes", reflect.TypeOf((*MockTransaction)(nil).DisableTenantJoinCodes), ctx, tenantID, now)
+}
+
+// EndRoomAssignmentsByTenant mocks base method.
+func (m *MockTransaction) EndRoomAssignmentsByTenant(ctx context.Context, tenantID model.TenantID, now time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndRoomAssignmentsByTenant", ctx, tenantID, now)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EndRoomAssignmentsByTenant indicates an expected call of EndRoomAssignmentsByTenant.
+func (mr *MockTransactionMockRecorder) EndRoomAssignmentsByTenant(ctx, tenantID, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndRoomAssignmentsByTenant", reflect.TypeOf((*MockTransaction)(nil).EndRoomAssignmentsByTenant), ctx, tenantID, now)
+}
+
+// EnsureAppSessionCSRFToken mocks base method.
+func (m *MockTransaction) EnsureAppSessionCSRFToken(ctx context.Context, sessionID model.AppSessionID, token string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureAppSessionCSRFToken", ctx, sessionID, token)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureAppSessionCSRFToken indicates an expected call of EnsureAppSessionCSRFToken.
+func (mr *MockTransactionMockRecorder) EnsureAppSessionCSRFToken(ctx, sessionID, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureAppSessionCSRFToken", reflect.TypeOf((*MockTransaction)(nil).EnsureAppSessionCSRFToken), ctx, sessionID, token)
+}
+
+// ExtendAppSession mocks base method.
+func (m *MockTransaction) ExtendAppSession(ctx context.Context, sessionID model.AppSessionID, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtendAppSession", ctx, sessionID, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExtendAppSession indicates an expected call of ExtendAppSession.
+func (mr *MockTransactionMockRecorder) ExtendAppSession(ctx, sessionID, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtendAppSession", reflect.TypeOf((*MockTransaction)(nil).ExtendAppSession), ctx, sessionID, expiresAt)
+}
+
+// ExtendSession mocks base method.
+func (m *MockTransaction) ExtendSession(ctx context.Context, sessionID model.ConsoleSessionID, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtendSession", ctx, sessionID, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExtendSession indicates an expected call of ExtendSession.
+func (mr *MockTransactionMockRecorder) ExtendSession(ctx, sessionID, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtendSession", reflect.TypeOf((*MockTransaction)(nil).ExtendSession), ctx, sessionID, expiresAt)
+}
+
+// GetAPITokenByHash mocks base method.
+func (m *MockTransaction) GetAPITokenByHash(ctx context.Context, tokenHash string) (model.APIToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAPITokenByHash", ctx, tokenHash)
+	ret0, _ := ret[0].(model.APIToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAPITokenByHash indicates an expected call of GetAPITokenByHash.
+func (mr *MockTransactionMockRecorder) GetAPITokenByHash(ctx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAPITokenByHash", reflect.TypeOf((*MockTransaction)(nil).GetAPITokenByHash), ctx, tokenHash)
+}
+
+// GetAppSession mocks base method.
+func (m *MockTransaction) GetAppSession(ctx context.Context, sessionID model.AppSessionID) (model.AppSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAppSession", ctx, sessionID)
+	ret0, _ := ret[0].(model.AppSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAppSession indicates an expected call of GetAppSession.
+func (mr *MockTransactionMockRecorder) GetAppSession(ctx, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppSession", reflect.TypeOf((*MockTransaction)(nil).GetAppSession), ctx, sessionID)
+}
+
+// GetAssignedRoomIDsByTenant mocks base method.
+func (m *MockTransaction) GetAssignedRoomIDsByTenant(ctx context.Context, tenantID model.TenantID) ([]model.RoomID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssignedRoomIDsByTenant", ctx, tenantID)
+	ret0, _ := ret[0].([]model.RoomID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAssignedRoomIDsByTenant indicates an expected call of GetAssignedRoomIDsByTenant.
+func (mr *MockTransactionMockRecorder) GetAssignedRoomIDsByTenant(ctx, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssignedRoomIDsByTenant", reflect.TypeOf((*MockTransaction)(nil).GetAssignedRoomIDsByTenant), ctx, tenantID)
+}
+
+// GetBuilding mocks base method.
+func (m *MockTransaction) GetBuilding(ctx context.Context, id model.BuildingID) (model.Building, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBuilding", ctx, id)
+	ret0, _ := ret[0].(model.Building)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBuilding indicates an expected call of GetBuilding.
+func (mr *MockTransactionMockRecorder) GetBuilding(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBuilding", reflect.TypeOf((*MockTransaction)(nil).GetBuilding), ctx, id)
+}
+
+// GetBuildingByOrganizationAndName mocks base method.
+func (m *MockTransaction) GetBuildingByOrganizationAndName(ctx context.Context, organizationID model.OrganizationID, name model.BuildingName) (model.Building, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBuildingByOrganizationAndName", ctx, organizationID, name)
+	ret0, _ := ret[0].(model.Building)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBuildingByOrganizationAndName indicates an expected call of GetBuildingByOrganizationAndName.
+func (mr *MockTransactionMockRecorder) GetBuildingByOrganizationAndName(ctx, organizationID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBuildingByOrganizationAndName", reflect.TypeOf((*MockTransaction)(nil).GetBuildingByOrganizationAndName), ctx, organizationID, name)
+}
+
+// GetConsoleOperatorByKeyHash mocks base method.
+func (m *MockTransaction) GetConsoleOperatorByKeyHash(ctx context.Context, keyHash string) (model.ConsoleOperator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConsoleOperatorByKeyHash", ctx, keyHash)
+	ret0, _ := ret[0].(model.ConsoleOperator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConsoleOperatorByKeyHash indicates an expected call of GetConsoleOperatorByKeyHash.
+func (mr *MockTransactionMockRecorder) GetConsoleOperatorByKeyHash(ctx, keyHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConsoleOperatorByKeyHash", reflect.TypeOf((*MockTransaction)(nil).GetConsoleOperatorByKeyHash), ctx, keyHash)
+}
+
+// GetFloor mocks base method.
+func (m *MockTransaction) GetFloor(ctx context.Context, id model.FloorID) (model.Floor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFloor", ctx, id)
+	ret0, _ := ret[0].(model.Floor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFloor indicates an expected call of GetFloor.
+func (mr *MockTransactionMockRecorder) GetFloor(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFloor", reflect.TypeOf((*MockTransaction)(nil).GetFloor), ctx, id)
+}
+
+// GetFloorByBuildingAndName mocks base method.
+func (m *MockTransaction) GetFloorByBuildingAndName(ctx context.Context, buildingID model.BuildingID, name model.FloorNumber) (model.Floor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFloorByBuildingAndName", ctx, buildingID, name)
+	ret0, _ := ret[0].(model.Floor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFloorByBuildingAndName indicates an expected call of GetFloorByBuildingAndName.
+func (mr *MockTransactionMockRecorder) GetFloorByBuildingAndName(ctx, buildingID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFloorByBuildingAndName", reflect.TypeOf((*MockTransaction)(nil).GetFloorByBuildingAndName), ctx, buildingID, name)
+}
+
+// GetKeyByID mocks base method.
+func (m *MockTransaction) GetKeyByID(ctx context.Context, id model.KeyID) (model.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKeyByID", ctx, id)
+	ret0, _ := ret[0].(model.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKeyByID indicates an expected call of GetKeyByID.
+func (mr *MockTransactionMockRecorder) GetKeyByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKeyByID", reflect.TypeOf((*MockTransaction)(nil).GetKeyByID), ctx, id)
+}
+
+// GetKeysByOrganization mocks base method.
+func (m *MockTransaction) GetKeysByOrganization(ctx context.Context, organizationID model.OrganizationID) ([]model.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKeysByOrganization", ctx, organizationID)
+	ret0, _ := ret[0].([]model.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKeysByOrganization indicates an expected call of GetKeysByOrganization.
+func (mr *MockTransactionMockRecorder) GetKeysByOrganization(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKeysByOrganization", reflect.TypeOf((*MockTransaction)(nil).GetKeysByOrganization), ctx, organizationID)
+}
+
+// GetKeysByRoom mocks base method.
+func (m *MockTransaction) GetKeysByRoom(ctx context.Context, roomID model.RoomID) ([]model.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKeysByRoom", ctx, roomID)
+	ret0, _ := ret[0].([]model.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKeysByRoom indicates an expected call of GetKeysByRoom.
+func (mr *MockTransactionMockRecorder) GetKeysByRoom(ctx, roomID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKeysByRoom", reflect.TypeOf((*MockTransaction)(nil).GetKeysByRoom), ctx, roomID)
+}
+
+// GetLoginFailures mocks base method.
+func (m *MockTransaction) GetLoginFailures(ctx context.Context, key string) (model.LoginFailures, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoginFailures", ctx, key)
+	ret0, _ := ret[0].(model.LoginFailures)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoginFailures indicates an expected call of GetLoginFailures.
+func (mr *MockTransactionMockRecorder) GetLoginFailures(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoginFailures", reflect.TypeOf((*MockTransaction)(nil).GetLoginFailures), ctx, key)
+}
+
+// GetNotificationPreferences mocks base method.
+func (m *MockTransaction) GetNotificationPreferences(ctx context.Context, userID model.UserID) (model.NotificationPreferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationPreferences", ctx, userID)
+	ret0, _ := ret[0].(model.NotificationPreferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationPreferences indicates an expected call of GetNotificationPreferences.
+func (mr *MockTransactionMockRecorder) GetNotificationPreferences(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationPreferences", reflect.TypeOf((*MockTransaction)(nil).GetNotificationPreferences), ctx, userID)
+}
+
+// GetOAuthState mocks base method.
+func (m *MockTransaction) GetOAuthState(ctx context.Context, state string) (model.OAuthState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOAuthState", ctx, state)
+	ret0, _ := ret[0].(model.OAuthState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOAuthState indicates an expected call of GetOAuthState.
+func (mr *MockTransactionMockRecorder) GetOAuthState(ctx, state any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOAuthState", reflect.TypeOf((*MockTransaction)(nil).GetOAuthState), ctx, state)
+}
+
+// GetOrganization mocks base method.
+func (m *MockTransaction) GetOrganization(ctx context.Context, id model.OrganizationID) (model.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganization", ctx, id)
+	ret0, _ := ret[0].(model.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganization indicates an expected call of GetOrganization.
+func (mr *MockTransactionMockRecorder) GetOrganization(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganization", reflect.TypeOf((*MockTransaction)(nil).GetOrganization), ctx, id)
+}
+
+// GetOrganizationBySlug mocks base method.
+func (m *MockTransaction) GetOrganizationBySlug(ctx context.Context, slug model.OrganizationSlug) (model.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationBySlug", ctx, slug)
+	ret0, _ := ret[0].(model.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationBySlug indicates an expected call of GetOrganizationBySlug.
+func (mr *MockTransactionMockRecorder) GetOrganizationBySlug(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationBySlug", reflect.TypeOf((*MockTransaction)(nil).GetOrganizationBySlug), ctx, slug)
+}
+
+// GetOrganizationKeyHash mocks base method.
+func (m *MockTransaction) GetOrganizationKeyHash(ctx context.Context, id model.OrganizationID) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationKeyHash", ctx, id)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationKeyHash indicates an expected call of GetOrganizationKeyHash.
+func (mr *MockTransactionMockRecorder) GetOrganizationKeyHash(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationKeyHash", reflect.TypeOf((*MockTransaction)(nil).GetOrganizationKeyHash), ctx, id)
+}
+
+// GetRoomByID mocks base method.
+func (m *MockTransaction) GetRoomByID(ctx context.Context, id model.RoomID) (model.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoomByID", ctx, id)
+	ret0, _ := ret[0].(model.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoomByID indicates an expected call of GetRoomByID.
+func (mr *MockTransactionMockRecorder) GetRoomByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoomByID", reflect.TypeOf((*MockTransaction)(nil).GetRoomByID), ctx, id)
+}
+
+// GetRoomCustomField mocks base method.
+func (m *MockTransaction) GetRoomCustomField(ctx context.Context, id model.RoomCustomFieldID) (model.RoomCustomField, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoomCustomField", ctx, id)
+	ret0, _ := ret[0].(model.RoomCustomField)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoomCustomField indicates an expected call of GetRoomCustomField.
+func (mr *MockTransactionMockRecorder) GetRoomCustomField(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoomCustomField", reflect.TypeOf((*MockTransaction)(nil).GetRoomCustomField), ctx, id)
+}
+
+// GetRoomType mocks base method.
+func (m *MockTransaction) GetRoomType(ctx context.Context, id model.RoomTypeID) (model.RoomTypeDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoomType", ctx, id)
+	ret0, _ := ret[0].(model.RoomTypeDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoomType indicates an expected call of GetRoomType.
+func (mr *MockTransactionMockRecorder) GetRoomType(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoomType", reflect.TypeOf((*MockTransaction)(nil).GetRoomType), ctx, id)
+}
+
+// GetRoomsByTenant mocks base method.
+func (m *MockTransaction) GetRoomsByTenant(ctx context.Context, tenantID model.TenantID, userID model.UserID, filter model.RoomFilter) ([]repository.AccessibleRoom, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoomsByTenant", ctx, tenantID, userID, filter)
+	ret0, _ := ret[0].([]repository.AccessibleRoom)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoomsByTenant indicates an expected call of GetRoomsByTenant.
+func (mr *MockTransactionMockRecorder) GetRoomsByTenant(ctx, tenantID, userID, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoomsByTenant", reflect.TypeOf((*MockTransaction)(nil).GetRoomsByTenant), ctx, tenantID, userID, filter)
+}
+
+// GetSession mocks base method.
+func (m *MockTransaction) GetSession(ctx context.Context, sessionID model.ConsoleSessionID) (model.ConsoleSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSession", ctx, sessionID)
+	ret0, _ := ret[0].(model.ConsoleSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSession indicates an expected call of GetSession.
+func (mr *MockTransactionMockRecorder) GetSession(ctx, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockTransaction)(nil).GetSession), ctx, sessionID)
+}
+
+// GetTenantByID mocks base method.
+func (m *MockTransaction) GetTenantByID(ctx context.Context, id model.TenantID) (repository.TenantWithJoinCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTenantByID", ctx, id)
+	ret0, _ := ret[0].(repository.TenantWithJoinCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTenantByID indicates an expected call of GetTenantByID.
+func (mr *MockTransactionMockRecorder) GetTenantByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenantByID", reflect.TypeOf((*MockTransaction)(nil).GetTenantByID), ctx, id)
+}
+
+// GetTenantByJoinCode mocks base method.
+func (m *MockTransaction) GetTenantByJoinCode(ctx context.Context, organizationID model.OrganizationID, code model.TenantJoinCode) (model.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTenantByJoinCode", ctx, organizationID, code)
+	ret0, _ := ret[0].(model.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTenantByJoinCode indicates an expected call of GetTenantByJoinCode.
+func (mr *MockTransactionMockRecorder) GetTenantByJoinCode(ctx, organizationID, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenantByJoinCode", reflect.TypeOf((*MockTransaction)(nil).GetTenantByJoinCode), ctx, organizationID, code)
+}
+
+// GetTenantGroup mocks base method.
+func (m *MockTransaction) GetTenantGroup(ctx context.Context, id model.TenantGroupID) (model.TenantGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTenantGroup", ctx, id)
+	ret0, _ := ret[0].(model.TenantGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTenantGroup indicates an expected call of GetTenantGroup.
+func (mr *MockTransactionMockRecorder) GetTenantGroup(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenantGroup", reflect.TypeOf((*MockTransaction)(nil).GetTenantGroup), ctx, id)
+}
+
+// GetTenantGroupByTenantAndName mocks base method.
+func (m *MockTransaction) GetTenantGroupByTenantAndName(ctx context.Context, tenantID model.TenantID, name model.TenantGroupName) (model.TenantGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTenantGroupByTenantAndName", ctx, tenantID, name)
+	ret0, _ := ret[0].(model.TenantGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTenantGroupByTenantAndName indicates an expected call of GetTenantGroupByTenantAndName.
+func (mr *MockTransactionMockRecorder) GetTenantGroupByTenantAndName(ctx, tenantID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenantGroupByTenantAndName", reflect.TypeOf((*MockTransaction)(nil).GetTenantGroupByTenantAndName), ctx, tenantID, name)
+}
+
+// GetTenantMembershipByTenantAndUser mocks base method.
+func (m *MockTransaction) GetTenantMembershipByTenantAndUser(ctx context.Context, tenantID model.TenantID, userID model.UserID) (model.TenantMembership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTenantMembershipByTenantAndUser", ctx, tenantID, userID)
+	ret0, _ := ret[0].(model.TenantMembership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTenantMembershipByTenantAndUser indicates an expected call of GetTenantMembershipByTenantAndUser.
+func (mr *MockTransactionMockRecorder) GetTenantMembershipByTenantAndUser(ctx, tenantID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenantMembershipByTenantAndUser", reflect.TypeOf((*MockTransaction)(nil).GetTenantMembershipByTenantAndUser), ctx, tenantID, userID)
+}
+
+// GetTenantType mocks base method.
+func (m *MockTransaction) GetTenantType(ctx context.Context, id model.TenantTypeID) (model.TenantTypeDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTenantType", ctx, id)
+	ret0, _ := ret[0].(model.TenantTypeDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTenantType indicates an expected call of GetTenantType.
+func (mr *MockTransactionMockRecorder) GetTenantType(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenantType", reflect.TypeOf((*MockTransaction)(nil).GetTenantType), ctx, id)
 }
 
 // GetUser mocks base method.
-func (m *MockRepository) GetUser(ctx context.Context, userID model.UserID) (model.User, error) {
+func (m *MockTransaction) GetUser(ctx context.Context, userID model.UserID) (model.User, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetUser", ctx, userID)
 	ret0, _ := ret[0].(model.User)
@@ -372,13 +3531,13 @@ func (m *MockRepository) GetUser(ctx context.Context, userID model.UserID) (mode
 }
 
 // GetUser indicates an expected call of GetUser.
-func (mr *MockRepositoryMockRecorder) GetUser(ctx, userID any) *gomock.Call {
+func (mr *MockTransactionMockRecorder) GetUser(ctx, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockRepository)(nil).GetUser), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockTransaction)(nil).GetUser), ctx, userID)
 }
 
 // GetUserByProviderIdentity mocks base method.
-func (m *MockRepository) GetUserByProviderIdentity(ctx context.Context, provider, providerSub string) (model.User, error) {
+func (m *MockTransaction) GetUserByProviderIdentity(ctx context.Context, provider, providerSub string) (model.User, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetUserByProviderIdentity", ctx, provider, providerSub)
 	ret0, _ := ret[0].(model.User)
@@ -387,13 +3546,58 @@ func (m *MockRepository) GetUserByProviderIdentity(ctx context.Context, provider
 }
 
 // GetUserByProviderIdentity indicates an expected call of GetUserByProviderIdentity.
-func (mr *MockRepositoryMockRecorder) GetUserByProviderIdentity(ctx, provider, providerSub any) *gomock.Call {
+func (mr *MockTransactionMockRecorder) GetUserByProviderIdentity(ctx, provider, providerSub any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByProviderIdentity", reflect.TypeOf((*MockRepository)(nil).GetUserByProviderIdentity), ctx, provider, providerSub)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByProviderIdentity", reflect.TypeOf((*MockTransaction)(nil).GetUserByProviderIdentity), ctx, provider, providerSub)
+}
+
+// GetUserProviderSub mocks base method.
+func (m *MockTransaction) GetUserProviderSub(ctx context.Context, userID model.UserID, provider string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserProviderSub", ctx, userID, provider)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserProviderSub indicates an expected call of GetUserProviderSub.
+func (mr *MockTransactionMockRecorder) GetUserProviderSub(ctx, userID, provider any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserProviderSub", reflect.TypeOf((*MockTransaction)(nil).GetUserProviderSub), ctx, userID, provider)
+}
+
+// GetWebhookSubscription mocks base method.
+func (m *MockTransaction) GetWebhookSubscription(ctx context.Context, organizationID model.OrganizationID, id model.WebhookSubscriptionID) (model.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookSubscription", ctx, organizationID, id)
+	ret0, _ := ret[0].(model.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookSubscription indicates an expected call of GetWebhookSubscription.
+func (mr *MockTransactionMockRecorder) GetWebhookSubscription(ctx, organizationID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookSubscription", reflect.TypeOf((*MockTransaction)(nil).GetWebhookSubscription), ctx, organizationID, id)
+}
+
+// HasSucceededWebhookDelivery mocks base method.
+func (m *MockTransaction) HasSucceededWebhookDelivery(ctx context.Context, subscriptionID model.WebhookSubscriptionID, eventID model.OutboxEventID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasSucceededWebhookDelivery", ctx, subscriptionID, eventID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasSucceededWebhookDelivery indicates an expected call of HasSucceededWebhookDelivery.
+func (mr *MockTransactionMockRecorder) HasSucceededWebhookDelivery(ctx, subscriptionID, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasSucceededWebhookDelivery", reflect.TypeOf((*MockTransaction)(nil).HasSucceededWebhookDelivery), ctx, subscriptionID, eventID)
 }
 
 // IncrementJoinCodeUsedCount mocks base method.
-func (m *MockRepository) IncrementJoinCodeUsedCount(ctx context.Context, code model.TenantJoinCode) error {
+func (m *MockTransaction) IncrementJoinCodeUsedCount(ctx context.Context, code model.TenantJoinCode) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "IncrementJoinCodeUsedCount", ctx, code)
 	ret0, _ := ret[0].(error)
@@ -401,532 +3605,785 @@ func (m *MockRepository) IncrementJoinCodeUsedCount(ctx context.Context, code mo
 }
 
 // IncrementJoinCodeUsedCount indicates an expected call of IncrementJoinCodeUsedCount.
-func (mr *MockRepositoryMockRecorder) IncrementJoinCodeUsedCount(ctx, code any) *gomock.Call {
+func (mr *MockTransactionMockRecorder) IncrementJoinCodeUsedCount(ctx, code any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementJoinCodeUsedCount", reflect.TypeOf((*MockRepository)(nil).IncrementJoinCodeUsedCount), ctx, code)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementJoinCodeUsedCount", reflect.TypeOf((*MockTransaction)(nil).IncrementJoinCodeUsedCount), ctx, code)
 }
 
-// RevokeAppSession mocks base method.
-func (m *MockRepository) RevokeAppSession(ctx context.Context, sessionID model.AppSessionID) error {
+// ListAPITokensByOrganization mocks base method.
+func (m *MockTransaction) ListAPITokensByOrganization(ctx context.Context, organizationID model.OrganizationID) ([]model.APIToken, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RevokeAppSession", ctx, sessionID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ListAPITokensByOrganization", ctx, organizationID)
+	ret0, _ := ret[0].([]model.APIToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// RevokeAppSession indicates an expected call of RevokeAppSession.
-func (mr *MockRepositoryMockRecorder) RevokeAppSession(ctx, sessionID any) *gomock.Call {
+// ListAPITokensByOrganization indicates an expected call of ListAPITokensByOrganization.
+func (mr *MockTransactionMockRecorder) ListAPITokensByOrganization(ctx, organizationID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAppSession", reflect.TypeOf((*MockRepository)(nil).RevokeAppSession), ctx, sessionID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPITokensByOrganization", reflect.TypeOf((*MockTransaction)(nil).ListAPITokensByOrganization), ctx, organizationID)
 }
 
-// SaveOAuthState mocks base method.
-func (m *MockRepository) SaveOAuthState(ctx context.Context, oauthState model.OAuthState) error {
+// ListAPITokensByUser mocks base method.
+func (m *MockTransaction) ListAPITokensByUser(ctx context.Context, userID model.UserID) ([]model.APIToken, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SaveOAuthState", ctx, oauthState)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ListAPITokensByUser", ctx, userID)
+	ret0, _ := ret[0].([]model.APIToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// SaveOAuthState indicates an expected call of SaveOAuthState.
-func (mr *MockRepositoryMockRecorder) SaveOAuthState(ctx, oauthState any) *gomock.Call {
+// ListAPITokensByUser indicates an expected call of ListAPITokensByUser.
+func (mr *MockTransactionMockRecorder) ListAPITokensByUser(ctx, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveOAuthState", reflect.TypeOf((*MockRepository)(nil).SaveOAuthState), ctx, oauthState)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPITokensByUser", reflect.TypeOf((*MockTransaction)(nil).ListAPITokensByUser), ctx, userID)
 }
 
-// UpdateTenant mocks base method.
-func (m *MockRepository) UpdateTenant(ctx context.Context, arg repository.UpdateTenantArg) error {
+// ListActiveAppSessionsByUser mocks base method.
+func (m *MockTransaction) ListActiveAppSessionsByUser(ctx context.Context, userID model.UserID) ([]model.AppSession, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateTenant", ctx, arg)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ListActiveAppSessionsByUser", ctx, userID)
+	ret0, _ := ret[0].([]model.AppSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// UpdateTenant indicates an expected call of UpdateTenant.
-func (mr *MockRepositoryMockRecorder) UpdateTenant(ctx, arg any) *gomock.Call {
+// ListActiveAppSessionsByUser indicates an expected call of ListActiveAppSessionsByUser.
+func (mr *MockTransactionMockRecorder) ListActiveAppSessionsByUser(ctx, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTenant", reflect.TypeOf((*MockRepository)(nil).UpdateTenant), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveAppSessionsByUser", reflect.TypeOf((*MockTransaction)(nil).ListActiveAppSessionsByUser), ctx, userID)
 }
 
-// UpdateTenantJoinCodeByTenantId mocks base method.
-func (m *MockRepository) UpdateTenantJoinCodeByTenantId(ctx context.Context, arg repository.UpdateTenantJoinCodeArg) error {
+// ListActiveSessionsByOrganization mocks base method.
+func (m *MockTransaction) ListActiveSessionsByOrganization(ctx context.Context, organizationID model.OrganizationID) ([]model.ConsoleSession, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateTenantJoinCodeByTenantId", ctx, arg)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ListActiveSessionsByOrganization", ctx, organizationID)
+	ret0, _ := ret[0].([]model.ConsoleSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// UpdateTenantJoinCodeByTenantId indicates an expected call of UpdateTenantJoinCodeByTenantId.
-func (mr *MockRepositoryMockRecorder) UpdateTenantJoinCodeByTenantId(ctx, arg any) *gomock.Call {
+// ListActiveSessionsByOrganization indicates an expected call of ListActiveSessionsByOrganization.
+func (mr *MockTransactionMockRecorder) ListActiveSessionsByOrganization(ctx, organizationID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTenantJoinCodeByTenantId", reflect.TypeOf((*MockRepository)(nil).UpdateTenantJoinCodeByTenantId), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveSessionsByOrganization", reflect.TypeOf((*MockTransaction)(nil).ListActiveSessionsByOrganization), ctx, organizationID)
 }
 
-// UpsertUser mocks base method.
-func (m *MockRepository) UpsertUser(ctx context.Context, arg repository.UpsertUserArg) (model.User, error) {
+// ListAuditLogOrganizationIDs mocks base method.
+func (m *MockTransaction) ListAuditLogOrganizationIDs(ctx context.Context) ([]model.OrganizationID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpsertUser", ctx, arg)
-	ret0, _ := ret[0].(model.User)
+	ret := m.ctrl.Call(m, "ListAuditLogOrganizationIDs", ctx)
+	ret0, _ := ret[0].([]model.OrganizationID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpsertUser indicates an expected call of UpsertUser.
-func (mr *MockRepositoryMockRecorder) UpsertUser(ctx, arg any) *gomock.Call {
+// ListAuditLogOrganizationIDs indicates an expected call of ListAuditLogOrganizationIDs.
+func (mr *MockTransactionMockRecorder) ListAuditLogOrganizationIDs(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertUser", reflect.TypeOf((*MockRepository)(nil).UpsertUser), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditLogOrganizationIDs", reflect.TypeOf((*MockTransaction)(nil).ListAuditLogOrganizationIDs), ctx)
 }
 
-// UpsertUserIdentity mocks base method.
-func (m *MockRepository) UpsertUserIdentity(ctx context.Context, arg repository.UpsertUserIdentityArg) error {
+// ListAuditLogsBySeq mocks base method.
+func (m *MockTransaction) ListAuditLogsBySeq(ctx context.Context, organizationID model.OrganizationID, afterSeq int64, limit int32) ([]model.AuditLog, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpsertUserIdentity", ctx, arg)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ListAuditLogsBySeq", ctx, organizationID, afterSeq, limit)
+	ret0, _ := ret[0].([]model.AuditLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// UpsertUserIdentity indicates an expected call of UpsertUserIdentity.
-func (mr *MockRepositoryMockRecorder) UpsertUserIdentity(ctx, arg any) *gomock.Call {
+// ListAuditLogsBySeq indicates an expected call of ListAuditLogsBySeq.
+func (mr *MockTransactionMockRecorder) ListAuditLogsBySeq(ctx, organizationID, afterSeq, limit any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertUserIdentity", reflect.TypeOf((*MockRepository)(nil).UpsertUserIdentity), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditLogsBySeq", reflect.TypeOf((*MockTransaction)(nil).ListAuditLogsBySeq), ctx, organizationID, afterSeq, limit)
 }
 
-// WithTransaction mocks base method.
-func (m *MockRepository) WithTransaction(ctx context.Context, fn func(context.Context, repository.Transaction) error) error {
+// ListBuildings mocks base method.
+func (m *MockTransaction) ListBuildings(ctx context.Context) ([]model.Building, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "WithTransaction", ctx, fn)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ListBuildings", ctx)
+	ret0, _ := ret[0].([]model.Building)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// WithTransaction indicates an expected call of WithTransaction.
-func (mr *MockRepositoryMockRecorder) WithTransaction(ctx, fn any) *gomock.Call {
+// ListBuildings indicates an expected call of ListBuildings.
+func (mr *MockTransactionMockRecorder) ListBuildings(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTransaction", reflect.TypeOf((*MockRepository)(nil).WithTransaction), ctx, fn)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBuildings", reflect.TypeOf((*MockTransaction)(nil).ListBuildings), ctx)
 }
 
-// MockTransaction is a mock of Transaction interface.
-type MockTransaction struct {
-	ctrl     *gomock.Controller
-	recorder *MockTransactionMockRecorder
-	isgomock struct{}
+// ListConsoleOperatorsByOrganization mocks base method.
+func (m *MockTransaction) ListConsoleOperatorsByOrganization(ctx context.Context, organizationID model.OrganizationID) ([]model.ConsoleOperator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListConsoleOperatorsByOrganization", ctx, organizationID)
+	ret0, _ := ret[0].([]model.ConsoleOperator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockTransactionMockRecorder is the mock recorder for MockTransaction.
-type MockTransactionMockRecorder struct {
-	mock *MockTransaction
+// ListConsoleOperatorsByOrganization indicates an expected call of ListConsoleOperatorsByOrganization.
+func (mr *MockTransactionMockRecorder) ListConsoleOperatorsByOrganization(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConsoleOperatorsByOrganization", reflect.TypeOf((*MockTransaction)(nil).ListConsoleOperatorsByOrganization), ctx, organizationID)
 }
 
-// NewMockTransaction creates a new mock instance.
-func NewMockTransaction(ctrl *gomock.Controller) *MockTransaction {
-	mock := &MockTransaction{ctrl: ctrl}
-	mock.recorder = &MockTransactionMockRecorder{mock}
-	return mock
+// ListExpiringRoomAssignments mocks base method.
+func (m *MockTransaction) ListExpiringRoomAssignments(ctx context.Context, now, until time.Time) ([]repository.ExpiringRoomAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExpiringRoomAssignments", ctx, now, until)
+	ret0, _ := ret[0].([]repository.ExpiringRoomAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockTransaction) EXPECT() *MockTransactionMockRecorder {
-	return m.recorder
+// ListExpiringRoomAssignments indicates an expected call of ListExpiringRoomAssignments.
+func (mr *MockTransactionMockRecorder) ListExpiringRoomAssignments(ctx, now, until any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExpiringRoomAssignments", reflect.TypeOf((*MockTransaction)(nil).ListExpiringRoomAssignments), ctx, now, until)
+}
+
+// ListFloors mocks base method.
+func (m *MockTransaction) ListFloors(ctx context.Context) ([]model.Floor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFloors", ctx)
+	ret0, _ := ret[0].([]model.Floor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFloors indicates an expected call of ListFloors.
+func (mr *MockTransactionMockRecorder) ListFloors(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFloors", reflect.TypeOf((*MockTransaction)(nil).ListFloors), ctx)
+}
+
+// ListKeysByRoom mocks base method.
+func (m *MockTransaction) ListKeysByRoom(ctx context.Context, arg repository.ListKeysByRoomArg) ([]model.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListKeysByRoom", ctx, arg)
+	ret0, _ := ret[0].([]model.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListKeysByRoom indicates an expected call of ListKeysByRoom.
+func (mr *MockTransactionMockRecorder) ListKeysByRoom(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKeysByRoom", reflect.TypeOf((*MockTransaction)(nil).ListKeysByRoom), ctx, arg)
+}
+
+// ListOrganizations mocks base method.
+func (m *MockTransaction) ListOrganizations(ctx context.Context) ([]model.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrganizations", ctx)
+	ret0, _ := ret[0].([]model.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrganizations indicates an expected call of ListOrganizations.
+func (mr *MockTransactionMockRecorder) ListOrganizations(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrganizations", reflect.TypeOf((*MockTransaction)(nil).ListOrganizations), ctx)
+}
+
+// ListPasskeysByUser mocks base method.
+func (m *MockTransaction) ListPasskeysByUser(ctx context.Context, userID model.UserID) ([]model.Passkey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPasskeysByUser", ctx, userID)
+	ret0, _ := ret[0].([]model.Passkey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPasskeysByUser indicates an expected call of ListPasskeysByUser.
+func (mr *MockTransactionMockRecorder) ListPasskeysByUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPasskeysByUser", reflect.TypeOf((*MockTransaction)(nil).ListPasskeysByUser), ctx, userID)
+}
+
+// ListRoomCustomFields mocks base method.
+func (m *MockTransaction) ListRoomCustomFields(ctx context.Context) (model.RoomCustomFieldSchema, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoomCustomFields", ctx)
+	ret0, _ := ret[0].(model.RoomCustomFieldSchema)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoomCustomFields indicates an expected call of ListRoomCustomFields.
+func (mr *MockTransactionMockRecorder) ListRoomCustomFields(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoomCustomFields", reflect.TypeOf((*MockTransaction)(nil).ListRoomCustomFields), ctx)
+}
+
+// ListRoomTypes mocks base method.
+func (m *MockTransaction) ListRoomTypes(ctx context.Context) (model.RoomTypeCatalog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoomTypes", ctx)
+	ret0, _ := ret[0].(model.RoomTypeCatalog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoomTypes indicates an expected call of ListRoomTypes.
+func (mr *MockTransactionMockRecorder) ListRoomTypes(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoomTypes", reflect.TypeOf((*MockTransaction)(nil).ListRoomTypes), ctx)
+}
+
+// ListRooms mocks base method.
+func (m *MockTransaction) ListRooms(ctx context.Context, arg repository.ListRoomsArg) ([]model.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRooms", ctx, arg)
+	ret0, _ := ret[0].([]model.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRooms indicates an expected call of ListRooms.
+func (mr *MockTransactionMockRecorder) ListRooms(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRooms", reflect.TypeOf((*MockTransaction)(nil).ListRooms), ctx, arg)
+}
+
+// ListTenantGroupMembers mocks base method.
+func (m *MockTransaction) ListTenantGroupMembers(ctx context.Context, groupID model.TenantGroupID) ([]model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTenantGroupMembers", ctx, groupID)
+	ret0, _ := ret[0].([]model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTenantGroupMembers indicates an expected call of ListTenantGroupMembers.
+func (mr *MockTransactionMockRecorder) ListTenantGroupMembers(ctx, groupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTenantGroupMembers", reflect.TypeOf((*MockTransaction)(nil).ListTenantGroupMembers), ctx, groupID)
+}
+
+// ListTenantGroupsByTenant mocks base method.
+func (m *MockTransaction) ListTenantGroupsByTenant(ctx context.Context, tenantID model.TenantID) ([]repository.TenantGroupWithMemberCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTenantGroupsByTenant", ctx, tenantID)
+	ret0, _ := ret[0].([]repository.TenantGroupWithMemberCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTenantGroupsByTenant indicates an expected call of ListTenantGroupsByTenant.
+func (mr *MockTransactionMockRecorder) ListTenantGroupsByTenant(ctx, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTenantGroupsByTenant", reflect.TypeOf((*MockTransaction)(nil).ListTenantGroupsByTenant), ctx, tenantID)
+}
+
+// ListTenantNotificationRecipients mocks base method.
+func (m *MockTransaction) ListTenantNotificationRecipients(ctx context.Context, tenantID model.TenantID, adminsOnly bool) ([]model.NotificationRecipient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTenantNotificationRecipients", ctx, tenantID, adminsOnly)
+	ret0, _ := ret[0].([]model.NotificationRecipient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTenantNotificationRecipients indicates an expected call of ListTenantNotificationRecipients.
+func (mr *MockTransactionMockRecorder) ListTenantNotificationRecipients(ctx, tenantID, adminsOnly any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTenantNotificationRecipients", reflect.TypeOf((*MockTransaction)(nil).ListTenantNotificationRecipients), ctx, tenantID, adminsOnly)
+}
+
+// ListTenantTypes mocks base method.
+func (m *MockTransaction) ListTenantTypes(ctx context.Context) (model.TenantTypeCatalog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTenantTypes", ctx)
+	ret0, _ := ret[0].(model.TenantTypeCatalog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTenantTypes indicates an expected call of ListTenantTypes.
+func (mr *MockTransactionMockRecorder) ListTenantTypes(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTenantTypes", reflect.TypeOf((*MockTransaction)(nil).ListTenantTypes), ctx)
+}
+
+// ListTenants mocks base method.
+func (m *MockTransaction) ListTenants(ctx context.Context, arg repository.ListTenantsArg) ([]model.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTenants", ctx, arg)
+	ret0, _ := ret[0].([]model.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTenants indicates an expected call of ListTenants.
+func (mr *MockTransactionMockRecorder) ListTenants(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTenants", reflect.TypeOf((*MockTransaction)(nil).ListTenants), ctx, arg)
+}
+
+// ListTenantsByUserID mocks base method.
+func (m *MockTransaction) ListTenantsByUserID(ctx context.Context, userID model.UserID, arg repository.ListTenantsArg) ([]repository.TenantWithMemberCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTenantsByUserID", ctx, userID, arg)
+	ret0, _ := ret[0].([]repository.TenantWithMemberCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTenantsByUserID indicates an expected call of ListTenantsByUserID.
+func (mr *MockTransactionMockRecorder) ListTenantsByUserID(ctx, userID, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTenantsByUserID", reflect.TypeOf((*MockTransaction)(nil).ListTenantsByUserID), ctx, userID, arg)
+}
+
+// ListWebhookDeliveries mocks base method.
+func (m *MockTransaction) ListWebhookDeliveries(ctx context.Context, organizationID model.OrganizationID, subscriptionID model.WebhookSubscriptionID, limit int32) ([]model.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhookDeliveries", ctx, organizationID, subscriptionID, limit)
+	ret0, _ := ret[0].([]model.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhookDeliveries indicates an expected call of ListWebhookDeliveries.
+func (mr *MockTransactionMockRecorder) ListWebhookDeliveries(ctx, organizationID, subscriptionID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookDeliveries", reflect.TypeOf((*MockTransaction)(nil).ListWebhookDeliveries), ctx, organizationID, subscriptionID, limit)
 }
 
-// ConsumeOAuthState mocks base method.
-func (m *MockTransaction) ConsumeOAuthState(ctx context.Context, state string) error {
+// ListWebhookSubscriptionsByEventType mocks base method.
+func (m *MockTransaction) ListWebhookSubscriptionsByEventType(ctx context.Context, organizationID model.OrganizationID, eventType model.DomainEventType) ([]model.WebhookSubscription, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ConsumeOAuthState", ctx, state)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ListWebhookSubscriptionsByEventType", ctx, organizationID, eventType)
+	ret0, _ := ret[0].([]model.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// ConsumeOAuthState indicates an expected call of ConsumeOAuthState.
-func (mr *MockTransactionMockRecorder) ConsumeOAuthState(ctx, state any) *gomock.Call {
+// ListWebhookSubscriptionsByEventType indicates an expected call of ListWebhookSubscriptionsByEventType.
+func (mr *MockTransactionMockRecorder) ListWebhookSubscriptionsByEventType(ctx, organizationID, eventType any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeOAuthState", reflect.TypeOf((*MockTransaction)(nil).ConsumeOAuthState), ctx, state)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookSubscriptionsByEventType", reflect.TypeOf((*MockTransaction)(nil).ListWebhookSubscriptionsByEventType), ctx, organizationID, eventType)
 }
 
-// CreateAppSession mocks base method.
-func (m *MockTransaction) CreateAppSession(ctx context.Context, arg repository.CreateAppSessionArg) error {
+// ListWebhookSubscriptionsByOrganization mocks base method.
+func (m *MockTransaction) ListWebhookSubscriptionsByOrganization(ctx context.Context, organizationID model.OrganizationID) ([]model.WebhookSubscription, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAppSession", ctx, arg)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ListWebhookSubscriptionsByOrganization", ctx, organizationID)
+	ret0, _ := ret[0].([]model.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// CreateAppSession indicates an expected call of CreateAppSession.
-func (mr *MockTransactionMockRecorder) CreateAppSession(ctx, arg any) *gomock.Call {
+// ListWebhookSubscriptionsByOrganization indicates an expected call of ListWebhookSubscriptionsByOrganization.
+func (mr *MockTransactionMockRecorder) ListWebhookSubscriptionsByOrganization(ctx, organizationID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAppSession", reflect.TypeOf((*MockTransaction)(nil).CreateAppSession), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookSubscriptionsByOrganization", reflect.TypeOf((*MockTransaction)(nil).ListWebhookSubscriptionsByOrganization), ctx, organizationID)
 }
 
-// CreateKey mocks base method.
-func (m *MockTransaction) CreateKey(ctx context.Context, arg repository.CreateKeyArg) error {
+// LockAuditChain mocks base method.
+func (m *MockTransaction) LockAuditChain(ctx context.Context, organizationID model.OrganizationID) (model.AuditChainHead, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateKey", ctx, arg)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "LockAuditChain", ctx, organizationID)
+	ret0, _ := ret[0].(model.AuditChainHead)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// CreateKey indicates an expected call of CreateKey.
-func (mr *MockTransactionMockRecorder) CreateKey(ctx, arg any) *gomock.Call {
+// LockAuditChain indicates an expected call of LockAuditChain.
+func (mr *MockTransactionMockRecorder) LockAuditChain(ctx, organizationID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKey", reflect.TypeOf((*MockTransaction)(nil).CreateKey), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockAuditChain", reflect.TypeOf((*MockTransaction)(nil).LockAuditChain), ctx, organizationID)
 }
 
-// CreateRoom mocks base method.
-func (m *MockTransaction) CreateRoom(ctx context.Context, arg repository.CreateRoomArg) error {
+// LockLoginFailures mocks base method.
+func (m *MockTransaction) LockLoginFailures(ctx context.Context, key string, now time.Time) (model.LoginFailures, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateRoom", ctx, arg)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "LockLoginFailures", ctx, key, now)
+	ret0, _ := ret[0].(model.LoginFailures)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// CreateRoom indicates an expected call of CreateRoom.
-func (mr *MockTransactionMockRecorder) CreateRoom(ctx, arg any) *gomock.Call {
+// LockLoginFailures indicates an expected call of LockLoginFailures.
+func (mr *MockTransactionMockRecorder) LockLoginFailures(ctx, key, now any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoom", reflect.TypeOf((*MockTransaction)(nil).CreateRoom), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockLoginFailures", reflect.TypeOf((*MockTransaction)(nil).LockLoginFailures), ctx, key, now)
 }
 
-// CreateRoomAssignment mocks base method.
-func (m *MockTransaction) CreateRoomAssignment(ctx context.Context, arg repository.CreateRoomAssignmentArg) error {
+// LockRateLimitBucket mocks base method.
+func (m *MockTransaction) LockRateLimitBucket(ctx context.Context, key string, initial model.RateLimitBucket) (model.RateLimitBucket, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateRoomAssignment", ctx, arg)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "LockRateLimitBucket", ctx, key, initial)
+	ret0, _ := ret[0].(model.RateLimitBucket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// CreateRoomAssignment indicates an expected call of CreateRoomAssignment.
-func (mr *MockTransactionMockRecorder) CreateRoomAssignment(ctx, arg any) *gomock.Call {
+// LockRateLimitBucket indicates an expected call of LockRateLimitBucket.
+func (mr *MockTransactionMockRecorder) LockRateLimitBucket(ctx, key, initial any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoomAssignment", reflect.TypeOf((*MockTransaction)(nil).CreateRoomAssignment), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockRateLimitBucket", reflect.TypeOf((*MockTransaction)(nil).LockRateLimitBucket), ctx, key, initial)
 }
 
-// CreateSession mocks base method.
-func (m *MockTransaction) CreateSession(ctx context.Context, arg repository.CreateConsoleSessionArg) error {
+// RemoveRoomCustomFieldValues mocks base method.
+func (m *MockTransaction) RemoveRoomCustomFieldValues(ctx context.Context, organizationID model.OrganizationID, key model.RoomCustomFieldKey) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSession", ctx, arg)
+	ret := m.ctrl.Call(m, "RemoveRoomCustomFieldValues", ctx, organizationID, key)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// CreateSession indicates an expected call of CreateSession.
-func (mr *MockTransactionMockRecorder) CreateSession(ctx, arg any) *gomock.Call {
+// RemoveRoomCustomFieldValues indicates an expected call of RemoveRoomCustomFieldValues.
+func (mr *MockTransactionMockRecorder) RemoveRoomCustomFieldValues(ctx, organizationID, key any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockTransaction)(nil).CreateSession), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRoomCustomFieldValues", reflect.TypeOf((*MockTransaction)(nil).RemoveRoomCustomFieldValues), ctx, organizationID, key)
 }
 
-// CreateTenant mocks base method.
-func (m *MockTransaction) CreateTenant(ctx context.Context, arg repository.CreateTenantArg) error {
+// RemoveTenantGroupMember mocks base method.
+func (m *MockTransaction) RemoveTenantGroupMember(ctx context.Context, groupID model.TenantGroupID, userID model.UserID) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTenant", ctx, arg)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "RemoveTenantGroupMember", ctx, groupID, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// CreateTenant indicates an expected call of CreateTenant.
-func (mr *MockTransactionMockRecorder) CreateTenant(ctx, arg any) *gomock.Call {
+// RemoveTenantGroupMember indicates an expected call of RemoveTenantGroupMember.
+func (mr *MockTransactionMockRecorder) RemoveTenantGroupMember(ctx, groupID, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTenant", reflect.TypeOf((*MockTransaction)(nil).CreateTenant), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTenantGroupMember", reflect.TypeOf((*MockTransaction)(nil).RemoveTenantGroupMember), ctx, groupID, userID)
 }
 
-// CreateTenantJoinCode mocks base method.
-func (m *MockTransaction) CreateTenantJoinCode(ctx context.Context, arg repository.CreateTenantJoinCodeArg) error {
+// RevokeAPITokenByOrganization mocks base method.
+func (m *MockTransaction) RevokeAPITokenByOrganization(ctx context.Context, organizationID model.OrganizationID, id model.APITokenID) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTenantJoinCode", ctx, arg)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "RevokeAPITokenByOrganization", ctx, organizationID, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// CreateTenantJoinCode indicates an expected call of CreateTenantJoinCode.
-func (mr *MockTransactionMockRecorder) CreateTenantJoinCode(ctx, arg any) *gomock.Call {
+// RevokeAPITokenByOrganization indicates an expected call of RevokeAPITokenByOrganization.
+func (mr *MockTransactionMockRecorder) RevokeAPITokenByOrganization(ctx, organizationID, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTenantJoinCode", reflect.TypeOf((*MockTransaction)(nil).CreateTenantJoinCode), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPITokenByOrganization", reflect.TypeOf((*MockTransaction)(nil).RevokeAPITokenByOrganization), ctx, organizationID, id)
 }
 
-// CreateTenantMembership mocks base method.
-func (m *MockTransaction) CreateTenantMembership(ctx context.Context, membership model.TenantMembership) error {
+// RevokeAPITokenByUser mocks base method.
+func (m *MockTransaction) RevokeAPITokenByUser(ctx context.Context, userID model.UserID, id model.APITokenID) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTenantMembership", ctx, membership)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "RevokeAPITokenByUser", ctx, userID, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// CreateTenantMembership indicates an expected call of CreateTenantMembership.
-func (mr *MockTransactionMockRecorder) CreateTenantMembership(ctx, membership any) *gomock.Call {
+// RevokeAPITokenByUser indicates an expected call of RevokeAPITokenByUser.
+func (mr *MockTransactionMockRecorder) RevokeAPITokenByUser(ctx, userID, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTenantMembership", reflect.TypeOf((*MockTransaction)(nil).CreateTenantMembership), ctx, membership)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPITokenByUser", reflect.TypeOf((*MockTransaction)(nil).RevokeAPITokenByUser), ctx, userID, id)
 }
 
-// DeleteSession mocks base method.
-func (m *MockTransaction) DeleteSession(ctx context.Context, sessionID model.ConsoleSessionID) error {
+// RevokeAppSession mocks base method.
+func (m *MockTransaction) RevokeAppSession(ctx context.Context, sessionID model.AppSessionID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteSession", ctx, sessionID)
+	ret := m.ctrl.Call(m, "RevokeAppSession", ctx, sessionID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteSession indicates an expected call of DeleteSession.
-func (mr *MockTransactionMockRecorder) DeleteSession(ctx, sessionID any) *gomock.Call {
+// RevokeAppSession indicates an expected call of RevokeAppSession.
+func (mr *MockTransactionMockRecorder) RevokeAppSession(ctx, sessionID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSession", reflect.TypeOf((*MockTransaction)(nil).DeleteSession), ctx, sessionID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAppSession", reflect.TypeOf((*MockTransaction)(nil).RevokeAppSession), ctx, sessionID)
 }
 
-// GetAllRooms mocks base method.
-func (m *MockTransaction) GetAllRooms(ctx context.Context) ([]model.Room, error) {
+// RevokeAppSessionByUser mocks base method.
+func (m *MockTransaction) RevokeAppSessionByUser(ctx context.Context, userID model.UserID, sessionID model.AppSessionID) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllRooms", ctx)
-	ret0, _ := ret[0].([]model.Room)
+	ret := m.ctrl.Call(m, "RevokeAppSessionByUser", ctx, userID, sessionID)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAllRooms indicates an expected call of GetAllRooms.
-func (mr *MockTransactionMockRecorder) GetAllRooms(ctx any) *gomock.Call {
+// RevokeAppSessionByUser indicates an expected call of RevokeAppSessionByUser.
+func (mr *MockTransactionMockRecorder) RevokeAppSessionByUser(ctx, userID, sessionID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllRooms", reflect.TypeOf((*MockTransaction)(nil).GetAllRooms), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAppSessionByUser", reflect.TypeOf((*MockTransaction)(nil).RevokeAppSessionByUser), ctx, userID, sessionID)
 }
 
-// GetAllTenants mocks base method.
-func (m *MockTransaction) GetAllTenants(ctx context.Context) ([]model.Tenant, error) {
+// RevokeConsoleOperator mocks base method.
+func (m *MockTransaction) RevokeConsoleOperator(ctx context.Context, organizationID model.OrganizationID, id model.ConsoleOperatorID) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllTenants", ctx)
-	ret0, _ := ret[0].([]model.Tenant)
+	ret := m.ctrl.Call(m, "RevokeConsoleOperator", ctx, organizationID, id)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAllTenants indicates an expected call of GetAllTenants.
-func (mr *MockTransactionMockRecorder) GetAllTenants(ctx any) *gomock.Call {
+// RevokeConsoleOperator indicates an expected call of RevokeConsoleOperator.
+func (mr *MockTransactionMockRecorder) RevokeConsoleOperator(ctx, organizationID, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllTenants", reflect.TypeOf((*MockTransaction)(nil).GetAllTenants), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeConsoleOperator", reflect.TypeOf((*MockTransaction)(nil).RevokeConsoleOperator), ctx, organizationID, id)
 }
 
-// GetAppSession mocks base method.
-func (m *MockTransaction) GetAppSession(ctx context.Context, sessionID model.AppSessionID) (model.AppSession, error) {
+// RevokeOtherAppSessionsByUser mocks base method.
+func (m *MockTransaction) RevokeOtherAppSessionsByUser(ctx context.Context, userID model.UserID, currentSessionID model.AppSessionID) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAppSession", ctx, sessionID)
-	ret0, _ := ret[0].(model.AppSession)
+	ret := m.ctrl.Call(m, "RevokeOtherAppSessionsByUser", ctx, userID, currentSessionID)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAppSession indicates an expected call of GetAppSession.
-func (mr *MockTransactionMockRecorder) GetAppSession(ctx, sessionID any) *gomock.Call {
+// RevokeOtherAppSessionsByUser indicates an expected call of RevokeOtherAppSessionsByUser.
+func (mr *MockTransactionMockRecorder) RevokeOtherAppSessionsByUser(ctx, userID, currentSessionID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppSession", reflect.TypeOf((*MockTransaction)(nil).GetAppSession), ctx, sessionID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeOtherAppSessionsByUser", reflect.TypeOf((*MockTransaction)(nil).RevokeOtherAppSessionsByUser), ctx, userID, currentSessionID)
 }
 
-// GetKeysByRoom mocks base method.
-func (m *MockTransaction) GetKeysByRoom(ctx context.Context, roomID model.RoomID) ([]model.Key, error) {
+// SaveLoginFailures mocks base method.
+func (m *MockTransaction) SaveLoginFailures(ctx context.Context, arg repository.SaveLoginFailuresArg) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetKeysByRoom", ctx, roomID)
-	ret0, _ := ret[0].([]model.Key)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "SaveLoginFailures", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetKeysByRoom indicates an expected call of GetKeysByRoom.
-func (mr *MockTransactionMockRecorder) GetKeysByRoom(ctx, roomID any) *gomock.Call {
+// SaveLoginFailures indicates an expected call of SaveLoginFailures.
+func (mr *MockTransactionMockRecorder) SaveLoginFailures(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKeysByRoom", reflect.TypeOf((*MockTransaction)(nil).GetKeysByRoom), ctx, roomID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveLoginFailures", reflect.TypeOf((*MockTransaction)(nil).SaveLoginFailures), ctx, arg)
 }
 
-// GetOAuthState mocks base method.
-func (m *MockTransaction) GetOAuthState(ctx context.Context, state string) (model.OAuthState, error) {
+// SaveOAuthState mocks base method.
+func (m *MockTransaction) SaveOAuthState(ctx context.Context, oauthState model.OAuthState) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOAuthState", ctx, state)
-	ret0, _ := ret[0].(model.OAuthState)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "SaveOAuthState", ctx, oauthState)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetOAuthState indicates an expected call of GetOAuthState.
-func (mr *MockTransactionMockRecorder) GetOAuthState(ctx, state any) *gomock.Call {
+// SaveOAuthState indicates an expected call of SaveOAuthState.
+func (mr *MockTransactionMockRecorder) SaveOAuthState(ctx, oauthState any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOAuthState", reflect.TypeOf((*MockTransaction)(nil).GetOAuthState), ctx, state)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveOAuthState", reflect.TypeOf((*MockTransaction)(nil).SaveOAuthState), ctx, oauthState)
 }
 
-// GetRoomByID mocks base method.
-func (m *MockTransaction) GetRoomByID(ctx context.Context, id model.RoomID) (model.Room, error) {
+// SavePasskeyCeremony mocks base method.
+func (m *MockTransaction) SavePasskeyCeremony(ctx context.Context, ceremony model.PasskeyCeremony) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRoomByID", ctx, id)
-	ret0, _ := ret[0].(model.Room)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "SavePasskeyCeremony", ctx, ceremony)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetRoomByID indicates an expected call of GetRoomByID.
-func (mr *MockTransactionMockRecorder) GetRoomByID(ctx, id any) *gomock.Call {
+// SavePasskeyCeremony indicates an expected call of SavePasskeyCeremony.
+func (mr *MockTransactionMockRecorder) SavePasskeyCeremony(ctx, ceremony any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoomByID", reflect.TypeOf((*MockTransaction)(nil).GetRoomByID), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePasskeyCeremony", reflect.TypeOf((*MockTransaction)(nil).SavePasskeyCeremony), ctx, ceremony)
 }
 
-// GetRoomsByTenant mocks base method.
-func (m *MockTransaction) GetRoomsByTenant(ctx context.Context, tenantID model.TenantID) ([]model.Room, error) {
+// SaveRateLimitBucket mocks base method.
+func (m *MockTransaction) SaveRateLimitBucket(ctx context.Context, arg repository.SaveRateLimitBucketArg) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRoomsByTenant", ctx, tenantID)
-	ret0, _ := ret[0].([]model.Room)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "SaveRateLimitBucket", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetRoomsByTenant indicates an expected call of GetRoomsByTenant.
-func (mr *MockTransactionMockRecorder) GetRoomsByTenant(ctx, tenantID any) *gomock.Call {
+// SaveRateLimitBucket indicates an expected call of SaveRateLimitBucket.
+func (mr *MockTransactionMockRecorder) SaveRateLimitBucket(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoomsByTenant", reflect.TypeOf((*MockTransaction)(nil).GetRoomsByTenant), ctx, tenantID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRateLimitBucket", reflect.TypeOf((*MockTransaction)(nil).SaveRateLimitBucket), ctx, arg)
 }
 
-// GetSession mocks base method.
-func (m *MockTransaction) GetSession(ctx context.Context, sessionID model.ConsoleSessionID) (model.ConsoleSession, error) {
+// Search mocks base method.
+func (m *MockTransaction) Search(ctx context.Context, arg repository.SearchArg) ([]model.SearchResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSession", ctx, sessionID)
-	ret0, _ := ret[0].(model.ConsoleSession)
+	ret := m.ctrl.Call(m, "Search", ctx, arg)
+	ret0, _ := ret[0].([]model.SearchResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSession indicates an expected call of GetSession.
-func (mr *MockTransactionMockRecorder) GetSession(ctx, sessionID any) *gomock.Call {
+// Search indicates an expected call of Search.
+func (mr *MockTransactionMockRecorder) Search(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockTransaction)(nil).GetSession), ctx, sessionID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockTransaction)(nil).Search), ctx, arg)
 }
 
-// GetTenantByID mocks base method.
-func (m *MockTransaction) GetTenantByID(ctx context.Context, id model.TenantID) (repository.TenantWithJoinCode, error) {
+// SearchAuditLogs mocks base method.
+func (m *MockTransaction) SearchAuditLogs(ctx context.Context, arg repository.SearchAuditLogsArg) ([]model.AuditLog, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTenantByID", ctx, id)
-	ret0, _ := ret[0].(repository.TenantWithJoinCode)
+	ret := m.ctrl.Call(m, "SearchAuditLogs", ctx, arg)
+	ret0, _ := ret[0].([]model.AuditLog)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTenantByID indicates an expected call of GetTenantByID.
-func (mr *MockTransactionMockRecorder) GetTenantByID(ctx, id any) *gomock.Call {
+// SearchAuditLogs indicates an expected call of SearchAuditLogs.
+func (mr *MockTransactionMockRecorder) SearchAuditLogs(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenantByID", reflect.TypeOf((*MockTransaction)(nil).GetTenantByID), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAuditLogs", reflect.TypeOf((*MockTransaction)(nil).SearchAuditLogs), ctx, arg)
 }
 
-// GetTenantByJoinCode mocks base method.
-func (m *MockTransaction) GetTenantByJoinCode(ctx context.Context, code model.TenantJoinCode) (model.Tenant, error) {
+// TouchAPIToken mocks base method.
+func (m *MockTransaction) TouchAPIToken(ctx context.Context, id model.APITokenID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTenantByJoinCode", ctx, code)
-	ret0, _ := ret[0].(model.Tenant)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "TouchAPIToken", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetTenantByJoinCode indicates an expected call of GetTenantByJoinCode.
-func (mr *MockTransactionMockRecorder) GetTenantByJoinCode(ctx, code any) *gomock.Call {
+// TouchAPIToken indicates an expected call of TouchAPIToken.
+func (mr *MockTransactionMockRecorder) TouchAPIToken(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenantByJoinCode", reflect.TypeOf((*MockTransaction)(nil).GetTenantByJoinCode), ctx, code)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchAPIToken", reflect.TypeOf((*MockTransaction)(nil).TouchAPIToken), ctx, id)
 }
 
-// GetTenantMembershipByTenantAndUser mocks base method.
-func (m *MockTransaction) GetTenantMembershipByTenantAndUser(ctx context.Context, tenantID model.TenantID, userID model.UserID) (model.TenantMembership, error) {
+// TouchAppSession mocks base method.
+func (m *MockTransaction) TouchAppSession(ctx context.Context, sessionID model.AppSessionID, client model.SessionClient) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTenantMembershipByTenantAndUser", ctx, tenantID, userID)
-	ret0, _ := ret[0].(model.TenantMembership)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "TouchAppSession", ctx, sessionID, client)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetTenantMembershipByTenantAndUser indicates an expected call of GetTenantMembershipByTenantAndUser.
-func (mr *MockTransactionMockRecorder) GetTenantMembershipByTenantAndUser(ctx, tenantID, userID any) *gomock.Call {
+// TouchAppSession indicates an expected call of TouchAppSession.
+func (mr *MockTransactionMockRecorder) TouchAppSession(ctx, sessionID, client any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenantMembershipByTenantAndUser", reflect.TypeOf((*MockTransaction)(nil).GetTenantMembershipByTenantAndUser), ctx, tenantID, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchAppSession", reflect.TypeOf((*MockTransaction)(nil).TouchAppSession), ctx, sessionID, client)
 }
 
-// GetTenantsByUserID mocks base method.
-func (m *MockTransaction) GetTenantsByUserID(ctx context.Context, userID model.UserID) ([]repository.TenantWithMemberCount, error) {
+// TouchSession mocks base method.
+func (m *MockTransaction) TouchSession(ctx context.Context, sessionID model.ConsoleSessionID, client model.SessionClient) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTenantsByUserID", ctx, userID)
-	ret0, _ := ret[0].([]repository.TenantWithMemberCount)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "TouchSession", ctx, sessionID, client)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetTenantsByUserID indicates an expected call of GetTenantsByUserID.
-func (mr *MockTransactionMockRecorder) GetTenantsByUserID(ctx, userID any) *gomock.Call {
+// TouchSession indicates an expected call of TouchSession.
+func (mr *MockTransactionMockRecorder) TouchSession(ctx, sessionID, client any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenantsByUserID", reflect.TypeOf((*MockTransaction)(nil).GetTenantsByUserID), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchSession", reflect.TypeOf((*MockTransaction)(nil).TouchSession), ctx, sessionID, client)
 }
 
-// GetUser mocks base method.
-func (m *MockTransaction) GetUser(ctx context.Context, userID model.UserID) (model.User, error) {
+// UpdateBuilding mocks base method.
+func (m *MockTransaction) UpdateBuilding(ctx context.Context, building model.Building) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUser", ctx, userID)
-	ret0, _ := ret[0].(model.User)
+	ret := m.ctrl.Call(m, "UpdateBuilding", ctx, building)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateBuilding indicates an expected call of UpdateBuilding.
+func (mr *MockTransactionMockRecorder) UpdateBuilding(ctx, building any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBuilding", reflect.TypeOf((*MockTransaction)(nil).UpdateBuilding), ctx, building)
+}
+
+// UpdateFloor mocks base method.
+func (m *MockTransaction) UpdateFloor(ctx context.Context, floor model.Floor) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFloor", ctx, floor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFloor indicates an expected call of UpdateFloor.
+func (mr *MockTransactionMockRecorder) UpdateFloor(ctx, floor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFloor", reflect.TypeOf((*MockTransaction)(nil).UpdateFloor), ctx, floor)
+}
+
+// UpdateKeyNumber mocks base method.
+func (m *MockTransaction) UpdateKeyNumber(ctx context.Context, id model.KeyID, keyNumber model.KeyNumber, version int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateKeyNumber", ctx, id, keyNumber, version)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUser indicates an expected call of GetUser.
-func (mr *MockTransactionMockRecorder) GetUser(ctx, userID any) *gomock.Call {
+// UpdateKeyNumber indicates an expected call of UpdateKeyNumber.
+func (mr *MockTransactionMockRecorder) UpdateKeyNumber(ctx, id, keyNumber, version any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockTransaction)(nil).GetUser), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateKeyNumber", reflect.TypeOf((*MockTransaction)(nil).UpdateKeyNumber), ctx, id, keyNumber, version)
 }
 
-// GetUserByProviderIdentity mocks base method.
-func (m *MockTransaction) GetUserByProviderIdentity(ctx context.Context, provider, providerSub string) (model.User, error) {
+// UpdateOrganizationKeyHash mocks base method.
+func (m *MockTransaction) UpdateOrganizationKeyHash(ctx context.Context, id model.OrganizationID, keyHash string) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserByProviderIdentity", ctx, provider, providerSub)
-	ret0, _ := ret[0].(model.User)
+	ret := m.ctrl.Call(m, "UpdateOrganizationKeyHash", ctx, id, keyHash)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUserByProviderIdentity indicates an expected call of GetUserByProviderIdentity.
-func (mr *MockTransactionMockRecorder) GetUserByProviderIdentity(ctx, provider, providerSub any) *gomock.Call {
+// UpdateOrganizationKeyHash indicates an expected call of UpdateOrganizationKeyHash.
+func (mr *MockTransactionMockRecorder) UpdateOrganizationKeyHash(ctx, id, keyHash any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByProviderIdentity", reflect.TypeOf((*MockTransaction)(nil).GetUserByProviderIdentity), ctx, provider, providerSub)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrganizationKeyHash", reflect.TypeOf((*MockTransaction)(nil).UpdateOrganizationKeyHash), ctx, id, keyHash)
 }
 
-// IncrementJoinCodeUsedCount mocks base method.
-func (m *MockTransaction) IncrementJoinCodeUsedCount(ctx context.Context, code model.TenantJoinCode) error {
+// UpdateOutboxEvent mocks base method.
+func (m *MockTransaction) UpdateOutboxEvent(ctx context.Context, event model.OutboxEvent) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IncrementJoinCodeUsedCount", ctx, code)
+	ret := m.ctrl.Call(m, "UpdateOutboxEvent", ctx, event)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// IncrementJoinCodeUsedCount indicates an expected call of IncrementJoinCodeUsedCount.
-func (mr *MockTransactionMockRecorder) IncrementJoinCodeUsedCount(ctx, code any) *gomock.Call {
+// UpdateOutboxEvent indicates an expected call of UpdateOutboxEvent.
+func (mr *MockTransactionMockRecorder) UpdateOutboxEvent(ctx, event any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementJoinCodeUsedCount", reflect.TypeOf((*MockTransaction)(nil).IncrementJoinCodeUsedCount), ctx, code)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOutboxEvent", reflect.TypeOf((*MockTransaction)(nil).UpdateOutboxEvent), ctx, event)
 }
 
-// RevokeAppSession mocks base method.
-func (m *MockTransaction) RevokeAppSession(ctx context.Context, sessionID model.AppSessionID) error {
+// UpdatePasskeyUsage mocks base method.
+func (m *MockTransaction) UpdatePasskeyUsage(ctx context.Context, arg repository.UpdatePasskeyUsageArg) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RevokeAppSession", ctx, sessionID)
+	ret := m.ctrl.Call(m, "UpdatePasskeyUsage", ctx, arg)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// RevokeAppSession indicates an expected call of RevokeAppSession.
-func (mr *MockTransactionMockRecorder) RevokeAppSession(ctx, sessionID any) *gomock.Call {
+// UpdatePasskeyUsage indicates an expected call of UpdatePasskeyUsage.
+func (mr *MockTransactionMockRecorder) UpdatePasskeyUsage(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAppSession", reflect.TypeOf((*MockTransaction)(nil).RevokeAppSession), ctx, sessionID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePasskeyUsage", reflect.TypeOf((*MockTransaction)(nil).UpdatePasskeyUsage), ctx, arg)
 }
 
-// SaveOAuthState mocks base method.
-func (m *MockTransaction) SaveOAuthState(ctx context.Context, oauthState model.OAuthState) error {
+// UpdateRoomAttributes mocks base method.
+func (m *MockTransaction) UpdateRoomAttributes(ctx context.Context, id model.RoomID, attributes model.RoomAttributes, version int64) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SaveOAuthState", ctx, oauthState)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "UpdateRoomAttributes", ctx, id, attributes, version)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// SaveOAuthState indicates an expected call of SaveOAuthState.
-func (mr *MockTransactionMockRecorder) SaveOAuthState(ctx, oauthState any) *gomock.Call {
+// UpdateRoomAttributes indicates an expected call of UpdateRoomAttributes.
+func (mr *MockTransactionMockRecorder) UpdateRoomAttributes(ctx, id, attributes, version any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveOAuthState", reflect.TypeOf((*MockTransaction)(nil).SaveOAuthState), ctx, oauthState)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoomAttributes", reflect.TypeOf((*MockTransaction)(nil).UpdateRoomAttributes), ctx, id, attributes, version)
 }
 
 // UpdateTenant mocks base method.
-func (m *MockTransaction) UpdateTenant(ctx context.Context, arg repository.UpdateTenantArg) error {
+func (m *MockTransaction) UpdateTenant(ctx context.Context, arg repository.UpdateTenantArg) (int64, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdateTenant", ctx, arg)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // UpdateTenant indicates an expected call of UpdateTenant.
@@ -949,6 +4406,20 @@ func (mr *MockTransactionMockRecorder) UpdateTenantJoinCodeByTenantId(ctx, arg a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTenantJoinCodeByTenantId", reflect.TypeOf((*MockTransaction)(nil).UpdateTenantJoinCodeByTenantId), ctx, arg)
 }
 
+// UpsertNotificationPreferences mocks base method.
+func (m *MockTransaction) UpsertNotificationPreferences(ctx context.Context, preferences model.NotificationPreferences) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertNotificationPreferences", ctx, preferences)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertNotificationPreferences indicates an expected call of UpsertNotificationPreferences.
+func (mr *MockTransactionMockRecorder) UpsertNotificationPreferences(ctx, preferences any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertNotificationPreferences", reflect.TypeOf((*MockTransaction)(nil).UpsertNotificationPreferences), ctx, preferences)
+}
+
 // UpsertUser mocks base method.
 func (m *MockTransaction) UpsertUser(ctx context.Context, arg repository.UpsertUserArg) (model.User, error) {
 	m.ctrl.T.Helper()
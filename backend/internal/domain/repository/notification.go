@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+)
+
+type NotificationRepository interface {
+	// GetNotificationPreferences はユーザーの通知設定を返す。保存していない場合は既定の設定を返す
+	GetNotificationPreferences(ctx context.Context, userID model.UserID) (model.NotificationPreferences, error)
+	UpsertNotificationPreferences(ctx context.Context, preferences model.NotificationPreferences) error
+	// ListTenantNotificationRecipients はテナントに所属しているユーザーを通知設定とともに返す。adminsOnly の場合は管理者だけを返す
+	ListTenantNotificationRecipients(ctx context.Context, tenantID model.TenantID, adminsOnly bool) ([]model.NotificationRecipient, error)
+	// ClaimNotificationDelivery は送信記録を作成し、同じ DedupKey の記録が既にあれば false を返す
+	ClaimNotificationDelivery(ctx context.Context, delivery model.NotificationDelivery) (bool, error)
+	// DeleteNotificationDelivery は送信に失敗した通知の記録を消し、次の機会に送り直せるようにする
+	DeleteNotificationDelivery(ctx context.Context, dedupKey string) error
+}
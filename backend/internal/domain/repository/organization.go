@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+)
+
+type CreateOrganizationArg struct {
+	Organization model.Organization
+	KeyHash      string
+}
+
+type OrganizationRepository interface {
+	CreateOrganization(ctx context.Context, arg CreateOrganizationArg) error
+	GetOrganization(ctx context.Context, id model.OrganizationID) (model.Organization, error)
+	GetOrganizationBySlug(ctx context.Context, slug model.OrganizationSlug) (model.Organization, error)
+	// GetOrganizationKeyHash はログインキーのハッシュを返す。キーが未発行の場合は空文字を返す
+	GetOrganizationKeyHash(ctx context.Context, id model.OrganizationID) (string, error)
+	ListOrganizations(ctx context.Context) ([]model.Organization, error)
+	UpdateOrganizationKeyHash(ctx context.Context, id model.OrganizationID, keyHash string) (int64, error)
+}
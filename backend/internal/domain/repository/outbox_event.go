@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+)
+
+type OutboxEventRepository interface {
+	CreateOutboxEvent(ctx context.Context, event model.OutboxEvent) error
+	// ClaimOutboxEvents は now の時点で配送期限が来たイベントを最大 limit 件取り出す。
+	// 取り出したイベントは leaseUntil まで他のディスパッチャーから取り出されない
+	ClaimOutboxEvents(ctx context.Context, now, leaseUntil time.Time, limit int32) ([]model.OutboxEvent, error)
+	UpdateOutboxEvent(ctx context.Context, event model.OutboxEvent) error
+}
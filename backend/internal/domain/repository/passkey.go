@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+)
+
+type UpdatePasskeyUsageArg struct {
+	ID          model.PasskeyID
+	SignCount   uint32
+	BackupState bool
+}
+
+type PasskeyRepository interface {
+	CreatePasskey(ctx context.Context, passkey model.Passkey) error
+	ListPasskeysByUser(ctx context.Context, userID model.UserID) ([]model.Passkey, error)
+	UpdatePasskeyUsage(ctx context.Context, arg UpdatePasskeyUsageArg) error
+	DeletePasskeyByUser(ctx context.Context, userID model.UserID, id model.PasskeyID) (int64, error)
+	SavePasskeyCeremony(ctx context.Context, ceremony model.PasskeyCeremony) error
+	// ConsumePasskeyCeremony はセレモニーを取得すると同時に削除し、同じチャレンジの再利用を防ぐ
+	ConsumePasskeyCeremony(ctx context.Context, id string) (model.PasskeyCeremony, error)
+}
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+)
+
+type SaveRateLimitBucketArg struct {
+	Key    string
+	Bucket model.RateLimitBucket
+	// IdleAt を過ぎたバケットは満タンまで回復しているため削除してよい
+	IdleAt time.Time
+}
+
+type SaveLoginFailuresArg struct {
+	Key      string
+	Failures model.LoginFailures
+	// IdleAt を過ぎた記録はロックも失敗回数も期限切れのため削除してよい
+	IdleAt time.Time
+}
+
+type RateLimitRepository interface {
+	// LockRateLimitBucket はバケットがなければ initial で作成し、行ロックを取得して返す
+	LockRateLimitBucket(ctx context.Context, key string, initial model.RateLimitBucket) (model.RateLimitBucket, error)
+	SaveRateLimitBucket(ctx context.Context, arg SaveRateLimitBucketArg) error
+	// GetLoginFailures は失敗の記録を返す。記録がなければゼロ値を返す
+	GetLoginFailures(ctx context.Context, key string) (model.LoginFailures, error)
+	// LockLoginFailures は失敗の記録がなければ作成し、行ロックを取得して返す
+	LockLoginFailures(ctx context.Context, key string, now time.Time) (model.LoginFailures, error)
+	SaveLoginFailures(ctx context.Context, arg SaveLoginFailuresArg) error
+	// DeleteLoginFailures はロック中でなければ失敗の記録を削除する
+	DeleteLoginFailures(ctx context.Context, key string) error
+	DeleteIdleRateLimits(ctx context.Context, before time.Time) error
+}
@@ -2,7 +2,14 @@ package repository
 
 //go:generate go run go.uber.org/mock/mockgen@latest -source=$GOFILE -destination=mock/mock_repository.go -package=mock
 
-import "context"
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+)
+
+// ErrReferenced は削除しようとした行が他の行から参照されていて削除できないことを表す
+var ErrReferenced = errors.New("row is still referenced")
 
 type Repository interface {
 	Transaction
@@ -11,14 +18,29 @@ type Repository interface {
 }
 
 type Transaction interface {
+	OrganizationRepository
 	UserRepository
 	TenantRepository
+	TenantTypeRepository
 	TenantJoinCodeRepository
 	TenantMembershipRepository
+	TenantGroupRepository
 	ConsoleSessionRepository
+	ConsoleOperatorRepository
 	AppSessionRepository
 	OAuthStateRepository
+	BuildingRepository
 	RoomRepository
+	RoomCustomFieldRepository
+	RoomTypeRepository
 	RoomAssignmentRepository
 	KeyRepository
+	APITokenRepository
+	PasskeyRepository
+	RateLimitRepository
+	AuditLogRepository
+	OutboxEventRepository
+	WebhookRepository
+	NotificationRepository
+	SearchRepository
 }
@@ -10,15 +10,38 @@ type CreateRoomArg struct {
 	ID             model.RoomID
 	OrganizationID model.OrganizationID
 	Name           model.RoomName
-	BuildingName   model.BuildingName
-	FloorNumber    model.FloorNumber
+	FloorID        model.FloorID
 	Type           model.RoomType
 	Description    model.RoomDescription
+	Attributes     model.RoomAttributes
+}
+
+// AccessibleRoom はユーザーが利用できる部屋。CanBorrowKeys は鍵の貸出をグループに限定した部屋で、ユーザーがそのグループに属するかを表す
+type AccessibleRoom struct {
+	Room          model.Room
+	CanBorrowKeys bool
+}
+
+// ListRoomsArg は部屋の一覧の条件。ゼロ値の条件は絞り込みに使わない
+type ListRoomsArg struct {
+	BuildingID   *model.BuildingID
+	FloorID      *model.FloorID
+	BuildingName model.BuildingName
+	FloorNumber  model.FloorNumber
+	Type         model.RoomType
+	NamePrefix   string
+	Filter       model.RoomFilter
+	Order        model.ListOrder
+	Cursor       *model.PageCursor
+	Limit        int32
 }
 
 type RoomRepository interface {
 	CreateRoom(ctx context.Context, arg CreateRoomArg) error
 	GetRoomByID(ctx context.Context, id model.RoomID) (model.Room, error)
-	GetAllRooms(ctx context.Context) ([]model.Room, error)
-	GetRoomsByTenant(ctx context.Context, tenantID model.TenantID) ([]model.Room, error)
+	ListRooms(ctx context.Context, arg ListRoomsArg) ([]model.Room, error)
+	// UpdateRoomAttributes は更新後の版数を返す。部屋が無いか版数が version から変わっていた場合は更新せず 0 を返す
+	UpdateRoomAttributes(ctx context.Context, id model.RoomID, attributes model.RoomAttributes, version int64) (int64, error)
+	// GetRoomsByTenant はテナントに割り当てられた部屋のうち、ユーザーが所属するグループから利用できるものを filter で絞り込んで返す
+	GetRoomsByTenant(ctx context.Context, tenantID model.TenantID, userID model.UserID, filter model.RoomFilter) ([]AccessibleRoom, error)
 }
@@ -8,13 +8,29 @@ import (
 )
 
 type CreateRoomAssignmentArg struct {
-	ID         model.RoomAssignmentID
-	TenantID   model.TenantID
-	RoomID     model.RoomID
-	AssignedAt time.Time
-	ExpiresAt  *time.Time
+	ID             model.RoomAssignmentID
+	TenantID       model.TenantID
+	RoomID         model.RoomID
+	GroupID        *model.TenantGroupID
+	KeyLoanGroupID *model.TenantGroupID
+	AssignedAt     time.Time
+	ExpiresAt      *time.Time
+}
+
+// ExpiringRoomAssignment は期限が近い部屋の割り当てと、その割り当て先のテナント・部屋
+type ExpiringRoomAssignment struct {
+	Assignment model.RoomAssignment
+	Tenant     model.Tenant
+	Room       model.Room
 }
 
 type RoomAssignmentRepository interface {
 	CreateRoomAssignment(ctx context.Context, arg CreateRoomAssignmentArg) error
+	// ListExpiringRoomAssignments は now より後、until までに期限を迎える部屋の割り当てを期限の近い順に返す
+	ListExpiringRoomAssignments(ctx context.Context, now, until time.Time) ([]ExpiringRoomAssignment, error)
+	// GetAssignedRoomIDsByTenant はテナントに現在割り当てられている部屋のIDを返す
+	GetAssignedRoomIDsByTenant(ctx context.Context, tenantID model.TenantID) ([]model.RoomID, error)
+	// EndRoomAssignmentsByTenant はテナントの有効な割り当ての期限を now にして終了し、終了した件数を返す
+	EndRoomAssignmentsByTenant(ctx context.Context, tenantID model.TenantID, now time.Time) (int64, error)
+	DeleteRoomAssignmentsByTenant(ctx context.Context, tenantID model.TenantID) error
 }
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+)
+
+type RoomCustomFieldRepository interface {
+	CreateRoomCustomField(ctx context.Context, field model.RoomCustomField) error
+	GetRoomCustomField(ctx context.Context, id model.RoomCustomFieldID) (model.RoomCustomField, error)
+	// ListRoomCustomFields は組織のカスタム項目の定義を作成順に返す
+	ListRoomCustomFields(ctx context.Context) (model.RoomCustomFieldSchema, error)
+	DeleteRoomCustomField(ctx context.Context, id model.RoomCustomFieldID) (int64, error)
+	// RemoveRoomCustomFieldValues は組織の部屋からカスタム項目の値を取り除く
+	RemoveRoomCustomFieldValues(ctx context.Context, organizationID model.OrganizationID, key model.RoomCustomFieldKey) error
+}
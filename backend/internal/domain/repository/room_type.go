@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+)
+
+type RoomTypeRepository interface {
+	CreateRoomType(ctx context.Context, definition model.RoomTypeDefinition) error
+	GetRoomType(ctx context.Context, id model.RoomTypeID) (model.RoomTypeDefinition, error)
+	// ListRoomTypes は組織が追加した部屋タイプを作成順に返す。既定の部屋タイプは含まない
+	ListRoomTypes(ctx context.Context) (model.RoomTypeCatalog, error)
+	DeleteRoomType(ctx context.Context, id model.RoomTypeID) (int64, error)
+	// CountRoomsByType は部屋タイプを使っている組織の部屋の数を返す
+	CountRoomsByType(ctx context.Context, organizationID model.OrganizationID, key model.RoomType) (int32, error)
+}
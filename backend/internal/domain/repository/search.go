@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+)
+
+// SearchArg は横断検索の条件。Types が空の場合はすべての種類を探す
+type SearchArg struct {
+	OrganizationID model.OrganizationID
+	Query          model.SearchQuery
+	Types          []model.SearchResultType
+	Limit          int32
+}
+
+type SearchRepository interface {
+	// Search は組織の部屋・鍵・テナント・メンバーを横断して探し、関連度の高い順に返す
+	Search(ctx context.Context, arg SearchArg) ([]model.SearchResult, error)
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/shibayama-club/keyhub/internal/domain/model"
 )
@@ -23,16 +24,35 @@ type UpdateTenantArg struct {
 	Name        model.TenantName
 	Description model.TenantDescription
 	Type        model.TenantType
+	// Version は取得時の版数。版数が変わっていないときだけ更新する
+	Version int64
 }
 type TenantWithJoinCode struct {
 	Tenant   model.Tenant
 	JoinCode model.TenantJoinCodeEntity
 }
 
+// ListTenantsArg はテナントの一覧の条件。ゼロ値の条件は絞り込みに使わない
+type ListTenantsArg struct {
+	Type       model.TenantType
+	NamePrefix string
+	Order      model.ListOrder
+	Cursor     *model.PageCursor
+	Limit      int32
+}
+
 type TenantRepository interface {
 	CreateTenant(ctx context.Context, arg CreateTenantArg) error
-	GetAllTenants(ctx context.Context) ([]model.Tenant, error)
-	GetTenantsByUserID(ctx context.Context, userID model.UserID) ([]TenantWithMemberCount, error)
+	ListTenants(ctx context.Context, arg ListTenantsArg) ([]model.Tenant, error)
+	ListTenantsByUserID(ctx context.Context, userID model.UserID, arg ListTenantsArg) ([]TenantWithMemberCount, error)
 	GetTenantByID(ctx context.Context, id model.TenantID) (TenantWithJoinCode, error)
-	UpdateTenant(ctx context.Context, arg UpdateTenantArg) error
+	// UpdateTenant は更新後の版数を返す。テナントが無いか、アーカイブ済みか、版数が arg.Version から変わっていた場合は更新せず 0 を返す
+	UpdateTenant(ctx context.Context, arg UpdateTenantArg) (int64, error)
+	// ArchiveTenant はテナントをアーカイブする。アーカイブ済みのテナントは更新せず 0 を返す
+	ArchiveTenant(ctx context.Context, id model.TenantID, archivedAt time.Time) (int64, error)
+	// DeleteTenant はテナントを削除する。メンバーシップなどの関連するデータは先に削除しておく
+	DeleteTenant(ctx context.Context, id model.TenantID) (int64, error)
+	// CountKeysInUseByTenant はテナントに割り当てられている部屋の貸し出し中の鍵を数える。
+	// アーカイブしたテナントは、アーカイブで終了した割り当ての部屋も含める
+	CountKeysInUseByTenant(ctx context.Context, id model.TenantID) (int32, error)
 }
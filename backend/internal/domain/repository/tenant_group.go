@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+)
+
+type TenantGroupWithMemberCount struct {
+	Group       model.TenantGroup
+	MemberCount int32
+}
+
+type TenantGroupRepository interface {
+	CreateTenantGroup(ctx context.Context, group model.TenantGroup) error
+	GetTenantGroup(ctx context.Context, id model.TenantGroupID) (model.TenantGroup, error)
+	GetTenantGroupByTenantAndName(ctx context.Context, tenantID model.TenantID, name model.TenantGroupName) (model.TenantGroup, error)
+	ListTenantGroupsByTenant(ctx context.Context, tenantID model.TenantID) ([]TenantGroupWithMemberCount, error)
+	// AddTenantGroupMember はメンバーを追加する。既にメンバーの場合は 0 を返す
+	AddTenantGroupMember(ctx context.Context, groupID model.TenantGroupID, userID model.UserID) (int64, error)
+	RemoveTenantGroupMember(ctx context.Context, groupID model.TenantGroupID, userID model.UserID) (int64, error)
+	ListTenantGroupMembers(ctx context.Context, groupID model.TenantGroupID) ([]model.User, error)
+	// DeleteTenantGroupsByTenant はテナントのグループをメンバーとともに削除する。
+	// 部屋の割り当てが参照しているグループがある場合は ErrReferenced を返す
+	DeleteTenantGroupsByTenant(ctx context.Context, tenantID model.TenantID) error
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/shibayama-club/keyhub/internal/domain/model"
 )
@@ -23,6 +24,10 @@ type UpdateTenantJoinCodeArg struct {
 }
 type TenantJoinCodeRepository interface {
 	CreateTenantJoinCode(ctx context.Context, arg CreateTenantJoinCodeArg) error
-	GetTenantByJoinCode(ctx context.Context, code model.TenantJoinCode) (model.Tenant, error)
+	// GetTenantByJoinCode は指定した組織のテナントだけを参加コードで検索する
+	GetTenantByJoinCode(ctx context.Context, organizationID model.OrganizationID, code model.TenantJoinCode) (model.Tenant, error)
 	UpdateTenantJoinCodeByTenantId(ctx context.Context, arg UpdateTenantJoinCodeArg) error
+	// DisableTenantJoinCodes はテナントの有効な参加コードの期限を now にして使えなくする
+	DisableTenantJoinCodes(ctx context.Context, tenantID model.TenantID, now time.Time) error
+	DeleteTenantJoinCodesByTenant(ctx context.Context, tenantID model.TenantID) error
 }
@@ -10,4 +10,6 @@ type TenantMembershipRepository interface {
 	CreateTenantMembership(ctx context.Context, membership model.TenantMembership) error
 	IncrementJoinCodeUsedCount(ctx context.Context, code model.TenantJoinCode) error
 	GetTenantMembershipByTenantAndUser(ctx context.Context, tenantID model.TenantID, userID model.UserID) (model.TenantMembership, error)
+	// DeleteTenantMembershipsByTenant はテナントのメンバーシップを削除する。それを参照しているセッションからは外す
+	DeleteTenantMembershipsByTenant(ctx context.Context, tenantID model.TenantID) error
 }
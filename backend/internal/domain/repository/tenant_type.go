@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+)
+
+type TenantTypeRepository interface {
+	CreateTenantType(ctx context.Context, definition model.TenantTypeDefinition) error
+	GetTenantType(ctx context.Context, id model.TenantTypeID) (model.TenantTypeDefinition, error)
+	// ListTenantTypes は組織が追加したテナントタイプを作成順に返す。既定のテナントタイプは含まない
+	ListTenantTypes(ctx context.Context) (model.TenantTypeCatalog, error)
+	DeleteTenantType(ctx context.Context, id model.TenantTypeID) (int64, error)
+	// CountTenantsByType はテナントタイプを使っている組織のテナントの数を返す
+	CountTenantsByType(ctx context.Context, organizationID model.OrganizationID, key model.TenantType) (int32, error)
+}
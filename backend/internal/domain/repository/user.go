@@ -23,4 +23,6 @@ type UserRepository interface {
 	GetUserByProviderIdentity(ctx context.Context, provider, providerSub string) (model.User, error)
 	UpsertUser(ctx context.Context, arg UpsertUserArg) (model.User, error)
 	UpsertUserIdentity(ctx context.Context, arg UpsertUserIdentityArg) error
+	// GetUserProviderSub はユーザーに紐づく指定プロバイダーの provider_sub を取得する
+	GetUserProviderSub(ctx context.Context, userID model.UserID, provider string) (string, error)
 }
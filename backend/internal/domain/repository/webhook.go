@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+)
+
+type WebhookRepository interface {
+	CreateWebhookSubscription(ctx context.Context, subscription model.WebhookSubscription) error
+	GetWebhookSubscription(ctx context.Context, organizationID model.OrganizationID, id model.WebhookSubscriptionID) (model.WebhookSubscription, error)
+	ListWebhookSubscriptionsByOrganization(ctx context.Context, organizationID model.OrganizationID) ([]model.WebhookSubscription, error)
+	// ListWebhookSubscriptionsByEventType は組織の購読のうち、そのイベントを受け取るものを返す
+	ListWebhookSubscriptionsByEventType(ctx context.Context, organizationID model.OrganizationID, eventType model.DomainEventType) ([]model.WebhookSubscription, error)
+	DeleteWebhookSubscription(ctx context.Context, organizationID model.OrganizationID, id model.WebhookSubscriptionID) (int64, error)
+	CreateWebhookDelivery(ctx context.Context, delivery model.WebhookDelivery) error
+	// HasSucceededWebhookDelivery はイベントをその購読先へ送信済みかを返す
+	HasSucceededWebhookDelivery(ctx context.Context, subscriptionID model.WebhookSubscriptionID, eventID model.OutboxEventID) (bool, error)
+	ListWebhookDeliveries(ctx context.Context, organizationID model.OrganizationID, subscriptionID model.WebhookSubscriptionID, limit int32) ([]model.WebhookDelivery, error)
+}
@@ -0,0 +1,13 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+)
+
+// Sender は購読先へイベントを送信する。
+// 購読先が2xx以外を返した場合や応答がなかった場合はエラーを返し、結果には分かる範囲を入れる
+type Sender interface {
+	Send(ctx context.Context, subscription model.WebhookSubscription, message model.WebhookMessage) (model.WebhookSendResult, error)
+}
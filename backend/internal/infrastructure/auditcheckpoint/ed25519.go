@@ -0,0 +1,94 @@
+package auditcheckpoint
+
+import (
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/pem"
+
+	"github.com/cockroachdb/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/audit"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+)
+
+// Signer はEd25519の秘密鍵でチェックポイントに署名する。
+// 公開鍵だけを配布すれば、DBの管理者でなくてもチェックポイントを検証できる
+type Signer struct {
+	keyID      string
+	privateKey ed25519.PrivateKey
+}
+
+var _ audit.CheckpointSigner = (*Signer)(nil)
+
+// NewSigner はPKCS#8形式（"PRIVATE KEY"）のPEMからEd25519の秘密鍵を読み込む
+func NewSigner(keyID string, pemBytes []byte) (*Signer, error) {
+	if keyID == "" {
+		return nil, errors.New("checkpoint key ID is required")
+	}
+
+	block, _ := pem.Decode(pemBytes)
+	if block == nil || block.Type != "PRIVATE KEY" {
+		return nil, errors.New("checkpoint private key must be a PKCS#8 PEM block")
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse checkpoint private key")
+	}
+
+	privateKey, ok := parsed.(ed25519.PrivateKey)
+	if !ok {
+		return nil, errors.Newf("checkpoint private key must be Ed25519, got %T", parsed)
+	}
+
+	return &Signer{keyID: keyID, privateKey: privateKey}, nil
+}
+
+func (s *Signer) Sign(checkpoint model.AuditCheckpoint) (model.AuditCheckpoint, error) {
+	checkpoint.KeyID = s.keyID
+	checkpoint.Signature = ed25519.Sign(s.privateKey, checkpoint.SigningPayload())
+	return checkpoint, nil
+}
+
+// Verifier は鍵の識別子ごとのEd25519の公開鍵でチェックポイントの署名を検証する
+type Verifier struct {
+	publicKeys map[string]ed25519.PublicKey
+}
+
+var _ audit.CheckpointVerifier = (*Verifier)(nil)
+
+// NewVerifier はPKIX形式（"PUBLIC KEY"）のPEMからEd25519の公開鍵を読み込む
+func NewVerifier(keyID string, pemBytes []byte) (*Verifier, error) {
+	if keyID == "" {
+		return nil, errors.New("checkpoint key ID is required")
+	}
+
+	block, _ := pem.Decode(pemBytes)
+	if block == nil || block.Type != "PUBLIC KEY" {
+		return nil, errors.New("checkpoint public key must be a PKIX PEM block")
+	}
+
+	parsed, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse checkpoint public key")
+	}
+
+	publicKey, ok := parsed.(ed25519.PublicKey)
+	if !ok {
+		return nil, errors.Newf("checkpoint public key must be Ed25519, got %T", parsed)
+	}
+
+	return &Verifier{publicKeys: map[string]ed25519.PublicKey{keyID: publicKey}}, nil
+}
+
+func (v *Verifier) Verify(checkpoint model.AuditCheckpoint) error {
+	publicKey, ok := v.publicKeys[checkpoint.KeyID]
+	if !ok {
+		return errors.Newf("unknown checkpoint key ID %q", checkpoint.KeyID)
+	}
+
+	if !ed25519.Verify(publicKey, checkpoint.SigningPayload(), checkpoint.Signature) {
+		return errors.Newf("invalid signature on checkpoint for organization %s at seq %d", checkpoint.OrganizationID, checkpoint.Seq)
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package auditcheckpoint
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+)
+
+// record はチェックポイントファイルの1行。JSON Lines形式で追記する
+type record struct {
+	OrganizationID string    `json:"organization_id"`
+	Seq            int64     `json:"seq"`
+	Hash           string    `json:"hash"`
+	CreatedAt      time.Time `json:"created_at"`
+	KeyID          string    `json:"key_id"`
+	Signature      []byte    `json:"signature"`
+}
+
+// AppendFile はチェックポイントをファイルの末尾に追記する。ファイルがなければ作成する
+func AppendFile(path string, checkpoints []model.AuditCheckpoint) error {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return errors.Wrap(err, "failed to open checkpoint file")
+	}
+	defer f.Close()
+
+	enc := json.NewEncoder(f)
+	for _, c := range checkpoints {
+		if err := enc.Encode(record{
+			OrganizationID: c.OrganizationID.String(),
+			Seq:            c.Seq,
+			Hash:           c.Hash,
+			CreatedAt:      c.CreatedAt,
+			KeyID:          c.KeyID,
+			Signature:      c.Signature,
+		}); err != nil {
+			return errors.Wrap(err, "failed to write checkpoint")
+		}
+	}
+
+	return errors.Wrap(f.Sync(), "failed to sync checkpoint file")
+}
+
+// ReadFile はファイルに追記されたチェックポイントをすべて読み込む
+func ReadFile(path string) ([]model.AuditCheckpoint, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open checkpoint file")
+	}
+	defer f.Close()
+
+	var checkpoints []model.AuditCheckpoint
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		var r record
+		if err := json.Unmarshal(scanner.Bytes(), &r); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse checkpoint at line %d", line)
+		}
+
+		orgID, err := model.ParseOrganizationID(r.OrganizationID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid organization ID at line %d", line)
+		}
+
+		checkpoints = append(checkpoints, model.AuditCheckpoint{
+			OrganizationID: orgID,
+			Seq:            r.Seq,
+			Hash:           r.Hash,
+			CreatedAt:      r.CreatedAt,
+			KeyID:          r.KeyID,
+			Signature:      r.Signature,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to read checkpoint file")
+	}
+
+	return checkpoints, nil
+}
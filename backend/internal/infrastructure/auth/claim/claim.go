@@ -3,6 +3,12 @@ package claim
 type Claims interface {
 	GetExpiration() int64
 	GetIssuedAt() int64
+	GetNotBefore() int64
+	GetIssuer() string
+	GetAudience() string
 	SetExpiration(exp int64)
 	SetIssuedAt(iat int64)
+	SetNotBefore(nbf int64)
+	SetIssuer(iss string)
+	SetAudience(aud string)
 }
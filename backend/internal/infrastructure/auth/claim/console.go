@@ -4,6 +4,9 @@ type ConsoleClaims struct {
 	Sub string `json:"sub"`
 	Exp int64  `json:"exp"`
 	Iat int64  `json:"iat"`
+	Nbf int64  `json:"nbf,omitempty"`
+	Iss string `json:"iss,omitempty"`
+	Aud string `json:"aud,omitempty"`
 	Org string `json:"org"`
 	Sid string `json:"sid"`
 }
@@ -16,6 +19,18 @@ func (c *ConsoleClaims) GetIssuedAt() int64 {
 	return c.Iat
 }
 
+func (c *ConsoleClaims) GetNotBefore() int64 {
+	return c.Nbf
+}
+
+func (c *ConsoleClaims) GetIssuer() string {
+	return c.Iss
+}
+
+func (c *ConsoleClaims) GetAudience() string {
+	return c.Aud
+}
+
 func (c *ConsoleClaims) SetExpiration(exp int64) {
 	c.Exp = exp
 }
@@ -24,6 +39,18 @@ func (c *ConsoleClaims) SetIssuedAt(iat int64) {
 	c.Iat = iat
 }
 
+func (c *ConsoleClaims) SetNotBefore(nbf int64) {
+	c.Nbf = nbf
+}
+
+func (c *ConsoleClaims) SetIssuer(iss string) {
+	c.Iss = iss
+}
+
+func (c *ConsoleClaims) SetAudience(aud string) {
+	c.Aud = aud
+}
+
 func NewConsoleClaims(organizationID, sessionID string) *ConsoleClaims {
 	return &ConsoleClaims{
 		Sub: organizationID,
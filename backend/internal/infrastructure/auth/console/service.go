@@ -10,24 +10,26 @@ import (
 )
 
 type AuthService struct {
+	keys      *jwt.KeySet
 	generator *jwt.Generator
 	validator *jwt.Validator
 }
 
 var _ authenticator.ConsoleAuthenticator = (*AuthService)(nil)
 
-func NewAuthService(secret string) (*AuthService, error) {
-	generator, err := jwt.NewGenerator(secret)
+func NewAuthService(keys *jwt.KeySet, issuer, audience string) (*AuthService, error) {
+	generator, err := jwt.NewGenerator(keys, issuer, audience)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create JWT generator")
 	}
 
-	validator, err := jwt.NewValidator(secret)
+	validator, err := jwt.NewValidator(keys, issuer, audience)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create JWT validator")
 	}
 
 	return &AuthService{
+		keys:      keys,
 		generator: generator,
 		validator: validator,
 	}, nil
@@ -45,3 +47,8 @@ func (s *AuthService) ValidateToken(token string) (*claim.ConsoleClaims, error)
 	}
 	return claims, nil
 }
+
+// JWKS は他サービスがコンソールトークンを検証するための公開鍵セットを返す
+func (s *AuthService) JWKS() jwt.JWKS {
+	return s.keys.JWKS()
+}
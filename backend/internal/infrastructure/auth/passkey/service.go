@@ -0,0 +1,237 @@
+package passkey
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/cockroachdb/errors"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/samber/lo"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+)
+
+type Config struct {
+	RPID          string
+	RPDisplayName string
+	RPOrigins     []string
+}
+
+// Service はWebAuthnの登録・認証セレモニーを扱う。
+// オプションとセッションデータはJSONのまま受け渡し、永続化は呼び出し側で行う
+type Service struct {
+	webAuthn *webauthn.WebAuthn
+}
+
+// Credential は検証済みのクレデンシャルと、それを登録したユーザーハンドル
+type Credential struct {
+	UserHandle model.PasskeyUserHandle
+	Passkey    model.Passkey
+}
+
+// CredentialLookup はユーザーハンドルから登録済みのパスキーを取得する
+type CredentialLookup func(handle model.PasskeyUserHandle) ([]model.Passkey, error)
+
+func NewService(config Config) (*Service, error) {
+	if config.RPID == "" {
+		return nil, errors.New("relying party ID is required")
+	}
+	if len(config.RPOrigins) == 0 {
+		return nil, errors.New("relying party origins are required")
+	}
+
+	webAuthn, err := webauthn.New(&webauthn.Config{
+		RPID:          config.RPID,
+		RPDisplayName: config.RPDisplayName,
+		RPOrigins:     config.RPOrigins,
+		// ユーザー名を入力せずにログインできるよう、認証器に保存されるパスキーと生体認証・PINを必須にする
+		AuthenticatorSelection: protocol.AuthenticatorSelection{
+			RequireResidentKey: protocol.ResidentKeyRequired(),
+			ResidentKey:        protocol.ResidentKeyRequirementRequired,
+			UserVerification:   protocol.VerificationRequired,
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create WebAuthn relying party")
+	}
+
+	return &Service{webAuthn: webAuthn}, nil
+}
+
+// BeginRegistration は登録セレモニーを開始し、ブラウザに渡す作成オプションとセッションデータを返す。
+// 登録済みのパスキーは除外リストに入れ、同じ認証器での重複登録を防ぐ
+func (s *Service) BeginRegistration(handle model.PasskeyUserHandle, user model.User, passkeys []model.Passkey) (options, session []byte, err error) {
+	u := newUser(handle, user, passkeys)
+
+	creation, sessionData, err := s.webAuthn.BeginRegistration(
+		u,
+		webauthn.WithExclusions(webauthn.Credentials(u.credentials).CredentialDescriptors()),
+	)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to begin registration")
+	}
+
+	return marshalCeremony(creation, sessionData)
+}
+
+// FinishRegistration はブラウザから受け取った登録レスポンスを検証し、保存するパスキーを返す
+func (s *Service) FinishRegistration(user model.User, passkeys []model.Passkey, session, response []byte) (Credential, error) {
+	var sessionData webauthn.SessionData
+	if err := json.Unmarshal(session, &sessionData); err != nil {
+		return Credential{}, errors.Wrap(err, "failed to unmarshal registration session")
+	}
+
+	parsed, err := protocol.ParseCredentialCreationResponseBytes(response)
+	if err != nil {
+		return Credential{}, errors.Wrap(err, "failed to parse registration response")
+	}
+
+	handle := model.PasskeyUserHandle(sessionData.UserID)
+	credential, err := s.webAuthn.CreateCredential(newUser(handle, user, passkeys), sessionData, parsed)
+	if err != nil {
+		return Credential{}, errors.Wrap(err, "failed to verify registration response")
+	}
+
+	return Credential{
+		UserHandle: handle,
+		Passkey: model.Passkey{
+			UserID:          user.UserId,
+			CredentialID:    credential.ID,
+			PublicKey:       credential.PublicKey,
+			AttestationType: credential.AttestationType,
+			AAGUID:          credential.Authenticator.AAGUID,
+			SignCount:       credential.Authenticator.SignCount,
+			Transports: lo.Map(credential.Transport, func(t protocol.AuthenticatorTransport, _ int) string {
+				return string(t)
+			}),
+			BackupEligible: credential.Flags.BackupEligible,
+			BackupState:    credential.Flags.BackupState,
+		},
+	}, nil
+}
+
+// BeginLogin はユーザーを指定しない（discoverable credential による）認証セレモニーを開始する
+func (s *Service) BeginLogin() (options, session []byte, err error) {
+	assertion, sessionData, err := s.webAuthn.BeginDiscoverableLogin(
+		webauthn.WithUserVerification(protocol.VerificationRequired),
+	)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to begin login")
+	}
+
+	return marshalCeremony(assertion, sessionData)
+}
+
+// FinishLogin は認証レスポンスを検証し、使用されたパスキーを署名カウンタ更新後の状態で返す。
+// 署名カウンタが巻き戻った場合は認証器が複製された可能性があるため拒否する
+func (s *Service) FinishLogin(session, response []byte, lookup CredentialLookup) (Credential, error) {
+	var sessionData webauthn.SessionData
+	if err := json.Unmarshal(session, &sessionData); err != nil {
+		return Credential{}, errors.Wrap(err, "failed to unmarshal login session")
+	}
+
+	parsed, err := protocol.ParseCredentialRequestResponseBytes(response)
+	if err != nil {
+		return Credential{}, errors.Wrap(err, "failed to parse login response")
+	}
+
+	var passkeys []model.Passkey
+	handler := func(_, userHandle []byte) (webauthn.User, error) {
+		handle := model.PasskeyUserHandle(userHandle)
+		found, err := lookup(handle)
+		if err != nil {
+			return nil, err
+		}
+		passkeys = found
+		return newUser(handle, model.User{}, found), nil
+	}
+
+	u, credential, err := s.webAuthn.ValidatePasskeyLogin(handler, sessionData, parsed)
+	if err != nil {
+		return Credential{}, errors.Wrap(err, "failed to verify login response")
+	}
+
+	if credential.Authenticator.CloneWarning {
+		return Credential{}, errors.New("passkey sign counter did not increase")
+	}
+
+	passkey, ok := lo.Find(passkeys, func(p model.Passkey) bool {
+		return bytes.Equal(p.CredentialID, credential.ID)
+	})
+	if !ok {
+		return Credential{}, errors.New("verified credential is not registered")
+	}
+	passkey.SignCount = credential.Authenticator.SignCount
+	passkey.BackupState = credential.Flags.BackupState
+
+	return Credential{
+		UserHandle: model.PasskeyUserHandle(u.WebAuthnID()),
+		Passkey:    passkey,
+	}, nil
+}
+
+func marshalCeremony(options any, sessionData *webauthn.SessionData) ([]byte, []byte, error) {
+	optionsJSON, err := json.Marshal(options)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to marshal WebAuthn options")
+	}
+
+	sessionJSON, err := json.Marshal(sessionData)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to marshal WebAuthn session")
+	}
+
+	return optionsJSON, sessionJSON, nil
+}
+
+// user は webauthn.User の実装。ライブラリの型を model に持ち込まないためにここで変換する
+type user struct {
+	handle      model.PasskeyUserHandle
+	name        string
+	displayName string
+	credentials []webauthn.Credential
+}
+
+var _ webauthn.User = (*user)(nil)
+
+func newUser(handle model.PasskeyUserHandle, u model.User, passkeys []model.Passkey) *user {
+	return &user{
+		handle:      handle,
+		name:        u.Email.String(),
+		displayName: u.Name.String(),
+		credentials: lo.Map(passkeys, func(p model.Passkey, _ int) webauthn.Credential {
+			return webauthn.Credential{
+				ID:              p.CredentialID,
+				PublicKey:       p.PublicKey,
+				AttestationType: p.AttestationType,
+				Transport: lo.Map(p.Transports, func(t string, _ int) protocol.AuthenticatorTransport {
+					return protocol.AuthenticatorTransport(t)
+				}),
+				Flags: webauthn.CredentialFlags{
+					BackupEligible: p.BackupEligible,
+					BackupState:    p.BackupState,
+				},
+				Authenticator: webauthn.Authenticator{
+					AAGUID:    p.AAGUID,
+					SignCount: p.SignCount,
+				},
+			}
+		}),
+	}
+}
+
+func (u *user) WebAuthnID() []byte {
+	return u.handle
+}
+
+func (u *user) WebAuthnName() string {
+	return u.name
+}
+
+func (u *user) WebAuthnDisplayName() string {
+	return u.displayName
+}
+
+func (u *user) WebAuthnCredentials() []webauthn.Credential {
+	return u.credentials
+}
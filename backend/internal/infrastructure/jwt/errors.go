@@ -3,9 +3,15 @@ package jwt
 import "github.com/cockroachdb/errors"
 
 var (
-	ErrInvalidToken     = errors.New("invalid token")
-	ErrInvalidSignature = errors.New("invalid signature")
-	ErrTokenExpired     = errors.New("token expired")
-	ErrInvalidSecret    = errors.New("invalid secret")
-	ErrInvalidClaims    = errors.New("invalid claims")
+	ErrInvalidToken         = errors.New("invalid token")
+	ErrInvalidSignature     = errors.New("invalid signature")
+	ErrTokenExpired         = errors.New("token expired")
+	ErrTokenNotYetValid     = errors.New("token not yet valid")
+	ErrInvalidSecret        = errors.New("invalid secret")
+	ErrInvalidClaims        = errors.New("invalid claims")
+	ErrInvalidIssuer        = errors.New("invalid issuer")
+	ErrInvalidAudience      = errors.New("invalid audience")
+	ErrInvalidKey           = errors.New("invalid key")
+	ErrUnknownKey           = errors.New("unknown key id")
+	ErrUnsupportedAlgorithm = errors.New("unsupported algorithm")
 )
@@ -1,8 +1,6 @@
 package jwt
 
 import (
-	"crypto/hmac"
-	"crypto/sha256"
 	"encoding/base64"
 	"encoding/json"
 	"strings"
@@ -12,21 +10,29 @@ import (
 	"github.com/shibayama-club/keyhub/internal/infrastructure/auth/claim"
 )
 
+// サーバー間の時刻ずれを許容する幅（nbfの検証に使用）
+const clockSkew = 30 * time.Second
+
 type Header struct {
 	Alg string `json:"alg"`
 	Typ string `json:"typ"`
+	Kid string `json:"kid,omitempty"`
 }
 
 type Generator struct {
-	secret string
+	keys     *KeySet
+	issuer   string
+	audience string
 }
 
-func NewGenerator(secret string) (*Generator, error) {
-	if secret == "" {
-		return nil, ErrInvalidSecret
+func NewGenerator(keys *KeySet, issuer, audience string) (*Generator, error) {
+	if keys == nil {
+		return nil, errors.Wrap(ErrInvalidKey, "key set is required")
 	}
 	return &Generator{
-		secret: secret,
+		keys:     keys,
+		issuer:   issuer,
+		audience: audience,
 	}, nil
 }
 
@@ -39,14 +45,19 @@ func (g *Generator) Generate(claims claim.Claims, expiresIn time.Duration) (stri
 		return "", errors.Wrap(ErrInvalidClaims, "expiration duration must be positive")
 	}
 
+	key := g.keys.SigningKey()
 	header := Header{
-		Alg: "HS256",
+		Alg: string(key.Algorithm()),
 		Typ: "JWT",
+		Kid: key.ID(),
 	}
 
 	now := time.Now()
 	claims.SetIssuedAt(now.Unix())
+	claims.SetNotBefore(now.Unix())
 	claims.SetExpiration(now.Add(expiresIn).Unix())
+	claims.SetIssuer(g.issuer)
+	claims.SetAudience(g.audience)
 
 	headerJSON, err := json.Marshal(header)
 	if err != nil {
@@ -61,9 +72,11 @@ func (g *Generator) Generate(claims claim.Claims, expiresIn time.Duration) (stri
 	claimsB64 := base64.RawURLEncoding.EncodeToString(claimsJSON)
 
 	message := headerB64 + "." + claimsB64
-	h := hmac.New(sha256.New, []byte(g.secret))
-	h.Write([]byte(message))
-	signature := base64.RawURLEncoding.EncodeToString(h.Sum(nil))
+	sig, err := key.sign([]byte(message))
+	if err != nil {
+		return "", errors.Wrap(err, "failed to sign token")
+	}
+	signature := base64.RawURLEncoding.EncodeToString(sig)
 
 	token := message + "." + signature
 
@@ -71,15 +84,20 @@ func (g *Generator) Generate(claims claim.Claims, expiresIn time.Duration) (stri
 }
 
 type Validator struct {
-	secret string
+	keys     *KeySet
+	issuer   string
+	audience string
 }
 
-func NewValidator(secret string) (*Validator, error) {
-	if secret == "" {
-		return nil, ErrInvalidSecret
+// NewValidator はトークン検証器を作成する。issuer / audience が空の場合はそのクレームを検証しない
+func NewValidator(keys *KeySet, issuer, audience string) (*Validator, error) {
+	if keys == nil {
+		return nil, errors.Wrap(ErrInvalidKey, "key set is required")
 	}
 	return &Validator{
-		secret: secret,
+		keys:     keys,
+		issuer:   issuer,
+		audience: audience,
 	}, nil
 }
 
@@ -103,13 +121,24 @@ func (v *Validator) Validate(tokenString string, claims claim.Claims) error {
 		}
 	}
 
-	message := parts[0] + "." + parts[1]
-	h := hmac.New(sha256.New, []byte(v.secret))
-	h.Write([]byte(message))
-	expectedSig := base64.RawURLEncoding.EncodeToString(h.Sum(nil))
+	headerJSON, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return errors.Wrap(ErrInvalidToken, "failed to decode header")
+	}
+
+	var header Header
+	if err := json.Unmarshal(headerJSON, &header); err != nil {
+		return errors.Wrap(ErrInvalidToken, "failed to unmarshal header")
+	}
 
-	if !hmac.Equal([]byte(parts[2]), []byte(expectedSig)) {
-		return ErrInvalidSignature
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return errors.Wrap(ErrInvalidSignature, "failed to decode signature")
+	}
+
+	key, err := v.verifySignature(header, []byte(parts[0]+"."+parts[1]), signature)
+	if err != nil {
+		return err
 	}
 
 	claimsJSON, err := base64.RawURLEncoding.DecodeString(parts[1])
@@ -121,9 +150,53 @@ func (v *Validator) Validate(tokenString string, claims claim.Claims) error {
 		return errors.Wrap(ErrInvalidClaims, "failed to unmarshal claims")
 	}
 
-	if time.Now().Unix() > claims.GetExpiration() {
+	now := time.Now()
+	if now.Unix() > claims.GetExpiration() {
 		return ErrTokenExpired
 	}
 
+	if nbf := claims.GetNotBefore(); nbf != 0 && now.Add(clockSkew).Unix() < nbf {
+		return ErrTokenNotYetValid
+	}
+
+	// iss / aud を付ける前に旧鍵で発行したトークンは、クレームがなくても有効期限まで受け入れる
+	legacy := v.keys.isLegacy(key)
+
+	if v.issuer != "" && claims.GetIssuer() != v.issuer && (!legacy || claims.GetIssuer() != "") {
+		return ErrInvalidIssuer
+	}
+
+	if v.audience != "" && claims.GetAudience() != v.audience && (!legacy || claims.GetAudience() != "") {
+		return ErrInvalidAudience
+	}
+
 	return nil
 }
+
+// verifySignature は署名を検証し、検証できた鍵を返す
+func (v *Validator) verifySignature(header Header, message, signature []byte) (*Key, error) {
+	alg := Algorithm(header.Alg)
+
+	if header.Kid != "" {
+		key, ok := v.keys.Lookup(header.Kid)
+		if !ok {
+			return nil, errors.Wrapf(ErrUnknownKey, "kid %q", header.Kid)
+		}
+		// alg差し替え攻撃を防ぐため、ヘッダーのalgは鍵のalgと一致しなければならない
+		if key.Algorithm() != alg {
+			return nil, errors.Wrapf(ErrUnsupportedAlgorithm, "algorithm %q does not match key %q", header.Alg, header.Kid)
+		}
+		if err := key.verify(message, signature); err != nil {
+			return nil, err
+		}
+		return key, nil
+	}
+
+	// kidを持たないトークン（鍵セット導入前に発行されたもの）は同じアルゴリズムの鍵を順に試す
+	for _, key := range v.keys.keysForAlgorithm(alg) {
+		if err := key.verify(message, signature); err == nil {
+			return key, nil
+		}
+	}
+	return nil, ErrInvalidSignature
+}
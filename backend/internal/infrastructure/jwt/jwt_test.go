@@ -0,0 +1,312 @@
+package jwt
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/shibayama-club/keyhub/internal/infrastructure/auth/claim"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestKeys(t *testing.T) (hs, es, ed *Key) {
+	t.Helper()
+
+	hs, err := NewHMACKey("hs-1", "test-secret")
+	require.NoError(t, err)
+
+	ecPriv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	es, err = NewECDSAKey("es-1", ecPriv)
+	require.NoError(t, err)
+
+	_, edPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	ed, err = NewEd25519Key("ed-1", edPriv)
+	require.NoError(t, err)
+
+	return hs, es, ed
+}
+
+// legacyToken は鍵セット導入前の形式（kidなしのHS256）でトークンを作成する
+func legacyToken(t *testing.T, secret string, claims *claim.ConsoleClaims) string {
+	t.Helper()
+
+	headerJSON, err := json.Marshal(Header{Alg: "HS256", Typ: "JWT"})
+	require.NoError(t, err)
+	claimsJSON, err := json.Marshal(claims)
+	require.NoError(t, err)
+
+	message := base64.RawURLEncoding.EncodeToString(headerJSON) + "." + base64.RawURLEncoding.EncodeToString(claimsJSON)
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(message))
+	return message + "." + base64.RawURLEncoding.EncodeToString(h.Sum(nil))
+}
+
+func TestGenerateAndValidate(t *testing.T) {
+	hs, es, ed := newTestKeys(t)
+
+	tests := []struct {
+		name         string
+		signingKeyID string
+	}{
+		{name: "正常系: HS256で署名・検証できる", signingKeyID: "hs-1"},
+		{name: "正常系: ES256で署名・検証できる", signingKeyID: "es-1"},
+		{name: "正常系: EdDSAで署名・検証できる", signingKeyID: "ed-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys, err := NewKeySet(tt.signingKeyID, hs, es, ed)
+			require.NoError(t, err)
+
+			generator, err := NewGenerator(keys, "keyhub-console", "keyhub")
+			require.NoError(t, err)
+			validator, err := NewValidator(keys, "keyhub-console", "keyhub")
+			require.NoError(t, err)
+
+			token, err := generator.Generate(claim.NewConsoleClaims("org", "sid"), time.Hour)
+			require.NoError(t, err)
+
+			got := &claim.ConsoleClaims{}
+			require.NoError(t, validator.Validate(token, got))
+			assert.Equal(t, "org", got.Org)
+			assert.Equal(t, "sid", got.Sid)
+			assert.Equal(t, "keyhub-console", got.Iss)
+			assert.Equal(t, "keyhub", got.Aud)
+			assert.Equal(t, got.Iat, got.Nbf)
+		})
+	}
+}
+
+func TestValidate_KeyRotation(t *testing.T) {
+	hs, es, ed := newTestKeys(t)
+
+	oldKeys, err := NewKeySet("hs-1", hs)
+	require.NoError(t, err)
+	oldGenerator, err := NewGenerator(oldKeys, "", "")
+	require.NoError(t, err)
+	oldToken, err := oldGenerator.Generate(claim.NewConsoleClaims("org", "sid"), time.Hour)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		keys    []*Key
+		token   string
+		wantErr error
+	}{
+		{
+			name:  "正常系: 旧鍵を検証用に残せばローテーション後も検証できる",
+			keys:  []*Key{es, hs},
+			token: oldToken,
+		},
+		{
+			name:    "異常系: 旧鍵を外すと未知のkidとして拒否される",
+			keys:    []*Key{es, ed},
+			token:   oldToken,
+			wantErr: ErrUnknownKey,
+		},
+		{
+			name:  "正常系: kidのない旧形式トークンも同じアルゴリズムの鍵で検証できる",
+			keys:  []*Key{es, hs},
+			token: legacyToken(t, "test-secret", &claim.ConsoleClaims{Org: "org", Sid: "sid", Exp: time.Now().Add(time.Hour).Unix()}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys, err := NewKeySet(tt.keys[0].ID(), tt.keys...)
+			require.NoError(t, err)
+			validator, err := NewValidator(keys, "", "")
+			require.NoError(t, err)
+
+			err = validator.Validate(tt.token, &claim.ConsoleClaims{})
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "expected %v, got %v", tt.wantErr, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidate_Claims(t *testing.T) {
+	hs, es, _ := newTestKeys(t)
+	keys, err := NewKeySet("es-1", es, hs)
+	require.NoError(t, err)
+
+	generator, err := NewGenerator(keys, "keyhub-console", "keyhub")
+	require.NoError(t, err)
+	token, err := generator.Generate(claim.NewConsoleClaims("org", "sid"), time.Hour)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		issuer   string
+		audience string
+		token    string
+		wantErr  error
+	}{
+		{
+			name:     "異常系: issuerが一致しない",
+			issuer:   "other",
+			audience: "keyhub",
+			token:    token,
+			wantErr:  ErrInvalidIssuer,
+		},
+		{
+			name:     "異常系: audienceが一致しない",
+			issuer:   "keyhub-console",
+			audience: "other",
+			token:    token,
+			wantErr:  ErrInvalidAudience,
+		},
+		{
+			name:    "異常系: nbfが未来のトークンは拒否される",
+			token:   legacyToken(t, "test-secret", &claim.ConsoleClaims{Exp: time.Now().Add(2 * time.Hour).Unix(), Nbf: time.Now().Add(time.Hour).Unix()}),
+			wantErr: ErrTokenNotYetValid,
+		},
+		{
+			name:    "異常系: 期限切れのトークンは拒否される",
+			token:   legacyToken(t, "test-secret", &claim.ConsoleClaims{Exp: time.Now().Add(-time.Minute).Unix()}),
+			wantErr: ErrTokenExpired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator, err := NewValidator(keys, tt.issuer, tt.audience)
+			require.NoError(t, err)
+
+			err = validator.Validate(tt.token, &claim.ConsoleClaims{})
+			assert.True(t, errors.Is(err, tt.wantErr), "expected %v, got %v", tt.wantErr, err)
+		})
+	}
+}
+
+func TestValidate_LegacyKeyWithoutIssuer(t *testing.T) {
+	hs, es, _ := newTestKeys(t)
+
+	// iss / aud を付ける前の Generator と同じく、クレームを持たないトークンを作る
+	esGenerator, err := NewGenerator(mustKeySet(t, "es-1", es, hs), "", "")
+	require.NoError(t, err)
+	esToken, err := esGenerator.Generate(claim.NewConsoleClaims("org", "sid"), time.Hour)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		legacyKey string
+		token     string
+		wantErr   error
+	}{
+		{
+			name:      "正常系: 旧鍵で検証できたトークンは iss / aud がなくても受け入れる",
+			legacyKey: "hs-1",
+			token:     legacyToken(t, "test-secret", &claim.ConsoleClaims{Org: "org", Sid: "sid", Exp: time.Now().Add(time.Hour).Unix()}),
+		},
+		{
+			name:      "異常系: 旧鍵で検証できても iss が異なるトークンは拒否される",
+			legacyKey: "hs-1",
+			token:     legacyToken(t, "test-secret", &claim.ConsoleClaims{Exp: time.Now().Add(time.Hour).Unix(), Iss: "other"}),
+			wantErr:   ErrInvalidIssuer,
+		},
+		{
+			name:      "異常系: 旧鍵で検証できても期限切れのトークンは拒否される",
+			legacyKey: "hs-1",
+			token:     legacyToken(t, "test-secret", &claim.ConsoleClaims{Exp: time.Now().Add(-time.Minute).Unix()}),
+			wantErr:   ErrTokenExpired,
+		},
+		{
+			name:      "異常系: 旧鍵以外で検証したトークンは iss がなければ拒否される",
+			legacyKey: "hs-1",
+			token:     esToken,
+			wantErr:   ErrInvalidIssuer,
+		},
+		{
+			name:    "異常系: 旧鍵を指定しなければ iss がないトークンは拒否される",
+			token:   legacyToken(t, "test-secret", &claim.ConsoleClaims{Exp: time.Now().Add(time.Hour).Unix()}),
+			wantErr: ErrInvalidIssuer,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys := mustKeySet(t, "es-1", es, hs)
+			if tt.legacyKey != "" {
+				require.NoError(t, keys.SetLegacyKey(tt.legacyKey))
+			}
+			validator, err := NewValidator(keys, "keyhub-console", "keyhub")
+			require.NoError(t, err)
+
+			err = validator.Validate(tt.token, &claim.ConsoleClaims{})
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "expected %v, got %v", tt.wantErr, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestKeySet_SetLegacyKey_UnknownKey(t *testing.T) {
+	hs, _, _ := newTestKeys(t)
+	keys := mustKeySet(t, "hs-1", hs)
+
+	err := keys.SetLegacyKey("missing")
+	assert.True(t, errors.Is(err, ErrUnknownKey), "expected %v, got %v", ErrUnknownKey, err)
+}
+
+func mustKeySet(t *testing.T, signingKeyID string, keys ...*Key) *KeySet {
+	t.Helper()
+
+	set, err := NewKeySet(signingKeyID, keys...)
+	require.NoError(t, err)
+	return set
+}
+
+func TestValidate_AlgorithmMismatch(t *testing.T) {
+	hs, es, _ := newTestKeys(t)
+	keys, err := NewKeySet("es-1", es, hs)
+	require.NoError(t, err)
+	validator, err := NewValidator(keys, "", "")
+	require.NoError(t, err)
+
+	// ES256鍵のkidを指定しつつHS256で署名したトークンは拒否されなければならない
+	headerJSON, err := json.Marshal(Header{Alg: "HS256", Typ: "JWT", Kid: "es-1"})
+	require.NoError(t, err)
+	claimsJSON, err := json.Marshal(&claim.ConsoleClaims{Exp: time.Now().Add(time.Hour).Unix()})
+	require.NoError(t, err)
+	message := base64.RawURLEncoding.EncodeToString(headerJSON) + "." + base64.RawURLEncoding.EncodeToString(claimsJSON)
+	sig, err := hs.sign([]byte(message))
+	require.NoError(t, err)
+
+	err = validator.Validate(message+"."+base64.RawURLEncoding.EncodeToString(sig), &claim.ConsoleClaims{})
+	assert.True(t, errors.Is(err, ErrUnsupportedAlgorithm), "expected %v, got %v", ErrUnsupportedAlgorithm, err)
+}
+
+func TestKeySet_JWKS(t *testing.T) {
+	hs, es, ed := newTestKeys(t)
+	keys, err := NewKeySet("hs-1", hs, es, ed)
+	require.NoError(t, err)
+
+	jwks := keys.JWKS()
+
+	// HMAC鍵は公開されない
+	require.Len(t, jwks.Keys, 2)
+	assert.Equal(t, "es-1", jwks.Keys[0].Kid)
+	assert.Equal(t, "EC", jwks.Keys[0].Kty)
+	assert.Equal(t, "P-256", jwks.Keys[0].Crv)
+	assert.NotEmpty(t, jwks.Keys[0].Y)
+	assert.Equal(t, "ed-1", jwks.Keys[1].Kid)
+	assert.Equal(t, "OKP", jwks.Keys[1].Kty)
+	assert.Equal(t, "EdDSA", jwks.Keys[1].Alg)
+}
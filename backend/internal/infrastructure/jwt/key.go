@@ -0,0 +1,243 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+
+	"github.com/cockroachdb/errors"
+)
+
+type Algorithm string
+
+const (
+	AlgorithmHS256 Algorithm = "HS256"
+	AlgorithmES256 Algorithm = "ES256"
+	AlgorithmEdDSA Algorithm = "EdDSA"
+)
+
+// ES256の署名はr||sをそれぞれ32バイトで連結した固定長 (RFC 7518 3.4)
+const es256KeySize = 32
+
+// Key はkidで識別される署名鍵・検証鍵
+type Key struct {
+	id         string
+	alg        Algorithm
+	secret     []byte
+	privateKey crypto.Signer
+	publicKey  crypto.PublicKey
+}
+
+func NewHMACKey(id, secret string) (*Key, error) {
+	if secret == "" {
+		return nil, ErrInvalidSecret
+	}
+	return &Key{
+		id:     id,
+		alg:    AlgorithmHS256,
+		secret: []byte(secret),
+	}, nil
+}
+
+func NewECDSAKey(id string, privateKey *ecdsa.PrivateKey) (*Key, error) {
+	if privateKey == nil || privateKey.Curve != elliptic.P256() {
+		return nil, errors.Wrap(ErrInvalidKey, "ES256 requires a P-256 private key")
+	}
+	return &Key{
+		id:         id,
+		alg:        AlgorithmES256,
+		privateKey: privateKey,
+		publicKey:  &privateKey.PublicKey,
+	}, nil
+}
+
+func NewEd25519Key(id string, privateKey ed25519.PrivateKey) (*Key, error) {
+	if len(privateKey) != ed25519.PrivateKeySize {
+		return nil, errors.Wrap(ErrInvalidKey, "invalid Ed25519 private key size")
+	}
+	return &Key{
+		id:         id,
+		alg:        AlgorithmEdDSA,
+		privateKey: privateKey,
+		publicKey:  privateKey.Public(),
+	}, nil
+}
+
+// NewPublicKey は検証専用の鍵を作成する（ローテーションで退役した鍵など）
+func NewPublicKey(id string, publicKey crypto.PublicKey) (*Key, error) {
+	switch pub := publicKey.(type) {
+	case *ecdsa.PublicKey:
+		if pub.Curve != elliptic.P256() {
+			return nil, errors.Wrap(ErrInvalidKey, "ES256 requires a P-256 public key")
+		}
+		return &Key{id: id, alg: AlgorithmES256, publicKey: pub}, nil
+	case ed25519.PublicKey:
+		if len(pub) != ed25519.PublicKeySize {
+			return nil, errors.Wrap(ErrInvalidKey, "invalid Ed25519 public key size")
+		}
+		return &Key{id: id, alg: AlgorithmEdDSA, publicKey: pub}, nil
+	default:
+		return nil, errors.Wrapf(ErrUnsupportedAlgorithm, "unsupported public key type %T", publicKey)
+	}
+}
+
+// ParsePEMKey はPEM形式の秘密鍵（PKCS#8 / SEC1）または公開鍵（PKIX）から鍵を作成する
+func ParsePEMKey(id string, data []byte) (*Key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.Wrap(ErrInvalidKey, "failed to decode PEM block")
+	}
+
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, errors.Wrap(errors.Mark(err, ErrInvalidKey), "failed to parse PKCS#8 private key")
+		}
+		switch priv := parsed.(type) {
+		case *ecdsa.PrivateKey:
+			return NewECDSAKey(id, priv)
+		case ed25519.PrivateKey:
+			return NewEd25519Key(id, priv)
+		default:
+			return nil, errors.Wrapf(ErrUnsupportedAlgorithm, "unsupported private key type %T", parsed)
+		}
+	case "EC PRIVATE KEY":
+		priv, err := x509.ParseECPrivateKey(block.Bytes)
+		if err != nil {
+			return nil, errors.Wrap(errors.Mark(err, ErrInvalidKey), "failed to parse EC private key")
+		}
+		return NewECDSAKey(id, priv)
+	case "PUBLIC KEY":
+		pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, errors.Wrap(errors.Mark(err, ErrInvalidKey), "failed to parse public key")
+		}
+		return NewPublicKey(id, pub)
+	default:
+		return nil, errors.Wrapf(ErrInvalidKey, "unsupported PEM block type %q", block.Type)
+	}
+}
+
+func (k *Key) ID() string {
+	return k.id
+}
+
+func (k *Key) Algorithm() Algorithm {
+	return k.alg
+}
+
+// CanSign は鍵が署名に使えるかどうかを返す
+func (k *Key) CanSign() bool {
+	return k.alg == AlgorithmHS256 || k.privateKey != nil
+}
+
+func (k *Key) sign(message []byte) ([]byte, error) {
+	if !k.CanSign() {
+		return nil, errors.Wrapf(ErrInvalidKey, "key %q is verification only", k.id)
+	}
+
+	switch k.alg {
+	case AlgorithmHS256:
+		h := hmac.New(sha256.New, k.secret)
+		h.Write(message)
+		return h.Sum(nil), nil
+	case AlgorithmES256:
+		digest := sha256.Sum256(message)
+		r, s, err := ecdsa.Sign(rand.Reader, k.privateKey.(*ecdsa.PrivateKey), digest[:])
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to sign with ES256")
+		}
+		sig := make([]byte, 2*es256KeySize)
+		r.FillBytes(sig[:es256KeySize])
+		s.FillBytes(sig[es256KeySize:])
+		return sig, nil
+	case AlgorithmEdDSA:
+		return ed25519.Sign(k.privateKey.(ed25519.PrivateKey), message), nil
+	default:
+		return nil, ErrUnsupportedAlgorithm
+	}
+}
+
+func (k *Key) verify(message, signature []byte) error {
+	switch k.alg {
+	case AlgorithmHS256:
+		h := hmac.New(sha256.New, k.secret)
+		h.Write(message)
+		if !hmac.Equal(signature, h.Sum(nil)) {
+			return ErrInvalidSignature
+		}
+		return nil
+	case AlgorithmES256:
+		if len(signature) != 2*es256KeySize {
+			return ErrInvalidSignature
+		}
+		digest := sha256.Sum256(message)
+		r := new(big.Int).SetBytes(signature[:es256KeySize])
+		s := new(big.Int).SetBytes(signature[es256KeySize:])
+		if !ecdsa.Verify(k.publicKey.(*ecdsa.PublicKey), digest[:], r, s) {
+			return ErrInvalidSignature
+		}
+		return nil
+	case AlgorithmEdDSA:
+		if !ed25519.Verify(k.publicKey.(ed25519.PublicKey), message, signature) {
+			return ErrInvalidSignature
+		}
+		return nil
+	default:
+		return ErrUnsupportedAlgorithm
+	}
+}
+
+// JWK はRFC 7517形式の公開鍵
+type JWK struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y,omitempty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+}
+
+// publicJWK は公開鍵をJWKに変換する。HMAC鍵は公開できないためfalseを返す
+func (k *Key) publicJWK() (JWK, bool) {
+	switch pub := k.publicKey.(type) {
+	case *ecdsa.PublicKey:
+		ecdhKey, err := pub.ECDH()
+		if err != nil {
+			return JWK{}, false
+		}
+		// 非圧縮形式 0x04 || X || Y
+		point := ecdhKey.Bytes()
+		x, y := point[1:1+es256KeySize], point[1+es256KeySize:]
+		return JWK{
+			Kty: "EC",
+			Crv: "P-256",
+			X:   base64.RawURLEncoding.EncodeToString(x),
+			Y:   base64.RawURLEncoding.EncodeToString(y),
+			Kid: k.id,
+			Alg: string(k.alg),
+			Use: "sig",
+		}, true
+	case ed25519.PublicKey:
+		return JWK{
+			Kty: "OKP",
+			Crv: "Ed25519",
+			X:   base64.RawURLEncoding.EncodeToString(pub),
+			Kid: k.id,
+			Alg: string(k.alg),
+			Use: "sig",
+		}, true
+	default:
+		return JWK{}, false
+	}
+}
@@ -0,0 +1,93 @@
+package jwt
+
+import (
+	"github.com/cockroachdb/errors"
+)
+
+// KeySet は署名に使う鍵1つと、検証に使う鍵の集合を保持する。
+// 鍵をローテーションする場合は新しい鍵を署名鍵にし、古い鍵は発行済みトークンが失効するまで検証用に残す。
+type KeySet struct {
+	signing *Key
+	keys    map[string]*Key
+	order   []string
+	// legacy は iss / aud を付ける前に発行したトークンの検証に使う鍵のkid
+	legacy string
+}
+
+func NewKeySet(signingKeyID string, keys ...*Key) (*KeySet, error) {
+	set := &KeySet{
+		keys:  make(map[string]*Key, len(keys)),
+		order: make([]string, 0, len(keys)),
+	}
+
+	for _, key := range keys {
+		if key == nil {
+			return nil, errors.Wrap(ErrInvalidKey, "key must not be nil")
+		}
+		if _, exists := set.keys[key.id]; exists {
+			return nil, errors.Wrapf(ErrInvalidKey, "duplicate key id %q", key.id)
+		}
+		set.keys[key.id] = key
+		set.order = append(set.order, key.id)
+	}
+
+	signing, ok := set.keys[signingKeyID]
+	if !ok {
+		return nil, errors.Wrapf(ErrUnknownKey, "signing key %q is not in the key set", signingKeyID)
+	}
+	if !signing.CanSign() {
+		return nil, errors.Wrapf(ErrInvalidKey, "signing key %q has no private key", signingKeyID)
+	}
+	set.signing = signing
+
+	return set, nil
+}
+
+// SetLegacyKey は iss / aud を付ける前に発行したトークンの検証に使う鍵を指定する。
+// この鍵で検証できたトークンに限り、iss / aud を持たなくても有効期限まで受け入れる
+func (s *KeySet) SetLegacyKey(kid string) error {
+	if _, ok := s.keys[kid]; !ok {
+		return errors.Wrapf(ErrUnknownKey, "legacy key %q is not in the key set", kid)
+	}
+	s.legacy = kid
+	return nil
+}
+
+func (s *KeySet) isLegacy(key *Key) bool {
+	return s.legacy != "" && key.id == s.legacy
+}
+
+func (s *KeySet) SigningKey() *Key {
+	return s.signing
+}
+
+func (s *KeySet) Lookup(kid string) (*Key, bool) {
+	key, ok := s.keys[kid]
+	return key, ok
+}
+
+// keysForAlgorithm はkidを持たない旧形式トークンの検証用に、指定アルゴリズムの鍵を登録順で返す
+func (s *KeySet) keysForAlgorithm(alg Algorithm) []*Key {
+	keys := make([]*Key, 0, len(s.order))
+	for _, id := range s.order {
+		if key := s.keys[id]; key.alg == alg {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// JWKS は公開可能な鍵（非対称鍵）のみを含むJSON Web Key Set
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+func (s *KeySet) JWKS() JWKS {
+	jwks := JWKS{Keys: make([]JWK, 0, len(s.order))}
+	for _, id := range s.order {
+		if jwk, ok := s.keys[id].publicJWK(); ok {
+			jwks.Keys = append(jwks.Keys, jwk)
+		}
+	}
+	return jwks
+}
@@ -0,0 +1,220 @@
+package pgnotify
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shibayama-club/keyhub/internal/domain/keywatch"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+)
+
+const (
+	// keyChangesChannel は keys テーブルのトリガーが通知を送るチャネル
+	keyChangesChannel = "key_changes"
+	// subscriberBuffer は購読者ごとに溜めておける変更の数。溢れた購読者は取りこぼしとして打ち切る
+	subscriberBuffer = 64
+	// reconnectInterval は接続が切れてから再接続するまでの待ち時間
+	reconnectInterval = 5 * time.Second
+)
+
+// KeyWatcher はPostgreSQLの LISTEN で鍵の変更を受け取り、購読者に配る。
+// 接続プールとは別の専用の接続を使い、接続が切れた場合は購読者をすべて打ち切ってから再接続する
+type KeyWatcher struct {
+	connConfig *pgx.ConnConfig
+
+	mu          sync.Mutex
+	listening   bool
+	subscribers map[*subscriber]struct{}
+}
+
+type subscriber struct {
+	organizationID model.OrganizationID
+	ch             chan model.KeyChange
+}
+
+var _ keywatch.Watcher = (*KeyWatcher)(nil)
+
+func NewKeyWatcher(pool *pgxpool.Pool) *KeyWatcher {
+	return &KeyWatcher{
+		connConfig:  pool.Config().ConnConfig,
+		subscribers: make(map[*subscriber]struct{}),
+	}
+}
+
+// Run は ctx がキャンセルされるまで鍵の変更を受け取り続ける
+func (w *KeyWatcher) Run(ctx context.Context) {
+	for {
+		err := w.listen(ctx)
+		// 再接続までの間の変更は届かないため、購読者には購読し直してもらう
+		w.setListening(false)
+		if ctx.Err() != nil {
+			return
+		}
+		slog.ErrorContext(ctx, "key change listener disconnected", slog.String("error", err.Error()))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(reconnectInterval):
+		}
+	}
+}
+
+func (w *KeyWatcher) listen(ctx context.Context) error {
+	conn, err := pgx.ConnectConfig(ctx, w.connConfig)
+	if err != nil {
+		return errors.Wrap(err, "failed to connect to postgres")
+	}
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+keyChangesChannel); err != nil {
+		return errors.Wrap(err, "failed to listen key changes")
+	}
+	w.setListening(true)
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return errors.Wrap(err, "failed to wait for key change notification")
+		}
+
+		change, err := parseKeyChange(notification.Payload)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to parse key change notification",
+				slog.String("error", err.Error()),
+				slog.String("payload", notification.Payload),
+			)
+			continue
+		}
+		w.publish(change)
+	}
+}
+
+// Watch は LISTEN できていない間に呼ばれた場合、変更を取りこぼさないよう閉じたチャネルを返す
+func (w *KeyWatcher) Watch(ctx context.Context, organizationID model.OrganizationID) <-chan model.KeyChange {
+	s := &subscriber{
+		organizationID: organizationID,
+		ch:             make(chan model.KeyChange, subscriberBuffer),
+	}
+
+	w.mu.Lock()
+	if !w.listening {
+		w.mu.Unlock()
+		close(s.ch)
+		return s.ch
+	}
+	w.subscribers[s] = struct{}{}
+	w.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		w.unsubscribe(s)
+	}()
+
+	return s.ch
+}
+
+func (w *KeyWatcher) unsubscribe(s *subscriber) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if _, ok := w.subscribers[s]; ok {
+		delete(w.subscribers, s)
+		close(s.ch)
+	}
+}
+
+// setListening は LISTEN の状態を切り替える。LISTEN をやめる場合は購読者をすべて打ち切る
+func (w *KeyWatcher) setListening(listening bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.listening = listening
+	if listening {
+		return
+	}
+	for s := range w.subscribers {
+		delete(w.subscribers, s)
+		close(s.ch)
+	}
+}
+
+// publish は変更を同じ組織の購読者に配る。受け取りが追いつかない購読者は待たずに打ち切る
+func (w *KeyWatcher) publish(change model.KeyChange) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for s := range w.subscribers {
+		if s.organizationID != change.Key.OrganizationID {
+			continue
+		}
+		select {
+		case s.ch <- change:
+		default:
+			slog.Warn("dropping slow key change subscriber", slog.String("organization_id", s.organizationID.String()))
+			delete(w.subscribers, s)
+			close(s.ch)
+		}
+	}
+}
+
+// keyChangePayload は notify_key_change() が送る通知の内容
+type keyChangePayload struct {
+	Operation      string    `json:"operation"`
+	ID             string    `json:"id"`
+	RoomID         string    `json:"room_id"`
+	OrganizationID string    `json:"organization_id"`
+	KeyNumber      string    `json:"key_number"`
+	Status         string    `json:"status"`
+	Version        int64     `json:"version"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+func parseKeyChange(payload string) (model.KeyChange, error) {
+	var p keyChangePayload
+	if err := json.Unmarshal([]byte(payload), &p); err != nil {
+		return model.KeyChange{}, errors.Wrap(err, "failed to unmarshal key change payload")
+	}
+
+	operation := model.KeyChangeOperation(p.Operation)
+	if err := operation.Validate(); err != nil {
+		return model.KeyChange{}, err
+	}
+	id, err := model.ParseKeyID(p.ID)
+	if err != nil {
+		return model.KeyChange{}, err
+	}
+	roomID, err := model.ParseRoomID(p.RoomID)
+	if err != nil {
+		return model.KeyChange{}, err
+	}
+	organizationID, err := model.ParseOrganizationID(p.OrganizationID)
+	if err != nil {
+		return model.KeyChange{}, err
+	}
+	status, err := model.NewKeyStatus(p.Status)
+	if err != nil {
+		return model.KeyChange{}, err
+	}
+
+	return model.KeyChange{
+		Operation: operation,
+		Key: model.Key{
+			ID:             id,
+			RoomID:         roomID,
+			OrganizationID: organizationID,
+			KeyNumber:      model.KeyNumber(p.KeyNumber),
+			Status:         status,
+			Version:        p.Version,
+			CreatedAt:      p.CreatedAt,
+			UpdatedAt:      p.UpdatedAt,
+		},
+	}, nil
+}
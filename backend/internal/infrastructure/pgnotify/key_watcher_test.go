@@ -0,0 +1,158 @@
+package pgnotify
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseKeyChange(t *testing.T) {
+	keyID := uuid.New()
+	roomID := uuid.New()
+	orgID := uuid.New()
+
+	tests := []struct {
+		name    string
+		payload string
+		want    model.KeyChange
+		wantErr bool
+	}{
+		{
+			name: "正常系: トリガーの通知を鍵の変更に変換する",
+			payload: `{"operation":"updated","id":"` + keyID.String() + `","room_id":"` + roomID.String() +
+				`","organization_id":"` + orgID.String() + `","key_number":"A-1","status":"in_use","version":3,` +
+				`"created_at":"2026-10-19T12:00:00.123456+00:00","updated_at":"2026-10-19T21:30:00+09:00"}`,
+			want: model.KeyChange{
+				Operation: model.KeyChangeOperationUpdated,
+				Key: model.Key{
+					ID:             model.KeyID(keyID),
+					RoomID:         model.RoomID(roomID),
+					OrganizationID: model.OrganizationID(orgID),
+					KeyNumber:      "A-1",
+					Status:         model.KeyStatusInUse,
+					Version:        3,
+					CreatedAt:      time.Date(2026, 10, 19, 12, 0, 0, 123456000, time.UTC),
+					UpdatedAt:      time.Date(2026, 10, 19, 12, 30, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name:    "異常系: 不明な操作",
+			payload: `{"operation":"truncated","id":"` + keyID.String() + `","room_id":"` + roomID.String() + `","organization_id":"` + orgID.String() + `","status":"available"}`,
+			wantErr: true,
+		},
+		{
+			name:    "異常系: JSONではない",
+			payload: `not json`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseKeyChange(tt.payload)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.Operation, got.Operation)
+			assert.Equal(t, tt.want.Key.ID, got.Key.ID)
+			assert.Equal(t, tt.want.Key.RoomID, got.Key.RoomID)
+			assert.Equal(t, tt.want.Key.OrganizationID, got.Key.OrganizationID)
+			assert.Equal(t, tt.want.Key.KeyNumber, got.Key.KeyNumber)
+			assert.Equal(t, tt.want.Key.Status, got.Key.Status)
+			assert.True(t, tt.want.Key.CreatedAt.Equal(got.Key.CreatedAt))
+			assert.True(t, tt.want.Key.UpdatedAt.Equal(got.Key.UpdatedAt))
+		})
+	}
+}
+
+func newTestKeyWatcher(listening bool) *KeyWatcher {
+	w := &KeyWatcher{subscribers: make(map[*subscriber]struct{})}
+	w.setListening(listening)
+	return w
+}
+
+func keyChangeIn(orgID model.OrganizationID) model.KeyChange {
+	return model.KeyChange{
+		Operation: model.KeyChangeOperationUpdated,
+		Key: model.Key{
+			ID:             model.KeyID(uuid.New()),
+			OrganizationID: orgID,
+			Status:         model.KeyStatusInUse,
+		},
+	}
+}
+
+func TestKeyWatcher_Watch(t *testing.T) {
+	orgID := model.OrganizationID(uuid.New())
+	otherOrgID := model.OrganizationID(uuid.New())
+
+	t.Run("正常系: 同じ組織の変更だけを届ける", func(t *testing.T) {
+		w := newTestKeyWatcher(true)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		ch := w.Watch(ctx, orgID)
+		change := keyChangeIn(orgID)
+		w.publish(keyChangeIn(otherOrgID))
+		w.publish(change)
+
+		got := <-ch
+		assert.Equal(t, change.Key.ID, got.Key.ID)
+		assert.Empty(t, ch)
+	})
+
+	t.Run("正常系: ctxが終了するとチャネルを閉じる", func(t *testing.T) {
+		w := newTestKeyWatcher(true)
+		ctx, cancel := context.WithCancel(context.Background())
+
+		ch := w.Watch(ctx, orgID)
+		cancel()
+
+		_, ok := <-ch
+		assert.False(t, ok)
+	})
+
+	t.Run("異常系: LISTENしていない間はすぐに閉じたチャネルを返す", func(t *testing.T) {
+		w := newTestKeyWatcher(false)
+
+		_, ok := <-w.Watch(context.Background(), orgID)
+		assert.False(t, ok)
+	})
+
+	t.Run("異常系: 接続が切れると購読者を打ち切る", func(t *testing.T) {
+		w := newTestKeyWatcher(true)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		ch := w.Watch(ctx, orgID)
+		w.setListening(false)
+
+		_, ok := <-ch
+		assert.False(t, ok)
+	})
+
+	t.Run("異常系: 受け取りが追いつかない購読者を打ち切る", func(t *testing.T) {
+		w := newTestKeyWatcher(true)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		ch := w.Watch(ctx, orgID)
+		for range subscriberBuffer + 1 {
+			w.publish(keyChangeIn(orgID))
+		}
+
+		received := 0
+		for range ch {
+			received++
+		}
+		assert.Equal(t, subscriberBuffer, received)
+	})
+}
@@ -0,0 +1,114 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/ratelimit"
+)
+
+// sweepInterval は使われなくなったキーを破棄する間隔
+const sweepInterval = time.Minute
+
+type memoryBucket struct {
+	bucket model.RateLimitBucket
+	limit  model.RateLimit
+}
+
+type memoryFailures struct {
+	failures model.LoginFailures
+	policy   model.LockoutPolicy
+}
+
+// MemoryStore はプロセス内で状態を保持する Store。インスタンス間では共有されない
+type MemoryStore struct {
+	mu        sync.Mutex
+	now       func() time.Time
+	buckets   map[string]memoryBucket
+	failures  map[string]memoryFailures
+	lastSweep time.Time
+}
+
+var _ ratelimit.Store = (*MemoryStore)(nil)
+
+func NewMemoryStore() *MemoryStore {
+	return newMemoryStore(time.Now)
+}
+
+func newMemoryStore(now func() time.Time) *MemoryStore {
+	return &MemoryStore{
+		now:       now,
+		buckets:   make(map[string]memoryBucket),
+		failures:  make(map[string]memoryFailures),
+		lastSweep: now(),
+	}
+}
+
+func (s *MemoryStore) Take(_ context.Context, key string, limit model.RateLimit) (model.RateLimitDecision, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.sweep(now)
+
+	entry, ok := s.buckets[key]
+	if !ok {
+		entry.bucket = model.NewRateLimitBucket(limit, now)
+	}
+
+	bucket, decision := entry.bucket.Take(limit, now)
+	s.buckets[key] = memoryBucket{bucket: bucket, limit: limit}
+
+	return decision, nil
+}
+
+func (s *MemoryStore) LockedFor(_ context.Context, key string) (time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.failures[key].failures.LockedFor(s.now()), nil
+}
+
+func (s *MemoryStore) RecordFailure(_ context.Context, key string, policy model.LockoutPolicy) (time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	failures := s.failures[key].failures.Record(policy, now)
+	s.failures[key] = memoryFailures{failures: failures, policy: policy}
+
+	return failures.LockedFor(now), nil
+}
+
+func (s *MemoryStore) ResetFailures(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// ロック中に成功することはないが、念のためロックは解除しない
+	if s.failures[key].failures.LockedFor(s.now()) == 0 {
+		delete(s.failures, key)
+	}
+	return nil
+}
+
+// sweep は満タンまで回復したバケットと期限切れの失敗記録を破棄し、キーが増え続けないようにする。
+// 呼び出し側でロックを取得していること
+func (s *MemoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < sweepInterval {
+		return
+	}
+	s.lastSweep = now
+
+	for key, entry := range s.buckets {
+		if entry.bucket.IsIdle(entry.limit, now) {
+			delete(s.buckets, key)
+		}
+	}
+	for key, entry := range s.failures {
+		if entry.failures.IsIdle(entry.policy, now) {
+			delete(s.failures, key)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryStoreTake(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	store := newMemoryStore(func() time.Time { return now })
+	limit := model.RateLimit{Burst: 1, Period: time.Minute}
+
+	decision, err := store.Take(ctx, "ip:192.0.2.1", limit)
+	require.NoError(t, err)
+	assert.True(t, decision.Allowed)
+
+	decision, err = store.Take(ctx, "ip:192.0.2.1", limit)
+	require.NoError(t, err)
+	assert.False(t, decision.Allowed)
+	assert.Equal(t, time.Minute, decision.RetryAfter)
+
+	decision, err = store.Take(ctx, "ip:192.0.2.2", limit)
+	require.NoError(t, err)
+	assert.True(t, decision.Allowed, "キーごとに独立して数える")
+
+	now = now.Add(2 * time.Minute)
+	_, err = store.Take(ctx, "ip:192.0.2.3", limit)
+	require.NoError(t, err)
+	assert.NotContains(t, store.buckets, "ip:192.0.2.1", "回復したバケットは破棄される")
+}
+
+func TestMemoryStoreLockout(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	store := newMemoryStore(func() time.Time { return now })
+	policy := model.LockoutPolicy{MaxFailures: 2, Window: time.Minute, Duration: 5 * time.Minute}
+
+	locked, err := store.RecordFailure(ctx, "org", policy)
+	require.NoError(t, err)
+	assert.Zero(t, locked)
+
+	require.NoError(t, store.ResetFailures(ctx, "org"))
+
+	_, err = store.RecordFailure(ctx, "org", policy)
+	require.NoError(t, err)
+	locked, err = store.RecordFailure(ctx, "org", policy)
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Minute, locked)
+
+	require.NoError(t, store.ResetFailures(ctx, "org"))
+	locked, err = store.LockedFor(ctx, "org")
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Minute, locked, "ロック中は成功してもロックを解除しない")
+
+	now = now.Add(5 * time.Minute)
+	locked, err = store.LockedFor(ctx, "org")
+	require.NoError(t, err)
+	assert.Zero(t, locked)
+}
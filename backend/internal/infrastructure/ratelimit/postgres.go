@@ -0,0 +1,117 @@
+package ratelimit
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/ratelimit"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+)
+
+// PostgresStore はPostgresに状態を保持する Store。複数インスタンスで同じ制限を共有できる。
+// キーごとに行ロックを取るため、同時リクエストでもトークンを二重に消費しない
+type PostgresStore struct {
+	repo repository.Repository
+	now  func() time.Time
+
+	mu        sync.Mutex
+	lastSweep time.Time
+}
+
+var _ ratelimit.Store = (*PostgresStore)(nil)
+
+func NewPostgresStore(repo repository.Repository) *PostgresStore {
+	return &PostgresStore{
+		repo:      repo,
+		now:       time.Now,
+		lastSweep: time.Now(),
+	}
+}
+
+func (s *PostgresStore) Take(ctx context.Context, key string, limit model.RateLimit) (model.RateLimitDecision, error) {
+	now := s.now()
+	s.sweep(ctx, now)
+
+	var decision model.RateLimitDecision
+	err := s.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
+		bucket, err := tx.LockRateLimitBucket(ctx, key, model.NewRateLimitBucket(limit, now))
+		if err != nil {
+			return err
+		}
+
+		bucket, decision = bucket.Take(limit, now)
+		return tx.SaveRateLimitBucket(ctx, repository.SaveRateLimitBucketArg{
+			Key:    key,
+			Bucket: bucket,
+			IdleAt: now.Add(limit.Period),
+		})
+	})
+	if err != nil {
+		return model.RateLimitDecision{}, errors.Wrap(err, "failed to take rate limit token")
+	}
+
+	return decision, nil
+}
+
+func (s *PostgresStore) LockedFor(ctx context.Context, key string) (time.Duration, error) {
+	failures, err := s.repo.GetLoginFailures(ctx, key)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get login failures")
+	}
+	return failures.LockedFor(s.now()), nil
+}
+
+func (s *PostgresStore) RecordFailure(ctx context.Context, key string, policy model.LockoutPolicy) (time.Duration, error) {
+	now := s.now()
+
+	var failures model.LoginFailures
+	err := s.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
+		current, err := tx.LockLoginFailures(ctx, key, now)
+		if err != nil {
+			return err
+		}
+
+		failures = current.Record(policy, now)
+		idleAt := failures.WindowStartedAt.Add(policy.Window)
+		if failures.LockedUntil != nil && failures.LockedUntil.After(idleAt) {
+			idleAt = *failures.LockedUntil
+		}
+
+		return tx.SaveLoginFailures(ctx, repository.SaveLoginFailuresArg{
+			Key:      key,
+			Failures: failures,
+			IdleAt:   idleAt,
+		})
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to record login failure")
+	}
+
+	return failures.LockedFor(now), nil
+}
+
+func (s *PostgresStore) ResetFailures(ctx context.Context, key string) error {
+	if err := s.repo.DeleteLoginFailures(ctx, key); err != nil {
+		return errors.Wrap(err, "failed to reset login failures")
+	}
+	return nil
+}
+
+// sweep は期限切れの行を定期的に削除する。削除に失敗しても判定には影響しないためログのみ残す
+func (s *PostgresStore) sweep(ctx context.Context, now time.Time) {
+	s.mu.Lock()
+	if now.Sub(s.lastSweep) < sweepInterval {
+		s.mu.Unlock()
+		return
+	}
+	s.lastSweep = now
+	s.mu.Unlock()
+
+	if err := s.repo.DeleteIdleRateLimits(ctx, now); err != nil {
+		slog.WarnContext(ctx, "failed to delete idle rate limits", "error", err)
+	}
+}
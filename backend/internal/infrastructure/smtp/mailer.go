@@ -0,0 +1,148 @@
+package smtp
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/shibayama-club/keyhub/cmd/config"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/notification"
+)
+
+// sendTimeout は1通の送信でSMTPサーバーとのやり取りを待つ時間
+const sendTimeout = 30 * time.Second
+
+// Mailer はSMTPサーバーへ1通ごとに接続してメールを送る
+type Mailer struct {
+	config config.SMTPConfig
+	from   *mail.Address
+	now    func() time.Time
+}
+
+var _ notification.Mailer = (*Mailer)(nil)
+
+func NewMailer(cf config.SMTPConfig) (*Mailer, error) {
+	if cf.Host == "" {
+		return nil, errors.New("smtp host is required")
+	}
+	from, err := mail.ParseAddress(cf.From)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid smtp from address %q", cf.From)
+	}
+
+	return &Mailer{
+		config: cf,
+		from:   from,
+		now:    time.Now,
+	}, nil
+}
+
+func (m *Mailer) Send(ctx context.Context, message model.Mail) error {
+	to, err := mail.ParseAddress(message.To.String())
+	if err != nil {
+		return errors.Wrapf(err, "invalid recipient address %q", message.To)
+	}
+
+	body, err := m.buildMessage(to, message)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, sendTimeout)
+	defer cancel()
+
+	addr := net.JoinHostPort(m.config.Host, strconv.Itoa(m.config.Port))
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return errors.Wrapf(err, "failed to connect to smtp server %s", addr)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, m.config.Host)
+	if err != nil {
+		conn.Close()
+		return errors.Wrap(err, "failed to start smtp session")
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: m.config.Host}); err != nil {
+			return errors.Wrap(err, "failed to start tls")
+		}
+	}
+	if m.config.Username != "" {
+		// PlainAuth はTLSでない接続ではlocalhost以外への認証を拒否するため、パスワードを平文で送ることはない
+		auth := smtp.PlainAuth("", m.config.Username, m.config.Password, m.config.Host)
+		if err := client.Auth(auth); err != nil {
+			return errors.Wrap(err, "failed to authenticate to smtp server")
+		}
+	}
+
+	if err := client.Mail(m.from.Address); err != nil {
+		return errors.Wrap(err, "smtp server rejected sender")
+	}
+	if err := client.Rcpt(to.Address); err != nil {
+		return errors.Wrap(err, "smtp server rejected recipient")
+	}
+	w, err := client.Data()
+	if err != nil {
+		return errors.Wrap(err, "failed to start smtp data")
+	}
+	if _, err := w.Write(body); err != nil {
+		return errors.Wrap(err, "failed to write smtp data")
+	}
+	if err := w.Close(); err != nil {
+		return errors.Wrap(err, "smtp server rejected message")
+	}
+
+	return client.Quit()
+}
+
+// buildMessage はUTF-8のプレーンテキストのメールを組み立てる。件名はMIMEエンコードし、本文はquoted-printableにする
+func (m *Mailer) buildMessage(to *mail.Address, message model.Mail) ([]byte, error) {
+	var buf bytes.Buffer
+
+	domain := m.from.Address[strings.LastIndex(m.from.Address, "@")+1:]
+	// 件名にテナント名などの入力値が入るため、ヘッダーを分割されないよう改行を取り除く
+	subject := strings.Join(strings.Fields(message.Subject), " ")
+
+	headers := [][2]string{
+		{"From", m.from.String()},
+		{"To", to.String()},
+		{"Subject", mime.BEncoding.Encode("UTF-8", subject)},
+		{"Date", m.now().Format(time.RFC1123Z)},
+		{"Message-ID", fmt.Sprintf("<%s@%s>", uuid.NewString(), domain)},
+		{"MIME-Version", "1.0"},
+		{"Content-Type", `text/plain; charset="UTF-8"`},
+		{"Content-Transfer-Encoding", "quoted-printable"},
+	}
+	for _, h := range headers {
+		fmt.Fprintf(&buf, "%s: %s\r\n", h[0], h[1])
+	}
+	buf.WriteString("\r\n")
+
+	qp := quotedprintable.NewWriter(&buf)
+	if _, err := qp.Write([]byte(message.Body)); err != nil {
+		return nil, errors.Wrap(err, "failed to encode mail body")
+	}
+	if err := qp.Close(); err != nil {
+		return nil, errors.Wrap(err, "failed to encode mail body")
+	}
+
+	return buf.Bytes(), nil
+}
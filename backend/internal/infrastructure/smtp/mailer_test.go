@@ -0,0 +1,185 @@
+package smtp
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shibayama-club/keyhub/cmd/config"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSMTPServer は受け取ったメールを記録するだけのSMTPサーバー
+type fakeSMTPServer struct {
+	listener net.Listener
+	// rejectRcpt が true の場合は RCPT TO を拒否する
+	rejectRcpt bool
+
+	mu       sync.Mutex
+	from     string
+	rcpt     []string
+	messages []string
+}
+
+func newFakeSMTPServer(t *testing.T) *fakeSMTPServer {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := &fakeSMTPServer{listener: listener}
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *fakeSMTPServer) port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (s *fakeSMTPServer) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	reply := func(line string) { io.WriteString(conn, line+"\r\n") }
+
+	reply("220 fake ESMTP")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		cmd := strings.ToUpper(strings.TrimSpace(line))
+		switch {
+		case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+			reply("250 fake")
+		case strings.HasPrefix(cmd, "MAIL FROM:"):
+			s.mu.Lock()
+			s.from = strings.TrimSpace(line)[len("MAIL FROM:"):]
+			s.mu.Unlock()
+			reply("250 OK")
+		case strings.HasPrefix(cmd, "RCPT TO:"):
+			if s.rejectRcpt {
+				reply("550 no such user")
+				continue
+			}
+			s.mu.Lock()
+			s.rcpt = append(s.rcpt, strings.TrimSpace(line)[len("RCPT TO:"):])
+			s.mu.Unlock()
+			reply("250 OK")
+		case cmd == "DATA":
+			reply("354 go ahead")
+			var data strings.Builder
+			for {
+				l, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if l == ".\r\n" {
+					break
+				}
+				data.WriteString(strings.TrimPrefix(l, "."))
+			}
+			s.mu.Lock()
+			s.messages = append(s.messages, data.String())
+			s.mu.Unlock()
+			reply("250 queued")
+		case cmd == "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("250 OK")
+		}
+	}
+}
+
+func TestMailer_Send(t *testing.T) {
+	tests := []struct {
+		name       string
+		rejectRcpt bool
+		wantErr    bool
+	}{
+		{
+			name: "正常系: 件名と本文をエンコードして送信する",
+		},
+		{
+			name:       "異常系: 宛先を拒否されたらエラーを返す",
+			rejectRcpt: true,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newFakeSMTPServer(t)
+			server.rejectRcpt = tt.rejectRcpt
+
+			mailer, err := NewMailer(config.SMTPConfig{
+				Host: "127.0.0.1",
+				Port: server.port(),
+				From: "KeyHub <noreply@keyhub.example>",
+			})
+			require.NoError(t, err)
+			mailer.now = func() time.Time { return time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC) }
+
+			err = mailer.Send(context.Background(), model.Mail{
+				To:      "taro@example.com",
+				Subject: "【KeyHub】部屋の割り当て期限が近づいています\r\nBcc: evil@example.com",
+				Body:    "情報工学科 のみなさん\n部屋 101 の割り当ては 10月22日 に終了します。\n",
+			})
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Empty(t, server.messages)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, "<noreply@keyhub.example>", server.from)
+			assert.Equal(t, []string{"<taro@example.com>"}, server.rcpt)
+			require.Len(t, server.messages, 1)
+
+			msg, err := mail.ReadMessage(strings.NewReader(server.messages[0]))
+			require.NoError(t, err)
+
+			subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+			require.NoError(t, err)
+			assert.Equal(t, "【KeyHub】部屋の割り当て期限が近づいています Bcc: evil@example.com", subject)
+			assert.Empty(t, msg.Header.Get("Bcc"))
+			assert.Equal(t, "Mon, 19 Oct 2026 12:00:00 +0000", msg.Header.Get("Date"))
+			assert.Equal(t, `text/plain; charset="UTF-8"`, msg.Header.Get("Content-Type"))
+
+			body, err := io.ReadAll(quotedprintable.NewReader(msg.Body))
+			require.NoError(t, err)
+			assert.Equal(t, "情報工学科 のみなさん\r\n部屋 101 の割り当ては 10月22日 に終了します。\r\n", string(body))
+		})
+	}
+}
+
+func TestNewMailer(t *testing.T) {
+	_, err := NewMailer(config.SMTPConfig{Host: "", From: "noreply@keyhub.example"})
+	assert.Error(t, err, "ホストが空の場合はエラー")
+
+	_, err = NewMailer(config.SMTPConfig{Host: "localhost", Port: 25, From: "not an address"})
+	assert.Error(t, err, "送信元の形式が正しくない場合はエラー")
+
+	m, err := NewMailer(config.SMTPConfig{Host: "localhost", Port: 25, From: "KeyHub <noreply@keyhub.example>"})
+	require.NoError(t, err)
+	assert.Equal(t, "noreply@keyhub.example", m.from.Address)
+}
@@ -0,0 +1,110 @@
+package sqlc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+	sqlcgen "github.com/shibayama-club/keyhub/internal/infrastructure/sqlc/gen"
+	"github.com/shibayama-club/keyhub/internal/util"
+)
+
+func parseSqlcAPIToken(token sqlcgen.ApiToken) (model.APIToken, error) {
+	var userID *model.UserID
+	if token.UserID != nil {
+		id := model.UserID(*token.UserID)
+		userID = &id
+	}
+
+	return model.APIToken{
+		ID:             model.APITokenID(token.ID),
+		UserID:         userID,
+		OrganizationID: model.OrganizationID(token.OrganizationID),
+		Name:           model.APITokenName(token.Name),
+		TokenPrefix:    token.TokenPrefix,
+		Scopes: lo.Map(token.Scopes, func(s string, _ int) model.APITokenScope {
+			return model.APITokenScope(s)
+		}),
+		ExpiresAt:  token.ExpiresAt.Time,
+		LastUsedAt: util.PgTimestamptzToGoTime(token.LastUsedAt),
+		RevokedAt:  util.PgTimestamptzToGoTime(token.RevokedAt),
+		CreatedAt:  token.CreatedAt.Time,
+	}, nil
+}
+
+func (t *SqlcTransaction) CreateAPIToken(ctx context.Context, arg repository.CreateAPITokenArg) error {
+	var userID *uuid.UUID
+	if arg.Token.UserID != nil {
+		userID = lo.ToPtr(arg.Token.UserID.UUID())
+	}
+
+	return t.queries.CreateAPIToken(ctx, sqlcgen.CreateAPITokenParams{
+		ID:             arg.Token.ID.UUID(),
+		UserID:         userID,
+		OrganizationID: arg.Token.OrganizationID.UUID(),
+		Name:           arg.Token.Name.String(),
+		TokenPrefix:    arg.Token.TokenPrefix,
+		TokenHash:      arg.TokenHash,
+		Scopes: lo.Map(arg.Token.Scopes, func(s model.APITokenScope, _ int) string {
+			return s.String()
+		}),
+		ExpiresAt: util.GoTimeToPgTimestamptz(&arg.Token.ExpiresAt),
+		CreatedAt: util.GoTimeToPgTimestamptz(&arg.Token.CreatedAt),
+	})
+}
+
+func (t *SqlcTransaction) GetAPITokenByHash(ctx context.Context, tokenHash string) (model.APIToken, error) {
+	row, err := t.queries.GetAPITokenByHash(ctx, tokenHash)
+	if err != nil {
+		return model.APIToken{}, err
+	}
+	return parseSqlcAPIToken(row.ApiToken)
+}
+
+func (t *SqlcTransaction) ListAPITokensByUser(ctx context.Context, userID model.UserID) ([]model.APIToken, error) {
+	rows, err := t.queries.ListAPITokensByUser(ctx, lo.ToPtr(userID.UUID()))
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := lo.Map(rows, func(row sqlcgen.ListAPITokensByUserRow, _ int) model.APIToken {
+		token, _ := parseSqlcAPIToken(row.ApiToken)
+		return token
+	})
+
+	return tokens, nil
+}
+
+func (t *SqlcTransaction) ListAPITokensByOrganization(ctx context.Context, organizationID model.OrganizationID) ([]model.APIToken, error) {
+	rows, err := t.queries.ListAPITokensByOrganization(ctx, organizationID.UUID())
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := lo.Map(rows, func(row sqlcgen.ListAPITokensByOrganizationRow, _ int) model.APIToken {
+		token, _ := parseSqlcAPIToken(row.ApiToken)
+		return token
+	})
+
+	return tokens, nil
+}
+
+func (t *SqlcTransaction) TouchAPIToken(ctx context.Context, id model.APITokenID) error {
+	return t.queries.TouchAPIToken(ctx, id.UUID())
+}
+
+func (t *SqlcTransaction) RevokeAPITokenByUser(ctx context.Context, userID model.UserID, id model.APITokenID) (int64, error) {
+	return t.queries.RevokeAPITokenByUser(ctx, sqlcgen.RevokeAPITokenByUserParams{
+		ID:     id.UUID(),
+		UserID: lo.ToPtr(userID.UUID()),
+	})
+}
+
+func (t *SqlcTransaction) RevokeAPITokenByOrganization(ctx context.Context, organizationID model.OrganizationID, id model.APITokenID) (int64, error) {
+	return t.queries.RevokeAPITokenByOrganization(ctx, sqlcgen.RevokeAPITokenByOrganizationParams{
+		ID:             id.UUID(),
+		OrganizationID: organizationID.UUID(),
+	})
+}
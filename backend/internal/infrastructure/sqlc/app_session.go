@@ -2,8 +2,11 @@ package sqlc
 
 import (
 	"context"
+	"time"
 
+	"github.com/cockroachdb/errors"
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/samber/lo"
 	"github.com/shibayama-club/keyhub/internal/domain/model"
 	"github.com/shibayama-club/keyhub/internal/domain/repository"
 	sqlcgen "github.com/shibayama-club/keyhub/internal/infrastructure/sqlc/gen"
@@ -13,11 +16,14 @@ func parseSqlcSession(session sqlcgen.Session) (model.AppSession, error) {
 	return model.AppSession{
 		SessionID:          model.AppSessionID(session.SessionID),
 		UserID:             model.UserID(session.UserID),
+		OrganizationID:     model.OrganizationID(session.OrganizationID),
 		ActiveMembershipID: session.ActiveMembershipID,
 		CreatedAt:          session.CreatedAt.Time,
 		ExpiresAt:          session.ExpiresAt.Time,
 		CSRFToken:          session.CsrfToken,
 		Revoked:            session.Revoked,
+		Client:             model.NewSessionClient(session.UserAgent, session.IpAddress),
+		LastSeenAt:         session.LastSeenAt.Time,
 	}, nil
 }
 
@@ -30,7 +36,10 @@ func (t *SqlcTransaction) CreateAppSession(ctx context.Context, arg repository.C
 			Time:  arg.ExpiresAt,
 			Valid: true,
 		},
-		CsrfToken: nil, // Optional field
+		CsrfToken:      &arg.CSRFToken,
+		UserAgent:      arg.Client.UserAgent,
+		IpAddress:      arg.Client.IPAddress,
+		OrganizationID: arg.OrganizationID.UUID(),
 	})
 }
 
@@ -43,6 +52,66 @@ func (t *SqlcTransaction) GetAppSession(ctx context.Context, sessionID model.App
 	return parseSqlcSession(sqlcSessionRow.Session)
 }
 
+func (t *SqlcTransaction) ListActiveAppSessionsByUser(ctx context.Context, userID model.UserID) ([]model.AppSession, error) {
+	rows, err := t.queries.ListActiveAppSessionsByUser(ctx, userID.UUID())
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := lo.Map(rows, func(row sqlcgen.ListActiveAppSessionsByUserRow, _ int) model.AppSession {
+		session, _ := parseSqlcSession(row.Session)
+		return session
+	})
+
+	return sessions, nil
+}
+
+func (t *SqlcTransaction) TouchAppSession(ctx context.Context, sessionID model.AppSessionID, client model.SessionClient) error {
+	return t.queries.TouchAppSession(ctx, sqlcgen.TouchAppSessionParams{
+		SessionID: sessionID.String(),
+		UserAgent: client.UserAgent,
+		IpAddress: client.IPAddress,
+	})
+}
+
+func (t *SqlcTransaction) EnsureAppSessionCSRFToken(ctx context.Context, sessionID model.AppSessionID, token string) (string, error) {
+	stored, err := t.queries.EnsureAppSessionCSRFToken(ctx, sqlcgen.EnsureAppSessionCSRFTokenParams{
+		SessionID: sessionID.String(),
+		CsrfToken: &token,
+	})
+	if err != nil {
+		return "", err
+	}
+	if stored == nil {
+		return "", errors.New("csrf token was not stored")
+	}
+	return *stored, nil
+}
+
+func (t *SqlcTransaction) ExtendAppSession(ctx context.Context, sessionID model.AppSessionID, expiresAt time.Time) error {
+	return t.queries.ExtendAppSession(ctx, sqlcgen.ExtendAppSessionParams{
+		SessionID: sessionID.String(),
+		ExpiresAt: pgtype.Timestamptz{
+			Time:  expiresAt,
+			Valid: true,
+		},
+	})
+}
+
 func (t *SqlcTransaction) RevokeAppSession(ctx context.Context, sessionID model.AppSessionID) error {
 	return t.queries.RevokeAppSession(ctx, sessionID.String())
 }
+
+func (t *SqlcTransaction) RevokeAppSessionByUser(ctx context.Context, userID model.UserID, sessionID model.AppSessionID) (int64, error) {
+	return t.queries.RevokeAppSessionByUser(ctx, sqlcgen.RevokeAppSessionByUserParams{
+		SessionID: sessionID.String(),
+		UserID:    userID.UUID(),
+	})
+}
+
+func (t *SqlcTransaction) RevokeOtherAppSessionsByUser(ctx context.Context, userID model.UserID, currentSessionID model.AppSessionID) (int64, error) {
+	return t.queries.RevokeOtherAppSessionsByUser(ctx, sqlcgen.RevokeOtherAppSessionsByUserParams{
+		UserID:    userID.UUID(),
+		SessionID: currentSessionID.String(),
+	})
+}
@@ -0,0 +1,151 @@
+package sqlc
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/samber/lo"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+	sqlcgen "github.com/shibayama-club/keyhub/internal/infrastructure/sqlc/gen"
+	"github.com/shibayama-club/keyhub/internal/util"
+)
+
+func parseSqlcAuditLog(log sqlcgen.AuditLog) (model.AuditLog, error) {
+	targetIDs := map[string]string{}
+	if err := json.Unmarshal(log.TargetIds, &targetIDs); err != nil {
+		return model.AuditLog{}, errors.Wrap(err, "failed to unmarshal audit log target IDs")
+	}
+
+	return model.AuditLog{
+		ID:             model.AuditLogID(log.ID),
+		OrganizationID: model.OrganizationID(log.OrganizationID),
+		Actor: model.AuditActor{
+			Type: model.AuditActorType(log.ActorType),
+			ID:   log.ActorID,
+		},
+		Procedure:  log.Procedure,
+		TargetIDs:  targetIDs,
+		ResultCode: log.ResultCode,
+		RequestID:  log.RequestID,
+		Client:     model.NewSessionClient(log.UserAgent, log.IpAddress),
+		CreatedAt:  log.CreatedAt.Time,
+		Seq:        log.Seq,
+		PrevHash:   log.PrevHash,
+		Hash:       log.Hash,
+	}, nil
+}
+
+func (t *SqlcTransaction) CreateAuditLog(ctx context.Context, log model.AuditLog) error {
+	targetIDs, err := json.Marshal(log.TargetIDs)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal audit log target IDs")
+	}
+
+	return t.queries.CreateAuditLog(ctx, sqlcgen.CreateAuditLogParams{
+		ID:             log.ID.UUID(),
+		OrganizationID: log.OrganizationID.UUID(),
+		ActorType:      log.Actor.Type.String(),
+		ActorID:        log.Actor.ID,
+		Procedure:      log.Procedure,
+		TargetIds:      targetIDs,
+		ResultCode:     log.ResultCode,
+		RequestID:      log.RequestID,
+		IpAddress:      log.Client.IPAddress,
+		UserAgent:      log.Client.UserAgent,
+		CreatedAt: pgtype.Timestamptz{
+			Time:  log.CreatedAt,
+			Valid: true,
+		},
+		Seq:      log.Seq,
+		PrevHash: log.PrevHash,
+		Hash:     log.Hash,
+	})
+}
+
+func (t *SqlcTransaction) SearchAuditLogs(ctx context.Context, arg repository.SearchAuditLogsArg) ([]model.AuditLog, error) {
+	params := sqlcgen.SearchAuditLogsParams{
+		OrganizationID: arg.OrganizationID.UUID(),
+		ActorType:      lo.EmptyableToPtr(arg.ActorType.String()),
+		ActorID:        lo.EmptyableToPtr(arg.ActorID),
+		Procedure:      lo.EmptyableToPtr(arg.Procedure),
+		ResultCode:     lo.EmptyableToPtr(arg.ResultCode),
+		TargetID:       lo.EmptyableToPtr(arg.TargetID),
+		Since:          util.GoTimeToPgTimestamptz(arg.Since),
+		Until:          util.GoTimeToPgTimestamptz(arg.Until),
+		PageSize:       arg.Limit,
+	}
+	if arg.Cursor != nil {
+		params.CursorCreatedAt = pgtype.Timestamptz{Time: arg.Cursor.CreatedAt, Valid: true}
+		params.CursorID = lo.ToPtr(arg.Cursor.ID.UUID())
+	}
+
+	rows, err := t.queries.SearchAuditLogs(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	logs := make([]model.AuditLog, 0, len(rows))
+	for _, row := range rows {
+		log, err := parseSqlcAuditLog(row.AuditLog)
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, log)
+	}
+
+	return logs, nil
+}
+
+func (t *SqlcTransaction) LockAuditChain(ctx context.Context, organizationID model.OrganizationID) (model.AuditChainHead, error) {
+	if err := t.queries.LockAuditChain(ctx, organizationID.String()); err != nil {
+		return model.AuditChainHead{}, err
+	}
+
+	head, err := t.queries.GetAuditChainHead(ctx, organizationID.UUID())
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.AuditChainHead{}, nil
+	}
+	if err != nil {
+		return model.AuditChainHead{}, err
+	}
+
+	return model.AuditChainHead{Seq: head.Seq, Hash: head.Hash}, nil
+}
+
+func (t *SqlcTransaction) ListAuditLogsBySeq(ctx context.Context, organizationID model.OrganizationID, afterSeq int64, limit int32) ([]model.AuditLog, error) {
+	rows, err := t.queries.ListAuditLogsBySeq(ctx, sqlcgen.ListAuditLogsBySeqParams{
+		OrganizationID: organizationID.UUID(),
+		AfterSeq:       afterSeq,
+		BatchSize:      limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logs := make([]model.AuditLog, 0, len(rows))
+	for _, row := range rows {
+		log, err := parseSqlcAuditLog(row.AuditLog)
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, log)
+	}
+
+	return logs, nil
+}
+
+func (t *SqlcTransaction) ListAuditLogOrganizationIDs(ctx context.Context) ([]model.OrganizationID, error) {
+	ids, err := t.queries.ListAuditLogOrganizationIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(ids, func(id uuid.UUID, _ int) model.OrganizationID {
+		return model.OrganizationID(id)
+	}), nil
+}
@@ -0,0 +1,158 @@
+package sqlc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	sqlcgen "github.com/shibayama-club/keyhub/internal/infrastructure/sqlc/gen"
+)
+
+func parseSqlcBuilding(building sqlcgen.Building) model.Building {
+	return model.Building{
+		ID:             model.BuildingID(building.ID),
+		OrganizationID: model.OrganizationID(building.OrganizationID),
+		Name:           model.BuildingName(building.Name),
+		Description:    model.BuildingDescription(building.Description),
+		CreatedAt:      building.CreatedAt.Time,
+		UpdatedAt:      building.UpdatedAt.Time,
+	}
+}
+
+func parseSqlcFloor(floor sqlcgen.Floor) model.Floor {
+	return model.Floor{
+		ID:             model.FloorID(floor.ID),
+		BuildingID:     model.BuildingID(floor.BuildingID),
+		OrganizationID: model.OrganizationID(floor.OrganizationID),
+		Name:           model.FloorNumber(floor.Name),
+		Level:          floor.Level,
+		CreatedAt:      floor.CreatedAt.Time,
+		UpdatedAt:      floor.UpdatedAt.Time,
+	}
+}
+
+func buildingIDToUUID(id *model.BuildingID) *uuid.UUID {
+	if id == nil {
+		return nil
+	}
+	return lo.ToPtr(id.UUID())
+}
+
+func floorIDToUUID(id *model.FloorID) *uuid.UUID {
+	if id == nil {
+		return nil
+	}
+	return lo.ToPtr(id.UUID())
+}
+
+func (t *SqlcTransaction) CreateBuilding(ctx context.Context, building model.Building) error {
+	return t.queries.CreateBuilding(ctx, sqlcgen.CreateBuildingParams{
+		ID:             building.ID.UUID(),
+		OrganizationID: building.OrganizationID.UUID(),
+		Name:           building.Name.String(),
+		Description:    building.Description.String(),
+	})
+}
+
+func (t *SqlcTransaction) GetBuilding(ctx context.Context, id model.BuildingID) (model.Building, error) {
+	row, err := t.queries.GetBuilding(ctx, id.UUID())
+	if err != nil {
+		return model.Building{}, err
+	}
+	return parseSqlcBuilding(row.Building), nil
+}
+
+func (t *SqlcTransaction) GetBuildingByOrganizationAndName(ctx context.Context, organizationID model.OrganizationID, name model.BuildingName) (model.Building, error) {
+	row, err := t.queries.GetBuildingByOrganizationAndName(ctx, sqlcgen.GetBuildingByOrganizationAndNameParams{
+		OrganizationID: organizationID.UUID(),
+		Name:           name.String(),
+	})
+	if err != nil {
+		return model.Building{}, err
+	}
+	return parseSqlcBuilding(row.Building), nil
+}
+
+func (t *SqlcTransaction) ListBuildings(ctx context.Context) ([]model.Building, error) {
+	rows, err := t.queries.ListBuildings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(rows, func(row sqlcgen.ListBuildingsRow, _ int) model.Building {
+		return parseSqlcBuilding(row.Building)
+	}), nil
+}
+
+func (t *SqlcTransaction) UpdateBuilding(ctx context.Context, building model.Building) error {
+	return t.queries.UpdateBuilding(ctx, sqlcgen.UpdateBuildingParams{
+		ID:          building.ID.UUID(),
+		Name:        building.Name.String(),
+		Description: building.Description.String(),
+	})
+}
+
+func (t *SqlcTransaction) DeleteBuilding(ctx context.Context, id model.BuildingID) (int64, error) {
+	return t.queries.DeleteBuilding(ctx, id.UUID())
+}
+
+func (t *SqlcTransaction) CountFloorsByBuilding(ctx context.Context, id model.BuildingID) (int32, error) {
+	return t.queries.CountFloorsByBuilding(ctx, id.UUID())
+}
+
+func (t *SqlcTransaction) CreateFloor(ctx context.Context, floor model.Floor) error {
+	return t.queries.CreateFloor(ctx, sqlcgen.CreateFloorParams{
+		ID:             floor.ID.UUID(),
+		BuildingID:     floor.BuildingID.UUID(),
+		OrganizationID: floor.OrganizationID.UUID(),
+		Name:           floor.Name.String(),
+		Level:          floor.Level,
+	})
+}
+
+func (t *SqlcTransaction) GetFloor(ctx context.Context, id model.FloorID) (model.Floor, error) {
+	row, err := t.queries.GetFloor(ctx, id.UUID())
+	if err != nil {
+		return model.Floor{}, err
+	}
+	return parseSqlcFloor(row.Floor), nil
+}
+
+func (t *SqlcTransaction) GetFloorByBuildingAndName(ctx context.Context, buildingID model.BuildingID, name model.FloorNumber) (model.Floor, error) {
+	row, err := t.queries.GetFloorByBuildingAndName(ctx, sqlcgen.GetFloorByBuildingAndNameParams{
+		BuildingID: buildingID.UUID(),
+		Name:       name.String(),
+	})
+	if err != nil {
+		return model.Floor{}, err
+	}
+	return parseSqlcFloor(row.Floor), nil
+}
+
+func (t *SqlcTransaction) ListFloors(ctx context.Context) ([]model.Floor, error) {
+	rows, err := t.queries.ListFloors(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(rows, func(row sqlcgen.ListFloorsRow, _ int) model.Floor {
+		return parseSqlcFloor(row.Floor)
+	}), nil
+}
+
+func (t *SqlcTransaction) UpdateFloor(ctx context.Context, floor model.Floor) error {
+	return t.queries.UpdateFloor(ctx, sqlcgen.UpdateFloorParams{
+		ID:    floor.ID.UUID(),
+		Name:  floor.Name.String(),
+		Level: floor.Level,
+	})
+}
+
+func (t *SqlcTransaction) DeleteFloor(ctx context.Context, id model.FloorID) (int64, error) {
+	return t.queries.DeleteFloor(ctx, id.UUID())
+}
+
+func (t *SqlcTransaction) CountRoomsByFloor(ctx context.Context, id model.FloorID) (int32, error) {
+	return t.queries.CountRoomsByFloor(ctx, id.UUID())
+}
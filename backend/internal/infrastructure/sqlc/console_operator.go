@@ -0,0 +1,74 @@
+package sqlc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/samber/lo"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+	sqlcgen "github.com/shibayama-club/keyhub/internal/infrastructure/sqlc/gen"
+	"github.com/shibayama-club/keyhub/internal/util"
+)
+
+func parseSqlcConsoleOperator(operator sqlcgen.ConsoleOperator) (model.ConsoleOperator, error) {
+	return model.ConsoleOperator{
+		ID:             model.ConsoleOperatorID(operator.ID),
+		OrganizationID: model.OrganizationID(operator.OrganizationID),
+		Name:           model.ConsoleOperatorName(operator.Name),
+		Role:           model.ConsoleRole(operator.Role),
+		CreatedAt:      operator.CreatedAt.Time,
+		RevokedAt:      util.PgTimestamptzToGoTime(operator.RevokedAt),
+	}, nil
+}
+
+func consoleOperatorIDToUUID(id *model.ConsoleOperatorID) *uuid.UUID {
+	if id == nil {
+		return nil
+	}
+	return lo.ToPtr(id.UUID())
+}
+
+func (t *SqlcTransaction) CreateConsoleOperator(ctx context.Context, arg repository.CreateConsoleOperatorArg) error {
+	return t.queries.CreateConsoleOperator(ctx, sqlcgen.CreateConsoleOperatorParams{
+		ID:             arg.Operator.ID.UUID(),
+		OrganizationID: arg.Operator.OrganizationID.UUID(),
+		Name:           arg.Operator.Name.String(),
+		Role:           arg.Operator.Role.String(),
+		KeyHash:        arg.KeyHash,
+		CreatedAt: pgtype.Timestamptz{
+			Time:  arg.Operator.CreatedAt,
+			Valid: true,
+		},
+	})
+}
+
+func (t *SqlcTransaction) GetConsoleOperatorByKeyHash(ctx context.Context, keyHash string) (model.ConsoleOperator, error) {
+	row, err := t.queries.GetConsoleOperatorByKeyHash(ctx, keyHash)
+	if err != nil {
+		return model.ConsoleOperator{}, err
+	}
+	return parseSqlcConsoleOperator(row.ConsoleOperator)
+}
+
+func (t *SqlcTransaction) ListConsoleOperatorsByOrganization(ctx context.Context, organizationID model.OrganizationID) ([]model.ConsoleOperator, error) {
+	rows, err := t.queries.ListConsoleOperatorsByOrganization(ctx, organizationID.UUID())
+	if err != nil {
+		return nil, err
+	}
+
+	operators := lo.Map(rows, func(row sqlcgen.ListConsoleOperatorsByOrganizationRow, _ int) model.ConsoleOperator {
+		operator, _ := parseSqlcConsoleOperator(row.ConsoleOperator)
+		return operator
+	})
+
+	return operators, nil
+}
+
+func (t *SqlcTransaction) RevokeConsoleOperator(ctx context.Context, organizationID model.OrganizationID, id model.ConsoleOperatorID) (int64, error) {
+	return t.queries.RevokeConsoleOperator(ctx, sqlcgen.RevokeConsoleOperatorParams{
+		ID:             id.UUID(),
+		OrganizationID: organizationID.UUID(),
+	})
+}
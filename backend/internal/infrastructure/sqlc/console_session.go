@@ -2,18 +2,31 @@ package sqlc
 
 import (
 	"context"
+	"time"
 
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/samber/lo"
 	"github.com/shibayama-club/keyhub/internal/domain/model"
 	"github.com/shibayama-club/keyhub/internal/domain/repository"
 	sqlcgen "github.com/shibayama-club/keyhub/internal/infrastructure/sqlc/gen"
 )
 
 func parseSqlcConsoleSession(consoleSession sqlcgen.ConsoleSession) (model.ConsoleSession, error) {
+	var operatorID *model.ConsoleOperatorID
+	if consoleSession.OperatorID != nil {
+		id := model.ConsoleOperatorID(*consoleSession.OperatorID)
+		operatorID = &id
+	}
+
 	return model.ConsoleSession{
 		SessionID:      model.ConsoleSessionID(consoleSession.SessionID),
 		OrganizationID: model.OrganizationID(consoleSession.OrganizationID),
+		Role:           model.ConsoleRole(consoleSession.Role),
+		OperatorID:     operatorID,
 		CreatedAt:      consoleSession.CreatedAt.Time,
 		ExpiresAt:      consoleSession.ExpiresAt.Time,
+		Client:         model.NewSessionClient(consoleSession.UserAgent, consoleSession.IpAddress),
+		LastSeenAt:     consoleSession.LastSeenAt.Time,
 	}, nil
 }
 
@@ -21,6 +34,18 @@ func (t *SqlcTransaction) CreateSession(ctx context.Context, arg repository.Crea
 	return t.queries.CreateConsoleSession(ctx, sqlcgen.CreateConsoleSessionParams{
 		SessionID:      arg.SessionID.String(),
 		OrganizationID: arg.OrganizationID.UUID(),
+		Role:           arg.Role.String(),
+		OperatorID:     consoleOperatorIDToUUID(arg.OperatorID),
+		CreatedAt: pgtype.Timestamptz{
+			Time:  arg.CreatedAt,
+			Valid: true,
+		},
+		ExpiresAt: pgtype.Timestamptz{
+			Time:  arg.ExpiresAt,
+			Valid: true,
+		},
+		UserAgent: arg.Client.UserAgent,
+		IpAddress: arg.Client.IPAddress,
 	})
 }
 
@@ -32,6 +57,60 @@ func (t *SqlcTransaction) GetSession(ctx context.Context, sessionID model.Consol
 	return parseSqlcConsoleSession(sqlcConsoleSessionRow.ConsoleSession)
 }
 
+func (t *SqlcTransaction) ListActiveSessionsByOrganization(ctx context.Context, organizationID model.OrganizationID) ([]model.ConsoleSession, error) {
+	rows, err := t.queries.ListActiveConsoleSessionsByOrganization(ctx, organizationID.UUID())
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := lo.Map(rows, func(row sqlcgen.ListActiveConsoleSessionsByOrganizationRow, _ int) model.ConsoleSession {
+		session, _ := parseSqlcConsoleSession(row.ConsoleSession)
+		return session
+	})
+
+	return sessions, nil
+}
+
+func (t *SqlcTransaction) TouchSession(ctx context.Context, sessionID model.ConsoleSessionID, client model.SessionClient) error {
+	return t.queries.TouchConsoleSession(ctx, sqlcgen.TouchConsoleSessionParams{
+		SessionID: sessionID.String(),
+		UserAgent: client.UserAgent,
+		IpAddress: client.IPAddress,
+	})
+}
+
+func (t *SqlcTransaction) ExtendSession(ctx context.Context, sessionID model.ConsoleSessionID, expiresAt time.Time) error {
+	return t.queries.ExtendConsoleSession(ctx, sqlcgen.ExtendConsoleSessionParams{
+		SessionID: sessionID.String(),
+		ExpiresAt: pgtype.Timestamptz{
+			Time:  expiresAt,
+			Valid: true,
+		},
+	})
+}
+
 func (t *SqlcTransaction) DeleteSession(ctx context.Context, sessionID model.ConsoleSessionID) error {
 	return t.queries.DeleteConsoleSession(ctx, sessionID.String())
 }
+
+func (t *SqlcTransaction) DeleteSessionByOrganization(ctx context.Context, organizationID model.OrganizationID, sessionID model.ConsoleSessionID) (int64, error) {
+	return t.queries.DeleteConsoleSessionByOrganization(ctx, sqlcgen.DeleteConsoleSessionByOrganizationParams{
+		SessionID:      sessionID.String(),
+		OrganizationID: organizationID.UUID(),
+	})
+}
+
+func (t *SqlcTransaction) DeleteOtherSessionsByOrganization(ctx context.Context, organizationID model.OrganizationID, currentSessionID model.ConsoleSessionID) (int64, error) {
+	return t.queries.DeleteOtherConsoleSessionsByOrganization(ctx, sqlcgen.DeleteOtherConsoleSessionsByOrganizationParams{
+		OrganizationID: organizationID.UUID(),
+		SessionID:      currentSessionID.String(),
+	})
+}
+
+func (t *SqlcTransaction) DeleteSessionsByOrganization(ctx context.Context, organizationID model.OrganizationID) (int64, error) {
+	return t.queries.DeleteConsoleSessionsByOrganization(ctx, organizationID.UUID())
+}
+
+func (t *SqlcTransaction) DeleteSessionsByOperator(ctx context.Context, operatorID model.ConsoleOperatorID) (int64, error) {
+	return t.queries.DeleteConsoleSessionsByOperator(ctx, lo.ToPtr(operatorID.UUID()))
+}
@@ -0,0 +1,236 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: api_token.sql
+
+package gen
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createAPIToken = `-- name: CreateAPIToken :exec
+INSERT INTO api_tokens (
+    id,
+    user_id,
+    organization_id,
+    name,
+    token_prefix,
+    token_hash,
+    scopes,
+    expires_at,
+    created_at
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $8,
+    $9
+)
+`
+
+type CreateAPITokenParams struct {
+	ID             uuid.UUID
+	UserID         *uuid.UUID
+	OrganizationID uuid.UUID
+	Name           string
+	TokenPrefix    string
+	TokenHash      string
+	Scopes         []string
+	ExpiresAt      pgtype.Timestamptz
+	CreatedAt      pgtype.Timestamptz
+}
+
+func (q *Queries) CreateAPIToken(ctx context.Context, arg CreateAPITokenParams) error {
+	_, err := q.db.Exec(ctx, createAPIToken,
+		arg.ID,
+		arg.UserID,
+		arg.OrganizationID,
+		arg.Name,
+		arg.TokenPrefix,
+		arg.TokenHash,
+		arg.Scopes,
+		arg.ExpiresAt,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const getAPITokenByHash = `-- name: GetAPITokenByHash :one
+SELECT t.id, t.user_id, t.organization_id, t.name, t.token_prefix, t.token_hash, t.scopes, t.expires_at, t.last_used_at, t.revoked_at, t.created_at
+FROM api_tokens t
+WHERE t.token_hash = $1
+`
+
+type GetAPITokenByHashRow struct {
+	ApiToken ApiToken
+}
+
+func (q *Queries) GetAPITokenByHash(ctx context.Context, tokenHash string) (GetAPITokenByHashRow, error) {
+	row := q.db.QueryRow(ctx, getAPITokenByHash, tokenHash)
+	var i GetAPITokenByHashRow
+	err := row.Scan(
+		&i.ApiToken.ID,
+		&i.ApiToken.UserID,
+		&i.ApiToken.OrganizationID,
+		&i.ApiToken.Name,
+		&i.ApiToken.TokenPrefix,
+		&i.ApiToken.TokenHash,
+		&i.ApiToken.Scopes,
+		&i.ApiToken.ExpiresAt,
+		&i.ApiToken.LastUsedAt,
+		&i.ApiToken.RevokedAt,
+		&i.ApiToken.CreatedAt,
+	)
+	return i, err
+}
+
+const listAPITokensByOrganization = `-- name: ListAPITokensByOrganization :many
+SELECT t.id, t.user_id, t.organization_id, t.name, t.token_prefix, t.token_hash, t.scopes, t.expires_at, t.last_used_at, t.revoked_at, t.created_at
+FROM api_tokens t
+WHERE t.organization_id = $1
+AND t.user_id IS NULL
+AND t.revoked_at IS NULL
+ORDER BY t.created_at DESC
+`
+
+type ListAPITokensByOrganizationRow struct {
+	ApiToken ApiToken
+}
+
+func (q *Queries) ListAPITokensByOrganization(ctx context.Context, organizationID uuid.UUID) ([]ListAPITokensByOrganizationRow, error) {
+	rows, err := q.db.Query(ctx, listAPITokensByOrganization, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAPITokensByOrganizationRow
+	for rows.Next() {
+		var i ListAPITokensByOrganizationRow
+		if err := rows.Scan(
+			&i.ApiToken.ID,
+			&i.ApiToken.UserID,
+			&i.ApiToken.OrganizationID,
+			&i.ApiToken.Name,
+			&i.ApiToken.TokenPrefix,
+			&i.ApiToken.TokenHash,
+			&i.ApiToken.Scopes,
+			&i.ApiToken.ExpiresAt,
+			&i.ApiToken.LastUsedAt,
+			&i.ApiToken.RevokedAt,
+			&i.ApiToken.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAPITokensByUser = `-- name: ListAPITokensByUser :many
+SELECT t.id, t.user_id, t.organization_id, t.name, t.token_prefix, t.token_hash, t.scopes, t.expires_at, t.last_used_at, t.revoked_at, t.created_at
+FROM api_tokens t
+WHERE t.user_id = $1
+AND t.revoked_at IS NULL
+ORDER BY t.created_at DESC
+`
+
+type ListAPITokensByUserRow struct {
+	ApiToken ApiToken
+}
+
+func (q *Queries) ListAPITokensByUser(ctx context.Context, userID *uuid.UUID) ([]ListAPITokensByUserRow, error) {
+	rows, err := q.db.Query(ctx, listAPITokensByUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAPITokensByUserRow
+	for rows.Next() {
+		var i ListAPITokensByUserRow
+		if err := rows.Scan(
+			&i.ApiToken.ID,
+			&i.ApiToken.UserID,
+			&i.ApiToken.OrganizationID,
+			&i.ApiToken.Name,
+			&i.ApiToken.TokenPrefix,
+			&i.ApiToken.TokenHash,
+			&i.ApiToken.Scopes,
+			&i.ApiToken.ExpiresAt,
+			&i.ApiToken.LastUsedAt,
+			&i.ApiToken.RevokedAt,
+			&i.ApiToken.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const revokeAPITokenByOrganization = `-- name: RevokeAPITokenByOrganization :execrows
+UPDATE api_tokens
+SET revoked_at = NOW()
+WHERE id = $1
+AND organization_id = $2
+AND user_id IS NULL
+AND revoked_at IS NULL
+`
+
+type RevokeAPITokenByOrganizationParams struct {
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+}
+
+func (q *Queries) RevokeAPITokenByOrganization(ctx context.Context, arg RevokeAPITokenByOrganizationParams) (int64, error) {
+	result, err := q.db.Exec(ctx, revokeAPITokenByOrganization, arg.ID, arg.OrganizationID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const revokeAPITokenByUser = `-- name: RevokeAPITokenByUser :execrows
+UPDATE api_tokens
+SET revoked_at = NOW()
+WHERE id = $1
+AND user_id = $2
+AND revoked_at IS NULL
+`
+
+type RevokeAPITokenByUserParams struct {
+	ID     uuid.UUID
+	UserID *uuid.UUID
+}
+
+func (q *Queries) RevokeAPITokenByUser(ctx context.Context, arg RevokeAPITokenByUserParams) (int64, error) {
+	result, err := q.db.Exec(ctx, revokeAPITokenByUser, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const touchAPIToken = `-- name: TouchAPIToken :exec
+UPDATE api_tokens
+SET last_used_at = NOW()
+WHERE id = $1
+`
+
+func (q *Queries) TouchAPIToken(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, touchAPIToken, id)
+	return err
+}
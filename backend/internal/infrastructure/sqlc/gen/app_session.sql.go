@@ -30,9 +30,12 @@ INSERT INTO sessions (
     active_membership_id,
     expires_at,
     csrf_token,
-    revoked
+    revoked,
+    user_agent,
+    ip_address,
+    organization_id
 ) VALUES (
-    $1, $2, $3, $4, $5, FALSE
+    $1, $2, $3, $4, $5, FALSE, $6, $7, $8
 )
 `
 
@@ -42,6 +45,9 @@ type CreateAppSessionParams struct {
 	ActiveMembershipID *uuid.UUID
 	ExpiresAt          pgtype.Timestamptz
 	CsrfToken          *string
+	UserAgent          string
+	IpAddress          string
+	OrganizationID     uuid.UUID
 }
 
 func (q *Queries) CreateAppSession(ctx context.Context, arg CreateAppSessionParams) error {
@@ -51,12 +57,53 @@ func (q *Queries) CreateAppSession(ctx context.Context, arg CreateAppSessionPara
 		arg.ActiveMembershipID,
 		arg.ExpiresAt,
 		arg.CsrfToken,
+		arg.UserAgent,
+		arg.IpAddress,
+		arg.OrganizationID,
 	)
 	return err
 }
 
+const ensureAppSessionCSRFToken = `-- name: EnsureAppSessionCSRFToken :one
+UPDATE sessions
+SET csrf_token = COALESCE(NULLIF(csrf_token, ''), $2)
+WHERE session_id = $1
+RETURNING csrf_token
+`
+
+type EnsureAppSessionCSRFTokenParams struct {
+	SessionID string
+	CsrfToken *string
+}
+
+// CSRFトークンを持たないセッションにだけ設定し、保存されているトークンを返す。
+// 同時に呼ばれても先に設定したトークンを返すため、リクエストごとに値が変わらない
+func (q *Queries) EnsureAppSessionCSRFToken(ctx context.Context, arg EnsureAppSessionCSRFTokenParams) (*string, error) {
+	row := q.db.QueryRow(ctx, ensureAppSessionCSRFToken, arg.SessionID, arg.CsrfToken)
+	var csrf_token *string
+	err := row.Scan(&csrf_token)
+	return csrf_token, err
+}
+
+const extendAppSession = `-- name: ExtendAppSession :exec
+UPDATE sessions
+SET expires_at = $2
+WHERE session_id = $1
+AND revoked = FALSE
+`
+
+type ExtendAppSessionParams struct {
+	SessionID string
+	ExpiresAt pgtype.Timestamptz
+}
+
+func (q *Queries) ExtendAppSession(ctx context.Context, arg ExtendAppSessionParams) error {
+	_, err := q.db.Exec(ctx, extendAppSession, arg.SessionID, arg.ExpiresAt)
+	return err
+}
+
 const getAppSession = `-- name: GetAppSession :one
-SELECT s.session_id, s.user_id, s.active_membership_id, s.created_at, s.expires_at, s.csrf_token, s.revoked
+SELECT s.session_id, s.user_id, s.active_membership_id, s.created_at, s.expires_at, s.csrf_token, s.revoked, s.user_agent, s.ip_address, s.last_seen_at, s.organization_id
 FROM sessions s
 WHERE s.session_id = $1
 AND s.revoked = FALSE
@@ -77,10 +124,59 @@ func (q *Queries) GetAppSession(ctx context.Context, sessionID string) (GetAppSe
 		&i.Session.ExpiresAt,
 		&i.Session.CsrfToken,
 		&i.Session.Revoked,
+		&i.Session.UserAgent,
+		&i.Session.IpAddress,
+		&i.Session.LastSeenAt,
+		&i.Session.OrganizationID,
 	)
 	return i, err
 }
 
+const listActiveAppSessionsByUser = `-- name: ListActiveAppSessionsByUser :many
+SELECT s.session_id, s.user_id, s.active_membership_id, s.created_at, s.expires_at, s.csrf_token, s.revoked, s.user_agent, s.ip_address, s.last_seen_at, s.organization_id
+FROM sessions s
+WHERE s.user_id = $1
+AND s.revoked = FALSE
+AND s.expires_at > NOW()
+ORDER BY s.last_seen_at DESC
+`
+
+type ListActiveAppSessionsByUserRow struct {
+	Session Session
+}
+
+func (q *Queries) ListActiveAppSessionsByUser(ctx context.Context, userID uuid.UUID) ([]ListActiveAppSessionsByUserRow, error) {
+	rows, err := q.db.Query(ctx, listActiveAppSessionsByUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListActiveAppSessionsByUserRow
+	for rows.Next() {
+		var i ListActiveAppSessionsByUserRow
+		if err := rows.Scan(
+			&i.Session.SessionID,
+			&i.Session.UserID,
+			&i.Session.ActiveMembershipID,
+			&i.Session.CreatedAt,
+			&i.Session.ExpiresAt,
+			&i.Session.CsrfToken,
+			&i.Session.Revoked,
+			&i.Session.UserAgent,
+			&i.Session.IpAddress,
+			&i.Session.LastSeenAt,
+			&i.Session.OrganizationID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const revokeAppSession = `-- name: RevokeAppSession :exec
 UPDATE sessions
 SET revoked = TRUE
@@ -91,3 +187,64 @@ func (q *Queries) RevokeAppSession(ctx context.Context, sessionID string) error
 	_, err := q.db.Exec(ctx, revokeAppSession, sessionID)
 	return err
 }
+
+const revokeAppSessionByUser = `-- name: RevokeAppSessionByUser :execrows
+UPDATE sessions
+SET revoked = TRUE
+WHERE session_id = $1
+AND user_id = $2
+AND revoked = FALSE
+`
+
+type RevokeAppSessionByUserParams struct {
+	SessionID string
+	UserID    uuid.UUID
+}
+
+func (q *Queries) RevokeAppSessionByUser(ctx context.Context, arg RevokeAppSessionByUserParams) (int64, error) {
+	result, err := q.db.Exec(ctx, revokeAppSessionByUser, arg.SessionID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const revokeOtherAppSessionsByUser = `-- name: RevokeOtherAppSessionsByUser :execrows
+UPDATE sessions
+SET revoked = TRUE
+WHERE user_id = $1
+AND session_id <> $2
+AND revoked = FALSE
+`
+
+type RevokeOtherAppSessionsByUserParams struct {
+	UserID    uuid.UUID
+	SessionID string
+}
+
+func (q *Queries) RevokeOtherAppSessionsByUser(ctx context.Context, arg RevokeOtherAppSessionsByUserParams) (int64, error) {
+	result, err := q.db.Exec(ctx, revokeOtherAppSessionsByUser, arg.UserID, arg.SessionID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const touchAppSession = `-- name: TouchAppSession :exec
+UPDATE sessions
+SET last_seen_at = NOW(),
+    user_agent = $2,
+    ip_address = $3
+WHERE session_id = $1
+`
+
+type TouchAppSessionParams struct {
+	SessionID string
+	UserAgent string
+	IpAddress string
+}
+
+func (q *Queries) TouchAppSession(ctx context.Context, arg TouchAppSessionParams) error {
+	_, err := q.db.Exec(ctx, touchAppSession, arg.SessionID, arg.UserAgent, arg.IpAddress)
+	return err
+}
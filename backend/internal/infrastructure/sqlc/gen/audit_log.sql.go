@@ -0,0 +1,282 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: audit_log.sql
+
+package gen
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createAuditLog = `-- name: CreateAuditLog :exec
+INSERT INTO audit_logs (
+    id,
+    organization_id,
+    actor_type,
+    actor_id,
+    procedure,
+    target_ids,
+    result_code,
+    request_id,
+    ip_address,
+    user_agent,
+    created_at,
+    seq,
+    prev_hash,
+    hash
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $8,
+    $9,
+    $10,
+    $11,
+    $12,
+    $13,
+    $14
+)
+`
+
+type CreateAuditLogParams struct {
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+	ActorType      string
+	ActorID        string
+	Procedure      string
+	TargetIds      []byte
+	ResultCode     string
+	RequestID      string
+	IpAddress      string
+	UserAgent      string
+	CreatedAt      pgtype.Timestamptz
+	Seq            int64
+	PrevHash       string
+	Hash           string
+}
+
+func (q *Queries) CreateAuditLog(ctx context.Context, arg CreateAuditLogParams) error {
+	_, err := q.db.Exec(ctx, createAuditLog,
+		arg.ID,
+		arg.OrganizationID,
+		arg.ActorType,
+		arg.ActorID,
+		arg.Procedure,
+		arg.TargetIds,
+		arg.ResultCode,
+		arg.RequestID,
+		arg.IpAddress,
+		arg.UserAgent,
+		arg.CreatedAt,
+		arg.Seq,
+		arg.PrevHash,
+		arg.Hash,
+	)
+	return err
+}
+
+const getAuditChainHead = `-- name: GetAuditChainHead :one
+SELECT a.seq, a.hash
+FROM audit_logs a
+WHERE a.organization_id = $1
+ORDER BY a.seq DESC
+LIMIT 1
+`
+
+type GetAuditChainHeadRow struct {
+	Seq  int64
+	Hash string
+}
+
+func (q *Queries) GetAuditChainHead(ctx context.Context, organizationID uuid.UUID) (GetAuditChainHeadRow, error) {
+	row := q.db.QueryRow(ctx, getAuditChainHead, organizationID)
+	var i GetAuditChainHeadRow
+	err := row.Scan(&i.Seq, &i.Hash)
+	return i, err
+}
+
+const listAuditLogOrganizationIDs = `-- name: ListAuditLogOrganizationIDs :many
+SELECT DISTINCT a.organization_id
+FROM audit_logs a
+ORDER BY a.organization_id
+`
+
+func (q *Queries) ListAuditLogOrganizationIDs(ctx context.Context) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, listAuditLogOrganizationIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var organization_id uuid.UUID
+		if err := rows.Scan(&organization_id); err != nil {
+			return nil, err
+		}
+		items = append(items, organization_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAuditLogsBySeq = `-- name: ListAuditLogsBySeq :many
+SELECT a.id, a.organization_id, a.actor_type, a.actor_id, a.procedure, a.target_ids, a.result_code, a.request_id, a.ip_address, a.user_agent, a.created_at, a.seq, a.prev_hash, a.hash
+FROM audit_logs a
+WHERE a.organization_id = $1
+AND a.seq > $2
+ORDER BY a.seq ASC
+LIMIT $3
+`
+
+type ListAuditLogsBySeqParams struct {
+	OrganizationID uuid.UUID
+	AfterSeq       int64
+	BatchSize      int32
+}
+
+type ListAuditLogsBySeqRow struct {
+	AuditLog AuditLog
+}
+
+func (q *Queries) ListAuditLogsBySeq(ctx context.Context, arg ListAuditLogsBySeqParams) ([]ListAuditLogsBySeqRow, error) {
+	rows, err := q.db.Query(ctx, listAuditLogsBySeq, arg.OrganizationID, arg.AfterSeq, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAuditLogsBySeqRow
+	for rows.Next() {
+		var i ListAuditLogsBySeqRow
+		if err := rows.Scan(
+			&i.AuditLog.ID,
+			&i.AuditLog.OrganizationID,
+			&i.AuditLog.ActorType,
+			&i.AuditLog.ActorID,
+			&i.AuditLog.Procedure,
+			&i.AuditLog.TargetIds,
+			&i.AuditLog.ResultCode,
+			&i.AuditLog.RequestID,
+			&i.AuditLog.IpAddress,
+			&i.AuditLog.UserAgent,
+			&i.AuditLog.CreatedAt,
+			&i.AuditLog.Seq,
+			&i.AuditLog.PrevHash,
+			&i.AuditLog.Hash,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockAuditChain = `-- name: LockAuditChain :exec
+SELECT pg_advisory_xact_lock(hashtextextended('audit_logs:' || $1::text, 0))
+`
+
+// 同じ組織の記録を同時に追記すると連鎖が分岐するため、トランザクションの終わりまで組織単位で排他する
+func (q *Queries) LockAuditChain(ctx context.Context, organizationID string) error {
+	_, err := q.db.Exec(ctx, lockAuditChain, organizationID)
+	return err
+}
+
+const searchAuditLogs = `-- name: SearchAuditLogs :many
+SELECT a.id, a.organization_id, a.actor_type, a.actor_id, a.procedure, a.target_ids, a.result_code, a.request_id, a.ip_address, a.user_agent, a.created_at, a.seq, a.prev_hash, a.hash
+FROM audit_logs a
+WHERE a.organization_id = $1
+AND ($2::text IS NULL OR a.actor_type = $2::text)
+AND ($3::text IS NULL OR a.actor_id = $3::text)
+AND ($4::text IS NULL OR a.procedure = $4::text)
+AND ($5::text IS NULL OR a.result_code = $5::text)
+AND (
+    $6::text IS NULL
+    OR jsonb_path_exists(a.target_ids, '$.* ? (@ == $id)', jsonb_build_object('id', $6::text))
+)
+AND ($7::timestamptz IS NULL OR a.created_at >= $7::timestamptz)
+AND ($8::timestamptz IS NULL OR a.created_at < $8::timestamptz)
+AND (
+    $9::timestamptz IS NULL
+    OR (a.created_at, a.id) < ($9::timestamptz, $10::uuid)
+)
+ORDER BY a.created_at DESC, a.id DESC
+LIMIT $11
+`
+
+type SearchAuditLogsParams struct {
+	OrganizationID  uuid.UUID
+	ActorType       *string
+	ActorID         *string
+	Procedure       *string
+	ResultCode      *string
+	TargetID        *string
+	Since           pgtype.Timestamptz
+	Until           pgtype.Timestamptz
+	CursorCreatedAt pgtype.Timestamptz
+	CursorID        *uuid.UUID
+	PageSize        int32
+}
+
+type SearchAuditLogsRow struct {
+	AuditLog AuditLog
+}
+
+// 新しい順に返す。カーソルを指定した場合はその記録より古いものだけを返す
+func (q *Queries) SearchAuditLogs(ctx context.Context, arg SearchAuditLogsParams) ([]SearchAuditLogsRow, error) {
+	rows, err := q.db.Query(ctx, searchAuditLogs,
+		arg.OrganizationID,
+		arg.ActorType,
+		arg.ActorID,
+		arg.Procedure,
+		arg.ResultCode,
+		arg.TargetID,
+		arg.Since,
+		arg.Until,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchAuditLogsRow
+	for rows.Next() {
+		var i SearchAuditLogsRow
+		if err := rows.Scan(
+			&i.AuditLog.ID,
+			&i.AuditLog.OrganizationID,
+			&i.AuditLog.ActorType,
+			&i.AuditLog.ActorID,
+			&i.AuditLog.Procedure,
+			&i.AuditLog.TargetIds,
+			&i.AuditLog.ResultCode,
+			&i.AuditLog.RequestID,
+			&i.AuditLog.IpAddress,
+			&i.AuditLog.UserAgent,
+			&i.AuditLog.CreatedAt,
+			&i.AuditLog.Seq,
+			&i.AuditLog.PrevHash,
+			&i.AuditLog.Hash,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
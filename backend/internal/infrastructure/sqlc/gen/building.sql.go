@@ -0,0 +1,350 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: building.sql
+
+package gen
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const countFloorsByBuilding = `-- name: CountFloorsByBuilding :one
+SELECT COUNT(*)::INT
+FROM floors f
+WHERE f.building_id = $1
+`
+
+func (q *Queries) CountFloorsByBuilding(ctx context.Context, buildingID uuid.UUID) (int32, error) {
+	row := q.db.QueryRow(ctx, countFloorsByBuilding, buildingID)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const countRoomsByFloor = `-- name: CountRoomsByFloor :one
+SELECT COUNT(*)::INT
+FROM rooms r
+WHERE r.floor_id = $1
+`
+
+func (q *Queries) CountRoomsByFloor(ctx context.Context, floorID uuid.UUID) (int32, error) {
+	row := q.db.QueryRow(ctx, countRoomsByFloor, floorID)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const createBuilding = `-- name: CreateBuilding :exec
+INSERT INTO buildings(
+    id,
+    organization_id,
+    name,
+    description
+)
+VALUES(
+    $1,
+    $2,
+    $3,
+    $4
+)
+`
+
+type CreateBuildingParams struct {
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+	Name           string
+	Description    string
+}
+
+func (q *Queries) CreateBuilding(ctx context.Context, arg CreateBuildingParams) error {
+	_, err := q.db.Exec(ctx, createBuilding,
+		arg.ID,
+		arg.OrganizationID,
+		arg.Name,
+		arg.Description,
+	)
+	return err
+}
+
+const createFloor = `-- name: CreateFloor :exec
+INSERT INTO floors(
+    id,
+    building_id,
+    organization_id,
+    name,
+    level
+)
+VALUES(
+    $1,
+    $2,
+    $3,
+    $4,
+    $5
+)
+`
+
+type CreateFloorParams struct {
+	ID             uuid.UUID
+	BuildingID     uuid.UUID
+	OrganizationID uuid.UUID
+	Name           string
+	Level          int32
+}
+
+func (q *Queries) CreateFloor(ctx context.Context, arg CreateFloorParams) error {
+	_, err := q.db.Exec(ctx, createFloor,
+		arg.ID,
+		arg.BuildingID,
+		arg.OrganizationID,
+		arg.Name,
+		arg.Level,
+	)
+	return err
+}
+
+const deleteBuilding = `-- name: DeleteBuilding :execrows
+DELETE FROM buildings
+WHERE id = $1
+`
+
+func (q *Queries) DeleteBuilding(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteBuilding, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteFloor = `-- name: DeleteFloor :execrows
+DELETE FROM floors
+WHERE id = $1
+`
+
+func (q *Queries) DeleteFloor(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteFloor, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getBuilding = `-- name: GetBuilding :one
+SELECT b.id, b.organization_id, b.name, b.description, b.created_at, b.updated_at
+FROM buildings b
+WHERE b.id = $1
+`
+
+type GetBuildingRow struct {
+	Building Building
+}
+
+func (q *Queries) GetBuilding(ctx context.Context, id uuid.UUID) (GetBuildingRow, error) {
+	row := q.db.QueryRow(ctx, getBuilding, id)
+	var i GetBuildingRow
+	err := row.Scan(
+		&i.Building.ID,
+		&i.Building.OrganizationID,
+		&i.Building.Name,
+		&i.Building.Description,
+		&i.Building.CreatedAt,
+		&i.Building.UpdatedAt,
+	)
+	return i, err
+}
+
+const getBuildingByOrganizationAndName = `-- name: GetBuildingByOrganizationAndName :one
+SELECT b.id, b.organization_id, b.name, b.description, b.created_at, b.updated_at
+FROM buildings b
+WHERE b.organization_id = $1 AND b.name = $2
+`
+
+type GetBuildingByOrganizationAndNameParams struct {
+	OrganizationID uuid.UUID
+	Name           string
+}
+
+type GetBuildingByOrganizationAndNameRow struct {
+	Building Building
+}
+
+func (q *Queries) GetBuildingByOrganizationAndName(ctx context.Context, arg GetBuildingByOrganizationAndNameParams) (GetBuildingByOrganizationAndNameRow, error) {
+	row := q.db.QueryRow(ctx, getBuildingByOrganizationAndName, arg.OrganizationID, arg.Name)
+	var i GetBuildingByOrganizationAndNameRow
+	err := row.Scan(
+		&i.Building.ID,
+		&i.Building.OrganizationID,
+		&i.Building.Name,
+		&i.Building.Description,
+		&i.Building.CreatedAt,
+		&i.Building.UpdatedAt,
+	)
+	return i, err
+}
+
+const getFloor = `-- name: GetFloor :one
+SELECT f.id, f.building_id, f.organization_id, f.name, f.level, f.created_at, f.updated_at
+FROM floors f
+WHERE f.id = $1
+`
+
+type GetFloorRow struct {
+	Floor Floor
+}
+
+func (q *Queries) GetFloor(ctx context.Context, id uuid.UUID) (GetFloorRow, error) {
+	row := q.db.QueryRow(ctx, getFloor, id)
+	var i GetFloorRow
+	err := row.Scan(
+		&i.Floor.ID,
+		&i.Floor.BuildingID,
+		&i.Floor.OrganizationID,
+		&i.Floor.Name,
+		&i.Floor.Level,
+		&i.Floor.CreatedAt,
+		&i.Floor.UpdatedAt,
+	)
+	return i, err
+}
+
+const getFloorByBuildingAndName = `-- name: GetFloorByBuildingAndName :one
+SELECT f.id, f.building_id, f.organization_id, f.name, f.level, f.created_at, f.updated_at
+FROM floors f
+WHERE f.building_id = $1 AND f.name = $2
+`
+
+type GetFloorByBuildingAndNameParams struct {
+	BuildingID uuid.UUID
+	Name       string
+}
+
+type GetFloorByBuildingAndNameRow struct {
+	Floor Floor
+}
+
+func (q *Queries) GetFloorByBuildingAndName(ctx context.Context, arg GetFloorByBuildingAndNameParams) (GetFloorByBuildingAndNameRow, error) {
+	row := q.db.QueryRow(ctx, getFloorByBuildingAndName, arg.BuildingID, arg.Name)
+	var i GetFloorByBuildingAndNameRow
+	err := row.Scan(
+		&i.Floor.ID,
+		&i.Floor.BuildingID,
+		&i.Floor.OrganizationID,
+		&i.Floor.Name,
+		&i.Floor.Level,
+		&i.Floor.CreatedAt,
+		&i.Floor.UpdatedAt,
+	)
+	return i, err
+}
+
+const listBuildings = `-- name: ListBuildings :many
+SELECT b.id, b.organization_id, b.name, b.description, b.created_at, b.updated_at
+FROM buildings b
+ORDER BY b.name, b.id
+`
+
+type ListBuildingsRow struct {
+	Building Building
+}
+
+func (q *Queries) ListBuildings(ctx context.Context) ([]ListBuildingsRow, error) {
+	rows, err := q.db.Query(ctx, listBuildings)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListBuildingsRow
+	for rows.Next() {
+		var i ListBuildingsRow
+		if err := rows.Scan(
+			&i.Building.ID,
+			&i.Building.OrganizationID,
+			&i.Building.Name,
+			&i.Building.Description,
+			&i.Building.CreatedAt,
+			&i.Building.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listFloors = `-- name: ListFloors :many
+SELECT f.id, f.building_id, f.organization_id, f.name, f.level, f.created_at, f.updated_at
+FROM floors f
+ORDER BY f.building_id, f.level, f.name
+`
+
+type ListFloorsRow struct {
+	Floor Floor
+}
+
+// 建物ごとに level の昇順（地下から上の階）で返す
+func (q *Queries) ListFloors(ctx context.Context) ([]ListFloorsRow, error) {
+	rows, err := q.db.Query(ctx, listFloors)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListFloorsRow
+	for rows.Next() {
+		var i ListFloorsRow
+		if err := rows.Scan(
+			&i.Floor.ID,
+			&i.Floor.BuildingID,
+			&i.Floor.OrganizationID,
+			&i.Floor.Name,
+			&i.Floor.Level,
+			&i.Floor.CreatedAt,
+			&i.Floor.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateBuilding = `-- name: UpdateBuilding :exec
+UPDATE buildings
+SET name = $1, description = $2
+WHERE id = $3
+`
+
+type UpdateBuildingParams struct {
+	Name        string
+	Description string
+	ID          uuid.UUID
+}
+
+func (q *Queries) UpdateBuilding(ctx context.Context, arg UpdateBuildingParams) error {
+	_, err := q.db.Exec(ctx, updateBuilding, arg.Name, arg.Description, arg.ID)
+	return err
+}
+
+const updateFloor = `-- name: UpdateFloor :exec
+UPDATE floors
+SET name = $1, level = $2
+WHERE id = $3
+`
+
+type UpdateFloorParams struct {
+	Name  string
+	Level int32
+	ID    uuid.UUID
+}
+
+func (q *Queries) UpdateFloor(ctx context.Context, arg UpdateFloorParams) error {
+	_, err := q.db.Exec(ctx, updateFloor, arg.Name, arg.Level, arg.ID)
+	return err
+}
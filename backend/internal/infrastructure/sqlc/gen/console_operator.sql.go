@@ -0,0 +1,139 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: console_operator.sql
+
+package gen
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createConsoleOperator = `-- name: CreateConsoleOperator :exec
+INSERT INTO console_operators (
+    id,
+    organization_id,
+    name,
+    role,
+    key_hash,
+    created_at
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6
+)
+`
+
+type CreateConsoleOperatorParams struct {
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+	Name           string
+	Role           string
+	KeyHash        string
+	CreatedAt      pgtype.Timestamptz
+}
+
+func (q *Queries) CreateConsoleOperator(ctx context.Context, arg CreateConsoleOperatorParams) error {
+	_, err := q.db.Exec(ctx, createConsoleOperator,
+		arg.ID,
+		arg.OrganizationID,
+		arg.Name,
+		arg.Role,
+		arg.KeyHash,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const getConsoleOperatorByKeyHash = `-- name: GetConsoleOperatorByKeyHash :one
+SELECT o.id, o.organization_id, o.name, o.role, o.key_hash, o.created_at, o.revoked_at
+FROM console_operators o
+WHERE o.key_hash = $1
+AND o.revoked_at IS NULL
+`
+
+type GetConsoleOperatorByKeyHashRow struct {
+	ConsoleOperator ConsoleOperator
+}
+
+func (q *Queries) GetConsoleOperatorByKeyHash(ctx context.Context, keyHash string) (GetConsoleOperatorByKeyHashRow, error) {
+	row := q.db.QueryRow(ctx, getConsoleOperatorByKeyHash, keyHash)
+	var i GetConsoleOperatorByKeyHashRow
+	err := row.Scan(
+		&i.ConsoleOperator.ID,
+		&i.ConsoleOperator.OrganizationID,
+		&i.ConsoleOperator.Name,
+		&i.ConsoleOperator.Role,
+		&i.ConsoleOperator.KeyHash,
+		&i.ConsoleOperator.CreatedAt,
+		&i.ConsoleOperator.RevokedAt,
+	)
+	return i, err
+}
+
+const listConsoleOperatorsByOrganization = `-- name: ListConsoleOperatorsByOrganization :many
+SELECT o.id, o.organization_id, o.name, o.role, o.key_hash, o.created_at, o.revoked_at
+FROM console_operators o
+WHERE o.organization_id = $1
+AND o.revoked_at IS NULL
+ORDER BY o.created_at DESC
+`
+
+type ListConsoleOperatorsByOrganizationRow struct {
+	ConsoleOperator ConsoleOperator
+}
+
+func (q *Queries) ListConsoleOperatorsByOrganization(ctx context.Context, organizationID uuid.UUID) ([]ListConsoleOperatorsByOrganizationRow, error) {
+	rows, err := q.db.Query(ctx, listConsoleOperatorsByOrganization, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListConsoleOperatorsByOrganizationRow
+	for rows.Next() {
+		var i ListConsoleOperatorsByOrganizationRow
+		if err := rows.Scan(
+			&i.ConsoleOperator.ID,
+			&i.ConsoleOperator.OrganizationID,
+			&i.ConsoleOperator.Name,
+			&i.ConsoleOperator.Role,
+			&i.ConsoleOperator.KeyHash,
+			&i.ConsoleOperator.CreatedAt,
+			&i.ConsoleOperator.RevokedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const revokeConsoleOperator = `-- name: RevokeConsoleOperator :execrows
+UPDATE console_operators
+SET revoked_at = NOW()
+WHERE id = $1
+AND organization_id = $2
+AND revoked_at IS NULL
+`
+
+type RevokeConsoleOperatorParams struct {
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+}
+
+func (q *Queries) RevokeConsoleOperator(ctx context.Context, arg RevokeConsoleOperatorParams) (int64, error) {
+	result, err := q.db.Exec(ctx, revokeConsoleOperator, arg.ID, arg.OrganizationID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
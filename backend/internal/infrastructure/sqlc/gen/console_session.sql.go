@@ -9,6 +9,7 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const cleanupExpiredConsoleSessions = `-- name: CleanupExpiredConsoleSessions :exec
@@ -25,20 +26,39 @@ const createConsoleSession = `-- name: CreateConsoleSession :exec
 INSERT INTO console_sessions (
     session_id,
     organization_id,
+    role,
+    operator_id,
     created_at,
-    expires_at
+    expires_at,
+    user_agent,
+    ip_address
 ) VALUES (
-    $1, $2, NOW(), NOW() + INTERVAL '24 hours'
+    $1, $2, $3, $4, $5, $6, $7, $8
 )
 `
 
 type CreateConsoleSessionParams struct {
 	SessionID      string
 	OrganizationID uuid.UUID
+	Role           string
+	OperatorID     *uuid.UUID
+	CreatedAt      pgtype.Timestamptz
+	ExpiresAt      pgtype.Timestamptz
+	UserAgent      string
+	IpAddress      string
 }
 
 func (q *Queries) CreateConsoleSession(ctx context.Context, arg CreateConsoleSessionParams) error {
-	_, err := q.db.Exec(ctx, createConsoleSession, arg.SessionID, arg.OrganizationID)
+	_, err := q.db.Exec(ctx, createConsoleSession,
+		arg.SessionID,
+		arg.OrganizationID,
+		arg.Role,
+		arg.OperatorID,
+		arg.CreatedAt,
+		arg.ExpiresAt,
+		arg.UserAgent,
+		arg.IpAddress,
+	)
 	return err
 }
 
@@ -52,8 +72,88 @@ func (q *Queries) DeleteConsoleSession(ctx context.Context, sessionID string) er
 	return err
 }
 
+const deleteConsoleSessionByOrganization = `-- name: DeleteConsoleSessionByOrganization :execrows
+DELETE FROM console_sessions
+WHERE session_id = $1
+AND organization_id = $2
+`
+
+type DeleteConsoleSessionByOrganizationParams struct {
+	SessionID      string
+	OrganizationID uuid.UUID
+}
+
+func (q *Queries) DeleteConsoleSessionByOrganization(ctx context.Context, arg DeleteConsoleSessionByOrganizationParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteConsoleSessionByOrganization, arg.SessionID, arg.OrganizationID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteConsoleSessionsByOperator = `-- name: DeleteConsoleSessionsByOperator :execrows
+DELETE FROM console_sessions
+WHERE operator_id = $1
+`
+
+func (q *Queries) DeleteConsoleSessionsByOperator(ctx context.Context, operatorID *uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteConsoleSessionsByOperator, operatorID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteConsoleSessionsByOrganization = `-- name: DeleteConsoleSessionsByOrganization :execrows
+DELETE FROM console_sessions
+WHERE organization_id = $1
+`
+
+func (q *Queries) DeleteConsoleSessionsByOrganization(ctx context.Context, organizationID uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteConsoleSessionsByOrganization, organizationID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteOtherConsoleSessionsByOrganization = `-- name: DeleteOtherConsoleSessionsByOrganization :execrows
+DELETE FROM console_sessions
+WHERE organization_id = $1
+AND session_id <> $2
+`
+
+type DeleteOtherConsoleSessionsByOrganizationParams struct {
+	OrganizationID uuid.UUID
+	SessionID      string
+}
+
+func (q *Queries) DeleteOtherConsoleSessionsByOrganization(ctx context.Context, arg DeleteOtherConsoleSessionsByOrganizationParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteOtherConsoleSessionsByOrganization, arg.OrganizationID, arg.SessionID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const extendConsoleSession = `-- name: ExtendConsoleSession :exec
+UPDATE console_sessions
+SET expires_at = $2
+WHERE session_id = $1
+`
+
+type ExtendConsoleSessionParams struct {
+	SessionID string
+	ExpiresAt pgtype.Timestamptz
+}
+
+func (q *Queries) ExtendConsoleSession(ctx context.Context, arg ExtendConsoleSessionParams) error {
+	_, err := q.db.Exec(ctx, extendConsoleSession, arg.SessionID, arg.ExpiresAt)
+	return err
+}
+
 const getConsoleSession = `-- name: GetConsoleSession :one
-SELECT cs.session_id, cs.organization_id, cs.created_at, cs.expires_at
+SELECT cs.session_id, cs.organization_id, cs.created_at, cs.expires_at, cs.user_agent, cs.ip_address, cs.last_seen_at, cs.role, cs.operator_id
 FROM console_sessions cs
 WHERE cs.session_id = $1
 AND cs.expires_at > NOW()
@@ -71,6 +171,72 @@ func (q *Queries) GetConsoleSession(ctx context.Context, sessionID string) (GetC
 		&i.ConsoleSession.OrganizationID,
 		&i.ConsoleSession.CreatedAt,
 		&i.ConsoleSession.ExpiresAt,
+		&i.ConsoleSession.UserAgent,
+		&i.ConsoleSession.IpAddress,
+		&i.ConsoleSession.LastSeenAt,
+		&i.ConsoleSession.Role,
+		&i.ConsoleSession.OperatorID,
 	)
 	return i, err
 }
+
+const listActiveConsoleSessionsByOrganization = `-- name: ListActiveConsoleSessionsByOrganization :many
+SELECT cs.session_id, cs.organization_id, cs.created_at, cs.expires_at, cs.user_agent, cs.ip_address, cs.last_seen_at, cs.role, cs.operator_id
+FROM console_sessions cs
+WHERE cs.organization_id = $1
+AND cs.expires_at > NOW()
+ORDER BY cs.last_seen_at DESC
+`
+
+type ListActiveConsoleSessionsByOrganizationRow struct {
+	ConsoleSession ConsoleSession
+}
+
+func (q *Queries) ListActiveConsoleSessionsByOrganization(ctx context.Context, organizationID uuid.UUID) ([]ListActiveConsoleSessionsByOrganizationRow, error) {
+	rows, err := q.db.Query(ctx, listActiveConsoleSessionsByOrganization, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListActiveConsoleSessionsByOrganizationRow
+	for rows.Next() {
+		var i ListActiveConsoleSessionsByOrganizationRow
+		if err := rows.Scan(
+			&i.ConsoleSession.SessionID,
+			&i.ConsoleSession.OrganizationID,
+			&i.ConsoleSession.CreatedAt,
+			&i.ConsoleSession.ExpiresAt,
+			&i.ConsoleSession.UserAgent,
+			&i.ConsoleSession.IpAddress,
+			&i.ConsoleSession.LastSeenAt,
+			&i.ConsoleSession.Role,
+			&i.ConsoleSession.OperatorID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const touchConsoleSession = `-- name: TouchConsoleSession :exec
+UPDATE console_sessions
+SET last_seen_at = NOW(),
+    user_agent = $2,
+    ip_address = $3
+WHERE session_id = $1
+`
+
+type TouchConsoleSessionParams struct {
+	SessionID string
+	UserAgent string
+	IpAddress string
+}
+
+func (q *Queries) TouchConsoleSession(ctx context.Context, arg TouchConsoleSessionParams) error {
+	_, err := q.db.Exec(ctx, touchConsoleSession, arg.SessionID, arg.UserAgent, arg.IpAddress)
+	return err
+}
@@ -9,6 +9,7 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const createKey = `-- name: CreateKey :exec
@@ -47,8 +48,74 @@ func (q *Queries) CreateKey(ctx context.Context, arg CreateKeyParams) error {
 	return err
 }
 
+const getKeyById = `-- name: GetKeyById :one
+SELECT k.id, k.room_id, k.organization_id, k.key_number, k.status, k.created_at, k.updated_at, k.version
+FROM keys k
+WHERE k.id = $1
+`
+
+type GetKeyByIdRow struct {
+	Key Key
+}
+
+func (q *Queries) GetKeyById(ctx context.Context, id uuid.UUID) (GetKeyByIdRow, error) {
+	row := q.db.QueryRow(ctx, getKeyById, id)
+	var i GetKeyByIdRow
+	err := row.Scan(
+		&i.Key.ID,
+		&i.Key.RoomID,
+		&i.Key.OrganizationID,
+		&i.Key.KeyNumber,
+		&i.Key.Status,
+		&i.Key.CreatedAt,
+		&i.Key.UpdatedAt,
+		&i.Key.Version,
+	)
+	return i, err
+}
+
+const getKeysByOrganization = `-- name: GetKeysByOrganization :many
+SELECT k.id, k.room_id, k.organization_id, k.key_number, k.status, k.created_at, k.updated_at, k.version
+FROM keys k
+WHERE k.organization_id = $1
+ORDER BY k.created_at DESC
+`
+
+type GetKeysByOrganizationRow struct {
+	Key Key
+}
+
+func (q *Queries) GetKeysByOrganization(ctx context.Context, organizationID uuid.UUID) ([]GetKeysByOrganizationRow, error) {
+	rows, err := q.db.Query(ctx, getKeysByOrganization, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetKeysByOrganizationRow
+	for rows.Next() {
+		var i GetKeysByOrganizationRow
+		if err := rows.Scan(
+			&i.Key.ID,
+			&i.Key.RoomID,
+			&i.Key.OrganizationID,
+			&i.Key.KeyNumber,
+			&i.Key.Status,
+			&i.Key.CreatedAt,
+			&i.Key.UpdatedAt,
+			&i.Key.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getKeysByRoom = `-- name: GetKeysByRoom :many
-SELECT k.id, k.room_id, k.organization_id, k.key_number, k.status, k.created_at, k.updated_at
+SELECT k.id, k.room_id, k.organization_id, k.key_number, k.status, k.created_at, k.updated_at, k.version
 FROM keys k
 WHERE k.room_id = $1
 ORDER BY k.created_at DESC
@@ -75,6 +142,7 @@ func (q *Queries) GetKeysByRoom(ctx context.Context, roomID uuid.UUID) ([]GetKey
 			&i.Key.Status,
 			&i.Key.CreatedAt,
 			&i.Key.UpdatedAt,
+			&i.Key.Version,
 		); err != nil {
 			return nil, err
 		}
@@ -85,3 +153,98 @@ func (q *Queries) GetKeysByRoom(ctx context.Context, roomID uuid.UUID) ([]GetKey
 	}
 	return items, nil
 }
+
+const listKeysByRoom = `-- name: ListKeysByRoom :many
+SELECT k.id, k.room_id, k.organization_id, k.key_number, k.status, k.created_at, k.updated_at, k.version
+FROM keys k
+WHERE k.room_id = $1
+AND ($2::text IS NULL OR k.status = $2::text)
+AND ($3::text IS NULL OR starts_with(k.key_number, $3::text))
+AND (
+    $4::uuid IS NULL
+    OR ($5::text = 'name' AND (k.key_number, k.id) > ($6::text, $4::uuid))
+    OR ($5::text <> 'name' AND (k.created_at, k.id) < ($7::timestamptz, $4::uuid))
+)
+ORDER BY
+    CASE WHEN $5::text = 'name' THEN k.key_number END ASC,
+    CASE WHEN $5::text = 'name' THEN k.id END ASC,
+    k.created_at DESC,
+    k.id DESC
+LIMIT $8
+`
+
+type ListKeysByRoomParams struct {
+	RoomID          uuid.UUID
+	Status          *string
+	KeyNumberPrefix *string
+	CursorID        *uuid.UUID
+	OrderBy         string
+	CursorName      *string
+	CursorCreatedAt pgtype.Timestamptz
+	PageSize        int32
+}
+
+type ListKeysByRoomRow struct {
+	Key Key
+}
+
+// order_by が name の場合は鍵番号の昇順、それ以外は新しい順に返す。カーソルを指定した場合はその鍵より後ろだけを返す
+func (q *Queries) ListKeysByRoom(ctx context.Context, arg ListKeysByRoomParams) ([]ListKeysByRoomRow, error) {
+	rows, err := q.db.Query(ctx, listKeysByRoom,
+		arg.RoomID,
+		arg.Status,
+		arg.KeyNumberPrefix,
+		arg.CursorID,
+		arg.OrderBy,
+		arg.CursorName,
+		arg.CursorCreatedAt,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListKeysByRoomRow
+	for rows.Next() {
+		var i ListKeysByRoomRow
+		if err := rows.Scan(
+			&i.Key.ID,
+			&i.Key.RoomID,
+			&i.Key.OrganizationID,
+			&i.Key.KeyNumber,
+			&i.Key.Status,
+			&i.Key.CreatedAt,
+			&i.Key.UpdatedAt,
+			&i.Key.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateKeyNumber = `-- name: UpdateKeyNumber :one
+UPDATE keys
+SET key_number = $1
+WHERE id = $2
+AND version = $3
+RETURNING version
+`
+
+type UpdateKeyNumberParams struct {
+	KeyNumber string
+	ID        uuid.UUID
+	Version   int64
+}
+
+// 取得時から版数が変わっていないときだけ更新し、更新後の版数を返す。版数はトリガーで増やす
+func (q *Queries) UpdateKeyNumber(ctx context.Context, arg UpdateKeyNumberParams) (int64, error) {
+	row := q.db.QueryRow(ctx, updateKeyNumber, arg.KeyNumber, arg.ID, arg.Version)
+	var version int64
+	err := row.Scan(&version)
+	return version, err
+}
@@ -9,11 +9,76 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type ApiToken struct {
+	ID             uuid.UUID
+	UserID         *uuid.UUID
+	OrganizationID uuid.UUID
+	Name           string
+	TokenPrefix    string
+	TokenHash      string
+	Scopes         []string
+	ExpiresAt      pgtype.Timestamptz
+	LastUsedAt     pgtype.Timestamptz
+	RevokedAt      pgtype.Timestamptz
+	CreatedAt      pgtype.Timestamptz
+}
+
+type AuditLog struct {
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+	ActorType      string
+	ActorID        string
+	Procedure      string
+	TargetIds      []byte
+	ResultCode     string
+	RequestID      string
+	IpAddress      string
+	UserAgent      string
+	CreatedAt      pgtype.Timestamptz
+	Seq            int64
+	PrevHash       string
+	Hash           string
+}
+
+type Building struct {
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+	Name           string
+	Description    string
+	CreatedAt      pgtype.Timestamptz
+	UpdatedAt      pgtype.Timestamptz
+}
+
+type ConsoleOperator struct {
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+	Name           string
+	Role           string
+	KeyHash        string
+	CreatedAt      pgtype.Timestamptz
+	RevokedAt      pgtype.Timestamptz
+}
+
 type ConsoleSession struct {
 	SessionID      string
 	OrganizationID uuid.UUID
 	CreatedAt      pgtype.Timestamptz
 	ExpiresAt      pgtype.Timestamptz
+	UserAgent      string
+	IpAddress      string
+	LastSeenAt     pgtype.Timestamptz
+	Role           string
+	OperatorID     *uuid.UUID
+}
+
+type Floor struct {
+	ID             uuid.UUID
+	BuildingID     uuid.UUID
+	OrganizationID uuid.UUID
+	Name           string
+	Level          int32
+	CreatedAt      pgtype.Timestamptz
+	UpdatedAt      pgtype.Timestamptz
 }
 
 type Key struct {
@@ -24,14 +89,70 @@ type Key struct {
 	Status         string
 	CreatedAt      pgtype.Timestamptz
 	UpdatedAt      pgtype.Timestamptz
+	Version        int64
+}
+
+type NotificationDelivery struct {
+	DedupKey       string
+	OrganizationID uuid.UUID
+	UserID         uuid.UUID
+	Kind           string
+	CreatedAt      pgtype.Timestamptz
+}
+
+type NotificationPreference struct {
+	UserID        uuid.UUID
+	Locale        string
+	DisabledKinds []string
+	UpdatedAt     pgtype.Timestamptz
 }
 
 type OauthState struct {
-	State        string
-	CodeVerifier string
-	Nonce        string
-	CreatedAt    pgtype.Timestamptz
-	ConsumedAt   pgtype.Timestamptz
+	State          string
+	CodeVerifier   string
+	Nonce          string
+	CreatedAt      pgtype.Timestamptz
+	ConsumedAt     pgtype.Timestamptz
+	OrganizationID uuid.UUID
+}
+
+type Organization struct {
+	ID        uuid.UUID
+	Name      string
+	Slug      string
+	Settings  []byte
+	KeyHash   *string
+	CreatedAt pgtype.Timestamptz
+	UpdatedAt pgtype.Timestamptz
+}
+
+type OutboxEvent struct {
+	ID                uuid.UUID
+	OrganizationID    uuid.UUID
+	EventType         string
+	Payload           []byte
+	OccurredAt        pgtype.Timestamptz
+	Status            string
+	Attempts          int32
+	NextAttemptAt     pgtype.Timestamptz
+	LastError         string
+	DeliveredAt       pgtype.Timestamptz
+	CompletedHandlers []string
+}
+
+type RateLimitBucket struct {
+	Key       string
+	Tokens    float64
+	UpdatedAt pgtype.Timestamptz
+	IdleAt    pgtype.Timestamptz
+}
+
+type RateLimitFailure struct {
+	Key             string
+	FailureCount    int32
+	WindowStartedAt pgtype.Timestamptz
+	LockedUntil     pgtype.Timestamptz
+	IdleAt          pgtype.Timestamptz
 }
 
 type Room struct {
@@ -44,16 +165,45 @@ type Room struct {
 	Description    string
 	CreatedAt      pgtype.Timestamptz
 	UpdatedAt      pgtype.Timestamptz
+	FloorID        uuid.UUID
+	Capacity       int32
+	Equipment      []string
+	Accessibility  []string
+	CustomFields   []byte
+	Version        int64
 }
 
 type RoomAssignment struct {
-	ID         uuid.UUID
-	TenantID   uuid.UUID
-	RoomID     uuid.UUID
-	AssignedAt pgtype.Timestamptz
-	ExpiresAt  pgtype.Timestamptz
-	CreatedAt  pgtype.Timestamptz
-	UpdatedAt  pgtype.Timestamptz
+	ID             uuid.UUID
+	TenantID       uuid.UUID
+	RoomID         uuid.UUID
+	AssignedAt     pgtype.Timestamptz
+	ExpiresAt      pgtype.Timestamptz
+	CreatedAt      pgtype.Timestamptz
+	UpdatedAt      pgtype.Timestamptz
+	GroupID        *uuid.UUID
+	KeyLoanGroupID *uuid.UUID
+}
+
+type RoomCustomField struct {
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+	Key            string
+	Label          string
+	FieldType      string
+	Required       bool
+	Options        []string
+	CreatedAt      pgtype.Timestamptz
+	UpdatedAt      pgtype.Timestamptz
+}
+
+type RoomType struct {
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+	Key            string
+	Label          string
+	CreatedAt      pgtype.Timestamptz
+	UpdatedAt      pgtype.Timestamptz
 }
 
 type Session struct {
@@ -64,6 +214,10 @@ type Session struct {
 	ExpiresAt          pgtype.Timestamptz
 	CsrfToken          *string
 	Revoked            bool
+	UserAgent          string
+	IpAddress          string
+	LastSeenAt         pgtype.Timestamptz
+	OrganizationID     uuid.UUID
 }
 
 type Tenant struct {
@@ -74,18 +228,38 @@ type Tenant struct {
 	TenantType     string
 	CreatedAt      pgtype.Timestamptz
 	UpdatedAt      pgtype.Timestamptz
+	ArchivedAt     pgtype.Timestamptz
+	Version        int64
 }
 
-type TenantJoinCode struct {
-	ID        uuid.UUID
-	TenantID  uuid.UUID
-	Code      string
-	ExpiresAt pgtype.Timestamptz
-	MaxUses   int32
-	UsedCount int32
+type TenantGroup struct {
+	ID             uuid.UUID
+	TenantID       uuid.UUID
+	OrganizationID uuid.UUID
+	ParentGroupID  *uuid.UUID
+	Name           string
+	Description    string
+	CreatedAt      pgtype.Timestamptz
+	UpdatedAt      pgtype.Timestamptz
+}
+
+type TenantGroupMembership struct {
+	GroupID   uuid.UUID
+	UserID    uuid.UUID
 	CreatedAt pgtype.Timestamptz
 }
 
+type TenantJoinCode struct {
+	ID             uuid.UUID
+	TenantID       uuid.UUID
+	Code           string
+	ExpiresAt      pgtype.Timestamptz
+	MaxUses        int32
+	UsedCount      int32
+	CreatedAt      pgtype.Timestamptz
+	OrganizationID uuid.UUID
+}
+
 type TenantMembership struct {
 	ID        uuid.UUID
 	TenantID  uuid.UUID
@@ -95,6 +269,15 @@ type TenantMembership struct {
 	LeftAt    pgtype.Timestamptz
 }
 
+type TenantType struct {
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+	Key            string
+	Label          string
+	CreatedAt      pgtype.Timestamptz
+	UpdatedAt      pgtype.Timestamptz
+}
+
 type User struct {
 	ID        uuid.UUID
 	Email     string
@@ -112,3 +295,51 @@ type UserIdentity struct {
 	CreatedAt   pgtype.Timestamptz
 	UpdatedAt   pgtype.Timestamptz
 }
+
+type WebauthnCeremony struct {
+	ID           string
+	CeremonyType string
+	UserID       *uuid.UUID
+	SessionData  []byte
+	ExpiresAt    pgtype.Timestamptz
+	CreatedAt    pgtype.Timestamptz
+}
+
+type WebauthnCredential struct {
+	ID              uuid.UUID
+	UserID          uuid.UUID
+	Name            string
+	CredentialID    []byte
+	PublicKey       []byte
+	AttestationType string
+	Aaguid          []byte
+	SignCount       int64
+	Transports      []string
+	BackupEligible  bool
+	BackupState     bool
+	LastUsedAt      pgtype.Timestamptz
+	CreatedAt       pgtype.Timestamptz
+}
+
+type WebhookDelivery struct {
+	ID             uuid.UUID
+	SubscriptionID uuid.UUID
+	OrganizationID uuid.UUID
+	EventID        uuid.UUID
+	EventType      string
+	StatusCode     int32
+	Error          string
+	Succeeded      bool
+	DurationMs     int32
+	CreatedAt      pgtype.Timestamptz
+}
+
+type WebhookSubscription struct {
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+	Url            string
+	EventTypes     []string
+	Secret         string
+	Description    string
+	CreatedAt      pgtype.Timestamptz
+}
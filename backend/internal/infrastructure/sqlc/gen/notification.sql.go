@@ -0,0 +1,174 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: notification.sql
+
+package gen
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const claimNotificationDelivery = `-- name: ClaimNotificationDelivery :execrows
+INSERT INTO notification_deliveries (
+    dedup_key,
+    organization_id,
+    user_id,
+    kind,
+    created_at
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5
+)
+ON CONFLICT (dedup_key) DO NOTHING
+`
+
+type ClaimNotificationDeliveryParams struct {
+	DedupKey       string
+	OrganizationID uuid.UUID
+	UserID         uuid.UUID
+	Kind           string
+	CreatedAt      pgtype.Timestamptz
+}
+
+func (q *Queries) ClaimNotificationDelivery(ctx context.Context, arg ClaimNotificationDeliveryParams) (int64, error) {
+	result, err := q.db.Exec(ctx, claimNotificationDelivery,
+		arg.DedupKey,
+		arg.OrganizationID,
+		arg.UserID,
+		arg.Kind,
+		arg.CreatedAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteNotificationDelivery = `-- name: DeleteNotificationDelivery :exec
+DELETE FROM notification_deliveries
+WHERE dedup_key = $1
+`
+
+func (q *Queries) DeleteNotificationDelivery(ctx context.Context, dedupKey string) error {
+	_, err := q.db.Exec(ctx, deleteNotificationDelivery, dedupKey)
+	return err
+}
+
+const getNotificationPreferences = `-- name: GetNotificationPreferences :one
+SELECT p.user_id, p.locale, p.disabled_kinds, p.updated_at
+FROM notification_preferences p
+WHERE p.user_id = $1
+`
+
+type GetNotificationPreferencesRow struct {
+	NotificationPreference NotificationPreference
+}
+
+func (q *Queries) GetNotificationPreferences(ctx context.Context, userID uuid.UUID) (GetNotificationPreferencesRow, error) {
+	row := q.db.QueryRow(ctx, getNotificationPreferences, userID)
+	var i GetNotificationPreferencesRow
+	err := row.Scan(
+		&i.NotificationPreference.UserID,
+		&i.NotificationPreference.Locale,
+		&i.NotificationPreference.DisabledKinds,
+		&i.NotificationPreference.UpdatedAt,
+	)
+	return i, err
+}
+
+const listTenantNotificationRecipients = `-- name: ListTenantNotificationRecipients :many
+SELECT
+    u.id, u.email, u.name, u.icon, u.created_at, u.updated_at,
+    COALESCE(p.locale, '')::TEXT AS locale,
+    COALESCE(p.disabled_kinds, '{}')::TEXT[] AS disabled_kinds
+FROM tenant_memberships tm
+INNER JOIN users u ON u.id = tm.user_id
+LEFT JOIN notification_preferences p ON p.user_id = u.id
+WHERE tm.tenant_id = $1
+AND tm.left_at IS NULL
+AND (NOT $2::BOOLEAN OR tm.role = 'admin')
+ORDER BY tm.created_at
+`
+
+type ListTenantNotificationRecipientsParams struct {
+	TenantID   uuid.UUID
+	AdminsOnly bool
+}
+
+type ListTenantNotificationRecipientsRow struct {
+	User          User
+	Locale        string
+	DisabledKinds []string
+}
+
+func (q *Queries) ListTenantNotificationRecipients(ctx context.Context, arg ListTenantNotificationRecipientsParams) ([]ListTenantNotificationRecipientsRow, error) {
+	rows, err := q.db.Query(ctx, listTenantNotificationRecipients, arg.TenantID, arg.AdminsOnly)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTenantNotificationRecipientsRow
+	for rows.Next() {
+		var i ListTenantNotificationRecipientsRow
+		if err := rows.Scan(
+			&i.User.ID,
+			&i.User.Email,
+			&i.User.Name,
+			&i.User.Icon,
+			&i.User.CreatedAt,
+			&i.User.UpdatedAt,
+			&i.Locale,
+			&i.DisabledKinds,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertNotificationPreferences = `-- name: UpsertNotificationPreferences :exec
+INSERT INTO notification_preferences (
+    user_id,
+    locale,
+    disabled_kinds,
+    updated_at
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4
+)
+ON CONFLICT (user_id)
+DO UPDATE SET
+    locale = EXCLUDED.locale,
+    disabled_kinds = EXCLUDED.disabled_kinds,
+    updated_at = EXCLUDED.updated_at
+`
+
+type UpsertNotificationPreferencesParams struct {
+	UserID        uuid.UUID
+	Locale        string
+	DisabledKinds []string
+	UpdatedAt     pgtype.Timestamptz
+}
+
+func (q *Queries) UpsertNotificationPreferences(ctx context.Context, arg UpsertNotificationPreferencesParams) error {
+	_, err := q.db.Exec(ctx, upsertNotificationPreferences,
+		arg.UserID,
+		arg.Locale,
+		arg.DisabledKinds,
+		arg.UpdatedAt,
+	)
+	return err
+}
@@ -7,6 +7,8 @@ package gen
 
 import (
 	"context"
+
+	"github.com/google/uuid"
 )
 
 const cleanupExpiredOAuthStates = `-- name: CleanupExpiredOAuthStates :exec
@@ -31,7 +33,7 @@ func (q *Queries) ConsumeOAuthState(ctx context.Context, state string) error {
 }
 
 const getOAuthState = `-- name: GetOAuthState :one
-SELECT os.state, os.code_verifier, os.nonce, os.created_at, os.consumed_at
+SELECT os.state, os.code_verifier, os.nonce, os.created_at, os.consumed_at, os.organization_id
 FROM oauth_states os
 WHERE os.state = $1
 AND os.consumed_at IS NULL
@@ -50,6 +52,7 @@ func (q *Queries) GetOAuthState(ctx context.Context, state string) (GetOAuthStat
 		&i.OauthState.Nonce,
 		&i.OauthState.CreatedAt,
 		&i.OauthState.ConsumedAt,
+		&i.OauthState.OrganizationID,
 	)
 	return i, err
 }
@@ -59,19 +62,26 @@ INSERT INTO oauth_states (
     state,
     code_verifier,
     nonce,
+    organization_id,
     created_at
 ) VALUES (
-    $1, $2, $3, NOW()
+    $1, $2, $3, $4, NOW()
 )
 `
 
 type SaveOAuthStateParams struct {
-	State        string
-	CodeVerifier string
-	Nonce        string
+	State          string
+	CodeVerifier   string
+	Nonce          string
+	OrganizationID uuid.UUID
 }
 
 func (q *Queries) SaveOAuthState(ctx context.Context, arg SaveOAuthStateParams) error {
-	_, err := q.db.Exec(ctx, saveOAuthState, arg.State, arg.CodeVerifier, arg.Nonce)
+	_, err := q.db.Exec(ctx, saveOAuthState,
+		arg.State,
+		arg.CodeVerifier,
+		arg.Nonce,
+		arg.OrganizationID,
+	)
 	return err
 }
@@ -0,0 +1,177 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: organization.sql
+
+package gen
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createOrganization = `-- name: CreateOrganization :exec
+INSERT INTO organizations (
+    id,
+    name,
+    slug,
+    settings,
+    key_hash,
+    created_at,
+    updated_at
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7
+)
+`
+
+type CreateOrganizationParams struct {
+	ID        uuid.UUID
+	Name      string
+	Slug      string
+	Settings  []byte
+	KeyHash   *string
+	CreatedAt pgtype.Timestamptz
+	UpdatedAt pgtype.Timestamptz
+}
+
+func (q *Queries) CreateOrganization(ctx context.Context, arg CreateOrganizationParams) error {
+	_, err := q.db.Exec(ctx, createOrganization,
+		arg.ID,
+		arg.Name,
+		arg.Slug,
+		arg.Settings,
+		arg.KeyHash,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	return err
+}
+
+const getOrganization = `-- name: GetOrganization :one
+SELECT o.id, o.name, o.slug, o.settings, o.key_hash, o.created_at, o.updated_at
+FROM organizations o
+WHERE o.id = $1
+`
+
+type GetOrganizationRow struct {
+	Organization Organization
+}
+
+func (q *Queries) GetOrganization(ctx context.Context, id uuid.UUID) (GetOrganizationRow, error) {
+	row := q.db.QueryRow(ctx, getOrganization, id)
+	var i GetOrganizationRow
+	err := row.Scan(
+		&i.Organization.ID,
+		&i.Organization.Name,
+		&i.Organization.Slug,
+		&i.Organization.Settings,
+		&i.Organization.KeyHash,
+		&i.Organization.CreatedAt,
+		&i.Organization.UpdatedAt,
+	)
+	return i, err
+}
+
+const getOrganizationBySlug = `-- name: GetOrganizationBySlug :one
+SELECT o.id, o.name, o.slug, o.settings, o.key_hash, o.created_at, o.updated_at
+FROM organizations o
+WHERE o.slug = $1
+`
+
+type GetOrganizationBySlugRow struct {
+	Organization Organization
+}
+
+func (q *Queries) GetOrganizationBySlug(ctx context.Context, slug string) (GetOrganizationBySlugRow, error) {
+	row := q.db.QueryRow(ctx, getOrganizationBySlug, slug)
+	var i GetOrganizationBySlugRow
+	err := row.Scan(
+		&i.Organization.ID,
+		&i.Organization.Name,
+		&i.Organization.Slug,
+		&i.Organization.Settings,
+		&i.Organization.KeyHash,
+		&i.Organization.CreatedAt,
+		&i.Organization.UpdatedAt,
+	)
+	return i, err
+}
+
+const getOrganizationKeyHash = `-- name: GetOrganizationKeyHash :one
+SELECT key_hash
+FROM organizations
+WHERE id = $1
+`
+
+func (q *Queries) GetOrganizationKeyHash(ctx context.Context, id uuid.UUID) (*string, error) {
+	row := q.db.QueryRow(ctx, getOrganizationKeyHash, id)
+	var key_hash *string
+	err := row.Scan(&key_hash)
+	return key_hash, err
+}
+
+const listOrganizations = `-- name: ListOrganizations :many
+SELECT o.id, o.name, o.slug, o.settings, o.key_hash, o.created_at, o.updated_at
+FROM organizations o
+ORDER BY o.created_at
+`
+
+type ListOrganizationsRow struct {
+	Organization Organization
+}
+
+func (q *Queries) ListOrganizations(ctx context.Context) ([]ListOrganizationsRow, error) {
+	rows, err := q.db.Query(ctx, listOrganizations)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListOrganizationsRow
+	for rows.Next() {
+		var i ListOrganizationsRow
+		if err := rows.Scan(
+			&i.Organization.ID,
+			&i.Organization.Name,
+			&i.Organization.Slug,
+			&i.Organization.Settings,
+			&i.Organization.KeyHash,
+			&i.Organization.CreatedAt,
+			&i.Organization.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateOrganizationKeyHash = `-- name: UpdateOrganizationKeyHash :execrows
+UPDATE organizations
+SET key_hash = $1,
+    updated_at = NOW()
+WHERE id = $2
+`
+
+type UpdateOrganizationKeyHashParams struct {
+	KeyHash *string
+	ID      uuid.UUID
+}
+
+func (q *Queries) UpdateOrganizationKeyHash(ctx context.Context, arg UpdateOrganizationKeyHashParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateOrganizationKeyHash, arg.KeyHash, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -0,0 +1,153 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: outbox_event.sql
+
+package gen
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const claimOutboxEvents = `-- name: ClaimOutboxEvents :many
+UPDATE outbox_events o
+SET next_attempt_at = $1
+WHERE o.id IN (
+    SELECT p.id
+    FROM outbox_events p
+    WHERE p.status = 'pending'
+      AND p.next_attempt_at <= $2
+    ORDER BY p.next_attempt_at, p.id
+    LIMIT $3
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING o.id, o.organization_id, o.event_type, o.payload, o.occurred_at, o.status, o.attempts, o.next_attempt_at, o.last_error, o.delivered_at, o.completed_handlers
+`
+
+type ClaimOutboxEventsParams struct {
+	LeaseUntil pgtype.Timestamptz
+	Now        pgtype.Timestamptz
+	BatchSize  int32
+}
+
+type ClaimOutboxEventsRow struct {
+	OutboxEvent OutboxEvent
+}
+
+// 配送期限が来たイベントを取り出し、配送中に他のディスパッチャーが重ねて取り出さないよう
+// next_attempt_at を lease_until まで先送りする
+func (q *Queries) ClaimOutboxEvents(ctx context.Context, arg ClaimOutboxEventsParams) ([]ClaimOutboxEventsRow, error) {
+	rows, err := q.db.Query(ctx, claimOutboxEvents, arg.LeaseUntil, arg.Now, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ClaimOutboxEventsRow
+	for rows.Next() {
+		var i ClaimOutboxEventsRow
+		if err := rows.Scan(
+			&i.OutboxEvent.ID,
+			&i.OutboxEvent.OrganizationID,
+			&i.OutboxEvent.EventType,
+			&i.OutboxEvent.Payload,
+			&i.OutboxEvent.OccurredAt,
+			&i.OutboxEvent.Status,
+			&i.OutboxEvent.Attempts,
+			&i.OutboxEvent.NextAttemptAt,
+			&i.OutboxEvent.LastError,
+			&i.OutboxEvent.DeliveredAt,
+			&i.OutboxEvent.CompletedHandlers,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const createOutboxEvent = `-- name: CreateOutboxEvent :exec
+INSERT INTO outbox_events (
+    id,
+    organization_id,
+    event_type,
+    payload,
+    occurred_at,
+    status,
+    attempts,
+    next_attempt_at
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $8
+)
+`
+
+type CreateOutboxEventParams struct {
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+	EventType      string
+	Payload        []byte
+	OccurredAt     pgtype.Timestamptz
+	Status         string
+	Attempts       int32
+	NextAttemptAt  pgtype.Timestamptz
+}
+
+func (q *Queries) CreateOutboxEvent(ctx context.Context, arg CreateOutboxEventParams) error {
+	_, err := q.db.Exec(ctx, createOutboxEvent,
+		arg.ID,
+		arg.OrganizationID,
+		arg.EventType,
+		arg.Payload,
+		arg.OccurredAt,
+		arg.Status,
+		arg.Attempts,
+		arg.NextAttemptAt,
+	)
+	return err
+}
+
+const updateOutboxEvent = `-- name: UpdateOutboxEvent :exec
+UPDATE outbox_events
+SET status = $1,
+    attempts = $2,
+    next_attempt_at = $3,
+    last_error = $4,
+    delivered_at = $5,
+    completed_handlers = $6
+WHERE id = $7
+`
+
+type UpdateOutboxEventParams struct {
+	Status            string
+	Attempts          int32
+	NextAttemptAt     pgtype.Timestamptz
+	LastError         string
+	DeliveredAt       pgtype.Timestamptz
+	CompletedHandlers []string
+	ID                uuid.UUID
+}
+
+func (q *Queries) UpdateOutboxEvent(ctx context.Context, arg UpdateOutboxEventParams) error {
+	_, err := q.db.Exec(ctx, updateOutboxEvent,
+		arg.Status,
+		arg.Attempts,
+		arg.NextAttemptAt,
+		arg.LastError,
+		arg.DeliveredAt,
+		arg.CompletedHandlers,
+		arg.ID,
+	)
+	return err
+}
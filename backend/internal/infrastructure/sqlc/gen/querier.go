@@ -8,42 +8,195 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Querier interface {
+	AddTenantGroupMember(ctx context.Context, arg AddTenantGroupMemberParams) (int64, error)
+	// アーカイブ済みのテナントは更新しない
+	ArchiveTenant(ctx context.Context, arg ArchiveTenantParams) (int64, error)
+	ClaimNotificationDelivery(ctx context.Context, arg ClaimNotificationDeliveryParams) (int64, error)
+	// 配送期限が来たイベントを取り出し、配送中に他のディスパッチャーが重ねて取り出さないよう
+	// next_attempt_at を lease_until まで先送りする
+	ClaimOutboxEvents(ctx context.Context, arg ClaimOutboxEventsParams) ([]ClaimOutboxEventsRow, error)
 	// 期限切れまたは無効化されたセッションを物理削除する（バッチ処理用）
 	CleanupExpiredAppSessions(ctx context.Context) error
 	CleanupExpiredConsoleSessions(ctx context.Context) error
 	CleanupExpiredOAuthStates(ctx context.Context) error
+	CleanupExpiredWebAuthnCeremonies(ctx context.Context) error
+	// テナントのメンバーシップを削除する前に、それを参照しているセッションから外す
+	ClearActiveMembershipsByTenant(ctx context.Context, tenantID uuid.UUID) error
 	ConsumeOAuthState(ctx context.Context, state string) error
+	ConsumeWebAuthnCeremony(ctx context.Context, id string) (ConsumeWebAuthnCeremonyRow, error)
+	CountFloorsByBuilding(ctx context.Context, buildingID uuid.UUID) (int32, error)
+	// テナントに割り当てられている部屋の貸し出し中の鍵を数える。
+	// アーカイブしたテナントは、アーカイブで終了した割り当ての部屋も含める
+	CountKeysInUseByTenant(ctx context.Context, tenantID uuid.UUID) (int32, error)
+	CountRoomsByFloor(ctx context.Context, floorID uuid.UUID) (int32, error)
+	CountRoomsByType(ctx context.Context, arg CountRoomsByTypeParams) (int32, error)
+	CountTenantsByType(ctx context.Context, arg CountTenantsByTypeParams) (int32, error)
+	CreateAPIToken(ctx context.Context, arg CreateAPITokenParams) error
 	CreateAppSession(ctx context.Context, arg CreateAppSessionParams) error
+	CreateAuditLog(ctx context.Context, arg CreateAuditLogParams) error
+	CreateBuilding(ctx context.Context, arg CreateBuildingParams) error
+	CreateConsoleOperator(ctx context.Context, arg CreateConsoleOperatorParams) error
 	CreateConsoleSession(ctx context.Context, arg CreateConsoleSessionParams) error
+	CreateFloor(ctx context.Context, arg CreateFloorParams) error
 	CreateKey(ctx context.Context, arg CreateKeyParams) error
+	CreateOrganization(ctx context.Context, arg CreateOrganizationParams) error
+	CreateOutboxEvent(ctx context.Context, arg CreateOutboxEventParams) error
+	// building_name と floor_number は floor_id の建物と階からトリガーで埋められる
 	CreateRoom(ctx context.Context, arg CreateRoomParams) error
 	CreateRoomAssignment(ctx context.Context, arg CreateRoomAssignmentParams) error
+	CreateRoomCustomField(ctx context.Context, arg CreateRoomCustomFieldParams) error
+	CreateRoomType(ctx context.Context, arg CreateRoomTypeParams) error
 	CreateTenant(ctx context.Context, arg CreateTenantParams) error
+	CreateTenantGroup(ctx context.Context, arg CreateTenantGroupParams) error
 	CreateTenantJoinCode(ctx context.Context, arg CreateTenantJoinCodeParams) error
 	CreateTenantMembership(ctx context.Context, arg CreateTenantMembershipParams) error
+	CreateTenantType(ctx context.Context, arg CreateTenantTypeParams) error
+	CreateWebAuthnCredential(ctx context.Context, arg CreateWebAuthnCredentialParams) error
+	CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) error
+	CreateWebhookSubscription(ctx context.Context, arg CreateWebhookSubscriptionParams) error
+	DeleteBuilding(ctx context.Context, id uuid.UUID) (int64, error)
 	DeleteConsoleSession(ctx context.Context, sessionID string) error
-	GetAllRooms(ctx context.Context) ([]GetAllRoomsRow, error)
-	GetAllTenants(ctx context.Context) ([]GetAllTenantsRow, error)
+	DeleteConsoleSessionByOrganization(ctx context.Context, arg DeleteConsoleSessionByOrganizationParams) (int64, error)
+	DeleteConsoleSessionsByOperator(ctx context.Context, operatorID *uuid.UUID) (int64, error)
+	DeleteConsoleSessionsByOrganization(ctx context.Context, organizationID uuid.UUID) (int64, error)
+	DeleteFloor(ctx context.Context, id uuid.UUID) (int64, error)
+	DeleteIdleRateLimitBuckets(ctx context.Context, idleAt pgtype.Timestamptz) error
+	DeleteIdleRateLimitFailures(ctx context.Context, idleAt pgtype.Timestamptz) error
+	DeleteNotificationDelivery(ctx context.Context, dedupKey string) error
+	DeleteOtherConsoleSessionsByOrganization(ctx context.Context, arg DeleteOtherConsoleSessionsByOrganizationParams) (int64, error)
+	DeleteRoomAssignmentsByTenant(ctx context.Context, tenantID uuid.UUID) error
+	DeleteRoomCustomField(ctx context.Context, id uuid.UUID) (int64, error)
+	DeleteRoomType(ctx context.Context, id uuid.UUID) (int64, error)
+	DeleteTenant(ctx context.Context, id uuid.UUID) (int64, error)
+	// グループのメンバーと子グループも合わせて削除される
+	DeleteTenantGroupsByTenant(ctx context.Context, tenantID uuid.UUID) error
+	DeleteTenantJoinCodesByTenant(ctx context.Context, tenantID uuid.UUID) error
+	DeleteTenantMembershipsByTenant(ctx context.Context, tenantID uuid.UUID) error
+	DeleteTenantType(ctx context.Context, id uuid.UUID) (int64, error)
+	DeleteUnlockedRateLimitFailure(ctx context.Context, key string) error
+	DeleteWebAuthnCredentialByUser(ctx context.Context, arg DeleteWebAuthnCredentialByUserParams) (int64, error)
+	DeleteWebhookSubscription(ctx context.Context, arg DeleteWebhookSubscriptionParams) (int64, error)
+	// 有効な参加コードの期限を now にして使えなくする
+	DisableTenantJoinCodes(ctx context.Context, arg DisableTenantJoinCodesParams) error
+	// テナントの有効な割り当ての期限を now にして終了する
+	EndRoomAssignmentsByTenant(ctx context.Context, arg EndRoomAssignmentsByTenantParams) (int64, error)
+	// CSRFトークンを持たないセッションにだけ設定し、保存されているトークンを返す。
+	// 同時に呼ばれても先に設定したトークンを返すため、リクエストごとに値が変わらない
+	EnsureAppSessionCSRFToken(ctx context.Context, arg EnsureAppSessionCSRFTokenParams) (*string, error)
+	EnsureRateLimitBucket(ctx context.Context, arg EnsureRateLimitBucketParams) error
+	EnsureRateLimitFailure(ctx context.Context, arg EnsureRateLimitFailureParams) error
+	ExtendAppSession(ctx context.Context, arg ExtendAppSessionParams) error
+	ExtendConsoleSession(ctx context.Context, arg ExtendConsoleSessionParams) error
+	GetAPITokenByHash(ctx context.Context, tokenHash string) (GetAPITokenByHashRow, error)
 	GetAppSession(ctx context.Context, sessionID string) (GetAppSessionRow, error)
+	// テナントに現在割り当てられている部屋のIDを返す
+	GetAssignedRoomIDsByTenant(ctx context.Context, tenantID uuid.UUID) ([]uuid.UUID, error)
+	GetAuditChainHead(ctx context.Context, organizationID uuid.UUID) (GetAuditChainHeadRow, error)
+	GetBuilding(ctx context.Context, id uuid.UUID) (GetBuildingRow, error)
+	GetBuildingByOrganizationAndName(ctx context.Context, arg GetBuildingByOrganizationAndNameParams) (GetBuildingByOrganizationAndNameRow, error)
+	GetConsoleOperatorByKeyHash(ctx context.Context, keyHash string) (GetConsoleOperatorByKeyHashRow, error)
 	GetConsoleSession(ctx context.Context, sessionID string) (GetConsoleSessionRow, error)
+	GetFloor(ctx context.Context, id uuid.UUID) (GetFloorRow, error)
+	GetFloorByBuildingAndName(ctx context.Context, arg GetFloorByBuildingAndNameParams) (GetFloorByBuildingAndNameRow, error)
+	GetKeyById(ctx context.Context, id uuid.UUID) (GetKeyByIdRow, error)
+	GetKeysByOrganization(ctx context.Context, organizationID uuid.UUID) ([]GetKeysByOrganizationRow, error)
 	GetKeysByRoom(ctx context.Context, roomID uuid.UUID) ([]GetKeysByRoomRow, error)
+	GetNotificationPreferences(ctx context.Context, userID uuid.UUID) (GetNotificationPreferencesRow, error)
 	GetOAuthState(ctx context.Context, state string) (GetOAuthStateRow, error)
+	GetOrganization(ctx context.Context, id uuid.UUID) (GetOrganizationRow, error)
+	GetOrganizationBySlug(ctx context.Context, slug string) (GetOrganizationBySlugRow, error)
+	GetOrganizationKeyHash(ctx context.Context, id uuid.UUID) (*string, error)
+	GetRateLimitBucketForUpdate(ctx context.Context, key string) (GetRateLimitBucketForUpdateRow, error)
+	GetRateLimitFailure(ctx context.Context, key string) (GetRateLimitFailureRow, error)
+	GetRateLimitFailureForUpdate(ctx context.Context, key string) (GetRateLimitFailureForUpdateRow, error)
 	GetRoomById(ctx context.Context, id uuid.UUID) (GetRoomByIdRow, error)
-	GetRoomsByTenant(ctx context.Context, tenantID uuid.UUID) ([]GetRoomsByTenantRow, error)
+	GetRoomCustomField(ctx context.Context, id uuid.UUID) (GetRoomCustomFieldRow, error)
+	GetRoomType(ctx context.Context, id uuid.UUID) (GetRoomTypeRow, error)
+	// テナントのメンバーが利用できる部屋を返す。グループに割り当てた部屋は、そのグループか子グループのメンバーにだけ返す
+	GetRoomsByTenant(ctx context.Context, arg GetRoomsByTenantParams) ([]GetRoomsByTenantRow, error)
 	GetTenantById(ctx context.Context, id uuid.UUID) (GetTenantByIdRow, error)
-	GetTenantByJoinCode(ctx context.Context, code string) (GetTenantByJoinCodeRow, error)
+	GetTenantByJoinCode(ctx context.Context, arg GetTenantByJoinCodeParams) (GetTenantByJoinCodeRow, error)
+	GetTenantGroup(ctx context.Context, id uuid.UUID) (GetTenantGroupRow, error)
+	GetTenantGroupByTenantAndName(ctx context.Context, arg GetTenantGroupByTenantAndNameParams) (GetTenantGroupByTenantAndNameRow, error)
 	GetTenantMembershipByTenantAndUser(ctx context.Context, arg GetTenantMembershipByTenantAndUserParams) (GetTenantMembershipByTenantAndUserRow, error)
-	GetTenantsByUserID(ctx context.Context, userID uuid.UUID) ([]GetTenantsByUserIDRow, error)
+	GetTenantType(ctx context.Context, id uuid.UUID) (GetTenantTypeRow, error)
 	GetUser(ctx context.Context, id uuid.UUID) (GetUserRow, error)
 	GetUserByProviderIdentity(ctx context.Context, arg GetUserByProviderIdentityParams) (GetUserByProviderIdentityRow, error)
+	GetUserIdentityByUser(ctx context.Context, arg GetUserIdentityByUserParams) (GetUserIdentityByUserRow, error)
+	GetWebhookSubscription(ctx context.Context, arg GetWebhookSubscriptionParams) (GetWebhookSubscriptionRow, error)
+	HasSucceededWebhookDelivery(ctx context.Context, arg HasSucceededWebhookDeliveryParams) (bool, error)
 	IncrementJoinCodeUsedCount(ctx context.Context, code string) error
+	ListAPITokensByOrganization(ctx context.Context, organizationID uuid.UUID) ([]ListAPITokensByOrganizationRow, error)
+	ListAPITokensByUser(ctx context.Context, userID *uuid.UUID) ([]ListAPITokensByUserRow, error)
+	ListActiveAppSessionsByUser(ctx context.Context, userID uuid.UUID) ([]ListActiveAppSessionsByUserRow, error)
+	ListActiveConsoleSessionsByOrganization(ctx context.Context, organizationID uuid.UUID) ([]ListActiveConsoleSessionsByOrganizationRow, error)
+	ListAuditLogOrganizationIDs(ctx context.Context) ([]uuid.UUID, error)
+	ListAuditLogsBySeq(ctx context.Context, arg ListAuditLogsBySeqParams) ([]ListAuditLogsBySeqRow, error)
+	ListBuildings(ctx context.Context) ([]ListBuildingsRow, error)
+	ListConsoleOperatorsByOrganization(ctx context.Context, organizationID uuid.UUID) ([]ListConsoleOperatorsByOrganizationRow, error)
+	ListExpiringRoomAssignments(ctx context.Context, arg ListExpiringRoomAssignmentsParams) ([]ListExpiringRoomAssignmentsRow, error)
+	// 建物ごとに level の昇順（地下から上の階）で返す
+	ListFloors(ctx context.Context) ([]ListFloorsRow, error)
+	// order_by が name の場合は鍵番号の昇順、それ以外は新しい順に返す。カーソルを指定した場合はその鍵より後ろだけを返す
+	ListKeysByRoom(ctx context.Context, arg ListKeysByRoomParams) ([]ListKeysByRoomRow, error)
+	ListOrganizations(ctx context.Context) ([]ListOrganizationsRow, error)
+	ListRoomCustomFields(ctx context.Context) ([]ListRoomCustomFieldsRow, error)
+	ListRoomTypes(ctx context.Context) ([]ListRoomTypesRow, error)
+	// order_by が name の場合は名前の昇順、それ以外は新しい順に返す。カーソルを指定した場合はその部屋より後ろだけを返す
+	ListRooms(ctx context.Context, arg ListRoomsParams) ([]ListRoomsRow, error)
+	ListTenantGroupMembers(ctx context.Context, groupID uuid.UUID) ([]ListTenantGroupMembersRow, error)
+	ListTenantGroupsByTenant(ctx context.Context, tenantID uuid.UUID) ([]ListTenantGroupsByTenantRow, error)
+	ListTenantNotificationRecipients(ctx context.Context, arg ListTenantNotificationRecipientsParams) ([]ListTenantNotificationRecipientsRow, error)
+	ListTenantTypes(ctx context.Context) ([]ListTenantTypesRow, error)
+	// order_by が name の場合は名前の昇順、それ以外は新しい順に返す。カーソルを指定した場合はそのテナントより後ろだけを返す
+	ListTenants(ctx context.Context, arg ListTenantsParams) ([]ListTenantsRow, error)
+	// ユーザーが参加しているテナントを ListTenants と同じ並び順・カーソルで返す
+	ListTenantsByUserID(ctx context.Context, arg ListTenantsByUserIDParams) ([]ListTenantsByUserIDRow, error)
+	ListWebAuthnCredentialsByUser(ctx context.Context, userID uuid.UUID) ([]ListWebAuthnCredentialsByUserRow, error)
+	ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]ListWebhookDeliveriesRow, error)
+	ListWebhookSubscriptionsByEventType(ctx context.Context, arg ListWebhookSubscriptionsByEventTypeParams) ([]ListWebhookSubscriptionsByEventTypeRow, error)
+	ListWebhookSubscriptionsByOrganization(ctx context.Context, organizationID uuid.UUID) ([]ListWebhookSubscriptionsByOrganizationRow, error)
+	// 同じ組織の記録を同時に追記すると連鎖が分岐するため、トランザクションの終わりまで組織単位で排他する
+	LockAuditChain(ctx context.Context, organizationID string) error
+	// 削除したカスタム項目の値を組織の部屋から取り除く
+	RemoveRoomCustomFieldValues(ctx context.Context, arg RemoveRoomCustomFieldValuesParams) error
+	RemoveTenantGroupMember(ctx context.Context, arg RemoveTenantGroupMemberParams) (int64, error)
+	RevokeAPITokenByOrganization(ctx context.Context, arg RevokeAPITokenByOrganizationParams) (int64, error)
+	RevokeAPITokenByUser(ctx context.Context, arg RevokeAPITokenByUserParams) (int64, error)
 	RevokeAppSession(ctx context.Context, sessionID string) error
+	RevokeAppSessionByUser(ctx context.Context, arg RevokeAppSessionByUserParams) (int64, error)
+	RevokeConsoleOperator(ctx context.Context, arg RevokeConsoleOperatorParams) (int64, error)
+	RevokeOtherAppSessionsByUser(ctx context.Context, arg RevokeOtherAppSessionsByUserParams) (int64, error)
 	SaveOAuthState(ctx context.Context, arg SaveOAuthStateParams) error
-	UpdateTenant(ctx context.Context, arg UpdateTenantParams) error
+	SaveRateLimitBucket(ctx context.Context, arg SaveRateLimitBucketParams) error
+	SaveRateLimitFailure(ctx context.Context, arg SaveRateLimitFailureParams) error
+	SaveWebAuthnCeremony(ctx context.Context, arg SaveWebAuthnCeremonyParams) error
+	// 組織の部屋・鍵・テナント・メンバーを横断して探し、関連度の高い順に返す。
+	// pattern は ILIKE のワイルドカードをエスケープして % で囲んだ検索語。
+	// RLS に加えて organization_id でも絞り込み、他の組織の行を返さない
+	Search(ctx context.Context, arg SearchParams) ([]SearchRow, error)
+	// 新しい順に返す。カーソルを指定した場合はその記録より古いものだけを返す
+	SearchAuditLogs(ctx context.Context, arg SearchAuditLogsParams) ([]SearchAuditLogsRow, error)
+	TouchAPIToken(ctx context.Context, id uuid.UUID) error
+	TouchAppSession(ctx context.Context, arg TouchAppSessionParams) error
+	TouchConsoleSession(ctx context.Context, arg TouchConsoleSessionParams) error
+	UpdateBuilding(ctx context.Context, arg UpdateBuildingParams) error
+	UpdateFloor(ctx context.Context, arg UpdateFloorParams) error
+	// 取得時から版数が変わっていないときだけ更新し、更新後の版数を返す。版数はトリガーで増やす
+	UpdateKeyNumber(ctx context.Context, arg UpdateKeyNumberParams) (int64, error)
+	UpdateOrganizationKeyHash(ctx context.Context, arg UpdateOrganizationKeyHashParams) (int64, error)
+	UpdateOutboxEvent(ctx context.Context, arg UpdateOutboxEventParams) error
+	// 取得時から版数が変わっていないときだけ更新し、更新後の版数を返す。版数はトリガーで増やす
+	UpdateRoomAttributes(ctx context.Context, arg UpdateRoomAttributesParams) (int64, error)
+	// 取得時から版数が変わっていない、アーカイブされていないテナントだけを更新し、更新後の版数を返す。版数はトリガーで増やす
+	UpdateTenant(ctx context.Context, arg UpdateTenantParams) (int64, error)
 	UpdateTenantJoinCodeByTenantId(ctx context.Context, arg UpdateTenantJoinCodeByTenantIdParams) error
+	UpdateWebAuthnCredentialUsage(ctx context.Context, arg UpdateWebAuthnCredentialUsageParams) error
+	UpsertNotificationPreferences(ctx context.Context, arg UpsertNotificationPreferencesParams) error
 	UpsertUser(ctx context.Context, arg UpsertUserParams) (UpsertUserRow, error)
 	UpsertUserIdentity(ctx context.Context, arg UpsertUserIdentityParams) error
 }
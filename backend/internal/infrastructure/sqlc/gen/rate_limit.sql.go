@@ -0,0 +1,215 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: rate_limit.sql
+
+package gen
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const deleteIdleRateLimitBuckets = `-- name: DeleteIdleRateLimitBuckets :exec
+DELETE FROM rate_limit_buckets
+WHERE idle_at < $1
+`
+
+func (q *Queries) DeleteIdleRateLimitBuckets(ctx context.Context, idleAt pgtype.Timestamptz) error {
+	_, err := q.db.Exec(ctx, deleteIdleRateLimitBuckets, idleAt)
+	return err
+}
+
+const deleteIdleRateLimitFailures = `-- name: DeleteIdleRateLimitFailures :exec
+DELETE FROM rate_limit_failures
+WHERE idle_at < $1
+`
+
+func (q *Queries) DeleteIdleRateLimitFailures(ctx context.Context, idleAt pgtype.Timestamptz) error {
+	_, err := q.db.Exec(ctx, deleteIdleRateLimitFailures, idleAt)
+	return err
+}
+
+const deleteUnlockedRateLimitFailure = `-- name: DeleteUnlockedRateLimitFailure :exec
+DELETE FROM rate_limit_failures
+WHERE key = $1
+AND (locked_until IS NULL OR locked_until <= NOW())
+`
+
+func (q *Queries) DeleteUnlockedRateLimitFailure(ctx context.Context, key string) error {
+	_, err := q.db.Exec(ctx, deleteUnlockedRateLimitFailure, key)
+	return err
+}
+
+const ensureRateLimitBucket = `-- name: EnsureRateLimitBucket :exec
+INSERT INTO rate_limit_buckets (
+    key,
+    tokens,
+    updated_at,
+    idle_at
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $3
+)
+ON CONFLICT (key) DO NOTHING
+`
+
+type EnsureRateLimitBucketParams struct {
+	Key       string
+	Tokens    float64
+	UpdatedAt pgtype.Timestamptz
+}
+
+func (q *Queries) EnsureRateLimitBucket(ctx context.Context, arg EnsureRateLimitBucketParams) error {
+	_, err := q.db.Exec(ctx, ensureRateLimitBucket, arg.Key, arg.Tokens, arg.UpdatedAt)
+	return err
+}
+
+const ensureRateLimitFailure = `-- name: EnsureRateLimitFailure :exec
+INSERT INTO rate_limit_failures (
+    key,
+    window_started_at,
+    idle_at
+) VALUES (
+    $1,
+    $2,
+    $2
+)
+ON CONFLICT (key) DO NOTHING
+`
+
+type EnsureRateLimitFailureParams struct {
+	Key string
+	Now pgtype.Timestamptz
+}
+
+func (q *Queries) EnsureRateLimitFailure(ctx context.Context, arg EnsureRateLimitFailureParams) error {
+	_, err := q.db.Exec(ctx, ensureRateLimitFailure, arg.Key, arg.Now)
+	return err
+}
+
+const getRateLimitBucketForUpdate = `-- name: GetRateLimitBucketForUpdate :one
+SELECT b.key, b.tokens, b.updated_at, b.idle_at
+FROM rate_limit_buckets b
+WHERE b.key = $1
+FOR UPDATE
+`
+
+type GetRateLimitBucketForUpdateRow struct {
+	RateLimitBucket RateLimitBucket
+}
+
+func (q *Queries) GetRateLimitBucketForUpdate(ctx context.Context, key string) (GetRateLimitBucketForUpdateRow, error) {
+	row := q.db.QueryRow(ctx, getRateLimitBucketForUpdate, key)
+	var i GetRateLimitBucketForUpdateRow
+	err := row.Scan(
+		&i.RateLimitBucket.Key,
+		&i.RateLimitBucket.Tokens,
+		&i.RateLimitBucket.UpdatedAt,
+		&i.RateLimitBucket.IdleAt,
+	)
+	return i, err
+}
+
+const getRateLimitFailure = `-- name: GetRateLimitFailure :one
+SELECT f.key, f.failure_count, f.window_started_at, f.locked_until, f.idle_at
+FROM rate_limit_failures f
+WHERE f.key = $1
+`
+
+type GetRateLimitFailureRow struct {
+	RateLimitFailure RateLimitFailure
+}
+
+func (q *Queries) GetRateLimitFailure(ctx context.Context, key string) (GetRateLimitFailureRow, error) {
+	row := q.db.QueryRow(ctx, getRateLimitFailure, key)
+	var i GetRateLimitFailureRow
+	err := row.Scan(
+		&i.RateLimitFailure.Key,
+		&i.RateLimitFailure.FailureCount,
+		&i.RateLimitFailure.WindowStartedAt,
+		&i.RateLimitFailure.LockedUntil,
+		&i.RateLimitFailure.IdleAt,
+	)
+	return i, err
+}
+
+const getRateLimitFailureForUpdate = `-- name: GetRateLimitFailureForUpdate :one
+SELECT f.key, f.failure_count, f.window_started_at, f.locked_until, f.idle_at
+FROM rate_limit_failures f
+WHERE f.key = $1
+FOR UPDATE
+`
+
+type GetRateLimitFailureForUpdateRow struct {
+	RateLimitFailure RateLimitFailure
+}
+
+func (q *Queries) GetRateLimitFailureForUpdate(ctx context.Context, key string) (GetRateLimitFailureForUpdateRow, error) {
+	row := q.db.QueryRow(ctx, getRateLimitFailureForUpdate, key)
+	var i GetRateLimitFailureForUpdateRow
+	err := row.Scan(
+		&i.RateLimitFailure.Key,
+		&i.RateLimitFailure.FailureCount,
+		&i.RateLimitFailure.WindowStartedAt,
+		&i.RateLimitFailure.LockedUntil,
+		&i.RateLimitFailure.IdleAt,
+	)
+	return i, err
+}
+
+const saveRateLimitBucket = `-- name: SaveRateLimitBucket :exec
+UPDATE rate_limit_buckets
+SET tokens = $1,
+    updated_at = $2,
+    idle_at = $3
+WHERE key = $4
+`
+
+type SaveRateLimitBucketParams struct {
+	Tokens    float64
+	UpdatedAt pgtype.Timestamptz
+	IdleAt    pgtype.Timestamptz
+	Key       string
+}
+
+func (q *Queries) SaveRateLimitBucket(ctx context.Context, arg SaveRateLimitBucketParams) error {
+	_, err := q.db.Exec(ctx, saveRateLimitBucket,
+		arg.Tokens,
+		arg.UpdatedAt,
+		arg.IdleAt,
+		arg.Key,
+	)
+	return err
+}
+
+const saveRateLimitFailure = `-- name: SaveRateLimitFailure :exec
+UPDATE rate_limit_failures
+SET failure_count = $1,
+    window_started_at = $2,
+    locked_until = $3,
+    idle_at = $4
+WHERE key = $5
+`
+
+type SaveRateLimitFailureParams struct {
+	FailureCount    int32
+	WindowStartedAt pgtype.Timestamptz
+	LockedUntil     pgtype.Timestamptz
+	IdleAt          pgtype.Timestamptz
+	Key             string
+}
+
+func (q *Queries) SaveRateLimitFailure(ctx context.Context, arg SaveRateLimitFailureParams) error {
+	_, err := q.db.Exec(ctx, saveRateLimitFailure,
+		arg.FailureCount,
+		arg.WindowStartedAt,
+		arg.LockedUntil,
+		arg.IdleAt,
+		arg.Key,
+	)
+	return err
+}
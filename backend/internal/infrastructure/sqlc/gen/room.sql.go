@@ -9,6 +9,7 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const createRoom = `-- name: CreateRoom :exec
@@ -16,10 +17,13 @@ INSERT INTO rooms(
     id,
     organization_id,
     name,
-    building_name,
-    floor_number,
+    floor_id,
     room_type,
-    description
+    description,
+    capacity,
+    equipment,
+    accessibility,
+    custom_fields
 )
 VALUES(
     $1,
@@ -28,7 +32,10 @@ VALUES(
     $4,
     $5,
     $6,
-    $7
+    $7,
+    $8,
+    $9,
+    $10
 )
 `
 
@@ -36,44 +43,126 @@ type CreateRoomParams struct {
 	ID             uuid.UUID
 	OrganizationID uuid.UUID
 	Name           string
-	BuildingName   string
-	FloorNumber    string
+	FloorID        uuid.UUID
 	RoomType       string
 	Description    string
+	Capacity       int32
+	Equipment      []string
+	Accessibility  []string
+	CustomFields   []byte
 }
 
+// building_name と floor_number は floor_id の建物と階からトリガーで埋められる
 func (q *Queries) CreateRoom(ctx context.Context, arg CreateRoomParams) error {
 	_, err := q.db.Exec(ctx, createRoom,
 		arg.ID,
 		arg.OrganizationID,
 		arg.Name,
-		arg.BuildingName,
-		arg.FloorNumber,
+		arg.FloorID,
 		arg.RoomType,
 		arg.Description,
+		arg.Capacity,
+		arg.Equipment,
+		arg.Accessibility,
+		arg.CustomFields,
 	)
 	return err
 }
 
-const getAllRooms = `-- name: GetAllRooms :many
-SELECT r.id, r.organization_id, r.name, r.building_name, r.floor_number, r.room_type, r.description, r.created_at, r.updated_at
+const getRoomById = `-- name: GetRoomById :one
+SELECT r.id, r.organization_id, r.name, r.building_name, r.floor_number, r.room_type, r.description, r.created_at, r.updated_at, r.floor_id, r.capacity, r.equipment, r.accessibility, r.custom_fields, r.version
 FROM rooms r
-ORDER BY created_at DESC
+WHERE r.id = $1
 `
 
-type GetAllRoomsRow struct {
+type GetRoomByIdRow struct {
 	Room Room
 }
 
-func (q *Queries) GetAllRooms(ctx context.Context) ([]GetAllRoomsRow, error) {
-	rows, err := q.db.Query(ctx, getAllRooms)
+func (q *Queries) GetRoomById(ctx context.Context, id uuid.UUID) (GetRoomByIdRow, error) {
+	row := q.db.QueryRow(ctx, getRoomById, id)
+	var i GetRoomByIdRow
+	err := row.Scan(
+		&i.Room.ID,
+		&i.Room.OrganizationID,
+		&i.Room.Name,
+		&i.Room.BuildingName,
+		&i.Room.FloorNumber,
+		&i.Room.RoomType,
+		&i.Room.Description,
+		&i.Room.CreatedAt,
+		&i.Room.UpdatedAt,
+		&i.Room.FloorID,
+		&i.Room.Capacity,
+		&i.Room.Equipment,
+		&i.Room.Accessibility,
+		&i.Room.CustomFields,
+		&i.Room.Version,
+	)
+	return i, err
+}
+
+const getRoomsByTenant = `-- name: GetRoomsByTenant :many
+WITH RECURSIVE user_groups AS (
+    SELECT g.id, g.parent_group_id
+    FROM tenant_groups g
+    INNER JOIN tenant_group_memberships gm ON g.id = gm.group_id
+    WHERE g.tenant_id = $1 AND gm.user_id = $2
+    UNION
+    SELECT p.id, p.parent_group_id
+    FROM tenant_groups p
+    INNER JOIN user_groups ug ON p.id = ug.parent_group_id
+)
+SELECT
+    r.id, r.organization_id, r.name, r.building_name, r.floor_number, r.room_type, r.description, r.created_at, r.updated_at, r.floor_id, r.capacity, r.equipment, r.accessibility, r.custom_fields, r.version,
+    (ra.key_loan_group_id IS NULL OR ra.key_loan_group_id IN (SELECT id FROM user_groups))::BOOLEAN AS can_borrow_keys
+FROM rooms r
+INNER JOIN room_assignments ra ON r.id = ra.room_id
+WHERE ra.tenant_id = $1
+  AND (ra.expires_at IS NULL OR ra.expires_at > NOW())
+  AND EXISTS (
+      SELECT 1 FROM tenant_memberships tm
+      WHERE tm.tenant_id = ra.tenant_id AND tm.user_id = $2 AND tm.left_at IS NULL
+  )
+  AND (ra.group_id IS NULL OR ra.group_id IN (SELECT id FROM user_groups))
+  AND r.capacity >= $3::int
+  AND r.equipment @> $4::text[]
+  AND r.accessibility @> $5::text[]
+  AND r.custom_fields @> $6::jsonb
+ORDER BY r.created_at DESC
+`
+
+type GetRoomsByTenantParams struct {
+	TenantID      uuid.UUID
+	UserID        uuid.UUID
+	MinCapacity   int32
+	Equipment     []string
+	Accessibility []string
+	CustomFields  []byte
+}
+
+type GetRoomsByTenantRow struct {
+	Room          Room
+	CanBorrowKeys bool
+}
+
+// テナントのメンバーが利用できる部屋を返す。グループに割り当てた部屋は、そのグループか子グループのメンバーにだけ返す
+func (q *Queries) GetRoomsByTenant(ctx context.Context, arg GetRoomsByTenantParams) ([]GetRoomsByTenantRow, error) {
+	rows, err := q.db.Query(ctx, getRoomsByTenant,
+		arg.TenantID,
+		arg.UserID,
+		arg.MinCapacity,
+		arg.Equipment,
+		arg.Accessibility,
+		arg.CustomFields,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetAllRoomsRow
+	var items []GetRoomsByTenantRow
 	for rows.Next() {
-		var i GetAllRoomsRow
+		var i GetRoomsByTenantRow
 		if err := rows.Scan(
 			&i.Room.ID,
 			&i.Room.OrganizationID,
@@ -84,6 +173,13 @@ func (q *Queries) GetAllRooms(ctx context.Context) ([]GetAllRoomsRow, error) {
 			&i.Room.Description,
 			&i.Room.CreatedAt,
 			&i.Room.UpdatedAt,
+			&i.Room.FloorID,
+			&i.Room.Capacity,
+			&i.Room.Equipment,
+			&i.Room.Accessibility,
+			&i.Room.CustomFields,
+			&i.Room.Version,
+			&i.CanBorrowKeys,
 		); err != nil {
 			return nil, err
 		}
@@ -95,55 +191,80 @@ func (q *Queries) GetAllRooms(ctx context.Context) ([]GetAllRoomsRow, error) {
 	return items, nil
 }
 
-const getRoomById = `-- name: GetRoomById :one
-SELECT r.id, r.organization_id, r.name, r.building_name, r.floor_number, r.room_type, r.description, r.created_at, r.updated_at
+const listRooms = `-- name: ListRooms :many
+SELECT r.id, r.organization_id, r.name, r.building_name, r.floor_number, r.room_type, r.description, r.created_at, r.updated_at, r.floor_id, r.capacity, r.equipment, r.accessibility, r.custom_fields, r.version
 FROM rooms r
-WHERE r.id = $1
+WHERE ($1::text IS NULL OR r.building_name = $1::text)
+AND ($2::uuid IS NULL OR r.floor_id IN (SELECT f.id FROM floors f WHERE f.building_id = $2::uuid))
+AND ($3::uuid IS NULL OR r.floor_id = $3::uuid)
+AND ($4::text IS NULL OR r.floor_number = $4::text)
+AND ($5::text IS NULL OR r.room_type = $5::text)
+AND ($6::text IS NULL OR starts_with(r.name, $6::text))
+AND r.capacity >= $7::int
+AND r.equipment @> $8::text[]
+AND r.accessibility @> $9::text[]
+AND r.custom_fields @> $10::jsonb
+AND (
+    $11::uuid IS NULL
+    OR ($12::text = 'name' AND (r.name, r.id) > ($13::text, $11::uuid))
+    OR ($12::text <> 'name' AND (r.created_at, r.id) < ($14::timestamptz, $11::uuid))
+)
+ORDER BY
+    CASE WHEN $12::text = 'name' THEN r.name END ASC,
+    CASE WHEN $12::text = 'name' THEN r.id END ASC,
+    r.created_at DESC,
+    r.id DESC
+LIMIT $15
 `
 
-type GetRoomByIdRow struct {
-	Room Room
+type ListRoomsParams struct {
+	BuildingName    *string
+	BuildingID      *uuid.UUID
+	FloorID         *uuid.UUID
+	FloorNumber     *string
+	RoomType        *string
+	NamePrefix      *string
+	MinCapacity     int32
+	Equipment       []string
+	Accessibility   []string
+	CustomFields    []byte
+	CursorID        *uuid.UUID
+	OrderBy         string
+	CursorName      *string
+	CursorCreatedAt pgtype.Timestamptz
+	PageSize        int32
 }
 
-func (q *Queries) GetRoomById(ctx context.Context, id uuid.UUID) (GetRoomByIdRow, error) {
-	row := q.db.QueryRow(ctx, getRoomById, id)
-	var i GetRoomByIdRow
-	err := row.Scan(
-		&i.Room.ID,
-		&i.Room.OrganizationID,
-		&i.Room.Name,
-		&i.Room.BuildingName,
-		&i.Room.FloorNumber,
-		&i.Room.RoomType,
-		&i.Room.Description,
-		&i.Room.CreatedAt,
-		&i.Room.UpdatedAt,
-	)
-	return i, err
-}
-
-const getRoomsByTenant = `-- name: GetRoomsByTenant :many
-SELECT r.id, r.organization_id, r.name, r.building_name, r.floor_number, r.room_type, r.description, r.created_at, r.updated_at
-FROM rooms r
-INNER JOIN room_assignments ra ON r.id = ra.room_id
-WHERE ra.tenant_id = $1
-  AND (ra.expires_at IS NULL OR ra.expires_at > NOW())
-ORDER BY r.created_at DESC
-`
-
-type GetRoomsByTenantRow struct {
+type ListRoomsRow struct {
 	Room Room
 }
 
-func (q *Queries) GetRoomsByTenant(ctx context.Context, tenantID uuid.UUID) ([]GetRoomsByTenantRow, error) {
-	rows, err := q.db.Query(ctx, getRoomsByTenant, tenantID)
+// order_by が name の場合は名前の昇順、それ以外は新しい順に返す。カーソルを指定した場合はその部屋より後ろだけを返す
+func (q *Queries) ListRooms(ctx context.Context, arg ListRoomsParams) ([]ListRoomsRow, error) {
+	rows, err := q.db.Query(ctx, listRooms,
+		arg.BuildingName,
+		arg.BuildingID,
+		arg.FloorID,
+		arg.FloorNumber,
+		arg.RoomType,
+		arg.NamePrefix,
+		arg.MinCapacity,
+		arg.Equipment,
+		arg.Accessibility,
+		arg.CustomFields,
+		arg.CursorID,
+		arg.OrderBy,
+		arg.CursorName,
+		arg.CursorCreatedAt,
+		arg.PageSize,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetRoomsByTenantRow
+	var items []ListRoomsRow
 	for rows.Next() {
-		var i GetRoomsByTenantRow
+		var i ListRoomsRow
 		if err := rows.Scan(
 			&i.Room.ID,
 			&i.Room.OrganizationID,
@@ -154,6 +275,12 @@ func (q *Queries) GetRoomsByTenant(ctx context.Context, tenantID uuid.UUID) ([]G
 			&i.Room.Description,
 			&i.Room.CreatedAt,
 			&i.Room.UpdatedAt,
+			&i.Room.FloorID,
+			&i.Room.Capacity,
+			&i.Room.Equipment,
+			&i.Room.Accessibility,
+			&i.Room.CustomFields,
+			&i.Room.Version,
 		); err != nil {
 			return nil, err
 		}
@@ -164,3 +291,38 @@ func (q *Queries) GetRoomsByTenant(ctx context.Context, tenantID uuid.UUID) ([]G
 	}
 	return items, nil
 }
+
+const updateRoomAttributes = `-- name: UpdateRoomAttributes :one
+UPDATE rooms
+SET capacity = $1,
+    equipment = $2,
+    accessibility = $3,
+    custom_fields = $4
+WHERE id = $5
+AND version = $6
+RETURNING version
+`
+
+type UpdateRoomAttributesParams struct {
+	Capacity      int32
+	Equipment     []string
+	Accessibility []string
+	CustomFields  []byte
+	ID            uuid.UUID
+	Version       int64
+}
+
+// 取得時から版数が変わっていないときだけ更新し、更新後の版数を返す。版数はトリガーで増やす
+func (q *Queries) UpdateRoomAttributes(ctx context.Context, arg UpdateRoomAttributesParams) (int64, error) {
+	row := q.db.QueryRow(ctx, updateRoomAttributes,
+		arg.Capacity,
+		arg.Equipment,
+		arg.Accessibility,
+		arg.CustomFields,
+		arg.ID,
+		arg.Version,
+	)
+	var version int64
+	err := row.Scan(&version)
+	return version, err
+}
@@ -17,6 +17,8 @@ INSERT INTO room_assignments(
     id,
     tenant_id,
     room_id,
+    group_id,
+    key_loan_group_id,
     assigned_at,
     expires_at
 )
@@ -25,16 +27,20 @@ VALUES(
     $2,
     $3,
     $4,
-    $5
+    $5,
+    $6,
+    $7
 )
 `
 
 type CreateRoomAssignmentParams struct {
-	ID         uuid.UUID
-	TenantID   uuid.UUID
-	RoomID     uuid.UUID
-	AssignedAt pgtype.Timestamptz
-	ExpiresAt  pgtype.Timestamptz
+	ID             uuid.UUID
+	TenantID       uuid.UUID
+	RoomID         uuid.UUID
+	GroupID        *uuid.UUID
+	KeyLoanGroupID *uuid.UUID
+	AssignedAt     pgtype.Timestamptz
+	ExpiresAt      pgtype.Timestamptz
 }
 
 func (q *Queries) CreateRoomAssignment(ctx context.Context, arg CreateRoomAssignmentParams) error {
@@ -42,8 +48,147 @@ func (q *Queries) CreateRoomAssignment(ctx context.Context, arg CreateRoomAssign
 		arg.ID,
 		arg.TenantID,
 		arg.RoomID,
+		arg.GroupID,
+		arg.KeyLoanGroupID,
 		arg.AssignedAt,
 		arg.ExpiresAt,
 	)
 	return err
 }
+
+const deleteRoomAssignmentsByTenant = `-- name: DeleteRoomAssignmentsByTenant :exec
+DELETE FROM room_assignments
+WHERE tenant_id = $1
+`
+
+func (q *Queries) DeleteRoomAssignmentsByTenant(ctx context.Context, tenantID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteRoomAssignmentsByTenant, tenantID)
+	return err
+}
+
+const endRoomAssignmentsByTenant = `-- name: EndRoomAssignmentsByTenant :execrows
+UPDATE room_assignments
+SET expires_at = $1
+WHERE tenant_id = $2
+AND (expires_at IS NULL OR expires_at > $1)
+`
+
+type EndRoomAssignmentsByTenantParams struct {
+	Now      pgtype.Timestamptz
+	TenantID uuid.UUID
+}
+
+// テナントの有効な割り当ての期限を now にして終了する
+func (q *Queries) EndRoomAssignmentsByTenant(ctx context.Context, arg EndRoomAssignmentsByTenantParams) (int64, error) {
+	result, err := q.db.Exec(ctx, endRoomAssignmentsByTenant, arg.Now, arg.TenantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getAssignedRoomIDsByTenant = `-- name: GetAssignedRoomIDsByTenant :many
+SELECT DISTINCT ra.room_id
+FROM room_assignments ra
+WHERE ra.tenant_id = $1
+AND (ra.expires_at IS NULL OR ra.expires_at > NOW())
+`
+
+// テナントに現在割り当てられている部屋のIDを返す
+func (q *Queries) GetAssignedRoomIDsByTenant(ctx context.Context, tenantID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, getAssignedRoomIDsByTenant, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var room_id uuid.UUID
+		if err := rows.Scan(&room_id); err != nil {
+			return nil, err
+		}
+		items = append(items, room_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listExpiringRoomAssignments = `-- name: ListExpiringRoomAssignments :many
+SELECT
+    ra.id, ra.tenant_id, ra.room_id, ra.assigned_at, ra.expires_at, ra.created_at, ra.updated_at, ra.group_id, ra.key_loan_group_id,
+    t.id, t.organization_id, t.name, t.description, t.tenant_type, t.created_at, t.updated_at, t.archived_at, t.version,
+    r.id, r.organization_id, r.name, r.building_name, r.floor_number, r.room_type, r.description, r.created_at, r.updated_at, r.floor_id, r.capacity, r.equipment, r.accessibility, r.custom_fields, r.version
+FROM room_assignments ra
+INNER JOIN tenants t ON t.id = ra.tenant_id
+INNER JOIN rooms r ON r.id = ra.room_id
+WHERE ra.expires_at > $1
+AND ra.expires_at <= $2
+ORDER BY ra.expires_at
+`
+
+type ListExpiringRoomAssignmentsParams struct {
+	Now   pgtype.Timestamptz
+	Until pgtype.Timestamptz
+}
+
+type ListExpiringRoomAssignmentsRow struct {
+	RoomAssignment RoomAssignment
+	Tenant         Tenant
+	Room           Room
+}
+
+func (q *Queries) ListExpiringRoomAssignments(ctx context.Context, arg ListExpiringRoomAssignmentsParams) ([]ListExpiringRoomAssignmentsRow, error) {
+	rows, err := q.db.Query(ctx, listExpiringRoomAssignments, arg.Now, arg.Until)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListExpiringRoomAssignmentsRow
+	for rows.Next() {
+		var i ListExpiringRoomAssignmentsRow
+		if err := rows.Scan(
+			&i.RoomAssignment.ID,
+			&i.RoomAssignment.TenantID,
+			&i.RoomAssignment.RoomID,
+			&i.RoomAssignment.AssignedAt,
+			&i.RoomAssignment.ExpiresAt,
+			&i.RoomAssignment.CreatedAt,
+			&i.RoomAssignment.UpdatedAt,
+			&i.RoomAssignment.GroupID,
+			&i.RoomAssignment.KeyLoanGroupID,
+			&i.Tenant.ID,
+			&i.Tenant.OrganizationID,
+			&i.Tenant.Name,
+			&i.Tenant.Description,
+			&i.Tenant.TenantType,
+			&i.Tenant.CreatedAt,
+			&i.Tenant.UpdatedAt,
+			&i.Tenant.ArchivedAt,
+			&i.Tenant.Version,
+			&i.Room.ID,
+			&i.Room.OrganizationID,
+			&i.Room.Name,
+			&i.Room.BuildingName,
+			&i.Room.FloorNumber,
+			&i.Room.RoomType,
+			&i.Room.Description,
+			&i.Room.CreatedAt,
+			&i.Room.UpdatedAt,
+			&i.Room.FloorID,
+			&i.Room.Capacity,
+			&i.Room.Equipment,
+			&i.Room.Accessibility,
+			&i.Room.CustomFields,
+			&i.Room.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
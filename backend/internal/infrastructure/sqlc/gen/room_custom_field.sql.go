@@ -0,0 +1,153 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: room_custom_field.sql
+
+package gen
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const createRoomCustomField = `-- name: CreateRoomCustomField :exec
+INSERT INTO room_custom_fields(
+    id,
+    organization_id,
+    key,
+    label,
+    field_type,
+    required,
+    options
+)
+VALUES(
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7
+)
+`
+
+type CreateRoomCustomFieldParams struct {
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+	Key            string
+	Label          string
+	FieldType      string
+	Required       bool
+	Options        []string
+}
+
+func (q *Queries) CreateRoomCustomField(ctx context.Context, arg CreateRoomCustomFieldParams) error {
+	_, err := q.db.Exec(ctx, createRoomCustomField,
+		arg.ID,
+		arg.OrganizationID,
+		arg.Key,
+		arg.Label,
+		arg.FieldType,
+		arg.Required,
+		arg.Options,
+	)
+	return err
+}
+
+const deleteRoomCustomField = `-- name: DeleteRoomCustomField :execrows
+DELETE FROM room_custom_fields
+WHERE id = $1
+`
+
+func (q *Queries) DeleteRoomCustomField(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteRoomCustomField, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getRoomCustomField = `-- name: GetRoomCustomField :one
+SELECT f.id, f.organization_id, f.key, f.label, f.field_type, f.required, f.options, f.created_at, f.updated_at
+FROM room_custom_fields f
+WHERE f.id = $1
+`
+
+type GetRoomCustomFieldRow struct {
+	RoomCustomField RoomCustomField
+}
+
+func (q *Queries) GetRoomCustomField(ctx context.Context, id uuid.UUID) (GetRoomCustomFieldRow, error) {
+	row := q.db.QueryRow(ctx, getRoomCustomField, id)
+	var i GetRoomCustomFieldRow
+	err := row.Scan(
+		&i.RoomCustomField.ID,
+		&i.RoomCustomField.OrganizationID,
+		&i.RoomCustomField.Key,
+		&i.RoomCustomField.Label,
+		&i.RoomCustomField.FieldType,
+		&i.RoomCustomField.Required,
+		&i.RoomCustomField.Options,
+		&i.RoomCustomField.CreatedAt,
+		&i.RoomCustomField.UpdatedAt,
+	)
+	return i, err
+}
+
+const listRoomCustomFields = `-- name: ListRoomCustomFields :many
+SELECT f.id, f.organization_id, f.key, f.label, f.field_type, f.required, f.options, f.created_at, f.updated_at
+FROM room_custom_fields f
+ORDER BY f.created_at, f.id
+`
+
+type ListRoomCustomFieldsRow struct {
+	RoomCustomField RoomCustomField
+}
+
+func (q *Queries) ListRoomCustomFields(ctx context.Context) ([]ListRoomCustomFieldsRow, error) {
+	rows, err := q.db.Query(ctx, listRoomCustomFields)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRoomCustomFieldsRow
+	for rows.Next() {
+		var i ListRoomCustomFieldsRow
+		if err := rows.Scan(
+			&i.RoomCustomField.ID,
+			&i.RoomCustomField.OrganizationID,
+			&i.RoomCustomField.Key,
+			&i.RoomCustomField.Label,
+			&i.RoomCustomField.FieldType,
+			&i.RoomCustomField.Required,
+			&i.RoomCustomField.Options,
+			&i.RoomCustomField.CreatedAt,
+			&i.RoomCustomField.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const removeRoomCustomFieldValues = `-- name: RemoveRoomCustomFieldValues :exec
+UPDATE rooms
+SET custom_fields = custom_fields - $1::text
+WHERE organization_id = $2 AND custom_fields ? $1::text
+`
+
+type RemoveRoomCustomFieldValuesParams struct {
+	Key            string
+	OrganizationID uuid.UUID
+}
+
+// 削除したカスタム項目の値を組織の部屋から取り除く
+func (q *Queries) RemoveRoomCustomFieldValues(ctx context.Context, arg RemoveRoomCustomFieldValuesParams) error {
+	_, err := q.db.Exec(ctx, removeRoomCustomFieldValues, arg.Key, arg.OrganizationID)
+	return err
+}
@@ -0,0 +1,136 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: room_type.sql
+
+package gen
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const countRoomsByType = `-- name: CountRoomsByType :one
+SELECT COUNT(*)::INT
+FROM rooms r
+WHERE r.organization_id = $1 AND r.room_type = $2
+`
+
+type CountRoomsByTypeParams struct {
+	OrganizationID uuid.UUID
+	RoomType       string
+}
+
+func (q *Queries) CountRoomsByType(ctx context.Context, arg CountRoomsByTypeParams) (int32, error) {
+	row := q.db.QueryRow(ctx, countRoomsByType, arg.OrganizationID, arg.RoomType)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const createRoomType = `-- name: CreateRoomType :exec
+INSERT INTO room_types(
+    id,
+    organization_id,
+    key,
+    label
+)
+VALUES(
+    $1,
+    $2,
+    $3,
+    $4
+)
+`
+
+type CreateRoomTypeParams struct {
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+	Key            string
+	Label          string
+}
+
+func (q *Queries) CreateRoomType(ctx context.Context, arg CreateRoomTypeParams) error {
+	_, err := q.db.Exec(ctx, createRoomType,
+		arg.ID,
+		arg.OrganizationID,
+		arg.Key,
+		arg.Label,
+	)
+	return err
+}
+
+const deleteRoomType = `-- name: DeleteRoomType :execrows
+DELETE FROM room_types
+WHERE id = $1
+`
+
+func (q *Queries) DeleteRoomType(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteRoomType, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getRoomType = `-- name: GetRoomType :one
+SELECT t.id, t.organization_id, t.key, t.label, t.created_at, t.updated_at
+FROM room_types t
+WHERE t.id = $1
+`
+
+type GetRoomTypeRow struct {
+	RoomType RoomType
+}
+
+func (q *Queries) GetRoomType(ctx context.Context, id uuid.UUID) (GetRoomTypeRow, error) {
+	row := q.db.QueryRow(ctx, getRoomType, id)
+	var i GetRoomTypeRow
+	err := row.Scan(
+		&i.RoomType.ID,
+		&i.RoomType.OrganizationID,
+		&i.RoomType.Key,
+		&i.RoomType.Label,
+		&i.RoomType.CreatedAt,
+		&i.RoomType.UpdatedAt,
+	)
+	return i, err
+}
+
+const listRoomTypes = `-- name: ListRoomTypes :many
+SELECT t.id, t.organization_id, t.key, t.label, t.created_at, t.updated_at
+FROM room_types t
+ORDER BY t.created_at, t.id
+`
+
+type ListRoomTypesRow struct {
+	RoomType RoomType
+}
+
+func (q *Queries) ListRoomTypes(ctx context.Context) ([]ListRoomTypesRow, error) {
+	rows, err := q.db.Query(ctx, listRoomTypes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRoomTypesRow
+	for rows.Next() {
+		var i ListRoomTypesRow
+		if err := rows.Scan(
+			&i.RoomType.ID,
+			&i.RoomType.OrganizationID,
+			&i.RoomType.Key,
+			&i.RoomType.Label,
+			&i.RoomType.CreatedAt,
+			&i.RoomType.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,159 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: search.sql
+
+package gen
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const search = `-- name: Search :many
+SELECT kind, id, title, subtitle, parent_id, score
+FROM (
+    SELECT
+        'room'::text AS kind,
+        r.id,
+        r.name AS title,
+        (r.building_name || ' ' || r.floor_number)::text AS subtitle,
+        NULL::uuid AS parent_id,
+        (
+            GREATEST(
+                similarity(r.name, $1::text),
+                similarity(r.building_name, $1::text) * 0.8,
+                word_similarity($1::text, r.description) * 0.5
+            )
+            + ts_rank(
+                to_tsvector('simple', r.name || ' ' || r.building_name || ' ' || r.description),
+                plainto_tsquery('simple', $1::text)
+            )
+        )::real AS score
+    FROM rooms r
+    WHERE r.organization_id = $2
+      AND (cardinality($3::text[]) = 0 OR 'room' = ANY($3::text[]))
+      AND (
+          r.name ILIKE $4::text
+          OR r.building_name ILIKE $4::text
+          OR r.description ILIKE $4::text
+          OR to_tsvector('simple', r.name || ' ' || r.building_name || ' ' || r.description) @@ plainto_tsquery('simple', $1::text)
+      )
+
+    UNION ALL
+
+    SELECT
+        'key'::text AS kind,
+        k.id,
+        k.key_number AS title,
+        kr.name AS subtitle,
+        k.room_id AS parent_id,
+        similarity(k.key_number, $1::text)::real AS score
+    FROM keys k
+    INNER JOIN rooms kr ON kr.id = k.room_id
+    WHERE k.organization_id = $2
+      AND (cardinality($3::text[]) = 0 OR 'key' = ANY($3::text[]))
+      AND k.key_number ILIKE $4::text
+
+    UNION ALL
+
+    SELECT
+        'tenant'::text AS kind,
+        t.id,
+        t.name AS title,
+        t.description AS subtitle,
+        NULL::uuid AS parent_id,
+        (
+            similarity(t.name, $1::text)
+            + ts_rank(
+                to_tsvector('simple', t.name || ' ' || t.description),
+                plainto_tsquery('simple', $1::text)
+            )
+        )::real AS score
+    FROM tenants t
+    WHERE t.organization_id = $2
+      AND (cardinality($3::text[]) = 0 OR 'tenant' = ANY($3::text[]))
+      AND (
+          t.name ILIKE $4::text
+          OR to_tsvector('simple', t.name || ' ' || t.description) @@ plainto_tsquery('simple', $1::text)
+      )
+
+    UNION ALL
+
+    -- 組織のいずれかのテナントに参加中のユーザーだけを返す
+    SELECT
+        'member'::text AS kind,
+        u.id,
+        u.email AS title,
+        u.name AS subtitle,
+        NULL::uuid AS parent_id,
+        similarity(u.email, $1::text)::real AS score
+    FROM users u
+    WHERE (cardinality($3::text[]) = 0 OR 'member' = ANY($3::text[]))
+      AND u.email ILIKE $4::text
+      AND EXISTS (
+          SELECT 1
+          FROM tenant_memberships tm
+          INNER JOIN tenants mt ON mt.id = tm.tenant_id
+          WHERE tm.user_id = u.id
+            AND tm.left_at IS NULL
+            AND mt.organization_id = $2
+      )
+) results
+ORDER BY score DESC, title ASC, id ASC
+LIMIT $5
+`
+
+type SearchParams struct {
+	Query          string
+	OrganizationID uuid.UUID
+	Kinds          []string
+	Pattern        string
+	ResultLimit    int32
+}
+
+type SearchRow struct {
+	Kind     string
+	ID       uuid.UUID
+	Title    string
+	Subtitle string
+	ParentID *uuid.UUID
+	Score    float32
+}
+
+// 組織の部屋・鍵・テナント・メンバーを横断して探し、関連度の高い順に返す。
+// pattern は ILIKE のワイルドカードをエスケープして % で囲んだ検索語。
+// RLS に加えて organization_id でも絞り込み、他の組織の行を返さない
+func (q *Queries) Search(ctx context.Context, arg SearchParams) ([]SearchRow, error) {
+	rows, err := q.db.Query(ctx, search,
+		arg.Query,
+		arg.OrganizationID,
+		arg.Kinds,
+		arg.Pattern,
+		arg.ResultLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchRow
+	for rows.Next() {
+		var i SearchRow
+		if err := rows.Scan(
+			&i.Kind,
+			&i.ID,
+			&i.Title,
+			&i.Subtitle,
+			&i.ParentID,
+			&i.Score,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
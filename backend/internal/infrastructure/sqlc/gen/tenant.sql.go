@@ -9,8 +9,48 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const archiveTenant = `-- name: ArchiveTenant :execrows
+UPDATE tenants
+SET archived_at = $1
+WHERE id = $2 AND archived_at IS NULL
+`
+
+type ArchiveTenantParams struct {
+	ArchivedAt pgtype.Timestamptz
+	ID         uuid.UUID
+}
+
+// アーカイブ済みのテナントは更新しない
+func (q *Queries) ArchiveTenant(ctx context.Context, arg ArchiveTenantParams) (int64, error) {
+	result, err := q.db.Exec(ctx, archiveTenant, arg.ArchivedAt, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const countKeysInUseByTenant = `-- name: CountKeysInUseByTenant :one
+SELECT COUNT(DISTINCT k.id)::INT
+FROM keys k
+INNER JOIN room_assignments ra ON ra.room_id = k.room_id
+INNER JOIN tenants t ON t.id = ra.tenant_id
+WHERE ra.tenant_id = $1
+AND k.status = 'in_use'
+AND (ra.expires_at IS NULL OR ra.expires_at >= COALESCE(t.archived_at, NOW()))
+`
+
+// テナントに割り当てられている部屋の貸し出し中の鍵を数える。
+// アーカイブしたテナントは、アーカイブで終了した割り当ての部屋も含める
+func (q *Queries) CountKeysInUseByTenant(ctx context.Context, tenantID uuid.UUID) (int32, error) {
+	row := q.db.QueryRow(ctx, countKeysInUseByTenant, tenantID)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
 const createTenant = `-- name: CreateTenant :exec
 INSERT INTO tenants(
     id,
@@ -47,48 +87,23 @@ func (q *Queries) CreateTenant(ctx context.Context, arg CreateTenantParams) erro
 	return err
 }
 
-const getAllTenants = `-- name: GetAllTenants :many
-SELECT t.id, t.organization_id, t.name, t.description, t.tenant_type, t.created_at, t.updated_at
-FROM tenants t
-ORDER BY created_at DESC
+const deleteTenant = `-- name: DeleteTenant :execrows
+DELETE FROM tenants
+WHERE id = $1
 `
 
-type GetAllTenantsRow struct {
-	Tenant Tenant
-}
-
-func (q *Queries) GetAllTenants(ctx context.Context) ([]GetAllTenantsRow, error) {
-	rows, err := q.db.Query(ctx, getAllTenants)
+func (q *Queries) DeleteTenant(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteTenant, id)
 	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []GetAllTenantsRow
-	for rows.Next() {
-		var i GetAllTenantsRow
-		if err := rows.Scan(
-			&i.Tenant.ID,
-			&i.Tenant.OrganizationID,
-			&i.Tenant.Name,
-			&i.Tenant.Description,
-			&i.Tenant.TenantType,
-			&i.Tenant.CreatedAt,
-			&i.Tenant.UpdatedAt,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
+		return 0, err
 	}
-	return items, nil
+	return result.RowsAffected(), nil
 }
 
 const getTenantById = `-- name: GetTenantById :one
 SELECT
-    t.id, t.organization_id, t.name, t.description, t.tenant_type, t.created_at, t.updated_at,
-    jc.id, jc.tenant_id, jc.code, jc.expires_at, jc.max_uses, jc.used_count, jc.created_at
+    t.id, t.organization_id, t.name, t.description, t.tenant_type, t.created_at, t.updated_at, t.archived_at, t.version,
+    jc.id, jc.tenant_id, jc.code, jc.expires_at, jc.max_uses, jc.used_count, jc.created_at, jc.organization_id
 FROM tenants t
 INNER JOIN tenant_join_codes jc
     ON jc.tenant_id = t.id
@@ -111,6 +126,8 @@ func (q *Queries) GetTenantById(ctx context.Context, id uuid.UUID) (GetTenantByI
 		&i.Tenant.TenantType,
 		&i.Tenant.CreatedAt,
 		&i.Tenant.UpdatedAt,
+		&i.Tenant.ArchivedAt,
+		&i.Tenant.Version,
 		&i.TenantJoinCode.ID,
 		&i.TenantJoinCode.TenantID,
 		&i.TenantJoinCode.Code,
@@ -118,37 +135,142 @@ func (q *Queries) GetTenantById(ctx context.Context, id uuid.UUID) (GetTenantByI
 		&i.TenantJoinCode.MaxUses,
 		&i.TenantJoinCode.UsedCount,
 		&i.TenantJoinCode.CreatedAt,
+		&i.TenantJoinCode.OrganizationID,
 	)
 	return i, err
 }
 
-const getTenantsByUserID = `-- name: GetTenantsByUserID :many
+const listTenants = `-- name: ListTenants :many
+SELECT t.id, t.organization_id, t.name, t.description, t.tenant_type, t.created_at, t.updated_at, t.archived_at, t.version
+FROM tenants t
+WHERE ($1::text IS NULL OR t.tenant_type = $1::text)
+AND ($2::text IS NULL OR starts_with(t.name, $2::text))
+AND (
+    $3::uuid IS NULL
+    OR ($4::text = 'name' AND (t.name, t.id) > ($5::text, $3::uuid))
+    OR ($4::text <> 'name' AND (t.created_at, t.id) < ($6::timestamptz, $3::uuid))
+)
+ORDER BY
+    CASE WHEN $4::text = 'name' THEN t.name END ASC,
+    CASE WHEN $4::text = 'name' THEN t.id END ASC,
+    t.created_at DESC,
+    t.id DESC
+LIMIT $7
+`
+
+type ListTenantsParams struct {
+	TenantType      *string
+	NamePrefix      *string
+	CursorID        *uuid.UUID
+	OrderBy         string
+	CursorName      *string
+	CursorCreatedAt pgtype.Timestamptz
+	PageSize        int32
+}
+
+type ListTenantsRow struct {
+	Tenant Tenant
+}
+
+// order_by が name の場合は名前の昇順、それ以外は新しい順に返す。カーソルを指定した場合はそのテナントより後ろだけを返す
+func (q *Queries) ListTenants(ctx context.Context, arg ListTenantsParams) ([]ListTenantsRow, error) {
+	rows, err := q.db.Query(ctx, listTenants,
+		arg.TenantType,
+		arg.NamePrefix,
+		arg.CursorID,
+		arg.OrderBy,
+		arg.CursorName,
+		arg.CursorCreatedAt,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTenantsRow
+	for rows.Next() {
+		var i ListTenantsRow
+		if err := rows.Scan(
+			&i.Tenant.ID,
+			&i.Tenant.OrganizationID,
+			&i.Tenant.Name,
+			&i.Tenant.Description,
+			&i.Tenant.TenantType,
+			&i.Tenant.CreatedAt,
+			&i.Tenant.UpdatedAt,
+			&i.Tenant.ArchivedAt,
+			&i.Tenant.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTenantsByUserID = `-- name: ListTenantsByUserID :many
 SELECT
-    t.id, t.organization_id, t.name, t.description, t.tenant_type, t.created_at, t.updated_at,
+    t.id, t.organization_id, t.name, t.description, t.tenant_type, t.created_at, t.updated_at, t.archived_at, t.version,
     COUNT(tm_all.id)::INT AS member_count
 FROM tenants t
 INNER JOIN tenant_memberships tm ON t.id = tm.tenant_id
 LEFT JOIN tenant_memberships tm_all ON t.id = tm_all.tenant_id AND tm_all.left_at IS NULL
 WHERE tm.user_id = $1
   AND tm.left_at IS NULL
+  AND ($2::text IS NULL OR t.tenant_type = $2::text)
+  AND ($3::text IS NULL OR starts_with(t.name, $3::text))
+  AND (
+      $4::uuid IS NULL
+      OR ($5::text = 'name' AND (t.name, t.id) > ($6::text, $4::uuid))
+      OR ($5::text <> 'name' AND (t.created_at, t.id) < ($7::timestamptz, $4::uuid))
+  )
 GROUP BY t.id
-ORDER BY t.created_at DESC
+ORDER BY
+    CASE WHEN $5::text = 'name' THEN t.name END ASC,
+    CASE WHEN $5::text = 'name' THEN t.id END ASC,
+    t.created_at DESC,
+    t.id DESC
+LIMIT $8
 `
 
-type GetTenantsByUserIDRow struct {
+type ListTenantsByUserIDParams struct {
+	UserID          uuid.UUID
+	TenantType      *string
+	NamePrefix      *string
+	CursorID        *uuid.UUID
+	OrderBy         string
+	CursorName      *string
+	CursorCreatedAt pgtype.Timestamptz
+	PageSize        int32
+}
+
+type ListTenantsByUserIDRow struct {
 	Tenant      Tenant
 	MemberCount int32
 }
 
-func (q *Queries) GetTenantsByUserID(ctx context.Context, userID uuid.UUID) ([]GetTenantsByUserIDRow, error) {
-	rows, err := q.db.Query(ctx, getTenantsByUserID, userID)
+// ユーザーが参加しているテナントを ListTenants と同じ並び順・カーソルで返す
+func (q *Queries) ListTenantsByUserID(ctx context.Context, arg ListTenantsByUserIDParams) ([]ListTenantsByUserIDRow, error) {
+	rows, err := q.db.Query(ctx, listTenantsByUserID,
+		arg.UserID,
+		arg.TenantType,
+		arg.NamePrefix,
+		arg.CursorID,
+		arg.OrderBy,
+		arg.CursorName,
+		arg.CursorCreatedAt,
+		arg.PageSize,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetTenantsByUserIDRow
+	var items []ListTenantsByUserIDRow
 	for rows.Next() {
-		var i GetTenantsByUserIDRow
+		var i ListTenantsByUserIDRow
 		if err := rows.Scan(
 			&i.Tenant.ID,
 			&i.Tenant.OrganizationID,
@@ -157,6 +279,8 @@ func (q *Queries) GetTenantsByUserID(ctx context.Context, userID uuid.UUID) ([]G
 			&i.Tenant.TenantType,
 			&i.Tenant.CreatedAt,
 			&i.Tenant.UpdatedAt,
+			&i.Tenant.ArchivedAt,
+			&i.Tenant.Version,
 			&i.MemberCount,
 		); err != nil {
 			return nil, err
@@ -169,28 +293,36 @@ func (q *Queries) GetTenantsByUserID(ctx context.Context, userID uuid.UUID) ([]G
 	return items, nil
 }
 
-const updateTenant = `-- name: UpdateTenant :exec
+const updateTenant = `-- name: UpdateTenant :one
 UPDATE tenants
-SET 
-    name = $2,
-    description = $3,
-    tenant_type = $4
-WHERE id = $1
+SET
+    name = $1,
+    description = $2,
+    tenant_type = $3
+WHERE id = $4
+AND archived_at IS NULL
+AND version = $5
+RETURNING version
 `
 
 type UpdateTenantParams struct {
-	ID          uuid.UUID
 	Name        string
 	Description string
 	TenantType  string
+	ID          uuid.UUID
+	Version     int64
 }
 
-func (q *Queries) UpdateTenant(ctx context.Context, arg UpdateTenantParams) error {
-	_, err := q.db.Exec(ctx, updateTenant,
-		arg.ID,
+// 取得時から版数が変わっていない、アーカイブされていないテナントだけを更新し、更新後の版数を返す。版数はトリガーで増やす
+func (q *Queries) UpdateTenant(ctx context.Context, arg UpdateTenantParams) (int64, error) {
+	row := q.db.QueryRow(ctx, updateTenant,
 		arg.Name,
 		arg.Description,
 		arg.TenantType,
+		arg.ID,
+		arg.Version,
 	)
-	return err
+	var version int64
+	err := row.Scan(&version)
+	return version, err
 }
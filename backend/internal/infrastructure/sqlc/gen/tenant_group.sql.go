@@ -0,0 +1,246 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: tenant_group.sql
+
+package gen
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const addTenantGroupMember = `-- name: AddTenantGroupMember :execrows
+INSERT INTO tenant_group_memberships(
+    group_id,
+    user_id
+)
+VALUES(
+    $1,
+    $2
+)
+ON CONFLICT (group_id, user_id) DO NOTHING
+`
+
+type AddTenantGroupMemberParams struct {
+	GroupID uuid.UUID
+	UserID  uuid.UUID
+}
+
+func (q *Queries) AddTenantGroupMember(ctx context.Context, arg AddTenantGroupMemberParams) (int64, error) {
+	result, err := q.db.Exec(ctx, addTenantGroupMember, arg.GroupID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const createTenantGroup = `-- name: CreateTenantGroup :exec
+INSERT INTO tenant_groups(
+    id,
+    tenant_id,
+    organization_id,
+    parent_group_id,
+    name,
+    description
+)
+VALUES(
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6
+)
+`
+
+type CreateTenantGroupParams struct {
+	ID             uuid.UUID
+	TenantID       uuid.UUID
+	OrganizationID uuid.UUID
+	ParentGroupID  *uuid.UUID
+	Name           string
+	Description    string
+}
+
+func (q *Queries) CreateTenantGroup(ctx context.Context, arg CreateTenantGroupParams) error {
+	_, err := q.db.Exec(ctx, createTenantGroup,
+		arg.ID,
+		arg.TenantID,
+		arg.OrganizationID,
+		arg.ParentGroupID,
+		arg.Name,
+		arg.Description,
+	)
+	return err
+}
+
+const deleteTenantGroupsByTenant = `-- name: DeleteTenantGroupsByTenant :exec
+DELETE FROM tenant_groups
+WHERE tenant_id = $1
+`
+
+// グループのメンバーと子グループも合わせて削除される
+func (q *Queries) DeleteTenantGroupsByTenant(ctx context.Context, tenantID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteTenantGroupsByTenant, tenantID)
+	return err
+}
+
+const getTenantGroup = `-- name: GetTenantGroup :one
+SELECT g.id, g.tenant_id, g.organization_id, g.parent_group_id, g.name, g.description, g.created_at, g.updated_at
+FROM tenant_groups g
+WHERE g.id = $1
+`
+
+type GetTenantGroupRow struct {
+	TenantGroup TenantGroup
+}
+
+func (q *Queries) GetTenantGroup(ctx context.Context, id uuid.UUID) (GetTenantGroupRow, error) {
+	row := q.db.QueryRow(ctx, getTenantGroup, id)
+	var i GetTenantGroupRow
+	err := row.Scan(
+		&i.TenantGroup.ID,
+		&i.TenantGroup.TenantID,
+		&i.TenantGroup.OrganizationID,
+		&i.TenantGroup.ParentGroupID,
+		&i.TenantGroup.Name,
+		&i.TenantGroup.Description,
+		&i.TenantGroup.CreatedAt,
+		&i.TenantGroup.UpdatedAt,
+	)
+	return i, err
+}
+
+const getTenantGroupByTenantAndName = `-- name: GetTenantGroupByTenantAndName :one
+SELECT g.id, g.tenant_id, g.organization_id, g.parent_group_id, g.name, g.description, g.created_at, g.updated_at
+FROM tenant_groups g
+WHERE g.tenant_id = $1 AND g.name = $2
+`
+
+type GetTenantGroupByTenantAndNameParams struct {
+	TenantID uuid.UUID
+	Name     string
+}
+
+type GetTenantGroupByTenantAndNameRow struct {
+	TenantGroup TenantGroup
+}
+
+func (q *Queries) GetTenantGroupByTenantAndName(ctx context.Context, arg GetTenantGroupByTenantAndNameParams) (GetTenantGroupByTenantAndNameRow, error) {
+	row := q.db.QueryRow(ctx, getTenantGroupByTenantAndName, arg.TenantID, arg.Name)
+	var i GetTenantGroupByTenantAndNameRow
+	err := row.Scan(
+		&i.TenantGroup.ID,
+		&i.TenantGroup.TenantID,
+		&i.TenantGroup.OrganizationID,
+		&i.TenantGroup.ParentGroupID,
+		&i.TenantGroup.Name,
+		&i.TenantGroup.Description,
+		&i.TenantGroup.CreatedAt,
+		&i.TenantGroup.UpdatedAt,
+	)
+	return i, err
+}
+
+const listTenantGroupMembers = `-- name: ListTenantGroupMembers :many
+SELECT u.id, u.email, u.name, u.icon, u.created_at, u.updated_at
+FROM users u
+INNER JOIN tenant_group_memberships gm ON u.id = gm.user_id
+WHERE gm.group_id = $1
+ORDER BY gm.created_at
+`
+
+type ListTenantGroupMembersRow struct {
+	User User
+}
+
+func (q *Queries) ListTenantGroupMembers(ctx context.Context, groupID uuid.UUID) ([]ListTenantGroupMembersRow, error) {
+	rows, err := q.db.Query(ctx, listTenantGroupMembers, groupID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTenantGroupMembersRow
+	for rows.Next() {
+		var i ListTenantGroupMembersRow
+		if err := rows.Scan(
+			&i.User.ID,
+			&i.User.Email,
+			&i.User.Name,
+			&i.User.Icon,
+			&i.User.CreatedAt,
+			&i.User.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTenantGroupsByTenant = `-- name: ListTenantGroupsByTenant :many
+SELECT
+    g.id, g.tenant_id, g.organization_id, g.parent_group_id, g.name, g.description, g.created_at, g.updated_at,
+    (SELECT COUNT(*) FROM tenant_group_memberships gm WHERE gm.group_id = g.id)::INT AS member_count
+FROM tenant_groups g
+WHERE g.tenant_id = $1
+ORDER BY g.created_at
+`
+
+type ListTenantGroupsByTenantRow struct {
+	TenantGroup TenantGroup
+	MemberCount int32
+}
+
+func (q *Queries) ListTenantGroupsByTenant(ctx context.Context, tenantID uuid.UUID) ([]ListTenantGroupsByTenantRow, error) {
+	rows, err := q.db.Query(ctx, listTenantGroupsByTenant, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTenantGroupsByTenantRow
+	for rows.Next() {
+		var i ListTenantGroupsByTenantRow
+		if err := rows.Scan(
+			&i.TenantGroup.ID,
+			&i.TenantGroup.TenantID,
+			&i.TenantGroup.OrganizationID,
+			&i.TenantGroup.ParentGroupID,
+			&i.TenantGroup.Name,
+			&i.TenantGroup.Description,
+			&i.TenantGroup.CreatedAt,
+			&i.TenantGroup.UpdatedAt,
+			&i.MemberCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const removeTenantGroupMember = `-- name: RemoveTenantGroupMember :execrows
+DELETE FROM tenant_group_memberships
+WHERE group_id = $1 AND user_id = $2
+`
+
+type RemoveTenantGroupMemberParams struct {
+	GroupID uuid.UUID
+	UserID  uuid.UUID
+}
+
+func (q *Queries) RemoveTenantGroupMember(ctx context.Context, arg RemoveTenantGroupMemberParams) (int64, error) {
+	result, err := q.db.Exec(ctx, removeTenantGroupMember, arg.GroupID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -16,6 +16,7 @@ const createTenantJoinCode = `-- name: CreateTenantJoinCode :exec
 INSERT INTO tenant_join_codes(
     id,
     tenant_id,
+    organization_id,
     code,
     expires_at,
     max_uses,
@@ -24,6 +25,7 @@ INSERT INTO tenant_join_codes(
 VALUES(
     $1,
     $2,
+    (SELECT organization_id FROM tenants WHERE id = $2),
     $3,
     $4,
     $5,
@@ -52,22 +54,57 @@ func (q *Queries) CreateTenantJoinCode(ctx context.Context, arg CreateTenantJoin
 	return err
 }
 
+const deleteTenantJoinCodesByTenant = `-- name: DeleteTenantJoinCodesByTenant :exec
+DELETE FROM tenant_join_codes
+WHERE tenant_id = $1
+`
+
+func (q *Queries) DeleteTenantJoinCodesByTenant(ctx context.Context, tenantID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteTenantJoinCodesByTenant, tenantID)
+	return err
+}
+
+const disableTenantJoinCodes = `-- name: DisableTenantJoinCodes :exec
+UPDATE tenant_join_codes
+SET expires_at = $1
+WHERE tenant_id = $2
+AND (expires_at IS NULL OR expires_at > $1)
+`
+
+type DisableTenantJoinCodesParams struct {
+	Now      pgtype.Timestamptz
+	TenantID uuid.UUID
+}
+
+// 有効な参加コードの期限を now にして使えなくする
+func (q *Queries) DisableTenantJoinCodes(ctx context.Context, arg DisableTenantJoinCodesParams) error {
+	_, err := q.db.Exec(ctx, disableTenantJoinCodes, arg.Now, arg.TenantID)
+	return err
+}
+
 const getTenantByJoinCode = `-- name: GetTenantByJoinCode :one
 SELECT
-    t.id, t.organization_id, t.name, t.description, t.tenant_type, t.created_at, t.updated_at
+    t.id, t.organization_id, t.name, t.description, t.tenant_type, t.created_at, t.updated_at, t.archived_at, t.version
 FROM tenant_join_codes tjc
 INNER JOIN tenants t ON tjc.tenant_id = t.id
 WHERE tjc.code = $1
+    AND tjc.organization_id = $2
+    AND t.archived_at IS NULL
     AND (tjc.expires_at IS NULL OR tjc.expires_at > CURRENT_TIMESTAMP)
     AND (tjc.max_uses = 0 OR tjc.used_count < tjc.max_uses)
 `
 
+type GetTenantByJoinCodeParams struct {
+	Code           string
+	OrganizationID uuid.UUID
+}
+
 type GetTenantByJoinCodeRow struct {
 	Tenant Tenant
 }
 
-func (q *Queries) GetTenantByJoinCode(ctx context.Context, code string) (GetTenantByJoinCodeRow, error) {
-	row := q.db.QueryRow(ctx, getTenantByJoinCode, code)
+func (q *Queries) GetTenantByJoinCode(ctx context.Context, arg GetTenantByJoinCodeParams) (GetTenantByJoinCodeRow, error) {
+	row := q.db.QueryRow(ctx, getTenantByJoinCode, arg.Code, arg.OrganizationID)
 	var i GetTenantByJoinCodeRow
 	err := row.Scan(
 		&i.Tenant.ID,
@@ -77,6 +114,8 @@ func (q *Queries) GetTenantByJoinCode(ctx context.Context, code string) (GetTena
 		&i.Tenant.TenantType,
 		&i.Tenant.CreatedAt,
 		&i.Tenant.UpdatedAt,
+		&i.Tenant.ArchivedAt,
+		&i.Tenant.Version,
 	)
 	return i, err
 }
@@ -11,6 +11,20 @@ import (
 	"github.com/google/uuid"
 )
 
+const clearActiveMembershipsByTenant = `-- name: ClearActiveMembershipsByTenant :exec
+UPDATE sessions
+SET active_membership_id = NULL
+WHERE active_membership_id IN (
+    SELECT tm.id FROM tenant_memberships tm WHERE tm.tenant_id = $1
+)
+`
+
+// テナントのメンバーシップを削除する前に、それを参照しているセッションから外す
+func (q *Queries) ClearActiveMembershipsByTenant(ctx context.Context, tenantID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, clearActiveMembershipsByTenant, tenantID)
+	return err
+}
+
 const createTenantMembership = `-- name: CreateTenantMembership :exec
 INSERT INTO tenant_memberships(
     id,
@@ -43,6 +57,16 @@ func (q *Queries) CreateTenantMembership(ctx context.Context, arg CreateTenantMe
 	return err
 }
 
+const deleteTenantMembershipsByTenant = `-- name: DeleteTenantMembershipsByTenant :exec
+DELETE FROM tenant_memberships
+WHERE tenant_id = $1
+`
+
+func (q *Queries) DeleteTenantMembershipsByTenant(ctx context.Context, tenantID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteTenantMembershipsByTenant, tenantID)
+	return err
+}
+
 const getTenantMembershipByTenantAndUser = `-- name: GetTenantMembershipByTenantAndUser :one
 SELECT tenant_memberships.id, tenant_memberships.tenant_id, tenant_memberships.user_id, tenant_memberships.role, tenant_memberships.created_at, tenant_memberships.left_at
 FROM tenant_memberships
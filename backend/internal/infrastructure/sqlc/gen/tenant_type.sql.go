@@ -0,0 +1,136 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: tenant_type.sql
+
+package gen
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const countTenantsByType = `-- name: CountTenantsByType :one
+SELECT COUNT(*)::INT
+FROM tenants t
+WHERE t.organization_id = $1 AND t.tenant_type = $2
+`
+
+type CountTenantsByTypeParams struct {
+	OrganizationID uuid.UUID
+	TenantType     string
+}
+
+func (q *Queries) CountTenantsByType(ctx context.Context, arg CountTenantsByTypeParams) (int32, error) {
+	row := q.db.QueryRow(ctx, countTenantsByType, arg.OrganizationID, arg.TenantType)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const createTenantType = `-- name: CreateTenantType :exec
+INSERT INTO tenant_types(
+    id,
+    organization_id,
+    key,
+    label
+)
+VALUES(
+    $1,
+    $2,
+    $3,
+    $4
+)
+`
+
+type CreateTenantTypeParams struct {
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+	Key            string
+	Label          string
+}
+
+func (q *Queries) CreateTenantType(ctx context.Context, arg CreateTenantTypeParams) error {
+	_, err := q.db.Exec(ctx, createTenantType,
+		arg.ID,
+		arg.OrganizationID,
+		arg.Key,
+		arg.Label,
+	)
+	return err
+}
+
+const deleteTenantType = `-- name: DeleteTenantType :execrows
+DELETE FROM tenant_types
+WHERE id = $1
+`
+
+func (q *Queries) DeleteTenantType(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteTenantType, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getTenantType = `-- name: GetTenantType :one
+SELECT t.id, t.organization_id, t.key, t.label, t.created_at, t.updated_at
+FROM tenant_types t
+WHERE t.id = $1
+`
+
+type GetTenantTypeRow struct {
+	TenantType TenantType
+}
+
+func (q *Queries) GetTenantType(ctx context.Context, id uuid.UUID) (GetTenantTypeRow, error) {
+	row := q.db.QueryRow(ctx, getTenantType, id)
+	var i GetTenantTypeRow
+	err := row.Scan(
+		&i.TenantType.ID,
+		&i.TenantType.OrganizationID,
+		&i.TenantType.Key,
+		&i.TenantType.Label,
+		&i.TenantType.CreatedAt,
+		&i.TenantType.UpdatedAt,
+	)
+	return i, err
+}
+
+const listTenantTypes = `-- name: ListTenantTypes :many
+SELECT t.id, t.organization_id, t.key, t.label, t.created_at, t.updated_at
+FROM tenant_types t
+ORDER BY t.created_at, t.id
+`
+
+type ListTenantTypesRow struct {
+	TenantType TenantType
+}
+
+func (q *Queries) ListTenantTypes(ctx context.Context) ([]ListTenantTypesRow, error) {
+	rows, err := q.db.Query(ctx, listTenantTypes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTenantTypesRow
+	for rows.Next() {
+		var i ListTenantTypesRow
+		if err := rows.Scan(
+			&i.TenantType.ID,
+			&i.TenantType.OrganizationID,
+			&i.TenantType.Key,
+			&i.TenantType.Label,
+			&i.TenantType.CreatedAt,
+			&i.TenantType.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
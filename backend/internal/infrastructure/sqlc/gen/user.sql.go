@@ -65,6 +65,35 @@ func (q *Queries) GetUserByProviderIdentity(ctx context.Context, arg GetUserByPr
 	return i, err
 }
 
+const getUserIdentityByUser = `-- name: GetUserIdentityByUser :one
+SELECT ui.id, ui.user_id, ui.provider, ui.provider_sub, ui.created_at, ui.updated_at
+FROM user_identities ui
+WHERE ui.user_id = $1 AND ui.provider = $2
+`
+
+type GetUserIdentityByUserParams struct {
+	UserID   uuid.UUID
+	Provider string
+}
+
+type GetUserIdentityByUserRow struct {
+	UserIdentity UserIdentity
+}
+
+func (q *Queries) GetUserIdentityByUser(ctx context.Context, arg GetUserIdentityByUserParams) (GetUserIdentityByUserRow, error) {
+	row := q.db.QueryRow(ctx, getUserIdentityByUser, arg.UserID, arg.Provider)
+	var i GetUserIdentityByUserRow
+	err := row.Scan(
+		&i.UserIdentity.ID,
+		&i.UserIdentity.UserID,
+		&i.UserIdentity.Provider,
+		&i.UserIdentity.ProviderSub,
+		&i.UserIdentity.CreatedAt,
+		&i.UserIdentity.UpdatedAt,
+	)
+	return i, err
+}
+
 const upsertUser = `-- name: UpsertUser :one
 INSERT INTO users (
     email,
@@ -0,0 +1,232 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: webauthn.sql
+
+package gen
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const cleanupExpiredWebAuthnCeremonies = `-- name: CleanupExpiredWebAuthnCeremonies :exec
+DELETE FROM webauthn_ceremonies
+WHERE expires_at < NOW()
+`
+
+func (q *Queries) CleanupExpiredWebAuthnCeremonies(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, cleanupExpiredWebAuthnCeremonies)
+	return err
+}
+
+const consumeWebAuthnCeremony = `-- name: ConsumeWebAuthnCeremony :one
+DELETE FROM webauthn_ceremonies
+WHERE id = $1
+RETURNING webauthn_ceremonies.id, webauthn_ceremonies.ceremony_type, webauthn_ceremonies.user_id, webauthn_ceremonies.session_data, webauthn_ceremonies.expires_at, webauthn_ceremonies.created_at
+`
+
+type ConsumeWebAuthnCeremonyRow struct {
+	WebauthnCeremony WebauthnCeremony
+}
+
+func (q *Queries) ConsumeWebAuthnCeremony(ctx context.Context, id string) (ConsumeWebAuthnCeremonyRow, error) {
+	row := q.db.QueryRow(ctx, consumeWebAuthnCeremony, id)
+	var i ConsumeWebAuthnCeremonyRow
+	err := row.Scan(
+		&i.WebauthnCeremony.ID,
+		&i.WebauthnCeremony.CeremonyType,
+		&i.WebauthnCeremony.UserID,
+		&i.WebauthnCeremony.SessionData,
+		&i.WebauthnCeremony.ExpiresAt,
+		&i.WebauthnCeremony.CreatedAt,
+	)
+	return i, err
+}
+
+const createWebAuthnCredential = `-- name: CreateWebAuthnCredential :exec
+INSERT INTO webauthn_credentials (
+    id,
+    user_id,
+    name,
+    credential_id,
+    public_key,
+    attestation_type,
+    aaguid,
+    sign_count,
+    transports,
+    backup_eligible,
+    backup_state,
+    created_at
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $8,
+    $9,
+    $10,
+    $11,
+    $12
+)
+`
+
+type CreateWebAuthnCredentialParams struct {
+	ID              uuid.UUID
+	UserID          uuid.UUID
+	Name            string
+	CredentialID    []byte
+	PublicKey       []byte
+	AttestationType string
+	Aaguid          []byte
+	SignCount       int64
+	Transports      []string
+	BackupEligible  bool
+	BackupState     bool
+	CreatedAt       pgtype.Timestamptz
+}
+
+func (q *Queries) CreateWebAuthnCredential(ctx context.Context, arg CreateWebAuthnCredentialParams) error {
+	_, err := q.db.Exec(ctx, createWebAuthnCredential,
+		arg.ID,
+		arg.UserID,
+		arg.Name,
+		arg.CredentialID,
+		arg.PublicKey,
+		arg.AttestationType,
+		arg.Aaguid,
+		arg.SignCount,
+		arg.Transports,
+		arg.BackupEligible,
+		arg.BackupState,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const deleteWebAuthnCredentialByUser = `-- name: DeleteWebAuthnCredentialByUser :execrows
+DELETE FROM webauthn_credentials
+WHERE id = $1
+AND user_id = $2
+`
+
+type DeleteWebAuthnCredentialByUserParams struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+}
+
+func (q *Queries) DeleteWebAuthnCredentialByUser(ctx context.Context, arg DeleteWebAuthnCredentialByUserParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteWebAuthnCredentialByUser, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const listWebAuthnCredentialsByUser = `-- name: ListWebAuthnCredentialsByUser :many
+SELECT wc.id, wc.user_id, wc.name, wc.credential_id, wc.public_key, wc.attestation_type, wc.aaguid, wc.sign_count, wc.transports, wc.backup_eligible, wc.backup_state, wc.last_used_at, wc.created_at
+FROM webauthn_credentials wc
+WHERE wc.user_id = $1
+ORDER BY wc.created_at DESC
+`
+
+type ListWebAuthnCredentialsByUserRow struct {
+	WebauthnCredential WebauthnCredential
+}
+
+func (q *Queries) ListWebAuthnCredentialsByUser(ctx context.Context, userID uuid.UUID) ([]ListWebAuthnCredentialsByUserRow, error) {
+	rows, err := q.db.Query(ctx, listWebAuthnCredentialsByUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListWebAuthnCredentialsByUserRow
+	for rows.Next() {
+		var i ListWebAuthnCredentialsByUserRow
+		if err := rows.Scan(
+			&i.WebauthnCredential.ID,
+			&i.WebauthnCredential.UserID,
+			&i.WebauthnCredential.Name,
+			&i.WebauthnCredential.CredentialID,
+			&i.WebauthnCredential.PublicKey,
+			&i.WebauthnCredential.AttestationType,
+			&i.WebauthnCredential.Aaguid,
+			&i.WebauthnCredential.SignCount,
+			&i.WebauthnCredential.Transports,
+			&i.WebauthnCredential.BackupEligible,
+			&i.WebauthnCredential.BackupState,
+			&i.WebauthnCredential.LastUsedAt,
+			&i.WebauthnCredential.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const saveWebAuthnCeremony = `-- name: SaveWebAuthnCeremony :exec
+INSERT INTO webauthn_ceremonies (
+    id,
+    ceremony_type,
+    user_id,
+    session_data,
+    expires_at,
+    created_at
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6
+)
+`
+
+type SaveWebAuthnCeremonyParams struct {
+	ID           string
+	CeremonyType string
+	UserID       *uuid.UUID
+	SessionData  []byte
+	ExpiresAt    pgtype.Timestamptz
+	CreatedAt    pgtype.Timestamptz
+}
+
+func (q *Queries) SaveWebAuthnCeremony(ctx context.Context, arg SaveWebAuthnCeremonyParams) error {
+	_, err := q.db.Exec(ctx, saveWebAuthnCeremony,
+		arg.ID,
+		arg.CeremonyType,
+		arg.UserID,
+		arg.SessionData,
+		arg.ExpiresAt,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const updateWebAuthnCredentialUsage = `-- name: UpdateWebAuthnCredentialUsage :exec
+UPDATE webauthn_credentials
+SET sign_count = $1,
+    backup_state = $2,
+    last_used_at = NOW()
+WHERE id = $3
+`
+
+type UpdateWebAuthnCredentialUsageParams struct {
+	SignCount   int64
+	BackupState bool
+	ID          uuid.UUID
+}
+
+func (q *Queries) UpdateWebAuthnCredentialUsage(ctx context.Context, arg UpdateWebAuthnCredentialUsageParams) error {
+	_, err := q.db.Exec(ctx, updateWebAuthnCredentialUsage, arg.SignCount, arg.BackupState, arg.ID)
+	return err
+}
@@ -0,0 +1,317 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: webhook.sql
+
+package gen
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createWebhookDelivery = `-- name: CreateWebhookDelivery :exec
+INSERT INTO webhook_deliveries (
+    id,
+    subscription_id,
+    organization_id,
+    event_id,
+    event_type,
+    status_code,
+    error,
+    succeeded,
+    duration_ms,
+    created_at
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $8,
+    $9,
+    $10
+)
+`
+
+type CreateWebhookDeliveryParams struct {
+	ID             uuid.UUID
+	SubscriptionID uuid.UUID
+	OrganizationID uuid.UUID
+	EventID        uuid.UUID
+	EventType      string
+	StatusCode     int32
+	Error          string
+	Succeeded      bool
+	DurationMs     int32
+	CreatedAt      pgtype.Timestamptz
+}
+
+func (q *Queries) CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) error {
+	_, err := q.db.Exec(ctx, createWebhookDelivery,
+		arg.ID,
+		arg.SubscriptionID,
+		arg.OrganizationID,
+		arg.EventID,
+		arg.EventType,
+		arg.StatusCode,
+		arg.Error,
+		arg.Succeeded,
+		arg.DurationMs,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const createWebhookSubscription = `-- name: CreateWebhookSubscription :exec
+INSERT INTO webhook_subscriptions (
+    id,
+    organization_id,
+    url,
+    event_types,
+    secret,
+    description,
+    created_at
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7
+)
+`
+
+type CreateWebhookSubscriptionParams struct {
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+	Url            string
+	EventTypes     []string
+	Secret         string
+	Description    string
+	CreatedAt      pgtype.Timestamptz
+}
+
+func (q *Queries) CreateWebhookSubscription(ctx context.Context, arg CreateWebhookSubscriptionParams) error {
+	_, err := q.db.Exec(ctx, createWebhookSubscription,
+		arg.ID,
+		arg.OrganizationID,
+		arg.Url,
+		arg.EventTypes,
+		arg.Secret,
+		arg.Description,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const deleteWebhookSubscription = `-- name: DeleteWebhookSubscription :execrows
+DELETE FROM webhook_subscriptions
+WHERE id = $1
+AND organization_id = $2
+`
+
+type DeleteWebhookSubscriptionParams struct {
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+}
+
+func (q *Queries) DeleteWebhookSubscription(ctx context.Context, arg DeleteWebhookSubscriptionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteWebhookSubscription, arg.ID, arg.OrganizationID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getWebhookSubscription = `-- name: GetWebhookSubscription :one
+SELECT s.id, s.organization_id, s.url, s.event_types, s.secret, s.description, s.created_at
+FROM webhook_subscriptions s
+WHERE s.id = $1
+AND s.organization_id = $2
+`
+
+type GetWebhookSubscriptionParams struct {
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+}
+
+type GetWebhookSubscriptionRow struct {
+	WebhookSubscription WebhookSubscription
+}
+
+func (q *Queries) GetWebhookSubscription(ctx context.Context, arg GetWebhookSubscriptionParams) (GetWebhookSubscriptionRow, error) {
+	row := q.db.QueryRow(ctx, getWebhookSubscription, arg.ID, arg.OrganizationID)
+	var i GetWebhookSubscriptionRow
+	err := row.Scan(
+		&i.WebhookSubscription.ID,
+		&i.WebhookSubscription.OrganizationID,
+		&i.WebhookSubscription.Url,
+		&i.WebhookSubscription.EventTypes,
+		&i.WebhookSubscription.Secret,
+		&i.WebhookSubscription.Description,
+		&i.WebhookSubscription.CreatedAt,
+	)
+	return i, err
+}
+
+const hasSucceededWebhookDelivery = `-- name: HasSucceededWebhookDelivery :one
+SELECT EXISTS (
+    SELECT 1
+    FROM webhook_deliveries d
+    WHERE d.subscription_id = $1
+    AND d.event_id = $2
+    AND d.succeeded
+)::BOOLEAN
+`
+
+type HasSucceededWebhookDeliveryParams struct {
+	SubscriptionID uuid.UUID
+	EventID        uuid.UUID
+}
+
+func (q *Queries) HasSucceededWebhookDelivery(ctx context.Context, arg HasSucceededWebhookDeliveryParams) (bool, error) {
+	row := q.db.QueryRow(ctx, hasSucceededWebhookDelivery, arg.SubscriptionID, arg.EventID)
+	var column_1 bool
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const listWebhookDeliveries = `-- name: ListWebhookDeliveries :many
+SELECT d.id, d.subscription_id, d.organization_id, d.event_id, d.event_type, d.status_code, d.error, d.succeeded, d.duration_ms, d.created_at
+FROM webhook_deliveries d
+WHERE d.subscription_id = $1
+AND d.organization_id = $2
+ORDER BY d.created_at DESC
+LIMIT $3
+`
+
+type ListWebhookDeliveriesParams struct {
+	SubscriptionID uuid.UUID
+	OrganizationID uuid.UUID
+	PageSize       int32
+}
+
+type ListWebhookDeliveriesRow struct {
+	WebhookDelivery WebhookDelivery
+}
+
+func (q *Queries) ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]ListWebhookDeliveriesRow, error) {
+	rows, err := q.db.Query(ctx, listWebhookDeliveries, arg.SubscriptionID, arg.OrganizationID, arg.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListWebhookDeliveriesRow
+	for rows.Next() {
+		var i ListWebhookDeliveriesRow
+		if err := rows.Scan(
+			&i.WebhookDelivery.ID,
+			&i.WebhookDelivery.SubscriptionID,
+			&i.WebhookDelivery.OrganizationID,
+			&i.WebhookDelivery.EventID,
+			&i.WebhookDelivery.EventType,
+			&i.WebhookDelivery.StatusCode,
+			&i.WebhookDelivery.Error,
+			&i.WebhookDelivery.Succeeded,
+			&i.WebhookDelivery.DurationMs,
+			&i.WebhookDelivery.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhookSubscriptionsByEventType = `-- name: ListWebhookSubscriptionsByEventType :many
+SELECT s.id, s.organization_id, s.url, s.event_types, s.secret, s.description, s.created_at
+FROM webhook_subscriptions s
+WHERE s.organization_id = $1
+AND $2::TEXT = ANY(s.event_types)
+ORDER BY s.created_at
+`
+
+type ListWebhookSubscriptionsByEventTypeParams struct {
+	OrganizationID uuid.UUID
+	EventType      string
+}
+
+type ListWebhookSubscriptionsByEventTypeRow struct {
+	WebhookSubscription WebhookSubscription
+}
+
+func (q *Queries) ListWebhookSubscriptionsByEventType(ctx context.Context, arg ListWebhookSubscriptionsByEventTypeParams) ([]ListWebhookSubscriptionsByEventTypeRow, error) {
+	rows, err := q.db.Query(ctx, listWebhookSubscriptionsByEventType, arg.OrganizationID, arg.EventType)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListWebhookSubscriptionsByEventTypeRow
+	for rows.Next() {
+		var i ListWebhookSubscriptionsByEventTypeRow
+		if err := rows.Scan(
+			&i.WebhookSubscription.ID,
+			&i.WebhookSubscription.OrganizationID,
+			&i.WebhookSubscription.Url,
+			&i.WebhookSubscription.EventTypes,
+			&i.WebhookSubscription.Secret,
+			&i.WebhookSubscription.Description,
+			&i.WebhookSubscription.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhookSubscriptionsByOrganization = `-- name: ListWebhookSubscriptionsByOrganization :many
+SELECT s.id, s.organization_id, s.url, s.event_types, s.secret, s.description, s.created_at
+FROM webhook_subscriptions s
+WHERE s.organization_id = $1
+ORDER BY s.created_at DESC
+`
+
+type ListWebhookSubscriptionsByOrganizationRow struct {
+	WebhookSubscription WebhookSubscription
+}
+
+func (q *Queries) ListWebhookSubscriptionsByOrganization(ctx context.Context, organizationID uuid.UUID) ([]ListWebhookSubscriptionsByOrganizationRow, error) {
+	rows, err := q.db.Query(ctx, listWebhookSubscriptionsByOrganization, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListWebhookSubscriptionsByOrganizationRow
+	for rows.Next() {
+		var i ListWebhookSubscriptionsByOrganizationRow
+		if err := rows.Scan(
+			&i.WebhookSubscription.ID,
+			&i.WebhookSubscription.OrganizationID,
+			&i.WebhookSubscription.Url,
+			&i.WebhookSubscription.EventTypes,
+			&i.WebhookSubscription.Secret,
+			&i.WebhookSubscription.Description,
+			&i.WebhookSubscription.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -3,6 +3,8 @@ package sqlc
 import (
 	"context"
 
+	"github.com/cockroachdb/errors"
+	"github.com/jackc/pgx/v5"
 	"github.com/samber/lo"
 	"github.com/shibayama-club/keyhub/internal/domain/model"
 	"github.com/shibayama-club/keyhub/internal/domain/repository"
@@ -16,6 +18,7 @@ func parseSqlcKey(key sqlcgen.Key) (model.Key, error) {
 		OrganizationID: model.OrganizationID(key.OrganizationID),
 		KeyNumber:      model.KeyNumber(key.KeyNumber),
 		Status:         model.KeyStatus(key.Status),
+		Version:        key.Version,
 		CreatedAt:      key.CreatedAt.Time,
 		UpdatedAt:      key.UpdatedAt.Time,
 	}, nil
@@ -31,6 +34,26 @@ func (t *SqlcTransaction) CreateKey(ctx context.Context, arg repository.CreateKe
 	})
 }
 
+func (t *SqlcTransaction) GetKeyByID(ctx context.Context, id model.KeyID) (model.Key, error) {
+	row, err := t.queries.GetKeyById(ctx, id.UUID())
+	if err != nil {
+		return model.Key{}, err
+	}
+	return parseSqlcKey(row.Key)
+}
+
+func (t *SqlcTransaction) UpdateKeyNumber(ctx context.Context, id model.KeyID, keyNumber model.KeyNumber, version int64) (int64, error) {
+	newVersion, err := t.queries.UpdateKeyNumber(ctx, sqlcgen.UpdateKeyNumberParams{
+		ID:        id.UUID(),
+		KeyNumber: keyNumber.String(),
+		Version:   version,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, nil
+	}
+	return newVersion, err
+}
+
 func (t *SqlcTransaction) GetKeysByRoom(ctx context.Context, roomID model.RoomID) ([]model.Key, error) {
 	rows, err := t.queries.GetKeysByRoom(ctx, roomID.UUID())
 	if err != nil {
@@ -44,3 +67,41 @@ func (t *SqlcTransaction) GetKeysByRoom(ctx context.Context, roomID model.RoomID
 
 	return keys, nil
 }
+
+func (t *SqlcTransaction) ListKeysByRoom(ctx context.Context, arg repository.ListKeysByRoomArg) ([]model.Key, error) {
+	cursorID, cursorName, cursorCreatedAt := pageCursorParams(arg.Cursor)
+	rows, err := t.queries.ListKeysByRoom(ctx, sqlcgen.ListKeysByRoomParams{
+		RoomID:          arg.RoomID.UUID(),
+		Status:          lo.EmptyableToPtr(arg.Status.String()),
+		KeyNumberPrefix: lo.EmptyableToPtr(arg.KeyNumberPrefix),
+		CursorID:        cursorID,
+		OrderBy:         arg.Order.String(),
+		CursorName:      cursorName,
+		CursorCreatedAt: cursorCreatedAt,
+		PageSize:        arg.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	keys := lo.Map(rows, func(row sqlcgen.ListKeysByRoomRow, _ int) model.Key {
+		key, _ := parseSqlcKey(row.Key)
+		return key
+	})
+
+	return keys, nil
+}
+
+func (t *SqlcTransaction) GetKeysByOrganization(ctx context.Context, organizationID model.OrganizationID) ([]model.Key, error) {
+	rows, err := t.queries.GetKeysByOrganization(ctx, organizationID.UUID())
+	if err != nil {
+		return nil, err
+	}
+
+	keys := lo.Map(rows, func(row sqlcgen.GetKeysByOrganizationRow, _ int) model.Key {
+		key, _ := parseSqlcKey(row.Key)
+		return key
+	})
+
+	return keys, nil
+}
@@ -0,0 +1,94 @@
+package sqlc
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/samber/lo"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	sqlcgen "github.com/shibayama-club/keyhub/internal/infrastructure/sqlc/gen"
+)
+
+func parseSqlcNotificationPreferences(userID model.UserID, locale string, disabledKinds []string) model.NotificationPreferences {
+	return model.NotificationPreferences{
+		UserID: userID,
+		Locale: model.NotificationLocale(locale),
+		DisabledKinds: lo.Map(disabledKinds, func(k string, _ int) model.NotificationKind {
+			return model.NotificationKind(k)
+		}),
+	}
+}
+
+func (t *SqlcTransaction) GetNotificationPreferences(ctx context.Context, userID model.UserID) (model.NotificationPreferences, error) {
+	row, err := t.queries.GetNotificationPreferences(ctx, userID.UUID())
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.DefaultNotificationPreferences(userID), nil
+	}
+	if err != nil {
+		return model.NotificationPreferences{}, err
+	}
+
+	preferences := parseSqlcNotificationPreferences(userID, row.NotificationPreference.Locale, row.NotificationPreference.DisabledKinds)
+	preferences.UpdatedAt = row.NotificationPreference.UpdatedAt.Time
+	return preferences, nil
+}
+
+func (t *SqlcTransaction) UpsertNotificationPreferences(ctx context.Context, preferences model.NotificationPreferences) error {
+	return t.queries.UpsertNotificationPreferences(ctx, sqlcgen.UpsertNotificationPreferencesParams{
+		UserID: preferences.UserID.UUID(),
+		Locale: preferences.Locale.String(),
+		DisabledKinds: lo.Map(preferences.DisabledKinds, func(k model.NotificationKind, _ int) string {
+			return k.String()
+		}),
+		UpdatedAt: pgtype.Timestamptz{
+			Time:  preferences.UpdatedAt,
+			Valid: true,
+		},
+	})
+}
+
+func (t *SqlcTransaction) ListTenantNotificationRecipients(ctx context.Context, tenantID model.TenantID, adminsOnly bool) ([]model.NotificationRecipient, error) {
+	rows, err := t.queries.ListTenantNotificationRecipients(ctx, sqlcgen.ListTenantNotificationRecipientsParams{
+		TenantID:   tenantID.UUID(),
+		AdminsOnly: adminsOnly,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	recipients := make([]model.NotificationRecipient, 0, len(rows))
+	for _, row := range rows {
+		user, err := parseSqlcUser(row.User)
+		if err != nil {
+			return nil, err
+		}
+		recipients = append(recipients, model.NotificationRecipient{
+			User:        user,
+			Preferences: parseSqlcNotificationPreferences(user.UserId, row.Locale, row.DisabledKinds),
+		})
+	}
+	return recipients, nil
+}
+
+func (t *SqlcTransaction) ClaimNotificationDelivery(ctx context.Context, delivery model.NotificationDelivery) (bool, error) {
+	rows, err := t.queries.ClaimNotificationDelivery(ctx, sqlcgen.ClaimNotificationDeliveryParams{
+		DedupKey:       delivery.DedupKey,
+		OrganizationID: delivery.OrganizationID.UUID(),
+		UserID:         delivery.UserID.UUID(),
+		Kind:           delivery.Kind.String(),
+		CreatedAt: pgtype.Timestamptz{
+			Time:  delivery.CreatedAt,
+			Valid: true,
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+func (t *SqlcTransaction) DeleteNotificationDelivery(ctx context.Context, dedupKey string) error {
+	return t.queries.DeleteNotificationDelivery(ctx, dedupKey)
+}
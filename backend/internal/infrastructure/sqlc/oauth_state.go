@@ -16,19 +16,21 @@ func parseSqlcOAuthState(state sqlcgen.OauthState) (model.OAuthState, error) {
 	}
 
 	return model.OAuthState{
-		State:        model.OAuthStateValue(state.State),
-		CodeVerifier: state.CodeVerifier,
-		Nonce:        state.Nonce,
-		CreatedAt:    state.CreatedAt.Time,
-		ConsumedAt:   consumedAt,
+		State:          model.OAuthStateValue(state.State),
+		CodeVerifier:   state.CodeVerifier,
+		Nonce:          state.Nonce,
+		OrganizationID: model.OrganizationID(state.OrganizationID),
+		CreatedAt:      state.CreatedAt.Time,
+		ConsumedAt:     consumedAt,
 	}, nil
 }
 
 func (t *SqlcTransaction) SaveOAuthState(ctx context.Context, oauthState model.OAuthState) error {
 	return t.queries.SaveOAuthState(ctx, sqlcgen.SaveOAuthStateParams{
-		State:        oauthState.State.String(),
-		CodeVerifier: oauthState.CodeVerifier,
-		Nonce:        oauthState.Nonce,
+		State:          oauthState.State.String(),
+		CodeVerifier:   oauthState.CodeVerifier,
+		Nonce:          oauthState.Nonce,
+		OrganizationID: oauthState.OrganizationID.UUID(),
 	})
 }
 
@@ -0,0 +1,100 @@
+package sqlc
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+	sqlcgen "github.com/shibayama-club/keyhub/internal/infrastructure/sqlc/gen"
+	"github.com/shibayama-club/keyhub/internal/util"
+)
+
+func parseSqlcOrganization(organization sqlcgen.Organization) (model.Organization, error) {
+	var settings model.OrganizationSettings
+	if err := json.Unmarshal(organization.Settings, &settings); err != nil {
+		return model.Organization{}, errors.Wrap(err, "failed to unmarshal organization settings")
+	}
+
+	return model.Organization{
+		ID:        model.OrganizationID(organization.ID),
+		Name:      model.OrganizationName(organization.Name),
+		Slug:      model.OrganizationSlug(organization.Slug),
+		Settings:  settings.WithDefaults(),
+		HasKey:    organization.KeyHash != nil,
+		CreatedAt: organization.CreatedAt.Time,
+		UpdatedAt: organization.UpdatedAt.Time,
+	}, nil
+}
+
+func (t *SqlcTransaction) CreateOrganization(ctx context.Context, arg repository.CreateOrganizationArg) error {
+	settings, err := json.Marshal(arg.Organization.Settings)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal organization settings")
+	}
+
+	var keyHash *string
+	if arg.KeyHash != "" {
+		keyHash = lo.ToPtr(arg.KeyHash)
+	}
+
+	return t.queries.CreateOrganization(ctx, sqlcgen.CreateOrganizationParams{
+		ID:        arg.Organization.ID.UUID(),
+		Name:      arg.Organization.Name.String(),
+		Slug:      arg.Organization.Slug.String(),
+		Settings:  settings,
+		KeyHash:   keyHash,
+		CreatedAt: util.GoTimeToPgTimestamptz(&arg.Organization.CreatedAt),
+		UpdatedAt: util.GoTimeToPgTimestamptz(&arg.Organization.UpdatedAt),
+	})
+}
+
+func (t *SqlcTransaction) GetOrganization(ctx context.Context, id model.OrganizationID) (model.Organization, error) {
+	row, err := t.queries.GetOrganization(ctx, id.UUID())
+	if err != nil {
+		return model.Organization{}, err
+	}
+	return parseSqlcOrganization(row.Organization)
+}
+
+func (t *SqlcTransaction) GetOrganizationBySlug(ctx context.Context, slug model.OrganizationSlug) (model.Organization, error) {
+	row, err := t.queries.GetOrganizationBySlug(ctx, slug.String())
+	if err != nil {
+		return model.Organization{}, err
+	}
+	return parseSqlcOrganization(row.Organization)
+}
+
+func (t *SqlcTransaction) GetOrganizationKeyHash(ctx context.Context, id model.OrganizationID) (string, error) {
+	keyHash, err := t.queries.GetOrganizationKeyHash(ctx, id.UUID())
+	if err != nil {
+		return "", err
+	}
+	return lo.FromPtr(keyHash), nil
+}
+
+func (t *SqlcTransaction) ListOrganizations(ctx context.Context) ([]model.Organization, error) {
+	rows, err := t.queries.ListOrganizations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	organizations := make([]model.Organization, 0, len(rows))
+	for _, row := range rows {
+		organization, err := parseSqlcOrganization(row.Organization)
+		if err != nil {
+			return nil, err
+		}
+		organizations = append(organizations, organization)
+	}
+	return organizations, nil
+}
+
+func (t *SqlcTransaction) UpdateOrganizationKeyHash(ctx context.Context, id model.OrganizationID, keyHash string) (int64, error) {
+	return t.queries.UpdateOrganizationKeyHash(ctx, sqlcgen.UpdateOrganizationKeyHashParams{
+		ID:      id.UUID(),
+		KeyHash: lo.ToPtr(keyHash),
+	})
+}
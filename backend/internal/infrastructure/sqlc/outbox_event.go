@@ -0,0 +1,71 @@
+package sqlc
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/samber/lo"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	sqlcgen "github.com/shibayama-club/keyhub/internal/infrastructure/sqlc/gen"
+	"github.com/shibayama-club/keyhub/internal/util"
+)
+
+func parseSqlcOutboxEvent(event sqlcgen.OutboxEvent) model.OutboxEvent {
+	return model.OutboxEvent{
+		ID:                model.OutboxEventID(event.ID),
+		OrganizationID:    model.OrganizationID(event.OrganizationID),
+		Type:              model.DomainEventType(event.EventType),
+		Payload:           event.Payload,
+		OccurredAt:        event.OccurredAt.Time,
+		Status:            model.OutboxEventStatus(event.Status),
+		Attempts:          event.Attempts,
+		NextAttemptAt:     event.NextAttemptAt.Time,
+		LastError:         event.LastError,
+		DeliveredAt:       util.PgTimestamptzToGoTime(event.DeliveredAt),
+		CompletedHandlers: event.CompletedHandlers,
+	}
+}
+
+func (t *SqlcTransaction) CreateOutboxEvent(ctx context.Context, event model.OutboxEvent) error {
+	return t.queries.CreateOutboxEvent(ctx, sqlcgen.CreateOutboxEventParams{
+		ID:             event.ID.UUID(),
+		OrganizationID: event.OrganizationID.UUID(),
+		EventType:      event.Type.String(),
+		Payload:        event.Payload,
+		OccurredAt:     pgtype.Timestamptz{Time: event.OccurredAt, Valid: true},
+		Status:         event.Status.String(),
+		Attempts:       event.Attempts,
+		NextAttemptAt:  pgtype.Timestamptz{Time: event.NextAttemptAt, Valid: true},
+	})
+}
+
+func (t *SqlcTransaction) ClaimOutboxEvents(ctx context.Context, now, leaseUntil time.Time, limit int32) ([]model.OutboxEvent, error) {
+	rows, err := t.queries.ClaimOutboxEvents(ctx, sqlcgen.ClaimOutboxEventsParams{
+		LeaseUntil: pgtype.Timestamptz{Time: leaseUntil, Valid: true},
+		Now:        pgtype.Timestamptz{Time: now, Valid: true},
+		BatchSize:  limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]model.OutboxEvent, 0, len(rows))
+	for _, row := range rows {
+		events = append(events, parseSqlcOutboxEvent(row.OutboxEvent))
+	}
+	return events, nil
+}
+
+func (t *SqlcTransaction) UpdateOutboxEvent(ctx context.Context, event model.OutboxEvent) error {
+	return t.queries.UpdateOutboxEvent(ctx, sqlcgen.UpdateOutboxEventParams{
+		ID:            event.ID.UUID(),
+		Status:        event.Status.String(),
+		Attempts:      event.Attempts,
+		NextAttemptAt: pgtype.Timestamptz{Time: event.NextAttemptAt, Valid: true},
+		LastError:     event.LastError,
+		DeliveredAt:   util.GoTimeToPgTimestamptz(event.DeliveredAt),
+		// NOT NULL の列のため、nil は空の配列として書き込む
+		CompletedHandlers: lo.Ternary(event.CompletedHandlers == nil, []string{}, event.CompletedHandlers),
+	})
+}
@@ -0,0 +1,15 @@
+package sqlc
+
+import (
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+)
+
+// pageCursorParams は一覧のクエリに渡すカーソルの値を返す。カーソルがなければ先頭から取得する
+func pageCursorParams(cursor *model.PageCursor) (*uuid.UUID, *string, pgtype.Timestamptz) {
+	if cursor == nil {
+		return nil, nil, pgtype.Timestamptz{}
+	}
+	return &cursor.ID, &cursor.Name, pgtype.Timestamptz{Time: cursor.CreatedAt, Valid: true}
+}
@@ -0,0 +1,117 @@
+package sqlc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+	sqlcgen "github.com/shibayama-club/keyhub/internal/infrastructure/sqlc/gen"
+	"github.com/shibayama-club/keyhub/internal/util"
+)
+
+func parseSqlcPasskey(credential sqlcgen.WebauthnCredential) (model.Passkey, error) {
+	return model.Passkey{
+		ID:              model.PasskeyID(credential.ID),
+		UserID:          model.UserID(credential.UserID),
+		Name:            model.PasskeyName(credential.Name),
+		CredentialID:    credential.CredentialID,
+		PublicKey:       credential.PublicKey,
+		AttestationType: credential.AttestationType,
+		AAGUID:          credential.Aaguid,
+		SignCount:       uint32(credential.SignCount),
+		Transports:      credential.Transports,
+		BackupEligible:  credential.BackupEligible,
+		BackupState:     credential.BackupState,
+		LastUsedAt:      util.PgTimestamptzToGoTime(credential.LastUsedAt),
+		CreatedAt:       credential.CreatedAt.Time,
+	}, nil
+}
+
+func parseSqlcPasskeyCeremony(ceremony sqlcgen.WebauthnCeremony) (model.PasskeyCeremony, error) {
+	var userID *model.UserID
+	if ceremony.UserID != nil {
+		id := model.UserID(*ceremony.UserID)
+		userID = &id
+	}
+
+	return model.PasskeyCeremony{
+		ID:          ceremony.ID,
+		Type:        model.PasskeyCeremonyType(ceremony.CeremonyType),
+		UserID:      userID,
+		SessionData: ceremony.SessionData,
+		ExpiresAt:   ceremony.ExpiresAt.Time,
+		CreatedAt:   ceremony.CreatedAt.Time,
+	}, nil
+}
+
+func (t *SqlcTransaction) CreatePasskey(ctx context.Context, passkey model.Passkey) error {
+	return t.queries.CreateWebAuthnCredential(ctx, sqlcgen.CreateWebAuthnCredentialParams{
+		ID:              passkey.ID.UUID(),
+		UserID:          passkey.UserID.UUID(),
+		Name:            passkey.Name.String(),
+		CredentialID:    passkey.CredentialID,
+		PublicKey:       passkey.PublicKey,
+		AttestationType: passkey.AttestationType,
+		Aaguid:          passkey.AAGUID,
+		SignCount:       int64(passkey.SignCount),
+		Transports:      lo.Ternary(passkey.Transports == nil, []string{}, passkey.Transports),
+		BackupEligible:  passkey.BackupEligible,
+		BackupState:     passkey.BackupState,
+		CreatedAt:       util.GoTimeToPgTimestamptz(&passkey.CreatedAt),
+	})
+}
+
+func (t *SqlcTransaction) ListPasskeysByUser(ctx context.Context, userID model.UserID) ([]model.Passkey, error) {
+	rows, err := t.queries.ListWebAuthnCredentialsByUser(ctx, userID.UUID())
+	if err != nil {
+		return nil, err
+	}
+
+	passkeys := lo.Map(rows, func(row sqlcgen.ListWebAuthnCredentialsByUserRow, _ int) model.Passkey {
+		passkey, _ := parseSqlcPasskey(row.WebauthnCredential)
+		return passkey
+	})
+
+	return passkeys, nil
+}
+
+func (t *SqlcTransaction) UpdatePasskeyUsage(ctx context.Context, arg repository.UpdatePasskeyUsageArg) error {
+	return t.queries.UpdateWebAuthnCredentialUsage(ctx, sqlcgen.UpdateWebAuthnCredentialUsageParams{
+		ID:          arg.ID.UUID(),
+		SignCount:   int64(arg.SignCount),
+		BackupState: arg.BackupState,
+	})
+}
+
+func (t *SqlcTransaction) DeletePasskeyByUser(ctx context.Context, userID model.UserID, id model.PasskeyID) (int64, error) {
+	return t.queries.DeleteWebAuthnCredentialByUser(ctx, sqlcgen.DeleteWebAuthnCredentialByUserParams{
+		ID:     id.UUID(),
+		UserID: userID.UUID(),
+	})
+}
+
+func (t *SqlcTransaction) SavePasskeyCeremony(ctx context.Context, ceremony model.PasskeyCeremony) error {
+	var userID *uuid.UUID
+	if ceremony.UserID != nil {
+		userID = lo.ToPtr(ceremony.UserID.UUID())
+	}
+
+	return t.queries.SaveWebAuthnCeremony(ctx, sqlcgen.SaveWebAuthnCeremonyParams{
+		ID:           ceremony.ID,
+		CeremonyType: ceremony.Type.String(),
+		UserID:       userID,
+		SessionData:  ceremony.SessionData,
+		ExpiresAt:    util.GoTimeToPgTimestamptz(&ceremony.ExpiresAt),
+		CreatedAt:    util.GoTimeToPgTimestamptz(&ceremony.CreatedAt),
+	})
+}
+
+func (t *SqlcTransaction) ConsumePasskeyCeremony(ctx context.Context, id string) (model.PasskeyCeremony, error) {
+	row, err := t.queries.ConsumeWebAuthnCeremony(ctx, id)
+	if err != nil {
+		return model.PasskeyCeremony{}, err
+	}
+	return parseSqlcPasskeyCeremony(row.WebauthnCeremony)
+}
@@ -0,0 +1,100 @@
+package sqlc
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+	sqlcgen "github.com/shibayama-club/keyhub/internal/infrastructure/sqlc/gen"
+	"github.com/shibayama-club/keyhub/internal/util"
+)
+
+func parseSqlcRateLimitBucket(bucket sqlcgen.RateLimitBucket) model.RateLimitBucket {
+	return model.RateLimitBucket{
+		Tokens:    bucket.Tokens,
+		UpdatedAt: bucket.UpdatedAt.Time,
+	}
+}
+
+func parseSqlcLoginFailures(failure sqlcgen.RateLimitFailure) model.LoginFailures {
+	return model.LoginFailures{
+		Count:           int(failure.FailureCount),
+		WindowStartedAt: failure.WindowStartedAt.Time,
+		LockedUntil:     util.PgTimestamptzToGoTime(failure.LockedUntil),
+	}
+}
+
+func (t *SqlcTransaction) LockRateLimitBucket(ctx context.Context, key string, initial model.RateLimitBucket) (model.RateLimitBucket, error) {
+	if err := t.queries.EnsureRateLimitBucket(ctx, sqlcgen.EnsureRateLimitBucketParams{
+		Key:       key,
+		Tokens:    initial.Tokens,
+		UpdatedAt: util.GoTimeToPgTimestamptz(&initial.UpdatedAt),
+	}); err != nil {
+		return model.RateLimitBucket{}, err
+	}
+
+	row, err := t.queries.GetRateLimitBucketForUpdate(ctx, key)
+	if err != nil {
+		return model.RateLimitBucket{}, err
+	}
+	return parseSqlcRateLimitBucket(row.RateLimitBucket), nil
+}
+
+func (t *SqlcTransaction) SaveRateLimitBucket(ctx context.Context, arg repository.SaveRateLimitBucketArg) error {
+	return t.queries.SaveRateLimitBucket(ctx, sqlcgen.SaveRateLimitBucketParams{
+		Key:       arg.Key,
+		Tokens:    arg.Bucket.Tokens,
+		UpdatedAt: util.GoTimeToPgTimestamptz(&arg.Bucket.UpdatedAt),
+		IdleAt:    util.GoTimeToPgTimestamptz(&arg.IdleAt),
+	})
+}
+
+func (t *SqlcTransaction) GetLoginFailures(ctx context.Context, key string) (model.LoginFailures, error) {
+	row, err := t.queries.GetRateLimitFailure(ctx, key)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.LoginFailures{}, nil
+		}
+		return model.LoginFailures{}, err
+	}
+	return parseSqlcLoginFailures(row.RateLimitFailure), nil
+}
+
+func (t *SqlcTransaction) LockLoginFailures(ctx context.Context, key string, now time.Time) (model.LoginFailures, error) {
+	if err := t.queries.EnsureRateLimitFailure(ctx, sqlcgen.EnsureRateLimitFailureParams{
+		Key: key,
+		Now: util.GoTimeToPgTimestamptz(&now),
+	}); err != nil {
+		return model.LoginFailures{}, err
+	}
+
+	row, err := t.queries.GetRateLimitFailureForUpdate(ctx, key)
+	if err != nil {
+		return model.LoginFailures{}, err
+	}
+	return parseSqlcLoginFailures(row.RateLimitFailure), nil
+}
+
+func (t *SqlcTransaction) SaveLoginFailures(ctx context.Context, arg repository.SaveLoginFailuresArg) error {
+	return t.queries.SaveRateLimitFailure(ctx, sqlcgen.SaveRateLimitFailureParams{
+		Key:             arg.Key,
+		FailureCount:    int32(arg.Failures.Count),
+		WindowStartedAt: util.GoTimeToPgTimestamptz(&arg.Failures.WindowStartedAt),
+		LockedUntil:     util.GoTimeToPgTimestamptz(arg.Failures.LockedUntil),
+		IdleAt:          util.GoTimeToPgTimestamptz(&arg.IdleAt),
+	})
+}
+
+func (t *SqlcTransaction) DeleteLoginFailures(ctx context.Context, key string) error {
+	return t.queries.DeleteUnlockedRateLimitFailure(ctx, key)
+}
+
+func (t *SqlcTransaction) DeleteIdleRateLimits(ctx context.Context, before time.Time) error {
+	if err := t.queries.DeleteIdleRateLimitBuckets(ctx, util.GoTimeToPgTimestamptz(&before)); err != nil {
+		return err
+	}
+	return t.queries.DeleteIdleRateLimitFailures(ctx, util.GoTimeToPgTimestamptz(&before))
+}
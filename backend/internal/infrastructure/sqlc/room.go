@@ -2,7 +2,10 @@ package sqlc
 
 import (
 	"context"
+	"encoding/json"
 
+	"github.com/cockroachdb/errors"
+	"github.com/jackc/pgx/v5"
 	"github.com/samber/lo"
 	"github.com/shibayama-club/keyhub/internal/domain/model"
 	"github.com/shibayama-club/keyhub/internal/domain/repository"
@@ -10,31 +13,88 @@ import (
 )
 
 func parseSqlcRoom(room sqlcgen.Room) (model.Room, error) {
+	var customFields map[string]string
+	if err := json.Unmarshal(room.CustomFields, &customFields); err != nil {
+		return model.Room{}, errors.Wrap(err, "failed to unmarshal room custom fields")
+	}
+
 	return model.Room{
 		ID:             model.RoomID(room.ID),
 		OrganizationID: model.OrganizationID(room.OrganizationID),
 		Name:           model.RoomName(room.Name),
+		FloorID:        model.FloorID(room.FloorID),
 		BuildingName:   model.BuildingName(room.BuildingName),
 		FloorNumber:    model.FloorNumber(room.FloorNumber),
 		Type:           model.RoomType(room.RoomType),
 		Description:    model.RoomDescription(room.Description),
-		CreatedAt:      room.CreatedAt.Time,
-		UpdatedAt:      room.UpdatedAt.Time,
+		Attributes: model.RoomAttributes{
+			Capacity:      model.RoomCapacity(room.Capacity),
+			Equipment:     lo.Map(room.Equipment, func(e string, _ int) model.Equipment { return model.Equipment(e) }),
+			Accessibility: lo.Map(room.Accessibility, func(a string, _ int) model.RoomAccessibility { return model.RoomAccessibility(a) }),
+			CustomFields:  customFields,
+		},
+		Version:   room.Version,
+		CreatedAt: room.CreatedAt.Time,
+		UpdatedAt: room.UpdatedAt.Time,
 	}, nil
 }
 
+// roomAttributeParams は属性を保存と絞り込みの形にする。NULL では @> の絞り込みが常に偽になるため、空の一覧とオブジェクトにする
+func roomAttributeParams(equipment []model.Equipment, accessibility []model.RoomAccessibility, customFields map[string]string) ([]string, []string, []byte, error) {
+	if customFields == nil {
+		customFields = map[string]string{}
+	}
+	customFieldsJSON, err := json.Marshal(customFields)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "failed to marshal room custom fields")
+	}
+
+	return lo.Map(equipment, func(e model.Equipment, _ int) string { return e.String() }),
+		lo.Map(accessibility, func(a model.RoomAccessibility, _ int) string { return a.String() }),
+		customFieldsJSON,
+		nil
+}
+
 func (t *SqlcTransaction) CreateRoom(ctx context.Context, arg repository.CreateRoomArg) error {
+	equipment, accessibility, customFields, err := roomAttributeParams(arg.Attributes.Equipment, arg.Attributes.Accessibility, arg.Attributes.CustomFields)
+	if err != nil {
+		return err
+	}
+
 	return t.queries.CreateRoom(ctx, sqlcgen.CreateRoomParams{
 		ID:             arg.ID.UUID(),
 		OrganizationID: arg.OrganizationID.UUID(),
 		Name:           arg.Name.String(),
-		BuildingName:   arg.BuildingName.String(),
-		FloorNumber:    arg.FloorNumber.String(),
+		FloorID:        arg.FloorID.UUID(),
 		RoomType:       arg.Type.String(),
 		Description:    arg.Description.String(),
+		Capacity:       int32(arg.Attributes.Capacity),
+		Equipment:      equipment,
+		Accessibility:  accessibility,
+		CustomFields:   customFields,
 	})
 }
 
+func (t *SqlcTransaction) UpdateRoomAttributes(ctx context.Context, id model.RoomID, attributes model.RoomAttributes, version int64) (int64, error) {
+	equipment, accessibility, customFields, err := roomAttributeParams(attributes.Equipment, attributes.Accessibility, attributes.CustomFields)
+	if err != nil {
+		return 0, err
+	}
+
+	newVersion, err := t.queries.UpdateRoomAttributes(ctx, sqlcgen.UpdateRoomAttributesParams{
+		ID:            id.UUID(),
+		Capacity:      int32(attributes.Capacity),
+		Equipment:     equipment,
+		Accessibility: accessibility,
+		CustomFields:  customFields,
+		Version:       version,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, nil
+	}
+	return newVersion, err
+}
+
 func (t *SqlcTransaction) GetRoomByID(ctx context.Context, id model.RoomID) (model.Room, error) {
 	row, err := t.queries.GetRoomById(ctx, id.UUID())
 	if err != nil {
@@ -43,13 +103,35 @@ func (t *SqlcTransaction) GetRoomByID(ctx context.Context, id model.RoomID) (mod
 	return parseSqlcRoom(row.Room)
 }
 
-func (t *SqlcTransaction) GetAllRooms(ctx context.Context) ([]model.Room, error) {
-	rows, err := t.queries.GetAllRooms(ctx)
+func (t *SqlcTransaction) ListRooms(ctx context.Context, arg repository.ListRoomsArg) ([]model.Room, error) {
+	cursorID, cursorName, cursorCreatedAt := pageCursorParams(arg.Cursor)
+	equipment, accessibility, customFields, err := roomAttributeParams(arg.Filter.Equipment, arg.Filter.Accessibility, arg.Filter.CustomFields)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := t.queries.ListRooms(ctx, sqlcgen.ListRoomsParams{
+		BuildingName:    lo.EmptyableToPtr(arg.BuildingName.String()),
+		BuildingID:      buildingIDToUUID(arg.BuildingID),
+		FloorID:         floorIDToUUID(arg.FloorID),
+		FloorNumber:     lo.EmptyableToPtr(arg.FloorNumber.String()),
+		RoomType:        lo.EmptyableToPtr(arg.Type.String()),
+		NamePrefix:      lo.EmptyableToPtr(arg.NamePrefix),
+		MinCapacity:     int32(arg.Filter.MinCapacity),
+		Equipment:       equipment,
+		Accessibility:   accessibility,
+		CustomFields:    customFields,
+		CursorID:        cursorID,
+		OrderBy:         arg.Order.String(),
+		CursorName:      cursorName,
+		CursorCreatedAt: cursorCreatedAt,
+		PageSize:        arg.Limit,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	rooms := lo.Map(rows, func(row sqlcgen.GetAllRoomsRow, _ int) model.Room {
+	rooms := lo.Map(rows, func(row sqlcgen.ListRoomsRow, _ int) model.Room {
 		room, _ := parseSqlcRoom(row.Room)
 		return room
 	})
@@ -57,15 +139,30 @@ func (t *SqlcTransaction) GetAllRooms(ctx context.Context) ([]model.Room, error)
 	return rooms, nil
 }
 
-func (t *SqlcTransaction) GetRoomsByTenant(ctx context.Context, tenantID model.TenantID) ([]model.Room, error) {
-	rows, err := t.queries.GetRoomsByTenant(ctx, tenantID.UUID())
+func (t *SqlcTransaction) GetRoomsByTenant(ctx context.Context, tenantID model.TenantID, userID model.UserID, filter model.RoomFilter) ([]repository.AccessibleRoom, error) {
+	equipment, accessibility, customFields, err := roomAttributeParams(filter.Equipment, filter.Accessibility, filter.CustomFields)
 	if err != nil {
 		return nil, err
 	}
 
-	rooms := lo.Map(rows, func(row sqlcgen.GetRoomsByTenantRow, _ int) model.Room {
+	rows, err := t.queries.GetRoomsByTenant(ctx, sqlcgen.GetRoomsByTenantParams{
+		TenantID:      tenantID.UUID(),
+		UserID:        userID.UUID(),
+		MinCapacity:   int32(filter.MinCapacity),
+		Equipment:     equipment,
+		Accessibility: accessibility,
+		CustomFields:  customFields,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rooms := lo.Map(rows, func(row sqlcgen.GetRoomsByTenantRow, _ int) repository.AccessibleRoom {
 		room, _ := parseSqlcRoom(row.Room)
-		return room
+		return repository.AccessibleRoom{
+			Room:          room,
+			CanBorrowKeys: row.CanBorrowKeys,
+		}
 	})
 
 	return rooms, nil
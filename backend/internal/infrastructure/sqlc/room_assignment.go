@@ -2,18 +2,99 @@ package sqlc
 
 import (
 	"context"
+	"time"
 
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/shibayama-club/keyhub/internal/domain/model"
 	"github.com/shibayama-club/keyhub/internal/domain/repository"
 	sqlcgen "github.com/shibayama-club/keyhub/internal/infrastructure/sqlc/gen"
 	"github.com/shibayama-club/keyhub/internal/util"
 )
 
+func parseSqlcRoomAssignment(assignment sqlcgen.RoomAssignment) model.RoomAssignment {
+	var groupID, keyLoanGroupID *model.TenantGroupID
+	if assignment.GroupID != nil {
+		id := model.TenantGroupID(*assignment.GroupID)
+		groupID = &id
+	}
+	if assignment.KeyLoanGroupID != nil {
+		id := model.TenantGroupID(*assignment.KeyLoanGroupID)
+		keyLoanGroupID = &id
+	}
+
+	return model.RoomAssignment{
+		ID:             model.RoomAssignmentID(assignment.ID),
+		TenantID:       model.TenantID(assignment.TenantID),
+		RoomID:         model.RoomID(assignment.RoomID),
+		GroupID:        groupID,
+		KeyLoanGroupID: keyLoanGroupID,
+		AssignedAt:     assignment.AssignedAt.Time,
+		ExpiresAt:      util.PgTimestamptzToGoTime(assignment.ExpiresAt),
+		CreatedAt:      assignment.CreatedAt.Time,
+		UpdatedAt:      assignment.UpdatedAt.Time,
+	}
+}
+
 func (t *SqlcTransaction) CreateRoomAssignment(ctx context.Context, arg repository.CreateRoomAssignmentArg) error {
 	return t.queries.CreateRoomAssignment(ctx, sqlcgen.CreateRoomAssignmentParams{
-		ID:         arg.ID.UUID(),
-		TenantID:   arg.TenantID.UUID(),
-		RoomID:     arg.RoomID.UUID(),
-		AssignedAt: util.GoTimeToPgTimestamptz(&arg.AssignedAt),
-		ExpiresAt:  util.GoTimeToPgTimestamptz(arg.ExpiresAt),
+		ID:             arg.ID.UUID(),
+		TenantID:       arg.TenantID.UUID(),
+		RoomID:         arg.RoomID.UUID(),
+		GroupID:        tenantGroupIDToUUID(arg.GroupID),
+		KeyLoanGroupID: tenantGroupIDToUUID(arg.KeyLoanGroupID),
+		AssignedAt:     util.GoTimeToPgTimestamptz(&arg.AssignedAt),
+		ExpiresAt:      util.GoTimeToPgTimestamptz(arg.ExpiresAt),
+	})
+}
+
+func (t *SqlcTransaction) ListExpiringRoomAssignments(ctx context.Context, now, until time.Time) ([]repository.ExpiringRoomAssignment, error) {
+	rows, err := t.queries.ListExpiringRoomAssignments(ctx, sqlcgen.ListExpiringRoomAssignmentsParams{
+		Now:   util.GoTimeToPgTimestamptz(&now),
+		Until: util.GoTimeToPgTimestamptz(&until),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	assignments := make([]repository.ExpiringRoomAssignment, 0, len(rows))
+	for _, row := range rows {
+		tenant, err := parseSqlcTenant(row.Tenant)
+		if err != nil {
+			return nil, err
+		}
+		room, err := parseSqlcRoom(row.Room)
+		if err != nil {
+			return nil, err
+		}
+		assignments = append(assignments, repository.ExpiringRoomAssignment{
+			Assignment: parseSqlcRoomAssignment(row.RoomAssignment),
+			Tenant:     tenant,
+			Room:       room,
+		})
+	}
+	return assignments, nil
+}
+
+func (t *SqlcTransaction) GetAssignedRoomIDsByTenant(ctx context.Context, tenantID model.TenantID) ([]model.RoomID, error) {
+	rows, err := t.queries.GetAssignedRoomIDsByTenant(ctx, tenantID.UUID())
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(rows, func(id uuid.UUID, _ int) model.RoomID {
+		return model.RoomID(id)
+	}), nil
+}
+
+func (t *SqlcTransaction) EndRoomAssignmentsByTenant(ctx context.Context, tenantID model.TenantID, now time.Time) (int64, error) {
+	return t.queries.EndRoomAssignmentsByTenant(ctx, sqlcgen.EndRoomAssignmentsByTenantParams{
+		TenantID: tenantID.UUID(),
+		Now:      util.GoTimeToPgTimestamptz(&now),
 	})
 }
+
+func (t *SqlcTransaction) DeleteRoomAssignmentsByTenant(ctx context.Context, tenantID model.TenantID) error {
+	return t.queries.DeleteRoomAssignmentsByTenant(ctx, tenantID.UUID())
+}
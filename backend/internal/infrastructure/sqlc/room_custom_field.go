@@ -0,0 +1,65 @@
+package sqlc
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	sqlcgen "github.com/shibayama-club/keyhub/internal/infrastructure/sqlc/gen"
+)
+
+func parseSqlcRoomCustomField(field sqlcgen.RoomCustomField) model.RoomCustomField {
+	return model.RoomCustomField{
+		ID:             model.RoomCustomFieldID(field.ID),
+		OrganizationID: model.OrganizationID(field.OrganizationID),
+		Key:            model.RoomCustomFieldKey(field.Key),
+		Label:          field.Label,
+		Type:           model.RoomCustomFieldType(field.FieldType),
+		Required:       field.Required,
+		Options:        field.Options,
+		CreatedAt:      field.CreatedAt.Time,
+		UpdatedAt:      field.UpdatedAt.Time,
+	}
+}
+
+func (t *SqlcTransaction) CreateRoomCustomField(ctx context.Context, field model.RoomCustomField) error {
+	return t.queries.CreateRoomCustomField(ctx, sqlcgen.CreateRoomCustomFieldParams{
+		ID:             field.ID.UUID(),
+		OrganizationID: field.OrganizationID.UUID(),
+		Key:            field.Key.String(),
+		Label:          field.Label,
+		FieldType:      field.Type.String(),
+		Required:       field.Required,
+		Options:        lo.Ternary(field.Options != nil, field.Options, []string{}),
+	})
+}
+
+func (t *SqlcTransaction) GetRoomCustomField(ctx context.Context, id model.RoomCustomFieldID) (model.RoomCustomField, error) {
+	row, err := t.queries.GetRoomCustomField(ctx, id.UUID())
+	if err != nil {
+		return model.RoomCustomField{}, err
+	}
+	return parseSqlcRoomCustomField(row.RoomCustomField), nil
+}
+
+func (t *SqlcTransaction) ListRoomCustomFields(ctx context.Context) (model.RoomCustomFieldSchema, error) {
+	rows, err := t.queries.ListRoomCustomFields(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(rows, func(row sqlcgen.ListRoomCustomFieldsRow, _ int) model.RoomCustomField {
+		return parseSqlcRoomCustomField(row.RoomCustomField)
+	}), nil
+}
+
+func (t *SqlcTransaction) DeleteRoomCustomField(ctx context.Context, id model.RoomCustomFieldID) (int64, error) {
+	return t.queries.DeleteRoomCustomField(ctx, id.UUID())
+}
+
+func (t *SqlcTransaction) RemoveRoomCustomFieldValues(ctx context.Context, organizationID model.OrganizationID, key model.RoomCustomFieldKey) error {
+	return t.queries.RemoveRoomCustomFieldValues(ctx, sqlcgen.RemoveRoomCustomFieldValuesParams{
+		Key:            key.String(),
+		OrganizationID: organizationID.UUID(),
+	})
+}
@@ -0,0 +1,59 @@
+package sqlc
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	sqlcgen "github.com/shibayama-club/keyhub/internal/infrastructure/sqlc/gen"
+)
+
+func parseSqlcRoomType(definition sqlcgen.RoomType) model.RoomTypeDefinition {
+	return model.RoomTypeDefinition{
+		ID:             model.RoomTypeID(definition.ID),
+		OrganizationID: model.OrganizationID(definition.OrganizationID),
+		Key:            model.RoomType(definition.Key),
+		Label:          definition.Label,
+		CreatedAt:      definition.CreatedAt.Time,
+		UpdatedAt:      definition.UpdatedAt.Time,
+	}
+}
+
+func (t *SqlcTransaction) CreateRoomType(ctx context.Context, definition model.RoomTypeDefinition) error {
+	return t.queries.CreateRoomType(ctx, sqlcgen.CreateRoomTypeParams{
+		ID:             definition.ID.UUID(),
+		OrganizationID: definition.OrganizationID.UUID(),
+		Key:            definition.Key.String(),
+		Label:          definition.Label,
+	})
+}
+
+func (t *SqlcTransaction) GetRoomType(ctx context.Context, id model.RoomTypeID) (model.RoomTypeDefinition, error) {
+	row, err := t.queries.GetRoomType(ctx, id.UUID())
+	if err != nil {
+		return model.RoomTypeDefinition{}, err
+	}
+	return parseSqlcRoomType(row.RoomType), nil
+}
+
+func (t *SqlcTransaction) ListRoomTypes(ctx context.Context) (model.RoomTypeCatalog, error) {
+	rows, err := t.queries.ListRoomTypes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(rows, func(row sqlcgen.ListRoomTypesRow, _ int) model.RoomTypeDefinition {
+		return parseSqlcRoomType(row.RoomType)
+	}), nil
+}
+
+func (t *SqlcTransaction) DeleteRoomType(ctx context.Context, id model.RoomTypeID) (int64, error) {
+	return t.queries.DeleteRoomType(ctx, id.UUID())
+}
+
+func (t *SqlcTransaction) CountRoomsByType(ctx context.Context, organizationID model.OrganizationID, key model.RoomType) (int32, error) {
+	return t.queries.CountRoomsByType(ctx, sqlcgen.CountRoomsByTypeParams{
+		OrganizationID: organizationID.UUID(),
+		RoomType:       key.String(),
+	})
+}
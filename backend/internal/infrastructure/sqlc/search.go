@@ -0,0 +1,36 @@
+package sqlc
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+	sqlcgen "github.com/shibayama-club/keyhub/internal/infrastructure/sqlc/gen"
+)
+
+func (t *SqlcTransaction) Search(ctx context.Context, arg repository.SearchArg) ([]model.SearchResult, error) {
+	rows, err := t.queries.Search(ctx, sqlcgen.SearchParams{
+		Query:          arg.Query.String(),
+		OrganizationID: arg.OrganizationID.UUID(),
+		Kinds:          lo.Map(arg.Types, func(t model.SearchResultType, _ int) string { return t.String() }),
+		Pattern:        arg.Query.LikePattern(),
+		ResultLimit:    arg.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results := lo.Map(rows, func(row sqlcgen.SearchRow, _ int) model.SearchResult {
+		return model.SearchResult{
+			Type:     model.SearchResultType(row.Kind),
+			ID:       row.ID,
+			Title:    row.Title,
+			Subtitle: row.Subtitle,
+			ParentID: row.ParentID,
+			Score:    row.Score,
+		}
+	})
+
+	return results, nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/shibayama-club/keyhub/internal/domain/repository"
 	"github.com/shibayama-club/keyhub/internal/infrastructure/sqlc/gen"
@@ -62,3 +63,12 @@ func (r *SqlcRepository) WithTransaction(ctx context.Context, fn func(ctx contex
 func (r *SqlcRepository) Ping(ctx context.Context) error {
 	return r.pool.Ping(ctx)
 }
+
+// markReferenced は外部キー制約で削除できなかったエラーに repository.ErrReferenced を付ける
+func markReferenced(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+		return errors.Mark(err, repository.ErrReferenced)
+	}
+	return err
+}
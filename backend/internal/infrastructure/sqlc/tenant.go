@@ -2,11 +2,15 @@ package sqlc
 
 import (
 	"context"
+	"time"
 
+	"github.com/cockroachdb/errors"
+	"github.com/jackc/pgx/v5"
 	"github.com/samber/lo"
 	"github.com/shibayama-club/keyhub/internal/domain/model"
 	"github.com/shibayama-club/keyhub/internal/domain/repository"
 	sqlcgen "github.com/shibayama-club/keyhub/internal/infrastructure/sqlc/gen"
+	"github.com/shibayama-club/keyhub/internal/util"
 )
 
 func parseSqlcTenant(tenant sqlcgen.Tenant) (model.Tenant, error) {
@@ -16,6 +20,8 @@ func parseSqlcTenant(tenant sqlcgen.Tenant) (model.Tenant, error) {
 		Name:           model.TenantName(tenant.Name),
 		Description:    model.TenantDescription(tenant.Description),
 		Type:           model.TenantType(tenant.TenantType),
+		ArchivedAt:     timestamptzPtrValue(tenant.ArchivedAt),
+		Version:        tenant.Version,
 		CreatedAt:      tenant.CreatedAt.Time,
 		UpdatedAt:      tenant.UpdatedAt.Time,
 	}, nil
@@ -31,13 +37,22 @@ func (t *SqlcTransaction) CreateTenant(ctx context.Context, arg repository.Creat
 	})
 }
 
-func (t *SqlcTransaction) GetAllTenants(ctx context.Context) ([]model.Tenant, error) {
-	rows, err := t.queries.GetAllTenants(ctx)
+func (t *SqlcTransaction) ListTenants(ctx context.Context, arg repository.ListTenantsArg) ([]model.Tenant, error) {
+	cursorID, cursorName, cursorCreatedAt := pageCursorParams(arg.Cursor)
+	rows, err := t.queries.ListTenants(ctx, sqlcgen.ListTenantsParams{
+		TenantType:      lo.EmptyableToPtr(arg.Type.String()),
+		NamePrefix:      lo.EmptyableToPtr(arg.NamePrefix),
+		CursorID:        cursorID,
+		OrderBy:         arg.Order.String(),
+		CursorName:      cursorName,
+		CursorCreatedAt: cursorCreatedAt,
+		PageSize:        arg.Limit,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	tenants := lo.Map(rows, func(row sqlcgen.GetAllTenantsRow, _ int) model.Tenant {
+	tenants := lo.Map(rows, func(row sqlcgen.ListTenantsRow, _ int) model.Tenant {
 		tenant, _ := parseSqlcTenant(row.Tenant)
 		return tenant
 	})
@@ -45,13 +60,23 @@ func (t *SqlcTransaction) GetAllTenants(ctx context.Context) ([]model.Tenant, er
 	return tenants, nil
 }
 
-func (t *SqlcTransaction) GetTenantsByUserID(ctx context.Context, userID model.UserID) ([]repository.TenantWithMemberCount, error) {
-	rows, err := t.queries.GetTenantsByUserID(ctx, userID.UUID())
+func (t *SqlcTransaction) ListTenantsByUserID(ctx context.Context, userID model.UserID, arg repository.ListTenantsArg) ([]repository.TenantWithMemberCount, error) {
+	cursorID, cursorName, cursorCreatedAt := pageCursorParams(arg.Cursor)
+	rows, err := t.queries.ListTenantsByUserID(ctx, sqlcgen.ListTenantsByUserIDParams{
+		UserID:          userID.UUID(),
+		TenantType:      lo.EmptyableToPtr(arg.Type.String()),
+		NamePrefix:      lo.EmptyableToPtr(arg.NamePrefix),
+		CursorID:        cursorID,
+		OrderBy:         arg.Order.String(),
+		CursorName:      cursorName,
+		CursorCreatedAt: cursorCreatedAt,
+		PageSize:        arg.Limit,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	tenants := lo.Map(rows, func(row sqlcgen.GetTenantsByUserIDRow, _ int) repository.TenantWithMemberCount {
+	tenants := lo.Map(rows, func(row sqlcgen.ListTenantsByUserIDRow, _ int) repository.TenantWithMemberCount {
 		tenant, _ := parseSqlcTenant(row.Tenant)
 		return repository.TenantWithMemberCount{
 			Tenant:      tenant,
@@ -82,15 +107,31 @@ func (t *SqlcTransaction) GetTenantByID(ctx context.Context, id model.TenantID)
 	}, nil
 }
 
-func (t *SqlcTransaction) UpdateTenant(ctx context.Context, arg repository.UpdateTenantArg) error {
-	err := t.queries.UpdateTenant(ctx, sqlcgen.UpdateTenantParams{
+func (t *SqlcTransaction) UpdateTenant(ctx context.Context, arg repository.UpdateTenantArg) (int64, error) {
+	version, err := t.queries.UpdateTenant(ctx, sqlcgen.UpdateTenantParams{
 		ID:          arg.ID.UUID(),
 		Name:        arg.Name.String(),
 		Description: arg.Description.String(),
 		TenantType:  arg.Type.String(),
+		Version:     arg.Version,
 	})
-	if err != nil {
-		return err
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, nil
 	}
-	return nil
+	return version, err
+}
+
+func (t *SqlcTransaction) ArchiveTenant(ctx context.Context, id model.TenantID, archivedAt time.Time) (int64, error) {
+	return t.queries.ArchiveTenant(ctx, sqlcgen.ArchiveTenantParams{
+		ID:         id.UUID(),
+		ArchivedAt: util.GoTimeToPgTimestamptz(&archivedAt),
+	})
+}
+
+func (t *SqlcTransaction) DeleteTenant(ctx context.Context, id model.TenantID) (int64, error) {
+	return t.queries.DeleteTenant(ctx, id.UUID())
+}
+
+func (t *SqlcTransaction) CountKeysInUseByTenant(ctx context.Context, id model.TenantID) (int32, error) {
+	return t.queries.CountKeysInUseByTenant(ctx, id.UUID())
 }
@@ -0,0 +1,112 @@
+package sqlc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+	sqlcgen "github.com/shibayama-club/keyhub/internal/infrastructure/sqlc/gen"
+)
+
+func parseSqlcTenantGroup(group sqlcgen.TenantGroup) (model.TenantGroup, error) {
+	var parentID *model.TenantGroupID
+	if group.ParentGroupID != nil {
+		id := model.TenantGroupID(*group.ParentGroupID)
+		parentID = &id
+	}
+
+	return model.TenantGroup{
+		ID:             model.TenantGroupID(group.ID),
+		TenantID:       model.TenantID(group.TenantID),
+		OrganizationID: model.OrganizationID(group.OrganizationID),
+		ParentID:       parentID,
+		Name:           model.TenantGroupName(group.Name),
+		Description:    model.TenantGroupDescription(group.Description),
+		CreatedAt:      group.CreatedAt.Time,
+		UpdatedAt:      group.UpdatedAt.Time,
+	}, nil
+}
+
+func tenantGroupIDToUUID(id *model.TenantGroupID) *uuid.UUID {
+	if id == nil {
+		return nil
+	}
+	return lo.ToPtr(id.UUID())
+}
+
+func (t *SqlcTransaction) CreateTenantGroup(ctx context.Context, group model.TenantGroup) error {
+	return t.queries.CreateTenantGroup(ctx, sqlcgen.CreateTenantGroupParams{
+		ID:             group.ID.UUID(),
+		TenantID:       group.TenantID.UUID(),
+		OrganizationID: group.OrganizationID.UUID(),
+		ParentGroupID:  tenantGroupIDToUUID(group.ParentID),
+		Name:           group.Name.String(),
+		Description:    group.Description.String(),
+	})
+}
+
+func (t *SqlcTransaction) GetTenantGroup(ctx context.Context, id model.TenantGroupID) (model.TenantGroup, error) {
+	row, err := t.queries.GetTenantGroup(ctx, id.UUID())
+	if err != nil {
+		return model.TenantGroup{}, err
+	}
+	return parseSqlcTenantGroup(row.TenantGroup)
+}
+
+func (t *SqlcTransaction) GetTenantGroupByTenantAndName(ctx context.Context, tenantID model.TenantID, name model.TenantGroupName) (model.TenantGroup, error) {
+	row, err := t.queries.GetTenantGroupByTenantAndName(ctx, sqlcgen.GetTenantGroupByTenantAndNameParams{
+		TenantID: tenantID.UUID(),
+		Name:     name.String(),
+	})
+	if err != nil {
+		return model.TenantGroup{}, err
+	}
+	return parseSqlcTenantGroup(row.TenantGroup)
+}
+
+func (t *SqlcTransaction) ListTenantGroupsByTenant(ctx context.Context, tenantID model.TenantID) ([]repository.TenantGroupWithMemberCount, error) {
+	rows, err := t.queries.ListTenantGroupsByTenant(ctx, tenantID.UUID())
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(rows, func(row sqlcgen.ListTenantGroupsByTenantRow, _ int) repository.TenantGroupWithMemberCount {
+		group, _ := parseSqlcTenantGroup(row.TenantGroup)
+		return repository.TenantGroupWithMemberCount{
+			Group:       group,
+			MemberCount: row.MemberCount,
+		}
+	}), nil
+}
+
+func (t *SqlcTransaction) AddTenantGroupMember(ctx context.Context, groupID model.TenantGroupID, userID model.UserID) (int64, error) {
+	return t.queries.AddTenantGroupMember(ctx, sqlcgen.AddTenantGroupMemberParams{
+		GroupID: groupID.UUID(),
+		UserID:  userID.UUID(),
+	})
+}
+
+func (t *SqlcTransaction) RemoveTenantGroupMember(ctx context.Context, groupID model.TenantGroupID, userID model.UserID) (int64, error) {
+	return t.queries.RemoveTenantGroupMember(ctx, sqlcgen.RemoveTenantGroupMemberParams{
+		GroupID: groupID.UUID(),
+		UserID:  userID.UUID(),
+	})
+}
+
+func (t *SqlcTransaction) ListTenantGroupMembers(ctx context.Context, groupID model.TenantGroupID) ([]model.User, error) {
+	rows, err := t.queries.ListTenantGroupMembers(ctx, groupID.UUID())
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(rows, func(row sqlcgen.ListTenantGroupMembersRow, _ int) model.User {
+		user, _ := parseSqlcUser(row.User)
+		return user
+	}), nil
+}
+
+func (t *SqlcTransaction) DeleteTenantGroupsByTenant(ctx context.Context, tenantID model.TenantID) error {
+	return markReferenced(t.queries.DeleteTenantGroupsByTenant(ctx, tenantID.UUID()))
+}
@@ -53,8 +53,11 @@ func (t *SqlcTransaction) CreateTenantJoinCode(ctx context.Context, arg reposito
 	})
 }
 
-func (t *SqlcTransaction) GetTenantByJoinCode(ctx context.Context, code model.TenantJoinCode) (model.Tenant, error) {
-	sqlcRow, err := t.queries.GetTenantByJoinCode(ctx, code.String())
+func (t *SqlcTransaction) GetTenantByJoinCode(ctx context.Context, organizationID model.OrganizationID, code model.TenantJoinCode) (model.Tenant, error) {
+	sqlcRow, err := t.queries.GetTenantByJoinCode(ctx, sqlcgen.GetTenantByJoinCodeParams{
+		Code:           code.String(),
+		OrganizationID: organizationID.UUID(),
+	})
 	if err != nil {
 		return model.Tenant{}, err
 	}
@@ -74,3 +77,14 @@ func (t *SqlcTransaction) UpdateTenantJoinCodeByTenantId(ctx context.Context, ar
 	}
 	return nil
 }
+
+func (t *SqlcTransaction) DisableTenantJoinCodes(ctx context.Context, tenantID model.TenantID, now time.Time) error {
+	return t.queries.DisableTenantJoinCodes(ctx, sqlcgen.DisableTenantJoinCodesParams{
+		TenantID: tenantID.UUID(),
+		Now:      util.GoTimeToPgTimestamptz(&now),
+	})
+}
+
+func (t *SqlcTransaction) DeleteTenantJoinCodesByTenant(ctx context.Context, tenantID model.TenantID) error {
+	return t.queries.DeleteTenantJoinCodesByTenant(ctx, tenantID.UUID())
+}
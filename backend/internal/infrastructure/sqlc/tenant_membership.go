@@ -50,3 +50,10 @@ func (t *SqlcTransaction) GetTenantMembershipByTenantAndUser(ctx context.Context
 	}
 	return parseSqlcTenantMembership(sqlcRow.TenantMembership)
 }
+
+func (t *SqlcTransaction) DeleteTenantMembershipsByTenant(ctx context.Context, tenantID model.TenantID) error {
+	if err := t.queries.ClearActiveMembershipsByTenant(ctx, tenantID.UUID()); err != nil {
+		return err
+	}
+	return t.queries.DeleteTenantMembershipsByTenant(ctx, tenantID.UUID())
+}
@@ -0,0 +1,59 @@
+package sqlc
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	sqlcgen "github.com/shibayama-club/keyhub/internal/infrastructure/sqlc/gen"
+)
+
+func parseSqlcTenantType(definition sqlcgen.TenantType) model.TenantTypeDefinition {
+	return model.TenantTypeDefinition{
+		ID:             model.TenantTypeID(definition.ID),
+		OrganizationID: model.OrganizationID(definition.OrganizationID),
+		Key:            model.TenantType(definition.Key),
+		Label:          definition.Label,
+		CreatedAt:      definition.CreatedAt.Time,
+		UpdatedAt:      definition.UpdatedAt.Time,
+	}
+}
+
+func (t *SqlcTransaction) CreateTenantType(ctx context.Context, definition model.TenantTypeDefinition) error {
+	return t.queries.CreateTenantType(ctx, sqlcgen.CreateTenantTypeParams{
+		ID:             definition.ID.UUID(),
+		OrganizationID: definition.OrganizationID.UUID(),
+		Key:            definition.Key.String(),
+		Label:          definition.Label,
+	})
+}
+
+func (t *SqlcTransaction) GetTenantType(ctx context.Context, id model.TenantTypeID) (model.TenantTypeDefinition, error) {
+	row, err := t.queries.GetTenantType(ctx, id.UUID())
+	if err != nil {
+		return model.TenantTypeDefinition{}, err
+	}
+	return parseSqlcTenantType(row.TenantType), nil
+}
+
+func (t *SqlcTransaction) ListTenantTypes(ctx context.Context) (model.TenantTypeCatalog, error) {
+	rows, err := t.queries.ListTenantTypes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(rows, func(row sqlcgen.ListTenantTypesRow, _ int) model.TenantTypeDefinition {
+		return parseSqlcTenantType(row.TenantType)
+	}), nil
+}
+
+func (t *SqlcTransaction) DeleteTenantType(ctx context.Context, id model.TenantTypeID) (int64, error) {
+	return t.queries.DeleteTenantType(ctx, id.UUID())
+}
+
+func (t *SqlcTransaction) CountTenantsByType(ctx context.Context, organizationID model.OrganizationID, key model.TenantType) (int32, error) {
+	return t.queries.CountTenantsByType(ctx, sqlcgen.CountTenantsByTypeParams{
+		OrganizationID: organizationID.UUID(),
+		TenantType:     key.String(),
+	})
+}
@@ -64,3 +64,18 @@ func (t *SqlcTransaction) UpsertUserIdentity(ctx context.Context, arg repository
 		ProviderSub: arg.ProviderSub,
 	})
 }
+
+func (t *SqlcTransaction) GetUserProviderSub(ctx context.Context, userID model.UserID, provider string) (string, error) {
+	row, err := t.queries.GetUserIdentityByUser(ctx, sqlcgen.GetUserIdentityByUserParams{
+		UserID:   userID.UUID(),
+		Provider: provider,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", errors.New("user identity not found")
+		}
+		return "", err
+	}
+
+	return row.UserIdentity.ProviderSub, nil
+}
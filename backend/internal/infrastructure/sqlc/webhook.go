@@ -0,0 +1,140 @@
+package sqlc
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/samber/lo"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	sqlcgen "github.com/shibayama-club/keyhub/internal/infrastructure/sqlc/gen"
+)
+
+func parseSqlcWebhookSubscription(subscription sqlcgen.WebhookSubscription) model.WebhookSubscription {
+	return model.WebhookSubscription{
+		ID:             model.WebhookSubscriptionID(subscription.ID),
+		OrganizationID: model.OrganizationID(subscription.OrganizationID),
+		URL:            model.WebhookURL(subscription.Url),
+		EventTypes: lo.Map(subscription.EventTypes, func(t string, _ int) model.DomainEventType {
+			return model.DomainEventType(t)
+		}),
+		Secret:      model.WebhookSecret(subscription.Secret),
+		Description: model.WebhookDescription(subscription.Description),
+		CreatedAt:   subscription.CreatedAt.Time,
+	}
+}
+
+func parseSqlcWebhookDelivery(delivery sqlcgen.WebhookDelivery) model.WebhookDelivery {
+	return model.WebhookDelivery{
+		ID:             model.WebhookDeliveryID(delivery.ID),
+		SubscriptionID: model.WebhookSubscriptionID(delivery.SubscriptionID),
+		OrganizationID: model.OrganizationID(delivery.OrganizationID),
+		EventID:        model.OutboxEventID(delivery.EventID),
+		EventType:      model.DomainEventType(delivery.EventType),
+		StatusCode:     int(delivery.StatusCode),
+		Error:          delivery.Error,
+		Succeeded:      delivery.Succeeded,
+		Duration:       time.Duration(delivery.DurationMs) * time.Millisecond,
+		CreatedAt:      delivery.CreatedAt.Time,
+	}
+}
+
+func (t *SqlcTransaction) CreateWebhookSubscription(ctx context.Context, subscription model.WebhookSubscription) error {
+	return t.queries.CreateWebhookSubscription(ctx, sqlcgen.CreateWebhookSubscriptionParams{
+		ID:             subscription.ID.UUID(),
+		OrganizationID: subscription.OrganizationID.UUID(),
+		Url:            subscription.URL.String(),
+		EventTypes: lo.Map(subscription.EventTypes, func(t model.DomainEventType, _ int) string {
+			return t.String()
+		}),
+		Secret:      subscription.Secret.String(),
+		Description: subscription.Description.String(),
+		CreatedAt: pgtype.Timestamptz{
+			Time:  subscription.CreatedAt,
+			Valid: true,
+		},
+	})
+}
+
+func (t *SqlcTransaction) GetWebhookSubscription(ctx context.Context, organizationID model.OrganizationID, id model.WebhookSubscriptionID) (model.WebhookSubscription, error) {
+	row, err := t.queries.GetWebhookSubscription(ctx, sqlcgen.GetWebhookSubscriptionParams{
+		ID:             id.UUID(),
+		OrganizationID: organizationID.UUID(),
+	})
+	if err != nil {
+		return model.WebhookSubscription{}, err
+	}
+	return parseSqlcWebhookSubscription(row.WebhookSubscription), nil
+}
+
+func (t *SqlcTransaction) ListWebhookSubscriptionsByOrganization(ctx context.Context, organizationID model.OrganizationID) ([]model.WebhookSubscription, error) {
+	rows, err := t.queries.ListWebhookSubscriptionsByOrganization(ctx, organizationID.UUID())
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(rows, func(row sqlcgen.ListWebhookSubscriptionsByOrganizationRow, _ int) model.WebhookSubscription {
+		return parseSqlcWebhookSubscription(row.WebhookSubscription)
+	}), nil
+}
+
+func (t *SqlcTransaction) ListWebhookSubscriptionsByEventType(ctx context.Context, organizationID model.OrganizationID, eventType model.DomainEventType) ([]model.WebhookSubscription, error) {
+	rows, err := t.queries.ListWebhookSubscriptionsByEventType(ctx, sqlcgen.ListWebhookSubscriptionsByEventTypeParams{
+		OrganizationID: organizationID.UUID(),
+		EventType:      eventType.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(rows, func(row sqlcgen.ListWebhookSubscriptionsByEventTypeRow, _ int) model.WebhookSubscription {
+		return parseSqlcWebhookSubscription(row.WebhookSubscription)
+	}), nil
+}
+
+func (t *SqlcTransaction) DeleteWebhookSubscription(ctx context.Context, organizationID model.OrganizationID, id model.WebhookSubscriptionID) (int64, error) {
+	return t.queries.DeleteWebhookSubscription(ctx, sqlcgen.DeleteWebhookSubscriptionParams{
+		ID:             id.UUID(),
+		OrganizationID: organizationID.UUID(),
+	})
+}
+
+func (t *SqlcTransaction) CreateWebhookDelivery(ctx context.Context, delivery model.WebhookDelivery) error {
+	return t.queries.CreateWebhookDelivery(ctx, sqlcgen.CreateWebhookDeliveryParams{
+		ID:             delivery.ID.UUID(),
+		SubscriptionID: delivery.SubscriptionID.UUID(),
+		OrganizationID: delivery.OrganizationID.UUID(),
+		EventID:        delivery.EventID.UUID(),
+		EventType:      delivery.EventType.String(),
+		StatusCode:     int32(delivery.StatusCode),
+		Error:          delivery.Error,
+		Succeeded:      delivery.Succeeded,
+		DurationMs:     int32(delivery.Duration.Milliseconds()),
+		CreatedAt: pgtype.Timestamptz{
+			Time:  delivery.CreatedAt,
+			Valid: true,
+		},
+	})
+}
+
+func (t *SqlcTransaction) HasSucceededWebhookDelivery(ctx context.Context, subscriptionID model.WebhookSubscriptionID, eventID model.OutboxEventID) (bool, error) {
+	return t.queries.HasSucceededWebhookDelivery(ctx, sqlcgen.HasSucceededWebhookDeliveryParams{
+		SubscriptionID: subscriptionID.UUID(),
+		EventID:        eventID.UUID(),
+	})
+}
+
+func (t *SqlcTransaction) ListWebhookDeliveries(ctx context.Context, organizationID model.OrganizationID, subscriptionID model.WebhookSubscriptionID, limit int32) ([]model.WebhookDelivery, error) {
+	rows, err := t.queries.ListWebhookDeliveries(ctx, sqlcgen.ListWebhookDeliveriesParams{
+		SubscriptionID: subscriptionID.UUID(),
+		OrganizationID: organizationID.UUID(),
+		PageSize:       limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(rows, func(row sqlcgen.ListWebhookDeliveriesRow, _ int) model.WebhookDelivery {
+		return parseSqlcWebhookDelivery(row.WebhookDelivery)
+	}), nil
+}
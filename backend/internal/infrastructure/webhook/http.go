@@ -0,0 +1,172 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/webhook"
+)
+
+const (
+	// HeaderSignature は本文の署名。形式は "t=<UNIX秒>,v1=<HMAC-SHA256の16進>"
+	HeaderSignature = "X-KeyHub-Signature"
+	// HeaderEvent はイベントの種類
+	HeaderEvent = "X-KeyHub-Event"
+	// HeaderDelivery はイベントID。再送しても変わらない
+	HeaderDelivery = "X-KeyHub-Delivery"
+
+	userAgent = "KeyHub-Webhook/1.0"
+	// sendTimeout は1回の送信で応答を待つ時間
+	sendTimeout = 10 * time.Second
+	// dialTimeout は送信先への接続を待つ時間
+	dialTimeout = 5 * time.Second
+)
+
+// ErrDisallowedAddress は送信先が内部ネットワークのアドレスに解決された場合のエラー
+var ErrDisallowedAddress = errors.New("webhook destination address is not allowed")
+
+// deniedNetworks は netip.Addr の判定メソッドでは拾えない、送信してはいけないネットワーク
+var deniedNetworks = []netip.Prefix{
+	// "このネットワーク"。0.0.0.0 以外も Linux ではローカルホストに届く
+	netip.MustParsePrefix("0.0.0.0/8"),
+	// キャリアグレードNATの共有アドレス
+	netip.MustParsePrefix("100.64.0.0/10"),
+	// NAT64 の変換用プレフィックス。埋め込んだIPv4アドレスが内部ネットワークでも届いてしまう
+	netip.MustParsePrefix("64:ff9b::/96"),
+}
+
+// HTTPSender は署名付きのJSONをPOSTで送信する
+type HTTPSender struct {
+	client *http.Client
+	now    func() time.Time
+}
+
+var _ webhook.Sender = (*HTTPSender)(nil)
+
+// NewHTTPSender は送信先のIPアドレスを接続の直前に検査する HTTPSender を作る。
+// ループバック・プライベート・リンクローカル・マルチキャスト・未指定のアドレス、
+// 共有アドレス（100.64.0.0/10）と NAT64（64:ff9b::/96）へは送らない。
+// allowedNetworks（CIDR または IP）に含まれるアドレスだけは例外として許可する（ローカル開発の受信スタブ用）
+func NewHTTPSender(allowedNetworks []string) (*HTTPSender, error) {
+	allowed, err := parseNetworks(allowedNetworks)
+	if err != nil {
+		return nil, err
+	}
+
+	dialer := &net.Dialer{
+		Timeout: dialTimeout,
+		// 名前解決後のアドレスで検査するため、DNSリバインディングでも内部ネットワークには接続しない
+		Control: func(network, address string, _ syscall.RawConn) error {
+			return checkAddress(address, allowed)
+		},
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// プロキシ経由ではプロキシのアドレスしか検査できないため、環境変数のプロキシ設定は使わない
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &HTTPSender{
+		client: &http.Client{
+			Timeout:   sendTimeout,
+			Transport: transport,
+			// リダイレクト先に署名付きの本文を送らないよう、リダイレクトは失敗として扱う
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		now: time.Now,
+	}, nil
+}
+
+func parseNetworks(values []string) ([]netip.Prefix, error) {
+	networks := make([]netip.Prefix, 0, len(values))
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		if !strings.Contains(v, "/") {
+			addr, err := netip.ParseAddr(v)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid webhook allowed network %q", v)
+			}
+			networks = append(networks, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+			continue
+		}
+		prefix, err := netip.ParsePrefix(v)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid webhook allowed network %q", v)
+		}
+		networks = append(networks, prefix.Masked())
+	}
+	return networks, nil
+}
+
+// checkAddress は接続先（"IP:ポート"）が送信してよいアドレスか確かめる
+func checkAddress(address string, allowed []netip.Prefix) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return errors.Wrapf(ErrDisallowedAddress, "unexpected address %q", address)
+	}
+	addr := addrPort.Addr().Unmap()
+	for _, prefix := range allowed {
+		if prefix.Contains(addr) {
+			return nil
+		}
+	}
+	if addr.IsLoopback() || addr.IsPrivate() || addr.IsUnspecified() ||
+		addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsMulticast() {
+		return errors.Wrapf(ErrDisallowedAddress, "refused to connect to %s", addr)
+	}
+	for _, prefix := range deniedNetworks {
+		if prefix.Contains(addr) {
+			return errors.Wrapf(ErrDisallowedAddress, "refused to connect to %s", addr)
+		}
+	}
+	return nil
+}
+
+func (s *HTTPSender) Send(ctx context.Context, subscription model.WebhookSubscription, message model.WebhookMessage) (model.WebhookSendResult, error) {
+	body, err := message.Body()
+	if err != nil {
+		return model.WebhookSendResult{}, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL.String(), bytes.NewReader(body))
+	if err != nil {
+		return model.WebhookSendResult{}, errors.Wrap(err, "failed to create webhook request")
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set(HeaderEvent, message.Type.String())
+	req.Header.Set(HeaderDelivery, message.EventID.String())
+	req.Header.Set(HeaderSignature, subscription.Secret.Sign(s.now(), body))
+
+	start := time.Now()
+	resp, err := s.client.Do(req)
+	result := model.WebhookSendResult{Duration: time.Since(start)}
+	if err != nil {
+		return result, errors.Wrap(err, "failed to send webhook")
+	}
+	defer resp.Body.Close()
+	// 接続を再利用できるよう本文は読み捨てる
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	result.StatusCode = resp.StatusCode
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return result, errors.Newf("webhook endpoint responded with status %d", resp.StatusCode)
+	}
+
+	return result, nil
+}
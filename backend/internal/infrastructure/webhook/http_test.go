@@ -0,0 +1,247 @@
+package webhook
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPSender_Send(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	secret, err := model.GenerateWebhookSecret()
+	require.NoError(t, err)
+
+	message := model.WebhookMessage{
+		EventID:        model.OutboxEventID(uuid.New()),
+		Type:           model.DomainEventMemberJoined,
+		OrganizationID: model.OrganizationID(uuid.New()),
+		OccurredAt:     now,
+		Data:           json.RawMessage(`{"tenant_id":"t1","user_id":"u1"}`),
+	}
+
+	tests := []struct {
+		name       string
+		status     int
+		wantStatus int
+		wantErr    bool
+	}{
+		{
+			name:       "正常系: 署名付きの本文を送信する",
+			status:     http.StatusNoContent,
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "異常系: 2xx以外はエラーにする",
+			status:     http.StatusInternalServerError,
+			wantStatus: http.StatusInternalServerError,
+			wantErr:    true,
+		},
+		{
+			name:       "異常系: リダイレクトには従わない",
+			status:     http.StatusFound,
+			wantStatus: http.StatusFound,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotBody []byte
+			var gotHeader http.Header
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotHeader = r.Header.Clone()
+				gotBody, _ = io.ReadAll(r.Body)
+				if tt.status == http.StatusFound {
+					w.Header().Set("Location", "/elsewhere")
+				}
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			subscription := model.WebhookSubscription{
+				ID:             model.WebhookSubscriptionID(uuid.New()),
+				OrganizationID: message.OrganizationID,
+				URL:            model.WebhookURL(server.URL),
+				Secret:         secret,
+			}
+
+			// httptest のサーバーはループバックで待ち受けるため、ローカル開発と同じく許可リストに入れる
+			sender, err := NewHTTPSender([]string{"127.0.0.1", "::1"})
+			require.NoError(t, err)
+			sender.now = func() time.Time { return now }
+
+			result, err := sender.Send(t.Context(), subscription, message)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantStatus, result.StatusCode)
+
+			assert.Equal(t, "application/json", gotHeader.Get("Content-Type"))
+			assert.Equal(t, model.DomainEventMemberJoined.String(), gotHeader.Get(HeaderEvent))
+			assert.Equal(t, message.EventID.String(), gotHeader.Get(HeaderDelivery))
+			assert.Equal(t, secret.Sign(now, gotBody), gotHeader.Get(HeaderSignature))
+
+			var body map[string]any
+			require.NoError(t, json.Unmarshal(gotBody, &body))
+			assert.Equal(t, message.EventID.String(), body["id"])
+			assert.Equal(t, "tenant.member_joined", body["type"])
+			assert.Equal(t, map[string]any{"tenant_id": "t1", "user_id": "u1"}, body["data"])
+		})
+	}
+}
+
+func TestHTTPSender_Send_DisallowedAddress(t *testing.T) {
+	secret, err := model.GenerateWebhookSecret()
+	require.NoError(t, err)
+
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	subscription := model.WebhookSubscription{
+		ID:             model.WebhookSubscriptionID(uuid.New()),
+		OrganizationID: model.OrganizationID(uuid.New()),
+		URL:            model.WebhookURL(server.URL),
+		Secret:         secret,
+	}
+	message := model.WebhookMessage{
+		EventID:        model.OutboxEventID(uuid.New()),
+		Type:           model.WebhookTestEventType,
+		OrganizationID: subscription.OrganizationID,
+		OccurredAt:     time.Now(),
+		Data:           json.RawMessage(`{}`),
+	}
+
+	sender, err := NewHTTPSender(nil)
+	require.NoError(t, err)
+
+	result, err := sender.Send(t.Context(), subscription, message)
+	assert.ErrorIs(t, err, ErrDisallowedAddress)
+	assert.Zero(t, result.StatusCode)
+	assert.False(t, called)
+}
+
+func TestCheckAddress(t *testing.T) {
+	tests := []struct {
+		name    string
+		address string
+		allowed []string
+		wantErr bool
+	}{
+		{
+			name:    "正常系: グローバルアドレスには送信できる",
+			address: "93.184.216.34:443",
+		},
+		{
+			name:    "正常系: 許可リストに含まれるループバックには送信できる",
+			address: "127.0.0.1:8080",
+			allowed: []string{"127.0.0.1"},
+		},
+		{
+			name:    "正常系: 許可リストのCIDRに含まれるプライベートアドレスには送信できる",
+			address: "10.1.2.3:80",
+			allowed: []string{"10.0.0.0/8"},
+		},
+		{
+			name:    "正常系: 許可リストに含まれる共有アドレスには送信できる",
+			address: "100.64.0.1:80",
+			allowed: []string{"100.64.0.0/10"},
+		},
+		{
+			name:    "異常系: ループバックには送信できない",
+			address: "127.0.0.1:8080",
+			wantErr: true,
+		},
+		{
+			name:    "異常系: IPv6のループバックには送信できない",
+			address: "[::1]:8080",
+			wantErr: true,
+		},
+		{
+			name:    "異常系: プライベートアドレスには送信できない",
+			address: "192.168.1.10:80",
+			wantErr: true,
+		},
+		{
+			name:    "異常系: リンクローカル（メタデータサーバー）には送信できない",
+			address: "169.254.169.254:80",
+			wantErr: true,
+		},
+		{
+			name:    "異常系: マルチキャストには送信できない",
+			address: "224.0.0.1:80",
+			wantErr: true,
+		},
+		{
+			name:    "異常系: 未指定のアドレスには送信できない",
+			address: "0.0.0.0:80",
+			wantErr: true,
+		},
+		{
+			name:    "異常系: 0.0.0.0/8 のアドレスには送信できない",
+			address: "0.1.2.3:80",
+			wantErr: true,
+		},
+		{
+			name:    "異常系: キャリアグレードNATの共有アドレスには送信できない",
+			address: "100.64.0.1:80",
+			wantErr: true,
+		},
+		{
+			name:    "異常系: NAT64のアドレスには送信できない",
+			address: "[64:ff9b::a9fe:a9fe]:80",
+			wantErr: true,
+		},
+		{
+			name:    "異常系: IPv4射影のループバックには送信できない",
+			address: "[::ffff:127.0.0.1]:80",
+			wantErr: true,
+		},
+		{
+			name:    "異常系: 許可リストに含まれないプライベートアドレスには送信できない",
+			address: "172.16.0.1:80",
+			allowed: []string{"127.0.0.1"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			allowed, err := parseNetworks(tt.allowed)
+			require.NoError(t, err)
+
+			err = checkAddress(tt.address, allowed)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrDisallowedAddress)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestNewHTTPSender_InvalidAllowedNetwork(t *testing.T) {
+	_, err := NewHTTPSender([]string{"not-an-ip"})
+	assert.Error(t, err)
+
+	_, err = parseNetworks([]string{"10.0.0.0/33"})
+	assert.Error(t, err)
+
+	networks, err := parseNetworks([]string{" 127.0.0.1 ", "", "10.1.0.0/8"})
+	require.NoError(t, err)
+	assert.Equal(t, []netip.Prefix{netip.MustParsePrefix("127.0.0.1/32"), netip.MustParsePrefix("10.0.0.0/8")}, networks)
+}
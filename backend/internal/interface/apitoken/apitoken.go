@@ -0,0 +1,40 @@
+package apitoken
+
+import (
+	"log/slog"
+
+	"connectrpc.com/connect"
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Scopes はトークンのスコープを ApiToken メッセージに載せる文字列に変換する
+func Scopes(t model.APIToken) []string {
+	return lo.Map(t.Scopes, func(s model.APITokenScope, _ int) string {
+		return s.String()
+	})
+}
+
+// LastUsedAt は最終利用日時を返す。一度も使われていなければ nil を返す
+func LastUsedAt(t model.APIToken) *timestamppb.Timestamp {
+	if t.LastUsedAt == nil {
+		return nil
+	}
+	return timestamppb.New(*t.LastUsedAt)
+}
+
+// ConnectError はトークンの発行・一覧・無効化で起きたエラーを Connect のエラーに変換する。
+// 入力の誤りと見つからない場合以外は内部エラーとして msg とともにログに残す
+func ConnectError(l *slog.Logger, err error, msg string) error {
+	switch {
+	case errors.Is(err, domainerrors.ErrValidation):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, domainerrors.ErrNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	}
+	l.Error(msg, "error", err)
+	return connect.NewError(connect.CodeInternal, errors.Wrap(err, msg))
+}
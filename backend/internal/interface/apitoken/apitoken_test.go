@@ -0,0 +1,55 @@
+package apitoken
+
+import (
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/cockroachdb/errors"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConnectError(t *testing.T) {
+	l := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	tests := []struct {
+		name     string
+		err      error
+		wantCode connect.Code
+	}{
+		{
+			name:     "異常系: 入力の誤りは InvalidArgument",
+			err:      errors.Mark(errors.New("invalid name"), domainerrors.ErrValidation),
+			wantCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:     "異常系: 見つからない場合は NotFound",
+			err:      errors.Mark(errors.New("not found"), domainerrors.ErrNotFound),
+			wantCode: connect.CodeNotFound,
+		},
+		{
+			name:     "異常系: それ以外は Internal",
+			err:      errors.Mark(errors.New("db down"), domainerrors.ErrInternal),
+			wantCode: connect.CodeInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ConnectError(l, tt.err, "failed to revoke API token")
+			assert.Equal(t, tt.wantCode, connect.CodeOf(err))
+			assert.True(t, errors.Is(err, tt.err), "expected error type %v, got %v", tt.err, err)
+		})
+	}
+}
+
+func TestLastUsedAt(t *testing.T) {
+	usedAt := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	assert.Nil(t, LastUsedAt(model.APIToken{}))
+	assert.Equal(t, usedAt, LastUsedAt(model.APIToken{LastUsedAt: &usedAt}).AsTime())
+}
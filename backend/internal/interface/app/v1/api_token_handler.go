@@ -0,0 +1,94 @@
+package v1
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"github.com/shibayama-club/keyhub/internal/domain"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/interface/apitoken"
+	appv1 "github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/app/v1"
+	"github.com/shibayama-club/keyhub/internal/usecase/app/dto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func convertAPITokenToProto(t model.APIToken) *appv1.ApiToken {
+	return &appv1.ApiToken{
+		Id:          t.ID.String(),
+		Name:        t.Name.String(),
+		TokenPrefix: t.TokenPrefix,
+		Scopes:      apitoken.Scopes(t),
+		ExpiresAt:   timestamppb.New(t.ExpiresAt),
+		LastUsedAt:  apitoken.LastUsedAt(t),
+		CreatedAt:   timestamppb.New(t.CreatedAt),
+	}
+}
+
+func (h *Handler) CreateApiToken(
+	ctx context.Context,
+	req *connect.Request[appv1.CreateApiTokenRequest],
+) (*connect.Response[appv1.CreateApiTokenResponse], error) {
+	userID, ok := domain.Value[model.UserID](ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user not authenticated"))
+	}
+	organizationID, ok := domain.Value[model.OrganizationID](ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("organization not authenticated"))
+	}
+
+	output, err := h.useCase.CreateAPIToken(ctx, dto.CreateAPITokenInput{
+		UserID:         userID,
+		OrganizationID: organizationID,
+		Name:           req.Msg.Name,
+		Scopes:         req.Msg.Scopes,
+		ExpiresAt:      req.Msg.ExpiresAt.AsTime(),
+	})
+	if err != nil {
+		return nil, apitoken.ConnectError(h.l, err, "failed to create API token")
+	}
+
+	return connect.NewResponse(&appv1.CreateApiTokenResponse{
+		ApiToken: convertAPITokenToProto(output.APIToken),
+		Token:    output.Token,
+	}), nil
+}
+
+func (h *Handler) ListApiTokens(
+	ctx context.Context,
+	req *connect.Request[appv1.ListApiTokensRequest],
+) (*connect.Response[appv1.ListApiTokensResponse], error) {
+	userID, ok := domain.Value[model.UserID](ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user not authenticated"))
+	}
+
+	tokens, err := h.useCase.ListAPITokens(ctx, userID)
+	if err != nil {
+		return nil, apitoken.ConnectError(h.l, err, "failed to list API tokens")
+	}
+
+	return connect.NewResponse(&appv1.ListApiTokensResponse{
+		ApiTokens: lo.Map(tokens, func(t model.APIToken, _ int) *appv1.ApiToken {
+			return convertAPITokenToProto(t)
+		}),
+	}), nil
+}
+
+func (h *Handler) RevokeApiToken(
+	ctx context.Context,
+	req *connect.Request[appv1.RevokeApiTokenRequest],
+) (*connect.Response[appv1.RevokeApiTokenResponse], error) {
+	userID, ok := domain.Value[model.UserID](ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user not authenticated"))
+	}
+
+	if err := h.useCase.RevokeAPIToken(ctx, userID, req.Msg.Id); err != nil {
+		return nil, apitoken.ConnectError(h.l, err, "failed to revoke API token")
+	}
+
+	return connect.NewResponse(&appv1.RevokeApiTokenResponse{}), nil
+}
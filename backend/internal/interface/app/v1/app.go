@@ -3,6 +3,8 @@ package v1
 import (
 	"log/slog"
 
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	appv1 "github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/app/v1"
 	"github.com/shibayama-club/keyhub/internal/usecase/app/iface"
 )
 
@@ -11,13 +13,30 @@ type Handler struct {
 	useCase     iface.IUseCase
 	env         string
 	frontendURL string
+	baseDomain  string
 }
 
-func NewHandler(useCase iface.IUseCase, env, frontendURL string) *Handler {
+func NewHandler(useCase iface.IUseCase, env, frontendURL, baseDomain string) *Handler {
 	return &Handler{
 		l:           slog.Default(),
 		useCase:     useCase,
 		env:         env,
 		frontendURL: frontendURL,
+		baseDomain:  baseDomain,
+	}
+}
+
+// convertListOrder は一覧の並び順をユースケースに渡す文字列にする。
+// 未指定は空文字、未知の値はユースケースで検証エラーになるようそのまま渡す
+func convertListOrder(order appv1.ListOrder) string {
+	switch order {
+	case appv1.ListOrder_LIST_ORDER_UNSPECIFIED:
+		return ""
+	case appv1.ListOrder_LIST_ORDER_NEWEST:
+		return model.ListOrderNewest.String()
+	case appv1.ListOrder_LIST_ORDER_NAME:
+		return model.ListOrderName.String()
+	default:
+		return order.String()
 	}
 }
@@ -5,6 +5,7 @@ import (
 
 	"connectrpc.com/connect"
 	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 	"github.com/shibayama-club/keyhub/internal/domain"
 	"github.com/shibayama-club/keyhub/internal/domain/model"
 	appv1 "github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/app/v1"
@@ -20,6 +21,11 @@ func (h *Handler) GetMe(
 		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user not authenticated"))
 	}
 
+	session, ok := domain.Value[model.AppSession](ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("session not found"))
+	}
+
 	user, err := h.useCase.GetUserByID(ctx, userID)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to get user"))
@@ -34,6 +40,7 @@ func (h *Handler) GetMe(
 			CreatedAt: timestamppb.New(user.CreatedAt),
 			UpdatedAt: timestamppb.New(user.UpdatedAt),
 		},
+		CsrfToken: lo.FromPtr(session.CSRFToken),
 	}), nil
 }
 
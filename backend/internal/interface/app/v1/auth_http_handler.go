@@ -3,14 +3,27 @@ package v1
 import (
 	"net/http"
 
+	"github.com/cockroachdb/errors"
 	"github.com/labstack/echo/v4"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/interface/app/v1/cookie"
+	"github.com/shibayama-club/keyhub/internal/interface/clientinfo"
+	"github.com/shibayama-club/keyhub/internal/interface/orgslug"
 )
 
+// GoogleLogin はログイン先の組織を ?organization= またはフロントエンドのサブドメインから決めてGoogleへリダイレクトする
 func (h *Handler) GoogleLogin(c echo.Context) error {
 	ctx := c.Request().Context()
 
-	authURL, err := h.useCase.StartGoogleLogin(ctx)
+	organizationSlug := orgslug.FromRequest(c.Request().Header, c.QueryParam("organization"), h.baseDomain)
+	authURL, err := h.useCase.StartGoogleLogin(ctx, organizationSlug)
 	if err != nil {
+		switch {
+		case errors.Is(err, domainerrors.ErrValidation):
+			return echo.NewHTTPError(http.StatusBadRequest, "Organization is not specified")
+		case errors.Is(err, domainerrors.ErrNotFound):
+			return echo.NewHTTPError(http.StatusNotFound, "Organization not found")
+		}
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to start login process")
 	}
 
@@ -27,27 +40,13 @@ func (h *Handler) GoogleCallback(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "Invalid callback parameters")
 	}
 
-	sessionID, err := h.useCase.GoogleCallback(ctx, code, state)
+	client := clientinfo.FromRequest(c.Request().Header, c.Request().RemoteAddr)
+	output, err := h.useCase.GoogleCallback(ctx, code, state, client)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusUnauthorized, "Authentication failed")
 	}
 
-	isLocal := h.env == "local"
-
-	cookie := &http.Cookie{
-		Name:     "session_id",
-		Value:    sessionID,
-		Path:     "/",
-		HttpOnly: true,
-		Secure:   !isLocal,
-		MaxAge:   24 * 60 * 60,
-	}
-
-	if !isLocal {
-		cookie.SameSite = http.SameSiteNoneMode
-	}
-
-	c.SetCookie(cookie)
+	c.SetCookie(cookie.NewSessionCookie(h.env, output.SessionID, output.ExpiresAt))
 
 	redirectURL := h.frontendURL + "/callback"
 	return c.Redirect(http.StatusFound, redirectURL)
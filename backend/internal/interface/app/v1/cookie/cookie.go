@@ -0,0 +1,29 @@
+package cookie
+
+import (
+	"net/http"
+	"time"
+)
+
+const SessionIDName = "session_id"
+
+// NewSessionCookie はセッションIDを保持するCookieを作成する。
+// ローカル環境以外ではフロントエンドと別オリジンになるため Secure かつ SameSite=None にする
+func NewSessionCookie(env, sessionID string, expiresAt time.Time) *http.Cookie {
+	isLocal := env == "local"
+
+	cookie := &http.Cookie{
+		Name:     SessionIDName,
+		Value:    sessionID,
+		Path:     "/",
+		HttpOnly: true,
+		Secure:   !isLocal,
+		MaxAge:   int(time.Until(expiresAt).Seconds()),
+	}
+
+	if !isLocal {
+		cookie.SameSite = http.SameSiteNoneMode
+	}
+
+	return cookie
+}
@@ -2,64 +2,164 @@ package interceptor
 
 import (
 	"context"
+	"net/http"
 	"strings"
+	"time"
 
 	"connectrpc.com/connect"
+	"github.com/cockroachdb/errors"
 	"github.com/shibayama-club/keyhub/internal/domain"
-	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/interface/app/v1/cookie"
+	"github.com/shibayama-club/keyhub/internal/interface/clientinfo"
+	"github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/app/v1/appv1connect"
+	"github.com/shibayama-club/keyhub/internal/interface/streamauth"
 	"github.com/shibayama-club/keyhub/internal/usecase/app/iface"
 )
 
+// HeaderCSRFToken は GetMe で取得したCSRFトークンを送るリクエストヘッダー
+const HeaderCSRFToken = "X-CSRF-Token"
+
+// publicProcedures はログイン前に呼び出す、認証不要の手続き
+var publicProcedures = map[string]bool{
+	appv1connect.AuthServiceBeginPasskeyLoginProcedure:  true,
+	appv1connect.AuthServiceFinishPasskeyLoginProcedure: true,
+}
+
 type AuthInterceptor struct {
 	useCase iface.IUseCase
+	env     string
+	// revalidateInterval はストリームの途中で認証をやり直す間隔
+	revalidateInterval time.Duration
 }
 
-func NewAuthInterceptor(useCase iface.IUseCase) *AuthInterceptor {
+func NewAuthInterceptor(useCase iface.IUseCase, env string) *AuthInterceptor {
 	return &AuthInterceptor{
-		useCase: useCase,
+		useCase:            useCase,
+		env:                env,
+		revalidateInterval: streamauth.RevalidateInterval,
 	}
 }
 
-func (i *AuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
-	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
-		if strings.Contains(req.Spec().Procedure, "Health") {
-			return next(ctx, req)
-		}
+// authenticate はAPIトークンかCookieのセッションで認証し、認証した情報を ctx に入れて返す。
+// セッションの有効期限を延長した場合は、再発行するCookieもあわせて返す
+func (i *AuthInterceptor) authenticate(ctx context.Context, spec connect.Spec, header http.Header, peerAddr string) (context.Context, *http.Cookie, error) {
+	if strings.Contains(spec.Procedure, "Health") || publicProcedures[spec.Procedure] {
+		return ctx, nil, nil
+	}
+
+	// スクリプトや端末からの呼び出しは Cookie の代わりにAPIトークンで認証する
+	if token, ok := bearerToken(header.Get("Authorization")); ok {
+		ctx, err := i.authenticateAPIToken(ctx, spec.Procedure, token)
+		return ctx, nil, err
+	}
 
-		cookies := req.Header().Get("Cookie")
-		sessionID := extractSessionID(cookies)
+	sessionID := extractSessionID(header.Get("Cookie"))
+	if sessionID == "" {
+		return ctx, nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	client := clientinfo.FromRequest(header, peerAddr)
+	output, err := i.useCase.ValidateSession(ctx, sessionID, client)
+	if err != nil {
+		return ctx, nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	// Cookie認証は別サイトからも送信されるため、更新系RPCはCSRFトークンの一致を必須にする
+	if spec.IdempotencyLevel != connect.IdempotencyNoSideEffects &&
+		!output.Session.VerifyCSRFToken(header.Get(HeaderCSRFToken)) {
+		return ctx, nil, connect.NewError(connect.CodePermissionDenied, errors.New("invalid CSRF token"))
+	}
+
+	// 組織IDを渡すと接続プールがRLS用の keyhub.organization_id を設定し、他の組織のデータが見えなくなる
+	ctx = domain.WithValue(ctx, output.Session)
+	ctx = domain.WithValue(ctx, output.Session.UserID)
+	ctx = domain.WithValue(ctx, output.Session.OrganizationID)
+	ctx = domain.WithValue(ctx, output.Session.SessionID)
+
+	// 有効期限を延長した場合はCookieのMax-Ageも合わせて再発行する
+	if output.Renewed {
+		return ctx, cookie.NewSessionCookie(i.env, output.Session.SessionID.String(), output.Session.ExpiresAt), nil
+	}
+	return ctx, nil, nil
+}
 
-		if sessionID == "" {
-			return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+func (i *AuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		ctx, renewed, err := i.authenticate(ctx, req.Spec(), req.Header(), req.Peer().Addr)
+		if err != nil {
+			return nil, err
 		}
 
-		user, err := i.useCase.GetMe(ctx, sessionID)
+		res, err := next(ctx, req)
 		if err != nil {
-			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+			return nil, err
 		}
+		if renewed != nil {
+			res.Header().Add("Set-Cookie", renewed.String())
+		}
+		return res, nil
+	}
+}
 
-		ctx = domain.WithValue(ctx, user.UserId)
-		appSessionID, _ := model.NewAppSessionID(sessionID)
-		ctx = domain.WithValue(ctx, appSessionID)
+// authenticateAPIToken はAPIトークンを検証し、呼び出す手続きに必要なスコープを持つか確認する
+func (i *AuthInterceptor) authenticateAPIToken(ctx context.Context, procedure, token string) (context.Context, error) {
+	apiToken, err := i.useCase.AuthenticateAPIToken(ctx, token)
+	if err != nil {
+		return ctx, connect.NewError(connect.CodeUnauthenticated, err)
+	}
 
-		return next(ctx, req)
+	scope, ok := apiTokenScopes[procedure]
+	if !ok || !apiToken.HasScope(scope) {
+		return ctx, connect.NewError(connect.CodePermissionDenied, errors.Newf("API token is not allowed to call %s", procedure))
 	}
+
+	ctx = domain.WithValue(ctx, apiToken)
+	ctx = domain.WithValue(ctx, *apiToken.UserID)
+	ctx = domain.WithValue(ctx, apiToken.OrganizationID)
+	return ctx, nil
 }
 
 func (i *AuthInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
 	return next
 }
 
+// WrapStreamingHandler はストリームを開く時点で Unary と同じように認証する。
+// 再発行したCookieは最初のメッセージとともに返すレスポンスヘッダーに載せ、
+// ストリームはセッションかAPIトークンの有効期限か、途中の再認証に失敗した時点で打ち切る
 func (i *AuthInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
-	return next
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctx, renewed, err := i.authenticate(ctx, conn.Spec(), conn.RequestHeader(), conn.Peer().Addr)
+		if err != nil {
+			return err
+		}
+		if renewed != nil {
+			conn.ResponseHeader().Add("Set-Cookie", renewed.String())
+		}
+
+		revalidate := func(ctx context.Context) error {
+			_, _, err := i.authenticate(ctx, conn.Spec(), conn.RequestHeader(), conn.Peer().Addr)
+			return err
+		}
+		return streamauth.Serve(ctx, i.revalidateInterval, revalidate, func(ctx context.Context) error {
+			return next(ctx, conn)
+		})
+	}
+}
+
+func bearerToken(authHeader string) (string, bool) {
+	token, ok := strings.CutPrefix(authHeader, "Bearer ")
+	if !ok || token == "" {
+		return "", false
+	}
+	return token, true
 }
 
 func extractSessionID(cookies string) string {
 	parts := strings.Split(cookies, ";")
 	for _, part := range parts {
 		part = strings.TrimSpace(part)
-		if strings.HasPrefix(part, "session_id=") {
-			return strings.TrimPrefix(part, "session_id=")
+		if strings.HasPrefix(part, cookie.SessionIDName+"=") {
+			return strings.TrimPrefix(part, cookie.SessionIDName+"=")
 		}
 	}
 	return ""
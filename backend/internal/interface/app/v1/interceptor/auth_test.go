@@ -0,0 +1,331 @@
+package interceptor
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/shibayama-club/keyhub/internal/domain"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/interface/app/v1/cookie"
+	"github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/app/v1/appv1connect"
+	"github.com/shibayama-club/keyhub/internal/usecase/app/dto"
+	"github.com/shibayama-club/keyhub/internal/usecase/app/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	// watchTestProcedure は参照系のストリーミングの手続き。
+	// APIトークンのスコープは手続き名で確認するため、rooms:read が必要な既存の手続きの名前で立てる
+	watchTestProcedure = appv1connect.RoomServiceGetRoomsByTenantProcedure
+	// updateTestProcedure は更新系のストリーミングの手続き。APIトークンでは呼び出せない
+	updateTestProcedure = "/keyhub.app.v1.StreamingTestService/Update"
+	// waitUntilDone をリクエストに指定すると、ハンドラーは ctx が終わるまでストリームを開いたままにする
+	waitUntilDone = "wait"
+)
+
+// newStreamingTestServer は認証インターセプターだけを通すサーバーストリーミングのテスト用サービスを立てる。
+// ハンドラーは ctx に入ったユーザーIDを1件返す。revalidateInterval を指定するとその間隔で認証をやり直す
+func newStreamingTestServer(t *testing.T, useCase *mock.MockIUseCase, revalidateInterval time.Duration) *httptest.Server {
+	t.Helper()
+
+	handle := func(ctx context.Context, req *connect.Request[wrapperspb.StringValue], stream *connect.ServerStream[wrapperspb.StringValue]) error {
+		userID, _ := domain.Value[model.UserID](ctx)
+		if err := stream.Send(wrapperspb.String(userID.String())); err != nil {
+			return err
+		}
+		if req.Msg.Value == waitUntilDone {
+			<-ctx.Done()
+		}
+		return nil
+	}
+	auth := NewAuthInterceptor(useCase, "local")
+	if revalidateInterval > 0 {
+		auth.revalidateInterval = revalidateInterval
+	}
+	interceptors := connect.WithInterceptors(auth)
+
+	mux := http.NewServeMux()
+	mux.Handle(watchTestProcedure, connect.NewServerStreamHandler(
+		watchTestProcedure, handle, interceptors, connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+	))
+	mux.Handle(updateTestProcedure, connect.NewServerStreamHandler(updateTestProcedure, handle, interceptors))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestAuthInterceptor_WrapStreamingHandler(t *testing.T) {
+	userID := model.UserID(uuid.New())
+	csrfToken := "csrf-token"
+	session := model.AppSession{
+		SessionID:      model.AppSessionID("session-id"),
+		UserID:         userID,
+		OrganizationID: model.OrganizationID(uuid.New()),
+		ExpiresAt:      time.Now().Add(time.Hour),
+		CSRFToken:      &csrfToken,
+	}
+	apiToken := model.APIToken{
+		UserID:         &userID,
+		OrganizationID: session.OrganizationID,
+		Scopes:         []model.APITokenScope{model.APITokenScopeRoomsRead},
+		ExpiresAt:      time.Now().Add(time.Hour),
+	}
+	sessionCookie := cookie.SessionIDName + "=session-id"
+
+	tests := []struct {
+		name          string
+		procedure     string
+		header        http.Header
+		message       string
+		revalidate    time.Duration
+		setupMock     func(*mock.MockIUseCase)
+		wantUserID    string
+		wantSetCookie bool
+		wantCode      connect.Code
+	}{
+		{
+			name:      "正常系: Cookieのセッションで認証し、ctx にユーザーIDを入れる",
+			procedure: watchTestProcedure,
+			header:    http.Header{"Cookie": {sessionCookie}},
+			setupMock: func(m *mock.MockIUseCase) {
+				m.EXPECT().ValidateSession(gomock.Any(), "session-id", gomock.Any()).
+					Return(dto.ValidateSessionOutput{Session: session, Renewed: true}, nil)
+			},
+			wantUserID:    userID.String(),
+			wantSetCookie: true,
+		},
+		{
+			name:      "正常系: 更新系の手続きはCSRFトークンが一致すれば呼び出せる",
+			procedure: updateTestProcedure,
+			header:    http.Header{"Cookie": {sessionCookie}, HeaderCSRFToken: {csrfToken}},
+			setupMock: func(m *mock.MockIUseCase) {
+				m.EXPECT().ValidateSession(gomock.Any(), "session-id", gomock.Any()).
+					Return(dto.ValidateSessionOutput{Session: session}, nil)
+			},
+			wantUserID: userID.String(),
+		},
+		{
+			name:      "正常系: スコープを持つAPIトークンで認証する",
+			procedure: watchTestProcedure,
+			header:    http.Header{"Authorization": {"Bearer khu_token"}},
+			setupMock: func(m *mock.MockIUseCase) {
+				m.EXPECT().AuthenticateAPIToken(gomock.Any(), "khu_token").Return(apiToken, nil)
+			},
+			wantUserID: userID.String(),
+		},
+		{
+			name:      "異常系: セッションのCookieがない",
+			procedure: watchTestProcedure,
+			header:    http.Header{},
+			setupMock: func(m *mock.MockIUseCase) {},
+			wantCode:  connect.CodeUnauthenticated,
+		},
+		{
+			name:      "異常系: 無効なセッション",
+			procedure: watchTestProcedure,
+			header:    http.Header{"Cookie": {sessionCookie}},
+			setupMock: func(m *mock.MockIUseCase) {
+				m.EXPECT().ValidateSession(gomock.Any(), "session-id", gomock.Any()).
+					Return(dto.ValidateSessionOutput{}, errors.New("session not found"))
+			},
+			wantCode: connect.CodeUnauthenticated,
+		},
+		{
+			name:      "異常系: 更新系の手続きにCSRFトークンがない",
+			procedure: updateTestProcedure,
+			header:    http.Header{"Cookie": {sessionCookie}},
+			setupMock: func(m *mock.MockIUseCase) {
+				m.EXPECT().ValidateSession(gomock.Any(), "session-id", gomock.Any()).
+					Return(dto.ValidateSessionOutput{Session: session}, nil)
+			},
+			wantCode: connect.CodePermissionDenied,
+		},
+		{
+			name:      "異常系: APIトークンでは許可されていない手続き",
+			procedure: updateTestProcedure,
+			header:    http.Header{"Authorization": {"Bearer khu_token"}},
+			setupMock: func(m *mock.MockIUseCase) {
+				m.EXPECT().AuthenticateAPIToken(gomock.Any(), "khu_token").Return(apiToken, nil)
+			},
+			wantCode: connect.CodePermissionDenied,
+		},
+		{
+			name:      "異常系: ストリームの途中でセッションの有効期限が切れる",
+			procedure: watchTestProcedure,
+			header:    http.Header{"Cookie": {sessionCookie}},
+			message:   waitUntilDone,
+			setupMock: func(m *mock.MockIUseCase) {
+				expiring := session
+				expiring.ExpiresAt = time.Now().Add(100 * time.Millisecond)
+				m.EXPECT().ValidateSession(gomock.Any(), "session-id", gomock.Any()).
+					Return(dto.ValidateSessionOutput{Session: expiring}, nil)
+			},
+			wantUserID: userID.String(),
+			wantCode:   connect.CodeUnauthenticated,
+		},
+		{
+			name:       "異常系: ストリームの途中でセッションが無効になる",
+			procedure:  watchTestProcedure,
+			header:     http.Header{"Cookie": {sessionCookie}},
+			message:    waitUntilDone,
+			revalidate: 50 * time.Millisecond,
+			setupMock: func(m *mock.MockIUseCase) {
+				gomock.InOrder(
+					m.EXPECT().ValidateSession(gomock.Any(), "session-id", gomock.Any()).
+						Return(dto.ValidateSessionOutput{Session: session}, nil),
+					m.EXPECT().ValidateSession(gomock.Any(), "session-id", gomock.Any()).
+						Return(dto.ValidateSessionOutput{Session: session}, nil),
+					m.EXPECT().ValidateSession(gomock.Any(), "session-id", gomock.Any()).
+						Return(dto.ValidateSessionOutput{}, errors.New("session not found")),
+				)
+			},
+			wantUserID: userID.String(),
+			wantCode:   connect.CodeUnauthenticated,
+		},
+		{
+			name:       "異常系: ストリームの途中でAPIトークンが失効する",
+			procedure:  watchTestProcedure,
+			header:     http.Header{"Authorization": {"Bearer khu_token"}},
+			message:    waitUntilDone,
+			revalidate: 50 * time.Millisecond,
+			setupMock: func(m *mock.MockIUseCase) {
+				gomock.InOrder(
+					m.EXPECT().AuthenticateAPIToken(gomock.Any(), "khu_token").Return(apiToken, nil),
+					m.EXPECT().AuthenticateAPIToken(gomock.Any(), "khu_token").Return(model.APIToken{}, errors.New("token revoked")),
+				)
+			},
+			wantUserID: userID.String(),
+			wantCode:   connect.CodeUnauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			useCase := mock.NewMockIUseCase(ctrl)
+			tt.setupMock(useCase)
+
+			server := newStreamingTestServer(t, useCase, tt.revalidate)
+			client := connect.NewClient[wrapperspb.StringValue, wrapperspb.StringValue](server.Client(), server.URL+tt.procedure)
+
+			req := connect.NewRequest(wrapperspb.String(tt.message))
+			for key, values := range tt.header {
+				for _, v := range values {
+					req.Header().Add(key, v)
+				}
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			stream, err := client.CallServerStream(ctx, req)
+			require.NoError(t, err)
+			defer stream.Close()
+
+			var received []string
+			for stream.Receive() {
+				received = append(received, stream.Msg().Value)
+			}
+
+			if tt.wantUserID != "" {
+				assert.Equal(t, []string{tt.wantUserID}, received)
+			} else {
+				assert.Empty(t, received)
+			}
+			assert.Equal(t, tt.wantSetCookie, stream.ResponseHeader().Get("Set-Cookie") != "")
+
+			if tt.wantCode == 0 {
+				assert.NoError(t, stream.Err())
+				return
+			}
+			assert.Equal(t, tt.wantCode, connect.CodeOf(stream.Err()))
+		})
+	}
+}
+
+func TestAuthInterceptor_WrapUnary_CSRF(t *testing.T) {
+	const (
+		readProcedure  = "/keyhub.app.v1.UnaryTestService/Read"
+		writeProcedure = "/keyhub.app.v1.UnaryTestService/Write"
+	)
+	csrfToken := "csrf-token"
+	session := model.AppSession{
+		SessionID:      model.AppSessionID("session-id"),
+		UserID:         model.UserID(uuid.New()),
+		OrganizationID: model.OrganizationID(uuid.New()),
+		ExpiresAt:      time.Now().Add(time.Hour),
+		CSRFToken:      &csrfToken,
+	}
+	sessionCookie := cookie.SessionIDName + "=session-id"
+
+	tests := []struct {
+		name      string
+		procedure string
+		csrfToken string
+		wantCode  connect.Code
+	}{
+		{
+			name:      "正常系: 更新系の手続きはCSRFトークンが一致すれば呼び出せる",
+			procedure: writeProcedure,
+			csrfToken: csrfToken,
+		},
+		{
+			name:      "正常系: 副作用のない手続きはCSRFトークンがなくても呼び出せる",
+			procedure: readProcedure,
+		},
+		{
+			name:      "異常系: 更新系の手続きにCSRFトークンのヘッダーがない",
+			procedure: writeProcedure,
+			wantCode:  connect.CodePermissionDenied,
+		},
+		{
+			name:      "異常系: 更新系の手続きのCSRFトークンが一致しない",
+			procedure: writeProcedure,
+			csrfToken: "other-token",
+			wantCode:  connect.CodePermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			useCase := mock.NewMockIUseCase(ctrl)
+			useCase.EXPECT().ValidateSession(gomock.Any(), "session-id", gomock.Any()).
+				Return(dto.ValidateSessionOutput{Session: session}, nil)
+
+			handle := func(ctx context.Context, req *connect.Request[wrapperspb.StringValue]) (*connect.Response[wrapperspb.StringValue], error) {
+				return connect.NewResponse(wrapperspb.String("ok")), nil
+			}
+			interceptors := connect.WithInterceptors(NewAuthInterceptor(useCase, "local"))
+			mux := http.NewServeMux()
+			mux.Handle(readProcedure, connect.NewUnaryHandler(
+				readProcedure, handle, interceptors, connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			))
+			mux.Handle(writeProcedure, connect.NewUnaryHandler(writeProcedure, handle, interceptors))
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client := connect.NewClient[wrapperspb.StringValue, wrapperspb.StringValue](server.Client(), server.URL+tt.procedure)
+			req := connect.NewRequest(wrapperspb.String(""))
+			req.Header().Set("Cookie", sessionCookie)
+			if tt.csrfToken != "" {
+				req.Header().Set(HeaderCSRFToken, tt.csrfToken)
+			}
+
+			_, err := client.CallUnary(context.Background(), req)
+			if tt.wantCode == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, tt.wantCode, connect.CodeOf(err))
+		})
+	}
+}
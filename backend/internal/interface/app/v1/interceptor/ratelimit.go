@@ -0,0 +1,56 @@
+package interceptor
+
+import (
+	"context"
+	"time"
+
+	"github.com/shibayama-club/keyhub/internal/domain"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	domainratelimit "github.com/shibayama-club/keyhub/internal/domain/ratelimit"
+	"github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/app/v1/appv1connect"
+	"github.com/shibayama-club/keyhub/internal/interface/ratelimit"
+)
+
+// joinCodeRule は参加コードの総当たり対策。参加コードは6文字程度と短いため、
+// 照会と参加を合算して接続元IPとユーザーごとに制限し、存在しないコードが続いたらロックする
+var joinCodeRule = ratelimit.Rule{
+	Name:          "join_code",
+	PerIP:         model.RateLimit{Burst: 20, Period: time.Minute},
+	PerIdentifier: model.RateLimit{Burst: 10, Period: time.Minute},
+	Identifier:    userIdentifier,
+	Lockout:       model.LockoutPolicy{MaxFailures: 10, Window: 10 * time.Minute, Duration: 15 * time.Minute},
+}
+
+var rateLimitRules = map[string]ratelimit.Rule{
+	appv1connect.TenantServiceGetTenantByJoinCodeProcedure: joinCodeRule,
+	appv1connect.TenantServiceJoinTenantProcedure:          joinCodeRule,
+	appv1connect.AuthServiceBeginPasskeyLoginProcedure: {
+		PerIP: model.RateLimit{Burst: 20, Period: time.Minute},
+	},
+	appv1connect.AuthServiceFinishPasskeyLoginProcedure: {
+		PerIP:   model.RateLimit{Burst: 10, Period: time.Minute},
+		Lockout: model.LockoutPolicy{MaxFailures: 10, Window: 10 * time.Minute, Duration: 15 * time.Minute},
+	},
+}
+
+// authFailureLockout はセッションやAPIトークンの総当たり対策。接続元IPごとに認証の失敗を数える
+var authFailureLockout = model.LockoutPolicy{MaxFailures: 20, Window: 10 * time.Minute, Duration: 15 * time.Minute}
+
+// NewRateLimitInterceptor はApp APIのレート制限インターセプターを作成する。
+// ユーザーごとの制限に認証済みのユーザーIDを使うため、AuthInterceptor の内側に置く
+func NewRateLimitInterceptor(store domainratelimit.Store) *ratelimit.Interceptor {
+	return ratelimit.NewInterceptor(store, rateLimitRules)
+}
+
+// NewAuthFailureInterceptor はApp APIの認証の失敗を数えるインターセプターを作成する。AuthInterceptor の外側に置く
+func NewAuthFailureInterceptor(store domainratelimit.Store) *ratelimit.AuthFailureInterceptor {
+	return ratelimit.NewAuthFailureInterceptor(store, authFailureLockout)
+}
+
+func userIdentifier(ctx context.Context, _ any) string {
+	userID, ok := domain.Value[model.UserID](ctx)
+	if !ok {
+		return ""
+	}
+	return userID.String()
+}
@@ -0,0 +1,15 @@
+package interceptor
+
+import (
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/app/v1/appv1connect"
+)
+
+// apiTokenScopes はAPIトークンで呼び出せる手続きと、必要なスコープの対応。
+// ここにない手続き（セッションやAPIトークン自体の管理など）はAPIトークンでは呼び出せない
+var apiTokenScopes = map[string]model.APITokenScope{
+	appv1connect.RoomServiceGetRoomsByTenantProcedure:      model.APITokenScopeRoomsRead,
+	appv1connect.TenantServiceGetTenantByJoinCodeProcedure: model.APITokenScopeTenantsRead,
+	appv1connect.TenantServiceGetMyTenantsProcedure:        model.APITokenScopeTenantsRead,
+	appv1connect.TenantServiceJoinTenantProcedure:          model.APITokenScopeTenantsWrite,
+}
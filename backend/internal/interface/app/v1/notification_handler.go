@@ -0,0 +1,78 @@
+package v1
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"github.com/shibayama-club/keyhub/internal/domain"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	appv1 "github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/app/v1"
+	"github.com/shibayama-club/keyhub/internal/usecase/app/dto"
+)
+
+func convertNotificationPreferencesToProto(p model.NotificationPreferences) *appv1.NotificationPreferences {
+	return &appv1.NotificationPreferences{
+		Locale: p.Locale.String(),
+		Settings: lo.Map(model.NotificationKinds(), func(k model.NotificationKind, _ int) *appv1.NotificationSetting {
+			return &appv1.NotificationSetting{
+				Kind:         k.String(),
+				EmailEnabled: p.Enabled(k),
+			}
+		}),
+	}
+}
+
+func (h *Handler) GetNotificationPreferences(
+	ctx context.Context,
+	req *connect.Request[appv1.GetNotificationPreferencesRequest],
+) (*connect.Response[appv1.GetNotificationPreferencesResponse], error) {
+	userID, ok := domain.Value[model.UserID](ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user not authenticated"))
+	}
+
+	preferences, err := h.useCase.GetNotificationPreferences(ctx, userID)
+	if err != nil {
+		h.l.Error("failed to get notification preferences", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to get notification preferences"))
+	}
+
+	return connect.NewResponse(&appv1.GetNotificationPreferencesResponse{
+		Preferences: convertNotificationPreferencesToProto(preferences),
+	}), nil
+}
+
+func (h *Handler) UpdateNotificationPreferences(
+	ctx context.Context,
+	req *connect.Request[appv1.UpdateNotificationPreferencesRequest],
+) (*connect.Response[appv1.UpdateNotificationPreferencesResponse], error) {
+	userID, ok := domain.Value[model.UserID](ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user not authenticated"))
+	}
+
+	emailEnabled := make(map[string]bool, len(req.Msg.Settings))
+	for _, s := range req.Msg.Settings {
+		emailEnabled[s.Kind] = s.EmailEnabled
+	}
+
+	preferences, err := h.useCase.UpdateNotificationPreferences(ctx, dto.UpdateNotificationPreferencesInput{
+		UserID:       userID,
+		Locale:       req.Msg.Locale,
+		EmailEnabled: emailEnabled,
+	})
+	if err != nil {
+		if errors.Is(err, domainerrors.ErrValidation) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		h.l.Error("failed to update notification preferences", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to update notification preferences"))
+	}
+
+	return connect.NewResponse(&appv1.UpdateNotificationPreferencesResponse{
+		Preferences: convertNotificationPreferencesToProto(preferences),
+	}), nil
+}
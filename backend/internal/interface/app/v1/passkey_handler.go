@@ -0,0 +1,172 @@
+package v1
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"github.com/shibayama-club/keyhub/internal/domain"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/interface/app/v1/cookie"
+	"github.com/shibayama-club/keyhub/internal/interface/clientinfo"
+	appv1 "github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/app/v1"
+	"github.com/shibayama-club/keyhub/internal/interface/orgslug"
+	"github.com/shibayama-club/keyhub/internal/usecase/app/dto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func convertPasskeyToProto(p model.Passkey) *appv1.Passkey {
+	var lastUsedAt *timestamppb.Timestamp
+	if p.LastUsedAt != nil {
+		lastUsedAt = timestamppb.New(*p.LastUsedAt)
+	}
+
+	return &appv1.Passkey{
+		Id:             p.ID.String(),
+		Name:           p.Name.String(),
+		BackupEligible: p.BackupEligible,
+		LastUsedAt:     lastUsedAt,
+		CreatedAt:      timestamppb.New(p.CreatedAt),
+	}
+}
+
+func (h *Handler) BeginPasskeyRegistration(
+	ctx context.Context,
+	req *connect.Request[appv1.BeginPasskeyRegistrationRequest],
+) (*connect.Response[appv1.BeginPasskeyRegistrationResponse], error) {
+	userID, ok := domain.Value[model.UserID](ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user not authenticated"))
+	}
+
+	output, err := h.useCase.BeginPasskeyRegistration(ctx, userID)
+	if err != nil {
+		h.l.Error("failed to begin passkey registration", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to begin passkey registration"))
+	}
+
+	return connect.NewResponse(&appv1.BeginPasskeyRegistrationResponse{
+		CeremonyId:  output.CeremonyID,
+		OptionsJson: string(output.Options),
+	}), nil
+}
+
+func (h *Handler) FinishPasskeyRegistration(
+	ctx context.Context,
+	req *connect.Request[appv1.FinishPasskeyRegistrationRequest],
+) (*connect.Response[appv1.FinishPasskeyRegistrationResponse], error) {
+	userID, ok := domain.Value[model.UserID](ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user not authenticated"))
+	}
+
+	passkey, err := h.useCase.FinishPasskeyRegistration(ctx, dto.FinishPasskeyRegistrationInput{
+		UserID:     userID,
+		CeremonyID: req.Msg.CeremonyId,
+		Name:       req.Msg.Name,
+		Credential: []byte(req.Msg.CredentialJson),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domainerrors.ErrValidation):
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		case errors.Is(err, domainerrors.ErrUnAuthorized):
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		h.l.Error("failed to finish passkey registration", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to finish passkey registration"))
+	}
+
+	return connect.NewResponse(&appv1.FinishPasskeyRegistrationResponse{
+		Passkey: convertPasskeyToProto(passkey),
+	}), nil
+}
+
+func (h *Handler) BeginPasskeyLogin(
+	ctx context.Context,
+	req *connect.Request[appv1.BeginPasskeyLoginRequest],
+) (*connect.Response[appv1.BeginPasskeyLoginResponse], error) {
+	output, err := h.useCase.BeginPasskeyLogin(ctx)
+	if err != nil {
+		h.l.Error("failed to begin passkey login", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to begin passkey login"))
+	}
+
+	return connect.NewResponse(&appv1.BeginPasskeyLoginResponse{
+		CeremonyId:  output.CeremonyID,
+		OptionsJson: string(output.Options),
+	}), nil
+}
+
+func (h *Handler) FinishPasskeyLogin(
+	ctx context.Context,
+	req *connect.Request[appv1.FinishPasskeyLoginRequest],
+) (*connect.Response[appv1.FinishPasskeyLoginResponse], error) {
+	output, err := h.useCase.FinishPasskeyLogin(ctx, dto.FinishPasskeyLoginInput{
+		CeremonyID:       req.Msg.CeremonyId,
+		Credential:       []byte(req.Msg.CredentialJson),
+		OrganizationSlug: orgslug.FromRequest(req.Header(), req.Msg.OrganizationSlug, h.baseDomain),
+		Client:           clientinfo.FromRequest(req.Header(), req.Peer().Addr),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domainerrors.ErrUnAuthorized):
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		case errors.Is(err, domainerrors.ErrValidation):
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		case errors.Is(err, domainerrors.ErrNotFound):
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		h.l.Error("failed to finish passkey login", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to finish passkey login"))
+	}
+
+	res := connect.NewResponse(&appv1.FinishPasskeyLoginResponse{})
+	res.Header().Add("Set-Cookie", cookie.NewSessionCookie(h.env, output.SessionID, output.ExpiresAt).String())
+	return res, nil
+}
+
+func (h *Handler) ListPasskeys(
+	ctx context.Context,
+	req *connect.Request[appv1.ListPasskeysRequest],
+) (*connect.Response[appv1.ListPasskeysResponse], error) {
+	userID, ok := domain.Value[model.UserID](ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user not authenticated"))
+	}
+
+	passkeys, err := h.useCase.ListPasskeys(ctx, userID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to list passkeys"))
+	}
+
+	return connect.NewResponse(&appv1.ListPasskeysResponse{
+		Passkeys: lo.Map(passkeys, func(p model.Passkey, _ int) *appv1.Passkey {
+			return convertPasskeyToProto(p)
+		}),
+	}), nil
+}
+
+func (h *Handler) DeletePasskey(
+	ctx context.Context,
+	req *connect.Request[appv1.DeletePasskeyRequest],
+) (*connect.Response[appv1.DeletePasskeyResponse], error) {
+	userID, ok := domain.Value[model.UserID](ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user not authenticated"))
+	}
+
+	if err := h.useCase.DeletePasskey(ctx, userID, req.Msg.PasskeyId); err != nil {
+		switch {
+		case errors.Is(err, domainerrors.ErrValidation):
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		case errors.Is(err, domainerrors.ErrNotFound):
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to delete passkey"))
+	}
+
+	return connect.NewResponse(&appv1.DeletePasskeyResponse{}), nil
+}
@@ -6,8 +6,11 @@ import (
 	"connectrpc.com/connect"
 	"github.com/cockroachdb/errors"
 	"github.com/samber/lo"
+	"github.com/shibayama-club/keyhub/internal/domain"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
 	"github.com/shibayama-club/keyhub/internal/domain/model"
 	appv1 "github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/app/v1"
+	"github.com/shibayama-club/keyhub/internal/usecase/app/dto"
 )
 
 func (h *Handler) GetRoomsByTenant(
@@ -19,13 +22,33 @@ func (h *Handler) GetRoomsByTenant(
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrap(err, "invalid tenant ID"))
 	}
 
-	rooms, err := h.useCase.GetRoomsByTenant(ctx, tenantID)
+	userID, ok := domain.Value[model.UserID](ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user not authenticated"))
+	}
+
+	accessibility, err := convertRoomAccessibilityList(req.Msg.Accessibility)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	rooms, err := h.useCase.GetRoomsByTenant(ctx, dto.GetRoomsByTenantInput{
+		TenantID:      tenantID,
+		UserID:        userID,
+		MinCapacity:   req.Msg.MinCapacity,
+		Equipment:     req.Msg.Equipment,
+		Accessibility: accessibility,
+	})
 	if err != nil {
+		if errors.Is(err, domainerrors.ErrValidation) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
 	protoRooms := make([]*appv1.Room, 0, len(rooms))
-	for _, room := range rooms {
+	for _, output := range rooms {
+		room := output.Room
 		keys, err := h.useCase.GetKeysByRoom(ctx, room.ID)
 		if err != nil {
 			return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to get keys for room"))
@@ -41,13 +64,16 @@ func (h *Handler) GetRoomsByTenant(
 		})
 
 		protoRooms = append(protoRooms, &appv1.Room{
-			Id:           room.ID.String(),
-			Name:         room.Name.String(),
-			BuildingName: room.BuildingName.String(),
-			FloorNumber:  room.FloorNumber.String(),
-			RoomType:     convertToProtoRoomType(room.Type),
-			Description:  room.Description.String(),
-			Keys:         protoKeys,
+			Id:            room.ID.String(),
+			Name:          room.Name.String(),
+			BuildingName:  room.BuildingName.String(),
+			FloorNumber:   room.FloorNumber.String(),
+			RoomType:      convertToProtoRoomType(room.Type),
+			RoomTypeKey:   room.Type.String(),
+			Description:   room.Description.String(),
+			Keys:          protoKeys,
+			CanBorrowKeys: output.CanBorrowKeys,
+			Attributes:    convertRoomAttributesToProto(room.Attributes),
 		})
 	}
 
@@ -75,6 +101,51 @@ func convertToProtoRoomType(roomType model.RoomType) appv1.RoomType {
 	}
 }
 
+func convertRoomAttributesToProto(attributes model.RoomAttributes) *appv1.RoomAttributes {
+	return &appv1.RoomAttributes{
+		Capacity:  int32(attributes.Capacity),
+		Equipment: lo.Map(attributes.Equipment, func(e model.Equipment, _ int) string { return e.String() }),
+		Accessibility: lo.Map(attributes.Accessibility, func(a model.RoomAccessibility, _ int) appv1.RoomAccessibility {
+			return convertToProtoRoomAccessibility(a)
+		}),
+		CustomFields: attributes.CustomFields,
+	}
+}
+
+func convertRoomAccessibilityList(values []appv1.RoomAccessibility) ([]string, error) {
+	accessibility := make([]string, 0, len(values))
+	for _, v := range values {
+		switch v {
+		case appv1.RoomAccessibility_ROOM_ACCESSIBILITY_WHEELCHAIR:
+			accessibility = append(accessibility, model.RoomAccessibilityWheelchair.String())
+		case appv1.RoomAccessibility_ROOM_ACCESSIBILITY_STEP_FREE:
+			accessibility = append(accessibility, model.RoomAccessibilityStepFree.String())
+		case appv1.RoomAccessibility_ROOM_ACCESSIBILITY_ACCESSIBLE_RESTROOM:
+			accessibility = append(accessibility, model.RoomAccessibilityAccessibleRestroom.String())
+		case appv1.RoomAccessibility_ROOM_ACCESSIBILITY_HEARING_LOOP:
+			accessibility = append(accessibility, model.RoomAccessibilityHearingLoop.String())
+		default:
+			return nil, errors.New("invalid room accessibility")
+		}
+	}
+	return accessibility, nil
+}
+
+func convertToProtoRoomAccessibility(accessibility model.RoomAccessibility) appv1.RoomAccessibility {
+	switch accessibility {
+	case model.RoomAccessibilityWheelchair:
+		return appv1.RoomAccessibility_ROOM_ACCESSIBILITY_WHEELCHAIR
+	case model.RoomAccessibilityStepFree:
+		return appv1.RoomAccessibility_ROOM_ACCESSIBILITY_STEP_FREE
+	case model.RoomAccessibilityAccessibleRestroom:
+		return appv1.RoomAccessibility_ROOM_ACCESSIBILITY_ACCESSIBLE_RESTROOM
+	case model.RoomAccessibilityHearingLoop:
+		return appv1.RoomAccessibility_ROOM_ACCESSIBILITY_HEARING_LOOP
+	default:
+		return appv1.RoomAccessibility_ROOM_ACCESSIBILITY_UNSPECIFIED
+	}
+}
+
 func convertToProtoKeyStatus(status model.KeyStatus) appv1.KeyStatus {
 	switch status {
 	case model.KeyStatusAvailable:
@@ -0,0 +1,86 @@
+package v1
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"github.com/shibayama-club/keyhub/internal/domain"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	appv1 "github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/app/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *Handler) ListSessions(
+	ctx context.Context,
+	req *connect.Request[appv1.ListSessionsRequest],
+) (*connect.Response[appv1.ListSessionsResponse], error) {
+	userID, ok := domain.Value[model.UserID](ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user not authenticated"))
+	}
+	currentSessionID, _ := domain.Value[model.AppSessionID](ctx)
+
+	sessions, err := h.useCase.ListSessions(ctx, userID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to list sessions"))
+	}
+
+	return connect.NewResponse(&appv1.ListSessionsResponse{
+		Sessions: lo.Map(sessions, func(s model.AppSession, _ int) *appv1.Session {
+			return &appv1.Session{
+				Id:         s.SessionID.String(),
+				UserAgent:  s.Client.UserAgent,
+				IpAddress:  s.Client.IPAddress,
+				CreatedAt:  timestamppb.New(s.CreatedAt),
+				LastSeenAt: timestamppb.New(s.LastSeenAt),
+				ExpiresAt:  timestamppb.New(s.ExpiresAt),
+				Current:    s.SessionID == currentSessionID,
+			}
+		}),
+	}), nil
+}
+
+func (h *Handler) RevokeSession(
+	ctx context.Context,
+	req *connect.Request[appv1.RevokeSessionRequest],
+) (*connect.Response[appv1.RevokeSessionResponse], error) {
+	userID, ok := domain.Value[model.UserID](ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user not authenticated"))
+	}
+
+	if err := h.useCase.RevokeSession(ctx, userID, req.Msg.SessionId); err != nil {
+		if errors.Is(err, domainerrors.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to revoke session"))
+	}
+
+	return connect.NewResponse(&appv1.RevokeSessionResponse{}), nil
+}
+
+func (h *Handler) RevokeAllOtherSessions(
+	ctx context.Context,
+	req *connect.Request[appv1.RevokeAllOtherSessionsRequest],
+) (*connect.Response[appv1.RevokeAllOtherSessionsResponse], error) {
+	userID, ok := domain.Value[model.UserID](ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user not authenticated"))
+	}
+	sessionID, ok := domain.Value[model.AppSessionID](ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("session not found"))
+	}
+
+	revoked, err := h.useCase.RevokeAllOtherSessions(ctx, userID, sessionID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to revoke other sessions"))
+	}
+
+	return connect.NewResponse(&appv1.RevokeAllOtherSessionsResponse{
+		RevokedCount: revoked,
+	}), nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/samber/lo"
 	"github.com/shibayama-club/keyhub/internal/domain"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
 	"github.com/shibayama-club/keyhub/internal/domain/model"
 	appv1 "github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/app/v1"
 	"github.com/shibayama-club/keyhub/internal/usecase/app/dto"
@@ -14,16 +15,22 @@ import (
 )
 
 func (h *Handler) GetTenantByJoinCode(ctx context.Context, req *connect.Request[appv1.GetTenantByJoinCodeRequest]) (*connect.Response[appv1.GetTenantByJoinCodeResponse], error) {
-	output, err := h.useCase.GetTenantByJoinCode(ctx, req.Msg.JoinCode)
+	organizationID, ok := domain.Value[model.OrganizationID](ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("organization not authenticated"))
+	}
+
+	output, err := h.useCase.GetTenantByJoinCode(ctx, organizationID, req.Msg.JoinCode)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeNotFound, err)
 	}
 
 	return connect.NewResponse(&appv1.GetTenantByJoinCodeResponse{
-		Id:          output.ID,
-		Name:        output.Name,
-		Description: output.Description,
-		TenantType:  convertStringToTenantTypeProto(output.TenantType),
+		Id:            output.ID,
+		Name:          output.Name,
+		Description:   output.Description,
+		TenantType:    convertStringToTenantTypeProto(output.TenantType),
+		TenantTypeKey: output.TenantType,
 	}), nil
 }
 
@@ -33,8 +40,19 @@ func (h *Handler) JoinTenant(ctx context.Context, req *connect.Request[appv1.Joi
 		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user not authenticated"))
 	}
 
-	err := h.useCase.JoinTenant(ctx, userID, req.Msg.JoinCode)
+	organizationID, ok := domain.Value[model.OrganizationID](ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("organization not authenticated"))
+	}
+
+	err := h.useCase.JoinTenant(ctx, organizationID, userID, req.Msg.JoinCode)
 	if err != nil {
+		switch {
+		case errors.Is(err, domainerrors.ErrNotFound):
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		case errors.Is(err, domainerrors.ErrAlreadyExists):
+			return nil, connect.NewError(connect.CodeAlreadyExists, err)
+		}
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
@@ -47,9 +65,31 @@ func (h *Handler) GetMyTenants(ctx context.Context, req *connect.Request[appv1.G
 		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user not authenticated"))
 	}
 
-	output, err := h.useCase.GetMyTenants(ctx, userID)
+	input := dto.GetMyTenantsInput{
+		UserID:     userID,
+		NamePrefix: req.Msg.NamePrefix,
+		Order:      convertListOrder(req.Msg.Order),
+		PageSize:   req.Msg.PageSize,
+		PageToken:  req.Msg.PageToken,
+	}
+	switch {
+	case req.Msg.TenantTypeKey != "":
+		input.TenantType = req.Msg.TenantTypeKey
+	case req.Msg.TenantType != appv1.TenantType_TENANT_TYPE_UNSPECIFIED:
+		tenantType, err := convertTenantType(req.Msg.TenantType)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		input.TenantType = tenantType
+	}
+
+	output, err := h.useCase.GetMyTenants(ctx, input)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		if errors.Is(err, domainerrors.ErrValidation) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		h.l.Error("failed to get my tenants", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to get my tenants"))
 	}
 
 	tenants := lo.Map(output.Tenants, func(t dto.TenantOutput, _ int) *appv1.Tenant {
@@ -57,20 +97,42 @@ func (h *Handler) GetMyTenants(ctx context.Context, req *connect.Request[appv1.G
 	})
 
 	return connect.NewResponse(&appv1.GetMyTenantsResponse{
-		Tenants: tenants,
+		Tenants:       tenants,
+		NextPageToken: output.NextPageToken,
 	}), nil
 }
 
 func convertTenantOutputToProto(tenant dto.TenantOutput) *appv1.Tenant {
+	var archivedAt *timestamppb.Timestamp
+	if tenant.ArchivedAt != nil {
+		archivedAt = timestamppb.New(*tenant.ArchivedAt)
+	}
 	return &appv1.Tenant{
 		Id:             tenant.ID,
 		OrganizationId: tenant.OrganizationID,
 		Name:           tenant.Name,
 		Description:    tenant.Description,
 		TenantType:     convertStringToTenantTypeProto(tenant.TenantType),
+		TenantTypeKey:  tenant.TenantType,
 		MemberCount:    tenant.MemberCount,
 		CreatedAt:      timestamppb.New(tenant.CreatedAt),
 		UpdatedAt:      timestamppb.New(tenant.UpdatedAt),
+		ArchivedAt:     archivedAt,
+	}
+}
+
+func convertTenantType(protoType appv1.TenantType) (string, error) {
+	switch protoType {
+	case appv1.TenantType_TENANT_TYPE_TEAM:
+		return model.TenantTypeTeam.String(), nil
+	case appv1.TenantType_TENANT_TYPE_DEPARTMENT:
+		return model.TenantTypeDepartment.String(), nil
+	case appv1.TenantType_TENANT_TYPE_PROJECT:
+		return model.TenantTypeProject.String(), nil
+	case appv1.TenantType_TENANT_TYPE_LABORATORY:
+		return model.TenantTypeLaboratory.String(), nil
+	default:
+		return "", errors.New("invalid tenant type")
 	}
 }
 
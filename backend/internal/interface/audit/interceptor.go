@@ -0,0 +1,168 @@
+package audit
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"unicode/utf8"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/shibayama-club/keyhub/internal/domain"
+	"github.com/shibayama-club/keyhub/internal/domain/logger"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/interface/clientinfo"
+	"github.com/shibayama-club/keyhub/internal/interface/sentry"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// HeaderRequestID はリクエストを識別するID。クライアントが送った値を使い、なければ発行してレスポンスに返す
+	HeaderRequestID = "X-Request-Id"
+
+	// ResultCodeOK は手続きが成功した場合の結果コード
+	ResultCodeOK = model.AuditResultCodeOK
+
+	maxRequestIDLength = 128
+	maxTargetIDLength  = 128
+)
+
+// Recorder は監査ログを保存する。App・Consoleそれぞれのユースケースが実装する
+type Recorder interface {
+	RecordAuditLog(ctx context.Context, log model.AuditLog) error
+}
+
+type Interceptor struct {
+	recorder Recorder
+}
+
+// NewInterceptor は更新系の手続き（冪等性レベルが NO_SIDE_EFFECTS 以外）の呼び出しを監査ログに記録する。
+// 認証インターセプターの後、権限の確認より前に置き、権限不足で拒否された呼び出しも記録する
+func NewInterceptor(recorder Recorder) *Interceptor {
+	return &Interceptor{recorder: recorder}
+}
+
+func (i *Interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		requestID := requestIDFromHeader(req.Header().Get(HeaderRequestID))
+		ctx = domain.WithValue(ctx, logger.RequestID(requestID))
+
+		res, err := next(ctx, req)
+
+		if req.Spec().IdempotencyLevel != connect.IdempotencyNoSideEffects {
+			resultCode := ResultCodeOK
+			if err != nil {
+				resultCode = sentry.CodeOf(err).String()
+			}
+			i.record(ctx, req, requestID, resultCode)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+		// 問い合わせの際に監査ログと突き合わせられるよう、レスポンスにリクエストIDを返す
+		res.Header().Set(HeaderRequestID, requestID)
+		return res, nil
+	}
+}
+
+func (i *Interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// ストリーミングの手続きは参照系のみのため記録しない
+func (i *Interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+// record は監査ログを保存する。保存に失敗しても手続きの結果は変えず、エラーログに残す
+func (i *Interceptor) record(ctx context.Context, req connect.AnyRequest, requestID, resultCode string) {
+	targetIDs := TargetIDs(req.Any())
+
+	organizationID, ok := domain.Value[model.OrganizationID](ctx)
+	if !ok {
+		// ログイン前の呼び出しはリクエストに含まれる組織IDに記録する
+		parsed, err := model.ParseOrganizationID(targetIDs["organization_id"])
+		if err != nil {
+			slog.DebugContext(ctx, "skip audit log without organization", "procedure", req.Spec().Procedure)
+			return
+		}
+		organizationID = parsed
+	}
+
+	log, err := model.NewAuditLog(
+		organizationID,
+		ActorFromContext(ctx),
+		req.Spec().Procedure,
+		targetIDs,
+		resultCode,
+		requestID,
+		clientinfo.FromRequest(req.Header(), req.Peer().Addr),
+	)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build audit log", "error", err, "procedure", req.Spec().Procedure)
+		return
+	}
+
+	if err := i.recorder.RecordAuditLog(ctx, log); err != nil {
+		slog.ErrorContext(ctx, "failed to record audit log", "error", err, "procedure", req.Spec().Procedure)
+	}
+}
+
+// ActorFromContext は認証インターセプターが設定した値から操作者を判定する
+func ActorFromContext(ctx context.Context) model.AuditActor {
+	if token, ok := domain.Value[model.APIToken](ctx); ok {
+		// ユーザーのAPIトークンはそのユーザーの操作として記録する
+		if token.UserID != nil {
+			return model.AuditActor{Type: model.AuditActorTypeUser, ID: token.UserID.String()}
+		}
+		return model.AuditActor{Type: model.AuditActorTypeAPIToken, ID: token.ID.String()}
+	}
+
+	if session, ok := domain.Value[model.ConsoleSession](ctx); ok {
+		if session.OperatorID != nil {
+			return model.AuditActor{Type: model.AuditActorTypeConsoleOperator, ID: session.OperatorID.String()}
+		}
+		return model.AuditActor{Type: model.AuditActorTypeConsoleOwner, ID: session.SessionID.String()}
+	}
+
+	if userID, ok := domain.Value[model.UserID](ctx); ok {
+		return model.AuditActor{Type: model.AuditActorTypeUser, ID: userID.String()}
+	}
+
+	return model.AuditActor{Type: model.AuditActorTypeAnonymous}
+}
+
+// TargetIDs はリクエストメッセージの直下にある "id" または "_id" で終わる文字列フィールドを操作対象として取り出す
+func TargetIDs(msg any) map[string]string {
+	ids := map[string]string{}
+
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return ids
+	}
+
+	m.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		name := string(fd.Name())
+		if fd.Kind() != protoreflect.StringKind || fd.IsList() || fd.IsMap() {
+			return true
+		}
+		if name != "id" && !strings.HasSuffix(name, "_id") {
+			return true
+		}
+		if value := v.String(); value != "" && utf8.RuneCountInString(value) <= maxTargetIDLength {
+			ids[name] = value
+		}
+		return true
+	})
+
+	return ids
+}
+
+func requestIDFromHeader(value string) string {
+	if value == "" || len(value) > maxRequestIDLength {
+		return uuid.NewString()
+	}
+	return value
+}
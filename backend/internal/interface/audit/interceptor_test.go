@@ -0,0 +1,74 @@
+package audit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shibayama-club/keyhub/internal/domain"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	consolev1 "github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActorFromContext(t *testing.T) {
+	userID := model.UserID(uuid.New())
+	operatorID := model.ConsoleOperatorID(uuid.New())
+	tokenID := model.APITokenID(uuid.New())
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want model.AuditActor
+	}{
+		{
+			name: "正常系: Appのユーザー",
+			ctx:  domain.WithValue(context.Background(), userID),
+			want: model.AuditActor{Type: model.AuditActorTypeUser, ID: userID.String()},
+		},
+		{
+			name: "正常系: ユーザーのAPIトークンはユーザーとして記録する",
+			ctx:  domain.WithValue(context.Background(), model.APIToken{ID: tokenID, UserID: &userID}),
+			want: model.AuditActor{Type: model.AuditActorTypeUser, ID: userID.String()},
+		},
+		{
+			name: "正常系: 組織のAPIトークン",
+			ctx:  domain.WithValue(context.Background(), model.APIToken{ID: tokenID}),
+			want: model.AuditActor{Type: model.AuditActorTypeAPIToken, ID: tokenID.String()},
+		},
+		{
+			name: "正常系: 管理者のキーでログインしたセッション",
+			ctx:  domain.WithValue(context.Background(), model.ConsoleSession{SessionID: "console_sess_1", OperatorID: &operatorID}),
+			want: model.AuditActor{Type: model.AuditActorTypeConsoleOperator, ID: operatorID.String()},
+		},
+		{
+			name: "正常系: Organization Keyでログインしたセッション",
+			ctx:  domain.WithValue(context.Background(), model.ConsoleSession{SessionID: "console_sess_1"}),
+			want: model.AuditActor{Type: model.AuditActorTypeConsoleOwner, ID: "console_sess_1"},
+		},
+		{
+			name: "正常系: ログイン前",
+			ctx:  context.Background(),
+			want: model.AuditActor{Type: model.AuditActorTypeAnonymous},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ActorFromContext(tt.ctx))
+		})
+	}
+}
+
+func TestTargetIDs(t *testing.T) {
+	tenantID := uuid.NewString()
+	roomID := uuid.NewString()
+
+	ids := TargetIDs(&consolev1.AssignRoomToTenantRequest{
+		TenantId: tenantID,
+		RoomId:   roomID,
+	})
+
+	assert.Equal(t, map[string]string{"tenant_id": tenantID, "room_id": roomID}, ids)
+	assert.Empty(t, TargetIDs(&consolev1.LoginWithOrgIdRequest{OrganizationKey: "kho_secret"}), "ID以外のフィールドは記録しない")
+}
@@ -0,0 +1,117 @@
+package clientinfo
+
+import (
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+)
+
+const (
+	headerForwardedFor = "X-Forwarded-For"
+	headerRealIP       = "X-Real-Ip"
+)
+
+// FromRequest はリクエストヘッダーと接続元アドレスからセッションのクライアント情報を組み立てる
+func FromRequest(header http.Header, remoteAddr string) model.SessionClient {
+	return model.NewSessionClient(header.Get("User-Agent"), IP(remoteAddr))
+}
+
+// IP は接続元アドレスからクライアントのIPアドレスを返す。
+// X-Forwarded-For などのヘッダーはクライアントが自由に書けるため読まない。
+// 信頼するリバースプロキシ経由の場合は Middleware が接続元アドレスをクライアントのアドレスに置き換えておく
+func IP(remoteAddr string) string {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		return remoteAddr
+	}
+	return host
+}
+
+// TrustedProxies は X-Forwarded-For を信頼するリバースプロキシのアドレス範囲
+type TrustedProxies []netip.Prefix
+
+// ParseTrustedProxies は CIDR（"10.0.0.0/8" など）または単一のIPアドレスの一覧を読み込む
+func ParseTrustedProxies(values []string) (TrustedProxies, error) {
+	proxies := make(TrustedProxies, 0, len(values))
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		if !strings.Contains(v, "/") {
+			addr, err := netip.ParseAddr(v)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid trusted proxy %q", v)
+			}
+			proxies = append(proxies, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+			continue
+		}
+		prefix, err := netip.ParsePrefix(v)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid trusted proxy %q", v)
+		}
+		proxies = append(proxies, prefix.Masked())
+	}
+	return proxies, nil
+}
+
+func (p TrustedProxies) contains(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	for _, prefix := range p {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// ClientIP はリクエストを送ったクライアントのIPアドレスを決める。
+// 接続元が信頼するプロキシの場合だけ X-Forwarded-For を右から辿り、信頼するプロキシではない最初のアドレスを使う。
+// 左側はクライアントが書き換えられるため、先頭のアドレスは使わない
+func (p TrustedProxies) ClientIP(header http.Header, remoteAddr string) string {
+	peer := IP(remoteAddr)
+	addr, err := netip.ParseAddr(peer)
+	if err != nil || !p.contains(addr) {
+		return peer
+	}
+
+	hops := strings.Split(strings.Join(header.Values(headerForwardedFor), ","), ",")
+	client := peer
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if hop == "" {
+			continue
+		}
+		hopAddr, err := netip.ParseAddr(hop)
+		if err != nil {
+			// 壊れた値より左は信用できないため、直前まで辿ったアドレスを使う
+			return client
+		}
+		client = hopAddr.Unmap().String()
+		if !p.contains(hopAddr) {
+			return client
+		}
+	}
+
+	if client == peer {
+		if realIP, err := netip.ParseAddr(strings.TrimSpace(header.Get(headerRealIP))); err == nil {
+			return realIP.Unmap().String()
+		}
+	}
+	return client
+}
+
+// Middleware は接続元アドレスを ClientIP で決めたクライアントのアドレスに置き換え、転送元のヘッダーを取り除く。
+// 以降のハンドラーは接続元アドレス（connect の Peer().Addr など）だけを見ればよい
+func (p TrustedProxies) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.RemoteAddr = net.JoinHostPort(p.ClientIP(r.Header, r.RemoteAddr), "0")
+		r.Header.Del(headerForwardedFor)
+		r.Header.Del(headerRealIP)
+		next.ServeHTTP(w, r)
+	})
+}
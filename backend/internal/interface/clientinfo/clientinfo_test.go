@@ -0,0 +1,111 @@
+package clientinfo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIP(t *testing.T) {
+	tests := []struct {
+		name       string
+		remoteAddr string
+		want       string
+	}{
+		{name: "正常系: ポート付きのアドレス", remoteAddr: "203.0.113.10:52431", want: "203.0.113.10"},
+		{name: "正常系: IPv6のアドレス", remoteAddr: "[2001:db8::1]:443", want: "2001:db8::1"},
+		{name: "正常系: ポートなしのアドレス", remoteAddr: "203.0.113.10", want: "203.0.113.10"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IP(tt.remoteAddr))
+		})
+	}
+}
+
+func TestTrustedProxies_ClientIP(t *testing.T) {
+	proxies, err := ParseTrustedProxies([]string{"10.0.0.0/8", "192.0.2.1"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		header     http.Header
+		want       string
+	}{
+		{
+			name:       "正常系: 信頼しない接続元のヘッダーは無視する",
+			remoteAddr: "203.0.113.10:52431",
+			header:     http.Header{"X-Forwarded-For": {"198.51.100.1"}, "X-Real-Ip": {"198.51.100.2"}},
+			want:       "203.0.113.10",
+		},
+		{
+			name:       "正常系: 信頼するプロキシ経由なら右端の信頼しないアドレスを使う",
+			remoteAddr: "10.0.0.5:443",
+			header:     http.Header{"X-Forwarded-For": {"198.51.100.1, 203.0.113.10"}},
+			want:       "203.0.113.10",
+		},
+		{
+			name:       "正常系: 多段のプロキシを右から辿る",
+			remoteAddr: "10.0.0.5:443",
+			header:     http.Header{"X-Forwarded-For": {"203.0.113.10, 192.0.2.1", "10.1.2.3"}},
+			want:       "203.0.113.10",
+		},
+		{
+			name:       "正常系: クライアントが先頭に書いた値は使わない",
+			remoteAddr: "10.0.0.5:443",
+			header:     http.Header{"X-Forwarded-For": {"127.0.0.1, 203.0.113.10"}},
+			want:       "203.0.113.10",
+		},
+		{
+			name:       "正常系: X-Forwarded-For がなければ X-Real-Ip を使う",
+			remoteAddr: "10.0.0.5:443",
+			header:     http.Header{"X-Real-Ip": {"203.0.113.10"}},
+			want:       "203.0.113.10",
+		},
+		{
+			name:       "異常系: 壊れた値より左は使わない",
+			remoteAddr: "10.0.0.5:443",
+			header:     http.Header{"X-Forwarded-For": {"203.0.113.10, not-an-ip, 10.1.2.3"}},
+			want:       "10.1.2.3",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, proxies.ClientIP(tt.header, tt.remoteAddr))
+		})
+	}
+}
+
+func TestParseTrustedProxies(t *testing.T) {
+	_, err := ParseTrustedProxies([]string{"10.0.0.0/33"})
+	assert.Error(t, err)
+
+	_, err = ParseTrustedProxies([]string{"proxy.internal"})
+	assert.Error(t, err)
+}
+
+func TestTrustedProxies_Middleware(t *testing.T) {
+	proxies, err := ParseTrustedProxies([]string{"10.0.0.0/8"})
+	require.NoError(t, err)
+
+	var gotIP string
+	var gotForwarded string
+	handler := proxies.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotIP = IP(r.RemoteAddr)
+		gotForwarded = r.Header.Get("X-Forwarded-For")
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	req.RemoteAddr = "10.0.0.5:443"
+	req.Header.Set("X-Forwarded-For", "198.51.100.1, 203.0.113.10")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.Equal(t, "203.0.113.10", gotIP)
+	assert.Empty(t, gotForwarded)
+}
@@ -0,0 +1,90 @@
+package v1
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"github.com/shibayama-club/keyhub/internal/domain"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/interface/apitoken"
+	consolev1 "github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1"
+	"github.com/shibayama-club/keyhub/internal/usecase/console/dto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func convertAPITokenToProto(t model.APIToken) *consolev1.ApiToken {
+	return &consolev1.ApiToken{
+		Id:          t.ID.String(),
+		Name:        t.Name.String(),
+		TokenPrefix: t.TokenPrefix,
+		Scopes:      apitoken.Scopes(t),
+		ExpiresAt:   timestamppb.New(t.ExpiresAt),
+		LastUsedAt:  apitoken.LastUsedAt(t),
+		CreatedAt:   timestamppb.New(t.CreatedAt),
+	}
+}
+
+func (h *Handler) CreateApiToken(
+	ctx context.Context,
+	req *connect.Request[consolev1.CreateApiTokenRequest],
+) (*connect.Response[consolev1.CreateApiTokenResponse], error) {
+	orgID, ok := domain.Value[model.OrganizationID](ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.WithMessage(domainerrors.ErrNotFound, "organization not found"))
+	}
+
+	output, err := h.useCase.CreateAPIToken(ctx, dto.CreateAPITokenInput{
+		OrganizationID: orgID,
+		Name:           req.Msg.Name,
+		Scopes:         req.Msg.Scopes,
+		ExpiresAt:      req.Msg.ExpiresAt.AsTime(),
+	})
+	if err != nil {
+		return nil, apitoken.ConnectError(h.l, err, "failed to create API token")
+	}
+
+	return connect.NewResponse(&consolev1.CreateApiTokenResponse{
+		ApiToken: convertAPITokenToProto(output.APIToken),
+		Token:    output.Token,
+	}), nil
+}
+
+func (h *Handler) ListApiTokens(
+	ctx context.Context,
+	req *connect.Request[consolev1.ListApiTokensRequest],
+) (*connect.Response[consolev1.ListApiTokensResponse], error) {
+	orgID, ok := domain.Value[model.OrganizationID](ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.WithMessage(domainerrors.ErrNotFound, "organization not found"))
+	}
+
+	tokens, err := h.useCase.ListAPITokens(ctx, orgID)
+	if err != nil {
+		return nil, apitoken.ConnectError(h.l, err, "failed to list API tokens")
+	}
+
+	return connect.NewResponse(&consolev1.ListApiTokensResponse{
+		ApiTokens: lo.Map(tokens, func(t model.APIToken, _ int) *consolev1.ApiToken {
+			return convertAPITokenToProto(t)
+		}),
+	}), nil
+}
+
+func (h *Handler) RevokeApiToken(
+	ctx context.Context,
+	req *connect.Request[consolev1.RevokeApiTokenRequest],
+) (*connect.Response[consolev1.RevokeApiTokenResponse], error) {
+	orgID, ok := domain.Value[model.OrganizationID](ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.WithMessage(domainerrors.ErrNotFound, "organization not found"))
+	}
+
+	if err := h.useCase.RevokeAPIToken(ctx, orgID, req.Msg.Id); err != nil {
+		return nil, apitoken.ConnectError(h.l, err, "failed to revoke API token")
+	}
+
+	return connect.NewResponse(&consolev1.RevokeApiTokenResponse{}), nil
+}
@@ -0,0 +1,68 @@
+package v1
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"github.com/shibayama-club/keyhub/internal/domain"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	consolev1 "github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1"
+	"github.com/shibayama-club/keyhub/internal/usecase/console/dto"
+	"github.com/shibayama-club/keyhub/internal/util"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func convertAuditLogToProto(l model.AuditLog) *consolev1.AuditLog {
+	return &consolev1.AuditLog{
+		Id:         l.ID.String(),
+		ActorType:  l.Actor.Type.String(),
+		ActorId:    l.Actor.ID,
+		Procedure:  l.Procedure,
+		TargetIds:  l.TargetIDs,
+		ResultCode: l.ResultCode,
+		RequestId:  l.RequestID,
+		IpAddress:  l.Client.IPAddress,
+		UserAgent:  l.Client.UserAgent,
+		CreatedAt:  timestamppb.New(l.CreatedAt),
+	}
+}
+
+func (h *Handler) SearchAuditLogs(
+	ctx context.Context,
+	req *connect.Request[consolev1.SearchAuditLogsRequest],
+) (*connect.Response[consolev1.SearchAuditLogsResponse], error) {
+	orgID, ok := domain.Value[model.OrganizationID](ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.WithMessage(domainerrors.ErrNotFound, "organization not found"))
+	}
+
+	output, err := h.useCase.SearchAuditLogs(ctx, dto.SearchAuditLogsInput{
+		OrganizationID: orgID,
+		ActorType:      req.Msg.ActorType,
+		ActorID:        req.Msg.ActorId,
+		Procedure:      req.Msg.Procedure,
+		ResultCode:     req.Msg.ResultCode,
+		TargetID:       req.Msg.TargetId,
+		Since:          util.ParseTimestampToTime(req.Msg.Since),
+		Until:          util.ParseTimestampToTime(req.Msg.Until),
+		PageSize:       req.Msg.PageSize,
+		PageToken:      req.Msg.PageToken,
+	})
+	if err != nil {
+		if errors.Is(err, domainerrors.ErrValidation) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		h.l.Error("failed to search audit logs", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to search audit logs"))
+	}
+
+	return connect.NewResponse(&consolev1.SearchAuditLogsResponse{
+		AuditLogs: lo.Map(output.AuditLogs, func(l model.AuditLog, _ int) *consolev1.AuditLog {
+			return convertAuditLogToProto(l)
+		}),
+		NextPageToken: output.NextPageToken,
+	}), nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"connectrpc.com/connect"
 	"github.com/cockroachdb/errors"
+	"github.com/shibayama-club/keyhub/internal/interface/clientinfo"
 	consolev1 "github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1"
 )
 
@@ -12,7 +13,8 @@ func (h *Handler) LoginWithOrgId(
 	ctx context.Context,
 	req *connect.Request[consolev1.LoginWithOrgIdRequest],
 ) (*connect.Response[consolev1.LoginWithOrgIdResponse], error) {
-	token, expiresIn, err := h.useCase.LoginWithOrgId(ctx, req.Msg.OrganizationId, req.Msg.OrganizationKey)
+	client := clientinfo.FromRequest(req.Header(), req.Peer().Addr)
+	token, expiresIn, err := h.useCase.LoginWithOrgId(ctx, req.Msg.OrganizationId, req.Msg.OrganizationKey, client)
 	if err != nil {
 		h.l.Error("failed to login with org id", "error", err)
 		return nil, connect.NewError(connect.CodeUnauthenticated, err)
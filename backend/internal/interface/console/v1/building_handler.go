@@ -0,0 +1,193 @@
+package v1
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"github.com/shibayama-club/keyhub/internal/domain"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	consolev1 "github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1"
+	"github.com/shibayama-club/keyhub/internal/usecase/console/dto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func convertBuildingToProto(b model.Building) *consolev1.Building {
+	return &consolev1.Building{
+		Id:          b.ID.String(),
+		Name:        b.Name.String(),
+		Description: b.Description.String(),
+		CreatedAt:   timestamppb.New(b.CreatedAt),
+	}
+}
+
+func convertFloorToProto(f model.Floor) *consolev1.Floor {
+	return &consolev1.Floor{
+		Id:         f.ID.String(),
+		BuildingId: f.BuildingID.String(),
+		Name:       f.Name.String(),
+		Level:      f.Level,
+		CreatedAt:  timestamppb.New(f.CreatedAt),
+	}
+}
+
+// buildingError は建物・階の操作のエラーをConnectのコードに変換する
+func (h *Handler) buildingError(err error, msg string) error {
+	switch {
+	case errors.Is(err, domainerrors.ErrValidation):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, domainerrors.ErrNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, domainerrors.ErrAlreadyExists):
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	}
+	h.l.Error(msg, "error", err)
+	return connect.NewError(connect.CodeInternal, errors.Wrap(err, msg))
+}
+
+func (h *Handler) CreateBuilding(
+	ctx context.Context,
+	req *connect.Request[consolev1.CreateBuildingRequest],
+) (*connect.Response[consolev1.CreateBuildingResponse], error) {
+	orgID, ok := domain.Value[model.OrganizationID](ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.WithMessage(domainerrors.ErrNotFound, "organization not found"))
+	}
+
+	building, err := h.useCase.CreateBuilding(ctx, dto.CreateBuildingInput{
+		OrganizationID: orgID,
+		Name:           req.Msg.Name,
+		Description:    req.Msg.Description,
+	})
+	if err != nil {
+		return nil, h.buildingError(err, "failed to create building")
+	}
+
+	return connect.NewResponse(&consolev1.CreateBuildingResponse{
+		Building: convertBuildingToProto(building),
+	}), nil
+}
+
+func (h *Handler) ListBuildings(
+	ctx context.Context,
+	req *connect.Request[consolev1.ListBuildingsRequest],
+) (*connect.Response[consolev1.ListBuildingsResponse], error) {
+	buildings, err := h.useCase.ListBuildings(ctx)
+	if err != nil {
+		return nil, h.buildingError(err, "failed to list buildings")
+	}
+
+	return connect.NewResponse(&consolev1.ListBuildingsResponse{
+		Buildings: lo.Map(buildings, func(b dto.BuildingWithFloors, _ int) *consolev1.BuildingFloors {
+			return &consolev1.BuildingFloors{
+				Building: convertBuildingToProto(b.Building),
+				Floors: lo.Map(b.Floors, func(f model.Floor, _ int) *consolev1.Floor {
+					return convertFloorToProto(f)
+				}),
+			}
+		}),
+	}), nil
+}
+
+func (h *Handler) UpdateBuilding(
+	ctx context.Context,
+	req *connect.Request[consolev1.UpdateBuildingRequest],
+) (*connect.Response[consolev1.UpdateBuildingResponse], error) {
+	buildingID, err := model.ParseBuildingID(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrap(err, "invalid building ID"))
+	}
+
+	building, err := h.useCase.UpdateBuilding(ctx, dto.UpdateBuildingInput{
+		ID:          buildingID,
+		Name:        req.Msg.Name,
+		Description: req.Msg.Description,
+	})
+	if err != nil {
+		return nil, h.buildingError(err, "failed to update building")
+	}
+
+	return connect.NewResponse(&consolev1.UpdateBuildingResponse{
+		Building: convertBuildingToProto(building),
+	}), nil
+}
+
+func (h *Handler) DeleteBuilding(
+	ctx context.Context,
+	req *connect.Request[consolev1.DeleteBuildingRequest],
+) (*connect.Response[consolev1.DeleteBuildingResponse], error) {
+	buildingID, err := model.ParseBuildingID(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrap(err, "invalid building ID"))
+	}
+
+	if err := h.useCase.DeleteBuilding(ctx, buildingID); err != nil {
+		return nil, h.buildingError(err, "failed to delete building")
+	}
+
+	return connect.NewResponse(&consolev1.DeleteBuildingResponse{}), nil
+}
+
+func (h *Handler) CreateFloor(
+	ctx context.Context,
+	req *connect.Request[consolev1.CreateFloorRequest],
+) (*connect.Response[consolev1.CreateFloorResponse], error) {
+	buildingID, err := model.ParseBuildingID(req.Msg.BuildingId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrap(err, "invalid building ID"))
+	}
+
+	floor, err := h.useCase.CreateFloor(ctx, dto.CreateFloorInput{
+		BuildingID: buildingID,
+		Name:       req.Msg.Name,
+		Level:      req.Msg.Level,
+	})
+	if err != nil {
+		return nil, h.buildingError(err, "failed to create floor")
+	}
+
+	return connect.NewResponse(&consolev1.CreateFloorResponse{
+		Floor: convertFloorToProto(floor),
+	}), nil
+}
+
+func (h *Handler) UpdateFloor(
+	ctx context.Context,
+	req *connect.Request[consolev1.UpdateFloorRequest],
+) (*connect.Response[consolev1.UpdateFloorResponse], error) {
+	floorID, err := model.ParseFloorID(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrap(err, "invalid floor ID"))
+	}
+
+	floor, err := h.useCase.UpdateFloor(ctx, dto.UpdateFloorInput{
+		ID:    floorID,
+		Name:  req.Msg.Name,
+		Level: req.Msg.Level,
+	})
+	if err != nil {
+		return nil, h.buildingError(err, "failed to update floor")
+	}
+
+	return connect.NewResponse(&consolev1.UpdateFloorResponse{
+		Floor: convertFloorToProto(floor),
+	}), nil
+}
+
+func (h *Handler) DeleteFloor(
+	ctx context.Context,
+	req *connect.Request[consolev1.DeleteFloorRequest],
+) (*connect.Response[consolev1.DeleteFloorResponse], error) {
+	floorID, err := model.ParseFloorID(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrap(err, "invalid floor ID"))
+	}
+
+	if err := h.useCase.DeleteFloor(ctx, floorID); err != nil {
+		return nil, h.buildingError(err, "failed to delete floor")
+	}
+
+	return connect.NewResponse(&consolev1.DeleteFloorResponse{}), nil
+}
@@ -3,8 +3,9 @@ package v1
 import (
 	"log/slog"
 
-	"github.com/cockroachdb/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
 	authConsole "github.com/shibayama-club/keyhub/internal/infrastructure/auth/console"
+	consolev1 "github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1"
 	"github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1/consolev1connect"
 	"github.com/shibayama-club/keyhub/internal/usecase/console/iface"
 )
@@ -17,15 +18,25 @@ type Handler struct {
 	authService *authConsole.AuthService
 }
 
-func NewHandler(useCase iface.IUseCase, jwtSecret string) (*Handler, error) {
-	authService, err := authConsole.NewAuthService(jwtSecret)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to create auth service")
-	}
-
+func NewHandler(useCase iface.IUseCase, authService *authConsole.AuthService) *Handler {
 	return &Handler{
 		l:           slog.Default(),
 		useCase:     useCase,
 		authService: authService,
-	}, nil
+	}
+}
+
+// convertListOrder は一覧の並び順をユースケースに渡す文字列にする。
+// 未指定は空文字、未知の値はユースケースで検証エラーになるようそのまま渡す
+func convertListOrder(order consolev1.ListOrder) string {
+	switch order {
+	case consolev1.ListOrder_LIST_ORDER_UNSPECIFIED:
+		return ""
+	case consolev1.ListOrder_LIST_ORDER_NEWEST:
+		return model.ListOrderNewest.String()
+	case consolev1.ListOrder_LIST_ORDER_NAME:
+		return model.ListOrderName.String()
+	default:
+		return order.String()
+	}
 }
@@ -2,28 +2,46 @@ package interceptor
 
 import (
 	"context"
+	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"connectrpc.com/connect"
+	"github.com/cockroachdb/errors"
 	"github.com/shibayama-club/keyhub/internal/domain"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/interface/clientinfo"
+	"github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1/consolev1connect"
+	"github.com/shibayama-club/keyhub/internal/interface/streamauth"
+	"github.com/shibayama-club/keyhub/internal/usecase/console/dto"
 	"github.com/shibayama-club/keyhub/internal/usecase/console/iface"
 )
 
+const (
+	// セッションを延長した際に再発行したトークンを返すレスポンスヘッダー
+	HeaderSessionToken     = "X-Session-Token"
+	HeaderSessionExpiresIn = "X-Session-Expires-In"
+)
+
 type authInterceptor struct {
 	useCase iface.IUseCase
+	// revalidateInterval はストリームの途中で認証をやり直す間隔
+	revalidateInterval time.Duration
 }
 
 func NewAuthInterceptor(useCase iface.IUseCase) connect.Interceptor {
-	return &authInterceptor{useCase: useCase}
+	return &authInterceptor{useCase: useCase, revalidateInterval: streamauth.RevalidateInterval}
 }
 
-func (i *authInterceptor) authenticate(ctx context.Context, procedure string, authHeader string) (context.Context, error) {
+func (i *authInterceptor) authenticate(ctx context.Context, procedure string, header http.Header, peerAddr string) (context.Context, dto.ValidateSessionOutput, error) {
 	if strings.Contains(procedure, "LoginWithOrgId") {
-		return ctx, nil
+		return ctx, dto.ValidateSessionOutput{}, nil
 	}
 
+	authHeader := header.Get("Authorization")
 	if authHeader == "" {
-		return ctx, connect.NewError(connect.CodeUnauthenticated, nil)
+		return ctx, dto.ValidateSessionOutput{}, connect.NewError(connect.CodeUnauthenticated, nil)
 	}
 
 	token := authHeader
@@ -31,22 +49,70 @@ func (i *authInterceptor) authenticate(ctx context.Context, procedure string, au
 		token = authHeader[7:]
 	}
 
-	session, err := i.useCase.ValidateSession(ctx, token)
+	// プラットフォーム管理APIは組織のセッションではなく管理者トークンで認証する
+	if strings.HasPrefix(procedure, "/"+consolev1connect.ConsolePlatformServiceName+"/") {
+		if err := i.useCase.AuthenticatePlatformAdmin(ctx, token); err != nil {
+			return ctx, dto.ValidateSessionOutput{}, connect.NewError(connect.CodeUnauthenticated, err)
+		}
+		return ctx, dto.ValidateSessionOutput{}, nil
+	}
+
+	// JWTではなくAPIトークンが送られた場合はスコープで呼び出せる手続きを制限する
+	if model.IsConsoleAPIToken(token) {
+		ctx, err := i.authenticateAPIToken(ctx, procedure, token)
+		return ctx, dto.ValidateSessionOutput{}, err
+	}
+
+	output, err := i.useCase.ValidateSession(ctx, token, clientinfo.FromRequest(header, peerAddr))
+	if err != nil {
+		return ctx, dto.ValidateSessionOutput{}, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	ctx = domain.WithValue(ctx, output.Session)
+	ctx = domain.WithValue(ctx, output.Session.OrganizationID)
+	ctx = domain.WithValue(ctx, output.Session.SessionID)
+	ctx = domain.WithValue(ctx, output.Session.Role)
+	return ctx, output, nil
+}
+
+func (i *authInterceptor) authenticateAPIToken(ctx context.Context, procedure, token string) (context.Context, error) {
+	apiToken, err := i.useCase.AuthenticateAPIToken(ctx, token)
 	if err != nil {
 		return ctx, connect.NewError(connect.CodeUnauthenticated, err)
 	}
 
-	ctx = domain.WithValue(ctx, session.OrganizationID)
+	scope, ok := apiTokenScopes[procedure]
+	if !ok || !apiToken.HasScope(scope) {
+		return ctx, connect.NewError(connect.CodePermissionDenied, errors.Newf("API token is not allowed to call %s", procedure))
+	}
+
+	ctx = domain.WithValue(ctx, apiToken)
+	ctx = domain.WithValue(ctx, apiToken.OrganizationID)
 	return ctx, nil
 }
 
+// setRenewedToken はセッションを延長した場合に再発行したトークンをレスポンスヘッダーに載せる
+func setRenewedToken(header http.Header, output dto.ValidateSessionOutput) {
+	if output.RenewedToken == "" {
+		return
+	}
+	header.Set(HeaderSessionToken, output.RenewedToken)
+	header.Set(HeaderSessionExpiresIn, strconv.FormatInt(output.ExpiresIn, 10))
+}
+
 func (i *authInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
-		ctx, err := i.authenticate(ctx, req.Spec().Procedure, req.Header().Get("Authorization"))
+		ctx, output, err := i.authenticate(ctx, req.Spec().Procedure, req.Header(), req.Peer().Addr)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := next(ctx, req)
 		if err != nil {
 			return nil, err
 		}
-		return next(ctx, req)
+		setRenewedToken(res.Header(), output)
+		return res, nil
 	}
 }
 
@@ -54,13 +120,23 @@ func (i *authInterceptor) WrapStreamingClient(next connect.StreamingClientFunc)
 	return next
 }
 
-// 将来的に使う可能性あり、現在は使用していない。
+// WrapStreamingHandler はストリームを開く時点で Unary と同じように認証する。
+// 延長したトークンは最初のメッセージとともに返すレスポンスヘッダーに載せ、
+// ストリームはセッションかAPIトークンの有効期限か、途中の再認証に失敗した時点で打ち切る
 func (i *authInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
 	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
-		ctx, err := i.authenticate(ctx, conn.Spec().Procedure, conn.RequestHeader().Get("Authorization"))
+		ctx, output, err := i.authenticate(ctx, conn.Spec().Procedure, conn.RequestHeader(), conn.Peer().Addr)
 		if err != nil {
 			return err
 		}
-		return next(ctx, conn)
+		setRenewedToken(conn.ResponseHeader(), output)
+
+		revalidate := func(ctx context.Context) error {
+			_, _, err := i.authenticate(ctx, conn.Spec().Procedure, conn.RequestHeader(), conn.Peer().Addr)
+			return err
+		}
+		return streamauth.Serve(ctx, i.revalidateInterval, revalidate, func(ctx context.Context) error {
+			return next(ctx, conn)
+		})
 	}
 }
@@ -0,0 +1,215 @@
+package interceptor
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/shibayama-club/keyhub/internal/domain"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1/consolev1connect"
+	"github.com/shibayama-club/keyhub/internal/usecase/console/dto"
+	"github.com/shibayama-club/keyhub/internal/usecase/console/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// streamingTestProcedure はテスト用のストリーミングの手続き。
+// APIトークンのスコープは手続き名で確認するため、既存の WatchKeys の名前で立てる
+const streamingTestProcedure = consolev1connect.ConsoleKeyServiceWatchKeysProcedure
+
+// waitUntilDone をリクエストに指定すると、ハンドラーは ctx が終わるまでストリームを開いたままにする
+const waitUntilDone = "wait"
+
+// newStreamingTestServer は認証インターセプターだけを通すサーバーストリーミングのテスト用サービスを立てる。
+// ハンドラーは ctx に入った組織IDを1件返す。revalidateInterval を指定するとその間隔で認証をやり直す
+func newStreamingTestServer(t *testing.T, useCase *mock.MockIUseCase, revalidateInterval time.Duration) *httptest.Server {
+	t.Helper()
+
+	auth := NewAuthInterceptor(useCase).(*authInterceptor)
+	if revalidateInterval > 0 {
+		auth.revalidateInterval = revalidateInterval
+	}
+
+	handler := connect.NewServerStreamHandler(
+		streamingTestProcedure,
+		func(ctx context.Context, req *connect.Request[wrapperspb.StringValue], stream *connect.ServerStream[wrapperspb.StringValue]) error {
+			orgID, _ := domain.Value[model.OrganizationID](ctx)
+			if err := stream.Send(wrapperspb.String(orgID.String())); err != nil {
+				return err
+			}
+			if req.Msg.Value == waitUntilDone {
+				<-ctx.Done()
+			}
+			return nil
+		},
+		connect.WithInterceptors(auth),
+	)
+
+	mux := http.NewServeMux()
+	mux.Handle(streamingTestProcedure, handler)
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestAuthInterceptor_WrapStreamingHandler(t *testing.T) {
+	orgID := model.OrganizationID(uuid.New())
+	session := model.ConsoleSession{
+		SessionID:      model.ConsoleSessionID("session-id"),
+		OrganizationID: orgID,
+		Role:           model.ConsoleRoleOperator,
+		ExpiresAt:      time.Now().Add(time.Hour),
+	}
+	apiToken := model.APIToken{
+		OrganizationID: orgID,
+		Scopes:         []model.APITokenScope{model.APITokenScopeKeysRead},
+		ExpiresAt:      time.Now().Add(time.Hour),
+	}
+
+	tests := []struct {
+		name          string
+		authorization string
+		message       string
+		revalidate    time.Duration
+		setupMock     func(*mock.MockIUseCase)
+		wantOrgID     string
+		wantRenewed   string
+		wantCode      connect.Code
+	}{
+		{
+			name:          "正常系: セッションで認証し、ctx に組織IDを入れる",
+			authorization: "Bearer jwt",
+			setupMock: func(m *mock.MockIUseCase) {
+				m.EXPECT().ValidateSession(gomock.Any(), "jwt", gomock.Any()).
+					Return(dto.ValidateSessionOutput{Session: session, RenewedToken: "renewed-jwt", ExpiresIn: 3600}, nil)
+			},
+			wantOrgID:   orgID.String(),
+			wantRenewed: "renewed-jwt",
+		},
+		{
+			name:          "正常系: スコープを持つAPIトークンで認証する",
+			authorization: "Bearer khc_token",
+			setupMock: func(m *mock.MockIUseCase) {
+				m.EXPECT().AuthenticateAPIToken(gomock.Any(), "khc_token").Return(apiToken, nil)
+			},
+			wantOrgID: orgID.String(),
+		},
+		{
+			name:      "異常系: Authorization ヘッダーがない",
+			setupMock: func(m *mock.MockIUseCase) {},
+			wantCode:  connect.CodeUnauthenticated,
+		},
+		{
+			name:          "異常系: 無効なセッション",
+			authorization: "Bearer jwt",
+			setupMock: func(m *mock.MockIUseCase) {
+				m.EXPECT().ValidateSession(gomock.Any(), "jwt", gomock.Any()).
+					Return(dto.ValidateSessionOutput{}, errors.New("session not found"))
+			},
+			wantCode: connect.CodeUnauthenticated,
+		},
+		{
+			name:          "異常系: APIトークンに必要なスコープがない",
+			authorization: "Bearer khc_token",
+			setupMock: func(m *mock.MockIUseCase) {
+				token := apiToken
+				token.Scopes = []model.APITokenScope{model.APITokenScopeTenantsRead}
+				m.EXPECT().AuthenticateAPIToken(gomock.Any(), "khc_token").Return(token, nil)
+			},
+			wantCode: connect.CodePermissionDenied,
+		},
+		{
+			name:          "異常系: ストリームの途中でセッションの有効期限が切れる",
+			authorization: "Bearer jwt",
+			message:       waitUntilDone,
+			setupMock: func(m *mock.MockIUseCase) {
+				expiring := session
+				expiring.ExpiresAt = time.Now().Add(100 * time.Millisecond)
+				m.EXPECT().ValidateSession(gomock.Any(), "jwt", gomock.Any()).
+					Return(dto.ValidateSessionOutput{Session: expiring}, nil)
+			},
+			wantOrgID: orgID.String(),
+			wantCode:  connect.CodeUnauthenticated,
+		},
+		{
+			name:          "異常系: ストリームの途中でセッションが無効になる",
+			authorization: "Bearer jwt",
+			message:       waitUntilDone,
+			revalidate:    50 * time.Millisecond,
+			setupMock: func(m *mock.MockIUseCase) {
+				gomock.InOrder(
+					m.EXPECT().ValidateSession(gomock.Any(), "jwt", gomock.Any()).
+						Return(dto.ValidateSessionOutput{Session: session}, nil),
+					m.EXPECT().ValidateSession(gomock.Any(), "jwt", gomock.Any()).
+						Return(dto.ValidateSessionOutput{Session: session}, nil),
+					m.EXPECT().ValidateSession(gomock.Any(), "jwt", gomock.Any()).
+						Return(dto.ValidateSessionOutput{}, errors.New("session not found")),
+				)
+			},
+			wantOrgID: orgID.String(),
+			wantCode:  connect.CodeUnauthenticated,
+		},
+		{
+			name:          "異常系: ストリームの途中でAPIトークンが失効する",
+			authorization: "Bearer khc_token",
+			message:       waitUntilDone,
+			revalidate:    50 * time.Millisecond,
+			setupMock: func(m *mock.MockIUseCase) {
+				gomock.InOrder(
+					m.EXPECT().AuthenticateAPIToken(gomock.Any(), "khc_token").Return(apiToken, nil),
+					m.EXPECT().AuthenticateAPIToken(gomock.Any(), "khc_token").Return(model.APIToken{}, errors.New("token revoked")),
+				)
+			},
+			wantOrgID: orgID.String(),
+			wantCode:  connect.CodeUnauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			useCase := mock.NewMockIUseCase(ctrl)
+			tt.setupMock(useCase)
+
+			server := newStreamingTestServer(t, useCase, tt.revalidate)
+			client := connect.NewClient[wrapperspb.StringValue, wrapperspb.StringValue](server.Client(), server.URL+streamingTestProcedure)
+
+			req := connect.NewRequest(wrapperspb.String(tt.message))
+			if tt.authorization != "" {
+				req.Header().Set("Authorization", tt.authorization)
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			stream, err := client.CallServerStream(ctx, req)
+			require.NoError(t, err)
+			defer stream.Close()
+
+			var received []string
+			for stream.Receive() {
+				received = append(received, stream.Msg().Value)
+			}
+
+			if tt.wantOrgID != "" {
+				assert.Equal(t, []string{tt.wantOrgID}, received)
+			} else {
+				assert.Empty(t, received)
+			}
+			assert.Equal(t, tt.wantRenewed, stream.ResponseHeader().Get(HeaderSessionToken))
+
+			if tt.wantCode == 0 {
+				assert.NoError(t, stream.Err())
+				return
+			}
+			assert.Equal(t, tt.wantCode, connect.CodeOf(stream.Err()))
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package interceptor
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/cockroachdb/errors"
+	"github.com/shibayama-club/keyhub/internal/domain"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1/consolev1connect"
+)
+
+// procedurePermissions はセッションで呼び出す際に権限が必要な手続きと、必要な権限の対応。
+// ここにない手続き（参照系やログアウトなど）はロールに関わらず呼び出せる
+var procedurePermissions = map[string]model.ConsolePermission{
+	consolev1connect.ConsoleServiceCreateTenantProcedure:                       model.ConsolePermissionTenantsManage,
+	consolev1connect.ConsoleServiceUpdateTenantProcedure:                       model.ConsolePermissionTenantsManage,
+	consolev1connect.ConsoleServiceArchiveTenantProcedure:                      model.ConsolePermissionTenantsManage,
+	consolev1connect.ConsoleServiceDeleteTenantProcedure:                       model.ConsolePermissionTenantsManage,
+	consolev1connect.ConsoleServiceCreateTenantTypeProcedure:                   model.ConsolePermissionTenantsManage,
+	consolev1connect.ConsoleServiceDeleteTenantTypeProcedure:                   model.ConsolePermissionTenantsManage,
+	consolev1connect.ConsoleTenantGroupServiceCreateTenantGroupProcedure:       model.ConsolePermissionTenantsManage,
+	consolev1connect.ConsoleTenantGroupServiceAddTenantGroupMemberProcedure:    model.ConsolePermissionTenantsManage,
+	consolev1connect.ConsoleTenantGroupServiceRemoveTenantGroupMemberProcedure: model.ConsolePermissionTenantsManage,
+	consolev1connect.ConsoleRoomServiceCreateRoomProcedure:                     model.ConsolePermissionRoomsManage,
+	consolev1connect.ConsoleRoomServiceAssignRoomToTenantProcedure:             model.ConsolePermissionRoomsManage,
+	consolev1connect.ConsoleRoomServiceUpdateRoomAttributesProcedure:           model.ConsolePermissionRoomsManage,
+	consolev1connect.ConsoleRoomServiceCreateRoomCustomFieldProcedure:          model.ConsolePermissionRoomsManage,
+	consolev1connect.ConsoleRoomServiceDeleteRoomCustomFieldProcedure:          model.ConsolePermissionRoomsManage,
+	consolev1connect.ConsoleRoomServiceCreateRoomTypeProcedure:                 model.ConsolePermissionRoomsManage,
+	consolev1connect.ConsoleRoomServiceDeleteRoomTypeProcedure:                 model.ConsolePermissionRoomsManage,
+	consolev1connect.ConsoleBuildingServiceCreateBuildingProcedure:             model.ConsolePermissionRoomsManage,
+	consolev1connect.ConsoleBuildingServiceUpdateBuildingProcedure:             model.ConsolePermissionRoomsManage,
+	consolev1connect.ConsoleBuildingServiceDeleteBuildingProcedure:             model.ConsolePermissionRoomsManage,
+	consolev1connect.ConsoleBuildingServiceCreateFloorProcedure:                model.ConsolePermissionRoomsManage,
+	consolev1connect.ConsoleBuildingServiceUpdateFloorProcedure:                model.ConsolePermissionRoomsManage,
+	consolev1connect.ConsoleBuildingServiceDeleteFloorProcedure:                model.ConsolePermissionRoomsManage,
+	consolev1connect.ConsoleKeyServiceCreateKeyProcedure:                       model.ConsolePermissionKeysManage,
+	consolev1connect.ConsoleKeyServiceUpdateKeyProcedure:                       model.ConsolePermissionKeysManage,
+	consolev1connect.ConsoleAuthServiceListSessionsProcedure:                   model.ConsolePermissionSessionsManage,
+	consolev1connect.ConsoleAuthServiceRevokeSessionProcedure:                  model.ConsolePermissionSessionsManage,
+	consolev1connect.ConsoleAuthServiceRevokeAllOtherSessionsProcedure:         model.ConsolePermissionSessionsManage,
+	consolev1connect.ConsoleApiTokenServiceCreateApiTokenProcedure:             model.ConsolePermissionAPITokensManage,
+	consolev1connect.ConsoleApiTokenServiceListApiTokensProcedure:              model.ConsolePermissionAPITokensManage,
+	consolev1connect.ConsoleApiTokenServiceRevokeApiTokenProcedure:             model.ConsolePermissionAPITokensManage,
+	consolev1connect.ConsoleOperatorServiceCreateOperatorProcedure:             model.ConsolePermissionOperatorsManage,
+	consolev1connect.ConsoleOperatorServiceListOperatorsProcedure:              model.ConsolePermissionOperatorsManage,
+	consolev1connect.ConsoleOperatorServiceRevokeOperatorProcedure:             model.ConsolePermissionOperatorsManage,
+	consolev1connect.ConsoleAuditServiceSearchAuditLogsProcedure:               model.ConsolePermissionAuditRead,
+	consolev1connect.ConsoleWebhookServiceCreateWebhookSubscriptionProcedure:   model.ConsolePermissionWebhooksManage,
+	consolev1connect.ConsoleWebhookServiceListWebhookSubscriptionsProcedure:    model.ConsolePermissionWebhooksManage,
+	consolev1connect.ConsoleWebhookServiceDeleteWebhookSubscriptionProcedure:   model.ConsolePermissionWebhooksManage,
+	consolev1connect.ConsoleWebhookServiceListWebhookDeliveriesProcedure:       model.ConsolePermissionWebhooksManage,
+	consolev1connect.ConsoleWebhookServiceSendTestWebhookEventProcedure:        model.ConsolePermissionWebhooksManage,
+}
+
+type permissionInterceptor struct{}
+
+// NewPermissionInterceptor はセッションのロールで手続きを呼び出せるかを確認する。
+// 認証インターセプターの後に置き、認証で取り出したロールを使う
+func NewPermissionInterceptor() connect.Interceptor {
+	return &permissionInterceptor{}
+}
+
+func authorize(ctx context.Context, procedure string) error {
+	permission, ok := procedurePermissions[procedure]
+	if !ok {
+		return nil
+	}
+
+	// APIトークンは認証時にスコープで呼び出せる手続きを制限している
+	if _, ok := domain.Value[model.APIToken](ctx); ok {
+		return nil
+	}
+
+	role, ok := domain.Value[model.ConsoleRole](ctx)
+	if !ok || !role.HasPermission(permission) {
+		return errors.WithHintf(
+			errors.Mark(errors.Newf("role %q is not allowed to call %s", role, procedure), domainerrors.ErrPermissionDenied),
+			"この操作には %s 権限が必要です。", permission,
+		)
+	}
+
+	return nil
+}
+
+func (i *permissionInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if err := authorize(ctx, req.Spec().Procedure); err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+func (i *permissionInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *permissionInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if err := authorize(ctx, conn.Spec().Procedure); err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
+}
@@ -0,0 +1,66 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shibayama-club/keyhub/internal/domain"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1/consolev1connect"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthorize(t *testing.T) {
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		procedure string
+		wantErr   bool
+	}{
+		{
+			name:      "正常系: 権限が不要な手続きはロールがなくても呼び出せる",
+			ctx:       context.Background(),
+			procedure: consolev1connect.ConsoleRoomServiceGetAllRoomsProcedure,
+		},
+		{
+			name:      "正常系: operator は鍵を作成できる",
+			ctx:       domain.WithValue(context.Background(), model.ConsoleRoleOperator),
+			procedure: consolev1connect.ConsoleKeyServiceCreateKeyProcedure,
+		},
+		{
+			name:      "異常系: operator はテナントを作成できない",
+			ctx:       domain.WithValue(context.Background(), model.ConsoleRoleOperator),
+			procedure: consolev1connect.ConsoleServiceCreateTenantProcedure,
+			wantErr:   true,
+		},
+		{
+			name:      "異常系: admin は管理者を登録できない",
+			ctx:       domain.WithValue(context.Background(), model.ConsoleRoleAdmin),
+			procedure: consolev1connect.ConsoleOperatorServiceCreateOperatorProcedure,
+			wantErr:   true,
+		},
+		{
+			name:      "正常系: APIトークンはスコープで制限済みのためロールを確認しない",
+			ctx:       domain.WithValue(context.Background(), model.APIToken{}),
+			procedure: consolev1connect.ConsoleRoomServiceCreateRoomProcedure,
+		},
+		{
+			name:      "異常系: ロールがない場合は拒否する",
+			ctx:       context.Background(),
+			procedure: consolev1connect.ConsoleRoomServiceCreateRoomProcedure,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := authorize(tt.ctx, tt.procedure)
+			if tt.wantErr {
+				assert.True(t, domainerrors.IsPermissionDeniedError(err))
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
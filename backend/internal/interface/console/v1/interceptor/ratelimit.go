@@ -0,0 +1,38 @@
+package interceptor
+
+import (
+	"time"
+
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	domainratelimit "github.com/shibayama-club/keyhub/internal/domain/ratelimit"
+	consolev1 "github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1"
+	"github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1/consolev1connect"
+	"github.com/shibayama-club/keyhub/internal/interface/ratelimit"
+)
+
+// rateLimitRules は組織キーの総当たり対策。組織ごとの失敗が続いた場合は、
+// 正しいキーを持つ管理者も含めてその組織のログインを一時的にロックする
+var rateLimitRules = map[string]ratelimit.Rule{
+	consolev1connect.ConsoleAuthServiceLoginWithOrgIdProcedure: {
+		PerIP:         model.RateLimit{Burst: 10, Period: time.Minute},
+		PerIdentifier: model.RateLimit{Burst: 5, Period: time.Minute},
+		Identifier: ratelimit.MessageIdentifier(func(msg *consolev1.LoginWithOrgIdRequest) string {
+			return msg.OrganizationId
+		}),
+		Lockout: model.LockoutPolicy{MaxFailures: 5, Window: 15 * time.Minute, Duration: 15 * time.Minute},
+	},
+}
+
+// authFailureLockout はセッションやAPIトークンの総当たり対策。接続元IPごとに認証の失敗を数える
+var authFailureLockout = model.LockoutPolicy{MaxFailures: 20, Window: 10 * time.Minute, Duration: 15 * time.Minute}
+
+// NewRateLimitInterceptor はConsole APIのレート制限インターセプターを作成する。
+// 制限に認証済みの情報を使わないため、AuthInterceptor の外側に置いて認証より先に数える
+func NewRateLimitInterceptor(store domainratelimit.Store) *ratelimit.Interceptor {
+	return ratelimit.NewInterceptor(store, rateLimitRules)
+}
+
+// NewAuthFailureInterceptor はConsole APIの認証の失敗を数えるインターセプターを作成する。AuthInterceptor の外側に置く
+func NewAuthFailureInterceptor(store domainratelimit.Store) *ratelimit.AuthFailureInterceptor {
+	return ratelimit.NewAuthFailureInterceptor(store, authFailureLockout)
+}
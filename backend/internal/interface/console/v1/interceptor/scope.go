@@ -0,0 +1,46 @@
+package interceptor
+
+import (
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1/consolev1connect"
+)
+
+// apiTokenScopes はAPIトークンで呼び出せる手続きと、必要なスコープの対応。
+// ここにない手続き（セッションやAPIトークン自体の管理など）はAPIトークンでは呼び出せない
+var apiTokenScopes = map[string]model.APITokenScope{
+	consolev1connect.ConsoleServiceGetAllTenantsProcedure:                      model.APITokenScopeTenantsRead,
+	consolev1connect.ConsoleServiceGetTenantByIdProcedure:                      model.APITokenScopeTenantsRead,
+	consolev1connect.ConsoleServiceCreateTenantProcedure:                       model.APITokenScopeTenantsWrite,
+	consolev1connect.ConsoleServiceUpdateTenantProcedure:                       model.APITokenScopeTenantsWrite,
+	consolev1connect.ConsoleServiceArchiveTenantProcedure:                      model.APITokenScopeTenantsWrite,
+	consolev1connect.ConsoleServiceDeleteTenantProcedure:                       model.APITokenScopeTenantsWrite,
+	consolev1connect.ConsoleServiceListTenantTypesProcedure:                    model.APITokenScopeTenantsRead,
+	consolev1connect.ConsoleServiceCreateTenantTypeProcedure:                   model.APITokenScopeTenantsWrite,
+	consolev1connect.ConsoleServiceDeleteTenantTypeProcedure:                   model.APITokenScopeTenantsWrite,
+	consolev1connect.ConsoleTenantGroupServiceListTenantGroupsProcedure:        model.APITokenScopeTenantsRead,
+	consolev1connect.ConsoleTenantGroupServiceListTenantGroupMembersProcedure:  model.APITokenScopeTenantsRead,
+	consolev1connect.ConsoleTenantGroupServiceCreateTenantGroupProcedure:       model.APITokenScopeTenantsWrite,
+	consolev1connect.ConsoleTenantGroupServiceAddTenantGroupMemberProcedure:    model.APITokenScopeTenantsWrite,
+	consolev1connect.ConsoleTenantGroupServiceRemoveTenantGroupMemberProcedure: model.APITokenScopeTenantsWrite,
+	consolev1connect.ConsoleRoomServiceGetAllRoomsProcedure:                    model.APITokenScopeRoomsRead,
+	consolev1connect.ConsoleRoomServiceCreateRoomProcedure:                     model.APITokenScopeRoomsWrite,
+	consolev1connect.ConsoleRoomServiceAssignRoomToTenantProcedure:             model.APITokenScopeRoomsWrite,
+	consolev1connect.ConsoleRoomServiceUpdateRoomAttributesProcedure:           model.APITokenScopeRoomsWrite,
+	consolev1connect.ConsoleRoomServiceCreateRoomCustomFieldProcedure:          model.APITokenScopeRoomsWrite,
+	consolev1connect.ConsoleRoomServiceListRoomCustomFieldsProcedure:           model.APITokenScopeRoomsRead,
+	consolev1connect.ConsoleRoomServiceDeleteRoomCustomFieldProcedure:          model.APITokenScopeRoomsWrite,
+	consolev1connect.ConsoleRoomServiceListRoomTypesProcedure:                  model.APITokenScopeRoomsRead,
+	consolev1connect.ConsoleRoomServiceCreateRoomTypeProcedure:                 model.APITokenScopeRoomsWrite,
+	consolev1connect.ConsoleRoomServiceDeleteRoomTypeProcedure:                 model.APITokenScopeRoomsWrite,
+	consolev1connect.ConsoleBuildingServiceListBuildingsProcedure:              model.APITokenScopeRoomsRead,
+	consolev1connect.ConsoleBuildingServiceCreateBuildingProcedure:             model.APITokenScopeRoomsWrite,
+	consolev1connect.ConsoleBuildingServiceUpdateBuildingProcedure:             model.APITokenScopeRoomsWrite,
+	consolev1connect.ConsoleBuildingServiceDeleteBuildingProcedure:             model.APITokenScopeRoomsWrite,
+	consolev1connect.ConsoleBuildingServiceCreateFloorProcedure:                model.APITokenScopeRoomsWrite,
+	consolev1connect.ConsoleBuildingServiceUpdateFloorProcedure:                model.APITokenScopeRoomsWrite,
+	consolev1connect.ConsoleBuildingServiceDeleteFloorProcedure:                model.APITokenScopeRoomsWrite,
+	consolev1connect.ConsoleKeyServiceGetKeysByRoomProcedure:                   model.APITokenScopeKeysRead,
+	consolev1connect.ConsoleKeyServiceCreateKeyProcedure:                       model.APITokenScopeKeysWrite,
+	consolev1connect.ConsoleKeyServiceUpdateKeyProcedure:                       model.APITokenScopeKeysWrite,
+	consolev1connect.ConsoleKeyServiceWatchKeysProcedure:                       model.APITokenScopeKeysRead,
+}
@@ -8,9 +8,11 @@ import (
 	"github.com/samber/lo"
 	"github.com/shibayama-club/keyhub/internal/domain"
 	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/keywatch"
 	"github.com/shibayama-club/keyhub/internal/domain/model"
 	consolev1 "github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1"
 	"github.com/shibayama-club/keyhub/internal/usecase/console/dto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (h *Handler) CreateKey(
@@ -43,6 +45,53 @@ func (h *Handler) CreateKey(
 	}), nil
 }
 
+func (h *Handler) UpdateKey(
+	ctx context.Context,
+	req *connect.Request[consolev1.UpdateKeyRequest],
+) (*connect.Response[consolev1.UpdateKeyResponse], error) {
+	keyID, err := model.ParseKeyID(req.Msg.KeyId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrap(err, "invalid key ID"))
+	}
+
+	key, err := h.useCase.UpdateKey(ctx, dto.UpdateKeyInput{
+		KeyID:     keyID,
+		KeyNumber: req.Msg.KeyNumber,
+		Version:   req.Msg.Version,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domainerrors.ErrValidation):
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		case errors.Is(err, domainerrors.ErrNotFound):
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		case errors.Is(err, domainerrors.ErrConflict):
+			return nil, connect.NewError(connect.CodeAborted, err)
+		}
+		h.l.Error("failed to update key", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to update key"))
+	}
+
+	return connect.NewResponse(&consolev1.UpdateKeyResponse{
+		Key: convertKeyToProto(key, 0),
+	}), nil
+}
+
+func convertKeyStatus(protoStatus consolev1.KeyStatus) (string, error) {
+	switch protoStatus {
+	case consolev1.KeyStatus_KEY_STATUS_AVAILABLE:
+		return model.KeyStatusAvailable.String(), nil
+	case consolev1.KeyStatus_KEY_STATUS_IN_USE:
+		return model.KeyStatusInUse.String(), nil
+	case consolev1.KeyStatus_KEY_STATUS_LOST:
+		return model.KeyStatusLost.String(), nil
+	case consolev1.KeyStatus_KEY_STATUS_DAMAGED:
+		return model.KeyStatusDamaged.String(), nil
+	default:
+		return "", errors.New("invalid key status")
+	}
+}
+
 func convertToProtoKeyStatus(status model.KeyStatus) consolev1.KeyStatus {
 	switch status {
 	case model.KeyStatusAvailable:
@@ -67,21 +116,129 @@ func (h *Handler) GetKeysByRoom(
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrap(err, "invalid room ID"))
 	}
 
-	keys, err := h.useCase.GetKeysByRoom(ctx, roomID)
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+	input := dto.GetKeysByRoomInput{
+		RoomID:          roomID,
+		KeyNumberPrefix: req.Msg.KeyNumberPrefix,
+		Order:           convertListOrder(req.Msg.Order),
+		PageSize:        req.Msg.PageSize,
+		PageToken:       req.Msg.PageToken,
+	}
+	if req.Msg.Status != consolev1.KeyStatus_KEY_STATUS_UNSPECIFIED {
+		status, err := convertKeyStatus(req.Msg.Status)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		input.Status = status
 	}
 
-	protoKeys := lo.Map(keys, func(key model.Key, _ int) *consolev1.Key {
-		return &consolev1.Key{
-			Id:        key.ID.String(),
-			KeyNumber: key.KeyNumber.String(),
-			RoomId:    key.RoomID.String(),
-			Status:    convertToProtoKeyStatus(key.Status),
+	output, err := h.useCase.GetKeysByRoom(ctx, input)
+	if err != nil {
+		if errors.Is(err, domainerrors.ErrValidation) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
 		}
-	})
+		h.l.Error("failed to get keys by room", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to get keys by room"))
+	}
 
 	return connect.NewResponse(&consolev1.GetKeysByRoomResponse{
-		Keys: protoKeys,
+		Keys:          lo.Map(output.Keys, convertKeyToProto),
+		NextPageToken: output.NextPageToken,
 	}), nil
 }
+
+func convertKeyToProto(key model.Key, _ int) *consolev1.Key {
+	return &consolev1.Key{
+		Id:        key.ID.String(),
+		KeyNumber: key.KeyNumber.String(),
+		RoomId:    key.RoomID.String(),
+		Status:    convertToProtoKeyStatus(key.Status),
+		Version:   key.Version,
+	}
+}
+
+func convertToProtoKeyChangeOperation(operation model.KeyChangeOperation) consolev1.KeyChangeOperation {
+	switch operation {
+	case model.KeyChangeOperationCreated:
+		return consolev1.KeyChangeOperation_KEY_CHANGE_OPERATION_CREATED
+	case model.KeyChangeOperationUpdated:
+		return consolev1.KeyChangeOperation_KEY_CHANGE_OPERATION_UPDATED
+	case model.KeyChangeOperationDeleted:
+		return consolev1.KeyChangeOperation_KEY_CHANGE_OPERATION_DELETED
+	default:
+		return consolev1.KeyChangeOperation_KEY_CHANGE_OPERATION_UNSPECIFIED
+	}
+}
+
+func convertWatchKeysEventToProto(event dto.WatchKeysEvent) *consolev1.WatchKeysResponse {
+	switch event.Type {
+	case dto.WatchKeysEventSnapshot:
+		return &consolev1.WatchKeysResponse{
+			Event: &consolev1.WatchKeysResponse_Snapshot{
+				Snapshot: &consolev1.KeySnapshot{Keys: lo.Map(event.Snapshot, convertKeyToProto)},
+			},
+		}
+	case dto.WatchKeysEventChange:
+		return &consolev1.WatchKeysResponse{
+			Event: &consolev1.WatchKeysResponse_Change{
+				Change: &consolev1.KeyChange{
+					Operation: convertToProtoKeyChangeOperation(event.Change.Operation),
+					Key:       convertKeyToProto(event.Change.Key, 0),
+					ChangedAt: timestamppb.New(event.Change.Key.UpdatedAt),
+				},
+			},
+		}
+	default:
+		return &consolev1.WatchKeysResponse{
+			Event: &consolev1.WatchKeysResponse_Heartbeat{Heartbeat: &consolev1.KeyWatchHeartbeat{}},
+		}
+	}
+}
+
+func (h *Handler) WatchKeys(
+	ctx context.Context,
+	req *connect.Request[consolev1.WatchKeysRequest],
+	stream *connect.ServerStream[consolev1.WatchKeysResponse],
+) error {
+	orgID, ok := domain.Value[model.OrganizationID](ctx)
+	if !ok {
+		return connect.NewError(connect.CodeUnauthenticated, errors.WithMessage(domainerrors.ErrNotFound, "organization not found"))
+	}
+
+	input := dto.WatchKeysInput{OrganizationID: orgID}
+	switch scope := req.Msg.Scope.(type) {
+	case *consolev1.WatchKeysRequest_RoomId:
+		roomID, err := model.ParseRoomID(scope.RoomId)
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, errors.Wrap(err, "invalid room ID"))
+		}
+		input.RoomID = &roomID
+	case *consolev1.WatchKeysRequest_TenantId:
+		tenantID, err := model.ParseTenantID(scope.TenantId)
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, errors.Wrap(err, "invalid tenant ID"))
+		}
+		input.TenantID = &tenantID
+	}
+
+	err := h.useCase.WatchKeys(ctx, input, func(event dto.WatchKeysEvent) error {
+		return stream.Send(convertWatchKeysEventToProto(event))
+	})
+	if err != nil {
+		// クライアントが切断して送信できなくなった場合は正常に終了する
+		if ctx.Err() != nil {
+			return nil
+		}
+		switch {
+		case errors.Is(err, domainerrors.ErrValidation):
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		case errors.Is(err, domainerrors.ErrNotFound):
+			return connect.NewError(connect.CodeNotFound, err)
+		case errors.Is(err, keywatch.ErrInterrupted):
+			return connect.NewError(connect.CodeUnavailable, err)
+		}
+		h.l.Error("failed to watch keys", "error", err)
+		return connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to watch keys"))
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package v1
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"github.com/shibayama-club/keyhub/internal/domain"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	consolev1 "github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1"
+	"github.com/shibayama-club/keyhub/internal/usecase/console/dto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func convertOperatorToProto(o model.ConsoleOperator) *consolev1.Operator {
+	return &consolev1.Operator{
+		Id:        o.ID.String(),
+		Name:      o.Name.String(),
+		Role:      o.Role.String(),
+		CreatedAt: timestamppb.New(o.CreatedAt),
+	}
+}
+
+func (h *Handler) CreateOperator(
+	ctx context.Context,
+	req *connect.Request[consolev1.CreateOperatorRequest],
+) (*connect.Response[consolev1.CreateOperatorResponse], error) {
+	orgID, ok := domain.Value[model.OrganizationID](ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.WithMessage(domainerrors.ErrNotFound, "organization not found"))
+	}
+
+	output, err := h.useCase.CreateOperator(ctx, dto.CreateOperatorInput{
+		OrganizationID: orgID,
+		Name:           req.Msg.Name,
+		Role:           req.Msg.Role,
+	})
+	if err != nil {
+		if errors.Is(err, domainerrors.ErrValidation) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		h.l.Error("failed to create console operator", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to create console operator"))
+	}
+
+	return connect.NewResponse(&consolev1.CreateOperatorResponse{
+		Operator: convertOperatorToProto(output.Operator),
+		Key:      output.Key.String(),
+	}), nil
+}
+
+func (h *Handler) ListOperators(
+	ctx context.Context,
+	req *connect.Request[consolev1.ListOperatorsRequest],
+) (*connect.Response[consolev1.ListOperatorsResponse], error) {
+	orgID, ok := domain.Value[model.OrganizationID](ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.WithMessage(domainerrors.ErrNotFound, "organization not found"))
+	}
+
+	operators, err := h.useCase.ListOperators(ctx, orgID)
+	if err != nil {
+		h.l.Error("failed to list console operators", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to list console operators"))
+	}
+
+	return connect.NewResponse(&consolev1.ListOperatorsResponse{
+		Operators: lo.Map(operators, func(o model.ConsoleOperator, _ int) *consolev1.Operator {
+			return convertOperatorToProto(o)
+		}),
+	}), nil
+}
+
+func (h *Handler) RevokeOperator(
+	ctx context.Context,
+	req *connect.Request[consolev1.RevokeOperatorRequest],
+) (*connect.Response[consolev1.RevokeOperatorResponse], error) {
+	orgID, ok := domain.Value[model.OrganizationID](ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.WithMessage(domainerrors.ErrNotFound, "organization not found"))
+	}
+
+	if err := h.useCase.RevokeOperator(ctx, orgID, req.Msg.Id); err != nil {
+		switch {
+		case errors.Is(err, domainerrors.ErrValidation):
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		case errors.Is(err, domainerrors.ErrNotFound):
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		h.l.Error("failed to revoke console operator", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to revoke console operator"))
+	}
+
+	return connect.NewResponse(&consolev1.RevokeOperatorResponse{}), nil
+}
@@ -0,0 +1,128 @@
+package v1
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"github.com/shibayama-club/keyhub/internal/domain"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	consolev1 "github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1"
+	"github.com/shibayama-club/keyhub/internal/usecase/console/dto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func convertOrganizationToProto(o model.Organization) *consolev1.Organization {
+	return &consolev1.Organization{
+		Id:   o.ID.String(),
+		Name: o.Name.String(),
+		Slug: o.Slug.String(),
+		Settings: &consolev1.OrganizationSettings{
+			Locale:   o.Settings.Locale,
+			Timezone: o.Settings.Timezone,
+		},
+		HasKey:    o.HasKey,
+		CreatedAt: timestamppb.New(o.CreatedAt),
+	}
+}
+
+func (h *Handler) GetCurrentOrganization(
+	ctx context.Context,
+	req *connect.Request[consolev1.GetCurrentOrganizationRequest],
+) (*connect.Response[consolev1.GetCurrentOrganizationResponse], error) {
+	orgID, ok := domain.Value[model.OrganizationID](ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.WithMessage(domainerrors.ErrNotFound, "organization not found"))
+	}
+
+	organization, err := h.useCase.GetOrganization(ctx, orgID)
+	if err != nil {
+		if errors.Is(err, domainerrors.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		h.l.Error("failed to get organization", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to get organization"))
+	}
+
+	res := &consolev1.GetCurrentOrganizationResponse{
+		Organization: convertOrganizationToProto(organization),
+	}
+	// APIトークンで呼び出した場合はロールを持たないため、権限はスコープで判断する
+	if role, ok := domain.Value[model.ConsoleRole](ctx); ok {
+		res.Role = role.String()
+		res.Permissions = lo.Map(role.Permissions(), func(p model.ConsolePermission, _ int) string {
+			return p.String()
+		})
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func (h *Handler) CreateOrganization(
+	ctx context.Context,
+	req *connect.Request[consolev1.CreateOrganizationRequest],
+) (*connect.Response[consolev1.CreateOrganizationResponse], error) {
+	output, err := h.useCase.CreateOrganization(ctx, dto.CreateOrganizationInput{
+		Name: req.Msg.Name,
+		Slug: req.Msg.Slug,
+		Settings: model.OrganizationSettings{
+			Locale:   req.Msg.GetSettings().GetLocale(),
+			Timezone: req.Msg.GetSettings().GetTimezone(),
+		},
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domainerrors.ErrValidation):
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		case errors.Is(err, domainerrors.ErrAlreadyExists):
+			return nil, connect.NewError(connect.CodeAlreadyExists, err)
+		}
+		h.l.Error("failed to create organization", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to create organization"))
+	}
+
+	return connect.NewResponse(&consolev1.CreateOrganizationResponse{
+		Organization:    convertOrganizationToProto(output.Organization),
+		OrganizationKey: output.Key.String(),
+	}), nil
+}
+
+func (h *Handler) ListOrganizations(
+	ctx context.Context,
+	req *connect.Request[consolev1.ListOrganizationsRequest],
+) (*connect.Response[consolev1.ListOrganizationsResponse], error) {
+	organizations, err := h.useCase.ListOrganizations(ctx)
+	if err != nil {
+		h.l.Error("failed to list organizations", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to list organizations"))
+	}
+
+	return connect.NewResponse(&consolev1.ListOrganizationsResponse{
+		Organizations: lo.Map(organizations, func(o model.Organization, _ int) *consolev1.Organization {
+			return convertOrganizationToProto(o)
+		}),
+	}), nil
+}
+
+func (h *Handler) RotateOrganizationKey(
+	ctx context.Context,
+	req *connect.Request[consolev1.RotateOrganizationKeyRequest],
+) (*connect.Response[consolev1.RotateOrganizationKeyResponse], error) {
+	key, err := h.useCase.RotateOrganizationKey(ctx, req.Msg.Id)
+	if err != nil {
+		switch {
+		case errors.Is(err, domainerrors.ErrValidation):
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		case errors.Is(err, domainerrors.ErrNotFound):
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		h.l.Error("failed to rotate organization key", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to rotate organization key"))
+	}
+
+	return connect.NewResponse(&consolev1.RotateOrganizationKeyResponse{
+		OrganizationKey: key.String(),
+	}), nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/shibayama-club/keyhub/internal/domain/model"
 	consolev1 "github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1"
 	"github.com/shibayama-club/keyhub/internal/usecase/console/dto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func convertRoomType(protoType consolev1.RoomType) (string, error) {
@@ -41,22 +42,38 @@ func (h *Handler) CreateRoom(
 		return nil, connect.NewError(connect.CodeUnauthenticated, errors.WithMessage(domainerrors.ErrNotFound, "organization not found"))
 	}
 
-	roomTypeStr, err := convertRoomType(req.Msg.RoomType)
+	roomTypeStr, err := roomTypeFromRequest(req.Msg.RoomType, req.Msg.RoomTypeKey)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
+	floorID, err := model.ParseFloorID(req.Msg.FloorId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrap(err, "invalid floor ID"))
+	}
+
 	input := dto.CreateRoomInput{
 		OrganizationID: orgID,
 		Name:           req.Msg.Name,
-		BuildingName:   req.Msg.BuildingName,
-		FloorNumber:    req.Msg.FloorNumber,
+		FloorID:        floorID,
 		RoomType:       roomTypeStr,
 		Description:    req.Msg.Description,
 	}
+	if req.Msg.Attributes != nil {
+		input.Attributes, err = convertRoomAttributesInput(req.Msg.Attributes)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
 
 	roomID, err := h.useCase.CreateRoom(ctx, input)
 	if err != nil {
+		switch {
+		case errors.Is(err, domainerrors.ErrValidation):
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		case errors.Is(err, domainerrors.ErrNotFound):
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
@@ -84,6 +101,22 @@ func (h *Handler) AssignRoomToTenant(
 		RoomID:   roomID,
 	}
 
+	if req.Msg.GroupId != nil {
+		groupID, err := model.ParseTenantGroupID(*req.Msg.GroupId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrap(err, "invalid group ID"))
+		}
+		input.GroupID = &groupID
+	}
+
+	if req.Msg.KeyLoanGroupId != nil {
+		keyLoanGroupID, err := model.ParseTenantGroupID(*req.Msg.KeyLoanGroupId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrap(err, "invalid key loan group ID"))
+		}
+		input.KeyLoanGroupID = &keyLoanGroupID
+	}
+
 	if req.Msg.ExpiresAt != nil {
 		expiryTime := req.Msg.ExpiresAt.AsTime()
 		input.ExpiresAt = &expiryTime
@@ -91,6 +124,12 @@ func (h *Handler) AssignRoomToTenant(
 
 	assignmentID, err := h.useCase.AssignRoomToTenant(ctx, input)
 	if err != nil {
+		switch {
+		case errors.Is(err, domainerrors.ErrValidation):
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		case errors.Is(err, domainerrors.ErrNotFound):
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
@@ -122,39 +161,286 @@ func (h *Handler) GetAllRooms(
 	ctx context.Context,
 	req *connect.Request[consolev1.GetAllRoomsRequest],
 ) (*connect.Response[consolev1.GetAllRoomsResponse], error) {
-	rooms, err := h.useCase.GetAllRooms(ctx)
+	input := dto.GetAllRoomsInput{
+		BuildingName: req.Msg.BuildingName,
+		FloorNumber:  req.Msg.FloorNumber,
+		NamePrefix:   req.Msg.NamePrefix,
+		Order:        convertListOrder(req.Msg.Order),
+		PageSize:     req.Msg.PageSize,
+		PageToken:    req.Msg.PageToken,
+		GroupByFloor: req.Msg.GroupByFloor,
+		MinCapacity:  req.Msg.MinCapacity,
+		Equipment:    req.Msg.Equipment,
+		CustomFields: req.Msg.CustomFields,
+	}
+	accessibility, err := convertRoomAccessibilityList(req.Msg.Accessibility)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
-
-	protoRooms := make([]*consolev1.Room, 0, len(rooms))
-	for _, room := range rooms {
-		keys, err := h.useCase.GetKeysByRoom(ctx, room.ID)
+	input.Accessibility = accessibility
+	if req.Msg.BuildingId != nil {
+		buildingID, err := model.ParseBuildingID(*req.Msg.BuildingId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrap(err, "invalid building ID"))
+		}
+		input.BuildingID = &buildingID
+	}
+	if req.Msg.FloorId != nil {
+		floorID, err := model.ParseFloorID(*req.Msg.FloorId)
 		if err != nil {
-			return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to get keys for room"))
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrap(err, "invalid floor ID"))
+		}
+		input.FloorID = &floorID
+	}
+	if req.Msg.RoomType != consolev1.RoomType_ROOM_TYPE_UNSPECIFIED || req.Msg.RoomTypeKey != "" {
+		roomType, err := roomTypeFromRequest(req.Msg.RoomType, req.Msg.RoomTypeKey)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		input.RoomType = roomType
+	}
+
+	output, err := h.useCase.GetAllRooms(ctx, input)
+	if err != nil {
+		if errors.Is(err, domainerrors.ErrValidation) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
 		}
+		h.l.Error("failed to get all rooms", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to get all rooms"))
+	}
 
-		protoKeys := lo.Map(keys, func(key model.Key, _ int) *consolev1.Key {
-			return &consolev1.Key{
-				Id:        key.ID.String(),
-				KeyNumber: key.KeyNumber.String(),
-				RoomId:    key.RoomID.String(),
-				Status:    convertToProtoKeyStatus(key.Status),
+	return connect.NewResponse(&consolev1.GetAllRoomsResponse{
+		Rooms:         lo.Map(output.Rooms, convertRoomToProto),
+		NextPageToken: output.NextPageToken,
+		Buildings: lo.Map(output.Buildings, func(b dto.BuildingRooms, _ int) *consolev1.BuildingRooms {
+			return &consolev1.BuildingRooms{
+				Building: convertBuildingToProto(b.Building),
+				Floors: lo.Map(b.Floors, func(f dto.FloorRooms, _ int) *consolev1.FloorRooms {
+					return &consolev1.FloorRooms{
+						Floor: convertFloorToProto(f.Floor),
+						Rooms: lo.Map(f.Rooms, convertRoomToProto),
+					}
+				}),
 			}
-		})
+		}),
+	}), nil
+}
+
+func convertRoomToProto(room dto.RoomWithKeys, _ int) *consolev1.Room {
+	return &consolev1.Room{
+		Id:           room.Room.ID.String(),
+		Name:         room.Room.Name.String(),
+		BuildingName: room.Room.BuildingName.String(),
+		FloorNumber:  room.Room.FloorNumber.String(),
+		RoomType:     convertToProtoRoomType(room.Room.Type),
+		RoomTypeKey:  room.Room.Type.String(),
+		Description:  room.Room.Description.String(),
+		Keys:         lo.Map(room.Keys, convertKeyToProto),
+		FloorId:      room.Room.FloorID.String(),
+		Attributes:   convertRoomAttributesToProto(room.Room.Attributes),
+		Version:      room.Room.Version,
+	}
+}
 
-		protoRooms = append(protoRooms, &consolev1.Room{
-			Id:           room.ID.String(),
-			Name:         room.Name.String(),
-			BuildingName: room.BuildingName.String(),
-			FloorNumber:  room.FloorNumber.String(),
-			RoomType:     convertToProtoRoomType(room.Type),
-			Description:  room.Description.String(),
-			Keys:         protoKeys,
-		})
+func (h *Handler) UpdateRoomAttributes(
+	ctx context.Context,
+	req *connect.Request[consolev1.UpdateRoomAttributesRequest],
+) (*connect.Response[consolev1.UpdateRoomAttributesResponse], error) {
+	roomID, err := model.ParseRoomID(req.Msg.RoomId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrap(err, "invalid room ID"))
 	}
 
-	return connect.NewResponse(&consolev1.GetAllRoomsResponse{
-		Rooms: protoRooms,
+	input := dto.UpdateRoomAttributesInput{RoomID: roomID, Version: req.Msg.Version}
+	if req.Msg.Attributes != nil {
+		input.Attributes, err = convertRoomAttributesInput(req.Msg.Attributes)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+
+	room, err := h.useCase.UpdateRoomAttributes(ctx, input)
+	if err != nil {
+		return nil, h.roomError(err, "failed to update room attributes")
+	}
+
+	return connect.NewResponse(&consolev1.UpdateRoomAttributesResponse{
+		Room: convertRoomToProto(dto.RoomWithKeys{Room: room}, 0),
 	}), nil
 }
+
+func (h *Handler) CreateRoomCustomField(
+	ctx context.Context,
+	req *connect.Request[consolev1.CreateRoomCustomFieldRequest],
+) (*connect.Response[consolev1.CreateRoomCustomFieldResponse], error) {
+	orgID, ok := domain.Value[model.OrganizationID](ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.WithMessage(domainerrors.ErrNotFound, "organization not found"))
+	}
+
+	fieldType, err := convertRoomCustomFieldType(req.Msg.Type)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	field, err := h.useCase.CreateRoomCustomField(ctx, dto.CreateRoomCustomFieldInput{
+		OrganizationID: orgID,
+		Key:            req.Msg.Key,
+		Label:          req.Msg.Label,
+		Type:           fieldType,
+		Required:       req.Msg.Required,
+		Options:        req.Msg.Options,
+	})
+	if err != nil {
+		return nil, h.roomError(err, "failed to create room custom field")
+	}
+
+	return connect.NewResponse(&consolev1.CreateRoomCustomFieldResponse{
+		Field: convertRoomCustomFieldToProto(field, 0),
+	}), nil
+}
+
+func (h *Handler) ListRoomCustomFields(
+	ctx context.Context,
+	_ *connect.Request[consolev1.ListRoomCustomFieldsRequest],
+) (*connect.Response[consolev1.ListRoomCustomFieldsResponse], error) {
+	schema, err := h.useCase.ListRoomCustomFields(ctx)
+	if err != nil {
+		return nil, h.roomError(err, "failed to list room custom fields")
+	}
+
+	return connect.NewResponse(&consolev1.ListRoomCustomFieldsResponse{
+		Fields: lo.Map(schema, convertRoomCustomFieldToProto),
+	}), nil
+}
+
+func (h *Handler) DeleteRoomCustomField(
+	ctx context.Context,
+	req *connect.Request[consolev1.DeleteRoomCustomFieldRequest],
+) (*connect.Response[consolev1.DeleteRoomCustomFieldResponse], error) {
+	id, err := model.ParseRoomCustomFieldID(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrap(err, "invalid room custom field ID"))
+	}
+
+	if err := h.useCase.DeleteRoomCustomField(ctx, id); err != nil {
+		return nil, h.roomError(err, "failed to delete room custom field")
+	}
+
+	return connect.NewResponse(&consolev1.DeleteRoomCustomFieldResponse{}), nil
+}
+
+func (h *Handler) roomError(err error, msg string) error {
+	switch {
+	case errors.Is(err, domainerrors.ErrValidation):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, domainerrors.ErrNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, domainerrors.ErrAlreadyExists):
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	case errors.Is(err, domainerrors.ErrConflict):
+		return connect.NewError(connect.CodeAborted, err)
+	}
+	h.l.Error(msg, "error", err)
+	return connect.NewError(connect.CodeInternal, errors.Wrap(err, msg))
+}
+
+func convertRoomAttributesInput(attributes *consolev1.RoomAttributes) (dto.RoomAttributesInput, error) {
+	accessibility, err := convertRoomAccessibilityList(attributes.Accessibility)
+	if err != nil {
+		return dto.RoomAttributesInput{}, err
+	}
+	return dto.RoomAttributesInput{
+		Capacity:      attributes.Capacity,
+		Equipment:     attributes.Equipment,
+		Accessibility: accessibility,
+		CustomFields:  attributes.CustomFields,
+	}, nil
+}
+
+func convertRoomAttributesToProto(attributes model.RoomAttributes) *consolev1.RoomAttributes {
+	return &consolev1.RoomAttributes{
+		Capacity:  int32(attributes.Capacity),
+		Equipment: lo.Map(attributes.Equipment, func(e model.Equipment, _ int) string { return e.String() }),
+		Accessibility: lo.Map(attributes.Accessibility, func(a model.RoomAccessibility, _ int) consolev1.RoomAccessibility {
+			return convertToProtoRoomAccessibility(a)
+		}),
+		CustomFields: attributes.CustomFields,
+	}
+}
+
+func convertRoomAccessibilityList(values []consolev1.RoomAccessibility) ([]string, error) {
+	accessibility := make([]string, 0, len(values))
+	for _, v := range values {
+		switch v {
+		case consolev1.RoomAccessibility_ROOM_ACCESSIBILITY_WHEELCHAIR:
+			accessibility = append(accessibility, model.RoomAccessibilityWheelchair.String())
+		case consolev1.RoomAccessibility_ROOM_ACCESSIBILITY_STEP_FREE:
+			accessibility = append(accessibility, model.RoomAccessibilityStepFree.String())
+		case consolev1.RoomAccessibility_ROOM_ACCESSIBILITY_ACCESSIBLE_RESTROOM:
+			accessibility = append(accessibility, model.RoomAccessibilityAccessibleRestroom.String())
+		case consolev1.RoomAccessibility_ROOM_ACCESSIBILITY_HEARING_LOOP:
+			accessibility = append(accessibility, model.RoomAccessibilityHearingLoop.String())
+		default:
+			return nil, errors.New("invalid room accessibility")
+		}
+	}
+	return accessibility, nil
+}
+
+func convertToProtoRoomAccessibility(accessibility model.RoomAccessibility) consolev1.RoomAccessibility {
+	switch accessibility {
+	case model.RoomAccessibilityWheelchair:
+		return consolev1.RoomAccessibility_ROOM_ACCESSIBILITY_WHEELCHAIR
+	case model.RoomAccessibilityStepFree:
+		return consolev1.RoomAccessibility_ROOM_ACCESSIBILITY_STEP_FREE
+	case model.RoomAccessibilityAccessibleRestroom:
+		return consolev1.RoomAccessibility_ROOM_ACCESSIBILITY_ACCESSIBLE_RESTROOM
+	case model.RoomAccessibilityHearingLoop:
+		return consolev1.RoomAccessibility_ROOM_ACCESSIBILITY_HEARING_LOOP
+	default:
+		return consolev1.RoomAccessibility_ROOM_ACCESSIBILITY_UNSPECIFIED
+	}
+}
+
+func convertRoomCustomFieldType(protoType consolev1.RoomCustomFieldType) (string, error) {
+	switch protoType {
+	case consolev1.RoomCustomFieldType_ROOM_CUSTOM_FIELD_TYPE_TEXT:
+		return model.RoomCustomFieldTypeText.String(), nil
+	case consolev1.RoomCustomFieldType_ROOM_CUSTOM_FIELD_TYPE_NUMBER:
+		return model.RoomCustomFieldTypeNumber.String(), nil
+	case consolev1.RoomCustomFieldType_ROOM_CUSTOM_FIELD_TYPE_BOOLEAN:
+		return model.RoomCustomFieldTypeBoolean.String(), nil
+	case consolev1.RoomCustomFieldType_ROOM_CUSTOM_FIELD_TYPE_SELECT:
+		return model.RoomCustomFieldTypeSelect.String(), nil
+	default:
+		return "", errors.New("invalid room custom field type")
+	}
+}
+
+func convertToProtoRoomCustomFieldType(fieldType model.RoomCustomFieldType) consolev1.RoomCustomFieldType {
+	switch fieldType {
+	case model.RoomCustomFieldTypeText:
+		return consolev1.RoomCustomFieldType_ROOM_CUSTOM_FIELD_TYPE_TEXT
+	case model.RoomCustomFieldTypeNumber:
+		return consolev1.RoomCustomFieldType_ROOM_CUSTOM_FIELD_TYPE_NUMBER
+	case model.RoomCustomFieldTypeBoolean:
+		return consolev1.RoomCustomFieldType_ROOM_CUSTOM_FIELD_TYPE_BOOLEAN
+	case model.RoomCustomFieldTypeSelect:
+		return consolev1.RoomCustomFieldType_ROOM_CUSTOM_FIELD_TYPE_SELECT
+	default:
+		return consolev1.RoomCustomFieldType_ROOM_CUSTOM_FIELD_TYPE_UNSPECIFIED
+	}
+}
+
+func convertRoomCustomFieldToProto(field model.RoomCustomField, _ int) *consolev1.RoomCustomField {
+	return &consolev1.RoomCustomField{
+		Id:        field.ID.String(),
+		Key:       field.Key.String(),
+		Label:     field.Label,
+		Type:      convertToProtoRoomCustomFieldType(field.Type),
+		Required:  field.Required,
+		Options:   field.Options,
+		CreatedAt: timestamppb.New(field.CreatedAt),
+	}
+}
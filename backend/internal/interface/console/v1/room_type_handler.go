@@ -0,0 +1,90 @@
+package v1
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"github.com/shibayama-club/keyhub/internal/domain"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	consolev1 "github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1"
+	"github.com/shibayama-club/keyhub/internal/usecase/console/dto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// roomTypeFromRequest はリクエストの部屋タイプを model の値にする。組織が追加したタイプのキーを指定した場合はそれを優先する
+func roomTypeFromRequest(protoType consolev1.RoomType, key string) (string, error) {
+	if key != "" {
+		return key, nil
+	}
+	return convertRoomType(protoType)
+}
+
+func convertRoomTypeDefinitionToProto(definition model.RoomTypeDefinition, _ int) *consolev1.RoomTypeDefinition {
+	protoDefinition := &consolev1.RoomTypeDefinition{
+		Key:      definition.Key.String(),
+		Label:    definition.Label,
+		BuiltIn:  definition.BuiltIn,
+		RoomType: convertToProtoRoomType(definition.Key),
+	}
+	if !definition.BuiltIn {
+		protoDefinition.Id = definition.ID.String()
+		protoDefinition.CreatedAt = timestamppb.New(definition.CreatedAt)
+	}
+	return protoDefinition
+}
+
+func (h *Handler) ListRoomTypes(
+	ctx context.Context,
+	_ *connect.Request[consolev1.ListRoomTypesRequest],
+) (*connect.Response[consolev1.ListRoomTypesResponse], error) {
+	definitions, err := h.useCase.ListRoomTypes(ctx)
+	if err != nil {
+		return nil, h.roomError(err, "failed to list room types")
+	}
+
+	return connect.NewResponse(&consolev1.ListRoomTypesResponse{
+		RoomTypes: lo.Map(definitions, convertRoomTypeDefinitionToProto),
+	}), nil
+}
+
+func (h *Handler) CreateRoomType(
+	ctx context.Context,
+	req *connect.Request[consolev1.CreateRoomTypeRequest],
+) (*connect.Response[consolev1.CreateRoomTypeResponse], error) {
+	orgID, ok := domain.Value[model.OrganizationID](ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.WithMessage(domainerrors.ErrNotFound, "organization not found"))
+	}
+
+	definition, err := h.useCase.CreateRoomType(ctx, dto.CreateRoomTypeInput{
+		OrganizationID: orgID,
+		Key:            req.Msg.Key,
+		Label:          req.Msg.Label,
+	})
+	if err != nil {
+		return nil, h.roomError(err, "failed to create room type")
+	}
+
+	return connect.NewResponse(&consolev1.CreateRoomTypeResponse{
+		RoomType: convertRoomTypeDefinitionToProto(definition, 0),
+	}), nil
+}
+
+func (h *Handler) DeleteRoomType(
+	ctx context.Context,
+	req *connect.Request[consolev1.DeleteRoomTypeRequest],
+) (*connect.Response[consolev1.DeleteRoomTypeResponse], error) {
+	id, err := model.ParseRoomTypeID(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrap(err, "invalid room type ID"))
+	}
+
+	if err := h.useCase.DeleteRoomType(ctx, id); err != nil {
+		return nil, h.roomError(err, "failed to delete room type")
+	}
+
+	return connect.NewResponse(&consolev1.DeleteRoomTypeResponse{}), nil
+}
@@ -0,0 +1,95 @@
+package v1
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"github.com/shibayama-club/keyhub/internal/domain"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	consolev1 "github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1"
+	"github.com/shibayama-club/keyhub/internal/usecase/console/dto"
+)
+
+func convertSearchResultType(protoType consolev1.SearchResultType) (string, error) {
+	switch protoType {
+	case consolev1.SearchResultType_SEARCH_RESULT_TYPE_ROOM:
+		return model.SearchResultTypeRoom.String(), nil
+	case consolev1.SearchResultType_SEARCH_RESULT_TYPE_KEY:
+		return model.SearchResultTypeKey.String(), nil
+	case consolev1.SearchResultType_SEARCH_RESULT_TYPE_TENANT:
+		return model.SearchResultTypeTenant.String(), nil
+	case consolev1.SearchResultType_SEARCH_RESULT_TYPE_MEMBER:
+		return model.SearchResultTypeMember.String(), nil
+	default:
+		return "", errors.New("invalid search result type")
+	}
+}
+
+func convertToProtoSearchResultType(t model.SearchResultType) consolev1.SearchResultType {
+	switch t {
+	case model.SearchResultTypeRoom:
+		return consolev1.SearchResultType_SEARCH_RESULT_TYPE_ROOM
+	case model.SearchResultTypeKey:
+		return consolev1.SearchResultType_SEARCH_RESULT_TYPE_KEY
+	case model.SearchResultTypeTenant:
+		return consolev1.SearchResultType_SEARCH_RESULT_TYPE_TENANT
+	case model.SearchResultTypeMember:
+		return consolev1.SearchResultType_SEARCH_RESULT_TYPE_MEMBER
+	default:
+		return consolev1.SearchResultType_SEARCH_RESULT_TYPE_UNSPECIFIED
+	}
+}
+
+func convertSearchResultToProto(r model.SearchResult, _ int) *consolev1.SearchResult {
+	result := &consolev1.SearchResult{
+		Type:     convertToProtoSearchResultType(r.Type),
+		Id:       r.ID.String(),
+		Title:    r.Title,
+		Subtitle: r.Subtitle,
+		Score:    r.Score,
+	}
+	if r.ParentID != nil {
+		result.ParentId = r.ParentID.String()
+	}
+	return result
+}
+
+func (h *Handler) Search(
+	ctx context.Context,
+	req *connect.Request[consolev1.SearchRequest],
+) (*connect.Response[consolev1.SearchResponse], error) {
+	orgID, ok := domain.Value[model.OrganizationID](ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.WithMessage(domainerrors.ErrNotFound, "organization not found"))
+	}
+
+	types := make([]string, 0, len(req.Msg.Types))
+	for _, protoType := range req.Msg.Types {
+		t, err := convertSearchResultType(protoType)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		types = append(types, t)
+	}
+
+	results, err := h.useCase.Search(ctx, dto.SearchInput{
+		OrganizationID: orgID,
+		Query:          req.Msg.Query,
+		Types:          types,
+		Limit:          req.Msg.Limit,
+	})
+	if err != nil {
+		if errors.Is(err, domainerrors.ErrValidation) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		h.l.Error("failed to search", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to search"))
+	}
+
+	return connect.NewResponse(&consolev1.SearchResponse{
+		Results: lo.Map(results, convertSearchResultToProto),
+	}), nil
+}
@@ -0,0 +1,89 @@
+package v1
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"github.com/shibayama-club/keyhub/internal/domain"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	consolev1 "github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *Handler) ListSessions(
+	ctx context.Context,
+	req *connect.Request[consolev1.ListSessionsRequest],
+) (*connect.Response[consolev1.ListSessionsResponse], error) {
+	orgID, ok := domain.Value[model.OrganizationID](ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.WithMessage(domainerrors.ErrNotFound, "organization not found"))
+	}
+	currentSessionID, _ := domain.Value[model.ConsoleSessionID](ctx)
+
+	sessions, err := h.useCase.ListSessions(ctx, orgID)
+	if err != nil {
+		h.l.Error("failed to list console sessions", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to list sessions"))
+	}
+
+	return connect.NewResponse(&consolev1.ListSessionsResponse{
+		Sessions: lo.Map(sessions, func(s model.ConsoleSession, _ int) *consolev1.Session {
+			return &consolev1.Session{
+				Id:         s.SessionID.String(),
+				UserAgent:  s.Client.UserAgent,
+				IpAddress:  s.Client.IPAddress,
+				CreatedAt:  timestamppb.New(s.CreatedAt),
+				LastSeenAt: timestamppb.New(s.LastSeenAt),
+				ExpiresAt:  timestamppb.New(s.ExpiresAt),
+				Current:    s.SessionID == currentSessionID,
+			}
+		}),
+	}), nil
+}
+
+func (h *Handler) RevokeSession(
+	ctx context.Context,
+	req *connect.Request[consolev1.RevokeSessionRequest],
+) (*connect.Response[consolev1.RevokeSessionResponse], error) {
+	orgID, ok := domain.Value[model.OrganizationID](ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.WithMessage(domainerrors.ErrNotFound, "organization not found"))
+	}
+
+	if err := h.useCase.RevokeSession(ctx, orgID, req.Msg.SessionId); err != nil {
+		if errors.Is(err, domainerrors.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		h.l.Error("failed to revoke console session", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to revoke session"))
+	}
+
+	return connect.NewResponse(&consolev1.RevokeSessionResponse{}), nil
+}
+
+func (h *Handler) RevokeAllOtherSessions(
+	ctx context.Context,
+	req *connect.Request[consolev1.RevokeAllOtherSessionsRequest],
+) (*connect.Response[consolev1.RevokeAllOtherSessionsResponse], error) {
+	orgID, ok := domain.Value[model.OrganizationID](ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.WithMessage(domainerrors.ErrNotFound, "organization not found"))
+	}
+	sessionID, ok := domain.Value[model.ConsoleSessionID](ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("session not found"))
+	}
+
+	revoked, err := h.useCase.RevokeAllOtherSessions(ctx, orgID, sessionID)
+	if err != nil {
+		h.l.Error("failed to revoke other console sessions", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to revoke other sessions"))
+	}
+
+	return connect.NewResponse(&consolev1.RevokeAllOtherSessionsResponse{
+		RevokedCount: revoked,
+	}), nil
+}
@@ -0,0 +1,171 @@
+package v1
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	consolev1 "github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1"
+	"github.com/shibayama-club/keyhub/internal/usecase/console/dto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func convertTenantGroupToProto(g model.TenantGroup, memberCount int32) *consolev1.TenantGroup {
+	var parentGroupID *string
+	if g.ParentID != nil {
+		parentGroupID = lo.ToPtr(g.ParentID.String())
+	}
+
+	return &consolev1.TenantGroup{
+		Id:            g.ID.String(),
+		TenantId:      g.TenantID.String(),
+		ParentGroupId: parentGroupID,
+		Name:          g.Name.String(),
+		Description:   g.Description.String(),
+		MemberCount:   memberCount,
+		CreatedAt:     timestamppb.New(g.CreatedAt),
+	}
+}
+
+// tenantGroupError はグループ操作のエラーをConnectのコードに変換する
+func (h *Handler) tenantGroupError(err error, msg string) error {
+	switch {
+	case errors.Is(err, domainerrors.ErrValidation):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, domainerrors.ErrNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, domainerrors.ErrAlreadyExists):
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	}
+	h.l.Error(msg, "error", err)
+	return connect.NewError(connect.CodeInternal, errors.Wrap(err, msg))
+}
+
+func (h *Handler) CreateTenantGroup(
+	ctx context.Context,
+	req *connect.Request[consolev1.CreateTenantGroupRequest],
+) (*connect.Response[consolev1.CreateTenantGroupResponse], error) {
+	tenantID, err := model.ParseTenantID(req.Msg.TenantId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrap(err, "invalid tenant ID"))
+	}
+
+	input := dto.CreateTenantGroupInput{
+		TenantID:    tenantID,
+		Name:        req.Msg.Name,
+		Description: req.Msg.Description,
+	}
+
+	if req.Msg.ParentGroupId != nil {
+		parentGroupID, err := model.ParseTenantGroupID(*req.Msg.ParentGroupId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrap(err, "invalid parent group ID"))
+		}
+		input.ParentGroupID = &parentGroupID
+	}
+
+	group, err := h.useCase.CreateTenantGroup(ctx, input)
+	if err != nil {
+		return nil, h.tenantGroupError(err, "failed to create tenant group")
+	}
+
+	return connect.NewResponse(&consolev1.CreateTenantGroupResponse{
+		Group: convertTenantGroupToProto(group, 0),
+	}), nil
+}
+
+func (h *Handler) ListTenantGroups(
+	ctx context.Context,
+	req *connect.Request[consolev1.ListTenantGroupsRequest],
+) (*connect.Response[consolev1.ListTenantGroupsResponse], error) {
+	tenantID, err := model.ParseTenantID(req.Msg.TenantId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrap(err, "invalid tenant ID"))
+	}
+
+	groups, err := h.useCase.ListTenantGroups(ctx, tenantID)
+	if err != nil {
+		return nil, h.tenantGroupError(err, "failed to list tenant groups")
+	}
+
+	return connect.NewResponse(&consolev1.ListTenantGroupsResponse{
+		Groups: lo.Map(groups, func(g dto.TenantGroupOutput, _ int) *consolev1.TenantGroup {
+			return convertTenantGroupToProto(g.Group, g.MemberCount)
+		}),
+	}), nil
+}
+
+func (h *Handler) AddTenantGroupMember(
+	ctx context.Context,
+	req *connect.Request[consolev1.AddTenantGroupMemberRequest],
+) (*connect.Response[consolev1.AddTenantGroupMemberResponse], error) {
+	groupID, userID, err := parseTenantGroupMember(req.Msg.GroupId, req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.useCase.AddTenantGroupMember(ctx, groupID, userID); err != nil {
+		return nil, h.tenantGroupError(err, "failed to add tenant group member")
+	}
+
+	return connect.NewResponse(&consolev1.AddTenantGroupMemberResponse{}), nil
+}
+
+func (h *Handler) RemoveTenantGroupMember(
+	ctx context.Context,
+	req *connect.Request[consolev1.RemoveTenantGroupMemberRequest],
+) (*connect.Response[consolev1.RemoveTenantGroupMemberResponse], error) {
+	groupID, userID, err := parseTenantGroupMember(req.Msg.GroupId, req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.useCase.RemoveTenantGroupMember(ctx, groupID, userID); err != nil {
+		return nil, h.tenantGroupError(err, "failed to remove tenant group member")
+	}
+
+	return connect.NewResponse(&consolev1.RemoveTenantGroupMemberResponse{}), nil
+}
+
+func (h *Handler) ListTenantGroupMembers(
+	ctx context.Context,
+	req *connect.Request[consolev1.ListTenantGroupMembersRequest],
+) (*connect.Response[consolev1.ListTenantGroupMembersResponse], error) {
+	groupID, err := model.ParseTenantGroupID(req.Msg.GroupId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrap(err, "invalid group ID"))
+	}
+
+	users, err := h.useCase.ListTenantGroupMembers(ctx, groupID)
+	if err != nil {
+		return nil, h.tenantGroupError(err, "failed to list tenant group members")
+	}
+
+	return connect.NewResponse(&consolev1.ListTenantGroupMembersResponse{
+		Members: lo.Map(users, func(u model.User, _ int) *consolev1.TenantGroupMember {
+			return &consolev1.TenantGroupMember{
+				UserId: u.UserId.String(),
+				Name:   u.Name.String(),
+				Email:  u.Email.String(),
+				Icon:   u.Icon.String(),
+			}
+		}),
+	}), nil
+}
+
+func parseTenantGroupMember(groupIDValue, userIDValue string) (model.TenantGroupID, model.UserID, error) {
+	groupID, err := model.ParseTenantGroupID(groupIDValue)
+	if err != nil {
+		return model.TenantGroupID{}, model.UserID{}, connect.NewError(connect.CodeInvalidArgument, errors.Wrap(err, "invalid group ID"))
+	}
+
+	userID, err := model.ParseUserID(userIDValue)
+	if err != nil {
+		return model.TenantGroupID{}, model.UserID{}, connect.NewError(connect.CodeInvalidArgument, errors.Wrap(err, "invalid user ID"))
+	}
+
+	return groupID, userID, nil
+}
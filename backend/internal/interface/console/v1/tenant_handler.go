@@ -9,12 +9,29 @@ import (
 	"github.com/shibayama-club/keyhub/internal/domain"
 	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
 	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/interface/audit"
+	"github.com/shibayama-club/keyhub/internal/interface/clientinfo"
 	consolev1 "github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1"
 	"github.com/shibayama-club/keyhub/internal/usecase/console/dto"
 	"github.com/shibayama-club/keyhub/internal/util"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+func (h *Handler) tenantError(err error, msg string) error {
+	switch {
+	case errors.Is(err, domainerrors.ErrValidation):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, domainerrors.ErrNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, domainerrors.ErrAlreadyExists):
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	case errors.Is(err, domainerrors.ErrConflict):
+		return connect.NewError(connect.CodeAborted, err)
+	}
+	h.l.Error(msg, "error", err)
+	return connect.NewError(connect.CodeInternal, errors.Wrap(err, msg))
+}
+
 // protobuf特有の型からmodelの型に変更
 func convertTenantType(protoType consolev1.TenantType) (string, error) {
 	switch protoType {
@@ -40,7 +57,7 @@ func (h *Handler) CreateTenant(
 		return nil, connect.NewError(connect.CodeUnauthenticated, errors.WithMessage(domainerrors.ErrNotFound, "organization not found"))
 	}
 
-	tenantTypeStr, err := convertTenantType(req.Msg.TenantType)
+	tenantTypeStr, err := tenantTypeFromRequest(req.Msg.TenantType, req.Msg.TenantTypeKey)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
@@ -61,7 +78,7 @@ func (h *Handler) CreateTenant(
 
 	tenantID, err := h.useCase.CreateTenant(ctx, input)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, h.tenantError(err, "failed to create tenant")
 	}
 
 	return connect.NewResponse(&consolev1.CreateTenantResponse{
@@ -86,11 +103,18 @@ func convertModelTenantTypeToProto(modelType model.TenantType) consolev1.TenantT
 }
 
 func convertModelTenantToProto(tenant model.Tenant) *consolev1.Tenant {
+	var archivedAt *timestamppb.Timestamp
+	if tenant.ArchivedAt != nil {
+		archivedAt = timestamppb.New(*tenant.ArchivedAt)
+	}
 	return &consolev1.Tenant{
-		Id:          tenant.ID.String(),
-		Name:        tenant.Name.String(),
-		Description: tenant.Description.String(),
-		TenantType:  convertModelTenantTypeToProto(tenant.Type),
+		Id:            tenant.ID.String(),
+		Name:          tenant.Name.String(),
+		Description:   tenant.Description.String(),
+		TenantType:    convertModelTenantTypeToProto(tenant.Type),
+		TenantTypeKey: tenant.Type.String(),
+		ArchivedAt:    archivedAt,
+		Version:       tenant.Version,
 	}
 }
 
@@ -111,17 +135,36 @@ func (h *Handler) GetAllTenants(
 	ctx context.Context,
 	req *connect.Request[consolev1.GetAllTenantsRequest],
 ) (*connect.Response[consolev1.GetAllTenantsResponse], error) {
-	tenants, err := h.useCase.GetAllTenants(ctx)
+	input := dto.GetAllTenantsInput{
+		NamePrefix: req.Msg.NamePrefix,
+		Order:      convertListOrder(req.Msg.Order),
+		PageSize:   req.Msg.PageSize,
+		PageToken:  req.Msg.PageToken,
+	}
+	if req.Msg.TenantType != consolev1.TenantType_TENANT_TYPE_UNSPECIFIED || req.Msg.TenantTypeKey != "" {
+		tenantType, err := tenantTypeFromRequest(req.Msg.TenantType, req.Msg.TenantTypeKey)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		input.TenantType = tenantType
+	}
+
+	output, err := h.useCase.GetAllTenants(ctx, input)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		if errors.Is(err, domainerrors.ErrValidation) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		h.l.Error("failed to get all tenants", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to get all tenants"))
 	}
 
-	protoTenants := lo.Map(tenants, func(tenant model.Tenant, _ int) *consolev1.Tenant {
+	protoTenants := lo.Map(output.Tenants, func(tenant model.Tenant, _ int) *consolev1.Tenant {
 		return convertModelTenantToProto(tenant)
 	})
 
 	return connect.NewResponse(&consolev1.GetAllTenantsResponse{
-		Tenants: protoTenants,
+		Tenants:       protoTenants,
+		NextPageToken: output.NextPageToken,
 	}), nil
 }
 
@@ -154,7 +197,7 @@ func (h *Handler) UpdateTenant(
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	tenantTypeStr, err := convertTenantType(req.Msg.TenantType)
+	tenantTypeStr, err := tenantTypeFromRequest(req.Msg.TenantType, req.Msg.TenantTypeKey)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
@@ -169,13 +212,55 @@ func (h *Handler) UpdateTenant(
 		JoinCode:       req.Msg.JoinCode,
 		JoinCodeExpiry: joinCodeExpiry,
 		JoinCodeMaxUse: req.Msg.JoinCodeMaxUse,
+		Version:        req.Msg.Version,
 	}
 
-	err = h.useCase.UpdateTenant(ctx, input)
+	version, err := h.useCase.UpdateTenant(ctx, input)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, h.tenantError(err, "failed to update tenant")
+	}
+
+	return connect.NewResponse(&consolev1.UpdateTenantResponse{Version: version}), nil
+
+}
+
+func (h *Handler) ArchiveTenant(
+	ctx context.Context,
+	req *connect.Request[consolev1.ArchiveTenantRequest],
+) (*connect.Response[consolev1.ArchiveTenantResponse], error) {
+	tenantID, err := model.ParseTenantID(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	tenant, err := h.useCase.ArchiveTenant(ctx, tenantID)
+	if err != nil {
+		return nil, h.tenantError(err, "failed to archive tenant")
+	}
+
+	return connect.NewResponse(&consolev1.ArchiveTenantResponse{
+		Tenant: convertModelTenantToProto(tenant),
+	}), nil
+}
+
+// DeleteTenant は操作者と接続元を墓標に残すため、監査ログと同じ方法で判定して渡す
+func (h *Handler) DeleteTenant(
+	ctx context.Context,
+	req *connect.Request[consolev1.DeleteTenantRequest],
+) (*connect.Response[consolev1.DeleteTenantResponse], error) {
+	tenantID, err := model.ParseTenantID(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	return connect.NewResponse(&consolev1.UpdateTenantResponse{}), nil
+	err = h.useCase.DeleteTenant(ctx, dto.DeleteTenantInput{
+		TenantID: tenantID,
+		Actor:    audit.ActorFromContext(ctx),
+		Client:   clientinfo.FromRequest(req.Header(), req.Peer().Addr),
+	})
+	if err != nil {
+		return nil, h.tenantError(err, "failed to delete tenant")
+	}
 
+	return connect.NewResponse(&consolev1.DeleteTenantResponse{}), nil
 }
@@ -0,0 +1,90 @@
+package v1
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"github.com/shibayama-club/keyhub/internal/domain"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	consolev1 "github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1"
+	"github.com/shibayama-club/keyhub/internal/usecase/console/dto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// tenantTypeFromRequest はリクエストのテナントタイプを model の値にする。組織が追加したタイプのキーを指定した場合はそれを優先する
+func tenantTypeFromRequest(protoType consolev1.TenantType, key string) (string, error) {
+	if key != "" {
+		return key, nil
+	}
+	return convertTenantType(protoType)
+}
+
+func convertTenantTypeDefinitionToProto(definition model.TenantTypeDefinition, _ int) *consolev1.TenantTypeDefinition {
+	protoDefinition := &consolev1.TenantTypeDefinition{
+		Key:        definition.Key.String(),
+		Label:      definition.Label,
+		BuiltIn:    definition.BuiltIn,
+		TenantType: convertModelTenantTypeToProto(definition.Key),
+	}
+	if !definition.BuiltIn {
+		protoDefinition.Id = definition.ID.String()
+		protoDefinition.CreatedAt = timestamppb.New(definition.CreatedAt)
+	}
+	return protoDefinition
+}
+
+func (h *Handler) ListTenantTypes(
+	ctx context.Context,
+	_ *connect.Request[consolev1.ListTenantTypesRequest],
+) (*connect.Response[consolev1.ListTenantTypesResponse], error) {
+	definitions, err := h.useCase.ListTenantTypes(ctx)
+	if err != nil {
+		return nil, h.tenantError(err, "failed to list tenant types")
+	}
+
+	return connect.NewResponse(&consolev1.ListTenantTypesResponse{
+		TenantTypes: lo.Map(definitions, convertTenantTypeDefinitionToProto),
+	}), nil
+}
+
+func (h *Handler) CreateTenantType(
+	ctx context.Context,
+	req *connect.Request[consolev1.CreateTenantTypeRequest],
+) (*connect.Response[consolev1.CreateTenantTypeResponse], error) {
+	orgID, ok := domain.Value[model.OrganizationID](ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.WithMessage(domainerrors.ErrNotFound, "organization not found"))
+	}
+
+	definition, err := h.useCase.CreateTenantType(ctx, dto.CreateTenantTypeInput{
+		OrganizationID: orgID,
+		Key:            req.Msg.Key,
+		Label:          req.Msg.Label,
+	})
+	if err != nil {
+		return nil, h.tenantError(err, "failed to create tenant type")
+	}
+
+	return connect.NewResponse(&consolev1.CreateTenantTypeResponse{
+		TenantType: convertTenantTypeDefinitionToProto(definition, 0),
+	}), nil
+}
+
+func (h *Handler) DeleteTenantType(
+	ctx context.Context,
+	req *connect.Request[consolev1.DeleteTenantTypeRequest],
+) (*connect.Response[consolev1.DeleteTenantTypeResponse], error) {
+	id, err := model.ParseTenantTypeID(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrap(err, "invalid tenant type ID"))
+	}
+
+	if err := h.useCase.DeleteTenantType(ctx, id); err != nil {
+		return nil, h.tenantError(err, "failed to delete tenant type")
+	}
+
+	return connect.NewResponse(&consolev1.DeleteTenantTypeResponse{}), nil
+}
@@ -0,0 +1,169 @@
+package v1
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"github.com/shibayama-club/keyhub/internal/domain"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	consolev1 "github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1"
+	"github.com/shibayama-club/keyhub/internal/usecase/console/dto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func convertWebhookSubscriptionToProto(s model.WebhookSubscription) *consolev1.WebhookSubscription {
+	return &consolev1.WebhookSubscription{
+		Id:  s.ID.String(),
+		Url: s.URL.String(),
+		EventTypes: lo.Map(s.EventTypes, func(t model.DomainEventType, _ int) string {
+			return t.String()
+		}),
+		Description: s.Description.String(),
+		CreatedAt:   timestamppb.New(s.CreatedAt),
+	}
+}
+
+func convertWebhookDeliveryToProto(d model.WebhookDelivery) *consolev1.WebhookDelivery {
+	return &consolev1.WebhookDelivery{
+		Id:         d.ID.String(),
+		EventId:    d.EventID.String(),
+		EventType:  d.EventType.String(),
+		StatusCode: int32(d.StatusCode),
+		Succeeded:  d.Succeeded,
+		Error:      d.Error,
+		DurationMs: d.Duration.Milliseconds(),
+		CreatedAt:  timestamppb.New(d.CreatedAt),
+	}
+}
+
+func (h *Handler) CreateWebhookSubscription(
+	ctx context.Context,
+	req *connect.Request[consolev1.CreateWebhookSubscriptionRequest],
+) (*connect.Response[consolev1.CreateWebhookSubscriptionResponse], error) {
+	orgID, ok := domain.Value[model.OrganizationID](ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.WithMessage(domainerrors.ErrNotFound, "organization not found"))
+	}
+
+	subscription, err := h.useCase.CreateWebhookSubscription(ctx, dto.CreateWebhookSubscriptionInput{
+		OrganizationID: orgID,
+		URL:            req.Msg.Url,
+		EventTypes:     req.Msg.EventTypes,
+		Description:    req.Msg.Description,
+	})
+	if err != nil {
+		if errors.Is(err, domainerrors.ErrValidation) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		h.l.Error("failed to create webhook subscription", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to create webhook subscription"))
+	}
+
+	return connect.NewResponse(&consolev1.CreateWebhookSubscriptionResponse{
+		Subscription: convertWebhookSubscriptionToProto(subscription),
+		Secret:       subscription.Secret.String(),
+	}), nil
+}
+
+func (h *Handler) ListWebhookSubscriptions(
+	ctx context.Context,
+	req *connect.Request[consolev1.ListWebhookSubscriptionsRequest],
+) (*connect.Response[consolev1.ListWebhookSubscriptionsResponse], error) {
+	orgID, ok := domain.Value[model.OrganizationID](ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.WithMessage(domainerrors.ErrNotFound, "organization not found"))
+	}
+
+	subscriptions, err := h.useCase.ListWebhookSubscriptions(ctx, orgID)
+	if err != nil {
+		h.l.Error("failed to list webhook subscriptions", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to list webhook subscriptions"))
+	}
+
+	return connect.NewResponse(&consolev1.ListWebhookSubscriptionsResponse{
+		Subscriptions: lo.Map(subscriptions, func(s model.WebhookSubscription, _ int) *consolev1.WebhookSubscription {
+			return convertWebhookSubscriptionToProto(s)
+		}),
+	}), nil
+}
+
+func (h *Handler) DeleteWebhookSubscription(
+	ctx context.Context,
+	req *connect.Request[consolev1.DeleteWebhookSubscriptionRequest],
+) (*connect.Response[consolev1.DeleteWebhookSubscriptionResponse], error) {
+	orgID, ok := domain.Value[model.OrganizationID](ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.WithMessage(domainerrors.ErrNotFound, "organization not found"))
+	}
+
+	if err := h.useCase.DeleteWebhookSubscription(ctx, orgID, req.Msg.Id); err != nil {
+		switch {
+		case errors.Is(err, domainerrors.ErrValidation):
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		case errors.Is(err, domainerrors.ErrNotFound):
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		h.l.Error("failed to delete webhook subscription", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to delete webhook subscription"))
+	}
+
+	return connect.NewResponse(&consolev1.DeleteWebhookSubscriptionResponse{}), nil
+}
+
+func (h *Handler) ListWebhookDeliveries(
+	ctx context.Context,
+	req *connect.Request[consolev1.ListWebhookDeliveriesRequest],
+) (*connect.Response[consolev1.ListWebhookDeliveriesResponse], error) {
+	orgID, ok := domain.Value[model.OrganizationID](ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.WithMessage(domainerrors.ErrNotFound, "organization not found"))
+	}
+
+	deliveries, err := h.useCase.ListWebhookDeliveries(ctx, dto.ListWebhookDeliveriesInput{
+		OrganizationID: orgID,
+		SubscriptionID: req.Msg.SubscriptionId,
+		PageSize:       req.Msg.PageSize,
+	})
+	if err != nil {
+		if errors.Is(err, domainerrors.ErrValidation) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		h.l.Error("failed to list webhook deliveries", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to list webhook deliveries"))
+	}
+
+	return connect.NewResponse(&consolev1.ListWebhookDeliveriesResponse{
+		Deliveries: lo.Map(deliveries, func(d model.WebhookDelivery, _ int) *consolev1.WebhookDelivery {
+			return convertWebhookDeliveryToProto(d)
+		}),
+	}), nil
+}
+
+func (h *Handler) SendTestWebhookEvent(
+	ctx context.Context,
+	req *connect.Request[consolev1.SendTestWebhookEventRequest],
+) (*connect.Response[consolev1.SendTestWebhookEventResponse], error) {
+	orgID, ok := domain.Value[model.OrganizationID](ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.WithMessage(domainerrors.ErrNotFound, "organization not found"))
+	}
+
+	delivery, err := h.useCase.SendTestWebhookEvent(ctx, orgID, req.Msg.SubscriptionId)
+	if err != nil {
+		switch {
+		case errors.Is(err, domainerrors.ErrValidation):
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		case errors.Is(err, domainerrors.ErrNotFound):
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		h.l.Error("failed to send test webhook event", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to send test webhook event"))
+	}
+
+	return connect.NewResponse(&consolev1.SendTestWebhookEventResponse{
+		Delivery: convertWebhookDeliveryToProto(delivery),
+	}), nil
+}
@@ -40,7 +40,7 @@ const (
 
 // RoomServiceClient is a client for the keyhub.app.v1.RoomService service.
 type RoomServiceClient interface {
-	// テナントに紐づくRoom一覧を取得（Keyを含む）
+	// テナントに紐づくRoom一覧を取得（Keyを含む）。グループに割り当てた部屋は、そのグループのメンバーにだけ返す
 	GetRoomsByTenant(context.Context, *connect.Request[v1.GetRoomsByTenantRequest]) (*connect.Response[v1.GetRoomsByTenantResponse], error)
 }
 
@@ -59,6 +59,7 @@ func NewRoomServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			httpClient,
 			baseURL+RoomServiceGetRoomsByTenantProcedure,
 			connect.WithSchema(roomServiceMethods.ByName("GetRoomsByTenant")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 	}
@@ -76,7 +77,7 @@ func (c *roomServiceClient) GetRoomsByTenant(ctx context.Context, req *connect.R
 
 // RoomServiceHandler is an implementation of the keyhub.app.v1.RoomService service.
 type RoomServiceHandler interface {
-	// テナントに紐づくRoom一覧を取得（Keyを含む）
+	// テナントに紐づくRoom一覧を取得（Keyを含む）。グループに割り当てた部屋は、そのグループのメンバーにだけ返す
 	GetRoomsByTenant(context.Context, *connect.Request[v1.GetRoomsByTenantRequest]) (*connect.Response[v1.GetRoomsByTenantResponse], error)
 }
 
@@ -91,6 +92,7 @@ func NewRoomServiceHandler(svc RoomServiceHandler, opts ...connect.HandlerOption
 		RoomServiceGetRoomsByTenantProcedure,
 		svc.GetRoomsByTenant,
 		connect.WithSchema(roomServiceMethods.ByName("GetRoomsByTenant")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	return "/keyhub.app.v1.RoomService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
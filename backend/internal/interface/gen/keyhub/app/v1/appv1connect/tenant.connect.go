@@ -69,6 +69,7 @@ func NewTenantServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			httpClient,
 			baseURL+TenantServiceGetTenantByJoinCodeProcedure,
 			connect.WithSchema(tenantServiceMethods.ByName("GetTenantByJoinCode")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		joinTenant: connect.NewClient[v1.JoinTenantRequest, v1.JoinTenantResponse](
@@ -81,6 +82,7 @@ func NewTenantServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			httpClient,
 			baseURL+TenantServiceGetMyTenantsProcedure,
 			connect.WithSchema(tenantServiceMethods.ByName("GetMyTenants")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 	}
@@ -129,6 +131,7 @@ func NewTenantServiceHandler(svc TenantServiceHandler, opts ...connect.HandlerOp
 		TenantServiceGetTenantByJoinCodeProcedure,
 		svc.GetTenantByJoinCode,
 		connect.WithSchema(tenantServiceMethods.ByName("GetTenantByJoinCode")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	tenantServiceJoinTenantHandler := connect.NewUnaryHandler(
@@ -141,6 +144,7 @@ func NewTenantServiceHandler(svc TenantServiceHandler, opts ...connect.HandlerOp
 		TenantServiceGetMyTenantsProcedure,
 		svc.GetMyTenants,
 		connect.WithSchema(tenantServiceMethods.ByName("GetMyTenants")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	return "/keyhub.app.v1.TenantService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
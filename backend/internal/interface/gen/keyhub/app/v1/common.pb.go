@@ -139,6 +139,61 @@ func (RoomType) EnumDescriptor() ([]byte, []int) {
 	return file_keyhub_app_v1_common_proto_rawDescGZIP(), []int{1}
 }
 
+type RoomAccessibility int32
+
+const (
+	RoomAccessibility_ROOM_ACCESSIBILITY_UNSPECIFIED         RoomAccessibility = 0
+	RoomAccessibility_ROOM_ACCESSIBILITY_WHEELCHAIR          RoomAccessibility = 1 // 車いすで利用できる
+	RoomAccessibility_ROOM_ACCESSIBILITY_STEP_FREE           RoomAccessibility = 2 // 段差がない
+	RoomAccessibility_ROOM_ACCESSIBILITY_ACCESSIBLE_RESTROOM RoomAccessibility = 3 // 近くに多目的トイレがある
+	RoomAccessibility_ROOM_ACCESSIBILITY_HEARING_LOOP        RoomAccessibility = 4 // ヒアリングループがある
+)
+
+// Enum value maps for RoomAccessibility.
+var (
+	RoomAccessibility_name = map[int32]string{
+		0: "ROOM_ACCESSIBILITY_UNSPECIFIED",
+		1: "ROOM_ACCESSIBILITY_WHEELCHAIR",
+		2: "ROOM_ACCESSIBILITY_STEP_FREE",
+		3: "ROOM_ACCESSIBILITY_ACCESSIBLE_RESTROOM",
+		4: "ROOM_ACCESSIBILITY_HEARING_LOOP",
+	}
+	RoomAccessibility_value = map[string]int32{
+		"ROOM_ACCESSIBILITY_UNSPECIFIED":         0,
+		"ROOM_ACCESSIBILITY_WHEELCHAIR":          1,
+		"ROOM_ACCESSIBILITY_STEP_FREE":           2,
+		"ROOM_ACCESSIBILITY_ACCESSIBLE_RESTROOM": 3,
+		"ROOM_ACCESSIBILITY_HEARING_LOOP":        4,
+	}
+)
+
+func (x RoomAccessibility) Enum() *RoomAccessibility {
+	p := new(RoomAccessibility)
+	*p = x
+	return p
+}
+
+func (x RoomAccessibility) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RoomAccessibility) Descriptor() protoreflect.EnumDescriptor {
+	return file_keyhub_app_v1_common_proto_enumTypes[2].Descriptor()
+}
+
+func (RoomAccessibility) Type() protoreflect.EnumType {
+	return &file_keyhub_app_v1_common_proto_enumTypes[2]
+}
+
+func (x RoomAccessibility) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RoomAccessibility.Descriptor instead.
+func (RoomAccessibility) EnumDescriptor() ([]byte, []int) {
+	return file_keyhub_app_v1_common_proto_rawDescGZIP(), []int{2}
+}
+
 type KeyStatus int32
 
 const (
@@ -178,11 +233,11 @@ func (x KeyStatus) String() string {
 }
 
 func (KeyStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_keyhub_app_v1_common_proto_enumTypes[2].Descriptor()
+	return file_keyhub_app_v1_common_proto_enumTypes[3].Descriptor()
 }
 
 func (KeyStatus) Type() protoreflect.EnumType {
-	return &file_keyhub_app_v1_common_proto_enumTypes[2]
+	return &file_keyhub_app_v1_common_proto_enumTypes[3]
 }
 
 func (x KeyStatus) Number() protoreflect.EnumNumber {
@@ -191,7 +246,57 @@ func (x KeyStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use KeyStatus.Descriptor instead.
 func (KeyStatus) EnumDescriptor() ([]byte, []int) {
-	return file_keyhub_app_v1_common_proto_rawDescGZIP(), []int{2}
+	return file_keyhub_app_v1_common_proto_rawDescGZIP(), []int{3}
+}
+
+// 一覧の並び順
+type ListOrder int32
+
+const (
+	ListOrder_LIST_ORDER_UNSPECIFIED ListOrder = 0 // 新しい順
+	ListOrder_LIST_ORDER_NEWEST      ListOrder = 1 // 作成日時の新しい順
+	ListOrder_LIST_ORDER_NAME        ListOrder = 2 // 名前の昇順（鍵の一覧では鍵番号の昇順）
+)
+
+// Enum value maps for ListOrder.
+var (
+	ListOrder_name = map[int32]string{
+		0: "LIST_ORDER_UNSPECIFIED",
+		1: "LIST_ORDER_NEWEST",
+		2: "LIST_ORDER_NAME",
+	}
+	ListOrder_value = map[string]int32{
+		"LIST_ORDER_UNSPECIFIED": 0,
+		"LIST_ORDER_NEWEST":      1,
+		"LIST_ORDER_NAME":        2,
+	}
+)
+
+func (x ListOrder) Enum() *ListOrder {
+	p := new(ListOrder)
+	*p = x
+	return p
+}
+
+func (x ListOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_keyhub_app_v1_common_proto_enumTypes[4].Descriptor()
+}
+
+func (ListOrder) Type() protoreflect.EnumType {
+	return &file_keyhub_app_v1_common_proto_enumTypes[4]
+}
+
+func (x ListOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListOrder.Descriptor instead.
+func (ListOrder) EnumDescriptor() ([]byte, []int) {
+	return file_keyhub_app_v1_common_proto_rawDescGZIP(), []int{4}
 }
 
 type User struct {
@@ -288,6 +393,8 @@ type Tenant struct {
 	MemberCount    int32                  `protobuf:"varint,7,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
 	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	TenantTypeKey  string                 `protobuf:"bytes,10,opt,name=tenant_type_key,json=tenantTypeKey,proto3" json:"tenant_type_key,omitempty"` // テナントタイプのキー。組織が追加したタイプの場合 tenant_type は UNSPECIFIED
+	ArchivedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`            // アーカイブされた日時。アーカイブされたテナントは閲覧のみできる
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -378,15 +485,33 @@ func (x *Tenant) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Tenant) GetTenantTypeKey() string {
+	if x != nil {
+		return x.TenantTypeKey
+	}
+	return ""
+}
+
+func (x *Tenant) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
 type Room struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	BuildingName  string                 `protobuf:"bytes,3,opt,name=building_name,json=buildingName,proto3" json:"building_name,omitempty"`
-	FloorNumber   string                 `protobuf:"bytes,4,opt,name=floor_number,json=floorNumber,proto3" json:"floor_number,omitempty"`
-	RoomType      RoomType               `protobuf:"varint,5,opt,name=room_type,json=roomType,proto3,enum=keyhub.app.v1.RoomType" json:"room_type,omitempty"`
-	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
-	Keys          []*Key                 `protobuf:"bytes,7,rep,name=keys,proto3" json:"keys,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	BuildingName string                 `protobuf:"bytes,3,opt,name=building_name,json=buildingName,proto3" json:"building_name,omitempty"`
+	FloorNumber  string                 `protobuf:"bytes,4,opt,name=floor_number,json=floorNumber,proto3" json:"floor_number,omitempty"`
+	RoomType     RoomType               `protobuf:"varint,5,opt,name=room_type,json=roomType,proto3,enum=keyhub.app.v1.RoomType" json:"room_type,omitempty"`
+	Description  string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Keys         []*Key                 `protobuf:"bytes,7,rep,name=keys,proto3" json:"keys,omitempty"`
+	// 鍵の貸出がグループに限定された部屋では、呼び出したユーザーがそのグループに属する場合だけ true
+	CanBorrowKeys bool            `protobuf:"varint,8,opt,name=can_borrow_keys,json=canBorrowKeys,proto3" json:"can_borrow_keys,omitempty"`
+	Attributes    *RoomAttributes `protobuf:"bytes,9,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	RoomTypeKey   string          `protobuf:"bytes,10,opt,name=room_type_key,json=roomTypeKey,proto3" json:"room_type_key,omitempty"` // 部屋タイプのキー。組織が追加したタイプの場合 room_type は UNSPECIFIED
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -470,6 +595,27 @@ func (x *Room) GetKeys() []*Key {
 	return nil
 }
 
+func (x *Room) GetCanBorrowKeys() bool {
+	if x != nil {
+		return x.CanBorrowKeys
+	}
+	return false
+}
+
+func (x *Room) GetAttributes() *RoomAttributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *Room) GetRoomTypeKey() string {
+	if x != nil {
+		return x.RoomTypeKey
+	}
+	return ""
+}
+
 type Key struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -538,6 +684,75 @@ func (x *Key) GetStatus() KeyStatus {
 	return KeyStatus_KEY_STATUS_UNSPECIFIED
 }
 
+// 部屋の収容人数・設備・バリアフリー対応と、組織が定義したカスタム項目の値
+type RoomAttributes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Capacity      int32                  `protobuf:"varint,1,opt,name=capacity,proto3" json:"capacity,omitempty"`  // 0 は未設定
+	Equipment     []string               `protobuf:"bytes,2,rep,name=equipment,proto3" json:"equipment,omitempty"` // 設備名（各30文字以内）
+	Accessibility []RoomAccessibility    `protobuf:"varint,3,rep,packed,name=accessibility,proto3,enum=keyhub.app.v1.RoomAccessibility" json:"accessibility,omitempty"`
+	CustomFields  map[string]string      `protobuf:"bytes,4,rep,name=custom_fields,json=customFields,proto3" json:"custom_fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // カスタム項目のキーごとの値
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomAttributes) Reset() {
+	*x = RoomAttributes{}
+	mi := &file_keyhub_app_v1_common_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomAttributes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomAttributes) ProtoMessage() {}
+
+func (x *RoomAttributes) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_app_v1_common_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomAttributes.ProtoReflect.Descriptor instead.
+func (*RoomAttributes) Descriptor() ([]byte, []int) {
+	return file_keyhub_app_v1_common_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RoomAttributes) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *RoomAttributes) GetEquipment() []string {
+	if x != nil {
+		return x.Equipment
+	}
+	return nil
+}
+
+func (x *RoomAttributes) GetAccessibility() []RoomAccessibility {
+	if x != nil {
+		return x.Accessibility
+	}
+	return nil
+}
+
+func (x *RoomAttributes) GetCustomFields() map[string]string {
+	if x != nil {
+		return x.CustomFields
+	}
+	return nil
+}
+
 var File_keyhub_app_v1_common_proto protoreflect.FileDescriptor
 
 const file_keyhub_app_v1_common_proto_rawDesc = "" +
@@ -551,7 +766,7 @@ const file_keyhub_app_v1_common_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xe0\x02\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xc5\x03\n" +
 	"\x06Tenant\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x121\n" +
 	"\x0forganization_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x0eorganizationId\x12\x12\n" +
@@ -563,7 +778,11 @@ const file_keyhub_app_v1_common_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xfc\x01\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12&\n" +
+	"\x0ftenant_type_key\x18\n" +
+	" \x01(\tR\rtenantTypeKey\x12;\n" +
+	"\varchived_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"archivedAt\"\x87\x03\n" +
 	"\x04Room\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
@@ -571,13 +790,28 @@ const file_keyhub_app_v1_common_proto_rawDesc = "" +
 	"\ffloor_number\x18\x04 \x01(\tR\vfloorNumber\x124\n" +
 	"\troom_type\x18\x05 \x01(\x0e2\x17.keyhub.app.v1.RoomTypeR\broomType\x12 \n" +
 	"\vdescription\x18\x06 \x01(\tR\vdescription\x12&\n" +
-	"\x04keys\x18\a \x03(\v2\x12.keyhub.app.v1.KeyR\x04keys\"\x93\x01\n" +
+	"\x04keys\x18\a \x03(\v2\x12.keyhub.app.v1.KeyR\x04keys\x12&\n" +
+	"\x0fcan_borrow_keys\x18\b \x01(\bR\rcanBorrowKeys\x12=\n" +
+	"\n" +
+	"attributes\x18\t \x01(\v2\x1d.keyhub.app.v1.RoomAttributesR\n" +
+	"attributes\x12\"\n" +
+	"\rroom_type_key\x18\n" +
+	" \x01(\tR\vroomTypeKey\"\x93\x01\n" +
 	"\x03Key\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x1d\n" +
 	"\n" +
 	"key_number\x18\x02 \x01(\tR\tkeyNumber\x12!\n" +
 	"\aroom_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\x120\n" +
-	"\x06status\x18\x04 \x01(\x0e2\x18.keyhub.app.v1.KeyStatusR\x06status*\x90\x01\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x18.keyhub.app.v1.KeyStatusR\x06status\"\xbf\x02\n" +
+	"\x0eRoomAttributes\x12&\n" +
+	"\bcapacity\x18\x01 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\x90N(\x00R\bcapacity\x12&\n" +
+	"\tequipment\x18\x02 \x03(\tB\b\xbaH\x05\x92\x01\x02\x10\x1eR\tequipment\x12F\n" +
+	"\raccessibility\x18\x03 \x03(\x0e2 .keyhub.app.v1.RoomAccessibilityR\raccessibility\x12T\n" +
+	"\rcustom_fields\x18\x04 \x03(\v2/.keyhub.app.v1.RoomAttributes.CustomFieldsEntryR\fcustomFields\x1a?\n" +
+	"\x11CustomFieldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01*\x90\x01\n" +
 	"\n" +
 	"TenantType\x12\x1b\n" +
 	"\x17TENANT_TYPE_UNSPECIFIED\x10\x00\x12\x14\n" +
@@ -592,13 +826,23 @@ const file_keyhub_app_v1_common_proto_rawDesc = "" +
 	"\x14ROOM_TYPE_LABORATORY\x10\x03\x12\x14\n" +
 	"\x10ROOM_TYPE_OFFICE\x10\x04\x12\x16\n" +
 	"\x12ROOM_TYPE_WORKSHOP\x10\x05\x12\x15\n" +
-	"\x11ROOM_TYPE_STORAGE\x10\x06*\x85\x01\n" +
+	"\x11ROOM_TYPE_STORAGE\x10\x06*\xcd\x01\n" +
+	"\x11RoomAccessibility\x12\"\n" +
+	"\x1eROOM_ACCESSIBILITY_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dROOM_ACCESSIBILITY_WHEELCHAIR\x10\x01\x12 \n" +
+	"\x1cROOM_ACCESSIBILITY_STEP_FREE\x10\x02\x12*\n" +
+	"&ROOM_ACCESSIBILITY_ACCESSIBLE_RESTROOM\x10\x03\x12#\n" +
+	"\x1fROOM_ACCESSIBILITY_HEARING_LOOP\x10\x04*\x85\x01\n" +
 	"\tKeyStatus\x12\x1a\n" +
 	"\x16KEY_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14KEY_STATUS_AVAILABLE\x10\x01\x12\x15\n" +
 	"\x11KEY_STATUS_IN_USE\x10\x02\x12\x13\n" +
 	"\x0fKEY_STATUS_LOST\x10\x03\x12\x16\n" +
-	"\x12KEY_STATUS_DAMAGED\x10\x04B\xc3\x01\n" +
+	"\x12KEY_STATUS_DAMAGED\x10\x04*S\n" +
+	"\tListOrder\x12\x1a\n" +
+	"\x16LIST_ORDER_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11LIST_ORDER_NEWEST\x10\x01\x12\x13\n" +
+	"\x0fLIST_ORDER_NAME\x10\x02B\xc3\x01\n" +
 	"\x11com.keyhub.app.v1B\vCommonProtoP\x01ZKgithub.com/shibayama-club/keyhub/internal/interface/gen/keyhub/app/v1;appv1\xa2\x02\x03KAX\xaa\x02\rKeyhub.App.V1\xca\x02\rKeyhub\\App\\V1\xe2\x02\x19Keyhub\\App\\V1\\GPBMetadata\xea\x02\x0fKeyhub::App::V1b\x06proto3"
 
 var (
@@ -613,32 +857,40 @@ func file_keyhub_app_v1_common_proto_rawDescGZIP() []byte {
 	return file_keyhub_app_v1_common_proto_rawDescData
 }
 
-var file_keyhub_app_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_keyhub_app_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_keyhub_app_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_keyhub_app_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_keyhub_app_v1_common_proto_goTypes = []any{
 	(TenantType)(0),               // 0: keyhub.app.v1.TenantType
 	(RoomType)(0),                 // 1: keyhub.app.v1.RoomType
-	(KeyStatus)(0),                // 2: keyhub.app.v1.KeyStatus
-	(*User)(nil),                  // 3: keyhub.app.v1.User
-	(*Tenant)(nil),                // 4: keyhub.app.v1.Tenant
-	(*Room)(nil),                  // 5: keyhub.app.v1.Room
-	(*Key)(nil),                   // 6: keyhub.app.v1.Key
-	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+	(RoomAccessibility)(0),        // 2: keyhub.app.v1.RoomAccessibility
+	(KeyStatus)(0),                // 3: keyhub.app.v1.KeyStatus
+	(ListOrder)(0),                // 4: keyhub.app.v1.ListOrder
+	(*User)(nil),                  // 5: keyhub.app.v1.User
+	(*Tenant)(nil),                // 6: keyhub.app.v1.Tenant
+	(*Room)(nil),                  // 7: keyhub.app.v1.Room
+	(*Key)(nil),                   // 8: keyhub.app.v1.Key
+	(*RoomAttributes)(nil),        // 9: keyhub.app.v1.RoomAttributes
+	nil,                           // 10: keyhub.app.v1.RoomAttributes.CustomFieldsEntry
+	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
 }
 var file_keyhub_app_v1_common_proto_depIdxs = []int32{
-	7, // 0: keyhub.app.v1.User.created_at:type_name -> google.protobuf.Timestamp
-	7, // 1: keyhub.app.v1.User.updated_at:type_name -> google.protobuf.Timestamp
-	0, // 2: keyhub.app.v1.Tenant.tenant_type:type_name -> keyhub.app.v1.TenantType
-	7, // 3: keyhub.app.v1.Tenant.created_at:type_name -> google.protobuf.Timestamp
-	7, // 4: keyhub.app.v1.Tenant.updated_at:type_name -> google.protobuf.Timestamp
-	1, // 5: keyhub.app.v1.Room.room_type:type_name -> keyhub.app.v1.RoomType
-	6, // 6: keyhub.app.v1.Room.keys:type_name -> keyhub.app.v1.Key
-	2, // 7: keyhub.app.v1.Key.status:type_name -> keyhub.app.v1.KeyStatus
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	11, // 0: keyhub.app.v1.User.created_at:type_name -> google.protobuf.Timestamp
+	11, // 1: keyhub.app.v1.User.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: keyhub.app.v1.Tenant.tenant_type:type_name -> keyhub.app.v1.TenantType
+	11, // 3: keyhub.app.v1.Tenant.created_at:type_name -> google.protobuf.Timestamp
+	11, // 4: keyhub.app.v1.Tenant.updated_at:type_name -> google.protobuf.Timestamp
+	11, // 5: keyhub.app.v1.Tenant.archived_at:type_name -> google.protobuf.Timestamp
+	1,  // 6: keyhub.app.v1.Room.room_type:type_name -> keyhub.app.v1.RoomType
+	8,  // 7: keyhub.app.v1.Room.keys:type_name -> keyhub.app.v1.Key
+	9,  // 8: keyhub.app.v1.Room.attributes:type_name -> keyhub.app.v1.RoomAttributes
+	3,  // 9: keyhub.app.v1.Key.status:type_name -> keyhub.app.v1.KeyStatus
+	2,  // 10: keyhub.app.v1.RoomAttributes.accessibility:type_name -> keyhub.app.v1.RoomAccessibility
+	10, // 11: keyhub.app.v1.RoomAttributes.custom_fields:type_name -> keyhub.app.v1.RoomAttributes.CustomFieldsEntry
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_keyhub_app_v1_common_proto_init() }
@@ -651,8 +903,8 @@ func file_keyhub_app_v1_common_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_keyhub_app_v1_common_proto_rawDesc), len(file_keyhub_app_v1_common_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   4,
+			NumEnums:      5,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,392 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: keyhub/app/v1/notification.proto
+
+package appv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type NotificationSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "member_joined" | "room_assigned" | "room_assignment_expiring"
+	Kind          string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	EmailEnabled  bool   `protobuf:"varint,2,opt,name=email_enabled,json=emailEnabled,proto3" json:"email_enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationSetting) Reset() {
+	*x = NotificationSetting{}
+	mi := &file_keyhub_app_v1_notification_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationSetting) ProtoMessage() {}
+
+func (x *NotificationSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_app_v1_notification_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationSetting.ProtoReflect.Descriptor instead.
+func (*NotificationSetting) Descriptor() ([]byte, []int) {
+	return file_keyhub_app_v1_notification_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NotificationSetting) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *NotificationSetting) GetEmailEnabled() bool {
+	if x != nil {
+		return x.EmailEnabled
+	}
+	return false
+}
+
+type NotificationPreferences struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "ja" | "en"。空の場合は組織の言語設定に従う
+	Locale string `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"`
+	// すべての通知の種類の設定
+	Settings      []*NotificationSetting `protobuf:"bytes,2,rep,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationPreferences) Reset() {
+	*x = NotificationPreferences{}
+	mi := &file_keyhub_app_v1_notification_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationPreferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationPreferences) ProtoMessage() {}
+
+func (x *NotificationPreferences) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_app_v1_notification_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationPreferences.ProtoReflect.Descriptor instead.
+func (*NotificationPreferences) Descriptor() ([]byte, []int) {
+	return file_keyhub_app_v1_notification_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NotificationPreferences) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *NotificationPreferences) GetSettings() []*NotificationSetting {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type GetNotificationPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationPreferencesRequest) Reset() {
+	*x = GetNotificationPreferencesRequest{}
+	mi := &file_keyhub_app_v1_notification_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationPreferencesRequest) ProtoMessage() {}
+
+func (x *GetNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_app_v1_notification_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_keyhub_app_v1_notification_proto_rawDescGZIP(), []int{2}
+}
+
+type GetNotificationPreferencesResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Preferences   *NotificationPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationPreferencesResponse) Reset() {
+	*x = GetNotificationPreferencesResponse{}
+	mi := &file_keyhub_app_v1_notification_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationPreferencesResponse) ProtoMessage() {}
+
+func (x *GetNotificationPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_app_v1_notification_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_keyhub_app_v1_notification_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetNotificationPreferencesResponse) GetPreferences() *NotificationPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type UpdateNotificationPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Locale        string                 `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"`
+	Settings      []*NotificationSetting `protobuf:"bytes,2,rep,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationPreferencesRequest) Reset() {
+	*x = UpdateNotificationPreferencesRequest{}
+	mi := &file_keyhub_app_v1_notification_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationPreferencesRequest) ProtoMessage() {}
+
+func (x *UpdateNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_app_v1_notification_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_keyhub_app_v1_notification_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateNotificationPreferencesRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *UpdateNotificationPreferencesRequest) GetSettings() []*NotificationSetting {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type UpdateNotificationPreferencesResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Preferences   *NotificationPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationPreferencesResponse) Reset() {
+	*x = UpdateNotificationPreferencesResponse{}
+	mi := &file_keyhub_app_v1_notification_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationPreferencesResponse) ProtoMessage() {}
+
+func (x *UpdateNotificationPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_app_v1_notification_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_keyhub_app_v1_notification_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateNotificationPreferencesResponse) GetPreferences() *NotificationPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+var File_keyhub_app_v1_notification_proto protoreflect.FileDescriptor
+
+const file_keyhub_app_v1_notification_proto_rawDesc = "" +
+	"\n" +
+	" keyhub/app/v1/notification.proto\x12\rkeyhub.app.v1\x1a\x1bbuf/validate/validate.proto\"W\n" +
+	"\x13NotificationSetting\x12\x1b\n" +
+	"\x04kind\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04kind\x12#\n" +
+	"\remail_enabled\x18\x02 \x01(\bR\femailEnabled\"q\n" +
+	"\x17NotificationPreferences\x12\x16\n" +
+	"\x06locale\x18\x01 \x01(\tR\x06locale\x12>\n" +
+	"\bsettings\x18\x02 \x03(\v2\".keyhub.app.v1.NotificationSettingR\bsettings\"#\n" +
+	"!GetNotificationPreferencesRequest\"n\n" +
+	"\"GetNotificationPreferencesResponse\x12H\n" +
+	"\vpreferences\x18\x01 \x01(\v2&.keyhub.app.v1.NotificationPreferencesR\vpreferences\"\x8f\x01\n" +
+	"$UpdateNotificationPreferencesRequest\x12'\n" +
+	"\x06locale\x18\x01 \x01(\tB\x0f\xbaH\fr\n" +
+	"R\x00R\x02jaR\x02enR\x06locale\x12>\n" +
+	"\bsettings\x18\x02 \x03(\v2\".keyhub.app.v1.NotificationSettingR\bsettings\"q\n" +
+	"%UpdateNotificationPreferencesResponse\x12H\n" +
+	"\vpreferences\x18\x01 \x01(\v2&.keyhub.app.v1.NotificationPreferencesR\vpreferences2\xab\x02\n" +
+	"\x13NotificationService\x12\x86\x01\n" +
+	"\x1aGetNotificationPreferences\x120.keyhub.app.v1.GetNotificationPreferencesRequest\x1a1.keyhub.app.v1.GetNotificationPreferencesResponse\"\x03\x90\x02\x01\x12\x8a\x01\n" +
+	"\x1dUpdateNotificationPreferences\x123.keyhub.app.v1.UpdateNotificationPreferencesRequest\x1a4.keyhub.app.v1.UpdateNotificationPreferencesResponseB\xc9\x01\n" +
+	"\x11com.keyhub.app.v1B\x11NotificationProtoP\x01ZKgithub.com/shibayama-club/keyhub/internal/interface/gen/keyhub/app/v1;appv1\xa2\x02\x03KAX\xaa\x02\rKeyhub.App.V1\xca\x02\rKeyhub\\App\\V1\xe2\x02\x19Keyhub\\App\\V1\\GPBMetadata\xea\x02\x0fKeyhub::App::V1b\x06proto3"
+
+var (
+	file_keyhub_app_v1_notification_proto_rawDescOnce sync.Once
+	file_keyhub_app_v1_notification_proto_rawDescData []byte
+)
+
+func file_keyhub_app_v1_notification_proto_rawDescGZIP() []byte {
+	file_keyhub_app_v1_notification_proto_rawDescOnce.Do(func() {
+		file_keyhub_app_v1_notification_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_keyhub_app_v1_notification_proto_rawDesc), len(file_keyhub_app_v1_notification_proto_rawDesc)))
+	})
+	return file_keyhub_app_v1_notification_proto_rawDescData
+}
+
+var file_keyhub_app_v1_notification_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_keyhub_app_v1_notification_proto_goTypes = []any{
+	(*NotificationSetting)(nil),                   // 0: keyhub.app.v1.NotificationSetting
+	(*NotificationPreferences)(nil),               // 1: keyhub.app.v1.NotificationPreferences
+	(*GetNotificationPreferencesRequest)(nil),     // 2: keyhub.app.v1.GetNotificationPreferencesRequest
+	(*GetNotificationPreferencesResponse)(nil),    // 3: keyhub.app.v1.GetNotificationPreferencesResponse
+	(*UpdateNotificationPreferencesRequest)(nil),  // 4: keyhub.app.v1.UpdateNotificationPreferencesRequest
+	(*UpdateNotificationPreferencesResponse)(nil), // 5: keyhub.app.v1.UpdateNotificationPreferencesResponse
+}
+var file_keyhub_app_v1_notification_proto_depIdxs = []int32{
+	0, // 0: keyhub.app.v1.NotificationPreferences.settings:type_name -> keyhub.app.v1.NotificationSetting
+	1, // 1: keyhub.app.v1.GetNotificationPreferencesResponse.preferences:type_name -> keyhub.app.v1.NotificationPreferences
+	0, // 2: keyhub.app.v1.UpdateNotificationPreferencesRequest.settings:type_name -> keyhub.app.v1.NotificationSetting
+	1, // 3: keyhub.app.v1.UpdateNotificationPreferencesResponse.preferences:type_name -> keyhub.app.v1.NotificationPreferences
+	2, // 4: keyhub.app.v1.NotificationService.GetNotificationPreferences:input_type -> keyhub.app.v1.GetNotificationPreferencesRequest
+	4, // 5: keyhub.app.v1.NotificationService.UpdateNotificationPreferences:input_type -> keyhub.app.v1.UpdateNotificationPreferencesRequest
+	3, // 6: keyhub.app.v1.NotificationService.GetNotificationPreferences:output_type -> keyhub.app.v1.GetNotificationPreferencesResponse
+	5, // 7: keyhub.app.v1.NotificationService.UpdateNotificationPreferences:output_type -> keyhub.app.v1.UpdateNotificationPreferencesResponse
+	6, // [6:8] is the sub-list for method output_type
+	4, // [4:6] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_keyhub_app_v1_notification_proto_init() }
+func file_keyhub_app_v1_notification_proto_init() {
+	if File_keyhub_app_v1_notification_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_keyhub_app_v1_notification_proto_rawDesc), len(file_keyhub_app_v1_notification_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_keyhub_app_v1_notification_proto_goTypes,
+		DependencyIndexes: file_keyhub_app_v1_notification_proto_depIdxs,
+		MessageInfos:      file_keyhub_app_v1_notification_proto_msgTypes,
+	}.Build()
+	File_keyhub_app_v1_notification_proto = out.File
+	file_keyhub_app_v1_notification_proto_goTypes = nil
+	file_keyhub_app_v1_notification_proto_depIdxs = nil
+}
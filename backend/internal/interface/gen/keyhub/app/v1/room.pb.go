@@ -23,8 +23,12 @@ const (
 )
 
 type GetRoomsByTenantRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TenantId string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// 以下の条件は指定したものだけで絞り込む
+	MinCapacity   int32               `protobuf:"varint,2,opt,name=min_capacity,json=minCapacity,proto3" json:"min_capacity,omitempty"`                              // 収容人数がこの値以上
+	Equipment     []string            `protobuf:"bytes,3,rep,name=equipment,proto3" json:"equipment,omitempty"`                                                      // すべての設備を持つ部屋
+	Accessibility []RoomAccessibility `protobuf:"varint,4,rep,packed,name=accessibility,proto3,enum=keyhub.app.v1.RoomAccessibility" json:"accessibility,omitempty"` // すべてのバリアフリー対応を持つ部屋
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -66,6 +70,27 @@ func (x *GetRoomsByTenantRequest) GetTenantId() string {
 	return ""
 }
 
+func (x *GetRoomsByTenantRequest) GetMinCapacity() int32 {
+	if x != nil {
+		return x.MinCapacity
+	}
+	return 0
+}
+
+func (x *GetRoomsByTenantRequest) GetEquipment() []string {
+	if x != nil {
+		return x.Equipment
+	}
+	return nil
+}
+
+func (x *GetRoomsByTenantRequest) GetAccessibility() []RoomAccessibility {
+	if x != nil {
+		return x.Accessibility
+	}
+	return nil
+}
+
 type GetRoomsByTenantResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Rooms         []*Room                `protobuf:"bytes,1,rep,name=rooms,proto3" json:"rooms,omitempty"`
@@ -114,13 +139,17 @@ var File_keyhub_app_v1_room_proto protoreflect.FileDescriptor
 
 const file_keyhub_app_v1_room_proto_rawDesc = "" +
 	"\n" +
-	"\x18keyhub/app/v1/room.proto\x12\rkeyhub.app.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1akeyhub/app/v1/common.proto\"@\n" +
+	"\x18keyhub/app/v1/room.proto\x12\rkeyhub.app.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1akeyhub/app/v1/common.proto\"\xd5\x01\n" +
 	"\x17GetRoomsByTenantRequest\x12%\n" +
-	"\ttenant_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\btenantId\"E\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\btenantId\x12-\n" +
+	"\fmin_capacity\x18\x02 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\x90N(\x00R\vminCapacity\x12\x1c\n" +
+	"\tequipment\x18\x03 \x03(\tR\tequipment\x12F\n" +
+	"\raccessibility\x18\x04 \x03(\x0e2 .keyhub.app.v1.RoomAccessibilityR\raccessibility\"E\n" +
 	"\x18GetRoomsByTenantResponse\x12)\n" +
-	"\x05rooms\x18\x01 \x03(\v2\x13.keyhub.app.v1.RoomR\x05rooms2r\n" +
-	"\vRoomService\x12c\n" +
-	"\x10GetRoomsByTenant\x12&.keyhub.app.v1.GetRoomsByTenantRequest\x1a'.keyhub.app.v1.GetRoomsByTenantResponseB\xc1\x01\n" +
+	"\x05rooms\x18\x01 \x03(\v2\x13.keyhub.app.v1.RoomR\x05rooms2w\n" +
+	"\vRoomService\x12h\n" +
+	"\x10GetRoomsByTenant\x12&.keyhub.app.v1.GetRoomsByTenantRequest\x1a'.keyhub.app.v1.GetRoomsByTenantResponse\"\x03\x90\x02\x01B\xc1\x01\n" +
 	"\x11com.keyhub.app.v1B\tRoomProtoP\x01ZKgithub.com/shibayama-club/keyhub/internal/interface/gen/keyhub/app/v1;appv1\xa2\x02\x03KAX\xaa\x02\rKeyhub.App.V1\xca\x02\rKeyhub\\App\\V1\xe2\x02\x19Keyhub\\App\\V1\\GPBMetadata\xea\x02\x0fKeyhub::App::V1b\x06proto3"
 
 var (
@@ -139,17 +168,19 @@ var file_keyhub_app_v1_room_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_keyhub_app_v1_room_proto_goTypes = []any{
 	(*GetRoomsByTenantRequest)(nil),  // 0: keyhub.app.v1.GetRoomsByTenantRequest
 	(*GetRoomsByTenantResponse)(nil), // 1: keyhub.app.v1.GetRoomsByTenantResponse
-	(*Room)(nil),                     // 2: keyhub.app.v1.Room
+	(RoomAccessibility)(0),           // 2: keyhub.app.v1.RoomAccessibility
+	(*Room)(nil),                     // 3: keyhub.app.v1.Room
 }
 var file_keyhub_app_v1_room_proto_depIdxs = []int32{
-	2, // 0: keyhub.app.v1.GetRoomsByTenantResponse.rooms:type_name -> keyhub.app.v1.Room
-	0, // 1: keyhub.app.v1.RoomService.GetRoomsByTenant:input_type -> keyhub.app.v1.GetRoomsByTenantRequest
-	1, // 2: keyhub.app.v1.RoomService.GetRoomsByTenant:output_type -> keyhub.app.v1.GetRoomsByTenantResponse
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2, // 0: keyhub.app.v1.GetRoomsByTenantRequest.accessibility:type_name -> keyhub.app.v1.RoomAccessibility
+	3, // 1: keyhub.app.v1.GetRoomsByTenantResponse.rooms:type_name -> keyhub.app.v1.Room
+	0, // 2: keyhub.app.v1.RoomService.GetRoomsByTenant:input_type -> keyhub.app.v1.GetRoomsByTenantRequest
+	1, // 3: keyhub.app.v1.RoomService.GetRoomsByTenant:output_type -> keyhub.app.v1.GetRoomsByTenantResponse
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_keyhub_app_v1_room_proto_init() }
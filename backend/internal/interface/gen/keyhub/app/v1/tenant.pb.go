@@ -72,6 +72,7 @@ type GetTenantByJoinCodeResponse struct {
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 	TenantType    TenantType             `protobuf:"varint,4,opt,name=tenant_type,json=tenantType,proto3,enum=keyhub.app.v1.TenantType" json:"tenant_type,omitempty"`
+	TenantTypeKey string                 `protobuf:"bytes,5,opt,name=tenant_type_key,json=tenantTypeKey,proto3" json:"tenant_type_key,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -134,6 +135,13 @@ func (x *GetTenantByJoinCodeResponse) GetTenantType() TenantType {
 	return TenantType_TENANT_TYPE_UNSPECIFIED
 }
 
+func (x *GetTenantByJoinCodeResponse) GetTenantTypeKey() string {
+	if x != nil {
+		return x.TenantTypeKey
+	}
+	return ""
+}
+
 type JoinTenantRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	JoinCode      string                 `protobuf:"bytes,1,opt,name=join_code,json=joinCode,proto3" json:"join_code,omitempty"`
@@ -231,7 +239,14 @@ func (x *JoinTenantResponse) GetMessage() string {
 }
 
 type GetMyTenantsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PageSize  int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // 省略時50件
+	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // 前回のレスポンスの next_page_token。条件と並び順は前回と同じにする
+	Order     ListOrder              `protobuf:"varint,3,opt,name=order,proto3,enum=keyhub.app.v1.ListOrder" json:"order,omitempty"`
+	// 以下の条件は指定したものだけで絞り込む
+	TenantType    TenantType `protobuf:"varint,4,opt,name=tenant_type,json=tenantType,proto3,enum=keyhub.app.v1.TenantType" json:"tenant_type,omitempty"`
+	NamePrefix    string     `protobuf:"bytes,5,opt,name=name_prefix,json=namePrefix,proto3" json:"name_prefix,omitempty"`            // テナント名の前方一致
+	TenantTypeKey string     `protobuf:"bytes,6,opt,name=tenant_type_key,json=tenantTypeKey,proto3" json:"tenant_type_key,omitempty"` // テナントタイプのキー。指定した場合は tenant_type より優先する
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -266,9 +281,52 @@ func (*GetMyTenantsRequest) Descriptor() ([]byte, []int) {
 	return file_keyhub_app_v1_tenant_proto_rawDescGZIP(), []int{4}
 }
 
+func (x *GetMyTenantsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetMyTenantsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetMyTenantsRequest) GetOrder() ListOrder {
+	if x != nil {
+		return x.Order
+	}
+	return ListOrder_LIST_ORDER_UNSPECIFIED
+}
+
+func (x *GetMyTenantsRequest) GetTenantType() TenantType {
+	if x != nil {
+		return x.TenantType
+	}
+	return TenantType_TENANT_TYPE_UNSPECIFIED
+}
+
+func (x *GetMyTenantsRequest) GetNamePrefix() string {
+	if x != nil {
+		return x.NamePrefix
+	}
+	return ""
+}
+
+func (x *GetMyTenantsRequest) GetTenantTypeKey() string {
+	if x != nil {
+		return x.TenantTypeKey
+	}
+	return ""
+}
+
 type GetMyTenantsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Tenants       []*Tenant              `protobuf:"bytes,1,rep,name=tenants,proto3" json:"tenants,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // 続きがない場合は空
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -310,32 +368,51 @@ func (x *GetMyTenantsResponse) GetTenants() []*Tenant {
 	return nil
 }
 
+func (x *GetMyTenantsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 var File_keyhub_app_v1_tenant_proto protoreflect.FileDescriptor
 
 const file_keyhub_app_v1_tenant_proto_rawDesc = "" +
 	"\n" +
 	"\x1akeyhub/app/v1/tenant.proto\x12\rkeyhub.app.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1akeyhub/app/v1/common.proto\"9\n" +
 	"\x1aGetTenantByJoinCodeRequest\x12\x1b\n" +
-	"\tjoin_code\x18\x01 \x01(\tR\bjoinCode\"\xa9\x01\n" +
+	"\tjoin_code\x18\x01 \x01(\tR\bjoinCode\"\xd1\x01\n" +
 	"\x1bGetTenantByJoinCodeResponse\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12:\n" +
 	"\vtenant_type\x18\x04 \x01(\x0e2\x19.keyhub.app.v1.TenantTypeR\n" +
-	"tenantType\"0\n" +
+	"tenantType\x12&\n" +
+	"\x0ftenant_type_key\x18\x05 \x01(\tR\rtenantTypeKey\"0\n" +
 	"\x11JoinTenantRequest\x12\x1b\n" +
 	"\tjoin_code\x18\x01 \x01(\tR\bjoinCode\"]\n" +
 	"\x12JoinTenantResponse\x12-\n" +
 	"\x06tenant\x18\x01 \x01(\v2\x15.keyhub.app.v1.TenantR\x06tenant\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\x15\n" +
-	"\x13GetMyTenantsRequest\"G\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x92\x02\n" +
+	"\x13GetMyTenantsRequest\x12'\n" +
+	"\tpage_size\x18\x01 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xc8\x01(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12.\n" +
+	"\x05order\x18\x03 \x01(\x0e2\x18.keyhub.app.v1.ListOrderR\x05order\x12:\n" +
+	"\vtenant_type\x18\x04 \x01(\x0e2\x19.keyhub.app.v1.TenantTypeR\n" +
+	"tenantType\x12\x1f\n" +
+	"\vname_prefix\x18\x05 \x01(\tR\n" +
+	"namePrefix\x12&\n" +
+	"\x0ftenant_type_key\x18\x06 \x01(\tR\rtenantTypeKey\"o\n" +
 	"\x14GetMyTenantsResponse\x12/\n" +
-	"\atenants\x18\x01 \x03(\v2\x15.keyhub.app.v1.TenantR\atenants2\xa9\x02\n" +
-	"\rTenantService\x12l\n" +
-	"\x13GetTenantByJoinCode\x12).keyhub.app.v1.GetTenantByJoinCodeRequest\x1a*.keyhub.app.v1.GetTenantByJoinCodeResponse\x12Q\n" +
+	"\atenants\x18\x01 \x03(\v2\x15.keyhub.app.v1.TenantR\atenants\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken2\xb3\x02\n" +
+	"\rTenantService\x12q\n" +
+	"\x13GetTenantByJoinCode\x12).keyhub.app.v1.GetTenantByJoinCodeRequest\x1a*.keyhub.app.v1.GetTenantByJoinCodeResponse\"\x03\x90\x02\x01\x12Q\n" +
 	"\n" +
-	"JoinTenant\x12 .keyhub.app.v1.JoinTenantRequest\x1a!.keyhub.app.v1.JoinTenantResponse\x12W\n" +
-	"\fGetMyTenants\x12\".keyhub.app.v1.GetMyTenantsRequest\x1a#.keyhub.app.v1.GetMyTenantsResponseB\xc3\x01\n" +
+	"JoinTenant\x12 .keyhub.app.v1.JoinTenantRequest\x1a!.keyhub.app.v1.JoinTenantResponse\x12\\\n" +
+	"\fGetMyTenants\x12\".keyhub.app.v1.GetMyTenantsRequest\x1a#.keyhub.app.v1.GetMyTenantsResponse\"\x03\x90\x02\x01B\xc3\x01\n" +
 	"\x11com.keyhub.app.v1B\vTenantProtoP\x01ZKgithub.com/shibayama-club/keyhub/internal/interface/gen/keyhub/app/v1;appv1\xa2\x02\x03KAX\xaa\x02\rKeyhub.App.V1\xca\x02\rKeyhub\\App\\V1\xe2\x02\x19Keyhub\\App\\V1\\GPBMetadata\xea\x02\x0fKeyhub::App::V1b\x06proto3"
 
 var (
@@ -360,22 +437,25 @@ var file_keyhub_app_v1_tenant_proto_goTypes = []any{
 	(*GetMyTenantsResponse)(nil),        // 5: keyhub.app.v1.GetMyTenantsResponse
 	(TenantType)(0),                     // 6: keyhub.app.v1.TenantType
 	(*Tenant)(nil),                      // 7: keyhub.app.v1.Tenant
+	(ListOrder)(0),                      // 8: keyhub.app.v1.ListOrder
 }
 var file_keyhub_app_v1_tenant_proto_depIdxs = []int32{
 	6, // 0: keyhub.app.v1.GetTenantByJoinCodeResponse.tenant_type:type_name -> keyhub.app.v1.TenantType
 	7, // 1: keyhub.app.v1.JoinTenantResponse.tenant:type_name -> keyhub.app.v1.Tenant
-	7, // 2: keyhub.app.v1.GetMyTenantsResponse.tenants:type_name -> keyhub.app.v1.Tenant
-	0, // 3: keyhub.app.v1.TenantService.GetTenantByJoinCode:input_type -> keyhub.app.v1.GetTenantByJoinCodeRequest
-	2, // 4: keyhub.app.v1.TenantService.JoinTenant:input_type -> keyhub.app.v1.JoinTenantRequest
-	4, // 5: keyhub.app.v1.TenantService.GetMyTenants:input_type -> keyhub.app.v1.GetMyTenantsRequest
-	1, // 6: keyhub.app.v1.TenantService.GetTenantByJoinCode:output_type -> keyhub.app.v1.GetTenantByJoinCodeResponse
-	3, // 7: keyhub.app.v1.TenantService.JoinTenant:output_type -> keyhub.app.v1.JoinTenantResponse
-	5, // 8: keyhub.app.v1.TenantService.GetMyTenants:output_type -> keyhub.app.v1.GetMyTenantsResponse
-	6, // [6:9] is the sub-list for method output_type
-	3, // [3:6] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	8, // 2: keyhub.app.v1.GetMyTenantsRequest.order:type_name -> keyhub.app.v1.ListOrder
+	6, // 3: keyhub.app.v1.GetMyTenantsRequest.tenant_type:type_name -> keyhub.app.v1.TenantType
+	7, // 4: keyhub.app.v1.GetMyTenantsResponse.tenants:type_name -> keyhub.app.v1.Tenant
+	0, // 5: keyhub.app.v1.TenantService.GetTenantByJoinCode:input_type -> keyhub.app.v1.GetTenantByJoinCodeRequest
+	2, // 6: keyhub.app.v1.TenantService.JoinTenant:input_type -> keyhub.app.v1.JoinTenantRequest
+	4, // 7: keyhub.app.v1.TenantService.GetMyTenants:input_type -> keyhub.app.v1.GetMyTenantsRequest
+	1, // 8: keyhub.app.v1.TenantService.GetTenantByJoinCode:output_type -> keyhub.app.v1.GetTenantByJoinCodeResponse
+	3, // 9: keyhub.app.v1.TenantService.JoinTenant:output_type -> keyhub.app.v1.JoinTenantResponse
+	5, // 10: keyhub.app.v1.TenantService.GetMyTenants:output_type -> keyhub.app.v1.GetMyTenantsResponse
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_keyhub_app_v1_tenant_proto_init() }
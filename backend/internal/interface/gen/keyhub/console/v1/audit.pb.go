@@ -0,0 +1,410 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: keyhub/console/v1/audit.proto
+
+package consolev1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AuditLog struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActorType     string                 `protobuf:"bytes,2,opt,name=actor_type,json=actorType,proto3" json:"actor_type,omitempty"` // "user" | "console_owner" | "console_operator" | "api_token" | "anonymous"
+	ActorId       string                 `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Procedure     string                 `protobuf:"bytes,4,opt,name=procedure,proto3" json:"procedure,omitempty"`                                                                                            // 例: "/keyhub.console.v1.ConsoleService/CreateTenant"
+	TargetIds     map[string]string      `protobuf:"bytes,5,rep,name=target_ids,json=targetIds,proto3" json:"target_ids,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 例: {"tenant_id": "..."}
+	ResultCode    string                 `protobuf:"bytes,6,opt,name=result_code,json=resultCode,proto3" json:"result_code,omitempty"`                                                                        // "ok" またはConnectのエラーコード（例: "permission_denied"）
+	RequestId     string                 `protobuf:"bytes,7,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	IpAddress     string                 `protobuf:"bytes,8,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,9,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditLog) Reset() {
+	*x = AuditLog{}
+	mi := &file_keyhub_console_v1_audit_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLog) ProtoMessage() {}
+
+func (x *AuditLog) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_audit_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLog.ProtoReflect.Descriptor instead.
+func (*AuditLog) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_audit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AuditLog) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditLog) GetActorType() string {
+	if x != nil {
+		return x.ActorType
+	}
+	return ""
+}
+
+func (x *AuditLog) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *AuditLog) GetProcedure() string {
+	if x != nil {
+		return x.Procedure
+	}
+	return ""
+}
+
+func (x *AuditLog) GetTargetIds() map[string]string {
+	if x != nil {
+		return x.TargetIds
+	}
+	return nil
+}
+
+func (x *AuditLog) GetResultCode() string {
+	if x != nil {
+		return x.ResultCode
+	}
+	return ""
+}
+
+func (x *AuditLog) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *AuditLog) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *AuditLog) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *AuditLog) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type SearchAuditLogsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 以下の条件は指定したものだけで絞り込む
+	ActorType     string                 `protobuf:"bytes,1,opt,name=actor_type,json=actorType,proto3" json:"actor_type,omitempty"`
+	ActorId       string                 `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Procedure     string                 `protobuf:"bytes,3,opt,name=procedure,proto3" json:"procedure,omitempty"`
+	ResultCode    string                 `protobuf:"bytes,4,opt,name=result_code,json=resultCode,proto3" json:"result_code,omitempty"`
+	TargetId      string                 `protobuf:"bytes,5,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`    // target_ids のいずれかの値と一致する記録
+	Since         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=since,proto3" json:"since,omitempty"`                          // この日時以降
+	Until         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=until,proto3" json:"until,omitempty"`                          // この日時より前
+	PageSize      int32                  `protobuf:"varint,8,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // 省略時50件
+	PageToken     string                 `protobuf:"bytes,9,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // 前回のレスポンスの next_page_token
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchAuditLogsRequest) Reset() {
+	*x = SearchAuditLogsRequest{}
+	mi := &file_keyhub_console_v1_audit_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchAuditLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAuditLogsRequest) ProtoMessage() {}
+
+func (x *SearchAuditLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_audit_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAuditLogsRequest.ProtoReflect.Descriptor instead.
+func (*SearchAuditLogsRequest) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_audit_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SearchAuditLogsRequest) GetActorType() string {
+	if x != nil {
+		return x.ActorType
+	}
+	return ""
+}
+
+func (x *SearchAuditLogsRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *SearchAuditLogsRequest) GetProcedure() string {
+	if x != nil {
+		return x.Procedure
+	}
+	return ""
+}
+
+func (x *SearchAuditLogsRequest) GetResultCode() string {
+	if x != nil {
+		return x.ResultCode
+	}
+	return ""
+}
+
+func (x *SearchAuditLogsRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *SearchAuditLogsRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *SearchAuditLogsRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *SearchAuditLogsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchAuditLogsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type SearchAuditLogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuditLogs     []*AuditLog            `protobuf:"bytes,1,rep,name=audit_logs,json=auditLogs,proto3" json:"audit_logs,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // 続きがない場合は空
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchAuditLogsResponse) Reset() {
+	*x = SearchAuditLogsResponse{}
+	mi := &file_keyhub_console_v1_audit_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchAuditLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAuditLogsResponse) ProtoMessage() {}
+
+func (x *SearchAuditLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_audit_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAuditLogsResponse.ProtoReflect.Descriptor instead.
+func (*SearchAuditLogsResponse) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_audit_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SearchAuditLogsResponse) GetAuditLogs() []*AuditLog {
+	if x != nil {
+		return x.AuditLogs
+	}
+	return nil
+}
+
+func (x *SearchAuditLogsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+var File_keyhub_console_v1_audit_proto protoreflect.FileDescriptor
+
+const file_keyhub_console_v1_audit_proto_rawDesc = "" +
+	"\n" +
+	"\x1dkeyhub/console/v1/audit.proto\x12\x11keyhub.console.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xbe\x03\n" +
+	"\bAuditLog\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x1d\n" +
+	"\n" +
+	"actor_type\x18\x02 \x01(\tR\tactorType\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\tR\aactorId\x12\x1c\n" +
+	"\tprocedure\x18\x04 \x01(\tR\tprocedure\x12I\n" +
+	"\n" +
+	"target_ids\x18\x05 \x03(\v2*.keyhub.console.v1.AuditLog.TargetIdsEntryR\ttargetIds\x12\x1f\n" +
+	"\vresult_code\x18\x06 \x01(\tR\n" +
+	"resultCode\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\a \x01(\tR\trequestId\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\b \x01(\tR\tipAddress\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\t \x01(\tR\tuserAgent\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x1a<\n" +
+	"\x0eTargetIdsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xda\x02\n" +
+	"\x16SearchAuditLogsRequest\x12\x1d\n" +
+	"\n" +
+	"actor_type\x18\x01 \x01(\tR\tactorType\x12\x19\n" +
+	"\bactor_id\x18\x02 \x01(\tR\aactorId\x12\x1c\n" +
+	"\tprocedure\x18\x03 \x01(\tR\tprocedure\x12\x1f\n" +
+	"\vresult_code\x18\x04 \x01(\tR\n" +
+	"resultCode\x12\x1b\n" +
+	"\ttarget_id\x18\x05 \x01(\tR\btargetId\x120\n" +
+	"\x05since\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x120\n" +
+	"\x05until\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x05until\x12'\n" +
+	"\tpage_size\x18\b \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xc8\x01(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\t \x01(\tR\tpageToken\"}\n" +
+	"\x17SearchAuditLogsResponse\x12:\n" +
+	"\n" +
+	"audit_logs\x18\x01 \x03(\v2\x1b.keyhub.console.v1.AuditLogR\tauditLogs\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken2\x84\x01\n" +
+	"\x13ConsoleAuditService\x12m\n" +
+	"\x0fSearchAuditLogs\x12).keyhub.console.v1.SearchAuditLogsRequest\x1a*.keyhub.console.v1.SearchAuditLogsResponse\"\x03\x90\x02\x01B\xde\x01\n" +
+	"\x15com.keyhub.console.v1B\n" +
+	"AuditProtoP\x01ZSgithub.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1;consolev1\xa2\x02\x03KCX\xaa\x02\x11Keyhub.Console.V1\xca\x02\x11Keyhub\\Console\\V1\xe2\x02\x1dKeyhub\\Console\\V1\\GPBMetadata\xea\x02\x13Keyhub::Console::V1b\x06proto3"
+
+var (
+	file_keyhub_console_v1_audit_proto_rawDescOnce sync.Once
+	file_keyhub_console_v1_audit_proto_rawDescData []byte
+)
+
+func file_keyhub_console_v1_audit_proto_rawDescGZIP() []byte {
+	file_keyhub_console_v1_audit_proto_rawDescOnce.Do(func() {
+		file_keyhub_console_v1_audit_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_keyhub_console_v1_audit_proto_rawDesc), len(file_keyhub_console_v1_audit_proto_rawDesc)))
+	})
+	return file_keyhub_console_v1_audit_proto_rawDescData
+}
+
+var file_keyhub_console_v1_audit_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_keyhub_console_v1_audit_proto_goTypes = []any{
+	(*AuditLog)(nil),                // 0: keyhub.console.v1.AuditLog
+	(*SearchAuditLogsRequest)(nil),  // 1: keyhub.console.v1.SearchAuditLogsRequest
+	(*SearchAuditLogsResponse)(nil), // 2: keyhub.console.v1.SearchAuditLogsResponse
+	nil,                             // 3: keyhub.console.v1.AuditLog.TargetIdsEntry
+	(*timestamppb.Timestamp)(nil),   // 4: google.protobuf.Timestamp
+}
+var file_keyhub_console_v1_audit_proto_depIdxs = []int32{
+	3, // 0: keyhub.console.v1.AuditLog.target_ids:type_name -> keyhub.console.v1.AuditLog.TargetIdsEntry
+	4, // 1: keyhub.console.v1.AuditLog.created_at:type_name -> google.protobuf.Timestamp
+	4, // 2: keyhub.console.v1.SearchAuditLogsRequest.since:type_name -> google.protobuf.Timestamp
+	4, // 3: keyhub.console.v1.SearchAuditLogsRequest.until:type_name -> google.protobuf.Timestamp
+	0, // 4: keyhub.console.v1.SearchAuditLogsResponse.audit_logs:type_name -> keyhub.console.v1.AuditLog
+	1, // 5: keyhub.console.v1.ConsoleAuditService.SearchAuditLogs:input_type -> keyhub.console.v1.SearchAuditLogsRequest
+	2, // 6: keyhub.console.v1.ConsoleAuditService.SearchAuditLogs:output_type -> keyhub.console.v1.SearchAuditLogsResponse
+	6, // [6:7] is the sub-list for method output_type
+	5, // [5:6] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_keyhub_console_v1_audit_proto_init() }
+func file_keyhub_console_v1_audit_proto_init() {
+	if File_keyhub_console_v1_audit_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_keyhub_console_v1_audit_proto_rawDesc), len(file_keyhub_console_v1_audit_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_keyhub_console_v1_audit_proto_goTypes,
+		DependencyIndexes: file_keyhub_console_v1_audit_proto_depIdxs,
+		MessageInfos:      file_keyhub_console_v1_audit_proto_msgTypes,
+	}.Build()
+	File_keyhub_console_v1_audit_proto = out.File
+	file_keyhub_console_v1_audit_proto_goTypes = nil
+	file_keyhub_console_v1_audit_proto_depIdxs = nil
+}
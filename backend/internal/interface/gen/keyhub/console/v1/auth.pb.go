@@ -10,6 +10,7 @@ import (
 	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -206,11 +207,439 @@ func (x *LogoutResponse) GetSuccess() bool {
 	return false
 }
 
+type Session struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,2,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	IpAddress     string                 `protobuf:"bytes,3,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastSeenAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Current       bool                   `protobuf:"varint,7,opt,name=current,proto3" json:"current,omitempty"` // リクエスト元のセッションかどうか
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Session) Reset() {
+	*x = Session{}
+	mi := &file_keyhub_console_v1_auth_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_auth_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_auth_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Session) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Session) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *Session) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *Session) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Session) GetLastSeenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return nil
+}
+
+func (x *Session) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Session) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+type ListSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsRequest) Reset() {
+	*x = ListSessionsRequest{}
+	mi := &file_keyhub_console_v1_auth_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsRequest) ProtoMessage() {}
+
+func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_auth_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_auth_proto_rawDescGZIP(), []int{5}
+}
+
+type ListSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*Session             `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
+	mi := &file_keyhub_console_v1_auth_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsResponse) ProtoMessage() {}
+
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_auth_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_auth_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListSessionsResponse) GetSessions() []*Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type RevokeSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionRequest) Reset() {
+	*x = RevokeSessionRequest{}
+	mi := &file_keyhub_console_v1_auth_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionRequest) ProtoMessage() {}
+
+func (x *RevokeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_auth_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_auth_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RevokeSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type RevokeSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionResponse) Reset() {
+	*x = RevokeSessionResponse{}
+	mi := &file_keyhub_console_v1_auth_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionResponse) ProtoMessage() {}
+
+func (x *RevokeSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_auth_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionResponse.ProtoReflect.Descriptor instead.
+func (*RevokeSessionResponse) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_auth_proto_rawDescGZIP(), []int{8}
+}
+
+type RevokeAllOtherSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAllOtherSessionsRequest) Reset() {
+	*x = RevokeAllOtherSessionsRequest{}
+	mi := &file_keyhub_console_v1_auth_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAllOtherSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAllOtherSessionsRequest) ProtoMessage() {}
+
+func (x *RevokeAllOtherSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_auth_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAllOtherSessionsRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAllOtherSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_auth_proto_rawDescGZIP(), []int{9}
+}
+
+type RevokeAllOtherSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RevokedCount  int64                  `protobuf:"varint,1,opt,name=revoked_count,json=revokedCount,proto3" json:"revoked_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAllOtherSessionsResponse) Reset() {
+	*x = RevokeAllOtherSessionsResponse{}
+	mi := &file_keyhub_console_v1_auth_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAllOtherSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAllOtherSessionsResponse) ProtoMessage() {}
+
+func (x *RevokeAllOtherSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_auth_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAllOtherSessionsResponse.ProtoReflect.Descriptor instead.
+func (*RevokeAllOtherSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_auth_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RevokeAllOtherSessionsResponse) GetRevokedCount() int64 {
+	if x != nil {
+		return x.RevokedCount
+	}
+	return 0
+}
+
+type GetCurrentOrganizationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCurrentOrganizationRequest) Reset() {
+	*x = GetCurrentOrganizationRequest{}
+	mi := &file_keyhub_console_v1_auth_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCurrentOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrentOrganizationRequest) ProtoMessage() {}
+
+func (x *GetCurrentOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_auth_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrentOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*GetCurrentOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_auth_proto_rawDescGZIP(), []int{11}
+}
+
+type GetCurrentOrganizationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organization  *Organization          `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`               // ログイン中のセッションのロール。APIトークンの場合は空
+	Permissions   []string               `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"` // ロールに許可された権限（例: "rooms.manage"）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCurrentOrganizationResponse) Reset() {
+	*x = GetCurrentOrganizationResponse{}
+	mi := &file_keyhub_console_v1_auth_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCurrentOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrentOrganizationResponse) ProtoMessage() {}
+
+func (x *GetCurrentOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_auth_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrentOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*GetCurrentOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_auth_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetCurrentOrganizationResponse) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+func (x *GetCurrentOrganizationResponse) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *GetCurrentOrganizationResponse) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
 var File_keyhub_console_v1_auth_proto protoreflect.FileDescriptor
 
 const file_keyhub_console_v1_auth_proto_rawDesc = "" +
 	"\n" +
-	"\x1ckeyhub/console/v1/auth.proto\x12\x11keyhub.console.v1\x1a\x1bbuf/validate/validate.proto\"u\n" +
+	"\x1ckeyhub/console/v1/auth.proto\x12\x11keyhub.console.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a$keyhub/console/v1/organization.proto\"u\n" +
 	"\x15LoginWithOrgIdRequest\x121\n" +
 	"\x0forganization_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x0eorganizationId\x12)\n" +
 	"\x10organization_key\x18\x02 \x01(\tR\x0forganizationKey\"\\\n" +
@@ -220,10 +649,42 @@ const file_keyhub_console_v1_auth_proto_rawDesc = "" +
 	"expires_in\x18\x02 \x01(\x03R\texpiresIn\"\x0f\n" +
 	"\rLogoutRequest\"*\n" +
 	"\x0eLogoutResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\xca\x01\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xa5\x02\n" +
+	"\aSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x02 \x01(\tR\tuserAgent\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x03 \x01(\tR\tipAddress\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
+	"\flast_seen_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastSeenAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x18\n" +
+	"\acurrent\x18\a \x01(\bR\acurrent\"\x15\n" +
+	"\x13ListSessionsRequest\"N\n" +
+	"\x14ListSessionsResponse\x126\n" +
+	"\bsessions\x18\x01 \x03(\v2\x1a.keyhub.console.v1.SessionR\bsessions\">\n" +
+	"\x14RevokeSessionRequest\x12&\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tsessionId\"\x17\n" +
+	"\x15RevokeSessionResponse\"\x1f\n" +
+	"\x1dRevokeAllOtherSessionsRequest\"E\n" +
+	"\x1eRevokeAllOtherSessionsResponse\x12#\n" +
+	"\rrevoked_count\x18\x01 \x01(\x03R\frevokedCount\"\x1f\n" +
+	"\x1dGetCurrentOrganizationRequest\"\x9b\x01\n" +
+	"\x1eGetCurrentOrganizationResponse\x12C\n" +
+	"\forganization\x18\x01 \x01(\v2\x1f.keyhub.console.v1.OrganizationR\forganization\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12 \n" +
+	"\vpermissions\x18\x03 \x03(\tR\vpermissions2\x93\x05\n" +
 	"\x12ConsoleAuthService\x12e\n" +
 	"\x0eLoginWithOrgId\x12(.keyhub.console.v1.LoginWithOrgIdRequest\x1a).keyhub.console.v1.LoginWithOrgIdResponse\x12M\n" +
-	"\x06Logout\x12 .keyhub.console.v1.LogoutRequest\x1a!.keyhub.console.v1.LogoutResponseB\xdd\x01\n" +
+	"\x06Logout\x12 .keyhub.console.v1.LogoutRequest\x1a!.keyhub.console.v1.LogoutResponse\x12_\n" +
+	"\fListSessions\x12&.keyhub.console.v1.ListSessionsRequest\x1a'.keyhub.console.v1.ListSessionsResponse\x12b\n" +
+	"\rRevokeSession\x12'.keyhub.console.v1.RevokeSessionRequest\x1a(.keyhub.console.v1.RevokeSessionResponse\x12}\n" +
+	"\x16RevokeAllOtherSessions\x120.keyhub.console.v1.RevokeAllOtherSessionsRequest\x1a1.keyhub.console.v1.RevokeAllOtherSessionsResponse\x12\x82\x01\n" +
+	"\x16GetCurrentOrganization\x120.keyhub.console.v1.GetCurrentOrganizationRequest\x1a1.keyhub.console.v1.GetCurrentOrganizationResponse\"\x03\x90\x02\x01B\xdd\x01\n" +
 	"\x15com.keyhub.console.v1B\tAuthProtoP\x01ZSgithub.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1;consolev1\xa2\x02\x03KCX\xaa\x02\x11Keyhub.Console.V1\xca\x02\x11Keyhub\\Console\\V1\xe2\x02\x1dKeyhub\\Console\\V1\\GPBMetadata\xea\x02\x13Keyhub::Console::V1b\x06proto3"
 
 var (
@@ -238,23 +699,47 @@ func file_keyhub_console_v1_auth_proto_rawDescGZIP() []byte {
 	return file_keyhub_console_v1_auth_proto_rawDescData
 }
 
-var file_keyhub_console_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_keyhub_console_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_keyhub_console_v1_auth_proto_goTypes = []any{
-	(*LoginWithOrgIdRequest)(nil),  // 0: keyhub.console.v1.LoginWithOrgIdRequest
-	(*LoginWithOrgIdResponse)(nil), // 1: keyhub.console.v1.LoginWithOrgIdResponse
-	(*LogoutRequest)(nil),          // 2: keyhub.console.v1.LogoutRequest
-	(*LogoutResponse)(nil),         // 3: keyhub.console.v1.LogoutResponse
+	(*LoginWithOrgIdRequest)(nil),          // 0: keyhub.console.v1.LoginWithOrgIdRequest
+	(*LoginWithOrgIdResponse)(nil),         // 1: keyhub.console.v1.LoginWithOrgIdResponse
+	(*LogoutRequest)(nil),                  // 2: keyhub.console.v1.LogoutRequest
+	(*LogoutResponse)(nil),                 // 3: keyhub.console.v1.LogoutResponse
+	(*Session)(nil),                        // 4: keyhub.console.v1.Session
+	(*ListSessionsRequest)(nil),            // 5: keyhub.console.v1.ListSessionsRequest
+	(*ListSessionsResponse)(nil),           // 6: keyhub.console.v1.ListSessionsResponse
+	(*RevokeSessionRequest)(nil),           // 7: keyhub.console.v1.RevokeSessionRequest
+	(*RevokeSessionResponse)(nil),          // 8: keyhub.console.v1.RevokeSessionResponse
+	(*RevokeAllOtherSessionsRequest)(nil),  // 9: keyhub.console.v1.RevokeAllOtherSessionsRequest
+	(*RevokeAllOtherSessionsResponse)(nil), // 10: keyhub.console.v1.RevokeAllOtherSessionsResponse
+	(*GetCurrentOrganizationRequest)(nil),  // 11: keyhub.console.v1.GetCurrentOrganizationRequest
+	(*GetCurrentOrganizationResponse)(nil), // 12: keyhub.console.v1.GetCurrentOrganizationResponse
+	(*timestamppb.Timestamp)(nil),          // 13: google.protobuf.Timestamp
+	(*Organization)(nil),                   // 14: keyhub.console.v1.Organization
 }
 var file_keyhub_console_v1_auth_proto_depIdxs = []int32{
-	0, // 0: keyhub.console.v1.ConsoleAuthService.LoginWithOrgId:input_type -> keyhub.console.v1.LoginWithOrgIdRequest
-	2, // 1: keyhub.console.v1.ConsoleAuthService.Logout:input_type -> keyhub.console.v1.LogoutRequest
-	1, // 2: keyhub.console.v1.ConsoleAuthService.LoginWithOrgId:output_type -> keyhub.console.v1.LoginWithOrgIdResponse
-	3, // 3: keyhub.console.v1.ConsoleAuthService.Logout:output_type -> keyhub.console.v1.LogoutResponse
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	13, // 0: keyhub.console.v1.Session.created_at:type_name -> google.protobuf.Timestamp
+	13, // 1: keyhub.console.v1.Session.last_seen_at:type_name -> google.protobuf.Timestamp
+	13, // 2: keyhub.console.v1.Session.expires_at:type_name -> google.protobuf.Timestamp
+	4,  // 3: keyhub.console.v1.ListSessionsResponse.sessions:type_name -> keyhub.console.v1.Session
+	14, // 4: keyhub.console.v1.GetCurrentOrganizationResponse.organization:type_name -> keyhub.console.v1.Organization
+	0,  // 5: keyhub.console.v1.ConsoleAuthService.LoginWithOrgId:input_type -> keyhub.console.v1.LoginWithOrgIdRequest
+	2,  // 6: keyhub.console.v1.ConsoleAuthService.Logout:input_type -> keyhub.console.v1.LogoutRequest
+	5,  // 7: keyhub.console.v1.ConsoleAuthService.ListSessions:input_type -> keyhub.console.v1.ListSessionsRequest
+	7,  // 8: keyhub.console.v1.ConsoleAuthService.RevokeSession:input_type -> keyhub.console.v1.RevokeSessionRequest
+	9,  // 9: keyhub.console.v1.ConsoleAuthService.RevokeAllOtherSessions:input_type -> keyhub.console.v1.RevokeAllOtherSessionsRequest
+	11, // 10: keyhub.console.v1.ConsoleAuthService.GetCurrentOrganization:input_type -> keyhub.console.v1.GetCurrentOrganizationRequest
+	1,  // 11: keyhub.console.v1.ConsoleAuthService.LoginWithOrgId:output_type -> keyhub.console.v1.LoginWithOrgIdResponse
+	3,  // 12: keyhub.console.v1.ConsoleAuthService.Logout:output_type -> keyhub.console.v1.LogoutResponse
+	6,  // 13: keyhub.console.v1.ConsoleAuthService.ListSessions:output_type -> keyhub.console.v1.ListSessionsResponse
+	8,  // 14: keyhub.console.v1.ConsoleAuthService.RevokeSession:output_type -> keyhub.console.v1.RevokeSessionResponse
+	10, // 15: keyhub.console.v1.ConsoleAuthService.RevokeAllOtherSessions:output_type -> keyhub.console.v1.RevokeAllOtherSessionsResponse
+	12, // 16: keyhub.console.v1.ConsoleAuthService.GetCurrentOrganization:output_type -> keyhub.console.v1.GetCurrentOrganizationResponse
+	11, // [11:17] is the sub-list for method output_type
+	5,  // [5:11] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_keyhub_console_v1_auth_proto_init() }
@@ -262,13 +747,14 @@ func file_keyhub_console_v1_auth_proto_init() {
 	if File_keyhub_console_v1_auth_proto != nil {
 		return
 	}
+	file_keyhub_console_v1_organization_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_keyhub_console_v1_auth_proto_rawDesc), len(file_keyhub_console_v1_auth_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
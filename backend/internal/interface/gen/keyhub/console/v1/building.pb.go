@@ -0,0 +1,1024 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: keyhub/console/v1/building.proto
+
+package consolev1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Building struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Building) Reset() {
+	*x = Building{}
+	mi := &file_keyhub_console_v1_building_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Building) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Building) ProtoMessage() {}
+
+func (x *Building) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_building_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Building.ProtoReflect.Descriptor instead.
+func (*Building) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_building_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Building) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Building) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Building) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Building) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type Floor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BuildingId    string                 `protobuf:"bytes,2,opt,name=building_id,json=buildingId,proto3" json:"building_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Level         int32                  `protobuf:"varint,4,opt,name=level,proto3" json:"level,omitempty"` // 建物内での並び順。地下は負の値
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Floor) Reset() {
+	*x = Floor{}
+	mi := &file_keyhub_console_v1_building_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Floor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Floor) ProtoMessage() {}
+
+func (x *Floor) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_building_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Floor.ProtoReflect.Descriptor instead.
+func (*Floor) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_building_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Floor) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Floor) GetBuildingId() string {
+	if x != nil {
+		return x.BuildingId
+	}
+	return ""
+}
+
+func (x *Floor) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Floor) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *Floor) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// 建物とその階
+type BuildingFloors struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Building      *Building              `protobuf:"bytes,1,opt,name=building,proto3" json:"building,omitempty"`
+	Floors        []*Floor               `protobuf:"bytes,2,rep,name=floors,proto3" json:"floors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildingFloors) Reset() {
+	*x = BuildingFloors{}
+	mi := &file_keyhub_console_v1_building_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildingFloors) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildingFloors) ProtoMessage() {}
+
+func (x *BuildingFloors) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_building_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildingFloors.ProtoReflect.Descriptor instead.
+func (*BuildingFloors) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_building_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BuildingFloors) GetBuilding() *Building {
+	if x != nil {
+		return x.Building
+	}
+	return nil
+}
+
+func (x *BuildingFloors) GetFloors() []*Floor {
+	if x != nil {
+		return x.Floors
+	}
+	return nil
+}
+
+type CreateBuildingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBuildingRequest) Reset() {
+	*x = CreateBuildingRequest{}
+	mi := &file_keyhub_console_v1_building_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBuildingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBuildingRequest) ProtoMessage() {}
+
+func (x *CreateBuildingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_building_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBuildingRequest.ProtoReflect.Descriptor instead.
+func (*CreateBuildingRequest) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_building_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateBuildingRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateBuildingRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type CreateBuildingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Building      *Building              `protobuf:"bytes,1,opt,name=building,proto3" json:"building,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBuildingResponse) Reset() {
+	*x = CreateBuildingResponse{}
+	mi := &file_keyhub_console_v1_building_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBuildingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBuildingResponse) ProtoMessage() {}
+
+func (x *CreateBuildingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_building_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBuildingResponse.ProtoReflect.Descriptor instead.
+func (*CreateBuildingResponse) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_building_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateBuildingResponse) GetBuilding() *Building {
+	if x != nil {
+		return x.Building
+	}
+	return nil
+}
+
+type ListBuildingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBuildingsRequest) Reset() {
+	*x = ListBuildingsRequest{}
+	mi := &file_keyhub_console_v1_building_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBuildingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBuildingsRequest) ProtoMessage() {}
+
+func (x *ListBuildingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_building_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBuildingsRequest.ProtoReflect.Descriptor instead.
+func (*ListBuildingsRequest) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_building_proto_rawDescGZIP(), []int{5}
+}
+
+type ListBuildingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Buildings     []*BuildingFloors      `protobuf:"bytes,1,rep,name=buildings,proto3" json:"buildings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBuildingsResponse) Reset() {
+	*x = ListBuildingsResponse{}
+	mi := &file_keyhub_console_v1_building_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBuildingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBuildingsResponse) ProtoMessage() {}
+
+func (x *ListBuildingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_building_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBuildingsResponse.ProtoReflect.Descriptor instead.
+func (*ListBuildingsResponse) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_building_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListBuildingsResponse) GetBuildings() []*BuildingFloors {
+	if x != nil {
+		return x.Buildings
+	}
+	return nil
+}
+
+type UpdateBuildingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBuildingRequest) Reset() {
+	*x = UpdateBuildingRequest{}
+	mi := &file_keyhub_console_v1_building_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBuildingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBuildingRequest) ProtoMessage() {}
+
+func (x *UpdateBuildingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_building_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBuildingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBuildingRequest) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_building_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateBuildingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateBuildingRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateBuildingRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type UpdateBuildingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Building      *Building              `protobuf:"bytes,1,opt,name=building,proto3" json:"building,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBuildingResponse) Reset() {
+	*x = UpdateBuildingResponse{}
+	mi := &file_keyhub_console_v1_building_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBuildingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBuildingResponse) ProtoMessage() {}
+
+func (x *UpdateBuildingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_building_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBuildingResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBuildingResponse) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_building_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateBuildingResponse) GetBuilding() *Building {
+	if x != nil {
+		return x.Building
+	}
+	return nil
+}
+
+type DeleteBuildingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBuildingRequest) Reset() {
+	*x = DeleteBuildingRequest{}
+	mi := &file_keyhub_console_v1_building_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBuildingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBuildingRequest) ProtoMessage() {}
+
+func (x *DeleteBuildingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_building_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBuildingRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBuildingRequest) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_building_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteBuildingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteBuildingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBuildingResponse) Reset() {
+	*x = DeleteBuildingResponse{}
+	mi := &file_keyhub_console_v1_building_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBuildingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBuildingResponse) ProtoMessage() {}
+
+func (x *DeleteBuildingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_building_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBuildingResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBuildingResponse) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_building_proto_rawDescGZIP(), []int{10}
+}
+
+type CreateFloorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BuildingId    string                 `protobuf:"bytes,1,opt,name=building_id,json=buildingId,proto3" json:"building_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Level         int32                  `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFloorRequest) Reset() {
+	*x = CreateFloorRequest{}
+	mi := &file_keyhub_console_v1_building_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFloorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFloorRequest) ProtoMessage() {}
+
+func (x *CreateFloorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_building_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFloorRequest.ProtoReflect.Descriptor instead.
+func (*CreateFloorRequest) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_building_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreateFloorRequest) GetBuildingId() string {
+	if x != nil {
+		return x.BuildingId
+	}
+	return ""
+}
+
+func (x *CreateFloorRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateFloorRequest) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+type CreateFloorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Floor         *Floor                 `protobuf:"bytes,1,opt,name=floor,proto3" json:"floor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFloorResponse) Reset() {
+	*x = CreateFloorResponse{}
+	mi := &file_keyhub_console_v1_building_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFloorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFloorResponse) ProtoMessage() {}
+
+func (x *CreateFloorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_building_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFloorResponse.ProtoReflect.Descriptor instead.
+func (*CreateFloorResponse) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_building_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateFloorResponse) GetFloor() *Floor {
+	if x != nil {
+		return x.Floor
+	}
+	return nil
+}
+
+type UpdateFloorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Level         int32                  `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFloorRequest) Reset() {
+	*x = UpdateFloorRequest{}
+	mi := &file_keyhub_console_v1_building_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFloorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFloorRequest) ProtoMessage() {}
+
+func (x *UpdateFloorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_building_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFloorRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFloorRequest) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_building_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateFloorRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateFloorRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateFloorRequest) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+type UpdateFloorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Floor         *Floor                 `protobuf:"bytes,1,opt,name=floor,proto3" json:"floor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFloorResponse) Reset() {
+	*x = UpdateFloorResponse{}
+	mi := &file_keyhub_console_v1_building_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFloorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFloorResponse) ProtoMessage() {}
+
+func (x *UpdateFloorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_building_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFloorResponse.ProtoReflect.Descriptor instead.
+func (*UpdateFloorResponse) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_building_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateFloorResponse) GetFloor() *Floor {
+	if x != nil {
+		return x.Floor
+	}
+	return nil
+}
+
+type DeleteFloorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFloorRequest) Reset() {
+	*x = DeleteFloorRequest{}
+	mi := &file_keyhub_console_v1_building_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFloorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFloorRequest) ProtoMessage() {}
+
+func (x *DeleteFloorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_building_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFloorRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFloorRequest) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_building_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeleteFloorRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteFloorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFloorResponse) Reset() {
+	*x = DeleteFloorResponse{}
+	mi := &file_keyhub_console_v1_building_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFloorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFloorResponse) ProtoMessage() {}
+
+func (x *DeleteFloorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_building_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFloorResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFloorResponse) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_building_proto_rawDescGZIP(), []int{16}
+}
+
+var File_keyhub_console_v1_building_proto protoreflect.FileDescriptor
+
+const file_keyhub_console_v1_building_proto_rawDesc = "" +
+	"\n" +
+	" keyhub/console/v1/building.proto\x12\x11keyhub.console.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x95\x01\n" +
+	"\bBuilding\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xb1\x01\n" +
+	"\x05Floor\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12)\n" +
+	"\vbuilding_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\n" +
+	"buildingId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05level\x18\x04 \x01(\x05R\x05level\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"{\n" +
+	"\x0eBuildingFloors\x127\n" +
+	"\bbuilding\x18\x01 \x01(\v2\x1b.keyhub.console.v1.BuildingR\bbuilding\x120\n" +
+	"\x06floors\x18\x02 \x03(\v2\x18.keyhub.console.v1.FloorR\x06floors\"b\n" +
+	"\x15CreateBuildingRequest\x12\x1d\n" +
+	"\x04name\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18\x14R\x04name\x12*\n" +
+	"\vdescription\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xac\x02R\vdescription\"Q\n" +
+	"\x16CreateBuildingResponse\x127\n" +
+	"\bbuilding\x18\x01 \x01(\v2\x1b.keyhub.console.v1.BuildingR\bbuilding\"\x16\n" +
+	"\x14ListBuildingsRequest\"X\n" +
+	"\x15ListBuildingsResponse\x12?\n" +
+	"\tbuildings\x18\x01 \x03(\v2!.keyhub.console.v1.BuildingFloorsR\tbuildings\"|\n" +
+	"\x15UpdateBuildingRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18\x14R\x04name\x12*\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xac\x02R\vdescription\"Q\n" +
+	"\x16UpdateBuildingResponse\x127\n" +
+	"\bbuilding\x18\x01 \x01(\v2\x1b.keyhub.console.v1.BuildingR\bbuilding\"1\n" +
+	"\x15DeleteBuildingRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x18\n" +
+	"\x16DeleteBuildingResponse\"t\n" +
+	"\x12CreateFloorRequest\x12)\n" +
+	"\vbuilding_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\n" +
+	"buildingId\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18\n" +
+	"R\x04name\x12\x14\n" +
+	"\x05level\x18\x03 \x01(\x05R\x05level\"E\n" +
+	"\x13CreateFloorResponse\x12.\n" +
+	"\x05floor\x18\x01 \x01(\v2\x18.keyhub.console.v1.FloorR\x05floor\"c\n" +
+	"\x12UpdateFloorRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18\n" +
+	"R\x04name\x12\x14\n" +
+	"\x05level\x18\x03 \x01(\x05R\x05level\"E\n" +
+	"\x13UpdateFloorResponse\x12.\n" +
+	"\x05floor\x18\x01 \x01(\v2\x18.keyhub.console.v1.FloorR\x05floor\".\n" +
+	"\x12DeleteFloorRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x15\n" +
+	"\x13DeleteFloorResponse2\xd0\x05\n" +
+	"\x16ConsoleBuildingService\x12e\n" +
+	"\x0eCreateBuilding\x12(.keyhub.console.v1.CreateBuildingRequest\x1a).keyhub.console.v1.CreateBuildingResponse\x12g\n" +
+	"\rListBuildings\x12'.keyhub.console.v1.ListBuildingsRequest\x1a(.keyhub.console.v1.ListBuildingsResponse\"\x03\x90\x02\x01\x12e\n" +
+	"\x0eUpdateBuilding\x12(.keyhub.console.v1.UpdateBuildingRequest\x1a).keyhub.console.v1.UpdateBuildingResponse\x12e\n" +
+	"\x0eDeleteBuilding\x12(.keyhub.console.v1.DeleteBuildingRequest\x1a).keyhub.console.v1.DeleteBuildingResponse\x12\\\n" +
+	"\vCreateFloor\x12%.keyhub.console.v1.CreateFloorRequest\x1a&.keyhub.console.v1.CreateFloorResponse\x12\\\n" +
+	"\vUpdateFloor\x12%.keyhub.console.v1.UpdateFloorRequest\x1a&.keyhub.console.v1.UpdateFloorResponse\x12\\\n" +
+	"\vDeleteFloor\x12%.keyhub.console.v1.DeleteFloorRequest\x1a&.keyhub.console.v1.DeleteFloorResponseB\xe1\x01\n" +
+	"\x15com.keyhub.console.v1B\rBuildingProtoP\x01ZSgithub.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1;consolev1\xa2\x02\x03KCX\xaa\x02\x11Keyhub.Console.V1\xca\x02\x11Keyhub\\Console\\V1\xe2\x02\x1dKeyhub\\Console\\V1\\GPBMetadata\xea\x02\x13Keyhub::Console::V1b\x06proto3"
+
+var (
+	file_keyhub_console_v1_building_proto_rawDescOnce sync.Once
+	file_keyhub_console_v1_building_proto_rawDescData []byte
+)
+
+func file_keyhub_console_v1_building_proto_rawDescGZIP() []byte {
+	file_keyhub_console_v1_building_proto_rawDescOnce.Do(func() {
+		file_keyhub_console_v1_building_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_keyhub_console_v1_building_proto_rawDesc), len(file_keyhub_console_v1_building_proto_rawDesc)))
+	})
+	return file_keyhub_console_v1_building_proto_rawDescData
+}
+
+var file_keyhub_console_v1_building_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_keyhub_console_v1_building_proto_goTypes = []any{
+	(*Building)(nil),               // 0: keyhub.console.v1.Building
+	(*Floor)(nil),                  // 1: keyhub.console.v1.Floor
+	(*BuildingFloors)(nil),         // 2: keyhub.console.v1.BuildingFloors
+	(*CreateBuildingRequest)(nil),  // 3: keyhub.console.v1.CreateBuildingRequest
+	(*CreateBuildingResponse)(nil), // 4: keyhub.console.v1.CreateBuildingResponse
+	(*ListBuildingsRequest)(nil),   // 5: keyhub.console.v1.ListBuildingsRequest
+	(*ListBuildingsResponse)(nil),  // 6: keyhub.console.v1.ListBuildingsResponse
+	(*UpdateBuildingRequest)(nil),  // 7: keyhub.console.v1.UpdateBuildingRequest
+	(*UpdateBuildingResponse)(nil), // 8: keyhub.console.v1.UpdateBuildingResponse
+	(*DeleteBuildingRequest)(nil),  // 9: keyhub.console.v1.DeleteBuildingRequest
+	(*DeleteBuildingResponse)(nil), // 10: keyhub.console.v1.DeleteBuildingResponse
+	(*CreateFloorRequest)(nil),     // 11: keyhub.console.v1.CreateFloorRequest
+	(*CreateFloorResponse)(nil),    // 12: keyhub.console.v1.CreateFloorResponse
+	(*UpdateFloorRequest)(nil),     // 13: keyhub.console.v1.UpdateFloorRequest
+	(*UpdateFloorResponse)(nil),    // 14: keyhub.console.v1.UpdateFloorResponse
+	(*DeleteFloorRequest)(nil),     // 15: keyhub.console.v1.DeleteFloorRequest
+	(*DeleteFloorResponse)(nil),    // 16: keyhub.console.v1.DeleteFloorResponse
+	(*timestamppb.Timestamp)(nil),  // 17: google.protobuf.Timestamp
+}
+var file_keyhub_console_v1_building_proto_depIdxs = []int32{
+	17, // 0: keyhub.console.v1.Building.created_at:type_name -> google.protobuf.Timestamp
+	17, // 1: keyhub.console.v1.Floor.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: keyhub.console.v1.BuildingFloors.building:type_name -> keyhub.console.v1.Building
+	1,  // 3: keyhub.console.v1.BuildingFloors.floors:type_name -> keyhub.console.v1.Floor
+	0,  // 4: keyhub.console.v1.CreateBuildingResponse.building:type_name -> keyhub.console.v1.Building
+	2,  // 5: keyhub.console.v1.ListBuildingsResponse.buildings:type_name -> keyhub.console.v1.BuildingFloors
+	0,  // 6: keyhub.console.v1.UpdateBuildingResponse.building:type_name -> keyhub.console.v1.Building
+	1,  // 7: keyhub.console.v1.CreateFloorResponse.floor:type_name -> keyhub.console.v1.Floor
+	1,  // 8: keyhub.console.v1.UpdateFloorResponse.floor:type_name -> keyhub.console.v1.Floor
+	3,  // 9: keyhub.console.v1.ConsoleBuildingService.CreateBuilding:input_type -> keyhub.console.v1.CreateBuildingRequest
+	5,  // 10: keyhub.console.v1.ConsoleBuildingService.ListBuildings:input_type -> keyhub.console.v1.ListBuildingsRequest
+	7,  // 11: keyhub.console.v1.ConsoleBuildingService.UpdateBuilding:input_type -> keyhub.console.v1.UpdateBuildingRequest
+	9,  // 12: keyhub.console.v1.ConsoleBuildingService.DeleteBuilding:input_type -> keyhub.console.v1.DeleteBuildingRequest
+	11, // 13: keyhub.console.v1.ConsoleBuildingService.CreateFloor:input_type -> keyhub.console.v1.CreateFloorRequest
+	13, // 14: keyhub.console.v1.ConsoleBuildingService.UpdateFloor:input_type -> keyhub.console.v1.UpdateFloorRequest
+	15, // 15: keyhub.console.v1.ConsoleBuildingService.DeleteFloor:input_type -> keyhub.console.v1.DeleteFloorRequest
+	4,  // 16: keyhub.console.v1.ConsoleBuildingService.CreateBuilding:output_type -> keyhub.console.v1.CreateBuildingResponse
+	6,  // 17: keyhub.console.v1.ConsoleBuildingService.ListBuildings:output_type -> keyhub.console.v1.ListBuildingsResponse
+	8,  // 18: keyhub.console.v1.ConsoleBuildingService.UpdateBuilding:output_type -> keyhub.console.v1.UpdateBuildingResponse
+	10, // 19: keyhub.console.v1.ConsoleBuildingService.DeleteBuilding:output_type -> keyhub.console.v1.DeleteBuildingResponse
+	12, // 20: keyhub.console.v1.ConsoleBuildingService.CreateFloor:output_type -> keyhub.console.v1.CreateFloorResponse
+	14, // 21: keyhub.console.v1.ConsoleBuildingService.UpdateFloor:output_type -> keyhub.console.v1.UpdateFloorResponse
+	16, // 22: keyhub.console.v1.ConsoleBuildingService.DeleteFloor:output_type -> keyhub.console.v1.DeleteFloorResponse
+	16, // [16:23] is the sub-list for method output_type
+	9,  // [9:16] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_keyhub_console_v1_building_proto_init() }
+func file_keyhub_console_v1_building_proto_init() {
+	if File_keyhub_console_v1_building_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_keyhub_console_v1_building_proto_rawDesc), len(file_keyhub_console_v1_building_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   17,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_keyhub_console_v1_building_proto_goTypes,
+		DependencyIndexes: file_keyhub_console_v1_building_proto_depIdxs,
+		MessageInfos:      file_keyhub_console_v1_building_proto_msgTypes,
+	}.Build()
+	File_keyhub_console_v1_building_proto = out.File
+	file_keyhub_console_v1_building_proto_goTypes = nil
+	file_keyhub_console_v1_building_proto_depIdxs = nil
+}
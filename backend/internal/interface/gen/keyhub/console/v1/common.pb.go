@@ -10,6 +10,7 @@ import (
 	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -193,12 +194,120 @@ func (RoomType) EnumDescriptor() ([]byte, []int) {
 	return file_keyhub_console_v1_common_proto_rawDescGZIP(), []int{2}
 }
 
+type RoomAccessibility int32
+
+const (
+	RoomAccessibility_ROOM_ACCESSIBILITY_UNSPECIFIED         RoomAccessibility = 0
+	RoomAccessibility_ROOM_ACCESSIBILITY_WHEELCHAIR          RoomAccessibility = 1 // 車いすで利用できる
+	RoomAccessibility_ROOM_ACCESSIBILITY_STEP_FREE           RoomAccessibility = 2 // 段差がない
+	RoomAccessibility_ROOM_ACCESSIBILITY_ACCESSIBLE_RESTROOM RoomAccessibility = 3 // 近くに多目的トイレがある
+	RoomAccessibility_ROOM_ACCESSIBILITY_HEARING_LOOP        RoomAccessibility = 4 // ヒアリングループがある
+)
+
+// Enum value maps for RoomAccessibility.
+var (
+	RoomAccessibility_name = map[int32]string{
+		0: "ROOM_ACCESSIBILITY_UNSPECIFIED",
+		1: "ROOM_ACCESSIBILITY_WHEELCHAIR",
+		2: "ROOM_ACCESSIBILITY_STEP_FREE",
+		3: "ROOM_ACCESSIBILITY_ACCESSIBLE_RESTROOM",
+		4: "ROOM_ACCESSIBILITY_HEARING_LOOP",
+	}
+	RoomAccessibility_value = map[string]int32{
+		"ROOM_ACCESSIBILITY_UNSPECIFIED":         0,
+		"ROOM_ACCESSIBILITY_WHEELCHAIR":          1,
+		"ROOM_ACCESSIBILITY_STEP_FREE":           2,
+		"ROOM_ACCESSIBILITY_ACCESSIBLE_RESTROOM": 3,
+		"ROOM_ACCESSIBILITY_HEARING_LOOP":        4,
+	}
+)
+
+func (x RoomAccessibility) Enum() *RoomAccessibility {
+	p := new(RoomAccessibility)
+	*p = x
+	return p
+}
+
+func (x RoomAccessibility) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RoomAccessibility) Descriptor() protoreflect.EnumDescriptor {
+	return file_keyhub_console_v1_common_proto_enumTypes[3].Descriptor()
+}
+
+func (RoomAccessibility) Type() protoreflect.EnumType {
+	return &file_keyhub_console_v1_common_proto_enumTypes[3]
+}
+
+func (x RoomAccessibility) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RoomAccessibility.Descriptor instead.
+func (RoomAccessibility) EnumDescriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_common_proto_rawDescGZIP(), []int{3}
+}
+
+// 一覧の並び順
+type ListOrder int32
+
+const (
+	ListOrder_LIST_ORDER_UNSPECIFIED ListOrder = 0 // 新しい順
+	ListOrder_LIST_ORDER_NEWEST      ListOrder = 1 // 作成日時の新しい順
+	ListOrder_LIST_ORDER_NAME        ListOrder = 2 // 名前の昇順（鍵の一覧では鍵番号の昇順）
+)
+
+// Enum value maps for ListOrder.
+var (
+	ListOrder_name = map[int32]string{
+		0: "LIST_ORDER_UNSPECIFIED",
+		1: "LIST_ORDER_NEWEST",
+		2: "LIST_ORDER_NAME",
+	}
+	ListOrder_value = map[string]int32{
+		"LIST_ORDER_UNSPECIFIED": 0,
+		"LIST_ORDER_NEWEST":      1,
+		"LIST_ORDER_NAME":        2,
+	}
+)
+
+func (x ListOrder) Enum() *ListOrder {
+	p := new(ListOrder)
+	*p = x
+	return p
+}
+
+func (x ListOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_keyhub_console_v1_common_proto_enumTypes[4].Descriptor()
+}
+
+func (ListOrder) Type() protoreflect.EnumType {
+	return &file_keyhub_console_v1_common_proto_enumTypes[4]
+}
+
+func (x ListOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListOrder.Descriptor instead.
+func (ListOrder) EnumDescriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_common_proto_rawDescGZIP(), []int{4}
+}
+
 type Tenant struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	TenantType    TenantType             `protobuf:"varint,4,opt,name=tenant_type,json=tenantType,proto3,enum=keyhub.console.v1.TenantType" json:"tenant_type,omitempty"`
+	TenantType    TenantType             `protobuf:"varint,4,opt,name=tenant_type,json=tenantType,proto3,enum=keyhub.console.v1.TenantType" json:"tenant_type,omitempty"` // 組織が追加したタイプの場合は UNSPECIFIED
+	TenantTypeKey string                 `protobuf:"bytes,5,opt,name=tenant_type_key,json=tenantTypeKey,proto3" json:"tenant_type_key,omitempty"`                         // テナントタイプのキー。既定のタイプは "TENANT_TYPE_TEAM" など
+	ArchivedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`                                    // アーカイブした日時。アーカイブしていなければ未設定
+	Version       int64                  `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`                                                           // 版数。更新するたびに増える。UpdateTenant に渡し、取得後に他の更新があった場合は ABORTED になる
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -261,6 +370,27 @@ func (x *Tenant) GetTenantType() TenantType {
 	return TenantType_TENANT_TYPE_UNSPECIFIED
 }
 
+func (x *Tenant) GetTenantTypeKey() string {
+	if x != nil {
+		return x.TenantTypeKey
+	}
+	return ""
+}
+
+func (x *Tenant) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+func (x *Tenant) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type Room struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -270,6 +400,10 @@ type Room struct {
 	RoomType      RoomType               `protobuf:"varint,5,opt,name=room_type,json=roomType,proto3,enum=keyhub.console.v1.RoomType" json:"room_type,omitempty"`
 	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
 	Keys          []*Key                 `protobuf:"bytes,7,rep,name=keys,proto3" json:"keys,omitempty"`
+	FloorId       string                 `protobuf:"bytes,8,opt,name=floor_id,json=floorId,proto3" json:"floor_id,omitempty"`
+	Attributes    *RoomAttributes        `protobuf:"bytes,9,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	RoomTypeKey   string                 `protobuf:"bytes,10,opt,name=room_type_key,json=roomTypeKey,proto3" json:"room_type_key,omitempty"` // 部屋タイプのキー。既定のタイプは "classroom" など。組織が追加したタイプの場合 room_type は UNSPECIFIED
+	Version       int64                  `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`                             // 版数。更新するたびに増える。UpdateRoomAttributes に渡し、取得後に他の更新があった場合は ABORTED になる
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -353,12 +487,41 @@ func (x *Room) GetKeys() []*Key {
 	return nil
 }
 
+func (x *Room) GetFloorId() string {
+	if x != nil {
+		return x.FloorId
+	}
+	return ""
+}
+
+func (x *Room) GetAttributes() *RoomAttributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *Room) GetRoomTypeKey() string {
+	if x != nil {
+		return x.RoomTypeKey
+	}
+	return ""
+}
+
+func (x *Room) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type Key struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	KeyNumber     string                 `protobuf:"bytes,2,opt,name=key_number,json=keyNumber,proto3" json:"key_number,omitempty"`
 	RoomId        string                 `protobuf:"bytes,3,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
 	Status        KeyStatus              `protobuf:"varint,4,opt,name=status,proto3,enum=keyhub.console.v1.KeyStatus" json:"status,omitempty"`
+	Version       int64                  `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"` // 版数。更新するたびに増える。UpdateKey に渡し、取得後に他の更新があった場合は ABORTED になる
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -421,17 +584,97 @@ func (x *Key) GetStatus() KeyStatus {
 	return KeyStatus_KEY_STATUS_UNSPECIFIED
 }
 
+func (x *Key) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// 部屋の収容人数・設備・バリアフリー対応と、組織が定義したカスタム項目の値
+type RoomAttributes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Capacity      int32                  `protobuf:"varint,1,opt,name=capacity,proto3" json:"capacity,omitempty"`  // 0 は未設定
+	Equipment     []string               `protobuf:"bytes,2,rep,name=equipment,proto3" json:"equipment,omitempty"` // 設備名（各30文字以内）
+	Accessibility []RoomAccessibility    `protobuf:"varint,3,rep,packed,name=accessibility,proto3,enum=keyhub.console.v1.RoomAccessibility" json:"accessibility,omitempty"`
+	CustomFields  map[string]string      `protobuf:"bytes,4,rep,name=custom_fields,json=customFields,proto3" json:"custom_fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // カスタム項目のキーごとの値
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomAttributes) Reset() {
+	*x = RoomAttributes{}
+	mi := &file_keyhub_console_v1_common_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomAttributes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomAttributes) ProtoMessage() {}
+
+func (x *RoomAttributes) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_common_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomAttributes.ProtoReflect.Descriptor instead.
+func (*RoomAttributes) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_common_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RoomAttributes) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *RoomAttributes) GetEquipment() []string {
+	if x != nil {
+		return x.Equipment
+	}
+	return nil
+}
+
+func (x *RoomAttributes) GetAccessibility() []RoomAccessibility {
+	if x != nil {
+		return x.Accessibility
+	}
+	return nil
+}
+
+func (x *RoomAttributes) GetCustomFields() map[string]string {
+	if x != nil {
+		return x.CustomFields
+	}
+	return nil
+}
+
 var File_keyhub_console_v1_common_proto protoreflect.FileDescriptor
 
 const file_keyhub_console_v1_common_proto_rawDesc = "" +
 	"\n" +
-	"\x1ekeyhub/console/v1/common.proto\x12\x11keyhub.console.v1\x1a\x1bbuf/validate/validate.proto\"\x98\x01\n" +
+	"\x1ekeyhub/console/v1/common.proto\x12\x11keyhub.console.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x97\x02\n" +
 	"\x06Tenant\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12>\n" +
 	"\vtenant_type\x18\x04 \x01(\x0e2\x1d.keyhub.console.v1.TenantTypeR\n" +
-	"tenantType\"\x84\x02\n" +
+	"tenantType\x12&\n" +
+	"\x0ftenant_type_key\x18\x05 \x01(\tR\rtenantTypeKey\x12;\n" +
+	"\varchived_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"archivedAt\x12\x18\n" +
+	"\aversion\x18\a \x01(\x03R\aversion\"\xaa\x03\n" +
 	"\x04Room\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
@@ -439,13 +682,30 @@ const file_keyhub_console_v1_common_proto_rawDesc = "" +
 	"\ffloor_number\x18\x04 \x01(\tR\vfloorNumber\x128\n" +
 	"\troom_type\x18\x05 \x01(\x0e2\x1b.keyhub.console.v1.RoomTypeR\broomType\x12 \n" +
 	"\vdescription\x18\x06 \x01(\tR\vdescription\x12*\n" +
-	"\x04keys\x18\a \x03(\v2\x16.keyhub.console.v1.KeyR\x04keys\"\x97\x01\n" +
+	"\x04keys\x18\a \x03(\v2\x16.keyhub.console.v1.KeyR\x04keys\x12#\n" +
+	"\bfloor_id\x18\b \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\afloorId\x12A\n" +
+	"\n" +
+	"attributes\x18\t \x01(\v2!.keyhub.console.v1.RoomAttributesR\n" +
+	"attributes\x12\"\n" +
+	"\rroom_type_key\x18\n" +
+	" \x01(\tR\vroomTypeKey\x12\x18\n" +
+	"\aversion\x18\v \x01(\x03R\aversion\"\xb1\x01\n" +
 	"\x03Key\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x1d\n" +
 	"\n" +
 	"key_number\x18\x02 \x01(\tR\tkeyNumber\x12!\n" +
 	"\aroom_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\x124\n" +
-	"\x06status\x18\x04 \x01(\x0e2\x1c.keyhub.console.v1.KeyStatusR\x06status*\x90\x01\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1c.keyhub.console.v1.KeyStatusR\x06status\x12\x18\n" +
+	"\aversion\x18\x05 \x01(\x03R\aversion\"\xc7\x02\n" +
+	"\x0eRoomAttributes\x12&\n" +
+	"\bcapacity\x18\x01 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\x90N(\x00R\bcapacity\x12&\n" +
+	"\tequipment\x18\x02 \x03(\tB\b\xbaH\x05\x92\x01\x02\x10\x1eR\tequipment\x12J\n" +
+	"\raccessibility\x18\x03 \x03(\x0e2$.keyhub.console.v1.RoomAccessibilityR\raccessibility\x12X\n" +
+	"\rcustom_fields\x18\x04 \x03(\v23.keyhub.console.v1.RoomAttributes.CustomFieldsEntryR\fcustomFields\x1a?\n" +
+	"\x11CustomFieldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01*\x90\x01\n" +
 	"\n" +
 	"TenantType\x12\x1b\n" +
 	"\x17TENANT_TYPE_UNSPECIFIED\x10\x00\x12\x14\n" +
@@ -466,7 +726,17 @@ const file_keyhub_console_v1_common_proto_rawDesc = "" +
 	"\x14ROOM_TYPE_LABORATORY\x10\x03\x12\x14\n" +
 	"\x10ROOM_TYPE_OFFICE\x10\x04\x12\x16\n" +
 	"\x12ROOM_TYPE_WORKSHOP\x10\x05\x12\x15\n" +
-	"\x11ROOM_TYPE_STORAGE\x10\x06B\xdf\x01\n" +
+	"\x11ROOM_TYPE_STORAGE\x10\x06*\xcd\x01\n" +
+	"\x11RoomAccessibility\x12\"\n" +
+	"\x1eROOM_ACCESSIBILITY_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dROOM_ACCESSIBILITY_WHEELCHAIR\x10\x01\x12 \n" +
+	"\x1cROOM_ACCESSIBILITY_STEP_FREE\x10\x02\x12*\n" +
+	"&ROOM_ACCESSIBILITY_ACCESSIBLE_RESTROOM\x10\x03\x12#\n" +
+	"\x1fROOM_ACCESSIBILITY_HEARING_LOOP\x10\x04*S\n" +
+	"\tListOrder\x12\x1a\n" +
+	"\x16LIST_ORDER_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11LIST_ORDER_NEWEST\x10\x01\x12\x13\n" +
+	"\x0fLIST_ORDER_NAME\x10\x02B\xdf\x01\n" +
 	"\x15com.keyhub.console.v1B\vCommonProtoP\x01ZSgithub.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1;consolev1\xa2\x02\x03KCX\xaa\x02\x11Keyhub.Console.V1\xca\x02\x11Keyhub\\Console\\V1\xe2\x02\x1dKeyhub\\Console\\V1\\GPBMetadata\xea\x02\x13Keyhub::Console::V1b\x06proto3"
 
 var (
@@ -481,26 +751,35 @@ func file_keyhub_console_v1_common_proto_rawDescGZIP() []byte {
 	return file_keyhub_console_v1_common_proto_rawDescData
 }
 
-var file_keyhub_console_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_keyhub_console_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_keyhub_console_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_keyhub_console_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_keyhub_console_v1_common_proto_goTypes = []any{
-	(TenantType)(0), // 0: keyhub.console.v1.TenantType
-	(KeyStatus)(0),  // 1: keyhub.console.v1.KeyStatus
-	(RoomType)(0),   // 2: keyhub.console.v1.RoomType
-	(*Tenant)(nil),  // 3: keyhub.console.v1.Tenant
-	(*Room)(nil),    // 4: keyhub.console.v1.Room
-	(*Key)(nil),     // 5: keyhub.console.v1.Key
+	(TenantType)(0),               // 0: keyhub.console.v1.TenantType
+	(KeyStatus)(0),                // 1: keyhub.console.v1.KeyStatus
+	(RoomType)(0),                 // 2: keyhub.console.v1.RoomType
+	(RoomAccessibility)(0),        // 3: keyhub.console.v1.RoomAccessibility
+	(ListOrder)(0),                // 4: keyhub.console.v1.ListOrder
+	(*Tenant)(nil),                // 5: keyhub.console.v1.Tenant
+	(*Room)(nil),                  // 6: keyhub.console.v1.Room
+	(*Key)(nil),                   // 7: keyhub.console.v1.Key
+	(*RoomAttributes)(nil),        // 8: keyhub.console.v1.RoomAttributes
+	nil,                           // 9: keyhub.console.v1.RoomAttributes.CustomFieldsEntry
+	(*timestamppb.Timestamp)(nil), // 10: google.protobuf.Timestamp
 }
 var file_keyhub_console_v1_common_proto_depIdxs = []int32{
-	0, // 0: keyhub.console.v1.Tenant.tenant_type:type_name -> keyhub.console.v1.TenantType
-	2, // 1: keyhub.console.v1.Room.room_type:type_name -> keyhub.console.v1.RoomType
-	5, // 2: keyhub.console.v1.Room.keys:type_name -> keyhub.console.v1.Key
-	1, // 3: keyhub.console.v1.Key.status:type_name -> keyhub.console.v1.KeyStatus
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	0,  // 0: keyhub.console.v1.Tenant.tenant_type:type_name -> keyhub.console.v1.TenantType
+	10, // 1: keyhub.console.v1.Tenant.archived_at:type_name -> google.protobuf.Timestamp
+	2,  // 2: keyhub.console.v1.Room.room_type:type_name -> keyhub.console.v1.RoomType
+	7,  // 3: keyhub.console.v1.Room.keys:type_name -> keyhub.console.v1.Key
+	8,  // 4: keyhub.console.v1.Room.attributes:type_name -> keyhub.console.v1.RoomAttributes
+	1,  // 5: keyhub.console.v1.Key.status:type_name -> keyhub.console.v1.KeyStatus
+	3,  // 6: keyhub.console.v1.RoomAttributes.accessibility:type_name -> keyhub.console.v1.RoomAccessibility
+	9,  // 7: keyhub.console.v1.RoomAttributes.custom_fields:type_name -> keyhub.console.v1.RoomAttributes.CustomFieldsEntry
+	8,  // [8:8] is the sub-list for method output_type
+	8,  // [8:8] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_keyhub_console_v1_common_proto_init() }
@@ -513,8 +792,8 @@ func file_keyhub_console_v1_common_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_keyhub_console_v1_common_proto_rawDesc), len(file_keyhub_console_v1_common_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   3,
+			NumEnums:      5,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,177 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: keyhub/console/v1/api_token.proto
+
+package consolev1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ConsoleApiTokenServiceName is the fully-qualified name of the ConsoleApiTokenService service.
+	ConsoleApiTokenServiceName = "keyhub.console.v1.ConsoleApiTokenService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ConsoleApiTokenServiceCreateApiTokenProcedure is the fully-qualified name of the
+	// ConsoleApiTokenService's CreateApiToken RPC.
+	ConsoleApiTokenServiceCreateApiTokenProcedure = "/keyhub.console.v1.ConsoleApiTokenService/CreateApiToken"
+	// ConsoleApiTokenServiceListApiTokensProcedure is the fully-qualified name of the
+	// ConsoleApiTokenService's ListApiTokens RPC.
+	ConsoleApiTokenServiceListApiTokensProcedure = "/keyhub.console.v1.ConsoleApiTokenService/ListApiTokens"
+	// ConsoleApiTokenServiceRevokeApiTokenProcedure is the fully-qualified name of the
+	// ConsoleApiTokenService's RevokeApiToken RPC.
+	ConsoleApiTokenServiceRevokeApiTokenProcedure = "/keyhub.console.v1.ConsoleApiTokenService/RevokeApiToken"
+)
+
+// ConsoleApiTokenServiceClient is a client for the keyhub.console.v1.ConsoleApiTokenService
+// service.
+type ConsoleApiTokenServiceClient interface {
+	// APIトークン発行（トークン本体はこのレスポンスでのみ返す）
+	CreateApiToken(context.Context, *connect.Request[v1.CreateApiTokenRequest]) (*connect.Response[v1.CreateApiTokenResponse], error)
+	// 有効なAPIトークン一覧取得
+	ListApiTokens(context.Context, *connect.Request[v1.ListApiTokensRequest]) (*connect.Response[v1.ListApiTokensResponse], error)
+	// APIトークンの無効化
+	RevokeApiToken(context.Context, *connect.Request[v1.RevokeApiTokenRequest]) (*connect.Response[v1.RevokeApiTokenResponse], error)
+}
+
+// NewConsoleApiTokenServiceClient constructs a client for the
+// keyhub.console.v1.ConsoleApiTokenService service. By default, it uses the Connect protocol with
+// the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed requests. To use
+// the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewConsoleApiTokenServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ConsoleApiTokenServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	consoleApiTokenServiceMethods := v1.File_keyhub_console_v1_api_token_proto.Services().ByName("ConsoleApiTokenService").Methods()
+	return &consoleApiTokenServiceClient{
+		createApiToken: connect.NewClient[v1.CreateApiTokenRequest, v1.CreateApiTokenResponse](
+			httpClient,
+			baseURL+ConsoleApiTokenServiceCreateApiTokenProcedure,
+			connect.WithSchema(consoleApiTokenServiceMethods.ByName("CreateApiToken")),
+			connect.WithClientOptions(opts...),
+		),
+		listApiTokens: connect.NewClient[v1.ListApiTokensRequest, v1.ListApiTokensResponse](
+			httpClient,
+			baseURL+ConsoleApiTokenServiceListApiTokensProcedure,
+			connect.WithSchema(consoleApiTokenServiceMethods.ByName("ListApiTokens")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		revokeApiToken: connect.NewClient[v1.RevokeApiTokenRequest, v1.RevokeApiTokenResponse](
+			httpClient,
+			baseURL+ConsoleApiTokenServiceRevokeApiTokenProcedure,
+			connect.WithSchema(consoleApiTokenServiceMethods.ByName("RevokeApiToken")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// consoleApiTokenServiceClient implements ConsoleApiTokenServiceClient.
+type consoleApiTokenServiceClient struct {
+	createApiToken *connect.Client[v1.CreateApiTokenRequest, v1.CreateApiTokenResponse]
+	listApiTokens  *connect.Client[v1.ListApiTokensRequest, v1.ListApiTokensResponse]
+	revokeApiToken *connect.Client[v1.RevokeApiTokenRequest, v1.RevokeApiTokenResponse]
+}
+
+// CreateApiToken calls keyhub.console.v1.ConsoleApiTokenService.CreateApiToken.
+func (c *consoleApiTokenServiceClient) CreateApiToken(ctx context.Context, req *connect.Request[v1.CreateApiTokenRequest]) (*connect.Response[v1.CreateApiTokenResponse], error) {
+	return c.createApiToken.CallUnary(ctx, req)
+}
+
+// ListApiTokens calls keyhub.console.v1.ConsoleApiTokenService.ListApiTokens.
+func (c *consoleApiTokenServiceClient) ListApiTokens(ctx context.Context, req *connect.Request[v1.ListApiTokensRequest]) (*connect.Response[v1.ListApiTokensResponse], error) {
+	return c.listApiTokens.CallUnary(ctx, req)
+}
+
+// RevokeApiToken calls keyhub.console.v1.ConsoleApiTokenService.RevokeApiToken.
+func (c *consoleApiTokenServiceClient) RevokeApiToken(ctx context.Context, req *connect.Request[v1.RevokeApiTokenRequest]) (*connect.Response[v1.RevokeApiTokenResponse], error) {
+	return c.revokeApiToken.CallUnary(ctx, req)
+}
+
+// ConsoleApiTokenServiceHandler is an implementation of the
+// keyhub.console.v1.ConsoleApiTokenService service.
+type ConsoleApiTokenServiceHandler interface {
+	// APIトークン発行（トークン本体はこのレスポンスでのみ返す）
+	CreateApiToken(context.Context, *connect.Request[v1.CreateApiTokenRequest]) (*connect.Response[v1.CreateApiTokenResponse], error)
+	// 有効なAPIトークン一覧取得
+	ListApiTokens(context.Context, *connect.Request[v1.ListApiTokensRequest]) (*connect.Response[v1.ListApiTokensResponse], error)
+	// APIトークンの無効化
+	RevokeApiToken(context.Context, *connect.Request[v1.RevokeApiTokenRequest]) (*connect.Response[v1.RevokeApiTokenResponse], error)
+}
+
+// NewConsoleApiTokenServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewConsoleApiTokenServiceHandler(svc ConsoleApiTokenServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	consoleApiTokenServiceMethods := v1.File_keyhub_console_v1_api_token_proto.Services().ByName("ConsoleApiTokenService").Methods()
+	consoleApiTokenServiceCreateApiTokenHandler := connect.NewUnaryHandler(
+		ConsoleApiTokenServiceCreateApiTokenProcedure,
+		svc.CreateApiToken,
+		connect.WithSchema(consoleApiTokenServiceMethods.ByName("CreateApiToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	consoleApiTokenServiceListApiTokensHandler := connect.NewUnaryHandler(
+		ConsoleApiTokenServiceListApiTokensProcedure,
+		svc.ListApiTokens,
+		connect.WithSchema(consoleApiTokenServiceMethods.ByName("ListApiTokens")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	consoleApiTokenServiceRevokeApiTokenHandler := connect.NewUnaryHandler(
+		ConsoleApiTokenServiceRevokeApiTokenProcedure,
+		svc.RevokeApiToken,
+		connect.WithSchema(consoleApiTokenServiceMethods.ByName("RevokeApiToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/keyhub.console.v1.ConsoleApiTokenService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ConsoleApiTokenServiceCreateApiTokenProcedure:
+			consoleApiTokenServiceCreateApiTokenHandler.ServeHTTP(w, r)
+		case ConsoleApiTokenServiceListApiTokensProcedure:
+			consoleApiTokenServiceListApiTokensHandler.ServeHTTP(w, r)
+		case ConsoleApiTokenServiceRevokeApiTokenProcedure:
+			consoleApiTokenServiceRevokeApiTokenHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedConsoleApiTokenServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedConsoleApiTokenServiceHandler struct{}
+
+func (UnimplementedConsoleApiTokenServiceHandler) CreateApiToken(context.Context, *connect.Request[v1.CreateApiTokenRequest]) (*connect.Response[v1.CreateApiTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleApiTokenService.CreateApiToken is not implemented"))
+}
+
+func (UnimplementedConsoleApiTokenServiceHandler) ListApiTokens(context.Context, *connect.Request[v1.ListApiTokensRequest]) (*connect.Response[v1.ListApiTokensResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleApiTokenService.ListApiTokens is not implemented"))
+}
+
+func (UnimplementedConsoleApiTokenServiceHandler) RevokeApiToken(context.Context, *connect.Request[v1.RevokeApiTokenRequest]) (*connect.Response[v1.RevokeApiTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleApiTokenService.RevokeApiToken is not implemented"))
+}
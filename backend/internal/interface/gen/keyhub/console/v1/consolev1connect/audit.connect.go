@@ -0,0 +1,114 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: keyhub/console/v1/audit.proto
+
+package consolev1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ConsoleAuditServiceName is the fully-qualified name of the ConsoleAuditService service.
+	ConsoleAuditServiceName = "keyhub.console.v1.ConsoleAuditService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ConsoleAuditServiceSearchAuditLogsProcedure is the fully-qualified name of the
+	// ConsoleAuditService's SearchAuditLogs RPC.
+	ConsoleAuditServiceSearchAuditLogsProcedure = "/keyhub.console.v1.ConsoleAuditService/SearchAuditLogs"
+)
+
+// ConsoleAuditServiceClient is a client for the keyhub.console.v1.ConsoleAuditService service.
+type ConsoleAuditServiceClient interface {
+	// 監査ログ検索（新しい順）
+	SearchAuditLogs(context.Context, *connect.Request[v1.SearchAuditLogsRequest]) (*connect.Response[v1.SearchAuditLogsResponse], error)
+}
+
+// NewConsoleAuditServiceClient constructs a client for the keyhub.console.v1.ConsoleAuditService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewConsoleAuditServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ConsoleAuditServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	consoleAuditServiceMethods := v1.File_keyhub_console_v1_audit_proto.Services().ByName("ConsoleAuditService").Methods()
+	return &consoleAuditServiceClient{
+		searchAuditLogs: connect.NewClient[v1.SearchAuditLogsRequest, v1.SearchAuditLogsResponse](
+			httpClient,
+			baseURL+ConsoleAuditServiceSearchAuditLogsProcedure,
+			connect.WithSchema(consoleAuditServiceMethods.ByName("SearchAuditLogs")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// consoleAuditServiceClient implements ConsoleAuditServiceClient.
+type consoleAuditServiceClient struct {
+	searchAuditLogs *connect.Client[v1.SearchAuditLogsRequest, v1.SearchAuditLogsResponse]
+}
+
+// SearchAuditLogs calls keyhub.console.v1.ConsoleAuditService.SearchAuditLogs.
+func (c *consoleAuditServiceClient) SearchAuditLogs(ctx context.Context, req *connect.Request[v1.SearchAuditLogsRequest]) (*connect.Response[v1.SearchAuditLogsResponse], error) {
+	return c.searchAuditLogs.CallUnary(ctx, req)
+}
+
+// ConsoleAuditServiceHandler is an implementation of the keyhub.console.v1.ConsoleAuditService
+// service.
+type ConsoleAuditServiceHandler interface {
+	// 監査ログ検索（新しい順）
+	SearchAuditLogs(context.Context, *connect.Request[v1.SearchAuditLogsRequest]) (*connect.Response[v1.SearchAuditLogsResponse], error)
+}
+
+// NewConsoleAuditServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewConsoleAuditServiceHandler(svc ConsoleAuditServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	consoleAuditServiceMethods := v1.File_keyhub_console_v1_audit_proto.Services().ByName("ConsoleAuditService").Methods()
+	consoleAuditServiceSearchAuditLogsHandler := connect.NewUnaryHandler(
+		ConsoleAuditServiceSearchAuditLogsProcedure,
+		svc.SearchAuditLogs,
+		connect.WithSchema(consoleAuditServiceMethods.ByName("SearchAuditLogs")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/keyhub.console.v1.ConsoleAuditService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ConsoleAuditServiceSearchAuditLogsProcedure:
+			consoleAuditServiceSearchAuditLogsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedConsoleAuditServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedConsoleAuditServiceHandler struct{}
+
+func (UnimplementedConsoleAuditServiceHandler) SearchAuditLogs(context.Context, *connect.Request[v1.SearchAuditLogsRequest]) (*connect.Response[v1.SearchAuditLogsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleAuditService.SearchAuditLogs is not implemented"))
+}
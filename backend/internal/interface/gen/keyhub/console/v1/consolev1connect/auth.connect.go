@@ -39,6 +39,18 @@ const (
 	// ConsoleAuthServiceLogoutProcedure is the fully-qualified name of the ConsoleAuthService's Logout
 	// RPC.
 	ConsoleAuthServiceLogoutProcedure = "/keyhub.console.v1.ConsoleAuthService/Logout"
+	// ConsoleAuthServiceListSessionsProcedure is the fully-qualified name of the ConsoleAuthService's
+	// ListSessions RPC.
+	ConsoleAuthServiceListSessionsProcedure = "/keyhub.console.v1.ConsoleAuthService/ListSessions"
+	// ConsoleAuthServiceRevokeSessionProcedure is the fully-qualified name of the ConsoleAuthService's
+	// RevokeSession RPC.
+	ConsoleAuthServiceRevokeSessionProcedure = "/keyhub.console.v1.ConsoleAuthService/RevokeSession"
+	// ConsoleAuthServiceRevokeAllOtherSessionsProcedure is the fully-qualified name of the
+	// ConsoleAuthService's RevokeAllOtherSessions RPC.
+	ConsoleAuthServiceRevokeAllOtherSessionsProcedure = "/keyhub.console.v1.ConsoleAuthService/RevokeAllOtherSessions"
+	// ConsoleAuthServiceGetCurrentOrganizationProcedure is the fully-qualified name of the
+	// ConsoleAuthService's GetCurrentOrganization RPC.
+	ConsoleAuthServiceGetCurrentOrganizationProcedure = "/keyhub.console.v1.ConsoleAuthService/GetCurrentOrganization"
 )
 
 // ConsoleAuthServiceClient is a client for the keyhub.console.v1.ConsoleAuthService service.
@@ -47,6 +59,14 @@ type ConsoleAuthServiceClient interface {
 	LoginWithOrgId(context.Context, *connect.Request[v1.LoginWithOrgIdRequest]) (*connect.Response[v1.LoginWithOrgIdResponse], error)
 	// ログアウト
 	Logout(context.Context, *connect.Request[v1.LogoutRequest]) (*connect.Response[v1.LogoutResponse], error)
+	// 組織のログイン中のセッション一覧取得
+	ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error)
+	// 指定したセッションを無効化
+	RevokeSession(context.Context, *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error)
+	// 現在のセッション以外をすべて無効化
+	RevokeAllOtherSessions(context.Context, *connect.Request[v1.RevokeAllOtherSessionsRequest]) (*connect.Response[v1.RevokeAllOtherSessionsResponse], error)
+	// ログイン中の組織情報取得
+	GetCurrentOrganization(context.Context, *connect.Request[v1.GetCurrentOrganizationRequest]) (*connect.Response[v1.GetCurrentOrganizationResponse], error)
 }
 
 // NewConsoleAuthServiceClient constructs a client for the keyhub.console.v1.ConsoleAuthService
@@ -72,13 +92,42 @@ func NewConsoleAuthServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(consoleAuthServiceMethods.ByName("Logout")),
 			connect.WithClientOptions(opts...),
 		),
+		listSessions: connect.NewClient[v1.ListSessionsRequest, v1.ListSessionsResponse](
+			httpClient,
+			baseURL+ConsoleAuthServiceListSessionsProcedure,
+			connect.WithSchema(consoleAuthServiceMethods.ByName("ListSessions")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeSession: connect.NewClient[v1.RevokeSessionRequest, v1.RevokeSessionResponse](
+			httpClient,
+			baseURL+ConsoleAuthServiceRevokeSessionProcedure,
+			connect.WithSchema(consoleAuthServiceMethods.ByName("RevokeSession")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeAllOtherSessions: connect.NewClient[v1.RevokeAllOtherSessionsRequest, v1.RevokeAllOtherSessionsResponse](
+			httpClient,
+			baseURL+ConsoleAuthServiceRevokeAllOtherSessionsProcedure,
+			connect.WithSchema(consoleAuthServiceMethods.ByName("RevokeAllOtherSessions")),
+			connect.WithClientOptions(opts...),
+		),
+		getCurrentOrganization: connect.NewClient[v1.GetCurrentOrganizationRequest, v1.GetCurrentOrganizationResponse](
+			httpClient,
+			baseURL+ConsoleAuthServiceGetCurrentOrganizationProcedure,
+			connect.WithSchema(consoleAuthServiceMethods.ByName("GetCurrentOrganization")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // consoleAuthServiceClient implements ConsoleAuthServiceClient.
 type consoleAuthServiceClient struct {
-	loginWithOrgId *connect.Client[v1.LoginWithOrgIdRequest, v1.LoginWithOrgIdResponse]
-	logout         *connect.Client[v1.LogoutRequest, v1.LogoutResponse]
+	loginWithOrgId         *connect.Client[v1.LoginWithOrgIdRequest, v1.LoginWithOrgIdResponse]
+	logout                 *connect.Client[v1.LogoutRequest, v1.LogoutResponse]
+	listSessions           *connect.Client[v1.ListSessionsRequest, v1.ListSessionsResponse]
+	revokeSession          *connect.Client[v1.RevokeSessionRequest, v1.RevokeSessionResponse]
+	revokeAllOtherSessions *connect.Client[v1.RevokeAllOtherSessionsRequest, v1.RevokeAllOtherSessionsResponse]
+	getCurrentOrganization *connect.Client[v1.GetCurrentOrganizationRequest, v1.GetCurrentOrganizationResponse]
 }
 
 // LoginWithOrgId calls keyhub.console.v1.ConsoleAuthService.LoginWithOrgId.
@@ -91,6 +140,26 @@ func (c *consoleAuthServiceClient) Logout(ctx context.Context, req *connect.Requ
 	return c.logout.CallUnary(ctx, req)
 }
 
+// ListSessions calls keyhub.console.v1.ConsoleAuthService.ListSessions.
+func (c *consoleAuthServiceClient) ListSessions(ctx context.Context, req *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error) {
+	return c.listSessions.CallUnary(ctx, req)
+}
+
+// RevokeSession calls keyhub.console.v1.ConsoleAuthService.RevokeSession.
+func (c *consoleAuthServiceClient) RevokeSession(ctx context.Context, req *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error) {
+	return c.revokeSession.CallUnary(ctx, req)
+}
+
+// RevokeAllOtherSessions calls keyhub.console.v1.ConsoleAuthService.RevokeAllOtherSessions.
+func (c *consoleAuthServiceClient) RevokeAllOtherSessions(ctx context.Context, req *connect.Request[v1.RevokeAllOtherSessionsRequest]) (*connect.Response[v1.RevokeAllOtherSessionsResponse], error) {
+	return c.revokeAllOtherSessions.CallUnary(ctx, req)
+}
+
+// GetCurrentOrganization calls keyhub.console.v1.ConsoleAuthService.GetCurrentOrganization.
+func (c *consoleAuthServiceClient) GetCurrentOrganization(ctx context.Context, req *connect.Request[v1.GetCurrentOrganizationRequest]) (*connect.Response[v1.GetCurrentOrganizationResponse], error) {
+	return c.getCurrentOrganization.CallUnary(ctx, req)
+}
+
 // ConsoleAuthServiceHandler is an implementation of the keyhub.console.v1.ConsoleAuthService
 // service.
 type ConsoleAuthServiceHandler interface {
@@ -98,6 +167,14 @@ type ConsoleAuthServiceHandler interface {
 	LoginWithOrgId(context.Context, *connect.Request[v1.LoginWithOrgIdRequest]) (*connect.Response[v1.LoginWithOrgIdResponse], error)
 	// ログアウト
 	Logout(context.Context, *connect.Request[v1.LogoutRequest]) (*connect.Response[v1.LogoutResponse], error)
+	// 組織のログイン中のセッション一覧取得
+	ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error)
+	// 指定したセッションを無効化
+	RevokeSession(context.Context, *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error)
+	// 現在のセッション以外をすべて無効化
+	RevokeAllOtherSessions(context.Context, *connect.Request[v1.RevokeAllOtherSessionsRequest]) (*connect.Response[v1.RevokeAllOtherSessionsResponse], error)
+	// ログイン中の組織情報取得
+	GetCurrentOrganization(context.Context, *connect.Request[v1.GetCurrentOrganizationRequest]) (*connect.Response[v1.GetCurrentOrganizationResponse], error)
 }
 
 // NewConsoleAuthServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -119,12 +196,45 @@ func NewConsoleAuthServiceHandler(svc ConsoleAuthServiceHandler, opts ...connect
 		connect.WithSchema(consoleAuthServiceMethods.ByName("Logout")),
 		connect.WithHandlerOptions(opts...),
 	)
+	consoleAuthServiceListSessionsHandler := connect.NewUnaryHandler(
+		ConsoleAuthServiceListSessionsProcedure,
+		svc.ListSessions,
+		connect.WithSchema(consoleAuthServiceMethods.ByName("ListSessions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	consoleAuthServiceRevokeSessionHandler := connect.NewUnaryHandler(
+		ConsoleAuthServiceRevokeSessionProcedure,
+		svc.RevokeSession,
+		connect.WithSchema(consoleAuthServiceMethods.ByName("RevokeSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	consoleAuthServiceRevokeAllOtherSessionsHandler := connect.NewUnaryHandler(
+		ConsoleAuthServiceRevokeAllOtherSessionsProcedure,
+		svc.RevokeAllOtherSessions,
+		connect.WithSchema(consoleAuthServiceMethods.ByName("RevokeAllOtherSessions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	consoleAuthServiceGetCurrentOrganizationHandler := connect.NewUnaryHandler(
+		ConsoleAuthServiceGetCurrentOrganizationProcedure,
+		svc.GetCurrentOrganization,
+		connect.WithSchema(consoleAuthServiceMethods.ByName("GetCurrentOrganization")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/keyhub.console.v1.ConsoleAuthService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ConsoleAuthServiceLoginWithOrgIdProcedure:
 			consoleAuthServiceLoginWithOrgIdHandler.ServeHTTP(w, r)
 		case ConsoleAuthServiceLogoutProcedure:
 			consoleAuthServiceLogoutHandler.ServeHTTP(w, r)
+		case ConsoleAuthServiceListSessionsProcedure:
+			consoleAuthServiceListSessionsHandler.ServeHTTP(w, r)
+		case ConsoleAuthServiceRevokeSessionProcedure:
+			consoleAuthServiceRevokeSessionHandler.ServeHTTP(w, r)
+		case ConsoleAuthServiceRevokeAllOtherSessionsProcedure:
+			consoleAuthServiceRevokeAllOtherSessionsHandler.ServeHTTP(w, r)
+		case ConsoleAuthServiceGetCurrentOrganizationProcedure:
+			consoleAuthServiceGetCurrentOrganizationHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -141,3 +251,19 @@ func (UnimplementedConsoleAuthServiceHandler) LoginWithOrgId(context.Context, *c
 func (UnimplementedConsoleAuthServiceHandler) Logout(context.Context, *connect.Request[v1.LogoutRequest]) (*connect.Response[v1.LogoutResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleAuthService.Logout is not implemented"))
 }
+
+func (UnimplementedConsoleAuthServiceHandler) ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleAuthService.ListSessions is not implemented"))
+}
+
+func (UnimplementedConsoleAuthServiceHandler) RevokeSession(context.Context, *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleAuthService.RevokeSession is not implemented"))
+}
+
+func (UnimplementedConsoleAuthServiceHandler) RevokeAllOtherSessions(context.Context, *connect.Request[v1.RevokeAllOtherSessionsRequest]) (*connect.Response[v1.RevokeAllOtherSessionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleAuthService.RevokeAllOtherSessions is not implemented"))
+}
+
+func (UnimplementedConsoleAuthServiceHandler) GetCurrentOrganization(context.Context, *connect.Request[v1.GetCurrentOrganizationRequest]) (*connect.Response[v1.GetCurrentOrganizationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleAuthService.GetCurrentOrganization is not implemented"))
+}
@@ -0,0 +1,301 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: keyhub/console/v1/building.proto
+
+package consolev1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ConsoleBuildingServiceName is the fully-qualified name of the ConsoleBuildingService service.
+	ConsoleBuildingServiceName = "keyhub.console.v1.ConsoleBuildingService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ConsoleBuildingServiceCreateBuildingProcedure is the fully-qualified name of the
+	// ConsoleBuildingService's CreateBuilding RPC.
+	ConsoleBuildingServiceCreateBuildingProcedure = "/keyhub.console.v1.ConsoleBuildingService/CreateBuilding"
+	// ConsoleBuildingServiceListBuildingsProcedure is the fully-qualified name of the
+	// ConsoleBuildingService's ListBuildings RPC.
+	ConsoleBuildingServiceListBuildingsProcedure = "/keyhub.console.v1.ConsoleBuildingService/ListBuildings"
+	// ConsoleBuildingServiceUpdateBuildingProcedure is the fully-qualified name of the
+	// ConsoleBuildingService's UpdateBuilding RPC.
+	ConsoleBuildingServiceUpdateBuildingProcedure = "/keyhub.console.v1.ConsoleBuildingService/UpdateBuilding"
+	// ConsoleBuildingServiceDeleteBuildingProcedure is the fully-qualified name of the
+	// ConsoleBuildingService's DeleteBuilding RPC.
+	ConsoleBuildingServiceDeleteBuildingProcedure = "/keyhub.console.v1.ConsoleBuildingService/DeleteBuilding"
+	// ConsoleBuildingServiceCreateFloorProcedure is the fully-qualified name of the
+	// ConsoleBuildingService's CreateFloor RPC.
+	ConsoleBuildingServiceCreateFloorProcedure = "/keyhub.console.v1.ConsoleBuildingService/CreateFloor"
+	// ConsoleBuildingServiceUpdateFloorProcedure is the fully-qualified name of the
+	// ConsoleBuildingService's UpdateFloor RPC.
+	ConsoleBuildingServiceUpdateFloorProcedure = "/keyhub.console.v1.ConsoleBuildingService/UpdateFloor"
+	// ConsoleBuildingServiceDeleteFloorProcedure is the fully-qualified name of the
+	// ConsoleBuildingService's DeleteFloor RPC.
+	ConsoleBuildingServiceDeleteFloorProcedure = "/keyhub.console.v1.ConsoleBuildingService/DeleteFloor"
+)
+
+// ConsoleBuildingServiceClient is a client for the keyhub.console.v1.ConsoleBuildingService
+// service.
+type ConsoleBuildingServiceClient interface {
+	// 建物作成（名前は組織内で一意）
+	CreateBuilding(context.Context, *connect.Request[v1.CreateBuildingRequest]) (*connect.Response[v1.CreateBuildingResponse], error)
+	// 建物と階の一覧取得（建物は名前順、階は level の昇順）
+	ListBuildings(context.Context, *connect.Request[v1.ListBuildingsRequest]) (*connect.Response[v1.ListBuildingsResponse], error)
+	// 建物の名前・説明を更新（部屋の建物名にも反映される）
+	UpdateBuilding(context.Context, *connect.Request[v1.UpdateBuildingRequest]) (*connect.Response[v1.UpdateBuildingResponse], error)
+	// 建物削除（階が残っている建物は削除できない）
+	DeleteBuilding(context.Context, *connect.Request[v1.DeleteBuildingRequest]) (*connect.Response[v1.DeleteBuildingResponse], error)
+	// 階作成（名前は建物内で一意）
+	CreateFloor(context.Context, *connect.Request[v1.CreateFloorRequest]) (*connect.Response[v1.CreateFloorResponse], error)
+	// 階の名前・並び順を更新（部屋の階にも反映される）
+	UpdateFloor(context.Context, *connect.Request[v1.UpdateFloorRequest]) (*connect.Response[v1.UpdateFloorResponse], error)
+	// 階削除（部屋が残っている階は削除できない）
+	DeleteFloor(context.Context, *connect.Request[v1.DeleteFloorRequest]) (*connect.Response[v1.DeleteFloorResponse], error)
+}
+
+// NewConsoleBuildingServiceClient constructs a client for the
+// keyhub.console.v1.ConsoleBuildingService service. By default, it uses the Connect protocol with
+// the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed requests. To use
+// the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewConsoleBuildingServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ConsoleBuildingServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	consoleBuildingServiceMethods := v1.File_keyhub_console_v1_building_proto.Services().ByName("ConsoleBuildingService").Methods()
+	return &consoleBuildingServiceClient{
+		createBuilding: connect.NewClient[v1.CreateBuildingRequest, v1.CreateBuildingResponse](
+			httpClient,
+			baseURL+ConsoleBuildingServiceCreateBuildingProcedure,
+			connect.WithSchema(consoleBuildingServiceMethods.ByName("CreateBuilding")),
+			connect.WithClientOptions(opts...),
+		),
+		listBuildings: connect.NewClient[v1.ListBuildingsRequest, v1.ListBuildingsResponse](
+			httpClient,
+			baseURL+ConsoleBuildingServiceListBuildingsProcedure,
+			connect.WithSchema(consoleBuildingServiceMethods.ByName("ListBuildings")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		updateBuilding: connect.NewClient[v1.UpdateBuildingRequest, v1.UpdateBuildingResponse](
+			httpClient,
+			baseURL+ConsoleBuildingServiceUpdateBuildingProcedure,
+			connect.WithSchema(consoleBuildingServiceMethods.ByName("UpdateBuilding")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteBuilding: connect.NewClient[v1.DeleteBuildingRequest, v1.DeleteBuildingResponse](
+			httpClient,
+			baseURL+ConsoleBuildingServiceDeleteBuildingProcedure,
+			connect.WithSchema(consoleBuildingServiceMethods.ByName("DeleteBuilding")),
+			connect.WithClientOptions(opts...),
+		),
+		createFloor: connect.NewClient[v1.CreateFloorRequest, v1.CreateFloorResponse](
+			httpClient,
+			baseURL+ConsoleBuildingServiceCreateFloorProcedure,
+			connect.WithSchema(consoleBuildingServiceMethods.ByName("CreateFloor")),
+			connect.WithClientOptions(opts...),
+		),
+		updateFloor: connect.NewClient[v1.UpdateFloorRequest, v1.UpdateFloorResponse](
+			httpClient,
+			baseURL+ConsoleBuildingServiceUpdateFloorProcedure,
+			connect.WithSchema(consoleBuildingServiceMethods.ByName("UpdateFloor")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteFloor: connect.NewClient[v1.DeleteFloorRequest, v1.DeleteFloorResponse](
+			httpClient,
+			baseURL+ConsoleBuildingServiceDeleteFloorProcedure,
+			connect.WithSchema(consoleBuildingServiceMethods.ByName("DeleteFloor")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// consoleBuildingServiceClient implements ConsoleBuildingServiceClient.
+type consoleBuildingServiceClient struct {
+	createBuilding *connect.Client[v1.CreateBuildingRequest, v1.CreateBuildingResponse]
+	listBuildings  *connect.Client[v1.ListBuildingsRequest, v1.ListBuildingsResponse]
+	updateBuilding *connect.Client[v1.UpdateBuildingRequest, v1.UpdateBuildingResponse]
+	deleteBuilding *connect.Client[v1.DeleteBuildingRequest, v1.DeleteBuildingResponse]
+	createFloor    *connect.Client[v1.CreateFloorRequest, v1.CreateFloorResponse]
+	updateFloor    *connect.Client[v1.UpdateFloorRequest, v1.UpdateFloorResponse]
+	deleteFloor    *connect.Client[v1.DeleteFloorRequest, v1.DeleteFloorResponse]
+}
+
+// CreateBuilding calls keyhub.console.v1.ConsoleBuildingService.CreateBuilding.
+func (c *consoleBuildingServiceClient) CreateBuilding(ctx context.Context, req *connect.Request[v1.CreateBuildingRequest]) (*connect.Response[v1.CreateBuildingResponse], error) {
+	return c.createBuilding.CallUnary(ctx, req)
+}
+
+// ListBuildings calls keyhub.console.v1.ConsoleBuildingService.ListBuildings.
+func (c *consoleBuildingServiceClient) ListBuildings(ctx context.Context, req *connect.Request[v1.ListBuildingsRequest]) (*connect.Response[v1.ListBuildingsResponse], error) {
+	return c.listBuildings.CallUnary(ctx, req)
+}
+
+// UpdateBuilding calls keyhub.console.v1.ConsoleBuildingService.UpdateBuilding.
+func (c *consoleBuildingServiceClient) UpdateBuilding(ctx context.Context, req *connect.Request[v1.UpdateBuildingRequest]) (*connect.Response[v1.UpdateBuildingResponse], error) {
+	return c.updateBuilding.CallUnary(ctx, req)
+}
+
+// DeleteBuilding calls keyhub.console.v1.ConsoleBuildingService.DeleteBuilding.
+func (c *consoleBuildingServiceClient) DeleteBuilding(ctx context.Context, req *connect.Request[v1.DeleteBuildingRequest]) (*connect.Response[v1.DeleteBuildingResponse], error) {
+	return c.deleteBuilding.CallUnary(ctx, req)
+}
+
+// CreateFloor calls keyhub.console.v1.ConsoleBuildingService.CreateFloor.
+func (c *consoleBuildingServiceClient) CreateFloor(ctx context.Context, req *connect.Request[v1.CreateFloorRequest]) (*connect.Response[v1.CreateFloorResponse], error) {
+	return c.createFloor.CallUnary(ctx, req)
+}
+
+// UpdateFloor calls keyhub.console.v1.ConsoleBuildingService.UpdateFloor.
+func (c *consoleBuildingServiceClient) UpdateFloor(ctx context.Context, req *connect.Request[v1.UpdateFloorRequest]) (*connect.Response[v1.UpdateFloorResponse], error) {
+	return c.updateFloor.CallUnary(ctx, req)
+}
+
+// DeleteFloor calls keyhub.console.v1.ConsoleBuildingService.DeleteFloor.
+func (c *consoleBuildingServiceClient) DeleteFloor(ctx context.Context, req *connect.Request[v1.DeleteFloorRequest]) (*connect.Response[v1.DeleteFloorResponse], error) {
+	return c.deleteFloor.CallUnary(ctx, req)
+}
+
+// ConsoleBuildingServiceHandler is an implementation of the
+// keyhub.console.v1.ConsoleBuildingService service.
+type ConsoleBuildingServiceHandler interface {
+	// 建物作成（名前は組織内で一意）
+	CreateBuilding(context.Context, *connect.Request[v1.CreateBuildingRequest]) (*connect.Response[v1.CreateBuildingResponse], error)
+	// 建物と階の一覧取得（建物は名前順、階は level の昇順）
+	ListBuildings(context.Context, *connect.Request[v1.ListBuildingsRequest]) (*connect.Response[v1.ListBuildingsResponse], error)
+	// 建物の名前・説明を更新（部屋の建物名にも反映される）
+	UpdateBuilding(context.Context, *connect.Request[v1.UpdateBuildingRequest]) (*connect.Response[v1.UpdateBuildingResponse], error)
+	// 建物削除（階が残っている建物は削除できない）
+	DeleteBuilding(context.Context, *connect.Request[v1.DeleteBuildingRequest]) (*connect.Response[v1.DeleteBuildingResponse], error)
+	// 階作成（名前は建物内で一意）
+	CreateFloor(context.Context, *connect.Request[v1.CreateFloorRequest]) (*connect.Response[v1.CreateFloorResponse], error)
+	// 階の名前・並び順を更新（部屋の階にも反映される）
+	UpdateFloor(context.Context, *connect.Request[v1.UpdateFloorRequest]) (*connect.Response[v1.UpdateFloorResponse], error)
+	// 階削除（部屋が残っている階は削除できない）
+	DeleteFloor(context.Context, *connect.Request[v1.DeleteFloorRequest]) (*connect.Response[v1.DeleteFloorResponse], error)
+}
+
+// NewConsoleBuildingServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewConsoleBuildingServiceHandler(svc ConsoleBuildingServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	consoleBuildingServiceMethods := v1.File_keyhub_console_v1_building_proto.Services().ByName("ConsoleBuildingService").Methods()
+	consoleBuildingServiceCreateBuildingHandler := connect.NewUnaryHandler(
+		ConsoleBuildingServiceCreateBuildingProcedure,
+		svc.CreateBuilding,
+		connect.WithSchema(consoleBuildingServiceMethods.ByName("CreateBuilding")),
+		connect.WithHandlerOptions(opts...),
+	)
+	consoleBuildingServiceListBuildingsHandler := connect.NewUnaryHandler(
+		ConsoleBuildingServiceListBuildingsProcedure,
+		svc.ListBuildings,
+		connect.WithSchema(consoleBuildingServiceMethods.ByName("ListBuildings")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	consoleBuildingServiceUpdateBuildingHandler := connect.NewUnaryHandler(
+		ConsoleBuildingServiceUpdateBuildingProcedure,
+		svc.UpdateBuilding,
+		connect.WithSchema(consoleBuildingServiceMethods.ByName("UpdateBuilding")),
+		connect.WithHandlerOptions(opts...),
+	)
+	consoleBuildingServiceDeleteBuildingHandler := connect.NewUnaryHandler(
+		ConsoleBuildingServiceDeleteBuildingProcedure,
+		svc.DeleteBuilding,
+		connect.WithSchema(consoleBuildingServiceMethods.ByName("DeleteBuilding")),
+		connect.WithHandlerOptions(opts...),
+	)
+	consoleBuildingServiceCreateFloorHandler := connect.NewUnaryHandler(
+		ConsoleBuildingServiceCreateFloorProcedure,
+		svc.CreateFloor,
+		connect.WithSchema(consoleBuildingServiceMethods.ByName("CreateFloor")),
+		connect.WithHandlerOptions(opts...),
+	)
+	consoleBuildingServiceUpdateFloorHandler := connect.NewUnaryHandler(
+		ConsoleBuildingServiceUpdateFloorProcedure,
+		svc.UpdateFloor,
+		connect.WithSchema(consoleBuildingServiceMethods.ByName("UpdateFloor")),
+		connect.WithHandlerOptions(opts...),
+	)
+	consoleBuildingServiceDeleteFloorHandler := connect.NewUnaryHandler(
+		ConsoleBuildingServiceDeleteFloorProcedure,
+		svc.DeleteFloor,
+		connect.WithSchema(consoleBuildingServiceMethods.ByName("DeleteFloor")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/keyhub.console.v1.ConsoleBuildingService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ConsoleBuildingServiceCreateBuildingProcedure:
+			consoleBuildingServiceCreateBuildingHandler.ServeHTTP(w, r)
+		case ConsoleBuildingServiceListBuildingsProcedure:
+			consoleBuildingServiceListBuildingsHandler.ServeHTTP(w, r)
+		case ConsoleBuildingServiceUpdateBuildingProcedure:
+			consoleBuildingServiceUpdateBuildingHandler.ServeHTTP(w, r)
+		case ConsoleBuildingServiceDeleteBuildingProcedure:
+			consoleBuildingServiceDeleteBuildingHandler.ServeHTTP(w, r)
+		case ConsoleBuildingServiceCreateFloorProcedure:
+			consoleBuildingServiceCreateFloorHandler.ServeHTTP(w, r)
+		case ConsoleBuildingServiceUpdateFloorProcedure:
+			consoleBuildingServiceUpdateFloorHandler.ServeHTTP(w, r)
+		case ConsoleBuildingServiceDeleteFloorProcedure:
+			consoleBuildingServiceDeleteFloorHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedConsoleBuildingServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedConsoleBuildingServiceHandler struct{}
+
+func (UnimplementedConsoleBuildingServiceHandler) CreateBuilding(context.Context, *connect.Request[v1.CreateBuildingRequest]) (*connect.Response[v1.CreateBuildingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleBuildingService.CreateBuilding is not implemented"))
+}
+
+func (UnimplementedConsoleBuildingServiceHandler) ListBuildings(context.Context, *connect.Request[v1.ListBuildingsRequest]) (*connect.Response[v1.ListBuildingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleBuildingService.ListBuildings is not implemented"))
+}
+
+func (UnimplementedConsoleBuildingServiceHandler) UpdateBuilding(context.Context, *connect.Request[v1.UpdateBuildingRequest]) (*connect.Response[v1.UpdateBuildingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleBuildingService.UpdateBuilding is not implemented"))
+}
+
+func (UnimplementedConsoleBuildingServiceHandler) DeleteBuilding(context.Context, *connect.Request[v1.DeleteBuildingRequest]) (*connect.Response[v1.DeleteBuildingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleBuildingService.DeleteBuilding is not implemented"))
+}
+
+func (UnimplementedConsoleBuildingServiceHandler) CreateFloor(context.Context, *connect.Request[v1.CreateFloorRequest]) (*connect.Response[v1.CreateFloorResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleBuildingService.CreateFloor is not implemented"))
+}
+
+func (UnimplementedConsoleBuildingServiceHandler) UpdateFloor(context.Context, *connect.Request[v1.UpdateFloorRequest]) (*connect.Response[v1.UpdateFloorResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleBuildingService.UpdateFloor is not implemented"))
+}
+
+func (UnimplementedConsoleBuildingServiceHandler) DeleteFloor(context.Context, *connect.Request[v1.DeleteFloorRequest]) (*connect.Response[v1.DeleteFloorResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleBuildingService.DeleteFloor is not implemented"))
+}
@@ -36,17 +36,30 @@ const (
 	// ConsoleKeyServiceCreateKeyProcedure is the fully-qualified name of the ConsoleKeyService's
 	// CreateKey RPC.
 	ConsoleKeyServiceCreateKeyProcedure = "/keyhub.console.v1.ConsoleKeyService/CreateKey"
+	// ConsoleKeyServiceUpdateKeyProcedure is the fully-qualified name of the ConsoleKeyService's
+	// UpdateKey RPC.
+	ConsoleKeyServiceUpdateKeyProcedure = "/keyhub.console.v1.ConsoleKeyService/UpdateKey"
 	// ConsoleKeyServiceGetKeysByRoomProcedure is the fully-qualified name of the ConsoleKeyService's
 	// GetKeysByRoom RPC.
 	ConsoleKeyServiceGetKeysByRoomProcedure = "/keyhub.console.v1.ConsoleKeyService/GetKeysByRoom"
+	// ConsoleKeyServiceWatchKeysProcedure is the fully-qualified name of the ConsoleKeyService's
+	// WatchKeys RPC.
+	ConsoleKeyServiceWatchKeysProcedure = "/keyhub.console.v1.ConsoleKeyService/WatchKeys"
 )
 
 // ConsoleKeyServiceClient is a client for the keyhub.console.v1.ConsoleKeyService service.
 type ConsoleKeyServiceClient interface {
 	// 鍵を作成（Roomに紐付けて作成）
 	CreateKey(context.Context, *connect.Request[v1.CreateKeyRequest]) (*connect.Response[v1.CreateKeyResponse], error)
+	// 鍵番号を変更
+	// 取得後に他の管理者が更新していた場合は ABORTED を返す
+	UpdateKey(context.Context, *connect.Request[v1.UpdateKeyRequest]) (*connect.Response[v1.UpdateKeyResponse], error)
 	// Roomに紐付く鍵一覧を取得
 	GetKeysByRoom(context.Context, *connect.Request[v1.GetKeysByRoomRequest]) (*connect.Response[v1.GetKeysByRoomResponse], error)
+	// 鍵の状態の変化を購読する（サーバーストリーミング）
+	// 最初に現在の鍵の一覧を返し、その後は鍵が変更されるたびに返す。
+	// 変更を取りこぼした可能性がある場合は UNAVAILABLE で終了するため、購読し直す
+	WatchKeys(context.Context, *connect.Request[v1.WatchKeysRequest]) (*connect.ServerStreamForClient[v1.WatchKeysResponse], error)
 }
 
 // NewConsoleKeyServiceClient constructs a client for the keyhub.console.v1.ConsoleKeyService
@@ -66,19 +79,34 @@ func NewConsoleKeyServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(consoleKeyServiceMethods.ByName("CreateKey")),
 			connect.WithClientOptions(opts...),
 		),
+		updateKey: connect.NewClient[v1.UpdateKeyRequest, v1.UpdateKeyResponse](
+			httpClient,
+			baseURL+ConsoleKeyServiceUpdateKeyProcedure,
+			connect.WithSchema(consoleKeyServiceMethods.ByName("UpdateKey")),
+			connect.WithClientOptions(opts...),
+		),
 		getKeysByRoom: connect.NewClient[v1.GetKeysByRoomRequest, v1.GetKeysByRoomResponse](
 			httpClient,
 			baseURL+ConsoleKeyServiceGetKeysByRoomProcedure,
 			connect.WithSchema(consoleKeyServiceMethods.ByName("GetKeysByRoom")),
 			connect.WithClientOptions(opts...),
 		),
+		watchKeys: connect.NewClient[v1.WatchKeysRequest, v1.WatchKeysResponse](
+			httpClient,
+			baseURL+ConsoleKeyServiceWatchKeysProcedure,
+			connect.WithSchema(consoleKeyServiceMethods.ByName("WatchKeys")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // consoleKeyServiceClient implements ConsoleKeyServiceClient.
 type consoleKeyServiceClient struct {
 	createKey     *connect.Client[v1.CreateKeyRequest, v1.CreateKeyResponse]
+	updateKey     *connect.Client[v1.UpdateKeyRequest, v1.UpdateKeyResponse]
 	getKeysByRoom *connect.Client[v1.GetKeysByRoomRequest, v1.GetKeysByRoomResponse]
+	watchKeys     *connect.Client[v1.WatchKeysRequest, v1.WatchKeysResponse]
 }
 
 // CreateKey calls keyhub.console.v1.ConsoleKeyService.CreateKey.
@@ -86,17 +114,34 @@ func (c *consoleKeyServiceClient) CreateKey(ctx context.Context, req *connect.Re
 	return c.createKey.CallUnary(ctx, req)
 }
 
+// UpdateKey calls keyhub.console.v1.ConsoleKeyService.UpdateKey.
+func (c *consoleKeyServiceClient) UpdateKey(ctx context.Context, req *connect.Request[v1.UpdateKeyRequest]) (*connect.Response[v1.UpdateKeyResponse], error) {
+	return c.updateKey.CallUnary(ctx, req)
+}
+
 // GetKeysByRoom calls keyhub.console.v1.ConsoleKeyService.GetKeysByRoom.
 func (c *consoleKeyServiceClient) GetKeysByRoom(ctx context.Context, req *connect.Request[v1.GetKeysByRoomRequest]) (*connect.Response[v1.GetKeysByRoomResponse], error) {
 	return c.getKeysByRoom.CallUnary(ctx, req)
 }
 
+// WatchKeys calls keyhub.console.v1.ConsoleKeyService.WatchKeys.
+func (c *consoleKeyServiceClient) WatchKeys(ctx context.Context, req *connect.Request[v1.WatchKeysRequest]) (*connect.ServerStreamForClient[v1.WatchKeysResponse], error) {
+	return c.watchKeys.CallServerStream(ctx, req)
+}
+
 // ConsoleKeyServiceHandler is an implementation of the keyhub.console.v1.ConsoleKeyService service.
 type ConsoleKeyServiceHandler interface {
 	// 鍵を作成（Roomに紐付けて作成）
 	CreateKey(context.Context, *connect.Request[v1.CreateKeyRequest]) (*connect.Response[v1.CreateKeyResponse], error)
+	// 鍵番号を変更
+	// 取得後に他の管理者が更新していた場合は ABORTED を返す
+	UpdateKey(context.Context, *connect.Request[v1.UpdateKeyRequest]) (*connect.Response[v1.UpdateKeyResponse], error)
 	// Roomに紐付く鍵一覧を取得
 	GetKeysByRoom(context.Context, *connect.Request[v1.GetKeysByRoomRequest]) (*connect.Response[v1.GetKeysByRoomResponse], error)
+	// 鍵の状態の変化を購読する（サーバーストリーミング）
+	// 最初に現在の鍵の一覧を返し、その後は鍵が変更されるたびに返す。
+	// 変更を取りこぼした可能性がある場合は UNAVAILABLE で終了するため、購読し直す
+	WatchKeys(context.Context, *connect.Request[v1.WatchKeysRequest], *connect.ServerStream[v1.WatchKeysResponse]) error
 }
 
 // NewConsoleKeyServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -112,18 +157,35 @@ func NewConsoleKeyServiceHandler(svc ConsoleKeyServiceHandler, opts ...connect.H
 		connect.WithSchema(consoleKeyServiceMethods.ByName("CreateKey")),
 		connect.WithHandlerOptions(opts...),
 	)
+	consoleKeyServiceUpdateKeyHandler := connect.NewUnaryHandler(
+		ConsoleKeyServiceUpdateKeyProcedure,
+		svc.UpdateKey,
+		connect.WithSchema(consoleKeyServiceMethods.ByName("UpdateKey")),
+		connect.WithHandlerOptions(opts...),
+	)
 	consoleKeyServiceGetKeysByRoomHandler := connect.NewUnaryHandler(
 		ConsoleKeyServiceGetKeysByRoomProcedure,
 		svc.GetKeysByRoom,
 		connect.WithSchema(consoleKeyServiceMethods.ByName("GetKeysByRoom")),
 		connect.WithHandlerOptions(opts...),
 	)
+	consoleKeyServiceWatchKeysHandler := connect.NewServerStreamHandler(
+		ConsoleKeyServiceWatchKeysProcedure,
+		svc.WatchKeys,
+		connect.WithSchema(consoleKeyServiceMethods.ByName("WatchKeys")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/keyhub.console.v1.ConsoleKeyService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ConsoleKeyServiceCreateKeyProcedure:
 			consoleKeyServiceCreateKeyHandler.ServeHTTP(w, r)
+		case ConsoleKeyServiceUpdateKeyProcedure:
+			consoleKeyServiceUpdateKeyHandler.ServeHTTP(w, r)
 		case ConsoleKeyServiceGetKeysByRoomProcedure:
 			consoleKeyServiceGetKeysByRoomHandler.ServeHTTP(w, r)
+		case ConsoleKeyServiceWatchKeysProcedure:
+			consoleKeyServiceWatchKeysHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -137,6 +199,14 @@ func (UnimplementedConsoleKeyServiceHandler) CreateKey(context.Context, *connect
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleKeyService.CreateKey is not implemented"))
 }
 
+func (UnimplementedConsoleKeyServiceHandler) UpdateKey(context.Context, *connect.Request[v1.UpdateKeyRequest]) (*connect.Response[v1.UpdateKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleKeyService.UpdateKey is not implemented"))
+}
+
 func (UnimplementedConsoleKeyServiceHandler) GetKeysByRoom(context.Context, *connect.Request[v1.GetKeysByRoomRequest]) (*connect.Response[v1.GetKeysByRoomResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleKeyService.GetKeysByRoom is not implemented"))
 }
+
+func (UnimplementedConsoleKeyServiceHandler) WatchKeys(context.Context, *connect.Request[v1.WatchKeysRequest], *connect.ServerStream[v1.WatchKeysResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleKeyService.WatchKeys is not implemented"))
+}
@@ -0,0 +1,177 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: keyhub/console/v1/operator.proto
+
+package consolev1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ConsoleOperatorServiceName is the fully-qualified name of the ConsoleOperatorService service.
+	ConsoleOperatorServiceName = "keyhub.console.v1.ConsoleOperatorService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ConsoleOperatorServiceCreateOperatorProcedure is the fully-qualified name of the
+	// ConsoleOperatorService's CreateOperator RPC.
+	ConsoleOperatorServiceCreateOperatorProcedure = "/keyhub.console.v1.ConsoleOperatorService/CreateOperator"
+	// ConsoleOperatorServiceListOperatorsProcedure is the fully-qualified name of the
+	// ConsoleOperatorService's ListOperators RPC.
+	ConsoleOperatorServiceListOperatorsProcedure = "/keyhub.console.v1.ConsoleOperatorService/ListOperators"
+	// ConsoleOperatorServiceRevokeOperatorProcedure is the fully-qualified name of the
+	// ConsoleOperatorService's RevokeOperator RPC.
+	ConsoleOperatorServiceRevokeOperatorProcedure = "/keyhub.console.v1.ConsoleOperatorService/RevokeOperator"
+)
+
+// ConsoleOperatorServiceClient is a client for the keyhub.console.v1.ConsoleOperatorService
+// service.
+type ConsoleOperatorServiceClient interface {
+	// 管理者登録（ログインキーはこのレスポンスでのみ返す）
+	CreateOperator(context.Context, *connect.Request[v1.CreateOperatorRequest]) (*connect.Response[v1.CreateOperatorResponse], error)
+	// 有効な管理者一覧取得
+	ListOperators(context.Context, *connect.Request[v1.ListOperatorsRequest]) (*connect.Response[v1.ListOperatorsResponse], error)
+	// 管理者の無効化（その管理者のセッションもすべて削除する）
+	RevokeOperator(context.Context, *connect.Request[v1.RevokeOperatorRequest]) (*connect.Response[v1.RevokeOperatorResponse], error)
+}
+
+// NewConsoleOperatorServiceClient constructs a client for the
+// keyhub.console.v1.ConsoleOperatorService service. By default, it uses the Connect protocol with
+// the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed requests. To use
+// the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewConsoleOperatorServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ConsoleOperatorServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	consoleOperatorServiceMethods := v1.File_keyhub_console_v1_operator_proto.Services().ByName("ConsoleOperatorService").Methods()
+	return &consoleOperatorServiceClient{
+		createOperator: connect.NewClient[v1.CreateOperatorRequest, v1.CreateOperatorResponse](
+			httpClient,
+			baseURL+ConsoleOperatorServiceCreateOperatorProcedure,
+			connect.WithSchema(consoleOperatorServiceMethods.ByName("CreateOperator")),
+			connect.WithClientOptions(opts...),
+		),
+		listOperators: connect.NewClient[v1.ListOperatorsRequest, v1.ListOperatorsResponse](
+			httpClient,
+			baseURL+ConsoleOperatorServiceListOperatorsProcedure,
+			connect.WithSchema(consoleOperatorServiceMethods.ByName("ListOperators")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		revokeOperator: connect.NewClient[v1.RevokeOperatorRequest, v1.RevokeOperatorResponse](
+			httpClient,
+			baseURL+ConsoleOperatorServiceRevokeOperatorProcedure,
+			connect.WithSchema(consoleOperatorServiceMethods.ByName("RevokeOperator")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// consoleOperatorServiceClient implements ConsoleOperatorServiceClient.
+type consoleOperatorServiceClient struct {
+	createOperator *connect.Client[v1.CreateOperatorRequest, v1.CreateOperatorResponse]
+	listOperators  *connect.Client[v1.ListOperatorsRequest, v1.ListOperatorsResponse]
+	revokeOperator *connect.Client[v1.RevokeOperatorRequest, v1.RevokeOperatorResponse]
+}
+
+// CreateOperator calls keyhub.console.v1.ConsoleOperatorService.CreateOperator.
+func (c *consoleOperatorServiceClient) CreateOperator(ctx context.Context, req *connect.Request[v1.CreateOperatorRequest]) (*connect.Response[v1.CreateOperatorResponse], error) {
+	return c.createOperator.CallUnary(ctx, req)
+}
+
+// ListOperators calls keyhub.console.v1.ConsoleOperatorService.ListOperators.
+func (c *consoleOperatorServiceClient) ListOperators(ctx context.Context, req *connect.Request[v1.ListOperatorsRequest]) (*connect.Response[v1.ListOperatorsResponse], error) {
+	return c.listOperators.CallUnary(ctx, req)
+}
+
+// RevokeOperator calls keyhub.console.v1.ConsoleOperatorService.RevokeOperator.
+func (c *consoleOperatorServiceClient) RevokeOperator(ctx context.Context, req *connect.Request[v1.RevokeOperatorRequest]) (*connect.Response[v1.RevokeOperatorResponse], error) {
+	return c.revokeOperator.CallUnary(ctx, req)
+}
+
+// ConsoleOperatorServiceHandler is an implementation of the
+// keyhub.console.v1.ConsoleOperatorService service.
+type ConsoleOperatorServiceHandler interface {
+	// 管理者登録（ログインキーはこのレスポンスでのみ返す）
+	CreateOperator(context.Context, *connect.Request[v1.CreateOperatorRequest]) (*connect.Response[v1.CreateOperatorResponse], error)
+	// 有効な管理者一覧取得
+	ListOperators(context.Context, *connect.Request[v1.ListOperatorsRequest]) (*connect.Response[v1.ListOperatorsResponse], error)
+	// 管理者の無効化（その管理者のセッションもすべて削除する）
+	RevokeOperator(context.Context, *connect.Request[v1.RevokeOperatorRequest]) (*connect.Response[v1.RevokeOperatorResponse], error)
+}
+
+// NewConsoleOperatorServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewConsoleOperatorServiceHandler(svc ConsoleOperatorServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	consoleOperatorServiceMethods := v1.File_keyhub_console_v1_operator_proto.Services().ByName("ConsoleOperatorService").Methods()
+	consoleOperatorServiceCreateOperatorHandler := connect.NewUnaryHandler(
+		ConsoleOperatorServiceCreateOperatorProcedure,
+		svc.CreateOperator,
+		connect.WithSchema(consoleOperatorServiceMethods.ByName("CreateOperator")),
+		connect.WithHandlerOptions(opts...),
+	)
+	consoleOperatorServiceListOperatorsHandler := connect.NewUnaryHandler(
+		ConsoleOperatorServiceListOperatorsProcedure,
+		svc.ListOperators,
+		connect.WithSchema(consoleOperatorServiceMethods.ByName("ListOperators")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	consoleOperatorServiceRevokeOperatorHandler := connect.NewUnaryHandler(
+		ConsoleOperatorServiceRevokeOperatorProcedure,
+		svc.RevokeOperator,
+		connect.WithSchema(consoleOperatorServiceMethods.ByName("RevokeOperator")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/keyhub.console.v1.ConsoleOperatorService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ConsoleOperatorServiceCreateOperatorProcedure:
+			consoleOperatorServiceCreateOperatorHandler.ServeHTTP(w, r)
+		case ConsoleOperatorServiceListOperatorsProcedure:
+			consoleOperatorServiceListOperatorsHandler.ServeHTTP(w, r)
+		case ConsoleOperatorServiceRevokeOperatorProcedure:
+			consoleOperatorServiceRevokeOperatorHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedConsoleOperatorServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedConsoleOperatorServiceHandler struct{}
+
+func (UnimplementedConsoleOperatorServiceHandler) CreateOperator(context.Context, *connect.Request[v1.CreateOperatorRequest]) (*connect.Response[v1.CreateOperatorResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleOperatorService.CreateOperator is not implemented"))
+}
+
+func (UnimplementedConsoleOperatorServiceHandler) ListOperators(context.Context, *connect.Request[v1.ListOperatorsRequest]) (*connect.Response[v1.ListOperatorsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleOperatorService.ListOperators is not implemented"))
+}
+
+func (UnimplementedConsoleOperatorServiceHandler) RevokeOperator(context.Context, *connect.Request[v1.RevokeOperatorRequest]) (*connect.Response[v1.RevokeOperatorResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleOperatorService.RevokeOperator is not implemented"))
+}
@@ -0,0 +1,177 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: keyhub/console/v1/organization.proto
+
+package consolev1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ConsolePlatformServiceName is the fully-qualified name of the ConsolePlatformService service.
+	ConsolePlatformServiceName = "keyhub.console.v1.ConsolePlatformService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ConsolePlatformServiceCreateOrganizationProcedure is the fully-qualified name of the
+	// ConsolePlatformService's CreateOrganization RPC.
+	ConsolePlatformServiceCreateOrganizationProcedure = "/keyhub.console.v1.ConsolePlatformService/CreateOrganization"
+	// ConsolePlatformServiceListOrganizationsProcedure is the fully-qualified name of the
+	// ConsolePlatformService's ListOrganizations RPC.
+	ConsolePlatformServiceListOrganizationsProcedure = "/keyhub.console.v1.ConsolePlatformService/ListOrganizations"
+	// ConsolePlatformServiceRotateOrganizationKeyProcedure is the fully-qualified name of the
+	// ConsolePlatformService's RotateOrganizationKey RPC.
+	ConsolePlatformServiceRotateOrganizationKeyProcedure = "/keyhub.console.v1.ConsolePlatformService/RotateOrganizationKey"
+)
+
+// ConsolePlatformServiceClient is a client for the keyhub.console.v1.ConsolePlatformService
+// service.
+type ConsolePlatformServiceClient interface {
+	// 組織作成（Organization Keyはこのレスポンスでのみ返す）
+	CreateOrganization(context.Context, *connect.Request[v1.CreateOrganizationRequest]) (*connect.Response[v1.CreateOrganizationResponse], error)
+	// 組織一覧取得
+	ListOrganizations(context.Context, *connect.Request[v1.ListOrganizationsRequest]) (*connect.Response[v1.ListOrganizationsResponse], error)
+	// Organization Keyの再発行（既存のコンソールセッションはすべて無効化される）
+	RotateOrganizationKey(context.Context, *connect.Request[v1.RotateOrganizationKeyRequest]) (*connect.Response[v1.RotateOrganizationKeyResponse], error)
+}
+
+// NewConsolePlatformServiceClient constructs a client for the
+// keyhub.console.v1.ConsolePlatformService service. By default, it uses the Connect protocol with
+// the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed requests. To use
+// the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewConsolePlatformServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ConsolePlatformServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	consolePlatformServiceMethods := v1.File_keyhub_console_v1_organization_proto.Services().ByName("ConsolePlatformService").Methods()
+	return &consolePlatformServiceClient{
+		createOrganization: connect.NewClient[v1.CreateOrganizationRequest, v1.CreateOrganizationResponse](
+			httpClient,
+			baseURL+ConsolePlatformServiceCreateOrganizationProcedure,
+			connect.WithSchema(consolePlatformServiceMethods.ByName("CreateOrganization")),
+			connect.WithClientOptions(opts...),
+		),
+		listOrganizations: connect.NewClient[v1.ListOrganizationsRequest, v1.ListOrganizationsResponse](
+			httpClient,
+			baseURL+ConsolePlatformServiceListOrganizationsProcedure,
+			connect.WithSchema(consolePlatformServiceMethods.ByName("ListOrganizations")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		rotateOrganizationKey: connect.NewClient[v1.RotateOrganizationKeyRequest, v1.RotateOrganizationKeyResponse](
+			httpClient,
+			baseURL+ConsolePlatformServiceRotateOrganizationKeyProcedure,
+			connect.WithSchema(consolePlatformServiceMethods.ByName("RotateOrganizationKey")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// consolePlatformServiceClient implements ConsolePlatformServiceClient.
+type consolePlatformServiceClient struct {
+	createOrganization    *connect.Client[v1.CreateOrganizationRequest, v1.CreateOrganizationResponse]
+	listOrganizations     *connect.Client[v1.ListOrganizationsRequest, v1.ListOrganizationsResponse]
+	rotateOrganizationKey *connect.Client[v1.RotateOrganizationKeyRequest, v1.RotateOrganizationKeyResponse]
+}
+
+// CreateOrganization calls keyhub.console.v1.ConsolePlatformService.CreateOrganization.
+func (c *consolePlatformServiceClient) CreateOrganization(ctx context.Context, req *connect.Request[v1.CreateOrganizationRequest]) (*connect.Response[v1.CreateOrganizationResponse], error) {
+	return c.createOrganization.CallUnary(ctx, req)
+}
+
+// ListOrganizations calls keyhub.console.v1.ConsolePlatformService.ListOrganizations.
+func (c *consolePlatformServiceClient) ListOrganizations(ctx context.Context, req *connect.Request[v1.ListOrganizationsRequest]) (*connect.Response[v1.ListOrganizationsResponse], error) {
+	return c.listOrganizations.CallUnary(ctx, req)
+}
+
+// RotateOrganizationKey calls keyhub.console.v1.ConsolePlatformService.RotateOrganizationKey.
+func (c *consolePlatformServiceClient) RotateOrganizationKey(ctx context.Context, req *connect.Request[v1.RotateOrganizationKeyRequest]) (*connect.Response[v1.RotateOrganizationKeyResponse], error) {
+	return c.rotateOrganizationKey.CallUnary(ctx, req)
+}
+
+// ConsolePlatformServiceHandler is an implementation of the
+// keyhub.console.v1.ConsolePlatformService service.
+type ConsolePlatformServiceHandler interface {
+	// 組織作成（Organization Keyはこのレスポンスでのみ返す）
+	CreateOrganization(context.Context, *connect.Request[v1.CreateOrganizationRequest]) (*connect.Response[v1.CreateOrganizationResponse], error)
+	// 組織一覧取得
+	ListOrganizations(context.Context, *connect.Request[v1.ListOrganizationsRequest]) (*connect.Response[v1.ListOrganizationsResponse], error)
+	// Organization Keyの再発行（既存のコンソールセッションはすべて無効化される）
+	RotateOrganizationKey(context.Context, *connect.Request[v1.RotateOrganizationKeyRequest]) (*connect.Response[v1.RotateOrganizationKeyResponse], error)
+}
+
+// NewConsolePlatformServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewConsolePlatformServiceHandler(svc ConsolePlatformServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	consolePlatformServiceMethods := v1.File_keyhub_console_v1_organization_proto.Services().ByName("ConsolePlatformService").Methods()
+	consolePlatformServiceCreateOrganizationHandler := connect.NewUnaryHandler(
+		ConsolePlatformServiceCreateOrganizationProcedure,
+		svc.CreateOrganization,
+		connect.WithSchema(consolePlatformServiceMethods.ByName("CreateOrganization")),
+		connect.WithHandlerOptions(opts...),
+	)
+	consolePlatformServiceListOrganizationsHandler := connect.NewUnaryHandler(
+		ConsolePlatformServiceListOrganizationsProcedure,
+		svc.ListOrganizations,
+		connect.WithSchema(consolePlatformServiceMethods.ByName("ListOrganizations")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	consolePlatformServiceRotateOrganizationKeyHandler := connect.NewUnaryHandler(
+		ConsolePlatformServiceRotateOrganizationKeyProcedure,
+		svc.RotateOrganizationKey,
+		connect.WithSchema(consolePlatformServiceMethods.ByName("RotateOrganizationKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/keyhub.console.v1.ConsolePlatformService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ConsolePlatformServiceCreateOrganizationProcedure:
+			consolePlatformServiceCreateOrganizationHandler.ServeHTTP(w, r)
+		case ConsolePlatformServiceListOrganizationsProcedure:
+			consolePlatformServiceListOrganizationsHandler.ServeHTTP(w, r)
+		case ConsolePlatformServiceRotateOrganizationKeyProcedure:
+			consolePlatformServiceRotateOrganizationKeyHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedConsolePlatformServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedConsolePlatformServiceHandler struct{}
+
+func (UnimplementedConsolePlatformServiceHandler) CreateOrganization(context.Context, *connect.Request[v1.CreateOrganizationRequest]) (*connect.Response[v1.CreateOrganizationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsolePlatformService.CreateOrganization is not implemented"))
+}
+
+func (UnimplementedConsolePlatformServiceHandler) ListOrganizations(context.Context, *connect.Request[v1.ListOrganizationsRequest]) (*connect.Response[v1.ListOrganizationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsolePlatformService.ListOrganizations is not implemented"))
+}
+
+func (UnimplementedConsolePlatformServiceHandler) RotateOrganizationKey(context.Context, *connect.Request[v1.RotateOrganizationKeyRequest]) (*connect.Response[v1.RotateOrganizationKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsolePlatformService.RotateOrganizationKey is not implemented"))
+}
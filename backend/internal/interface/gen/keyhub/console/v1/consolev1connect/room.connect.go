@@ -42,6 +42,27 @@ const (
 	// ConsoleRoomServiceAssignRoomToTenantProcedure is the fully-qualified name of the
 	// ConsoleRoomService's AssignRoomToTenant RPC.
 	ConsoleRoomServiceAssignRoomToTenantProcedure = "/keyhub.console.v1.ConsoleRoomService/AssignRoomToTenant"
+	// ConsoleRoomServiceUpdateRoomAttributesProcedure is the fully-qualified name of the
+	// ConsoleRoomService's UpdateRoomAttributes RPC.
+	ConsoleRoomServiceUpdateRoomAttributesProcedure = "/keyhub.console.v1.ConsoleRoomService/UpdateRoomAttributes"
+	// ConsoleRoomServiceCreateRoomCustomFieldProcedure is the fully-qualified name of the
+	// ConsoleRoomService's CreateRoomCustomField RPC.
+	ConsoleRoomServiceCreateRoomCustomFieldProcedure = "/keyhub.console.v1.ConsoleRoomService/CreateRoomCustomField"
+	// ConsoleRoomServiceListRoomCustomFieldsProcedure is the fully-qualified name of the
+	// ConsoleRoomService's ListRoomCustomFields RPC.
+	ConsoleRoomServiceListRoomCustomFieldsProcedure = "/keyhub.console.v1.ConsoleRoomService/ListRoomCustomFields"
+	// ConsoleRoomServiceDeleteRoomCustomFieldProcedure is the fully-qualified name of the
+	// ConsoleRoomService's DeleteRoomCustomField RPC.
+	ConsoleRoomServiceDeleteRoomCustomFieldProcedure = "/keyhub.console.v1.ConsoleRoomService/DeleteRoomCustomField"
+	// ConsoleRoomServiceListRoomTypesProcedure is the fully-qualified name of the ConsoleRoomService's
+	// ListRoomTypes RPC.
+	ConsoleRoomServiceListRoomTypesProcedure = "/keyhub.console.v1.ConsoleRoomService/ListRoomTypes"
+	// ConsoleRoomServiceCreateRoomTypeProcedure is the fully-qualified name of the ConsoleRoomService's
+	// CreateRoomType RPC.
+	ConsoleRoomServiceCreateRoomTypeProcedure = "/keyhub.console.v1.ConsoleRoomService/CreateRoomType"
+	// ConsoleRoomServiceDeleteRoomTypeProcedure is the fully-qualified name of the ConsoleRoomService's
+	// DeleteRoomType RPC.
+	ConsoleRoomServiceDeleteRoomTypeProcedure = "/keyhub.console.v1.ConsoleRoomService/DeleteRoomType"
 )
 
 // ConsoleRoomServiceClient is a client for the keyhub.console.v1.ConsoleRoomService service.
@@ -52,6 +73,20 @@ type ConsoleRoomServiceClient interface {
 	GetAllRooms(context.Context, *connect.Request[v1.GetAllRoomsRequest]) (*connect.Response[v1.GetAllRoomsResponse], error)
 	// テナントに部屋を割り当て
 	AssignRoomToTenant(context.Context, *connect.Request[v1.AssignRoomToTenantRequest]) (*connect.Response[v1.AssignRoomToTenantResponse], error)
+	// 部屋の属性（収容人数・設備・バリアフリー対応・カスタム項目）をすべて置き換える
+	UpdateRoomAttributes(context.Context, *connect.Request[v1.UpdateRoomAttributesRequest]) (*connect.Response[v1.UpdateRoomAttributesResponse], error)
+	// 部屋のカスタム項目を定義
+	CreateRoomCustomField(context.Context, *connect.Request[v1.CreateRoomCustomFieldRequest]) (*connect.Response[v1.CreateRoomCustomFieldResponse], error)
+	// 部屋のカスタム項目の定義一覧を取得
+	ListRoomCustomFields(context.Context, *connect.Request[v1.ListRoomCustomFieldsRequest]) (*connect.Response[v1.ListRoomCustomFieldsResponse], error)
+	// 部屋のカスタム項目の定義を削除（部屋に保存した値も削除される）
+	DeleteRoomCustomField(context.Context, *connect.Request[v1.DeleteRoomCustomFieldRequest]) (*connect.Response[v1.DeleteRoomCustomFieldResponse], error)
+	// 部屋タイプの一覧を取得（既定のタイプに続けて組織が追加したタイプ）
+	ListRoomTypes(context.Context, *connect.Request[v1.ListRoomTypesRequest]) (*connect.Response[v1.ListRoomTypesResponse], error)
+	// 組織の部屋タイプを追加
+	CreateRoomType(context.Context, *connect.Request[v1.CreateRoomTypeRequest]) (*connect.Response[v1.CreateRoomTypeResponse], error)
+	// 組織が追加した部屋タイプを削除（そのタイプの部屋が残っている場合は削除できない）
+	DeleteRoomType(context.Context, *connect.Request[v1.DeleteRoomTypeRequest]) (*connect.Response[v1.DeleteRoomTypeResponse], error)
 }
 
 // NewConsoleRoomServiceClient constructs a client for the keyhub.console.v1.ConsoleRoomService
@@ -83,14 +118,65 @@ func NewConsoleRoomServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(consoleRoomServiceMethods.ByName("AssignRoomToTenant")),
 			connect.WithClientOptions(opts...),
 		),
+		updateRoomAttributes: connect.NewClient[v1.UpdateRoomAttributesRequest, v1.UpdateRoomAttributesResponse](
+			httpClient,
+			baseURL+ConsoleRoomServiceUpdateRoomAttributesProcedure,
+			connect.WithSchema(consoleRoomServiceMethods.ByName("UpdateRoomAttributes")),
+			connect.WithClientOptions(opts...),
+		),
+		createRoomCustomField: connect.NewClient[v1.CreateRoomCustomFieldRequest, v1.CreateRoomCustomFieldResponse](
+			httpClient,
+			baseURL+ConsoleRoomServiceCreateRoomCustomFieldProcedure,
+			connect.WithSchema(consoleRoomServiceMethods.ByName("CreateRoomCustomField")),
+			connect.WithClientOptions(opts...),
+		),
+		listRoomCustomFields: connect.NewClient[v1.ListRoomCustomFieldsRequest, v1.ListRoomCustomFieldsResponse](
+			httpClient,
+			baseURL+ConsoleRoomServiceListRoomCustomFieldsProcedure,
+			connect.WithSchema(consoleRoomServiceMethods.ByName("ListRoomCustomFields")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		deleteRoomCustomField: connect.NewClient[v1.DeleteRoomCustomFieldRequest, v1.DeleteRoomCustomFieldResponse](
+			httpClient,
+			baseURL+ConsoleRoomServiceDeleteRoomCustomFieldProcedure,
+			connect.WithSchema(consoleRoomServiceMethods.ByName("DeleteRoomCustomField")),
+			connect.WithClientOptions(opts...),
+		),
+		listRoomTypes: connect.NewClient[v1.ListRoomTypesRequest, v1.ListRoomTypesResponse](
+			httpClient,
+			baseURL+ConsoleRoomServiceListRoomTypesProcedure,
+			connect.WithSchema(consoleRoomServiceMethods.ByName("ListRoomTypes")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		createRoomType: connect.NewClient[v1.CreateRoomTypeRequest, v1.CreateRoomTypeResponse](
+			httpClient,
+			baseURL+ConsoleRoomServiceCreateRoomTypeProcedure,
+			connect.WithSchema(consoleRoomServiceMethods.ByName("CreateRoomType")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteRoomType: connect.NewClient[v1.DeleteRoomTypeRequest, v1.DeleteRoomTypeResponse](
+			httpClient,
+			baseURL+ConsoleRoomServiceDeleteRoomTypeProcedure,
+			connect.WithSchema(consoleRoomServiceMethods.ByName("DeleteRoomType")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // consoleRoomServiceClient implements ConsoleRoomServiceClient.
 type consoleRoomServiceClient struct {
-	createRoom         *connect.Client[v1.CreateRoomRequest, v1.CreateRoomResponse]
-	getAllRooms        *connect.Client[v1.GetAllRoomsRequest, v1.GetAllRoomsResponse]
-	assignRoomToTenant *connect.Client[v1.AssignRoomToTenantRequest, v1.AssignRoomToTenantResponse]
+	createRoom            *connect.Client[v1.CreateRoomRequest, v1.CreateRoomResponse]
+	getAllRooms           *connect.Client[v1.GetAllRoomsRequest, v1.GetAllRoomsResponse]
+	assignRoomToTenant    *connect.Client[v1.AssignRoomToTenantRequest, v1.AssignRoomToTenantResponse]
+	updateRoomAttributes  *connect.Client[v1.UpdateRoomAttributesRequest, v1.UpdateRoomAttributesResponse]
+	createRoomCustomField *connect.Client[v1.CreateRoomCustomFieldRequest, v1.CreateRoomCustomFieldResponse]
+	listRoomCustomFields  *connect.Client[v1.ListRoomCustomFieldsRequest, v1.ListRoomCustomFieldsResponse]
+	deleteRoomCustomField *connect.Client[v1.DeleteRoomCustomFieldRequest, v1.DeleteRoomCustomFieldResponse]
+	listRoomTypes         *connect.Client[v1.ListRoomTypesRequest, v1.ListRoomTypesResponse]
+	createRoomType        *connect.Client[v1.CreateRoomTypeRequest, v1.CreateRoomTypeResponse]
+	deleteRoomType        *connect.Client[v1.DeleteRoomTypeRequest, v1.DeleteRoomTypeResponse]
 }
 
 // CreateRoom calls keyhub.console.v1.ConsoleRoomService.CreateRoom.
@@ -108,6 +194,41 @@ func (c *consoleRoomServiceClient) AssignRoomToTenant(ctx context.Context, req *
 	return c.assignRoomToTenant.CallUnary(ctx, req)
 }
 
+// UpdateRoomAttributes calls keyhub.console.v1.ConsoleRoomService.UpdateRoomAttributes.
+func (c *consoleRoomServiceClient) UpdateRoomAttributes(ctx context.Context, req *connect.Request[v1.UpdateRoomAttributesRequest]) (*connect.Response[v1.UpdateRoomAttributesResponse], error) {
+	return c.updateRoomAttributes.CallUnary(ctx, req)
+}
+
+// CreateRoomCustomField calls keyhub.console.v1.ConsoleRoomService.CreateRoomCustomField.
+func (c *consoleRoomServiceClient) CreateRoomCustomField(ctx context.Context, req *connect.Request[v1.CreateRoomCustomFieldRequest]) (*connect.Response[v1.CreateRoomCustomFieldResponse], error) {
+	return c.createRoomCustomField.CallUnary(ctx, req)
+}
+
+// ListRoomCustomFields calls keyhub.console.v1.ConsoleRoomService.ListRoomCustomFields.
+func (c *consoleRoomServiceClient) ListRoomCustomFields(ctx context.Context, req *connect.Request[v1.ListRoomCustomFieldsRequest]) (*connect.Response[v1.ListRoomCustomFieldsResponse], error) {
+	return c.listRoomCustomFields.CallUnary(ctx, req)
+}
+
+// DeleteRoomCustomField calls keyhub.console.v1.ConsoleRoomService.DeleteRoomCustomField.
+func (c *consoleRoomServiceClient) DeleteRoomCustomField(ctx context.Context, req *connect.Request[v1.DeleteRoomCustomFieldRequest]) (*connect.Response[v1.DeleteRoomCustomFieldResponse], error) {
+	return c.deleteRoomCustomField.CallUnary(ctx, req)
+}
+
+// ListRoomTypes calls keyhub.console.v1.ConsoleRoomService.ListRoomTypes.
+func (c *consoleRoomServiceClient) ListRoomTypes(ctx context.Context, req *connect.Request[v1.ListRoomTypesRequest]) (*connect.Response[v1.ListRoomTypesResponse], error) {
+	return c.listRoomTypes.CallUnary(ctx, req)
+}
+
+// CreateRoomType calls keyhub.console.v1.ConsoleRoomService.CreateRoomType.
+func (c *consoleRoomServiceClient) CreateRoomType(ctx context.Context, req *connect.Request[v1.CreateRoomTypeRequest]) (*connect.Response[v1.CreateRoomTypeResponse], error) {
+	return c.createRoomType.CallUnary(ctx, req)
+}
+
+// DeleteRoomType calls keyhub.console.v1.ConsoleRoomService.DeleteRoomType.
+func (c *consoleRoomServiceClient) DeleteRoomType(ctx context.Context, req *connect.Request[v1.DeleteRoomTypeRequest]) (*connect.Response[v1.DeleteRoomTypeResponse], error) {
+	return c.deleteRoomType.CallUnary(ctx, req)
+}
+
 // ConsoleRoomServiceHandler is an implementation of the keyhub.console.v1.ConsoleRoomService
 // service.
 type ConsoleRoomServiceHandler interface {
@@ -117,6 +238,20 @@ type ConsoleRoomServiceHandler interface {
 	GetAllRooms(context.Context, *connect.Request[v1.GetAllRoomsRequest]) (*connect.Response[v1.GetAllRoomsResponse], error)
 	// テナントに部屋を割り当て
 	AssignRoomToTenant(context.Context, *connect.Request[v1.AssignRoomToTenantRequest]) (*connect.Response[v1.AssignRoomToTenantResponse], error)
+	// 部屋の属性（収容人数・設備・バリアフリー対応・カスタム項目）をすべて置き換える
+	UpdateRoomAttributes(context.Context, *connect.Request[v1.UpdateRoomAttributesRequest]) (*connect.Response[v1.UpdateRoomAttributesResponse], error)
+	// 部屋のカスタム項目を定義
+	CreateRoomCustomField(context.Context, *connect.Request[v1.CreateRoomCustomFieldRequest]) (*connect.Response[v1.CreateRoomCustomFieldResponse], error)
+	// 部屋のカスタム項目の定義一覧を取得
+	ListRoomCustomFields(context.Context, *connect.Request[v1.ListRoomCustomFieldsRequest]) (*connect.Response[v1.ListRoomCustomFieldsResponse], error)
+	// 部屋のカスタム項目の定義を削除（部屋に保存した値も削除される）
+	DeleteRoomCustomField(context.Context, *connect.Request[v1.DeleteRoomCustomFieldRequest]) (*connect.Response[v1.DeleteRoomCustomFieldResponse], error)
+	// 部屋タイプの一覧を取得（既定のタイプに続けて組織が追加したタイプ）
+	ListRoomTypes(context.Context, *connect.Request[v1.ListRoomTypesRequest]) (*connect.Response[v1.ListRoomTypesResponse], error)
+	// 組織の部屋タイプを追加
+	CreateRoomType(context.Context, *connect.Request[v1.CreateRoomTypeRequest]) (*connect.Response[v1.CreateRoomTypeResponse], error)
+	// 組織が追加した部屋タイプを削除（そのタイプの部屋が残っている場合は削除できない）
+	DeleteRoomType(context.Context, *connect.Request[v1.DeleteRoomTypeRequest]) (*connect.Response[v1.DeleteRoomTypeResponse], error)
 }
 
 // NewConsoleRoomServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -144,6 +279,50 @@ func NewConsoleRoomServiceHandler(svc ConsoleRoomServiceHandler, opts ...connect
 		connect.WithSchema(consoleRoomServiceMethods.ByName("AssignRoomToTenant")),
 		connect.WithHandlerOptions(opts...),
 	)
+	consoleRoomServiceUpdateRoomAttributesHandler := connect.NewUnaryHandler(
+		ConsoleRoomServiceUpdateRoomAttributesProcedure,
+		svc.UpdateRoomAttributes,
+		connect.WithSchema(consoleRoomServiceMethods.ByName("UpdateRoomAttributes")),
+		connect.WithHandlerOptions(opts...),
+	)
+	consoleRoomServiceCreateRoomCustomFieldHandler := connect.NewUnaryHandler(
+		ConsoleRoomServiceCreateRoomCustomFieldProcedure,
+		svc.CreateRoomCustomField,
+		connect.WithSchema(consoleRoomServiceMethods.ByName("CreateRoomCustomField")),
+		connect.WithHandlerOptions(opts...),
+	)
+	consoleRoomServiceListRoomCustomFieldsHandler := connect.NewUnaryHandler(
+		ConsoleRoomServiceListRoomCustomFieldsProcedure,
+		svc.ListRoomCustomFields,
+		connect.WithSchema(consoleRoomServiceMethods.ByName("ListRoomCustomFields")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	consoleRoomServiceDeleteRoomCustomFieldHandler := connect.NewUnaryHandler(
+		ConsoleRoomServiceDeleteRoomCustomFieldProcedure,
+		svc.DeleteRoomCustomField,
+		connect.WithSchema(consoleRoomServiceMethods.ByName("DeleteRoomCustomField")),
+		connect.WithHandlerOptions(opts...),
+	)
+	consoleRoomServiceListRoomTypesHandler := connect.NewUnaryHandler(
+		ConsoleRoomServiceListRoomTypesProcedure,
+		svc.ListRoomTypes,
+		connect.WithSchema(consoleRoomServiceMethods.ByName("ListRoomTypes")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	consoleRoomServiceCreateRoomTypeHandler := connect.NewUnaryHandler(
+		ConsoleRoomServiceCreateRoomTypeProcedure,
+		svc.CreateRoomType,
+		connect.WithSchema(consoleRoomServiceMethods.ByName("CreateRoomType")),
+		connect.WithHandlerOptions(opts...),
+	)
+	consoleRoomServiceDeleteRoomTypeHandler := connect.NewUnaryHandler(
+		ConsoleRoomServiceDeleteRoomTypeProcedure,
+		svc.DeleteRoomType,
+		connect.WithSchema(consoleRoomServiceMethods.ByName("DeleteRoomType")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/keyhub.console.v1.ConsoleRoomService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ConsoleRoomServiceCreateRoomProcedure:
@@ -152,6 +331,20 @@ func NewConsoleRoomServiceHandler(svc ConsoleRoomServiceHandler, opts ...connect
 			consoleRoomServiceGetAllRoomsHandler.ServeHTTP(w, r)
 		case ConsoleRoomServiceAssignRoomToTenantProcedure:
 			consoleRoomServiceAssignRoomToTenantHandler.ServeHTTP(w, r)
+		case ConsoleRoomServiceUpdateRoomAttributesProcedure:
+			consoleRoomServiceUpdateRoomAttributesHandler.ServeHTTP(w, r)
+		case ConsoleRoomServiceCreateRoomCustomFieldProcedure:
+			consoleRoomServiceCreateRoomCustomFieldHandler.ServeHTTP(w, r)
+		case ConsoleRoomServiceListRoomCustomFieldsProcedure:
+			consoleRoomServiceListRoomCustomFieldsHandler.ServeHTTP(w, r)
+		case ConsoleRoomServiceDeleteRoomCustomFieldProcedure:
+			consoleRoomServiceDeleteRoomCustomFieldHandler.ServeHTTP(w, r)
+		case ConsoleRoomServiceListRoomTypesProcedure:
+			consoleRoomServiceListRoomTypesHandler.ServeHTTP(w, r)
+		case ConsoleRoomServiceCreateRoomTypeProcedure:
+			consoleRoomServiceCreateRoomTypeHandler.ServeHTTP(w, r)
+		case ConsoleRoomServiceDeleteRoomTypeProcedure:
+			consoleRoomServiceDeleteRoomTypeHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -172,3 +365,31 @@ func (UnimplementedConsoleRoomServiceHandler) GetAllRooms(context.Context, *conn
 func (UnimplementedConsoleRoomServiceHandler) AssignRoomToTenant(context.Context, *connect.Request[v1.AssignRoomToTenantRequest]) (*connect.Response[v1.AssignRoomToTenantResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleRoomService.AssignRoomToTenant is not implemented"))
 }
+
+func (UnimplementedConsoleRoomServiceHandler) UpdateRoomAttributes(context.Context, *connect.Request[v1.UpdateRoomAttributesRequest]) (*connect.Response[v1.UpdateRoomAttributesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleRoomService.UpdateRoomAttributes is not implemented"))
+}
+
+func (UnimplementedConsoleRoomServiceHandler) CreateRoomCustomField(context.Context, *connect.Request[v1.CreateRoomCustomFieldRequest]) (*connect.Response[v1.CreateRoomCustomFieldResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleRoomService.CreateRoomCustomField is not implemented"))
+}
+
+func (UnimplementedConsoleRoomServiceHandler) ListRoomCustomFields(context.Context, *connect.Request[v1.ListRoomCustomFieldsRequest]) (*connect.Response[v1.ListRoomCustomFieldsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleRoomService.ListRoomCustomFields is not implemented"))
+}
+
+func (UnimplementedConsoleRoomServiceHandler) DeleteRoomCustomField(context.Context, *connect.Request[v1.DeleteRoomCustomFieldRequest]) (*connect.Response[v1.DeleteRoomCustomFieldResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleRoomService.DeleteRoomCustomField is not implemented"))
+}
+
+func (UnimplementedConsoleRoomServiceHandler) ListRoomTypes(context.Context, *connect.Request[v1.ListRoomTypesRequest]) (*connect.Response[v1.ListRoomTypesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleRoomService.ListRoomTypes is not implemented"))
+}
+
+func (UnimplementedConsoleRoomServiceHandler) CreateRoomType(context.Context, *connect.Request[v1.CreateRoomTypeRequest]) (*connect.Response[v1.CreateRoomTypeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleRoomService.CreateRoomType is not implemented"))
+}
+
+func (UnimplementedConsoleRoomServiceHandler) DeleteRoomType(context.Context, *connect.Request[v1.DeleteRoomTypeRequest]) (*connect.Response[v1.DeleteRoomTypeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleRoomService.DeleteRoomType is not implemented"))
+}
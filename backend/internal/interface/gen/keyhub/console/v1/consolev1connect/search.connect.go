@@ -0,0 +1,114 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: keyhub/console/v1/search.proto
+
+package consolev1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ConsoleSearchServiceName is the fully-qualified name of the ConsoleSearchService service.
+	ConsoleSearchServiceName = "keyhub.console.v1.ConsoleSearchService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ConsoleSearchServiceSearchProcedure is the fully-qualified name of the ConsoleSearchService's
+	// Search RPC.
+	ConsoleSearchServiceSearchProcedure = "/keyhub.console.v1.ConsoleSearchService/Search"
+)
+
+// ConsoleSearchServiceClient is a client for the keyhub.console.v1.ConsoleSearchService service.
+type ConsoleSearchServiceClient interface {
+	// 検索語に一致するものを関連度の高い順に返す
+	Search(context.Context, *connect.Request[v1.SearchRequest]) (*connect.Response[v1.SearchResponse], error)
+}
+
+// NewConsoleSearchServiceClient constructs a client for the keyhub.console.v1.ConsoleSearchService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewConsoleSearchServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ConsoleSearchServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	consoleSearchServiceMethods := v1.File_keyhub_console_v1_search_proto.Services().ByName("ConsoleSearchService").Methods()
+	return &consoleSearchServiceClient{
+		search: connect.NewClient[v1.SearchRequest, v1.SearchResponse](
+			httpClient,
+			baseURL+ConsoleSearchServiceSearchProcedure,
+			connect.WithSchema(consoleSearchServiceMethods.ByName("Search")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// consoleSearchServiceClient implements ConsoleSearchServiceClient.
+type consoleSearchServiceClient struct {
+	search *connect.Client[v1.SearchRequest, v1.SearchResponse]
+}
+
+// Search calls keyhub.console.v1.ConsoleSearchService.Search.
+func (c *consoleSearchServiceClient) Search(ctx context.Context, req *connect.Request[v1.SearchRequest]) (*connect.Response[v1.SearchResponse], error) {
+	return c.search.CallUnary(ctx, req)
+}
+
+// ConsoleSearchServiceHandler is an implementation of the keyhub.console.v1.ConsoleSearchService
+// service.
+type ConsoleSearchServiceHandler interface {
+	// 検索語に一致するものを関連度の高い順に返す
+	Search(context.Context, *connect.Request[v1.SearchRequest]) (*connect.Response[v1.SearchResponse], error)
+}
+
+// NewConsoleSearchServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewConsoleSearchServiceHandler(svc ConsoleSearchServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	consoleSearchServiceMethods := v1.File_keyhub_console_v1_search_proto.Services().ByName("ConsoleSearchService").Methods()
+	consoleSearchServiceSearchHandler := connect.NewUnaryHandler(
+		ConsoleSearchServiceSearchProcedure,
+		svc.Search,
+		connect.WithSchema(consoleSearchServiceMethods.ByName("Search")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/keyhub.console.v1.ConsoleSearchService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ConsoleSearchServiceSearchProcedure:
+			consoleSearchServiceSearchHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedConsoleSearchServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedConsoleSearchServiceHandler struct{}
+
+func (UnimplementedConsoleSearchServiceHandler) Search(context.Context, *connect.Request[v1.SearchRequest]) (*connect.Response[v1.SearchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleSearchService.Search is not implemented"))
+}
@@ -45,6 +45,21 @@ const (
 	// ConsoleServiceUpdateTenantProcedure is the fully-qualified name of the ConsoleService's
 	// UpdateTenant RPC.
 	ConsoleServiceUpdateTenantProcedure = "/keyhub.console.v1.ConsoleService/UpdateTenant"
+	// ConsoleServiceArchiveTenantProcedure is the fully-qualified name of the ConsoleService's
+	// ArchiveTenant RPC.
+	ConsoleServiceArchiveTenantProcedure = "/keyhub.console.v1.ConsoleService/ArchiveTenant"
+	// ConsoleServiceDeleteTenantProcedure is the fully-qualified name of the ConsoleService's
+	// DeleteTenant RPC.
+	ConsoleServiceDeleteTenantProcedure = "/keyhub.console.v1.ConsoleService/DeleteTenant"
+	// ConsoleServiceListTenantTypesProcedure is the fully-qualified name of the ConsoleService's
+	// ListTenantTypes RPC.
+	ConsoleServiceListTenantTypesProcedure = "/keyhub.console.v1.ConsoleService/ListTenantTypes"
+	// ConsoleServiceCreateTenantTypeProcedure is the fully-qualified name of the ConsoleService's
+	// CreateTenantType RPC.
+	ConsoleServiceCreateTenantTypeProcedure = "/keyhub.console.v1.ConsoleService/CreateTenantType"
+	// ConsoleServiceDeleteTenantTypeProcedure is the fully-qualified name of the ConsoleService's
+	// DeleteTenantType RPC.
+	ConsoleServiceDeleteTenantTypeProcedure = "/keyhub.console.v1.ConsoleService/DeleteTenantType"
 )
 
 // ConsoleServiceClient is a client for the keyhub.console.v1.ConsoleService service.
@@ -57,6 +72,16 @@ type ConsoleServiceClient interface {
 	GetTenantById(context.Context, *connect.Request[v1.GetTenantByIdRequest]) (*connect.Response[v1.GetTenantByIdResponse], error)
 	// Tenant編集
 	UpdateTenant(context.Context, *connect.Request[v1.UpdateTenantRequest]) (*connect.Response[v1.UpdateTenantResponse], error)
+	// Tenantをアーカイブ（閲覧のみにし、参加コードを無効化、部屋の割り当てを終了してメンバーに通知する）
+	ArchiveTenant(context.Context, *connect.Request[v1.ArchiveTenantRequest]) (*connect.Response[v1.ArchiveTenantResponse], error)
+	// Tenant削除（貸し出し中の鍵がある場合は削除できない）
+	DeleteTenant(context.Context, *connect.Request[v1.DeleteTenantRequest]) (*connect.Response[v1.DeleteTenantResponse], error)
+	// テナントタイプの一覧取得（既定のタイプに続けて組織が追加したタイプ）
+	ListTenantTypes(context.Context, *connect.Request[v1.ListTenantTypesRequest]) (*connect.Response[v1.ListTenantTypesResponse], error)
+	// 組織のテナントタイプを追加
+	CreateTenantType(context.Context, *connect.Request[v1.CreateTenantTypeRequest]) (*connect.Response[v1.CreateTenantTypeResponse], error)
+	// 組織が追加したテナントタイプを削除（そのタイプのテナントが残っている場合は削除できない）
+	DeleteTenantType(context.Context, *connect.Request[v1.DeleteTenantTypeRequest]) (*connect.Response[v1.DeleteTenantTypeResponse], error)
 }
 
 // NewConsoleServiceClient constructs a client for the keyhub.console.v1.ConsoleService service. By
@@ -94,15 +119,51 @@ func NewConsoleServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(consoleServiceMethods.ByName("UpdateTenant")),
 			connect.WithClientOptions(opts...),
 		),
+		archiveTenant: connect.NewClient[v1.ArchiveTenantRequest, v1.ArchiveTenantResponse](
+			httpClient,
+			baseURL+ConsoleServiceArchiveTenantProcedure,
+			connect.WithSchema(consoleServiceMethods.ByName("ArchiveTenant")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteTenant: connect.NewClient[v1.DeleteTenantRequest, v1.DeleteTenantResponse](
+			httpClient,
+			baseURL+ConsoleServiceDeleteTenantProcedure,
+			connect.WithSchema(consoleServiceMethods.ByName("DeleteTenant")),
+			connect.WithClientOptions(opts...),
+		),
+		listTenantTypes: connect.NewClient[v1.ListTenantTypesRequest, v1.ListTenantTypesResponse](
+			httpClient,
+			baseURL+ConsoleServiceListTenantTypesProcedure,
+			connect.WithSchema(consoleServiceMethods.ByName("ListTenantTypes")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		createTenantType: connect.NewClient[v1.CreateTenantTypeRequest, v1.CreateTenantTypeResponse](
+			httpClient,
+			baseURL+ConsoleServiceCreateTenantTypeProcedure,
+			connect.WithSchema(consoleServiceMethods.ByName("CreateTenantType")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteTenantType: connect.NewClient[v1.DeleteTenantTypeRequest, v1.DeleteTenantTypeResponse](
+			httpClient,
+			baseURL+ConsoleServiceDeleteTenantTypeProcedure,
+			connect.WithSchema(consoleServiceMethods.ByName("DeleteTenantType")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // consoleServiceClient implements ConsoleServiceClient.
 type consoleServiceClient struct {
-	createTenant  *connect.Client[v1.CreateTenantRequest, v1.CreateTenantResponse]
-	getAllTenants *connect.Client[v1.GetAllTenantsRequest, v1.GetAllTenantsResponse]
-	getTenantById *connect.Client[v1.GetTenantByIdRequest, v1.GetTenantByIdResponse]
-	updateTenant  *connect.Client[v1.UpdateTenantRequest, v1.UpdateTenantResponse]
+	createTenant     *connect.Client[v1.CreateTenantRequest, v1.CreateTenantResponse]
+	getAllTenants    *connect.Client[v1.GetAllTenantsRequest, v1.GetAllTenantsResponse]
+	getTenantById    *connect.Client[v1.GetTenantByIdRequest, v1.GetTenantByIdResponse]
+	updateTenant     *connect.Client[v1.UpdateTenantRequest, v1.UpdateTenantResponse]
+	archiveTenant    *connect.Client[v1.ArchiveTenantRequest, v1.ArchiveTenantResponse]
+	deleteTenant     *connect.Client[v1.DeleteTenantRequest, v1.DeleteTenantResponse]
+	listTenantTypes  *connect.Client[v1.ListTenantTypesRequest, v1.ListTenantTypesResponse]
+	createTenantType *connect.Client[v1.CreateTenantTypeRequest, v1.CreateTenantTypeResponse]
+	deleteTenantType *connect.Client[v1.DeleteTenantTypeRequest, v1.DeleteTenantTypeResponse]
 }
 
 // CreateTenant calls keyhub.console.v1.ConsoleService.CreateTenant.
@@ -125,6 +186,31 @@ func (c *consoleServiceClient) UpdateTenant(ctx context.Context, req *connect.Re
 	return c.updateTenant.CallUnary(ctx, req)
 }
 
+// ArchiveTenant calls keyhub.console.v1.ConsoleService.ArchiveTenant.
+func (c *consoleServiceClient) ArchiveTenant(ctx context.Context, req *connect.Request[v1.ArchiveTenantRequest]) (*connect.Response[v1.ArchiveTenantResponse], error) {
+	return c.archiveTenant.CallUnary(ctx, req)
+}
+
+// DeleteTenant calls keyhub.console.v1.ConsoleService.DeleteTenant.
+func (c *consoleServiceClient) DeleteTenant(ctx context.Context, req *connect.Request[v1.DeleteTenantRequest]) (*connect.Response[v1.DeleteTenantResponse], error) {
+	return c.deleteTenant.CallUnary(ctx, req)
+}
+
+// ListTenantTypes calls keyhub.console.v1.ConsoleService.ListTenantTypes.
+func (c *consoleServiceClient) ListTenantTypes(ctx context.Context, req *connect.Request[v1.ListTenantTypesRequest]) (*connect.Response[v1.ListTenantTypesResponse], error) {
+	return c.listTenantTypes.CallUnary(ctx, req)
+}
+
+// CreateTenantType calls keyhub.console.v1.ConsoleService.CreateTenantType.
+func (c *consoleServiceClient) CreateTenantType(ctx context.Context, req *connect.Request[v1.CreateTenantTypeRequest]) (*connect.Response[v1.CreateTenantTypeResponse], error) {
+	return c.createTenantType.CallUnary(ctx, req)
+}
+
+// DeleteTenantType calls keyhub.console.v1.ConsoleService.DeleteTenantType.
+func (c *consoleServiceClient) DeleteTenantType(ctx context.Context, req *connect.Request[v1.DeleteTenantTypeRequest]) (*connect.Response[v1.DeleteTenantTypeResponse], error) {
+	return c.deleteTenantType.CallUnary(ctx, req)
+}
+
 // ConsoleServiceHandler is an implementation of the keyhub.console.v1.ConsoleService service.
 type ConsoleServiceHandler interface {
 	// Tenant作成
@@ -135,6 +221,16 @@ type ConsoleServiceHandler interface {
 	GetTenantById(context.Context, *connect.Request[v1.GetTenantByIdRequest]) (*connect.Response[v1.GetTenantByIdResponse], error)
 	// Tenant編集
 	UpdateTenant(context.Context, *connect.Request[v1.UpdateTenantRequest]) (*connect.Response[v1.UpdateTenantResponse], error)
+	// Tenantをアーカイブ（閲覧のみにし、参加コードを無効化、部屋の割り当てを終了してメンバーに通知する）
+	ArchiveTenant(context.Context, *connect.Request[v1.ArchiveTenantRequest]) (*connect.Response[v1.ArchiveTenantResponse], error)
+	// Tenant削除（貸し出し中の鍵がある場合は削除できない）
+	DeleteTenant(context.Context, *connect.Request[v1.DeleteTenantRequest]) (*connect.Response[v1.DeleteTenantResponse], error)
+	// テナントタイプの一覧取得（既定のタイプに続けて組織が追加したタイプ）
+	ListTenantTypes(context.Context, *connect.Request[v1.ListTenantTypesRequest]) (*connect.Response[v1.ListTenantTypesResponse], error)
+	// 組織のテナントタイプを追加
+	CreateTenantType(context.Context, *connect.Request[v1.CreateTenantTypeRequest]) (*connect.Response[v1.CreateTenantTypeResponse], error)
+	// 組織が追加したテナントタイプを削除（そのタイプのテナントが残っている場合は削除できない）
+	DeleteTenantType(context.Context, *connect.Request[v1.DeleteTenantTypeRequest]) (*connect.Response[v1.DeleteTenantTypeResponse], error)
 }
 
 // NewConsoleServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -168,6 +264,37 @@ func NewConsoleServiceHandler(svc ConsoleServiceHandler, opts ...connect.Handler
 		connect.WithSchema(consoleServiceMethods.ByName("UpdateTenant")),
 		connect.WithHandlerOptions(opts...),
 	)
+	consoleServiceArchiveTenantHandler := connect.NewUnaryHandler(
+		ConsoleServiceArchiveTenantProcedure,
+		svc.ArchiveTenant,
+		connect.WithSchema(consoleServiceMethods.ByName("ArchiveTenant")),
+		connect.WithHandlerOptions(opts...),
+	)
+	consoleServiceDeleteTenantHandler := connect.NewUnaryHandler(
+		ConsoleServiceDeleteTenantProcedure,
+		svc.DeleteTenant,
+		connect.WithSchema(consoleServiceMethods.ByName("DeleteTenant")),
+		connect.WithHandlerOptions(opts...),
+	)
+	consoleServiceListTenantTypesHandler := connect.NewUnaryHandler(
+		ConsoleServiceListTenantTypesProcedure,
+		svc.ListTenantTypes,
+		connect.WithSchema(consoleServiceMethods.ByName("ListTenantTypes")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	consoleServiceCreateTenantTypeHandler := connect.NewUnaryHandler(
+		ConsoleServiceCreateTenantTypeProcedure,
+		svc.CreateTenantType,
+		connect.WithSchema(consoleServiceMethods.ByName("CreateTenantType")),
+		connect.WithHandlerOptions(opts...),
+	)
+	consoleServiceDeleteTenantTypeHandler := connect.NewUnaryHandler(
+		ConsoleServiceDeleteTenantTypeProcedure,
+		svc.DeleteTenantType,
+		connect.WithSchema(consoleServiceMethods.ByName("DeleteTenantType")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/keyhub.console.v1.ConsoleService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ConsoleServiceCreateTenantProcedure:
@@ -178,6 +305,16 @@ func NewConsoleServiceHandler(svc ConsoleServiceHandler, opts ...connect.Handler
 			consoleServiceGetTenantByIdHandler.ServeHTTP(w, r)
 		case ConsoleServiceUpdateTenantProcedure:
 			consoleServiceUpdateTenantHandler.ServeHTTP(w, r)
+		case ConsoleServiceArchiveTenantProcedure:
+			consoleServiceArchiveTenantHandler.ServeHTTP(w, r)
+		case ConsoleServiceDeleteTenantProcedure:
+			consoleServiceDeleteTenantHandler.ServeHTTP(w, r)
+		case ConsoleServiceListTenantTypesProcedure:
+			consoleServiceListTenantTypesHandler.ServeHTTP(w, r)
+		case ConsoleServiceCreateTenantTypeProcedure:
+			consoleServiceCreateTenantTypeHandler.ServeHTTP(w, r)
+		case ConsoleServiceDeleteTenantTypeProcedure:
+			consoleServiceDeleteTenantTypeHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -202,3 +339,23 @@ func (UnimplementedConsoleServiceHandler) GetTenantById(context.Context, *connec
 func (UnimplementedConsoleServiceHandler) UpdateTenant(context.Context, *connect.Request[v1.UpdateTenantRequest]) (*connect.Response[v1.UpdateTenantResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleService.UpdateTenant is not implemented"))
 }
+
+func (UnimplementedConsoleServiceHandler) ArchiveTenant(context.Context, *connect.Request[v1.ArchiveTenantRequest]) (*connect.Response[v1.ArchiveTenantResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleService.ArchiveTenant is not implemented"))
+}
+
+func (UnimplementedConsoleServiceHandler) DeleteTenant(context.Context, *connect.Request[v1.DeleteTenantRequest]) (*connect.Response[v1.DeleteTenantResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleService.DeleteTenant is not implemented"))
+}
+
+func (UnimplementedConsoleServiceHandler) ListTenantTypes(context.Context, *connect.Request[v1.ListTenantTypesRequest]) (*connect.Response[v1.ListTenantTypesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleService.ListTenantTypes is not implemented"))
+}
+
+func (UnimplementedConsoleServiceHandler) CreateTenantType(context.Context, *connect.Request[v1.CreateTenantTypeRequest]) (*connect.Response[v1.CreateTenantTypeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleService.CreateTenantType is not implemented"))
+}
+
+func (UnimplementedConsoleServiceHandler) DeleteTenantType(context.Context, *connect.Request[v1.DeleteTenantTypeRequest]) (*connect.Response[v1.DeleteTenantTypeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleService.DeleteTenantType is not implemented"))
+}
@@ -0,0 +1,244 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: keyhub/console/v1/tenant_group.proto
+
+package consolev1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ConsoleTenantGroupServiceName is the fully-qualified name of the ConsoleTenantGroupService
+	// service.
+	ConsoleTenantGroupServiceName = "keyhub.console.v1.ConsoleTenantGroupService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ConsoleTenantGroupServiceCreateTenantGroupProcedure is the fully-qualified name of the
+	// ConsoleTenantGroupService's CreateTenantGroup RPC.
+	ConsoleTenantGroupServiceCreateTenantGroupProcedure = "/keyhub.console.v1.ConsoleTenantGroupService/CreateTenantGroup"
+	// ConsoleTenantGroupServiceListTenantGroupsProcedure is the fully-qualified name of the
+	// ConsoleTenantGroupService's ListTenantGroups RPC.
+	ConsoleTenantGroupServiceListTenantGroupsProcedure = "/keyhub.console.v1.ConsoleTenantGroupService/ListTenantGroups"
+	// ConsoleTenantGroupServiceAddTenantGroupMemberProcedure is the fully-qualified name of the
+	// ConsoleTenantGroupService's AddTenantGroupMember RPC.
+	ConsoleTenantGroupServiceAddTenantGroupMemberProcedure = "/keyhub.console.v1.ConsoleTenantGroupService/AddTenantGroupMember"
+	// ConsoleTenantGroupServiceRemoveTenantGroupMemberProcedure is the fully-qualified name of the
+	// ConsoleTenantGroupService's RemoveTenantGroupMember RPC.
+	ConsoleTenantGroupServiceRemoveTenantGroupMemberProcedure = "/keyhub.console.v1.ConsoleTenantGroupService/RemoveTenantGroupMember"
+	// ConsoleTenantGroupServiceListTenantGroupMembersProcedure is the fully-qualified name of the
+	// ConsoleTenantGroupService's ListTenantGroupMembers RPC.
+	ConsoleTenantGroupServiceListTenantGroupMembersProcedure = "/keyhub.console.v1.ConsoleTenantGroupService/ListTenantGroupMembers"
+)
+
+// ConsoleTenantGroupServiceClient is a client for the keyhub.console.v1.ConsoleTenantGroupService
+// service.
+type ConsoleTenantGroupServiceClient interface {
+	// グループ作成（parent_group_id を指定すると子グループとして作成）
+	CreateTenantGroup(context.Context, *connect.Request[v1.CreateTenantGroupRequest]) (*connect.Response[v1.CreateTenantGroupResponse], error)
+	// テナントのグループ一覧取得
+	ListTenantGroups(context.Context, *connect.Request[v1.ListTenantGroupsRequest]) (*connect.Response[v1.ListTenantGroupsResponse], error)
+	// グループにメンバーを追加（テナントに参加しているユーザーのみ）
+	AddTenantGroupMember(context.Context, *connect.Request[v1.AddTenantGroupMemberRequest]) (*connect.Response[v1.AddTenantGroupMemberResponse], error)
+	// グループからメンバーを削除
+	RemoveTenantGroupMember(context.Context, *connect.Request[v1.RemoveTenantGroupMemberRequest]) (*connect.Response[v1.RemoveTenantGroupMemberResponse], error)
+	// グループのメンバー一覧取得
+	ListTenantGroupMembers(context.Context, *connect.Request[v1.ListTenantGroupMembersRequest]) (*connect.Response[v1.ListTenantGroupMembersResponse], error)
+}
+
+// NewConsoleTenantGroupServiceClient constructs a client for the
+// keyhub.console.v1.ConsoleTenantGroupService service. By default, it uses the Connect protocol
+// with the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed requests. To
+// use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or connect.WithGRPCWeb()
+// options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewConsoleTenantGroupServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ConsoleTenantGroupServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	consoleTenantGroupServiceMethods := v1.File_keyhub_console_v1_tenant_group_proto.Services().ByName("ConsoleTenantGroupService").Methods()
+	return &consoleTenantGroupServiceClient{
+		createTenantGroup: connect.NewClient[v1.CreateTenantGroupRequest, v1.CreateTenantGroupResponse](
+			httpClient,
+			baseURL+ConsoleTenantGroupServiceCreateTenantGroupProcedure,
+			connect.WithSchema(consoleTenantGroupServiceMethods.ByName("CreateTenantGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		listTenantGroups: connect.NewClient[v1.ListTenantGroupsRequest, v1.ListTenantGroupsResponse](
+			httpClient,
+			baseURL+ConsoleTenantGroupServiceListTenantGroupsProcedure,
+			connect.WithSchema(consoleTenantGroupServiceMethods.ByName("ListTenantGroups")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		addTenantGroupMember: connect.NewClient[v1.AddTenantGroupMemberRequest, v1.AddTenantGroupMemberResponse](
+			httpClient,
+			baseURL+ConsoleTenantGroupServiceAddTenantGroupMemberProcedure,
+			connect.WithSchema(consoleTenantGroupServiceMethods.ByName("AddTenantGroupMember")),
+			connect.WithClientOptions(opts...),
+		),
+		removeTenantGroupMember: connect.NewClient[v1.RemoveTenantGroupMemberRequest, v1.RemoveTenantGroupMemberResponse](
+			httpClient,
+			baseURL+ConsoleTenantGroupServiceRemoveTenantGroupMemberProcedure,
+			connect.WithSchema(consoleTenantGroupServiceMethods.ByName("RemoveTenantGroupMember")),
+			connect.WithClientOptions(opts...),
+		),
+		listTenantGroupMembers: connect.NewClient[v1.ListTenantGroupMembersRequest, v1.ListTenantGroupMembersResponse](
+			httpClient,
+			baseURL+ConsoleTenantGroupServiceListTenantGroupMembersProcedure,
+			connect.WithSchema(consoleTenantGroupServiceMethods.ByName("ListTenantGroupMembers")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// consoleTenantGroupServiceClient implements ConsoleTenantGroupServiceClient.
+type consoleTenantGroupServiceClient struct {
+	createTenantGroup       *connect.Client[v1.CreateTenantGroupRequest, v1.CreateTenantGroupResponse]
+	listTenantGroups        *connect.Client[v1.ListTenantGroupsRequest, v1.ListTenantGroupsResponse]
+	addTenantGroupMember    *connect.Client[v1.AddTenantGroupMemberRequest, v1.AddTenantGroupMemberResponse]
+	removeTenantGroupMember *connect.Client[v1.RemoveTenantGroupMemberRequest, v1.RemoveTenantGroupMemberResponse]
+	listTenantGroupMembers  *connect.Client[v1.ListTenantGroupMembersRequest, v1.ListTenantGroupMembersResponse]
+}
+
+// CreateTenantGroup calls keyhub.console.v1.ConsoleTenantGroupService.CreateTenantGroup.
+func (c *consoleTenantGroupServiceClient) CreateTenantGroup(ctx context.Context, req *connect.Request[v1.CreateTenantGroupRequest]) (*connect.Response[v1.CreateTenantGroupResponse], error) {
+	return c.createTenantGroup.CallUnary(ctx, req)
+}
+
+// ListTenantGroups calls keyhub.console.v1.ConsoleTenantGroupService.ListTenantGroups.
+func (c *consoleTenantGroupServiceClient) ListTenantGroups(ctx context.Context, req *connect.Request[v1.ListTenantGroupsRequest]) (*connect.Response[v1.ListTenantGroupsResponse], error) {
+	return c.listTenantGroups.CallUnary(ctx, req)
+}
+
+// AddTenantGroupMember calls keyhub.console.v1.ConsoleTenantGroupService.AddTenantGroupMember.
+func (c *consoleTenantGroupServiceClient) AddTenantGroupMember(ctx context.Context, req *connect.Request[v1.AddTenantGroupMemberRequest]) (*connect.Response[v1.AddTenantGroupMemberResponse], error) {
+	return c.addTenantGroupMember.CallUnary(ctx, req)
+}
+
+// RemoveTenantGroupMember calls
+// keyhub.console.v1.ConsoleTenantGroupService.RemoveTenantGroupMember.
+func (c *consoleTenantGroupServiceClient) RemoveTenantGroupMember(ctx context.Context, req *connect.Request[v1.RemoveTenantGroupMemberRequest]) (*connect.Response[v1.RemoveTenantGroupMemberResponse], error) {
+	return c.removeTenantGroupMember.CallUnary(ctx, req)
+}
+
+// ListTenantGroupMembers calls keyhub.console.v1.ConsoleTenantGroupService.ListTenantGroupMembers.
+func (c *consoleTenantGroupServiceClient) ListTenantGroupMembers(ctx context.Context, req *connect.Request[v1.ListTenantGroupMembersRequest]) (*connect.Response[v1.ListTenantGroupMembersResponse], error) {
+	return c.listTenantGroupMembers.CallUnary(ctx, req)
+}
+
+// ConsoleTenantGroupServiceHandler is an implementation of the
+// keyhub.console.v1.ConsoleTenantGroupService service.
+type ConsoleTenantGroupServiceHandler interface {
+	// グループ作成（parent_group_id を指定すると子グループとして作成）
+	CreateTenantGroup(context.Context, *connect.Request[v1.CreateTenantGroupRequest]) (*connect.Response[v1.CreateTenantGroupResponse], error)
+	// テナントのグループ一覧取得
+	ListTenantGroups(context.Context, *connect.Request[v1.ListTenantGroupsRequest]) (*connect.Response[v1.ListTenantGroupsResponse], error)
+	// グループにメンバーを追加（テナントに参加しているユーザーのみ）
+	AddTenantGroupMember(context.Context, *connect.Request[v1.AddTenantGroupMemberRequest]) (*connect.Response[v1.AddTenantGroupMemberResponse], error)
+	// グループからメンバーを削除
+	RemoveTenantGroupMember(context.Context, *connect.Request[v1.RemoveTenantGroupMemberRequest]) (*connect.Response[v1.RemoveTenantGroupMemberResponse], error)
+	// グループのメンバー一覧取得
+	ListTenantGroupMembers(context.Context, *connect.Request[v1.ListTenantGroupMembersRequest]) (*connect.Response[v1.ListTenantGroupMembersResponse], error)
+}
+
+// NewConsoleTenantGroupServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewConsoleTenantGroupServiceHandler(svc ConsoleTenantGroupServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	consoleTenantGroupServiceMethods := v1.File_keyhub_console_v1_tenant_group_proto.Services().ByName("ConsoleTenantGroupService").Methods()
+	consoleTenantGroupServiceCreateTenantGroupHandler := connect.NewUnaryHandler(
+		ConsoleTenantGroupServiceCreateTenantGroupProcedure,
+		svc.CreateTenantGroup,
+		connect.WithSchema(consoleTenantGroupServiceMethods.ByName("CreateTenantGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	consoleTenantGroupServiceListTenantGroupsHandler := connect.NewUnaryHandler(
+		ConsoleTenantGroupServiceListTenantGroupsProcedure,
+		svc.ListTenantGroups,
+		connect.WithSchema(consoleTenantGroupServiceMethods.ByName("ListTenantGroups")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	consoleTenantGroupServiceAddTenantGroupMemberHandler := connect.NewUnaryHandler(
+		ConsoleTenantGroupServiceAddTenantGroupMemberProcedure,
+		svc.AddTenantGroupMember,
+		connect.WithSchema(consoleTenantGroupServiceMethods.ByName("AddTenantGroupMember")),
+		connect.WithHandlerOptions(opts...),
+	)
+	consoleTenantGroupServiceRemoveTenantGroupMemberHandler := connect.NewUnaryHandler(
+		ConsoleTenantGroupServiceRemoveTenantGroupMemberProcedure,
+		svc.RemoveTenantGroupMember,
+		connect.WithSchema(consoleTenantGroupServiceMethods.ByName("RemoveTenantGroupMember")),
+		connect.WithHandlerOptions(opts...),
+	)
+	consoleTenantGroupServiceListTenantGroupMembersHandler := connect.NewUnaryHandler(
+		ConsoleTenantGroupServiceListTenantGroupMembersProcedure,
+		svc.ListTenantGroupMembers,
+		connect.WithSchema(consoleTenantGroupServiceMethods.ByName("ListTenantGroupMembers")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/keyhub.console.v1.ConsoleTenantGroupService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ConsoleTenantGroupServiceCreateTenantGroupProcedure:
+			consoleTenantGroupServiceCreateTenantGroupHandler.ServeHTTP(w, r)
+		case ConsoleTenantGroupServiceListTenantGroupsProcedure:
+			consoleTenantGroupServiceListTenantGroupsHandler.ServeHTTP(w, r)
+		case ConsoleTenantGroupServiceAddTenantGroupMemberProcedure:
+			consoleTenantGroupServiceAddTenantGroupMemberHandler.ServeHTTP(w, r)
+		case ConsoleTenantGroupServiceRemoveTenantGroupMemberProcedure:
+			consoleTenantGroupServiceRemoveTenantGroupMemberHandler.ServeHTTP(w, r)
+		case ConsoleTenantGroupServiceListTenantGroupMembersProcedure:
+			consoleTenantGroupServiceListTenantGroupMembersHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedConsoleTenantGroupServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedConsoleTenantGroupServiceHandler struct{}
+
+func (UnimplementedConsoleTenantGroupServiceHandler) CreateTenantGroup(context.Context, *connect.Request[v1.CreateTenantGroupRequest]) (*connect.Response[v1.CreateTenantGroupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleTenantGroupService.CreateTenantGroup is not implemented"))
+}
+
+func (UnimplementedConsoleTenantGroupServiceHandler) ListTenantGroups(context.Context, *connect.Request[v1.ListTenantGroupsRequest]) (*connect.Response[v1.ListTenantGroupsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleTenantGroupService.ListTenantGroups is not implemented"))
+}
+
+func (UnimplementedConsoleTenantGroupServiceHandler) AddTenantGroupMember(context.Context, *connect.Request[v1.AddTenantGroupMemberRequest]) (*connect.Response[v1.AddTenantGroupMemberResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleTenantGroupService.AddTenantGroupMember is not implemented"))
+}
+
+func (UnimplementedConsoleTenantGroupServiceHandler) RemoveTenantGroupMember(context.Context, *connect.Request[v1.RemoveTenantGroupMemberRequest]) (*connect.Response[v1.RemoveTenantGroupMemberResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleTenantGroupService.RemoveTenantGroupMember is not implemented"))
+}
+
+func (UnimplementedConsoleTenantGroupServiceHandler) ListTenantGroupMembers(context.Context, *connect.Request[v1.ListTenantGroupMembersRequest]) (*connect.Response[v1.ListTenantGroupMembersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleTenantGroupService.ListTenantGroupMembers is not implemented"))
+}
@@ -0,0 +1,242 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: keyhub/console/v1/webhook.proto
+
+package consolev1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ConsoleWebhookServiceName is the fully-qualified name of the ConsoleWebhookService service.
+	ConsoleWebhookServiceName = "keyhub.console.v1.ConsoleWebhookService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ConsoleWebhookServiceCreateWebhookSubscriptionProcedure is the fully-qualified name of the
+	// ConsoleWebhookService's CreateWebhookSubscription RPC.
+	ConsoleWebhookServiceCreateWebhookSubscriptionProcedure = "/keyhub.console.v1.ConsoleWebhookService/CreateWebhookSubscription"
+	// ConsoleWebhookServiceListWebhookSubscriptionsProcedure is the fully-qualified name of the
+	// ConsoleWebhookService's ListWebhookSubscriptions RPC.
+	ConsoleWebhookServiceListWebhookSubscriptionsProcedure = "/keyhub.console.v1.ConsoleWebhookService/ListWebhookSubscriptions"
+	// ConsoleWebhookServiceDeleteWebhookSubscriptionProcedure is the fully-qualified name of the
+	// ConsoleWebhookService's DeleteWebhookSubscription RPC.
+	ConsoleWebhookServiceDeleteWebhookSubscriptionProcedure = "/keyhub.console.v1.ConsoleWebhookService/DeleteWebhookSubscription"
+	// ConsoleWebhookServiceListWebhookDeliveriesProcedure is the fully-qualified name of the
+	// ConsoleWebhookService's ListWebhookDeliveries RPC.
+	ConsoleWebhookServiceListWebhookDeliveriesProcedure = "/keyhub.console.v1.ConsoleWebhookService/ListWebhookDeliveries"
+	// ConsoleWebhookServiceSendTestWebhookEventProcedure is the fully-qualified name of the
+	// ConsoleWebhookService's SendTestWebhookEvent RPC.
+	ConsoleWebhookServiceSendTestWebhookEventProcedure = "/keyhub.console.v1.ConsoleWebhookService/SendTestWebhookEvent"
+)
+
+// ConsoleWebhookServiceClient is a client for the keyhub.console.v1.ConsoleWebhookService service.
+type ConsoleWebhookServiceClient interface {
+	// 購読登録（署名用のシークレットはこのレスポンスでのみ返す）
+	CreateWebhookSubscription(context.Context, *connect.Request[v1.CreateWebhookSubscriptionRequest]) (*connect.Response[v1.CreateWebhookSubscriptionResponse], error)
+	// 購読一覧取得
+	ListWebhookSubscriptions(context.Context, *connect.Request[v1.ListWebhookSubscriptionsRequest]) (*connect.Response[v1.ListWebhookSubscriptionsResponse], error)
+	// 購読削除（送信履歴も削除する）
+	DeleteWebhookSubscription(context.Context, *connect.Request[v1.DeleteWebhookSubscriptionRequest]) (*connect.Response[v1.DeleteWebhookSubscriptionResponse], error)
+	// 送信履歴取得（新しい順）
+	ListWebhookDeliveries(context.Context, *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error)
+	// テスト用のイベント（webhook.test）をすぐに送信し、その結果を返す
+	SendTestWebhookEvent(context.Context, *connect.Request[v1.SendTestWebhookEventRequest]) (*connect.Response[v1.SendTestWebhookEventResponse], error)
+}
+
+// NewConsoleWebhookServiceClient constructs a client for the
+// keyhub.console.v1.ConsoleWebhookService service. By default, it uses the Connect protocol with
+// the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed requests. To use
+// the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewConsoleWebhookServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ConsoleWebhookServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	consoleWebhookServiceMethods := v1.File_keyhub_console_v1_webhook_proto.Services().ByName("ConsoleWebhookService").Methods()
+	return &consoleWebhookServiceClient{
+		createWebhookSubscription: connect.NewClient[v1.CreateWebhookSubscriptionRequest, v1.CreateWebhookSubscriptionResponse](
+			httpClient,
+			baseURL+ConsoleWebhookServiceCreateWebhookSubscriptionProcedure,
+			connect.WithSchema(consoleWebhookServiceMethods.ByName("CreateWebhookSubscription")),
+			connect.WithClientOptions(opts...),
+		),
+		listWebhookSubscriptions: connect.NewClient[v1.ListWebhookSubscriptionsRequest, v1.ListWebhookSubscriptionsResponse](
+			httpClient,
+			baseURL+ConsoleWebhookServiceListWebhookSubscriptionsProcedure,
+			connect.WithSchema(consoleWebhookServiceMethods.ByName("ListWebhookSubscriptions")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		deleteWebhookSubscription: connect.NewClient[v1.DeleteWebhookSubscriptionRequest, v1.DeleteWebhookSubscriptionResponse](
+			httpClient,
+			baseURL+ConsoleWebhookServiceDeleteWebhookSubscriptionProcedure,
+			connect.WithSchema(consoleWebhookServiceMethods.ByName("DeleteWebhookSubscription")),
+			connect.WithClientOptions(opts...),
+		),
+		listWebhookDeliveries: connect.NewClient[v1.ListWebhookDeliveriesRequest, v1.ListWebhookDeliveriesResponse](
+			httpClient,
+			baseURL+ConsoleWebhookServiceListWebhookDeliveriesProcedure,
+			connect.WithSchema(consoleWebhookServiceMethods.ByName("ListWebhookDeliveries")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		sendTestWebhookEvent: connect.NewClient[v1.SendTestWebhookEventRequest, v1.SendTestWebhookEventResponse](
+			httpClient,
+			baseURL+ConsoleWebhookServiceSendTestWebhookEventProcedure,
+			connect.WithSchema(consoleWebhookServiceMethods.ByName("SendTestWebhookEvent")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// consoleWebhookServiceClient implements ConsoleWebhookServiceClient.
+type consoleWebhookServiceClient struct {
+	createWebhookSubscription *connect.Client[v1.CreateWebhookSubscriptionRequest, v1.CreateWebhookSubscriptionResponse]
+	listWebhookSubscriptions  *connect.Client[v1.ListWebhookSubscriptionsRequest, v1.ListWebhookSubscriptionsResponse]
+	deleteWebhookSubscription *connect.Client[v1.DeleteWebhookSubscriptionRequest, v1.DeleteWebhookSubscriptionResponse]
+	listWebhookDeliveries     *connect.Client[v1.ListWebhookDeliveriesRequest, v1.ListWebhookDeliveriesResponse]
+	sendTestWebhookEvent      *connect.Client[v1.SendTestWebhookEventRequest, v1.SendTestWebhookEventResponse]
+}
+
+// CreateWebhookSubscription calls
+// keyhub.console.v1.ConsoleWebhookService.CreateWebhookSubscription.
+func (c *consoleWebhookServiceClient) CreateWebhookSubscription(ctx context.Context, req *connect.Request[v1.CreateWebhookSubscriptionRequest]) (*connect.Response[v1.CreateWebhookSubscriptionResponse], error) {
+	return c.createWebhookSubscription.CallUnary(ctx, req)
+}
+
+// ListWebhookSubscriptions calls keyhub.console.v1.ConsoleWebhookService.ListWebhookSubscriptions.
+func (c *consoleWebhookServiceClient) ListWebhookSubscriptions(ctx context.Context, req *connect.Request[v1.ListWebhookSubscriptionsRequest]) (*connect.Response[v1.ListWebhookSubscriptionsResponse], error) {
+	return c.listWebhookSubscriptions.CallUnary(ctx, req)
+}
+
+// DeleteWebhookSubscription calls
+// keyhub.console.v1.ConsoleWebhookService.DeleteWebhookSubscription.
+func (c *consoleWebhookServiceClient) DeleteWebhookSubscription(ctx context.Context, req *connect.Request[v1.DeleteWebhookSubscriptionRequest]) (*connect.Response[v1.DeleteWebhookSubscriptionResponse], error) {
+	return c.deleteWebhookSubscription.CallUnary(ctx, req)
+}
+
+// ListWebhookDeliveries calls keyhub.console.v1.ConsoleWebhookService.ListWebhookDeliveries.
+func (c *consoleWebhookServiceClient) ListWebhookDeliveries(ctx context.Context, req *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error) {
+	return c.listWebhookDeliveries.CallUnary(ctx, req)
+}
+
+// SendTestWebhookEvent calls keyhub.console.v1.ConsoleWebhookService.SendTestWebhookEvent.
+func (c *consoleWebhookServiceClient) SendTestWebhookEvent(ctx context.Context, req *connect.Request[v1.SendTestWebhookEventRequest]) (*connect.Response[v1.SendTestWebhookEventResponse], error) {
+	return c.sendTestWebhookEvent.CallUnary(ctx, req)
+}
+
+// ConsoleWebhookServiceHandler is an implementation of the keyhub.console.v1.ConsoleWebhookService
+// service.
+type ConsoleWebhookServiceHandler interface {
+	// 購読登録（署名用のシークレットはこのレスポンスでのみ返す）
+	CreateWebhookSubscription(context.Context, *connect.Request[v1.CreateWebhookSubscriptionRequest]) (*connect.Response[v1.CreateWebhookSubscriptionResponse], error)
+	// 購読一覧取得
+	ListWebhookSubscriptions(context.Context, *connect.Request[v1.ListWebhookSubscriptionsRequest]) (*connect.Response[v1.ListWebhookSubscriptionsResponse], error)
+	// 購読削除（送信履歴も削除する）
+	DeleteWebhookSubscription(context.Context, *connect.Request[v1.DeleteWebhookSubscriptionRequest]) (*connect.Response[v1.DeleteWebhookSubscriptionResponse], error)
+	// 送信履歴取得（新しい順）
+	ListWebhookDeliveries(context.Context, *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error)
+	// テスト用のイベント（webhook.test）をすぐに送信し、その結果を返す
+	SendTestWebhookEvent(context.Context, *connect.Request[v1.SendTestWebhookEventRequest]) (*connect.Response[v1.SendTestWebhookEventResponse], error)
+}
+
+// NewConsoleWebhookServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewConsoleWebhookServiceHandler(svc ConsoleWebhookServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	consoleWebhookServiceMethods := v1.File_keyhub_console_v1_webhook_proto.Services().ByName("ConsoleWebhookService").Methods()
+	consoleWebhookServiceCreateWebhookSubscriptionHandler := connect.NewUnaryHandler(
+		ConsoleWebhookServiceCreateWebhookSubscriptionProcedure,
+		svc.CreateWebhookSubscription,
+		connect.WithSchema(consoleWebhookServiceMethods.ByName("CreateWebhookSubscription")),
+		connect.WithHandlerOptions(opts...),
+	)
+	consoleWebhookServiceListWebhookSubscriptionsHandler := connect.NewUnaryHandler(
+		ConsoleWebhookServiceListWebhookSubscriptionsProcedure,
+		svc.ListWebhookSubscriptions,
+		connect.WithSchema(consoleWebhookServiceMethods.ByName("ListWebhookSubscriptions")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	consoleWebhookServiceDeleteWebhookSubscriptionHandler := connect.NewUnaryHandler(
+		ConsoleWebhookServiceDeleteWebhookSubscriptionProcedure,
+		svc.DeleteWebhookSubscription,
+		connect.WithSchema(consoleWebhookServiceMethods.ByName("DeleteWebhookSubscription")),
+		connect.WithHandlerOptions(opts...),
+	)
+	consoleWebhookServiceListWebhookDeliveriesHandler := connect.NewUnaryHandler(
+		ConsoleWebhookServiceListWebhookDeliveriesProcedure,
+		svc.ListWebhookDeliveries,
+		connect.WithSchema(consoleWebhookServiceMethods.ByName("ListWebhookDeliveries")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	consoleWebhookServiceSendTestWebhookEventHandler := connect.NewUnaryHandler(
+		ConsoleWebhookServiceSendTestWebhookEventProcedure,
+		svc.SendTestWebhookEvent,
+		connect.WithSchema(consoleWebhookServiceMethods.ByName("SendTestWebhookEvent")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/keyhub.console.v1.ConsoleWebhookService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ConsoleWebhookServiceCreateWebhookSubscriptionProcedure:
+			consoleWebhookServiceCreateWebhookSubscriptionHandler.ServeHTTP(w, r)
+		case ConsoleWebhookServiceListWebhookSubscriptionsProcedure:
+			consoleWebhookServiceListWebhookSubscriptionsHandler.ServeHTTP(w, r)
+		case ConsoleWebhookServiceDeleteWebhookSubscriptionProcedure:
+			consoleWebhookServiceDeleteWebhookSubscriptionHandler.ServeHTTP(w, r)
+		case ConsoleWebhookServiceListWebhookDeliveriesProcedure:
+			consoleWebhookServiceListWebhookDeliveriesHandler.ServeHTTP(w, r)
+		case ConsoleWebhookServiceSendTestWebhookEventProcedure:
+			consoleWebhookServiceSendTestWebhookEventHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedConsoleWebhookServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedConsoleWebhookServiceHandler struct{}
+
+func (UnimplementedConsoleWebhookServiceHandler) CreateWebhookSubscription(context.Context, *connect.Request[v1.CreateWebhookSubscriptionRequest]) (*connect.Response[v1.CreateWebhookSubscriptionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleWebhookService.CreateWebhookSubscription is not implemented"))
+}
+
+func (UnimplementedConsoleWebhookServiceHandler) ListWebhookSubscriptions(context.Context, *connect.Request[v1.ListWebhookSubscriptionsRequest]) (*connect.Response[v1.ListWebhookSubscriptionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleWebhookService.ListWebhookSubscriptions is not implemented"))
+}
+
+func (UnimplementedConsoleWebhookServiceHandler) DeleteWebhookSubscription(context.Context, *connect.Request[v1.DeleteWebhookSubscriptionRequest]) (*connect.Response[v1.DeleteWebhookSubscriptionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleWebhookService.DeleteWebhookSubscription is not implemented"))
+}
+
+func (UnimplementedConsoleWebhookServiceHandler) ListWebhookDeliveries(context.Context, *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleWebhookService.ListWebhookDeliveries is not implemented"))
+}
+
+func (UnimplementedConsoleWebhookServiceHandler) SendTestWebhookEvent(context.Context, *connect.Request[v1.SendTestWebhookEventRequest]) (*connect.Response[v1.SendTestWebhookEventResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keyhub.console.v1.ConsoleWebhookService.SendTestWebhookEvent is not implemented"))
+}
@@ -10,6 +10,7 @@ import (
 	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -22,6 +23,58 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type KeyChangeOperation int32
+
+const (
+	KeyChangeOperation_KEY_CHANGE_OPERATION_UNSPECIFIED KeyChangeOperation = 0
+	KeyChangeOperation_KEY_CHANGE_OPERATION_CREATED     KeyChangeOperation = 1 // 追加
+	KeyChangeOperation_KEY_CHANGE_OPERATION_UPDATED     KeyChangeOperation = 2 // 状態などの変更
+	KeyChangeOperation_KEY_CHANGE_OPERATION_DELETED     KeyChangeOperation = 3 // 削除
+)
+
+// Enum value maps for KeyChangeOperation.
+var (
+	KeyChangeOperation_name = map[int32]string{
+		0: "KEY_CHANGE_OPERATION_UNSPECIFIED",
+		1: "KEY_CHANGE_OPERATION_CREATED",
+		2: "KEY_CHANGE_OPERATION_UPDATED",
+		3: "KEY_CHANGE_OPERATION_DELETED",
+	}
+	KeyChangeOperation_value = map[string]int32{
+		"KEY_CHANGE_OPERATION_UNSPECIFIED": 0,
+		"KEY_CHANGE_OPERATION_CREATED":     1,
+		"KEY_CHANGE_OPERATION_UPDATED":     2,
+		"KEY_CHANGE_OPERATION_DELETED":     3,
+	}
+)
+
+func (x KeyChangeOperation) Enum() *KeyChangeOperation {
+	p := new(KeyChangeOperation)
+	*p = x
+	return p
+}
+
+func (x KeyChangeOperation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (KeyChangeOperation) Descriptor() protoreflect.EnumDescriptor {
+	return file_keyhub_console_v1_key_proto_enumTypes[0].Descriptor()
+}
+
+func (KeyChangeOperation) Type() protoreflect.EnumType {
+	return &file_keyhub_console_v1_key_proto_enumTypes[0]
+}
+
+func (x KeyChangeOperation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use KeyChangeOperation.Descriptor instead.
+func (KeyChangeOperation) EnumDescriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_key_proto_rawDescGZIP(), []int{0}
+}
+
 type CreateKeyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
@@ -118,16 +171,126 @@ func (x *CreateKeyResponse) GetId() string {
 	return ""
 }
 
-type GetKeysByRoomRequest struct {
+type UpdateKeyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	KeyNumber     string                 `protobuf:"bytes,2,opt,name=key_number,json=keyNumber,proto3" json:"key_number,omitempty"`
+	Version       int64                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"` // 取得時の Key.version。他の更新で版数が変わっていれば更新せず ABORTED を返す
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *UpdateKeyRequest) Reset() {
+	*x = UpdateKeyRequest{}
+	mi := &file_keyhub_console_v1_key_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateKeyRequest) ProtoMessage() {}
+
+func (x *UpdateKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_key_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateKeyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateKeyRequest) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_key_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpdateKeyRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *UpdateKeyRequest) GetKeyNumber() string {
+	if x != nil {
+		return x.KeyNumber
+	}
+	return ""
+}
+
+func (x *UpdateKeyRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type UpdateKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           *Key                   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"` // version は更新後の版数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateKeyResponse) Reset() {
+	*x = UpdateKeyResponse{}
+	mi := &file_keyhub_console_v1_key_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateKeyResponse) ProtoMessage() {}
+
+func (x *UpdateKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_key_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateKeyResponse.ProtoReflect.Descriptor instead.
+func (*UpdateKeyResponse) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_key_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateKeyResponse) GetKey() *Key {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+type GetKeysByRoomRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	RoomId    string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	PageSize  int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`            // 省略時50件
+	PageToken string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`          // 前回のレスポンスの next_page_token。条件と並び順は前回と同じにする
+	Order     ListOrder              `protobuf:"varint,4,opt,name=order,proto3,enum=keyhub.console.v1.ListOrder" json:"order,omitempty"` // LIST_ORDER_NAME は鍵番号の昇順
+	// 以下の条件は指定したものだけで絞り込む
+	Status          KeyStatus `protobuf:"varint,5,opt,name=status,proto3,enum=keyhub.console.v1.KeyStatus" json:"status,omitempty"`
+	KeyNumberPrefix string    `protobuf:"bytes,6,opt,name=key_number_prefix,json=keyNumberPrefix,proto3" json:"key_number_prefix,omitempty"` // 鍵番号の前方一致
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
 func (x *GetKeysByRoomRequest) Reset() {
 	*x = GetKeysByRoomRequest{}
-	mi := &file_keyhub_console_v1_key_proto_msgTypes[2]
+	mi := &file_keyhub_console_v1_key_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -139,7 +302,7 @@ func (x *GetKeysByRoomRequest) String() string {
 func (*GetKeysByRoomRequest) ProtoMessage() {}
 
 func (x *GetKeysByRoomRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_keyhub_console_v1_key_proto_msgTypes[2]
+	mi := &file_keyhub_console_v1_key_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -152,7 +315,7 @@ func (x *GetKeysByRoomRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKeysByRoomRequest.ProtoReflect.Descriptor instead.
 func (*GetKeysByRoomRequest) Descriptor() ([]byte, []int) {
-	return file_keyhub_console_v1_key_proto_rawDescGZIP(), []int{2}
+	return file_keyhub_console_v1_key_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetKeysByRoomRequest) GetRoomId() string {
@@ -162,16 +325,52 @@ func (x *GetKeysByRoomRequest) GetRoomId() string {
 	return ""
 }
 
+func (x *GetKeysByRoomRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetKeysByRoomRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetKeysByRoomRequest) GetOrder() ListOrder {
+	if x != nil {
+		return x.Order
+	}
+	return ListOrder_LIST_ORDER_UNSPECIFIED
+}
+
+func (x *GetKeysByRoomRequest) GetStatus() KeyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return KeyStatus_KEY_STATUS_UNSPECIFIED
+}
+
+func (x *GetKeysByRoomRequest) GetKeyNumberPrefix() string {
+	if x != nil {
+		return x.KeyNumberPrefix
+	}
+	return ""
+}
+
 type GetKeysByRoomResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Keys          []*Key                 `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // 続きがない場合は空
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetKeysByRoomResponse) Reset() {
 	*x = GetKeysByRoomResponse{}
-	mi := &file_keyhub_console_v1_key_proto_msgTypes[3]
+	mi := &file_keyhub_console_v1_key_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -183,7 +382,7 @@ func (x *GetKeysByRoomResponse) String() string {
 func (*GetKeysByRoomResponse) ProtoMessage() {}
 
 func (x *GetKeysByRoomResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_keyhub_console_v1_key_proto_msgTypes[3]
+	mi := &file_keyhub_console_v1_key_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -196,7 +395,7 @@ func (x *GetKeysByRoomResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKeysByRoomResponse.ProtoReflect.Descriptor instead.
 func (*GetKeysByRoomResponse) Descriptor() ([]byte, []int) {
-	return file_keyhub_console_v1_key_proto_rawDescGZIP(), []int{3}
+	return file_keyhub_console_v1_key_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetKeysByRoomResponse) GetKeys() []*Key {
@@ -206,24 +405,397 @@ func (x *GetKeysByRoomResponse) GetKeys() []*Key {
 	return nil
 }
 
+func (x *GetKeysByRoomResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type WatchKeysRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 省略時は組織のすべての鍵を購読する
+	//
+	// Types that are valid to be assigned to Scope:
+	//
+	//	*WatchKeysRequest_RoomId
+	//	*WatchKeysRequest_TenantId
+	Scope         isWatchKeysRequest_Scope `protobuf_oneof:"scope"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchKeysRequest) Reset() {
+	*x = WatchKeysRequest{}
+	mi := &file_keyhub_console_v1_key_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchKeysRequest) ProtoMessage() {}
+
+func (x *WatchKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_key_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchKeysRequest.ProtoReflect.Descriptor instead.
+func (*WatchKeysRequest) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_key_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *WatchKeysRequest) GetScope() isWatchKeysRequest_Scope {
+	if x != nil {
+		return x.Scope
+	}
+	return nil
+}
+
+func (x *WatchKeysRequest) GetRoomId() string {
+	if x != nil {
+		if x, ok := x.Scope.(*WatchKeysRequest_RoomId); ok {
+			return x.RoomId
+		}
+	}
+	return ""
+}
+
+func (x *WatchKeysRequest) GetTenantId() string {
+	if x != nil {
+		if x, ok := x.Scope.(*WatchKeysRequest_TenantId); ok {
+			return x.TenantId
+		}
+	}
+	return ""
+}
+
+type isWatchKeysRequest_Scope interface {
+	isWatchKeysRequest_Scope()
+}
+
+type WatchKeysRequest_RoomId struct {
+	RoomId string `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3,oneof"`
+}
+
+type WatchKeysRequest_TenantId struct {
+	// 購読を始めた時点でテナントに割り当てられている部屋の鍵を購読する
+	TenantId string `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3,oneof"`
+}
+
+func (*WatchKeysRequest_RoomId) isWatchKeysRequest_Scope() {}
+
+func (*WatchKeysRequest_TenantId) isWatchKeysRequest_Scope() {}
+
+type KeyChange struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Operation KeyChangeOperation     `protobuf:"varint,1,opt,name=operation,proto3,enum=keyhub.console.v1.KeyChangeOperation" json:"operation,omitempty"`
+	// 変更後の鍵。削除の場合は削除前の鍵
+	Key           *Key                   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	ChangedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeyChange) Reset() {
+	*x = KeyChange{}
+	mi := &file_keyhub_console_v1_key_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeyChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyChange) ProtoMessage() {}
+
+func (x *KeyChange) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_key_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyChange.ProtoReflect.Descriptor instead.
+func (*KeyChange) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_key_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *KeyChange) GetOperation() KeyChangeOperation {
+	if x != nil {
+		return x.Operation
+	}
+	return KeyChangeOperation_KEY_CHANGE_OPERATION_UNSPECIFIED
+}
+
+func (x *KeyChange) GetKey() *Key {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *KeyChange) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+type KeySnapshot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []*Key                 `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeySnapshot) Reset() {
+	*x = KeySnapshot{}
+	mi := &file_keyhub_console_v1_key_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeySnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeySnapshot) ProtoMessage() {}
+
+func (x *KeySnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_key_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeySnapshot.ProtoReflect.Descriptor instead.
+func (*KeySnapshot) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_key_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *KeySnapshot) GetKeys() []*Key {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// 接続を保つために一定間隔で送る。クライアントは読み捨ててよい
+type KeyWatchHeartbeat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeyWatchHeartbeat) Reset() {
+	*x = KeyWatchHeartbeat{}
+	mi := &file_keyhub_console_v1_key_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeyWatchHeartbeat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyWatchHeartbeat) ProtoMessage() {}
+
+func (x *KeyWatchHeartbeat) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_key_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyWatchHeartbeat.ProtoReflect.Descriptor instead.
+func (*KeyWatchHeartbeat) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_key_proto_rawDescGZIP(), []int{9}
+}
+
+type WatchKeysResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Event:
+	//
+	//	*WatchKeysResponse_Snapshot
+	//	*WatchKeysResponse_Change
+	//	*WatchKeysResponse_Heartbeat
+	Event         isWatchKeysResponse_Event `protobuf_oneof:"event"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchKeysResponse) Reset() {
+	*x = WatchKeysResponse{}
+	mi := &file_keyhub_console_v1_key_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchKeysResponse) ProtoMessage() {}
+
+func (x *WatchKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_key_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchKeysResponse.ProtoReflect.Descriptor instead.
+func (*WatchKeysResponse) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_key_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *WatchKeysResponse) GetEvent() isWatchKeysResponse_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *WatchKeysResponse) GetSnapshot() *KeySnapshot {
+	if x != nil {
+		if x, ok := x.Event.(*WatchKeysResponse_Snapshot); ok {
+			return x.Snapshot
+		}
+	}
+	return nil
+}
+
+func (x *WatchKeysResponse) GetChange() *KeyChange {
+	if x != nil {
+		if x, ok := x.Event.(*WatchKeysResponse_Change); ok {
+			return x.Change
+		}
+	}
+	return nil
+}
+
+func (x *WatchKeysResponse) GetHeartbeat() *KeyWatchHeartbeat {
+	if x != nil {
+		if x, ok := x.Event.(*WatchKeysResponse_Heartbeat); ok {
+			return x.Heartbeat
+		}
+	}
+	return nil
+}
+
+type isWatchKeysResponse_Event interface {
+	isWatchKeysResponse_Event()
+}
+
+type WatchKeysResponse_Snapshot struct {
+	// 最初の1回だけ送る
+	Snapshot *KeySnapshot `protobuf:"bytes,1,opt,name=snapshot,proto3,oneof"`
+}
+
+type WatchKeysResponse_Change struct {
+	Change *KeyChange `protobuf:"bytes,2,opt,name=change,proto3,oneof"`
+}
+
+type WatchKeysResponse_Heartbeat struct {
+	Heartbeat *KeyWatchHeartbeat `protobuf:"bytes,3,opt,name=heartbeat,proto3,oneof"`
+}
+
+func (*WatchKeysResponse_Snapshot) isWatchKeysResponse_Event() {}
+
+func (*WatchKeysResponse_Change) isWatchKeysResponse_Event() {}
+
+func (*WatchKeysResponse_Heartbeat) isWatchKeysResponse_Event() {}
+
 var File_keyhub_console_v1_key_proto protoreflect.FileDescriptor
 
 const file_keyhub_console_v1_key_proto_rawDesc = "" +
 	"\n" +
-	"\x1bkeyhub/console/v1/key.proto\x12\x11keyhub.console.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1ekeyhub/console/v1/common.proto\"T\n" +
+	"\x1bkeyhub/console/v1/key.proto\x12\x11keyhub.console.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1ekeyhub/console/v1/common.proto\"T\n" +
 	"\x10CreateKeyRequest\x12!\n" +
 	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\x12\x1d\n" +
 	"\n" +
 	"key_number\x18\x02 \x01(\tR\tkeyNumber\"-\n" +
 	"\x11CreateKeyResponse\x12\x18\n" +
-	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"9\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"x\n" +
+	"\x10UpdateKeyRequest\x12\x1f\n" +
+	"\x06key_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x05keyId\x12\x1d\n" +
+	"\n" +
+	"key_number\x18\x02 \x01(\tR\tkeyNumber\x12$\n" +
+	"\aversion\x18\x03 \x01(\x03B\n" +
+	"\xbaH\a\xc8\x01\x01\"\x02 \x00R\aversion\"=\n" +
+	"\x11UpdateKeyResponse\x12(\n" +
+	"\x03key\x18\x01 \x01(\v2\x16.keyhub.console.v1.KeyR\x03key\"\x97\x02\n" +
 	"\x14GetKeysByRoomRequest\x12!\n" +
-	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\"C\n" +
+	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\x12'\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xc8\x01(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x122\n" +
+	"\x05order\x18\x04 \x01(\x0e2\x1c.keyhub.console.v1.ListOrderR\x05order\x124\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x1c.keyhub.console.v1.KeyStatusR\x06status\x12*\n" +
+	"\x11key_number_prefix\x18\x06 \x01(\tR\x0fkeyNumberPrefix\"k\n" +
 	"\x15GetKeysByRoomResponse\x12*\n" +
-	"\x04keys\x18\x01 \x03(\v2\x16.keyhub.console.v1.KeyR\x04keys2\xcf\x01\n" +
+	"\x04keys\x18\x01 \x03(\v2\x16.keyhub.console.v1.KeyR\x04keys\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"i\n" +
+	"\x10WatchKeysRequest\x12#\n" +
+	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\x06roomId\x12'\n" +
+	"\ttenant_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\btenantIdB\a\n" +
+	"\x05scope\"\xb5\x01\n" +
+	"\tKeyChange\x12C\n" +
+	"\toperation\x18\x01 \x01(\x0e2%.keyhub.console.v1.KeyChangeOperationR\toperation\x12(\n" +
+	"\x03key\x18\x02 \x01(\v2\x16.keyhub.console.v1.KeyR\x03key\x129\n" +
+	"\n" +
+	"changed_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\"9\n" +
+	"\vKeySnapshot\x12*\n" +
+	"\x04keys\x18\x01 \x03(\v2\x16.keyhub.console.v1.KeyR\x04keys\"\x13\n" +
+	"\x11KeyWatchHeartbeat\"\xd8\x01\n" +
+	"\x11WatchKeysResponse\x12<\n" +
+	"\bsnapshot\x18\x01 \x01(\v2\x1e.keyhub.console.v1.KeySnapshotH\x00R\bsnapshot\x126\n" +
+	"\x06change\x18\x02 \x01(\v2\x1c.keyhub.console.v1.KeyChangeH\x00R\x06change\x12D\n" +
+	"\theartbeat\x18\x03 \x01(\v2$.keyhub.console.v1.KeyWatchHeartbeatH\x00R\theartbeatB\a\n" +
+	"\x05event*\xa0\x01\n" +
+	"\x12KeyChangeOperation\x12$\n" +
+	" KEY_CHANGE_OPERATION_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cKEY_CHANGE_OPERATION_CREATED\x10\x01\x12 \n" +
+	"\x1cKEY_CHANGE_OPERATION_UPDATED\x10\x02\x12 \n" +
+	"\x1cKEY_CHANGE_OPERATION_DELETED\x10\x032\x86\x03\n" +
 	"\x11ConsoleKeyService\x12V\n" +
-	"\tCreateKey\x12#.keyhub.console.v1.CreateKeyRequest\x1a$.keyhub.console.v1.CreateKeyResponse\x12b\n" +
-	"\rGetKeysByRoom\x12'.keyhub.console.v1.GetKeysByRoomRequest\x1a(.keyhub.console.v1.GetKeysByRoomResponseB\xdc\x01\n" +
+	"\tCreateKey\x12#.keyhub.console.v1.CreateKeyRequest\x1a$.keyhub.console.v1.CreateKeyResponse\x12V\n" +
+	"\tUpdateKey\x12#.keyhub.console.v1.UpdateKeyRequest\x1a$.keyhub.console.v1.UpdateKeyResponse\x12b\n" +
+	"\rGetKeysByRoom\x12'.keyhub.console.v1.GetKeysByRoomRequest\x1a(.keyhub.console.v1.GetKeysByRoomResponse\x12]\n" +
+	"\tWatchKeys\x12#.keyhub.console.v1.WatchKeysRequest\x1a$.keyhub.console.v1.WatchKeysResponse\"\x03\x90\x02\x010\x01B\xdc\x01\n" +
 	"\x15com.keyhub.console.v1B\bKeyProtoP\x01ZSgithub.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1;consolev1\xa2\x02\x03KCX\xaa\x02\x11Keyhub.Console.V1\xca\x02\x11Keyhub\\Console\\V1\xe2\x02\x1dKeyhub\\Console\\V1\\GPBMetadata\xea\x02\x13Keyhub::Console::V1b\x06proto3"
 
 var (
@@ -238,25 +810,51 @@ func file_keyhub_console_v1_key_proto_rawDescGZIP() []byte {
 	return file_keyhub_console_v1_key_proto_rawDescData
 }
 
-var file_keyhub_console_v1_key_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_keyhub_console_v1_key_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_keyhub_console_v1_key_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_keyhub_console_v1_key_proto_goTypes = []any{
-	(*CreateKeyRequest)(nil),      // 0: keyhub.console.v1.CreateKeyRequest
-	(*CreateKeyResponse)(nil),     // 1: keyhub.console.v1.CreateKeyResponse
-	(*GetKeysByRoomRequest)(nil),  // 2: keyhub.console.v1.GetKeysByRoomRequest
-	(*GetKeysByRoomResponse)(nil), // 3: keyhub.console.v1.GetKeysByRoomResponse
-	(*Key)(nil),                   // 4: keyhub.console.v1.Key
+	(KeyChangeOperation)(0),       // 0: keyhub.console.v1.KeyChangeOperation
+	(*CreateKeyRequest)(nil),      // 1: keyhub.console.v1.CreateKeyRequest
+	(*CreateKeyResponse)(nil),     // 2: keyhub.console.v1.CreateKeyResponse
+	(*UpdateKeyRequest)(nil),      // 3: keyhub.console.v1.UpdateKeyRequest
+	(*UpdateKeyResponse)(nil),     // 4: keyhub.console.v1.UpdateKeyResponse
+	(*GetKeysByRoomRequest)(nil),  // 5: keyhub.console.v1.GetKeysByRoomRequest
+	(*GetKeysByRoomResponse)(nil), // 6: keyhub.console.v1.GetKeysByRoomResponse
+	(*WatchKeysRequest)(nil),      // 7: keyhub.console.v1.WatchKeysRequest
+	(*KeyChange)(nil),             // 8: keyhub.console.v1.KeyChange
+	(*KeySnapshot)(nil),           // 9: keyhub.console.v1.KeySnapshot
+	(*KeyWatchHeartbeat)(nil),     // 10: keyhub.console.v1.KeyWatchHeartbeat
+	(*WatchKeysResponse)(nil),     // 11: keyhub.console.v1.WatchKeysResponse
+	(*Key)(nil),                   // 12: keyhub.console.v1.Key
+	(ListOrder)(0),                // 13: keyhub.console.v1.ListOrder
+	(KeyStatus)(0),                // 14: keyhub.console.v1.KeyStatus
+	(*timestamppb.Timestamp)(nil), // 15: google.protobuf.Timestamp
 }
 var file_keyhub_console_v1_key_proto_depIdxs = []int32{
-	4, // 0: keyhub.console.v1.GetKeysByRoomResponse.keys:type_name -> keyhub.console.v1.Key
-	0, // 1: keyhub.console.v1.ConsoleKeyService.CreateKey:input_type -> keyhub.console.v1.CreateKeyRequest
-	2, // 2: keyhub.console.v1.ConsoleKeyService.GetKeysByRoom:input_type -> keyhub.console.v1.GetKeysByRoomRequest
-	1, // 3: keyhub.console.v1.ConsoleKeyService.CreateKey:output_type -> keyhub.console.v1.CreateKeyResponse
-	3, // 4: keyhub.console.v1.ConsoleKeyService.GetKeysByRoom:output_type -> keyhub.console.v1.GetKeysByRoomResponse
-	3, // [3:5] is the sub-list for method output_type
-	1, // [1:3] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	12, // 0: keyhub.console.v1.UpdateKeyResponse.key:type_name -> keyhub.console.v1.Key
+	13, // 1: keyhub.console.v1.GetKeysByRoomRequest.order:type_name -> keyhub.console.v1.ListOrder
+	14, // 2: keyhub.console.v1.GetKeysByRoomRequest.status:type_name -> keyhub.console.v1.KeyStatus
+	12, // 3: keyhub.console.v1.GetKeysByRoomResponse.keys:type_name -> keyhub.console.v1.Key
+	0,  // 4: keyhub.console.v1.KeyChange.operation:type_name -> keyhub.console.v1.KeyChangeOperation
+	12, // 5: keyhub.console.v1.KeyChange.key:type_name -> keyhub.console.v1.Key
+	15, // 6: keyhub.console.v1.KeyChange.changed_at:type_name -> google.protobuf.Timestamp
+	12, // 7: keyhub.console.v1.KeySnapshot.keys:type_name -> keyhub.console.v1.Key
+	9,  // 8: keyhub.console.v1.WatchKeysResponse.snapshot:type_name -> keyhub.console.v1.KeySnapshot
+	8,  // 9: keyhub.console.v1.WatchKeysResponse.change:type_name -> keyhub.console.v1.KeyChange
+	10, // 10: keyhub.console.v1.WatchKeysResponse.heartbeat:type_name -> keyhub.console.v1.KeyWatchHeartbeat
+	1,  // 11: keyhub.console.v1.ConsoleKeyService.CreateKey:input_type -> keyhub.console.v1.CreateKeyRequest
+	3,  // 12: keyhub.console.v1.ConsoleKeyService.UpdateKey:input_type -> keyhub.console.v1.UpdateKeyRequest
+	5,  // 13: keyhub.console.v1.ConsoleKeyService.GetKeysByRoom:input_type -> keyhub.console.v1.GetKeysByRoomRequest
+	7,  // 14: keyhub.console.v1.ConsoleKeyService.WatchKeys:input_type -> keyhub.console.v1.WatchKeysRequest
+	2,  // 15: keyhub.console.v1.ConsoleKeyService.CreateKey:output_type -> keyhub.console.v1.CreateKeyResponse
+	4,  // 16: keyhub.console.v1.ConsoleKeyService.UpdateKey:output_type -> keyhub.console.v1.UpdateKeyResponse
+	6,  // 17: keyhub.console.v1.ConsoleKeyService.GetKeysByRoom:output_type -> keyhub.console.v1.GetKeysByRoomResponse
+	11, // 18: keyhub.console.v1.ConsoleKeyService.WatchKeys:output_type -> keyhub.console.v1.WatchKeysResponse
+	15, // [15:19] is the sub-list for method output_type
+	11, // [11:15] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_keyhub_console_v1_key_proto_init() }
@@ -265,18 +863,28 @@ func file_keyhub_console_v1_key_proto_init() {
 		return
 	}
 	file_keyhub_console_v1_common_proto_init()
+	file_keyhub_console_v1_key_proto_msgTypes[6].OneofWrappers = []any{
+		(*WatchKeysRequest_RoomId)(nil),
+		(*WatchKeysRequest_TenantId)(nil),
+	}
+	file_keyhub_console_v1_key_proto_msgTypes[10].OneofWrappers = []any{
+		(*WatchKeysResponse_Snapshot)(nil),
+		(*WatchKeysResponse_Change)(nil),
+		(*WatchKeysResponse_Heartbeat)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_keyhub_console_v1_key_proto_rawDesc), len(file_keyhub_console_v1_key_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   4,
+			NumEnums:      1,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_keyhub_console_v1_key_proto_goTypes,
 		DependencyIndexes: file_keyhub_console_v1_key_proto_depIdxs,
+		EnumInfos:         file_keyhub_console_v1_key_proto_enumTypes,
 		MessageInfos:      file_keyhub_console_v1_key_proto_msgTypes,
 	}.Build()
 	File_keyhub_console_v1_key_proto = out.File
@@ -0,0 +1,543 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: keyhub/console/v1/organization.proto
+
+package consolev1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type OrganizationSettings struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Locale        string                 `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"`     // "ja" または "en"（省略時は "ja"）
+	Timezone      string                 `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"` // IANA形式（省略時は "Asia/Tokyo"）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrganizationSettings) Reset() {
+	*x = OrganizationSettings{}
+	mi := &file_keyhub_console_v1_organization_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationSettings) ProtoMessage() {}
+
+func (x *OrganizationSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_organization_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationSettings.ProtoReflect.Descriptor instead.
+func (*OrganizationSettings) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_organization_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OrganizationSettings) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *OrganizationSettings) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type Organization struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Slug          string                 `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
+	Settings      *OrganizationSettings  `protobuf:"bytes,4,opt,name=settings,proto3" json:"settings,omitempty"`
+	HasKey        bool                   `protobuf:"varint,5,opt,name=has_key,json=hasKey,proto3" json:"has_key,omitempty"` // Organization Keyが発行済みかどうか
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Organization) Reset() {
+	*x = Organization{}
+	mi := &file_keyhub_console_v1_organization_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Organization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Organization) ProtoMessage() {}
+
+func (x *Organization) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_organization_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Organization.ProtoReflect.Descriptor instead.
+func (*Organization) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_organization_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Organization) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Organization) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Organization) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Organization) GetSettings() *OrganizationSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *Organization) GetHasKey() bool {
+	if x != nil {
+		return x.HasKey
+	}
+	return false
+}
+
+func (x *Organization) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateOrganizationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Slug          string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Settings      *OrganizationSettings  `protobuf:"bytes,3,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrganizationRequest) Reset() {
+	*x = CreateOrganizationRequest{}
+	mi := &file_keyhub_console_v1_organization_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrganizationRequest) ProtoMessage() {}
+
+func (x *CreateOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_organization_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*CreateOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_organization_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateOrganizationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateOrganizationRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *CreateOrganizationRequest) GetSettings() *OrganizationSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type CreateOrganizationResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Organization    *Organization          `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	OrganizationKey string                 `protobuf:"bytes,2,opt,name=organization_key,json=organizationKey,proto3" json:"organization_key,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateOrganizationResponse) Reset() {
+	*x = CreateOrganizationResponse{}
+	mi := &file_keyhub_console_v1_organization_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrganizationResponse) ProtoMessage() {}
+
+func (x *CreateOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_organization_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*CreateOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_organization_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateOrganizationResponse) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+func (x *CreateOrganizationResponse) GetOrganizationKey() string {
+	if x != nil {
+		return x.OrganizationKey
+	}
+	return ""
+}
+
+type ListOrganizationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrganizationsRequest) Reset() {
+	*x = ListOrganizationsRequest{}
+	mi := &file_keyhub_console_v1_organization_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrganizationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrganizationsRequest) ProtoMessage() {}
+
+func (x *ListOrganizationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_organization_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrganizationsRequest.ProtoReflect.Descriptor instead.
+func (*ListOrganizationsRequest) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_organization_proto_rawDescGZIP(), []int{4}
+}
+
+type ListOrganizationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organizations []*Organization        `protobuf:"bytes,1,rep,name=organizations,proto3" json:"organizations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrganizationsResponse) Reset() {
+	*x = ListOrganizationsResponse{}
+	mi := &file_keyhub_console_v1_organization_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrganizationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrganizationsResponse) ProtoMessage() {}
+
+func (x *ListOrganizationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_organization_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrganizationsResponse.ProtoReflect.Descriptor instead.
+func (*ListOrganizationsResponse) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_organization_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListOrganizationsResponse) GetOrganizations() []*Organization {
+	if x != nil {
+		return x.Organizations
+	}
+	return nil
+}
+
+type RotateOrganizationKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateOrganizationKeyRequest) Reset() {
+	*x = RotateOrganizationKeyRequest{}
+	mi := &file_keyhub_console_v1_organization_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateOrganizationKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateOrganizationKeyRequest) ProtoMessage() {}
+
+func (x *RotateOrganizationKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_organization_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateOrganizationKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateOrganizationKeyRequest) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_organization_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RotateOrganizationKeyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RotateOrganizationKeyResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationKey string                 `protobuf:"bytes,1,opt,name=organization_key,json=organizationKey,proto3" json:"organization_key,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RotateOrganizationKeyResponse) Reset() {
+	*x = RotateOrganizationKeyResponse{}
+	mi := &file_keyhub_console_v1_organization_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateOrganizationKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateOrganizationKeyResponse) ProtoMessage() {}
+
+func (x *RotateOrganizationKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_organization_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateOrganizationKeyResponse.ProtoReflect.Descriptor instead.
+func (*RotateOrganizationKeyResponse) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_organization_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RotateOrganizationKeyResponse) GetOrganizationKey() string {
+	if x != nil {
+		return x.OrganizationKey
+	}
+	return ""
+}
+
+var File_keyhub_console_v1_organization_proto protoreflect.FileDescriptor
+
+const file_keyhub_console_v1_organization_proto_rawDesc = "" +
+	"\n" +
+	"$keyhub/console/v1/organization.proto\x12\x11keyhub.console.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"J\n" +
+	"\x14OrganizationSettings\x12\x16\n" +
+	"\x06locale\x18\x01 \x01(\tR\x06locale\x12\x1a\n" +
+	"\btimezone\x18\x02 \x01(\tR\btimezone\"\xe9\x01\n" +
+	"\fOrganization\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04slug\x18\x03 \x01(\tR\x04slug\x12C\n" +
+	"\bsettings\x18\x04 \x01(\v2'.keyhub.console.v1.OrganizationSettingsR\bsettings\x12\x17\n" +
+	"\ahas_key\x18\x05 \x01(\bR\x06hasKey\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xbd\x01\n" +
+	"\x19CreateOrganizationRequest\x12\x1d\n" +
+	"\x04name\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\x04name\x12<\n" +
+	"\x04slug\x18\x02 \x01(\tB(\xbaH%r#2!^[a-z0-9][a-z0-9-]{1,61}[a-z0-9]$R\x04slug\x12C\n" +
+	"\bsettings\x18\x03 \x01(\v2'.keyhub.console.v1.OrganizationSettingsR\bsettings\"\x8c\x01\n" +
+	"\x1aCreateOrganizationResponse\x12C\n" +
+	"\forganization\x18\x01 \x01(\v2\x1f.keyhub.console.v1.OrganizationR\forganization\x12)\n" +
+	"\x10organization_key\x18\x02 \x01(\tR\x0forganizationKey\"\x1a\n" +
+	"\x18ListOrganizationsRequest\"b\n" +
+	"\x19ListOrganizationsResponse\x12E\n" +
+	"\rorganizations\x18\x01 \x03(\v2\x1f.keyhub.console.v1.OrganizationR\rorganizations\"8\n" +
+	"\x1cRotateOrganizationKeyRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"J\n" +
+	"\x1dRotateOrganizationKeyResponse\x12)\n" +
+	"\x10organization_key\x18\x01 \x01(\tR\x0forganizationKey2\xfc\x02\n" +
+	"\x16ConsolePlatformService\x12q\n" +
+	"\x12CreateOrganization\x12,.keyhub.console.v1.CreateOrganizationRequest\x1a-.keyhub.console.v1.CreateOrganizationResponse\x12s\n" +
+	"\x11ListOrganizations\x12+.keyhub.console.v1.ListOrganizationsRequest\x1a,.keyhub.console.v1.ListOrganizationsResponse\"\x03\x90\x02\x01\x12z\n" +
+	"\x15RotateOrganizationKey\x12/.keyhub.console.v1.RotateOrganizationKeyRequest\x1a0.keyhub.console.v1.RotateOrganizationKeyResponseB\xe5\x01\n" +
+	"\x15com.keyhub.console.v1B\x11OrganizationProtoP\x01ZSgithub.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1;consolev1\xa2\x02\x03KCX\xaa\x02\x11Keyhub.Console.V1\xca\x02\x11Keyhub\\Console\\V1\xe2\x02\x1dKeyhub\\Console\\V1\\GPBMetadata\xea\x02\x13Keyhub::Console::V1b\x06proto3"
+
+var (
+	file_keyhub_console_v1_organization_proto_rawDescOnce sync.Once
+	file_keyhub_console_v1_organization_proto_rawDescData []byte
+)
+
+func file_keyhub_console_v1_organization_proto_rawDescGZIP() []byte {
+	file_keyhub_console_v1_organization_proto_rawDescOnce.Do(func() {
+		file_keyhub_console_v1_organization_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_keyhub_console_v1_organization_proto_rawDesc), len(file_keyhub_console_v1_organization_proto_rawDesc)))
+	})
+	return file_keyhub_console_v1_organization_proto_rawDescData
+}
+
+var file_keyhub_console_v1_organization_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_keyhub_console_v1_organization_proto_goTypes = []any{
+	(*OrganizationSettings)(nil),          // 0: keyhub.console.v1.OrganizationSettings
+	(*Organization)(nil),                  // 1: keyhub.console.v1.Organization
+	(*CreateOrganizationRequest)(nil),     // 2: keyhub.console.v1.CreateOrganizationRequest
+	(*CreateOrganizationResponse)(nil),    // 3: keyhub.console.v1.CreateOrganizationResponse
+	(*ListOrganizationsRequest)(nil),      // 4: keyhub.console.v1.ListOrganizationsRequest
+	(*ListOrganizationsResponse)(nil),     // 5: keyhub.console.v1.ListOrganizationsResponse
+	(*RotateOrganizationKeyRequest)(nil),  // 6: keyhub.console.v1.RotateOrganizationKeyRequest
+	(*RotateOrganizationKeyResponse)(nil), // 7: keyhub.console.v1.RotateOrganizationKeyResponse
+	(*timestamppb.Timestamp)(nil),         // 8: google.protobuf.Timestamp
+}
+var file_keyhub_console_v1_organization_proto_depIdxs = []int32{
+	0, // 0: keyhub.console.v1.Organization.settings:type_name -> keyhub.console.v1.OrganizationSettings
+	8, // 1: keyhub.console.v1.Organization.created_at:type_name -> google.protobuf.Timestamp
+	0, // 2: keyhub.console.v1.CreateOrganizationRequest.settings:type_name -> keyhub.console.v1.OrganizationSettings
+	1, // 3: keyhub.console.v1.CreateOrganizationResponse.organization:type_name -> keyhub.console.v1.Organization
+	1, // 4: keyhub.console.v1.ListOrganizationsResponse.organizations:type_name -> keyhub.console.v1.Organization
+	2, // 5: keyhub.console.v1.ConsolePlatformService.CreateOrganization:input_type -> keyhub.console.v1.CreateOrganizationRequest
+	4, // 6: keyhub.console.v1.ConsolePlatformService.ListOrganizations:input_type -> keyhub.console.v1.ListOrganizationsRequest
+	6, // 7: keyhub.console.v1.ConsolePlatformService.RotateOrganizationKey:input_type -> keyhub.console.v1.RotateOrganizationKeyRequest
+	3, // 8: keyhub.console.v1.ConsolePlatformService.CreateOrganization:output_type -> keyhub.console.v1.CreateOrganizationResponse
+	5, // 9: keyhub.console.v1.ConsolePlatformService.ListOrganizations:output_type -> keyhub.console.v1.ListOrganizationsResponse
+	7, // 10: keyhub.console.v1.ConsolePlatformService.RotateOrganizationKey:output_type -> keyhub.console.v1.RotateOrganizationKeyResponse
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_keyhub_console_v1_organization_proto_init() }
+func file_keyhub_console_v1_organization_proto_init() {
+	if File_keyhub_console_v1_organization_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_keyhub_console_v1_organization_proto_rawDesc), len(file_keyhub_console_v1_organization_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_keyhub_console_v1_organization_proto_goTypes,
+		DependencyIndexes: file_keyhub_console_v1_organization_proto_depIdxs,
+		MessageInfos:      file_keyhub_console_v1_organization_proto_msgTypes,
+	}.Build()
+	File_keyhub_console_v1_organization_proto = out.File
+	file_keyhub_console_v1_organization_proto_goTypes = nil
+	file_keyhub_console_v1_organization_proto_depIdxs = nil
+}
@@ -23,13 +23,69 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type RoomCustomFieldType int32
+
+const (
+	RoomCustomFieldType_ROOM_CUSTOM_FIELD_TYPE_UNSPECIFIED RoomCustomFieldType = 0
+	RoomCustomFieldType_ROOM_CUSTOM_FIELD_TYPE_TEXT        RoomCustomFieldType = 1 // 200文字以内の文字列
+	RoomCustomFieldType_ROOM_CUSTOM_FIELD_TYPE_NUMBER      RoomCustomFieldType = 2 // 数値
+	RoomCustomFieldType_ROOM_CUSTOM_FIELD_TYPE_BOOLEAN     RoomCustomFieldType = 3 // "true" か "false"
+	RoomCustomFieldType_ROOM_CUSTOM_FIELD_TYPE_SELECT      RoomCustomFieldType = 4 // options のいずれか
+)
+
+// Enum value maps for RoomCustomFieldType.
+var (
+	RoomCustomFieldType_name = map[int32]string{
+		0: "ROOM_CUSTOM_FIELD_TYPE_UNSPECIFIED",
+		1: "ROOM_CUSTOM_FIELD_TYPE_TEXT",
+		2: "ROOM_CUSTOM_FIELD_TYPE_NUMBER",
+		3: "ROOM_CUSTOM_FIELD_TYPE_BOOLEAN",
+		4: "ROOM_CUSTOM_FIELD_TYPE_SELECT",
+	}
+	RoomCustomFieldType_value = map[string]int32{
+		"ROOM_CUSTOM_FIELD_TYPE_UNSPECIFIED": 0,
+		"ROOM_CUSTOM_FIELD_TYPE_TEXT":        1,
+		"ROOM_CUSTOM_FIELD_TYPE_NUMBER":      2,
+		"ROOM_CUSTOM_FIELD_TYPE_BOOLEAN":     3,
+		"ROOM_CUSTOM_FIELD_TYPE_SELECT":      4,
+	}
+)
+
+func (x RoomCustomFieldType) Enum() *RoomCustomFieldType {
+	p := new(RoomCustomFieldType)
+	*p = x
+	return p
+}
+
+func (x RoomCustomFieldType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RoomCustomFieldType) Descriptor() protoreflect.EnumDescriptor {
+	return file_keyhub_console_v1_room_proto_enumTypes[0].Descriptor()
+}
+
+func (RoomCustomFieldType) Type() protoreflect.EnumType {
+	return &file_keyhub_console_v1_room_proto_enumTypes[0]
+}
+
+func (x RoomCustomFieldType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RoomCustomFieldType.Descriptor instead.
+func (RoomCustomFieldType) EnumDescriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_room_proto_rawDescGZIP(), []int{0}
+}
+
 type CreateRoomRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	BuildingName  string                 `protobuf:"bytes,2,opt,name=building_name,json=buildingName,proto3" json:"building_name,omitempty"`
-	FloorNumber   string                 `protobuf:"bytes,3,opt,name=floor_number,json=floorNumber,proto3" json:"floor_number,omitempty"`
 	RoomType      RoomType               `protobuf:"varint,4,opt,name=room_type,json=roomType,proto3,enum=keyhub.console.v1.RoomType" json:"room_type,omitempty"`
 	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	FloorId       string                 `protobuf:"bytes,6,opt,name=floor_id,json=floorId,proto3" json:"floor_id,omitempty"` // 部屋を置く階。建物名・階は階から決まる
+	Attributes    *RoomAttributes        `protobuf:"bytes,7,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	RoomTypeKey   string                 `protobuf:"bytes,8,opt,name=room_type_key,json=roomTypeKey,proto3" json:"room_type_key,omitempty"` // 組織が追加した部屋タイプのキー。指定した場合は room_type より優先する
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -71,30 +127,37 @@ func (x *CreateRoomRequest) GetName() string {
 	return ""
 }
 
-func (x *CreateRoomRequest) GetBuildingName() string {
+func (x *CreateRoomRequest) GetRoomType() RoomType {
 	if x != nil {
-		return x.BuildingName
+		return x.RoomType
+	}
+	return RoomType_ROOM_TYPE_UNSPECIFIED
+}
+
+func (x *CreateRoomRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
 	}
 	return ""
 }
 
-func (x *CreateRoomRequest) GetFloorNumber() string {
+func (x *CreateRoomRequest) GetFloorId() string {
 	if x != nil {
-		return x.FloorNumber
+		return x.FloorId
 	}
 	return ""
 }
 
-func (x *CreateRoomRequest) GetRoomType() RoomType {
+func (x *CreateRoomRequest) GetAttributes() *RoomAttributes {
 	if x != nil {
-		return x.RoomType
+		return x.Attributes
 	}
-	return RoomType_ROOM_TYPE_UNSPECIFIED
+	return nil
 }
 
-func (x *CreateRoomRequest) GetDescription() string {
+func (x *CreateRoomRequest) GetRoomTypeKey() string {
 	if x != nil {
-		return x.Description
+		return x.RoomTypeKey
 	}
 	return ""
 }
@@ -144,7 +207,24 @@ func (x *CreateRoomResponse) GetId() string {
 }
 
 type GetAllRoomsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PageSize  int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // 省略時50件
+	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // 前回のレスポンスの next_page_token。条件と並び順は前回と同じにする
+	Order     ListOrder              `protobuf:"varint,3,opt,name=order,proto3,enum=keyhub.console.v1.ListOrder" json:"order,omitempty"`
+	// 以下の条件は指定したものだけで絞り込む
+	BuildingName  string              `protobuf:"bytes,4,opt,name=building_name,json=buildingName,proto3" json:"building_name,omitempty"`
+	FloorNumber   string              `protobuf:"bytes,5,opt,name=floor_number,json=floorNumber,proto3" json:"floor_number,omitempty"`
+	RoomType      RoomType            `protobuf:"varint,6,opt,name=room_type,json=roomType,proto3,enum=keyhub.console.v1.RoomType" json:"room_type,omitempty"`
+	NamePrefix    string              `protobuf:"bytes,7,opt,name=name_prefix,json=namePrefix,proto3" json:"name_prefix,omitempty"` // 部屋名の前方一致
+	BuildingId    *string             `protobuf:"bytes,8,opt,name=building_id,json=buildingId,proto3,oneof" json:"building_id,omitempty"`
+	FloorId       *string             `protobuf:"bytes,9,opt,name=floor_id,json=floorId,proto3,oneof" json:"floor_id,omitempty"`
+	MinCapacity   int32               `protobuf:"varint,11,opt,name=min_capacity,json=minCapacity,proto3" json:"min_capacity,omitempty"`                                                                             // 収容人数がこの値以上
+	Equipment     []string            `protobuf:"bytes,12,rep,name=equipment,proto3" json:"equipment,omitempty"`                                                                                                     // すべての設備を持つ部屋
+	Accessibility []RoomAccessibility `protobuf:"varint,13,rep,packed,name=accessibility,proto3,enum=keyhub.console.v1.RoomAccessibility" json:"accessibility,omitempty"`                                            // すべてのバリアフリー対応を持つ部屋
+	CustomFields  map[string]string   `protobuf:"bytes,14,rep,name=custom_fields,json=customFields,proto3" json:"custom_fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // カスタム項目の値が一致する部屋
+	RoomTypeKey   string              `protobuf:"bytes,15,opt,name=room_type_key,json=roomTypeKey,proto3" json:"room_type_key,omitempty"`                                                                            // 部屋タイプのキー。指定した場合は room_type より優先する
+	// true の場合、取得したページの部屋を建物・階ごとにまとめた buildings も返す
+	GroupByFloor  bool `protobuf:"varint,10,opt,name=group_by_floor,json=groupByFloor,proto3" json:"group_by_floor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -179,9 +259,116 @@ func (*GetAllRoomsRequest) Descriptor() ([]byte, []int) {
 	return file_keyhub_console_v1_room_proto_rawDescGZIP(), []int{2}
 }
 
+func (x *GetAllRoomsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetAllRoomsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetAllRoomsRequest) GetOrder() ListOrder {
+	if x != nil {
+		return x.Order
+	}
+	return ListOrder_LIST_ORDER_UNSPECIFIED
+}
+
+func (x *GetAllRoomsRequest) GetBuildingName() string {
+	if x != nil {
+		return x.BuildingName
+	}
+	return ""
+}
+
+func (x *GetAllRoomsRequest) GetFloorNumber() string {
+	if x != nil {
+		return x.FloorNumber
+	}
+	return ""
+}
+
+func (x *GetAllRoomsRequest) GetRoomType() RoomType {
+	if x != nil {
+		return x.RoomType
+	}
+	return RoomType_ROOM_TYPE_UNSPECIFIED
+}
+
+func (x *GetAllRoomsRequest) GetNamePrefix() string {
+	if x != nil {
+		return x.NamePrefix
+	}
+	return ""
+}
+
+func (x *GetAllRoomsRequest) GetBuildingId() string {
+	if x != nil && x.BuildingId != nil {
+		return *x.BuildingId
+	}
+	return ""
+}
+
+func (x *GetAllRoomsRequest) GetFloorId() string {
+	if x != nil && x.FloorId != nil {
+		return *x.FloorId
+	}
+	return ""
+}
+
+func (x *GetAllRoomsRequest) GetMinCapacity() int32 {
+	if x != nil {
+		return x.MinCapacity
+	}
+	return 0
+}
+
+func (x *GetAllRoomsRequest) GetEquipment() []string {
+	if x != nil {
+		return x.Equipment
+	}
+	return nil
+}
+
+func (x *GetAllRoomsRequest) GetAccessibility() []RoomAccessibility {
+	if x != nil {
+		return x.Accessibility
+	}
+	return nil
+}
+
+func (x *GetAllRoomsRequest) GetCustomFields() map[string]string {
+	if x != nil {
+		return x.CustomFields
+	}
+	return nil
+}
+
+func (x *GetAllRoomsRequest) GetRoomTypeKey() string {
+	if x != nil {
+		return x.RoomTypeKey
+	}
+	return ""
+}
+
+func (x *GetAllRoomsRequest) GetGroupByFloor() bool {
+	if x != nil {
+		return x.GroupByFloor
+	}
+	return false
+}
+
 type GetAllRoomsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Rooms         []*Room                `protobuf:"bytes,1,rep,name=rooms,proto3" json:"rooms,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // 続きがない場合は空
+	Buildings     []*BuildingRooms       `protobuf:"bytes,3,rep,name=buildings,proto3" json:"buildings,omitempty"`                                // group_by_floor を指定した場合だけ返す
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -223,18 +410,141 @@ func (x *GetAllRoomsResponse) GetRooms() []*Room {
 	return nil
 }
 
-type AssignRoomToTenantRequest struct {
+func (x *GetAllRoomsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetAllRoomsResponse) GetBuildings() []*BuildingRooms {
+	if x != nil {
+		return x.Buildings
+	}
+	return nil
+}
+
+// 建物ごとの部屋。建物は名前順、階は level の昇順に並ぶ
+type BuildingRooms struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Building      *Building              `protobuf:"bytes,1,opt,name=building,proto3" json:"building,omitempty"`
+	Floors        []*FloorRooms          `protobuf:"bytes,2,rep,name=floors,proto3" json:"floors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildingRooms) Reset() {
+	*x = BuildingRooms{}
+	mi := &file_keyhub_console_v1_room_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildingRooms) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildingRooms) ProtoMessage() {}
+
+func (x *BuildingRooms) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_room_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildingRooms.ProtoReflect.Descriptor instead.
+func (*BuildingRooms) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_room_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BuildingRooms) GetBuilding() *Building {
+	if x != nil {
+		return x.Building
+	}
+	return nil
+}
+
+func (x *BuildingRooms) GetFloors() []*FloorRooms {
+	if x != nil {
+		return x.Floors
+	}
+	return nil
+}
+
+type FloorRooms struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	RoomId        string                 `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
-	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	Floor         *Floor                 `protobuf:"bytes,1,opt,name=floor,proto3" json:"floor,omitempty"`
+	Rooms         []*Room                `protobuf:"bytes,2,rep,name=rooms,proto3" json:"rooms,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *FloorRooms) Reset() {
+	*x = FloorRooms{}
+	mi := &file_keyhub_console_v1_room_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FloorRooms) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FloorRooms) ProtoMessage() {}
+
+func (x *FloorRooms) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_room_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FloorRooms.ProtoReflect.Descriptor instead.
+func (*FloorRooms) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_room_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FloorRooms) GetFloor() *Floor {
+	if x != nil {
+		return x.Floor
+	}
+	return nil
+}
+
+func (x *FloorRooms) GetRooms() []*Room {
+	if x != nil {
+		return x.Rooms
+	}
+	return nil
+}
+
+type AssignRoomToTenantRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	TenantId  string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	RoomId    string                 `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	// 指定するとそのグループ（子グループを含む）のメンバーだけが部屋を利用できる
+	GroupId *string `protobuf:"bytes,4,opt,name=group_id,json=groupId,proto3,oneof" json:"group_id,omitempty"`
+	// 指定すると鍵を借りられるのはそのグループのメンバーだけになる
+	KeyLoanGroupId *string `protobuf:"bytes,5,opt,name=key_loan_group_id,json=keyLoanGroupId,proto3,oneof" json:"key_loan_group_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
 func (x *AssignRoomToTenantRequest) Reset() {
 	*x = AssignRoomToTenantRequest{}
-	mi := &file_keyhub_console_v1_room_proto_msgTypes[4]
+	mi := &file_keyhub_console_v1_room_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -246,7 +556,7 @@ func (x *AssignRoomToTenantRequest) String() string {
 func (*AssignRoomToTenantRequest) ProtoMessage() {}
 
 func (x *AssignRoomToTenantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_keyhub_console_v1_room_proto_msgTypes[4]
+	mi := &file_keyhub_console_v1_room_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -259,7 +569,7 @@ func (x *AssignRoomToTenantRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssignRoomToTenantRequest.ProtoReflect.Descriptor instead.
 func (*AssignRoomToTenantRequest) Descriptor() ([]byte, []int) {
-	return file_keyhub_console_v1_room_proto_rawDescGZIP(), []int{4}
+	return file_keyhub_console_v1_room_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *AssignRoomToTenantRequest) GetTenantId() string {
@@ -283,6 +593,20 @@ func (x *AssignRoomToTenantRequest) GetExpiresAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *AssignRoomToTenantRequest) GetGroupId() string {
+	if x != nil && x.GroupId != nil {
+		return *x.GroupId
+	}
+	return ""
+}
+
+func (x *AssignRoomToTenantRequest) GetKeyLoanGroupId() string {
+	if x != nil && x.KeyLoanGroupId != nil {
+		return *x.KeyLoanGroupId
+	}
+	return ""
+}
+
 type AssignRoomToTenantResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AssignmentId  string                 `protobuf:"bytes,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
@@ -292,7 +616,7 @@ type AssignRoomToTenantResponse struct {
 
 func (x *AssignRoomToTenantResponse) Reset() {
 	*x = AssignRoomToTenantResponse{}
-	mi := &file_keyhub_console_v1_room_proto_msgTypes[5]
+	mi := &file_keyhub_console_v1_room_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -304,7 +628,7 @@ func (x *AssignRoomToTenantResponse) String() string {
 func (*AssignRoomToTenantResponse) ProtoMessage() {}
 
 func (x *AssignRoomToTenantResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_keyhub_console_v1_room_proto_msgTypes[5]
+	mi := &file_keyhub_console_v1_room_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -317,7 +641,7 @@ func (x *AssignRoomToTenantResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssignRoomToTenantResponse.ProtoReflect.Descriptor instead.
 func (*AssignRoomToTenantResponse) Descriptor() ([]byte, []int) {
-	return file_keyhub_console_v1_room_proto_rawDescGZIP(), []int{5}
+	return file_keyhub_console_v1_room_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *AssignRoomToTenantResponse) GetAssignmentId() string {
@@ -327,35 +651,959 @@ func (x *AssignRoomToTenantResponse) GetAssignmentId() string {
 	return ""
 }
 
-var File_keyhub_console_v1_room_proto protoreflect.FileDescriptor
+type UpdateRoomAttributesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Attributes    *RoomAttributes        `protobuf:"bytes,2,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	Version       int64                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"` // 取得時の Room.version。他の更新で版数が変わっていれば更新せず ABORTED を返す
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_keyhub_console_v1_room_proto_rawDesc = "" +
-	"\n" +
-	"\x1ckeyhub/console/v1/room.proto\x12\x11keyhub.console.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1ekeyhub/console/v1/common.proto\"\xcb\x01\n" +
-	"\x11CreateRoomRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12#\n" +
-	"\rbuilding_name\x18\x02 \x01(\tR\fbuildingName\x12!\n" +
-	"\ffloor_number\x18\x03 \x01(\tR\vfloorNumber\x128\n" +
-	"\troom_type\x18\x04 \x01(\x0e2\x1b.keyhub.console.v1.RoomTypeR\broomType\x12 \n" +
-	"\vdescription\x18\x05 \x01(\tR\vdescription\".\n" +
-	"\x12CreateRoomResponse\x12\x18\n" +
-	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x14\n" +
-	"\x12GetAllRoomsRequest\"D\n" +
-	"\x13GetAllRoomsResponse\x12-\n" +
-	"\x05rooms\x18\x01 \x03(\v2\x17.keyhub.console.v1.RoomR\x05rooms\"\xb4\x01\n" +
-	"\x19AssignRoomToTenantRequest\x12%\n" +
-	"\ttenant_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\btenantId\x12!\n" +
-	"\aroom_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\x12>\n" +
-	"\n" +
-	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\texpiresAt\x88\x01\x01B\r\n" +
-	"\v_expires_at\"K\n" +
-	"\x1aAssignRoomToTenantResponse\x12-\n" +
-	"\rassignment_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\fassignmentId2\xc0\x02\n" +
+func (x *UpdateRoomAttributesRequest) Reset() {
+	*x = UpdateRoomAttributesRequest{}
+	mi := &file_keyhub_console_v1_room_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRoomAttributesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoomAttributesRequest) ProtoMessage() {}
+
+func (x *UpdateRoomAttributesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_room_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoomAttributesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRoomAttributesRequest) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_room_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateRoomAttributesRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *UpdateRoomAttributesRequest) GetAttributes() *RoomAttributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *UpdateRoomAttributesRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type UpdateRoomAttributesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Room          *Room                  `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRoomAttributesResponse) Reset() {
+	*x = UpdateRoomAttributesResponse{}
+	mi := &file_keyhub_console_v1_room_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRoomAttributesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoomAttributesResponse) ProtoMessage() {}
+
+func (x *UpdateRoomAttributesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_room_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoomAttributesResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRoomAttributesResponse) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_room_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateRoomAttributesResponse) GetRoom() *Room {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+type RoomCustomField struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Label         string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	Type          RoomCustomFieldType    `protobuf:"varint,4,opt,name=type,proto3,enum=keyhub.console.v1.RoomCustomFieldType" json:"type,omitempty"`
+	Required      bool                   `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
+	Options       []string               `protobuf:"bytes,6,rep,name=options,proto3" json:"options,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomCustomField) Reset() {
+	*x = RoomCustomField{}
+	mi := &file_keyhub_console_v1_room_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomCustomField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomCustomField) ProtoMessage() {}
+
+func (x *RoomCustomField) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_room_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomCustomField.ProtoReflect.Descriptor instead.
+func (*RoomCustomField) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_room_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RoomCustomField) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RoomCustomField) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *RoomCustomField) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *RoomCustomField) GetType() RoomCustomFieldType {
+	if x != nil {
+		return x.Type
+	}
+	return RoomCustomFieldType_ROOM_CUSTOM_FIELD_TYPE_UNSPECIFIED
+}
+
+func (x *RoomCustomField) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *RoomCustomField) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *RoomCustomField) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateRoomCustomFieldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Type          RoomCustomFieldType    `protobuf:"varint,3,opt,name=type,proto3,enum=keyhub.console.v1.RoomCustomFieldType" json:"type,omitempty"`
+	Required      bool                   `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"` // 必須の項目は部屋の作成・属性の更新で値が必要になる
+	Options       []string               `protobuf:"bytes,5,rep,name=options,proto3" json:"options,omitempty"`    // type が SELECT の場合だけ指定する
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoomCustomFieldRequest) Reset() {
+	*x = CreateRoomCustomFieldRequest{}
+	mi := &file_keyhub_console_v1_room_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoomCustomFieldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomCustomFieldRequest) ProtoMessage() {}
+
+func (x *CreateRoomCustomFieldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_room_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoomCustomFieldRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoomCustomFieldRequest) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_room_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreateRoomCustomFieldRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CreateRoomCustomFieldRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *CreateRoomCustomFieldRequest) GetType() RoomCustomFieldType {
+	if x != nil {
+		return x.Type
+	}
+	return RoomCustomFieldType_ROOM_CUSTOM_FIELD_TYPE_UNSPECIFIED
+}
+
+func (x *CreateRoomCustomFieldRequest) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *CreateRoomCustomFieldRequest) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type CreateRoomCustomFieldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         *RoomCustomField       `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoomCustomFieldResponse) Reset() {
+	*x = CreateRoomCustomFieldResponse{}
+	mi := &file_keyhub_console_v1_room_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoomCustomFieldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomCustomFieldResponse) ProtoMessage() {}
+
+func (x *CreateRoomCustomFieldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_room_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoomCustomFieldResponse.ProtoReflect.Descriptor instead.
+func (*CreateRoomCustomFieldResponse) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_room_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateRoomCustomFieldResponse) GetField() *RoomCustomField {
+	if x != nil {
+		return x.Field
+	}
+	return nil
+}
+
+type ListRoomCustomFieldsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoomCustomFieldsRequest) Reset() {
+	*x = ListRoomCustomFieldsRequest{}
+	mi := &file_keyhub_console_v1_room_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoomCustomFieldsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoomCustomFieldsRequest) ProtoMessage() {}
+
+func (x *ListRoomCustomFieldsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_room_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoomCustomFieldsRequest.ProtoReflect.Descriptor instead.
+func (*ListRoomCustomFieldsRequest) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_room_proto_rawDescGZIP(), []int{13}
+}
+
+type ListRoomCustomFieldsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fields        []*RoomCustomField     `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoomCustomFieldsResponse) Reset() {
+	*x = ListRoomCustomFieldsResponse{}
+	mi := &file_keyhub_console_v1_room_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoomCustomFieldsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoomCustomFieldsResponse) ProtoMessage() {}
+
+func (x *ListRoomCustomFieldsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_room_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoomCustomFieldsResponse.ProtoReflect.Descriptor instead.
+func (*ListRoomCustomFieldsResponse) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_room_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListRoomCustomFieldsResponse) GetFields() []*RoomCustomField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type DeleteRoomCustomFieldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoomCustomFieldRequest) Reset() {
+	*x = DeleteRoomCustomFieldRequest{}
+	mi := &file_keyhub_console_v1_room_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoomCustomFieldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoomCustomFieldRequest) ProtoMessage() {}
+
+func (x *DeleteRoomCustomFieldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_room_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoomCustomFieldRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRoomCustomFieldRequest) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_room_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeleteRoomCustomFieldRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteRoomCustomFieldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoomCustomFieldResponse) Reset() {
+	*x = DeleteRoomCustomFieldResponse{}
+	mi := &file_keyhub_console_v1_room_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoomCustomFieldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoomCustomFieldResponse) ProtoMessage() {}
+
+func (x *DeleteRoomCustomFieldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_room_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoomCustomFieldResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRoomCustomFieldResponse) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_room_proto_rawDescGZIP(), []int{16}
+}
+
+type RoomTypeDefinition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // 既定のタイプは空
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Label         string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	BuiltIn       bool                   `protobuf:"varint,4,opt,name=built_in,json=builtIn,proto3" json:"built_in,omitempty"`                                    // 全組織で使える既定のタイプ。削除できない
+	RoomType      RoomType               `protobuf:"varint,5,opt,name=room_type,json=roomType,proto3,enum=keyhub.console.v1.RoomType" json:"room_type,omitempty"` // 既定のタイプの列挙値。組織が追加したタイプは UNSPECIFIED
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomTypeDefinition) Reset() {
+	*x = RoomTypeDefinition{}
+	mi := &file_keyhub_console_v1_room_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomTypeDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomTypeDefinition) ProtoMessage() {}
+
+func (x *RoomTypeDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_room_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomTypeDefinition.ProtoReflect.Descriptor instead.
+func (*RoomTypeDefinition) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_room_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RoomTypeDefinition) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RoomTypeDefinition) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *RoomTypeDefinition) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *RoomTypeDefinition) GetBuiltIn() bool {
+	if x != nil {
+		return x.BuiltIn
+	}
+	return false
+}
+
+func (x *RoomTypeDefinition) GetRoomType() RoomType {
+	if x != nil {
+		return x.RoomType
+	}
+	return RoomType_ROOM_TYPE_UNSPECIFIED
+}
+
+func (x *RoomTypeDefinition) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListRoomTypesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoomTypesRequest) Reset() {
+	*x = ListRoomTypesRequest{}
+	mi := &file_keyhub_console_v1_room_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoomTypesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoomTypesRequest) ProtoMessage() {}
+
+func (x *ListRoomTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_room_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoomTypesRequest.ProtoReflect.Descriptor instead.
+func (*ListRoomTypesRequest) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_room_proto_rawDescGZIP(), []int{18}
+}
+
+type ListRoomTypesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomTypes     []*RoomTypeDefinition  `protobuf:"bytes,1,rep,name=room_types,json=roomTypes,proto3" json:"room_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoomTypesResponse) Reset() {
+	*x = ListRoomTypesResponse{}
+	mi := &file_keyhub_console_v1_room_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoomTypesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoomTypesResponse) ProtoMessage() {}
+
+func (x *ListRoomTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_room_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoomTypesResponse.ProtoReflect.Descriptor instead.
+func (*ListRoomTypesResponse) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_room_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListRoomTypesResponse) GetRoomTypes() []*RoomTypeDefinition {
+	if x != nil {
+		return x.RoomTypes
+	}
+	return nil
+}
+
+type CreateRoomTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoomTypeRequest) Reset() {
+	*x = CreateRoomTypeRequest{}
+	mi := &file_keyhub_console_v1_room_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoomTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomTypeRequest) ProtoMessage() {}
+
+func (x *CreateRoomTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_room_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoomTypeRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoomTypeRequest) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_room_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateRoomTypeRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CreateRoomTypeRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+type CreateRoomTypeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomType      *RoomTypeDefinition    `protobuf:"bytes,1,opt,name=room_type,json=roomType,proto3" json:"room_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoomTypeResponse) Reset() {
+	*x = CreateRoomTypeResponse{}
+	mi := &file_keyhub_console_v1_room_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoomTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomTypeResponse) ProtoMessage() {}
+
+func (x *CreateRoomTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_room_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoomTypeResponse.ProtoReflect.Descriptor instead.
+func (*CreateRoomTypeResponse) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_room_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateRoomTypeResponse) GetRoomType() *RoomTypeDefinition {
+	if x != nil {
+		return x.RoomType
+	}
+	return nil
+}
+
+type DeleteRoomTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoomTypeRequest) Reset() {
+	*x = DeleteRoomTypeRequest{}
+	mi := &file_keyhub_console_v1_room_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoomTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoomTypeRequest) ProtoMessage() {}
+
+func (x *DeleteRoomTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_room_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoomTypeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRoomTypeRequest) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_room_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeleteRoomTypeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteRoomTypeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoomTypeResponse) Reset() {
+	*x = DeleteRoomTypeResponse{}
+	mi := &file_keyhub_console_v1_room_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoomTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoomTypeResponse) ProtoMessage() {}
+
+func (x *DeleteRoomTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_room_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoomTypeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRoomTypeResponse) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_room_proto_rawDescGZIP(), []int{23}
+}
+
+var File_keyhub_console_v1_room_proto protoreflect.FileDescriptor
+
+const file_keyhub_console_v1_room_proto_rawDesc = "" +
+	"\n" +
+	"\x1ckeyhub/console/v1/room.proto\x12\x11keyhub.console.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a keyhub/console/v1/building.proto\x1a\x1ekeyhub/console/v1/common.proto\"\xb8\x02\n" +
+	"\x11CreateRoomRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x128\n" +
+	"\troom_type\x18\x04 \x01(\x0e2\x1b.keyhub.console.v1.RoomTypeR\broomType\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12#\n" +
+	"\bfloor_id\x18\x06 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\afloorId\x12A\n" +
+	"\n" +
+	"attributes\x18\a \x01(\v2!.keyhub.console.v1.RoomAttributesR\n" +
+	"attributes\x12\"\n" +
+	"\rroom_type_key\x18\b \x01(\tR\vroomTypeKeyJ\x04\b\x02\x10\x03J\x04\b\x03\x10\x04R\rbuilding_nameR\ffloor_number\".\n" +
+	"\x12CreateRoomResponse\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\xac\x06\n" +
+	"\x12GetAllRoomsRequest\x12'\n" +
+	"\tpage_size\x18\x01 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xc8\x01(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x122\n" +
+	"\x05order\x18\x03 \x01(\x0e2\x1c.keyhub.console.v1.ListOrderR\x05order\x12#\n" +
+	"\rbuilding_name\x18\x04 \x01(\tR\fbuildingName\x12!\n" +
+	"\ffloor_number\x18\x05 \x01(\tR\vfloorNumber\x128\n" +
+	"\troom_type\x18\x06 \x01(\x0e2\x1b.keyhub.console.v1.RoomTypeR\broomType\x12\x1f\n" +
+	"\vname_prefix\x18\a \x01(\tR\n" +
+	"namePrefix\x12.\n" +
+	"\vbuilding_id\x18\b \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\n" +
+	"buildingId\x88\x01\x01\x12(\n" +
+	"\bfloor_id\x18\t \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x01R\afloorId\x88\x01\x01\x12-\n" +
+	"\fmin_capacity\x18\v \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\x90N(\x00R\vminCapacity\x12\x1c\n" +
+	"\tequipment\x18\f \x03(\tR\tequipment\x12J\n" +
+	"\raccessibility\x18\r \x03(\x0e2$.keyhub.console.v1.RoomAccessibilityR\raccessibility\x12\\\n" +
+	"\rcustom_fields\x18\x0e \x03(\v27.keyhub.console.v1.GetAllRoomsRequest.CustomFieldsEntryR\fcustomFields\x12\"\n" +
+	"\rroom_type_key\x18\x0f \x01(\tR\vroomTypeKey\x12$\n" +
+	"\x0egroup_by_floor\x18\n" +
+	" \x01(\bR\fgroupByFloor\x1a?\n" +
+	"\x11CustomFieldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x0e\n" +
+	"\f_building_idB\v\n" +
+	"\t_floor_id\"\xac\x01\n" +
+	"\x13GetAllRoomsResponse\x12-\n" +
+	"\x05rooms\x18\x01 \x03(\v2\x17.keyhub.console.v1.RoomR\x05rooms\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12>\n" +
+	"\tbuildings\x18\x03 \x03(\v2 .keyhub.console.v1.BuildingRoomsR\tbuildings\"\x7f\n" +
+	"\rBuildingRooms\x127\n" +
+	"\bbuilding\x18\x01 \x01(\v2\x1b.keyhub.console.v1.BuildingR\bbuilding\x125\n" +
+	"\x06floors\x18\x02 \x03(\v2\x1d.keyhub.console.v1.FloorRoomsR\x06floors\"k\n" +
+	"\n" +
+	"FloorRooms\x12.\n" +
+	"\x05floor\x18\x01 \x01(\v2\x18.keyhub.console.v1.FloorR\x05floor\x12-\n" +
+	"\x05rooms\x18\x02 \x03(\v2\x17.keyhub.console.v1.RoomR\x05rooms\"\xbb\x02\n" +
+	"\x19AssignRoomToTenantRequest\x12%\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\btenantId\x12!\n" +
+	"\aroom_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\x12>\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\texpiresAt\x88\x01\x01\x12(\n" +
+	"\bgroup_id\x18\x04 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x01R\agroupId\x88\x01\x01\x128\n" +
+	"\x11key_loan_group_id\x18\x05 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x02R\x0ekeyLoanGroupId\x88\x01\x01B\r\n" +
+	"\v_expires_atB\v\n" +
+	"\t_group_idB\x14\n" +
+	"\x12_key_loan_group_id\"K\n" +
+	"\x1aAssignRoomToTenantResponse\x12-\n" +
+	"\rassignment_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\fassignmentId\"\xa9\x01\n" +
+	"\x1bUpdateRoomAttributesRequest\x12!\n" +
+	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\x12A\n" +
+	"\n" +
+	"attributes\x18\x02 \x01(\v2!.keyhub.console.v1.RoomAttributesR\n" +
+	"attributes\x12$\n" +
+	"\aversion\x18\x03 \x01(\x03B\n" +
+	"\xbaH\a\xc8\x01\x01\"\x02 \x00R\aversion\"K\n" +
+	"\x1cUpdateRoomAttributesResponse\x12+\n" +
+	"\x04room\x18\x01 \x01(\v2\x17.keyhub.console.v1.RoomR\x04room\"\x80\x02\n" +
+	"\x0fRoomCustomField\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x12:\n" +
+	"\x04type\x18\x04 \x01(\x0e2&.keyhub.console.v1.RoomCustomFieldTypeR\x04type\x12\x1a\n" +
+	"\brequired\x18\x05 \x01(\bR\brequired\x12\x18\n" +
+	"\aoptions\x18\x06 \x03(\tR\aoptions\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xf8\x01\n" +
+	"\x1cCreateRoomCustomFieldRequest\x12/\n" +
+	"\x03key\x18\x01 \x01(\tB\x1d\xbaH\x1ar\x182\x16^[a-z][a-z0-9_]{0,29}$R\x03key\x12\x1f\n" +
+	"\x05label\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\x05label\x12F\n" +
+	"\x04type\x18\x03 \x01(\x0e2&.keyhub.console.v1.RoomCustomFieldTypeB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x04type\x12\x1a\n" +
+	"\brequired\x18\x04 \x01(\bR\brequired\x12\"\n" +
+	"\aoptions\x18\x05 \x03(\tB\b\xbaH\x05\x92\x01\x02\x18\x01R\aoptions\"Y\n" +
+	"\x1dCreateRoomCustomFieldResponse\x128\n" +
+	"\x05field\x18\x01 \x01(\v2\".keyhub.console.v1.RoomCustomFieldR\x05field\"\x1d\n" +
+	"\x1bListRoomCustomFieldsRequest\"Z\n" +
+	"\x1cListRoomCustomFieldsResponse\x12:\n" +
+	"\x06fields\x18\x01 \x03(\v2\".keyhub.console.v1.RoomCustomFieldR\x06fields\"8\n" +
+	"\x1cDeleteRoomCustomFieldRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x1f\n" +
+	"\x1dDeleteRoomCustomFieldResponse\"\xdc\x01\n" +
+	"\x12RoomTypeDefinition\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x12\x19\n" +
+	"\bbuilt_in\x18\x04 \x01(\bR\abuiltIn\x128\n" +
+	"\troom_type\x18\x05 \x01(\x0e2\x1b.keyhub.console.v1.RoomTypeR\broomType\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x16\n" +
+	"\x14ListRoomTypesRequest\"]\n" +
+	"\x15ListRoomTypesResponse\x12D\n" +
+	"\n" +
+	"room_types\x18\x01 \x03(\v2%.keyhub.console.v1.RoomTypeDefinitionR\troomTypes\"i\n" +
+	"\x15CreateRoomTypeRequest\x12/\n" +
+	"\x03key\x18\x01 \x01(\tB\x1d\xbaH\x1ar\x182\x16^[a-z][a-z0-9_]{0,29}$R\x03key\x12\x1f\n" +
+	"\x05label\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18\x1eR\x05label\"\\\n" +
+	"\x16CreateRoomTypeResponse\x12B\n" +
+	"\troom_type\x18\x01 \x01(\v2%.keyhub.console.v1.RoomTypeDefinitionR\broomType\"1\n" +
+	"\x15DeleteRoomTypeRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x18\n" +
+	"\x16DeleteRoomTypeResponse*\xc8\x01\n" +
+	"\x13RoomCustomFieldType\x12&\n" +
+	"\"ROOM_CUSTOM_FIELD_TYPE_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bROOM_CUSTOM_FIELD_TYPE_TEXT\x10\x01\x12!\n" +
+	"\x1dROOM_CUSTOM_FIELD_TYPE_NUMBER\x10\x02\x12\"\n" +
+	"\x1eROOM_CUSTOM_FIELD_TYPE_BOOLEAN\x10\x03\x12!\n" +
+	"\x1dROOM_CUSTOM_FIELD_TYPE_SELECT\x10\x042\xe6\b\n" +
 	"\x12ConsoleRoomService\x12Y\n" +
 	"\n" +
 	"CreateRoom\x12$.keyhub.console.v1.CreateRoomRequest\x1a%.keyhub.console.v1.CreateRoomResponse\x12\\\n" +
 	"\vGetAllRooms\x12%.keyhub.console.v1.GetAllRoomsRequest\x1a&.keyhub.console.v1.GetAllRoomsResponse\x12q\n" +
-	"\x12AssignRoomToTenant\x12,.keyhub.console.v1.AssignRoomToTenantRequest\x1a-.keyhub.console.v1.AssignRoomToTenantResponseB\xdd\x01\n" +
+	"\x12AssignRoomToTenant\x12,.keyhub.console.v1.AssignRoomToTenantRequest\x1a-.keyhub.console.v1.AssignRoomToTenantResponse\x12w\n" +
+	"\x14UpdateRoomAttributes\x12..keyhub.console.v1.UpdateRoomAttributesRequest\x1a/.keyhub.console.v1.UpdateRoomAttributesResponse\x12z\n" +
+	"\x15CreateRoomCustomField\x12/.keyhub.console.v1.CreateRoomCustomFieldRequest\x1a0.keyhub.console.v1.CreateRoomCustomFieldResponse\x12|\n" +
+	"\x14ListRoomCustomFields\x12..keyhub.console.v1.ListRoomCustomFieldsRequest\x1a/.keyhub.console.v1.ListRoomCustomFieldsResponse\"\x03\x90\x02\x01\x12z\n" +
+	"\x15DeleteRoomCustomField\x12/.keyhub.console.v1.DeleteRoomCustomFieldRequest\x1a0.keyhub.console.v1.DeleteRoomCustomFieldResponse\x12g\n" +
+	"\rListRoomTypes\x12'.keyhub.console.v1.ListRoomTypesRequest\x1a(.keyhub.console.v1.ListRoomTypesResponse\"\x03\x90\x02\x01\x12e\n" +
+	"\x0eCreateRoomType\x12(.keyhub.console.v1.CreateRoomTypeRequest\x1a).keyhub.console.v1.CreateRoomTypeResponse\x12e\n" +
+	"\x0eDeleteRoomType\x12(.keyhub.console.v1.DeleteRoomTypeRequest\x1a).keyhub.console.v1.DeleteRoomTypeResponseB\xdd\x01\n" +
 	"\x15com.keyhub.console.v1B\tRoomProtoP\x01ZSgithub.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1;consolev1\xa2\x02\x03KCX\xaa\x02\x11Keyhub.Console.V1\xca\x02\x11Keyhub\\Console\\V1\xe2\x02\x1dKeyhub\\Console\\V1\\GPBMetadata\xea\x02\x13Keyhub::Console::V1b\x06proto3"
 
 var (
@@ -370,33 +1618,94 @@ func file_keyhub_console_v1_room_proto_rawDescGZIP() []byte {
 	return file_keyhub_console_v1_room_proto_rawDescData
 }
 
-var file_keyhub_console_v1_room_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_keyhub_console_v1_room_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_keyhub_console_v1_room_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_keyhub_console_v1_room_proto_goTypes = []any{
-	(*CreateRoomRequest)(nil),          // 0: keyhub.console.v1.CreateRoomRequest
-	(*CreateRoomResponse)(nil),         // 1: keyhub.console.v1.CreateRoomResponse
-	(*GetAllRoomsRequest)(nil),         // 2: keyhub.console.v1.GetAllRoomsRequest
-	(*GetAllRoomsResponse)(nil),        // 3: keyhub.console.v1.GetAllRoomsResponse
-	(*AssignRoomToTenantRequest)(nil),  // 4: keyhub.console.v1.AssignRoomToTenantRequest
-	(*AssignRoomToTenantResponse)(nil), // 5: keyhub.console.v1.AssignRoomToTenantResponse
-	(RoomType)(0),                      // 6: keyhub.console.v1.RoomType
-	(*Room)(nil),                       // 7: keyhub.console.v1.Room
-	(*timestamppb.Timestamp)(nil),      // 8: google.protobuf.Timestamp
+	(RoomCustomFieldType)(0),              // 0: keyhub.console.v1.RoomCustomFieldType
+	(*CreateRoomRequest)(nil),             // 1: keyhub.console.v1.CreateRoomRequest
+	(*CreateRoomResponse)(nil),            // 2: keyhub.console.v1.CreateRoomResponse
+	(*GetAllRoomsRequest)(nil),            // 3: keyhub.console.v1.GetAllRoomsRequest
+	(*GetAllRoomsResponse)(nil),           // 4: keyhub.console.v1.GetAllRoomsResponse
+	(*BuildingRooms)(nil),                 // 5: keyhub.console.v1.BuildingRooms
+	(*FloorRooms)(nil),                    // 6: keyhub.console.v1.FloorRooms
+	(*AssignRoomToTenantRequest)(nil),     // 7: keyhub.console.v1.AssignRoomToTenantRequest
+	(*AssignRoomToTenantResponse)(nil),    // 8: keyhub.console.v1.AssignRoomToTenantResponse
+	(*UpdateRoomAttributesRequest)(nil),   // 9: keyhub.console.v1.UpdateRoomAttributesRequest
+	(*UpdateRoomAttributesResponse)(nil),  // 10: keyhub.console.v1.UpdateRoomAttributesResponse
+	(*RoomCustomField)(nil),               // 11: keyhub.console.v1.RoomCustomField
+	(*CreateRoomCustomFieldRequest)(nil),  // 12: keyhub.console.v1.CreateRoomCustomFieldRequest
+	(*CreateRoomCustomFieldResponse)(nil), // 13: keyhub.console.v1.CreateRoomCustomFieldResponse
+	(*ListRoomCustomFieldsRequest)(nil),   // 14: keyhub.console.v1.ListRoomCustomFieldsRequest
+	(*ListRoomCustomFieldsResponse)(nil),  // 15: keyhub.console.v1.ListRoomCustomFieldsResponse
+	(*DeleteRoomCustomFieldRequest)(nil),  // 16: keyhub.console.v1.DeleteRoomCustomFieldRequest
+	(*DeleteRoomCustomFieldResponse)(nil), // 17: keyhub.console.v1.DeleteRoomCustomFieldResponse
+	(*RoomTypeDefinition)(nil),            // 18: keyhub.console.v1.RoomTypeDefinition
+	(*ListRoomTypesRequest)(nil),          // 19: keyhub.console.v1.ListRoomTypesRequest
+	(*ListRoomTypesResponse)(nil),         // 20: keyhub.console.v1.ListRoomTypesResponse
+	(*CreateRoomTypeRequest)(nil),         // 21: keyhub.console.v1.CreateRoomTypeRequest
+	(*CreateRoomTypeResponse)(nil),        // 22: keyhub.console.v1.CreateRoomTypeResponse
+	(*DeleteRoomTypeRequest)(nil),         // 23: keyhub.console.v1.DeleteRoomTypeRequest
+	(*DeleteRoomTypeResponse)(nil),        // 24: keyhub.console.v1.DeleteRoomTypeResponse
+	nil,                                   // 25: keyhub.console.v1.GetAllRoomsRequest.CustomFieldsEntry
+	(RoomType)(0),                         // 26: keyhub.console.v1.RoomType
+	(*RoomAttributes)(nil),                // 27: keyhub.console.v1.RoomAttributes
+	(ListOrder)(0),                        // 28: keyhub.console.v1.ListOrder
+	(RoomAccessibility)(0),                // 29: keyhub.console.v1.RoomAccessibility
+	(*Room)(nil),                          // 30: keyhub.console.v1.Room
+	(*Building)(nil),                      // 31: keyhub.console.v1.Building
+	(*Floor)(nil),                         // 32: keyhub.console.v1.Floor
+	(*timestamppb.Timestamp)(nil),         // 33: google.protobuf.Timestamp
 }
 var file_keyhub_console_v1_room_proto_depIdxs = []int32{
-	6, // 0: keyhub.console.v1.CreateRoomRequest.room_type:type_name -> keyhub.console.v1.RoomType
-	7, // 1: keyhub.console.v1.GetAllRoomsResponse.rooms:type_name -> keyhub.console.v1.Room
-	8, // 2: keyhub.console.v1.AssignRoomToTenantRequest.expires_at:type_name -> google.protobuf.Timestamp
-	0, // 3: keyhub.console.v1.ConsoleRoomService.CreateRoom:input_type -> keyhub.console.v1.CreateRoomRequest
-	2, // 4: keyhub.console.v1.ConsoleRoomService.GetAllRooms:input_type -> keyhub.console.v1.GetAllRoomsRequest
-	4, // 5: keyhub.console.v1.ConsoleRoomService.AssignRoomToTenant:input_type -> keyhub.console.v1.AssignRoomToTenantRequest
-	1, // 6: keyhub.console.v1.ConsoleRoomService.CreateRoom:output_type -> keyhub.console.v1.CreateRoomResponse
-	3, // 7: keyhub.console.v1.ConsoleRoomService.GetAllRooms:output_type -> keyhub.console.v1.GetAllRoomsResponse
-	5, // 8: keyhub.console.v1.ConsoleRoomService.AssignRoomToTenant:output_type -> keyhub.console.v1.AssignRoomToTenantResponse
-	6, // [6:9] is the sub-list for method output_type
-	3, // [3:6] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	26, // 0: keyhub.console.v1.CreateRoomRequest.room_type:type_name -> keyhub.console.v1.RoomType
+	27, // 1: keyhub.console.v1.CreateRoomRequest.attributes:type_name -> keyhub.console.v1.RoomAttributes
+	28, // 2: keyhub.console.v1.GetAllRoomsRequest.order:type_name -> keyhub.console.v1.ListOrder
+	26, // 3: keyhub.console.v1.GetAllRoomsRequest.room_type:type_name -> keyhub.console.v1.RoomType
+	29, // 4: keyhub.console.v1.GetAllRoomsRequest.accessibility:type_name -> keyhub.console.v1.RoomAccessibility
+	25, // 5: keyhub.console.v1.GetAllRoomsRequest.custom_fields:type_name -> keyhub.console.v1.GetAllRoomsRequest.CustomFieldsEntry
+	30, // 6: keyhub.console.v1.GetAllRoomsResponse.rooms:type_name -> keyhub.console.v1.Room
+	5,  // 7: keyhub.console.v1.GetAllRoomsResponse.buildings:type_name -> keyhub.console.v1.BuildingRooms
+	31, // 8: keyhub.console.v1.BuildingRooms.building:type_name -> keyhub.console.v1.Building
+	6,  // 9: keyhub.console.v1.BuildingRooms.floors:type_name -> keyhub.console.v1.FloorRooms
+	32, // 10: keyhub.console.v1.FloorRooms.floor:type_name -> keyhub.console.v1.Floor
+	30, // 11: keyhub.console.v1.FloorRooms.rooms:type_name -> keyhub.console.v1.Room
+	33, // 12: keyhub.console.v1.AssignRoomToTenantRequest.expires_at:type_name -> google.protobuf.Timestamp
+	27, // 13: keyhub.console.v1.UpdateRoomAttributesRequest.attributes:type_name -> keyhub.console.v1.RoomAttributes
+	30, // 14: keyhub.console.v1.UpdateRoomAttributesResponse.room:type_name -> keyhub.console.v1.Room
+	0,  // 15: keyhub.console.v1.RoomCustomField.type:type_name -> keyhub.console.v1.RoomCustomFieldType
+	33, // 16: keyhub.console.v1.RoomCustomField.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 17: keyhub.console.v1.CreateRoomCustomFieldRequest.type:type_name -> keyhub.console.v1.RoomCustomFieldType
+	11, // 18: keyhub.console.v1.CreateRoomCustomFieldResponse.field:type_name -> keyhub.console.v1.RoomCustomField
+	11, // 19: keyhub.console.v1.ListRoomCustomFieldsResponse.fields:type_name -> keyhub.console.v1.RoomCustomField
+	26, // 20: keyhub.console.v1.RoomTypeDefinition.room_type:type_name -> keyhub.console.v1.RoomType
+	33, // 21: keyhub.console.v1.RoomTypeDefinition.created_at:type_name -> google.protobuf.Timestamp
+	18, // 22: keyhub.console.v1.ListRoomTypesResponse.room_types:type_name -> keyhub.console.v1.RoomTypeDefinition
+	18, // 23: keyhub.console.v1.CreateRoomTypeResponse.room_type:type_name -> keyhub.console.v1.RoomTypeDefinition
+	1,  // 24: keyhub.console.v1.ConsoleRoomService.CreateRoom:input_type -> keyhub.console.v1.CreateRoomRequest
+	3,  // 25: keyhub.console.v1.ConsoleRoomService.GetAllRooms:input_type -> keyhub.console.v1.GetAllRoomsRequest
+	7,  // 26: keyhub.console.v1.ConsoleRoomService.AssignRoomToTenant:input_type -> keyhub.console.v1.AssignRoomToTenantRequest
+	9,  // 27: keyhub.console.v1.ConsoleRoomService.UpdateRoomAttributes:input_type -> keyhub.console.v1.UpdateRoomAttributesRequest
+	12, // 28: keyhub.console.v1.ConsoleRoomService.CreateRoomCustomField:input_type -> keyhub.console.v1.CreateRoomCustomFieldRequest
+	14, // 29: keyhub.console.v1.ConsoleRoomService.ListRoomCustomFields:input_type -> keyhub.console.v1.ListRoomCustomFieldsRequest
+	16, // 30: keyhub.console.v1.ConsoleRoomService.DeleteRoomCustomField:input_type -> keyhub.console.v1.DeleteRoomCustomFieldRequest
+	19, // 31: keyhub.console.v1.ConsoleRoomService.ListRoomTypes:input_type -> keyhub.console.v1.ListRoomTypesRequest
+	21, // 32: keyhub.console.v1.ConsoleRoomService.CreateRoomType:input_type -> keyhub.console.v1.CreateRoomTypeRequest
+	23, // 33: keyhub.console.v1.ConsoleRoomService.DeleteRoomType:input_type -> keyhub.console.v1.DeleteRoomTypeRequest
+	2,  // 34: keyhub.console.v1.ConsoleRoomService.CreateRoom:output_type -> keyhub.console.v1.CreateRoomResponse
+	4,  // 35: keyhub.console.v1.ConsoleRoomService.GetAllRooms:output_type -> keyhub.console.v1.GetAllRoomsResponse
+	8,  // 36: keyhub.console.v1.ConsoleRoomService.AssignRoomToTenant:output_type -> keyhub.console.v1.AssignRoomToTenantResponse
+	10, // 37: keyhub.console.v1.ConsoleRoomService.UpdateRoomAttributes:output_type -> keyhub.console.v1.UpdateRoomAttributesResponse
+	13, // 38: keyhub.console.v1.ConsoleRoomService.CreateRoomCustomField:output_type -> keyhub.console.v1.CreateRoomCustomFieldResponse
+	15, // 39: keyhub.console.v1.ConsoleRoomService.ListRoomCustomFields:output_type -> keyhub.console.v1.ListRoomCustomFieldsResponse
+	17, // 40: keyhub.console.v1.ConsoleRoomService.DeleteRoomCustomField:output_type -> keyhub.console.v1.DeleteRoomCustomFieldResponse
+	20, // 41: keyhub.console.v1.ConsoleRoomService.ListRoomTypes:output_type -> keyhub.console.v1.ListRoomTypesResponse
+	22, // 42: keyhub.console.v1.ConsoleRoomService.CreateRoomType:output_type -> keyhub.console.v1.CreateRoomTypeResponse
+	24, // 43: keyhub.console.v1.ConsoleRoomService.DeleteRoomType:output_type -> keyhub.console.v1.DeleteRoomTypeResponse
+	34, // [34:44] is the sub-list for method output_type
+	24, // [24:34] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_keyhub_console_v1_room_proto_init() }
@@ -404,20 +1713,23 @@ func file_keyhub_console_v1_room_proto_init() {
 	if File_keyhub_console_v1_room_proto != nil {
 		return
 	}
+	file_keyhub_console_v1_building_proto_init()
 	file_keyhub_console_v1_common_proto_init()
-	file_keyhub_console_v1_room_proto_msgTypes[4].OneofWrappers = []any{}
+	file_keyhub_console_v1_room_proto_msgTypes[2].OneofWrappers = []any{}
+	file_keyhub_console_v1_room_proto_msgTypes[6].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_keyhub_console_v1_room_proto_rawDesc), len(file_keyhub_console_v1_room_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      1,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_keyhub_console_v1_room_proto_goTypes,
 		DependencyIndexes: file_keyhub_console_v1_room_proto_depIdxs,
+		EnumInfos:         file_keyhub_console_v1_room_proto_enumTypes,
 		MessageInfos:      file_keyhub_console_v1_room_proto_msgTypes,
 	}.Build()
 	File_keyhub_console_v1_room_proto = out.File
@@ -0,0 +1,355 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: keyhub/console/v1/search.proto
+
+package consolev1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SearchResultType int32
+
+const (
+	SearchResultType_SEARCH_RESULT_TYPE_UNSPECIFIED SearchResultType = 0
+	SearchResultType_SEARCH_RESULT_TYPE_ROOM        SearchResultType = 1 // 部屋名・建物名・説明
+	SearchResultType_SEARCH_RESULT_TYPE_KEY         SearchResultType = 2 // 鍵番号
+	SearchResultType_SEARCH_RESULT_TYPE_TENANT      SearchResultType = 3 // テナント名・説明
+	SearchResultType_SEARCH_RESULT_TYPE_MEMBER      SearchResultType = 4 // 組織のテナントに参加中のユーザーのメールアドレス
+)
+
+// Enum value maps for SearchResultType.
+var (
+	SearchResultType_name = map[int32]string{
+		0: "SEARCH_RESULT_TYPE_UNSPECIFIED",
+		1: "SEARCH_RESULT_TYPE_ROOM",
+		2: "SEARCH_RESULT_TYPE_KEY",
+		3: "SEARCH_RESULT_TYPE_TENANT",
+		4: "SEARCH_RESULT_TYPE_MEMBER",
+	}
+	SearchResultType_value = map[string]int32{
+		"SEARCH_RESULT_TYPE_UNSPECIFIED": 0,
+		"SEARCH_RESULT_TYPE_ROOM":        1,
+		"SEARCH_RESULT_TYPE_KEY":         2,
+		"SEARCH_RESULT_TYPE_TENANT":      3,
+		"SEARCH_RESULT_TYPE_MEMBER":      4,
+	}
+)
+
+func (x SearchResultType) Enum() *SearchResultType {
+	p := new(SearchResultType)
+	*p = x
+	return p
+}
+
+func (x SearchResultType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchResultType) Descriptor() protoreflect.EnumDescriptor {
+	return file_keyhub_console_v1_search_proto_enumTypes[0].Descriptor()
+}
+
+func (SearchResultType) Type() protoreflect.EnumType {
+	return &file_keyhub_console_v1_search_proto_enumTypes[0]
+}
+
+func (x SearchResultType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchResultType.Descriptor instead.
+func (SearchResultType) EnumDescriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_search_proto_rawDescGZIP(), []int{0}
+}
+
+type SearchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Query string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// 指定した種類だけを探す。省略時はすべて
+	Types         []SearchResultType `protobuf:"varint,2,rep,packed,name=types,proto3,enum=keyhub.console.v1.SearchResultType" json:"types,omitempty"`
+	Limit         int32              `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"` // 省略時20件
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	mi := &file_keyhub_console_v1_search_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_search_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_search_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetTypes() []SearchResultType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *SearchRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  SearchResultType       `protobuf:"varint,1,opt,name=type,proto3,enum=keyhub.console.v1.SearchResultType" json:"type,omitempty"`
+	Id    string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// 部屋: 部屋名 / 建物名と階、鍵: 鍵番号 / 部屋名、テナント: テナント名 / 説明、メンバー: メールアドレス / 名前
+	Title    string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Subtitle string `protobuf:"bytes,4,opt,name=subtitle,proto3" json:"subtitle,omitempty"`
+	// 鍵の結果でだけ部屋のIDを入れる
+	ParentId      string  `protobuf:"bytes,5,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Score         float32 `protobuf:"fixed32,6,opt,name=score,proto3" json:"score,omitempty"` // 関連度。大きいほど検索語に近い
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	mi := &file_keyhub_console_v1_search_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_search_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_search_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SearchResult) GetType() SearchResultType {
+	if x != nil {
+		return x.Type
+	}
+	return SearchResultType_SEARCH_RESULT_TYPE_UNSPECIFIED
+}
+
+func (x *SearchResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SearchResult) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SearchResult) GetSubtitle() string {
+	if x != nil {
+		return x.Subtitle
+	}
+	return ""
+}
+
+func (x *SearchResult) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *SearchResult) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type SearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*SearchResult        `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
+	mi := &file_keyhub_console_v1_search_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse) ProtoMessage() {}
+
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_search_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_search_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SearchResponse) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+var File_keyhub_console_v1_search_proto protoreflect.FileDescriptor
+
+const file_keyhub_console_v1_search_proto_rawDesc = "" +
+	"\n" +
+	"\x1ekeyhub/console/v1/search.proto\x12\x11keyhub.console.v1\x1a\x1bbuf/validate/validate.proto\"\x8c\x01\n" +
+	"\rSearchRequest\x12\x1f\n" +
+	"\x05query\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\x05query\x129\n" +
+	"\x05types\x18\x02 \x03(\x0e2#.keyhub.console.v1.SearchResultTypeR\x05types\x12\x1f\n" +
+	"\x05limit\x18\x03 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00R\x05limit\"\xc6\x01\n" +
+	"\fSearchResult\x127\n" +
+	"\x04type\x18\x01 \x01(\x0e2#.keyhub.console.v1.SearchResultTypeR\x04type\x12\x18\n" +
+	"\x02id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x1a\n" +
+	"\bsubtitle\x18\x04 \x01(\tR\bsubtitle\x12\x1b\n" +
+	"\tparent_id\x18\x05 \x01(\tR\bparentId\x12\x14\n" +
+	"\x05score\x18\x06 \x01(\x02R\x05score\"K\n" +
+	"\x0eSearchResponse\x129\n" +
+	"\aresults\x18\x01 \x03(\v2\x1f.keyhub.console.v1.SearchResultR\aresults*\xad\x01\n" +
+	"\x10SearchResultType\x12\"\n" +
+	"\x1eSEARCH_RESULT_TYPE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17SEARCH_RESULT_TYPE_ROOM\x10\x01\x12\x1a\n" +
+	"\x16SEARCH_RESULT_TYPE_KEY\x10\x02\x12\x1d\n" +
+	"\x19SEARCH_RESULT_TYPE_TENANT\x10\x03\x12\x1d\n" +
+	"\x19SEARCH_RESULT_TYPE_MEMBER\x10\x042j\n" +
+	"\x14ConsoleSearchService\x12R\n" +
+	"\x06Search\x12 .keyhub.console.v1.SearchRequest\x1a!.keyhub.console.v1.SearchResponse\"\x03\x90\x02\x01B\xdf\x01\n" +
+	"\x15com.keyhub.console.v1B\vSearchProtoP\x01ZSgithub.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1;consolev1\xa2\x02\x03KCX\xaa\x02\x11Keyhub.Console.V1\xca\x02\x11Keyhub\\Console\\V1\xe2\x02\x1dKeyhub\\Console\\V1\\GPBMetadata\xea\x02\x13Keyhub::Console::V1b\x06proto3"
+
+var (
+	file_keyhub_console_v1_search_proto_rawDescOnce sync.Once
+	file_keyhub_console_v1_search_proto_rawDescData []byte
+)
+
+func file_keyhub_console_v1_search_proto_rawDescGZIP() []byte {
+	file_keyhub_console_v1_search_proto_rawDescOnce.Do(func() {
+		file_keyhub_console_v1_search_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_keyhub_console_v1_search_proto_rawDesc), len(file_keyhub_console_v1_search_proto_rawDesc)))
+	})
+	return file_keyhub_console_v1_search_proto_rawDescData
+}
+
+var file_keyhub_console_v1_search_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_keyhub_console_v1_search_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_keyhub_console_v1_search_proto_goTypes = []any{
+	(SearchResultType)(0),  // 0: keyhub.console.v1.SearchResultType
+	(*SearchRequest)(nil),  // 1: keyhub.console.v1.SearchRequest
+	(*SearchResult)(nil),   // 2: keyhub.console.v1.SearchResult
+	(*SearchResponse)(nil), // 3: keyhub.console.v1.SearchResponse
+}
+var file_keyhub_console_v1_search_proto_depIdxs = []int32{
+	0, // 0: keyhub.console.v1.SearchRequest.types:type_name -> keyhub.console.v1.SearchResultType
+	0, // 1: keyhub.console.v1.SearchResult.type:type_name -> keyhub.console.v1.SearchResultType
+	2, // 2: keyhub.console.v1.SearchResponse.results:type_name -> keyhub.console.v1.SearchResult
+	1, // 3: keyhub.console.v1.ConsoleSearchService.Search:input_type -> keyhub.console.v1.SearchRequest
+	3, // 4: keyhub.console.v1.ConsoleSearchService.Search:output_type -> keyhub.console.v1.SearchResponse
+	4, // [4:5] is the sub-list for method output_type
+	3, // [3:4] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_keyhub_console_v1_search_proto_init() }
+func file_keyhub_console_v1_search_proto_init() {
+	if File_keyhub_console_v1_search_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_keyhub_console_v1_search_proto_rawDesc), len(file_keyhub_console_v1_search_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_keyhub_console_v1_search_proto_goTypes,
+		DependencyIndexes: file_keyhub_console_v1_search_proto_depIdxs,
+		EnumInfos:         file_keyhub_console_v1_search_proto_enumTypes,
+		MessageInfos:      file_keyhub_console_v1_search_proto_msgTypes,
+	}.Build()
+	File_keyhub_console_v1_search_proto = out.File
+	file_keyhub_console_v1_search_proto_goTypes = nil
+	file_keyhub_console_v1_search_proto_depIdxs = nil
+}
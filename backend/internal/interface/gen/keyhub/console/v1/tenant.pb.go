@@ -31,6 +31,7 @@ type CreateTenantRequest struct {
 	JoinCode       string                 `protobuf:"bytes,4,opt,name=join_code,json=joinCode,proto3" json:"join_code,omitempty"`
 	JoinCodeExpiry *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=join_code_expiry,json=joinCodeExpiry,proto3" json:"join_code_expiry,omitempty"`
 	JoinCodeMaxUse int32                  `protobuf:"varint,6,opt,name=join_code_max_use,json=joinCodeMaxUse,proto3" json:"join_code_max_use,omitempty"`
+	TenantTypeKey  string                 `protobuf:"bytes,7,opt,name=tenant_type_key,json=tenantTypeKey,proto3" json:"tenant_type_key,omitempty"` // 組織が追加したテナントタイプのキー。指定した場合は tenant_type より優先する
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -107,6 +108,13 @@ func (x *CreateTenantRequest) GetJoinCodeMaxUse() int32 {
 	return 0
 }
 
+func (x *CreateTenantRequest) GetTenantTypeKey() string {
+	if x != nil {
+		return x.TenantTypeKey
+	}
+	return ""
+}
+
 type CreateTenantResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -152,7 +160,14 @@ func (x *CreateTenantResponse) GetId() string {
 }
 
 type GetAllTenantsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PageSize  int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // 省略時50件
+	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // 前回のレスポンスの next_page_token。条件と並び順は前回と同じにする
+	Order     ListOrder              `protobuf:"varint,3,opt,name=order,proto3,enum=keyhub.console.v1.ListOrder" json:"order,omitempty"`
+	// 以下の条件は指定したものだけで絞り込む
+	TenantType    TenantType `protobuf:"varint,4,opt,name=tenant_type,json=tenantType,proto3,enum=keyhub.console.v1.TenantType" json:"tenant_type,omitempty"`
+	NamePrefix    string     `protobuf:"bytes,5,opt,name=name_prefix,json=namePrefix,proto3" json:"name_prefix,omitempty"`            // テナント名の前方一致
+	TenantTypeKey string     `protobuf:"bytes,6,opt,name=tenant_type_key,json=tenantTypeKey,proto3" json:"tenant_type_key,omitempty"` // テナントタイプのキー。指定した場合は tenant_type より優先する
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -187,9 +202,52 @@ func (*GetAllTenantsRequest) Descriptor() ([]byte, []int) {
 	return file_keyhub_console_v1_tenant_proto_rawDescGZIP(), []int{2}
 }
 
+func (x *GetAllTenantsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetAllTenantsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetAllTenantsRequest) GetOrder() ListOrder {
+	if x != nil {
+		return x.Order
+	}
+	return ListOrder_LIST_ORDER_UNSPECIFIED
+}
+
+func (x *GetAllTenantsRequest) GetTenantType() TenantType {
+	if x != nil {
+		return x.TenantType
+	}
+	return TenantType_TENANT_TYPE_UNSPECIFIED
+}
+
+func (x *GetAllTenantsRequest) GetNamePrefix() string {
+	if x != nil {
+		return x.NamePrefix
+	}
+	return ""
+}
+
+func (x *GetAllTenantsRequest) GetTenantTypeKey() string {
+	if x != nil {
+		return x.TenantTypeKey
+	}
+	return ""
+}
+
 type GetAllTenantsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Tenants       []*Tenant              `protobuf:"bytes,1,rep,name=tenants,proto3" json:"tenants,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // 続きがない場合は空
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -231,6 +289,13 @@ func (x *GetAllTenantsResponse) GetTenants() []*Tenant {
 	return nil
 }
 
+func (x *GetAllTenantsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type GetTenantByIdRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -352,6 +417,8 @@ type UpdateTenantRequest struct {
 	JoinCode       string                 `protobuf:"bytes,5,opt,name=join_code,json=joinCode,proto3" json:"join_code,omitempty"`
 	JoinCodeExpiry *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=join_code_expiry,json=joinCodeExpiry,proto3" json:"join_code_expiry,omitempty"`
 	JoinCodeMaxUse int32                  `protobuf:"varint,7,opt,name=join_code_max_use,json=joinCodeMaxUse,proto3" json:"join_code_max_use,omitempty"`
+	TenantTypeKey  string                 `protobuf:"bytes,8,opt,name=tenant_type_key,json=tenantTypeKey,proto3" json:"tenant_type_key,omitempty"` // 組織が追加したテナントタイプのキー。指定した場合は tenant_type より優先する
+	Version        int64                  `protobuf:"varint,9,opt,name=version,proto3" json:"version,omitempty"`                                   // 取得時の Tenant.version。他の更新で版数が変わっていれば更新せず ABORTED を返す
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -435,8 +502,23 @@ func (x *UpdateTenantRequest) GetJoinCodeMaxUse() int32 {
 	return 0
 }
 
+func (x *UpdateTenantRequest) GetTenantTypeKey() string {
+	if x != nil {
+		return x.TenantTypeKey
+	}
+	return ""
+}
+
+func (x *UpdateTenantRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type UpdateTenantResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       int64                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"` // 更新後の Tenant.version
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -471,11 +553,526 @@ func (*UpdateTenantResponse) Descriptor() ([]byte, []int) {
 	return file_keyhub_console_v1_tenant_proto_rawDescGZIP(), []int{7}
 }
 
+func (x *UpdateTenantResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type ArchiveTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveTenantRequest) Reset() {
+	*x = ArchiveTenantRequest{}
+	mi := &file_keyhub_console_v1_tenant_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveTenantRequest) ProtoMessage() {}
+
+func (x *ArchiveTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_tenant_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveTenantRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveTenantRequest) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_tenant_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ArchiveTenantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ArchiveTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenant        *Tenant                `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveTenantResponse) Reset() {
+	*x = ArchiveTenantResponse{}
+	mi := &file_keyhub_console_v1_tenant_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveTenantResponse) ProtoMessage() {}
+
+func (x *ArchiveTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_tenant_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveTenantResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveTenantResponse) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_tenant_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ArchiveTenantResponse) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+type DeleteTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTenantRequest) Reset() {
+	*x = DeleteTenantRequest{}
+	mi := &file_keyhub_console_v1_tenant_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTenantRequest) ProtoMessage() {}
+
+func (x *DeleteTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_tenant_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTenantRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTenantRequest) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_tenant_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteTenantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTenantResponse) Reset() {
+	*x = DeleteTenantResponse{}
+	mi := &file_keyhub_console_v1_tenant_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTenantResponse) ProtoMessage() {}
+
+func (x *DeleteTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_tenant_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTenantResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTenantResponse) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_tenant_proto_rawDescGZIP(), []int{11}
+}
+
+type TenantTypeDefinition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // 既定のタイプは空
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Label         string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	BuiltIn       bool                   `protobuf:"varint,4,opt,name=built_in,json=builtIn,proto3" json:"built_in,omitempty"`                                            // 全組織で使える既定のタイプ。削除できない
+	TenantType    TenantType             `protobuf:"varint,5,opt,name=tenant_type,json=tenantType,proto3,enum=keyhub.console.v1.TenantType" json:"tenant_type,omitempty"` // 既定のタイプの列挙値。組織が追加したタイプは UNSPECIFIED
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantTypeDefinition) Reset() {
+	*x = TenantTypeDefinition{}
+	mi := &file_keyhub_console_v1_tenant_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantTypeDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantTypeDefinition) ProtoMessage() {}
+
+func (x *TenantTypeDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_tenant_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantTypeDefinition.ProtoReflect.Descriptor instead.
+func (*TenantTypeDefinition) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_tenant_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TenantTypeDefinition) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TenantTypeDefinition) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TenantTypeDefinition) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *TenantTypeDefinition) GetBuiltIn() bool {
+	if x != nil {
+		return x.BuiltIn
+	}
+	return false
+}
+
+func (x *TenantTypeDefinition) GetTenantType() TenantType {
+	if x != nil {
+		return x.TenantType
+	}
+	return TenantType_TENANT_TYPE_UNSPECIFIED
+}
+
+func (x *TenantTypeDefinition) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListTenantTypesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTenantTypesRequest) Reset() {
+	*x = ListTenantTypesRequest{}
+	mi := &file_keyhub_console_v1_tenant_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTenantTypesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantTypesRequest) ProtoMessage() {}
+
+func (x *ListTenantTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_tenant_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantTypesRequest.ProtoReflect.Descriptor instead.
+func (*ListTenantTypesRequest) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_tenant_proto_rawDescGZIP(), []int{13}
+}
+
+type ListTenantTypesResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	TenantTypes   []*TenantTypeDefinition `protobuf:"bytes,1,rep,name=tenant_types,json=tenantTypes,proto3" json:"tenant_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTenantTypesResponse) Reset() {
+	*x = ListTenantTypesResponse{}
+	mi := &file_keyhub_console_v1_tenant_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTenantTypesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantTypesResponse) ProtoMessage() {}
+
+func (x *ListTenantTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_tenant_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantTypesResponse.ProtoReflect.Descriptor instead.
+func (*ListTenantTypesResponse) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_tenant_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListTenantTypesResponse) GetTenantTypes() []*TenantTypeDefinition {
+	if x != nil {
+		return x.TenantTypes
+	}
+	return nil
+}
+
+type CreateTenantTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTenantTypeRequest) Reset() {
+	*x = CreateTenantTypeRequest{}
+	mi := &file_keyhub_console_v1_tenant_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTenantTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTenantTypeRequest) ProtoMessage() {}
+
+func (x *CreateTenantTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_tenant_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTenantTypeRequest.ProtoReflect.Descriptor instead.
+func (*CreateTenantTypeRequest) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_tenant_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateTenantTypeRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CreateTenantTypeRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+type CreateTenantTypeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantType    *TenantTypeDefinition  `protobuf:"bytes,1,opt,name=tenant_type,json=tenantType,proto3" json:"tenant_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTenantTypeResponse) Reset() {
+	*x = CreateTenantTypeResponse{}
+	mi := &file_keyhub_console_v1_tenant_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTenantTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTenantTypeResponse) ProtoMessage() {}
+
+func (x *CreateTenantTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_tenant_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTenantTypeResponse.ProtoReflect.Descriptor instead.
+func (*CreateTenantTypeResponse) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_tenant_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateTenantTypeResponse) GetTenantType() *TenantTypeDefinition {
+	if x != nil {
+		return x.TenantType
+	}
+	return nil
+}
+
+type DeleteTenantTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTenantTypeRequest) Reset() {
+	*x = DeleteTenantTypeRequest{}
+	mi := &file_keyhub_console_v1_tenant_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTenantTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTenantTypeRequest) ProtoMessage() {}
+
+func (x *DeleteTenantTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_tenant_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTenantTypeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTenantTypeRequest) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_tenant_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DeleteTenantTypeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteTenantTypeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTenantTypeResponse) Reset() {
+	*x = DeleteTenantTypeResponse{}
+	mi := &file_keyhub_console_v1_tenant_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTenantTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTenantTypeResponse) ProtoMessage() {}
+
+func (x *DeleteTenantTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_tenant_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTenantTypeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTenantTypeResponse) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_tenant_proto_rawDescGZIP(), []int{18}
+}
+
 var File_keyhub_console_v1_tenant_proto protoreflect.FileDescriptor
 
 const file_keyhub_console_v1_tenant_proto_rawDesc = "" +
 	"\n" +
-	"\x1ekeyhub/console/v1/tenant.proto\x12\x11keyhub.console.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1ekeyhub/console/v1/common.proto\"\x99\x02\n" +
+	"\x1ekeyhub/console/v1/tenant.proto\x12\x11keyhub.console.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1ekeyhub/console/v1/common.proto\"\xc1\x02\n" +
 	"\x13CreateTenantRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12>\n" +
@@ -483,19 +1080,31 @@ const file_keyhub_console_v1_tenant_proto_rawDesc = "" +
 	"tenantType\x12\x1b\n" +
 	"\tjoin_code\x18\x04 \x01(\tR\bjoinCode\x12D\n" +
 	"\x10join_code_expiry\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x0ejoinCodeExpiry\x12)\n" +
-	"\x11join_code_max_use\x18\x06 \x01(\x05R\x0ejoinCodeMaxUse\"0\n" +
+	"\x11join_code_max_use\x18\x06 \x01(\x05R\x0ejoinCodeMaxUse\x12&\n" +
+	"\x0ftenant_type_key\x18\a \x01(\tR\rtenantTypeKey\"0\n" +
 	"\x14CreateTenantResponse\x12\x18\n" +
-	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x16\n" +
-	"\x14GetAllTenantsRequest\"L\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x9b\x02\n" +
+	"\x14GetAllTenantsRequest\x12'\n" +
+	"\tpage_size\x18\x01 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xc8\x01(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x122\n" +
+	"\x05order\x18\x03 \x01(\x0e2\x1c.keyhub.console.v1.ListOrderR\x05order\x12>\n" +
+	"\vtenant_type\x18\x04 \x01(\x0e2\x1d.keyhub.console.v1.TenantTypeR\n" +
+	"tenantType\x12\x1f\n" +
+	"\vname_prefix\x18\x05 \x01(\tR\n" +
+	"namePrefix\x12&\n" +
+	"\x0ftenant_type_key\x18\x06 \x01(\tR\rtenantTypeKey\"t\n" +
 	"\x15GetAllTenantsResponse\x123\n" +
-	"\atenants\x18\x01 \x03(\v2\x19.keyhub.console.v1.TenantR\atenants\"0\n" +
+	"\atenants\x18\x01 \x03(\v2\x19.keyhub.console.v1.TenantR\atenants\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"0\n" +
 	"\x14GetTenantByIdRequest\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\xd8\x01\n" +
 	"\x15GetTenantByIdResponse\x121\n" +
 	"\x06tenant\x18\x01 \x01(\v2\x19.keyhub.console.v1.TenantR\x06tenant\x12\x1b\n" +
 	"\tjoin_code\x18\x02 \x01(\tR\bjoinCode\x12D\n" +
 	"\x10join_code_expiry\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0ejoinCodeExpiry\x12)\n" +
-	"\x11join_code_max_use\x18\x04 \x01(\x05R\x0ejoinCodeMaxUse\"\xb3\x02\n" +
+	"\x11join_code_max_use\x18\x04 \x01(\x05R\x0ejoinCodeMaxUse\"\x81\x03\n" +
 	"\x13UpdateTenantRequest\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -504,13 +1113,50 @@ const file_keyhub_console_v1_tenant_proto_rawDesc = "" +
 	"tenantType\x12\x1b\n" +
 	"\tjoin_code\x18\x05 \x01(\tR\bjoinCode\x12D\n" +
 	"\x10join_code_expiry\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x0ejoinCodeExpiry\x12)\n" +
-	"\x11join_code_max_use\x18\a \x01(\x05R\x0ejoinCodeMaxUse\"\x16\n" +
-	"\x14UpdateTenantResponse2\x9a\x03\n" +
+	"\x11join_code_max_use\x18\a \x01(\x05R\x0ejoinCodeMaxUse\x12&\n" +
+	"\x0ftenant_type_key\x18\b \x01(\tR\rtenantTypeKey\x12$\n" +
+	"\aversion\x18\t \x01(\x03B\n" +
+	"\xbaH\a\xc8\x01\x01\"\x02 \x00R\aversion\"0\n" +
+	"\x14UpdateTenantResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x03R\aversion\"0\n" +
+	"\x14ArchiveTenantRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"J\n" +
+	"\x15ArchiveTenantResponse\x121\n" +
+	"\x06tenant\x18\x01 \x01(\v2\x19.keyhub.console.v1.TenantR\x06tenant\"/\n" +
+	"\x13DeleteTenantRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x16\n" +
+	"\x14DeleteTenantResponse\"\xe4\x01\n" +
+	"\x14TenantTypeDefinition\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x12\x19\n" +
+	"\bbuilt_in\x18\x04 \x01(\bR\abuiltIn\x12>\n" +
+	"\vtenant_type\x18\x05 \x01(\x0e2\x1d.keyhub.console.v1.TenantTypeR\n" +
+	"tenantType\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x18\n" +
+	"\x16ListTenantTypesRequest\"e\n" +
+	"\x17ListTenantTypesResponse\x12J\n" +
+	"\ftenant_types\x18\x01 \x03(\v2'.keyhub.console.v1.TenantTypeDefinitionR\vtenantTypes\"k\n" +
+	"\x17CreateTenantTypeRequest\x12/\n" +
+	"\x03key\x18\x01 \x01(\tB\x1d\xbaH\x1ar\x182\x16^[a-z][a-z0-9_]{0,29}$R\x03key\x12\x1f\n" +
+	"\x05label\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18\x1eR\x05label\"d\n" +
+	"\x18CreateTenantTypeResponse\x12H\n" +
+	"\vtenant_type\x18\x01 \x01(\v2'.keyhub.console.v1.TenantTypeDefinitionR\n" +
+	"tenantType\"3\n" +
+	"\x17DeleteTenantTypeRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x1a\n" +
+	"\x18DeleteTenantTypeResponse2\xa8\a\n" +
 	"\x0eConsoleService\x12_\n" +
 	"\fCreateTenant\x12&.keyhub.console.v1.CreateTenantRequest\x1a'.keyhub.console.v1.CreateTenantResponse\x12b\n" +
 	"\rGetAllTenants\x12'.keyhub.console.v1.GetAllTenantsRequest\x1a(.keyhub.console.v1.GetAllTenantsResponse\x12b\n" +
 	"\rGetTenantById\x12'.keyhub.console.v1.GetTenantByIdRequest\x1a(.keyhub.console.v1.GetTenantByIdResponse\x12_\n" +
-	"\fUpdateTenant\x12&.keyhub.console.v1.UpdateTenantRequest\x1a'.keyhub.console.v1.UpdateTenantResponseB\xdf\x01\n" +
+	"\fUpdateTenant\x12&.keyhub.console.v1.UpdateTenantRequest\x1a'.keyhub.console.v1.UpdateTenantResponse\x12b\n" +
+	"\rArchiveTenant\x12'.keyhub.console.v1.ArchiveTenantRequest\x1a(.keyhub.console.v1.ArchiveTenantResponse\x12_\n" +
+	"\fDeleteTenant\x12&.keyhub.console.v1.DeleteTenantRequest\x1a'.keyhub.console.v1.DeleteTenantResponse\x12m\n" +
+	"\x0fListTenantTypes\x12).keyhub.console.v1.ListTenantTypesRequest\x1a*.keyhub.console.v1.ListTenantTypesResponse\"\x03\x90\x02\x01\x12k\n" +
+	"\x10CreateTenantType\x12*.keyhub.console.v1.CreateTenantTypeRequest\x1a+.keyhub.console.v1.CreateTenantTypeResponse\x12k\n" +
+	"\x10DeleteTenantType\x12*.keyhub.console.v1.DeleteTenantTypeRequest\x1a+.keyhub.console.v1.DeleteTenantTypeResponseB\xdf\x01\n" +
 	"\x15com.keyhub.console.v1B\vTenantProtoP\x01ZSgithub.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1;consolev1\xa2\x02\x03KCX\xaa\x02\x11Keyhub.Console.V1\xca\x02\x11Keyhub\\Console\\V1\xe2\x02\x1dKeyhub\\Console\\V1\\GPBMetadata\xea\x02\x13Keyhub::Console::V1b\x06proto3"
 
 var (
@@ -525,41 +1171,70 @@ func file_keyhub_console_v1_tenant_proto_rawDescGZIP() []byte {
 	return file_keyhub_console_v1_tenant_proto_rawDescData
 }
 
-var file_keyhub_console_v1_tenant_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_keyhub_console_v1_tenant_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_keyhub_console_v1_tenant_proto_goTypes = []any{
-	(*CreateTenantRequest)(nil),   // 0: keyhub.console.v1.CreateTenantRequest
-	(*CreateTenantResponse)(nil),  // 1: keyhub.console.v1.CreateTenantResponse
-	(*GetAllTenantsRequest)(nil),  // 2: keyhub.console.v1.GetAllTenantsRequest
-	(*GetAllTenantsResponse)(nil), // 3: keyhub.console.v1.GetAllTenantsResponse
-	(*GetTenantByIdRequest)(nil),  // 4: keyhub.console.v1.GetTenantByIdRequest
-	(*GetTenantByIdResponse)(nil), // 5: keyhub.console.v1.GetTenantByIdResponse
-	(*UpdateTenantRequest)(nil),   // 6: keyhub.console.v1.UpdateTenantRequest
-	(*UpdateTenantResponse)(nil),  // 7: keyhub.console.v1.UpdateTenantResponse
-	(TenantType)(0),               // 8: keyhub.console.v1.TenantType
-	(*timestamppb.Timestamp)(nil), // 9: google.protobuf.Timestamp
-	(*Tenant)(nil),                // 10: keyhub.console.v1.Tenant
+	(*CreateTenantRequest)(nil),      // 0: keyhub.console.v1.CreateTenantRequest
+	(*CreateTenantResponse)(nil),     // 1: keyhub.console.v1.CreateTenantResponse
+	(*GetAllTenantsRequest)(nil),     // 2: keyhub.console.v1.GetAllTenantsRequest
+	(*GetAllTenantsResponse)(nil),    // 3: keyhub.console.v1.GetAllTenantsResponse
+	(*GetTenantByIdRequest)(nil),     // 4: keyhub.console.v1.GetTenantByIdRequest
+	(*GetTenantByIdResponse)(nil),    // 5: keyhub.console.v1.GetTenantByIdResponse
+	(*UpdateTenantRequest)(nil),      // 6: keyhub.console.v1.UpdateTenantRequest
+	(*UpdateTenantResponse)(nil),     // 7: keyhub.console.v1.UpdateTenantResponse
+	(*ArchiveTenantRequest)(nil),     // 8: keyhub.console.v1.ArchiveTenantRequest
+	(*ArchiveTenantResponse)(nil),    // 9: keyhub.console.v1.ArchiveTenantResponse
+	(*DeleteTenantRequest)(nil),      // 10: keyhub.console.v1.DeleteTenantRequest
+	(*DeleteTenantResponse)(nil),     // 11: keyhub.console.v1.DeleteTenantResponse
+	(*TenantTypeDefinition)(nil),     // 12: keyhub.console.v1.TenantTypeDefinition
+	(*ListTenantTypesRequest)(nil),   // 13: keyhub.console.v1.ListTenantTypesRequest
+	(*ListTenantTypesResponse)(nil),  // 14: keyhub.console.v1.ListTenantTypesResponse
+	(*CreateTenantTypeRequest)(nil),  // 15: keyhub.console.v1.CreateTenantTypeRequest
+	(*CreateTenantTypeResponse)(nil), // 16: keyhub.console.v1.CreateTenantTypeResponse
+	(*DeleteTenantTypeRequest)(nil),  // 17: keyhub.console.v1.DeleteTenantTypeRequest
+	(*DeleteTenantTypeResponse)(nil), // 18: keyhub.console.v1.DeleteTenantTypeResponse
+	(TenantType)(0),                  // 19: keyhub.console.v1.TenantType
+	(*timestamppb.Timestamp)(nil),    // 20: google.protobuf.Timestamp
+	(ListOrder)(0),                   // 21: keyhub.console.v1.ListOrder
+	(*Tenant)(nil),                   // 22: keyhub.console.v1.Tenant
 }
 var file_keyhub_console_v1_tenant_proto_depIdxs = []int32{
-	8,  // 0: keyhub.console.v1.CreateTenantRequest.tenant_type:type_name -> keyhub.console.v1.TenantType
-	9,  // 1: keyhub.console.v1.CreateTenantRequest.join_code_expiry:type_name -> google.protobuf.Timestamp
-	10, // 2: keyhub.console.v1.GetAllTenantsResponse.tenants:type_name -> keyhub.console.v1.Tenant
-	10, // 3: keyhub.console.v1.GetTenantByIdResponse.tenant:type_name -> keyhub.console.v1.Tenant
-	9,  // 4: keyhub.console.v1.GetTenantByIdResponse.join_code_expiry:type_name -> google.protobuf.Timestamp
-	8,  // 5: keyhub.console.v1.UpdateTenantRequest.tenant_type:type_name -> keyhub.console.v1.TenantType
-	9,  // 6: keyhub.console.v1.UpdateTenantRequest.join_code_expiry:type_name -> google.protobuf.Timestamp
-	0,  // 7: keyhub.console.v1.ConsoleService.CreateTenant:input_type -> keyhub.console.v1.CreateTenantRequest
-	2,  // 8: keyhub.console.v1.ConsoleService.GetAllTenants:input_type -> keyhub.console.v1.GetAllTenantsRequest
-	4,  // 9: keyhub.console.v1.ConsoleService.GetTenantById:input_type -> keyhub.console.v1.GetTenantByIdRequest
-	6,  // 10: keyhub.console.v1.ConsoleService.UpdateTenant:input_type -> keyhub.console.v1.UpdateTenantRequest
-	1,  // 11: keyhub.console.v1.ConsoleService.CreateTenant:output_type -> keyhub.console.v1.CreateTenantResponse
-	3,  // 12: keyhub.console.v1.ConsoleService.GetAllTenants:output_type -> keyhub.console.v1.GetAllTenantsResponse
-	5,  // 13: keyhub.console.v1.ConsoleService.GetTenantById:output_type -> keyhub.console.v1.GetTenantByIdResponse
-	7,  // 14: keyhub.console.v1.ConsoleService.UpdateTenant:output_type -> keyhub.console.v1.UpdateTenantResponse
-	11, // [11:15] is the sub-list for method output_type
-	7,  // [7:11] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	19, // 0: keyhub.console.v1.CreateTenantRequest.tenant_type:type_name -> keyhub.console.v1.TenantType
+	20, // 1: keyhub.console.v1.CreateTenantRequest.join_code_expiry:type_name -> google.protobuf.Timestamp
+	21, // 2: keyhub.console.v1.GetAllTenantsRequest.order:type_name -> keyhub.console.v1.ListOrder
+	19, // 3: keyhub.console.v1.GetAllTenantsRequest.tenant_type:type_name -> keyhub.console.v1.TenantType
+	22, // 4: keyhub.console.v1.GetAllTenantsResponse.tenants:type_name -> keyhub.console.v1.Tenant
+	22, // 5: keyhub.console.v1.GetTenantByIdResponse.tenant:type_name -> keyhub.console.v1.Tenant
+	20, // 6: keyhub.console.v1.GetTenantByIdResponse.join_code_expiry:type_name -> google.protobuf.Timestamp
+	19, // 7: keyhub.console.v1.UpdateTenantRequest.tenant_type:type_name -> keyhub.console.v1.TenantType
+	20, // 8: keyhub.console.v1.UpdateTenantRequest.join_code_expiry:type_name -> google.protobuf.Timestamp
+	22, // 9: keyhub.console.v1.ArchiveTenantResponse.tenant:type_name -> keyhub.console.v1.Tenant
+	19, // 10: keyhub.console.v1.TenantTypeDefinition.tenant_type:type_name -> keyhub.console.v1.TenantType
+	20, // 11: keyhub.console.v1.TenantTypeDefinition.created_at:type_name -> google.protobuf.Timestamp
+	12, // 12: keyhub.console.v1.ListTenantTypesResponse.tenant_types:type_name -> keyhub.console.v1.TenantTypeDefinition
+	12, // 13: keyhub.console.v1.CreateTenantTypeResponse.tenant_type:type_name -> keyhub.console.v1.TenantTypeDefinition
+	0,  // 14: keyhub.console.v1.ConsoleService.CreateTenant:input_type -> keyhub.console.v1.CreateTenantRequest
+	2,  // 15: keyhub.console.v1.ConsoleService.GetAllTenants:input_type -> keyhub.console.v1.GetAllTenantsRequest
+	4,  // 16: keyhub.console.v1.ConsoleService.GetTenantById:input_type -> keyhub.console.v1.GetTenantByIdRequest
+	6,  // 17: keyhub.console.v1.ConsoleService.UpdateTenant:input_type -> keyhub.console.v1.UpdateTenantRequest
+	8,  // 18: keyhub.console.v1.ConsoleService.ArchiveTenant:input_type -> keyhub.console.v1.ArchiveTenantRequest
+	10, // 19: keyhub.console.v1.ConsoleService.DeleteTenant:input_type -> keyhub.console.v1.DeleteTenantRequest
+	13, // 20: keyhub.console.v1.ConsoleService.ListTenantTypes:input_type -> keyhub.console.v1.ListTenantTypesRequest
+	15, // 21: keyhub.console.v1.ConsoleService.CreateTenantType:input_type -> keyhub.console.v1.CreateTenantTypeRequest
+	17, // 22: keyhub.console.v1.ConsoleService.DeleteTenantType:input_type -> keyhub.console.v1.DeleteTenantTypeRequest
+	1,  // 23: keyhub.console.v1.ConsoleService.CreateTenant:output_type -> keyhub.console.v1.CreateTenantResponse
+	3,  // 24: keyhub.console.v1.ConsoleService.GetAllTenants:output_type -> keyhub.console.v1.GetAllTenantsResponse
+	5,  // 25: keyhub.console.v1.ConsoleService.GetTenantById:output_type -> keyhub.console.v1.GetTenantByIdResponse
+	7,  // 26: keyhub.console.v1.ConsoleService.UpdateTenant:output_type -> keyhub.console.v1.UpdateTenantResponse
+	9,  // 27: keyhub.console.v1.ConsoleService.ArchiveTenant:output_type -> keyhub.console.v1.ArchiveTenantResponse
+	11, // 28: keyhub.console.v1.ConsoleService.DeleteTenant:output_type -> keyhub.console.v1.DeleteTenantResponse
+	14, // 29: keyhub.console.v1.ConsoleService.ListTenantTypes:output_type -> keyhub.console.v1.ListTenantTypesResponse
+	16, // 30: keyhub.console.v1.ConsoleService.CreateTenantType:output_type -> keyhub.console.v1.CreateTenantTypeResponse
+	18, // 31: keyhub.console.v1.ConsoleService.DeleteTenantType:output_type -> keyhub.console.v1.DeleteTenantTypeResponse
+	23, // [23:32] is the sub-list for method output_type
+	14, // [14:23] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_keyhub_console_v1_tenant_proto_init() }
@@ -574,7 +1249,7 @@ func file_keyhub_console_v1_tenant_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_keyhub_console_v1_tenant_proto_rawDesc), len(file_keyhub_console_v1_tenant_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,776 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: keyhub/console/v1/tenant_group.proto
+
+package consolev1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TenantGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	ParentGroupId *string                `protobuf:"bytes,3,opt,name=parent_group_id,json=parentGroupId,proto3,oneof" json:"parent_group_id,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	MemberCount   int32                  `protobuf:"varint,6,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantGroup) Reset() {
+	*x = TenantGroup{}
+	mi := &file_keyhub_console_v1_tenant_group_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantGroup) ProtoMessage() {}
+
+func (x *TenantGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_tenant_group_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantGroup.ProtoReflect.Descriptor instead.
+func (*TenantGroup) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_tenant_group_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TenantGroup) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TenantGroup) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *TenantGroup) GetParentGroupId() string {
+	if x != nil && x.ParentGroupId != nil {
+		return *x.ParentGroupId
+	}
+	return ""
+}
+
+func (x *TenantGroup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TenantGroup) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TenantGroup) GetMemberCount() int32 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+func (x *TenantGroup) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type TenantGroupMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Icon          string                 `protobuf:"bytes,4,opt,name=icon,proto3" json:"icon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantGroupMember) Reset() {
+	*x = TenantGroupMember{}
+	mi := &file_keyhub_console_v1_tenant_group_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantGroupMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantGroupMember) ProtoMessage() {}
+
+func (x *TenantGroupMember) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_tenant_group_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantGroupMember.ProtoReflect.Descriptor instead.
+func (*TenantGroupMember) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_tenant_group_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TenantGroupMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TenantGroupMember) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TenantGroupMember) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *TenantGroupMember) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+type CreateTenantGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ParentGroupId *string                `protobuf:"bytes,4,opt,name=parent_group_id,json=parentGroupId,proto3,oneof" json:"parent_group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTenantGroupRequest) Reset() {
+	*x = CreateTenantGroupRequest{}
+	mi := &file_keyhub_console_v1_tenant_group_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTenantGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTenantGroupRequest) ProtoMessage() {}
+
+func (x *CreateTenantGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_tenant_group_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTenantGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateTenantGroupRequest) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_tenant_group_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateTenantGroupRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *CreateTenantGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTenantGroupRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateTenantGroupRequest) GetParentGroupId() string {
+	if x != nil && x.ParentGroupId != nil {
+		return *x.ParentGroupId
+	}
+	return ""
+}
+
+type CreateTenantGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *TenantGroup           `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTenantGroupResponse) Reset() {
+	*x = CreateTenantGroupResponse{}
+	mi := &file_keyhub_console_v1_tenant_group_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTenantGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTenantGroupResponse) ProtoMessage() {}
+
+func (x *CreateTenantGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_tenant_group_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTenantGroupResponse.ProtoReflect.Descriptor instead.
+func (*CreateTenantGroupResponse) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_tenant_group_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateTenantGroupResponse) GetGroup() *TenantGroup {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type ListTenantGroupsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTenantGroupsRequest) Reset() {
+	*x = ListTenantGroupsRequest{}
+	mi := &file_keyhub_console_v1_tenant_group_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTenantGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantGroupsRequest) ProtoMessage() {}
+
+func (x *ListTenantGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_tenant_group_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantGroupsRequest.ProtoReflect.Descriptor instead.
+func (*ListTenantGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_tenant_group_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListTenantGroupsRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type ListTenantGroupsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*TenantGroup         `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTenantGroupsResponse) Reset() {
+	*x = ListTenantGroupsResponse{}
+	mi := &file_keyhub_console_v1_tenant_group_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTenantGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantGroupsResponse) ProtoMessage() {}
+
+func (x *ListTenantGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_tenant_group_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantGroupsResponse.ProtoReflect.Descriptor instead.
+func (*ListTenantGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_tenant_group_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListTenantGroupsResponse) GetGroups() []*TenantGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type AddTenantGroupMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTenantGroupMemberRequest) Reset() {
+	*x = AddTenantGroupMemberRequest{}
+	mi := &file_keyhub_console_v1_tenant_group_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTenantGroupMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTenantGroupMemberRequest) ProtoMessage() {}
+
+func (x *AddTenantGroupMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_tenant_group_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTenantGroupMemberRequest.ProtoReflect.Descriptor instead.
+func (*AddTenantGroupMemberRequest) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_tenant_group_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AddTenantGroupMemberRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *AddTenantGroupMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type AddTenantGroupMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTenantGroupMemberResponse) Reset() {
+	*x = AddTenantGroupMemberResponse{}
+	mi := &file_keyhub_console_v1_tenant_group_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTenantGroupMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTenantGroupMemberResponse) ProtoMessage() {}
+
+func (x *AddTenantGroupMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_tenant_group_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTenantGroupMemberResponse.ProtoReflect.Descriptor instead.
+func (*AddTenantGroupMemberResponse) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_tenant_group_proto_rawDescGZIP(), []int{7}
+}
+
+type RemoveTenantGroupMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveTenantGroupMemberRequest) Reset() {
+	*x = RemoveTenantGroupMemberRequest{}
+	mi := &file_keyhub_console_v1_tenant_group_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTenantGroupMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTenantGroupMemberRequest) ProtoMessage() {}
+
+func (x *RemoveTenantGroupMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_tenant_group_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTenantGroupMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTenantGroupMemberRequest) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_tenant_group_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RemoveTenantGroupMemberRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *RemoveTenantGroupMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RemoveTenantGroupMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveTenantGroupMemberResponse) Reset() {
+	*x = RemoveTenantGroupMemberResponse{}
+	mi := &file_keyhub_console_v1_tenant_group_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTenantGroupMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTenantGroupMemberResponse) ProtoMessage() {}
+
+func (x *RemoveTenantGroupMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_tenant_group_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTenantGroupMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveTenantGroupMemberResponse) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_tenant_group_proto_rawDescGZIP(), []int{9}
+}
+
+type ListTenantGroupMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTenantGroupMembersRequest) Reset() {
+	*x = ListTenantGroupMembersRequest{}
+	mi := &file_keyhub_console_v1_tenant_group_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTenantGroupMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantGroupMembersRequest) ProtoMessage() {}
+
+func (x *ListTenantGroupMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_tenant_group_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantGroupMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListTenantGroupMembersRequest) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_tenant_group_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListTenantGroupMembersRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type ListTenantGroupMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*TenantGroupMember   `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTenantGroupMembersResponse) Reset() {
+	*x = ListTenantGroupMembersResponse{}
+	mi := &file_keyhub_console_v1_tenant_group_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTenantGroupMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantGroupMembersResponse) ProtoMessage() {}
+
+func (x *ListTenantGroupMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keyhub_console_v1_tenant_group_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantGroupMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListTenantGroupMembersResponse) Descriptor() ([]byte, []int) {
+	return file_keyhub_console_v1_tenant_group_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListTenantGroupMembersResponse) GetMembers() []*TenantGroupMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+var File_keyhub_console_v1_tenant_group_proto protoreflect.FileDescriptor
+
+const file_keyhub_console_v1_tenant_group_proto_rawDesc = "" +
+	"\n" +
+	"$keyhub/console/v1/tenant_group.proto\x12\x11keyhub.console.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa3\x02\n" +
+	"\vTenantGroup\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12%\n" +
+	"\ttenant_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\btenantId\x12+\n" +
+	"\x0fparent_group_id\x18\x03 \x01(\tH\x00R\rparentGroupId\x88\x01\x01\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12!\n" +
+	"\fmember_count\x18\x06 \x01(\x05R\vmemberCount\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x12\n" +
+	"\x10_parent_group_id\"t\n" +
+	"\x11TenantGroupMember\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x12\n" +
+	"\x04icon\x18\x04 \x01(\tR\x04icon\"\xd7\x01\n" +
+	"\x18CreateTenantGroupRequest\x12%\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\btenantId\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\x04name\x12*\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xac\x02R\vdescription\x125\n" +
+	"\x0fparent_group_id\x18\x04 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\rparentGroupId\x88\x01\x01B\x12\n" +
+	"\x10_parent_group_id\"Q\n" +
+	"\x19CreateTenantGroupResponse\x124\n" +
+	"\x05group\x18\x01 \x01(\v2\x1e.keyhub.console.v1.TenantGroupR\x05group\"@\n" +
+	"\x17ListTenantGroupsRequest\x12%\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\btenantId\"R\n" +
+	"\x18ListTenantGroupsResponse\x126\n" +
+	"\x06groups\x18\x01 \x03(\v2\x1e.keyhub.console.v1.TenantGroupR\x06groups\"e\n" +
+	"\x1bAddTenantGroupMemberRequest\x12#\n" +
+	"\bgroup_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\agroupId\x12!\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\"\x1e\n" +
+	"\x1cAddTenantGroupMemberResponse\"h\n" +
+	"\x1eRemoveTenantGroupMemberRequest\x12#\n" +
+	"\bgroup_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\agroupId\x12!\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\"!\n" +
+	"\x1fRemoveTenantGroupMemberResponse\"D\n" +
+	"\x1dListTenantGroupMembersRequest\x12#\n" +
+	"\bgroup_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\agroupId\"`\n" +
+	"\x1eListTenantGroupMembersResponse\x12>\n" +
+	"\amembers\x18\x01 \x03(\v2$.keyhub.console.v1.TenantGroupMemberR\amembers2\xfe\x04\n" +
+	"\x19ConsoleTenantGroupService\x12n\n" +
+	"\x11CreateTenantGroup\x12+.keyhub.console.v1.CreateTenantGroupRequest\x1a,.keyhub.console.v1.CreateTenantGroupResponse\x12p\n" +
+	"\x10ListTenantGroups\x12*.keyhub.console.v1.ListTenantGroupsRequest\x1a+.keyhub.console.v1.ListTenantGroupsResponse\"\x03\x90\x02\x01\x12w\n" +
+	"\x14AddTenantGroupMember\x12..keyhub.console.v1.AddTenantGroupMemberRequest\x1a/.keyhub.console.v1.AddTenantGroupMemberResponse\x12\x80\x01\n" +
+	"\x17RemoveTenantGroupMember\x121.keyhub.console.v1.RemoveTenantGroupMemberRequest\x1a2.keyhub.console.v1.RemoveTenantGroupMemberResponse\x12\x82\x01\n" +
+	"\x16ListTenantGroupMembers\x120.keyhub.console.v1.ListTenantGroupMembersRequest\x1a1.keyhub.console.v1.ListTenantGroupMembersResponse\"\x03\x90\x02\x01B\xe4\x01\n" +
+	"\x15com.keyhub.console.v1B\x10TenantGroupProtoP\x01ZSgithub.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1;consolev1\xa2\x02\x03KCX\xaa\x02\x11Keyhub.Console.V1\xca\x02\x11Keyhub\\Console\\V1\xe2\x02\x1dKeyhub\\Console\\V1\\GPBMetadata\xea\x02\x13Keyhub::Console::V1b\x06proto3"
+
+var (
+	file_keyhub_console_v1_tenant_group_proto_rawDescOnce sync.Once
+	file_keyhub_console_v1_tenant_group_proto_rawDescData []byte
+)
+
+func file_keyhub_console_v1_tenant_group_proto_rawDescGZIP() []byte {
+	file_keyhub_console_v1_tenant_group_proto_rawDescOnce.Do(func() {
+		file_keyhub_console_v1_tenant_group_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_keyhub_console_v1_tenant_group_proto_rawDesc), len(file_keyhub_console_v1_tenant_group_proto_rawDesc)))
+	})
+	return file_keyhub_console_v1_tenant_group_proto_rawDescData
+}
+
+var file_keyhub_console_v1_tenant_group_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_keyhub_console_v1_tenant_group_proto_goTypes = []any{
+	(*TenantGroup)(nil),                     // 0: keyhub.console.v1.TenantGroup
+	(*TenantGroupMember)(nil),               // 1: keyhub.console.v1.TenantGroupMember
+	(*CreateTenantGroupRequest)(nil),        // 2: keyhub.console.v1.CreateTenantGroupRequest
+	(*CreateTenantGroupResponse)(nil),       // 3: keyhub.console.v1.CreateTenantGroupResponse
+	(*ListTenantGroupsRequest)(nil),         // 4: keyhub.console.v1.ListTenantGroupsRequest
+	(*ListTenantGroupsResponse)(nil),        // 5: keyhub.console.v1.ListTenantGroupsResponse
+	(*AddTenantGroupMemberRequest)(nil),     // 6: keyhub.console.v1.AddTenantGroupMemberRequest
+	(*AddTenantGroupMemberResponse)(nil),    // 7: keyhub.console.v1.AddTenantGroupMemberResponse
+	(*RemoveTenantGroupMemberRequest)(nil),  // 8: keyhub.console.v1.RemoveTenantGroupMemberRequest
+	(*RemoveTenantGroupMemberResponse)(nil), // 9: keyhub.console.v1.RemoveTenantGroupMemberResponse
+	(*ListTenantGroupMembersRequest)(nil),   // 10: keyhub.console.v1.ListTenantGroupMembersRequest
+	(*ListTenantGroupMembersResponse)(nil),  // 11: keyhub.console.v1.ListTenantGroupMembersResponse
+	(*timestamppb.Timestamp)(nil),           // 12: google.protobuf.Timestamp
+}
+var file_keyhub_console_v1_tenant_group_proto_depIdxs = []int32{
+	12, // 0: keyhub.console.v1.TenantGroup.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 1: keyhub.console.v1.CreateTenantGroupResponse.group:type_name -> keyhub.console.v1.TenantGroup
+	0,  // 2: keyhub.console.v1.ListTenantGroupsResponse.groups:type_name -> keyhub.console.v1.TenantGroup
+	1,  // 3: keyhub.console.v1.ListTenantGroupMembersResponse.members:type_name -> keyhub.console.v1.TenantGroupMember
+	2,  // 4: keyhub.console.v1.ConsoleTenantGroupService.CreateTenantGroup:input_type -> keyhub.console.v1.CreateTenantGroupRequest
+	4,  // 5: keyhub.console.v1.ConsoleTenantGroupService.ListTenantGroups:input_type -> keyhub.console.v1.ListTenantGroupsRequest
+	6,  // 6: keyhub.console.v1.ConsoleTenantGroupService.AddTenantGroupMember:input_type -> keyhub.console.v1.AddTenantGroupMemberRequest
+	8,  // 7: keyhub.console.v1.ConsoleTenantGroupService.RemoveTenantGroupMember:input_type -> keyhub.console.v1.RemoveTenantGroupMemberRequest
+	10, // 8: keyhub.console.v1.ConsoleTenantGroupService.ListTenantGroupMembers:input_type -> keyhub.console.v1.ListTenantGroupMembersRequest
+	3,  // 9: keyhub.console.v1.ConsoleTenantGroupService.CreateTenantGroup:output_type -> keyhub.console.v1.CreateTenantGroupResponse
+	5,  // 10: keyhub.console.v1.ConsoleTenantGroupService.ListTenantGroups:output_type -> keyhub.console.v1.ListTenantGroupsResponse
+	7,  // 11: keyhub.console.v1.ConsoleTenantGroupService.AddTenantGroupMember:output_type -> keyhub.console.v1.AddTenantGroupMemberResponse
+	9,  // 12: keyhub.console.v1.ConsoleTenantGroupService.RemoveTenantGroupMember:output_type -> keyhub.console.v1.RemoveTenantGroupMemberResponse
+	11, // 13: keyhub.console.v1.ConsoleTenantGroupService.ListTenantGroupMembers:output_type -> keyhub.console.v1.ListTenantGroupMembersResponse
+	9,  // [9:14] is the sub-list for method output_type
+	4,  // [4:9] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_keyhub_console_v1_tenant_group_proto_init() }
+func file_keyhub_console_v1_tenant_group_proto_init() {
+	if File_keyhub_console_v1_tenant_group_proto != nil {
+		return
+	}
+	file_keyhub_console_v1_tenant_group_proto_msgTypes[0].OneofWrappers = []any{}
+	file_keyhub_console_v1_tenant_group_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_keyhub_console_v1_tenant_group_proto_rawDesc), len(file_keyhub_console_v1_tenant_group_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_keyhub_console_v1_tenant_group_proto_goTypes,
+		DependencyIndexes: file_keyhub_console_v1_tenant_group_proto_depIdxs,
+		MessageInfos:      file_keyhub_console_v1_tenant_group_proto_msgTypes,
+	}.Build()
+	File_keyhub_console_v1_tenant_group_proto = out.File
+	file_keyhub_console_v1_tenant_group_proto_goTypes = nil
+	file_keyhub_console_v1_tenant_group_proto_depIdxs = nil
+}
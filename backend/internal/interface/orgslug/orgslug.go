@@ -0,0 +1,42 @@
+package orgslug
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// FromRequest はログイン先の組織のスラッグを決める。
+// explicit（クエリやリクエストで明示した値）を優先し、なければ Origin または Referer のホストが
+// baseDomain のサブドメイン（acme.keyhub.example など）の場合に先頭のラベルを使う。決まらない場合は空文字を返す
+func FromRequest(header http.Header, explicit, baseDomain string) string {
+	if explicit != "" {
+		return explicit
+	}
+	if baseDomain == "" {
+		return ""
+	}
+
+	for _, name := range []string{"Origin", "Referer"} {
+		if slug := fromURL(header.Get(name), baseDomain); slug != "" {
+			return slug
+		}
+	}
+	return ""
+}
+
+func fromURL(rawURL, baseDomain string) string {
+	if rawURL == "" {
+		return ""
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+
+	sub, ok := strings.CutSuffix(strings.ToLower(u.Hostname()), "."+strings.ToLower(baseDomain))
+	if !ok || sub == "" || strings.Contains(sub, ".") {
+		return ""
+	}
+	return sub
+}
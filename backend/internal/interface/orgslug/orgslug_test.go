@@ -0,0 +1,67 @@
+package orgslug
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromRequest(t *testing.T) {
+	tests := []struct {
+		name       string
+		header     http.Header
+		explicit   string
+		baseDomain string
+		want       string
+	}{
+		{
+			name:       "正常系: 明示したスラッグを優先する",
+			header:     http.Header{"Origin": []string{"https://acme.keyhub.example"}},
+			explicit:   "other",
+			baseDomain: "keyhub.example",
+			want:       "other",
+		},
+		{
+			name:       "正常系: Originのサブドメインを使う",
+			header:     http.Header{"Origin": []string{"https://acme.keyhub.example"}},
+			baseDomain: "keyhub.example",
+			want:       "acme",
+		},
+		{
+			name:       "正常系: OriginがなければRefererのサブドメインを使う",
+			header:     http.Header{"Referer": []string{"https://acme.keyhub.example:8443/login"}},
+			baseDomain: "keyhub.example",
+			want:       "acme",
+		},
+		{
+			name:       "異常系: ベースドメイン以外のホストは無視する",
+			header:     http.Header{"Origin": []string{"https://acme.evil.example"}},
+			baseDomain: "keyhub.example",
+			want:       "",
+		},
+		{
+			name:       "異常系: 多段のサブドメインは無視する",
+			header:     http.Header{"Origin": []string{"https://a.b.keyhub.example"}},
+			baseDomain: "keyhub.example",
+			want:       "",
+		},
+		{
+			name:       "異常系: ベースドメインそのものは組織を表さない",
+			header:     http.Header{"Origin": []string{"https://keyhub.example"}},
+			baseDomain: "keyhub.example",
+			want:       "",
+		},
+		{
+			name:   "異常系: ベースドメイン未設定の場合はホストから判定しない",
+			header: http.Header{"Origin": []string{"https://acme.keyhub.example"}},
+			want:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, FromRequest(tt.header, tt.explicit, tt.baseDomain))
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/ratelimit"
+	"github.com/shibayama-club/keyhub/internal/interface/clientinfo"
+)
+
+// AuthFailureInterceptor は認証の失敗を接続元IPごとに数え、続いた場合はそのIPからの呼び出しをロックする。
+// 認証インターセプターより外側に置き、セッションIDやAPIトークンの総当たりも数える
+type AuthFailureInterceptor struct {
+	store  ratelimit.Store
+	policy model.LockoutPolicy
+}
+
+var _ connect.Interceptor = (*AuthFailureInterceptor)(nil)
+
+func NewAuthFailureInterceptor(store ratelimit.Store, policy model.LockoutPolicy) *AuthFailureInterceptor {
+	return &AuthFailureInterceptor{
+		store:  store,
+		policy: policy,
+	}
+}
+
+func (i *AuthFailureInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		key := authFailureKey(req.Peer().Addr)
+		if err := i.check(ctx, key); err != nil {
+			return nil, err
+		}
+
+		res, err := next(ctx, req)
+		i.observe(ctx, key, err)
+		return res, err
+	}
+}
+
+func (i *AuthFailureInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler はストリームを開く時点の認証の失敗も Unary と同じように数える
+func (i *AuthFailureInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		key := authFailureKey(conn.Peer().Addr)
+		if err := i.check(ctx, key); err != nil {
+			return err
+		}
+
+		err := next(ctx, conn)
+		i.observe(ctx, key, err)
+		return err
+	}
+}
+
+// check はIPがロック中か確認する。Interceptor と同じく、ストアのエラーはログに残して許可する
+func (i *AuthFailureInterceptor) check(ctx context.Context, key string) error {
+	locked, err := i.store.LockedFor(ctx, key)
+	if err != nil {
+		slog.WarnContext(ctx, "failed to check lockout", "key", key, "error", err)
+		return nil
+	}
+	if locked > 0 {
+		return exhaustedError(locked, "too many authentication failures",
+			"認証の失敗が続いたため一時的にロックされています。しばらくしてから再試行してください。")
+	}
+	return nil
+}
+
+// observe は認証の失敗だけを記録する。成功しても失敗回数は消さない（Interceptor のIPの扱いと同じ）
+func (i *AuthFailureInterceptor) observe(ctx context.Context, key string, err error) {
+	if connect.CodeOf(err) != connect.CodeUnauthenticated {
+		return
+	}
+	locked, err := i.store.RecordFailure(ctx, key, i.policy)
+	if err != nil {
+		slog.WarnContext(ctx, "failed to record authentication failure", "key", key, "error", err)
+		return
+	}
+	if locked > 0 {
+		slog.WarnContext(ctx, "locked out after repeated authentication failures", "key", key, "locked_for", locked)
+	}
+}
+
+func authFailureKey(peerAddr string) string {
+	return fmt.Sprintf("auth:ip:%s", clientinfo.IP(peerAddr))
+}
@@ -0,0 +1,135 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/cockroachdb/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	consolev1 "github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1"
+	"github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1/consolev1connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// lockoutStore は失敗が policy.MaxFailures 回に達したキーをロックする
+type lockoutStore struct {
+	mu       sync.Mutex
+	failures map[string]int
+	locked   map[string]bool
+}
+
+func (s *lockoutStore) Take(context.Context, string, model.RateLimit) (model.RateLimitDecision, error) {
+	return model.RateLimitDecision{Allowed: true}, nil
+}
+
+func (s *lockoutStore) LockedFor(_ context.Context, key string) (time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.locked[key] {
+		return time.Minute, nil
+	}
+	return 0, nil
+}
+
+func (s *lockoutStore) RecordFailure(_ context.Context, key string, policy model.LockoutPolicy) (time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures[key]++
+	if s.failures[key] >= policy.MaxFailures {
+		s.locked[key] = true
+		return policy.Duration, nil
+	}
+	return 0, nil
+}
+
+func (s *lockoutStore) ResetFailures(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.failures, key)
+	return nil
+}
+
+// failingLoginHandler は codes の順に結果を返す。nil は成功を表す
+type failingLoginHandler struct {
+	consolev1connect.UnimplementedConsoleAuthServiceHandler
+	codes []*connect.Code
+	calls int
+}
+
+func (h *failingLoginHandler) LoginWithOrgId(context.Context, *connect.Request[consolev1.LoginWithOrgIdRequest]) (*connect.Response[consolev1.LoginWithOrgIdResponse], error) {
+	code := h.codes[h.calls]
+	h.calls++
+	if code != nil {
+		return nil, connect.NewError(*code, errors.New("failed"))
+	}
+	return connect.NewResponse(&consolev1.LoginWithOrgIdResponse{}), nil
+}
+
+func TestAuthFailureInterceptor(t *testing.T) {
+	unauthenticated := connect.CodeUnauthenticated
+	notFound := connect.CodeNotFound
+	policy := model.LockoutPolicy{MaxFailures: 2, Window: time.Minute, Duration: time.Minute}
+
+	tests := []struct {
+		name      string
+		codes     []*connect.Code
+		wantCalls int
+		wantCode  connect.Code
+	}{
+		{
+			name:      "異常系: 認証の失敗が続いたIPはロックされ、ハンドラーを呼ばない",
+			codes:     []*connect.Code{&unauthenticated, &unauthenticated, nil},
+			wantCalls: 2,
+			wantCode:  connect.CodeResourceExhausted,
+		},
+		{
+			name:      "異常系: 間に成功を挟んでもIPの失敗回数は消えない",
+			codes:     []*connect.Code{&unauthenticated, nil, &unauthenticated, nil},
+			wantCalls: 3,
+			wantCode:  connect.CodeResourceExhausted,
+		},
+		{
+			name:      "正常系: 認証以外の失敗は数えない",
+			codes:     []*connect.Code{&notFound, &notFound, nil},
+			wantCalls: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &lockoutStore{failures: map[string]int{}, locked: map[string]bool{}}
+			handler := &failingLoginHandler{codes: tt.codes}
+
+			path, h := consolev1connect.NewConsoleAuthServiceHandler(
+				handler,
+				connect.WithInterceptors(NewAuthFailureInterceptor(store, policy)),
+			)
+			mux := http.NewServeMux()
+			mux.Handle(path, h)
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client := consolev1connect.NewConsoleAuthServiceClient(server.Client(), server.URL)
+			var err error
+			for range tt.codes {
+				_, err = client.LoginWithOrgId(context.Background(), connect.NewRequest(&consolev1.LoginWithOrgIdRequest{}))
+			}
+
+			assert.Equal(t, tt.wantCalls, handler.calls)
+			if tt.wantCode == 0 {
+				require.NoError(t, err)
+				return
+			}
+			var connectErr *connect.Error
+			require.True(t, errors.As(err, &connectErr))
+			assert.Equal(t, tt.wantCode, connectErr.Code())
+			assert.Equal(t, "60", connectErr.Meta().Get(HeaderRetryAfter))
+		})
+	}
+}
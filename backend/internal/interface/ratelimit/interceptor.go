@@ -0,0 +1,205 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"strconv"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/cockroachdb/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/ratelimit"
+	"github.com/shibayama-club/keyhub/internal/interface/clientinfo"
+)
+
+// HeaderRetryAfter は制限を超えた場合に再試行まで待つ秒数を返すレスポンスメタデータ
+const HeaderRetryAfter = "Retry-After"
+
+// Identifier はリクエストから制限をかける識別子（組織ID、ユーザーIDなど）を取り出す。空文字の場合は識別子による制限をかけない
+type Identifier func(ctx context.Context, msg any) string
+
+// Rule は手続きごとのレート制限。ゼロ値の項目は適用しない
+type Rule struct {
+	// Name は制限を共有する単位。複数の手続きで同じ Name を使うと回数が合算される。空の場合は手続き名を使う
+	Name string
+	// PerIP は接続元IPアドレスごとの制限。X-Forwarded-For は読まず、信頼するプロキシ経由の場合だけ
+	// clientinfo.TrustedProxies.Middleware が置き換えた接続元アドレスで数える
+	PerIP model.RateLimit
+	// PerIdentifier は Identifier が返す値ごとの制限
+	PerIdentifier model.RateLimit
+	Identifier    Identifier
+	// Lockout は認証失敗・存在しないリソースへのアクセスが続いた場合のロックアウト。IPと識別子のそれぞれに適用する
+	Lockout model.LockoutPolicy
+}
+
+type Interceptor struct {
+	store ratelimit.Store
+	rules map[string]Rule
+}
+
+var _ connect.Interceptor = (*Interceptor)(nil)
+
+func NewInterceptor(store ratelimit.Store, rules map[string]Rule) *Interceptor {
+	return &Interceptor{
+		store: store,
+		rules: rules,
+	}
+}
+
+// MessageIdentifier はリクエストメッセージの値を識別子にする Identifier を作る
+func MessageIdentifier[T any](fn func(msg *T) string) Identifier {
+	return func(_ context.Context, msg any) string {
+		m, ok := msg.(*T)
+		if !ok {
+			return ""
+		}
+		return fn(m)
+	}
+}
+
+func (i *Interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		procedure := req.Spec().Procedure
+		rule, ok := i.rules[procedure]
+		if !ok {
+			return next(ctx, req)
+		}
+
+		name := rule.Name
+		if name == "" {
+			name = procedure
+		}
+
+		ipKey := fmt.Sprintf("%s:ip:%s", name, clientinfo.IP(req.Peer().Addr))
+		var idKey string
+		if rule.Identifier != nil {
+			if id := rule.Identifier(ctx, req.Any()); id != "" {
+				idKey = fmt.Sprintf("%s:id:%s", name, id)
+			}
+		}
+
+		if err := i.check(ctx, rule, ipKey, idKey); err != nil {
+			return nil, err
+		}
+
+		res, err := next(ctx, req)
+
+		if !rule.Lockout.IsZero() {
+			i.observe(ctx, rule.Lockout, ipKey, idKey, err)
+		}
+
+		return res, err
+	}
+}
+
+// check はロックアウトとトークンバケットを確認する。
+// ストアの障害でログインできなくなるのを避けるため、ストアのエラーはログに残して許可する
+func (i *Interceptor) check(ctx context.Context, rule Rule, ipKey, idKey string) error {
+	if !rule.Lockout.IsZero() {
+		for _, key := range []string{ipKey, idKey} {
+			if key == "" {
+				continue
+			}
+			locked, err := i.store.LockedFor(ctx, key)
+			if err != nil {
+				slog.WarnContext(ctx, "failed to check lockout", "key", key, "error", err)
+				continue
+			}
+			if locked > 0 {
+				return exhaustedError(locked, "too many failed attempts",
+					"失敗が続いたため一時的にロックされています。しばらくしてから再試行してください。")
+			}
+		}
+	}
+
+	limits := []struct {
+		key   string
+		limit model.RateLimit
+	}{
+		{key: ipKey, limit: rule.PerIP},
+		{key: idKey, limit: rule.PerIdentifier},
+	}
+	for _, l := range limits {
+		if l.key == "" || l.limit.IsZero() {
+			continue
+		}
+		decision, err := i.store.Take(ctx, l.key, l.limit)
+		if err != nil {
+			slog.WarnContext(ctx, "failed to take rate limit token", "key", l.key, "error", err)
+			continue
+		}
+		if !decision.Allowed {
+			return exhaustedError(decision.RetryAfter, "rate limit exceeded",
+				"リクエストが多すぎます。しばらくしてから再試行してください。")
+		}
+	}
+
+	return nil
+}
+
+// observe は失敗を記録し、成功した場合は識別子の失敗回数を消す。
+// IPの失敗回数は成功しても消さない（自分の正しい値を混ぜて総当たりを続けられないようにする）
+func (i *Interceptor) observe(ctx context.Context, policy model.LockoutPolicy, ipKey, idKey string, err error) {
+	if err == nil {
+		if idKey != "" {
+			if err := i.store.ResetFailures(ctx, idKey); err != nil {
+				slog.WarnContext(ctx, "failed to reset login failures", "key", idKey, "error", err)
+			}
+		}
+		return
+	}
+
+	if !isFailure(err) {
+		return
+	}
+
+	for _, key := range []string{ipKey, idKey} {
+		if key == "" {
+			continue
+		}
+		locked, err := i.store.RecordFailure(ctx, key, policy)
+		if err != nil {
+			slog.WarnContext(ctx, "failed to record login failure", "key", key, "error", err)
+			continue
+		}
+		if locked > 0 {
+			slog.WarnContext(ctx, "locked out after repeated failures", "key", key, "locked_for", locked)
+		}
+	}
+}
+
+func (i *Interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler はストリーミングRPCには制限をかけない（制限対象はすべてUnary）
+func (i *Interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+// isFailure は総当たりの失敗とみなすエラーかどうかを判定する
+func isFailure(err error) bool {
+	switch connect.CodeOf(err) {
+	case connect.CodeUnauthenticated, connect.CodeNotFound:
+		return true
+	default:
+		return false
+	}
+}
+
+func exhaustedError(retryAfter time.Duration, message, hint string) *connect.Error {
+	seconds := int(math.Ceil(retryAfter.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+
+	connectErr := connect.NewError(
+		connect.CodeResourceExhausted,
+		errors.WithHint(errors.New(message), hint),
+	)
+	connectErr.Meta().Set(HeaderRetryAfter, strconv.Itoa(seconds))
+	return connectErr
+}
@@ -0,0 +1,104 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/interface/clientinfo"
+	consolev1 "github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1"
+	"github.com/shibayama-club/keyhub/internal/interface/gen/keyhub/console/v1/consolev1connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingStore は Take に渡されたキーを記録する
+type recordingStore struct {
+	mu   sync.Mutex
+	keys []string
+}
+
+func (s *recordingStore) Take(_ context.Context, key string, _ model.RateLimit) (model.RateLimitDecision, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys = append(s.keys, key)
+	return model.RateLimitDecision{Allowed: true}, nil
+}
+
+func (s *recordingStore) LockedFor(context.Context, string) (time.Duration, error) {
+	return 0, nil
+}
+
+func (s *recordingStore) RecordFailure(context.Context, string, model.LockoutPolicy) (time.Duration, error) {
+	return 0, nil
+}
+
+func (s *recordingStore) ResetFailures(context.Context, string) error {
+	return nil
+}
+
+type loginHandler struct {
+	consolev1connect.UnimplementedConsoleAuthServiceHandler
+}
+
+func (loginHandler) LoginWithOrgId(context.Context, *connect.Request[consolev1.LoginWithOrgIdRequest]) (*connect.Response[consolev1.LoginWithOrgIdResponse], error) {
+	return connect.NewResponse(&consolev1.LoginWithOrgIdResponse{}), nil
+}
+
+func TestInterceptor_IPKey(t *testing.T) {
+	procedure := consolev1connect.ConsoleAuthServiceLoginWithOrgIdProcedure
+	rules := map[string]Rule{
+		procedure: {Name: "login", PerIP: model.RateLimit{Burst: 10, Period: time.Minute}},
+	}
+
+	tests := []struct {
+		name           string
+		trustedProxies []string
+		forwardedFor   []string
+		want           []string
+	}{
+		{
+			name:         "正常系: 信頼するプロキシがなければ X-Forwarded-For を変えても同じIPとして数える",
+			forwardedFor: []string{"198.51.100.1", "198.51.100.2", "198.51.100.3"},
+			want:         []string{"login:ip:127.0.0.1", "login:ip:127.0.0.1", "login:ip:127.0.0.1"},
+		},
+		{
+			name:           "正常系: 信頼するプロキシ経由なら右端の信頼しないアドレスで数える",
+			trustedProxies: []string{"127.0.0.0/8"},
+			forwardedFor:   []string{"198.51.100.1, 203.0.113.10", "198.51.100.2, 203.0.113.10"},
+			want:           []string{"login:ip:203.0.113.10", "login:ip:203.0.113.10"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &recordingStore{}
+			proxies, err := clientinfo.ParseTrustedProxies(tt.trustedProxies)
+			require.NoError(t, err)
+
+			path, handler := consolev1connect.NewConsoleAuthServiceHandler(
+				loginHandler{},
+				connect.WithInterceptors(NewInterceptor(store, rules)),
+			)
+			mux := http.NewServeMux()
+			mux.Handle(path, proxies.Middleware(handler))
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client := consolev1connect.NewConsoleAuthServiceClient(server.Client(), server.URL)
+			for _, forwarded := range tt.forwardedFor {
+				req := connect.NewRequest(&consolev1.LoginWithOrgIdRequest{})
+				req.Header().Set("X-Forwarded-For", forwarded)
+				_, err := client.LoginWithOrgId(context.Background(), req)
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.want, store.keys)
+		})
+	}
+}
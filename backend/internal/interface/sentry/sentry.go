@@ -68,22 +68,41 @@ func (i *ErrorInterceptor) captureError(ctx context.Context, err error, code con
 	return ""
 }
 
-// toConnectError はドメインエラーをConnect RPCエラーに変換する
+// connectError はドメインエラーをConnect RPCエラーに変換する
 func (i *ErrorInterceptor) connectError(err error) *connect.Error {
+	return connect.NewError(CodeOf(err), err)
+}
+
+// CodeOf はエラーがクライアントに返るときのConnectのコードを返す。
+// Connectエラーはそのコードを、ドメインエラーは対応するコードを返し、不明なエラーは CodeUnknown とする
+func CodeOf(err error) connect.Code {
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return connectErr.Code()
+	}
+
 	switch {
 	case errors.Is(err, domainerrors.ErrValidation):
-		return connect.NewError(connect.CodeInvalidArgument, err)
+		return connect.CodeInvalidArgument
 	case errors.Is(err, domainerrors.ErrNotFound):
-		return connect.NewError(connect.CodeNotFound, err)
+		return connect.CodeNotFound
 	case errors.Is(err, domainerrors.ErrUnAuthorized):
-		return connect.NewError(connect.CodeUnauthenticated, err)
+		return connect.CodeUnauthenticated
+	case errors.Is(err, domainerrors.ErrPermissionDenied):
+		return connect.CodePermissionDenied
 	case errors.Is(err, domainerrors.ErrAlreadyExists):
-		return connect.NewError(connect.CodeAlreadyExists, err)
+		return connect.CodeAlreadyExists
+	case errors.Is(err, domainerrors.ErrConflict):
+		return connect.CodeAborted
 	case errors.Is(err, domainerrors.ErrInternal):
-		return connect.NewError(connect.CodeInternal, err)
+		return connect.CodeInternal
+	// クライアントの切断やタイムアウトでハンドラーが中断された場合
+	case errors.Is(err, context.Canceled):
+		return connect.CodeCanceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return connect.CodeDeadlineExceeded
 	default:
-		// 不明なエラーは内部エラーとして扱う
-		return connect.NewError(connect.CodeUnknown, err)
+		return connect.CodeUnknown
 	}
 }
 
@@ -108,7 +127,9 @@ func (i *ErrorInterceptor) logError(ctx context.Context, err error, code connect
 		connect.CodeNotFound,
 		connect.CodeAlreadyExists,
 		connect.CodeUnauthenticated,
-		connect.CodePermissionDenied:
+		connect.CodePermissionDenied,
+		connect.CodeResourceExhausted,
+		connect.CodeCanceled:
 		// クライアント側エラー - 警告として記録
 		slog.WarnContext(ctx, "request failed due to client error", baseAttrs...)
 	default:
@@ -152,6 +173,32 @@ func (i *ErrorInterceptor) buildErrorResponse(ctx context.Context, err error, co
 	return newError
 }
 
+// handleError はハンドラーが返したエラーを記録し、クライアントに返すエラーに変換する。
+// UnaryとストリーミングのどちらのRPCでも同じ形でエラーを返す
+func (i *ErrorInterceptor) handleError(ctx context.Context, err error, procedure string, peer connect.Peer) *connect.Error {
+	// 必要に応じてConnectエラーに変換
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) {
+		connectErr = i.connectError(err)
+	}
+
+	code := connectErr.Code()
+
+	// Sentryにエラーをキャプチャ（サーバー側エラーのみ）
+	var eventID string
+	if shouldCaptureInSentry(code) {
+		eventID = i.captureError(ctx, err, code, procedure, peer)
+	}
+
+	// エラーをログ出力
+	i.logError(ctx, err, code, eventID)
+
+	// エラーレスポンスを構築して返却
+	newError := i.buildErrorResponse(ctx, err, code, eventID)
+	copyMeta(newError, connectErr)
+	return newError
+}
+
 // WrapUnary はエラーハンドリングを伴うUnary RPC呼び出しをラップする
 func (i *ErrorInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
@@ -159,26 +206,7 @@ func (i *ErrorInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 		if err == nil {
 			return res, nil
 		}
-
-		// 必要に応じてConnectエラーに変換
-		var connectErr *connect.Error
-		if !errors.As(err, &connectErr) {
-			connectErr = i.connectError(err)
-		}
-
-		code := connectErr.Code()
-
-		// Sentryにエラーをキャプチャ（サーバー側エラーのみ）
-		var eventID string
-		if shouldCaptureInSentry(code) {
-			eventID = i.captureError(ctx, err, code, req.Spec().Procedure, req.Peer())
-		}
-
-		// エラーをログ出力
-		i.logError(ctx, err, code, eventID)
-
-		// エラーレスポンスを構築して返却
-		return nil, i.buildErrorResponse(ctx, err, code, eventID)
+		return nil, i.handleError(ctx, err, req.Spec().Procedure, req.Peer())
 	}
 }
 
@@ -187,43 +215,15 @@ func (i *ErrorInterceptor) WrapStreamingClient(next connect.StreamingClientFunc)
 	return next
 }
 
-// WrapStreamingHandler はエラーハンドリングを伴うストリーミングハンドラー呼び出しをラップする
+// WrapStreamingHandler はエラーハンドリングを伴うストリーミングハンドラー呼び出しをラップする。
+// ストリームの途中で終わった場合も、エラーは最後のメッセージとしてクライアントに届く
 func (i *ErrorInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
 	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
 		err := next(ctx, conn)
 		if err == nil {
 			return nil
 		}
-
-		// 必要に応じてConnectエラーに変換
-		var connectErr *connect.Error
-		if !errors.As(err, &connectErr) {
-			connectErr = i.connectError(err)
-		}
-
-		code := connectErr.Code()
-
-		// Sentryにエラーをキャプチャ（サーバー側エラーのみ）
-		var eventID string
-		if shouldCaptureInSentry(code) {
-			eventID = i.captureError(ctx, err, code, conn.Spec().Procedure, conn.Peer())
-		}
-
-		// エラーをログ出力
-		i.logError(ctx, err, code, eventID)
-
-		// ストリーミングの場合、エラーを直接返す
-		// クライアントはエラー詳細を受信する
-		if i.enableDetailedErrors {
-			return connectErr
-		}
-
-		// 本番環境ではエラー詳細を隠蔽
-		message := fmt.Sprintf("An error occurred. Event ID: %s", eventID)
-		newError := connect.NewError(code, errors.New(message))
-		newError.Meta().Set("Error-ID", eventID)
-
-		return newError
+		return i.handleError(ctx, err, conn.Spec().Procedure, conn.Peer())
 	}
 }
 
@@ -241,3 +241,15 @@ func shouldCaptureInSentry(code connect.Code) bool {
 		return false
 	}
 }
+
+// copyMeta は元のエラーに付与されたメタデータ（Retry-After など）をレスポンスのエラーに引き継ぐ
+func copyMeta(dst, src *connect.Error) {
+	for key, values := range src.Meta() {
+		if dst.Meta().Get(key) != "" {
+			continue
+		}
+		for _, v := range values {
+			dst.Meta().Add(key, v)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package sentry
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/cockroachdb/errors"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeStreamingHandlerConn はハンドラーに渡すだけのストリーム。送受信は行わない
+type fakeStreamingHandlerConn struct{}
+
+func (fakeStreamingHandlerConn) Spec() connect.Spec {
+	return connect.Spec{Procedure: "/test.v1.TestService/Watch", StreamType: connect.StreamTypeServer}
+}
+func (fakeStreamingHandlerConn) Peer() connect.Peer           { return connect.Peer{Addr: "127.0.0.1"} }
+func (fakeStreamingHandlerConn) Receive(any) error            { return nil }
+func (fakeStreamingHandlerConn) RequestHeader() http.Header   { return http.Header{} }
+func (fakeStreamingHandlerConn) Send(any) error               { return nil }
+func (fakeStreamingHandlerConn) ResponseHeader() http.Header  { return http.Header{} }
+func (fakeStreamingHandlerConn) ResponseTrailer() http.Header { return http.Header{} }
+
+func TestErrorInterceptor_WrapStreamingHandler(t *testing.T) {
+	tests := []struct {
+		name                 string
+		enableDetailedErrors bool
+		handlerErr           error
+		wantCode             connect.Code
+		wantMessage          string
+		wantHint             bool
+	}{
+		{
+			name:       "正常系: エラーがなければそのまま返す",
+			handlerErr: nil,
+		},
+		{
+			name: "異常系: ドメインエラーをコードに変換し、ヒントを詳細に載せる",
+			handlerErr: errors.Mark(
+				errors.WithHint(errors.New("room not found"), "部屋が見つかりません。"),
+				domainerrors.ErrNotFound,
+			),
+			wantCode:    connect.CodeNotFound,
+			wantMessage: "An error occurred. Event ID: ",
+			wantHint:    true,
+		},
+		{
+			name: "異常系: 同時編集による競合は ABORTED として返す",
+			handlerErr: errors.Mark(
+				errors.WithHint(errors.New("tenant was updated by another request"), "他の管理者がこのテナントを更新しました。再読み込みしてから編集し直してください。"),
+				domainerrors.ErrConflict,
+			),
+			wantCode:    connect.CodeAborted,
+			wantMessage: "An error occurred. Event ID: ",
+			wantHint:    true,
+		},
+		{
+			name:                 "異常系: 開発環境ではエラーの詳細を返す",
+			enableDetailedErrors: true,
+			handlerErr:           connect.NewError(connect.CodeUnavailable, errors.New("key changes may have been missed")),
+			wantCode:             connect.CodeUnavailable,
+			wantMessage:          "key changes may have been missed",
+		},
+		{
+			name:        "異常系: クライアントの切断はキャンセルとして返す",
+			handlerErr:  errors.Wrap(context.Canceled, "failed to send"),
+			wantCode:    connect.CodeCanceled,
+			wantMessage: "An error occurred. Event ID: ",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := NewErrorInterceptor(tt.enableDetailedErrors)
+			handler := i.WrapStreamingHandler(func(context.Context, connect.StreamingHandlerConn) error {
+				return tt.handlerErr
+			})
+
+			err := handler(context.Background(), fakeStreamingHandlerConn{})
+			if tt.handlerErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			var connectErr *connect.Error
+			require.ErrorAs(t, err, &connectErr)
+			assert.Equal(t, tt.wantCode, connectErr.Code())
+			assert.Contains(t, connectErr.Message(), tt.wantMessage)
+			assert.Equal(t, tt.wantHint, len(connectErr.Details()) > 0)
+		})
+	}
+}
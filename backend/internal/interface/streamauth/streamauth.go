@@ -0,0 +1,73 @@
+package streamauth
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/cockroachdb/errors"
+	"github.com/shibayama-club/keyhub/internal/domain"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+)
+
+// RevalidateInterval はストリームの途中で認証をやり直す間隔。WatchKeys のハートビートと同じ間隔にする
+const RevalidateInterval = 30 * time.Second
+
+var (
+	// ErrCredentialExpired はストリームの途中でセッションかAPIトークンの有効期限が切れたことを表す
+	ErrCredentialExpired = errors.New("credential expired during stream")
+	// ErrCredentialRevoked はストリームの途中でセッションかAPIトークンが無効になったことを表す
+	ErrCredentialRevoked = errors.New("credential revoked during stream")
+)
+
+// ExpiresAt は認証に使ったセッションかAPIトークンの有効期限を返す
+func ExpiresAt(ctx context.Context) (time.Time, bool) {
+	if session, ok := domain.Value[model.AppSession](ctx); ok {
+		return session.ExpiresAt, true
+	}
+	if session, ok := domain.Value[model.ConsoleSession](ctx); ok {
+		return session.ExpiresAt, true
+	}
+	if token, ok := domain.Value[model.APIToken](ctx); ok {
+		return token.ExpiresAt, true
+	}
+	return time.Time{}, false
+}
+
+// Serve は認証済みの ctx で next を呼び、ストリームを認証が有効な間だけ続ける。
+// セッションかAPIトークンの有効期限で打ち切り、interval ごとに revalidate で認証をやり直して、
+// ログアウトや失効で無効になった場合もその時点で打ち切る。打ち切った場合は Unauthenticated を返す
+func Serve(ctx context.Context, interval time.Duration, revalidate func(context.Context) error, next func(context.Context) error) error {
+	expiresAt, ok := ExpiresAt(ctx)
+	if !ok {
+		return next(ctx)
+	}
+	ctx, cancelDeadline := context.WithDeadlineCause(ctx, expiresAt, ErrCredentialExpired)
+	defer cancelDeadline()
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := revalidate(ctx); err != nil {
+					if ctx.Err() == nil {
+						cancel(errors.Mark(errors.Wrap(err, "failed to revalidate credential"), ErrCredentialRevoked))
+					}
+					return
+				}
+			}
+		}
+	}()
+
+	err := next(ctx)
+	if cause := context.Cause(ctx); errors.Is(cause, ErrCredentialExpired) || errors.Is(cause, ErrCredentialRevoked) {
+		return connect.NewError(connect.CodeUnauthenticated, cause)
+	}
+	return err
+}
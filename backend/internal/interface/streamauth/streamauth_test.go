@@ -0,0 +1,161 @@
+package streamauth
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/cockroachdb/errors"
+	"github.com/shibayama-club/keyhub/internal/domain"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServe(t *testing.T) {
+	errHandler := errors.New("handler failed")
+
+	tests := []struct {
+		name           string
+		ctx            func(context.Context) context.Context
+		revalidateErrs []error
+		next           func(context.Context) error
+		wantErrIs      error
+		wantCode       connect.Code
+		// wantCalls は再認証の回数。-1 の場合は確認しない
+		wantCalls int32
+	}{
+		{
+			name: "正常系: 認証情報がなければ再認証せずにそのまま呼ぶ",
+			ctx:  func(ctx context.Context) context.Context { return ctx },
+			next: func(ctx context.Context) error {
+				time.Sleep(50 * time.Millisecond)
+				return nil
+			},
+			wantCalls: 0,
+		},
+		{
+			name: "正常系: 再認証に成功する間はストリームを続ける",
+			ctx: func(ctx context.Context) context.Context {
+				return domain.WithValue(ctx, model.ConsoleSession{ExpiresAt: time.Now().Add(time.Hour)})
+			},
+			next: func(ctx context.Context) error {
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-time.After(100 * time.Millisecond):
+					return nil
+				}
+			},
+			wantCalls: -1,
+		},
+		{
+			name: "正常系: ハンドラーのエラーはそのまま返す",
+			ctx: func(ctx context.Context) context.Context {
+				return domain.WithValue(ctx, model.AppSession{ExpiresAt: time.Now().Add(time.Hour)})
+			},
+			next:      func(ctx context.Context) error { return errHandler },
+			wantErrIs: errHandler,
+			wantCalls: -1,
+		},
+		{
+			name: "異常系: セッションの有効期限で打ち切る",
+			ctx: func(ctx context.Context) context.Context {
+				return domain.WithValue(ctx, model.AppSession{ExpiresAt: time.Now().Add(30 * time.Millisecond)})
+			},
+			next: func(ctx context.Context) error {
+				<-ctx.Done()
+				return nil
+			},
+			wantErrIs: ErrCredentialExpired,
+			wantCode:  connect.CodeUnauthenticated,
+			wantCalls: -1,
+		},
+		{
+			name: "異常系: 再認証に失敗した時点で打ち切る",
+			ctx: func(ctx context.Context) context.Context {
+				return domain.WithValue(ctx, model.APIToken{ExpiresAt: time.Now().Add(time.Hour)})
+			},
+			revalidateErrs: []error{nil, errors.New("token revoked")},
+			next: func(ctx context.Context) error {
+				<-ctx.Done()
+				return nil
+			},
+			wantErrIs: ErrCredentialRevoked,
+			wantCode:  connect.CodeUnauthenticated,
+			wantCalls: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			revalidate := func(ctx context.Context) error {
+				n := calls.Add(1)
+				if int(n) <= len(tt.revalidateErrs) {
+					return tt.revalidateErrs[n-1]
+				}
+				return nil
+			}
+
+			ctx, cancel := context.WithTimeout(tt.ctx(context.Background()), 5*time.Second)
+			defer cancel()
+
+			err := Serve(ctx, 20*time.Millisecond, revalidate, tt.next)
+			if tt.wantCalls >= 0 {
+				assert.Equal(t, tt.wantCalls, calls.Load())
+			}
+			if tt.wantErrIs == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.True(t, errors.Is(err, tt.wantErrIs), "expected error type %v, got %v", tt.wantErrIs, err)
+			if tt.wantCode != 0 {
+				assert.Equal(t, tt.wantCode, connect.CodeOf(err))
+			}
+		})
+	}
+}
+
+func TestExpiresAt(t *testing.T) {
+	expiresAt := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		want   time.Time
+		wantOK bool
+	}{
+		{
+			name:   "正常系: アプリのセッション",
+			ctx:    domain.WithValue(context.Background(), model.AppSession{ExpiresAt: expiresAt}),
+			want:   expiresAt,
+			wantOK: true,
+		},
+		{
+			name:   "正常系: コンソールのセッション",
+			ctx:    domain.WithValue(context.Background(), model.ConsoleSession{ExpiresAt: expiresAt}),
+			want:   expiresAt,
+			wantOK: true,
+		},
+		{
+			name:   "正常系: APIトークン",
+			ctx:    domain.WithValue(context.Background(), model.APIToken{ExpiresAt: expiresAt}),
+			want:   expiresAt,
+			wantOK: true,
+		},
+		{
+			name: "正常系: 認証情報がない",
+			ctx:  context.Background(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ExpiresAt(tt.ctx)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package apitoken
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+)
+
+// Create はトークンを発行し、保存したトークンと利用者へ一度だけ返すトークン本体を返す。
+// App と Console のどちらから発行しても同じ手順で検証・保存するため、発行は必ずこの関数を通す
+func Create(
+	ctx context.Context,
+	repo repository.Repository,
+	owner model.APITokenOwner,
+	name string,
+	scopes []string,
+	expiresAt time.Time,
+) (model.APIToken, string, error) {
+	tokenName, err := model.NewAPITokenName(name)
+	if err != nil {
+		return model.APIToken{}, "", errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid API token name")
+	}
+
+	tokenScopes, err := model.NewAPITokenScopes(scopes)
+	if err != nil {
+		return model.APIToken{}, "", errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid API token scopes")
+	}
+
+	token, displayPrefix, err := model.GenerateAPITokenSecret(owner.Kind.Prefix())
+	if err != nil {
+		return model.APIToken{}, "", errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to generate API token")
+	}
+
+	apiToken, err := model.NewAPIToken(owner, tokenName, displayPrefix, tokenScopes, expiresAt)
+	if err != nil {
+		return model.APIToken{}, "", errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "failed to create API token")
+	}
+
+	err = repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
+		err := tx.CreateAPIToken(ctx, repository.CreateAPITokenArg{
+			Token:     apiToken,
+			TokenHash: model.HashAPIToken(token),
+		})
+		if err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to create API token in repository")
+		}
+		return nil
+	})
+	if err != nil {
+		return model.APIToken{}, "", err
+	}
+
+	return apiToken, token, nil
+}
+
+// List は持ち主が発行したトークンの一覧を返す
+func List(ctx context.Context, repo repository.Repository, owner model.APITokenOwner) ([]model.APIToken, error) {
+	var (
+		tokens []model.APIToken
+		err    error
+	)
+	if owner.Kind == model.APITokenOwnerUser {
+		tokens, err = repo.ListAPITokensByUser(ctx, owner.UserID)
+	} else {
+		tokens, err = repo.ListAPITokensByOrganization(ctx, owner.OrganizationID)
+	}
+	if err != nil {
+		return nil, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to list API tokens")
+	}
+
+	return tokens, nil
+}
+
+// Revoke は持ち主が発行したトークンを無効化する。他の持ち主のトークンは見つからないものとして扱う
+func Revoke(ctx context.Context, repo repository.Repository, owner model.APITokenOwner, tokenID string) error {
+	id, err := model.ParseAPITokenID(tokenID)
+	if err != nil {
+		return errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid API token ID")
+	}
+
+	return repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
+		var revoked int64
+		if owner.Kind == model.APITokenOwnerUser {
+			revoked, err = tx.RevokeAPITokenByUser(ctx, owner.UserID, id)
+		} else {
+			revoked, err = tx.RevokeAPITokenByOrganization(ctx, owner.OrganizationID, id)
+		}
+		if err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to revoke API token")
+		}
+		if revoked == 0 {
+			return errors.WithHint(
+				errors.Mark(errors.New("API token not found"), domainerrors.ErrNotFound),
+				"指定されたAPIトークンが見つかりません。",
+			)
+		}
+		return nil
+	})
+}
+
+// Authenticate は Authorization ヘッダーで送られたトークンが kind の持ち主の有効なトークンかを検証し、
+// 必要に応じて最終利用日時を更新する
+func Authenticate(ctx context.Context, repo repository.Repository, kind model.APITokenOwnerKind, token string) (model.APIToken, error) {
+	if !kind.Matches(token) {
+		return model.APIToken{}, errors.Mark(errors.New("unsupported API token format"), domainerrors.ErrUnAuthorized)
+	}
+
+	apiToken, err := repo.GetAPITokenByHash(ctx, model.HashAPIToken(token))
+	if err != nil {
+		return model.APIToken{}, errors.Wrap(errors.Mark(err, domainerrors.ErrUnAuthorized), "API token not found")
+	}
+
+	if !apiToken.IsValid() || apiToken.OwnerKind() != kind {
+		return model.APIToken{}, errors.WithHint(
+			errors.Mark(errors.New("API token is revoked or expired"), domainerrors.ErrUnAuthorized),
+			"APIトークンが無効または期限切れです。",
+		)
+	}
+
+	if apiToken.ShouldTouch() {
+		// 最終利用日時の更新に失敗しても認証自体は成功させる
+		if err := repo.TouchAPIToken(ctx, apiToken.ID); err != nil {
+			slog.WarnContext(ctx, "failed to touch API token", "error", err)
+		}
+	}
+
+	return apiToken, nil
+}
@@ -0,0 +1,269 @@
+package apitoken
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+	"github.com/shibayama-club/keyhub/internal/domain/repository/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func withTx(t *testing.T, m *mock.MockRepository, setup func(*mock.MockTransaction)) {
+	m.EXPECT().
+		WithTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context, repository.Transaction) error) error {
+			mockTx := mock.NewMockTransaction(gomock.NewController(t))
+			setup(mockTx)
+			return fn(ctx, mockTx)
+		})
+}
+
+func TestCreate(t *testing.T) {
+	userID := model.UserID(uuid.New())
+	orgID := model.OrganizationID(uuid.New())
+	expiresAt := time.Now().Add(90 * 24 * time.Hour)
+
+	tests := []struct {
+		name       string
+		owner      model.APITokenOwner
+		tokenName  string
+		scopes     []string
+		setupMock  func(*testing.T, *mock.MockRepository)
+		wantPrefix string
+		wantUser   bool
+		wantErr    error
+	}{
+		{
+			name:      "正常系: ユーザーのトークンは App 用の接頭辞で発行する",
+			owner:     model.NewUserAPITokenOwner(userID, orgID),
+			tokenName: "kiosk",
+			scopes:    []string{"keys:read"},
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				withTx(t, m, func(tx *mock.MockTransaction) {
+					tx.EXPECT().CreateAPIToken(gomock.Any(), gomock.Any()).Return(nil)
+				})
+			},
+			wantPrefix: model.APITokenPrefixApp,
+			wantUser:   true,
+		},
+		{
+			name:      "正常系: 組織のトークンは Console 用の接頭辞で発行する",
+			owner:     model.NewOrganizationAPITokenOwner(orgID),
+			tokenName: "sync",
+			scopes:    []string{"rooms:write"},
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				withTx(t, m, func(tx *mock.MockTransaction) {
+					tx.EXPECT().CreateAPIToken(gomock.Any(), gomock.Any()).Return(nil)
+				})
+			},
+			wantPrefix: model.APITokenPrefixConsole,
+		},
+		{
+			name:      "異常系: 名前が空",
+			owner:     model.NewOrganizationAPITokenOwner(orgID),
+			scopes:    []string{"keys:read"},
+			setupMock: func(*testing.T, *mock.MockRepository) {},
+			wantErr:   domainerrors.ErrValidation,
+		},
+		{
+			name:      "異常系: 未知のスコープ",
+			owner:     model.NewUserAPITokenOwner(userID, orgID),
+			tokenName: "kiosk",
+			scopes:    []string{"keys:delete"},
+			setupMock: func(*testing.T, *mock.MockRepository) {},
+			wantErr:   domainerrors.ErrValidation,
+		},
+		{
+			name:      "異常系: 保存に失敗",
+			owner:     model.NewOrganizationAPITokenOwner(orgID),
+			tokenName: "sync",
+			scopes:    []string{"keys:read"},
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				withTx(t, m, func(tx *mock.MockTransaction) {
+					tx.EXPECT().CreateAPIToken(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+				})
+			},
+			wantErr: domainerrors.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := mock.NewMockRepository(gomock.NewController(t))
+			tt.setupMock(t, m)
+
+			apiToken, token, err := Create(context.Background(), m, tt.owner, tt.tokenName, tt.scopes, expiresAt)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "expected error type %v, got %v", tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, tt.owner.Kind.Matches(token))
+			assert.Contains(t, token, tt.wantPrefix)
+			assert.Equal(t, tt.owner.Kind, apiToken.OwnerKind())
+			assert.Equal(t, tt.wantUser, apiToken.UserID != nil)
+			assert.Equal(t, orgID, apiToken.OrganizationID)
+		})
+	}
+}
+
+func TestRevoke(t *testing.T) {
+	userID := model.UserID(uuid.New())
+	orgID := model.OrganizationID(uuid.New())
+	tokenID := model.APITokenID(uuid.New())
+
+	tests := []struct {
+		name      string
+		owner     model.APITokenOwner
+		tokenID   string
+		setupMock func(*testing.T, *mock.MockRepository)
+		wantErr   error
+	}{
+		{
+			name:    "正常系: ユーザーは自分のトークンを無効化する",
+			owner:   model.NewUserAPITokenOwner(userID, orgID),
+			tokenID: tokenID.String(),
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				withTx(t, m, func(tx *mock.MockTransaction) {
+					tx.EXPECT().RevokeAPITokenByUser(gomock.Any(), userID, tokenID).Return(int64(1), nil)
+				})
+			},
+		},
+		{
+			name:    "正常系: 組織は組織のトークンを無効化する",
+			owner:   model.NewOrganizationAPITokenOwner(orgID),
+			tokenID: tokenID.String(),
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				withTx(t, m, func(tx *mock.MockTransaction) {
+					tx.EXPECT().RevokeAPITokenByOrganization(gomock.Any(), orgID, tokenID).Return(int64(1), nil)
+				})
+			},
+		},
+		{
+			name:      "異常系: IDの形式が不正",
+			owner:     model.NewOrganizationAPITokenOwner(orgID),
+			tokenID:   "not-a-uuid",
+			setupMock: func(*testing.T, *mock.MockRepository) {},
+			wantErr:   domainerrors.ErrValidation,
+		},
+		{
+			name:    "異常系: 他の持ち主のトークンは見つからない",
+			owner:   model.NewUserAPITokenOwner(userID, orgID),
+			tokenID: tokenID.String(),
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				withTx(t, m, func(tx *mock.MockTransaction) {
+					tx.EXPECT().RevokeAPITokenByUser(gomock.Any(), userID, tokenID).Return(int64(0), nil)
+				})
+			},
+			wantErr: domainerrors.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := mock.NewMockRepository(gomock.NewController(t))
+			tt.setupMock(t, m)
+
+			err := Revoke(context.Background(), m, tt.owner, tt.tokenID)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "expected error type %v, got %v", tt.wantErr, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	userID := model.UserID(uuid.New())
+	usedAt := time.Now()
+	userToken := model.APIToken{
+		ID:         model.APITokenID(uuid.New()),
+		UserID:     &userID,
+		ExpiresAt:  time.Now().Add(time.Hour),
+		LastUsedAt: &usedAt,
+	}
+	orgToken := model.APIToken{
+		ID:        model.APITokenID(uuid.New()),
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+	expired := orgToken
+	expired.ExpiresAt = time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name      string
+		kind      model.APITokenOwnerKind
+		token     string
+		setupMock func(*mock.MockRepository)
+		want      model.APIToken
+		wantErr   error
+	}{
+		{
+			name:  "正常系: ユーザーのトークン",
+			kind:  model.APITokenOwnerUser,
+			token: "khu_secret",
+			setupMock: func(m *mock.MockRepository) {
+				m.EXPECT().GetAPITokenByHash(gomock.Any(), model.HashAPIToken("khu_secret")).Return(userToken, nil)
+			},
+			want: userToken,
+		},
+		{
+			name:  "正常系: 初めて使う組織のトークンは最終利用日時を更新する",
+			kind:  model.APITokenOwnerOrganization,
+			token: "khc_secret",
+			setupMock: func(m *mock.MockRepository) {
+				m.EXPECT().GetAPITokenByHash(gomock.Any(), model.HashAPIToken("khc_secret")).Return(orgToken, nil)
+				m.EXPECT().TouchAPIToken(gomock.Any(), orgToken.ID).Return(nil)
+			},
+			want: orgToken,
+		},
+		{
+			name:      "異常系: 接頭辞が持ち主の種類と合わない",
+			kind:      model.APITokenOwnerUser,
+			token:     "khc_secret",
+			setupMock: func(*mock.MockRepository) {},
+			wantErr:   domainerrors.ErrUnAuthorized,
+		},
+		{
+			name:  "異常系: 保存されている持ち主の種類が合わない",
+			kind:  model.APITokenOwnerOrganization,
+			token: "khc_secret",
+			setupMock: func(m *mock.MockRepository) {
+				m.EXPECT().GetAPITokenByHash(gomock.Any(), gomock.Any()).Return(userToken, nil)
+			},
+			wantErr: domainerrors.ErrUnAuthorized,
+		},
+		{
+			name:  "異常系: 期限切れ",
+			kind:  model.APITokenOwnerOrganization,
+			token: "khc_secret",
+			setupMock: func(m *mock.MockRepository) {
+				m.EXPECT().GetAPITokenByHash(gomock.Any(), gomock.Any()).Return(expired, nil)
+			},
+			wantErr: domainerrors.ErrUnAuthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := mock.NewMockRepository(gomock.NewController(t))
+			tt.setupMock(m)
+
+			got, err := Authenticate(context.Background(), m, tt.kind, tt.token)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "expected error type %v, got %v", tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package app
+
+import (
+	"context"
+
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/usecase/apitoken"
+	"github.com/shibayama-club/keyhub/internal/usecase/app/dto"
+)
+
+func (u *UseCase) CreateAPIToken(ctx context.Context, input dto.CreateAPITokenInput) (dto.CreateAPITokenOutput, error) {
+	apiToken, token, err := apitoken.Create(ctx, u.repo, model.NewUserAPITokenOwner(input.UserID, input.OrganizationID), input.Name, input.Scopes, input.ExpiresAt)
+	if err != nil {
+		return dto.CreateAPITokenOutput{}, err
+	}
+
+	return dto.CreateAPITokenOutput{
+		APIToken: apiToken,
+		Token:    token,
+	}, nil
+}
+
+func (u *UseCase) ListAPITokens(ctx context.Context, userID model.UserID) ([]model.APIToken, error) {
+	return apitoken.List(ctx, u.repo, model.APITokenOwner{Kind: model.APITokenOwnerUser, UserID: userID})
+}
+
+func (u *UseCase) RevokeAPIToken(ctx context.Context, userID model.UserID, tokenID string) error {
+	return apitoken.Revoke(ctx, u.repo, model.APITokenOwner{Kind: model.APITokenOwnerUser, UserID: userID}, tokenID)
+}
+
+// AuthenticateAPIToken は Authorization ヘッダーで送られたAPIトークンを検証し、必要に応じて最終利用日時を更新する
+func (u *UseCase) AuthenticateAPIToken(ctx context.Context, token string) (model.APIToken, error) {
+	return apitoken.Authenticate(ctx, u.repo, model.APITokenOwnerUser, token)
+}
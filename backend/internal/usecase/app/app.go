@@ -5,27 +5,43 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/shibayama-club/keyhub/cmd/config"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
 	"github.com/shibayama-club/keyhub/internal/domain/repository"
 	"github.com/shibayama-club/keyhub/internal/infrastructure/auth/google"
+	"github.com/shibayama-club/keyhub/internal/infrastructure/auth/passkey"
 	"github.com/shibayama-club/keyhub/internal/usecase/app/iface"
 )
 
 type UseCase struct {
-	repo         repository.Repository
-	config       config.Config
-	oauthService *google.OAuthService
+	repo           repository.Repository
+	config         config.Config
+	oauthService   *google.OAuthService
+	passkeyService *passkey.Service
+	lifetime       model.SessionLifetime
+	pageTokens     model.PageTokenCodec
 }
 
 var _ iface.IUseCase = (*UseCase)(nil)
 
-func NewUseCase(ctx context.Context, repo repository.Repository, cf config.Config, oauthService *google.OAuthService) (iface.IUseCase, error) {
+func NewUseCase(ctx context.Context, repo repository.Repository, cf config.Config, oauthService *google.OAuthService, passkeyService *passkey.Service, pageTokens model.PageTokenCodec) (iface.IUseCase, error) {
 	if oauthService == nil {
 		return nil, errors.New("oauth service is required")
 	}
+	if passkeyService == nil {
+		return nil, errors.New("passkey service is required")
+	}
+
+	lifetime, err := model.NewSessionLifetime(cf.Session.App.IdleTimeout, cf.Session.App.AbsoluteTimeout)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid app session lifetime")
+	}
 
 	return &UseCase{
-		repo:         repo,
-		config:       cf,
-		oauthService: oauthService,
+		repo:           repo,
+		config:         cf,
+		oauthService:   oauthService,
+		passkeyService: passkeyService,
+		lifetime:       lifetime,
+		pageTokens:     pageTokens,
 	}, nil
 }
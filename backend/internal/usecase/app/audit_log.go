@@ -0,0 +1,16 @@
+package app
+
+import (
+	"context"
+
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+	"github.com/shibayama-club/keyhub/internal/usecase/audit"
+)
+
+// RecordAuditLog は組織の監査ログの末尾に記録を追記する
+func (u *UseCase) RecordAuditLog(ctx context.Context, log model.AuditLog) error {
+	return u.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
+		return audit.AppendLog(ctx, tx, log)
+	})
+}
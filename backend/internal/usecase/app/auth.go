@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/hex"
+	"log/slog"
 	"time"
 
 	"github.com/cockroachdb/errors"
@@ -11,9 +12,16 @@ import (
 	"github.com/shibayama-club/keyhub/internal/domain/model"
 	"github.com/shibayama-club/keyhub/internal/domain/repository"
 	"github.com/shibayama-club/keyhub/internal/infrastructure/auth/google"
+	"github.com/shibayama-club/keyhub/internal/usecase/app/dto"
 )
 
-func (u *UseCase) StartGoogleLogin(ctx context.Context) (authURL string, err error) {
+// StartGoogleLogin はログイン先の組織を決め、コールバックで引き継ぐためにOAuthの状態と一緒に保存する
+func (u *UseCase) StartGoogleLogin(ctx context.Context, organizationSlug string) (authURL string, err error) {
+	organization, err := u.resolveOrganization(ctx, organizationSlug)
+	if err != nil {
+		return "", err
+	}
+
 	codeVerifier, err := google.GenerateCodeVerifier()
 	if err != nil {
 		return "", errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to generate code verifier")
@@ -36,7 +44,7 @@ func (u *UseCase) StartGoogleLogin(ctx context.Context) (authURL string, err err
 		return "", errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to generate nonce")
 	}
 
-	oauthState, err := model.NewOAuthState(stateValue, codeVerifier, nonce)
+	oauthState, err := model.NewOAuthState(stateValue, codeVerifier, nonce, organization.ID)
 	if err != nil {
 		return "", errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid OAuth state")
 	}
@@ -53,31 +61,31 @@ func (u *UseCase) StartGoogleLogin(ctx context.Context) (authURL string, err err
 	return authURL, nil
 }
 
-func (u *UseCase) GoogleCallback(ctx context.Context, code, state string) (sessionID string, err error) {
+func (u *UseCase) GoogleCallback(ctx context.Context, code, state string, client model.SessionClient) (dto.LoginOutput, error) {
 	oauthState, err := u.repo.GetOAuthState(ctx, state)
 	if err != nil {
-		return "", errors.Wrap(errors.Mark(err, domainerrors.ErrUnAuthorized), "invalid or expired state")
+		return dto.LoginOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrUnAuthorized), "invalid or expired state")
 	}
 
 	if !oauthState.IsValid() {
-		return "", errors.WithHint(
+		return dto.LoginOutput{}, errors.WithHint(
 			errors.Mark(errors.New("OAuth state is invalid"), domainerrors.ErrUnAuthorized),
 			"認証フローが無効です。最初からやり直してください。",
 		)
 	}
 
 	if err := u.repo.ConsumeOAuthState(ctx, state); err != nil {
-		return "", errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to consume OAuth state")
+		return dto.LoginOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to consume OAuth state")
 	}
 
 	tokens, err := u.oauthService.ExchangeCode(ctx, code, oauthState.CodeVerifier)
 	if err != nil {
-		return "", errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to exchange code for tokens")
+		return dto.LoginOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to exchange code for tokens")
 	}
 
 	claims, err := u.oauthService.VerifyIDToken(ctx, tokens.IDToken, oauthState.Nonce)
 	if err != nil {
-		return "", errors.Wrap(errors.Mark(err, domainerrors.ErrUnAuthorized), "failed to verify ID token")
+		return dto.LoginOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrUnAuthorized), "failed to verify ID token")
 	}
 
 	email, name, picture, providerSub := claims.GetUserInfo()
@@ -127,26 +135,39 @@ func (u *UseCase) GoogleCallback(ctx context.Context, code, state string) (sessi
 		return nil
 	})
 	if err != nil {
-		return "", err
+		return dto.LoginOutput{}, err
 	}
 
+	return u.createSession(ctx, userID, oauthState.OrganizationID, client)
+}
+
+// createSession はログインしたユーザーに、指定した組織の app_sess_ セッションとCSRFトークンを発行する
+func (u *UseCase) createSession(ctx context.Context, userID model.UserID, organizationID model.OrganizationID, client model.SessionClient) (dto.LoginOutput, error) {
 	sessionBytes := make([]byte, 32)
 	if _, err := rand.Read(sessionBytes); err != nil {
-		return "", errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to generate session ID")
+		return dto.LoginOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to generate session ID")
 	}
 	sessionIDStr := "app_sess_" + hex.EncodeToString(sessionBytes)
 
 	appSessionID, err := model.NewAppSessionID(sessionIDStr)
 	if err != nil {
-		return "", errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "failed to create session ID")
+		return dto.LoginOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "failed to create session ID")
 	}
 
-	expiresAt := time.Now().Add(24 * time.Hour)
+	csrfToken, err := newCSRFToken()
+	if err != nil {
+		return dto.LoginOutput{}, err
+	}
+
+	expiresAt := u.lifetime.ExpiresAt(time.Now())
 	err = u.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
 		err := tx.CreateAppSession(ctx, repository.CreateAppSessionArg{
-			SessionID: appSessionID,
-			UserID:    userID,
-			ExpiresAt: expiresAt,
+			SessionID:      appSessionID,
+			UserID:         userID,
+			OrganizationID: organizationID,
+			ExpiresAt:      expiresAt,
+			CSRFToken:      csrfToken,
+			Client:         client,
 		})
 		if err != nil {
 			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to create session")
@@ -154,36 +175,67 @@ func (u *UseCase) GoogleCallback(ctx context.Context, code, state string) (sessi
 		return nil
 	})
 	if err != nil {
-		return "", err
+		return dto.LoginOutput{}, err
 	}
 
-	return sessionIDStr, nil
+	return dto.LoginOutput{
+		SessionID: sessionIDStr,
+		ExpiresAt: expiresAt,
+	}, nil
 }
 
-func (u *UseCase) GetMe(ctx context.Context, sessionID string) (model.User, error) {
+// ValidateSession はセッションの有効性を確認し、必要に応じて最終アクセス日時とクライアント情報を更新する。
+// 有効期限が近づいている場合はアイドルタイムアウト分だけ延長する（絶対タイムアウトは超えない）
+func (u *UseCase) ValidateSession(ctx context.Context, sessionID string, client model.SessionClient) (dto.ValidateSessionOutput, error) {
 	appSessionID, err := model.NewAppSessionID(sessionID)
 	if err != nil {
-		return model.User{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid session ID")
+		return dto.ValidateSessionOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid session ID")
 	}
 
 	session, err := u.repo.GetAppSession(ctx, appSessionID)
 	if err != nil {
-		return model.User{}, errors.Wrap(errors.Mark(err, domainerrors.ErrNotFound), "session not found")
+		return dto.ValidateSessionOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrNotFound), "session not found")
 	}
 
 	if !session.IsValid() {
-		return model.User{}, errors.WithHint(
+		return dto.ValidateSessionOutput{}, errors.WithHint(
 			errors.Mark(errors.New("session is invalid or expired"), domainerrors.ErrUnAuthorized),
 			"セッションが無効または期限切れです。再度ログインしてください。",
 		)
 	}
 
-	user, err := u.repo.GetUser(ctx, session.UserID)
-	if err != nil {
-		return model.User{}, errors.Wrap(errors.Mark(err, domainerrors.ErrNotFound), "user not found")
+	// CSRFトークンの導入前に発行したセッションはトークンを持たず、更新系RPCをすべて拒否されてしまうため、ここで発行する
+	if !session.HasCSRFToken() {
+		token, err := newCSRFToken()
+		if err != nil {
+			return dto.ValidateSessionOutput{}, err
+		}
+		stored, err := u.repo.EnsureAppSessionCSRFToken(ctx, appSessionID, token)
+		if err != nil {
+			return dto.ValidateSessionOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to issue CSRF token")
+		}
+		session.CSRFToken = &stored
 	}
 
-	return user, nil
+	if session.ShouldTouch(client) {
+		// 最終アクセス日時の更新に失敗しても認証自体は成功させる
+		if err := u.repo.TouchAppSession(ctx, appSessionID, client); err != nil {
+			slog.WarnContext(ctx, "failed to touch app session", "error", err)
+		}
+	}
+
+	expiresAt, renewed := u.lifetime.Renew(session.CreatedAt, session.ExpiresAt, time.Now())
+	if renewed {
+		if err := u.repo.ExtendAppSession(ctx, appSessionID, expiresAt); err != nil {
+			return dto.ValidateSessionOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to extend session")
+		}
+		session.ExpiresAt = expiresAt
+	}
+
+	return dto.ValidateSessionOutput{
+		Session: session,
+		Renewed: renewed,
+	}, nil
 }
 
 func (u *UseCase) GetUserByID(ctx context.Context, userID model.UserID) (model.User, error) {
@@ -210,3 +262,12 @@ func (u *UseCase) Logout(ctx context.Context, sessionID string) error {
 
 	return nil
 }
+
+// newCSRFToken は GetMe で返し、更新系RPCのヘッダーで送り返してもらうCSRFトークンを作る
+func newCSRFToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to generate CSRF token")
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,88 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/shibayama-club/keyhub/cmd/config"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUseCase_ValidateSession_CSRFToken(t *testing.T) {
+	client := model.NewSessionClient("Mozilla/5.0", "203.0.113.10")
+	stored := "stored-token"
+	newSession := func(csrfToken *string) model.AppSession {
+		return model.AppSession{
+			SessionID:      "session-1",
+			UserID:         model.UserID(uuid.New()),
+			OrganizationID: model.OrganizationID(uuid.New()),
+			CreatedAt:      time.Now(),
+			ExpiresAt:      time.Now().Add(time.Hour),
+			CSRFToken:      csrfToken,
+			Client:         client,
+			LastSeenAt:     time.Now(),
+		}
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func(*mock.MockRepository)
+		want      string
+		wantErr   error
+	}{
+		{
+			name: "正常系: 発行済みのCSRFトークンはそのまま返す",
+			setupMock: func(m *mock.MockRepository) {
+				m.EXPECT().GetAppSession(gomock.Any(), model.AppSessionID("session-1")).Return(newSession(&stored), nil)
+			},
+			want: stored,
+		},
+		{
+			name: "正常系: CSRFトークンを持たない既存のセッションには発行して保存する",
+			setupMock: func(m *mock.MockRepository) {
+				m.EXPECT().GetAppSession(gomock.Any(), model.AppSessionID("session-1")).Return(newSession(nil), nil)
+				m.EXPECT().EnsureAppSessionCSRFToken(gomock.Any(), model.AppSessionID("session-1"), gomock.Any()).Return(stored, nil)
+			},
+			want: stored,
+		},
+		{
+			name: "異常系: CSRFトークンの保存に失敗した",
+			setupMock: func(m *mock.MockRepository) {
+				m.EXPECT().GetAppSession(gomock.Any(), model.AppSessionID("session-1")).Return(newSession(nil), nil)
+				m.EXPECT().EnsureAppSessionCSRFToken(gomock.Any(), model.AppSessionID("session-1"), gomock.Any()).Return("", errors.New("db error"))
+			},
+			wantErr: domainerrors.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRepo := mock.NewMockRepository(ctrl)
+			tt.setupMock(mockRepo)
+
+			u := &UseCase{
+				repo:     mockRepo,
+				config:   config.Config{},
+				lifetime: model.SessionLifetime{IdleTimeout: time.Hour, AbsoluteTimeout: 24 * time.Hour},
+			}
+
+			got, err := u.ValidateSession(context.Background(), "session-1", client)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "expected error type %v, got %v", tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, got.Session.CSRFToken)
+			assert.Equal(t, tt.want, *got.Session.CSRFToken)
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+)
+
+type CreateAPITokenInput struct {
+	UserID model.UserID
+	// OrganizationID は発行元セッションの組織。トークンもこの組織のデータにのみアクセスできる
+	OrganizationID model.OrganizationID
+	Name           string
+	Scopes         []string
+	ExpiresAt      time.Time
+}
+
+type CreateAPITokenOutput struct {
+	APIToken model.APIToken
+	// Token は発行したトークン本体。保存しないため再取得はできない
+	Token string
+}
@@ -0,0 +1,19 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+)
+
+// LoginOutput はログイン完了時に発行したセッション。Cookieに設定して返す
+type LoginOutput struct {
+	SessionID string
+	ExpiresAt time.Time
+}
+
+type ValidateSessionOutput struct {
+	Session model.AppSession
+	// Renewed は有効期限がスライディング更新されたかどうか。true の場合はCookieを再発行する
+	Renewed bool
+}
@@ -0,0 +1,11 @@
+package dto
+
+import "github.com/shibayama-club/keyhub/internal/domain/model"
+
+type UpdateNotificationPreferencesInput struct {
+	UserID model.UserID
+	// Locale が空の場合は組織の言語設定に従う
+	Locale string
+	// EmailEnabled は種類ごとのメールの受け取り。指定しなかった種類は受け取る
+	EmailEnabled map[string]bool
+}
@@ -0,0 +1,26 @@
+package dto
+
+import "github.com/shibayama-club/keyhub/internal/domain/model"
+
+// BeginPasskeyCeremonyOutput は開始したセレモニーのIDと、ブラウザの WebAuthn API に渡すオプション(JSON)
+type BeginPasskeyCeremonyOutput struct {
+	CeremonyID string
+	Options    []byte
+}
+
+type FinishPasskeyRegistrationInput struct {
+	UserID     model.UserID
+	CeremonyID string
+	Name       string
+	// Credential は navigator.credentials.create() の結果をJSONにしたもの
+	Credential []byte
+}
+
+type FinishPasskeyLoginInput struct {
+	CeremonyID string
+	// Credential は navigator.credentials.get() の結果をJSONにしたもの
+	Credential []byte
+	// OrganizationSlug はログインする組織。空の場合は組織が1つだけの環境に限りその組織を使う
+	OrganizationSlug string
+	Client           model.SessionClient
+}
@@ -0,0 +1,19 @@
+package dto
+
+import "github.com/shibayama-club/keyhub/internal/domain/model"
+
+// GetRoomsByTenantInput はテナントの部屋の一覧の条件。MinCapacity 以上の収容人数で、
+// Equipment と Accessibility をすべて持つ部屋に絞り込む。ゼロ値の条件は絞り込みに使わない
+type GetRoomsByTenantInput struct {
+	TenantID      model.TenantID
+	UserID        model.UserID
+	MinCapacity   int32
+	Equipment     []string
+	Accessibility []string
+}
+
+type RoomOutput struct {
+	Room model.Room
+	// CanBorrowKeys は鍵の貸出がグループに限定されている場合に、ユーザーがそのグループに属するかを表す
+	CanBorrowKeys bool
+}
@@ -1,6 +1,10 @@
 package dto
 
-import "time"
+import (
+	"time"
+
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+)
 
 type GetTenantByJoinCodeOutput struct {
 	ID          string
@@ -16,10 +20,23 @@ type TenantOutput struct {
 	Description    string
 	TenantType     string
 	MemberCount    int32
+	ArchivedAt     *time.Time
 	CreatedAt      time.Time
 	UpdatedAt      time.Time
 }
 
+// GetMyTenantsInput は参加しているテナントの一覧の条件。空文字の条件は絞り込みに使わない
+type GetMyTenantsInput struct {
+	UserID     model.UserID
+	TenantType string
+	NamePrefix string
+	Order      string
+	PageSize   int32
+	PageToken  string
+}
+
 type GetMyTenantsOutput struct {
 	Tenants []TenantOutput
+	// NextPageToken は続きがある場合に次の取得で指定するトークン。最後のページでは空
+	NextPageToken string
 }
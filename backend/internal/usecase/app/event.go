@@ -0,0 +1,24 @@
+package app
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+)
+
+// publishEvent はドメインイベントを業務データと同じトランザクションでoutboxに記録する。
+// 配送はコミット後にディスパッチャーが行う
+func publishEvent(ctx context.Context, tx repository.Transaction, event model.DomainEvent) error {
+	outboxEvent, err := model.NewOutboxEvent(event)
+	if err != nil {
+		return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to create outbox event")
+	}
+
+	if err := tx.CreateOutboxEvent(ctx, outboxEvent); err != nil {
+		return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to create outbox event in repository")
+	}
+	return nil
+}
@@ -1,5 +1,7 @@
 package iface
 
+//go:generate go run go.uber.org/mock/mockgen@latest -source=$GOFILE -destination=../mock/mock_usecase.go -package=mock
+
 import (
 	"context"
 
@@ -8,14 +10,30 @@ import (
 )
 
 type IUseCase interface {
-	StartGoogleLogin(ctx context.Context) (authURL string, err error)
-	GoogleCallback(ctx context.Context, code, state string) (sessionID string, err error)
-	GetMe(ctx context.Context, sessionID string) (model.User, error)
+	StartGoogleLogin(ctx context.Context, organizationSlug string) (authURL string, err error)
+	GoogleCallback(ctx context.Context, code, state string, client model.SessionClient) (dto.LoginOutput, error)
+	ValidateSession(ctx context.Context, sessionID string, client model.SessionClient) (dto.ValidateSessionOutput, error)
+	BeginPasskeyRegistration(ctx context.Context, userID model.UserID) (dto.BeginPasskeyCeremonyOutput, error)
+	FinishPasskeyRegistration(ctx context.Context, input dto.FinishPasskeyRegistrationInput) (model.Passkey, error)
+	BeginPasskeyLogin(ctx context.Context) (dto.BeginPasskeyCeremonyOutput, error)
+	FinishPasskeyLogin(ctx context.Context, input dto.FinishPasskeyLoginInput) (dto.LoginOutput, error)
+	ListPasskeys(ctx context.Context, userID model.UserID) ([]model.Passkey, error)
+	DeletePasskey(ctx context.Context, userID model.UserID, passkeyID string) error
 	GetUserByID(ctx context.Context, userID model.UserID) (model.User, error)
 	Logout(ctx context.Context, sessionID string) error
-	GetTenantByJoinCode(ctx context.Context, joinCode string) (dto.GetTenantByJoinCodeOutput, error)
-	JoinTenant(ctx context.Context, userID model.UserID, joinCode string) error
-	GetMyTenants(ctx context.Context, userID model.UserID) (dto.GetMyTenantsOutput, error)
-	GetRoomsByTenant(ctx context.Context, tenantID model.TenantID) ([]model.Room, error)
+	ListSessions(ctx context.Context, userID model.UserID) ([]model.AppSession, error)
+	RevokeSession(ctx context.Context, userID model.UserID, sessionID string) error
+	RevokeAllOtherSessions(ctx context.Context, userID model.UserID, currentSessionID model.AppSessionID) (int64, error)
+	GetTenantByJoinCode(ctx context.Context, organizationID model.OrganizationID, joinCode string) (dto.GetTenantByJoinCodeOutput, error)
+	JoinTenant(ctx context.Context, organizationID model.OrganizationID, userID model.UserID, joinCode string) error
+	GetMyTenants(ctx context.Context, input dto.GetMyTenantsInput) (dto.GetMyTenantsOutput, error)
+	GetRoomsByTenant(ctx context.Context, input dto.GetRoomsByTenantInput) ([]dto.RoomOutput, error)
 	GetKeysByRoom(ctx context.Context, roomID model.RoomID) ([]model.Key, error)
+	CreateAPIToken(ctx context.Context, input dto.CreateAPITokenInput) (dto.CreateAPITokenOutput, error)
+	ListAPITokens(ctx context.Context, userID model.UserID) ([]model.APIToken, error)
+	RevokeAPIToken(ctx context.Context, userID model.UserID, tokenID string) error
+	AuthenticateAPIToken(ctx context.Context, token string) (model.APIToken, error)
+	GetNotificationPreferences(ctx context.Context, userID model.UserID) (model.NotificationPreferences, error)
+	UpdateNotificationPreferences(ctx context.Context, input dto.UpdateNotificationPreferencesInput) (model.NotificationPreferences, error)
+	RecordAuditLog(ctx context.Context, log model.AuditLog) error
 }
@@ -0,0 +1,427 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interface.go -destination=../mock/mock_usecase.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/shibayama-club/keyhub/internal/domain/model"
+	dto "github.com/shibayama-club/keyhub/internal/usecase/app/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIUseCase is a mock of IUseCase interface.
+type MockIUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockIUseCaseMockRecorder is the mock recorder for MockIUseCase.
+type MockIUseCaseMockRecorder struct {
+	mock *MockIUseCase
+}
+
+// NewMockIUseCase creates a new mock instance.
+func NewMockIUseCase(ctrl *gomock.Controller) *MockIUseCase {
+	mock := &MockIUseCase{ctrl: ctrl}
+	mock.recorder = &MockIUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIUseCase) EXPECT() *MockIUseCaseMockRecorder {
+	return m.recorder
+}
+
+// AuthenticateAPIToken mocks base method.
+func (m *MockIUseCase) AuthenticateAPIToken(ctx context.Context, token string) (model.APIToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthenticateAPIToken", ctx, token)
+	ret0, _ := ret[0].(model.APIToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthenticateAPIToken indicates an expected call of AuthenticateAPIToken.
+func (mr *MockIUseCaseMockRecorder) AuthenticateAPIToken(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateAPIToken", reflect.TypeOf((*MockIUseCase)(nil).AuthenticateAPIToken), ctx, token)
+}
+
+// BeginPasskeyLogin mocks base method.
+func (m *MockIUseCase) BeginPasskeyLogin(ctx context.Context) (dto.BeginPasskeyCeremonyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeginPasskeyLogin", ctx)
+	ret0, _ := ret[0].(dto.BeginPasskeyCeremonyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BeginPasskeyLogin indicates an expected call of BeginPasskeyLogin.
+func (mr *MockIUseCaseMockRecorder) BeginPasskeyLogin(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginPasskeyLogin", reflect.TypeOf((*MockIUseCase)(nil).BeginPasskeyLogin), ctx)
+}
+
+// BeginPasskeyRegistration mocks base method.
+func (m *MockIUseCase) BeginPasskeyRegistration(ctx context.Context, userID model.UserID) (dto.BeginPasskeyCeremonyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeginPasskeyRegistration", ctx, userID)
+	ret0, _ := ret[0].(dto.BeginPasskeyCeremonyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BeginPasskeyRegistration indicates an expected call of BeginPasskeyRegistration.
+func (mr *MockIUseCaseMockRecorder) BeginPasskeyRegistration(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginPasskeyRegistration", reflect.TypeOf((*MockIUseCase)(nil).BeginPasskeyRegistration), ctx, userID)
+}
+
+// CreateAPIToken mocks base method.
+func (m *MockIUseCase) CreateAPIToken(ctx context.Context, input dto.CreateAPITokenInput) (dto.CreateAPITokenOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIToken", ctx, input)
+	ret0, _ := ret[0].(dto.CreateAPITokenOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAPIToken indicates an expected call of CreateAPIToken.
+func (mr *MockIUseCaseMockRecorder) CreateAPIToken(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIToken", reflect.TypeOf((*MockIUseCase)(nil).CreateAPIToken), ctx, input)
+}
+
+// DeletePasskey mocks base method.
+func (m *MockIUseCase) DeletePasskey(ctx context.Context, userID model.UserID, passkeyID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePasskey", ctx, userID, passkeyID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePasskey indicates an expected call of DeletePasskey.
+func (mr *MockIUseCaseMockRecorder) DeletePasskey(ctx, userID, passkeyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePasskey", reflect.TypeOf((*MockIUseCase)(nil).DeletePasskey), ctx, userID, passkeyID)
+}
+
+// FinishPasskeyLogin mocks base method.
+func (m *MockIUseCase) FinishPasskeyLogin(ctx context.Context, input dto.FinishPasskeyLoginInput) (dto.LoginOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishPasskeyLogin", ctx, input)
+	ret0, _ := ret[0].(dto.LoginOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinishPasskeyLogin indicates an expected call of FinishPasskeyLogin.
+func (mr *MockIUseCaseMockRecorder) FinishPasskeyLogin(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishPasskeyLogin", reflect.TypeOf((*MockIUseCase)(nil).FinishPasskeyLogin), ctx, input)
+}
+
+// FinishPasskeyRegistration mocks base method.
+func (m *MockIUseCase) FinishPasskeyRegistration(ctx context.Context, input dto.FinishPasskeyRegistrationInput) (model.Passkey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishPasskeyRegistration", ctx, input)
+	ret0, _ := ret[0].(model.Passkey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinishPasskeyRegistration indicates an expected call of FinishPasskeyRegistration.
+func (mr *MockIUseCaseMockRecorder) FinishPasskeyRegistration(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishPasskeyRegistration", reflect.TypeOf((*MockIUseCase)(nil).FinishPasskeyRegistration), ctx, input)
+}
+
+// GetKeysByRoom mocks base method.
+func (m *MockIUseCase) GetKeysByRoom(ctx context.Context, roomID model.RoomID) ([]model.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKeysByRoom", ctx, roomID)
+	ret0, _ := ret[0].([]model.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKeysByRoom indicates an expected call of GetKeysByRoom.
+func (mr *MockIUseCaseMockRecorder) GetKeysByRoom(ctx, roomID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKeysByRoom", reflect.TypeOf((*MockIUseCase)(nil).GetKeysByRoom), ctx, roomID)
+}
+
+// GetMyTenants mocks base method.
+func (m *MockIUseCase) GetMyTenants(ctx context.Context, input dto.GetMyTenantsInput) (dto.GetMyTenantsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMyTenants", ctx, input)
+	ret0, _ := ret[0].(dto.GetMyTenantsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMyTenants indicates an expected call of GetMyTenants.
+func (mr *MockIUseCaseMockRecorder) GetMyTenants(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMyTenants", reflect.TypeOf((*MockIUseCase)(nil).GetMyTenants), ctx, input)
+}
+
+// GetNotificationPreferences mocks base method.
+func (m *MockIUseCase) GetNotificationPreferences(ctx context.Context, userID model.UserID) (model.NotificationPreferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationPreferences", ctx, userID)
+	ret0, _ := ret[0].(model.NotificationPreferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationPreferences indicates an expected call of GetNotificationPreferences.
+func (mr *MockIUseCaseMockRecorder) GetNotificationPreferences(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationPreferences", reflect.TypeOf((*MockIUseCase)(nil).GetNotificationPreferences), ctx, userID)
+}
+
+// GetRoomsByTenant mocks base method.
+func (m *MockIUseCase) GetRoomsByTenant(ctx context.Context, input dto.GetRoomsByTenantInput) ([]dto.RoomOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoomsByTenant", ctx, input)
+	ret0, _ := ret[0].([]dto.RoomOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoomsByTenant indicates an expected call of GetRoomsByTenant.
+func (mr *MockIUseCaseMockRecorder) GetRoomsByTenant(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoomsByTenant", reflect.TypeOf((*MockIUseCase)(nil).GetRoomsByTenant), ctx, input)
+}
+
+// GetTenantByJoinCode mocks base method.
+func (m *MockIUseCase) GetTenantByJoinCode(ctx context.Context, organizationID model.OrganizationID, joinCode string) (dto.GetTenantByJoinCodeOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTenantByJoinCode", ctx, organizationID, joinCode)
+	ret0, _ := ret[0].(dto.GetTenantByJoinCodeOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTenantByJoinCode indicates an expected call of GetTenantByJoinCode.
+func (mr *MockIUseCaseMockRecorder) GetTenantByJoinCode(ctx, organizationID, joinCode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenantByJoinCode", reflect.TypeOf((*MockIUseCase)(nil).GetTenantByJoinCode), ctx, organizationID, joinCode)
+}
+
+// GetUserByID mocks base method.
+func (m *MockIUseCase) GetUserByID(ctx context.Context, userID model.UserID) (model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByID", ctx, userID)
+	ret0, _ := ret[0].(model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByID indicates an expected call of GetUserByID.
+func (mr *MockIUseCaseMockRecorder) GetUserByID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockIUseCase)(nil).GetUserByID), ctx, userID)
+}
+
+// GoogleCallback mocks base method.
+func (m *MockIUseCase) GoogleCallback(ctx context.Context, code, state string, client model.SessionClient) (dto.LoginOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GoogleCallback", ctx, code, state, client)
+	ret0, _ := ret[0].(dto.LoginOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GoogleCallback indicates an expected call of GoogleCallback.
+func (mr *MockIUseCaseMockRecorder) GoogleCallback(ctx, code, state, client any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GoogleCallback", reflect.TypeOf((*MockIUseCase)(nil).GoogleCallback), ctx, code, state, client)
+}
+
+// JoinTenant mocks base method.
+func (m *MockIUseCase) JoinTenant(ctx context.Context, organizationID model.OrganizationID, userID model.UserID, joinCode string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinTenant", ctx, organizationID, userID, joinCode)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// JoinTenant indicates an expected call of JoinTenant.
+func (mr *MockIUseCaseMockRecorder) JoinTenant(ctx, organizationID, userID, joinCode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinTenant", reflect.TypeOf((*MockIUseCase)(nil).JoinTenant), ctx, organizationID, userID, joinCode)
+}
+
+// ListAPITokens mocks base method.
+func (m *MockIUseCase) ListAPITokens(ctx context.Context, userID model.UserID) ([]model.APIToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAPITokens", ctx, userID)
+	ret0, _ := ret[0].([]model.APIToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAPITokens indicates an expected call of ListAPITokens.
+func (mr *MockIUseCaseMockRecorder) ListAPITokens(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPITokens", reflect.TypeOf((*MockIUseCase)(nil).ListAPITokens), ctx, userID)
+}
+
+// ListPasskeys mocks base method.
+func (m *MockIUseCase) ListPasskeys(ctx context.Context, userID model.UserID) ([]model.Passkey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPasskeys", ctx, userID)
+	ret0, _ := ret[0].([]model.Passkey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPasskeys indicates an expected call of ListPasskeys.
+func (mr *MockIUseCaseMockRecorder) ListPasskeys(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPasskeys", reflect.TypeOf((*MockIUseCase)(nil).ListPasskeys), ctx, userID)
+}
+
+// ListSessions mocks base method.
+func (m *MockIUseCase) ListSessions(ctx context.Context, userID model.UserID) ([]model.AppSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", ctx, userID)
+	ret0, _ := ret[0].([]model.AppSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockIUseCaseMockRecorder) ListSessions(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockIUseCase)(nil).ListSessions), ctx, userID)
+}
+
+// Logout mocks base method.
+func (m *MockIUseCase) Logout(ctx context.Context, sessionID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logout", ctx, sessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockIUseCaseMockRecorder) Logout(ctx, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockIUseCase)(nil).Logout), ctx, sessionID)
+}
+
+// RecordAuditLog mocks base method.
+func (m *MockIUseCase) RecordAuditLog(ctx context.Context, log model.AuditLog) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordAuditLog", ctx, log)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordAuditLog indicates an expected call of RecordAuditLog.
+func (mr *MockIUseCaseMockRecorder) RecordAuditLog(ctx, log any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordAuditLog", reflect.TypeOf((*MockIUseCase)(nil).RecordAuditLog), ctx, log)
+}
+
+// RevokeAPIToken mocks base method.
+func (m *MockIUseCase) RevokeAPIToken(ctx context.Context, userID model.UserID, tokenID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAPIToken", ctx, userID, tokenID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAPIToken indicates an expected call of RevokeAPIToken.
+func (mr *MockIUseCaseMockRecorder) RevokeAPIToken(ctx, userID, tokenID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIToken", reflect.TypeOf((*MockIUseCase)(nil).RevokeAPIToken), ctx, userID, tokenID)
+}
+
+// RevokeAllOtherSessions mocks base method.
+func (m *MockIUseCase) RevokeAllOtherSessions(ctx context.Context, userID model.UserID, currentSessionID model.AppSessionID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAllOtherSessions", ctx, userID, currentSessionID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAllOtherSessions indicates an expected call of RevokeAllOtherSessions.
+func (mr *MockIUseCaseMockRecorder) RevokeAllOtherSessions(ctx, userID, currentSessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAllOtherSessions", reflect.TypeOf((*MockIUseCase)(nil).RevokeAllOtherSessions), ctx, userID, currentSessionID)
+}
+
+// RevokeSession mocks base method.
+func (m *MockIUseCase) RevokeSession(ctx context.Context, userID model.UserID, sessionID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", ctx, userID, sessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockIUseCaseMockRecorder) RevokeSession(ctx, userID, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockIUseCase)(nil).RevokeSession), ctx, userID, sessionID)
+}
+
+// StartGoogleLogin mocks base method.
+func (m *MockIUseCase) StartGoogleLogin(ctx context.Context, organizationSlug string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartGoogleLogin", ctx, organizationSlug)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartGoogleLogin indicates an expected call of StartGoogleLogin.
+func (mr *MockIUseCaseMockRecorder) StartGoogleLogin(ctx, organizationSlug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartGoogleLogin", reflect.TypeOf((*MockIUseCase)(nil).StartGoogleLogin), ctx, organizationSlug)
+}
+
+// UpdateNotificationPreferences mocks base method.
+func (m *MockIUseCase) UpdateNotificationPreferences(ctx context.Context, input dto.UpdateNotificationPreferencesInput) (model.NotificationPreferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNotificationPreferences", ctx, input)
+	ret0, _ := ret[0].(model.NotificationPreferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNotificationPreferences indicates an expected call of UpdateNotificationPreferences.
+func (mr *MockIUseCaseMockRecorder) UpdateNotificationPreferences(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNotificationPreferences", reflect.TypeOf((*MockIUseCase)(nil).UpdateNotificationPreferences), ctx, input)
+}
+
+// ValidateSession mocks base method.
+func (m *MockIUseCase) ValidateSession(ctx context.Context, sessionID string, client model.SessionClient) (dto.ValidateSessionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateSession", ctx, sessionID, client)
+	ret0, _ := ret[0].(dto.ValidateSessionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateSession indicates an expected call of ValidateSession.
+func (mr *MockIUseCaseMockRecorder) ValidateSession(ctx, sessionID, client any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateSession", reflect.TypeOf((*MockIUseCase)(nil).ValidateSession), ctx, sessionID, client)
+}
@@ -0,0 +1,42 @@
+package app
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/usecase/app/dto"
+)
+
+func (u *UseCase) GetNotificationPreferences(ctx context.Context, userID model.UserID) (model.NotificationPreferences, error) {
+	preferences, err := u.repo.GetNotificationPreferences(ctx, userID)
+	if err != nil {
+		return model.NotificationPreferences{}, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to get notification preferences")
+	}
+
+	return preferences, nil
+}
+
+func (u *UseCase) UpdateNotificationPreferences(ctx context.Context, input dto.UpdateNotificationPreferencesInput) (model.NotificationPreferences, error) {
+	var disabledKinds []string
+	for kind, enabled := range input.EmailEnabled {
+		if err := model.NotificationKind(kind).Validate(); err != nil {
+			return model.NotificationPreferences{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid notification kind")
+		}
+		if !enabled {
+			disabledKinds = append(disabledKinds, kind)
+		}
+	}
+
+	preferences, err := model.NewNotificationPreferences(input.UserID, input.Locale, disabledKinds)
+	if err != nil {
+		return model.NotificationPreferences{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid notification preferences")
+	}
+
+	if err := u.repo.UpsertNotificationPreferences(ctx, preferences); err != nil {
+		return model.NotificationPreferences{}, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to update notification preferences")
+	}
+
+	return preferences, nil
+}
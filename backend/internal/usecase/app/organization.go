@@ -0,0 +1,42 @@
+package app
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+)
+
+// resolveOrganization はログイン先の組織をスラッグから決める。
+// スラッグを指定しない場合は、組織が1つだけの環境に限りその組織を使う
+func (u *UseCase) resolveOrganization(ctx context.Context, slug string) (model.Organization, error) {
+	if slug == "" {
+		organizations, err := u.repo.ListOrganizations(ctx)
+		if err != nil {
+			return model.Organization{}, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to list organizations")
+		}
+		if len(organizations) != 1 {
+			return model.Organization{}, errors.WithHint(
+				errors.Mark(errors.New("organization is not specified"), domainerrors.ErrValidation),
+				"ログインする組織を指定してください。",
+			)
+		}
+		return organizations[0], nil
+	}
+
+	organizationSlug, err := model.NewOrganizationSlug(slug)
+	if err != nil {
+		return model.Organization{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid organization slug")
+	}
+
+	organization, err := u.repo.GetOrganizationBySlug(ctx, organizationSlug)
+	if err != nil {
+		return model.Organization{}, errors.WithHint(
+			errors.Wrap(errors.Mark(err, domainerrors.ErrNotFound), "organization not found"),
+			"指定した組織が見つかりません。",
+		)
+	}
+
+	return organization, nil
+}
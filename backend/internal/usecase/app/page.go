@@ -0,0 +1,19 @@
+package app
+
+import (
+	"github.com/cockroachdb/errors"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+)
+
+// decodePageToken はページトークンを検証してカーソルにする。トークンが空の場合は先頭から取得する
+func (u *UseCase) decodePageToken(query, token string) (*model.PageCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	cursor, err := u.pageTokens.Decode(query, token)
+	if err != nil {
+		return nil, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid page token")
+	}
+	return &cursor, nil
+}
@@ -0,0 +1,229 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+	"github.com/shibayama-club/keyhub/internal/usecase/app/dto"
+)
+
+// BeginPasskeyRegistration はログイン中のユーザーにパスキーを追加する登録セレモニーを開始する。
+// 未認証での新規登録は受け付けない（メールアドレスを検証できず、既存アカウントを乗っ取れてしまうため）
+func (u *UseCase) BeginPasskeyRegistration(ctx context.Context, userID model.UserID) (dto.BeginPasskeyCeremonyOutput, error) {
+	user, err := u.repo.GetUser(ctx, userID)
+	if err != nil {
+		return dto.BeginPasskeyCeremonyOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrNotFound), "user not found")
+	}
+
+	// 2つ目以降のパスキーは既存のユーザーハンドルに紐づける
+	var handle model.PasskeyUserHandle
+	if sub, err := u.repo.GetUserProviderSub(ctx, userID, model.UserIdentityProviderWebAuthn); err == nil {
+		handle, err = model.ParsePasskeyUserHandle(sub)
+		if err != nil {
+			return dto.BeginPasskeyCeremonyOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "invalid stored user handle")
+		}
+	} else {
+		handle, err = model.GeneratePasskeyUserHandle()
+		if err != nil {
+			return dto.BeginPasskeyCeremonyOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to generate user handle")
+		}
+	}
+
+	passkeys, err := u.repo.ListPasskeysByUser(ctx, userID)
+	if err != nil {
+		return dto.BeginPasskeyCeremonyOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to list passkeys")
+	}
+
+	options, session, err := u.passkeyService.BeginRegistration(handle, user, passkeys)
+	if err != nil {
+		return dto.BeginPasskeyCeremonyOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to begin passkey registration")
+	}
+
+	return u.savePasskeyCeremony(ctx, model.PasskeyCeremonyTypeRegistration, &userID, options, session)
+}
+
+func (u *UseCase) FinishPasskeyRegistration(ctx context.Context, input dto.FinishPasskeyRegistrationInput) (model.Passkey, error) {
+	name, err := model.NewPasskeyName(input.Name)
+	if err != nil {
+		return model.Passkey{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid passkey name")
+	}
+
+	ceremony, err := u.consumePasskeyCeremony(ctx, input.CeremonyID, model.PasskeyCeremonyTypeRegistration, &input.UserID)
+	if err != nil {
+		return model.Passkey{}, err
+	}
+
+	user, err := u.repo.GetUser(ctx, input.UserID)
+	if err != nil {
+		return model.Passkey{}, errors.Wrap(errors.Mark(err, domainerrors.ErrNotFound), "user not found")
+	}
+
+	passkeys, err := u.repo.ListPasskeysByUser(ctx, input.UserID)
+	if err != nil {
+		return model.Passkey{}, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to list passkeys")
+	}
+
+	credential, err := u.passkeyService.FinishRegistration(user, passkeys, ceremony.SessionData, input.Credential)
+	if err != nil {
+		return model.Passkey{}, errors.WithHint(
+			errors.Wrap(errors.Mark(err, domainerrors.ErrUnAuthorized), "failed to verify passkey registration"),
+			"パスキーを登録できませんでした。もう一度お試しください。",
+		)
+	}
+
+	passkey := credential.Passkey
+	passkey.ID = model.PasskeyID(uuid.New())
+	passkey.Name = name
+	passkey.CreatedAt = time.Now()
+	if err := passkey.Validate(); err != nil {
+		return model.Passkey{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid passkey")
+	}
+
+	err = u.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
+		if err := tx.UpsertUserIdentity(ctx, repository.UpsertUserIdentityArg{
+			UserID:      input.UserID,
+			Provider:    model.UserIdentityProviderWebAuthn,
+			ProviderSub: credential.UserHandle.String(),
+		}); err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to create user identity")
+		}
+
+		if err := tx.CreatePasskey(ctx, passkey); err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to create passkey")
+		}
+		return nil
+	})
+	if err != nil {
+		return model.Passkey{}, err
+	}
+
+	return passkey, nil
+}
+
+// BeginPasskeyLogin はユーザーを指定しない認証セレモニーを開始する。認証器に保存されたパスキーからユーザーを特定する
+func (u *UseCase) BeginPasskeyLogin(ctx context.Context) (dto.BeginPasskeyCeremonyOutput, error) {
+	options, session, err := u.passkeyService.BeginLogin()
+	if err != nil {
+		return dto.BeginPasskeyCeremonyOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to begin passkey login")
+	}
+
+	return u.savePasskeyCeremony(ctx, model.PasskeyCeremonyTypeLogin, nil, options, session)
+}
+
+// FinishPasskeyLogin は認証レスポンスを検証し、GoogleCallback と同じ app_sess_ セッションを発行する
+func (u *UseCase) FinishPasskeyLogin(ctx context.Context, input dto.FinishPasskeyLoginInput) (dto.LoginOutput, error) {
+	organization, err := u.resolveOrganization(ctx, input.OrganizationSlug)
+	if err != nil {
+		return dto.LoginOutput{}, err
+	}
+
+	ceremony, err := u.consumePasskeyCeremony(ctx, input.CeremonyID, model.PasskeyCeremonyTypeLogin, nil)
+	if err != nil {
+		return dto.LoginOutput{}, err
+	}
+
+	lookup := func(handle model.PasskeyUserHandle) ([]model.Passkey, error) {
+		user, err := u.repo.GetUserByProviderIdentity(ctx, model.UserIdentityProviderWebAuthn, handle.String())
+		if err != nil {
+			return nil, err
+		}
+		return u.repo.ListPasskeysByUser(ctx, user.UserId)
+	}
+
+	credential, err := u.passkeyService.FinishLogin(ceremony.SessionData, input.Credential, lookup)
+	if err != nil {
+		return dto.LoginOutput{}, errors.WithHint(
+			errors.Wrap(errors.Mark(err, domainerrors.ErrUnAuthorized), "failed to verify passkey login"),
+			"パスキーで認証できませんでした。もう一度お試しください。",
+		)
+	}
+
+	err = u.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
+		return tx.UpdatePasskeyUsage(ctx, repository.UpdatePasskeyUsageArg{
+			ID:          credential.Passkey.ID,
+			SignCount:   credential.Passkey.SignCount,
+			BackupState: credential.Passkey.BackupState,
+		})
+	})
+	if err != nil {
+		return dto.LoginOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to update passkey usage")
+	}
+
+	return u.createSession(ctx, credential.Passkey.UserID, organization.ID, input.Client)
+}
+
+func (u *UseCase) ListPasskeys(ctx context.Context, userID model.UserID) ([]model.Passkey, error) {
+	passkeys, err := u.repo.ListPasskeysByUser(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to list passkeys")
+	}
+
+	return passkeys, nil
+}
+
+func (u *UseCase) DeletePasskey(ctx context.Context, userID model.UserID, passkeyID string) error {
+	id, err := model.ParsePasskeyID(passkeyID)
+	if err != nil {
+		return errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid passkey ID")
+	}
+
+	return u.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
+		deleted, err := tx.DeletePasskeyByUser(ctx, userID, id)
+		if err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to delete passkey")
+		}
+		if deleted == 0 {
+			return errors.WithHint(
+				errors.Mark(errors.New("passkey not found"), domainerrors.ErrNotFound),
+				"指定されたパスキーが見つかりません。",
+			)
+		}
+		return nil
+	})
+}
+
+func (u *UseCase) savePasskeyCeremony(ctx context.Context, ceremonyType model.PasskeyCeremonyType, userID *model.UserID, options, session []byte) (dto.BeginPasskeyCeremonyOutput, error) {
+	ceremony, err := model.NewPasskeyCeremony(ceremonyType, userID, session)
+	if err != nil {
+		return dto.BeginPasskeyCeremonyOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to create passkey ceremony")
+	}
+
+	err = u.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
+		return tx.SavePasskeyCeremony(ctx, ceremony)
+	})
+	if err != nil {
+		return dto.BeginPasskeyCeremonyOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to save passkey ceremony")
+	}
+
+	return dto.BeginPasskeyCeremonyOutput{
+		CeremonyID: ceremony.ID,
+		Options:    options,
+	}, nil
+}
+
+// consumePasskeyCeremony はセレモニーを取り出して削除する。検証に失敗しても同じチャレンジは再利用できない
+func (u *UseCase) consumePasskeyCeremony(ctx context.Context, id string, ceremonyType model.PasskeyCeremonyType, userID *model.UserID) (model.PasskeyCeremony, error) {
+	var ceremony model.PasskeyCeremony
+	err := u.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
+		var err error
+		ceremony, err = tx.ConsumePasskeyCeremony(ctx, id)
+		return err
+	})
+	if err != nil {
+		return model.PasskeyCeremony{}, errors.Wrap(errors.Mark(err, domainerrors.ErrUnAuthorized), "invalid or expired passkey ceremony")
+	}
+
+	if !ceremony.IsValidFor(ceremonyType, userID) {
+		return model.PasskeyCeremony{}, errors.WithHint(
+			errors.Mark(errors.New("passkey ceremony is invalid"), domainerrors.ErrUnAuthorized),
+			"認証フローが無効です。最初からやり直してください。",
+		)
+	}
+
+	return ceremony, nil
+}
@@ -4,16 +4,32 @@ import (
 	"context"
 
 	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
 	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+	"github.com/shibayama-club/keyhub/internal/usecase/app/dto"
 )
 
-func (u *UseCase) GetRoomsByTenant(ctx context.Context, tenantID model.TenantID) ([]model.Room, error) {
-	rooms, err := u.repo.GetRoomsByTenant(ctx, tenantID)
+// GetRoomsByTenant はテナントの部屋のうち、ユーザーが所属するグループから利用できるものを返す。
+// テナントのメンバーでない場合は空になる
+func (u *UseCase) GetRoomsByTenant(ctx context.Context, input dto.GetRoomsByTenantInput) ([]dto.RoomOutput, error) {
+	filter, err := model.NewRoomFilter(input.MinCapacity, input.Equipment, input.Accessibility)
+	if err != nil {
+		return nil, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid room filter")
+	}
+
+	rooms, err := u.repo.GetRoomsByTenant(ctx, input.TenantID, input.UserID, filter)
 	if err != nil {
 		return nil, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to get rooms by tenant")
 	}
-	return rooms, nil
+
+	return lo.Map(rooms, func(room repository.AccessibleRoom, _ int) dto.RoomOutput {
+		return dto.RoomOutput{
+			Room:          room.Room,
+			CanBorrowKeys: room.CanBorrowKeys,
+		}
+	}), nil
 }
 
 func (u *UseCase) GetKeysByRoom(ctx context.Context, roomID model.RoomID) ([]model.Key, error) {
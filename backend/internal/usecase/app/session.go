@@ -0,0 +1,63 @@
+package app
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+)
+
+func (u *UseCase) ListSessions(ctx context.Context, userID model.UserID) ([]model.AppSession, error) {
+	sessions, err := u.repo.ListActiveAppSessionsByUser(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to list sessions")
+	}
+
+	return sessions, nil
+}
+
+// RevokeSession はユーザー自身のセッションを無効化する。他ユーザーのセッションは見つからない扱いにする
+func (u *UseCase) RevokeSession(ctx context.Context, userID model.UserID, sessionID string) error {
+	appSessionID, err := model.NewAppSessionID(sessionID)
+	if err != nil {
+		return errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid session ID")
+	}
+
+	err = u.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
+		revoked, err := tx.RevokeAppSessionByUser(ctx, userID, appSessionID)
+		if err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to revoke session")
+		}
+		if revoked == 0 {
+			return errors.WithHint(
+				errors.Mark(errors.New("session not found"), domainerrors.ErrNotFound),
+				"指定されたセッションが見つかりません。",
+			)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (u *UseCase) RevokeAllOtherSessions(ctx context.Context, userID model.UserID, currentSessionID model.AppSessionID) (int64, error) {
+	var revoked int64
+	err := u.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
+		var err error
+		revoked, err = tx.RevokeOtherAppSessionsByUser(ctx, userID, currentSessionID)
+		if err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to revoke other sessions")
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return revoked, nil
+}
@@ -0,0 +1,127 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/shibayama-club/keyhub/cmd/config"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+	"github.com/shibayama-club/keyhub/internal/domain/repository/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUseCase_ListSessions(t *testing.T) {
+	userID := model.UserID(uuid.New())
+	sessions := []model.AppSession{
+		{
+			SessionID:  "session-1",
+			UserID:     userID,
+			Client:     model.NewSessionClient("Mozilla/5.0", "203.0.113.10"),
+			LastSeenAt: time.Now(),
+		},
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func(*mock.MockRepository)
+		want      []model.AppSession
+		wantErr   error
+	}{
+		{
+			name: "正常系: ユーザーの有効なセッションを接続元の情報付きで返す",
+			setupMock: func(m *mock.MockRepository) {
+				m.EXPECT().ListActiveAppSessionsByUser(gomock.Any(), userID).Return(sessions, nil)
+			},
+			want: sessions,
+		},
+		{
+			name: "異常系: セッションの取得に失敗した",
+			setupMock: func(m *mock.MockRepository) {
+				m.EXPECT().ListActiveAppSessionsByUser(gomock.Any(), userID).Return(nil, errors.New("db error"))
+			},
+			wantErr: domainerrors.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRepo := mock.NewMockRepository(ctrl)
+			tt.setupMock(mockRepo)
+
+			u := &UseCase{repo: mockRepo, config: config.Config{}}
+
+			got, err := u.ListSessions(context.Background(), userID)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "expected error type %v, got %v", tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUseCase_RevokeAllOtherSessions(t *testing.T) {
+	userID := model.UserID(uuid.New())
+	current := model.AppSessionID("current")
+
+	tests := []struct {
+		name      string
+		setupMock func(*testing.T, *mock.MockRepository)
+		want      int64
+		wantErr   error
+	}{
+		{
+			name: "正常系: 現在のセッション以外を無効化して件数を返す",
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context, repository.Transaction) error) error {
+						mockTx := mock.NewMockTransaction(gomock.NewController(t))
+						mockTx.EXPECT().RevokeOtherAppSessionsByUser(gomock.Any(), userID, current).Return(int64(2), nil)
+						return fn(ctx, mockTx)
+					})
+			},
+			want: 2,
+		},
+		{
+			name: "異常系: 無効化に失敗した",
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context, repository.Transaction) error) error {
+						mockTx := mock.NewMockTransaction(gomock.NewController(t))
+						mockTx.EXPECT().RevokeOtherAppSessionsByUser(gomock.Any(), userID, current).Return(int64(0), errors.New("db error"))
+						return fn(ctx, mockTx)
+					})
+			},
+			wantErr: domainerrors.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRepo := mock.NewMockRepository(ctrl)
+			tt.setupMock(t, mockRepo)
+
+			u := &UseCase{repo: mockRepo, config: config.Config{}}
+
+			got, err := u.RevokeAllOtherSessions(context.Background(), userID, current)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "expected error type %v, got %v", tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
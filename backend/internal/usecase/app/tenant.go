@@ -12,10 +12,11 @@ import (
 	"github.com/shibayama-club/keyhub/internal/usecase/app/dto"
 )
 
-func (u *UseCase) GetTenantByJoinCode(ctx context.Context, joinCode string) (dto.GetTenantByJoinCodeOutput, error) {
+// GetTenantByJoinCode はセッションの組織のテナントだけを参加コードで検索する。他の組織のテナントは存在しない場合と同じく見つからない
+func (u *UseCase) GetTenantByJoinCode(ctx context.Context, organizationID model.OrganizationID, joinCode string) (dto.GetTenantByJoinCodeOutput, error) {
 	code := model.TenantJoinCode(joinCode)
 
-	tenant, err := u.repo.GetTenantByJoinCode(ctx, code)
+	tenant, err := u.repo.GetTenantByJoinCode(ctx, organizationID, code)
 	if err != nil {
 		return dto.GetTenantByJoinCodeOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrNotFound), "tenant not found")
 	}
@@ -28,11 +29,11 @@ func (u *UseCase) GetTenantByJoinCode(ctx context.Context, joinCode string) (dto
 	}, nil
 }
 
-func (u *UseCase) JoinTenant(ctx context.Context, userID model.UserID, joinCode string) error {
+func (u *UseCase) JoinTenant(ctx context.Context, organizationID model.OrganizationID, userID model.UserID, joinCode string) error {
 	code := model.TenantJoinCode(joinCode)
 
 	err := u.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
-		tenant, err := tx.GetTenantByJoinCode(ctx, code)
+		tenant, err := tx.GetTenantByJoinCode(ctx, organizationID, code)
 		if err != nil {
 			return errors.Wrap(errors.Mark(err, domainerrors.ErrNotFound), "tenant not found")
 		}
@@ -59,7 +60,7 @@ func (u *UseCase) JoinTenant(ctx context.Context, userID model.UserID, joinCode
 			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to increment join code used count in repository")
 		}
 
-		return nil
+		return publishEvent(ctx, tx, model.NewMemberJoinedEvent(tenant.OrganizationID, membership))
 	})
 	if err != nil {
 		return err
@@ -68,12 +69,50 @@ func (u *UseCase) JoinTenant(ctx context.Context, userID model.UserID, joinCode
 	return nil
 }
 
-func (u *UseCase) GetMyTenants(ctx context.Context, userID model.UserID) (dto.GetMyTenantsOutput, error) {
-	tenants, err := u.repo.GetTenantsByUserID(ctx, userID)
+// GetMyTenants はユーザーが参加しているテナントを条件で絞り込み、1ページ分を返す。
+// 続きの有無を判定するため、指定件数より1件多く取得する
+func (u *UseCase) GetMyTenants(ctx context.Context, input dto.GetMyTenantsInput) (dto.GetMyTenantsOutput, error) {
+	order, err := model.ParseListOrder(input.Order)
 	if err != nil {
-		return dto.GetMyTenantsOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to get tenants by user id")
+		return dto.GetMyTenantsOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid list order")
 	}
 
+	arg := repository.ListTenantsArg{
+		NamePrefix: input.NamePrefix,
+		Order:      order,
+	}
+	if input.TenantType != "" {
+		var catalog model.TenantTypeCatalog
+		if !model.TenantType(input.TenantType).IsBuiltin() {
+			catalog, err = u.repo.ListTenantTypes(ctx)
+			if err != nil {
+				return dto.GetMyTenantsOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to list tenant types")
+			}
+		}
+		tenantType, err := model.NewTenantType(input.TenantType, catalog)
+		if err != nil {
+			return dto.GetMyTenantsOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid tenant type")
+		}
+		arg.Type = tenantType
+	}
+
+	query := model.PageQuery("my_tenants", order, map[string]string{
+		"tenant_type": input.TenantType,
+		"name_prefix": input.NamePrefix,
+	})
+	arg.Cursor, err = u.decodePageToken(query, input.PageToken)
+	if err != nil {
+		return dto.GetMyTenantsOutput{}, err
+	}
+	pageSize := model.NormalizePageSize(input.PageSize)
+	arg.Limit = pageSize + 1
+
+	tenants, err := u.repo.ListTenantsByUserID(ctx, input.UserID, arg)
+	if err != nil {
+		return dto.GetMyTenantsOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to list tenants by user id")
+	}
+	tenants, hasNext := model.SplitPage(tenants, pageSize)
+
 	tenantOutputs := lo.Map(tenants, func(tenant repository.TenantWithMemberCount, _ int) dto.TenantOutput {
 		return dto.TenantOutput{
 			ID:             tenant.Tenant.ID.String(),
@@ -82,12 +121,21 @@ func (u *UseCase) GetMyTenants(ctx context.Context, userID model.UserID) (dto.Ge
 			Description:    tenant.Tenant.Description.String(),
 			TenantType:     tenant.Tenant.Type.String(),
 			MemberCount:    tenant.MemberCount,
+			ArchivedAt:     tenant.Tenant.ArchivedAt,
 			CreatedAt:      tenant.Tenant.CreatedAt,
 			UpdatedAt:      tenant.Tenant.UpdatedAt,
 		}
 	})
 
-	return dto.GetMyTenantsOutput{
-		Tenants: tenantOutputs,
-	}, nil
+	output := dto.GetMyTenantsOutput{Tenants: tenantOutputs}
+	if hasNext {
+		last := tenants[len(tenants)-1].Tenant
+		output.NextPageToken = u.pageTokens.Encode(query, model.PageCursor{
+			CreatedAt: last.CreatedAt,
+			Name:      last.Name.String(),
+			ID:        last.ID.UUID(),
+		})
+	}
+
+	return output, nil
 }
@@ -0,0 +1,25 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+)
+
+// AppendLog は呼び出し元のトランザクションで組織の監査ログの末尾に記録を追記する。
+// 連鎖の末尾を読んでから書き込むまでの間に他の記録が割り込まないよう、組織ごとにロックを取る。
+// App と Console のどちらから記録しても同じ手順で連鎖させるため、監査ログの追記は必ずこの関数を通す
+func AppendLog(ctx context.Context, tx repository.Transaction, log model.AuditLog) error {
+	head, err := tx.LockAuditChain(ctx, log.OrganizationID)
+	if err != nil {
+		return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to lock audit chain")
+	}
+
+	if err := tx.CreateAuditLog(ctx, log.Chain(head)); err != nil {
+		return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to create audit log")
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package audit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAppendLog(t *testing.T) {
+	orgID := model.OrganizationID(uuid.New())
+	log := model.AuditLog{OrganizationID: orgID, Procedure: "/keyhub.console.v1.ConsoleService/CreateTenant"}
+	head := model.AuditChainHead{Seq: 41, Hash: "prev-hash"}
+
+	tests := []struct {
+		name    string
+		setup   func(*mock.MockTransaction)
+		wantErr error
+	}{
+		{
+			name: "正常系: ロックした連鎖の末尾に続けて記録する",
+			setup: func(tx *mock.MockTransaction) {
+				gomock.InOrder(
+					tx.EXPECT().LockAuditChain(gomock.Any(), orgID).Return(head, nil),
+					tx.EXPECT().
+						CreateAuditLog(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(_ context.Context, got model.AuditLog) error {
+							assert.Equal(t, int64(42), got.Seq)
+							assert.Equal(t, "prev-hash", got.PrevHash)
+							assert.Equal(t, got.ComputeHash(), got.Hash)
+							return nil
+						}),
+				)
+			},
+		},
+		{
+			name: "異常系: ロックを取れなければ記録しない",
+			setup: func(tx *mock.MockTransaction) {
+				tx.EXPECT().LockAuditChain(gomock.Any(), orgID).Return(model.AuditChainHead{}, errors.New("db error"))
+			},
+			wantErr: domainerrors.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := mock.NewMockTransaction(gomock.NewController(t))
+			tt.setup(tx)
+
+			err := AppendLog(context.Background(), tx, log)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "expected error type %v, got %v", tt.wantErr, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/audit"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+	"github.com/shibayama-club/keyhub/internal/usecase/audit/dto"
+	"github.com/shibayama-club/keyhub/internal/usecase/audit/iface"
+)
+
+// verifyBatchSize は連鎖を検証するときに一度に読み込む記録の数
+const verifyBatchSize = 1000
+
+// UseCase は監査ログの連鎖の検証とチェックポイントの作成を行う。
+// signer と verifier は使う操作でのみ必要で、nil でもよい
+type UseCase struct {
+	repo     repository.Repository
+	signer   audit.CheckpointSigner
+	verifier audit.CheckpointVerifier
+	now      func() time.Time
+}
+
+var _ iface.IUseCase = (*UseCase)(nil)
+
+func NewUseCase(repo repository.Repository, signer audit.CheckpointSigner, verifier audit.CheckpointVerifier) iface.IUseCase {
+	return &UseCase{
+		repo:     repo,
+		signer:   signer,
+		verifier: verifier,
+		now:      time.Now,
+	}
+}
+
+// VerifyChain は組織ごとに監査ログを連番の順に読み、最初に壊れていた記録を報告する。
+// チェックポイントを渡した場合は、その位置の記録のハッシュと一致するか、
+// その位置までの記録が残っているかも確認する
+func (u *UseCase) VerifyChain(ctx context.Context, input dto.VerifyChainInput) ([]dto.VerifyChainOutput, error) {
+	checkpoints := map[model.OrganizationID][]model.AuditCheckpoint{}
+	if len(input.Checkpoints) > 0 {
+		if u.verifier == nil {
+			return nil, errors.Mark(errors.New("checkpoint verifier is not configured"), domainerrors.ErrValidation)
+		}
+		for _, c := range input.Checkpoints {
+			if err := u.verifier.Verify(c); err != nil {
+				return nil, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "failed to verify checkpoint signature")
+			}
+			checkpoints[c.OrganizationID] = append(checkpoints[c.OrganizationID], c)
+		}
+	}
+
+	organizationIDs := input.OrganizationIDs
+	if len(organizationIDs) == 0 {
+		ids, err := u.repo.ListAuditLogOrganizationIDs(ctx)
+		if err != nil {
+			return nil, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to list organizations with audit logs")
+		}
+		// 記録がすべて削除された組織もチェックポイントから検出する
+		for id := range checkpoints {
+			if !slices.Contains(ids, id) {
+				ids = append(ids, id)
+			}
+		}
+		organizationIDs = ids
+	}
+
+	outputs := make([]dto.VerifyChainOutput, 0, len(organizationIDs))
+	for _, organizationID := range organizationIDs {
+		output, err := u.verifyOrganization(ctx, organizationID, checkpoints[organizationID])
+		if err != nil {
+			return nil, err
+		}
+		outputs = append(outputs, output)
+	}
+
+	return outputs, nil
+}
+
+func (u *UseCase) verifyOrganization(ctx context.Context, organizationID model.OrganizationID, checkpoints []model.AuditCheckpoint) (dto.VerifyChainOutput, error) {
+	pending := map[int64][]model.AuditCheckpoint{}
+	for _, c := range checkpoints {
+		pending[c.Seq] = append(pending[c.Seq], c)
+	}
+
+	var verifier model.AuditChainVerifier
+	checkpointsVerified := 0
+	result := func(chainBreak *model.AuditChainBreak) dto.VerifyChainOutput {
+		return dto.VerifyChainOutput{
+			OrganizationID:      organizationID,
+			Head:                verifier.Head(),
+			Checked:             verifier.Checked,
+			Legacy:              verifier.Legacy,
+			CheckpointsVerified: checkpointsVerified,
+			Break:               chainBreak,
+		}
+	}
+
+	for {
+		logs, err := u.repo.ListAuditLogsBySeq(ctx, organizationID, verifier.Head().Seq, verifyBatchSize)
+		if err != nil {
+			return dto.VerifyChainOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to list audit logs")
+		}
+
+		for _, log := range logs {
+			if err := verifier.Next(log); err != nil {
+				var chainBreak *model.AuditChainBreak
+				if !errors.As(err, &chainBreak) {
+					return dto.VerifyChainOutput{}, err
+				}
+				return result(chainBreak), nil
+			}
+
+			for _, c := range pending[log.Seq] {
+				if c.Hash != log.Hash {
+					return result(&model.AuditChainBreak{
+						Seq:    log.Seq,
+						LogID:  log.ID,
+						Reason: fmt.Sprintf("hash does not match the checkpoint signed at %s", c.CreatedAt.Format(time.RFC3339)),
+					}), nil
+				}
+				checkpointsVerified++
+			}
+			delete(pending, log.Seq)
+		}
+
+		if len(logs) < verifyBatchSize {
+			break
+		}
+	}
+
+	// 残ったチェックポイントは末尾より後ろを指しており、その間の記録が削除されている
+	if len(pending) > 0 {
+		seq := slices.Min(slices.Collect(maps.Keys(pending)))
+		return result(&model.AuditChainBreak{
+			Seq:    seq,
+			Reason: fmt.Sprintf("chain ends at seq %d but a checkpoint covers seq %d, records may have been deleted", verifier.Head().Seq, seq),
+		}), nil
+	}
+
+	return result(nil), nil
+}
+
+// CreateCheckpoints は監査ログがあるすべての組織について、現在の連鎖の末尾に署名したチェックポイントを作成する
+func (u *UseCase) CreateCheckpoints(ctx context.Context) ([]model.AuditCheckpoint, error) {
+	if u.signer == nil {
+		return nil, errors.Mark(errors.New("checkpoint signer is not configured"), domainerrors.ErrValidation)
+	}
+
+	organizationIDs, err := u.repo.ListAuditLogOrganizationIDs(ctx)
+	if err != nil {
+		return nil, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to list organizations with audit logs")
+	}
+
+	checkpoints := make([]model.AuditCheckpoint, 0, len(organizationIDs))
+	for _, organizationID := range organizationIDs {
+		var head model.AuditChainHead
+		err := u.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
+			var err error
+			head, err = tx.LockAuditChain(ctx, organizationID)
+			if err != nil {
+				return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to lock audit chain")
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		// ハッシュ導入前の記録しかない組織には署名する対象がない
+		if head.Hash == "" {
+			continue
+		}
+
+		checkpoint, err := u.signer.Sign(model.NewAuditCheckpoint(organizationID, head, u.now()))
+		if err != nil {
+			return nil, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to sign audit checkpoint")
+		}
+		checkpoints = append(checkpoints, checkpoint)
+	}
+
+	return checkpoints, nil
+}
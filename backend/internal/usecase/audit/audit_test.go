@@ -0,0 +1,151 @@
+package audit
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository/mock"
+	"github.com/shibayama-club/keyhub/internal/infrastructure/auditcheckpoint"
+	"github.com/shibayama-club/keyhub/internal/usecase/audit/dto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newTestKeys(t *testing.T) (*auditcheckpoint.Signer, *auditcheckpoint.Verifier) {
+	t.Helper()
+
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	privateDER, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	require.NoError(t, err)
+	publicDER, err := x509.MarshalPKIXPublicKey(publicKey)
+	require.NoError(t, err)
+
+	signer, err := auditcheckpoint.NewSigner("test", pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateDER}))
+	require.NoError(t, err)
+	verifier, err := auditcheckpoint.NewVerifier("test", pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER}))
+	require.NoError(t, err)
+
+	return signer, verifier
+}
+
+func newTestChain(t *testing.T, orgID model.OrganizationID, n int) []model.AuditLog {
+	t.Helper()
+
+	var head model.AuditChainHead
+	logs := make([]model.AuditLog, 0, n)
+	for range n {
+		log, err := model.NewAuditLog(
+			orgID,
+			model.AuditActor{Type: model.AuditActorTypeConsoleOwner, ID: uuid.NewString()},
+			"/keyhub.console.v1.ConsoleTenantService/CreateTenant",
+			nil,
+			"ok",
+			uuid.NewString(),
+			model.SessionClient{},
+		)
+		require.NoError(t, err)
+
+		log = log.Chain(head)
+		head = model.AuditChainHead{Seq: log.Seq, Hash: log.Hash}
+		logs = append(logs, log)
+	}
+	return logs
+}
+
+func TestUseCase_VerifyChain(t *testing.T) {
+	orgID := model.OrganizationID(uuid.New())
+	signer, verifier := newTestKeys(t)
+	chain := newTestChain(t, orgID, 3)
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	sign := func(t *testing.T, log model.AuditLog) model.AuditCheckpoint {
+		c, err := signer.Sign(model.NewAuditCheckpoint(orgID, model.AuditChainHead{Seq: log.Seq, Hash: log.Hash}, now))
+		require.NoError(t, err)
+		return c
+	}
+
+	tests := []struct {
+		name            string
+		logs            []model.AuditLog
+		checkpoints     func(t *testing.T) []model.AuditCheckpoint
+		wantBreakAt     int64
+		wantCheckpoints int
+		wantErr         error
+	}{
+		{
+			name: "正常系: 連鎖とチェックポイントが一致する",
+			logs: chain,
+			checkpoints: func(t *testing.T) []model.AuditCheckpoint {
+				return []model.AuditCheckpoint{sign(t, chain[1]), sign(t, chain[2])}
+			},
+			wantCheckpoints: 2,
+		},
+		{
+			name: "異常系: 末尾の記録が削除されている",
+			logs: chain[:2],
+			checkpoints: func(t *testing.T) []model.AuditCheckpoint {
+				return []model.AuditCheckpoint{sign(t, chain[2])}
+			},
+			wantBreakAt: 3,
+		},
+		{
+			name: "異常系: 連鎖ごと作り直されている",
+			logs: newTestChain(t, orgID, 3),
+			checkpoints: func(t *testing.T) []model.AuditCheckpoint {
+				return []model.AuditCheckpoint{sign(t, chain[1])}
+			},
+			wantBreakAt: 2,
+		},
+		{
+			name: "異常系: チェックポイントの署名が不正",
+			checkpoints: func(t *testing.T) []model.AuditCheckpoint {
+				c := sign(t, chain[2])
+				c.Seq = 1
+				return []model.AuditCheckpoint{c}
+			},
+			wantErr: domainerrors.ErrValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := mock.NewMockRepository(ctrl)
+			if tt.wantErr == nil {
+				repo.EXPECT().ListAuditLogsBySeq(gomock.Any(), orgID, int64(0), int32(verifyBatchSize)).Return(tt.logs, nil)
+			}
+
+			u := NewUseCase(repo, nil, verifier)
+			outputs, err := u.VerifyChain(t.Context(), dto.VerifyChainInput{
+				OrganizationIDs: []model.OrganizationID{orgID},
+				Checkpoints:     tt.checkpoints(t),
+			})
+
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr))
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, outputs, 1)
+
+			if tt.wantBreakAt == 0 {
+				assert.Nil(t, outputs[0].Break)
+			} else {
+				require.NotNil(t, outputs[0].Break)
+				assert.Equal(t, tt.wantBreakAt, outputs[0].Break.Seq)
+			}
+			assert.Equal(t, tt.wantCheckpoints, outputs[0].CheckpointsVerified)
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package dto
+
+import "github.com/shibayama-club/keyhub/internal/domain/model"
+
+// VerifyChainInput の OrganizationIDs が空の場合は、監査ログかチェックポイントがあるすべての組織を検証する
+type VerifyChainInput struct {
+	OrganizationIDs []model.OrganizationID
+	Checkpoints     []model.AuditCheckpoint
+}
+
+// VerifyChainOutput は組織ごとの検証結果。Break が nil でなければ連鎖が壊れている
+type VerifyChainOutput struct {
+	OrganizationID      model.OrganizationID
+	Head                model.AuditChainHead
+	Checked             int64
+	Legacy              int64
+	CheckpointsVerified int
+	Break               *model.AuditChainBreak
+}
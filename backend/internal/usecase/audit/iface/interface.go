@@ -0,0 +1,13 @@
+package iface
+
+import (
+	"context"
+
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/usecase/audit/dto"
+)
+
+type IUseCase interface {
+	VerifyChain(ctx context.Context, input dto.VerifyChainInput) ([]dto.VerifyChainOutput, error)
+	CreateCheckpoints(ctx context.Context) ([]model.AuditCheckpoint, error)
+}
@@ -0,0 +1,34 @@
+package console
+
+import (
+	"context"
+
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/usecase/apitoken"
+	"github.com/shibayama-club/keyhub/internal/usecase/console/dto"
+)
+
+func (u *UseCase) CreateAPIToken(ctx context.Context, input dto.CreateAPITokenInput) (dto.CreateAPITokenOutput, error) {
+	apiToken, token, err := apitoken.Create(ctx, u.repo, model.NewOrganizationAPITokenOwner(input.OrganizationID), input.Name, input.Scopes, input.ExpiresAt)
+	if err != nil {
+		return dto.CreateAPITokenOutput{}, err
+	}
+
+	return dto.CreateAPITokenOutput{
+		APIToken: apiToken,
+		Token:    token,
+	}, nil
+}
+
+func (u *UseCase) ListAPITokens(ctx context.Context, organizationID model.OrganizationID) ([]model.APIToken, error) {
+	return apitoken.List(ctx, u.repo, model.NewOrganizationAPITokenOwner(organizationID))
+}
+
+func (u *UseCase) RevokeAPIToken(ctx context.Context, organizationID model.OrganizationID, tokenID string) error {
+	return apitoken.Revoke(ctx, u.repo, model.NewOrganizationAPITokenOwner(organizationID), tokenID)
+}
+
+// AuthenticateAPIToken は Authorization ヘッダーで送られたAPIトークンを検証し、必要に応じて最終利用日時を更新する
+func (u *UseCase) AuthenticateAPIToken(ctx context.Context, token string) (model.APIToken, error) {
+	return apitoken.Authenticate(ctx, u.repo, model.APITokenOwnerOrganization, token)
+}
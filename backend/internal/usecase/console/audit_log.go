@@ -0,0 +1,71 @@
+package console
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+	"github.com/shibayama-club/keyhub/internal/usecase/audit"
+	"github.com/shibayama-club/keyhub/internal/usecase/console/dto"
+)
+
+// RecordAuditLog は組織の監査ログの末尾に記録を追記する
+func (u *UseCase) RecordAuditLog(ctx context.Context, log model.AuditLog) error {
+	return u.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
+		return audit.AppendLog(ctx, tx, log)
+	})
+}
+
+// SearchAuditLogs は組織の監査ログを新しい順に検索する。
+// 続きの有無を判定するため、指定件数より1件多く取得する
+func (u *UseCase) SearchAuditLogs(ctx context.Context, input dto.SearchAuditLogsInput) (dto.SearchAuditLogsOutput, error) {
+	arg := repository.SearchAuditLogsArg{
+		OrganizationID: input.OrganizationID,
+		ActorID:        input.ActorID,
+		Procedure:      input.Procedure,
+		ResultCode:     input.ResultCode,
+		TargetID:       input.TargetID,
+		Since:          input.Since,
+		Until:          input.Until,
+	}
+
+	if input.ActorType != "" {
+		actorType := model.AuditActorType(input.ActorType)
+		if err := actorType.Validate(); err != nil {
+			return dto.SearchAuditLogsOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid audit actor type")
+		}
+		arg.ActorType = actorType
+	}
+
+	if input.PageToken != "" {
+		cursor, err := model.ParseAuditLogCursor(input.PageToken)
+		if err != nil {
+			return dto.SearchAuditLogsOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid audit log page token")
+		}
+		arg.Cursor = &cursor
+	}
+
+	pageSize := input.PageSize
+	switch {
+	case pageSize <= 0:
+		pageSize = model.AuditLogDefaultPageSize
+	case pageSize > model.AuditLogMaxPageSize:
+		pageSize = model.AuditLogMaxPageSize
+	}
+	arg.Limit = pageSize + 1
+
+	logs, err := u.repo.SearchAuditLogs(ctx, arg)
+	if err != nil {
+		return dto.SearchAuditLogsOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to search audit logs")
+	}
+
+	output := dto.SearchAuditLogsOutput{AuditLogs: logs}
+	if len(logs) > int(pageSize) {
+		output.AuditLogs = logs[:pageSize]
+		output.NextPageToken = model.NewAuditLogCursor(output.AuditLogs[pageSize-1]).Encode()
+	}
+
+	return output, nil
+}
@@ -0,0 +1,102 @@
+package console
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/shibayama-club/keyhub/cmd/config"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+	"github.com/shibayama-club/keyhub/internal/domain/repository/mock"
+	"github.com/shibayama-club/keyhub/internal/usecase/console/dto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUseCase_SearchAuditLogs(t *testing.T) {
+	orgID := model.OrganizationID(uuid.New())
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	logs := []model.AuditLog{
+		{ID: model.AuditLogID(uuid.New()), OrganizationID: orgID, CreatedAt: now},
+		{ID: model.AuditLogID(uuid.New()), OrganizationID: orgID, CreatedAt: now.Add(-time.Minute)},
+		{ID: model.AuditLogID(uuid.New()), OrganizationID: orgID, CreatedAt: now.Add(-2 * time.Minute)},
+	}
+	cursor := model.NewAuditLogCursor(logs[0])
+
+	tests := []struct {
+		name          string
+		input         dto.SearchAuditLogsInput
+		setupMock     func(*testing.T, *mock.MockRepository)
+		wantCount     int
+		wantNextToken string
+		wantErr       error
+	}{
+		{
+			name:  "正常系: 続きがある場合は最後の記録のトークンを返す",
+			input: dto.SearchAuditLogsInput{OrganizationID: orgID, PageSize: 2},
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().
+					SearchAuditLogs(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg repository.SearchAuditLogsArg) ([]model.AuditLog, error) {
+						assert.Equal(t, int32(3), arg.Limit, "続きの有無を判定するため1件多く取得する")
+						return logs, nil
+					})
+			},
+			wantCount:     2,
+			wantNextToken: model.NewAuditLogCursor(logs[1]).Encode(),
+		},
+		{
+			name:  "正常系: トークンを指定すると続きから検索する",
+			input: dto.SearchAuditLogsInput{OrganizationID: orgID, PageToken: cursor.Encode(), ActorType: "console_operator"},
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().
+					SearchAuditLogs(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg repository.SearchAuditLogsArg) ([]model.AuditLog, error) {
+						require.NotNil(t, arg.Cursor)
+						assert.Equal(t, cursor.ID, arg.Cursor.ID)
+						assert.True(t, cursor.CreatedAt.Equal(arg.Cursor.CreatedAt))
+						assert.Equal(t, model.AuditActorTypeConsoleOperator, arg.ActorType)
+						assert.Equal(t, int32(model.AuditLogDefaultPageSize+1), arg.Limit)
+						return logs[1:], nil
+					})
+			},
+			wantCount: 2,
+		},
+		{
+			name:      "異常系: ページトークンが不正",
+			input:     dto.SearchAuditLogsInput{OrganizationID: orgID, PageToken: "invalid"},
+			setupMock: func(t *testing.T, m *mock.MockRepository) {},
+			wantErr:   domainerrors.ErrValidation,
+		},
+		{
+			name:      "異常系: 操作者の種類が不正",
+			input:     dto.SearchAuditLogsInput{OrganizationID: orgID, ActorType: "admin"},
+			setupMock: func(t *testing.T, m *mock.MockRepository) {},
+			wantErr:   domainerrors.ErrValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRepo := mock.NewMockRepository(ctrl)
+			tt.setupMock(t, mockRepo)
+
+			u := &UseCase{repo: mockRepo, config: config.Config{}}
+
+			output, err := u.SearchAuditLogs(context.Background(), tt.input)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "expected error type %v, got %v", tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, output.AuditLogs, tt.wantCount)
+			assert.Equal(t, tt.wantNextToken, output.NextPageToken)
+		})
+	}
+}
@@ -3,32 +3,40 @@ package console
 import (
 	"context"
 	"crypto/rand"
+	"crypto/subtle"
 	"encoding/hex"
+	"log/slog"
 	"time"
 
 	"github.com/cockroachdb/errors"
-	"github.com/google/uuid"
 	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
 	"github.com/shibayama-club/keyhub/internal/domain/model"
 	"github.com/shibayama-club/keyhub/internal/domain/repository"
+	"github.com/shibayama-club/keyhub/internal/usecase/console/dto"
 )
 
-func (u *UseCase) LoginWithOrgId(ctx context.Context, orgID, orgKey string) (string, int64, error) {
-	expectedOrgID := u.config.Console.OrganizationId
-	expectedOrgKey := u.config.Console.OrganizationKey
-
-	if expectedOrgID == "" {
-		expectedOrgID = DEFAULT_ORGANIZATION_ID
-	}
-	if expectedOrgKey == "" {
-		expectedOrgKey = DEFAULT_ORGANIZATION_KEY
+// LoginWithOrgId は組織IDとキーを照合し、コンソールセッションを発行する。
+// Organization Keyでログインした場合は owner、管理者のキーでログインした場合はその管理者のロールをセッションに与える
+func (u *UseCase) LoginWithOrgId(ctx context.Context, orgID, orgKey string, client model.SessionClient) (string, int64, error) {
+	var (
+		organizationID model.OrganizationID
+		role           = model.ConsoleRoleOwner
+		operatorID     *model.ConsoleOperatorID
+		err            error
+	)
+	if model.IsConsoleOperatorKey(orgKey) {
+		var operator model.ConsoleOperator
+		operator, err = u.verifyOperatorKey(ctx, orgID, orgKey)
+		if err == nil {
+			organizationID = operator.OrganizationID
+			role = operator.Role
+			operatorID = &operator.ID
+		}
+	} else {
+		organizationID, err = u.verifyOrganizationKey(ctx, orgID, orgKey)
 	}
-
-	if orgID != expectedOrgID || orgKey != expectedOrgKey {
-		return "", 0, errors.WithHint(
-			errors.New("invalid organization credentials"),
-			"組織IDまたはキーが正しくありません。",
-		)
+	if err != nil {
+		return "", 0, err
 	}
 
 	sessionBytes := make([]byte, 32)
@@ -42,16 +50,17 @@ func (u *UseCase) LoginWithOrgId(ctx context.Context, orgID, orgKey string) (str
 		return "", 0, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "failed to create session ID")
 	}
 
-	orgUUID := uuid.MustParse(orgID)
-	organizationID, err := model.NewOrganizationID(orgUUID)
-	if err != nil {
-		return "", 0, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "failed to create organization ID")
-	}
-
+	createdAt := time.Now()
+	expiresAt := u.lifetime.ExpiresAt(createdAt)
 	err = u.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
 		err := tx.CreateSession(ctx, repository.CreateConsoleSessionArg{
 			SessionID:      sessionID,
 			OrganizationID: organizationID,
+			Role:           role,
+			OperatorID:     operatorID,
+			CreatedAt:      createdAt,
+			ExpiresAt:      expiresAt,
+			Client:         client,
 		})
 		if err != nil {
 			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to create console session")
@@ -62,9 +71,9 @@ func (u *UseCase) LoginWithOrgId(ctx context.Context, orgID, orgKey string) (str
 		return "", 0, err
 	}
 
-	// JWTトークンの有効期限
-	expiresIn := 24 * time.Hour
-	token, err := u.authService.GenerateToken(orgID, sessionIDStr, expiresIn)
+	// JWTトークンの有効期限はセッションに合わせる
+	expiresIn := expiresAt.Sub(createdAt)
+	token, err := u.authService.GenerateToken(organizationID.String(), sessionIDStr, expiresIn)
 	if err != nil {
 		return "", 0, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to generate JWT token")
 	}
@@ -72,6 +81,62 @@ func (u *UseCase) LoginWithOrgId(ctx context.Context, orgID, orgKey string) (str
 	return token, int64(expiresIn.Seconds()), nil
 }
 
+// verifyOrganizationKey は組織に発行済みのキーと照合する。
+// 組織が存在しない・キーが未発行・キーが異なるのいずれも同じエラーにし、組織IDの存在を推測できないようにする
+func (u *UseCase) verifyOrganizationKey(ctx context.Context, orgID, orgKey string) (model.OrganizationID, error) {
+	organizationID, err := model.ParseOrganizationID(orgID)
+	if err != nil {
+		return model.OrganizationID{}, invalidCredentials(err)
+	}
+
+	key := model.OrganizationKey(orgKey)
+	if err := key.Validate(); err != nil {
+		return model.OrganizationID{}, invalidCredentials(err)
+	}
+
+	expectedHash, err := u.repo.GetOrganizationKeyHash(ctx, organizationID)
+	if err != nil {
+		return model.OrganizationID{}, invalidCredentials(err)
+	}
+
+	if expectedHash == "" {
+		return model.OrganizationID{}, invalidCredentials(errors.New("organization key is not issued"))
+	}
+
+	if subtle.ConstantTimeCompare([]byte(key.Hash()), []byte(expectedHash)) != 1 {
+		return model.OrganizationID{}, invalidCredentials(errors.New("organization key mismatch"))
+	}
+
+	return organizationID, nil
+}
+
+// verifyOperatorKey は管理者のキーを照合し、指定された組織の管理者であることを確認する。
+// 失敗の理由は Organization Key と同じエラーにまとめ、キーや組織の存在を推測できないようにする
+func (u *UseCase) verifyOperatorKey(ctx context.Context, orgID, operatorKey string) (model.ConsoleOperator, error) {
+	organizationID, err := model.ParseOrganizationID(orgID)
+	if err != nil {
+		return model.ConsoleOperator{}, invalidCredentials(err)
+	}
+
+	operator, err := u.repo.GetConsoleOperatorByKeyHash(ctx, model.ConsoleOperatorKey(operatorKey).Hash())
+	if err != nil {
+		return model.ConsoleOperator{}, invalidCredentials(err)
+	}
+
+	if operator.OrganizationID != organizationID {
+		return model.ConsoleOperator{}, invalidCredentials(errors.New("console operator belongs to another organization"))
+	}
+
+	return operator, nil
+}
+
+func invalidCredentials(cause error) error {
+	return errors.WithHint(
+		errors.Mark(errors.Wrap(cause, "invalid organization credentials"), domainerrors.ErrUnAuthorized),
+		"組織IDまたはキーが正しくありません。",
+	)
+}
+
 func (u *UseCase) Logout(ctx context.Context, sessionID string) error {
 	sid := model.ConsoleSessionID(sessionID)
 
@@ -89,28 +154,58 @@ func (u *UseCase) Logout(ctx context.Context, sessionID string) error {
 	return nil
 }
 
-func (u *UseCase) ValidateSession(ctx context.Context, token string) (model.ConsoleSession, error) {
+// ValidateSession はトークンとセッションの有効性を確認し、必要に応じて最終アクセス日時とクライアント情報を更新する。
+// 有効期限が近づいている場合はセッションを延長し、新しい期限のトークンを再発行する
+func (u *UseCase) ValidateSession(ctx context.Context, token string, client model.SessionClient) (dto.ValidateSessionOutput, error) {
 	claims, err := u.authService.ValidateToken(token)
 	if err != nil {
-		return model.ConsoleSession{}, errors.Wrap(errors.Mark(err, domainerrors.ErrUnAuthorized), "failed to validate token")
+		return dto.ValidateSessionOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrUnAuthorized), "failed to validate token")
 	}
 
 	sid, err := model.NewConsoleSessionID(claims.Sid)
 	if err != nil {
-		return model.ConsoleSession{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid session ID in token")
+		return dto.ValidateSessionOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid session ID in token")
 	}
 
 	session, err := u.repo.GetSession(ctx, sid)
 	if err != nil {
-		return model.ConsoleSession{}, errors.Wrap(errors.Mark(err, domainerrors.ErrNotFound), "failed to get session from database")
+		return dto.ValidateSessionOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrNotFound), "failed to get session from database")
 	}
 
 	if session.OrganizationID.String() != claims.Org {
-		return model.ConsoleSession{}, errors.WithHint(
+		return dto.ValidateSessionOutput{}, errors.WithHint(
 			errors.New("organization mismatch"),
 			"トークンの組織IDがセッションと一致しません。",
 		)
 	}
 
-	return session, nil
+	if session.ShouldTouch(client) {
+		// 最終アクセス日時の更新に失敗しても認証自体は成功させる
+		if err := u.repo.TouchSession(ctx, sid, client); err != nil {
+			slog.WarnContext(ctx, "failed to touch console session", "error", err)
+		}
+	}
+
+	now := time.Now()
+	expiresAt, renewed := u.lifetime.Renew(session.CreatedAt, session.ExpiresAt, now)
+	if !renewed {
+		return dto.ValidateSessionOutput{Session: session}, nil
+	}
+
+	if err := u.repo.ExtendSession(ctx, sid, expiresAt); err != nil {
+		return dto.ValidateSessionOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to extend console session")
+	}
+	session.ExpiresAt = expiresAt
+
+	expiresIn := expiresAt.Sub(now)
+	renewedToken, err := u.authService.GenerateToken(claims.Org, claims.Sid, expiresIn)
+	if err != nil {
+		return dto.ValidateSessionOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to regenerate JWT token")
+	}
+
+	return dto.ValidateSessionOutput{
+		Session:      session,
+		RenewedToken: renewedToken,
+		ExpiresIn:    int64(expiresIn.Seconds()),
+	}, nil
 }
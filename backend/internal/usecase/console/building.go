@@ -0,0 +1,237 @@
+package console
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+	"github.com/shibayama-club/keyhub/internal/usecase/console/dto"
+)
+
+func (u *UseCase) CreateBuilding(ctx context.Context, input dto.CreateBuildingInput) (model.Building, error) {
+	name, err := model.NewBuildingName(input.Name)
+	if err != nil {
+		return model.Building{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid building name")
+	}
+
+	description, err := model.NewBuildingDescription(input.Description)
+	if err != nil {
+		return model.Building{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid building description")
+	}
+
+	building, err := model.NewBuilding(input.OrganizationID, name, description)
+	if err != nil {
+		return model.Building{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "failed to create building")
+	}
+
+	err = u.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
+		if err := verifyBuildingNameAvailable(ctx, tx, building); err != nil {
+			return err
+		}
+
+		if err := tx.CreateBuilding(ctx, building); err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to create building in repository")
+		}
+		return nil
+	})
+	if err != nil {
+		return model.Building{}, err
+	}
+
+	return building, nil
+}
+
+// ListBuildings は組織の建物を名前順に、それぞれの階を level の昇順にまとめて返す
+func (u *UseCase) ListBuildings(ctx context.Context) ([]dto.BuildingWithFloors, error) {
+	buildings, err := u.repo.ListBuildings(ctx)
+	if err != nil {
+		return nil, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to list buildings")
+	}
+
+	floors, err := u.repo.ListFloors(ctx)
+	if err != nil {
+		return nil, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to list floors")
+	}
+
+	floorsByBuilding := lo.GroupBy(floors, func(f model.Floor) model.BuildingID {
+		return f.BuildingID
+	})
+
+	return lo.Map(buildings, func(b model.Building, _ int) dto.BuildingWithFloors {
+		return dto.BuildingWithFloors{
+			Building: b,
+			Floors:   floorsByBuilding[b.ID],
+		}
+	}), nil
+}
+
+func (u *UseCase) UpdateBuilding(ctx context.Context, input dto.UpdateBuildingInput) (model.Building, error) {
+	building, err := u.repo.GetBuilding(ctx, input.ID)
+	if err != nil {
+		return model.Building{}, errors.Wrap(errors.Mark(err, domainerrors.ErrNotFound), "building not found")
+	}
+
+	building.Name = model.BuildingName(input.Name)
+	building.Description = model.BuildingDescription(input.Description)
+	building.UpdatedAt = time.Now()
+	if err := building.Validate(); err != nil {
+		return model.Building{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid building")
+	}
+
+	err = u.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
+		if err := verifyBuildingNameAvailable(ctx, tx, building); err != nil {
+			return err
+		}
+
+		if err := tx.UpdateBuilding(ctx, building); err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to update building in repository")
+		}
+		return nil
+	})
+	if err != nil {
+		return model.Building{}, err
+	}
+
+	return building, nil
+}
+
+// DeleteBuilding は建物を削除する。階が残っている建物は削除できない
+func (u *UseCase) DeleteBuilding(ctx context.Context, id model.BuildingID) error {
+	return u.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
+		count, err := tx.CountFloorsByBuilding(ctx, id)
+		if err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to count floors")
+		}
+		if count > 0 {
+			return errors.WithHint(
+				errors.Mark(errors.New("building still has floors"), domainerrors.ErrValidation),
+				"階が残っている建物は削除できません。先に階を削除してください。",
+			)
+		}
+
+		rows, err := tx.DeleteBuilding(ctx, id)
+		if err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to delete building in repository")
+		}
+		if rows == 0 {
+			return errors.Mark(errors.New("building not found"), domainerrors.ErrNotFound)
+		}
+		return nil
+	})
+}
+
+func (u *UseCase) CreateFloor(ctx context.Context, input dto.CreateFloorInput) (model.Floor, error) {
+	name, err := model.NewFloorNumber(input.Name)
+	if err != nil {
+		return model.Floor{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid floor name")
+	}
+
+	building, err := u.repo.GetBuilding(ctx, input.BuildingID)
+	if err != nil {
+		return model.Floor{}, errors.Wrap(errors.Mark(err, domainerrors.ErrNotFound), "building not found")
+	}
+
+	floor, err := model.NewFloor(building, name, input.Level)
+	if err != nil {
+		return model.Floor{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "failed to create floor")
+	}
+
+	err = u.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
+		if err := verifyFloorNameAvailable(ctx, tx, floor); err != nil {
+			return err
+		}
+
+		if err := tx.CreateFloor(ctx, floor); err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to create floor in repository")
+		}
+		return nil
+	})
+	if err != nil {
+		return model.Floor{}, err
+	}
+
+	return floor, nil
+}
+
+func (u *UseCase) UpdateFloor(ctx context.Context, input dto.UpdateFloorInput) (model.Floor, error) {
+	floor, err := u.repo.GetFloor(ctx, input.ID)
+	if err != nil {
+		return model.Floor{}, errors.Wrap(errors.Mark(err, domainerrors.ErrNotFound), "floor not found")
+	}
+
+	floor.Name = model.FloorNumber(input.Name)
+	floor.Level = input.Level
+	floor.UpdatedAt = time.Now()
+	if err := floor.Validate(); err != nil {
+		return model.Floor{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid floor")
+	}
+
+	err = u.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
+		if err := verifyFloorNameAvailable(ctx, tx, floor); err != nil {
+			return err
+		}
+
+		if err := tx.UpdateFloor(ctx, floor); err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to update floor in repository")
+		}
+		return nil
+	})
+	if err != nil {
+		return model.Floor{}, err
+	}
+
+	return floor, nil
+}
+
+// DeleteFloor は階を削除する。部屋が残っている階は削除できない
+func (u *UseCase) DeleteFloor(ctx context.Context, id model.FloorID) error {
+	return u.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
+		count, err := tx.CountRoomsByFloor(ctx, id)
+		if err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to count rooms")
+		}
+		if count > 0 {
+			return errors.WithHint(
+				errors.Mark(errors.New("floor still has rooms"), domainerrors.ErrValidation),
+				"部屋が残っている階は削除できません。",
+			)
+		}
+
+		rows, err := tx.DeleteFloor(ctx, id)
+		if err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to delete floor in repository")
+		}
+		if rows == 0 {
+			return errors.Mark(errors.New("floor not found"), domainerrors.ErrNotFound)
+		}
+		return nil
+	})
+}
+
+// verifyBuildingNameAvailable は組織内に同じ名前の別の建物がないことを確認する
+func verifyBuildingNameAvailable(ctx context.Context, tx repository.Transaction, building model.Building) error {
+	existing, err := tx.GetBuildingByOrganizationAndName(ctx, building.OrganizationID, building.Name)
+	if err == nil && existing.ID != building.ID {
+		return errors.WithHint(
+			errors.Mark(errors.New("building name already exists"), domainerrors.ErrAlreadyExists),
+			"同じ名前の建物が既に存在します。",
+		)
+	}
+	return nil
+}
+
+// verifyFloorNameAvailable は建物内に同じ名前の別の階がないことを確認する
+func verifyFloorNameAvailable(ctx context.Context, tx repository.Transaction, floor model.Floor) error {
+	existing, err := tx.GetFloorByBuildingAndName(ctx, floor.BuildingID, floor.Name)
+	if err == nil && existing.ID != floor.ID {
+		return errors.WithHint(
+			errors.Mark(errors.New("floor name already exists"), domainerrors.ErrAlreadyExists),
+			"同じ名前の階が既に存在します。",
+		)
+	}
+	return nil
+}
@@ -0,0 +1,208 @@
+package console
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/shibayama-club/keyhub/cmd/config"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+	"github.com/shibayama-club/keyhub/internal/domain/repository/mock"
+	"github.com/shibayama-club/keyhub/internal/usecase/console/dto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUseCase_UpdateBuilding(t *testing.T) {
+	building, err := model.NewBuilding(model.OrganizationID(uuid.New()), "本館", "")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		input     dto.UpdateBuildingInput
+		setupMock func(*testing.T, *mock.MockRepository)
+		wantErr   error
+	}{
+		{
+			name:  "正常系: 名前を変更できる",
+			input: dto.UpdateBuildingInput{ID: building.ID, Name: "1号館"},
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().GetBuilding(gomock.Any(), building.ID).Return(building, nil)
+				m.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context, repository.Transaction) error) error {
+						mockTx := mock.NewMockTransaction(gomock.NewController(t))
+						mockTx.EXPECT().
+							GetBuildingByOrganizationAndName(gomock.Any(), building.OrganizationID, model.BuildingName("1号館")).
+							Return(model.Building{}, errors.New("no rows"))
+						mockTx.EXPECT().
+							UpdateBuilding(gomock.Any(), gomock.Any()).
+							DoAndReturn(func(_ context.Context, b model.Building) error {
+								assert.Equal(t, building.ID, b.ID)
+								assert.Equal(t, model.BuildingName("1号館"), b.Name)
+								return nil
+							})
+						return fn(ctx, mockTx)
+					})
+			},
+		},
+		{
+			name:  "正常系: 名前を変えずに説明だけ変更できる",
+			input: dto.UpdateBuildingInput{ID: building.ID, Name: "本館", Description: "正門側の建物"},
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().GetBuilding(gomock.Any(), building.ID).Return(building, nil)
+				m.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context, repository.Transaction) error) error {
+						mockTx := mock.NewMockTransaction(gomock.NewController(t))
+						mockTx.EXPECT().
+							GetBuildingByOrganizationAndName(gomock.Any(), building.OrganizationID, model.BuildingName("本館")).
+							Return(building, nil)
+						mockTx.EXPECT().UpdateBuilding(gomock.Any(), gomock.Any()).Return(nil)
+						return fn(ctx, mockTx)
+					})
+			},
+		},
+		{
+			name:  "異常系: 同じ名前の別の建物が存在する",
+			input: dto.UpdateBuildingInput{ID: building.ID, Name: "西館"},
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().GetBuilding(gomock.Any(), building.ID).Return(building, nil)
+				m.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context, repository.Transaction) error) error {
+						mockTx := mock.NewMockTransaction(gomock.NewController(t))
+						mockTx.EXPECT().
+							GetBuildingByOrganizationAndName(gomock.Any(), building.OrganizationID, model.BuildingName("西館")).
+							Return(model.Building{ID: model.BuildingID(uuid.New())}, nil)
+						return fn(ctx, mockTx)
+					})
+			},
+			wantErr: domainerrors.ErrAlreadyExists,
+		},
+		{
+			name:  "異常系: 名前が空",
+			input: dto.UpdateBuildingInput{ID: building.ID},
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().GetBuilding(gomock.Any(), building.ID).Return(building, nil)
+			},
+			wantErr: domainerrors.ErrValidation,
+		},
+		{
+			name:  "異常系: 建物が存在しない",
+			input: dto.UpdateBuildingInput{ID: building.ID, Name: "1号館"},
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().GetBuilding(gomock.Any(), building.ID).Return(model.Building{}, errors.New("no rows"))
+			},
+			wantErr: domainerrors.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRepo := mock.NewMockRepository(ctrl)
+			tt.setupMock(t, mockRepo)
+
+			u := &UseCase{repo: mockRepo, config: config.Config{}}
+
+			got, err := u.UpdateBuilding(context.Background(), tt.input)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "expected error type %v, got %v", tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, model.BuildingName(tt.input.Name), got.Name)
+		})
+	}
+}
+
+func TestUseCase_DeleteFloor(t *testing.T) {
+	floorID := model.FloorID(uuid.New())
+
+	tests := []struct {
+		name      string
+		roomCount int32
+		rows      int64
+		wantErr   error
+	}{
+		{name: "正常系: 部屋のない階を削除できる", rows: 1},
+		{name: "異常系: 部屋が残っている階は削除できない", roomCount: 2, wantErr: domainerrors.ErrValidation},
+		{name: "異常系: 階が存在しない", rows: 0, wantErr: domainerrors.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRepo := mock.NewMockRepository(ctrl)
+			mockRepo.EXPECT().
+				WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context, repository.Transaction) error) error {
+					mockTx := mock.NewMockTransaction(ctrl)
+					mockTx.EXPECT().CountRoomsByFloor(gomock.Any(), floorID).Return(tt.roomCount, nil)
+					if tt.roomCount == 0 {
+						mockTx.EXPECT().DeleteFloor(gomock.Any(), floorID).Return(tt.rows, nil)
+					}
+					return fn(ctx, mockTx)
+				})
+
+			u := &UseCase{repo: mockRepo, config: config.Config{}}
+
+			err := u.DeleteFloor(context.Background(), floorID)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "expected error type %v, got %v", tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestUseCase_GetAllRooms_GroupByFloor(t *testing.T) {
+	orgID := model.OrganizationID(uuid.New())
+	mainHall, err := model.NewBuilding(orgID, "本館", "")
+	require.NoError(t, err)
+	westHall, err := model.NewBuilding(orgID, "西館", "")
+	require.NoError(t, err)
+	newHall, err := model.NewBuilding(orgID, "新館", "")
+	require.NoError(t, err)
+	mainFirst, err := model.NewFloor(mainHall, "1F", 1)
+	require.NoError(t, err)
+	mainThird, err := model.NewFloor(mainHall, "3F", 3)
+	require.NoError(t, err)
+	westBasement, err := model.NewFloor(westHall, "B1F", -1)
+	require.NoError(t, err)
+
+	meetingRoom, err := model.NewRoom(orgID, "会議室A", mainHall, mainThird, model.RoomTypeMeetingRoom, "", model.RoomAttributes{})
+	require.NoError(t, err)
+	office, err := model.NewRoom(orgID, "総務課オフィス", mainHall, mainFirst, model.RoomTypeOffice, "", model.RoomAttributes{})
+	require.NoError(t, err)
+	workshop, err := model.NewRoom(orgID, "工作室B", westHall, westBasement, model.RoomTypeWorkshop, "", model.RoomAttributes{})
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	mockRepo := mock.NewMockRepository(ctrl)
+	mockRepo.EXPECT().ListRooms(gomock.Any(), gomock.Any()).Return([]model.Room{meetingRoom, workshop, office}, nil)
+	mockRepo.EXPECT().GetKeysByRoom(gomock.Any(), gomock.Any()).Return(nil, nil).Times(3)
+	mockRepo.EXPECT().ListBuildings(gomock.Any()).Return([]model.Building{newHall, mainHall, westHall}, nil)
+	mockRepo.EXPECT().ListFloors(gomock.Any()).Return([]model.Floor{mainFirst, mainThird, westBasement}, nil)
+
+	u := &UseCase{repo: mockRepo, config: config.Config{}, pageTokens: model.NewRandomPageTokenCodec()}
+
+	output, err := u.GetAllRooms(context.Background(), dto.GetAllRoomsInput{GroupByFloor: true})
+	require.NoError(t, err)
+	assert.Len(t, output.Rooms, 3)
+
+	require.Len(t, output.Buildings, 2, "部屋のない建物は含めない")
+	assert.Equal(t, mainHall.ID, output.Buildings[0].Building.ID)
+	require.Len(t, output.Buildings[0].Floors, 2)
+	assert.Equal(t, mainFirst.ID, output.Buildings[0].Floors[0].Floor.ID, "階は level の昇順")
+	assert.Equal(t, office.ID, output.Buildings[0].Floors[0].Rooms[0].Room.ID)
+	assert.Equal(t, meetingRoom.ID, output.Buildings[0].Floors[1].Rooms[0].Room.ID)
+	assert.Equal(t, westHall.ID, output.Buildings[1].Building.ID)
+	assert.Equal(t, workshop.ID, output.Buildings[1].Floors[0].Rooms[0].Room.ID)
+}
@@ -3,23 +3,32 @@ package console
 import (
 	"context"
 
+	"github.com/cockroachdb/errors"
 	"github.com/shibayama-club/keyhub/cmd/config"
 	"github.com/shibayama-club/keyhub/internal/domain/authenticator"
+	"github.com/shibayama-club/keyhub/internal/domain/keywatch"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
 	"github.com/shibayama-club/keyhub/internal/domain/repository"
+	"github.com/shibayama-club/keyhub/internal/domain/webhook"
 	"github.com/shibayama-club/keyhub/internal/usecase/console/iface"
 )
 
 // 開発用環境変数
 const (
-	DEFAULT_ORGANIZATION_ID  = "550e8400-e29b-41d4-a716-446655440000"
-	DEFAULT_ORGANIZATION_KEY = "org_key_example_12345"
-	DEFAULT_JWT_SECRET       = "your-secret-jwt-key-change-in-production"
+	DEFAULT_JWT_SECRET = "your-secret-jwt-key-change-in-production"
 )
 
+// platformAdminTokenMinLength はプラットフォーム管理者トークンに求める最低文字数
+const platformAdminTokenMinLength = 32
+
 type UseCase struct {
 	repo        repository.Repository
 	config      config.Config
 	authService authenticator.ConsoleAuthenticator
+	lifetime    model.SessionLifetime
+	webhook     webhook.Sender
+	keyWatcher  keywatch.Watcher
+	pageTokens  model.PageTokenCodec
 }
 
 var _ iface.IUseCase = (*UseCase)(nil)
@@ -29,10 +38,26 @@ func NewUseCase(
 	repo repository.Repository,
 	cf config.Config,
 	auth authenticator.ConsoleAuthenticator,
+	webhookSender webhook.Sender,
+	keyWatcher keywatch.Watcher,
+	pageTokens model.PageTokenCodec,
 ) (iface.IUseCase, error) {
+	lifetime, err := model.NewSessionLifetime(cf.Session.Console.IdleTimeout, cf.Session.Console.AbsoluteTimeout)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid console session lifetime")
+	}
+
+	if token := cf.Console.PlatformAdminToken; token != "" && len(token) < platformAdminTokenMinLength {
+		return nil, errors.Newf("console.platform_admin_token must be at least %d characters", platformAdminTokenMinLength)
+	}
+
 	return &UseCase{
 		repo:        repo,
 		config:      cf,
 		authService: auth,
+		lifetime:    lifetime,
+		webhook:     webhookSender,
+		keyWatcher:  keyWatcher,
+		pageTokens:  pageTokens,
 	}, nil
 }
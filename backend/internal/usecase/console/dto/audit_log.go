@@ -0,0 +1,27 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+)
+
+// SearchAuditLogsInput は監査ログの検索条件。空文字や nil の条件は絞り込みに使わない
+type SearchAuditLogsInput struct {
+	OrganizationID model.OrganizationID
+	ActorType      string
+	ActorID        string
+	Procedure      string
+	ResultCode     string
+	TargetID       string
+	Since          *time.Time
+	Until          *time.Time
+	PageSize       int32
+	PageToken      string
+}
+
+type SearchAuditLogsOutput struct {
+	AuditLogs []model.AuditLog
+	// NextPageToken は続きがある場合に次の検索で指定するトークン。最後のページでは空
+	NextPageToken string
+}
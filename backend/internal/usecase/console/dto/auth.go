@@ -0,0 +1,10 @@
+package dto
+
+import "github.com/shibayama-club/keyhub/internal/domain/model"
+
+type ValidateSessionOutput struct {
+	Session model.ConsoleSession
+	// RenewedToken は有効期限をスライディング更新した場合に再発行したトークン。更新していなければ空
+	RenewedToken string
+	ExpiresIn    int64
+}
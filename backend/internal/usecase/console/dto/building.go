@@ -0,0 +1,33 @@
+package dto
+
+import "github.com/shibayama-club/keyhub/internal/domain/model"
+
+type CreateBuildingInput struct {
+	OrganizationID model.OrganizationID
+	Name           string
+	Description    string
+}
+
+type UpdateBuildingInput struct {
+	ID          model.BuildingID
+	Name        string
+	Description string
+}
+
+type CreateFloorInput struct {
+	BuildingID model.BuildingID
+	Name       string
+	Level      int32
+}
+
+type UpdateFloorInput struct {
+	ID    model.FloorID
+	Name  string
+	Level int32
+}
+
+// BuildingWithFloors は建物とその階。階は level の昇順
+type BuildingWithFloors struct {
+	Building model.Building
+	Floors   []model.Floor
+}
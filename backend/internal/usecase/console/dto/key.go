@@ -9,3 +9,50 @@ type CreateKeyInput struct {
 	OrganizationID model.OrganizationID
 	KeyNumber      string
 }
+
+// UpdateKeyInput は鍵番号を変更する
+type UpdateKeyInput struct {
+	KeyID     model.KeyID
+	KeyNumber string
+	// Version は取得時の版数。他の更新で版数が変わっていれば更新しない
+	Version int64
+}
+
+// WatchKeysInput の RoomID と TenantID はどちらか一方だけを指定する。
+// どちらも省略した場合は組織のすべての鍵を購読する
+type WatchKeysInput struct {
+	OrganizationID model.OrganizationID
+	RoomID         *model.RoomID
+	TenantID       *model.TenantID
+}
+
+type WatchKeysEventType int
+
+const (
+	WatchKeysEventSnapshot WatchKeysEventType = iota + 1
+	WatchKeysEventChange
+	WatchKeysEventHeartbeat
+)
+
+// WatchKeysEvent は鍵の購読で送るイベント。最初に1度だけ Snapshot を送り、その後は Change か Heartbeat を送る
+type WatchKeysEvent struct {
+	Type     WatchKeysEventType
+	Snapshot []model.Key
+	Change   model.KeyChange
+}
+
+// GetKeysByRoomInput は部屋の鍵の一覧の条件。空文字の条件は絞り込みに使わない
+type GetKeysByRoomInput struct {
+	RoomID          model.RoomID
+	Status          string
+	KeyNumberPrefix string
+	Order           string
+	PageSize        int32
+	PageToken       string
+}
+
+type GetKeysByRoomOutput struct {
+	Keys []model.Key
+	// NextPageToken は続きがある場合に次の取得で指定するトークン。最後のページでは空
+	NextPageToken string
+}
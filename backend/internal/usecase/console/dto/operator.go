@@ -0,0 +1,15 @@
+package dto
+
+import "github.com/shibayama-club/keyhub/internal/domain/model"
+
+type CreateOperatorInput struct {
+	OrganizationID model.OrganizationID
+	Name           string
+	Role           string
+}
+
+type CreateOperatorOutput struct {
+	Operator model.ConsoleOperator
+	// Key は発行したログインキー。保存しないため再取得はできない
+	Key model.ConsoleOperatorKey
+}
@@ -0,0 +1,15 @@
+package dto
+
+import "github.com/shibayama-club/keyhub/internal/domain/model"
+
+type CreateOrganizationInput struct {
+	Name     string
+	Slug     string
+	Settings model.OrganizationSettings
+}
+
+type CreateOrganizationOutput struct {
+	Organization model.Organization
+	// Key はConsoleログインに使うキー。保存しないため再取得はできない
+	Key model.OrganizationKey
+}
@@ -9,14 +9,93 @@ import (
 type CreateRoomInput struct {
 	OrganizationID model.OrganizationID
 	Name           string
-	BuildingName   string
-	FloorNumber    string
+	FloorID        model.FloorID
 	RoomType       string
 	Description    string
+	Attributes     RoomAttributesInput
+}
+
+// RoomAttributesInput は部屋の属性。CustomFields は組織のカスタム項目の定義で検証する
+type RoomAttributesInput struct {
+	Capacity      int32
+	Equipment     []string
+	Accessibility []string
+	CustomFields  map[string]string
+}
+
+// UpdateRoomAttributesInput は部屋の属性をすべて置き換える
+type UpdateRoomAttributesInput struct {
+	RoomID     model.RoomID
+	Attributes RoomAttributesInput
+	// Version は取得時の版数。他の更新で版数が変わっていれば更新しない
+	Version int64
+}
+
+// CreateRoomTypeInput は組織が追加する部屋タイプ。Key は rooms.room_type に保存する値
+type CreateRoomTypeInput struct {
+	OrganizationID model.OrganizationID
+	Key            string
+	Label          string
+}
+
+type CreateRoomCustomFieldInput struct {
+	OrganizationID model.OrganizationID
+	Key            string
+	Label          string
+	Type           string
+	Required       bool
+	Options        []string
 }
 
 type AssignRoomToTenantInput struct {
-	TenantID  model.TenantID
-	RoomID    model.RoomID
-	ExpiresAt *time.Time
+	TenantID model.TenantID
+	RoomID   model.RoomID
+	// GroupID を指定するとそのグループ（子グループを含む）のメンバーだけが部屋を利用できる
+	GroupID *model.TenantGroupID
+	// KeyLoanGroupID を指定すると鍵を借りられるのはそのグループのメンバーだけになる
+	KeyLoanGroupID *model.TenantGroupID
+	ExpiresAt      *time.Time
+}
+
+// GetAllRoomsInput は部屋の一覧の条件。空文字の条件は絞り込みに使わない
+type GetAllRoomsInput struct {
+	BuildingID   *model.BuildingID
+	FloorID      *model.FloorID
+	BuildingName string
+	FloorNumber  string
+	RoomType     string
+	NamePrefix   string
+	// MinCapacity 以上の収容人数で、Equipment・Accessibility・CustomFields をすべて持つ部屋に絞り込む
+	MinCapacity   int32
+	Equipment     []string
+	Accessibility []string
+	CustomFields  map[string]string
+	Order         string
+	PageSize      int32
+	PageToken     string
+	// GroupByFloor が true の場合、取得したページの部屋を建物・階ごとにまとめた Buildings も返す
+	GroupByFloor bool
+}
+
+type RoomWithKeys struct {
+	Room model.Room
+	Keys []model.Key
+}
+
+type GetAllRoomsOutput struct {
+	Rooms []RoomWithKeys
+	// NextPageToken は続きがある場合に次の取得で指定するトークン。最後のページでは空
+	NextPageToken string
+	Buildings     []BuildingRooms
+}
+
+// BuildingRooms は建物ごとの部屋。建物は名前順、階は level の昇順
+type BuildingRooms struct {
+	Building model.Building
+	Floors   []FloorRooms
+}
+
+type FloorRooms struct {
+	Floor model.Floor
+	Rooms []RoomWithKeys
 }
@@ -0,0 +1,11 @@
+package dto
+
+import "github.com/shibayama-club/keyhub/internal/domain/model"
+
+// SearchInput は横断検索の条件。Types が空の場合はすべての種類を探す
+type SearchInput struct {
+	OrganizationID model.OrganizationID
+	Query          string
+	Types          []string
+	Limit          int32
+}
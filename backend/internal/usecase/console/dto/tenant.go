@@ -6,6 +6,13 @@ import (
 	"github.com/shibayama-club/keyhub/internal/domain/model"
 )
 
+// CreateTenantTypeInput は組織が追加するテナントタイプ。Key は tenants.tenant_type に保存する値
+type CreateTenantTypeInput struct {
+	OrganizationID model.OrganizationID
+	Key            string
+	Label          string
+}
+
 type CreateTenantInput struct {
 	OrganizationID model.OrganizationID
 	Name           string
@@ -24,9 +31,33 @@ type UpdateTenantInput struct {
 	JoinCode       string
 	JoinCodeExpiry *time.Time
 	JoinCodeMaxUse int32
+	// Version は取得時の版数。他の更新で版数が変わっていれば更新しない
+	Version int64
+}
+
+// DeleteTenantInput の Actor と Client は、削除したテナントの墓標に記録する操作者と接続元
+type DeleteTenantInput struct {
+	TenantID model.TenantID
+	Actor    model.AuditActor
+	Client   model.SessionClient
 }
 
 type GetTenantByIdOutput struct {
 	Tenant   model.Tenant
 	JoinCode model.TenantJoinCodeEntity
 }
+
+// GetAllTenantsInput はテナントの一覧の条件。空文字の条件は絞り込みに使わない
+type GetAllTenantsInput struct {
+	TenantType string
+	NamePrefix string
+	Order      string
+	PageSize   int32
+	PageToken  string
+}
+
+type GetAllTenantsOutput struct {
+	Tenants []model.Tenant
+	// NextPageToken は続きがある場合に次の取得で指定するトークン。最後のページでは空
+	NextPageToken string
+}
@@ -0,0 +1,16 @@
+package dto
+
+import "github.com/shibayama-club/keyhub/internal/domain/model"
+
+type CreateTenantGroupInput struct {
+	TenantID model.TenantID
+	// ParentGroupID を指定すると子グループとして作成する
+	ParentGroupID *model.TenantGroupID
+	Name          string
+	Description   string
+}
+
+type TenantGroupOutput struct {
+	Group       model.TenantGroup
+	MemberCount int32
+}
@@ -0,0 +1,16 @@
+package dto
+
+import "github.com/shibayama-club/keyhub/internal/domain/model"
+
+type CreateWebhookSubscriptionInput struct {
+	OrganizationID model.OrganizationID
+	URL            string
+	EventTypes     []string
+	Description    string
+}
+
+type ListWebhookDeliveriesInput struct {
+	OrganizationID model.OrganizationID
+	SubscriptionID string
+	PageSize       int32
+}
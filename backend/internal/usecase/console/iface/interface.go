@@ -10,16 +10,67 @@ import (
 )
 
 type IUseCase interface {
-	LoginWithOrgId(ctx context.Context, orgID, orgKey string) (string, int64, error)
+	LoginWithOrgId(ctx context.Context, orgID, orgKey string, client model.SessionClient) (string, int64, error)
 	Logout(ctx context.Context, sessionID string) error
-	ValidateSession(ctx context.Context, token string) (model.ConsoleSession, error)
+	ValidateSession(ctx context.Context, token string, client model.SessionClient) (dto.ValidateSessionOutput, error)
+	ListSessions(ctx context.Context, organizationID model.OrganizationID) ([]model.ConsoleSession, error)
+	RevokeSession(ctx context.Context, organizationID model.OrganizationID, sessionID string) error
+	RevokeAllOtherSessions(ctx context.Context, organizationID model.OrganizationID, currentSessionID model.ConsoleSessionID) (int64, error)
+	GetOrganization(ctx context.Context, organizationID model.OrganizationID) (model.Organization, error)
+	AuthenticatePlatformAdmin(ctx context.Context, token string) error
+	CreateOrganization(ctx context.Context, input dto.CreateOrganizationInput) (dto.CreateOrganizationOutput, error)
+	ListOrganizations(ctx context.Context) ([]model.Organization, error)
+	RotateOrganizationKey(ctx context.Context, organizationID string) (model.OrganizationKey, error)
 	CreateTenant(ctx context.Context, input dto.CreateTenantInput) (string, error)
-	GetAllTenants(ctx context.Context) ([]model.Tenant, error)
+	GetAllTenants(ctx context.Context, input dto.GetAllTenantsInput) (dto.GetAllTenantsOutput, error)
 	GetTenantById(ctx context.Context, tenantId model.TenantID) (dto.GetTenantByIdOutput, error)
-	UpdateTenant(ctx context.Context, input dto.UpdateTenantInput) error
+	UpdateTenant(ctx context.Context, input dto.UpdateTenantInput) (int64, error)
+	ArchiveTenant(ctx context.Context, tenantID model.TenantID) (model.Tenant, error)
+	DeleteTenant(ctx context.Context, input dto.DeleteTenantInput) error
+	ListTenantTypes(ctx context.Context) ([]model.TenantTypeDefinition, error)
+	CreateTenantType(ctx context.Context, input dto.CreateTenantTypeInput) (model.TenantTypeDefinition, error)
+	DeleteTenantType(ctx context.Context, id model.TenantTypeID) error
+	CreateTenantGroup(ctx context.Context, input dto.CreateTenantGroupInput) (model.TenantGroup, error)
+	ListTenantGroups(ctx context.Context, tenantID model.TenantID) ([]dto.TenantGroupOutput, error)
+	AddTenantGroupMember(ctx context.Context, groupID model.TenantGroupID, userID model.UserID) error
+	RemoveTenantGroupMember(ctx context.Context, groupID model.TenantGroupID, userID model.UserID) error
+	ListTenantGroupMembers(ctx context.Context, groupID model.TenantGroupID) ([]model.User, error)
+	CreateBuilding(ctx context.Context, input dto.CreateBuildingInput) (model.Building, error)
+	ListBuildings(ctx context.Context) ([]dto.BuildingWithFloors, error)
+	UpdateBuilding(ctx context.Context, input dto.UpdateBuildingInput) (model.Building, error)
+	DeleteBuilding(ctx context.Context, id model.BuildingID) error
+	CreateFloor(ctx context.Context, input dto.CreateFloorInput) (model.Floor, error)
+	UpdateFloor(ctx context.Context, input dto.UpdateFloorInput) (model.Floor, error)
+	DeleteFloor(ctx context.Context, id model.FloorID) error
 	CreateRoom(ctx context.Context, input dto.CreateRoomInput) (string, error)
-	GetAllRooms(ctx context.Context) ([]model.Room, error)
+	GetAllRooms(ctx context.Context, input dto.GetAllRoomsInput) (dto.GetAllRoomsOutput, error)
+	UpdateRoomAttributes(ctx context.Context, input dto.UpdateRoomAttributesInput) (model.Room, error)
+	CreateRoomCustomField(ctx context.Context, input dto.CreateRoomCustomFieldInput) (model.RoomCustomField, error)
+	ListRoomCustomFields(ctx context.Context) (model.RoomCustomFieldSchema, error)
+	DeleteRoomCustomField(ctx context.Context, id model.RoomCustomFieldID) error
+	ListRoomTypes(ctx context.Context) ([]model.RoomTypeDefinition, error)
+	CreateRoomType(ctx context.Context, input dto.CreateRoomTypeInput) (model.RoomTypeDefinition, error)
+	DeleteRoomType(ctx context.Context, id model.RoomTypeID) error
 	AssignRoomToTenant(ctx context.Context, input dto.AssignRoomToTenantInput) (string, error)
 	CreateKey(ctx context.Context, input dto.CreateKeyInput) (string, error)
-	GetKeysByRoom(ctx context.Context, roomID model.RoomID) ([]model.Key, error)
+	// UpdateKey は鍵番号を変更し、更新後の鍵を返す。取得後に他の更新があった場合は ErrConflict を返す
+	UpdateKey(ctx context.Context, input dto.UpdateKeyInput) (model.Key, error)
+	GetKeysByRoom(ctx context.Context, input dto.GetKeysByRoomInput) (dto.GetKeysByRoomOutput, error)
+	// WatchKeys は鍵の一覧を send で送ったあと、ctx が終了するまで鍵の変更を送り続ける
+	WatchKeys(ctx context.Context, input dto.WatchKeysInput, send func(dto.WatchKeysEvent) error) error
+	CreateAPIToken(ctx context.Context, input dto.CreateAPITokenInput) (dto.CreateAPITokenOutput, error)
+	ListAPITokens(ctx context.Context, organizationID model.OrganizationID) ([]model.APIToken, error)
+	RevokeAPIToken(ctx context.Context, organizationID model.OrganizationID, tokenID string) error
+	AuthenticateAPIToken(ctx context.Context, token string) (model.APIToken, error)
+	CreateOperator(ctx context.Context, input dto.CreateOperatorInput) (dto.CreateOperatorOutput, error)
+	ListOperators(ctx context.Context, organizationID model.OrganizationID) ([]model.ConsoleOperator, error)
+	RevokeOperator(ctx context.Context, organizationID model.OrganizationID, operatorID string) error
+	RecordAuditLog(ctx context.Context, log model.AuditLog) error
+	SearchAuditLogs(ctx context.Context, input dto.SearchAuditLogsInput) (dto.SearchAuditLogsOutput, error)
+	Search(ctx context.Context, input dto.SearchInput) ([]model.SearchResult, error)
+	CreateWebhookSubscription(ctx context.Context, input dto.CreateWebhookSubscriptionInput) (model.WebhookSubscription, error)
+	ListWebhookSubscriptions(ctx context.Context, organizationID model.OrganizationID) ([]model.WebhookSubscription, error)
+	DeleteWebhookSubscription(ctx context.Context, organizationID model.OrganizationID, subscriptionID string) error
+	ListWebhookDeliveries(ctx context.Context, input dto.ListWebhookDeliveriesInput) ([]model.WebhookDelivery, error)
+	SendTestWebhookEvent(ctx context.Context, organizationID model.OrganizationID, subscriptionID string) (model.WebhookDelivery, error)
 }
@@ -2,9 +2,12 @@ package console
 
 import (
 	"context"
+	"slices"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/keywatch"
 	"github.com/shibayama-club/keyhub/internal/domain/model"
 	"github.com/shibayama-club/keyhub/internal/domain/repository"
 	"github.com/shibayama-club/keyhub/internal/usecase/console/dto"
@@ -42,7 +45,7 @@ func (u *UseCase) CreateKey(ctx context.Context, input dto.CreateKeyInput) (stri
 		if err != nil {
 			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to create key in repository")
 		}
-		return nil
+		return publishEvent(ctx, tx, model.NewKeyCreatedEvent(key))
 	})
 	if err != nil {
 		return "", err
@@ -51,10 +54,186 @@ func (u *UseCase) CreateKey(ctx context.Context, input dto.CreateKeyInput) (stri
 	return key.ID.String(), nil
 }
 
-func (u *UseCase) GetKeysByRoom(ctx context.Context, roomID model.RoomID) ([]model.Key, error) {
-	keys, err := u.repo.GetKeysByRoom(ctx, roomID)
+// UpdateKey は鍵番号を変更する
+func (u *UseCase) UpdateKey(ctx context.Context, input dto.UpdateKeyInput) (model.Key, error) {
+	if input.Version <= 0 {
+		return model.Key{}, errors.WithHint(
+			errors.Mark(errors.New("key version is required"), domainerrors.ErrValidation),
+			"取得した鍵の version を指定してください。",
+		)
+	}
+
+	keyNumber, err := model.NewKeyNumber(input.KeyNumber)
+	if err != nil {
+		return model.Key{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid key number")
+	}
+
+	var key model.Key
+	err = u.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
+		updated, err := tx.UpdateKeyNumber(ctx, input.KeyID, keyNumber, input.Version)
+		if err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to update key in repository")
+		}
+		// 鍵が削除されたのか、他の更新で版数が変わったのかを同じトランザクションで読み直して区別する
+		key, err = tx.GetKeyByID(ctx, input.KeyID)
+		if err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrNotFound), "key not found")
+		}
+		if updated == 0 {
+			return errors.WithHint(
+				errors.Mark(errors.New("key was updated by another request"), domainerrors.ErrConflict),
+				"他の管理者がこの鍵を更新しました。再読み込みしてから編集し直してください。",
+			)
+		}
+		return nil
+	})
+	if err != nil {
+		return model.Key{}, err
+	}
+
+	return key, nil
+}
+
+// GetKeysByRoom は部屋の鍵を条件で絞り込み、1ページ分を返す。
+// 続きの有無を判定するため、指定件数より1件多く取得する
+func (u *UseCase) GetKeysByRoom(ctx context.Context, input dto.GetKeysByRoomInput) (dto.GetKeysByRoomOutput, error) {
+	order, err := model.ParseListOrder(input.Order)
+	if err != nil {
+		return dto.GetKeysByRoomOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid list order")
+	}
+
+	arg := repository.ListKeysByRoomArg{
+		RoomID:          input.RoomID,
+		KeyNumberPrefix: input.KeyNumberPrefix,
+		Order:           order,
+	}
+	if input.Status != "" {
+		status, err := model.NewKeyStatus(input.Status)
+		if err != nil {
+			return dto.GetKeysByRoomOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid key status")
+		}
+		arg.Status = status
+	}
+
+	query := model.PageQuery("keys", order, map[string]string{
+		"room_id":           input.RoomID.String(),
+		"status":            input.Status,
+		"key_number_prefix": input.KeyNumberPrefix,
+	})
+	arg.Cursor, err = u.decodePageToken(query, input.PageToken)
+	if err != nil {
+		return dto.GetKeysByRoomOutput{}, err
+	}
+	pageSize := model.NormalizePageSize(input.PageSize)
+	arg.Limit = pageSize + 1
+
+	keys, err := u.repo.ListKeysByRoom(ctx, arg)
+	if err != nil {
+		return dto.GetKeysByRoomOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to list keys by room")
+	}
+	keys, hasNext := model.SplitPage(keys, pageSize)
+
+	output := dto.GetKeysByRoomOutput{Keys: keys}
+	if hasNext {
+		last := keys[len(keys)-1]
+		output.NextPageToken = u.pageTokens.Encode(query, model.PageCursor{
+			CreatedAt: last.CreatedAt,
+			Name:      last.KeyNumber.String(),
+			ID:        last.ID.UUID(),
+		})
+	}
+
+	return output, nil
+}
+
+// keyWatchHeartbeatInterval は鍵の購読で、変更がなくても接続を保つためにハートビートを送る間隔
+const keyWatchHeartbeatInterval = 30 * time.Second
+
+func (u *UseCase) WatchKeys(ctx context.Context, input dto.WatchKeysInput, send func(dto.WatchKeysEvent) error) error {
+	if input.RoomID != nil && input.TenantID != nil {
+		return errors.Wrap(errors.Mark(
+			errors.WithHint(errors.New("both room and tenant are specified"), "部屋とテナントはどちらか一方だけを指定してください。"),
+			domainerrors.ErrValidation,
+		), "invalid watch scope")
+	}
+
+	// 一覧の取得中に行われた変更を取りこぼさないよう、一覧より先に購読を始める。
+	// そのため一覧と最初の変更に同じ状態が含まれることがある
+	watchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	changes := u.keyWatcher.Watch(watchCtx, input.OrganizationID)
+
+	snapshot, inScope, err := u.keyWatchScope(ctx, input)
 	if err != nil {
-		return nil, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to get keys by room")
+		return err
+	}
+	if err := send(dto.WatchKeysEvent{Type: dto.WatchKeysEventSnapshot, Snapshot: snapshot}); err != nil {
+		return errors.Wrap(err, "failed to send key snapshot")
+	}
+
+	ticker := time.NewTicker(keyWatchHeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case change, ok := <-changes:
+			if !ok {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return errors.Wrap(keywatch.ErrInterrupted, "key changes may have been missed")
+			}
+			if !inScope(change.Key) {
+				continue
+			}
+			if err := send(dto.WatchKeysEvent{Type: dto.WatchKeysEventChange, Change: change}); err != nil {
+				return errors.Wrap(err, "failed to send key change")
+			}
+		case <-ticker.C:
+			if err := send(dto.WatchKeysEvent{Type: dto.WatchKeysEventHeartbeat}); err != nil {
+				return errors.Wrap(err, "failed to send heartbeat")
+			}
+		}
+	}
+}
+
+// keyWatchScope は購読する範囲の現在の鍵の一覧と、変更が範囲に含まれるかの判定を返す。
+// テナントの範囲は購読を始めた時点で割り当てられている部屋で決め、その後の割り当ての変更は反映しない
+func (u *UseCase) keyWatchScope(ctx context.Context, input dto.WatchKeysInput) ([]model.Key, func(model.Key) bool, error) {
+	switch {
+	case input.RoomID != nil:
+		roomID := *input.RoomID
+		if _, err := u.repo.GetRoomByID(ctx, roomID); err != nil {
+			return nil, nil, errors.Wrap(errors.Mark(err, domainerrors.ErrNotFound), "room not found")
+		}
+		keys, err := u.repo.GetKeysByRoom(ctx, roomID)
+		if err != nil {
+			return nil, nil, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to get keys by room")
+		}
+		return keys, func(key model.Key) bool { return key.RoomID == roomID }, nil
+
+	case input.TenantID != nil:
+		if _, err := u.repo.GetTenantByID(ctx, *input.TenantID); err != nil {
+			return nil, nil, errors.Wrap(errors.Mark(err, domainerrors.ErrNotFound), "tenant not found")
+		}
+		roomIDs, err := u.repo.GetAssignedRoomIDsByTenant(ctx, *input.TenantID)
+		if err != nil {
+			return nil, nil, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to get rooms assigned to tenant")
+		}
+		keys, err := u.repo.GetKeysByOrganization(ctx, input.OrganizationID)
+		if err != nil {
+			return nil, nil, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to get keys")
+		}
+		inScope := func(key model.Key) bool { return slices.Contains(roomIDs, key.RoomID) }
+		return slices.DeleteFunc(keys, func(key model.Key) bool { return !inScope(key) }), inScope, nil
+
+	default:
+		keys, err := u.repo.GetKeysByOrganization(ctx, input.OrganizationID)
+		if err != nil {
+			return nil, nil, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to get keys")
+		}
+		return keys, func(model.Key) bool { return true }, nil
 	}
-	return keys, nil
 }
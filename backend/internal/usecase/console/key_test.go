@@ -0,0 +1,253 @@
+package console
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/shibayama-club/keyhub/cmd/config"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/keywatch"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+	"github.com/shibayama-club/keyhub/internal/domain/repository/mock"
+	"github.com/shibayama-club/keyhub/internal/usecase/console/dto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// fakeKeyWatcher は用意した変更をそのまま返す。close すると取りこぼしとして扱われる
+type fakeKeyWatcher struct {
+	changes chan model.KeyChange
+}
+
+func (w fakeKeyWatcher) Watch(context.Context, model.OrganizationID) <-chan model.KeyChange {
+	return w.changes
+}
+
+func TestUseCase_WatchKeys(t *testing.T) {
+	orgID := model.OrganizationID(uuid.New())
+	room := model.Room{ID: model.RoomID(uuid.New()), OrganizationID: orgID}
+	otherRoomID := model.RoomID(uuid.New())
+	tenantID := model.TenantID(uuid.New())
+
+	keyInRoom := model.Key{ID: model.KeyID(uuid.New()), RoomID: room.ID, OrganizationID: orgID, Status: model.KeyStatusAvailable}
+	keyInOtherRoom := model.Key{ID: model.KeyID(uuid.New()), RoomID: otherRoomID, OrganizationID: orgID, Status: model.KeyStatusAvailable}
+
+	checkedOut := keyInRoom
+	checkedOut.Status = model.KeyStatusInUse
+	changeInRoom := model.KeyChange{Operation: model.KeyChangeOperationUpdated, Key: checkedOut}
+	changeInOtherRoom := model.KeyChange{Operation: model.KeyChangeOperationDeleted, Key: keyInOtherRoom}
+
+	tests := []struct {
+		name         string
+		input        dto.WatchKeysInput
+		changes      []model.KeyChange
+		closeChanges bool
+		setupMock    func(*mock.MockRepository)
+		wantSnapshot []model.Key
+		wantChanges  []model.KeyChange
+		wantErr      error
+	}{
+		{
+			name:    "正常系: 部屋の鍵の一覧を送ったあと、その部屋の変更だけを送る",
+			input:   dto.WatchKeysInput{OrganizationID: orgID, RoomID: &room.ID},
+			changes: []model.KeyChange{changeInOtherRoom, changeInRoom},
+			setupMock: func(m *mock.MockRepository) {
+				m.EXPECT().GetRoomByID(gomock.Any(), room.ID).Return(room, nil)
+				m.EXPECT().GetKeysByRoom(gomock.Any(), room.ID).Return([]model.Key{keyInRoom}, nil)
+			},
+			wantSnapshot: []model.Key{keyInRoom},
+			wantChanges:  []model.KeyChange{changeInRoom},
+		},
+		{
+			name:    "正常系: テナントに割り当てられた部屋の鍵だけを送る",
+			input:   dto.WatchKeysInput{OrganizationID: orgID, TenantID: &tenantID},
+			changes: []model.KeyChange{changeInOtherRoom, changeInRoom},
+			setupMock: func(m *mock.MockRepository) {
+				m.EXPECT().GetTenantByID(gomock.Any(), tenantID).Return(repository.TenantWithJoinCode{}, nil)
+				m.EXPECT().GetAssignedRoomIDsByTenant(gomock.Any(), tenantID).Return([]model.RoomID{room.ID}, nil)
+				m.EXPECT().GetKeysByOrganization(gomock.Any(), orgID).Return([]model.Key{keyInRoom, keyInOtherRoom}, nil)
+			},
+			wantSnapshot: []model.Key{keyInRoom},
+			wantChanges:  []model.KeyChange{changeInRoom},
+		},
+		{
+			name:    "正常系: 範囲を指定しなければ組織のすべての変更を送る",
+			input:   dto.WatchKeysInput{OrganizationID: orgID},
+			changes: []model.KeyChange{changeInOtherRoom, changeInRoom},
+			setupMock: func(m *mock.MockRepository) {
+				m.EXPECT().GetKeysByOrganization(gomock.Any(), orgID).Return([]model.Key{keyInRoom, keyInOtherRoom}, nil)
+			},
+			wantSnapshot: []model.Key{keyInRoom, keyInOtherRoom},
+			wantChanges:  []model.KeyChange{changeInOtherRoom, changeInRoom},
+		},
+		{
+			name:         "異常系: 変更を取りこぼした場合は購読を打ち切る",
+			input:        dto.WatchKeysInput{OrganizationID: orgID},
+			closeChanges: true,
+			setupMock: func(m *mock.MockRepository) {
+				m.EXPECT().GetKeysByOrganization(gomock.Any(), orgID).Return(nil, nil)
+			},
+			wantErr: keywatch.ErrInterrupted,
+		},
+		{
+			name:  "異常系: 部屋が存在しない",
+			input: dto.WatchKeysInput{OrganizationID: orgID, RoomID: &room.ID},
+			setupMock: func(m *mock.MockRepository) {
+				m.EXPECT().GetRoomByID(gomock.Any(), room.ID).Return(model.Room{}, errors.New("no rows"))
+			},
+			wantErr: domainerrors.ErrNotFound,
+		},
+		{
+			name:      "異常系: 部屋とテナントを両方指定した",
+			input:     dto.WatchKeysInput{OrganizationID: orgID, RoomID: &room.ID, TenantID: &tenantID},
+			setupMock: func(m *mock.MockRepository) {},
+			wantErr:   domainerrors.ErrValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRepo := mock.NewMockRepository(ctrl)
+			tt.setupMock(mockRepo)
+
+			changes := make(chan model.KeyChange, len(tt.changes))
+			for _, c := range tt.changes {
+				changes <- c
+			}
+			if tt.closeChanges {
+				close(changes)
+			}
+
+			u := &UseCase{repo: mockRepo, config: config.Config{}, keyWatcher: fakeKeyWatcher{changes: changes}}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			var (
+				gotSnapshot []model.Key
+				gotChanges  []model.KeyChange
+			)
+			err := u.WatchKeys(ctx, tt.input, func(event dto.WatchKeysEvent) error {
+				switch event.Type {
+				case dto.WatchKeysEventSnapshot:
+					gotSnapshot = event.Snapshot
+				case dto.WatchKeysEventChange:
+					gotChanges = append(gotChanges, event.Change)
+				}
+				// 範囲内の変更をすべて受け取ったらクライアントが切断したものとする
+				if event.Type != dto.WatchKeysEventHeartbeat && len(gotChanges) == len(tt.wantChanges) && !tt.closeChanges {
+					cancel()
+				}
+				return nil
+			})
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "expected error type %v, got %v", tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSnapshot, gotSnapshot)
+			assert.Equal(t, tt.wantChanges, gotChanges)
+		})
+	}
+}
+
+func TestUseCase_UpdateKey(t *testing.T) {
+	key := model.Key{
+		ID:             model.KeyID(uuid.New()),
+		RoomID:         model.RoomID(uuid.New()),
+		OrganizationID: model.OrganizationID(uuid.New()),
+		KeyNumber:      "A-1",
+		Status:         model.KeyStatusAvailable,
+		Version:        3,
+	}
+	updated := key
+	updated.KeyNumber = "A-2"
+	updated.Version = 4
+
+	withTx := func(t *testing.T, m *mock.MockRepository, setup func(*mock.MockTransaction)) {
+		m.EXPECT().
+			WithTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context, repository.Transaction) error) error {
+				mockTx := mock.NewMockTransaction(gomock.NewController(t))
+				setup(mockTx)
+				return fn(ctx, mockTx)
+			})
+	}
+
+	tests := []struct {
+		name      string
+		input     dto.UpdateKeyInput
+		setupMock func(*testing.T, *mock.MockRepository)
+		want      model.Key
+		wantErr   error
+	}{
+		{
+			name:  "正常系: 鍵番号を変更して更新後の版数を返す",
+			input: dto.UpdateKeyInput{KeyID: key.ID, KeyNumber: "A-2", Version: key.Version},
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				withTx(t, m, func(tx *mock.MockTransaction) {
+					tx.EXPECT().UpdateKeyNumber(gomock.Any(), key.ID, model.KeyNumber("A-2"), key.Version).Return(updated.Version, nil)
+					tx.EXPECT().GetKeyByID(gomock.Any(), key.ID).Return(updated, nil)
+				})
+			},
+			want: updated,
+		},
+		{
+			name:  "異常系: 取得後に他の更新があった",
+			input: dto.UpdateKeyInput{KeyID: key.ID, KeyNumber: "A-2", Version: key.Version - 1},
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				withTx(t, m, func(tx *mock.MockTransaction) {
+					tx.EXPECT().UpdateKeyNumber(gomock.Any(), key.ID, model.KeyNumber("A-2"), key.Version-1).Return(int64(0), nil)
+					tx.EXPECT().GetKeyByID(gomock.Any(), key.ID).Return(key, nil)
+				})
+			},
+			wantErr: domainerrors.ErrConflict,
+		},
+		{
+			name:  "異常系: 鍵が存在しない",
+			input: dto.UpdateKeyInput{KeyID: key.ID, KeyNumber: "A-2", Version: key.Version},
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				withTx(t, m, func(tx *mock.MockTransaction) {
+					tx.EXPECT().UpdateKeyNumber(gomock.Any(), key.ID, model.KeyNumber("A-2"), key.Version).Return(int64(0), nil)
+					tx.EXPECT().GetKeyByID(gomock.Any(), key.ID).Return(model.Key{}, errors.New("no rows"))
+				})
+			},
+			wantErr: domainerrors.ErrNotFound,
+		},
+		{
+			name:      "異常系: 鍵番号が空",
+			input:     dto.UpdateKeyInput{KeyID: key.ID, Version: key.Version},
+			setupMock: func(t *testing.T, m *mock.MockRepository) {},
+			wantErr:   domainerrors.ErrValidation,
+		},
+		{
+			name:      "異常系: 版数を指定していない",
+			input:     dto.UpdateKeyInput{KeyID: key.ID, KeyNumber: "A-2"},
+			setupMock: func(t *testing.T, m *mock.MockRepository) {},
+			wantErr:   domainerrors.ErrValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRepo := mock.NewMockRepository(ctrl)
+			tt.setupMock(t, mockRepo)
+
+			u := &UseCase{repo: mockRepo, config: config.Config{}}
+
+			got, err := u.UpdateKey(context.Background(), tt.input)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "expected error type %v, got %v", tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -42,6 +42,35 @@ func (m *MockIUseCase) EXPECT() *MockIUseCaseMockRecorder {
 	return m.recorder
 }
 
+// AddTenantGroupMember mocks base method.
+func (m *MockIUseCase) AddTenantGroupMember(ctx context.Context, groupID model.TenantGroupID, userID model.UserID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTenantGroupMember", ctx, groupID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddTenantGroupMember indicates an expected call of AddTenantGroupMember.
+func (mr *MockIUseCaseMockRecorder) AddTenantGroupMember(ctx, groupID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTenantGroupMember", reflect.TypeOf((*MockIUseCase)(nil).AddTenantGroupMember), ctx, groupID, userID)
+}
+
+// ArchiveTenant mocks base method.
+func (m *MockIUseCase) ArchiveTenant(ctx context.Context, tenantID model.TenantID) (model.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveTenant", ctx, tenantID)
+	ret0, _ := ret[0].(model.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveTenant indicates an expected call of ArchiveTenant.
+func (mr *MockIUseCaseMockRecorder) ArchiveTenant(ctx, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveTenant", reflect.TypeOf((*MockIUseCase)(nil).ArchiveTenant), ctx, tenantID)
+}
+
 // AssignRoomToTenant mocks base method.
 func (m *MockIUseCase) AssignRoomToTenant(ctx context.Context, input dto.AssignRoomToTenantInput) (string, error) {
 	m.ctrl.T.Helper()
@@ -57,6 +86,80 @@ func (mr *MockIUseCaseMockRecorder) AssignRoomToTenant(ctx, input any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignRoomToTenant", reflect.TypeOf((*MockIUseCase)(nil).AssignRoomToTenant), ctx, input)
 }
 
+// AuthenticateAPIToken mocks base method.
+func (m *MockIUseCase) AuthenticateAPIToken(ctx context.Context, token string) (model.APIToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthenticateAPIToken", ctx, token)
+	ret0, _ := ret[0].(model.APIToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthenticateAPIToken indicates an expected call of AuthenticateAPIToken.
+func (mr *MockIUseCaseMockRecorder) AuthenticateAPIToken(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateAPIToken", reflect.TypeOf((*MockIUseCase)(nil).AuthenticateAPIToken), ctx, token)
+}
+
+// AuthenticatePlatformAdmin mocks base method.
+func (m *MockIUseCase) AuthenticatePlatformAdmin(ctx context.Context, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthenticatePlatformAdmin", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AuthenticatePlatformAdmin indicates an expected call of AuthenticatePlatformAdmin.
+func (mr *MockIUseCaseMockRecorder) AuthenticatePlatformAdmin(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticatePlatformAdmin", reflect.TypeOf((*MockIUseCase)(nil).AuthenticatePlatformAdmin), ctx, token)
+}
+
+// CreateAPIToken mocks base method.
+func (m *MockIUseCase) CreateAPIToken(ctx context.Context, input dto.CreateAPITokenInput) (dto.CreateAPITokenOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIToken", ctx, input)
+	ret0, _ := ret[0].(dto.CreateAPITokenOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAPIToken indicates an expected call of CreateAPIToken.
+func (mr *MockIUseCaseMockRecorder) CreateAPIToken(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIToken", reflect.TypeOf((*MockIUseCase)(nil).CreateAPIToken), ctx, input)
+}
+
+// CreateBuilding mocks base method.
+func (m *MockIUseCase) CreateBuilding(ctx context.Context, input dto.CreateBuildingInput) (model.Building, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBuilding", ctx, input)
+	ret0, _ := ret[0].(model.Building)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBuilding indicates an expected call of CreateBuilding.
+func (mr *MockIUseCaseMockRecorder) CreateBuilding(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBuilding", reflect.TypeOf((*MockIUseCase)(nil).CreateBuilding), ctx, input)
+}
+
+// CreateFloor mocks base method.
+func (m *MockIUseCase) CreateFloor(ctx context.Context, input dto.CreateFloorInput) (model.Floor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFloor", ctx, input)
+	ret0, _ := ret[0].(model.Floor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFloor indicates an expected call of CreateFloor.
+func (mr *MockIUseCaseMockRecorder) CreateFloor(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFloor", reflect.TypeOf((*MockIUseCase)(nil).CreateFloor), ctx, input)
+}
+
 // CreateKey mocks base method.
 func (m *MockIUseCase) CreateKey(ctx context.Context, input dto.CreateKeyInput) (string, error) {
 	m.ctrl.T.Helper()
@@ -72,6 +175,36 @@ func (mr *MockIUseCaseMockRecorder) CreateKey(ctx, input any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKey", reflect.TypeOf((*MockIUseCase)(nil).CreateKey), ctx, input)
 }
 
+// CreateOperator mocks base method.
+func (m *MockIUseCase) CreateOperator(ctx context.Context, input dto.CreateOperatorInput) (dto.CreateOperatorOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOperator", ctx, input)
+	ret0, _ := ret[0].(dto.CreateOperatorOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOperator indicates an expected call of CreateOperator.
+func (mr *MockIUseCaseMockRecorder) CreateOperator(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOperator", reflect.TypeOf((*MockIUseCase)(nil).CreateOperator), ctx, input)
+}
+
+// CreateOrganization mocks base method.
+func (m *MockIUseCase) CreateOrganization(ctx context.Context, input dto.CreateOrganizationInput) (dto.CreateOrganizationOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrganization", ctx, input)
+	ret0, _ := ret[0].(dto.CreateOrganizationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrganization indicates an expected call of CreateOrganization.
+func (mr *MockIUseCaseMockRecorder) CreateOrganization(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganization", reflect.TypeOf((*MockIUseCase)(nil).CreateOrganization), ctx, input)
+}
+
 // CreateRoom mocks base method.
 func (m *MockIUseCase) CreateRoom(ctx context.Context, input dto.CreateRoomInput) (string, error) {
 	m.ctrl.T.Helper()
@@ -87,6 +220,36 @@ func (mr *MockIUseCaseMockRecorder) CreateRoom(ctx, input any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoom", reflect.TypeOf((*MockIUseCase)(nil).CreateRoom), ctx, input)
 }
 
+// CreateRoomCustomField mocks base method.
+func (m *MockIUseCase) CreateRoomCustomField(ctx context.Context, input dto.CreateRoomCustomFieldInput) (model.RoomCustomField, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRoomCustomField", ctx, input)
+	ret0, _ := ret[0].(model.RoomCustomField)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRoomCustomField indicates an expected call of CreateRoomCustomField.
+func (mr *MockIUseCaseMockRecorder) CreateRoomCustomField(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoomCustomField", reflect.TypeOf((*MockIUseCase)(nil).CreateRoomCustomField), ctx, input)
+}
+
+// CreateRoomType mocks base method.
+func (m *MockIUseCase) CreateRoomType(ctx context.Context, input dto.CreateRoomTypeInput) (model.RoomTypeDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRoomType", ctx, input)
+	ret0, _ := ret[0].(model.RoomTypeDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRoomType indicates an expected call of CreateRoomType.
+func (mr *MockIUseCaseMockRecorder) CreateRoomType(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoomType", reflect.TypeOf((*MockIUseCase)(nil).CreateRoomType), ctx, input)
+}
+
 // CreateTenant mocks base method.
 func (m *MockIUseCase) CreateTenant(ctx context.Context, input dto.CreateTenantInput) (string, error) {
 	m.ctrl.T.Helper()
@@ -102,49 +265,207 @@ func (mr *MockIUseCaseMockRecorder) CreateTenant(ctx, input any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTenant", reflect.TypeOf((*MockIUseCase)(nil).CreateTenant), ctx, input)
 }
 
+// CreateTenantGroup mocks base method.
+func (m *MockIUseCase) CreateTenantGroup(ctx context.Context, input dto.CreateTenantGroupInput) (model.TenantGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTenantGroup", ctx, input)
+	ret0, _ := ret[0].(model.TenantGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTenantGroup indicates an expected call of CreateTenantGroup.
+func (mr *MockIUseCaseMockRecorder) CreateTenantGroup(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTenantGroup", reflect.TypeOf((*MockIUseCase)(nil).CreateTenantGroup), ctx, input)
+}
+
+// CreateTenantType mocks base method.
+func (m *MockIUseCase) CreateTenantType(ctx context.Context, input dto.CreateTenantTypeInput) (model.TenantTypeDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTenantType", ctx, input)
+	ret0, _ := ret[0].(model.TenantTypeDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTenantType indicates an expected call of CreateTenantType.
+func (mr *MockIUseCaseMockRecorder) CreateTenantType(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTenantType", reflect.TypeOf((*MockIUseCase)(nil).CreateTenantType), ctx, input)
+}
+
+// CreateWebhookSubscription mocks base method.
+func (m *MockIUseCase) CreateWebhookSubscription(ctx context.Context, input dto.CreateWebhookSubscriptionInput) (model.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhookSubscription", ctx, input)
+	ret0, _ := ret[0].(model.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhookSubscription indicates an expected call of CreateWebhookSubscription.
+func (mr *MockIUseCaseMockRecorder) CreateWebhookSubscription(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhookSubscription", reflect.TypeOf((*MockIUseCase)(nil).CreateWebhookSubscription), ctx, input)
+}
+
+// DeleteBuilding mocks base method.
+func (m *MockIUseCase) DeleteBuilding(ctx context.Context, id model.BuildingID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBuilding", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBuilding indicates an expected call of DeleteBuilding.
+func (mr *MockIUseCaseMockRecorder) DeleteBuilding(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBuilding", reflect.TypeOf((*MockIUseCase)(nil).DeleteBuilding), ctx, id)
+}
+
+// DeleteFloor mocks base method.
+func (m *MockIUseCase) DeleteFloor(ctx context.Context, id model.FloorID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFloor", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFloor indicates an expected call of DeleteFloor.
+func (mr *MockIUseCaseMockRecorder) DeleteFloor(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFloor", reflect.TypeOf((*MockIUseCase)(nil).DeleteFloor), ctx, id)
+}
+
+// DeleteRoomCustomField mocks base method.
+func (m *MockIUseCase) DeleteRoomCustomField(ctx context.Context, id model.RoomCustomFieldID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRoomCustomField", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRoomCustomField indicates an expected call of DeleteRoomCustomField.
+func (mr *MockIUseCaseMockRecorder) DeleteRoomCustomField(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoomCustomField", reflect.TypeOf((*MockIUseCase)(nil).DeleteRoomCustomField), ctx, id)
+}
+
+// DeleteRoomType mocks base method.
+func (m *MockIUseCase) DeleteRoomType(ctx context.Context, id model.RoomTypeID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRoomType", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRoomType indicates an expected call of DeleteRoomType.
+func (mr *MockIUseCaseMockRecorder) DeleteRoomType(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoomType", reflect.TypeOf((*MockIUseCase)(nil).DeleteRoomType), ctx, id)
+}
+
+// DeleteTenant mocks base method.
+func (m *MockIUseCase) DeleteTenant(ctx context.Context, input dto.DeleteTenantInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTenant", ctx, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTenant indicates an expected call of DeleteTenant.
+func (mr *MockIUseCaseMockRecorder) DeleteTenant(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTenant", reflect.TypeOf((*MockIUseCase)(nil).DeleteTenant), ctx, input)
+}
+
+// DeleteTenantType mocks base method.
+func (m *MockIUseCase) DeleteTenantType(ctx context.Context, id model.TenantTypeID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTenantType", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTenantType indicates an expected call of DeleteTenantType.
+func (mr *MockIUseCaseMockRecorder) DeleteTenantType(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTenantType", reflect.TypeOf((*MockIUseCase)(nil).DeleteTenantType), ctx, id)
+}
+
+// DeleteWebhookSubscription mocks base method.
+func (m *MockIUseCase) DeleteWebhookSubscription(ctx context.Context, organizationID model.OrganizationID, subscriptionID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhookSubscription", ctx, organizationID, subscriptionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhookSubscription indicates an expected call of DeleteWebhookSubscription.
+func (mr *MockIUseCaseMockRecorder) DeleteWebhookSubscription(ctx, organizationID, subscriptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhookSubscription", reflect.TypeOf((*MockIUseCase)(nil).DeleteWebhookSubscription), ctx, organizationID, subscriptionID)
+}
+
 // GetAllRooms mocks base method.
-func (m *MockIUseCase) GetAllRooms(ctx context.Context) ([]model.Room, error) {
+func (m *MockIUseCase) GetAllRooms(ctx context.Context, input dto.GetAllRoomsInput) (dto.GetAllRoomsOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllRooms", ctx)
-	ret0, _ := ret[0].([]model.Room)
+	ret := m.ctrl.Call(m, "GetAllRooms", ctx, input)
+	ret0, _ := ret[0].(dto.GetAllRoomsOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAllRooms indicates an expected call of GetAllRooms.
-func (mr *MockIUseCaseMockRecorder) GetAllRooms(ctx any) *gomock.Call {
+func (mr *MockIUseCaseMockRecorder) GetAllRooms(ctx, input any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllRooms", reflect.TypeOf((*MockIUseCase)(nil).GetAllRooms), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllRooms", reflect.TypeOf((*MockIUseCase)(nil).GetAllRooms), ctx, input)
 }
 
 // GetAllTenants mocks base method.
-func (m *MockIUseCase) GetAllTenants(ctx context.Context) ([]model.Tenant, error) {
+func (m *MockIUseCase) GetAllTenants(ctx context.Context, input dto.GetAllTenantsInput) (dto.GetAllTenantsOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllTenants", ctx)
-	ret0, _ := ret[0].([]model.Tenant)
+	ret := m.ctrl.Call(m, "GetAllTenants", ctx, input)
+	ret0, _ := ret[0].(dto.GetAllTenantsOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAllTenants indicates an expected call of GetAllTenants.
-func (mr *MockIUseCaseMockRecorder) GetAllTenants(ctx any) *gomock.Call {
+func (mr *MockIUseCaseMockRecorder) GetAllTenants(ctx, input any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllTenants", reflect.TypeOf((*MockIUseCase)(nil).GetAllTenants), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllTenants", reflect.TypeOf((*MockIUseCase)(nil).GetAllTenants), ctx, input)
 }
 
 // GetKeysByRoom mocks base method.
-func (m *MockIUseCase) GetKeysByRoom(ctx context.Context, roomID model.RoomID) ([]model.Key, error) {
+func (m *MockIUseCase) GetKeysByRoom(ctx context.Context, input dto.GetKeysByRoomInput) (dto.GetKeysByRoomOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetKeysByRoom", ctx, roomID)
-	ret0, _ := ret[0].([]model.Key)
+	ret := m.ctrl.Call(m, "GetKeysByRoom", ctx, input)
+	ret0, _ := ret[0].(dto.GetKeysByRoomOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetKeysByRoom indicates an expected call of GetKeysByRoom.
-func (mr *MockIUseCaseMockRecorder) GetKeysByRoom(ctx, roomID any) *gomock.Call {
+func (mr *MockIUseCaseMockRecorder) GetKeysByRoom(ctx, input any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKeysByRoom", reflect.TypeOf((*MockIUseCase)(nil).GetKeysByRoom), ctx, roomID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKeysByRoom", reflect.TypeOf((*MockIUseCase)(nil).GetKeysByRoom), ctx, input)
+}
+
+// GetOrganization mocks base method.
+func (m *MockIUseCase) GetOrganization(ctx context.Context, organizationID model.OrganizationID) (model.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganization", ctx, organizationID)
+	ret0, _ := ret[0].(model.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganization indicates an expected call of GetOrganization.
+func (mr *MockIUseCaseMockRecorder) GetOrganization(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganization", reflect.TypeOf((*MockIUseCase)(nil).GetOrganization), ctx, organizationID)
 }
 
 // GetTenantById mocks base method.
@@ -162,10 +483,190 @@ func (mr *MockIUseCaseMockRecorder) GetTenantById(ctx, tenantId any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenantById", reflect.TypeOf((*MockIUseCase)(nil).GetTenantById), ctx, tenantId)
 }
 
+// ListAPITokens mocks base method.
+func (m *MockIUseCase) ListAPITokens(ctx context.Context, organizationID model.OrganizationID) ([]model.APIToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAPITokens", ctx, organizationID)
+	ret0, _ := ret[0].([]model.APIToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAPITokens indicates an expected call of ListAPITokens.
+func (mr *MockIUseCaseMockRecorder) ListAPITokens(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPITokens", reflect.TypeOf((*MockIUseCase)(nil).ListAPITokens), ctx, organizationID)
+}
+
+// ListBuildings mocks base method.
+func (m *MockIUseCase) ListBuildings(ctx context.Context) ([]dto.BuildingWithFloors, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBuildings", ctx)
+	ret0, _ := ret[0].([]dto.BuildingWithFloors)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBuildings indicates an expected call of ListBuildings.
+func (mr *MockIUseCaseMockRecorder) ListBuildings(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBuildings", reflect.TypeOf((*MockIUseCase)(nil).ListBuildings), ctx)
+}
+
+// ListOperators mocks base method.
+func (m *MockIUseCase) ListOperators(ctx context.Context, organizationID model.OrganizationID) ([]model.ConsoleOperator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOperators", ctx, organizationID)
+	ret0, _ := ret[0].([]model.ConsoleOperator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOperators indicates an expected call of ListOperators.
+func (mr *MockIUseCaseMockRecorder) ListOperators(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOperators", reflect.TypeOf((*MockIUseCase)(nil).ListOperators), ctx, organizationID)
+}
+
+// ListOrganizations mocks base method.
+func (m *MockIUseCase) ListOrganizations(ctx context.Context) ([]model.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrganizations", ctx)
+	ret0, _ := ret[0].([]model.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrganizations indicates an expected call of ListOrganizations.
+func (mr *MockIUseCaseMockRecorder) ListOrganizations(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrganizations", reflect.TypeOf((*MockIUseCase)(nil).ListOrganizations), ctx)
+}
+
+// ListRoomCustomFields mocks base method.
+func (m *MockIUseCase) ListRoomCustomFields(ctx context.Context) (model.RoomCustomFieldSchema, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoomCustomFields", ctx)
+	ret0, _ := ret[0].(model.RoomCustomFieldSchema)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoomCustomFields indicates an expected call of ListRoomCustomFields.
+func (mr *MockIUseCaseMockRecorder) ListRoomCustomFields(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoomCustomFields", reflect.TypeOf((*MockIUseCase)(nil).ListRoomCustomFields), ctx)
+}
+
+// ListRoomTypes mocks base method.
+func (m *MockIUseCase) ListRoomTypes(ctx context.Context) ([]model.RoomTypeDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoomTypes", ctx)
+	ret0, _ := ret[0].([]model.RoomTypeDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoomTypes indicates an expected call of ListRoomTypes.
+func (mr *MockIUseCaseMockRecorder) ListRoomTypes(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoomTypes", reflect.TypeOf((*MockIUseCase)(nil).ListRoomTypes), ctx)
+}
+
+// ListSessions mocks base method.
+func (m *MockIUseCase) ListSessions(ctx context.Context, organizationID model.OrganizationID) ([]model.ConsoleSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", ctx, organizationID)
+	ret0, _ := ret[0].([]model.ConsoleSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockIUseCaseMockRecorder) ListSessions(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockIUseCase)(nil).ListSessions), ctx, organizationID)
+}
+
+// ListTenantGroupMembers mocks base method.
+func (m *MockIUseCase) ListTenantGroupMembers(ctx context.Context, groupID model.TenantGroupID) ([]model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTenantGroupMembers", ctx, groupID)
+	ret0, _ := ret[0].([]model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTenantGroupMembers indicates an expected call of ListTenantGroupMembers.
+func (mr *MockIUseCaseMockRecorder) ListTenantGroupMembers(ctx, groupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTenantGroupMembers", reflect.TypeOf((*MockIUseCase)(nil).ListTenantGroupMembers), ctx, groupID)
+}
+
+// ListTenantGroups mocks base method.
+func (m *MockIUseCase) ListTenantGroups(ctx context.Context, tenantID model.TenantID) ([]dto.TenantGroupOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTenantGroups", ctx, tenantID)
+	ret0, _ := ret[0].([]dto.TenantGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTenantGroups indicates an expected call of ListTenantGroups.
+func (mr *MockIUseCaseMockRecorder) ListTenantGroups(ctx, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTenantGroups", reflect.TypeOf((*MockIUseCase)(nil).ListTenantGroups), ctx, tenantID)
+}
+
+// ListTenantTypes mocks base method.
+func (m *MockIUseCase) ListTenantTypes(ctx context.Context) ([]model.TenantTypeDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTenantTypes", ctx)
+	ret0, _ := ret[0].([]model.TenantTypeDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTenantTypes indicates an expected call of ListTenantTypes.
+func (mr *MockIUseCaseMockRecorder) ListTenantTypes(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTenantTypes", reflect.TypeOf((*MockIUseCase)(nil).ListTenantTypes), ctx)
+}
+
+// ListWebhookDeliveries mocks base method.
+func (m *MockIUseCase) ListWebhookDeliveries(ctx context.Context, input dto.ListWebhookDeliveriesInput) ([]model.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhookDeliveries", ctx, input)
+	ret0, _ := ret[0].([]model.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhookDeliveries indicates an expected call of ListWebhookDeliveries.
+func (mr *MockIUseCaseMockRecorder) ListWebhookDeliveries(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookDeliveries", reflect.TypeOf((*MockIUseCase)(nil).ListWebhookDeliveries), ctx, input)
+}
+
+// ListWebhookSubscriptions mocks base method.
+func (m *MockIUseCase) ListWebhookSubscriptions(ctx context.Context, organizationID model.OrganizationID) ([]model.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhookSubscriptions", ctx, organizationID)
+	ret0, _ := ret[0].([]model.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhookSubscriptions indicates an expected call of ListWebhookSubscriptions.
+func (mr *MockIUseCaseMockRecorder) ListWebhookSubscriptions(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookSubscriptions", reflect.TypeOf((*MockIUseCase)(nil).ListWebhookSubscriptions), ctx, organizationID)
+}
+
 // LoginWithOrgId mocks base method.
-func (m *MockIUseCase) LoginWithOrgId(ctx context.Context, orgID, orgKey string) (string, int64, error) {
+func (m *MockIUseCase) LoginWithOrgId(ctx context.Context, orgID, orgKey string, client model.SessionClient) (string, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LoginWithOrgId", ctx, orgID, orgKey)
+	ret := m.ctrl.Call(m, "LoginWithOrgId", ctx, orgID, orgKey, client)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(int64)
 	ret2, _ := ret[2].(error)
@@ -173,9 +674,9 @@ func (m *MockIUseCase) LoginWithOrgId(ctx context.Context, orgID, orgKey string)
 }
 
 // LoginWithOrgId indicates an expected call of LoginWithOrgId.
-func (mr *MockIUseCaseMockRecorder) LoginWithOrgId(ctx, orgID, orgKey any) *gomock.Call {
+func (mr *MockIUseCaseMockRecorder) LoginWithOrgId(ctx, orgID, orgKey, client any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginWithOrgId", reflect.TypeOf((*MockIUseCase)(nil).LoginWithOrgId), ctx, orgID, orgKey)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginWithOrgId", reflect.TypeOf((*MockIUseCase)(nil).LoginWithOrgId), ctx, orgID, orgKey, client)
 }
 
 // Logout mocks base method.
@@ -192,14 +693,220 @@ func (mr *MockIUseCaseMockRecorder) Logout(ctx, sessionID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockIUseCase)(nil).Logout), ctx, sessionID)
 }
 
-// UpdateTenant mocks base method.
-func (m *MockIUseCase) UpdateTenant(ctx context.Context, input dto.UpdateTenantInput) error {
+// RecordAuditLog mocks base method.
+func (m *MockIUseCase) RecordAuditLog(ctx context.Context, log model.AuditLog) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateTenant", ctx, input)
+	ret := m.ctrl.Call(m, "RecordAuditLog", ctx, log)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordAuditLog indicates an expected call of RecordAuditLog.
+func (mr *MockIUseCaseMockRecorder) RecordAuditLog(ctx, log any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordAuditLog", reflect.TypeOf((*MockIUseCase)(nil).RecordAuditLog), ctx, log)
+}
+
+// RemoveTenantGroupMember mocks base method.
+func (m *MockIUseCase) RemoveTenantGroupMember(ctx context.Context, groupID model.TenantGroupID, userID model.UserID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTenantGroupMember", ctx, groupID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveTenantGroupMember indicates an expected call of RemoveTenantGroupMember.
+func (mr *MockIUseCaseMockRecorder) RemoveTenantGroupMember(ctx, groupID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTenantGroupMember", reflect.TypeOf((*MockIUseCase)(nil).RemoveTenantGroupMember), ctx, groupID, userID)
+}
+
+// RevokeAPIToken mocks base method.
+func (m *MockIUseCase) RevokeAPIToken(ctx context.Context, organizationID model.OrganizationID, tokenID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAPIToken", ctx, organizationID, tokenID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAPIToken indicates an expected call of RevokeAPIToken.
+func (mr *MockIUseCaseMockRecorder) RevokeAPIToken(ctx, organizationID, tokenID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIToken", reflect.TypeOf((*MockIUseCase)(nil).RevokeAPIToken), ctx, organizationID, tokenID)
+}
+
+// RevokeAllOtherSessions mocks base method.
+func (m *MockIUseCase) RevokeAllOtherSessions(ctx context.Context, organizationID model.OrganizationID, currentSessionID model.ConsoleSessionID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAllOtherSessions", ctx, organizationID, currentSessionID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAllOtherSessions indicates an expected call of RevokeAllOtherSessions.
+func (mr *MockIUseCaseMockRecorder) RevokeAllOtherSessions(ctx, organizationID, currentSessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAllOtherSessions", reflect.TypeOf((*MockIUseCase)(nil).RevokeAllOtherSessions), ctx, organizationID, currentSessionID)
+}
+
+// RevokeOperator mocks base method.
+func (m *MockIUseCase) RevokeOperator(ctx context.Context, organizationID model.OrganizationID, operatorID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeOperator", ctx, organizationID, operatorID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// RevokeOperator indicates an expected call of RevokeOperator.
+func (mr *MockIUseCaseMockRecorder) RevokeOperator(ctx, organizationID, operatorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeOperator", reflect.TypeOf((*MockIUseCase)(nil).RevokeOperator), ctx, organizationID, operatorID)
+}
+
+// RevokeSession mocks base method.
+func (m *MockIUseCase) RevokeSession(ctx context.Context, organizationID model.OrganizationID, sessionID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", ctx, organizationID, sessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockIUseCaseMockRecorder) RevokeSession(ctx, organizationID, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockIUseCase)(nil).RevokeSession), ctx, organizationID, sessionID)
+}
+
+// RotateOrganizationKey mocks base method.
+func (m *MockIUseCase) RotateOrganizationKey(ctx context.Context, organizationID string) (model.OrganizationKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateOrganizationKey", ctx, organizationID)
+	ret0, _ := ret[0].(model.OrganizationKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateOrganizationKey indicates an expected call of RotateOrganizationKey.
+func (mr *MockIUseCaseMockRecorder) RotateOrganizationKey(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateOrganizationKey", reflect.TypeOf((*MockIUseCase)(nil).RotateOrganizationKey), ctx, organizationID)
+}
+
+// Search mocks base method.
+func (m *MockIUseCase) Search(ctx context.Context, input dto.SearchInput) ([]model.SearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, input)
+	ret0, _ := ret[0].([]model.SearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockIUseCaseMockRecorder) Search(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockIUseCase)(nil).Search), ctx, input)
+}
+
+// SearchAuditLogs mocks base method.
+func (m *MockIUseCase) SearchAuditLogs(ctx context.Context, input dto.SearchAuditLogsInput) (dto.SearchAuditLogsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchAuditLogs", ctx, input)
+	ret0, _ := ret[0].(dto.SearchAuditLogsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchAuditLogs indicates an expected call of SearchAuditLogs.
+func (mr *MockIUseCaseMockRecorder) SearchAuditLogs(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAuditLogs", reflect.TypeOf((*MockIUseCase)(nil).SearchAuditLogs), ctx, input)
+}
+
+// SendTestWebhookEvent mocks base method.
+func (m *MockIUseCase) SendTestWebhookEvent(ctx context.Context, organizationID model.OrganizationID, subscriptionID string) (model.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendTestWebhookEvent", ctx, organizationID, subscriptionID)
+	ret0, _ := ret[0].(model.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendTestWebhookEvent indicates an expected call of SendTestWebhookEvent.
+func (mr *MockIUseCaseMockRecorder) SendTestWebhookEvent(ctx, organizationID, subscriptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTestWebhookEvent", reflect.TypeOf((*MockIUseCase)(nil).SendTestWebhookEvent), ctx, organizationID, subscriptionID)
+}
+
+// UpdateBuilding mocks base method.
+func (m *MockIUseCase) UpdateBuilding(ctx context.Context, input dto.UpdateBuildingInput) (model.Building, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBuilding", ctx, input)
+	ret0, _ := ret[0].(model.Building)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBuilding indicates an expected call of UpdateBuilding.
+func (mr *MockIUseCaseMockRecorder) UpdateBuilding(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBuilding", reflect.TypeOf((*MockIUseCase)(nil).UpdateBuilding), ctx, input)
+}
+
+// UpdateFloor mocks base method.
+func (m *MockIUseCase) UpdateFloor(ctx context.Context, input dto.UpdateFloorInput) (model.Floor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFloor", ctx, input)
+	ret0, _ := ret[0].(model.Floor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFloor indicates an expected call of UpdateFloor.
+func (mr *MockIUseCaseMockRecorder) UpdateFloor(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFloor", reflect.TypeOf((*MockIUseCase)(nil).UpdateFloor), ctx, input)
+}
+
+// UpdateKey mocks base method.
+func (m *MockIUseCase) UpdateKey(ctx context.Context, input dto.UpdateKeyInput) (model.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateKey", ctx, input)
+	ret0, _ := ret[0].(model.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateKey indicates an expected call of UpdateKey.
+func (mr *MockIUseCaseMockRecorder) UpdateKey(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateKey", reflect.TypeOf((*MockIUseCase)(nil).UpdateKey), ctx, input)
+}
+
+// UpdateRoomAttributes mocks base method.
+func (m *MockIUseCase) UpdateRoomAttributes(ctx context.Context, input dto.UpdateRoomAttributesInput) (model.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRoomAttributes", ctx, input)
+	ret0, _ := ret[0].(model.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRoomAttributes indicates an expected call of UpdateRoomAttributes.
+func (mr *MockIUseCaseMockRecorder) UpdateRoomAttributes(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoomAttributes", reflect.TypeOf((*MockIUseCase)(nil).UpdateRoomAttributes), ctx, input)
+}
+
+// UpdateTenant mocks base method.
+func (m *MockIUseCase) UpdateTenant(ctx context.Context, input dto.UpdateTenantInput) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTenant", ctx, input)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // UpdateTenant indicates an expected call of UpdateTenant.
 func (mr *MockIUseCaseMockRecorder) UpdateTenant(ctx, input any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
@@ -207,16 +914,30 @@ func (mr *MockIUseCaseMockRecorder) UpdateTenant(ctx, input any) *gomock.Call {
 }
 
 // ValidateSession mocks base method.
-func (m *MockIUseCase) ValidateSession(ctx context.Context, token string) (model.ConsoleSession, error) {
+func (m *MockIUseCase) ValidateSession(ctx context.Context, token string, client model.SessionClient) (dto.ValidateSessionOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ValidateSession", ctx, token)
-	ret0, _ := ret[0].(model.ConsoleSession)
+	ret := m.ctrl.Call(m, "ValidateSession", ctx, token, client)
+	ret0, _ := ret[0].(dto.ValidateSessionOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ValidateSession indicates an expected call of ValidateSession.
-func (mr *MockIUseCaseMockRecorder) ValidateSession(ctx, token any) *gomock.Call {
+func (mr *MockIUseCaseMockRecorder) ValidateSession(ctx, token, client any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateSession", reflect.TypeOf((*MockIUseCase)(nil).ValidateSession), ctx, token, client)
+}
+
+// WatchKeys mocks base method.
+func (m *MockIUseCase) WatchKeys(ctx context.Context, input dto.WatchKeysInput, send func(dto.WatchKeysEvent) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchKeys", ctx, input, send)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchKeys indicates an expected call of WatchKeys.
+func (mr *MockIUseCaseMockRecorder) WatchKeys(ctx, input, send any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateSession", reflect.TypeOf((*MockIUseCase)(nil).ValidateSession), ctx, token)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchKeys", reflect.TypeOf((*MockIUseCase)(nil).WatchKeys), ctx, input, send)
 }
@@ -0,0 +1,93 @@
+package console
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+	"github.com/shibayama-club/keyhub/internal/usecase/console/dto"
+)
+
+// CreateOperator はロールを指定してコンソール管理者を登録し、ログインキーを発行する
+func (u *UseCase) CreateOperator(ctx context.Context, input dto.CreateOperatorInput) (dto.CreateOperatorOutput, error) {
+	name, err := model.NewConsoleOperatorName(input.Name)
+	if err != nil {
+		return dto.CreateOperatorOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid console operator name")
+	}
+
+	role, err := model.NewConsoleRole(input.Role)
+	if err != nil {
+		return dto.CreateOperatorOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid console role")
+	}
+
+	operator, err := model.NewConsoleOperator(input.OrganizationID, name, role)
+	if err != nil {
+		return dto.CreateOperatorOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "failed to create console operator")
+	}
+
+	key, err := model.GenerateConsoleOperatorKey()
+	if err != nil {
+		return dto.CreateOperatorOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to generate console operator key")
+	}
+
+	err = u.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
+		err := tx.CreateConsoleOperator(ctx, repository.CreateConsoleOperatorArg{
+			Operator: operator,
+			KeyHash:  key.Hash(),
+		})
+		if err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to create console operator in repository")
+		}
+		return nil
+	})
+	if err != nil {
+		return dto.CreateOperatorOutput{}, err
+	}
+
+	return dto.CreateOperatorOutput{
+		Operator: operator,
+		Key:      key,
+	}, nil
+}
+
+func (u *UseCase) ListOperators(ctx context.Context, organizationID model.OrganizationID) ([]model.ConsoleOperator, error) {
+	operators, err := u.repo.ListConsoleOperatorsByOrganization(ctx, organizationID)
+	if err != nil {
+		return nil, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to list console operators")
+	}
+
+	return operators, nil
+}
+
+// RevokeOperator は管理者のキーを無効化し、その管理者のセッションもすべて削除する
+func (u *UseCase) RevokeOperator(ctx context.Context, organizationID model.OrganizationID, operatorID string) error {
+	id, err := model.ParseConsoleOperatorID(operatorID)
+	if err != nil {
+		return errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid console operator ID")
+	}
+
+	err = u.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
+		revoked, err := tx.RevokeConsoleOperator(ctx, organizationID, id)
+		if err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to revoke console operator")
+		}
+		if revoked == 0 {
+			return errors.WithHint(
+				errors.Mark(errors.New("console operator not found"), domainerrors.ErrNotFound),
+				"指定された管理者が見つかりません。",
+			)
+		}
+
+		if _, err := tx.DeleteSessionsByOperator(ctx, id); err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to delete console operator sessions")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package console
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"github.com/cockroachdb/errors"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+	"github.com/shibayama-club/keyhub/internal/usecase/console/dto"
+)
+
+func (u *UseCase) GetOrganization(ctx context.Context, organizationID model.OrganizationID) (model.Organization, error) {
+	organization, err := u.repo.GetOrganization(ctx, organizationID)
+	if err != nil {
+		return model.Organization{}, errors.Wrap(errors.Mark(err, domainerrors.ErrNotFound), "failed to get organization")
+	}
+
+	return organization, nil
+}
+
+// AuthenticatePlatformAdmin はプラットフォーム管理者のトークンを検証する。トークンが未設定の場合は常に失敗する
+func (u *UseCase) AuthenticatePlatformAdmin(ctx context.Context, token string) error {
+	expected := u.config.Console.PlatformAdminToken
+	if expected == "" {
+		return errors.WithHint(
+			errors.Mark(errors.New("platform admin API is disabled"), domainerrors.ErrUnAuthorized),
+			"プラットフォーム管理APIは無効です。",
+		)
+	}
+
+	if subtle.ConstantTimeCompare([]byte(token), []byte(expected)) != 1 {
+		return errors.Mark(errors.New("invalid platform admin token"), domainerrors.ErrUnAuthorized)
+	}
+
+	return nil
+}
+
+// CreateOrganization は組織を作成し、Consoleログイン用のキーを発行する
+func (u *UseCase) CreateOrganization(ctx context.Context, input dto.CreateOrganizationInput) (dto.CreateOrganizationOutput, error) {
+	name, err := model.NewOrganizationName(input.Name)
+	if err != nil {
+		return dto.CreateOrganizationOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid organization name")
+	}
+
+	slug, err := model.NewOrganizationSlug(input.Slug)
+	if err != nil {
+		return dto.CreateOrganizationOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid organization slug")
+	}
+
+	organization, err := model.NewOrganization(name, slug, input.Settings)
+	if err != nil {
+		return dto.CreateOrganizationOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "failed to create organization")
+	}
+
+	key, err := model.GenerateOrganizationKey()
+	if err != nil {
+		return dto.CreateOrganizationOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to generate organization key")
+	}
+	organization.HasKey = true
+
+	err = u.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
+		if _, err := tx.GetOrganizationBySlug(ctx, slug); err == nil {
+			return errors.WithHint(
+				errors.Mark(errors.New("organization slug already exists"), domainerrors.ErrAlreadyExists),
+				"このスラッグはすでに使われています。",
+			)
+		}
+
+		err := tx.CreateOrganization(ctx, repository.CreateOrganizationArg{
+			Organization: organization,
+			KeyHash:      key.Hash(),
+		})
+		if err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to create organization in repository")
+		}
+		return nil
+	})
+	if err != nil {
+		return dto.CreateOrganizationOutput{}, err
+	}
+
+	return dto.CreateOrganizationOutput{
+		Organization: organization,
+		Key:          key,
+	}, nil
+}
+
+func (u *UseCase) ListOrganizations(ctx context.Context) ([]model.Organization, error) {
+	organizations, err := u.repo.ListOrganizations(ctx)
+	if err != nil {
+		return nil, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to list organizations")
+	}
+
+	return organizations, nil
+}
+
+// RotateOrganizationKey は組織のキーを再発行する。
+// 古いキーが漏えいした場合に備え、その組織のコンソールセッションもすべて無効化する
+func (u *UseCase) RotateOrganizationKey(ctx context.Context, organizationID string) (model.OrganizationKey, error) {
+	id, err := model.ParseOrganizationID(organizationID)
+	if err != nil {
+		return "", errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid organization ID")
+	}
+
+	key, err := model.GenerateOrganizationKey()
+	if err != nil {
+		return "", errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to generate organization key")
+	}
+
+	err = u.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
+		updated, err := tx.UpdateOrganizationKeyHash(ctx, id, key.Hash())
+		if err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to update organization key")
+		}
+		if updated == 0 {
+			return errors.WithHint(
+				errors.Mark(errors.New("organization not found"), domainerrors.ErrNotFound),
+				"指定された組織が見つかりません。",
+			)
+		}
+
+		if _, err := tx.DeleteSessionsByOrganization(ctx, id); err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to delete console sessions")
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return key, nil
+}
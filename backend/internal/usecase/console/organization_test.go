@@ -0,0 +1,183 @@
+package console
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/shibayama-club/keyhub/cmd/config"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+	"github.com/shibayama-club/keyhub/internal/domain/repository/mock"
+	"github.com/shibayama-club/keyhub/internal/usecase/console/dto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUseCase_CreateOrganization(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     dto.CreateOrganizationInput
+		setupMock func(*testing.T, *mock.MockRepository)
+		wantErr   error
+	}{
+		{
+			name:  "正常系: 組織作成成功",
+			input: dto.CreateOrganizationInput{Name: "東京大学", Slug: "tokyo-univ"},
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context, repository.Transaction) error) error {
+						mockTx := mock.NewMockTransaction(gomock.NewController(t))
+						mockTx.EXPECT().
+							GetOrganizationBySlug(gomock.Any(), model.OrganizationSlug("tokyo-univ")).
+							Return(model.Organization{}, errors.New("no rows"))
+						mockTx.EXPECT().
+							CreateOrganization(gomock.Any(), gomock.Any()).
+							DoAndReturn(func(_ context.Context, arg repository.CreateOrganizationArg) error {
+								assert.NotEmpty(t, arg.KeyHash)
+								assert.Equal(t, model.DefaultOrganizationSettings(), arg.Organization.Settings)
+								return nil
+							})
+						return fn(ctx, mockTx)
+					})
+			},
+		},
+		{
+			name:  "異常系: スラッグが使用済み",
+			input: dto.CreateOrganizationInput{Name: "東京大学", Slug: "tokyo-univ"},
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context, repository.Transaction) error) error {
+						mockTx := mock.NewMockTransaction(gomock.NewController(t))
+						mockTx.EXPECT().
+							GetOrganizationBySlug(gomock.Any(), model.OrganizationSlug("tokyo-univ")).
+							Return(model.Organization{}, nil)
+						return fn(ctx, mockTx)
+					})
+			},
+			wantErr: domainerrors.ErrAlreadyExists,
+		},
+		{
+			name:      "異常系: 不正なスラッグ",
+			input:     dto.CreateOrganizationInput{Name: "東京大学", Slug: "Tokyo Univ"},
+			setupMock: func(*testing.T, *mock.MockRepository) {},
+			wantErr:   domainerrors.ErrValidation,
+		},
+		{
+			name: "異常系: 不正なタイムゾーン",
+			input: dto.CreateOrganizationInput{
+				Name:     "東京大学",
+				Slug:     "tokyo-univ",
+				Settings: model.OrganizationSettings{Timezone: "Asia/Nowhere"},
+			},
+			setupMock: func(*testing.T, *mock.MockRepository) {},
+			wantErr:   domainerrors.ErrValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRepo := mock.NewMockRepository(ctrl)
+			tt.setupMock(t, mockRepo)
+
+			u := &UseCase{repo: mockRepo, config: config.Config{}}
+
+			got, err := u.CreateOrganization(context.Background(), tt.input)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "expected error type %v, got %v", tt.wantErr, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, got.Organization.HasKey)
+			assert.NoError(t, got.Key.Validate())
+		})
+	}
+}
+
+func TestUseCase_verifyOrganizationKey(t *testing.T) {
+	orgID := "550e8400-e29b-41d4-a716-446655440000"
+	key := model.OrganizationKey("kho_test-key")
+
+	tests := []struct {
+		name      string
+		orgID     string
+		orgKey    string
+		setupMock func(*mock.MockRepository)
+		wantErr   bool
+	}{
+		{
+			name:   "正常系: キーが一致",
+			orgID:  orgID,
+			orgKey: key.String(),
+			setupMock: func(m *mock.MockRepository) {
+				m.EXPECT().GetOrganizationKeyHash(gomock.Any(), gomock.Any()).Return(key.Hash(), nil)
+			},
+		},
+		{
+			name:   "異常系: キーが異なる",
+			orgID:  orgID,
+			orgKey: "kho_wrong-key",
+			setupMock: func(m *mock.MockRepository) {
+				m.EXPECT().GetOrganizationKeyHash(gomock.Any(), gomock.Any()).Return(key.Hash(), nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:   "異常系: キーが未発行",
+			orgID:  orgID,
+			orgKey: key.String(),
+			setupMock: func(m *mock.MockRepository) {
+				m.EXPECT().GetOrganizationKeyHash(gomock.Any(), gomock.Any()).Return("", nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:   "異常系: 組織が存在しない",
+			orgID:  orgID,
+			orgKey: key.String(),
+			setupMock: func(m *mock.MockRepository) {
+				m.EXPECT().GetOrganizationKeyHash(gomock.Any(), gomock.Any()).Return("", errors.New("no rows"))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "異常系: 組織IDの形式が不正",
+			orgID:     "not-a-uuid",
+			orgKey:    key.String(),
+			setupMock: func(*mock.MockRepository) {},
+			wantErr:   true,
+		},
+		{
+			name:      "異常系: キーが空",
+			orgID:     orgID,
+			orgKey:    "",
+			setupMock: func(*mock.MockRepository) {},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRepo := mock.NewMockRepository(ctrl)
+			tt.setupMock(mockRepo)
+
+			u := &UseCase{repo: mockRepo, config: config.Config{}}
+
+			got, err := u.verifyOrganizationKey(context.Background(), tt.orgID, tt.orgKey)
+			if tt.wantErr {
+				assert.True(t, errors.Is(err, domainerrors.ErrUnAuthorized), "expected unauthorized, got %v", err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, orgID, got.String())
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
 	"github.com/shibayama-club/keyhub/internal/domain/model"
 	"github.com/shibayama-club/keyhub/internal/domain/repository"
@@ -16,33 +17,39 @@ func (u *UseCase) CreateRoom(ctx context.Context, input dto.CreateRoomInput) (st
 		return "", errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid room name")
 	}
 
-	buildingName, err := model.NewBuildingName(input.BuildingName)
+	roomType, err := u.newRoomType(ctx, input.RoomType)
 	if err != nil {
-		return "", errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid building name")
+		return "", err
 	}
 
-	floorNumber, err := model.NewFloorNumber(input.FloorNumber)
+	roomDescription, err := model.NewRoomDescription(input.Description)
 	if err != nil {
-		return "", errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid floor number")
+		return "", errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid room description")
 	}
 
-	roomType, err := model.NewRoomType(input.RoomType)
+	attributes, err := u.newRoomAttributes(ctx, input.Attributes)
 	if err != nil {
-		return "", errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid room type")
+		return "", err
 	}
 
-	roomDescription, err := model.NewRoomDescription(input.Description)
+	floor, err := u.repo.GetFloor(ctx, input.FloorID)
 	if err != nil {
-		return "", errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid room description")
+		return "", errors.Wrap(errors.Mark(err, domainerrors.ErrNotFound), "floor not found")
+	}
+
+	building, err := u.repo.GetBuilding(ctx, floor.BuildingID)
+	if err != nil {
+		return "", errors.Wrap(errors.Mark(err, domainerrors.ErrNotFound), "building not found")
 	}
 
 	room, err := model.NewRoom(
 		input.OrganizationID,
 		roomName,
-		buildingName,
-		floorNumber,
+		building,
+		floor,
 		roomType,
 		roomDescription,
+		attributes,
 	)
 	if err != nil {
 		return "", errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "failed to create room")
@@ -53,10 +60,10 @@ func (u *UseCase) CreateRoom(ctx context.Context, input dto.CreateRoomInput) (st
 			ID:             room.ID,
 			OrganizationID: room.OrganizationID,
 			Name:           room.Name,
-			BuildingName:   room.BuildingName,
-			FloorNumber:    room.FloorNumber,
+			FloorID:        room.FloorID,
 			Type:           room.Type,
 			Description:    room.Description,
+			Attributes:     room.Attributes,
 		})
 		if err != nil {
 			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to create room in repository")
@@ -78,14 +85,28 @@ func (u *UseCase) AssignRoomToTenant(ctx context.Context, input dto.AssignRoomTo
 	}
 
 	// Verify tenant exists
-	_, err = u.repo.GetTenantByID(ctx, input.TenantID)
+	tenant, err := u.repo.GetTenantByID(ctx, input.TenantID)
 	if err != nil {
 		return "", errors.Wrap(errors.Mark(err, domainerrors.ErrNotFound), "tenant not found")
 	}
+	if err := tenant.Tenant.EnsureWritable(); err != nil {
+		return "", errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "tenant is archived")
+	}
+
+	for _, groupID := range []*model.TenantGroupID{input.GroupID, input.KeyLoanGroupID} {
+		if groupID == nil {
+			continue
+		}
+		if err := u.verifyTenantGroup(ctx, input.TenantID, *groupID); err != nil {
+			return "", err
+		}
+	}
 
 	assignment, err := model.NewRoomAssignment(
 		input.TenantID,
 		input.RoomID,
+		input.GroupID,
+		input.KeyLoanGroupID,
 		input.ExpiresAt,
 	)
 	if err != nil {
@@ -94,16 +115,18 @@ func (u *UseCase) AssignRoomToTenant(ctx context.Context, input dto.AssignRoomTo
 
 	err = u.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
 		err = tx.CreateRoomAssignment(ctx, repository.CreateRoomAssignmentArg{
-			ID:         assignment.ID,
-			TenantID:   assignment.TenantID,
-			RoomID:     assignment.RoomID,
-			AssignedAt: assignment.AssignedAt,
-			ExpiresAt:  assignment.ExpiresAt,
+			ID:             assignment.ID,
+			TenantID:       assignment.TenantID,
+			RoomID:         assignment.RoomID,
+			GroupID:        assignment.GroupID,
+			KeyLoanGroupID: assignment.KeyLoanGroupID,
+			AssignedAt:     assignment.AssignedAt,
+			ExpiresAt:      assignment.ExpiresAt,
 		})
 		if err != nil {
 			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to create room assignment in repository")
 		}
-		return nil
+		return publishEvent(ctx, tx, model.NewRoomAssignedEvent(tenant.Tenant.OrganizationID, assignment))
 	})
 	if err != nil {
 		return "", err
@@ -112,10 +135,210 @@ func (u *UseCase) AssignRoomToTenant(ctx context.Context, input dto.AssignRoomTo
 	return assignment.ID.String(), nil
 }
 
-func (u *UseCase) GetAllRooms(ctx context.Context) ([]model.Room, error) {
-	rooms, err := u.repo.GetAllRooms(ctx)
+// GetAllRooms は組織の部屋を条件で絞り込み、1ページ分を鍵とともに返す。
+// 続きの有無を判定するため、指定件数より1件多く取得する
+func (u *UseCase) GetAllRooms(ctx context.Context, input dto.GetAllRoomsInput) (dto.GetAllRoomsOutput, error) {
+	order, err := model.ParseListOrder(input.Order)
+	if err != nil {
+		return dto.GetAllRoomsOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid list order")
+	}
+
+	arg := repository.ListRoomsArg{
+		BuildingID:   input.BuildingID,
+		FloorID:      input.FloorID,
+		BuildingName: model.BuildingName(input.BuildingName),
+		FloorNumber:  model.FloorNumber(input.FloorNumber),
+		NamePrefix:   input.NamePrefix,
+		Order:        order,
+	}
+	if input.RoomType != "" {
+		roomType, err := u.newRoomType(ctx, input.RoomType)
+		if err != nil {
+			return dto.GetAllRoomsOutput{}, err
+		}
+		arg.Type = roomType
+	}
+
+	arg.Filter, err = u.newRoomFilter(ctx, input.MinCapacity, input.Equipment, input.Accessibility, input.CustomFields)
+	if err != nil {
+		return dto.GetAllRoomsOutput{}, err
+	}
+
+	filters := arg.Filter.PageFilters()
+	filters["building_name"] = input.BuildingName
+	filters["floor_number"] = input.FloorNumber
+	filters["room_type"] = input.RoomType
+	filters["name_prefix"] = input.NamePrefix
+	filters["building_id"] = idString(input.BuildingID)
+	filters["floor_id"] = idString(input.FloorID)
+	query := model.PageQuery("rooms", order, filters)
+	arg.Cursor, err = u.decodePageToken(query, input.PageToken)
 	if err != nil {
-		return nil, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to get all rooms")
+		return dto.GetAllRoomsOutput{}, err
+	}
+	pageSize := model.NormalizePageSize(input.PageSize)
+	arg.Limit = pageSize + 1
+
+	rooms, err := u.repo.ListRooms(ctx, arg)
+	if err != nil {
+		return dto.GetAllRoomsOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to list rooms")
+	}
+	rooms, hasNext := model.SplitPage(rooms, pageSize)
+
+	output := dto.GetAllRoomsOutput{Rooms: make([]dto.RoomWithKeys, 0, len(rooms))}
+	for _, room := range rooms {
+		keys, err := u.repo.GetKeysByRoom(ctx, room.ID)
+		if err != nil {
+			return dto.GetAllRoomsOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to get keys by room")
+		}
+		output.Rooms = append(output.Rooms, dto.RoomWithKeys{Room: room, Keys: keys})
+	}
+
+	if hasNext {
+		last := rooms[len(rooms)-1]
+		output.NextPageToken = u.pageTokens.Encode(query, model.PageCursor{
+			CreatedAt: last.CreatedAt,
+			Name:      last.Name.String(),
+			ID:        last.ID.UUID(),
+		})
+	}
+
+	if input.GroupByFloor {
+		output.Buildings, err = u.groupRoomsByFloor(ctx, output.Rooms)
+		if err != nil {
+			return dto.GetAllRoomsOutput{}, err
+		}
+	}
+
+	return output, nil
+}
+
+// groupRoomsByFloor は部屋を建物・階ごとにまとめる。部屋のない建物と階は含めない
+func (u *UseCase) groupRoomsByFloor(ctx context.Context, rooms []dto.RoomWithKeys) ([]dto.BuildingRooms, error) {
+	buildings, err := u.ListBuildings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	roomsByFloor := lo.GroupBy(rooms, func(r dto.RoomWithKeys) model.FloorID {
+		return r.Room.FloorID
+	})
+
+	groups := make([]dto.BuildingRooms, 0)
+	for _, b := range buildings {
+		group := dto.BuildingRooms{Building: b.Building}
+		for _, f := range b.Floors {
+			if rooms, ok := roomsByFloor[f.ID]; ok {
+				group.Floors = append(group.Floors, dto.FloorRooms{Floor: f, Rooms: rooms})
+			}
+		}
+		if len(group.Floors) > 0 {
+			groups = append(groups, group)
+		}
 	}
-	return rooms, nil
+
+	return groups, nil
+}
+
+// UpdateRoomAttributes は部屋の収容人数・設備・バリアフリー対応・カスタム項目の値をすべて置き換える
+func (u *UseCase) UpdateRoomAttributes(ctx context.Context, input dto.UpdateRoomAttributesInput) (model.Room, error) {
+	if input.Version <= 0 {
+		return model.Room{}, errors.WithHint(
+			errors.Mark(errors.New("room version is required"), domainerrors.ErrValidation),
+			"取得した部屋の version を指定してください。",
+		)
+	}
+
+	room, err := u.repo.GetRoomByID(ctx, input.RoomID)
+	if err != nil {
+		return model.Room{}, errors.Wrap(errors.Mark(err, domainerrors.ErrNotFound), "room not found")
+	}
+
+	room.Attributes, err = u.newRoomAttributes(ctx, input.Attributes)
+	if err != nil {
+		return model.Room{}, err
+	}
+
+	err = u.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
+		updated, err := tx.UpdateRoomAttributes(ctx, room.ID, room.Attributes, input.Version)
+		if err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to update room attributes in repository")
+		}
+		if updated == 0 {
+			// 部屋が削除されたのか、他の更新で版数が変わったのかを同じトランザクションで読み直して区別する
+			if _, err := tx.GetRoomByID(ctx, room.ID); err != nil {
+				return errors.Wrap(errors.Mark(err, domainerrors.ErrNotFound), "room not found")
+			}
+			return errors.WithHint(
+				errors.Mark(errors.New("room was updated by another request"), domainerrors.ErrConflict),
+				"他の管理者がこの部屋を更新しました。再読み込みしてから編集し直してください。",
+			)
+		}
+		room.Version = updated
+		return nil
+	})
+	if err != nil {
+		return model.Room{}, err
+	}
+
+	return room, nil
+}
+
+// newRoomAttributes は入力を部屋の属性にする。カスタム項目の値は組織の定義で検証する
+func (u *UseCase) newRoomAttributes(ctx context.Context, input dto.RoomAttributesInput) (model.RoomAttributes, error) {
+	equipment, err := model.NewEquipmentList(input.Equipment)
+	if err != nil {
+		return model.RoomAttributes{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid room equipment")
+	}
+
+	accessibility, err := model.NewRoomAccessibilityList(input.Accessibility)
+	if err != nil {
+		return model.RoomAttributes{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid room accessibility")
+	}
+
+	schema, err := u.repo.ListRoomCustomFields(ctx)
+	if err != nil {
+		return model.RoomAttributes{}, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to list room custom fields")
+	}
+
+	customFields, err := schema.NormalizeValues(input.CustomFields)
+	if err != nil {
+		return model.RoomAttributes{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid room custom fields")
+	}
+
+	attributes := model.RoomAttributes{
+		Capacity:      model.RoomCapacity(input.Capacity),
+		Equipment:     equipment,
+		Accessibility: accessibility,
+		CustomFields:  customFields,
+	}
+	if err := attributes.Validate(); err != nil {
+		return model.RoomAttributes{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid room attributes")
+	}
+
+	return attributes, nil
+}
+
+// newRoomFilter は部屋の属性の絞り込み条件を作る。カスタム項目を指定した場合だけ組織の定義で検証する
+func (u *UseCase) newRoomFilter(ctx context.Context, minCapacity int32, equipment, accessibility []string, customFields map[string]string) (model.RoomFilter, error) {
+	filter, err := model.NewRoomFilter(minCapacity, equipment, accessibility)
+	if err != nil {
+		return model.RoomFilter{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid room filter")
+	}
+
+	if len(customFields) == 0 {
+		return filter, nil
+	}
+
+	schema, err := u.repo.ListRoomCustomFields(ctx)
+	if err != nil {
+		return model.RoomFilter{}, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to list room custom fields")
+	}
+
+	filter.CustomFields, err = schema.NormalizeFilter(customFields)
+	if err != nil {
+		return model.RoomFilter{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid room custom field filter")
+	}
+
+	return filter, nil
 }
@@ -0,0 +1,81 @@
+package console
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+	"github.com/shibayama-club/keyhub/internal/usecase/console/dto"
+)
+
+func (u *UseCase) CreateRoomCustomField(ctx context.Context, input dto.CreateRoomCustomFieldInput) (model.RoomCustomField, error) {
+	field, err := model.NewRoomCustomField(
+		input.OrganizationID,
+		model.RoomCustomFieldKey(input.Key),
+		input.Label,
+		model.RoomCustomFieldType(input.Type),
+		input.Required,
+		input.Options,
+	)
+	if err != nil {
+		return model.RoomCustomField{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "failed to create room custom field")
+	}
+
+	err = u.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
+		schema, err := tx.ListRoomCustomFields(ctx)
+		if err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to list room custom fields")
+		}
+		for _, f := range schema {
+			if f.Key == field.Key {
+				return errors.WithHint(
+					errors.Mark(errors.New("room custom field key already exists"), domainerrors.ErrAlreadyExists),
+					"同じキーのカスタム項目が既に存在します。",
+				)
+			}
+		}
+
+		if err := tx.CreateRoomCustomField(ctx, field); err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to create room custom field in repository")
+		}
+		return nil
+	})
+	if err != nil {
+		return model.RoomCustomField{}, err
+	}
+
+	return field, nil
+}
+
+func (u *UseCase) ListRoomCustomFields(ctx context.Context) (model.RoomCustomFieldSchema, error) {
+	schema, err := u.repo.ListRoomCustomFields(ctx)
+	if err != nil {
+		return nil, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to list room custom fields")
+	}
+	return schema, nil
+}
+
+// DeleteRoomCustomField はカスタム項目の定義を削除し、部屋に保存した値も取り除く
+func (u *UseCase) DeleteRoomCustomField(ctx context.Context, id model.RoomCustomFieldID) error {
+	field, err := u.repo.GetRoomCustomField(ctx, id)
+	if err != nil {
+		return errors.Wrap(errors.Mark(err, domainerrors.ErrNotFound), "room custom field not found")
+	}
+
+	return u.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
+		rows, err := tx.DeleteRoomCustomField(ctx, field.ID)
+		if err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to delete room custom field in repository")
+		}
+		if rows == 0 {
+			return errors.Mark(errors.New("room custom field not found"), domainerrors.ErrNotFound)
+		}
+
+		if err := tx.RemoveRoomCustomFieldValues(ctx, field.OrganizationID, field.Key); err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to remove room custom field values")
+		}
+		return nil
+	})
+}
@@ -0,0 +1,229 @@
+package console
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/shibayama-club/keyhub/cmd/config"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+	"github.com/shibayama-club/keyhub/internal/domain/repository/mock"
+	"github.com/shibayama-club/keyhub/internal/usecase/console/dto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUseCase_UpdateRoomAttributes(t *testing.T) {
+	orgID := model.OrganizationID(uuid.New())
+	room := model.Room{ID: model.RoomID(uuid.New()), OrganizationID: orgID, Name: "第1実験室", Version: 3}
+	version := room.Version
+	safetyLevel, err := model.NewRoomCustomField(orgID, "lab_safety_level", "安全レベル", model.RoomCustomFieldTypeSelect, true, []string{"BSL1", "BSL2"})
+	require.NoError(t, err)
+	schema := model.RoomCustomFieldSchema{safetyLevel}
+
+	tests := []struct {
+		name      string
+		input     dto.UpdateRoomAttributesInput
+		setupMock func(*testing.T, *mock.MockRepository)
+		want      model.RoomAttributes
+		wantVer   int64
+		wantErr   error
+	}{
+		{
+			name: "正常系: 属性を置き換える",
+			input: dto.UpdateRoomAttributesInput{
+				RoomID:  room.ID,
+				Version: version,
+				Attributes: dto.RoomAttributesInput{
+					Capacity:      24,
+					Equipment:     []string{"ドラフトチャンバー", "ドラフトチャンバー"},
+					Accessibility: []string{"wheelchair"},
+					CustomFields:  map[string]string{"lab_safety_level": "BSL2"},
+				},
+			},
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().GetRoomByID(gomock.Any(), room.ID).Return(room, nil)
+				m.EXPECT().ListRoomCustomFields(gomock.Any()).Return(schema, nil)
+				m.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context, repository.Transaction) error) error {
+						mockTx := mock.NewMockTransaction(gomock.NewController(t))
+						// 同時に他の更新があっても、返す版数はDBが返した更新後の値にする
+						mockTx.EXPECT().UpdateRoomAttributes(gomock.Any(), room.ID, gomock.Any(), version).Return(version+2, nil)
+						return fn(ctx, mockTx)
+					})
+			},
+			want: model.RoomAttributes{
+				Capacity:      24,
+				Equipment:     []model.Equipment{"ドラフトチャンバー"},
+				Accessibility: []model.RoomAccessibility{model.RoomAccessibilityWheelchair},
+				CustomFields:  map[string]string{"lab_safety_level": "BSL2"},
+			},
+			wantVer: version + 2,
+		},
+		{
+			name: "異常系: 取得した後に他の管理者が更新した",
+			input: dto.UpdateRoomAttributesInput{
+				RoomID:  room.ID,
+				Version: version,
+				Attributes: dto.RoomAttributesInput{
+					Capacity:     24,
+					CustomFields: map[string]string{"lab_safety_level": "BSL1"},
+				},
+			},
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().GetRoomByID(gomock.Any(), room.ID).Return(room, nil)
+				m.EXPECT().ListRoomCustomFields(gomock.Any()).Return(schema, nil)
+				m.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context, repository.Transaction) error) error {
+						mockTx := mock.NewMockTransaction(gomock.NewController(t))
+						mockTx.EXPECT().UpdateRoomAttributes(gomock.Any(), room.ID, gomock.Any(), version).Return(int64(0), nil)
+						mockTx.EXPECT().GetRoomByID(gomock.Any(), room.ID).Return(room, nil)
+						return fn(ctx, mockTx)
+					})
+			},
+			wantErr: domainerrors.ErrConflict,
+		},
+		{
+			name: "異常系: 取得した後に部屋が削除された",
+			input: dto.UpdateRoomAttributesInput{
+				RoomID:  room.ID,
+				Version: version,
+				Attributes: dto.RoomAttributesInput{
+					Capacity:     24,
+					CustomFields: map[string]string{"lab_safety_level": "BSL1"},
+				},
+			},
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().GetRoomByID(gomock.Any(), room.ID).Return(room, nil)
+				m.EXPECT().ListRoomCustomFields(gomock.Any()).Return(schema, nil)
+				m.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context, repository.Transaction) error) error {
+						mockTx := mock.NewMockTransaction(gomock.NewController(t))
+						mockTx.EXPECT().UpdateRoomAttributes(gomock.Any(), room.ID, gomock.Any(), version).Return(int64(0), nil)
+						mockTx.EXPECT().GetRoomByID(gomock.Any(), room.ID).Return(model.Room{}, errors.New("no rows"))
+						return fn(ctx, mockTx)
+					})
+			},
+			wantErr: domainerrors.ErrNotFound,
+		},
+		{
+			name: "異常系: 必須のカスタム項目がない",
+			input: dto.UpdateRoomAttributesInput{
+				RoomID:     room.ID,
+				Version:    version,
+				Attributes: dto.RoomAttributesInput{Capacity: 24},
+			},
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().GetRoomByID(gomock.Any(), room.ID).Return(room, nil)
+				m.EXPECT().ListRoomCustomFields(gomock.Any()).Return(schema, nil)
+			},
+			wantErr: domainerrors.ErrValidation,
+		},
+		{
+			name: "異常系: 収容人数が上限を超える",
+			input: dto.UpdateRoomAttributesInput{
+				RoomID:  room.ID,
+				Version: version,
+				Attributes: dto.RoomAttributesInput{
+					Capacity:     model.MaxRoomCapacity + 1,
+					CustomFields: map[string]string{"lab_safety_level": "BSL1"},
+				},
+			},
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().GetRoomByID(gomock.Any(), room.ID).Return(room, nil)
+				m.EXPECT().ListRoomCustomFields(gomock.Any()).Return(schema, nil)
+			},
+			wantErr: domainerrors.ErrValidation,
+		},
+		{
+			name:  "異常系: 部屋が存在しない",
+			input: dto.UpdateRoomAttributesInput{RoomID: room.ID, Version: version},
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().GetRoomByID(gomock.Any(), room.ID).Return(model.Room{}, errors.New("no rows"))
+			},
+			wantErr: domainerrors.ErrNotFound,
+		},
+		{
+			name:      "異常系: 版数を指定していない",
+			input:     dto.UpdateRoomAttributesInput{RoomID: room.ID},
+			setupMock: func(t *testing.T, m *mock.MockRepository) {},
+			wantErr:   domainerrors.ErrValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRepo := mock.NewMockRepository(ctrl)
+			tt.setupMock(t, mockRepo)
+
+			u := &UseCase{repo: mockRepo, config: config.Config{}}
+
+			got, err := u.UpdateRoomAttributes(context.Background(), tt.input)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "expected error type %v, got %v", tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.Attributes)
+			assert.Equal(t, tt.wantVer, got.Version)
+		})
+	}
+}
+
+func TestUseCase_DeleteRoomCustomField(t *testing.T) {
+	field, err := model.NewRoomCustomField(model.OrganizationID(uuid.New()), "network_port", "ネットワーク口", model.RoomCustomFieldTypeText, false, nil)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		setupMock func(*testing.T, *mock.MockRepository)
+		wantErr   error
+	}{
+		{
+			name: "正常系: 定義と部屋の値を削除する",
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().GetRoomCustomField(gomock.Any(), field.ID).Return(field, nil)
+				m.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context, repository.Transaction) error) error {
+						mockTx := mock.NewMockTransaction(gomock.NewController(t))
+						mockTx.EXPECT().DeleteRoomCustomField(gomock.Any(), field.ID).Return(int64(1), nil)
+						mockTx.EXPECT().RemoveRoomCustomFieldValues(gomock.Any(), field.OrganizationID, field.Key).Return(nil)
+						return fn(ctx, mockTx)
+					})
+			},
+		},
+		{
+			name: "異常系: 定義が存在しない",
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().GetRoomCustomField(gomock.Any(), field.ID).Return(model.RoomCustomField{}, errors.New("no rows"))
+			},
+			wantErr: domainerrors.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRepo := mock.NewMockRepository(ctrl)
+			tt.setupMock(t, mockRepo)
+
+			u := &UseCase{repo: mockRepo, config: config.Config{}}
+
+			err := u.DeleteRoomCustomField(context.Background(), field.ID)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "expected error type %v, got %v", tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package console
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+	"github.com/shibayama-club/keyhub/internal/usecase/console/dto"
+)
+
+// ListRoomTypes は既定の部屋タイプに続けて組織が追加した部屋タイプを返す
+func (u *UseCase) ListRoomTypes(ctx context.Context) ([]model.RoomTypeDefinition, error) {
+	catalog, err := u.repo.ListRoomTypes(ctx)
+	if err != nil {
+		return nil, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to list room types")
+	}
+	return catalog.All(), nil
+}
+
+func (u *UseCase) CreateRoomType(ctx context.Context, input dto.CreateRoomTypeInput) (model.RoomTypeDefinition, error) {
+	definition, err := model.NewRoomTypeDefinition(input.OrganizationID, model.RoomType(input.Key), input.Label)
+	if err != nil {
+		return model.RoomTypeDefinition{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "failed to create room type")
+	}
+
+	err = u.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
+		catalog, err := tx.ListRoomTypes(ctx)
+		if err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to list room types")
+		}
+		if catalog.Contains(definition.Key) {
+			return errors.WithHint(
+				errors.Mark(errors.New("room type key already exists"), domainerrors.ErrAlreadyExists),
+				"同じキーの部屋タイプが既に存在します。",
+			)
+		}
+
+		if err := tx.CreateRoomType(ctx, definition); err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to create room type in repository")
+		}
+		return nil
+	})
+	if err != nil {
+		return model.RoomTypeDefinition{}, err
+	}
+
+	return definition, nil
+}
+
+// DeleteRoomType は組織が追加した部屋タイプを削除する。部屋タイプを使っている部屋が残っている場合は削除できない
+func (u *UseCase) DeleteRoomType(ctx context.Context, id model.RoomTypeID) error {
+	definition, err := u.repo.GetRoomType(ctx, id)
+	if err != nil {
+		return errors.Wrap(errors.Mark(err, domainerrors.ErrNotFound), "room type not found")
+	}
+
+	return u.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
+		count, err := tx.CountRoomsByType(ctx, definition.OrganizationID, definition.Key)
+		if err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to count rooms by type")
+		}
+		if count > 0 {
+			return errors.WithHint(
+				errors.Mark(errors.Newf("room type is used by %d rooms", count), domainerrors.ErrValidation),
+				"この部屋タイプの部屋が残っているため削除できません。部屋のタイプを変更してから削除してください。",
+			)
+		}
+
+		rows, err := tx.DeleteRoomType(ctx, definition.ID)
+		if err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to delete room type in repository")
+		}
+		if rows == 0 {
+			return errors.Mark(errors.New("room type not found"), domainerrors.ErrNotFound)
+		}
+		return nil
+	})
+}
+
+// newRoomType は値を部屋タイプにする。既定の部屋タイプでない場合だけ組織が追加した部屋タイプを読み込んで確認する
+func (u *UseCase) newRoomType(ctx context.Context, value string) (model.RoomType, error) {
+	var catalog model.RoomTypeCatalog
+	if !model.RoomType(value).IsBuiltin() {
+		var err error
+		catalog, err = u.repo.ListRoomTypes(ctx)
+		if err != nil {
+			return "", errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to list room types")
+		}
+	}
+
+	t, err := model.NewRoomType(value, catalog)
+	if err != nil {
+		return "", errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid room type")
+	}
+	return t, nil
+}
@@ -0,0 +1,153 @@
+package console
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/shibayama-club/keyhub/cmd/config"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+	"github.com/shibayama-club/keyhub/internal/domain/repository/mock"
+	"github.com/shibayama-club/keyhub/internal/usecase/console/dto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUseCase_CreateRoomType(t *testing.T) {
+	orgID := model.OrganizationID(uuid.New())
+	serverRoom, err := model.NewRoomTypeDefinition(orgID, "server_room", "サーバー室")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		input     dto.CreateRoomTypeInput
+		setupMock func(*testing.T, *mock.MockRepository)
+		wantErr   error
+	}{
+		{
+			name:  "正常系: 部屋タイプを追加できる",
+			input: dto.CreateRoomTypeInput{OrganizationID: orgID, Key: "studio", Label: "スタジオ"},
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context, repository.Transaction) error) error {
+						mockTx := mock.NewMockTransaction(gomock.NewController(t))
+						mockTx.EXPECT().ListRoomTypes(gomock.Any()).Return(model.RoomTypeCatalog{serverRoom}, nil)
+						mockTx.EXPECT().
+							CreateRoomType(gomock.Any(), gomock.Any()).
+							DoAndReturn(func(_ context.Context, d model.RoomTypeDefinition) error {
+								assert.Equal(t, model.RoomType("studio"), d.Key)
+								return nil
+							})
+						return fn(ctx, mockTx)
+					})
+			},
+		},
+		{
+			name:  "異常系: 同じキーの部屋タイプが存在する",
+			input: dto.CreateRoomTypeInput{OrganizationID: orgID, Key: "server_room", Label: "サーバールーム"},
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context, repository.Transaction) error) error {
+						mockTx := mock.NewMockTransaction(gomock.NewController(t))
+						mockTx.EXPECT().ListRoomTypes(gomock.Any()).Return(model.RoomTypeCatalog{serverRoom}, nil)
+						return fn(ctx, mockTx)
+					})
+			},
+			wantErr: domainerrors.ErrAlreadyExists,
+		},
+		{
+			name:      "異常系: 既定の部屋タイプと同じキー",
+			input:     dto.CreateRoomTypeInput{OrganizationID: orgID, Key: "classroom", Label: "教室"},
+			setupMock: func(t *testing.T, m *mock.MockRepository) {},
+			wantErr:   domainerrors.ErrValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRepo := mock.NewMockRepository(ctrl)
+			tt.setupMock(t, mockRepo)
+
+			u := &UseCase{repo: mockRepo, config: config.Config{}}
+
+			got, err := u.CreateRoomType(context.Background(), tt.input)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "expected error type %v, got %v", tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, model.RoomType(tt.input.Key), got.Key)
+		})
+	}
+}
+
+func TestUseCase_DeleteRoomType(t *testing.T) {
+	serverRoom, err := model.NewRoomTypeDefinition(model.OrganizationID(uuid.New()), "server_room", "サーバー室")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		setupMock func(*testing.T, *mock.MockRepository)
+		wantErr   error
+	}{
+		{
+			name: "正常系: 使われていない部屋タイプを削除できる",
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().GetRoomType(gomock.Any(), serverRoom.ID).Return(serverRoom, nil)
+				m.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context, repository.Transaction) error) error {
+						mockTx := mock.NewMockTransaction(gomock.NewController(t))
+						mockTx.EXPECT().CountRoomsByType(gomock.Any(), serverRoom.OrganizationID, serverRoom.Key).Return(int32(0), nil)
+						mockTx.EXPECT().DeleteRoomType(gomock.Any(), serverRoom.ID).Return(int64(1), nil)
+						return fn(ctx, mockTx)
+					})
+			},
+		},
+		{
+			name: "異常系: 部屋タイプを使っている部屋が残っている",
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().GetRoomType(gomock.Any(), serverRoom.ID).Return(serverRoom, nil)
+				m.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context, repository.Transaction) error) error {
+						mockTx := mock.NewMockTransaction(gomock.NewController(t))
+						mockTx.EXPECT().CountRoomsByType(gomock.Any(), serverRoom.OrganizationID, serverRoom.Key).Return(int32(2), nil)
+						return fn(ctx, mockTx)
+					})
+			},
+			wantErr: domainerrors.ErrValidation,
+		},
+		{
+			name: "異常系: 部屋タイプが存在しない",
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().GetRoomType(gomock.Any(), serverRoom.ID).Return(model.RoomTypeDefinition{}, errors.New("no rows"))
+			},
+			wantErr: domainerrors.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRepo := mock.NewMockRepository(ctrl)
+			tt.setupMock(t, mockRepo)
+
+			u := &UseCase{repo: mockRepo, config: config.Config{}}
+
+			err := u.DeleteRoomType(context.Background(), serverRoom.ID)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "expected error type %v, got %v", tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
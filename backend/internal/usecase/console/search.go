@@ -0,0 +1,48 @@
+package console
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+	"github.com/shibayama-club/keyhub/internal/usecase/console/dto"
+)
+
+// Search は組織の部屋・鍵・テナント・メンバーを横断して探し、関連度の高い順に返す
+func (u *UseCase) Search(ctx context.Context, input dto.SearchInput) ([]model.SearchResult, error) {
+	query, err := model.NewSearchQuery(input.Query)
+	if err != nil {
+		return nil, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid search query")
+	}
+
+	types := make([]model.SearchResultType, 0, len(input.Types))
+	for _, value := range lo.Uniq(input.Types) {
+		t := model.SearchResultType(value)
+		if err := t.Validate(); err != nil {
+			return nil, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid search result type")
+		}
+		types = append(types, t)
+	}
+
+	limit := input.Limit
+	switch {
+	case limit <= 0:
+		limit = model.DefaultSearchLimit
+	case limit > model.MaxSearchLimit:
+		limit = model.MaxSearchLimit
+	}
+
+	results, err := u.repo.Search(ctx, repository.SearchArg{
+		OrganizationID: input.OrganizationID,
+		Query:          query,
+		Types:          types,
+		Limit:          limit,
+	})
+	if err != nil {
+		return nil, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to search")
+	}
+	return results, nil
+}
@@ -0,0 +1,114 @@
+package console
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/shibayama-club/keyhub/cmd/config"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+	"github.com/shibayama-club/keyhub/internal/domain/repository/mock"
+	"github.com/shibayama-club/keyhub/internal/usecase/console/dto"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUseCase_Search(t *testing.T) {
+	orgID := model.OrganizationID(uuid.MustParse("550e8400-e29b-41d4-a716-446655440000"))
+	roomResult := model.SearchResult{
+		Type:     model.SearchResultTypeRoom,
+		ID:       uuid.MustParse("550e8400-e29b-41d4-a716-446655440001"),
+		Title:    "会議室A",
+		Subtitle: "本館 3F",
+		Score:    0.8,
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func(*mock.MockRepository)
+		input     dto.SearchInput
+		want      []model.SearchResult
+		wantErrIs error
+	}{
+		{
+			name: "正常系: 検索語を整えて既定の件数ですべての種類を探す",
+			setupMock: func(m *mock.MockRepository) {
+				m.EXPECT().
+					Search(gomock.Any(), repository.SearchArg{
+						OrganizationID: orgID,
+						Query:          "会議室",
+						Types:          []model.SearchResultType{},
+						Limit:          model.DefaultSearchLimit,
+					}).
+					Return([]model.SearchResult{roomResult}, nil)
+			},
+			input: dto.SearchInput{OrganizationID: orgID, Query: " 会議室 "},
+			want:  []model.SearchResult{roomResult},
+		},
+		{
+			name: "正常系: 種類の重複を取り除き、件数は上限に収める",
+			setupMock: func(m *mock.MockRepository) {
+				m.EXPECT().
+					Search(gomock.Any(), repository.SearchArg{
+						OrganizationID: orgID,
+						Query:          "A-1",
+						Types:          []model.SearchResultType{model.SearchResultTypeKey, model.SearchResultTypeRoom},
+						Limit:          model.MaxSearchLimit,
+					}).
+					Return([]model.SearchResult{}, nil)
+			},
+			input: dto.SearchInput{
+				OrganizationID: orgID,
+				Query:          "A-1",
+				Types:          []string{"key", "room", "key"},
+				Limit:          model.MaxSearchLimit + 1,
+			},
+			want: []model.SearchResult{},
+		},
+		{
+			name:      "異常系: 空の検索語はバリデーションエラー",
+			setupMock: func(m *mock.MockRepository) {},
+			input:     dto.SearchInput{OrganizationID: orgID, Query: "  "},
+			wantErrIs: domainerrors.ErrValidation,
+		},
+		{
+			name:      "異常系: 無効な種類はバリデーションエラー",
+			setupMock: func(m *mock.MockRepository) {},
+			input:     dto.SearchInput{OrganizationID: orgID, Query: "会議室", Types: []string{"building"}},
+			wantErrIs: domainerrors.ErrValidation,
+		},
+		{
+			name: "異常系: リポジトリのエラーは内部エラー",
+			setupMock: func(m *mock.MockRepository) {
+				m.EXPECT().Search(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+			},
+			input:     dto.SearchInput{OrganizationID: orgID, Query: "会議室"},
+			wantErrIs: domainerrors.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mock.NewMockRepository(ctrl)
+			tt.setupMock(mockRepo)
+
+			u := &UseCase{repo: mockRepo, config: config.Config{}}
+
+			got, err := u.Search(context.Background(), tt.input)
+
+			if tt.wantErrIs != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.wantErrIs))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package console
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+)
+
+func (u *UseCase) ListSessions(ctx context.Context, organizationID model.OrganizationID) ([]model.ConsoleSession, error) {
+	sessions, err := u.repo.ListActiveSessionsByOrganization(ctx, organizationID)
+	if err != nil {
+		return nil, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to list console sessions")
+	}
+
+	return sessions, nil
+}
+
+// RevokeSession は組織のセッションを削除する。他組織のセッションは見つからない扱いにする
+func (u *UseCase) RevokeSession(ctx context.Context, organizationID model.OrganizationID, sessionID string) error {
+	sid, err := model.NewConsoleSessionID(sessionID)
+	if err != nil {
+		return errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid session ID")
+	}
+
+	err = u.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
+		deleted, err := tx.DeleteSessionByOrganization(ctx, organizationID, sid)
+		if err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to delete console session")
+		}
+		if deleted == 0 {
+			return errors.WithHint(
+				errors.Mark(errors.New("console session not found"), domainerrors.ErrNotFound),
+				"指定されたセッションが見つかりません。",
+			)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (u *UseCase) RevokeAllOtherSessions(ctx context.Context, organizationID model.OrganizationID, currentSessionID model.ConsoleSessionID) (int64, error) {
+	var deleted int64
+	err := u.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
+		var err error
+		deleted, err = tx.DeleteOtherSessionsByOrganization(ctx, organizationID, currentSessionID)
+		if err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to delete other console sessions")
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,133 @@
+package console
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/shibayama-club/keyhub/cmd/config"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+	"github.com/shibayama-club/keyhub/internal/domain/repository/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUseCase_ListSessions(t *testing.T) {
+	orgID := model.OrganizationID(uuid.New())
+	sessions := []model.ConsoleSession{
+		{
+			SessionID:      "session-1",
+			OrganizationID: orgID,
+			Role:           model.ConsoleRoleOwner,
+			Client:         model.NewSessionClient("Mozilla/5.0", "203.0.113.10"),
+			LastSeenAt:     time.Now(),
+		},
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func(*mock.MockRepository)
+		want      []model.ConsoleSession
+		wantErr   error
+	}{
+		{
+			name: "正常系: 組織の有効なセッションを接続元の情報付きで返す",
+			setupMock: func(m *mock.MockRepository) {
+				m.EXPECT().ListActiveSessionsByOrganization(gomock.Any(), orgID).Return(sessions, nil)
+			},
+			want: sessions,
+		},
+		{
+			name: "異常系: セッションの取得に失敗した",
+			setupMock: func(m *mock.MockRepository) {
+				m.EXPECT().ListActiveSessionsByOrganization(gomock.Any(), orgID).Return(nil, errors.New("db error"))
+			},
+			wantErr: domainerrors.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRepo := mock.NewMockRepository(ctrl)
+			tt.setupMock(mockRepo)
+
+			u := &UseCase{repo: mockRepo, config: config.Config{}}
+
+			got, err := u.ListSessions(context.Background(), orgID)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "expected error type %v, got %v", tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUseCase_RevokeSession(t *testing.T) {
+	orgID := model.OrganizationID(uuid.New())
+
+	tests := []struct {
+		name      string
+		sessionID string
+		setupMock func(*testing.T, *mock.MockRepository)
+		wantErr   error
+	}{
+		{
+			name:      "正常系: 組織のセッションを削除する",
+			sessionID: "session-1",
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context, repository.Transaction) error) error {
+						mockTx := mock.NewMockTransaction(gomock.NewController(t))
+						mockTx.EXPECT().DeleteSessionByOrganization(gomock.Any(), orgID, model.ConsoleSessionID("session-1")).Return(int64(1), nil)
+						return fn(ctx, mockTx)
+					})
+			},
+		},
+		{
+			name:      "異常系: 他の組織のセッションは見つからない扱いにする",
+			sessionID: "session-2",
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context, repository.Transaction) error) error {
+						mockTx := mock.NewMockTransaction(gomock.NewController(t))
+						mockTx.EXPECT().DeleteSessionByOrganization(gomock.Any(), orgID, model.ConsoleSessionID("session-2")).Return(int64(0), nil)
+						return fn(ctx, mockTx)
+					})
+			},
+			wantErr: domainerrors.ErrNotFound,
+		},
+		{
+			name:      "異常系: セッションIDが空",
+			sessionID: "",
+			setupMock: func(t *testing.T, m *mock.MockRepository) {},
+			wantErr:   domainerrors.ErrValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRepo := mock.NewMockRepository(ctrl)
+			tt.setupMock(t, mockRepo)
+
+			u := &UseCase{repo: mockRepo, config: config.Config{}}
+
+			err := u.RevokeSession(context.Background(), orgID, tt.sessionID)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "expected error type %v, got %v", tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -2,11 +2,15 @@ package console
 
 import (
 	"context"
+	"time"
 
 	"github.com/cockroachdb/errors"
+	"github.com/shibayama-club/keyhub/internal/domain"
 	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/logger"
 	"github.com/shibayama-club/keyhub/internal/domain/model"
 	"github.com/shibayama-club/keyhub/internal/domain/repository"
+	"github.com/shibayama-club/keyhub/internal/usecase/audit"
 	"github.com/shibayama-club/keyhub/internal/usecase/console/dto"
 )
 
@@ -21,9 +25,9 @@ func (u *UseCase) CreateTenant(ctx context.Context, input dto.CreateTenantInput)
 		return "", errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid tenant description")
 	}
 
-	tenantType, err := model.NewTenantType(input.TenantType)
+	tenantType, err := u.newTenantType(ctx, input.TenantType)
 	if err != nil {
-		return "", errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid tenant type")
+		return "", err
 	}
 
 	tenant, err := model.NewTenant(
@@ -85,7 +89,7 @@ func (u *UseCase) CreateTenant(ctx context.Context, input dto.CreateTenantInput)
 			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to create tenant join code in repository")
 		}
 
-		return nil
+		return publishEvent(ctx, tx, model.NewTenantCreatedEvent(tenant))
 	})
 	if err != nil {
 		return "", err
@@ -94,13 +98,54 @@ func (u *UseCase) CreateTenant(ctx context.Context, input dto.CreateTenantInput)
 	return tenant.ID.String(), nil
 }
 
-func (u *UseCase) GetAllTenants(ctx context.Context) ([]model.Tenant, error) {
-	tenants, err := u.repo.GetAllTenants(ctx)
+// GetAllTenants は組織のテナントを条件で絞り込み、1ページ分を返す。
+// 続きの有無を判定するため、指定件数より1件多く取得する
+func (u *UseCase) GetAllTenants(ctx context.Context, input dto.GetAllTenantsInput) (dto.GetAllTenantsOutput, error) {
+	order, err := model.ParseListOrder(input.Order)
+	if err != nil {
+		return dto.GetAllTenantsOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid list order")
+	}
+
+	arg := repository.ListTenantsArg{
+		NamePrefix: input.NamePrefix,
+		Order:      order,
+	}
+	if input.TenantType != "" {
+		tenantType, err := u.newTenantType(ctx, input.TenantType)
+		if err != nil {
+			return dto.GetAllTenantsOutput{}, err
+		}
+		arg.Type = tenantType
+	}
+
+	query := model.PageQuery("tenants", order, map[string]string{
+		"tenant_type": input.TenantType,
+		"name_prefix": input.NamePrefix,
+	})
+	arg.Cursor, err = u.decodePageToken(query, input.PageToken)
+	if err != nil {
+		return dto.GetAllTenantsOutput{}, err
+	}
+	pageSize := model.NormalizePageSize(input.PageSize)
+	arg.Limit = pageSize + 1
+
+	tenants, err := u.repo.ListTenants(ctx, arg)
 	if err != nil {
-		return nil, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to get all tenants from repository")
+		return dto.GetAllTenantsOutput{}, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to list tenants from repository")
 	}
+	tenants, hasNext := model.SplitPage(tenants, pageSize)
 
-	return tenants, nil
+	output := dto.GetAllTenantsOutput{Tenants: tenants}
+	if hasNext {
+		last := tenants[len(tenants)-1]
+		output.NextPageToken = u.pageTokens.Encode(query, model.PageCursor{
+			CreatedAt: last.CreatedAt,
+			Name:      last.Name.String(),
+			ID:        last.ID.UUID(),
+		})
+	}
+
+	return output, nil
 }
 
 func (u *UseCase) GetTenantById(ctx context.Context, TenantId model.TenantID) (dto.GetTenantByIdOutput, error) {
@@ -115,35 +160,42 @@ func (u *UseCase) GetTenantById(ctx context.Context, TenantId model.TenantID) (d
 	}, nil
 }
 
-func (u *UseCase) UpdateTenant(ctx context.Context, input dto.UpdateTenantInput) error {
+func (u *UseCase) UpdateTenant(ctx context.Context, input dto.UpdateTenantInput) (int64, error) {
+	if input.Version <= 0 {
+		return 0, errors.WithHint(
+			errors.Mark(errors.New("tenant version is required"), domainerrors.ErrValidation),
+			"取得したテナントの version を指定してください。",
+		)
+	}
+
 	tenantName, err := model.NewTenantName(input.Name)
 	if err != nil {
-		return errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid tenant name")
+		return 0, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid tenant name")
 	}
 
 	tenantDescription, err := model.NewTenantDescription(input.Description)
 	if err != nil {
-		return errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid tenant description")
+		return 0, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid tenant description")
 	}
 
-	tenantType, err := model.NewTenantType(input.TenantType)
+	tenantType, err := u.newTenantType(ctx, input.TenantType)
 	if err != nil {
-		return errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid tenant type")
+		return 0, err
 	}
 
 	joinCode, err := model.NewTenantJoinCode(input.JoinCode)
 	if err != nil {
-		return errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid join code")
+		return 0, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid join code")
 	}
 
 	joinCodeExpiry, err := model.NewTenantJoinCodeExpiresAt(input.JoinCodeExpiry)
 	if err != nil {
-		return errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid join code expiry")
+		return 0, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid join code expiry")
 	}
 
 	joinCodeMaxUse, err := model.NewTenantJoinCodeMaxUses(input.JoinCodeMaxUse)
 	if err != nil {
-		return errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid join code max use")
+		return 0, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid join code max use")
 	}
 
 	joinCodeEntity, err := model.NewTenantJoinCodeEntity(
@@ -153,19 +205,36 @@ func (u *UseCase) UpdateTenant(ctx context.Context, input dto.UpdateTenantInput)
 		joinCodeMaxUse,
 	)
 	if err != nil {
-		return errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "failed to create tenant join code entity")
+		return 0, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "failed to create tenant join code entity")
 	}
 
+	var version int64
 	err = u.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
-		err = tx.UpdateTenant(ctx, repository.UpdateTenantArg{
+		updated, err := tx.UpdateTenant(ctx, repository.UpdateTenantArg{
 			ID:          input.TenantID,
 			Name:        tenantName,
 			Description: tenantDescription,
 			Type:        tenantType,
+			Version:     input.Version,
 		})
 		if err != nil {
 			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to update a tenant in repository")
 		}
+		if updated == 0 {
+			// 更新できなかった理由を、同じトランザクションで読み直して区別する
+			current, err := tx.GetTenantByID(ctx, input.TenantID)
+			if err != nil {
+				return errors.Wrap(errors.Mark(err, domainerrors.ErrNotFound), "tenant not found")
+			}
+			if err := current.Tenant.EnsureWritable(); err != nil {
+				return errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "tenant is archived")
+			}
+			return errors.WithHint(
+				errors.Mark(errors.New("tenant was updated by another request"), domainerrors.ErrConflict),
+				"他の管理者がこのテナントを更新しました。再読み込みしてから編集し直してください。",
+			)
+		}
+		version = updated
 		err = tx.UpdateTenantJoinCodeByTenantId(ctx, repository.UpdateTenantJoinCodeArg{
 			TenantID:  input.TenantID,
 			Code:      joinCodeEntity.Code,
@@ -178,8 +247,125 @@ func (u *UseCase) UpdateTenant(ctx context.Context, input dto.UpdateTenantInput)
 		return nil
 	})
 	if err != nil {
-		return err
+		return 0, err
+	}
+
+	return version, nil
+}
+
+// ArchiveTenant はテナントをアーカイブする。アーカイブしたテナントは閲覧のみになり、
+// 参加コードは使えなくなり、有効な部屋の割り当ては終了する。メンバーへの通知はイベントから送る
+func (u *UseCase) ArchiveTenant(ctx context.Context, tenantID model.TenantID) (model.Tenant, error) {
+	var archived model.Tenant
+	err := u.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
+		current, err := tx.GetTenantByID(ctx, tenantID)
+		if err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrNotFound), "tenant not found")
+		}
+
+		now := time.Now()
+		archived, err = current.Tenant.Archive(now)
+		if err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "failed to archive tenant")
+		}
+
+		rows, err := tx.ArchiveTenant(ctx, tenantID, now)
+		if err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to archive tenant in repository")
+		}
+		if rows == 0 {
+			return errors.WithHint(
+				errors.Mark(errors.New("tenant is already archived"), domainerrors.ErrValidation),
+				"このテナントは既にアーカイブしています。",
+			)
+		}
+
+		if err := tx.DisableTenantJoinCodes(ctx, tenantID, now); err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to disable tenant join codes in repository")
+		}
+
+		ended, err := tx.EndRoomAssignmentsByTenant(ctx, tenantID, now)
+		if err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to end room assignments in repository")
+		}
+
+		return publishEvent(ctx, tx, model.NewTenantArchivedEvent(archived, ended))
+	})
+	if err != nil {
+		return model.Tenant{}, err
+	}
+
+	return archived, nil
+}
+
+// DeleteTenant はテナントをメンバーシップ・参加コード・グループ・部屋の割り当てとともに削除する。
+// 貸し出し中の鍵がある場合は削除しない。削除したテナントは参照できなくなるため、
+// 同じトランザクションで監査ログに墓標を残す
+func (u *UseCase) DeleteTenant(ctx context.Context, input dto.DeleteTenantInput) error {
+	requestID, _ := domain.Value[logger.RequestID](ctx)
+
+	return u.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
+		current, err := tx.GetTenantByID(ctx, input.TenantID)
+		if err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrNotFound), "tenant not found")
+		}
+		tenant := current.Tenant
+
+		inUse, err := tx.CountKeysInUseByTenant(ctx, tenant.ID)
+		if err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to count keys in use")
+		}
+		if inUse > 0 {
+			return errors.WithHintf(
+				errors.Mark(errors.Newf("tenant has %d keys in use", inUse), domainerrors.ErrValidation),
+				"貸し出し中の鍵が%d本あるため削除できません。鍵が返却されてから削除してください。", inUse,
+			)
+		}
+
+		tombstone, err := model.NewTenantTombstone(tenant, input.Actor, string(requestID), input.Client)
+		if err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to build tenant tombstone")
+		}
+
+		// 部屋の割り当てがグループを参照しているため、割り当て・グループ・メンバーシップ・参加コードの順に削除する
+		if err := tx.DeleteRoomAssignmentsByTenant(ctx, tenant.ID); err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to delete room assignments in repository")
+		}
+		if err := deleteTenantGroups(ctx, tx, tenant.ID); err != nil {
+			return err
+		}
+		if err := tx.DeleteTenantMembershipsByTenant(ctx, tenant.ID); err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to delete tenant memberships in repository")
+		}
+		if err := tx.DeleteTenantJoinCodesByTenant(ctx, tenant.ID); err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to delete tenant join codes in repository")
+		}
+
+		rows, err := tx.DeleteTenant(ctx, tenant.ID)
+		if err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to delete tenant in repository")
+		}
+		if rows == 0 {
+			return errors.Mark(errors.New("tenant not found"), domainerrors.ErrNotFound)
+		}
+
+		if err := audit.AppendLog(ctx, tx, tombstone); err != nil {
+			return err
+		}
+
+		return publishEvent(ctx, tx, model.NewTenantDeletedEvent(tenant))
+	})
+}
+
+// ensureTenantWritable はテナントが存在し、アーカイブされていないことを確認する
+func (u *UseCase) ensureTenantWritable(ctx context.Context, tenantID model.TenantID) error {
+	tenant, err := u.repo.GetTenantByID(ctx, tenantID)
+	if err != nil {
+		return errors.Wrap(errors.Mark(err, domainerrors.ErrNotFound), "tenant not found")
 	}
 
+	if err := tenant.Tenant.EnsureWritable(); err != nil {
+		return errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "tenant is archived")
+	}
 	return nil
 }
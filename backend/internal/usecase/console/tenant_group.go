@@ -0,0 +1,191 @@
+package console
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+	"github.com/shibayama-club/keyhub/internal/usecase/console/dto"
+)
+
+func (u *UseCase) CreateTenantGroup(ctx context.Context, input dto.CreateTenantGroupInput) (model.TenantGroup, error) {
+	name, err := model.NewTenantGroupName(input.Name)
+	if err != nil {
+		return model.TenantGroup{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid tenant group name")
+	}
+
+	description, err := model.NewTenantGroupDescription(input.Description)
+	if err != nil {
+		return model.TenantGroup{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid tenant group description")
+	}
+
+	tenant, err := u.repo.GetTenantByID(ctx, input.TenantID)
+	if err != nil {
+		return model.TenantGroup{}, errors.Wrap(errors.Mark(err, domainerrors.ErrNotFound), "tenant not found")
+	}
+	if err := tenant.Tenant.EnsureWritable(); err != nil {
+		return model.TenantGroup{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "tenant is archived")
+	}
+
+	var parent *model.TenantGroup
+	if input.ParentGroupID != nil {
+		group, err := u.repo.GetTenantGroup(ctx, *input.ParentGroupID)
+		if err != nil {
+			return model.TenantGroup{}, errors.Wrap(errors.Mark(err, domainerrors.ErrNotFound), "parent tenant group not found")
+		}
+		parent = &group
+	}
+
+	group, err := model.NewTenantGroup(tenant.Tenant, parent, name, description)
+	if err != nil {
+		return model.TenantGroup{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "failed to create tenant group")
+	}
+
+	err = u.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
+		if _, err := tx.GetTenantGroupByTenantAndName(ctx, group.TenantID, group.Name); err == nil {
+			return errors.WithHint(
+				errors.Mark(errors.New("tenant group name already exists"), domainerrors.ErrAlreadyExists),
+				"同じ名前のグループが既に存在します。",
+			)
+		}
+
+		if err := tx.CreateTenantGroup(ctx, group); err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to create tenant group in repository")
+		}
+		return nil
+	})
+	if err != nil {
+		return model.TenantGroup{}, err
+	}
+
+	return group, nil
+}
+
+func (u *UseCase) ListTenantGroups(ctx context.Context, tenantID model.TenantID) ([]dto.TenantGroupOutput, error) {
+	groups, err := u.repo.ListTenantGroupsByTenant(ctx, tenantID)
+	if err != nil {
+		return nil, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to list tenant groups")
+	}
+
+	return lo.Map(groups, func(g repository.TenantGroupWithMemberCount, _ int) dto.TenantGroupOutput {
+		return dto.TenantGroupOutput{
+			Group:       g.Group,
+			MemberCount: g.MemberCount,
+		}
+	}), nil
+}
+
+// AddTenantGroupMember はグループにメンバーを追加する。追加できるのはグループのテナントに参加中のユーザーだけ
+func (u *UseCase) AddTenantGroupMember(ctx context.Context, groupID model.TenantGroupID, userID model.UserID) error {
+	group, err := u.repo.GetTenantGroup(ctx, groupID)
+	if err != nil {
+		return errors.Wrap(errors.Mark(err, domainerrors.ErrNotFound), "tenant group not found")
+	}
+
+	if err := u.ensureTenantWritable(ctx, group.TenantID); err != nil {
+		return err
+	}
+
+	membership, err := u.repo.GetTenantMembershipByTenantAndUser(ctx, group.TenantID, userID)
+	if err != nil || membership.LeftAt != nil {
+		return errors.WithHint(
+			errors.Mark(errors.New("user is not a member of the tenant"), domainerrors.ErrValidation),
+			"グループにはテナントに参加しているユーザーだけを追加できます。",
+		)
+	}
+
+	var rows int64
+	err = u.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
+		rows, err = tx.AddTenantGroupMember(ctx, groupID, userID)
+		if err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to add tenant group member in repository")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return errors.Mark(errors.New("user is already a member of this group"), domainerrors.ErrAlreadyExists)
+	}
+
+	return nil
+}
+
+func (u *UseCase) RemoveTenantGroupMember(ctx context.Context, groupID model.TenantGroupID, userID model.UserID) error {
+	group, err := u.repo.GetTenantGroup(ctx, groupID)
+	if err != nil {
+		return errors.Wrap(errors.Mark(err, domainerrors.ErrNotFound), "tenant group not found")
+	}
+
+	if err := u.ensureTenantWritable(ctx, group.TenantID); err != nil {
+		return err
+	}
+
+	var rows int64
+	err = u.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
+		var err error
+		rows, err = tx.RemoveTenantGroupMember(ctx, groupID, userID)
+		if err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to remove tenant group member in repository")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return errors.Mark(errors.New("tenant group member not found"), domainerrors.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (u *UseCase) ListTenantGroupMembers(ctx context.Context, groupID model.TenantGroupID) ([]model.User, error) {
+	if _, err := u.repo.GetTenantGroup(ctx, groupID); err != nil {
+		return nil, errors.Wrap(errors.Mark(err, domainerrors.ErrNotFound), "tenant group not found")
+	}
+
+	users, err := u.repo.ListTenantGroupMembers(ctx, groupID)
+	if err != nil {
+		return nil, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to list tenant group members")
+	}
+	return users, nil
+}
+
+// verifyTenantGroup はグループが存在し、指定したテナントに属することを確認する
+func (u *UseCase) verifyTenantGroup(ctx context.Context, tenantID model.TenantID, groupID model.TenantGroupID) error {
+	group, err := u.repo.GetTenantGroup(ctx, groupID)
+	if err != nil {
+		return errors.Wrap(errors.Mark(err, domainerrors.ErrNotFound), "tenant group not found")
+	}
+
+	if group.TenantID != tenantID {
+		return errors.WithHint(
+			errors.Mark(errors.New("tenant group belongs to another tenant"), domainerrors.ErrValidation),
+			"割り当て先のテナントのグループを指定してください。",
+		)
+	}
+
+	return nil
+}
+
+// deleteTenantGroups はテナントのグループを削除する。割り当てを削除してからグループを削除するまでの間に
+// グループを参照する割り当てが追加された場合は ErrConflict を返す
+func deleteTenantGroups(ctx context.Context, tx repository.Transaction, tenantID model.TenantID) error {
+	if err := tx.DeleteTenantGroupsByTenant(ctx, tenantID); err != nil {
+		if errors.Is(err, repository.ErrReferenced) {
+			return errors.WithHint(
+				errors.Mark(errors.Wrap(err, "tenant group is referenced by a room assignment"), domainerrors.ErrConflict),
+				"削除中にグループへの部屋の割り当てが追加されました。もう一度削除してください。",
+			)
+		}
+		return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to delete tenant groups in repository")
+	}
+	return nil
+}
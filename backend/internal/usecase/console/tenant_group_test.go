@@ -0,0 +1,206 @@
+package console
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/shibayama-club/keyhub/cmd/config"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+	"github.com/shibayama-club/keyhub/internal/domain/repository/mock"
+	"github.com/shibayama-club/keyhub/internal/usecase/console/dto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUseCase_CreateTenantGroup(t *testing.T) {
+	tenant := model.Tenant{
+		ID:             model.TenantID(uuid.New()),
+		OrganizationID: model.OrganizationID(uuid.New()),
+	}
+	parent := model.TenantGroup{ID: model.TenantGroupID(uuid.New()), TenantID: tenant.ID}
+	otherTenantGroup := model.TenantGroup{ID: model.TenantGroupID(uuid.New()), TenantID: model.TenantID(uuid.New())}
+
+	tests := []struct {
+		name      string
+		input     dto.CreateTenantGroupInput
+		setupMock func(*testing.T, *mock.MockRepository)
+		wantErr   error
+	}{
+		{
+			name:  "正常系: 子グループ作成成功",
+			input: dto.CreateTenantGroupInput{TenantID: tenant.ID, ParentGroupID: &parent.ID, Name: "山田研究室"},
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().GetTenantByID(gomock.Any(), tenant.ID).Return(repository.TenantWithJoinCode{Tenant: tenant}, nil)
+				m.EXPECT().GetTenantGroup(gomock.Any(), parent.ID).Return(parent, nil)
+				m.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context, repository.Transaction) error) error {
+						mockTx := mock.NewMockTransaction(gomock.NewController(t))
+						mockTx.EXPECT().
+							GetTenantGroupByTenantAndName(gomock.Any(), tenant.ID, model.TenantGroupName("山田研究室")).
+							Return(model.TenantGroup{}, errors.New("no rows"))
+						mockTx.EXPECT().
+							CreateTenantGroup(gomock.Any(), gomock.Any()).
+							DoAndReturn(func(_ context.Context, group model.TenantGroup) error {
+								assert.Equal(t, tenant.OrganizationID, group.OrganizationID)
+								require.NotNil(t, group.ParentID)
+								assert.Equal(t, parent.ID, *group.ParentID)
+								return nil
+							})
+						return fn(ctx, mockTx)
+					})
+			},
+		},
+		{
+			name:  "異常系: 親グループが別のテナント",
+			input: dto.CreateTenantGroupInput{TenantID: tenant.ID, ParentGroupID: &otherTenantGroup.ID, Name: "山田研究室"},
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().GetTenantByID(gomock.Any(), tenant.ID).Return(repository.TenantWithJoinCode{Tenant: tenant}, nil)
+				m.EXPECT().GetTenantGroup(gomock.Any(), otherTenantGroup.ID).Return(otherTenantGroup, nil)
+			},
+			wantErr: domainerrors.ErrValidation,
+		},
+		{
+			name:  "異常系: 同じ名前のグループが存在する",
+			input: dto.CreateTenantGroupInput{TenantID: tenant.ID, Name: "山田研究室"},
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().GetTenantByID(gomock.Any(), tenant.ID).Return(repository.TenantWithJoinCode{Tenant: tenant}, nil)
+				m.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context, repository.Transaction) error) error {
+						mockTx := mock.NewMockTransaction(gomock.NewController(t))
+						mockTx.EXPECT().
+							GetTenantGroupByTenantAndName(gomock.Any(), tenant.ID, model.TenantGroupName("山田研究室")).
+							Return(model.TenantGroup{}, nil)
+						return fn(ctx, mockTx)
+					})
+			},
+			wantErr: domainerrors.ErrAlreadyExists,
+		},
+		{
+			name:      "異常系: 名前が空",
+			input:     dto.CreateTenantGroupInput{TenantID: tenant.ID},
+			setupMock: func(t *testing.T, m *mock.MockRepository) {},
+			wantErr:   domainerrors.ErrValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRepo := mock.NewMockRepository(ctrl)
+			tt.setupMock(t, mockRepo)
+
+			u := &UseCase{repo: mockRepo, config: config.Config{}}
+
+			group, err := u.CreateTenantGroup(context.Background(), tt.input)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "expected error type %v, got %v", tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.input.TenantID, group.TenantID)
+		})
+	}
+}
+
+func TestUseCase_AddTenantGroupMember(t *testing.T) {
+	group := model.TenantGroup{ID: model.TenantGroupID(uuid.New()), TenantID: model.TenantID(uuid.New())}
+	tenant := model.Tenant{ID: group.TenantID}
+	userID := model.UserID(uuid.New())
+	leftAt := time.Now()
+	archivedAt := time.Now()
+
+	tests := []struct {
+		name      string
+		setupMock func(*testing.T, *mock.MockRepository)
+		wantErr   error
+	}{
+		{
+			name: "正常系: テナントのメンバーを追加",
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().GetTenantGroup(gomock.Any(), group.ID).Return(group, nil)
+				m.EXPECT().GetTenantByID(gomock.Any(), group.TenantID).Return(repository.TenantWithJoinCode{Tenant: tenant}, nil)
+				m.EXPECT().
+					GetTenantMembershipByTenantAndUser(gomock.Any(), group.TenantID, userID).
+					Return(model.TenantMembership{TenantID: group.TenantID, UserID: userID}, nil)
+				m.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context, repository.Transaction) error) error {
+						mockTx := mock.NewMockTransaction(gomock.NewController(t))
+						mockTx.EXPECT().AddTenantGroupMember(gomock.Any(), group.ID, userID).Return(int64(1), nil)
+						return fn(ctx, mockTx)
+					})
+			},
+		},
+		{
+			name: "異常系: テナントを退出したユーザー",
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().GetTenantGroup(gomock.Any(), group.ID).Return(group, nil)
+				m.EXPECT().GetTenantByID(gomock.Any(), group.TenantID).Return(repository.TenantWithJoinCode{Tenant: tenant}, nil)
+				m.EXPECT().
+					GetTenantMembershipByTenantAndUser(gomock.Any(), group.TenantID, userID).
+					Return(model.TenantMembership{TenantID: group.TenantID, UserID: userID, LeftAt: &leftAt}, nil)
+			},
+			wantErr: domainerrors.ErrValidation,
+		},
+		{
+			name: "異常系: 既にグループのメンバー",
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().GetTenantGroup(gomock.Any(), group.ID).Return(group, nil)
+				m.EXPECT().GetTenantByID(gomock.Any(), group.TenantID).Return(repository.TenantWithJoinCode{Tenant: tenant}, nil)
+				m.EXPECT().
+					GetTenantMembershipByTenantAndUser(gomock.Any(), group.TenantID, userID).
+					Return(model.TenantMembership{TenantID: group.TenantID, UserID: userID}, nil)
+				m.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context, repository.Transaction) error) error {
+						mockTx := mock.NewMockTransaction(gomock.NewController(t))
+						mockTx.EXPECT().AddTenantGroupMember(gomock.Any(), group.ID, userID).Return(int64(0), nil)
+						return fn(ctx, mockTx)
+					})
+			},
+			wantErr: domainerrors.ErrAlreadyExists,
+		},
+		{
+			name: "異常系: アーカイブしたテナントのグループ",
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().GetTenantGroup(gomock.Any(), group.ID).Return(group, nil)
+				m.EXPECT().
+					GetTenantByID(gomock.Any(), group.TenantID).
+					Return(repository.TenantWithJoinCode{Tenant: model.Tenant{ID: group.TenantID, ArchivedAt: &archivedAt}}, nil)
+			},
+			wantErr: domainerrors.ErrValidation,
+		},
+		{
+			name: "異常系: グループが存在しない",
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().GetTenantGroup(gomock.Any(), group.ID).Return(model.TenantGroup{}, errors.New("no rows"))
+			},
+			wantErr: domainerrors.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRepo := mock.NewMockRepository(ctrl)
+			tt.setupMock(t, mockRepo)
+
+			u := &UseCase{repo: mockRepo, config: config.Config{}}
+
+			err := u.AddTenantGroupMember(context.Background(), group.ID, userID)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "expected error type %v, got %v", tt.wantErr, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package console
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/google/uuid"
@@ -13,6 +14,7 @@ import (
 	"github.com/shibayama-club/keyhub/internal/domain/repository/mock"
 	"github.com/shibayama-club/keyhub/internal/usecase/console/dto"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
 
@@ -47,6 +49,12 @@ func TestUseCase_CreateTenant(t *testing.T) {
 							mockTx.EXPECT().
 								CreateTenantJoinCode(gomock.Any(), gomock.Any()).
 								Return(nil)
+							mockTx.EXPECT().
+								CreateOutboxEvent(gomock.Any(), gomock.Any()).
+								DoAndReturn(func(_ context.Context, event model.OutboxEvent) error {
+									assert.Equal(t, model.DomainEventTenantCreated, event.Type)
+									return nil
+								})
 							return fn(ctx, mockTx)
 						})
 				},
@@ -93,7 +101,8 @@ func TestUseCase_CreateTenant(t *testing.T) {
 			name: "異常系: 不正なテナントタイプ",
 			fields: fields{
 				setupMock: func(m *mock.MockRepository) {
-					// モックは呼ばれない
+					// 組織が追加したテナントタイプにもない
+					m.EXPECT().ListTenantTypes(gomock.Any()).Return(model.TenantTypeCatalog{}, nil)
 				},
 			},
 			args: args{
@@ -149,6 +158,9 @@ func TestUseCase_CreateTenant(t *testing.T) {
 							mockTx.EXPECT().
 								CreateTenantJoinCode(gomock.Any(), gomock.Any()).
 								Return(nil)
+							mockTx.EXPECT().
+								CreateOutboxEvent(gomock.Any(), gomock.Any()).
+								Return(nil)
 							return fn(ctx, mockTx)
 						})
 				},
@@ -208,80 +220,183 @@ func TestUseCase_CreateTenant(t *testing.T) {
 }
 
 func TestUseCase_GetAllTenants(t *testing.T) {
+	pageTokens, err := model.NewPageTokenCodec([]byte("0123456789abcdef0123456789abcdef"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	orgID := model.OrganizationID(uuid.MustParse("550e8400-e29b-41d4-a716-446655440000"))
+	tenant1 := model.Tenant{
+		ID:             model.TenantID(uuid.MustParse("550e8400-e29b-41d4-a716-446655440001")),
+		OrganizationID: orgID,
+		Name:           model.TenantName("テナント1"),
+		Description:    model.TenantDescription("説明1"),
+		Type:           model.TenantTypeTeam,
+		CreatedAt:      time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC),
+	}
+	tenant2 := model.Tenant{
+		ID:             model.TenantID(uuid.MustParse("550e8400-e29b-41d4-a716-446655440002")),
+		OrganizationID: orgID,
+		Name:           model.TenantName("テナント2"),
+		Description:    model.TenantDescription("説明2"),
+		Type:           model.TenantTypeDepartment,
+		CreatedAt:      time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+	}
+	teamQuery := model.PageQuery("tenants", model.ListOrderNewest, map[string]string{"tenant_type": model.TenantTypeTeam.String()})
+	tenant1Cursor := model.PageCursor{CreatedAt: tenant1.CreatedAt, Name: tenant1.Name.String(), ID: tenant1.ID.UUID()}
+
 	type fields struct {
 		setupMock func(*mock.MockRepository)
 	}
 	type args struct {
-		ctx context.Context
+		ctx   context.Context
+		input dto.GetAllTenantsInput
 	}
 	tests := []struct {
-		name    string
-		fields  fields
-		args    args
-		want    []model.Tenant
-		wantErr bool
+		name      string
+		fields    fields
+		args      args
+		want      dto.GetAllTenantsOutput
+		wantErr   bool
+		wantErrIs error
 	}{
 		{
-			name: "正常系: テナント一覧取得成功",
+			name: "正常系: 既定の件数と新しい順で取得し、続きがなければトークンを返さない",
 			fields: fields{
 				setupMock: func(m *mock.MockRepository) {
-					expectedTenants := []model.Tenant{
-						{
-							ID:             model.TenantID(uuid.MustParse("550e8400-e29b-41d4-a716-446655440001")),
-							OrganizationID: model.OrganizationID(uuid.MustParse("550e8400-e29b-41d4-a716-446655440000")),
-							Name:           model.TenantName("テナント1"),
-							Description:    model.TenantDescription("説明1"),
-							Type:           model.TenantTypeTeam,
-						},
-						{
-							ID:             model.TenantID(uuid.MustParse("550e8400-e29b-41d4-a716-446655440002")),
-							OrganizationID: model.OrganizationID(uuid.MustParse("550e8400-e29b-41d4-a716-446655440000")),
-							Name:           model.TenantName("テナント2"),
-							Description:    model.TenantDescription("説明2"),
-							Type:           model.TenantTypeDepartment,
-						},
-					}
-
 					m.EXPECT().
-						GetAllTenants(gomock.Any()).
-						Return(expectedTenants, nil)
+						ListTenants(gomock.Any(), repository.ListTenantsArg{
+							Order: model.ListOrderNewest,
+							Limit: model.DefaultPageSize + 1,
+						}).
+						Return([]model.Tenant{tenant1, tenant2}, nil)
 				},
 			},
 			args: args{
 				ctx: context.Background(),
 			},
-			want: []model.Tenant{
-				{
-					ID:             model.TenantID(uuid.MustParse("550e8400-e29b-41d4-a716-446655440001")),
-					OrganizationID: model.OrganizationID(uuid.MustParse("550e8400-e29b-41d4-a716-446655440000")),
-					Name:           model.TenantName("テナント1"),
-					Description:    model.TenantDescription("説明1"),
-					Type:           model.TenantTypeTeam,
-				},
-				{
-					ID:             model.TenantID(uuid.MustParse("550e8400-e29b-41d4-a716-446655440002")),
-					OrganizationID: model.OrganizationID(uuid.MustParse("550e8400-e29b-41d4-a716-446655440000")),
-					Name:           model.TenantName("テナント2"),
-					Description:    model.TenantDescription("説明2"),
-					Type:           model.TenantTypeDepartment,
-				},
+			want: dto.GetAllTenantsOutput{
+				Tenants: []model.Tenant{tenant1, tenant2},
 			},
-			wantErr: false,
 		},
 		{
 			name: "正常系: テナントが0件でも成功",
 			fields: fields{
 				setupMock: func(m *mock.MockRepository) {
 					m.EXPECT().
-						GetAllTenants(gomock.Any()).
+						ListTenants(gomock.Any(), gomock.Any()).
 						Return([]model.Tenant{}, nil)
 				},
 			},
 			args: args{
 				ctx: context.Background(),
 			},
-			want:    []model.Tenant{},
-			wantErr: false,
+			want: dto.GetAllTenantsOutput{
+				Tenants: []model.Tenant{},
+			},
+		},
+		{
+			name: "正常系: 続きがある場合は最後のテナントを指すトークンを返す",
+			fields: fields{
+				setupMock: func(m *mock.MockRepository) {
+					m.EXPECT().
+						ListTenants(gomock.Any(), repository.ListTenantsArg{
+							Type:  model.TenantTypeTeam,
+							Order: model.ListOrderNewest,
+							Limit: 2,
+						}).
+						Return([]model.Tenant{tenant1, tenant2}, nil)
+				},
+			},
+			args: args{
+				ctx:   context.Background(),
+				input: dto.GetAllTenantsInput{TenantType: model.TenantTypeTeam.String(), PageSize: 1},
+			},
+			want: dto.GetAllTenantsOutput{
+				Tenants:       []model.Tenant{tenant1},
+				NextPageToken: pageTokens.Encode(teamQuery, tenant1Cursor),
+			},
+		},
+		{
+			name: "正常系: トークンを指定すると続きから取得する",
+			fields: fields{
+				setupMock: func(m *mock.MockRepository) {
+					m.EXPECT().
+						ListTenants(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(_ context.Context, arg repository.ListTenantsArg) ([]model.Tenant, error) {
+							assert.Equal(t, tenant1.ID.UUID(), arg.Cursor.ID)
+							assert.True(t, tenant1.CreatedAt.Equal(arg.Cursor.CreatedAt))
+							return []model.Tenant{tenant2}, nil
+						})
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: dto.GetAllTenantsInput{
+					TenantType: model.TenantTypeTeam.String(),
+					PageSize:   1,
+					PageToken:  pageTokens.Encode(teamQuery, tenant1Cursor),
+				},
+			},
+			want: dto.GetAllTenantsOutput{
+				Tenants: []model.Tenant{tenant2},
+			},
+		},
+		{
+			name: "異常系: 条件を変えて前回のトークンを指定するとバリデーションエラー",
+			fields: fields{
+				setupMock: func(m *mock.MockRepository) {},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: dto.GetAllTenantsInput{
+					TenantType: model.TenantTypeDepartment.String(),
+					PageToken:  pageTokens.Encode(teamQuery, tenant1Cursor),
+				},
+			},
+			wantErr:   true,
+			wantErrIs: domainerrors.ErrValidation,
+		},
+		{
+			name: "異常系: 無効なテナント種別はバリデーションエラー",
+			fields: fields{
+				setupMock: func(m *mock.MockRepository) {
+					m.EXPECT().ListTenantTypes(gomock.Any()).Return(model.TenantTypeCatalog{}, nil)
+				},
+			},
+			args: args{
+				ctx:   context.Background(),
+				input: dto.GetAllTenantsInput{TenantType: "unknown"},
+			},
+			wantErr:   true,
+			wantErrIs: domainerrors.ErrValidation,
+		},
+		{
+			name: "異常系: 無効な並び順はバリデーションエラー",
+			fields: fields{
+				setupMock: func(m *mock.MockRepository) {},
+			},
+			args: args{
+				ctx:   context.Background(),
+				input: dto.GetAllTenantsInput{Order: "oldest"},
+			},
+			wantErr:   true,
+			wantErrIs: domainerrors.ErrValidation,
+		},
+		{
+			name: "異常系: リポジトリのエラーは内部エラー",
+			fields: fields{
+				setupMock: func(m *mock.MockRepository) {
+					m.EXPECT().
+						ListTenants(gomock.Any(), gomock.Any()).
+						Return(nil, errors.New("db error"))
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr:   true,
+			wantErrIs: domainerrors.ErrInternal,
 		},
 	}
 
@@ -295,16 +410,18 @@ func TestUseCase_GetAllTenants(t *testing.T) {
 			tt.fields.setupMock(mockRepo)
 
 			u := &UseCase{
-				repo:   mockRepo,
-				config: config.Config{},
+				repo:       mockRepo,
+				config:     config.Config{},
+				pageTokens: pageTokens,
 			}
 
 			// Act
-			got, err := u.GetAllTenants(tt.args.ctx)
+			got, err := u.GetAllTenants(tt.args.ctx, tt.args.input)
 
 			// Assert
 			if tt.wantErr {
 				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.wantErrIs))
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, tt.want, got)
@@ -312,3 +429,326 @@ func TestUseCase_GetAllTenants(t *testing.T) {
 		})
 	}
 }
+
+func TestUseCase_UpdateTenant(t *testing.T) {
+	tenant := model.Tenant{
+		ID:             model.TenantID(uuid.New()),
+		OrganizationID: model.OrganizationID(uuid.New()),
+		Name:           "情報工学研究会",
+		Version:        5,
+	}
+	archivedAt := time.Now()
+	archived := tenant
+	archived.ArchivedAt = &archivedAt
+	input := dto.UpdateTenantInput{
+		TenantID:    tenant.ID,
+		Name:        "情報工学研究会（新）",
+		Description: "説明",
+		TenantType:  model.TenantTypeTeam.String(),
+		JoinCode:    "testcode123",
+		Version:     tenant.Version,
+	}
+
+	tests := []struct {
+		name      string
+		input     func(dto.UpdateTenantInput) dto.UpdateTenantInput
+		setupMock func(*testing.T, *mock.MockRepository)
+		want      int64
+		wantErr   error
+	}{
+		{
+			name: "正常系: 取得時の版数のまま更新し、更新後の版数を返す",
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context, repository.Transaction) error) error {
+						mockTx := mock.NewMockTransaction(gomock.NewController(t))
+						mockTx.EXPECT().
+							UpdateTenant(gomock.Any(), gomock.Any()).
+							DoAndReturn(func(_ context.Context, arg repository.UpdateTenantArg) (int64, error) {
+								assert.Equal(t, tenant.Version, arg.Version)
+								return tenant.Version + 1, nil
+							})
+						mockTx.EXPECT().UpdateTenantJoinCodeByTenantId(gomock.Any(), gomock.Any()).Return(nil)
+						return fn(ctx, mockTx)
+					})
+			},
+			want: tenant.Version + 1,
+		},
+		{
+			name: "異常系: 取得した後に他の管理者が更新した",
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context, repository.Transaction) error) error {
+						mockTx := mock.NewMockTransaction(gomock.NewController(t))
+						mockTx.EXPECT().UpdateTenant(gomock.Any(), gomock.Any()).Return(int64(0), nil)
+						mockTx.EXPECT().GetTenantByID(gomock.Any(), tenant.ID).Return(repository.TenantWithJoinCode{Tenant: tenant}, nil)
+						return fn(ctx, mockTx)
+					})
+			},
+			wantErr: domainerrors.ErrConflict,
+		},
+		{
+			name: "異常系: 取得した後にテナントがアーカイブされた",
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context, repository.Transaction) error) error {
+						mockTx := mock.NewMockTransaction(gomock.NewController(t))
+						mockTx.EXPECT().UpdateTenant(gomock.Any(), gomock.Any()).Return(int64(0), nil)
+						mockTx.EXPECT().GetTenantByID(gomock.Any(), tenant.ID).Return(repository.TenantWithJoinCode{Tenant: archived}, nil)
+						return fn(ctx, mockTx)
+					})
+			},
+			wantErr: domainerrors.ErrValidation,
+		},
+		{
+			name: "異常系: テナントが存在しない",
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context, repository.Transaction) error) error {
+						mockTx := mock.NewMockTransaction(gomock.NewController(t))
+						mockTx.EXPECT().UpdateTenant(gomock.Any(), gomock.Any()).Return(int64(0), nil)
+						mockTx.EXPECT().GetTenantByID(gomock.Any(), tenant.ID).Return(repository.TenantWithJoinCode{}, errors.New("no rows"))
+						return fn(ctx, mockTx)
+					})
+			},
+			wantErr: domainerrors.ErrNotFound,
+		},
+		{
+			name: "異常系: 版数を指定していない",
+			input: func(in dto.UpdateTenantInput) dto.UpdateTenantInput {
+				in.Version = 0
+				return in
+			},
+			setupMock: func(t *testing.T, m *mock.MockRepository) {},
+			wantErr:   domainerrors.ErrValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRepo := mock.NewMockRepository(ctrl)
+			tt.setupMock(t, mockRepo)
+
+			u := &UseCase{repo: mockRepo, config: config.Config{}}
+
+			in := input
+			if tt.input != nil {
+				in = tt.input(input)
+			}
+			got, err := u.UpdateTenant(context.Background(), in)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "expected error type %v, got %v", tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUseCase_ArchiveTenant(t *testing.T) {
+	tenant := model.Tenant{
+		ID:             model.TenantID(uuid.New()),
+		OrganizationID: model.OrganizationID(uuid.New()),
+		Name:           "情報工学研究会",
+	}
+	archivedAt := time.Now()
+	archived := tenant
+	archived.ArchivedAt = &archivedAt
+
+	tests := []struct {
+		name      string
+		setupMock func(*testing.T, *mock.MockRepository)
+		wantErr   error
+	}{
+		{
+			name: "正常系: 参加コードを無効化し、部屋の割り当てを終了してイベントを記録する",
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context, repository.Transaction) error) error {
+						mockTx := mock.NewMockTransaction(gomock.NewController(t))
+						mockTx.EXPECT().GetTenantByID(gomock.Any(), tenant.ID).Return(repository.TenantWithJoinCode{Tenant: tenant}, nil)
+						mockTx.EXPECT().ArchiveTenant(gomock.Any(), tenant.ID, gomock.Any()).Return(int64(1), nil)
+						mockTx.EXPECT().DisableTenantJoinCodes(gomock.Any(), tenant.ID, gomock.Any()).Return(nil)
+						mockTx.EXPECT().EndRoomAssignmentsByTenant(gomock.Any(), tenant.ID, gomock.Any()).Return(int64(2), nil)
+						mockTx.EXPECT().
+							CreateOutboxEvent(gomock.Any(), gomock.Any()).
+							DoAndReturn(func(_ context.Context, e model.OutboxEvent) error {
+								assert.Equal(t, model.DomainEventTenantArchived, e.Type)
+								return nil
+							})
+						return fn(ctx, mockTx)
+					})
+			},
+		},
+		{
+			name: "異常系: アーカイブ済みのテナント",
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context, repository.Transaction) error) error {
+						mockTx := mock.NewMockTransaction(gomock.NewController(t))
+						mockTx.EXPECT().GetTenantByID(gomock.Any(), tenant.ID).Return(repository.TenantWithJoinCode{Tenant: archived}, nil)
+						return fn(ctx, mockTx)
+					})
+			},
+			wantErr: domainerrors.ErrValidation,
+		},
+		{
+			name: "異常系: テナントが存在しない",
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context, repository.Transaction) error) error {
+						mockTx := mock.NewMockTransaction(gomock.NewController(t))
+						mockTx.EXPECT().GetTenantByID(gomock.Any(), tenant.ID).Return(repository.TenantWithJoinCode{}, errors.New("no rows"))
+						return fn(ctx, mockTx)
+					})
+			},
+			wantErr: domainerrors.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRepo := mock.NewMockRepository(ctrl)
+			tt.setupMock(t, mockRepo)
+
+			u := &UseCase{repo: mockRepo, config: config.Config{}}
+
+			got, err := u.ArchiveTenant(context.Background(), tenant.ID)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "expected error type %v, got %v", tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, got.IsArchived())
+		})
+	}
+}
+
+func TestUseCase_DeleteTenant(t *testing.T) {
+	tenant := model.Tenant{
+		ID:             model.TenantID(uuid.New()),
+		OrganizationID: model.OrganizationID(uuid.New()),
+		Name:           "情報工学研究会",
+		Type:           model.TenantTypeTeam,
+	}
+	input := dto.DeleteTenantInput{
+		TenantID: tenant.ID,
+		Actor:    model.AuditActor{Type: model.AuditActorTypeConsoleOperator, ID: uuid.NewString()},
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func(*testing.T, *mock.MockRepository)
+		wantErr   error
+	}{
+		{
+			name: "正常系: 関連するデータを削除し、墓標を監査ログに残す",
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context, repository.Transaction) error) error {
+						mockTx := mock.NewMockTransaction(gomock.NewController(t))
+						mockTx.EXPECT().GetTenantByID(gomock.Any(), tenant.ID).Return(repository.TenantWithJoinCode{Tenant: tenant}, nil)
+						mockTx.EXPECT().CountKeysInUseByTenant(gomock.Any(), tenant.ID).Return(int32(0), nil)
+						gomock.InOrder(
+							mockTx.EXPECT().DeleteRoomAssignmentsByTenant(gomock.Any(), tenant.ID).Return(nil),
+							mockTx.EXPECT().DeleteTenantGroupsByTenant(gomock.Any(), tenant.ID).Return(nil),
+							mockTx.EXPECT().DeleteTenantMembershipsByTenant(gomock.Any(), tenant.ID).Return(nil),
+							mockTx.EXPECT().DeleteTenantJoinCodesByTenant(gomock.Any(), tenant.ID).Return(nil),
+							mockTx.EXPECT().DeleteTenant(gomock.Any(), tenant.ID).Return(int64(1), nil),
+						)
+						mockTx.EXPECT().LockAuditChain(gomock.Any(), tenant.OrganizationID).Return(model.AuditChainHead{Seq: 3, Hash: "prev"}, nil)
+						mockTx.EXPECT().
+							CreateAuditLog(gomock.Any(), gomock.Any()).
+							DoAndReturn(func(_ context.Context, log model.AuditLog) error {
+								assert.Equal(t, model.AuditProcedureTenantTombstone, log.Procedure)
+								assert.Equal(t, tenant.ID.String(), log.TargetIDs["tenant_id"])
+								assert.Equal(t, tenant.Name.String(), log.TargetIDs["tenant_name"])
+								assert.Equal(t, input.Actor, log.Actor)
+								assert.Equal(t, int64(4), log.Seq)
+								return nil
+							})
+						mockTx.EXPECT().
+							CreateOutboxEvent(gomock.Any(), gomock.Any()).
+							DoAndReturn(func(_ context.Context, e model.OutboxEvent) error {
+								assert.Equal(t, model.DomainEventTenantDeleted, e.Type)
+								return nil
+							})
+						return fn(ctx, mockTx)
+					})
+			},
+		},
+		{
+			name: "異常系: 貸し出し中の鍵がある",
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context, repository.Transaction) error) error {
+						mockTx := mock.NewMockTransaction(gomock.NewController(t))
+						mockTx.EXPECT().GetTenantByID(gomock.Any(), tenant.ID).Return(repository.TenantWithJoinCode{Tenant: tenant}, nil)
+						mockTx.EXPECT().CountKeysInUseByTenant(gomock.Any(), tenant.ID).Return(int32(2), nil)
+						return fn(ctx, mockTx)
+					})
+			},
+			wantErr: domainerrors.ErrValidation,
+		},
+		{
+			name: "異常系: 削除中にグループを参照する部屋の割り当てが追加された",
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context, repository.Transaction) error) error {
+						mockTx := mock.NewMockTransaction(gomock.NewController(t))
+						mockTx.EXPECT().GetTenantByID(gomock.Any(), tenant.ID).Return(repository.TenantWithJoinCode{Tenant: tenant}, nil)
+						mockTx.EXPECT().CountKeysInUseByTenant(gomock.Any(), tenant.ID).Return(int32(0), nil)
+						mockTx.EXPECT().DeleteRoomAssignmentsByTenant(gomock.Any(), tenant.ID).Return(nil)
+						mockTx.EXPECT().
+							DeleteTenantGroupsByTenant(gomock.Any(), tenant.ID).
+							Return(errors.Mark(errors.New("foreign key violation"), repository.ErrReferenced))
+						return fn(ctx, mockTx)
+					})
+			},
+			wantErr: domainerrors.ErrConflict,
+		},
+		{
+			name: "異常系: テナントが存在しない",
+			setupMock: func(t *testing.T, m *mock.MockRepository) {
+				m.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context, repository.Transaction) error) error {
+						mockTx := mock.NewMockTransaction(gomock.NewController(t))
+						mockTx.EXPECT().GetTenantByID(gomock.Any(), tenant.ID).Return(repository.TenantWithJoinCode{}, errors.New("no rows"))
+						return fn(ctx, mockTx)
+					})
+			},
+			wantErr: domainerrors.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRepo := mock.NewMockRepository(ctrl)
+			tt.setupMock(t, mockRepo)
+
+			u := &UseCase{repo: mockRepo, config: config.Config{}}
+
+			err := u.DeleteTenant(context.Background(), input)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "expected error type %v, got %v", tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package console
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+	"github.com/shibayama-club/keyhub/internal/usecase/console/dto"
+)
+
+// ListTenantTypes は既定のテナントタイプに続けて組織が追加したテナントタイプを返す
+func (u *UseCase) ListTenantTypes(ctx context.Context) ([]model.TenantTypeDefinition, error) {
+	catalog, err := u.repo.ListTenantTypes(ctx)
+	if err != nil {
+		return nil, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to list tenant types")
+	}
+	return catalog.All(), nil
+}
+
+func (u *UseCase) CreateTenantType(ctx context.Context, input dto.CreateTenantTypeInput) (model.TenantTypeDefinition, error) {
+	definition, err := model.NewTenantTypeDefinition(input.OrganizationID, model.TenantType(input.Key), input.Label)
+	if err != nil {
+		return model.TenantTypeDefinition{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "failed to create tenant type")
+	}
+
+	err = u.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
+		catalog, err := tx.ListTenantTypes(ctx)
+		if err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to list tenant types")
+		}
+		if catalog.Contains(definition.Key) {
+			return errors.WithHint(
+				errors.Mark(errors.New("tenant type key already exists"), domainerrors.ErrAlreadyExists),
+				"同じキーのテナントタイプが既に存在します。",
+			)
+		}
+
+		if err := tx.CreateTenantType(ctx, definition); err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to create tenant type in repository")
+		}
+		return nil
+	})
+	if err != nil {
+		return model.TenantTypeDefinition{}, err
+	}
+
+	return definition, nil
+}
+
+// DeleteTenantType は組織が追加したテナントタイプを削除する。テナントタイプを使っているテナントが残っている場合は削除できない
+func (u *UseCase) DeleteTenantType(ctx context.Context, id model.TenantTypeID) error {
+	definition, err := u.repo.GetTenantType(ctx, id)
+	if err != nil {
+		return errors.Wrap(errors.Mark(err, domainerrors.ErrNotFound), "tenant type not found")
+	}
+
+	return u.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
+		count, err := tx.CountTenantsByType(ctx, definition.OrganizationID, definition.Key)
+		if err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to count tenants by type")
+		}
+		if count > 0 {
+			return errors.WithHint(
+				errors.Mark(errors.Newf("tenant type is used by %d tenants", count), domainerrors.ErrValidation),
+				"このテナントタイプのテナントが残っているため削除できません。テナントのタイプを変更してから削除してください。",
+			)
+		}
+
+		rows, err := tx.DeleteTenantType(ctx, definition.ID)
+		if err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to delete tenant type in repository")
+		}
+		if rows == 0 {
+			return errors.Mark(errors.New("tenant type not found"), domainerrors.ErrNotFound)
+		}
+		return nil
+	})
+}
+
+// newTenantType は値をテナントタイプにする。既定のテナントタイプでない場合だけ組織が追加したテナントタイプを読み込んで確認する
+func (u *UseCase) newTenantType(ctx context.Context, value string) (model.TenantType, error) {
+	var catalog model.TenantTypeCatalog
+	if !model.TenantType(value).IsBuiltin() {
+		var err error
+		catalog, err = u.repo.ListTenantTypes(ctx)
+		if err != nil {
+			return "", errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to list tenant types")
+		}
+	}
+
+	t, err := model.NewTenantType(value, catalog)
+	if err != nil {
+		return "", errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid tenant type")
+	}
+	return t, nil
+}
@@ -0,0 +1,126 @@
+package console
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+	"github.com/shibayama-club/keyhub/internal/usecase/console/dto"
+	"github.com/shibayama-club/keyhub/internal/usecase/webhook"
+)
+
+// CreateWebhookSubscription はWebhookの購読を登録する。署名用のシークレットは返り値の購読にのみ含まれる
+func (u *UseCase) CreateWebhookSubscription(ctx context.Context, input dto.CreateWebhookSubscriptionInput) (model.WebhookSubscription, error) {
+	webhookURL, err := model.NewWebhookURL(input.URL)
+	if err != nil {
+		return model.WebhookSubscription{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid webhook URL")
+	}
+
+	description, err := model.NewWebhookDescription(input.Description)
+	if err != nil {
+		return model.WebhookSubscription{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid webhook description")
+	}
+
+	eventTypes := make([]model.DomainEventType, 0, len(input.EventTypes))
+	for _, t := range input.EventTypes {
+		eventTypes = append(eventTypes, model.DomainEventType(t))
+	}
+
+	subscription, err := model.NewWebhookSubscription(input.OrganizationID, webhookURL, eventTypes, description)
+	if err != nil {
+		return model.WebhookSubscription{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "failed to create webhook subscription")
+	}
+
+	err = u.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
+		if err := tx.CreateWebhookSubscription(ctx, subscription); err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to create webhook subscription in repository")
+		}
+		return nil
+	})
+	if err != nil {
+		return model.WebhookSubscription{}, err
+	}
+
+	return subscription, nil
+}
+
+func (u *UseCase) ListWebhookSubscriptions(ctx context.Context, organizationID model.OrganizationID) ([]model.WebhookSubscription, error) {
+	subscriptions, err := u.repo.ListWebhookSubscriptionsByOrganization(ctx, organizationID)
+	if err != nil {
+		return nil, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to list webhook subscriptions")
+	}
+
+	return subscriptions, nil
+}
+
+// DeleteWebhookSubscription は購読を削除する。送信履歴も合わせて削除される
+func (u *UseCase) DeleteWebhookSubscription(ctx context.Context, organizationID model.OrganizationID, subscriptionID string) error {
+	id, err := model.ParseWebhookSubscriptionID(subscriptionID)
+	if err != nil {
+		return errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid webhook subscription ID")
+	}
+
+	return u.repo.WithTransaction(ctx, func(ctx context.Context, tx repository.Transaction) error {
+		deleted, err := tx.DeleteWebhookSubscription(ctx, organizationID, id)
+		if err != nil {
+			return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to delete webhook subscription")
+		}
+		if deleted == 0 {
+			return webhookSubscriptionNotFound(errors.New("webhook subscription not found"))
+		}
+		return nil
+	})
+}
+
+// ListWebhookDeliveries は購読の送信履歴を新しい順に返す
+func (u *UseCase) ListWebhookDeliveries(ctx context.Context, input dto.ListWebhookDeliveriesInput) ([]model.WebhookDelivery, error) {
+	id, err := model.ParseWebhookSubscriptionID(input.SubscriptionID)
+	if err != nil {
+		return nil, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid webhook subscription ID")
+	}
+
+	pageSize := input.PageSize
+	switch {
+	case pageSize <= 0:
+		pageSize = model.WebhookDeliveryDefaultPageSize
+	case pageSize > model.WebhookDeliveryMaxPageSize:
+		pageSize = model.WebhookDeliveryMaxPageSize
+	}
+
+	deliveries, err := u.repo.ListWebhookDeliveries(ctx, input.OrganizationID, id, pageSize)
+	if err != nil {
+		return nil, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to list webhook deliveries")
+	}
+
+	return deliveries, nil
+}
+
+// SendTestWebhookEvent は購読先へテスト用のイベントをすぐに送信し、その結果を返す。
+// 購読先が失敗を返してもエラーにはせず、結果の送信履歴で伝える
+func (u *UseCase) SendTestWebhookEvent(ctx context.Context, organizationID model.OrganizationID, subscriptionID string) (model.WebhookDelivery, error) {
+	id, err := model.ParseWebhookSubscriptionID(subscriptionID)
+	if err != nil {
+		return model.WebhookDelivery{}, errors.Wrap(errors.Mark(err, domainerrors.ErrValidation), "invalid webhook subscription ID")
+	}
+
+	subscription, err := u.repo.GetWebhookSubscription(ctx, organizationID, id)
+	if err != nil {
+		return model.WebhookDelivery{}, webhookSubscriptionNotFound(err)
+	}
+
+	delivery, err := webhook.Deliver(ctx, u.repo, u.webhook, subscription, model.NewWebhookTestMessage(subscription))
+	if err != nil && errors.Is(err, domainerrors.ErrInternal) {
+		return model.WebhookDelivery{}, err
+	}
+
+	return delivery, nil
+}
+
+func webhookSubscriptionNotFound(cause error) error {
+	return errors.WithHint(
+		errors.Mark(errors.Wrap(cause, "webhook subscription not found"), domainerrors.ErrNotFound),
+		"指定されたWebhookが見つかりません。",
+	)
+}
@@ -0,0 +1,142 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/cockroachdb/errors"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/event"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+)
+
+var _ event.Handler = (*Notifier)(nil)
+
+func (n *Notifier) Name() string { return "notification" }
+
+// HandleEvent はドメインイベントのうち、ユーザーに知らせるものをメールで送る。
+// 1人でも送信に失敗すればエラーを返してイベントごと再試行させる
+func (n *Notifier) HandleEvent(ctx context.Context, e model.OutboxEvent) error {
+	switch e.Type {
+	case model.DomainEventMemberJoined:
+		var payload model.MemberJoined
+		if err := json.Unmarshal(e.Payload, &payload); err != nil {
+			return errors.Wrap(err, "failed to decode member joined event")
+		}
+		return n.notifyMemberJoined(ctx, e, payload)
+	case model.DomainEventRoomAssigned:
+		var payload model.RoomAssigned
+		if err := json.Unmarshal(e.Payload, &payload); err != nil {
+			return errors.Wrap(err, "failed to decode room assigned event")
+		}
+		return n.notifyRoomAssigned(ctx, e, payload)
+	case model.DomainEventTenantArchived:
+		var payload model.TenantArchived
+		if err := json.Unmarshal(e.Payload, &payload); err != nil {
+			return errors.Wrap(err, "failed to decode tenant archived event")
+		}
+		return n.notifyTenantArchived(ctx, e, payload)
+	default:
+		return nil
+	}
+}
+
+// notifyMemberJoined は参加した本人を除くテナントの管理者に知らせる
+func (n *Notifier) notifyMemberJoined(ctx context.Context, e model.OutboxEvent, payload model.MemberJoined) error {
+	tenantID, err := model.ParseTenantID(payload.TenantID)
+	if err != nil {
+		return err
+	}
+	userID, err := model.ParseUserID(payload.UserID)
+	if err != nil {
+		return err
+	}
+
+	organization, err := n.repo.GetOrganization(ctx, e.OrganizationID)
+	if err != nil {
+		return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to get organization")
+	}
+	tenant, err := n.repo.GetTenantByID(ctx, tenantID)
+	if err != nil {
+		return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to get tenant")
+	}
+	member, err := n.repo.GetUser(ctx, userID)
+	if err != nil {
+		return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to get user")
+	}
+
+	admins, err := n.repo.ListTenantNotificationRecipients(ctx, tenantID, true)
+	if err != nil {
+		return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to list tenant admins")
+	}
+	recipients := make([]model.NotificationRecipient, 0, len(admins))
+	for _, admin := range admins {
+		if admin.User.UserId != userID {
+			recipients = append(recipients, admin)
+		}
+	}
+
+	return n.notify(ctx, organization, model.NotificationKindMemberJoined, e.ID.String(), recipients, templateData{
+		TenantName: tenant.Tenant.Name.String(),
+		MemberName: member.Name.String(),
+	}, nil)
+}
+
+// notifyRoomAssigned はテナントのメンバー全員に知らせる
+func (n *Notifier) notifyRoomAssigned(ctx context.Context, e model.OutboxEvent, payload model.RoomAssigned) error {
+	tenantID, err := model.ParseTenantID(payload.TenantID)
+	if err != nil {
+		return err
+	}
+	roomID, err := model.ParseRoomID(payload.RoomID)
+	if err != nil {
+		return err
+	}
+
+	organization, err := n.repo.GetOrganization(ctx, e.OrganizationID)
+	if err != nil {
+		return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to get organization")
+	}
+	tenant, err := n.repo.GetTenantByID(ctx, tenantID)
+	if err != nil {
+		return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to get tenant")
+	}
+	room, err := n.repo.GetRoomByID(ctx, roomID)
+	if err != nil {
+		return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to get room")
+	}
+
+	members, err := n.repo.ListTenantNotificationRecipients(ctx, tenantID, false)
+	if err != nil {
+		return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to list tenant members")
+	}
+
+	return n.notify(ctx, organization, model.NotificationKindRoomAssigned, e.ID.String(), members, templateData{
+		TenantName:   tenant.Tenant.Name.String(),
+		RoomName:     room.Name.String(),
+		BuildingName: room.BuildingName.String(),
+	}, payload.ExpiresAt)
+}
+
+// notifyTenantArchived はテナントのメンバー全員に知らせる。
+// 通知までにテナントが削除された場合はメンバーもいないため送らない
+func (n *Notifier) notifyTenantArchived(ctx context.Context, e model.OutboxEvent, payload model.TenantArchived) error {
+	tenantID, err := model.ParseTenantID(payload.TenantID)
+	if err != nil {
+		return err
+	}
+
+	organization, err := n.repo.GetOrganization(ctx, e.OrganizationID)
+	if err != nil {
+		return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to get organization")
+	}
+
+	members, err := n.repo.ListTenantNotificationRecipients(ctx, tenantID, false)
+	if err != nil {
+		return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to list tenant members")
+	}
+
+	return n.notify(ctx, organization, model.NotificationKindTenantArchived, e.ID.String(), members, templateData{
+		TenantName: payload.Name,
+	}, nil)
+}
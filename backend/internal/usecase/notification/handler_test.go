@@ -0,0 +1,293 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/shibayama-club/keyhub/cmd/config"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+	"github.com/shibayama-club/keyhub/internal/domain/repository/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type mailerFunc func(ctx context.Context, mail model.Mail) error
+
+func (f mailerFunc) Send(ctx context.Context, mail model.Mail) error {
+	return f(ctx, mail)
+}
+
+func newRecipient(name, email string, disabled ...model.NotificationKind) model.NotificationRecipient {
+	userID := model.UserID(uuid.New())
+	preferences := model.DefaultNotificationPreferences(userID)
+	preferences.DisabledKinds = disabled
+	return model.NotificationRecipient{
+		User: model.User{
+			UserId: userID,
+			Name:   model.UserName(name),
+			Email:  model.UserEmail(email),
+		},
+		Preferences: preferences,
+	}
+}
+
+func TestNotifier_HandleEvent_MemberJoined(t *testing.T) {
+	organization := model.Organization{
+		ID:       model.OrganizationID(uuid.New()),
+		Name:     "芝山大学",
+		Settings: model.DefaultOrganizationSettings(),
+	}
+	tenant := model.Tenant{ID: model.TenantID(uuid.New()), OrganizationID: organization.ID, Name: "情報工学研究会"}
+
+	joined := newRecipient("佐藤 花子", "hanako@example.com")
+	admin := newRecipient("山田 太郎", "taro@example.com")
+	optedOut := newRecipient("鈴木 一郎", "ichiro@example.com", model.NotificationKindMemberJoined)
+	alreadyNotified := newRecipient("田中 次郎", "jiro@example.com")
+
+	payload, err := json.Marshal(model.NewMemberJoinedEvent(organization.ID, model.TenantMembership{
+		TenantID: tenant.ID,
+		UserID:   joined.User.UserId,
+	}))
+	require.NoError(t, err)
+	e := model.OutboxEvent{
+		ID:             model.OutboxEventID(uuid.New()),
+		OrganizationID: organization.ID,
+		Type:           model.DomainEventMemberJoined,
+		Payload:        payload,
+	}
+
+	tests := []struct {
+		name     string
+		sendErr  error
+		wantSent []model.UserEmail
+		wantErr  bool
+	}{
+		{
+			name:     "正常系: 参加した本人・受け取りを止めた管理者・送信済みの管理者を除いて送る",
+			wantSent: []model.UserEmail{admin.User.Email},
+		},
+		{
+			name:     "異常系: 送信に失敗したら記録を消してエラーを返す",
+			sendErr:  errors.New("421 service not available"),
+			wantSent: []model.UserEmail{admin.User.Email},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := mock.NewMockRepository(ctrl)
+
+			repo.EXPECT().GetOrganization(gomock.Any(), organization.ID).Return(organization, nil)
+			repo.EXPECT().GetTenantByID(gomock.Any(), tenant.ID).Return(repository.TenantWithJoinCode{Tenant: tenant}, nil)
+			repo.EXPECT().GetUser(gomock.Any(), joined.User.UserId).Return(joined.User, nil)
+			repo.EXPECT().ListTenantNotificationRecipients(gomock.Any(), tenant.ID, true).
+				Return([]model.NotificationRecipient{joined, admin, optedOut, alreadyNotified}, nil)
+
+			repo.EXPECT().ClaimNotificationDelivery(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, d model.NotificationDelivery) (bool, error) {
+					assert.Equal(t, model.NotificationKindMemberJoined, d.Kind)
+					assert.Contains(t, d.DedupKey, e.ID.String())
+					return d.UserID != alreadyNotified.User.UserId, nil
+				}).
+				Times(2)
+			if tt.sendErr != nil {
+				repo.EXPECT().DeleteNotificationDelivery(gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			var sent []model.Mail
+			mailer := mailerFunc(func(_ context.Context, mail model.Mail) error {
+				sent = append(sent, mail)
+				return tt.sendErr
+			})
+
+			notifier, err := NewNotifier(repo, mailer, "https://app.keyhub.example")
+			require.NoError(t, err)
+
+			err = notifier.HandleEvent(context.Background(), e)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Len(t, sent, len(tt.wantSent))
+			for i, mail := range sent {
+				assert.Equal(t, tt.wantSent[i], mail.To)
+				assert.Equal(t, "【KeyHub】情報工学研究会 に 佐藤 花子 さんが参加しました", mail.Subject)
+				assert.True(t, strings.HasPrefix(mail.Body, "山田 太郎 さん\n"))
+			}
+		})
+	}
+}
+
+func TestNotifier_HandleEvent_RoomAssigned(t *testing.T) {
+	organization := model.Organization{
+		ID:       model.OrganizationID(uuid.New()),
+		Name:     "Shibayama University",
+		Settings: model.OrganizationSettings{Locale: model.OrganizationLocaleEn, Timezone: "Asia/Tokyo"},
+	}
+	tenant := model.Tenant{ID: model.TenantID(uuid.New()), OrganizationID: organization.ID, Name: "Robotics Club"}
+	room := model.Room{ID: model.RoomID(uuid.New()), OrganizationID: organization.ID, Name: "301", BuildingName: "Building 3"}
+	expiresAt := time.Date(2027, 3, 31, 9, 0, 0, 0, time.UTC)
+
+	member := newRecipient("Taro Yamada", "taro@example.com")
+	// 組織の言語が英語でも、日本語を選んだユーザーには日本語で送る
+	japanese := newRecipient("佐藤 花子", "hanako@example.com")
+	japanese.Preferences.Locale = model.OrganizationLocaleJa
+
+	payload, err := json.Marshal(model.NewRoomAssignedEvent(organization.ID, model.RoomAssignment{
+		ID:        model.RoomAssignmentID(uuid.New()),
+		TenantID:  tenant.ID,
+		RoomID:    room.ID,
+		ExpiresAt: &expiresAt,
+	}))
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockRepository(ctrl)
+	repo.EXPECT().GetOrganization(gomock.Any(), organization.ID).Return(organization, nil)
+	repo.EXPECT().GetTenantByID(gomock.Any(), tenant.ID).Return(repository.TenantWithJoinCode{Tenant: tenant}, nil)
+	repo.EXPECT().GetRoomByID(gomock.Any(), room.ID).Return(room, nil)
+	repo.EXPECT().ListTenantNotificationRecipients(gomock.Any(), tenant.ID, false).
+		Return([]model.NotificationRecipient{member, japanese}, nil)
+	repo.EXPECT().ClaimNotificationDelivery(gomock.Any(), gomock.Any()).Return(true, nil).Times(2)
+
+	var sent []model.Mail
+	notifier, err := NewNotifier(repo, mailerFunc(func(_ context.Context, mail model.Mail) error {
+		sent = append(sent, mail)
+		return nil
+	}), "https://app.keyhub.example")
+	require.NoError(t, err)
+
+	err = notifier.HandleEvent(context.Background(), model.OutboxEvent{
+		ID:             model.OutboxEventID(uuid.New()),
+		OrganizationID: organization.ID,
+		Type:           model.DomainEventRoomAssigned,
+		Payload:        payload,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, sent, 2)
+	assert.Equal(t, "[KeyHub] 301 has been assigned to Robotics Club", sent[0].Subject)
+	assert.Contains(t, sent[0].Body, "Available until: Mar 31, 2027 18:00 JST")
+	assert.Equal(t, "【KeyHub】Robotics Club に 301 が割り当てられました", sent[1].Subject)
+	assert.Contains(t, sent[1].Body, "利用期限: 2027年3月31日 18:00")
+}
+
+func TestNotifier_HandleEvent_TenantArchived(t *testing.T) {
+	organization := model.Organization{
+		ID:       model.OrganizationID(uuid.New()),
+		Name:     "芝山大学",
+		Settings: model.DefaultOrganizationSettings(),
+	}
+	archivedAt := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	tenant := model.Tenant{ID: model.TenantID(uuid.New()), OrganizationID: organization.ID, Name: "情報工学研究会", ArchivedAt: &archivedAt}
+
+	member := newRecipient("山田 太郎", "taro@example.com")
+	optedOut := newRecipient("鈴木 一郎", "ichiro@example.com", model.NotificationKindTenantArchived)
+
+	payload, err := json.Marshal(model.NewTenantArchivedEvent(tenant, 2))
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockRepository(ctrl)
+	repo.EXPECT().GetOrganization(gomock.Any(), organization.ID).Return(organization, nil)
+	repo.EXPECT().ListTenantNotificationRecipients(gomock.Any(), tenant.ID, false).
+		Return([]model.NotificationRecipient{member, optedOut}, nil)
+	repo.EXPECT().ClaimNotificationDelivery(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, d model.NotificationDelivery) (bool, error) {
+			assert.Equal(t, model.NotificationKindTenantArchived, d.Kind)
+			return true, nil
+		})
+
+	var sent []model.Mail
+	notifier, err := NewNotifier(repo, mailerFunc(func(_ context.Context, mail model.Mail) error {
+		sent = append(sent, mail)
+		return nil
+	}), "https://app.keyhub.example")
+	require.NoError(t, err)
+
+	err = notifier.HandleEvent(context.Background(), model.OutboxEvent{
+		ID:             model.OutboxEventID(uuid.New()),
+		OrganizationID: organization.ID,
+		Type:           model.DomainEventTenantArchived,
+		Payload:        payload,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, sent, 1)
+	assert.Equal(t, member.User.Email, sent[0].To)
+	assert.Equal(t, "【KeyHub】情報工学研究会 がアーカイブされました", sent[0].Subject)
+}
+
+func TestNotifier_HandleEvent_Ignored(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockRepository(ctrl)
+
+	notifier, err := NewNotifier(repo, mailerFunc(func(context.Context, model.Mail) error {
+		t.Fatal("通知しないイベントでメールを送った")
+		return nil
+	}), "")
+	require.NoError(t, err)
+
+	err = notifier.HandleEvent(context.Background(), model.OutboxEvent{
+		ID:      model.OutboxEventID(uuid.New()),
+		Type:    model.DomainEventKeyCreated,
+		Payload: []byte(`{}`),
+	})
+	assert.NoError(t, err)
+}
+
+func TestReminder_RemindOnce(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	expiresAt := now.Add(48 * time.Hour)
+	organization := model.Organization{
+		ID:       model.OrganizationID(uuid.New()),
+		Name:     "芝山大学",
+		Settings: model.DefaultOrganizationSettings(),
+	}
+	tenant := model.Tenant{ID: model.TenantID(uuid.New()), OrganizationID: organization.ID, Name: "情報工学研究会"}
+	room := model.Room{ID: model.RoomID(uuid.New()), OrganizationID: organization.ID, Name: "301", BuildingName: "3号館"}
+	assignment := model.RoomAssignment{ID: model.RoomAssignmentID(uuid.New()), TenantID: tenant.ID, RoomID: room.ID, ExpiresAt: &expiresAt}
+	member := newRecipient("山田 太郎", "taro@example.com")
+
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockRepository(ctrl)
+	repo.EXPECT().ListExpiringRoomAssignments(gomock.Any(), now, now.Add(72*time.Hour)).
+		Return([]repository.ExpiringRoomAssignment{{Assignment: assignment, Tenant: tenant, Room: room}}, nil)
+	repo.EXPECT().GetOrganization(gomock.Any(), organization.ID).Return(organization, nil)
+	repo.EXPECT().ListTenantNotificationRecipients(gomock.Any(), tenant.ID, false).
+		Return([]model.NotificationRecipient{member}, nil)
+	repo.EXPECT().ClaimNotificationDelivery(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, d model.NotificationDelivery) (bool, error) {
+			// 期限を延長したら改めて知らせるよう、期限を含めて重複を判定する
+			assert.Contains(t, d.DedupKey, assignment.ID.String())
+			assert.Contains(t, d.DedupKey, strconv.FormatInt(expiresAt.Unix(), 10))
+			return true, nil
+		})
+
+	var sent []model.Mail
+	notifier, err := NewNotifier(repo, mailerFunc(func(_ context.Context, mail model.Mail) error {
+		sent = append(sent, mail)
+		return nil
+	}), "https://app.keyhub.example")
+	require.NoError(t, err)
+
+	reminder := NewReminder(notifier, config.NotificationConfig{})
+	reminder.now = func() time.Time { return now }
+
+	require.NoError(t, reminder.RemindOnce(context.Background()))
+	require.Len(t, sent, 1)
+	assert.Equal(t, "【KeyHub】301 の利用期限が近づいています", sent[0].Subject)
+	assert.Contains(t, sent[0].Body, "利用期限: 2026年10月21日 21:00")
+}
@@ -0,0 +1,108 @@
+package notification
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/notification"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+)
+
+// Notifier は通知設定に従ってユーザーへメールを送る
+type Notifier struct {
+	repo     repository.Repository
+	mailer   notification.Mailer
+	renderer *Renderer
+	appURL   string
+}
+
+// NewNotifier の appURL はメールに載せるアプリのURL
+func NewNotifier(repo repository.Repository, mailer notification.Mailer, appURL string) (*Notifier, error) {
+	renderer, err := NewRenderer()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Notifier{
+		repo:     repo,
+		mailer:   mailer,
+		renderer: renderer,
+		appURL:   appURL,
+	}, nil
+}
+
+// notify は宛先ごとに通知を送る。expiresAt は部屋の割り当ての期限で、宛先の言語で整形してテンプレートに渡す。
+// 受け取りを止めているユーザーと、同じ通知を送信済みのユーザーは飛ばす。
+// 送信に失敗した宛先は記録を消してからエラーを返すため、次の再試行で送り直す
+func (n *Notifier) notify(
+	ctx context.Context,
+	organization model.Organization,
+	kind model.NotificationKind,
+	sourceID string,
+	recipients []model.NotificationRecipient,
+	data templateData,
+	expiresAt *time.Time,
+) error {
+	data.OrganizationName = organization.Name.String()
+	data.AppURL = n.appURL
+
+	var errs []error
+	for _, recipient := range recipients {
+		if !recipient.Preferences.Enabled(kind) {
+			continue
+		}
+
+		delivery := model.NewNotificationDelivery(organization.ID, recipient.User.UserId, kind, sourceID)
+		claimed, err := n.repo.ClaimNotificationDelivery(ctx, delivery)
+		if err != nil {
+			errs = append(errs, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to claim notification delivery"))
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		if err := n.send(ctx, organization, kind, recipient, data, expiresAt); err != nil {
+			if err := n.repo.DeleteNotificationDelivery(ctx, delivery.DedupKey); err != nil {
+				slog.ErrorContext(ctx, "failed to release notification delivery",
+					slog.String("dedup_key", delivery.DedupKey),
+					slog.String("error", err.Error()),
+				)
+			}
+			errs = append(errs, errors.Wrapf(err, "failed to notify user %s", recipient.User.UserId))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (n *Notifier) send(
+	ctx context.Context,
+	organization model.Organization,
+	kind model.NotificationKind,
+	recipient model.NotificationRecipient,
+	data templateData,
+	expiresAt *time.Time,
+) error {
+	locale := recipient.Preferences.ResolveLocale(organization.Settings)
+	data.UserName = recipient.User.Name.String()
+	// 期限は宛先の言語に合わせて整形する
+	if expiresAt != nil {
+		data.ExpiresAt = formatDateTime(*expiresAt, locale, organization.Settings)
+	}
+
+	subject, body, err := n.renderer.Render(locale, kind, data)
+	if err != nil {
+		return errors.Mark(err, domainerrors.ErrInternal)
+	}
+
+	return n.mailer.Send(ctx, model.Mail{
+		To:      recipient.User.Email,
+		Subject: subject,
+		Body:    body,
+	})
+}
@@ -0,0 +1,100 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/shibayama-club/keyhub/cmd/config"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+)
+
+// Reminder は期限が近い部屋の割り当てを定期的に探し、テナントのメンバーに知らせる。
+// 送信記録で重複を除くため、複数のインスタンスで動かしても同じ期限について知らせるのは1度だけになる
+type Reminder struct {
+	notifier *Notifier
+	interval time.Duration
+	notice   time.Duration
+	now      func() time.Time
+}
+
+func NewReminder(notifier *Notifier, cf config.NotificationConfig) *Reminder {
+	interval := cf.ReminderInterval
+	if interval <= 0 {
+		interval = 10 * time.Minute
+	}
+
+	notice := cf.AssignmentExpiryNotice
+	if notice <= 0 {
+		notice = 72 * time.Hour
+	}
+
+	return &Reminder{
+		notifier: notifier,
+		interval: interval,
+		notice:   notice,
+		now:      time.Now,
+	}
+}
+
+// Run は ctx がキャンセルされるまで定期的に通知を送る
+func (r *Reminder) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := r.RemindOnce(ctx); err != nil {
+			slog.ErrorContext(ctx, "failed to send reminders", slog.String("error", err.Error()))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RemindOnce は期限が近い部屋の割り当てを1回探して通知する
+func (r *Reminder) RemindOnce(ctx context.Context) error {
+	now := r.now()
+	assignments, err := r.notifier.repo.ListExpiringRoomAssignments(ctx, now, now.Add(r.notice))
+	if err != nil {
+		return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to list expiring room assignments")
+	}
+
+	organizations := make(map[model.OrganizationID]model.Organization)
+	var errs []error
+	for _, a := range assignments {
+		organization, ok := organizations[a.Tenant.OrganizationID]
+		if !ok {
+			organization, err = r.notifier.repo.GetOrganization(ctx, a.Tenant.OrganizationID)
+			if err != nil {
+				errs = append(errs, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to get organization"))
+				continue
+			}
+			organizations[organization.ID] = organization
+		}
+
+		members, err := r.notifier.repo.ListTenantNotificationRecipients(ctx, a.Tenant.ID, false)
+		if err != nil {
+			errs = append(errs, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to list tenant members"))
+			continue
+		}
+
+		// 期限を延長した場合に改めて知らせるよう、期限を含めて重複を判定する
+		sourceID := fmt.Sprintf("%s@%d", a.Assignment.ID, a.Assignment.ExpiresAt.Unix())
+		if err := r.notifier.notify(ctx, organization, model.NotificationKindRoomAssignmentExpiring, sourceID, members, templateData{
+			TenantName:   a.Tenant.Name.String(),
+			RoomName:     a.Room.Name.String(),
+			BuildingName: a.Room.BuildingName.String(),
+		}, a.Assignment.ExpiresAt); err != nil {
+			errs = append(errs, errors.Wrapf(err, "room assignment %s", a.Assignment.ID))
+		}
+	}
+
+	return errors.Join(errs...)
+}
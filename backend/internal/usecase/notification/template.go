@@ -0,0 +1,101 @@
+package notification
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+)
+
+//go:embed templates
+var templateFS embed.FS
+
+// locales は通知のテンプレートを用意している言語
+var locales = []string{model.OrganizationLocaleJa, model.OrganizationLocaleEn}
+
+// templateData はテンプレートに渡す値。使わない項目は空のままにする
+type templateData struct {
+	OrganizationName string
+	TenantName       string
+	// UserName は宛先のユーザーの名前
+	UserName string
+	// MemberName はテナントに参加したユーザーの名前
+	MemberName   string
+	RoomName     string
+	BuildingName string
+	// ExpiresAt は組織のタイムゾーンで整形した部屋の割り当ての期限。期限がなければ空
+	ExpiresAt string
+	AppURL    string
+}
+
+// Renderer は通知の種類と言語ごとのテンプレートから件名と本文を作る。
+// テンプレートは templates/<言語>/<種類>.tmpl で、"subject" と "body" の2つを定義する
+type Renderer struct {
+	templates map[string]map[model.NotificationKind]*template.Template
+}
+
+// NewRenderer はすべての言語と種類のテンプレートを読み込む。足りないテンプレートがあればエラーを返す
+func NewRenderer() (*Renderer, error) {
+	r := &Renderer{templates: make(map[string]map[model.NotificationKind]*template.Template, len(locales))}
+	for _, locale := range locales {
+		r.templates[locale] = make(map[model.NotificationKind]*template.Template)
+		for _, kind := range model.NotificationKinds() {
+			path := fmt.Sprintf("templates/%s/%s.tmpl", locale, kind)
+			t, err := template.New(kind.String()).Option("missingkey=error").ParseFS(templateFS, path)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse notification template %s", path)
+			}
+			for _, name := range []string{"subject", "body"} {
+				if t.Lookup(name) == nil {
+					return nil, errors.Newf("notification template %s does not define %q", path, name)
+				}
+			}
+			r.templates[locale][kind] = t
+		}
+	}
+	return r, nil
+}
+
+// Render は件名と本文を返す。テンプレートのない言語は日本語にする
+func (r *Renderer) Render(locale string, kind model.NotificationKind, data templateData) (subject, body string, err error) {
+	templates, ok := r.templates[locale]
+	if !ok {
+		templates = r.templates[model.OrganizationLocaleJa]
+	}
+	t, ok := templates[kind]
+	if !ok {
+		return "", "", errors.Newf("no notification template for %s", kind)
+	}
+
+	var buf bytes.Buffer
+	if err := t.ExecuteTemplate(&buf, "subject", data); err != nil {
+		return "", "", errors.Wrapf(err, "failed to render subject of %s", kind)
+	}
+	subject = strings.TrimSpace(buf.String())
+
+	buf.Reset()
+	if err := t.ExecuteTemplate(&buf, "body", data); err != nil {
+		return "", "", errors.Wrapf(err, "failed to render body of %s", kind)
+	}
+	body = buf.String()
+
+	return subject, body, nil
+}
+
+// formatDateTime は日時を組織のタイムゾーンで言語に合わせて整形する
+func formatDateTime(t time.Time, locale string, settings model.OrganizationSettings) string {
+	settings = settings.WithDefaults()
+	if loc, err := time.LoadLocation(settings.Timezone); err == nil {
+		t = t.In(loc)
+	}
+
+	if locale == model.OrganizationLocaleEn {
+		return t.Format("Jan 2, 2006 15:04 MST")
+	}
+	return t.Format("2006年1月2日 15:04")
+}
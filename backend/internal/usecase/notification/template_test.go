@@ -0,0 +1,65 @@
+package notification
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderer_Render(t *testing.T) {
+	renderer, err := NewRenderer()
+	require.NoError(t, err)
+
+	data := templateData{
+		OrganizationName: "芝山大学",
+		TenantName:       "情報工学研究会",
+		UserName:         "山田 太郎",
+		MemberName:       "佐藤 花子",
+		RoomName:         "301",
+		BuildingName:     "3号館",
+		ExpiresAt:        "2026年10月22日 18:00",
+		AppURL:           "https://app.keyhub.example",
+	}
+
+	// すべての言語と種類のテンプレートが、値を埋めた件名と本文を返すこと
+	for _, locale := range locales {
+		for _, kind := range model.NotificationKinds() {
+			t.Run(locale+"/"+kind.String(), func(t *testing.T) {
+				subject, body, err := renderer.Render(locale, kind, data)
+				require.NoError(t, err)
+				assert.NotEmpty(t, subject)
+				assert.NotContains(t, subject, "\n")
+				assert.Contains(t, body, data.UserName)
+				assert.Contains(t, body, data.AppURL)
+				assert.NotContains(t, subject+body, "<no value>")
+			})
+		}
+	}
+
+	t.Run("未対応の言語は日本語にする", func(t *testing.T) {
+		subject, _, err := renderer.Render("fr", model.NotificationKindRoomAssigned, data)
+		require.NoError(t, err)
+		assert.Equal(t, "【KeyHub】情報工学研究会 に 301 が割り当てられました", subject)
+	})
+
+	t.Run("期限がなければ期限の行を出さない", func(t *testing.T) {
+		noExpiry := data
+		noExpiry.ExpiresAt = ""
+		_, body, err := renderer.Render("ja", model.NotificationKindRoomAssigned, noExpiry)
+		require.NoError(t, err)
+		assert.NotContains(t, body, "利用期限")
+	})
+}
+
+func TestFormatDateTime(t *testing.T) {
+	at := time.Date(2026, 10, 22, 9, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, "2026年10月22日 18:00", formatDateTime(at, "ja", model.OrganizationSettings{}))
+	assert.Equal(t, "Oct 22, 2026 05:00 EDT", formatDateTime(at, "en", model.OrganizationSettings{
+		Locale:   model.OrganizationLocaleEn,
+		Timezone: "America/New_York",
+	}))
+}
@@ -0,0 +1,138 @@
+package outbox
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/shibayama-club/keyhub/cmd/config"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/event"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+)
+
+// leaseDuration は取り出したイベントを他のディスパッチャーに渡さない期間。
+// ハンドラーはこの時間内に終える必要があり、超えた場合は重複して配送されうる
+const leaseDuration = time.Minute
+
+// Dispatcher はoutboxに記録されたドメインイベントを定期的に取り出してハンドラーに渡す。
+// 複数のインスタンスで動かしても、同じイベントを同時に配送することはない
+type Dispatcher struct {
+	repo         repository.Repository
+	handlers     []event.Handler
+	policy       model.OutboxRetryPolicy
+	pollInterval time.Duration
+	batchSize    int32
+	now          func() time.Time
+}
+
+func NewDispatcher(repo repository.Repository, cf config.OutboxConfig, handlers ...event.Handler) *Dispatcher {
+	policy := model.DefaultOutboxRetryPolicy()
+	if cf.MaxAttempts > 0 {
+		policy.MaxAttempts = cf.MaxAttempts
+	}
+
+	pollInterval := cf.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = time.Second
+	}
+
+	batchSize := cf.BatchSize
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+
+	return &Dispatcher{
+		repo:         repo,
+		handlers:     handlers,
+		policy:       policy,
+		pollInterval: pollInterval,
+		batchSize:    batchSize,
+		now:          time.Now,
+	}
+}
+
+// Run は ctx がキャンセルされるまでイベントを配送し続ける
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		// 1回で取り出しきれなかった場合は待たずに続きを配送する
+		for {
+			n, err := d.DispatchOnce(ctx)
+			if err != nil {
+				slog.ErrorContext(ctx, "failed to dispatch outbox events", slog.String("error", err.Error()))
+				break
+			}
+			if n < int(d.batchSize) {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DispatchOnce は配送期限が来たイベントを1回分取り出して配送し、取り出した件数を返す
+func (d *Dispatcher) DispatchOnce(ctx context.Context) (int, error) {
+	now := d.now()
+	events, err := d.repo.ClaimOutboxEvents(ctx, now, now.Add(leaseDuration), d.batchSize)
+	if err != nil {
+		return 0, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to claim outbox events")
+	}
+
+	for _, e := range events {
+		if err := d.dispatch(ctx, e); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(events), nil
+}
+
+func (d *Dispatcher) dispatch(ctx context.Context, e model.OutboxEvent) error {
+	handleCtx, cancel := context.WithTimeout(ctx, leaseDuration)
+	defer cancel()
+
+	// 前回までに処理を終えたハンドラーは飛ばし、一部だけ失敗した場合も成功したハンドラーを記録して重ねて呼ばない
+	var errs []error
+	for _, h := range d.handlers {
+		if e.HandlerCompleted(h.Name()) {
+			continue
+		}
+		if err := h.HandleEvent(handleCtx, e); err != nil {
+			errs = append(errs, errors.Wrapf(err, "handler %s", h.Name()))
+			continue
+		}
+		e = e.CompleteHandler(h.Name())
+	}
+
+	result := e.Delivered(d.now())
+	if err := errors.Join(errs...); err != nil {
+		result = e.Failed(err, d.now(), d.policy)
+
+		attrs := []any{
+			slog.String("event_id", e.ID.String()),
+			slog.String("event_type", e.Type.String()),
+			slog.Int("attempts", int(result.Attempts)),
+			slog.String("error", err.Error()),
+		}
+		if result.Status == model.OutboxEventStatusDead {
+			slog.ErrorContext(ctx, "outbox event dead-lettered", attrs...)
+		} else {
+			slog.WarnContext(ctx, "failed to handle outbox event, will retry", attrs...)
+		}
+	}
+
+	if err := d.repo.UpdateOutboxEvent(ctx, result); err != nil {
+		return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to update outbox event")
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package outbox
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/shibayama-club/keyhub/cmd/config"
+	"github.com/shibayama-club/keyhub/internal/domain/event"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDispatcher_DispatchOnce(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	pending := model.OutboxEvent{
+		ID:             model.OutboxEventID(uuid.New()),
+		OrganizationID: model.OrganizationID(uuid.New()),
+		Type:           model.DomainEventMemberJoined,
+		Payload:        []byte(`{}`),
+		Status:         model.OutboxEventStatusPending,
+		NextAttemptAt:  now,
+	}
+
+	tests := []struct {
+		name       string
+		event      func() model.OutboxEvent
+		handlerErr error
+		wantStatus model.OutboxEventStatus
+		wantNext   time.Time
+	}{
+		{
+			name:       "正常系: すべてのハンドラーが成功したら配送済みにする",
+			event:      func() model.OutboxEvent { return pending },
+			wantStatus: model.OutboxEventStatusDelivered,
+			wantNext:   now,
+		},
+		{
+			name:       "異常系: ハンドラーが失敗したら待ってから再試行する",
+			event:      func() model.OutboxEvent { return pending },
+			handlerErr: errors.New("webhook returned 500"),
+			wantStatus: model.OutboxEventStatusPending,
+			wantNext:   now.Add(time.Second),
+		},
+		{
+			name: "異常系: 再試行の上限に達したら dead にする",
+			event: func() model.OutboxEvent {
+				e := pending
+				e.Attempts = 2
+				return e
+			},
+			handlerErr: errors.New("webhook returned 500"),
+			wantStatus: model.OutboxEventStatusDead,
+			wantNext:   now,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := mock.NewMockRepository(ctrl)
+
+			repo.EXPECT().
+				ClaimOutboxEvents(gomock.Any(), now, now.Add(leaseDuration), int32(100)).
+				Return([]model.OutboxEvent{tt.event()}, nil)
+			repo.EXPECT().
+				UpdateOutboxEvent(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, got model.OutboxEvent) error {
+					assert.Equal(t, tt.wantStatus, got.Status)
+					assert.Equal(t, tt.wantNext, got.NextAttemptAt)
+					return nil
+				})
+
+			var received []model.OutboxEventID
+			handler := event.HandlerFunc("test", func(_ context.Context, e model.OutboxEvent) error {
+				received = append(received, e.ID)
+				return tt.handlerErr
+			})
+
+			d := NewDispatcher(repo, config.OutboxConfig{MaxAttempts: 3}, handler)
+			d.now = func() time.Time { return now }
+
+			n, err := d.DispatchOnce(t.Context())
+			require.NoError(t, err)
+			assert.Equal(t, 1, n)
+			assert.Equal(t, []model.OutboxEventID{pending.ID}, received)
+		})
+	}
+}
+
+func TestDispatcher_DispatchOnce_PartialFailure(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	pending := model.OutboxEvent{
+		ID:             model.OutboxEventID(uuid.New()),
+		OrganizationID: model.OrganizationID(uuid.New()),
+		Type:           model.DomainEventMemberJoined,
+		Payload:        []byte(`{}`),
+		Status:         model.OutboxEventStatusPending,
+		NextAttemptAt:  now,
+	}
+
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockRepository(ctrl)
+
+	calls := map[string]int{}
+	notificationErr := errors.New("smtp unavailable")
+	webhookHandler := event.HandlerFunc("webhook", func(context.Context, model.OutboxEvent) error {
+		calls["webhook"]++
+		return nil
+	})
+	notificationHandler := event.HandlerFunc("notification", func(context.Context, model.OutboxEvent) error {
+		calls["notification"]++
+		return notificationErr
+	})
+
+	d := NewDispatcher(repo, config.OutboxConfig{MaxAttempts: 3}, webhookHandler, notificationHandler)
+	d.now = func() time.Time { return now }
+
+	// 1回目は通知だけが失敗する。成功したWebhookは処理済みとして記録する
+	var stored model.OutboxEvent
+	repo.EXPECT().
+		ClaimOutboxEvents(gomock.Any(), now, now.Add(leaseDuration), int32(100)).
+		Return([]model.OutboxEvent{pending}, nil)
+	repo.EXPECT().
+		UpdateOutboxEvent(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, got model.OutboxEvent) error {
+			stored = got
+			return nil
+		})
+
+	_, err := d.DispatchOnce(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, model.OutboxEventStatusPending, stored.Status)
+	assert.Equal(t, []string{"webhook"}, stored.CompletedHandlers)
+	assert.Contains(t, stored.LastError, "notification")
+	assert.Equal(t, map[string]int{"webhook": 1, "notification": 1}, calls)
+
+	// 2回目は失敗した通知だけを呼び直し、Webhookは重ねて送らない
+	notificationErr = nil
+	now = stored.NextAttemptAt
+	repo.EXPECT().
+		ClaimOutboxEvents(gomock.Any(), now, now.Add(leaseDuration), int32(100)).
+		Return([]model.OutboxEvent{stored}, nil)
+	repo.EXPECT().
+		UpdateOutboxEvent(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, got model.OutboxEvent) error {
+			stored = got
+			return nil
+		})
+
+	_, err = d.DispatchOnce(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, model.OutboxEventStatusDelivered, stored.Status)
+	assert.Equal(t, []string{"webhook", "notification"}, stored.CompletedHandlers)
+	assert.Equal(t, map[string]int{"webhook": 1, "notification": 2}, calls)
+}
@@ -0,0 +1,79 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	domainerrors "github.com/shibayama-club/keyhub/internal/domain/errors"
+	"github.com/shibayama-club/keyhub/internal/domain/event"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository"
+	"github.com/shibayama-club/keyhub/internal/domain/webhook"
+)
+
+// Handler はoutboxのイベントを、そのイベントを購読している組織のWebhookへ送信する
+type Handler struct {
+	repo   repository.WebhookRepository
+	sender webhook.Sender
+}
+
+var _ event.Handler = (*Handler)(nil)
+
+func NewHandler(repo repository.WebhookRepository, sender webhook.Sender) *Handler {
+	return &Handler{
+		repo:   repo,
+		sender: sender,
+	}
+}
+
+func (h *Handler) Name() string { return "webhook" }
+
+// HandleEvent は購読先ごとに送信し、1つでも失敗すればエラーを返してイベントごと再試行させる。
+// 再試行では送信済みの購読先を飛ばすため、成功した購読先に同じイベントを重ねて送ることはない
+func (h *Handler) HandleEvent(ctx context.Context, e model.OutboxEvent) error {
+	subscriptions, err := h.repo.ListWebhookSubscriptionsByEventType(ctx, e.OrganizationID, e.Type)
+	if err != nil {
+		return errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to list webhook subscriptions")
+	}
+
+	message := model.NewWebhookMessage(e)
+
+	var errs []error
+	for _, subscription := range subscriptions {
+		delivered, err := h.repo.HasSucceededWebhookDelivery(ctx, subscription.ID, e.ID)
+		if err != nil {
+			errs = append(errs, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to check webhook delivery"))
+			continue
+		}
+		if delivered {
+			continue
+		}
+
+		if _, err := Deliver(ctx, h.repo, h.sender, subscription, message); err != nil {
+			errs = append(errs, errors.Wrapf(err, "webhook subscription %s", subscription.ID))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Deliver は購読先へ1回送信し、結果を送信履歴に記録する。送信に失敗した場合は記録した上でエラーを返す
+func Deliver(
+	ctx context.Context,
+	repo repository.WebhookRepository,
+	sender webhook.Sender,
+	subscription model.WebhookSubscription,
+	message model.WebhookMessage,
+) (model.WebhookDelivery, error) {
+	result, sendErr := sender.Send(ctx, subscription, message)
+
+	delivery := model.NewWebhookDelivery(subscription, message, result, sendErr)
+	if err := repo.CreateWebhookDelivery(ctx, delivery); err != nil {
+		return model.WebhookDelivery{}, errors.Wrap(errors.Mark(err, domainerrors.ErrInternal), "failed to create webhook delivery")
+	}
+
+	if sendErr != nil {
+		return delivery, errors.Wrap(sendErr, "failed to deliver webhook")
+	}
+	return delivery, nil
+}
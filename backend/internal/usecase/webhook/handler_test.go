@@ -0,0 +1,100 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/shibayama-club/keyhub/internal/domain/model"
+	"github.com/shibayama-club/keyhub/internal/domain/repository/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type senderFunc func(ctx context.Context, subscription model.WebhookSubscription, message model.WebhookMessage) (model.WebhookSendResult, error)
+
+func (f senderFunc) Send(ctx context.Context, subscription model.WebhookSubscription, message model.WebhookMessage) (model.WebhookSendResult, error) {
+	return f(ctx, subscription, message)
+}
+
+func TestHandler_HandleEvent(t *testing.T) {
+	e := model.OutboxEvent{
+		ID:             model.OutboxEventID(uuid.New()),
+		OrganizationID: model.OrganizationID(uuid.New()),
+		Type:           model.DomainEventKeyCreated,
+		Payload:        []byte(`{"key_id":"k1"}`),
+	}
+	delivered := model.WebhookSubscription{ID: model.WebhookSubscriptionID(uuid.New()), OrganizationID: e.OrganizationID}
+	healthy := model.WebhookSubscription{ID: model.WebhookSubscriptionID(uuid.New()), OrganizationID: e.OrganizationID}
+	broken := model.WebhookSubscription{ID: model.WebhookSubscriptionID(uuid.New()), OrganizationID: e.OrganizationID}
+
+	tests := []struct {
+		name          string
+		subscriptions []model.WebhookSubscription
+		wantSent      []model.WebhookSubscriptionID
+		wantErr       bool
+	}{
+		{
+			name:          "正常系: 送信済みの購読先を飛ばして未送信の購読先にだけ送る",
+			subscriptions: []model.WebhookSubscription{delivered, healthy},
+			wantSent:      []model.WebhookSubscriptionID{healthy.ID},
+		},
+		{
+			name:          "異常系: 1つでも送信に失敗したらエラーを返して再試行させる",
+			subscriptions: []model.WebhookSubscription{healthy, broken},
+			wantSent:      []model.WebhookSubscriptionID{healthy.ID, broken.ID},
+			wantErr:       true,
+		},
+		{
+			name: "正常系: 購読先がなければ何もしない",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := mock.NewMockRepository(ctrl)
+
+			repo.EXPECT().ListWebhookSubscriptionsByEventType(gomock.Any(), e.OrganizationID, e.Type).Return(tt.subscriptions, nil)
+			for _, s := range tt.subscriptions {
+				repo.EXPECT().HasSucceededWebhookDelivery(gomock.Any(), s.ID, e.ID).Return(s.ID == delivered.ID, nil)
+			}
+
+			var recorded []model.WebhookDelivery
+			repo.EXPECT().CreateWebhookDelivery(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, d model.WebhookDelivery) error {
+					recorded = append(recorded, d)
+					return nil
+				}).
+				Times(len(tt.wantSent))
+
+			var sent []model.WebhookSubscriptionID
+			sender := senderFunc(func(_ context.Context, s model.WebhookSubscription, m model.WebhookMessage) (model.WebhookSendResult, error) {
+				sent = append(sent, s.ID)
+				assert.Equal(t, e.ID, m.EventID)
+				if s.ID == broken.ID {
+					return model.WebhookSendResult{StatusCode: http.StatusBadGateway}, errors.New("webhook returned status 502")
+				}
+				return model.WebhookSendResult{StatusCode: http.StatusOK}, nil
+			})
+
+			err := NewHandler(repo, sender).HandleEvent(context.Background(), e)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantSent, sent)
+
+			require.Len(t, recorded, len(tt.wantSent))
+			for i, d := range recorded {
+				assert.Equal(t, tt.wantSent[i], d.SubscriptionID)
+				assert.Equal(t, e.ID, d.EventID)
+				assert.Equal(t, d.SubscriptionID != broken.ID, d.Succeeded)
+			}
+		})
+	}
+}
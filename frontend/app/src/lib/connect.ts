@@ -1,9 +1,27 @@
 import { createConnectTransport } from '@connectrpc/connect-web';
+import type { Interceptor } from '@connectrpc/connect';
+import { isMessage } from '@bufbuild/protobuf';
+import { GetMeResponseSchema } from '../../../gen/src/keyhub/app/v1/auth_pb';
 
 const API_BASE_URL = import.meta.env.VITE_API_BASE_URL || 'http://localhost:8080';
 
+// GetMe で受け取ったCSRFトークン。更新系RPCの X-CSRF-Token ヘッダーに付与する
+let csrfToken: string | null = null;
+
+const csrfInterceptor: Interceptor = (next) => async (req) => {
+  if (csrfToken) {
+    req.header.set('X-CSRF-Token', csrfToken);
+  }
+  const res = await next(req);
+  if (!res.stream && isMessage(res.message, GetMeResponseSchema) && res.message.csrfToken) {
+    csrfToken = res.message.csrfToken;
+  }
+  return res;
+};
+
 export const transport = createConnectTransport({
   baseUrl: API_BASE_URL,
+  interceptors: [csrfInterceptor],
   fetch: (input, init) => {
     return fetch(input, {
       ...init,
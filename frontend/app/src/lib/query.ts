@@ -1,7 +1,7 @@
 import { Code, ConnectError } from '@connectrpc/connect';
 import { useMutation, useQuery } from '@connectrpc/connect-query';
 import { MutationCache, QueryCache, QueryClient } from '@tanstack/react-query';
-import { getMe, logout } from '../../../gen/src/keyhub/app/v1/app-AuthService_connectquery';
+import { getMe, logout } from '../../../gen/src/keyhub/app/v1/auth-AuthService_connectquery';
 import { getTenantByJoinCode, joinTenant } from '../../../gen/src/keyhub/app/v1/app-TenantService_connectquery';
 import { getRoomsByTenant } from '../../../gen/src/keyhub/app/v1/room-RoomService_connectquery';
 import { transport } from './connect';
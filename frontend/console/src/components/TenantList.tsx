@@ -1,5 +1,5 @@
 import { useNavigate } from 'react-router-dom';
-import type { Tenant } from '../../../gen/src/keyhub/console/v1/console_pb';
+import type { Tenant } from '../../../gen/src/keyhub/console/v1/common_pb';
 import { getTenantTypeLabel } from '../utils/tenant';
 
 export const TenantList = ({
@@ -4,6 +4,7 @@ import { ConsoleAuthService, ConsoleService } from '../../../gen/src/keyhub/cons
 import { IS_PRODUCTION } from './env';
 
 const STORAGE_TOKEN_KEY = 'console_token';
+const STORAGE_EXPIRES_KEY = 'console_expires_at';
 
 const getBaseUrl = (): string => {
   // 本番環境時にセットが必須
@@ -28,7 +29,15 @@ export const transport = createConnectTransport({
       if (token) {
         req.header.set('Authorization', `Bearer ${token}`);
       }
-      return await next(req);
+      const res = await next(req);
+      // セッションが延長された場合はサーバーが再発行したトークンに差し替える
+      const renewedToken = res.header.get('X-Session-Token');
+      const expiresIn = Number(res.header.get('X-Session-Expires-In'));
+      if (renewedToken && expiresIn > 0) {
+        localStorage.setItem(STORAGE_TOKEN_KEY, renewedToken);
+        localStorage.setItem(STORAGE_EXPIRES_KEY, (Date.now() + expiresIn * 1000).toString());
+      }
+      return res;
     },
     (next) => async (req) => {
       try {
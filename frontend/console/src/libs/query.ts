@@ -1,12 +1,9 @@
 import { Code, ConnectError } from '@connectrpc/connect';
-import { useMutation, useQuery } from '@connectrpc/connect-query';
+import { useInfiniteQuery, useMutation, useQuery } from '@connectrpc/connect-query';
 import { MutationCache, QueryCache, QueryClient } from '@tanstack/react-query';
 import { loginWithOrgId, logout } from '../../../gen/src/keyhub/console/v1/console-ConsoleAuthService_connectquery';
-import {
-  createTenant,
-  getAllTenants,
-  getTenantById,
-} from '../../../gen/src/keyhub/console/v1/console-ConsoleService_connectquery';
+import { createTenant, getTenantById } from '../../../gen/src/keyhub/console/v1/console-ConsoleService_connectquery';
+import { getAllTenants } from '../../../gen/src/keyhub/console/v1/tenant-ConsoleService_connectquery';
 import {
   createRoom,
   getAllRooms,
@@ -53,8 +50,15 @@ export const useMutationCreateTenant = () => {
   return useMutation(createTenant);
 };
 
-export const useQueryGetAllTenants = () => {
-  return useQuery(getAllTenants, {});
+// 一覧はページ単位で返るため、next_page_token を次の page_token に渡して続きを読み込む
+const getNextPageToken = (res: { nextPageToken: string }) => res.nextPageToken || undefined;
+
+export const useInfiniteQueryGetAllTenants = () => {
+  return useInfiniteQuery(
+    getAllTenants,
+    { pageToken: '' },
+    { pageParamKey: 'pageToken', getNextPageParam: getNextPageToken },
+  );
 };
 
 export const useQueryGetTenantById = (id: string) => {
@@ -65,8 +69,12 @@ export const useMutationCreateRoom = () => {
   return useMutation(createRoom);
 };
 
-export const useQueryGetAllRooms = () => {
-  return useQuery(getAllRooms, {});
+export const useInfiniteQueryGetAllRooms = () => {
+  return useInfiniteQuery(
+    getAllRooms,
+    { pageToken: '' },
+    { pageParamKey: 'pageToken', getNextPageParam: getNextPageToken },
+  );
 };
 
 export const useMutationAssignRoomToTenant = () => {
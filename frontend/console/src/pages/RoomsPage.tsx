@@ -1,11 +1,11 @@
 import { useNavigate } from 'react-router-dom';
 import { Navbar } from '../components/Navbar';
 import { RoomList } from '../components/RoomList';
-import { useQueryGetAllRooms } from '../libs/query';
+import { useInfiniteQueryGetAllRooms } from '../libs/query';
 
 export const RoomsPage = () => {
   const navigate = useNavigate();
-  const { data, isLoading, isError } = useQueryGetAllRooms();
+  const { data, isLoading, isError, hasNextPage, fetchNextPage, isFetchingNextPage } = useInfiniteQueryGetAllRooms();
 
   return (
     <div className="min-h-screen bg-gray-50">
@@ -33,8 +33,23 @@ export const RoomsPage = () => {
               <h3 className="text-lg leading-6 font-medium text-gray-900">既存のRoom</h3>
             </div>
             <div className="border-t border-gray-200">
-              <RoomList rooms={data?.rooms || []} isLoading={isLoading} isError={isError} />
+              <RoomList
+                rooms={data?.pages.flatMap((page) => page.rooms) || []}
+                isLoading={isLoading}
+                isError={isError}
+              />
             </div>
+            {hasNextPage && (
+              <div className="border-t border-gray-200 px-4 py-4 text-center sm:px-6">
+                <button
+                  onClick={() => fetchNextPage()}
+                  disabled={isFetchingNextPage}
+                  className="inline-flex items-center rounded-md border border-gray-300 bg-white px-4 py-2 text-sm font-medium text-gray-700 hover:bg-gray-50 focus:ring-2 focus:ring-indigo-500 focus:ring-offset-2 focus:outline-none disabled:opacity-50"
+                >
+                  {isFetchingNextPage ? '読み込み中...' : 'さらに読み込む'}
+                </button>
+              </div>
+            )}
           </div>
         </div>
       </div>
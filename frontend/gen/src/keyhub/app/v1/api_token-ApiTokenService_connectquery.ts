@@ -0,0 +1,26 @@
+// @generated by protoc-gen-connect-query v2.2.0 with parameter "target=ts"
+// @generated from file keyhub/app/v1/api_token.proto (package keyhub.app.v1, syntax proto3)
+/* eslint-disable */
+
+import { ApiTokenService } from "./api_token_pb";
+
+/**
+ * APIトークン発行（トークン本体はこのレスポンスでのみ返す）
+ *
+ * @generated from rpc keyhub.app.v1.ApiTokenService.CreateApiToken
+ */
+export const createApiToken = ApiTokenService.method.createApiToken;
+
+/**
+ * 有効なAPIトークン一覧取得
+ *
+ * @generated from rpc keyhub.app.v1.ApiTokenService.ListApiTokens
+ */
+export const listApiTokens = ApiTokenService.method.listApiTokens;
+
+/**
+ * APIトークンの無効化
+ *
+ * @generated from rpc keyhub.app.v1.ApiTokenService.RevokeApiToken
+ */
+export const revokeApiToken = ApiTokenService.method.revokeApiToken;
@@ -0,0 +1,219 @@
+// @generated by protoc-gen-es v2.12.0 with parameter "target=ts"
+// @generated from file keyhub/app/v1/api_token.proto (package keyhub.app.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_buf_validate_validate } from "../../../buf/validate/validate_pb";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file keyhub/app/v1/api_token.proto.
+ */
+export const file_keyhub_app_v1_api_token: GenFile = /*@__PURE__*/
+  fileDesc("Ch1rZXlodWIvYXBwL3YxL2FwaV90b2tlbi5wcm90bxINa2V5aHViLmFwcC52MSLmAQoIQXBpVG9rZW4SFAoCaWQYASABKAlCCLpIBXIDsAEBEgwKBG5hbWUYAiABKAkSFAoMdG9rZW5fcHJlZml4GAMgASgJEg4KBnNjb3BlcxgEIAMoCRIuCgpleHBpcmVzX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIwCgxsYXN0X3VzZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCmNyZWF0ZWRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIoIBChVDcmVhdGVBcGlUb2tlblJlcXVlc3QSFwoEbmFtZRgBIAEoCUIJukgGcgQQARgyEhgKBnNjb3BlcxgCIAMoCUIIukgFkgECCAESNgoKZXhwaXJlc19hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCBrpIA8gBASJTChZDcmVhdGVBcGlUb2tlblJlc3BvbnNlEioKCWFwaV90b2tlbhgBIAEoCzIXLmtleWh1Yi5hcHAudjEuQXBpVG9rZW4SDQoFdG9rZW4YAiABKAkiFgoUTGlzdEFwaVRva2Vuc1JlcXVlc3QiRAoVTGlzdEFwaVRva2Vuc1Jlc3BvbnNlEisKCmFwaV90b2tlbnMYASADKAsyFy5rZXlodWIuYXBwLnYxLkFwaVRva2VuIi0KFVJldm9rZUFwaVRva2VuUmVxdWVzdBIUCgJpZBgBIAEoCUIIukgFcgOwAQEiGAoWUmV2b2tlQXBpVG9rZW5SZXNwb25zZTKwAgoPQXBpVG9rZW5TZXJ2aWNlEl0KDkNyZWF0ZUFwaVRva2VuEiQua2V5aHViLmFwcC52MS5DcmVhdGVBcGlUb2tlblJlcXVlc3QaJS5rZXlodWIuYXBwLnYxLkNyZWF0ZUFwaVRva2VuUmVzcG9uc2USXwoNTGlzdEFwaVRva2VucxIjLmtleWh1Yi5hcHAudjEuTGlzdEFwaVRva2Vuc1JlcXVlc3QaJC5rZXlodWIuYXBwLnYxLkxpc3RBcGlUb2tlbnNSZXNwb25zZSIDkAIBEl0KDlJldm9rZUFwaVRva2VuEiQua2V5aHViLmFwcC52MS5SZXZva2VBcGlUb2tlblJlcXVlc3QaJS5rZXlodWIuYXBwLnYxLlJldm9rZUFwaVRva2VuUmVzcG9uc2VCxQEKEWNvbS5rZXlodWIuYXBwLnYxQg1BcGlUb2tlblByb3RvUAFaS2dpdGh1Yi5jb20vc2hpYmF5YW1hLWNsdWIva2V5aHViL2ludGVybmFsL2ludGVyZmFjZS9nZW4va2V5aHViL2FwcC92MTthcHB2MaICA0tBWKoCDUtleWh1Yi5BcHAuVjHKAg1LZXlodWJcQXBwXFYx4gIZS2V5aHViXEFwcFxWMVxHUEJNZXRhZGF0YeoCD0tleWh1Yjo6QXBwOjpWMWIGcHJvdG8z", [file_buf_validate_validate, file_google_protobuf_timestamp]);
+
+/**
+ * @generated from message keyhub.app.v1.ApiToken
+ */
+export type ApiToken = Message<"keyhub.app.v1.ApiToken"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * トークンの先頭部分（識別用）
+   *
+   * @generated from field: string token_prefix = 3;
+   */
+  tokenPrefix: string;
+
+  /**
+   * 例: "keys:read", "rooms:write"
+   *
+   * @generated from field: repeated string scopes = 4;
+   */
+  scopes: string[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 5;
+   */
+  expiresAt?: Timestamp | undefined;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_used_at = 6;
+   */
+  lastUsedAt?: Timestamp | undefined;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message keyhub.app.v1.ApiToken.
+ * Use `create(ApiTokenSchema)` to create a new message.
+ */
+export const ApiTokenSchema: GenMessage<ApiToken> = /*@__PURE__*/
+  messageDesc(file_keyhub_app_v1_api_token, 0);
+
+/**
+ * @generated from message keyhub.app.v1.CreateApiTokenRequest
+ */
+export type CreateApiTokenRequest = Message<"keyhub.app.v1.CreateApiTokenRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: repeated string scopes = 2;
+   */
+  scopes: string[];
+
+  /**
+   * 最長1年
+   *
+   * @generated from field: google.protobuf.Timestamp expires_at = 3;
+   */
+  expiresAt?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message keyhub.app.v1.CreateApiTokenRequest.
+ * Use `create(CreateApiTokenRequestSchema)` to create a new message.
+ */
+export const CreateApiTokenRequestSchema: GenMessage<CreateApiTokenRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_app_v1_api_token, 1);
+
+/**
+ * @generated from message keyhub.app.v1.CreateApiTokenResponse
+ */
+export type CreateApiTokenResponse = Message<"keyhub.app.v1.CreateApiTokenResponse"> & {
+  /**
+   * @generated from field: keyhub.app.v1.ApiToken api_token = 1;
+   */
+  apiToken?: ApiToken | undefined;
+
+  /**
+   * @generated from field: string token = 2;
+   */
+  token: string;
+};
+
+/**
+ * Describes the message keyhub.app.v1.CreateApiTokenResponse.
+ * Use `create(CreateApiTokenResponseSchema)` to create a new message.
+ */
+export const CreateApiTokenResponseSchema: GenMessage<CreateApiTokenResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_app_v1_api_token, 2);
+
+/**
+ * @generated from message keyhub.app.v1.ListApiTokensRequest
+ */
+export type ListApiTokensRequest = Message<"keyhub.app.v1.ListApiTokensRequest"> & {
+};
+
+/**
+ * Describes the message keyhub.app.v1.ListApiTokensRequest.
+ * Use `create(ListApiTokensRequestSchema)` to create a new message.
+ */
+export const ListApiTokensRequestSchema: GenMessage<ListApiTokensRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_app_v1_api_token, 3);
+
+/**
+ * @generated from message keyhub.app.v1.ListApiTokensResponse
+ */
+export type ListApiTokensResponse = Message<"keyhub.app.v1.ListApiTokensResponse"> & {
+  /**
+   * @generated from field: repeated keyhub.app.v1.ApiToken api_tokens = 1;
+   */
+  apiTokens: ApiToken[];
+};
+
+/**
+ * Describes the message keyhub.app.v1.ListApiTokensResponse.
+ * Use `create(ListApiTokensResponseSchema)` to create a new message.
+ */
+export const ListApiTokensResponseSchema: GenMessage<ListApiTokensResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_app_v1_api_token, 4);
+
+/**
+ * @generated from message keyhub.app.v1.RevokeApiTokenRequest
+ */
+export type RevokeApiTokenRequest = Message<"keyhub.app.v1.RevokeApiTokenRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message keyhub.app.v1.RevokeApiTokenRequest.
+ * Use `create(RevokeApiTokenRequestSchema)` to create a new message.
+ */
+export const RevokeApiTokenRequestSchema: GenMessage<RevokeApiTokenRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_app_v1_api_token, 5);
+
+/**
+ * @generated from message keyhub.app.v1.RevokeApiTokenResponse
+ */
+export type RevokeApiTokenResponse = Message<"keyhub.app.v1.RevokeApiTokenResponse"> & {
+};
+
+/**
+ * Describes the message keyhub.app.v1.RevokeApiTokenResponse.
+ * Use `create(RevokeApiTokenResponseSchema)` to create a new message.
+ */
+export const RevokeApiTokenResponseSchema: GenMessage<RevokeApiTokenResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_app_v1_api_token, 6);
+
+/**
+ * ユーザーがスクリプトや端末から App API を呼び出すためのAPIトークン管理
+ * 発行したトークンは Authorization: Bearer <token> で利用する
+ *
+ * @generated from service keyhub.app.v1.ApiTokenService
+ */
+export const ApiTokenService: GenService<{
+  /**
+   * APIトークン発行（トークン本体はこのレスポンスでのみ返す）
+   *
+   * @generated from rpc keyhub.app.v1.ApiTokenService.CreateApiToken
+   */
+  createApiToken: {
+    methodKind: "unary";
+    input: typeof CreateApiTokenRequestSchema;
+    output: typeof CreateApiTokenResponseSchema;
+  },
+  /**
+   * 有効なAPIトークン一覧取得
+   *
+   * @generated from rpc keyhub.app.v1.ApiTokenService.ListApiTokens
+   */
+  listApiTokens: {
+    methodKind: "unary";
+    input: typeof ListApiTokensRequestSchema;
+    output: typeof ListApiTokensResponseSchema;
+  },
+  /**
+   * APIトークンの無効化
+   *
+   * @generated from rpc keyhub.app.v1.ApiTokenService.RevokeApiToken
+   */
+  revokeApiToken: {
+    methodKind: "unary";
+    input: typeof RevokeApiTokenRequestSchema;
+    output: typeof RevokeApiTokenResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_keyhub_app_v1_api_token, 0);
+
@@ -17,3 +17,66 @@ export const getMe = AuthService.method.getMe;
  * @generated from rpc keyhub.app.v1.AuthService.Logout
  */
 export const logout = AuthService.method.logout;
+
+/**
+ * ログイン中のセッション一覧取得
+ *
+ * @generated from rpc keyhub.app.v1.AuthService.ListSessions
+ */
+export const listSessions = AuthService.method.listSessions;
+
+/**
+ * 指定したセッションを無効化
+ *
+ * @generated from rpc keyhub.app.v1.AuthService.RevokeSession
+ */
+export const revokeSession = AuthService.method.revokeSession;
+
+/**
+ * 現在のセッション以外をすべて無効化
+ *
+ * @generated from rpc keyhub.app.v1.AuthService.RevokeAllOtherSessions
+ */
+export const revokeAllOtherSessions = AuthService.method.revokeAllOtherSessions;
+
+/**
+ * ログイン中のユーザーにパスキーを追加する登録セレモニーの開始
+ *
+ * @generated from rpc keyhub.app.v1.AuthService.BeginPasskeyRegistration
+ */
+export const beginPasskeyRegistration = AuthService.method.beginPasskeyRegistration;
+
+/**
+ * パスキー登録の完了
+ *
+ * @generated from rpc keyhub.app.v1.AuthService.FinishPasskeyRegistration
+ */
+export const finishPasskeyRegistration = AuthService.method.finishPasskeyRegistration;
+
+/**
+ * パスキーによるログインの開始（認証不要）
+ *
+ * @generated from rpc keyhub.app.v1.AuthService.BeginPasskeyLogin
+ */
+export const beginPasskeyLogin = AuthService.method.beginPasskeyLogin;
+
+/**
+ * パスキーによるログインの完了（認証不要）。成功するとセッションCookieを発行する
+ *
+ * @generated from rpc keyhub.app.v1.AuthService.FinishPasskeyLogin
+ */
+export const finishPasskeyLogin = AuthService.method.finishPasskeyLogin;
+
+/**
+ * 登録済みパスキー一覧取得
+ *
+ * @generated from rpc keyhub.app.v1.AuthService.ListPasskeys
+ */
+export const listPasskeys = AuthService.method.listPasskeys;
+
+/**
+ * パスキーの削除
+ *
+ * @generated from rpc keyhub.app.v1.AuthService.DeletePasskey
+ */
+export const deletePasskey = AuthService.method.deletePasskey;
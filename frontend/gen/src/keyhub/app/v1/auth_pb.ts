@@ -4,6 +4,9 @@
 
 import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_buf_validate_validate } from "../../../buf/validate/validate_pb";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import type { User } from "./common_pb";
 import { file_keyhub_app_v1_common } from "./common_pb";
 import type { Message } from "@bufbuild/protobuf";
@@ -12,7 +15,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file keyhub/app/v1/auth.proto.
  */
 export const file_keyhub_app_v1_auth: GenFile = /*@__PURE__*/
-  fileDesc("ChhrZXlodWIvYXBwL3YxL2F1dGgucHJvdG8SDWtleWh1Yi5hcHAudjEiDgoMR2V0TWVSZXF1ZXN0IjIKDUdldE1lUmVzcG9uc2USIQoEdXNlchgBIAEoCzITLmtleWh1Yi5hcHAudjEuVXNlciIPCg1Mb2dvdXRSZXF1ZXN0IiEKDkxvZ291dFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgymAEKC0F1dGhTZXJ2aWNlEkIKBUdldE1lEhsua2V5aHViLmFwcC52MS5HZXRNZVJlcXVlc3QaHC5rZXlodWIuYXBwLnYxLkdldE1lUmVzcG9uc2USRQoGTG9nb3V0Ehwua2V5aHViLmFwcC52MS5Mb2dvdXRSZXF1ZXN0Gh0ua2V5aHViLmFwcC52MS5Mb2dvdXRSZXNwb25zZULBAQoRY29tLmtleWh1Yi5hcHAudjFCCUF1dGhQcm90b1ABWktnaXRodWIuY29tL3NoaWJheWFtYS1jbHViL2tleWh1Yi9pbnRlcm5hbC9pbnRlcmZhY2UvZ2VuL2tleWh1Yi9hcHAvdjE7YXBwdjGiAgNLQViqAg1LZXlodWIuQXBwLlYxygINS2V5aHViXEFwcFxWMeICGUtleWh1YlxBcHBcVjFcR1BCTWV0YWRhdGHqAg9LZXlodWI6OkFwcDo6VjFiBnByb3RvMw", [file_keyhub_app_v1_common]);
+  fileDesc("ChhrZXlodWIvYXBwL3YxL2F1dGgucHJvdG8SDWtleWh1Yi5hcHAudjEiDgoMR2V0TWVSZXF1ZXN0IkYKDUdldE1lUmVzcG9uc2USIQoEdXNlchgBIAEoCzITLmtleWh1Yi5hcHAudjEuVXNlchISCgpjc3JmX3Rva2VuGAIgASgJIg8KDUxvZ291dFJlcXVlc3QiIQoOTG9nb3V0UmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCLgAQoHU2Vzc2lvbhIKCgJpZBgBIAEoCRISCgp1c2VyX2FnZW50GAIgASgJEhIKCmlwX2FkZHJlc3MYAyABKAkSLgoKY3JlYXRlZF9hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMAoMbGFzdF9zZWVuX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpleHBpcmVzX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIPCgdjdXJyZW50GAcgASgIIhUKE0xpc3RTZXNzaW9uc1JlcXVlc3QiQAoUTGlzdFNlc3Npb25zUmVzcG9uc2USKAoIc2Vzc2lvbnMYASADKAsyFi5rZXlodWIuYXBwLnYxLlNlc3Npb24iMwoUUmV2b2tlU2Vzc2lvblJlcXVlc3QSGwoKc2Vzc2lvbl9pZBgBIAEoCUIHukgEcgIQASIXChVSZXZva2VTZXNzaW9uUmVzcG9uc2UiHwodUmV2b2tlQWxsT3RoZXJTZXNzaW9uc1JlcXVlc3QiNwoeUmV2b2tlQWxsT3RoZXJTZXNzaW9uc1Jlc3BvbnNlEhUKDXJldm9rZWRfY291bnQYASABKAMiqAEKB1Bhc3NrZXkSFAoCaWQYASABKAlCCLpIBXIDsAEBEgwKBG5hbWUYAiABKAkSFwoPYmFja3VwX2VsaWdpYmxlGAMgASgIEjAKDGxhc3RfdXNlZF9hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKY3JlYXRlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiIQofQmVnaW5QYXNza2V5UmVnaXN0cmF0aW9uUmVxdWVzdCJNCiBCZWdpblBhc3NrZXlSZWdpc3RyYXRpb25SZXNwb25zZRITCgtjZXJlbW9ueV9pZBgBIAEoCRIUCgxvcHRpb25zX2pzb24YAiABKAkiewogRmluaXNoUGFzc2tleVJlZ2lzdHJhdGlvblJlcXVlc3QSHAoLY2VyZW1vbnlfaWQYASABKAlCB7pIBHICEAESFwoEbmFtZRgCIAEoCUIJukgGcgQQARgyEiAKD2NyZWRlbnRpYWxfanNvbhgDIAEoCUIHukgEcgIQASJMCiFGaW5pc2hQYXNza2V5UmVnaXN0cmF0aW9uUmVzcG9uc2USJwoHcGFzc2tleRgBIAEoCzIWLmtleWh1Yi5hcHAudjEuUGFzc2tleSIaChhCZWdpblBhc3NrZXlMb2dpblJlcXVlc3QiRgoZQmVnaW5QYXNza2V5TG9naW5SZXNwb25zZRITCgtjZXJlbW9ueV9pZBgBIAEoCRIUCgxvcHRpb25zX2pzb24YAiABKAkidgoZRmluaXNoUGFzc2tleUxvZ2luUmVxdWVzdBIcCgtjZXJlbW9ueV9pZBgBIAEoCUIHukgEcgIQARIgCg9jcmVkZW50aWFsX2pzb24YAiABKAlCB7pIBHICEAESGQoRb3JnYW5pemF0aW9uX3NsdWcYAyABKAkiHAoaRmluaXNoUGFzc2tleUxvZ2luUmVzcG9uc2UiFQoTTGlzdFBhc3NrZXlzUmVxdWVzdCJAChRMaXN0UGFzc2tleXNSZXNwb25zZRIoCghwYXNza2V5cxgBIAMoCzIWLmtleWh1Yi5hcHAudjEuUGFzc2tleSI0ChREZWxldGVQYXNza2V5UmVxdWVzdBIcCgpwYXNza2V5X2lkGAEgASgJQgi6SAVyA7ABASIXChVEZWxldGVQYXNza2V5UmVzcG9uc2Uy2AgKC0F1dGhTZXJ2aWNlEkcKBUdldE1lEhsua2V5aHViLmFwcC52MS5HZXRNZVJlcXVlc3QaHC5rZXlodWIuYXBwLnYxLkdldE1lUmVzcG9uc2UiA5ACARJFCgZMb2dvdXQSHC5rZXlodWIuYXBwLnYxLkxvZ291dFJlcXVlc3QaHS5rZXlodWIuYXBwLnYxLkxvZ291dFJlc3BvbnNlElwKDExpc3RTZXNzaW9ucxIiLmtleWh1Yi5hcHAudjEuTGlzdFNlc3Npb25zUmVxdWVzdBojLmtleWh1Yi5hcHAudjEuTGlzdFNlc3Npb25zUmVzcG9uc2UiA5ACARJaCg1SZXZva2VTZXNzaW9uEiMua2V5aHViLmFwcC52MS5SZXZva2VTZXNzaW9uUmVxdWVzdBokLmtleWh1Yi5hcHAudjEuUmV2b2tlU2Vzc2lvblJlc3BvbnNlEnUKFlJldm9rZUFsbE90aGVyU2Vzc2lvbnMSLC5rZXlodWIuYXBwLnYxLlJldm9rZUFsbE90aGVyU2Vzc2lvbnNSZXF1ZXN0Gi0ua2V5aHViLmFwcC52MS5SZXZva2VBbGxPdGhlclNlc3Npb25zUmVzcG9uc2USewoYQmVnaW5QYXNza2V5UmVnaXN0cmF0aW9uEi4ua2V5aHViLmFwcC52MS5CZWdpblBhc3NrZXlSZWdpc3RyYXRpb25SZXF1ZXN0Gi8ua2V5aHViLmFwcC52MS5CZWdpblBhc3NrZXlSZWdpc3RyYXRpb25SZXNwb25zZRJ+ChlGaW5pc2hQYXNza2V5UmVnaXN0cmF0aW9uEi8ua2V5aHViLmFwcC52MS5GaW5pc2hQYXNza2V5UmVnaXN0cmF0aW9uUmVxdWVzdBowLmtleWh1Yi5hcHAudjEuRmluaXNoUGFzc2tleVJlZ2lzdHJhdGlvblJlc3BvbnNlEmYKEUJlZ2luUGFzc2tleUxvZ2luEicua2V5aHViLmFwcC52MS5CZWdpblBhc3NrZXlMb2dpblJlcXVlc3QaKC5rZXlodWIuYXBwLnYxLkJlZ2luUGFzc2tleUxvZ2luUmVzcG9uc2USaQoSRmluaXNoUGFzc2tleUxvZ2luEigua2V5aHViLmFwcC52MS5GaW5pc2hQYXNza2V5TG9naW5SZXF1ZXN0Gikua2V5aHViLmFwcC52MS5GaW5pc2hQYXNza2V5TG9naW5SZXNwb25zZRJcCgxMaXN0UGFzc2tleXMSIi5rZXlodWIuYXBwLnYxLkxpc3RQYXNza2V5c1JlcXVlc3QaIy5rZXlodWIuYXBwLnYxLkxpc3RQYXNza2V5c1Jlc3BvbnNlIgOQAgESWgoNRGVsZXRlUGFzc2tleRIjLmtleWh1Yi5hcHAudjEuRGVsZXRlUGFzc2tleVJlcXVlc3QaJC5rZXlodWIuYXBwLnYxLkRlbGV0ZVBhc3NrZXlSZXNwb25zZULBAQoRY29tLmtleWh1Yi5hcHAudjFCCUF1dGhQcm90b1ABWktnaXRodWIuY29tL3NoaWJheWFtYS1jbHViL2tleWh1Yi9pbnRlcm5hbC9pbnRlcmZhY2UvZ2VuL2tleWh1Yi9hcHAvdjE7YXBwdjGiAgNLQViqAg1LZXlodWIuQXBwLlYxygINS2V5aHViXEFwcFxWMeICGUtleWh1YlxBcHBcVjFcR1BCTWV0YWRhdGHqAg9LZXlodWI6OkFwcDo6VjFiBnByb3RvMw", [file_buf_validate_validate, file_google_protobuf_timestamp, file_keyhub_app_v1_common]);
 
 /**
  * @generated from message keyhub.app.v1.GetMeRequest
@@ -35,6 +38,13 @@ export type GetMeResponse = Message<"keyhub.app.v1.GetMeResponse"> & {
    * @generated from field: keyhub.app.v1.User user = 1;
    */
   user?: User | undefined;
+
+  /**
+   * 更新系RPCの X-CSRF-Token ヘッダーに付与する
+   *
+   * @generated from field: string csrf_token = 2;
+   */
+  csrfToken: string;
 };
 
 /**
@@ -75,6 +85,410 @@ export const LogoutResponseSchema: GenMessage<LogoutResponse> = /*@__PURE__*/
   messageDesc(file_keyhub_app_v1_auth, 3);
 
 /**
+ * @generated from message keyhub.app.v1.Session
+ */
+export type Session = Message<"keyhub.app.v1.Session"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string user_agent = 2;
+   */
+  userAgent: string;
+
+  /**
+   * @generated from field: string ip_address = 3;
+   */
+  ipAddress: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 4;
+   */
+  createdAt?: Timestamp | undefined;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_seen_at = 5;
+   */
+  lastSeenAt?: Timestamp | undefined;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 6;
+   */
+  expiresAt?: Timestamp | undefined;
+
+  /**
+   * リクエスト元のセッションかどうか
+   *
+   * @generated from field: bool current = 7;
+   */
+  current: boolean;
+};
+
+/**
+ * Describes the message keyhub.app.v1.Session.
+ * Use `create(SessionSchema)` to create a new message.
+ */
+export const SessionSchema: GenMessage<Session> = /*@__PURE__*/
+  messageDesc(file_keyhub_app_v1_auth, 4);
+
+/**
+ * @generated from message keyhub.app.v1.ListSessionsRequest
+ */
+export type ListSessionsRequest = Message<"keyhub.app.v1.ListSessionsRequest"> & {
+};
+
+/**
+ * Describes the message keyhub.app.v1.ListSessionsRequest.
+ * Use `create(ListSessionsRequestSchema)` to create a new message.
+ */
+export const ListSessionsRequestSchema: GenMessage<ListSessionsRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_app_v1_auth, 5);
+
+/**
+ * @generated from message keyhub.app.v1.ListSessionsResponse
+ */
+export type ListSessionsResponse = Message<"keyhub.app.v1.ListSessionsResponse"> & {
+  /**
+   * @generated from field: repeated keyhub.app.v1.Session sessions = 1;
+   */
+  sessions: Session[];
+};
+
+/**
+ * Describes the message keyhub.app.v1.ListSessionsResponse.
+ * Use `create(ListSessionsResponseSchema)` to create a new message.
+ */
+export const ListSessionsResponseSchema: GenMessage<ListSessionsResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_app_v1_auth, 6);
+
+/**
+ * @generated from message keyhub.app.v1.RevokeSessionRequest
+ */
+export type RevokeSessionRequest = Message<"keyhub.app.v1.RevokeSessionRequest"> & {
+  /**
+   * @generated from field: string session_id = 1;
+   */
+  sessionId: string;
+};
+
+/**
+ * Describes the message keyhub.app.v1.RevokeSessionRequest.
+ * Use `create(RevokeSessionRequestSchema)` to create a new message.
+ */
+export const RevokeSessionRequestSchema: GenMessage<RevokeSessionRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_app_v1_auth, 7);
+
+/**
+ * @generated from message keyhub.app.v1.RevokeSessionResponse
+ */
+export type RevokeSessionResponse = Message<"keyhub.app.v1.RevokeSessionResponse"> & {
+};
+
+/**
+ * Describes the message keyhub.app.v1.RevokeSessionResponse.
+ * Use `create(RevokeSessionResponseSchema)` to create a new message.
+ */
+export const RevokeSessionResponseSchema: GenMessage<RevokeSessionResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_app_v1_auth, 8);
+
+/**
+ * @generated from message keyhub.app.v1.RevokeAllOtherSessionsRequest
+ */
+export type RevokeAllOtherSessionsRequest = Message<"keyhub.app.v1.RevokeAllOtherSessionsRequest"> & {
+};
+
+/**
+ * Describes the message keyhub.app.v1.RevokeAllOtherSessionsRequest.
+ * Use `create(RevokeAllOtherSessionsRequestSchema)` to create a new message.
+ */
+export const RevokeAllOtherSessionsRequestSchema: GenMessage<RevokeAllOtherSessionsRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_app_v1_auth, 9);
+
+/**
+ * @generated from message keyhub.app.v1.RevokeAllOtherSessionsResponse
+ */
+export type RevokeAllOtherSessionsResponse = Message<"keyhub.app.v1.RevokeAllOtherSessionsResponse"> & {
+  /**
+   * @generated from field: int64 revoked_count = 1;
+   */
+  revokedCount: bigint;
+};
+
+/**
+ * Describes the message keyhub.app.v1.RevokeAllOtherSessionsResponse.
+ * Use `create(RevokeAllOtherSessionsResponseSchema)` to create a new message.
+ */
+export const RevokeAllOtherSessionsResponseSchema: GenMessage<RevokeAllOtherSessionsResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_app_v1_auth, 10);
+
+/**
+ * @generated from message keyhub.app.v1.Passkey
+ */
+export type Passkey = Message<"keyhub.app.v1.Passkey"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * 複数端末で同期されるパスキーかどうか
+   *
+   * @generated from field: bool backup_eligible = 3;
+   */
+  backupEligible: boolean;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_used_at = 4;
+   */
+  lastUsedAt?: Timestamp | undefined;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 5;
+   */
+  createdAt?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message keyhub.app.v1.Passkey.
+ * Use `create(PasskeySchema)` to create a new message.
+ */
+export const PasskeySchema: GenMessage<Passkey> = /*@__PURE__*/
+  messageDesc(file_keyhub_app_v1_auth, 11);
+
+/**
+ * @generated from message keyhub.app.v1.BeginPasskeyRegistrationRequest
+ */
+export type BeginPasskeyRegistrationRequest = Message<"keyhub.app.v1.BeginPasskeyRegistrationRequest"> & {
+};
+
+/**
+ * Describes the message keyhub.app.v1.BeginPasskeyRegistrationRequest.
+ * Use `create(BeginPasskeyRegistrationRequestSchema)` to create a new message.
+ */
+export const BeginPasskeyRegistrationRequestSchema: GenMessage<BeginPasskeyRegistrationRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_app_v1_auth, 12);
+
+/**
+ * @generated from message keyhub.app.v1.BeginPasskeyRegistrationResponse
+ */
+export type BeginPasskeyRegistrationResponse = Message<"keyhub.app.v1.BeginPasskeyRegistrationResponse"> & {
+  /**
+   * @generated from field: string ceremony_id = 1;
+   */
+  ceremonyId: string;
+
+  /**
+   * navigator.credentials.create() に渡す { "publicKey": ... } のJSON
+   *
+   * @generated from field: string options_json = 2;
+   */
+  optionsJson: string;
+};
+
+/**
+ * Describes the message keyhub.app.v1.BeginPasskeyRegistrationResponse.
+ * Use `create(BeginPasskeyRegistrationResponseSchema)` to create a new message.
+ */
+export const BeginPasskeyRegistrationResponseSchema: GenMessage<BeginPasskeyRegistrationResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_app_v1_auth, 13);
+
+/**
+ * @generated from message keyhub.app.v1.FinishPasskeyRegistrationRequest
+ */
+export type FinishPasskeyRegistrationRequest = Message<"keyhub.app.v1.FinishPasskeyRegistrationRequest"> & {
+  /**
+   * @generated from field: string ceremony_id = 1;
+   */
+  ceremonyId: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * PublicKeyCredential をJSONにしたもの
+   *
+   * @generated from field: string credential_json = 3;
+   */
+  credentialJson: string;
+};
+
+/**
+ * Describes the message keyhub.app.v1.FinishPasskeyRegistrationRequest.
+ * Use `create(FinishPasskeyRegistrationRequestSchema)` to create a new message.
+ */
+export const FinishPasskeyRegistrationRequestSchema: GenMessage<FinishPasskeyRegistrationRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_app_v1_auth, 14);
+
+/**
+ * @generated from message keyhub.app.v1.FinishPasskeyRegistrationResponse
+ */
+export type FinishPasskeyRegistrationResponse = Message<"keyhub.app.v1.FinishPasskeyRegistrationResponse"> & {
+  /**
+   * @generated from field: keyhub.app.v1.Passkey passkey = 1;
+   */
+  passkey?: Passkey | undefined;
+};
+
+/**
+ * Describes the message keyhub.app.v1.FinishPasskeyRegistrationResponse.
+ * Use `create(FinishPasskeyRegistrationResponseSchema)` to create a new message.
+ */
+export const FinishPasskeyRegistrationResponseSchema: GenMessage<FinishPasskeyRegistrationResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_app_v1_auth, 15);
+
+/**
+ * @generated from message keyhub.app.v1.BeginPasskeyLoginRequest
+ */
+export type BeginPasskeyLoginRequest = Message<"keyhub.app.v1.BeginPasskeyLoginRequest"> & {
+};
+
+/**
+ * Describes the message keyhub.app.v1.BeginPasskeyLoginRequest.
+ * Use `create(BeginPasskeyLoginRequestSchema)` to create a new message.
+ */
+export const BeginPasskeyLoginRequestSchema: GenMessage<BeginPasskeyLoginRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_app_v1_auth, 16);
+
+/**
+ * @generated from message keyhub.app.v1.BeginPasskeyLoginResponse
+ */
+export type BeginPasskeyLoginResponse = Message<"keyhub.app.v1.BeginPasskeyLoginResponse"> & {
+  /**
+   * @generated from field: string ceremony_id = 1;
+   */
+  ceremonyId: string;
+
+  /**
+   * navigator.credentials.get() に渡す { "publicKey": ... } のJSON
+   *
+   * @generated from field: string options_json = 2;
+   */
+  optionsJson: string;
+};
+
+/**
+ * Describes the message keyhub.app.v1.BeginPasskeyLoginResponse.
+ * Use `create(BeginPasskeyLoginResponseSchema)` to create a new message.
+ */
+export const BeginPasskeyLoginResponseSchema: GenMessage<BeginPasskeyLoginResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_app_v1_auth, 17);
+
+/**
+ * @generated from message keyhub.app.v1.FinishPasskeyLoginRequest
+ */
+export type FinishPasskeyLoginRequest = Message<"keyhub.app.v1.FinishPasskeyLoginRequest"> & {
+  /**
+   * @generated from field: string ceremony_id = 1;
+   */
+  ceremonyId: string;
+
+  /**
+   * PublicKeyCredential をJSONにしたもの
+   *
+   * @generated from field: string credential_json = 2;
+   */
+  credentialJson: string;
+
+  /**
+   * ログインする組織のスラッグ。省略時は Origin のサブドメインから決め、組織が1つだけの環境ではその組織を使う
+   *
+   * @generated from field: string organization_slug = 3;
+   */
+  organizationSlug: string;
+};
+
+/**
+ * Describes the message keyhub.app.v1.FinishPasskeyLoginRequest.
+ * Use `create(FinishPasskeyLoginRequestSchema)` to create a new message.
+ */
+export const FinishPasskeyLoginRequestSchema: GenMessage<FinishPasskeyLoginRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_app_v1_auth, 18);
+
+/**
+ * @generated from message keyhub.app.v1.FinishPasskeyLoginResponse
+ */
+export type FinishPasskeyLoginResponse = Message<"keyhub.app.v1.FinishPasskeyLoginResponse"> & {
+};
+
+/**
+ * Describes the message keyhub.app.v1.FinishPasskeyLoginResponse.
+ * Use `create(FinishPasskeyLoginResponseSchema)` to create a new message.
+ */
+export const FinishPasskeyLoginResponseSchema: GenMessage<FinishPasskeyLoginResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_app_v1_auth, 19);
+
+/**
+ * @generated from message keyhub.app.v1.ListPasskeysRequest
+ */
+export type ListPasskeysRequest = Message<"keyhub.app.v1.ListPasskeysRequest"> & {
+};
+
+/**
+ * Describes the message keyhub.app.v1.ListPasskeysRequest.
+ * Use `create(ListPasskeysRequestSchema)` to create a new message.
+ */
+export const ListPasskeysRequestSchema: GenMessage<ListPasskeysRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_app_v1_auth, 20);
+
+/**
+ * @generated from message keyhub.app.v1.ListPasskeysResponse
+ */
+export type ListPasskeysResponse = Message<"keyhub.app.v1.ListPasskeysResponse"> & {
+  /**
+   * @generated from field: repeated keyhub.app.v1.Passkey passkeys = 1;
+   */
+  passkeys: Passkey[];
+};
+
+/**
+ * Describes the message keyhub.app.v1.ListPasskeysResponse.
+ * Use `create(ListPasskeysResponseSchema)` to create a new message.
+ */
+export const ListPasskeysResponseSchema: GenMessage<ListPasskeysResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_app_v1_auth, 21);
+
+/**
+ * @generated from message keyhub.app.v1.DeletePasskeyRequest
+ */
+export type DeletePasskeyRequest = Message<"keyhub.app.v1.DeletePasskeyRequest"> & {
+  /**
+   * @generated from field: string passkey_id = 1;
+   */
+  passkeyId: string;
+};
+
+/**
+ * Describes the message keyhub.app.v1.DeletePasskeyRequest.
+ * Use `create(DeletePasskeyRequestSchema)` to create a new message.
+ */
+export const DeletePasskeyRequestSchema: GenMessage<DeletePasskeyRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_app_v1_auth, 22);
+
+/**
+ * @generated from message keyhub.app.v1.DeletePasskeyResponse
+ */
+export type DeletePasskeyResponse = Message<"keyhub.app.v1.DeletePasskeyResponse"> & {
+};
+
+/**
+ * Describes the message keyhub.app.v1.DeletePasskeyResponse.
+ * Use `create(DeletePasskeyResponseSchema)` to create a new message.
+ */
+export const DeletePasskeyResponseSchema: GenMessage<DeletePasskeyResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_app_v1_auth, 23);
+
+/**
+ * 更新系RPC（idempotency_level が NO_SIDE_EFFECTS 以外）は X-CSRF-Token ヘッダーが必須
+ *
  * @generated from service keyhub.app.v1.AuthService
  */
 export const AuthService: GenService<{
@@ -98,6 +512,96 @@ export const AuthService: GenService<{
     input: typeof LogoutRequestSchema;
     output: typeof LogoutResponseSchema;
   },
+  /**
+   * ログイン中のセッション一覧取得
+   *
+   * @generated from rpc keyhub.app.v1.AuthService.ListSessions
+   */
+  listSessions: {
+    methodKind: "unary";
+    input: typeof ListSessionsRequestSchema;
+    output: typeof ListSessionsResponseSchema;
+  },
+  /**
+   * 指定したセッションを無効化
+   *
+   * @generated from rpc keyhub.app.v1.AuthService.RevokeSession
+   */
+  revokeSession: {
+    methodKind: "unary";
+    input: typeof RevokeSessionRequestSchema;
+    output: typeof RevokeSessionResponseSchema;
+  },
+  /**
+   * 現在のセッション以外をすべて無効化
+   *
+   * @generated from rpc keyhub.app.v1.AuthService.RevokeAllOtherSessions
+   */
+  revokeAllOtherSessions: {
+    methodKind: "unary";
+    input: typeof RevokeAllOtherSessionsRequestSchema;
+    output: typeof RevokeAllOtherSessionsResponseSchema;
+  },
+  /**
+   * ログイン中のユーザーにパスキーを追加する登録セレモニーの開始
+   *
+   * @generated from rpc keyhub.app.v1.AuthService.BeginPasskeyRegistration
+   */
+  beginPasskeyRegistration: {
+    methodKind: "unary";
+    input: typeof BeginPasskeyRegistrationRequestSchema;
+    output: typeof BeginPasskeyRegistrationResponseSchema;
+  },
+  /**
+   * パスキー登録の完了
+   *
+   * @generated from rpc keyhub.app.v1.AuthService.FinishPasskeyRegistration
+   */
+  finishPasskeyRegistration: {
+    methodKind: "unary";
+    input: typeof FinishPasskeyRegistrationRequestSchema;
+    output: typeof FinishPasskeyRegistrationResponseSchema;
+  },
+  /**
+   * パスキーによるログインの開始（認証不要）
+   *
+   * @generated from rpc keyhub.app.v1.AuthService.BeginPasskeyLogin
+   */
+  beginPasskeyLogin: {
+    methodKind: "unary";
+    input: typeof BeginPasskeyLoginRequestSchema;
+    output: typeof BeginPasskeyLoginResponseSchema;
+  },
+  /**
+   * パスキーによるログインの完了（認証不要）。成功するとセッションCookieを発行する
+   *
+   * @generated from rpc keyhub.app.v1.AuthService.FinishPasskeyLogin
+   */
+  finishPasskeyLogin: {
+    methodKind: "unary";
+    input: typeof FinishPasskeyLoginRequestSchema;
+    output: typeof FinishPasskeyLoginResponseSchema;
+  },
+  /**
+   * 登録済みパスキー一覧取得
+   *
+   * @generated from rpc keyhub.app.v1.AuthService.ListPasskeys
+   */
+  listPasskeys: {
+    methodKind: "unary";
+    input: typeof ListPasskeysRequestSchema;
+    output: typeof ListPasskeysResponseSchema;
+  },
+  /**
+   * パスキーの削除
+   *
+   * @generated from rpc keyhub.app.v1.AuthService.DeletePasskey
+   */
+  deletePasskey: {
+    methodKind: "unary";
+    input: typeof DeletePasskeyRequestSchema;
+    output: typeof DeletePasskeyResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_keyhub_app_v1_auth, 0);
 
@@ -13,7 +13,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file keyhub/app/v1/common.proto.
  */
 export const file_keyhub_app_v1_common: GenFile = /*@__PURE__*/
-  fileDesc("ChprZXlodWIvYXBwL3YxL2NvbW1vbi5wcm90bxINa2V5aHViLmFwcC52MSKnAQoEVXNlchIUCgJpZBgBIAEoCUIIukgFcgOwAQESDQoFZW1haWwYAiABKAkSDAoEbmFtZRgDIAEoCRIMCgRpY29uGAQgASgJEi4KCmNyZWF0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIooCCgZUZW5hbnQSFAoCaWQYASABKAlCCLpIBXIDsAEBEiEKD29yZ2FuaXphdGlvbl9pZBgCIAEoCUIIukgFcgOwAQESDAoEbmFtZRgDIAEoCRITCgtkZXNjcmlwdGlvbhgFIAEoCRIuCgt0ZW5hbnRfdHlwZRgGIAEoDjIZLmtleWh1Yi5hcHAudjEuVGVuYW50VHlwZRIUCgxtZW1iZXJfY291bnQYByABKAUSLgoKY3JlYXRlZF9hdBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiugEKBFJvb20SFAoCaWQYASABKAlCCLpIBXIDsAEBEgwKBG5hbWUYAiABKAkSFQoNYnVpbGRpbmdfbmFtZRgDIAEoCRIUCgxmbG9vcl9udW1iZXIYBCABKAkSKgoJcm9vbV90eXBlGAUgASgOMhcua2V5aHViLmFwcC52MS5Sb29tVHlwZRITCgtkZXNjcmlwdGlvbhgGIAEoCRIgCgRrZXlzGAcgAygLMhIua2V5aHViLmFwcC52MS5LZXkidAoDS2V5EhQKAmlkGAEgASgJQgi6SAVyA7ABARISCgprZXlfbnVtYmVyGAIgASgJEhkKB3Jvb21faWQYAyABKAlCCLpIBXIDsAEBEigKBnN0YXR1cxgEIAEoDjIYLmtleWh1Yi5hcHAudjEuS2V5U3RhdHVzKpABCgpUZW5hbnRUeXBlEhsKF1RFTkFOVF9UWVBFX1VOU1BFQ0lGSUVEEAASFAoQVEVOQU5UX1RZUEVfVEVBTRABEhoKFlRFTkFOVF9UWVBFX0RFUEFSVE1FTlQQAhIXChNURU5BTlRfVFlQRV9QUk9KRUNUEAMSGgoWVEVOQU5UX1RZUEVfTEFCT1JBVE9SWRAEKrkBCghSb29tVHlwZRIZChVST09NX1RZUEVfVU5TUEVDSUZJRUQQABIXChNST09NX1RZUEVfQ0xBU1NST09NEAESGgoWUk9PTV9UWVBFX01FRVRJTkdfUk9PTRACEhgKFFJPT01fVFlQRV9MQUJPUkFUT1JZEAMSFAoQUk9PTV9UWVBFX09GRklDRRAEEhYKElJPT01fVFlQRV9XT1JLU0hPUBAFEhUKEVJPT01fVFlQRV9TVE9SQUdFEAYqhQEKCUtleVN0YXR1cxIaChZLRVlfU1RBVFVTX1VOU1BFQ0lGSUVEEAASGAoUS0VZX1NUQVRVU19BVkFJTEFCTEUQARIVChFLRVlfU1RBVFVTX0lOX1VTRRACEhMKD0tFWV9TVEFUVVNfTE9TVBADEhYKEktFWV9TVEFUVVNfREFNQUdFRBAEQsMBChFjb20ua2V5aHViLmFwcC52MUILQ29tbW9uUHJvdG9QAVpLZ2l0aHViLmNvbS9zaGliYXlhbWEtY2x1Yi9rZXlodWIvaW50ZXJuYWwvaW50ZXJmYWNlL2dlbi9rZXlodWIvYXBwL3YxO2FwcHYxogIDS0FYqgINS2V5aHViLkFwcC5WMcoCDUtleWh1YlxBcHBcVjHiAhlLZXlodWJcQXBwXFYxXEdQQk1ldGFkYXRh6gIPS2V5aHViOjpBcHA6OlYxYgZwcm90bzM", [file_buf_validate_validate, file_google_protobuf_timestamp]);
+  fileDesc("ChprZXlodWIvYXBwL3YxL2NvbW1vbi5wcm90bxINa2V5aHViLmFwcC52MSKnAQoEVXNlchIUCgJpZBgBIAEoCUIIukgFcgOwAQESDQoFZW1haWwYAiABKAkSDAoEbmFtZRgDIAEoCRIMCgRpY29uGAQgASgJEi4KCmNyZWF0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wItQCCgZUZW5hbnQSFAoCaWQYASABKAlCCLpIBXIDsAEBEiEKD29yZ2FuaXphdGlvbl9pZBgCIAEoCUIIukgFcgOwAQESDAoEbmFtZRgDIAEoCRITCgtkZXNjcmlwdGlvbhgFIAEoCRIuCgt0ZW5hbnRfdHlwZRgGIAEoDjIZLmtleWh1Yi5hcHAudjEuVGVuYW50VHlwZRIUCgxtZW1iZXJfY291bnQYByABKAUSLgoKY3JlYXRlZF9hdBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFwoPdGVuYW50X3R5cGVfa2V5GAogASgJEi8KC2FyY2hpdmVkX2F0GAsgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCKdAgoEUm9vbRIUCgJpZBgBIAEoCUIIukgFcgOwAQESDAoEbmFtZRgCIAEoCRIVCg1idWlsZGluZ19uYW1lGAMgASgJEhQKDGZsb29yX251bWJlchgEIAEoCRIqCglyb29tX3R5cGUYBSABKA4yFy5rZXlodWIuYXBwLnYxLlJvb21UeXBlEhMKC2Rlc2NyaXB0aW9uGAYgASgJEiAKBGtleXMYByADKAsyEi5rZXlodWIuYXBwLnYxLktleRIXCg9jYW5fYm9ycm93X2tleXMYCCABKAgSMQoKYXR0cmlidXRlcxgJIAEoCzIdLmtleWh1Yi5hcHAudjEuUm9vbUF0dHJpYnV0ZXMSFQoNcm9vbV90eXBlX2tleRgKIAEoCSJ0CgNLZXkSFAoCaWQYASABKAlCCLpIBXIDsAEBEhIKCmtleV9udW1iZXIYAiABKAkSGQoHcm9vbV9pZBgDIAEoCUIIukgFcgOwAQESKAoGc3RhdHVzGAQgASgOMhgua2V5aHViLmFwcC52MS5LZXlTdGF0dXMigQIKDlJvb21BdHRyaWJ1dGVzEhwKCGNhcGFjaXR5GAEgASgFQgq6SAcaBRiQTigAEhsKCWVxdWlwbWVudBgCIAMoCUIIukgFkgECEB4SNwoNYWNjZXNzaWJpbGl0eRgDIAMoDjIgLmtleWh1Yi5hcHAudjEuUm9vbUFjY2Vzc2liaWxpdHkSRgoNY3VzdG9tX2ZpZWxkcxgEIAMoCzIvLmtleWh1Yi5hcHAudjEuUm9vbUF0dHJpYnV0ZXMuQ3VzdG9tRmllbGRzRW50cnkaMwoRQ3VzdG9tRmllbGRzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASqQAQoKVGVuYW50VHlwZRIbChdURU5BTlRfVFlQRV9VTlNQRUNJRklFRBAAEhQKEFRFTkFOVF9UWVBFX1RFQU0QARIaChZURU5BTlRfVFlQRV9ERVBBUlRNRU5UEAISFwoTVEVOQU5UX1RZUEVfUFJPSkVDVBADEhoKFlRFTkFOVF9UWVBFX0xBQk9SQVRPUlkQBCq5AQoIUm9vbVR5cGUSGQoVUk9PTV9UWVBFX1VOU1BFQ0lGSUVEEAASFwoTUk9PTV9UWVBFX0NMQVNTUk9PTRABEhoKFlJPT01fVFlQRV9NRUVUSU5HX1JPT00QAhIYChRST09NX1RZUEVfTEFCT1JBVE9SWRADEhQKEFJPT01fVFlQRV9PRkZJQ0UQBBIWChJST09NX1RZUEVfV09SS1NIT1AQBRIVChFST09NX1RZUEVfU1RPUkFHRRAGKs0BChFSb29tQWNjZXNzaWJpbGl0eRIiCh5ST09NX0FDQ0VTU0lCSUxJVFlfVU5TUEVDSUZJRUQQABIhCh1ST09NX0FDQ0VTU0lCSUxJVFlfV0hFRUxDSEFJUhABEiAKHFJPT01fQUNDRVNTSUJJTElUWV9TVEVQX0ZSRUUQAhIqCiZST09NX0FDQ0VTU0lCSUxJVFlfQUNDRVNTSUJMRV9SRVNUUk9PTRADEiMKH1JPT01fQUNDRVNTSUJJTElUWV9IRUFSSU5HX0xPT1AQBCqFAQoJS2V5U3RhdHVzEhoKFktFWV9TVEFUVVNfVU5TUEVDSUZJRUQQABIYChRLRVlfU1RBVFVTX0FWQUlMQUJMRRABEhUKEUtFWV9TVEFUVVNfSU5fVVNFEAISEwoPS0VZX1NUQVRVU19MT1NUEAMSFgoSS0VZX1NUQVRVU19EQU1BR0VEEAQqUwoJTGlzdE9yZGVyEhoKFkxJU1RfT1JERVJfVU5TUEVDSUZJRUQQABIVChFMSVNUX09SREVSX05FV0VTVBABEhMKD0xJU1RfT1JERVJfTkFNRRACQsMBChFjb20ua2V5aHViLmFwcC52MUILQ29tbW9uUHJvdG9QAVpLZ2l0aHViLmNvbS9zaGliYXlhbWEtY2x1Yi9rZXlodWIvaW50ZXJuYWwvaW50ZXJmYWNlL2dlbi9rZXlodWIvYXBwL3YxO2FwcHYxogIDS0FYqgINS2V5aHViLkFwcC5WMcoCDUtleWh1YlxBcHBcVjHiAhlLZXlodWJcQXBwXFYxXEdQQk1ldGFkYXRh6gIPS2V5aHViOjpBcHA6OlYxYgZwcm90bzM", [file_buf_validate_validate, file_google_protobuf_timestamp]);
 
 /**
  * @generated from message keyhub.app.v1.User
@@ -100,6 +100,20 @@ export type Tenant = Message<"keyhub.app.v1.Tenant"> & {
    * @generated from field: google.protobuf.Timestamp updated_at = 9;
    */
   updatedAt?: Timestamp | undefined;
+
+  /**
+   * テナントタイプのキー。組織が追加したタイプの場合 tenant_type は UNSPECIFIED
+   *
+   * @generated from field: string tenant_type_key = 10;
+   */
+  tenantTypeKey: string;
+
+  /**
+   * アーカイブされた日時。アーカイブされたテナントは閲覧のみできる
+   *
+   * @generated from field: google.protobuf.Timestamp archived_at = 11;
+   */
+  archivedAt?: Timestamp | undefined;
 };
 
 /**
@@ -147,6 +161,25 @@ export type Room = Message<"keyhub.app.v1.Room"> & {
    * @generated from field: repeated keyhub.app.v1.Key keys = 7;
    */
   keys: Key[];
+
+  /**
+   * 鍵の貸出がグループに限定された部屋では、呼び出したユーザーがそのグループに属する場合だけ true
+   *
+   * @generated from field: bool can_borrow_keys = 8;
+   */
+  canBorrowKeys: boolean;
+
+  /**
+   * @generated from field: keyhub.app.v1.RoomAttributes attributes = 9;
+   */
+  attributes?: RoomAttributes | undefined;
+
+  /**
+   * 部屋タイプのキー。組織が追加したタイプの場合 room_type は UNSPECIFIED
+   *
+   * @generated from field: string room_type_key = 10;
+   */
+  roomTypeKey: string;
 };
 
 /**
@@ -188,6 +221,46 @@ export type Key = Message<"keyhub.app.v1.Key"> & {
 export const KeySchema: GenMessage<Key> = /*@__PURE__*/
   messageDesc(file_keyhub_app_v1_common, 3);
 
+/**
+ * 部屋の収容人数・設備・バリアフリー対応と、組織が定義したカスタム項目の値
+ *
+ * @generated from message keyhub.app.v1.RoomAttributes
+ */
+export type RoomAttributes = Message<"keyhub.app.v1.RoomAttributes"> & {
+  /**
+   * 0 は未設定
+   *
+   * @generated from field: int32 capacity = 1;
+   */
+  capacity: number;
+
+  /**
+   * 設備名（各30文字以内）
+   *
+   * @generated from field: repeated string equipment = 2;
+   */
+  equipment: string[];
+
+  /**
+   * @generated from field: repeated keyhub.app.v1.RoomAccessibility accessibility = 3;
+   */
+  accessibility: RoomAccessibility[];
+
+  /**
+   * カスタム項目のキーごとの値
+   *
+   * @generated from field: map<string, string> custom_fields = 4;
+   */
+  customFields: { [key: string]: string };
+};
+
+/**
+ * Describes the message keyhub.app.v1.RoomAttributes.
+ * Use `create(RoomAttributesSchema)` to create a new message.
+ */
+export const RoomAttributesSchema: GenMessage<RoomAttributes> = /*@__PURE__*/
+  messageDesc(file_keyhub_app_v1_common, 4);
+
 /**
  * @generated from enum keyhub.app.v1.TenantType
  */
@@ -290,6 +363,50 @@ export enum RoomType {
 export const RoomTypeSchema: GenEnum<RoomType> = /*@__PURE__*/
   enumDesc(file_keyhub_app_v1_common, 1);
 
+/**
+ * @generated from enum keyhub.app.v1.RoomAccessibility
+ */
+export enum RoomAccessibility {
+  /**
+   * @generated from enum value: ROOM_ACCESSIBILITY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * 車いすで利用できる
+   *
+   * @generated from enum value: ROOM_ACCESSIBILITY_WHEELCHAIR = 1;
+   */
+  WHEELCHAIR = 1,
+
+  /**
+   * 段差がない
+   *
+   * @generated from enum value: ROOM_ACCESSIBILITY_STEP_FREE = 2;
+   */
+  STEP_FREE = 2,
+
+  /**
+   * 近くに多目的トイレがある
+   *
+   * @generated from enum value: ROOM_ACCESSIBILITY_ACCESSIBLE_RESTROOM = 3;
+   */
+  ACCESSIBLE_RESTROOM = 3,
+
+  /**
+   * ヒアリングループがある
+   *
+   * @generated from enum value: ROOM_ACCESSIBILITY_HEARING_LOOP = 4;
+   */
+  HEARING_LOOP = 4,
+}
+
+/**
+ * Describes the enum keyhub.app.v1.RoomAccessibility.
+ */
+export const RoomAccessibilitySchema: GenEnum<RoomAccessibility> = /*@__PURE__*/
+  enumDesc(file_keyhub_app_v1_common, 2);
+
 /**
  * @generated from enum keyhub.app.v1.KeyStatus
  */
@@ -332,5 +449,39 @@ export enum KeyStatus {
  * Describes the enum keyhub.app.v1.KeyStatus.
  */
 export const KeyStatusSchema: GenEnum<KeyStatus> = /*@__PURE__*/
-  enumDesc(file_keyhub_app_v1_common, 2);
+  enumDesc(file_keyhub_app_v1_common, 3);
+
+/**
+ * 一覧の並び順
+ *
+ * @generated from enum keyhub.app.v1.ListOrder
+ */
+export enum ListOrder {
+  /**
+   * 新しい順
+   *
+   * @generated from enum value: LIST_ORDER_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * 作成日時の新しい順
+   *
+   * @generated from enum value: LIST_ORDER_NEWEST = 1;
+   */
+  NEWEST = 1,
+
+  /**
+   * 名前の昇順（鍵の一覧では鍵番号の昇順）
+   *
+   * @generated from enum value: LIST_ORDER_NAME = 2;
+   */
+  NAME = 2,
+}
+
+/**
+ * Describes the enum keyhub.app.v1.ListOrder.
+ */
+export const ListOrderSchema: GenEnum<ListOrder> = /*@__PURE__*/
+  enumDesc(file_keyhub_app_v1_common, 4);
 
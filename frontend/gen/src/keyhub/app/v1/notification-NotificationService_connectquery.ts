@@ -0,0 +1,19 @@
+// @generated by protoc-gen-connect-query v2.2.0 with parameter "target=ts"
+// @generated from file keyhub/app/v1/notification.proto (package keyhub.app.v1, syntax proto3)
+/* eslint-disable */
+
+import { NotificationService } from "./notification_pb";
+
+/**
+ * 通知設定取得
+ *
+ * @generated from rpc keyhub.app.v1.NotificationService.GetNotificationPreferences
+ */
+export const getNotificationPreferences = NotificationService.method.getNotificationPreferences;
+
+/**
+ * 通知設定更新（指定しなかった種類は受け取る設定になる）
+ *
+ * @generated from rpc keyhub.app.v1.NotificationService.UpdateNotificationPreferences
+ */
+export const updateNotificationPreferences = NotificationService.method.updateNotificationPreferences;
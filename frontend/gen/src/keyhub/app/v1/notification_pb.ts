@@ -0,0 +1,163 @@
+// @generated by protoc-gen-es v2.12.0 with parameter "target=ts"
+// @generated from file keyhub/app/v1/notification.proto (package keyhub.app.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_buf_validate_validate } from "../../../buf/validate/validate_pb";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file keyhub/app/v1/notification.proto.
+ */
+export const file_keyhub_app_v1_notification: GenFile = /*@__PURE__*/
+  fileDesc("CiBrZXlodWIvYXBwL3YxL25vdGlmaWNhdGlvbi5wcm90bxINa2V5aHViLmFwcC52MSJDChNOb3RpZmljYXRpb25TZXR0aW5nEhUKBGtpbmQYASABKAlCB7pIBHICEAESFQoNZW1haWxfZW5hYmxlZBgCIAEoCCJfChdOb3RpZmljYXRpb25QcmVmZXJlbmNlcxIOCgZsb2NhbGUYASABKAkSNAoIc2V0dGluZ3MYAiADKAsyIi5rZXlodWIuYXBwLnYxLk5vdGlmaWNhdGlvblNldHRpbmciIwohR2V0Tm90aWZpY2F0aW9uUHJlZmVyZW5jZXNSZXF1ZXN0ImEKIkdldE5vdGlmaWNhdGlvblByZWZlcmVuY2VzUmVzcG9uc2USOwoLcHJlZmVyZW5jZXMYASABKAsyJi5rZXlodWIuYXBwLnYxLk5vdGlmaWNhdGlvblByZWZlcmVuY2VzIn0KJFVwZGF0ZU5vdGlmaWNhdGlvblByZWZlcmVuY2VzUmVxdWVzdBIfCgZsb2NhbGUYASABKAlCD7pIDHIKUgBSAmphUgJlbhI0CghzZXR0aW5ncxgCIAMoCzIiLmtleWh1Yi5hcHAudjEuTm90aWZpY2F0aW9uU2V0dGluZyJkCiVVcGRhdGVOb3RpZmljYXRpb25QcmVmZXJlbmNlc1Jlc3BvbnNlEjsKC3ByZWZlcmVuY2VzGAEgASgLMiYua2V5aHViLmFwcC52MS5Ob3RpZmljYXRpb25QcmVmZXJlbmNlczKrAgoTTm90aWZpY2F0aW9uU2VydmljZRKGAQoaR2V0Tm90aWZpY2F0aW9uUHJlZmVyZW5jZXMSMC5rZXlodWIuYXBwLnYxLkdldE5vdGlmaWNhdGlvblByZWZlcmVuY2VzUmVxdWVzdBoxLmtleWh1Yi5hcHAudjEuR2V0Tm90aWZpY2F0aW9uUHJlZmVyZW5jZXNSZXNwb25zZSIDkAIBEooBCh1VcGRhdGVOb3RpZmljYXRpb25QcmVmZXJlbmNlcxIzLmtleWh1Yi5hcHAudjEuVXBkYXRlTm90aWZpY2F0aW9uUHJlZmVyZW5jZXNSZXF1ZXN0GjQua2V5aHViLmFwcC52MS5VcGRhdGVOb3RpZmljYXRpb25QcmVmZXJlbmNlc1Jlc3BvbnNlQskBChFjb20ua2V5aHViLmFwcC52MUIRTm90aWZpY2F0aW9uUHJvdG9QAVpLZ2l0aHViLmNvbS9zaGliYXlhbWEtY2x1Yi9rZXlodWIvaW50ZXJuYWwvaW50ZXJmYWNlL2dlbi9rZXlodWIvYXBwL3YxO2FwcHYxogIDS0FYqgINS2V5aHViLkFwcC5WMcoCDUtleWh1YlxBcHBcVjHiAhlLZXlodWJcQXBwXFYxXEdQQk1ldGFkYXRh6gIPS2V5aHViOjpBcHA6OlYxYgZwcm90bzM", [file_buf_validate_validate]);
+
+/**
+ * @generated from message keyhub.app.v1.NotificationSetting
+ */
+export type NotificationSetting = Message<"keyhub.app.v1.NotificationSetting"> & {
+  /**
+   * "member_joined" | "room_assigned" | "room_assignment_expiring"
+   *
+   * @generated from field: string kind = 1;
+   */
+  kind: string;
+
+  /**
+   * @generated from field: bool email_enabled = 2;
+   */
+  emailEnabled: boolean;
+};
+
+/**
+ * Describes the message keyhub.app.v1.NotificationSetting.
+ * Use `create(NotificationSettingSchema)` to create a new message.
+ */
+export const NotificationSettingSchema: GenMessage<NotificationSetting> = /*@__PURE__*/
+  messageDesc(file_keyhub_app_v1_notification, 0);
+
+/**
+ * @generated from message keyhub.app.v1.NotificationPreferences
+ */
+export type NotificationPreferences = Message<"keyhub.app.v1.NotificationPreferences"> & {
+  /**
+   * "ja" | "en"。空の場合は組織の言語設定に従う
+   *
+   * @generated from field: string locale = 1;
+   */
+  locale: string;
+
+  /**
+   * すべての通知の種類の設定
+   *
+   * @generated from field: repeated keyhub.app.v1.NotificationSetting settings = 2;
+   */
+  settings: NotificationSetting[];
+};
+
+/**
+ * Describes the message keyhub.app.v1.NotificationPreferences.
+ * Use `create(NotificationPreferencesSchema)` to create a new message.
+ */
+export const NotificationPreferencesSchema: GenMessage<NotificationPreferences> = /*@__PURE__*/
+  messageDesc(file_keyhub_app_v1_notification, 1);
+
+/**
+ * @generated from message keyhub.app.v1.GetNotificationPreferencesRequest
+ */
+export type GetNotificationPreferencesRequest = Message<"keyhub.app.v1.GetNotificationPreferencesRequest"> & {
+};
+
+/**
+ * Describes the message keyhub.app.v1.GetNotificationPreferencesRequest.
+ * Use `create(GetNotificationPreferencesRequestSchema)` to create a new message.
+ */
+export const GetNotificationPreferencesRequestSchema: GenMessage<GetNotificationPreferencesRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_app_v1_notification, 2);
+
+/**
+ * @generated from message keyhub.app.v1.GetNotificationPreferencesResponse
+ */
+export type GetNotificationPreferencesResponse = Message<"keyhub.app.v1.GetNotificationPreferencesResponse"> & {
+  /**
+   * @generated from field: keyhub.app.v1.NotificationPreferences preferences = 1;
+   */
+  preferences?: NotificationPreferences | undefined;
+};
+
+/**
+ * Describes the message keyhub.app.v1.GetNotificationPreferencesResponse.
+ * Use `create(GetNotificationPreferencesResponseSchema)` to create a new message.
+ */
+export const GetNotificationPreferencesResponseSchema: GenMessage<GetNotificationPreferencesResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_app_v1_notification, 3);
+
+/**
+ * @generated from message keyhub.app.v1.UpdateNotificationPreferencesRequest
+ */
+export type UpdateNotificationPreferencesRequest = Message<"keyhub.app.v1.UpdateNotificationPreferencesRequest"> & {
+  /**
+   * @generated from field: string locale = 1;
+   */
+  locale: string;
+
+  /**
+   * @generated from field: repeated keyhub.app.v1.NotificationSetting settings = 2;
+   */
+  settings: NotificationSetting[];
+};
+
+/**
+ * Describes the message keyhub.app.v1.UpdateNotificationPreferencesRequest.
+ * Use `create(UpdateNotificationPreferencesRequestSchema)` to create a new message.
+ */
+export const UpdateNotificationPreferencesRequestSchema: GenMessage<UpdateNotificationPreferencesRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_app_v1_notification, 4);
+
+/**
+ * @generated from message keyhub.app.v1.UpdateNotificationPreferencesResponse
+ */
+export type UpdateNotificationPreferencesResponse = Message<"keyhub.app.v1.UpdateNotificationPreferencesResponse"> & {
+  /**
+   * @generated from field: keyhub.app.v1.NotificationPreferences preferences = 1;
+   */
+  preferences?: NotificationPreferences | undefined;
+};
+
+/**
+ * Describes the message keyhub.app.v1.UpdateNotificationPreferencesResponse.
+ * Use `create(UpdateNotificationPreferencesResponseSchema)` to create a new message.
+ */
+export const UpdateNotificationPreferencesResponseSchema: GenMessage<UpdateNotificationPreferencesResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_app_v1_notification, 5);
+
+/**
+ * ログイン中のユーザーのメール通知設定
+ *
+ * @generated from service keyhub.app.v1.NotificationService
+ */
+export const NotificationService: GenService<{
+  /**
+   * 通知設定取得
+   *
+   * @generated from rpc keyhub.app.v1.NotificationService.GetNotificationPreferences
+   */
+  getNotificationPreferences: {
+    methodKind: "unary";
+    input: typeof GetNotificationPreferencesRequestSchema;
+    output: typeof GetNotificationPreferencesResponseSchema;
+  },
+  /**
+   * 通知設定更新（指定しなかった種類は受け取る設定になる）
+   *
+   * @generated from rpc keyhub.app.v1.NotificationService.UpdateNotificationPreferences
+   */
+  updateNotificationPreferences: {
+    methodKind: "unary";
+    input: typeof UpdateNotificationPreferencesRequestSchema;
+    output: typeof UpdateNotificationPreferencesResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_keyhub_app_v1_notification, 0);
+
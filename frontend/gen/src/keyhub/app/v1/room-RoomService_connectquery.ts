@@ -5,7 +5,7 @@
 import { RoomService } from "./room_pb";
 
 /**
- * テナントに紐づくRoom一覧を取得（Keyを含む）
+ * テナントに紐づくRoom一覧を取得（Keyを含む）。グループに割り当てた部屋は、そのグループのメンバーにだけ返す
  *
  * @generated from rpc keyhub.app.v1.RoomService.GetRoomsByTenant
  */
@@ -5,7 +5,7 @@
 import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
 import { file_buf_validate_validate } from "../../../buf/validate/validate_pb";
-import type { Room } from "./common_pb";
+import type { Room, RoomAccessibility } from "./common_pb";
 import { file_keyhub_app_v1_common } from "./common_pb";
 import type { Message } from "@bufbuild/protobuf";
 
@@ -13,7 +13,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file keyhub/app/v1/room.proto.
  */
 export const file_keyhub_app_v1_room: GenFile = /*@__PURE__*/
-  fileDesc("ChhrZXlodWIvYXBwL3YxL3Jvb20ucHJvdG8SDWtleWh1Yi5hcHAudjEiNgoXR2V0Um9vbXNCeVRlbmFudFJlcXVlc3QSGwoJdGVuYW50X2lkGAEgASgJQgi6SAVyA7ABASI+ChhHZXRSb29tc0J5VGVuYW50UmVzcG9uc2USIgoFcm9vbXMYASADKAsyEy5rZXlodWIuYXBwLnYxLlJvb20ycgoLUm9vbVNlcnZpY2USYwoQR2V0Um9vbXNCeVRlbmFudBImLmtleWh1Yi5hcHAudjEuR2V0Um9vbXNCeVRlbmFudFJlcXVlc3QaJy5rZXlodWIuYXBwLnYxLkdldFJvb21zQnlUZW5hbnRSZXNwb25zZULBAQoRY29tLmtleWh1Yi5hcHAudjFCCVJvb21Qcm90b1ABWktnaXRodWIuY29tL3NoaWJheWFtYS1jbHViL2tleWh1Yi9pbnRlcm5hbC9pbnRlcmZhY2UvZ2VuL2tleWh1Yi9hcHAvdjE7YXBwdjGiAgNLQViqAg1LZXlodWIuQXBwLlYxygINS2V5aHViXEFwcFxWMeICGUtleWh1YlxBcHBcVjFcR1BCTWV0YWRhdGHqAg9LZXlodWI6OkFwcDo6VjFiBnByb3RvMw", [file_buf_validate_validate, file_keyhub_app_v1_common]);
+  fileDesc("ChhrZXlodWIvYXBwL3YxL3Jvb20ucHJvdG8SDWtleWh1Yi5hcHAudjEipAEKF0dldFJvb21zQnlUZW5hbnRSZXF1ZXN0EhsKCXRlbmFudF9pZBgBIAEoCUIIukgFcgOwAQESIAoMbWluX2NhcGFjaXR5GAIgASgFQgq6SAcaBRiQTigAEhEKCWVxdWlwbWVudBgDIAMoCRI3Cg1hY2Nlc3NpYmlsaXR5GAQgAygOMiAua2V5aHViLmFwcC52MS5Sb29tQWNjZXNzaWJpbGl0eSI+ChhHZXRSb29tc0J5VGVuYW50UmVzcG9uc2USIgoFcm9vbXMYASADKAsyEy5rZXlodWIuYXBwLnYxLlJvb20ydwoLUm9vbVNlcnZpY2USaAoQR2V0Um9vbXNCeVRlbmFudBImLmtleWh1Yi5hcHAudjEuR2V0Um9vbXNCeVRlbmFudFJlcXVlc3QaJy5rZXlodWIuYXBwLnYxLkdldFJvb21zQnlUZW5hbnRSZXNwb25zZSIDkAIBQsEBChFjb20ua2V5aHViLmFwcC52MUIJUm9vbVByb3RvUAFaS2dpdGh1Yi5jb20vc2hpYmF5YW1hLWNsdWIva2V5aHViL2ludGVybmFsL2ludGVyZmFjZS9nZW4va2V5aHViL2FwcC92MTthcHB2MaICA0tBWKoCDUtleWh1Yi5BcHAuVjHKAg1LZXlodWJcQXBwXFYx4gIZS2V5aHViXEFwcFxWMVxHUEJNZXRhZGF0YeoCD0tleWh1Yjo6QXBwOjpWMWIGcHJvdG8z", [file_buf_validate_validate, file_keyhub_app_v1_common]);
 
 /**
  * @generated from message keyhub.app.v1.GetRoomsByTenantRequest
@@ -23,6 +23,29 @@ export type GetRoomsByTenantRequest = Message<"keyhub.app.v1.GetRoomsByTenantReq
    * @generated from field: string tenant_id = 1;
    */
   tenantId: string;
+
+  /**
+   * 以下の条件は指定したものだけで絞り込む
+   *
+   * 収容人数がこの値以上
+   *
+   * @generated from field: int32 min_capacity = 2;
+   */
+  minCapacity: number;
+
+  /**
+   * すべての設備を持つ部屋
+   *
+   * @generated from field: repeated string equipment = 3;
+   */
+  equipment: string[];
+
+  /**
+   * すべてのバリアフリー対応を持つ部屋
+   *
+   * @generated from field: repeated keyhub.app.v1.RoomAccessibility accessibility = 4;
+   */
+  accessibility: RoomAccessibility[];
 };
 
 /**
@@ -54,7 +77,7 @@ export const GetRoomsByTenantResponseSchema: GenMessage<GetRoomsByTenantResponse
  */
 export const RoomService: GenService<{
   /**
-   * テナントに紐づくRoom一覧を取得（Keyを含む）
+   * テナントに紐づくRoom一覧を取得（Keyを含む）。グループに割り当てた部屋は、そのグループのメンバーにだけ返す
    *
    * @generated from rpc keyhub.app.v1.RoomService.GetRoomsByTenant
    */
@@ -5,7 +5,7 @@
 import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
 import { file_buf_validate_validate } from "../../../buf/validate/validate_pb";
-import type { Tenant, TenantType } from "./common_pb";
+import type { ListOrder, Tenant, TenantType } from "./common_pb";
 import { file_keyhub_app_v1_common } from "./common_pb";
 import type { Message } from "@bufbuild/protobuf";
 
@@ -13,7 +13,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file keyhub/app/v1/tenant.proto.
  */
 export const file_keyhub_app_v1_tenant: GenFile = /*@__PURE__*/
-  fileDesc("ChprZXlodWIvYXBwL3YxL3RlbmFudC5wcm90bxINa2V5aHViLmFwcC52MSIvChpHZXRUZW5hbnRCeUpvaW5Db2RlUmVxdWVzdBIRCglqb2luX2NvZGUYASABKAkihgEKG0dldFRlbmFudEJ5Sm9pbkNvZGVSZXNwb25zZRIUCgJpZBgBIAEoCUIIukgFcgOwAQESDAoEbmFtZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRIuCgt0ZW5hbnRfdHlwZRgEIAEoDjIZLmtleWh1Yi5hcHAudjEuVGVuYW50VHlwZSImChFKb2luVGVuYW50UmVxdWVzdBIRCglqb2luX2NvZGUYASABKAkiTAoSSm9pblRlbmFudFJlc3BvbnNlEiUKBnRlbmFudBgBIAEoCzIVLmtleWh1Yi5hcHAudjEuVGVuYW50Eg8KB21lc3NhZ2UYAiABKAkiFQoTR2V0TXlUZW5hbnRzUmVxdWVzdCI+ChRHZXRNeVRlbmFudHNSZXNwb25zZRImCgd0ZW5hbnRzGAEgAygLMhUua2V5aHViLmFwcC52MS5UZW5hbnQyqQIKDVRlbmFudFNlcnZpY2USbAoTR2V0VGVuYW50QnlKb2luQ29kZRIpLmtleWh1Yi5hcHAudjEuR2V0VGVuYW50QnlKb2luQ29kZVJlcXVlc3QaKi5rZXlodWIuYXBwLnYxLkdldFRlbmFudEJ5Sm9pbkNvZGVSZXNwb25zZRJRCgpKb2luVGVuYW50EiAua2V5aHViLmFwcC52MS5Kb2luVGVuYW50UmVxdWVzdBohLmtleWh1Yi5hcHAudjEuSm9pblRlbmFudFJlc3BvbnNlElcKDEdldE15VGVuYW50cxIiLmtleWh1Yi5hcHAudjEuR2V0TXlUZW5hbnRzUmVxdWVzdBojLmtleWh1Yi5hcHAudjEuR2V0TXlUZW5hbnRzUmVzcG9uc2VCwwEKEWNvbS5rZXlodWIuYXBwLnYxQgtUZW5hbnRQcm90b1ABWktnaXRodWIuY29tL3NoaWJheWFtYS1jbHViL2tleWh1Yi9pbnRlcm5hbC9pbnRlcmZhY2UvZ2VuL2tleWh1Yi9hcHAvdjE7YXBwdjGiAgNLQViqAg1LZXlodWIuQXBwLlYxygINS2V5aHViXEFwcFxWMeICGUtleWh1YlxBcHBcVjFcR1BCTWV0YWRhdGHqAg9LZXlodWI6OkFwcDo6VjFiBnByb3RvMw", [file_buf_validate_validate, file_keyhub_app_v1_common]);
+  fileDesc("ChprZXlodWIvYXBwL3YxL3RlbmFudC5wcm90bxINa2V5aHViLmFwcC52MSIvChpHZXRUZW5hbnRCeUpvaW5Db2RlUmVxdWVzdBIRCglqb2luX2NvZGUYASABKAkinwEKG0dldFRlbmFudEJ5Sm9pbkNvZGVSZXNwb25zZRIUCgJpZBgBIAEoCUIIukgFcgOwAQESDAoEbmFtZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRIuCgt0ZW5hbnRfdHlwZRgEIAEoDjIZLmtleWh1Yi5hcHAudjEuVGVuYW50VHlwZRIXCg90ZW5hbnRfdHlwZV9rZXkYBSABKAkiJgoRSm9pblRlbmFudFJlcXVlc3QSEQoJam9pbl9jb2RlGAEgASgJIkwKEkpvaW5UZW5hbnRSZXNwb25zZRIlCgZ0ZW5hbnQYASABKAsyFS5rZXlodWIuYXBwLnYxLlRlbmFudBIPCgdtZXNzYWdlGAIgASgJIs8BChNHZXRNeVRlbmFudHNSZXF1ZXN0Eh0KCXBhZ2Vfc2l6ZRgBIAEoBUIKukgHGgUYyAEoABISCgpwYWdlX3Rva2VuGAIgASgJEicKBW9yZGVyGAMgASgOMhgua2V5aHViLmFwcC52MS5MaXN0T3JkZXISLgoLdGVuYW50X3R5cGUYBCABKA4yGS5rZXlodWIuYXBwLnYxLlRlbmFudFR5cGUSEwoLbmFtZV9wcmVmaXgYBSABKAkSFwoPdGVuYW50X3R5cGVfa2V5GAYgASgJIlcKFEdldE15VGVuYW50c1Jlc3BvbnNlEiYKB3RlbmFudHMYASADKAsyFS5rZXlodWIuYXBwLnYxLlRlbmFudBIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkyswIKDVRlbmFudFNlcnZpY2UScQoTR2V0VGVuYW50QnlKb2luQ29kZRIpLmtleWh1Yi5hcHAudjEuR2V0VGVuYW50QnlKb2luQ29kZVJlcXVlc3QaKi5rZXlodWIuYXBwLnYxLkdldFRlbmFudEJ5Sm9pbkNvZGVSZXNwb25zZSIDkAIBElEKCkpvaW5UZW5hbnQSIC5rZXlodWIuYXBwLnYxLkpvaW5UZW5hbnRSZXF1ZXN0GiEua2V5aHViLmFwcC52MS5Kb2luVGVuYW50UmVzcG9uc2USXAoMR2V0TXlUZW5hbnRzEiIua2V5aHViLmFwcC52MS5HZXRNeVRlbmFudHNSZXF1ZXN0GiMua2V5aHViLmFwcC52MS5HZXRNeVRlbmFudHNSZXNwb25zZSIDkAIBQsMBChFjb20ua2V5aHViLmFwcC52MUILVGVuYW50UHJvdG9QAVpLZ2l0aHViLmNvbS9zaGliYXlhbWEtY2x1Yi9rZXlodWIvaW50ZXJuYWwvaW50ZXJmYWNlL2dlbi9rZXlodWIvYXBwL3YxO2FwcHYxogIDS0FYqgINS2V5aHViLkFwcC5WMcoCDUtleWh1YlxBcHBcVjHiAhlLZXlodWJcQXBwXFYxXEdQQk1ldGFkYXRh6gIPS2V5aHViOjpBcHA6OlYxYgZwcm90bzM", [file_buf_validate_validate, file_keyhub_app_v1_common]);
 
 /**
  * @generated from message keyhub.app.v1.GetTenantByJoinCodeRequest
@@ -55,6 +55,11 @@ export type GetTenantByJoinCodeResponse = Message<"keyhub.app.v1.GetTenantByJoin
    * @generated from field: keyhub.app.v1.TenantType tenant_type = 4;
    */
   tenantType: TenantType;
+
+  /**
+   * @generated from field: string tenant_type_key = 5;
+   */
+  tenantTypeKey: string;
 };
 
 /**
@@ -109,6 +114,45 @@ export const JoinTenantResponseSchema: GenMessage<JoinTenantResponse> = /*@__PUR
  * @generated from message keyhub.app.v1.GetMyTenantsRequest
  */
 export type GetMyTenantsRequest = Message<"keyhub.app.v1.GetMyTenantsRequest"> & {
+  /**
+   * 省略時50件
+   *
+   * @generated from field: int32 page_size = 1;
+   */
+  pageSize: number;
+
+  /**
+   * 前回のレスポンスの next_page_token。条件と並び順は前回と同じにする
+   *
+   * @generated from field: string page_token = 2;
+   */
+  pageToken: string;
+
+  /**
+   * @generated from field: keyhub.app.v1.ListOrder order = 3;
+   */
+  order: ListOrder;
+
+  /**
+   * 以下の条件は指定したものだけで絞り込む
+   *
+   * @generated from field: keyhub.app.v1.TenantType tenant_type = 4;
+   */
+  tenantType: TenantType;
+
+  /**
+   * テナント名の前方一致
+   *
+   * @generated from field: string name_prefix = 5;
+   */
+  namePrefix: string;
+
+  /**
+   * テナントタイプのキー。指定した場合は tenant_type より優先する
+   *
+   * @generated from field: string tenant_type_key = 6;
+   */
+  tenantTypeKey: string;
 };
 
 /**
@@ -126,6 +170,13 @@ export type GetMyTenantsResponse = Message<"keyhub.app.v1.GetMyTenantsResponse">
    * @generated from field: repeated keyhub.app.v1.Tenant tenants = 1;
    */
   tenants: Tenant[];
+
+  /**
+   * 続きがない場合は空
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
 };
 
 /**
@@ -0,0 +1,26 @@
+// @generated by protoc-gen-connect-query v2.2.0 with parameter "target=ts"
+// @generated from file keyhub/console/v1/api_token.proto (package keyhub.console.v1, syntax proto3)
+/* eslint-disable */
+
+import { ConsoleApiTokenService } from "./api_token_pb";
+
+/**
+ * APIトークン発行（トークン本体はこのレスポンスでのみ返す）
+ *
+ * @generated from rpc keyhub.console.v1.ConsoleApiTokenService.CreateApiToken
+ */
+export const createApiToken = ConsoleApiTokenService.method.createApiToken;
+
+/**
+ * 有効なAPIトークン一覧取得
+ *
+ * @generated from rpc keyhub.console.v1.ConsoleApiTokenService.ListApiTokens
+ */
+export const listApiTokens = ConsoleApiTokenService.method.listApiTokens;
+
+/**
+ * APIトークンの無効化
+ *
+ * @generated from rpc keyhub.console.v1.ConsoleApiTokenService.RevokeApiToken
+ */
+export const revokeApiToken = ConsoleApiTokenService.method.revokeApiToken;
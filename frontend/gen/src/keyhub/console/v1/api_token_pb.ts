@@ -0,0 +1,219 @@
+// @generated by protoc-gen-es v2.12.0 with parameter "target=ts"
+// @generated from file keyhub/console/v1/api_token.proto (package keyhub.console.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_buf_validate_validate } from "../../../buf/validate/validate_pb";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file keyhub/console/v1/api_token.proto.
+ */
+export const file_keyhub_console_v1_api_token: GenFile = /*@__PURE__*/
+  fileDesc("CiFrZXlodWIvY29uc29sZS92MS9hcGlfdG9rZW4ucHJvdG8SEWtleWh1Yi5jb25zb2xlLnYxIuYBCghBcGlUb2tlbhIUCgJpZBgBIAEoCUIIukgFcgOwAQESDAoEbmFtZRgCIAEoCRIUCgx0b2tlbl9wcmVmaXgYAyABKAkSDgoGc2NvcGVzGAQgAygJEi4KCmV4cGlyZXNfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjAKDGxhc3RfdXNlZF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKY3JlYXRlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiggEKFUNyZWF0ZUFwaVRva2VuUmVxdWVzdBIXCgRuYW1lGAEgASgJQgm6SAZyBBABGDISGAoGc2NvcGVzGAIgAygJQgi6SAWSAQIIARI2CgpleHBpcmVzX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIGukgDyAEBIlcKFkNyZWF0ZUFwaVRva2VuUmVzcG9uc2USLgoJYXBpX3Rva2VuGAEgASgLMhsua2V5aHViLmNvbnNvbGUudjEuQXBpVG9rZW4SDQoFdG9rZW4YAiABKAkiFgoUTGlzdEFwaVRva2Vuc1JlcXVlc3QiSAoVTGlzdEFwaVRva2Vuc1Jlc3BvbnNlEi8KCmFwaV90b2tlbnMYASADKAsyGy5rZXlodWIuY29uc29sZS52MS5BcGlUb2tlbiItChVSZXZva2VBcGlUb2tlblJlcXVlc3QSFAoCaWQYASABKAlCCLpIBXIDsAEBIhgKFlJldm9rZUFwaVRva2VuUmVzcG9uc2UyzwIKFkNvbnNvbGVBcGlUb2tlblNlcnZpY2USZQoOQ3JlYXRlQXBpVG9rZW4SKC5rZXlodWIuY29uc29sZS52MS5DcmVhdGVBcGlUb2tlblJlcXVlc3QaKS5rZXlodWIuY29uc29sZS52MS5DcmVhdGVBcGlUb2tlblJlc3BvbnNlEmcKDUxpc3RBcGlUb2tlbnMSJy5rZXlodWIuY29uc29sZS52MS5MaXN0QXBpVG9rZW5zUmVxdWVzdBooLmtleWh1Yi5jb25zb2xlLnYxLkxpc3RBcGlUb2tlbnNSZXNwb25zZSIDkAIBEmUKDlJldm9rZUFwaVRva2VuEigua2V5aHViLmNvbnNvbGUudjEuUmV2b2tlQXBpVG9rZW5SZXF1ZXN0Gikua2V5aHViLmNvbnNvbGUudjEuUmV2b2tlQXBpVG9rZW5SZXNwb25zZULhAQoVY29tLmtleWh1Yi5jb25zb2xlLnYxQg1BcGlUb2tlblByb3RvUAFaU2dpdGh1Yi5jb20vc2hpYmF5YW1hLWNsdWIva2V5aHViL2ludGVybmFsL2ludGVyZmFjZS9nZW4va2V5aHViL2NvbnNvbGUvdjE7Y29uc29sZXYxogIDS0NYqgIRS2V5aHViLkNvbnNvbGUuVjHKAhFLZXlodWJcQ29uc29sZVxWMeICHUtleWh1YlxDb25zb2xlXFYxXEdQQk1ldGFkYXRh6gITS2V5aHViOjpDb25zb2xlOjpWMWIGcHJvdG8z", [file_buf_validate_validate, file_google_protobuf_timestamp]);
+
+/**
+ * @generated from message keyhub.console.v1.ApiToken
+ */
+export type ApiToken = Message<"keyhub.console.v1.ApiToken"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * トークンの先頭部分（識別用）
+   *
+   * @generated from field: string token_prefix = 3;
+   */
+  tokenPrefix: string;
+
+  /**
+   * 例: "keys:read", "rooms:write"
+   *
+   * @generated from field: repeated string scopes = 4;
+   */
+  scopes: string[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 5;
+   */
+  expiresAt?: Timestamp | undefined;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_used_at = 6;
+   */
+  lastUsedAt?: Timestamp | undefined;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message keyhub.console.v1.ApiToken.
+ * Use `create(ApiTokenSchema)` to create a new message.
+ */
+export const ApiTokenSchema: GenMessage<ApiToken> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_api_token, 0);
+
+/**
+ * @generated from message keyhub.console.v1.CreateApiTokenRequest
+ */
+export type CreateApiTokenRequest = Message<"keyhub.console.v1.CreateApiTokenRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: repeated string scopes = 2;
+   */
+  scopes: string[];
+
+  /**
+   * 最長1年
+   *
+   * @generated from field: google.protobuf.Timestamp expires_at = 3;
+   */
+  expiresAt?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message keyhub.console.v1.CreateApiTokenRequest.
+ * Use `create(CreateApiTokenRequestSchema)` to create a new message.
+ */
+export const CreateApiTokenRequestSchema: GenMessage<CreateApiTokenRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_api_token, 1);
+
+/**
+ * @generated from message keyhub.console.v1.CreateApiTokenResponse
+ */
+export type CreateApiTokenResponse = Message<"keyhub.console.v1.CreateApiTokenResponse"> & {
+  /**
+   * @generated from field: keyhub.console.v1.ApiToken api_token = 1;
+   */
+  apiToken?: ApiToken | undefined;
+
+  /**
+   * @generated from field: string token = 2;
+   */
+  token: string;
+};
+
+/**
+ * Describes the message keyhub.console.v1.CreateApiTokenResponse.
+ * Use `create(CreateApiTokenResponseSchema)` to create a new message.
+ */
+export const CreateApiTokenResponseSchema: GenMessage<CreateApiTokenResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_api_token, 2);
+
+/**
+ * @generated from message keyhub.console.v1.ListApiTokensRequest
+ */
+export type ListApiTokensRequest = Message<"keyhub.console.v1.ListApiTokensRequest"> & {
+};
+
+/**
+ * Describes the message keyhub.console.v1.ListApiTokensRequest.
+ * Use `create(ListApiTokensRequestSchema)` to create a new message.
+ */
+export const ListApiTokensRequestSchema: GenMessage<ListApiTokensRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_api_token, 3);
+
+/**
+ * @generated from message keyhub.console.v1.ListApiTokensResponse
+ */
+export type ListApiTokensResponse = Message<"keyhub.console.v1.ListApiTokensResponse"> & {
+  /**
+   * @generated from field: repeated keyhub.console.v1.ApiToken api_tokens = 1;
+   */
+  apiTokens: ApiToken[];
+};
+
+/**
+ * Describes the message keyhub.console.v1.ListApiTokensResponse.
+ * Use `create(ListApiTokensResponseSchema)` to create a new message.
+ */
+export const ListApiTokensResponseSchema: GenMessage<ListApiTokensResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_api_token, 4);
+
+/**
+ * @generated from message keyhub.console.v1.RevokeApiTokenRequest
+ */
+export type RevokeApiTokenRequest = Message<"keyhub.console.v1.RevokeApiTokenRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message keyhub.console.v1.RevokeApiTokenRequest.
+ * Use `create(RevokeApiTokenRequestSchema)` to create a new message.
+ */
+export const RevokeApiTokenRequestSchema: GenMessage<RevokeApiTokenRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_api_token, 5);
+
+/**
+ * @generated from message keyhub.console.v1.RevokeApiTokenResponse
+ */
+export type RevokeApiTokenResponse = Message<"keyhub.console.v1.RevokeApiTokenResponse"> & {
+};
+
+/**
+ * Describes the message keyhub.console.v1.RevokeApiTokenResponse.
+ * Use `create(RevokeApiTokenResponseSchema)` to create a new message.
+ */
+export const RevokeApiTokenResponseSchema: GenMessage<RevokeApiTokenResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_api_token, 6);
+
+/**
+ * 組織がスクリプトや端末から Console API を呼び出すためのAPIトークン管理
+ * 発行したトークンは Authorization: Bearer <token> で利用する
+ *
+ * @generated from service keyhub.console.v1.ConsoleApiTokenService
+ */
+export const ConsoleApiTokenService: GenService<{
+  /**
+   * APIトークン発行（トークン本体はこのレスポンスでのみ返す）
+   *
+   * @generated from rpc keyhub.console.v1.ConsoleApiTokenService.CreateApiToken
+   */
+  createApiToken: {
+    methodKind: "unary";
+    input: typeof CreateApiTokenRequestSchema;
+    output: typeof CreateApiTokenResponseSchema;
+  },
+  /**
+   * 有効なAPIトークン一覧取得
+   *
+   * @generated from rpc keyhub.console.v1.ConsoleApiTokenService.ListApiTokens
+   */
+  listApiTokens: {
+    methodKind: "unary";
+    input: typeof ListApiTokensRequestSchema;
+    output: typeof ListApiTokensResponseSchema;
+  },
+  /**
+   * APIトークンの無効化
+   *
+   * @generated from rpc keyhub.console.v1.ConsoleApiTokenService.RevokeApiToken
+   */
+  revokeApiToken: {
+    methodKind: "unary";
+    input: typeof RevokeApiTokenRequestSchema;
+    output: typeof RevokeApiTokenResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_keyhub_console_v1_api_token, 0);
+
@@ -0,0 +1,12 @@
+// @generated by protoc-gen-connect-query v2.2.0 with parameter "target=ts"
+// @generated from file keyhub/console/v1/audit.proto (package keyhub.console.v1, syntax proto3)
+/* eslint-disable */
+
+import { ConsoleAuditService } from "./audit_pb";
+
+/**
+ * 監査ログ検索（新しい順）
+ *
+ * @generated from rpc keyhub.console.v1.ConsoleAuditService.SearchAuditLogs
+ */
+export const searchAuditLogs = ConsoleAuditService.method.searchAuditLogs;
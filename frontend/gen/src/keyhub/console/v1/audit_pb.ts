@@ -0,0 +1,200 @@
+// @generated by protoc-gen-es v2.12.0 with parameter "target=ts"
+// @generated from file keyhub/console/v1/audit.proto (package keyhub.console.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_buf_validate_validate } from "../../../buf/validate/validate_pb";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file keyhub/console/v1/audit.proto.
+ */
+export const file_keyhub_console_v1_audit: GenFile = /*@__PURE__*/
+  fileDesc("Ch1rZXlodWIvY29uc29sZS92MS9hdWRpdC5wcm90bxIRa2V5aHViLmNvbnNvbGUudjEizAIKCEF1ZGl0TG9nEhQKAmlkGAEgASgJQgi6SAVyA7ABARISCgphY3Rvcl90eXBlGAIgASgJEhAKCGFjdG9yX2lkGAMgASgJEhEKCXByb2NlZHVyZRgEIAEoCRI+Cgp0YXJnZXRfaWRzGAUgAygLMioua2V5aHViLmNvbnNvbGUudjEuQXVkaXRMb2cuVGFyZ2V0SWRzRW50cnkSEwoLcmVzdWx0X2NvZGUYBiABKAkSEgoKcmVxdWVzdF9pZBgHIAEoCRISCgppcF9hZGRyZXNzGAggASgJEhIKCnVzZXJfYWdlbnQYCSABKAkSLgoKY3JlYXRlZF9hdBgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAaMAoOVGFyZ2V0SWRzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASKCAgoWU2VhcmNoQXVkaXRMb2dzUmVxdWVzdBISCgphY3Rvcl90eXBlGAEgASgJEhAKCGFjdG9yX2lkGAIgASgJEhEKCXByb2NlZHVyZRgDIAEoCRITCgtyZXN1bHRfY29kZRgEIAEoCRIRCgl0YXJnZXRfaWQYBSABKAkSKQoFc2luY2UYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEikKBXVudGlsGAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIdCglwYWdlX3NpemUYCCABKAVCCrpIBxoFGMgBKAASEgoKcGFnZV90b2tlbhgJIAEoCSJjChdTZWFyY2hBdWRpdExvZ3NSZXNwb25zZRIvCgphdWRpdF9sb2dzGAEgAygLMhsua2V5aHViLmNvbnNvbGUudjEuQXVkaXRMb2cSFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJMoQBChNDb25zb2xlQXVkaXRTZXJ2aWNlEm0KD1NlYXJjaEF1ZGl0TG9ncxIpLmtleWh1Yi5jb25zb2xlLnYxLlNlYXJjaEF1ZGl0TG9nc1JlcXVlc3QaKi5rZXlodWIuY29uc29sZS52MS5TZWFyY2hBdWRpdExvZ3NSZXNwb25zZSIDkAIBQt4BChVjb20ua2V5aHViLmNvbnNvbGUudjFCCkF1ZGl0UHJvdG9QAVpTZ2l0aHViLmNvbS9zaGliYXlhbWEtY2x1Yi9rZXlodWIvaW50ZXJuYWwvaW50ZXJmYWNlL2dlbi9rZXlodWIvY29uc29sZS92MTtjb25zb2xldjGiAgNLQ1iqAhFLZXlodWIuQ29uc29sZS5WMcoCEUtleWh1YlxDb25zb2xlXFYx4gIdS2V5aHViXENvbnNvbGVcVjFcR1BCTWV0YWRhdGHqAhNLZXlodWI6OkNvbnNvbGU6OlYxYgZwcm90bzM", [file_buf_validate_validate, file_google_protobuf_timestamp]);
+
+/**
+ * @generated from message keyhub.console.v1.AuditLog
+ */
+export type AuditLog = Message<"keyhub.console.v1.AuditLog"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * "user" | "console_owner" | "console_operator" | "api_token" | "anonymous"
+   *
+   * @generated from field: string actor_type = 2;
+   */
+  actorType: string;
+
+  /**
+   * @generated from field: string actor_id = 3;
+   */
+  actorId: string;
+
+  /**
+   * 例: "/keyhub.console.v1.ConsoleService/CreateTenant"
+   *
+   * @generated from field: string procedure = 4;
+   */
+  procedure: string;
+
+  /**
+   * 例: {"tenant_id": "..."}
+   *
+   * @generated from field: map<string, string> target_ids = 5;
+   */
+  targetIds: { [key: string]: string };
+
+  /**
+   * "ok" またはConnectのエラーコード（例: "permission_denied"）
+   *
+   * @generated from field: string result_code = 6;
+   */
+  resultCode: string;
+
+  /**
+   * @generated from field: string request_id = 7;
+   */
+  requestId: string;
+
+  /**
+   * @generated from field: string ip_address = 8;
+   */
+  ipAddress: string;
+
+  /**
+   * @generated from field: string user_agent = 9;
+   */
+  userAgent: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 10;
+   */
+  createdAt?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message keyhub.console.v1.AuditLog.
+ * Use `create(AuditLogSchema)` to create a new message.
+ */
+export const AuditLogSchema: GenMessage<AuditLog> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_audit, 0);
+
+/**
+ * @generated from message keyhub.console.v1.SearchAuditLogsRequest
+ */
+export type SearchAuditLogsRequest = Message<"keyhub.console.v1.SearchAuditLogsRequest"> & {
+  /**
+   * 以下の条件は指定したものだけで絞り込む
+   *
+   * @generated from field: string actor_type = 1;
+   */
+  actorType: string;
+
+  /**
+   * @generated from field: string actor_id = 2;
+   */
+  actorId: string;
+
+  /**
+   * @generated from field: string procedure = 3;
+   */
+  procedure: string;
+
+  /**
+   * @generated from field: string result_code = 4;
+   */
+  resultCode: string;
+
+  /**
+   * target_ids のいずれかの値と一致する記録
+   *
+   * @generated from field: string target_id = 5;
+   */
+  targetId: string;
+
+  /**
+   * この日時以降
+   *
+   * @generated from field: google.protobuf.Timestamp since = 6;
+   */
+  since?: Timestamp | undefined;
+
+  /**
+   * この日時より前
+   *
+   * @generated from field: google.protobuf.Timestamp until = 7;
+   */
+  until?: Timestamp | undefined;
+
+  /**
+   * 省略時50件
+   *
+   * @generated from field: int32 page_size = 8;
+   */
+  pageSize: number;
+
+  /**
+   * 前回のレスポンスの next_page_token
+   *
+   * @generated from field: string page_token = 9;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message keyhub.console.v1.SearchAuditLogsRequest.
+ * Use `create(SearchAuditLogsRequestSchema)` to create a new message.
+ */
+export const SearchAuditLogsRequestSchema: GenMessage<SearchAuditLogsRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_audit, 1);
+
+/**
+ * @generated from message keyhub.console.v1.SearchAuditLogsResponse
+ */
+export type SearchAuditLogsResponse = Message<"keyhub.console.v1.SearchAuditLogsResponse"> & {
+  /**
+   * @generated from field: repeated keyhub.console.v1.AuditLog audit_logs = 1;
+   */
+  auditLogs: AuditLog[];
+
+  /**
+   * 続きがない場合は空
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message keyhub.console.v1.SearchAuditLogsResponse.
+ * Use `create(SearchAuditLogsResponseSchema)` to create a new message.
+ */
+export const SearchAuditLogsResponseSchema: GenMessage<SearchAuditLogsResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_audit, 2);
+
+/**
+ * 組織の更新系RPCの呼び出し履歴（監査ログ）の参照
+ * 記録は App API・Console API のインターセプターが行い、このサービスからは変更できない
+ *
+ * @generated from service keyhub.console.v1.ConsoleAuditService
+ */
+export const ConsoleAuditService: GenService<{
+  /**
+   * 監査ログ検索（新しい順）
+   *
+   * @generated from rpc keyhub.console.v1.ConsoleAuditService.SearchAuditLogs
+   */
+  searchAuditLogs: {
+    methodKind: "unary";
+    input: typeof SearchAuditLogsRequestSchema;
+    output: typeof SearchAuditLogsResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_keyhub_console_v1_audit, 0);
+
@@ -17,3 +17,31 @@ export const loginWithOrgId = ConsoleAuthService.method.loginWithOrgId;
  * @generated from rpc keyhub.console.v1.ConsoleAuthService.Logout
  */
 export const logout = ConsoleAuthService.method.logout;
+
+/**
+ * 組織のログイン中のセッション一覧取得
+ *
+ * @generated from rpc keyhub.console.v1.ConsoleAuthService.ListSessions
+ */
+export const listSessions = ConsoleAuthService.method.listSessions;
+
+/**
+ * 指定したセッションを無効化
+ *
+ * @generated from rpc keyhub.console.v1.ConsoleAuthService.RevokeSession
+ */
+export const revokeSession = ConsoleAuthService.method.revokeSession;
+
+/**
+ * 現在のセッション以外をすべて無効化
+ *
+ * @generated from rpc keyhub.console.v1.ConsoleAuthService.RevokeAllOtherSessions
+ */
+export const revokeAllOtherSessions = ConsoleAuthService.method.revokeAllOtherSessions;
+
+/**
+ * ログイン中の組織情報取得
+ *
+ * @generated from rpc keyhub.console.v1.ConsoleAuthService.GetCurrentOrganization
+ */
+export const getCurrentOrganization = ConsoleAuthService.method.getCurrentOrganization;
@@ -5,13 +5,17 @@
 import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
 import { file_buf_validate_validate } from "../../../buf/validate/validate_pb";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Organization } from "./organization_pb";
+import { file_keyhub_console_v1_organization } from "./organization_pb";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file keyhub/console/v1/auth.proto.
  */
 export const file_keyhub_console_v1_auth: GenFile = /*@__PURE__*/
-  fileDesc("ChxrZXlodWIvY29uc29sZS92MS9hdXRoLnByb3RvEhFrZXlodWIuY29uc29sZS52MSJUChVMb2dpbldpdGhPcmdJZFJlcXVlc3QSIQoPb3JnYW5pemF0aW9uX2lkGAEgASgJQgi6SAVyA7ABARIYChBvcmdhbml6YXRpb25fa2V5GAIgASgJIkMKFkxvZ2luV2l0aE9yZ0lkUmVzcG9uc2USFQoNc2Vzc2lvbl90b2tlbhgBIAEoCRISCgpleHBpcmVzX2luGAIgASgDIg8KDUxvZ291dFJlcXVlc3QiIQoOTG9nb3V0UmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCDLKAQoSQ29uc29sZUF1dGhTZXJ2aWNlEmUKDkxvZ2luV2l0aE9yZ0lkEigua2V5aHViLmNvbnNvbGUudjEuTG9naW5XaXRoT3JnSWRSZXF1ZXN0Gikua2V5aHViLmNvbnNvbGUudjEuTG9naW5XaXRoT3JnSWRSZXNwb25zZRJNCgZMb2dvdXQSIC5rZXlodWIuY29uc29sZS52MS5Mb2dvdXRSZXF1ZXN0GiEua2V5aHViLmNvbnNvbGUudjEuTG9nb3V0UmVzcG9uc2VC3QEKFWNvbS5rZXlodWIuY29uc29sZS52MUIJQXV0aFByb3RvUAFaU2dpdGh1Yi5jb20vc2hpYmF5YW1hLWNsdWIva2V5aHViL2ludGVybmFsL2ludGVyZmFjZS9nZW4va2V5aHViL2NvbnNvbGUvdjE7Y29uc29sZXYxogIDS0NYqgIRS2V5aHViLkNvbnNvbGUuVjHKAhFLZXlodWJcQ29uc29sZVxWMeICHUtleWh1YlxDb25zb2xlXFYxXEdQQk1ldGFkYXRh6gITS2V5aHViOjpDb25zb2xlOjpWMWIGcHJvdG8z", [file_buf_validate_validate]);
+  fileDesc("ChxrZXlodWIvY29uc29sZS92MS9hdXRoLnByb3RvEhFrZXlodWIuY29uc29sZS52MSJUChVMb2dpbldpdGhPcmdJZFJlcXVlc3QSIQoPb3JnYW5pemF0aW9uX2lkGAEgASgJQgi6SAVyA7ABARIYChBvcmdhbml6YXRpb25fa2V5GAIgASgJIkMKFkxvZ2luV2l0aE9yZ0lkUmVzcG9uc2USFQoNc2Vzc2lvbl90b2tlbhgBIAEoCRISCgpleHBpcmVzX2luGAIgASgDIg8KDUxvZ291dFJlcXVlc3QiIQoOTG9nb3V0UmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCLgAQoHU2Vzc2lvbhIKCgJpZBgBIAEoCRISCgp1c2VyX2FnZW50GAIgASgJEhIKCmlwX2FkZHJlc3MYAyABKAkSLgoKY3JlYXRlZF9hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMAoMbGFzdF9zZWVuX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpleHBpcmVzX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIPCgdjdXJyZW50GAcgASgIIhUKE0xpc3RTZXNzaW9uc1JlcXVlc3QiRAoUTGlzdFNlc3Npb25zUmVzcG9uc2USLAoIc2Vzc2lvbnMYASADKAsyGi5rZXlodWIuY29uc29sZS52MS5TZXNzaW9uIjMKFFJldm9rZVNlc3Npb25SZXF1ZXN0EhsKCnNlc3Npb25faWQYASABKAlCB7pIBHICEAEiFwoVUmV2b2tlU2Vzc2lvblJlc3BvbnNlIh8KHVJldm9rZUFsbE90aGVyU2Vzc2lvbnNSZXF1ZXN0IjcKHlJldm9rZUFsbE90aGVyU2Vzc2lvbnNSZXNwb25zZRIVCg1yZXZva2VkX2NvdW50GAEgASgDIh8KHUdldEN1cnJlbnRPcmdhbml6YXRpb25SZXF1ZXN0InoKHkdldEN1cnJlbnRPcmdhbml6YXRpb25SZXNwb25zZRI1Cgxvcmdhbml6YXRpb24YASABKAsyHy5rZXlodWIuY29uc29sZS52MS5Pcmdhbml6YXRpb24SDAoEcm9sZRgCIAEoCRITCgtwZXJtaXNzaW9ucxgDIAMoCTKTBQoSQ29uc29sZUF1dGhTZXJ2aWNlEmUKDkxvZ2luV2l0aE9yZ0lkEigua2V5aHViLmNvbnNvbGUudjEuTG9naW5XaXRoT3JnSWRSZXF1ZXN0Gikua2V5aHViLmNvbnNvbGUudjEuTG9naW5XaXRoT3JnSWRSZXNwb25zZRJNCgZMb2dvdXQSIC5rZXlodWIuY29uc29sZS52MS5Mb2dvdXRSZXF1ZXN0GiEua2V5aHViLmNvbnNvbGUudjEuTG9nb3V0UmVzcG9uc2USXwoMTGlzdFNlc3Npb25zEiYua2V5aHViLmNvbnNvbGUudjEuTGlzdFNlc3Npb25zUmVxdWVzdBonLmtleWh1Yi5jb25zb2xlLnYxLkxpc3RTZXNzaW9uc1Jlc3BvbnNlEmIKDVJldm9rZVNlc3Npb24SJy5rZXlodWIuY29uc29sZS52MS5SZXZva2VTZXNzaW9uUmVxdWVzdBooLmtleWh1Yi5jb25zb2xlLnYxLlJldm9rZVNlc3Npb25SZXNwb25zZRJ9ChZSZXZva2VBbGxPdGhlclNlc3Npb25zEjAua2V5aHViLmNvbnNvbGUudjEuUmV2b2tlQWxsT3RoZXJTZXNzaW9uc1JlcXVlc3QaMS5rZXlodWIuY29uc29sZS52MS5SZXZva2VBbGxPdGhlclNlc3Npb25zUmVzcG9uc2USggEKFkdldEN1cnJlbnRPcmdhbml6YXRpb24SMC5rZXlodWIuY29uc29sZS52MS5HZXRDdXJyZW50T3JnYW5pemF0aW9uUmVxdWVzdBoxLmtleWh1Yi5jb25zb2xlLnYxLkdldEN1cnJlbnRPcmdhbml6YXRpb25SZXNwb25zZSIDkAIBQt0BChVjb20ua2V5aHViLmNvbnNvbGUudjFCCUF1dGhQcm90b1ABWlNnaXRodWIuY29tL3NoaWJheWFtYS1jbHViL2tleWh1Yi9pbnRlcm5hbC9pbnRlcmZhY2UvZ2VuL2tleWh1Yi9jb25zb2xlL3YxO2NvbnNvbGV2MaICA0tDWKoCEUtleWh1Yi5Db25zb2xlLlYxygIRS2V5aHViXENvbnNvbGVcVjHiAh1LZXlodWJcQ29uc29sZVxWMVxHUEJNZXRhZGF0YeoCE0tleWh1Yjo6Q29uc29sZTo6VjFiBnByb3RvMw", [file_buf_validate_validate, file_google_protobuf_timestamp, file_keyhub_console_v1_organization]);
 
 /**
  * @generated from message keyhub.console.v1.LoginWithOrgIdRequest
@@ -93,6 +97,189 @@ export type LogoutResponse = Message<"keyhub.console.v1.LogoutResponse"> & {
 export const LogoutResponseSchema: GenMessage<LogoutResponse> = /*@__PURE__*/
   messageDesc(file_keyhub_console_v1_auth, 3);
 
+/**
+ * @generated from message keyhub.console.v1.Session
+ */
+export type Session = Message<"keyhub.console.v1.Session"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string user_agent = 2;
+   */
+  userAgent: string;
+
+  /**
+   * @generated from field: string ip_address = 3;
+   */
+  ipAddress: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 4;
+   */
+  createdAt?: Timestamp | undefined;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_seen_at = 5;
+   */
+  lastSeenAt?: Timestamp | undefined;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 6;
+   */
+  expiresAt?: Timestamp | undefined;
+
+  /**
+   * リクエスト元のセッションかどうか
+   *
+   * @generated from field: bool current = 7;
+   */
+  current: boolean;
+};
+
+/**
+ * Describes the message keyhub.console.v1.Session.
+ * Use `create(SessionSchema)` to create a new message.
+ */
+export const SessionSchema: GenMessage<Session> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_auth, 4);
+
+/**
+ * @generated from message keyhub.console.v1.ListSessionsRequest
+ */
+export type ListSessionsRequest = Message<"keyhub.console.v1.ListSessionsRequest"> & {
+};
+
+/**
+ * Describes the message keyhub.console.v1.ListSessionsRequest.
+ * Use `create(ListSessionsRequestSchema)` to create a new message.
+ */
+export const ListSessionsRequestSchema: GenMessage<ListSessionsRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_auth, 5);
+
+/**
+ * @generated from message keyhub.console.v1.ListSessionsResponse
+ */
+export type ListSessionsResponse = Message<"keyhub.console.v1.ListSessionsResponse"> & {
+  /**
+   * @generated from field: repeated keyhub.console.v1.Session sessions = 1;
+   */
+  sessions: Session[];
+};
+
+/**
+ * Describes the message keyhub.console.v1.ListSessionsResponse.
+ * Use `create(ListSessionsResponseSchema)` to create a new message.
+ */
+export const ListSessionsResponseSchema: GenMessage<ListSessionsResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_auth, 6);
+
+/**
+ * @generated from message keyhub.console.v1.RevokeSessionRequest
+ */
+export type RevokeSessionRequest = Message<"keyhub.console.v1.RevokeSessionRequest"> & {
+  /**
+   * @generated from field: string session_id = 1;
+   */
+  sessionId: string;
+};
+
+/**
+ * Describes the message keyhub.console.v1.RevokeSessionRequest.
+ * Use `create(RevokeSessionRequestSchema)` to create a new message.
+ */
+export const RevokeSessionRequestSchema: GenMessage<RevokeSessionRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_auth, 7);
+
+/**
+ * @generated from message keyhub.console.v1.RevokeSessionResponse
+ */
+export type RevokeSessionResponse = Message<"keyhub.console.v1.RevokeSessionResponse"> & {
+};
+
+/**
+ * Describes the message keyhub.console.v1.RevokeSessionResponse.
+ * Use `create(RevokeSessionResponseSchema)` to create a new message.
+ */
+export const RevokeSessionResponseSchema: GenMessage<RevokeSessionResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_auth, 8);
+
+/**
+ * @generated from message keyhub.console.v1.RevokeAllOtherSessionsRequest
+ */
+export type RevokeAllOtherSessionsRequest = Message<"keyhub.console.v1.RevokeAllOtherSessionsRequest"> & {
+};
+
+/**
+ * Describes the message keyhub.console.v1.RevokeAllOtherSessionsRequest.
+ * Use `create(RevokeAllOtherSessionsRequestSchema)` to create a new message.
+ */
+export const RevokeAllOtherSessionsRequestSchema: GenMessage<RevokeAllOtherSessionsRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_auth, 9);
+
+/**
+ * @generated from message keyhub.console.v1.RevokeAllOtherSessionsResponse
+ */
+export type RevokeAllOtherSessionsResponse = Message<"keyhub.console.v1.RevokeAllOtherSessionsResponse"> & {
+  /**
+   * @generated from field: int64 revoked_count = 1;
+   */
+  revokedCount: bigint;
+};
+
+/**
+ * Describes the message keyhub.console.v1.RevokeAllOtherSessionsResponse.
+ * Use `create(RevokeAllOtherSessionsResponseSchema)` to create a new message.
+ */
+export const RevokeAllOtherSessionsResponseSchema: GenMessage<RevokeAllOtherSessionsResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_auth, 10);
+
+/**
+ * @generated from message keyhub.console.v1.GetCurrentOrganizationRequest
+ */
+export type GetCurrentOrganizationRequest = Message<"keyhub.console.v1.GetCurrentOrganizationRequest"> & {
+};
+
+/**
+ * Describes the message keyhub.console.v1.GetCurrentOrganizationRequest.
+ * Use `create(GetCurrentOrganizationRequestSchema)` to create a new message.
+ */
+export const GetCurrentOrganizationRequestSchema: GenMessage<GetCurrentOrganizationRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_auth, 11);
+
+/**
+ * @generated from message keyhub.console.v1.GetCurrentOrganizationResponse
+ */
+export type GetCurrentOrganizationResponse = Message<"keyhub.console.v1.GetCurrentOrganizationResponse"> & {
+  /**
+   * @generated from field: keyhub.console.v1.Organization organization = 1;
+   */
+  organization?: Organization | undefined;
+
+  /**
+   * ログイン中のセッションのロール。APIトークンの場合は空
+   *
+   * @generated from field: string role = 2;
+   */
+  role: string;
+
+  /**
+   * ロールに許可された権限（例: "rooms.manage"）
+   *
+   * @generated from field: repeated string permissions = 3;
+   */
+  permissions: string[];
+};
+
+/**
+ * Describes the message keyhub.console.v1.GetCurrentOrganizationResponse.
+ * Use `create(GetCurrentOrganizationResponseSchema)` to create a new message.
+ */
+export const GetCurrentOrganizationResponseSchema: GenMessage<GetCurrentOrganizationResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_auth, 12);
+
 /**
  * @generated from service keyhub.console.v1.ConsoleAuthService
  */
@@ -117,6 +304,46 @@ export const ConsoleAuthService: GenService<{
     input: typeof LogoutRequestSchema;
     output: typeof LogoutResponseSchema;
   },
+  /**
+   * 組織のログイン中のセッション一覧取得
+   *
+   * @generated from rpc keyhub.console.v1.ConsoleAuthService.ListSessions
+   */
+  listSessions: {
+    methodKind: "unary";
+    input: typeof ListSessionsRequestSchema;
+    output: typeof ListSessionsResponseSchema;
+  },
+  /**
+   * 指定したセッションを無効化
+   *
+   * @generated from rpc keyhub.console.v1.ConsoleAuthService.RevokeSession
+   */
+  revokeSession: {
+    methodKind: "unary";
+    input: typeof RevokeSessionRequestSchema;
+    output: typeof RevokeSessionResponseSchema;
+  },
+  /**
+   * 現在のセッション以外をすべて無効化
+   *
+   * @generated from rpc keyhub.console.v1.ConsoleAuthService.RevokeAllOtherSessions
+   */
+  revokeAllOtherSessions: {
+    methodKind: "unary";
+    input: typeof RevokeAllOtherSessionsRequestSchema;
+    output: typeof RevokeAllOtherSessionsResponseSchema;
+  },
+  /**
+   * ログイン中の組織情報取得
+   *
+   * @generated from rpc keyhub.console.v1.ConsoleAuthService.GetCurrentOrganization
+   */
+  getCurrentOrganization: {
+    methodKind: "unary";
+    input: typeof GetCurrentOrganizationRequestSchema;
+    output: typeof GetCurrentOrganizationResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_keyhub_console_v1_auth, 0);
 
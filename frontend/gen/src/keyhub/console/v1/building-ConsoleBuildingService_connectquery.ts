@@ -0,0 +1,54 @@
+// @generated by protoc-gen-connect-query v2.2.0 with parameter "target=ts"
+// @generated from file keyhub/console/v1/building.proto (package keyhub.console.v1, syntax proto3)
+/* eslint-disable */
+
+import { ConsoleBuildingService } from "./building_pb";
+
+/**
+ * 建物作成（名前は組織内で一意）
+ *
+ * @generated from rpc keyhub.console.v1.ConsoleBuildingService.CreateBuilding
+ */
+export const createBuilding = ConsoleBuildingService.method.createBuilding;
+
+/**
+ * 建物と階の一覧取得（建物は名前順、階は level の昇順）
+ *
+ * @generated from rpc keyhub.console.v1.ConsoleBuildingService.ListBuildings
+ */
+export const listBuildings = ConsoleBuildingService.method.listBuildings;
+
+/**
+ * 建物の名前・説明を更新（部屋の建物名にも反映される）
+ *
+ * @generated from rpc keyhub.console.v1.ConsoleBuildingService.UpdateBuilding
+ */
+export const updateBuilding = ConsoleBuildingService.method.updateBuilding;
+
+/**
+ * 建物削除（階が残っている建物は削除できない）
+ *
+ * @generated from rpc keyhub.console.v1.ConsoleBuildingService.DeleteBuilding
+ */
+export const deleteBuilding = ConsoleBuildingService.method.deleteBuilding;
+
+/**
+ * 階作成（名前は建物内で一意）
+ *
+ * @generated from rpc keyhub.console.v1.ConsoleBuildingService.CreateFloor
+ */
+export const createFloor = ConsoleBuildingService.method.createFloor;
+
+/**
+ * 階の名前・並び順を更新（部屋の階にも反映される）
+ *
+ * @generated from rpc keyhub.console.v1.ConsoleBuildingService.UpdateFloor
+ */
+export const updateFloor = ConsoleBuildingService.method.updateFloor;
+
+/**
+ * 階削除（部屋が残っている階は削除できない）
+ *
+ * @generated from rpc keyhub.console.v1.ConsoleBuildingService.DeleteFloor
+ */
+export const deleteFloor = ConsoleBuildingService.method.deleteFloor;
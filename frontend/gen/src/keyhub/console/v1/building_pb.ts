@@ -0,0 +1,453 @@
+// @generated by protoc-gen-es v2.12.0 with parameter "target=ts"
+// @generated from file keyhub/console/v1/building.proto (package keyhub.console.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_buf_validate_validate } from "../../../buf/validate/validate_pb";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file keyhub/console/v1/building.proto.
+ */
+export const file_keyhub_console_v1_building: GenFile = /*@__PURE__*/
+  fileDesc("CiBrZXlodWIvY29uc29sZS92MS9idWlsZGluZy5wcm90bxIRa2V5aHViLmNvbnNvbGUudjEicwoIQnVpbGRpbmcSFAoCaWQYASABKAlCCLpIBXIDsAEBEgwKBG5hbWUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSLgoKY3JlYXRlZF9hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiiQEKBUZsb29yEhQKAmlkGAEgASgJQgi6SAVyA7ABARIdCgtidWlsZGluZ19pZBgCIAEoCUIIukgFcgOwAQESDAoEbmFtZRgDIAEoCRINCgVsZXZlbBgEIAEoBRIuCgpjcmVhdGVkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJpCg5CdWlsZGluZ0Zsb29ycxItCghidWlsZGluZxgBIAEoCzIbLmtleWh1Yi5jb25zb2xlLnYxLkJ1aWxkaW5nEigKBmZsb29ycxgCIAMoCzIYLmtleWh1Yi5jb25zb2xlLnYxLkZsb29yIk8KFUNyZWF0ZUJ1aWxkaW5nUmVxdWVzdBIXCgRuYW1lGAEgASgJQgm6SAZyBBABGBQSHQoLZGVzY3JpcHRpb24YAiABKAlCCLpIBXIDGKwCIkcKFkNyZWF0ZUJ1aWxkaW5nUmVzcG9uc2USLQoIYnVpbGRpbmcYASABKAsyGy5rZXlodWIuY29uc29sZS52MS5CdWlsZGluZyIWChRMaXN0QnVpbGRpbmdzUmVxdWVzdCJNChVMaXN0QnVpbGRpbmdzUmVzcG9uc2USNAoJYnVpbGRpbmdzGAEgAygLMiEua2V5aHViLmNvbnNvbGUudjEuQnVpbGRpbmdGbG9vcnMiZQoVVXBkYXRlQnVpbGRpbmdSZXF1ZXN0EhQKAmlkGAEgASgJQgi6SAVyA7ABARIXCgRuYW1lGAIgASgJQgm6SAZyBBABGBQSHQoLZGVzY3JpcHRpb24YAyABKAlCCLpIBXIDGKwCIkcKFlVwZGF0ZUJ1aWxkaW5nUmVzcG9uc2USLQoIYnVpbGRpbmcYASABKAsyGy5rZXlodWIuY29uc29sZS52MS5CdWlsZGluZyItChVEZWxldGVCdWlsZGluZ1JlcXVlc3QSFAoCaWQYASABKAlCCLpIBXIDsAEBIhgKFkRlbGV0ZUJ1aWxkaW5nUmVzcG9uc2UiWwoSQ3JlYXRlRmxvb3JSZXF1ZXN0Eh0KC2J1aWxkaW5nX2lkGAEgASgJQgi6SAVyA7ABARIXCgRuYW1lGAIgASgJQgm6SAZyBBABGAoSDQoFbGV2ZWwYAyABKAUiPgoTQ3JlYXRlRmxvb3JSZXNwb25zZRInCgVmbG9vchgBIAEoCzIYLmtleWh1Yi5jb25zb2xlLnYxLkZsb29yIlIKElVwZGF0ZUZsb29yUmVxdWVzdBIUCgJpZBgBIAEoCUIIukgFcgOwAQESFwoEbmFtZRgCIAEoCUIJukgGcgQQARgKEg0KBWxldmVsGAMgASgFIj4KE1VwZGF0ZUZsb29yUmVzcG9uc2USJwoFZmxvb3IYASABKAsyGC5rZXlodWIuY29uc29sZS52MS5GbG9vciIqChJEZWxldGVGbG9vclJlcXVlc3QSFAoCaWQYASABKAlCCLpIBXIDsAEBIhUKE0RlbGV0ZUZsb29yUmVzcG9uc2Uy0AUKFkNvbnNvbGVCdWlsZGluZ1NlcnZpY2USZQoOQ3JlYXRlQnVpbGRpbmcSKC5rZXlodWIuY29uc29sZS52MS5DcmVhdGVCdWlsZGluZ1JlcXVlc3QaKS5rZXlodWIuY29uc29sZS52MS5DcmVhdGVCdWlsZGluZ1Jlc3BvbnNlEmcKDUxpc3RCdWlsZGluZ3MSJy5rZXlodWIuY29uc29sZS52MS5MaXN0QnVpbGRpbmdzUmVxdWVzdBooLmtleWh1Yi5jb25zb2xlLnYxLkxpc3RCdWlsZGluZ3NSZXNwb25zZSIDkAIBEmUKDlVwZGF0ZUJ1aWxkaW5nEigua2V5aHViLmNvbnNvbGUudjEuVXBkYXRlQnVpbGRpbmdSZXF1ZXN0Gikua2V5aHViLmNvbnNvbGUudjEuVXBkYXRlQnVpbGRpbmdSZXNwb25zZRJlCg5EZWxldGVCdWlsZGluZxIoLmtleWh1Yi5jb25zb2xlLnYxLkRlbGV0ZUJ1aWxkaW5nUmVxdWVzdBopLmtleWh1Yi5jb25zb2xlLnYxLkRlbGV0ZUJ1aWxkaW5nUmVzcG9uc2USXAoLQ3JlYXRlRmxvb3ISJS5rZXlodWIuY29uc29sZS52MS5DcmVhdGVGbG9vclJlcXVlc3QaJi5rZXlodWIuY29uc29sZS52MS5DcmVhdGVGbG9vclJlc3BvbnNlElwKC1VwZGF0ZUZsb29yEiUua2V5aHViLmNvbnNvbGUudjEuVXBkYXRlRmxvb3JSZXF1ZXN0GiYua2V5aHViLmNvbnNvbGUudjEuVXBkYXRlRmxvb3JSZXNwb25zZRJcCgtEZWxldGVGbG9vchIlLmtleWh1Yi5jb25zb2xlLnYxLkRlbGV0ZUZsb29yUmVxdWVzdBomLmtleWh1Yi5jb25zb2xlLnYxLkRlbGV0ZUZsb29yUmVzcG9uc2VC4QEKFWNvbS5rZXlodWIuY29uc29sZS52MUINQnVpbGRpbmdQcm90b1ABWlNnaXRodWIuY29tL3NoaWJheWFtYS1jbHViL2tleWh1Yi9pbnRlcm5hbC9pbnRlcmZhY2UvZ2VuL2tleWh1Yi9jb25zb2xlL3YxO2NvbnNvbGV2MaICA0tDWKoCEUtleWh1Yi5Db25zb2xlLlYxygIRS2V5aHViXENvbnNvbGVcVjHiAh1LZXlodWJcQ29uc29sZVxWMVxHUEJNZXRhZGF0YeoCE0tleWh1Yjo6Q29uc29sZTo6VjFiBnByb3RvMw", [file_buf_validate_validate, file_google_protobuf_timestamp]);
+
+/**
+ * @generated from message keyhub.console.v1.Building
+ */
+export type Building = Message<"keyhub.console.v1.Building"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string description = 3;
+   */
+  description: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 4;
+   */
+  createdAt?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message keyhub.console.v1.Building.
+ * Use `create(BuildingSchema)` to create a new message.
+ */
+export const BuildingSchema: GenMessage<Building> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_building, 0);
+
+/**
+ * @generated from message keyhub.console.v1.Floor
+ */
+export type Floor = Message<"keyhub.console.v1.Floor"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string building_id = 2;
+   */
+  buildingId: string;
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name: string;
+
+  /**
+   * 建物内での並び順。地下は負の値
+   *
+   * @generated from field: int32 level = 4;
+   */
+  level: number;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 5;
+   */
+  createdAt?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message keyhub.console.v1.Floor.
+ * Use `create(FloorSchema)` to create a new message.
+ */
+export const FloorSchema: GenMessage<Floor> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_building, 1);
+
+/**
+ * 建物とその階
+ *
+ * @generated from message keyhub.console.v1.BuildingFloors
+ */
+export type BuildingFloors = Message<"keyhub.console.v1.BuildingFloors"> & {
+  /**
+   * @generated from field: keyhub.console.v1.Building building = 1;
+   */
+  building?: Building | undefined;
+
+  /**
+   * @generated from field: repeated keyhub.console.v1.Floor floors = 2;
+   */
+  floors: Floor[];
+};
+
+/**
+ * Describes the message keyhub.console.v1.BuildingFloors.
+ * Use `create(BuildingFloorsSchema)` to create a new message.
+ */
+export const BuildingFloorsSchema: GenMessage<BuildingFloors> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_building, 2);
+
+/**
+ * @generated from message keyhub.console.v1.CreateBuildingRequest
+ */
+export type CreateBuildingRequest = Message<"keyhub.console.v1.CreateBuildingRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string description = 2;
+   */
+  description: string;
+};
+
+/**
+ * Describes the message keyhub.console.v1.CreateBuildingRequest.
+ * Use `create(CreateBuildingRequestSchema)` to create a new message.
+ */
+export const CreateBuildingRequestSchema: GenMessage<CreateBuildingRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_building, 3);
+
+/**
+ * @generated from message keyhub.console.v1.CreateBuildingResponse
+ */
+export type CreateBuildingResponse = Message<"keyhub.console.v1.CreateBuildingResponse"> & {
+  /**
+   * @generated from field: keyhub.console.v1.Building building = 1;
+   */
+  building?: Building | undefined;
+};
+
+/**
+ * Describes the message keyhub.console.v1.CreateBuildingResponse.
+ * Use `create(CreateBuildingResponseSchema)` to create a new message.
+ */
+export const CreateBuildingResponseSchema: GenMessage<CreateBuildingResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_building, 4);
+
+/**
+ * @generated from message keyhub.console.v1.ListBuildingsRequest
+ */
+export type ListBuildingsRequest = Message<"keyhub.console.v1.ListBuildingsRequest"> & {
+};
+
+/**
+ * Describes the message keyhub.console.v1.ListBuildingsRequest.
+ * Use `create(ListBuildingsRequestSchema)` to create a new message.
+ */
+export const ListBuildingsRequestSchema: GenMessage<ListBuildingsRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_building, 5);
+
+/**
+ * @generated from message keyhub.console.v1.ListBuildingsResponse
+ */
+export type ListBuildingsResponse = Message<"keyhub.console.v1.ListBuildingsResponse"> & {
+  /**
+   * @generated from field: repeated keyhub.console.v1.BuildingFloors buildings = 1;
+   */
+  buildings: BuildingFloors[];
+};
+
+/**
+ * Describes the message keyhub.console.v1.ListBuildingsResponse.
+ * Use `create(ListBuildingsResponseSchema)` to create a new message.
+ */
+export const ListBuildingsResponseSchema: GenMessage<ListBuildingsResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_building, 6);
+
+/**
+ * @generated from message keyhub.console.v1.UpdateBuildingRequest
+ */
+export type UpdateBuildingRequest = Message<"keyhub.console.v1.UpdateBuildingRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string description = 3;
+   */
+  description: string;
+};
+
+/**
+ * Describes the message keyhub.console.v1.UpdateBuildingRequest.
+ * Use `create(UpdateBuildingRequestSchema)` to create a new message.
+ */
+export const UpdateBuildingRequestSchema: GenMessage<UpdateBuildingRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_building, 7);
+
+/**
+ * @generated from message keyhub.console.v1.UpdateBuildingResponse
+ */
+export type UpdateBuildingResponse = Message<"keyhub.console.v1.UpdateBuildingResponse"> & {
+  /**
+   * @generated from field: keyhub.console.v1.Building building = 1;
+   */
+  building?: Building | undefined;
+};
+
+/**
+ * Describes the message keyhub.console.v1.UpdateBuildingResponse.
+ * Use `create(UpdateBuildingResponseSchema)` to create a new message.
+ */
+export const UpdateBuildingResponseSchema: GenMessage<UpdateBuildingResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_building, 8);
+
+/**
+ * @generated from message keyhub.console.v1.DeleteBuildingRequest
+ */
+export type DeleteBuildingRequest = Message<"keyhub.console.v1.DeleteBuildingRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message keyhub.console.v1.DeleteBuildingRequest.
+ * Use `create(DeleteBuildingRequestSchema)` to create a new message.
+ */
+export const DeleteBuildingRequestSchema: GenMessage<DeleteBuildingRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_building, 9);
+
+/**
+ * @generated from message keyhub.console.v1.DeleteBuildingResponse
+ */
+export type DeleteBuildingResponse = Message<"keyhub.console.v1.DeleteBuildingResponse"> & {
+};
+
+/**
+ * Describes the message keyhub.console.v1.DeleteBuildingResponse.
+ * Use `create(DeleteBuildingResponseSchema)` to create a new message.
+ */
+export const DeleteBuildingResponseSchema: GenMessage<DeleteBuildingResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_building, 10);
+
+/**
+ * @generated from message keyhub.console.v1.CreateFloorRequest
+ */
+export type CreateFloorRequest = Message<"keyhub.console.v1.CreateFloorRequest"> & {
+  /**
+   * @generated from field: string building_id = 1;
+   */
+  buildingId: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: int32 level = 3;
+   */
+  level: number;
+};
+
+/**
+ * Describes the message keyhub.console.v1.CreateFloorRequest.
+ * Use `create(CreateFloorRequestSchema)` to create a new message.
+ */
+export const CreateFloorRequestSchema: GenMessage<CreateFloorRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_building, 11);
+
+/**
+ * @generated from message keyhub.console.v1.CreateFloorResponse
+ */
+export type CreateFloorResponse = Message<"keyhub.console.v1.CreateFloorResponse"> & {
+  /**
+   * @generated from field: keyhub.console.v1.Floor floor = 1;
+   */
+  floor?: Floor | undefined;
+};
+
+/**
+ * Describes the message keyhub.console.v1.CreateFloorResponse.
+ * Use `create(CreateFloorResponseSchema)` to create a new message.
+ */
+export const CreateFloorResponseSchema: GenMessage<CreateFloorResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_building, 12);
+
+/**
+ * @generated from message keyhub.console.v1.UpdateFloorRequest
+ */
+export type UpdateFloorRequest = Message<"keyhub.console.v1.UpdateFloorRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: int32 level = 3;
+   */
+  level: number;
+};
+
+/**
+ * Describes the message keyhub.console.v1.UpdateFloorRequest.
+ * Use `create(UpdateFloorRequestSchema)` to create a new message.
+ */
+export const UpdateFloorRequestSchema: GenMessage<UpdateFloorRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_building, 13);
+
+/**
+ * @generated from message keyhub.console.v1.UpdateFloorResponse
+ */
+export type UpdateFloorResponse = Message<"keyhub.console.v1.UpdateFloorResponse"> & {
+  /**
+   * @generated from field: keyhub.console.v1.Floor floor = 1;
+   */
+  floor?: Floor | undefined;
+};
+
+/**
+ * Describes the message keyhub.console.v1.UpdateFloorResponse.
+ * Use `create(UpdateFloorResponseSchema)` to create a new message.
+ */
+export const UpdateFloorResponseSchema: GenMessage<UpdateFloorResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_building, 14);
+
+/**
+ * @generated from message keyhub.console.v1.DeleteFloorRequest
+ */
+export type DeleteFloorRequest = Message<"keyhub.console.v1.DeleteFloorRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message keyhub.console.v1.DeleteFloorRequest.
+ * Use `create(DeleteFloorRequestSchema)` to create a new message.
+ */
+export const DeleteFloorRequestSchema: GenMessage<DeleteFloorRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_building, 15);
+
+/**
+ * @generated from message keyhub.console.v1.DeleteFloorResponse
+ */
+export type DeleteFloorResponse = Message<"keyhub.console.v1.DeleteFloorResponse"> & {
+};
+
+/**
+ * Describes the message keyhub.console.v1.DeleteFloorResponse.
+ * Use `create(DeleteFloorResponseSchema)` to create a new message.
+ */
+export const DeleteFloorResponseSchema: GenMessage<DeleteFloorResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_building, 16);
+
+/**
+ * 部屋が置かれる建物と階を管理するサービス
+ * 部屋は CreateRoom で floor_id を指定して階に置く
+ *
+ * @generated from service keyhub.console.v1.ConsoleBuildingService
+ */
+export const ConsoleBuildingService: GenService<{
+  /**
+   * 建物作成（名前は組織内で一意）
+   *
+   * @generated from rpc keyhub.console.v1.ConsoleBuildingService.CreateBuilding
+   */
+  createBuilding: {
+    methodKind: "unary";
+    input: typeof CreateBuildingRequestSchema;
+    output: typeof CreateBuildingResponseSchema;
+  },
+  /**
+   * 建物と階の一覧取得（建物は名前順、階は level の昇順）
+   *
+   * @generated from rpc keyhub.console.v1.ConsoleBuildingService.ListBuildings
+   */
+  listBuildings: {
+    methodKind: "unary";
+    input: typeof ListBuildingsRequestSchema;
+    output: typeof ListBuildingsResponseSchema;
+  },
+  /**
+   * 建物の名前・説明を更新（部屋の建物名にも反映される）
+   *
+   * @generated from rpc keyhub.console.v1.ConsoleBuildingService.UpdateBuilding
+   */
+  updateBuilding: {
+    methodKind: "unary";
+    input: typeof UpdateBuildingRequestSchema;
+    output: typeof UpdateBuildingResponseSchema;
+  },
+  /**
+   * 建物削除（階が残っている建物は削除できない）
+   *
+   * @generated from rpc keyhub.console.v1.ConsoleBuildingService.DeleteBuilding
+   */
+  deleteBuilding: {
+    methodKind: "unary";
+    input: typeof DeleteBuildingRequestSchema;
+    output: typeof DeleteBuildingResponseSchema;
+  },
+  /**
+   * 階作成（名前は建物内で一意）
+   *
+   * @generated from rpc keyhub.console.v1.ConsoleBuildingService.CreateFloor
+   */
+  createFloor: {
+    methodKind: "unary";
+    input: typeof CreateFloorRequestSchema;
+    output: typeof CreateFloorResponseSchema;
+  },
+  /**
+   * 階の名前・並び順を更新（部屋の階にも反映される）
+   *
+   * @generated from rpc keyhub.console.v1.ConsoleBuildingService.UpdateFloor
+   */
+  updateFloor: {
+    methodKind: "unary";
+    input: typeof UpdateFloorRequestSchema;
+    output: typeof UpdateFloorResponseSchema;
+  },
+  /**
+   * 階削除（部屋が残っている階は削除できない）
+   *
+   * @generated from rpc keyhub.console.v1.ConsoleBuildingService.DeleteFloor
+   */
+  deleteFloor: {
+    methodKind: "unary";
+    input: typeof DeleteFloorRequestSchema;
+    output: typeof DeleteFloorResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_keyhub_console_v1_building, 0);
+
@@ -5,13 +5,15 @@
 import type { GenEnum, GenFile, GenMessage } from "@bufbuild/protobuf/codegenv2";
 import { enumDesc, fileDesc, messageDesc } from "@bufbuild/protobuf/codegenv2";
 import { file_buf_validate_validate } from "../../../buf/validate/validate_pb";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file keyhub/console/v1/common.proto.
  */
 export const file_keyhub_console_v1_common: GenFile = /*@__PURE__*/
-  fileDesc("Ch5rZXlodWIvY29uc29sZS92MS9jb21tb24ucHJvdG8SEWtleWh1Yi5jb25zb2xlLnYxInUKBlRlbmFudBIUCgJpZBgBIAEoCUIIukgFcgOwAQESDAoEbmFtZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRIyCgt0ZW5hbnRfdHlwZRgEIAEoDjIdLmtleWh1Yi5jb25zb2xlLnYxLlRlbmFudFR5cGUiwgEKBFJvb20SFAoCaWQYASABKAlCCLpIBXIDsAEBEgwKBG5hbWUYAiABKAkSFQoNYnVpbGRpbmdfbmFtZRgDIAEoCRIUCgxmbG9vcl9udW1iZXIYBCABKAkSLgoJcm9vbV90eXBlGAUgASgOMhsua2V5aHViLmNvbnNvbGUudjEuUm9vbVR5cGUSEwoLZGVzY3JpcHRpb24YBiABKAkSJAoEa2V5cxgHIAMoCzIWLmtleWh1Yi5jb25zb2xlLnYxLktleSJ4CgNLZXkSFAoCaWQYASABKAlCCLpIBXIDsAEBEhIKCmtleV9udW1iZXIYAiABKAkSGQoHcm9vbV9pZBgDIAEoCUIIukgFcgOwAQESLAoGc3RhdHVzGAQgASgOMhwua2V5aHViLmNvbnNvbGUudjEuS2V5U3RhdHVzKpABCgpUZW5hbnRUeXBlEhsKF1RFTkFOVF9UWVBFX1VOU1BFQ0lGSUVEEAASFAoQVEVOQU5UX1RZUEVfVEVBTRABEhoKFlRFTkFOVF9UWVBFX0RFUEFSVE1FTlQQAhIXChNURU5BTlRfVFlQRV9QUk9KRUNUEAMSGgoWVEVOQU5UX1RZUEVfTEFCT1JBVE9SWRAEKoUBCglLZXlTdGF0dXMSGgoWS0VZX1NUQVRVU19VTlNQRUNJRklFRBAAEhgKFEtFWV9TVEFUVVNfQVZBSUxBQkxFEAESFQoRS0VZX1NUQVRVU19JTl9VU0UQAhITCg9LRVlfU1RBVFVTX0xPU1QQAxIWChJLRVlfU1RBVFVTX0RBTUFHRUQQBCq5AQoIUm9vbVR5cGUSGQoVUk9PTV9UWVBFX1VOU1BFQ0lGSUVEEAASFwoTUk9PTV9UWVBFX0NMQVNTUk9PTRABEhoKFlJPT01fVFlQRV9NRUVUSU5HX1JPT00QAhIYChRST09NX1RZUEVfTEFCT1JBVE9SWRADEhQKEFJPT01fVFlQRV9PRkZJQ0UQBBIWChJST09NX1RZUEVfV09SS1NIT1AQBRIVChFST09NX1RZUEVfU1RPUkFHRRAGQt8BChVjb20ua2V5aHViLmNvbnNvbGUudjFCC0NvbW1vblByb3RvUAFaU2dpdGh1Yi5jb20vc2hpYmF5YW1hLWNsdWIva2V5aHViL2ludGVybmFsL2ludGVyZmFjZS9nZW4va2V5aHViL2NvbnNvbGUvdjE7Y29uc29sZXYxogIDS0NYqgIRS2V5aHViLkNvbnNvbGUuVjHKAhFLZXlodWJcQ29uc29sZVxWMeICHUtleWh1YlxDb25zb2xlXFYxXEdQQk1ldGFkYXRh6gITS2V5aHViOjpDb25zb2xlOjpWMWIGcHJvdG8z", [file_buf_validate_validate]);
+  fileDesc("Ch5rZXlodWIvY29uc29sZS92MS9jb21tb24ucHJvdG8SEWtleWh1Yi5jb25zb2xlLnYxItABCgZUZW5hbnQSFAoCaWQYASABKAlCCLpIBXIDsAEBEgwKBG5hbWUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSMgoLdGVuYW50X3R5cGUYBCABKA4yHS5rZXlodWIuY29uc29sZS52MS5UZW5hbnRUeXBlEhcKD3RlbmFudF90eXBlX2tleRgFIAEoCRIvCgthcmNoaXZlZF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDwoHdmVyc2lvbhgHIAEoAyK9AgoEUm9vbRIUCgJpZBgBIAEoCUIIukgFcgOwAQESDAoEbmFtZRgCIAEoCRIVCg1idWlsZGluZ19uYW1lGAMgASgJEhQKDGZsb29yX251bWJlchgEIAEoCRIuCglyb29tX3R5cGUYBSABKA4yGy5rZXlodWIuY29uc29sZS52MS5Sb29tVHlwZRITCgtkZXNjcmlwdGlvbhgGIAEoCRIkCgRrZXlzGAcgAygLMhYua2V5aHViLmNvbnNvbGUudjEuS2V5EhoKCGZsb29yX2lkGAggASgJQgi6SAVyA7ABARI1CgphdHRyaWJ1dGVzGAkgASgLMiEua2V5aHViLmNvbnNvbGUudjEuUm9vbUF0dHJpYnV0ZXMSFQoNcm9vbV90eXBlX2tleRgKIAEoCRIPCgd2ZXJzaW9uGAsgASgDIokBCgNLZXkSFAoCaWQYASABKAlCCLpIBXIDsAEBEhIKCmtleV9udW1iZXIYAiABKAkSGQoHcm9vbV9pZBgDIAEoCUIIukgFcgOwAQESLAoGc3RhdHVzGAQgASgOMhwua2V5aHViLmNvbnNvbGUudjEuS2V5U3RhdHVzEg8KB3ZlcnNpb24YBSABKAMiiQIKDlJvb21BdHRyaWJ1dGVzEhwKCGNhcGFjaXR5GAEgASgFQgq6SAcaBRiQTigAEhsKCWVxdWlwbWVudBgCIAMoCUIIukgFkgECEB4SOwoNYWNjZXNzaWJpbGl0eRgDIAMoDjIkLmtleWh1Yi5jb25zb2xlLnYxLlJvb21BY2Nlc3NpYmlsaXR5EkoKDWN1c3RvbV9maWVsZHMYBCADKAsyMy5rZXlodWIuY29uc29sZS52MS5Sb29tQXR0cmlidXRlcy5DdXN0b21GaWVsZHNFbnRyeRozChFDdXN0b21GaWVsZHNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBKpABCgpUZW5hbnRUeXBlEhsKF1RFTkFOVF9UWVBFX1VOU1BFQ0lGSUVEEAASFAoQVEVOQU5UX1RZUEVfVEVBTRABEhoKFlRFTkFOVF9UWVBFX0RFUEFSVE1FTlQQAhIXChNURU5BTlRfVFlQRV9QUk9KRUNUEAMSGgoWVEVOQU5UX1RZUEVfTEFCT1JBVE9SWRAEKoUBCglLZXlTdGF0dXMSGgoWS0VZX1NUQVRVU19VTlNQRUNJRklFRBAAEhgKFEtFWV9TVEFUVVNfQVZBSUxBQkxFEAESFQoRS0VZX1NUQVRVU19JTl9VU0UQAhITCg9LRVlfU1RBVFVTX0xPU1QQAxIWChJLRVlfU1RBVFVTX0RBTUFHRUQQBCq5AQoIUm9vbVR5cGUSGQoVUk9PTV9UWVBFX1VOU1BFQ0lGSUVEEAASFwoTUk9PTV9UWVBFX0NMQVNTUk9PTRABEhoKFlJPT01fVFlQRV9NRUVUSU5HX1JPT00QAhIYChRST09NX1RZUEVfTEFCT1JBVE9SWRADEhQKEFJPT01fVFlQRV9PRkZJQ0UQBBIWChJST09NX1RZUEVfV09SS1NIT1AQBRIVChFST09NX1RZUEVfU1RPUkFHRRAGKs0BChFSb29tQWNjZXNzaWJpbGl0eRIiCh5ST09NX0FDQ0VTU0lCSUxJVFlfVU5TUEVDSUZJRUQQABIhCh1ST09NX0FDQ0VTU0lCSUxJVFlfV0hFRUxDSEFJUhABEiAKHFJPT01fQUNDRVNTSUJJTElUWV9TVEVQX0ZSRUUQAhIqCiZST09NX0FDQ0VTU0lCSUxJVFlfQUNDRVNTSUJMRV9SRVNUUk9PTRADEiMKH1JPT01fQUNDRVNTSUJJTElUWV9IRUFSSU5HX0xPT1AQBCpTCglMaXN0T3JkZXISGgoWTElTVF9PUkRFUl9VTlNQRUNJRklFRBAAEhUKEUxJU1RfT1JERVJfTkVXRVNUEAESEwoPTElTVF9PUkRFUl9OQU1FEAJC3wEKFWNvbS5rZXlodWIuY29uc29sZS52MUILQ29tbW9uUHJvdG9QAVpTZ2l0aHViLmNvbS9zaGliYXlhbWEtY2x1Yi9rZXlodWIvaW50ZXJuYWwvaW50ZXJmYWNlL2dlbi9rZXlodWIvY29uc29sZS92MTtjb25zb2xldjGiAgNLQ1iqAhFLZXlodWIuQ29uc29sZS5WMcoCEUtleWh1YlxDb25zb2xlXFYx4gIdS2V5aHViXENvbnNvbGVcVjFcR1BCTWV0YWRhdGHqAhNLZXlodWI6OkNvbnNvbGU6OlYxYgZwcm90bzM", [file_buf_validate_validate, file_google_protobuf_timestamp]);
 
 /**
  * @generated from message keyhub.console.v1.Tenant
@@ -33,9 +35,32 @@ export type Tenant = Message<"keyhub.console.v1.Tenant"> & {
   description: string;
 
   /**
+   * 組織が追加したタイプの場合は UNSPECIFIED
+   *
    * @generated from field: keyhub.console.v1.TenantType tenant_type = 4;
    */
   tenantType: TenantType;
+
+  /**
+   * テナントタイプのキー。既定のタイプは "TENANT_TYPE_TEAM" など
+   *
+   * @generated from field: string tenant_type_key = 5;
+   */
+  tenantTypeKey: string;
+
+  /**
+   * アーカイブした日時。アーカイブしていなければ未設定
+   *
+   * @generated from field: google.protobuf.Timestamp archived_at = 6;
+   */
+  archivedAt?: Timestamp | undefined;
+
+  /**
+   * 版数。更新するたびに増える。UpdateTenant に渡し、取得後に他の更新があった場合は ABORTED になる
+   *
+   * @generated from field: int64 version = 7;
+   */
+  version: bigint;
 };
 
 /**
@@ -83,6 +108,30 @@ export type Room = Message<"keyhub.console.v1.Room"> & {
    * @generated from field: repeated keyhub.console.v1.Key keys = 7;
    */
   keys: Key[];
+
+  /**
+   * @generated from field: string floor_id = 8;
+   */
+  floorId: string;
+
+  /**
+   * @generated from field: keyhub.console.v1.RoomAttributes attributes = 9;
+   */
+  attributes?: RoomAttributes | undefined;
+
+  /**
+   * 部屋タイプのキー。既定のタイプは "classroom" など。組織が追加したタイプの場合 room_type は UNSPECIFIED
+   *
+   * @generated from field: string room_type_key = 10;
+   */
+  roomTypeKey: string;
+
+  /**
+   * 版数。更新するたびに増える。UpdateRoomAttributes に渡し、取得後に他の更新があった場合は ABORTED になる
+   *
+   * @generated from field: int64 version = 11;
+   */
+  version: bigint;
 };
 
 /**
@@ -115,6 +164,13 @@ export type Key = Message<"keyhub.console.v1.Key"> & {
    * @generated from field: keyhub.console.v1.KeyStatus status = 4;
    */
   status: KeyStatus;
+
+  /**
+   * 版数。更新するたびに増える。UpdateKey に渡し、取得後に他の更新があった場合は ABORTED になる
+   *
+   * @generated from field: int64 version = 5;
+   */
+  version: bigint;
 };
 
 /**
@@ -124,6 +180,46 @@ export type Key = Message<"keyhub.console.v1.Key"> & {
 export const KeySchema: GenMessage<Key> = /*@__PURE__*/
   messageDesc(file_keyhub_console_v1_common, 2);
 
+/**
+ * 部屋の収容人数・設備・バリアフリー対応と、組織が定義したカスタム項目の値
+ *
+ * @generated from message keyhub.console.v1.RoomAttributes
+ */
+export type RoomAttributes = Message<"keyhub.console.v1.RoomAttributes"> & {
+  /**
+   * 0 は未設定
+   *
+   * @generated from field: int32 capacity = 1;
+   */
+  capacity: number;
+
+  /**
+   * 設備名（各30文字以内）
+   *
+   * @generated from field: repeated string equipment = 2;
+   */
+  equipment: string[];
+
+  /**
+   * @generated from field: repeated keyhub.console.v1.RoomAccessibility accessibility = 3;
+   */
+  accessibility: RoomAccessibility[];
+
+  /**
+   * カスタム項目のキーごとの値
+   *
+   * @generated from field: map<string, string> custom_fields = 4;
+   */
+  customFields: { [key: string]: string };
+};
+
+/**
+ * Describes the message keyhub.console.v1.RoomAttributes.
+ * Use `create(RoomAttributesSchema)` to create a new message.
+ */
+export const RoomAttributesSchema: GenMessage<RoomAttributes> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_common, 3);
+
 /**
  * @generated from enum keyhub.console.v1.TenantType
  */
@@ -270,3 +366,81 @@ export enum RoomType {
 export const RoomTypeSchema: GenEnum<RoomType> = /*@__PURE__*/
   enumDesc(file_keyhub_console_v1_common, 2);
 
+/**
+ * @generated from enum keyhub.console.v1.RoomAccessibility
+ */
+export enum RoomAccessibility {
+  /**
+   * @generated from enum value: ROOM_ACCESSIBILITY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * 車いすで利用できる
+   *
+   * @generated from enum value: ROOM_ACCESSIBILITY_WHEELCHAIR = 1;
+   */
+  WHEELCHAIR = 1,
+
+  /**
+   * 段差がない
+   *
+   * @generated from enum value: ROOM_ACCESSIBILITY_STEP_FREE = 2;
+   */
+  STEP_FREE = 2,
+
+  /**
+   * 近くに多目的トイレがある
+   *
+   * @generated from enum value: ROOM_ACCESSIBILITY_ACCESSIBLE_RESTROOM = 3;
+   */
+  ACCESSIBLE_RESTROOM = 3,
+
+  /**
+   * ヒアリングループがある
+   *
+   * @generated from enum value: ROOM_ACCESSIBILITY_HEARING_LOOP = 4;
+   */
+  HEARING_LOOP = 4,
+}
+
+/**
+ * Describes the enum keyhub.console.v1.RoomAccessibility.
+ */
+export const RoomAccessibilitySchema: GenEnum<RoomAccessibility> = /*@__PURE__*/
+  enumDesc(file_keyhub_console_v1_common, 3);
+
+/**
+ * 一覧の並び順
+ *
+ * @generated from enum keyhub.console.v1.ListOrder
+ */
+export enum ListOrder {
+  /**
+   * 新しい順
+   *
+   * @generated from enum value: LIST_ORDER_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * 作成日時の新しい順
+   *
+   * @generated from enum value: LIST_ORDER_NEWEST = 1;
+   */
+  NEWEST = 1,
+
+  /**
+   * 名前の昇順（鍵の一覧では鍵番号の昇順）
+   *
+   * @generated from enum value: LIST_ORDER_NAME = 2;
+   */
+  NAME = 2,
+}
+
+/**
+ * Describes the enum keyhub.console.v1.ListOrder.
+ */
+export const ListOrderSchema: GenEnum<ListOrder> = /*@__PURE__*/
+  enumDesc(file_keyhub_console_v1_common, 4);
+
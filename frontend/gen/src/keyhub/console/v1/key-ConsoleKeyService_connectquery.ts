@@ -11,6 +11,14 @@ import { ConsoleKeyService } from "./key_pb";
  */
 export const createKey = ConsoleKeyService.method.createKey;
 
+/**
+ * 鍵番号を変更
+ * 取得後に他の管理者が更新していた場合は ABORTED を返す
+ *
+ * @generated from rpc keyhub.console.v1.ConsoleKeyService.UpdateKey
+ */
+export const updateKey = ConsoleKeyService.method.updateKey;
+
 /**
  * Roomに紐付く鍵一覧を取得
  *
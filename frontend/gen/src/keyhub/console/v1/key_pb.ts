@@ -2,10 +2,12 @@
 // @generated from file keyhub/console/v1/key.proto (package keyhub.console.v1, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
-import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
 import { file_buf_validate_validate } from "../../../buf/validate/validate_pb";
-import type { Key } from "./common_pb";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Key, KeyStatus, ListOrder } from "./common_pb";
 import { file_keyhub_console_v1_common } from "./common_pb";
 import type { Message } from "@bufbuild/protobuf";
 
@@ -13,7 +15,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file keyhub/console/v1/key.proto.
  */
 export const file_keyhub_console_v1_key: GenFile = /*@__PURE__*/
-  fileDesc("ChtrZXlodWIvY29uc29sZS92MS9rZXkucHJvdG8SEWtleWh1Yi5jb25zb2xlLnYxIkEKEENyZWF0ZUtleVJlcXVlc3QSGQoHcm9vbV9pZBgBIAEoCUIIukgFcgOwAQESEgoKa2V5X251bWJlchgCIAEoCSIpChFDcmVhdGVLZXlSZXNwb25zZRIUCgJpZBgBIAEoCUIIukgFcgOwAQEiMQoUR2V0S2V5c0J5Um9vbVJlcXVlc3QSGQoHcm9vbV9pZBgBIAEoCUIIukgFcgOwAQEiPQoVR2V0S2V5c0J5Um9vbVJlc3BvbnNlEiQKBGtleXMYASADKAsyFi5rZXlodWIuY29uc29sZS52MS5LZXkyzwEKEUNvbnNvbGVLZXlTZXJ2aWNlElYKCUNyZWF0ZUtleRIjLmtleWh1Yi5jb25zb2xlLnYxLkNyZWF0ZUtleVJlcXVlc3QaJC5rZXlodWIuY29uc29sZS52MS5DcmVhdGVLZXlSZXNwb25zZRJiCg1HZXRLZXlzQnlSb29tEicua2V5aHViLmNvbnNvbGUudjEuR2V0S2V5c0J5Um9vbVJlcXVlc3QaKC5rZXlodWIuY29uc29sZS52MS5HZXRLZXlzQnlSb29tUmVzcG9uc2VC3AEKFWNvbS5rZXlodWIuY29uc29sZS52MUIIS2V5UHJvdG9QAVpTZ2l0aHViLmNvbS9zaGliYXlhbWEtY2x1Yi9rZXlodWIvaW50ZXJuYWwvaW50ZXJmYWNlL2dlbi9rZXlodWIvY29uc29sZS92MTtjb25zb2xldjGiAgNLQ1iqAhFLZXlodWIuQ29uc29sZS5WMcoCEUtleWh1YlxDb25zb2xlXFYx4gIdS2V5aHViXENvbnNvbGVcVjFcR1BCTWV0YWRhdGHqAhNLZXlodWI6OkNvbnNvbGU6OlYxYgZwcm90bzM", [file_buf_validate_validate, file_keyhub_console_v1_common]);
+  fileDesc("ChtrZXlodWIvY29uc29sZS92MS9rZXkucHJvdG8SEWtleWh1Yi5jb25zb2xlLnYxIkEKEENyZWF0ZUtleVJlcXVlc3QSGQoHcm9vbV9pZBgBIAEoCUIIukgFcgOwAQESEgoKa2V5X251bWJlchgCIAEoCSIpChFDcmVhdGVLZXlSZXNwb25zZRIUCgJpZBgBIAEoCUIIukgFcgOwAQEiXQoQVXBkYXRlS2V5UmVxdWVzdBIYCgZrZXlfaWQYASABKAlCCLpIBXIDsAEBEhIKCmtleV9udW1iZXIYAiABKAkSGwoHdmVyc2lvbhgDIAEoA0IKukgHyAEBIgIgACI4ChFVcGRhdGVLZXlSZXNwb25zZRIjCgNrZXkYASABKAsyFi5rZXlodWIuY29uc29sZS52MS5LZXki2gEKFEdldEtleXNCeVJvb21SZXF1ZXN0EhkKB3Jvb21faWQYASABKAlCCLpIBXIDsAEBEh0KCXBhZ2Vfc2l6ZRgCIAEoBUIKukgHGgUYyAEoABISCgpwYWdlX3Rva2VuGAMgASgJEisKBW9yZGVyGAQgASgOMhwua2V5aHViLmNvbnNvbGUudjEuTGlzdE9yZGVyEiwKBnN0YXR1cxgFIAEoDjIcLmtleWh1Yi5jb25zb2xlLnYxLktleVN0YXR1cxIZChFrZXlfbnVtYmVyX3ByZWZpeBgGIAEoCSJWChVHZXRLZXlzQnlSb29tUmVzcG9uc2USJAoEa2V5cxgBIAMoCzIWLmtleWh1Yi5jb25zb2xlLnYxLktleRIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkiVwoQV2F0Y2hLZXlzUmVxdWVzdBIbCgdyb29tX2lkGAEgASgJSABCCLpIBXIDsAEBEh0KCXRlbmFudF9pZBgCIAEoCUgAQgi6SAVyA7ABAUIHCgVzY29wZSKaAQoJS2V5Q2hhbmdlEjgKCW9wZXJhdGlvbhgBIAEoDjIlLmtleWh1Yi5jb25zb2xlLnYxLktleUNoYW5nZU9wZXJhdGlvbhIjCgNrZXkYAiABKAsyFi5rZXlodWIuY29uc29sZS52MS5LZXkSLgoKY2hhbmdlZF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiMwoLS2V5U25hcHNob3QSJAoEa2V5cxgBIAMoCzIWLmtleWh1Yi5jb25zb2xlLnYxLktleSITChFLZXlXYXRjaEhlYXJ0YmVhdCK7AQoRV2F0Y2hLZXlzUmVzcG9uc2USMgoIc25hcHNob3QYASABKAsyHi5rZXlodWIuY29uc29sZS52MS5LZXlTbmFwc2hvdEgAEi4KBmNoYW5nZRgCIAEoCzIcLmtleWh1Yi5jb25zb2xlLnYxLktleUNoYW5nZUgAEjkKCWhlYXJ0YmVhdBgDIAEoCzIkLmtleWh1Yi5jb25zb2xlLnYxLktleVdhdGNoSGVhcnRiZWF0SABCBwoFZXZlbnQqoAEKEktleUNoYW5nZU9wZXJhdGlvbhIkCiBLRVlfQ0hBTkdFX09QRVJBVElPTl9VTlNQRUNJRklFRBAAEiAKHEtFWV9DSEFOR0VfT1BFUkFUSU9OX0NSRUFURUQQARIgChxLRVlfQ0hBTkdFX09QRVJBVElPTl9VUERBVEVEEAISIAocS0VZX0NIQU5HRV9PUEVSQVRJT05fREVMRVRFRBADMoYDChFDb25zb2xlS2V5U2VydmljZRJWCglDcmVhdGVLZXkSIy5rZXlodWIuY29uc29sZS52MS5DcmVhdGVLZXlSZXF1ZXN0GiQua2V5aHViLmNvbnNvbGUudjEuQ3JlYXRlS2V5UmVzcG9uc2USVgoJVXBkYXRlS2V5EiMua2V5aHViLmNvbnNvbGUudjEuVXBkYXRlS2V5UmVxdWVzdBokLmtleWh1Yi5jb25zb2xlLnYxLlVwZGF0ZUtleVJlc3BvbnNlEmIKDUdldEtleXNCeVJvb20SJy5rZXlodWIuY29uc29sZS52MS5HZXRLZXlzQnlSb29tUmVxdWVzdBooLmtleWh1Yi5jb25zb2xlLnYxLkdldEtleXNCeVJvb21SZXNwb25zZRJdCglXYXRjaEtleXMSIy5rZXlodWIuY29uc29sZS52MS5XYXRjaEtleXNSZXF1ZXN0GiQua2V5aHViLmNvbnNvbGUudjEuV2F0Y2hLZXlzUmVzcG9uc2UiA5ACATABQtwBChVjb20ua2V5aHViLmNvbnNvbGUudjFCCEtleVByb3RvUAFaU2dpdGh1Yi5jb20vc2hpYmF5YW1hLWNsdWIva2V5aHViL2ludGVybmFsL2ludGVyZmFjZS9nZW4va2V5aHViL2NvbnNvbGUvdjE7Y29uc29sZXYxogIDS0NYqgIRS2V5aHViLkNvbnNvbGUuVjHKAhFLZXlodWJcQ29uc29sZVxWMeICHUtleWh1YlxDb25zb2xlXFYxXEdQQk1ldGFkYXRh6gITS2V5aHViOjpDb25zb2xlOjpWMWIGcHJvdG8z", [file_buf_validate_validate, file_google_protobuf_timestamp, file_keyhub_console_v1_common]);
 
 /**
  * @generated from message keyhub.console.v1.CreateKeyRequest
@@ -54,6 +56,54 @@ export type CreateKeyResponse = Message<"keyhub.console.v1.CreateKeyResponse"> &
 export const CreateKeyResponseSchema: GenMessage<CreateKeyResponse> = /*@__PURE__*/
   messageDesc(file_keyhub_console_v1_key, 1);
 
+/**
+ * @generated from message keyhub.console.v1.UpdateKeyRequest
+ */
+export type UpdateKeyRequest = Message<"keyhub.console.v1.UpdateKeyRequest"> & {
+  /**
+   * @generated from field: string key_id = 1;
+   */
+  keyId: string;
+
+  /**
+   * @generated from field: string key_number = 2;
+   */
+  keyNumber: string;
+
+  /**
+   * 取得時の Key.version。他の更新で版数が変わっていれば更新せず ABORTED を返す
+   *
+   * @generated from field: int64 version = 3;
+   */
+  version: bigint;
+};
+
+/**
+ * Describes the message keyhub.console.v1.UpdateKeyRequest.
+ * Use `create(UpdateKeyRequestSchema)` to create a new message.
+ */
+export const UpdateKeyRequestSchema: GenMessage<UpdateKeyRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_key, 2);
+
+/**
+ * @generated from message keyhub.console.v1.UpdateKeyResponse
+ */
+export type UpdateKeyResponse = Message<"keyhub.console.v1.UpdateKeyResponse"> & {
+  /**
+   * version は更新後の版数
+   *
+   * @generated from field: keyhub.console.v1.Key key = 1;
+   */
+  key?: Key | undefined;
+};
+
+/**
+ * Describes the message keyhub.console.v1.UpdateKeyResponse.
+ * Use `create(UpdateKeyResponseSchema)` to create a new message.
+ */
+export const UpdateKeyResponseSchema: GenMessage<UpdateKeyResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_key, 3);
+
 /**
  * @generated from message keyhub.console.v1.GetKeysByRoomRequest
  */
@@ -62,6 +112,41 @@ export type GetKeysByRoomRequest = Message<"keyhub.console.v1.GetKeysByRoomReque
    * @generated from field: string room_id = 1;
    */
   roomId: string;
+
+  /**
+   * 省略時50件
+   *
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize: number;
+
+  /**
+   * 前回のレスポンスの next_page_token。条件と並び順は前回と同じにする
+   *
+   * @generated from field: string page_token = 3;
+   */
+  pageToken: string;
+
+  /**
+   * LIST_ORDER_NAME は鍵番号の昇順
+   *
+   * @generated from field: keyhub.console.v1.ListOrder order = 4;
+   */
+  order: ListOrder;
+
+  /**
+   * 以下の条件は指定したものだけで絞り込む
+   *
+   * @generated from field: keyhub.console.v1.KeyStatus status = 5;
+   */
+  status: KeyStatus;
+
+  /**
+   * 鍵番号の前方一致
+   *
+   * @generated from field: string key_number_prefix = 6;
+   */
+  keyNumberPrefix: string;
 };
 
 /**
@@ -69,7 +154,7 @@ export type GetKeysByRoomRequest = Message<"keyhub.console.v1.GetKeysByRoomReque
  * Use `create(GetKeysByRoomRequestSchema)` to create a new message.
  */
 export const GetKeysByRoomRequestSchema: GenMessage<GetKeysByRoomRequest> = /*@__PURE__*/
-  messageDesc(file_keyhub_console_v1_key, 2);
+  messageDesc(file_keyhub_console_v1_key, 4);
 
 /**
  * @generated from message keyhub.console.v1.GetKeysByRoomResponse
@@ -79,6 +164,13 @@ export type GetKeysByRoomResponse = Message<"keyhub.console.v1.GetKeysByRoomResp
    * @generated from field: repeated keyhub.console.v1.Key keys = 1;
    */
   keys: Key[];
+
+  /**
+   * 続きがない場合は空
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
 };
 
 /**
@@ -86,7 +178,175 @@ export type GetKeysByRoomResponse = Message<"keyhub.console.v1.GetKeysByRoomResp
  * Use `create(GetKeysByRoomResponseSchema)` to create a new message.
  */
 export const GetKeysByRoomResponseSchema: GenMessage<GetKeysByRoomResponse> = /*@__PURE__*/
-  messageDesc(file_keyhub_console_v1_key, 3);
+  messageDesc(file_keyhub_console_v1_key, 5);
+
+/**
+ * @generated from message keyhub.console.v1.WatchKeysRequest
+ */
+export type WatchKeysRequest = Message<"keyhub.console.v1.WatchKeysRequest"> & {
+  /**
+   * 省略時は組織のすべての鍵を購読する
+   *
+   * @generated from oneof keyhub.console.v1.WatchKeysRequest.scope
+   */
+  scope: {
+    /**
+     * @generated from field: string room_id = 1;
+     */
+    value: string;
+    case: "roomId";
+  } | {
+    /**
+     * 購読を始めた時点でテナントに割り当てられている部屋の鍵を購読する
+     *
+     * @generated from field: string tenant_id = 2;
+     */
+    value: string;
+    case: "tenantId";
+  } | { case: undefined; value?: undefined };
+};
+
+/**
+ * Describes the message keyhub.console.v1.WatchKeysRequest.
+ * Use `create(WatchKeysRequestSchema)` to create a new message.
+ */
+export const WatchKeysRequestSchema: GenMessage<WatchKeysRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_key, 6);
+
+/**
+ * @generated from message keyhub.console.v1.KeyChange
+ */
+export type KeyChange = Message<"keyhub.console.v1.KeyChange"> & {
+  /**
+   * @generated from field: keyhub.console.v1.KeyChangeOperation operation = 1;
+   */
+  operation: KeyChangeOperation;
+
+  /**
+   * 変更後の鍵。削除の場合は削除前の鍵
+   *
+   * @generated from field: keyhub.console.v1.Key key = 2;
+   */
+  key?: Key | undefined;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp changed_at = 3;
+   */
+  changedAt?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message keyhub.console.v1.KeyChange.
+ * Use `create(KeyChangeSchema)` to create a new message.
+ */
+export const KeyChangeSchema: GenMessage<KeyChange> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_key, 7);
+
+/**
+ * @generated from message keyhub.console.v1.KeySnapshot
+ */
+export type KeySnapshot = Message<"keyhub.console.v1.KeySnapshot"> & {
+  /**
+   * @generated from field: repeated keyhub.console.v1.Key keys = 1;
+   */
+  keys: Key[];
+};
+
+/**
+ * Describes the message keyhub.console.v1.KeySnapshot.
+ * Use `create(KeySnapshotSchema)` to create a new message.
+ */
+export const KeySnapshotSchema: GenMessage<KeySnapshot> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_key, 8);
+
+/**
+ * 接続を保つために一定間隔で送る。クライアントは読み捨ててよい
+ *
+ * @generated from message keyhub.console.v1.KeyWatchHeartbeat
+ */
+export type KeyWatchHeartbeat = Message<"keyhub.console.v1.KeyWatchHeartbeat"> & {
+};
+
+/**
+ * Describes the message keyhub.console.v1.KeyWatchHeartbeat.
+ * Use `create(KeyWatchHeartbeatSchema)` to create a new message.
+ */
+export const KeyWatchHeartbeatSchema: GenMessage<KeyWatchHeartbeat> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_key, 9);
+
+/**
+ * @generated from message keyhub.console.v1.WatchKeysResponse
+ */
+export type WatchKeysResponse = Message<"keyhub.console.v1.WatchKeysResponse"> & {
+  /**
+   * @generated from oneof keyhub.console.v1.WatchKeysResponse.event
+   */
+  event: {
+    /**
+     * 最初の1回だけ送る
+     *
+     * @generated from field: keyhub.console.v1.KeySnapshot snapshot = 1;
+     */
+    value: KeySnapshot;
+    case: "snapshot";
+  } | {
+    /**
+     * @generated from field: keyhub.console.v1.KeyChange change = 2;
+     */
+    value: KeyChange;
+    case: "change";
+  } | {
+    /**
+     * @generated from field: keyhub.console.v1.KeyWatchHeartbeat heartbeat = 3;
+     */
+    value: KeyWatchHeartbeat;
+    case: "heartbeat";
+  } | { case: undefined; value?: undefined };
+};
+
+/**
+ * Describes the message keyhub.console.v1.WatchKeysResponse.
+ * Use `create(WatchKeysResponseSchema)` to create a new message.
+ */
+export const WatchKeysResponseSchema: GenMessage<WatchKeysResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_key, 10);
+
+/**
+ * @generated from enum keyhub.console.v1.KeyChangeOperation
+ */
+export enum KeyChangeOperation {
+  /**
+   * @generated from enum value: KEY_CHANGE_OPERATION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * 追加
+   *
+   * @generated from enum value: KEY_CHANGE_OPERATION_CREATED = 1;
+   */
+  CREATED = 1,
+
+  /**
+   * 状態などの変更
+   *
+   * @generated from enum value: KEY_CHANGE_OPERATION_UPDATED = 2;
+   */
+  UPDATED = 2,
+
+  /**
+   * 削除
+   *
+   * @generated from enum value: KEY_CHANGE_OPERATION_DELETED = 3;
+   */
+  DELETED = 3,
+}
+
+/**
+ * Describes the enum keyhub.console.v1.KeyChangeOperation.
+ */
+export const KeyChangeOperationSchema: GenEnum<KeyChangeOperation> = /*@__PURE__*/
+  enumDesc(file_keyhub_console_v1_key, 0);
 
 /**
  * @generated from service keyhub.console.v1.ConsoleKeyService
@@ -102,6 +362,17 @@ export const ConsoleKeyService: GenService<{
     input: typeof CreateKeyRequestSchema;
     output: typeof CreateKeyResponseSchema;
   },
+  /**
+   * 鍵番号を変更
+   * 取得後に他の管理者が更新していた場合は ABORTED を返す
+   *
+   * @generated from rpc keyhub.console.v1.ConsoleKeyService.UpdateKey
+   */
+  updateKey: {
+    methodKind: "unary";
+    input: typeof UpdateKeyRequestSchema;
+    output: typeof UpdateKeyResponseSchema;
+  },
   /**
    * Roomに紐付く鍵一覧を取得
    *
@@ -112,6 +383,18 @@ export const ConsoleKeyService: GenService<{
     input: typeof GetKeysByRoomRequestSchema;
     output: typeof GetKeysByRoomResponseSchema;
   },
+  /**
+   * 鍵の状態の変化を購読する（サーバーストリーミング）
+   * 最初に現在の鍵の一覧を返し、その後は鍵が変更されるたびに返す。
+   * 変更を取りこぼした可能性がある場合は UNAVAILABLE で終了するため、購読し直す
+   *
+   * @generated from rpc keyhub.console.v1.ConsoleKeyService.WatchKeys
+   */
+  watchKeys: {
+    methodKind: "server_streaming";
+    input: typeof WatchKeysRequestSchema;
+    output: typeof WatchKeysResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_keyhub_console_v1_key, 0);
 
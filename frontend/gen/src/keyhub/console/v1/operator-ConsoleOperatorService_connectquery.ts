@@ -0,0 +1,26 @@
+// @generated by protoc-gen-connect-query v2.2.0 with parameter "target=ts"
+// @generated from file keyhub/console/v1/operator.proto (package keyhub.console.v1, syntax proto3)
+/* eslint-disable */
+
+import { ConsoleOperatorService } from "./operator_pb";
+
+/**
+ * 管理者登録（ログインキーはこのレスポンスでのみ返す）
+ *
+ * @generated from rpc keyhub.console.v1.ConsoleOperatorService.CreateOperator
+ */
+export const createOperator = ConsoleOperatorService.method.createOperator;
+
+/**
+ * 有効な管理者一覧取得
+ *
+ * @generated from rpc keyhub.console.v1.ConsoleOperatorService.ListOperators
+ */
+export const listOperators = ConsoleOperatorService.method.listOperators;
+
+/**
+ * 管理者の無効化（その管理者のセッションもすべて削除する）
+ *
+ * @generated from rpc keyhub.console.v1.ConsoleOperatorService.RevokeOperator
+ */
+export const revokeOperator = ConsoleOperatorService.method.revokeOperator;
@@ -0,0 +1,195 @@
+// @generated by protoc-gen-es v2.12.0 with parameter "target=ts"
+// @generated from file keyhub/console/v1/operator.proto (package keyhub.console.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_buf_validate_validate } from "../../../buf/validate/validate_pb";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file keyhub/console/v1/operator.proto.
+ */
+export const file_keyhub_console_v1_operator: GenFile = /*@__PURE__*/
+  fileDesc("CiBrZXlodWIvY29uc29sZS92MS9vcGVyYXRvci5wcm90bxIRa2V5aHViLmNvbnNvbGUudjEibAoIT3BlcmF0b3ISFAoCaWQYASABKAlCCLpIBXIDsAEBEgwKBG5hbWUYAiABKAkSDAoEcm9sZRgDIAEoCRIuCgpjcmVhdGVkX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJfChVDcmVhdGVPcGVyYXRvclJlcXVlc3QSFwoEbmFtZRgBIAEoCUIJukgGcgQQARgyEi0KBHJvbGUYAiABKAlCH7pIHHIaUgVhZG1pblIIb3BlcmF0b3JSB2F1ZGl0b3IiVAoWQ3JlYXRlT3BlcmF0b3JSZXNwb25zZRItCghvcGVyYXRvchgBIAEoCzIbLmtleWh1Yi5jb25zb2xlLnYxLk9wZXJhdG9yEgsKA2tleRgCIAEoCSIWChRMaXN0T3BlcmF0b3JzUmVxdWVzdCJHChVMaXN0T3BlcmF0b3JzUmVzcG9uc2USLgoJb3BlcmF0b3JzGAEgAygLMhsua2V5aHViLmNvbnNvbGUudjEuT3BlcmF0b3IiLQoVUmV2b2tlT3BlcmF0b3JSZXF1ZXN0EhQKAmlkGAEgASgJQgi6SAVyA7ABASIYChZSZXZva2VPcGVyYXRvclJlc3BvbnNlMs8CChZDb25zb2xlT3BlcmF0b3JTZXJ2aWNlEmUKDkNyZWF0ZU9wZXJhdG9yEigua2V5aHViLmNvbnNvbGUudjEuQ3JlYXRlT3BlcmF0b3JSZXF1ZXN0Gikua2V5aHViLmNvbnNvbGUudjEuQ3JlYXRlT3BlcmF0b3JSZXNwb25zZRJnCg1MaXN0T3BlcmF0b3JzEicua2V5aHViLmNvbnNvbGUudjEuTGlzdE9wZXJhdG9yc1JlcXVlc3QaKC5rZXlodWIuY29uc29sZS52MS5MaXN0T3BlcmF0b3JzUmVzcG9uc2UiA5ACARJlCg5SZXZva2VPcGVyYXRvchIoLmtleWh1Yi5jb25zb2xlLnYxLlJldm9rZU9wZXJhdG9yUmVxdWVzdBopLmtleWh1Yi5jb25zb2xlLnYxLlJldm9rZU9wZXJhdG9yUmVzcG9uc2VC4QEKFWNvbS5rZXlodWIuY29uc29sZS52MUINT3BlcmF0b3JQcm90b1ABWlNnaXRodWIuY29tL3NoaWJheWFtYS1jbHViL2tleWh1Yi9pbnRlcm5hbC9pbnRlcmZhY2UvZ2VuL2tleWh1Yi9jb25zb2xlL3YxO2NvbnNvbGV2MaICA0tDWKoCEUtleWh1Yi5Db25zb2xlLlYxygIRS2V5aHViXENvbnNvbGVcVjHiAh1LZXlodWJcQ29uc29sZVxWMVxHUEJNZXRhZGF0YeoCE0tleWh1Yjo6Q29uc29sZTo6VjFiBnByb3RvMw", [file_buf_validate_validate, file_google_protobuf_timestamp]);
+
+/**
+ * @generated from message keyhub.console.v1.Operator
+ */
+export type Operator = Message<"keyhub.console.v1.Operator"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * "admin" | "operator" | "auditor"
+   *
+   * @generated from field: string role = 3;
+   */
+  role: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 4;
+   */
+  createdAt?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message keyhub.console.v1.Operator.
+ * Use `create(OperatorSchema)` to create a new message.
+ */
+export const OperatorSchema: GenMessage<Operator> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_operator, 0);
+
+/**
+ * @generated from message keyhub.console.v1.CreateOperatorRequest
+ */
+export type CreateOperatorRequest = Message<"keyhub.console.v1.CreateOperatorRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string role = 2;
+   */
+  role: string;
+};
+
+/**
+ * Describes the message keyhub.console.v1.CreateOperatorRequest.
+ * Use `create(CreateOperatorRequestSchema)` to create a new message.
+ */
+export const CreateOperatorRequestSchema: GenMessage<CreateOperatorRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_operator, 1);
+
+/**
+ * @generated from message keyhub.console.v1.CreateOperatorResponse
+ */
+export type CreateOperatorResponse = Message<"keyhub.console.v1.CreateOperatorResponse"> & {
+  /**
+   * @generated from field: keyhub.console.v1.Operator operator = 1;
+   */
+  operator?: Operator | undefined;
+
+  /**
+   * @generated from field: string key = 2;
+   */
+  key: string;
+};
+
+/**
+ * Describes the message keyhub.console.v1.CreateOperatorResponse.
+ * Use `create(CreateOperatorResponseSchema)` to create a new message.
+ */
+export const CreateOperatorResponseSchema: GenMessage<CreateOperatorResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_operator, 2);
+
+/**
+ * @generated from message keyhub.console.v1.ListOperatorsRequest
+ */
+export type ListOperatorsRequest = Message<"keyhub.console.v1.ListOperatorsRequest"> & {
+};
+
+/**
+ * Describes the message keyhub.console.v1.ListOperatorsRequest.
+ * Use `create(ListOperatorsRequestSchema)` to create a new message.
+ */
+export const ListOperatorsRequestSchema: GenMessage<ListOperatorsRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_operator, 3);
+
+/**
+ * @generated from message keyhub.console.v1.ListOperatorsResponse
+ */
+export type ListOperatorsResponse = Message<"keyhub.console.v1.ListOperatorsResponse"> & {
+  /**
+   * @generated from field: repeated keyhub.console.v1.Operator operators = 1;
+   */
+  operators: Operator[];
+};
+
+/**
+ * Describes the message keyhub.console.v1.ListOperatorsResponse.
+ * Use `create(ListOperatorsResponseSchema)` to create a new message.
+ */
+export const ListOperatorsResponseSchema: GenMessage<ListOperatorsResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_operator, 4);
+
+/**
+ * @generated from message keyhub.console.v1.RevokeOperatorRequest
+ */
+export type RevokeOperatorRequest = Message<"keyhub.console.v1.RevokeOperatorRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message keyhub.console.v1.RevokeOperatorRequest.
+ * Use `create(RevokeOperatorRequestSchema)` to create a new message.
+ */
+export const RevokeOperatorRequestSchema: GenMessage<RevokeOperatorRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_operator, 5);
+
+/**
+ * @generated from message keyhub.console.v1.RevokeOperatorResponse
+ */
+export type RevokeOperatorResponse = Message<"keyhub.console.v1.RevokeOperatorResponse"> & {
+};
+
+/**
+ * Describes the message keyhub.console.v1.RevokeOperatorResponse.
+ * Use `create(RevokeOperatorResponseSchema)` to create a new message.
+ */
+export const RevokeOperatorResponseSchema: GenMessage<RevokeOperatorResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_operator, 6);
+
+/**
+ * 組織のキーを共有せずにコンソールを使うための管理者アカウント管理
+ * 発行したキーは LoginWithOrgId の organization_key に指定してログインする
+ *
+ * @generated from service keyhub.console.v1.ConsoleOperatorService
+ */
+export const ConsoleOperatorService: GenService<{
+  /**
+   * 管理者登録（ログインキーはこのレスポンスでのみ返す）
+   *
+   * @generated from rpc keyhub.console.v1.ConsoleOperatorService.CreateOperator
+   */
+  createOperator: {
+    methodKind: "unary";
+    input: typeof CreateOperatorRequestSchema;
+    output: typeof CreateOperatorResponseSchema;
+  },
+  /**
+   * 有効な管理者一覧取得
+   *
+   * @generated from rpc keyhub.console.v1.ConsoleOperatorService.ListOperators
+   */
+  listOperators: {
+    methodKind: "unary";
+    input: typeof ListOperatorsRequestSchema;
+    output: typeof ListOperatorsResponseSchema;
+  },
+  /**
+   * 管理者の無効化（その管理者のセッションもすべて削除する）
+   *
+   * @generated from rpc keyhub.console.v1.ConsoleOperatorService.RevokeOperator
+   */
+  revokeOperator: {
+    methodKind: "unary";
+    input: typeof RevokeOperatorRequestSchema;
+    output: typeof RevokeOperatorResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_keyhub_console_v1_operator, 0);
+
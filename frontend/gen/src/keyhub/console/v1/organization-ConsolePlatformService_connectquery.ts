@@ -0,0 +1,26 @@
+// @generated by protoc-gen-connect-query v2.2.0 with parameter "target=ts"
+// @generated from file keyhub/console/v1/organization.proto (package keyhub.console.v1, syntax proto3)
+/* eslint-disable */
+
+import { ConsolePlatformService } from "./organization_pb";
+
+/**
+ * 組織作成（Organization Keyはこのレスポンスでのみ返す）
+ *
+ * @generated from rpc keyhub.console.v1.ConsolePlatformService.CreateOrganization
+ */
+export const createOrganization = ConsolePlatformService.method.createOrganization;
+
+/**
+ * 組織一覧取得
+ *
+ * @generated from rpc keyhub.console.v1.ConsolePlatformService.ListOrganizations
+ */
+export const listOrganizations = ConsolePlatformService.method.listOrganizations;
+
+/**
+ * Organization Keyの再発行（既存のコンソールセッションはすべて無効化される）
+ *
+ * @generated from rpc keyhub.console.v1.ConsolePlatformService.RotateOrganizationKey
+ */
+export const rotateOrganizationKey = ConsolePlatformService.method.rotateOrganizationKey;
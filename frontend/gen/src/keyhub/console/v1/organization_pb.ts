@@ -0,0 +1,240 @@
+// @generated by protoc-gen-es v2.12.0 with parameter "target=ts"
+// @generated from file keyhub/console/v1/organization.proto (package keyhub.console.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_buf_validate_validate } from "../../../buf/validate/validate_pb";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file keyhub/console/v1/organization.proto.
+ */
+export const file_keyhub_console_v1_organization: GenFile = /*@__PURE__*/
+  fileDesc("CiRrZXlodWIvY29uc29sZS92MS9vcmdhbml6YXRpb24ucHJvdG8SEWtleWh1Yi5jb25zb2xlLnYxIjgKFE9yZ2FuaXphdGlvblNldHRpbmdzEg4KBmxvY2FsZRgBIAEoCRIQCgh0aW1lem9uZRgCIAEoCSK8AQoMT3JnYW5pemF0aW9uEhQKAmlkGAEgASgJQgi6SAVyA7ABARIMCgRuYW1lGAIgASgJEgwKBHNsdWcYAyABKAkSOQoIc2V0dGluZ3MYBCABKAsyJy5rZXlodWIuY29uc29sZS52MS5Pcmdhbml6YXRpb25TZXR0aW5ncxIPCgdoYXNfa2V5GAUgASgIEi4KCmNyZWF0ZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIqcBChlDcmVhdGVPcmdhbml6YXRpb25SZXF1ZXN0EhcKBG5hbWUYASABKAlCCbpIBnIEEAEYZBI2CgRzbHVnGAIgASgJQii6SCVyIzIhXlthLXowLTldW2EtejAtOS1dezEsNjF9W2EtejAtOV0kEjkKCHNldHRpbmdzGAMgASgLMicua2V5aHViLmNvbnNvbGUudjEuT3JnYW5pemF0aW9uU2V0dGluZ3MibQoaQ3JlYXRlT3JnYW5pemF0aW9uUmVzcG9uc2USNQoMb3JnYW5pemF0aW9uGAEgASgLMh8ua2V5aHViLmNvbnNvbGUudjEuT3JnYW5pemF0aW9uEhgKEG9yZ2FuaXphdGlvbl9rZXkYAiABKAkiGgoYTGlzdE9yZ2FuaXphdGlvbnNSZXF1ZXN0IlMKGUxpc3RPcmdhbml6YXRpb25zUmVzcG9uc2USNgoNb3JnYW5pemF0aW9ucxgBIAMoCzIfLmtleWh1Yi5jb25zb2xlLnYxLk9yZ2FuaXphdGlvbiI0ChxSb3RhdGVPcmdhbml6YXRpb25LZXlSZXF1ZXN0EhQKAmlkGAEgASgJQgi6SAVyA7ABASI5Ch1Sb3RhdGVPcmdhbml6YXRpb25LZXlSZXNwb25zZRIYChBvcmdhbml6YXRpb25fa2V5GAEgASgJMvwCChZDb25zb2xlUGxhdGZvcm1TZXJ2aWNlEnEKEkNyZWF0ZU9yZ2FuaXphdGlvbhIsLmtleWh1Yi5jb25zb2xlLnYxLkNyZWF0ZU9yZ2FuaXphdGlvblJlcXVlc3QaLS5rZXlodWIuY29uc29sZS52MS5DcmVhdGVPcmdhbml6YXRpb25SZXNwb25zZRJzChFMaXN0T3JnYW5pemF0aW9ucxIrLmtleWh1Yi5jb25zb2xlLnYxLkxpc3RPcmdhbml6YXRpb25zUmVxdWVzdBosLmtleWh1Yi5jb25zb2xlLnYxLkxpc3RPcmdhbml6YXRpb25zUmVzcG9uc2UiA5ACARJ6ChVSb3RhdGVPcmdhbml6YXRpb25LZXkSLy5rZXlodWIuY29uc29sZS52MS5Sb3RhdGVPcmdhbml6YXRpb25LZXlSZXF1ZXN0GjAua2V5aHViLmNvbnNvbGUudjEuUm90YXRlT3JnYW5pemF0aW9uS2V5UmVzcG9uc2VC5QEKFWNvbS5rZXlodWIuY29uc29sZS52MUIRT3JnYW5pemF0aW9uUHJvdG9QAVpTZ2l0aHViLmNvbS9zaGliYXlhbWEtY2x1Yi9rZXlodWIvaW50ZXJuYWwvaW50ZXJmYWNlL2dlbi9rZXlodWIvY29uc29sZS92MTtjb25zb2xldjGiAgNLQ1iqAhFLZXlodWIuQ29uc29sZS5WMcoCEUtleWh1YlxDb25zb2xlXFYx4gIdS2V5aHViXENvbnNvbGVcVjFcR1BCTWV0YWRhdGHqAhNLZXlodWI6OkNvbnNvbGU6OlYxYgZwcm90bzM", [file_buf_validate_validate, file_google_protobuf_timestamp]);
+
+/**
+ * @generated from message keyhub.console.v1.OrganizationSettings
+ */
+export type OrganizationSettings = Message<"keyhub.console.v1.OrganizationSettings"> & {
+  /**
+   * "ja" または "en"（省略時は "ja"）
+   *
+   * @generated from field: string locale = 1;
+   */
+  locale: string;
+
+  /**
+   * IANA形式（省略時は "Asia/Tokyo"）
+   *
+   * @generated from field: string timezone = 2;
+   */
+  timezone: string;
+};
+
+/**
+ * Describes the message keyhub.console.v1.OrganizationSettings.
+ * Use `create(OrganizationSettingsSchema)` to create a new message.
+ */
+export const OrganizationSettingsSchema: GenMessage<OrganizationSettings> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_organization, 0);
+
+/**
+ * @generated from message keyhub.console.v1.Organization
+ */
+export type Organization = Message<"keyhub.console.v1.Organization"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string slug = 3;
+   */
+  slug: string;
+
+  /**
+   * @generated from field: keyhub.console.v1.OrganizationSettings settings = 4;
+   */
+  settings?: OrganizationSettings | undefined;
+
+  /**
+   * Organization Keyが発行済みかどうか
+   *
+   * @generated from field: bool has_key = 5;
+   */
+  hasKey: boolean;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 6;
+   */
+  createdAt?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message keyhub.console.v1.Organization.
+ * Use `create(OrganizationSchema)` to create a new message.
+ */
+export const OrganizationSchema: GenMessage<Organization> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_organization, 1);
+
+/**
+ * @generated from message keyhub.console.v1.CreateOrganizationRequest
+ */
+export type CreateOrganizationRequest = Message<"keyhub.console.v1.CreateOrganizationRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string slug = 2;
+   */
+  slug: string;
+
+  /**
+   * @generated from field: keyhub.console.v1.OrganizationSettings settings = 3;
+   */
+  settings?: OrganizationSettings | undefined;
+};
+
+/**
+ * Describes the message keyhub.console.v1.CreateOrganizationRequest.
+ * Use `create(CreateOrganizationRequestSchema)` to create a new message.
+ */
+export const CreateOrganizationRequestSchema: GenMessage<CreateOrganizationRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_organization, 2);
+
+/**
+ * @generated from message keyhub.console.v1.CreateOrganizationResponse
+ */
+export type CreateOrganizationResponse = Message<"keyhub.console.v1.CreateOrganizationResponse"> & {
+  /**
+   * @generated from field: keyhub.console.v1.Organization organization = 1;
+   */
+  organization?: Organization | undefined;
+
+  /**
+   * @generated from field: string organization_key = 2;
+   */
+  organizationKey: string;
+};
+
+/**
+ * Describes the message keyhub.console.v1.CreateOrganizationResponse.
+ * Use `create(CreateOrganizationResponseSchema)` to create a new message.
+ */
+export const CreateOrganizationResponseSchema: GenMessage<CreateOrganizationResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_organization, 3);
+
+/**
+ * @generated from message keyhub.console.v1.ListOrganizationsRequest
+ */
+export type ListOrganizationsRequest = Message<"keyhub.console.v1.ListOrganizationsRequest"> & {
+};
+
+/**
+ * Describes the message keyhub.console.v1.ListOrganizationsRequest.
+ * Use `create(ListOrganizationsRequestSchema)` to create a new message.
+ */
+export const ListOrganizationsRequestSchema: GenMessage<ListOrganizationsRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_organization, 4);
+
+/**
+ * @generated from message keyhub.console.v1.ListOrganizationsResponse
+ */
+export type ListOrganizationsResponse = Message<"keyhub.console.v1.ListOrganizationsResponse"> & {
+  /**
+   * @generated from field: repeated keyhub.console.v1.Organization organizations = 1;
+   */
+  organizations: Organization[];
+};
+
+/**
+ * Describes the message keyhub.console.v1.ListOrganizationsResponse.
+ * Use `create(ListOrganizationsResponseSchema)` to create a new message.
+ */
+export const ListOrganizationsResponseSchema: GenMessage<ListOrganizationsResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_organization, 5);
+
+/**
+ * @generated from message keyhub.console.v1.RotateOrganizationKeyRequest
+ */
+export type RotateOrganizationKeyRequest = Message<"keyhub.console.v1.RotateOrganizationKeyRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message keyhub.console.v1.RotateOrganizationKeyRequest.
+ * Use `create(RotateOrganizationKeyRequestSchema)` to create a new message.
+ */
+export const RotateOrganizationKeyRequestSchema: GenMessage<RotateOrganizationKeyRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_organization, 6);
+
+/**
+ * @generated from message keyhub.console.v1.RotateOrganizationKeyResponse
+ */
+export type RotateOrganizationKeyResponse = Message<"keyhub.console.v1.RotateOrganizationKeyResponse"> & {
+  /**
+   * @generated from field: string organization_key = 1;
+   */
+  organizationKey: string;
+};
+
+/**
+ * Describes the message keyhub.console.v1.RotateOrganizationKeyResponse.
+ * Use `create(RotateOrganizationKeyResponseSchema)` to create a new message.
+ */
+export const RotateOrganizationKeyResponseSchema: GenMessage<RotateOrganizationKeyResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_organization, 7);
+
+/**
+ * プラットフォーム管理者が組織を作成・管理するためのサービス
+ * console.platform_admin_token を Authorization: Bearer <token> で送って利用する
+ *
+ * @generated from service keyhub.console.v1.ConsolePlatformService
+ */
+export const ConsolePlatformService: GenService<{
+  /**
+   * 組織作成（Organization Keyはこのレスポンスでのみ返す）
+   *
+   * @generated from rpc keyhub.console.v1.ConsolePlatformService.CreateOrganization
+   */
+  createOrganization: {
+    methodKind: "unary";
+    input: typeof CreateOrganizationRequestSchema;
+    output: typeof CreateOrganizationResponseSchema;
+  },
+  /**
+   * 組織一覧取得
+   *
+   * @generated from rpc keyhub.console.v1.ConsolePlatformService.ListOrganizations
+   */
+  listOrganizations: {
+    methodKind: "unary";
+    input: typeof ListOrganizationsRequestSchema;
+    output: typeof ListOrganizationsResponseSchema;
+  },
+  /**
+   * Organization Keyの再発行（既存のコンソールセッションはすべて無効化される）
+   *
+   * @generated from rpc keyhub.console.v1.ConsolePlatformService.RotateOrganizationKey
+   */
+  rotateOrganizationKey: {
+    methodKind: "unary";
+    input: typeof RotateOrganizationKeyRequestSchema;
+    output: typeof RotateOrganizationKeyResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_keyhub_console_v1_organization, 0);
+
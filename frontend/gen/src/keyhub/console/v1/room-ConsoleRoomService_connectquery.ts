@@ -24,3 +24,52 @@ export const getAllRooms = ConsoleRoomService.method.getAllRooms;
  * @generated from rpc keyhub.console.v1.ConsoleRoomService.AssignRoomToTenant
  */
 export const assignRoomToTenant = ConsoleRoomService.method.assignRoomToTenant;
+
+/**
+ * 部屋の属性（収容人数・設備・バリアフリー対応・カスタム項目）をすべて置き換える
+ *
+ * @generated from rpc keyhub.console.v1.ConsoleRoomService.UpdateRoomAttributes
+ */
+export const updateRoomAttributes = ConsoleRoomService.method.updateRoomAttributes;
+
+/**
+ * 部屋のカスタム項目を定義
+ *
+ * @generated from rpc keyhub.console.v1.ConsoleRoomService.CreateRoomCustomField
+ */
+export const createRoomCustomField = ConsoleRoomService.method.createRoomCustomField;
+
+/**
+ * 部屋のカスタム項目の定義一覧を取得
+ *
+ * @generated from rpc keyhub.console.v1.ConsoleRoomService.ListRoomCustomFields
+ */
+export const listRoomCustomFields = ConsoleRoomService.method.listRoomCustomFields;
+
+/**
+ * 部屋のカスタム項目の定義を削除（部屋に保存した値も削除される）
+ *
+ * @generated from rpc keyhub.console.v1.ConsoleRoomService.DeleteRoomCustomField
+ */
+export const deleteRoomCustomField = ConsoleRoomService.method.deleteRoomCustomField;
+
+/**
+ * 部屋タイプの一覧を取得（既定のタイプに続けて組織が追加したタイプ）
+ *
+ * @generated from rpc keyhub.console.v1.ConsoleRoomService.ListRoomTypes
+ */
+export const listRoomTypes = ConsoleRoomService.method.listRoomTypes;
+
+/**
+ * 組織の部屋タイプを追加
+ *
+ * @generated from rpc keyhub.console.v1.ConsoleRoomService.CreateRoomType
+ */
+export const createRoomType = ConsoleRoomService.method.createRoomType;
+
+/**
+ * 組織が追加した部屋タイプを削除（そのタイプの部屋が残っている場合は削除できない）
+ *
+ * @generated from rpc keyhub.console.v1.ConsoleRoomService.DeleteRoomType
+ */
+export const deleteRoomType = ConsoleRoomService.method.deleteRoomType;
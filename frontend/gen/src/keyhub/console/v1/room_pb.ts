@@ -2,12 +2,14 @@
 // @generated from file keyhub/console/v1/room.proto (package keyhub.console.v1, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
-import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
 import { file_buf_validate_validate } from "../../../buf/validate/validate_pb";
 import type { Timestamp } from "@bufbuild/protobuf/wkt";
 import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
-import type { Room, RoomType } from "./common_pb";
+import type { Building, Floor } from "./building_pb";
+import { file_keyhub_console_v1_building } from "./building_pb";
+import type { ListOrder, Room, RoomAccessibility, RoomAttributes, RoomType } from "./common_pb";
 import { file_keyhub_console_v1_common } from "./common_pb";
 import type { Message } from "@bufbuild/protobuf";
 
@@ -15,7 +17,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file keyhub/console/v1/room.proto.
  */
 export const file_keyhub_console_v1_room: GenFile = /*@__PURE__*/
-  fileDesc("ChxrZXlodWIvY29uc29sZS92MS9yb29tLnByb3RvEhFrZXlodWIuY29uc29sZS52MSKTAQoRQ3JlYXRlUm9vbVJlcXVlc3QSDAoEbmFtZRgBIAEoCRIVCg1idWlsZGluZ19uYW1lGAIgASgJEhQKDGZsb29yX251bWJlchgDIAEoCRIuCglyb29tX3R5cGUYBCABKA4yGy5rZXlodWIuY29uc29sZS52MS5Sb29tVHlwZRITCgtkZXNjcmlwdGlvbhgFIAEoCSIqChJDcmVhdGVSb29tUmVzcG9uc2USFAoCaWQYASABKAlCCLpIBXIDsAEBIhQKEkdldEFsbFJvb21zUmVxdWVzdCI9ChNHZXRBbGxSb29tc1Jlc3BvbnNlEiYKBXJvb21zGAEgAygLMhcua2V5aHViLmNvbnNvbGUudjEuUm9vbSKXAQoZQXNzaWduUm9vbVRvVGVuYW50UmVxdWVzdBIbCgl0ZW5hbnRfaWQYASABKAlCCLpIBXIDsAEBEhkKB3Jvb21faWQYAiABKAlCCLpIBXIDsAEBEjMKCmV4cGlyZXNfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSACIAQFCDQoLX2V4cGlyZXNfYXQiPQoaQXNzaWduUm9vbVRvVGVuYW50UmVzcG9uc2USHwoNYXNzaWdubWVudF9pZBgBIAEoCUIIukgFcgOwAQEywAIKEkNvbnNvbGVSb29tU2VydmljZRJZCgpDcmVhdGVSb29tEiQua2V5aHViLmNvbnNvbGUudjEuQ3JlYXRlUm9vbVJlcXVlc3QaJS5rZXlodWIuY29uc29sZS52MS5DcmVhdGVSb29tUmVzcG9uc2USXAoLR2V0QWxsUm9vbXMSJS5rZXlodWIuY29uc29sZS52MS5HZXRBbGxSb29tc1JlcXVlc3QaJi5rZXlodWIuY29uc29sZS52MS5HZXRBbGxSb29tc1Jlc3BvbnNlEnEKEkFzc2lnblJvb21Ub1RlbmFudBIsLmtleWh1Yi5jb25zb2xlLnYxLkFzc2lnblJvb21Ub1RlbmFudFJlcXVlc3QaLS5rZXlodWIuY29uc29sZS52MS5Bc3NpZ25Sb29tVG9UZW5hbnRSZXNwb25zZULdAQoVY29tLmtleWh1Yi5jb25zb2xlLnYxQglSb29tUHJvdG9QAVpTZ2l0aHViLmNvbS9zaGliYXlhbWEtY2x1Yi9rZXlodWIvaW50ZXJuYWwvaW50ZXJmYWNlL2dlbi9rZXlodWIvY29uc29sZS92MTtjb25zb2xldjGiAgNLQ1iqAhFLZXlodWIuQ29uc29sZS5WMcoCEUtleWh1YlxDb25zb2xlXFYx4gIdS2V5aHViXENvbnNvbGVcVjFcR1BCTWV0YWRhdGHqAhNLZXlodWI6OkNvbnNvbGU6OlYxYgZwcm90bzM", [file_buf_validate_validate, file_google_protobuf_timestamp, file_keyhub_console_v1_common]);
+  fileDesc("ChxrZXlodWIvY29uc29sZS92MS9yb29tLnByb3RvEhFrZXlodWIuY29uc29sZS52MSL5AQoRQ3JlYXRlUm9vbVJlcXVlc3QSDAoEbmFtZRgBIAEoCRIuCglyb29tX3R5cGUYBCABKA4yGy5rZXlodWIuY29uc29sZS52MS5Sb29tVHlwZRITCgtkZXNjcmlwdGlvbhgFIAEoCRIaCghmbG9vcl9pZBgGIAEoCUIIukgFcgOwAQESNQoKYXR0cmlidXRlcxgHIAEoCzIhLmtleWh1Yi5jb25zb2xlLnYxLlJvb21BdHRyaWJ1dGVzEhUKDXJvb21fdHlwZV9rZXkYCCABKAlKBAgCEANKBAgDEARSDWJ1aWxkaW5nX25hbWVSDGZsb29yX251bWJlciIqChJDcmVhdGVSb29tUmVzcG9uc2USFAoCaWQYASABKAlCCLpIBXIDsAEBIu4EChJHZXRBbGxSb29tc1JlcXVlc3QSHQoJcGFnZV9zaXplGAEgASgFQgq6SAcaBRjIASgAEhIKCnBhZ2VfdG9rZW4YAiABKAkSKwoFb3JkZXIYAyABKA4yHC5rZXlodWIuY29uc29sZS52MS5MaXN0T3JkZXISFQoNYnVpbGRpbmdfbmFtZRgEIAEoCRIUCgxmbG9vcl9udW1iZXIYBSABKAkSLgoJcm9vbV90eXBlGAYgASgOMhsua2V5aHViLmNvbnNvbGUudjEuUm9vbVR5cGUSEwoLbmFtZV9wcmVmaXgYByABKAkSIgoLYnVpbGRpbmdfaWQYCCABKAlIAEIIukgFcgOwAQGIAQESHwoIZmxvb3JfaWQYCSABKAlIAUIIukgFcgOwAQGIAQESIAoMbWluX2NhcGFjaXR5GAsgASgFQgq6SAcaBRiQTigAEhEKCWVxdWlwbWVudBgMIAMoCRI7Cg1hY2Nlc3NpYmlsaXR5GA0gAygOMiQua2V5aHViLmNvbnNvbGUudjEuUm9vbUFjY2Vzc2liaWxpdHkSTgoNY3VzdG9tX2ZpZWxkcxgOIAMoCzI3LmtleWh1Yi5jb25zb2xlLnYxLkdldEFsbFJvb21zUmVxdWVzdC5DdXN0b21GaWVsZHNFbnRyeRIVCg1yb29tX3R5cGVfa2V5GA8gASgJEhYKDmdyb3VwX2J5X2Zsb29yGAogASgIGjMKEUN1c3RvbUZpZWxkc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAFCDgoMX2J1aWxkaW5nX2lkQgsKCV9mbG9vcl9pZCKLAQoTR2V0QWxsUm9vbXNSZXNwb25zZRImCgVyb29tcxgBIAMoCzIXLmtleWh1Yi5jb25zb2xlLnYxLlJvb20SFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJEjMKCWJ1aWxkaW5ncxgDIAMoCzIgLmtleWh1Yi5jb25zb2xlLnYxLkJ1aWxkaW5nUm9vbXMibQoNQnVpbGRpbmdSb29tcxItCghidWlsZGluZxgBIAEoCzIbLmtleWh1Yi5jb25zb2xlLnYxLkJ1aWxkaW5nEi0KBmZsb29ycxgCIAMoCzIdLmtleWh1Yi5jb25zb2xlLnYxLkZsb29yUm9vbXMiXQoKRmxvb3JSb29tcxInCgVmbG9vchgBIAEoCzIYLmtleWh1Yi5jb25zb2xlLnYxLkZsb29yEiYKBXJvb21zGAIgAygLMhcua2V5aHViLmNvbnNvbGUudjEuUm9vbSKFAgoZQXNzaWduUm9vbVRvVGVuYW50UmVxdWVzdBIbCgl0ZW5hbnRfaWQYASABKAlCCLpIBXIDsAEBEhkKB3Jvb21faWQYAiABKAlCCLpIBXIDsAEBEjMKCmV4cGlyZXNfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSACIAQESHwoIZ3JvdXBfaWQYBCABKAlIAUIIukgFcgOwAQGIAQESKAoRa2V5X2xvYW5fZ3JvdXBfaWQYBSABKAlIAkIIukgFcgOwAQGIAQFCDQoLX2V4cGlyZXNfYXRCCwoJX2dyb3VwX2lkQhQKEl9rZXlfbG9hbl9ncm91cF9pZCI9ChpBc3NpZ25Sb29tVG9UZW5hbnRSZXNwb25zZRIfCg1hc3NpZ25tZW50X2lkGAEgASgJQgi6SAVyA7ABASKMAQobVXBkYXRlUm9vbUF0dHJpYnV0ZXNSZXF1ZXN0EhkKB3Jvb21faWQYASABKAlCCLpIBXIDsAEBEjUKCmF0dHJpYnV0ZXMYAiABKAsyIS5rZXlodWIuY29uc29sZS52MS5Sb29tQXR0cmlidXRlcxIbCgd2ZXJzaW9uGAMgASgDQgq6SAfIAQEiAiAAIkUKHFVwZGF0ZVJvb21BdHRyaWJ1dGVzUmVzcG9uc2USJQoEcm9vbRgBIAEoCzIXLmtleWh1Yi5jb25zb2xlLnYxLlJvb20izAEKD1Jvb21DdXN0b21GaWVsZBIUCgJpZBgBIAEoCUIIukgFcgOwAQESCwoDa2V5GAIgASgJEg0KBWxhYmVsGAMgASgJEjQKBHR5cGUYBCABKA4yJi5rZXlodWIuY29uc29sZS52MS5Sb29tQ3VzdG9tRmllbGRUeXBlEhAKCHJlcXVpcmVkGAUgASgIEg8KB29wdGlvbnMYBiADKAkSLgoKY3JlYXRlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAi0wEKHENyZWF0ZVJvb21DdXN0b21GaWVsZFJlcXVlc3QSKgoDa2V5GAEgASgJQh26SBpyGDIWXlthLXpdW2EtejAtOV9dezAsMjl9JBIYCgVsYWJlbBgCIAEoCUIJukgGcgQQARgyEkAKBHR5cGUYAyABKA4yJi5rZXlodWIuY29uc29sZS52MS5Sb29tQ3VzdG9tRmllbGRUeXBlQgq6SAeCAQQQASAAEhAKCHJlcXVpcmVkGAQgASgIEhkKB29wdGlvbnMYBSADKAlCCLpIBZIBAhgBIlIKHUNyZWF0ZVJvb21DdXN0b21GaWVsZFJlc3BvbnNlEjEKBWZpZWxkGAEgASgLMiIua2V5aHViLmNvbnNvbGUudjEuUm9vbUN1c3RvbUZpZWxkIh0KG0xpc3RSb29tQ3VzdG9tRmllbGRzUmVxdWVzdCJSChxMaXN0Um9vbUN1c3RvbUZpZWxkc1Jlc3BvbnNlEjIKBmZpZWxkcxgBIAMoCzIiLmtleWh1Yi5jb25zb2xlLnYxLlJvb21DdXN0b21GaWVsZCI0ChxEZWxldGVSb29tQ3VzdG9tRmllbGRSZXF1ZXN0EhQKAmlkGAEgASgJQgi6SAVyA7ABASIfCh1EZWxldGVSb29tQ3VzdG9tRmllbGRSZXNwb25zZSKuAQoSUm9vbVR5cGVEZWZpbml0aW9uEgoKAmlkGAEgASgJEgsKA2tleRgCIAEoCRINCgVsYWJlbBgDIAEoCRIQCghidWlsdF9pbhgEIAEoCBIuCglyb29tX3R5cGUYBSABKA4yGy5rZXlodWIuY29uc29sZS52MS5Sb29tVHlwZRIuCgpjcmVhdGVkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCIWChRMaXN0Um9vbVR5cGVzUmVxdWVzdCJSChVMaXN0Um9vbVR5cGVzUmVzcG9uc2USOQoKcm9vbV90eXBlcxgBIAMoCzIlLmtleWh1Yi5jb25zb2xlLnYxLlJvb21UeXBlRGVmaW5pdGlvbiJdChVDcmVhdGVSb29tVHlwZVJlcXVlc3QSKgoDa2V5GAEgASgJQh26SBpyGDIWXlthLXpdW2EtejAtOV9dezAsMjl9JBIYCgVsYWJlbBgCIAEoCUIJukgGcgQQARgeIlIKFkNyZWF0ZVJvb21UeXBlUmVzcG9uc2USOAoJcm9vbV90eXBlGAEgASgLMiUua2V5aHViLmNvbnNvbGUudjEuUm9vbVR5cGVEZWZpbml0aW9uIi0KFURlbGV0ZVJvb21UeXBlUmVxdWVzdBIUCgJpZBgBIAEoCUIIukgFcgOwAQEiGAoWRGVsZXRlUm9vbVR5cGVSZXNwb25zZSrIAQoTUm9vbUN1c3RvbUZpZWxkVHlwZRImCiJST09NX0NVU1RPTV9GSUVMRF9UWVBFX1VOU1BFQ0lGSUVEEAASHwobUk9PTV9DVVNUT01fRklFTERfVFlQRV9URVhUEAESIQodUk9PTV9DVVNUT01fRklFTERfVFlQRV9OVU1CRVIQAhIiCh5ST09NX0NVU1RPTV9GSUVMRF9UWVBFX0JPT0xFQU4QAxIhCh1ST09NX0NVU1RPTV9GSUVMRF9UWVBFX1NFTEVDVBAEMuYIChJDb25zb2xlUm9vbVNlcnZpY2USWQoKQ3JlYXRlUm9vbRIkLmtleWh1Yi5jb25zb2xlLnYxLkNyZWF0ZVJvb21SZXF1ZXN0GiUua2V5aHViLmNvbnNvbGUudjEuQ3JlYXRlUm9vbVJlc3BvbnNlElwKC0dldEFsbFJvb21zEiUua2V5aHViLmNvbnNvbGUudjEuR2V0QWxsUm9vbXNSZXF1ZXN0GiYua2V5aHViLmNvbnNvbGUudjEuR2V0QWxsUm9vbXNSZXNwb25zZRJxChJBc3NpZ25Sb29tVG9UZW5hbnQSLC5rZXlodWIuY29uc29sZS52MS5Bc3NpZ25Sb29tVG9UZW5hbnRSZXF1ZXN0Gi0ua2V5aHViLmNvbnNvbGUudjEuQXNzaWduUm9vbVRvVGVuYW50UmVzcG9uc2USdwoUVXBkYXRlUm9vbUF0dHJpYnV0ZXMSLi5rZXlodWIuY29uc29sZS52MS5VcGRhdGVSb29tQXR0cmlidXRlc1JlcXVlc3QaLy5rZXlodWIuY29uc29sZS52MS5VcGRhdGVSb29tQXR0cmlidXRlc1Jlc3BvbnNlEnoKFUNyZWF0ZVJvb21DdXN0b21GaWVsZBIvLmtleWh1Yi5jb25zb2xlLnYxLkNyZWF0ZVJvb21DdXN0b21GaWVsZFJlcXVlc3QaMC5rZXlodWIuY29uc29sZS52MS5DcmVhdGVSb29tQ3VzdG9tRmllbGRSZXNwb25zZRJ8ChRMaXN0Um9vbUN1c3RvbUZpZWxkcxIuLmtleWh1Yi5jb25zb2xlLnYxLkxpc3RSb29tQ3VzdG9tRmllbGRzUmVxdWVzdBovLmtleWh1Yi5jb25zb2xlLnYxLkxpc3RSb29tQ3VzdG9tRmllbGRzUmVzcG9uc2UiA5ACARJ6ChVEZWxldGVSb29tQ3VzdG9tRmllbGQSLy5rZXlodWIuY29uc29sZS52MS5EZWxldGVSb29tQ3VzdG9tRmllbGRSZXF1ZXN0GjAua2V5aHViLmNvbnNvbGUudjEuRGVsZXRlUm9vbUN1c3RvbUZpZWxkUmVzcG9uc2USZwoNTGlzdFJvb21UeXBlcxInLmtleWh1Yi5jb25zb2xlLnYxLkxpc3RSb29tVHlwZXNSZXF1ZXN0Gigua2V5aHViLmNvbnNvbGUudjEuTGlzdFJvb21UeXBlc1Jlc3BvbnNlIgOQAgESZQoOQ3JlYXRlUm9vbVR5cGUSKC5rZXlodWIuY29uc29sZS52MS5DcmVhdGVSb29tVHlwZVJlcXVlc3QaKS5rZXlodWIuY29uc29sZS52MS5DcmVhdGVSb29tVHlwZVJlc3BvbnNlEmUKDkRlbGV0ZVJvb21UeXBlEigua2V5aHViLmNvbnNvbGUudjEuRGVsZXRlUm9vbVR5cGVSZXF1ZXN0Gikua2V5aHViLmNvbnNvbGUudjEuRGVsZXRlUm9vbVR5cGVSZXNwb25zZULdAQoVY29tLmtleWh1Yi5jb25zb2xlLnYxQglSb29tUHJvdG9QAVpTZ2l0aHViLmNvbS9zaGliYXlhbWEtY2x1Yi9rZXlodWIvaW50ZXJuYWwvaW50ZXJmYWNlL2dlbi9rZXlodWIvY29uc29sZS92MTtjb25zb2xldjGiAgNLQ1iqAhFLZXlodWIuQ29uc29sZS5WMcoCEUtleWh1YlxDb25zb2xlXFYx4gIdS2V5aHViXENvbnNvbGVcVjFcR1BCTWV0YWRhdGHqAhNLZXlodWI6OkNvbnNvbGU6OlYxYgZwcm90bzM", [file_buf_validate_validate, file_google_protobuf_timestamp, file_keyhub_console_v1_building, file_keyhub_console_v1_common]);
 
 /**
  * @generated from message keyhub.console.v1.CreateRoomRequest
@@ -27,24 +29,33 @@ export type CreateRoomRequest = Message<"keyhub.console.v1.CreateRoomRequest"> &
   name: string;
 
   /**
-   * @generated from field: string building_name = 2;
+   * @generated from field: keyhub.console.v1.RoomType room_type = 4;
    */
-  buildingName: string;
+  roomType: RoomType;
 
   /**
-   * @generated from field: string floor_number = 3;
+   * @generated from field: string description = 5;
    */
-  floorNumber: string;
+  description: string;
 
   /**
-   * @generated from field: keyhub.console.v1.RoomType room_type = 4;
+   * 部屋を置く階。建物名・階は階から決まる
+   *
+   * @generated from field: string floor_id = 6;
    */
-  roomType: RoomType;
+  floorId: string;
 
   /**
-   * @generated from field: string description = 5;
+   * @generated from field: keyhub.console.v1.RoomAttributes attributes = 7;
    */
-  description: string;
+  attributes?: RoomAttributes | undefined;
+
+  /**
+   * 組織が追加した部屋タイプのキー。指定した場合は room_type より優先する
+   *
+   * @generated from field: string room_type_key = 8;
+   */
+  roomTypeKey: string;
 };
 
 /**
@@ -75,6 +86,100 @@ export const CreateRoomResponseSchema: GenMessage<CreateRoomResponse> = /*@__PUR
  * @generated from message keyhub.console.v1.GetAllRoomsRequest
  */
 export type GetAllRoomsRequest = Message<"keyhub.console.v1.GetAllRoomsRequest"> & {
+  /**
+   * 省略時50件
+   *
+   * @generated from field: int32 page_size = 1;
+   */
+  pageSize: number;
+
+  /**
+   * 前回のレスポンスの next_page_token。条件と並び順は前回と同じにする
+   *
+   * @generated from field: string page_token = 2;
+   */
+  pageToken: string;
+
+  /**
+   * @generated from field: keyhub.console.v1.ListOrder order = 3;
+   */
+  order: ListOrder;
+
+  /**
+   * 以下の条件は指定したものだけで絞り込む
+   *
+   * @generated from field: string building_name = 4;
+   */
+  buildingName: string;
+
+  /**
+   * @generated from field: string floor_number = 5;
+   */
+  floorNumber: string;
+
+  /**
+   * @generated from field: keyhub.console.v1.RoomType room_type = 6;
+   */
+  roomType: RoomType;
+
+  /**
+   * 部屋名の前方一致
+   *
+   * @generated from field: string name_prefix = 7;
+   */
+  namePrefix: string;
+
+  /**
+   * @generated from field: optional string building_id = 8;
+   */
+  buildingId?: string | undefined;
+
+  /**
+   * @generated from field: optional string floor_id = 9;
+   */
+  floorId?: string | undefined;
+
+  /**
+   * 収容人数がこの値以上
+   *
+   * @generated from field: int32 min_capacity = 11;
+   */
+  minCapacity: number;
+
+  /**
+   * すべての設備を持つ部屋
+   *
+   * @generated from field: repeated string equipment = 12;
+   */
+  equipment: string[];
+
+  /**
+   * すべてのバリアフリー対応を持つ部屋
+   *
+   * @generated from field: repeated keyhub.console.v1.RoomAccessibility accessibility = 13;
+   */
+  accessibility: RoomAccessibility[];
+
+  /**
+   * カスタム項目の値が一致する部屋
+   *
+   * @generated from field: map<string, string> custom_fields = 14;
+   */
+  customFields: { [key: string]: string };
+
+  /**
+   * 部屋タイプのキー。指定した場合は room_type より優先する
+   *
+   * @generated from field: string room_type_key = 15;
+   */
+  roomTypeKey: string;
+
+  /**
+   * true の場合、取得したページの部屋を建物・階ごとにまとめた buildings も返す
+   *
+   * @generated from field: bool group_by_floor = 10;
+   */
+  groupByFloor: boolean;
 };
 
 /**
@@ -92,6 +197,20 @@ export type GetAllRoomsResponse = Message<"keyhub.console.v1.GetAllRoomsResponse
    * @generated from field: repeated keyhub.console.v1.Room rooms = 1;
    */
   rooms: Room[];
+
+  /**
+   * 続きがない場合は空
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+
+  /**
+   * group_by_floor を指定した場合だけ返す
+   *
+   * @generated from field: repeated keyhub.console.v1.BuildingRooms buildings = 3;
+   */
+  buildings: BuildingRooms[];
 };
 
 /**
@@ -101,6 +220,52 @@ export type GetAllRoomsResponse = Message<"keyhub.console.v1.GetAllRoomsResponse
 export const GetAllRoomsResponseSchema: GenMessage<GetAllRoomsResponse> = /*@__PURE__*/
   messageDesc(file_keyhub_console_v1_room, 3);
 
+/**
+ * 建物ごとの部屋。建物は名前順、階は level の昇順に並ぶ
+ *
+ * @generated from message keyhub.console.v1.BuildingRooms
+ */
+export type BuildingRooms = Message<"keyhub.console.v1.BuildingRooms"> & {
+  /**
+   * @generated from field: keyhub.console.v1.Building building = 1;
+   */
+  building?: Building | undefined;
+
+  /**
+   * @generated from field: repeated keyhub.console.v1.FloorRooms floors = 2;
+   */
+  floors: FloorRooms[];
+};
+
+/**
+ * Describes the message keyhub.console.v1.BuildingRooms.
+ * Use `create(BuildingRoomsSchema)` to create a new message.
+ */
+export const BuildingRoomsSchema: GenMessage<BuildingRooms> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_room, 4);
+
+/**
+ * @generated from message keyhub.console.v1.FloorRooms
+ */
+export type FloorRooms = Message<"keyhub.console.v1.FloorRooms"> & {
+  /**
+   * @generated from field: keyhub.console.v1.Floor floor = 1;
+   */
+  floor?: Floor | undefined;
+
+  /**
+   * @generated from field: repeated keyhub.console.v1.Room rooms = 2;
+   */
+  rooms: Room[];
+};
+
+/**
+ * Describes the message keyhub.console.v1.FloorRooms.
+ * Use `create(FloorRoomsSchema)` to create a new message.
+ */
+export const FloorRoomsSchema: GenMessage<FloorRooms> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_room, 5);
+
 /**
  * @generated from message keyhub.console.v1.AssignRoomToTenantRequest
  */
@@ -119,6 +284,20 @@ export type AssignRoomToTenantRequest = Message<"keyhub.console.v1.AssignRoomToT
    * @generated from field: optional google.protobuf.Timestamp expires_at = 3;
    */
   expiresAt?: Timestamp | undefined;
+
+  /**
+   * 指定するとそのグループ（子グループを含む）のメンバーだけが部屋を利用できる
+   *
+   * @generated from field: optional string group_id = 4;
+   */
+  groupId?: string | undefined;
+
+  /**
+   * 指定すると鍵を借りられるのはそのグループのメンバーだけになる
+   *
+   * @generated from field: optional string key_loan_group_id = 5;
+   */
+  keyLoanGroupId?: string | undefined;
 };
 
 /**
@@ -126,7 +305,7 @@ export type AssignRoomToTenantRequest = Message<"keyhub.console.v1.AssignRoomToT
  * Use `create(AssignRoomToTenantRequestSchema)` to create a new message.
  */
 export const AssignRoomToTenantRequestSchema: GenMessage<AssignRoomToTenantRequest> = /*@__PURE__*/
-  messageDesc(file_keyhub_console_v1_room, 4);
+  messageDesc(file_keyhub_console_v1_room, 6);
 
 /**
  * @generated from message keyhub.console.v1.AssignRoomToTenantResponse
@@ -143,7 +322,409 @@ export type AssignRoomToTenantResponse = Message<"keyhub.console.v1.AssignRoomTo
  * Use `create(AssignRoomToTenantResponseSchema)` to create a new message.
  */
 export const AssignRoomToTenantResponseSchema: GenMessage<AssignRoomToTenantResponse> = /*@__PURE__*/
-  messageDesc(file_keyhub_console_v1_room, 5);
+  messageDesc(file_keyhub_console_v1_room, 7);
+
+/**
+ * @generated from message keyhub.console.v1.UpdateRoomAttributesRequest
+ */
+export type UpdateRoomAttributesRequest = Message<"keyhub.console.v1.UpdateRoomAttributesRequest"> & {
+  /**
+   * @generated from field: string room_id = 1;
+   */
+  roomId: string;
+
+  /**
+   * @generated from field: keyhub.console.v1.RoomAttributes attributes = 2;
+   */
+  attributes?: RoomAttributes | undefined;
+
+  /**
+   * 取得時の Room.version。他の更新で版数が変わっていれば更新せず ABORTED を返す
+   *
+   * @generated from field: int64 version = 3;
+   */
+  version: bigint;
+};
+
+/**
+ * Describes the message keyhub.console.v1.UpdateRoomAttributesRequest.
+ * Use `create(UpdateRoomAttributesRequestSchema)` to create a new message.
+ */
+export const UpdateRoomAttributesRequestSchema: GenMessage<UpdateRoomAttributesRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_room, 8);
+
+/**
+ * @generated from message keyhub.console.v1.UpdateRoomAttributesResponse
+ */
+export type UpdateRoomAttributesResponse = Message<"keyhub.console.v1.UpdateRoomAttributesResponse"> & {
+  /**
+   * @generated from field: keyhub.console.v1.Room room = 1;
+   */
+  room?: Room | undefined;
+};
+
+/**
+ * Describes the message keyhub.console.v1.UpdateRoomAttributesResponse.
+ * Use `create(UpdateRoomAttributesResponseSchema)` to create a new message.
+ */
+export const UpdateRoomAttributesResponseSchema: GenMessage<UpdateRoomAttributesResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_room, 9);
+
+/**
+ * @generated from message keyhub.console.v1.RoomCustomField
+ */
+export type RoomCustomField = Message<"keyhub.console.v1.RoomCustomField"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string key = 2;
+   */
+  key: string;
+
+  /**
+   * @generated from field: string label = 3;
+   */
+  label: string;
+
+  /**
+   * @generated from field: keyhub.console.v1.RoomCustomFieldType type = 4;
+   */
+  type: RoomCustomFieldType;
+
+  /**
+   * @generated from field: bool required = 5;
+   */
+  required: boolean;
+
+  /**
+   * @generated from field: repeated string options = 6;
+   */
+  options: string[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message keyhub.console.v1.RoomCustomField.
+ * Use `create(RoomCustomFieldSchema)` to create a new message.
+ */
+export const RoomCustomFieldSchema: GenMessage<RoomCustomField> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_room, 10);
+
+/**
+ * @generated from message keyhub.console.v1.CreateRoomCustomFieldRequest
+ */
+export type CreateRoomCustomFieldRequest = Message<"keyhub.console.v1.CreateRoomCustomFieldRequest"> & {
+  /**
+   * @generated from field: string key = 1;
+   */
+  key: string;
+
+  /**
+   * @generated from field: string label = 2;
+   */
+  label: string;
+
+  /**
+   * @generated from field: keyhub.console.v1.RoomCustomFieldType type = 3;
+   */
+  type: RoomCustomFieldType;
+
+  /**
+   * 必須の項目は部屋の作成・属性の更新で値が必要になる
+   *
+   * @generated from field: bool required = 4;
+   */
+  required: boolean;
+
+  /**
+   * type が SELECT の場合だけ指定する
+   *
+   * @generated from field: repeated string options = 5;
+   */
+  options: string[];
+};
+
+/**
+ * Describes the message keyhub.console.v1.CreateRoomCustomFieldRequest.
+ * Use `create(CreateRoomCustomFieldRequestSchema)` to create a new message.
+ */
+export const CreateRoomCustomFieldRequestSchema: GenMessage<CreateRoomCustomFieldRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_room, 11);
+
+/**
+ * @generated from message keyhub.console.v1.CreateRoomCustomFieldResponse
+ */
+export type CreateRoomCustomFieldResponse = Message<"keyhub.console.v1.CreateRoomCustomFieldResponse"> & {
+  /**
+   * @generated from field: keyhub.console.v1.RoomCustomField field = 1;
+   */
+  field?: RoomCustomField | undefined;
+};
+
+/**
+ * Describes the message keyhub.console.v1.CreateRoomCustomFieldResponse.
+ * Use `create(CreateRoomCustomFieldResponseSchema)` to create a new message.
+ */
+export const CreateRoomCustomFieldResponseSchema: GenMessage<CreateRoomCustomFieldResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_room, 12);
+
+/**
+ * @generated from message keyhub.console.v1.ListRoomCustomFieldsRequest
+ */
+export type ListRoomCustomFieldsRequest = Message<"keyhub.console.v1.ListRoomCustomFieldsRequest"> & {
+};
+
+/**
+ * Describes the message keyhub.console.v1.ListRoomCustomFieldsRequest.
+ * Use `create(ListRoomCustomFieldsRequestSchema)` to create a new message.
+ */
+export const ListRoomCustomFieldsRequestSchema: GenMessage<ListRoomCustomFieldsRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_room, 13);
+
+/**
+ * @generated from message keyhub.console.v1.ListRoomCustomFieldsResponse
+ */
+export type ListRoomCustomFieldsResponse = Message<"keyhub.console.v1.ListRoomCustomFieldsResponse"> & {
+  /**
+   * @generated from field: repeated keyhub.console.v1.RoomCustomField fields = 1;
+   */
+  fields: RoomCustomField[];
+};
+
+/**
+ * Describes the message keyhub.console.v1.ListRoomCustomFieldsResponse.
+ * Use `create(ListRoomCustomFieldsResponseSchema)` to create a new message.
+ */
+export const ListRoomCustomFieldsResponseSchema: GenMessage<ListRoomCustomFieldsResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_room, 14);
+
+/**
+ * @generated from message keyhub.console.v1.DeleteRoomCustomFieldRequest
+ */
+export type DeleteRoomCustomFieldRequest = Message<"keyhub.console.v1.DeleteRoomCustomFieldRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message keyhub.console.v1.DeleteRoomCustomFieldRequest.
+ * Use `create(DeleteRoomCustomFieldRequestSchema)` to create a new message.
+ */
+export const DeleteRoomCustomFieldRequestSchema: GenMessage<DeleteRoomCustomFieldRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_room, 15);
+
+/**
+ * @generated from message keyhub.console.v1.DeleteRoomCustomFieldResponse
+ */
+export type DeleteRoomCustomFieldResponse = Message<"keyhub.console.v1.DeleteRoomCustomFieldResponse"> & {
+};
+
+/**
+ * Describes the message keyhub.console.v1.DeleteRoomCustomFieldResponse.
+ * Use `create(DeleteRoomCustomFieldResponseSchema)` to create a new message.
+ */
+export const DeleteRoomCustomFieldResponseSchema: GenMessage<DeleteRoomCustomFieldResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_room, 16);
+
+/**
+ * @generated from message keyhub.console.v1.RoomTypeDefinition
+ */
+export type RoomTypeDefinition = Message<"keyhub.console.v1.RoomTypeDefinition"> & {
+  /**
+   * 既定のタイプは空
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string key = 2;
+   */
+  key: string;
+
+  /**
+   * @generated from field: string label = 3;
+   */
+  label: string;
+
+  /**
+   * 全組織で使える既定のタイプ。削除できない
+   *
+   * @generated from field: bool built_in = 4;
+   */
+  builtIn: boolean;
+
+  /**
+   * 既定のタイプの列挙値。組織が追加したタイプは UNSPECIFIED
+   *
+   * @generated from field: keyhub.console.v1.RoomType room_type = 5;
+   */
+  roomType: RoomType;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 6;
+   */
+  createdAt?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message keyhub.console.v1.RoomTypeDefinition.
+ * Use `create(RoomTypeDefinitionSchema)` to create a new message.
+ */
+export const RoomTypeDefinitionSchema: GenMessage<RoomTypeDefinition> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_room, 17);
+
+/**
+ * @generated from message keyhub.console.v1.ListRoomTypesRequest
+ */
+export type ListRoomTypesRequest = Message<"keyhub.console.v1.ListRoomTypesRequest"> & {
+};
+
+/**
+ * Describes the message keyhub.console.v1.ListRoomTypesRequest.
+ * Use `create(ListRoomTypesRequestSchema)` to create a new message.
+ */
+export const ListRoomTypesRequestSchema: GenMessage<ListRoomTypesRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_room, 18);
+
+/**
+ * @generated from message keyhub.console.v1.ListRoomTypesResponse
+ */
+export type ListRoomTypesResponse = Message<"keyhub.console.v1.ListRoomTypesResponse"> & {
+  /**
+   * @generated from field: repeated keyhub.console.v1.RoomTypeDefinition room_types = 1;
+   */
+  roomTypes: RoomTypeDefinition[];
+};
+
+/**
+ * Describes the message keyhub.console.v1.ListRoomTypesResponse.
+ * Use `create(ListRoomTypesResponseSchema)` to create a new message.
+ */
+export const ListRoomTypesResponseSchema: GenMessage<ListRoomTypesResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_room, 19);
+
+/**
+ * @generated from message keyhub.console.v1.CreateRoomTypeRequest
+ */
+export type CreateRoomTypeRequest = Message<"keyhub.console.v1.CreateRoomTypeRequest"> & {
+  /**
+   * @generated from field: string key = 1;
+   */
+  key: string;
+
+  /**
+   * @generated from field: string label = 2;
+   */
+  label: string;
+};
+
+/**
+ * Describes the message keyhub.console.v1.CreateRoomTypeRequest.
+ * Use `create(CreateRoomTypeRequestSchema)` to create a new message.
+ */
+export const CreateRoomTypeRequestSchema: GenMessage<CreateRoomTypeRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_room, 20);
+
+/**
+ * @generated from message keyhub.console.v1.CreateRoomTypeResponse
+ */
+export type CreateRoomTypeResponse = Message<"keyhub.console.v1.CreateRoomTypeResponse"> & {
+  /**
+   * @generated from field: keyhub.console.v1.RoomTypeDefinition room_type = 1;
+   */
+  roomType?: RoomTypeDefinition | undefined;
+};
+
+/**
+ * Describes the message keyhub.console.v1.CreateRoomTypeResponse.
+ * Use `create(CreateRoomTypeResponseSchema)` to create a new message.
+ */
+export const CreateRoomTypeResponseSchema: GenMessage<CreateRoomTypeResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_room, 21);
+
+/**
+ * @generated from message keyhub.console.v1.DeleteRoomTypeRequest
+ */
+export type DeleteRoomTypeRequest = Message<"keyhub.console.v1.DeleteRoomTypeRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message keyhub.console.v1.DeleteRoomTypeRequest.
+ * Use `create(DeleteRoomTypeRequestSchema)` to create a new message.
+ */
+export const DeleteRoomTypeRequestSchema: GenMessage<DeleteRoomTypeRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_room, 22);
+
+/**
+ * @generated from message keyhub.console.v1.DeleteRoomTypeResponse
+ */
+export type DeleteRoomTypeResponse = Message<"keyhub.console.v1.DeleteRoomTypeResponse"> & {
+};
+
+/**
+ * Describes the message keyhub.console.v1.DeleteRoomTypeResponse.
+ * Use `create(DeleteRoomTypeResponseSchema)` to create a new message.
+ */
+export const DeleteRoomTypeResponseSchema: GenMessage<DeleteRoomTypeResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_room, 23);
+
+/**
+ * @generated from enum keyhub.console.v1.RoomCustomFieldType
+ */
+export enum RoomCustomFieldType {
+  /**
+   * @generated from enum value: ROOM_CUSTOM_FIELD_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * 200文字以内の文字列
+   *
+   * @generated from enum value: ROOM_CUSTOM_FIELD_TYPE_TEXT = 1;
+   */
+  TEXT = 1,
+
+  /**
+   * 数値
+   *
+   * @generated from enum value: ROOM_CUSTOM_FIELD_TYPE_NUMBER = 2;
+   */
+  NUMBER = 2,
+
+  /**
+   * "true" か "false"
+   *
+   * @generated from enum value: ROOM_CUSTOM_FIELD_TYPE_BOOLEAN = 3;
+   */
+  BOOLEAN = 3,
+
+  /**
+   * options のいずれか
+   *
+   * @generated from enum value: ROOM_CUSTOM_FIELD_TYPE_SELECT = 4;
+   */
+  SELECT = 4,
+}
+
+/**
+ * Describes the enum keyhub.console.v1.RoomCustomFieldType.
+ */
+export const RoomCustomFieldTypeSchema: GenEnum<RoomCustomFieldType> = /*@__PURE__*/
+  enumDesc(file_keyhub_console_v1_room, 0);
 
 /**
  * @generated from service keyhub.console.v1.ConsoleRoomService
@@ -179,6 +760,76 @@ export const ConsoleRoomService: GenService<{
     input: typeof AssignRoomToTenantRequestSchema;
     output: typeof AssignRoomToTenantResponseSchema;
   },
+  /**
+   * 部屋の属性（収容人数・設備・バリアフリー対応・カスタム項目）をすべて置き換える
+   *
+   * @generated from rpc keyhub.console.v1.ConsoleRoomService.UpdateRoomAttributes
+   */
+  updateRoomAttributes: {
+    methodKind: "unary";
+    input: typeof UpdateRoomAttributesRequestSchema;
+    output: typeof UpdateRoomAttributesResponseSchema;
+  },
+  /**
+   * 部屋のカスタム項目を定義
+   *
+   * @generated from rpc keyhub.console.v1.ConsoleRoomService.CreateRoomCustomField
+   */
+  createRoomCustomField: {
+    methodKind: "unary";
+    input: typeof CreateRoomCustomFieldRequestSchema;
+    output: typeof CreateRoomCustomFieldResponseSchema;
+  },
+  /**
+   * 部屋のカスタム項目の定義一覧を取得
+   *
+   * @generated from rpc keyhub.console.v1.ConsoleRoomService.ListRoomCustomFields
+   */
+  listRoomCustomFields: {
+    methodKind: "unary";
+    input: typeof ListRoomCustomFieldsRequestSchema;
+    output: typeof ListRoomCustomFieldsResponseSchema;
+  },
+  /**
+   * 部屋のカスタム項目の定義を削除（部屋に保存した値も削除される）
+   *
+   * @generated from rpc keyhub.console.v1.ConsoleRoomService.DeleteRoomCustomField
+   */
+  deleteRoomCustomField: {
+    methodKind: "unary";
+    input: typeof DeleteRoomCustomFieldRequestSchema;
+    output: typeof DeleteRoomCustomFieldResponseSchema;
+  },
+  /**
+   * 部屋タイプの一覧を取得（既定のタイプに続けて組織が追加したタイプ）
+   *
+   * @generated from rpc keyhub.console.v1.ConsoleRoomService.ListRoomTypes
+   */
+  listRoomTypes: {
+    methodKind: "unary";
+    input: typeof ListRoomTypesRequestSchema;
+    output: typeof ListRoomTypesResponseSchema;
+  },
+  /**
+   * 組織の部屋タイプを追加
+   *
+   * @generated from rpc keyhub.console.v1.ConsoleRoomService.CreateRoomType
+   */
+  createRoomType: {
+    methodKind: "unary";
+    input: typeof CreateRoomTypeRequestSchema;
+    output: typeof CreateRoomTypeResponseSchema;
+  },
+  /**
+   * 組織が追加した部屋タイプを削除（そのタイプの部屋が残っている場合は削除できない）
+   *
+   * @generated from rpc keyhub.console.v1.ConsoleRoomService.DeleteRoomType
+   */
+  deleteRoomType: {
+    methodKind: "unary";
+    input: typeof DeleteRoomTypeRequestSchema;
+    output: typeof DeleteRoomTypeResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_keyhub_console_v1_room, 0);
 
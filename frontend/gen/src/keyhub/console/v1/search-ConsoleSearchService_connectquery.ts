@@ -0,0 +1,12 @@
+// @generated by protoc-gen-connect-query v2.2.0 with parameter "target=ts"
+// @generated from file keyhub/console/v1/search.proto (package keyhub.console.v1, syntax proto3)
+/* eslint-disable */
+
+import { ConsoleSearchService } from "./search_pb";
+
+/**
+ * 検索語に一致するものを関連度の高い順に返す
+ *
+ * @generated from rpc keyhub.console.v1.ConsoleSearchService.Search
+ */
+export const search = ConsoleSearchService.method.search;
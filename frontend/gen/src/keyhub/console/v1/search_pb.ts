@@ -0,0 +1,174 @@
+// @generated by protoc-gen-es v2.12.0 with parameter "target=ts"
+// @generated from file keyhub/console/v1/search.proto (package keyhub.console.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_buf_validate_validate } from "../../../buf/validate/validate_pb";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file keyhub/console/v1/search.proto.
+ */
+export const file_keyhub_console_v1_search: GenFile = /*@__PURE__*/
+  fileDesc("Ch5rZXlodWIvY29uc29sZS92MS9zZWFyY2gucHJvdG8SEWtleWh1Yi5jb25zb2xlLnYxIncKDVNlYXJjaFJlcXVlc3QSGAoFcXVlcnkYASABKAlCCbpIBnIEEAEYZBIyCgV0eXBlcxgCIAMoDjIjLmtleWh1Yi5jb25zb2xlLnYxLlNlYXJjaFJlc3VsdFR5cGUSGAoFbGltaXQYAyABKAVCCbpIBhoEGGQoACKaAQoMU2VhcmNoUmVzdWx0EjEKBHR5cGUYASABKA4yIy5rZXlodWIuY29uc29sZS52MS5TZWFyY2hSZXN1bHRUeXBlEhQKAmlkGAIgASgJQgi6SAVyA7ABARINCgV0aXRsZRgDIAEoCRIQCghzdWJ0aXRsZRgEIAEoCRIRCglwYXJlbnRfaWQYBSABKAkSDQoFc2NvcmUYBiABKAIiQgoOU2VhcmNoUmVzcG9uc2USMAoHcmVzdWx0cxgBIAMoCzIfLmtleWh1Yi5jb25zb2xlLnYxLlNlYXJjaFJlc3VsdCqtAQoQU2VhcmNoUmVzdWx0VHlwZRIiCh5TRUFSQ0hfUkVTVUxUX1RZUEVfVU5TUEVDSUZJRUQQABIbChdTRUFSQ0hfUkVTVUxUX1RZUEVfUk9PTRABEhoKFlNFQVJDSF9SRVNVTFRfVFlQRV9LRVkQAhIdChlTRUFSQ0hfUkVTVUxUX1RZUEVfVEVOQU5UEAMSHQoZU0VBUkNIX1JFU1VMVF9UWVBFX01FTUJFUhAEMmoKFENvbnNvbGVTZWFyY2hTZXJ2aWNlElIKBlNlYXJjaBIgLmtleWh1Yi5jb25zb2xlLnYxLlNlYXJjaFJlcXVlc3QaIS5rZXlodWIuY29uc29sZS52MS5TZWFyY2hSZXNwb25zZSIDkAIBQt8BChVjb20ua2V5aHViLmNvbnNvbGUudjFCC1NlYXJjaFByb3RvUAFaU2dpdGh1Yi5jb20vc2hpYmF5YW1hLWNsdWIva2V5aHViL2ludGVybmFsL2ludGVyZmFjZS9nZW4va2V5aHViL2NvbnNvbGUvdjE7Y29uc29sZXYxogIDS0NYqgIRS2V5aHViLkNvbnNvbGUuVjHKAhFLZXlodWJcQ29uc29sZVxWMeICHUtleWh1YlxDb25zb2xlXFYxXEdQQk1ldGFkYXRh6gITS2V5aHViOjpDb25zb2xlOjpWMWIGcHJvdG8z", [file_buf_validate_validate]);
+
+/**
+ * @generated from message keyhub.console.v1.SearchRequest
+ */
+export type SearchRequest = Message<"keyhub.console.v1.SearchRequest"> & {
+  /**
+   * @generated from field: string query = 1;
+   */
+  query: string;
+
+  /**
+   * 指定した種類だけを探す。省略時はすべて
+   *
+   * @generated from field: repeated keyhub.console.v1.SearchResultType types = 2;
+   */
+  types: SearchResultType[];
+
+  /**
+   * 省略時20件
+   *
+   * @generated from field: int32 limit = 3;
+   */
+  limit: number;
+};
+
+/**
+ * Describes the message keyhub.console.v1.SearchRequest.
+ * Use `create(SearchRequestSchema)` to create a new message.
+ */
+export const SearchRequestSchema: GenMessage<SearchRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_search, 0);
+
+/**
+ * @generated from message keyhub.console.v1.SearchResult
+ */
+export type SearchResult = Message<"keyhub.console.v1.SearchResult"> & {
+  /**
+   * @generated from field: keyhub.console.v1.SearchResultType type = 1;
+   */
+  type: SearchResultType;
+
+  /**
+   * @generated from field: string id = 2;
+   */
+  id: string;
+
+  /**
+   * 部屋: 部屋名 / 建物名と階、鍵: 鍵番号 / 部屋名、テナント: テナント名 / 説明、メンバー: メールアドレス / 名前
+   *
+   * @generated from field: string title = 3;
+   */
+  title: string;
+
+  /**
+   * @generated from field: string subtitle = 4;
+   */
+  subtitle: string;
+
+  /**
+   * 鍵の結果でだけ部屋のIDを入れる
+   *
+   * @generated from field: string parent_id = 5;
+   */
+  parentId: string;
+
+  /**
+   * 関連度。大きいほど検索語に近い
+   *
+   * @generated from field: float score = 6;
+   */
+  score: number;
+};
+
+/**
+ * Describes the message keyhub.console.v1.SearchResult.
+ * Use `create(SearchResultSchema)` to create a new message.
+ */
+export const SearchResultSchema: GenMessage<SearchResult> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_search, 1);
+
+/**
+ * @generated from message keyhub.console.v1.SearchResponse
+ */
+export type SearchResponse = Message<"keyhub.console.v1.SearchResponse"> & {
+  /**
+   * @generated from field: repeated keyhub.console.v1.SearchResult results = 1;
+   */
+  results: SearchResult[];
+};
+
+/**
+ * Describes the message keyhub.console.v1.SearchResponse.
+ * Use `create(SearchResponseSchema)` to create a new message.
+ */
+export const SearchResponseSchema: GenMessage<SearchResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_search, 2);
+
+/**
+ * @generated from enum keyhub.console.v1.SearchResultType
+ */
+export enum SearchResultType {
+  /**
+   * @generated from enum value: SEARCH_RESULT_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * 部屋名・建物名・説明
+   *
+   * @generated from enum value: SEARCH_RESULT_TYPE_ROOM = 1;
+   */
+  ROOM = 1,
+
+  /**
+   * 鍵番号
+   *
+   * @generated from enum value: SEARCH_RESULT_TYPE_KEY = 2;
+   */
+  KEY = 2,
+
+  /**
+   * テナント名・説明
+   *
+   * @generated from enum value: SEARCH_RESULT_TYPE_TENANT = 3;
+   */
+  TENANT = 3,
+
+  /**
+   * 組織のテナントに参加中のユーザーのメールアドレス
+   *
+   * @generated from enum value: SEARCH_RESULT_TYPE_MEMBER = 4;
+   */
+  MEMBER = 4,
+}
+
+/**
+ * Describes the enum keyhub.console.v1.SearchResultType.
+ */
+export const SearchResultTypeSchema: GenEnum<SearchResultType> = /*@__PURE__*/
+  enumDesc(file_keyhub_console_v1_search, 0);
+
+/**
+ * 組織の部屋・鍵・テナント・メンバーの横断検索
+ *
+ * @generated from service keyhub.console.v1.ConsoleSearchService
+ */
+export const ConsoleSearchService: GenService<{
+  /**
+   * 検索語に一致するものを関連度の高い順に返す
+   *
+   * @generated from rpc keyhub.console.v1.ConsoleSearchService.Search
+   */
+  search: {
+    methodKind: "unary";
+    input: typeof SearchRequestSchema;
+    output: typeof SearchResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_keyhub_console_v1_search, 0);
+
@@ -31,3 +31,38 @@ export const getTenantById = ConsoleService.method.getTenantById;
  * @generated from rpc keyhub.console.v1.ConsoleService.UpdateTenant
  */
 export const updateTenant = ConsoleService.method.updateTenant;
+
+/**
+ * Tenantをアーカイブ（閲覧のみにし、参加コードを無効化、部屋の割り当てを終了してメンバーに通知する）
+ *
+ * @generated from rpc keyhub.console.v1.ConsoleService.ArchiveTenant
+ */
+export const archiveTenant = ConsoleService.method.archiveTenant;
+
+/**
+ * Tenant削除（貸し出し中の鍵がある場合は削除できない）
+ *
+ * @generated from rpc keyhub.console.v1.ConsoleService.DeleteTenant
+ */
+export const deleteTenant = ConsoleService.method.deleteTenant;
+
+/**
+ * テナントタイプの一覧取得（既定のタイプに続けて組織が追加したタイプ）
+ *
+ * @generated from rpc keyhub.console.v1.ConsoleService.ListTenantTypes
+ */
+export const listTenantTypes = ConsoleService.method.listTenantTypes;
+
+/**
+ * 組織のテナントタイプを追加
+ *
+ * @generated from rpc keyhub.console.v1.ConsoleService.CreateTenantType
+ */
+export const createTenantType = ConsoleService.method.createTenantType;
+
+/**
+ * 組織が追加したテナントタイプを削除（そのタイプのテナントが残っている場合は削除できない）
+ *
+ * @generated from rpc keyhub.console.v1.ConsoleService.DeleteTenantType
+ */
+export const deleteTenantType = ConsoleService.method.deleteTenantType;
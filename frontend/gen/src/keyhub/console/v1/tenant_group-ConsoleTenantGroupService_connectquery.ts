@@ -0,0 +1,40 @@
+// @generated by protoc-gen-connect-query v2.2.0 with parameter "target=ts"
+// @generated from file keyhub/console/v1/tenant_group.proto (package keyhub.console.v1, syntax proto3)
+/* eslint-disable */
+
+import { ConsoleTenantGroupService } from "./tenant_group_pb";
+
+/**
+ * グループ作成（parent_group_id を指定すると子グループとして作成）
+ *
+ * @generated from rpc keyhub.console.v1.ConsoleTenantGroupService.CreateTenantGroup
+ */
+export const createTenantGroup = ConsoleTenantGroupService.method.createTenantGroup;
+
+/**
+ * テナントのグループ一覧取得
+ *
+ * @generated from rpc keyhub.console.v1.ConsoleTenantGroupService.ListTenantGroups
+ */
+export const listTenantGroups = ConsoleTenantGroupService.method.listTenantGroups;
+
+/**
+ * グループにメンバーを追加（テナントに参加しているユーザーのみ）
+ *
+ * @generated from rpc keyhub.console.v1.ConsoleTenantGroupService.AddTenantGroupMember
+ */
+export const addTenantGroupMember = ConsoleTenantGroupService.method.addTenantGroupMember;
+
+/**
+ * グループからメンバーを削除
+ *
+ * @generated from rpc keyhub.console.v1.ConsoleTenantGroupService.RemoveTenantGroupMember
+ */
+export const removeTenantGroupMember = ConsoleTenantGroupService.method.removeTenantGroupMember;
+
+/**
+ * グループのメンバー一覧取得
+ *
+ * @generated from rpc keyhub.console.v1.ConsoleTenantGroupService.ListTenantGroupMembers
+ */
+export const listTenantGroupMembers = ConsoleTenantGroupService.method.listTenantGroupMembers;
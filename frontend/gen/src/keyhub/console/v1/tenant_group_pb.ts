@@ -0,0 +1,343 @@
+// @generated by protoc-gen-es v2.12.0 with parameter "target=ts"
+// @generated from file keyhub/console/v1/tenant_group.proto (package keyhub.console.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_buf_validate_validate } from "../../../buf/validate/validate_pb";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file keyhub/console/v1/tenant_group.proto.
+ */
+export const file_keyhub_console_v1_tenant_group: GenFile = /*@__PURE__*/
+  fileDesc("CiRrZXlodWIvY29uc29sZS92MS90ZW5hbnRfZ3JvdXAucHJvdG8SEWtleWh1Yi5jb25zb2xlLnYxItsBCgtUZW5hbnRHcm91cBIUCgJpZBgBIAEoCUIIukgFcgOwAQESGwoJdGVuYW50X2lkGAIgASgJQgi6SAVyA7ABARIcCg9wYXJlbnRfZ3JvdXBfaWQYAyABKAlIAIgBARIMCgRuYW1lGAQgASgJEhMKC2Rlc2NyaXB0aW9uGAUgASgJEhQKDG1lbWJlcl9jb3VudBgGIAEoBRIuCgpjcmVhdGVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEISChBfcGFyZW50X2dyb3VwX2lkIlkKEVRlbmFudEdyb3VwTWVtYmVyEhkKB3VzZXJfaWQYASABKAlCCLpIBXIDsAEBEgwKBG5hbWUYAiABKAkSDQoFZW1haWwYAyABKAkSDAoEaWNvbhgEIAEoCSKrAQoYQ3JlYXRlVGVuYW50R3JvdXBSZXF1ZXN0EhsKCXRlbmFudF9pZBgBIAEoCUIIukgFcgOwAQESFwoEbmFtZRgCIAEoCUIJukgGcgQQARgyEh0KC2Rlc2NyaXB0aW9uGAMgASgJQgi6SAVyAxisAhImCg9wYXJlbnRfZ3JvdXBfaWQYBCABKAlIAEIIukgFcgOwAQGIAQFCEgoQX3BhcmVudF9ncm91cF9pZCJKChlDcmVhdGVUZW5hbnRHcm91cFJlc3BvbnNlEi0KBWdyb3VwGAEgASgLMh4ua2V5aHViLmNvbnNvbGUudjEuVGVuYW50R3JvdXAiNgoXTGlzdFRlbmFudEdyb3Vwc1JlcXVlc3QSGwoJdGVuYW50X2lkGAEgASgJQgi6SAVyA7ABASJKChhMaXN0VGVuYW50R3JvdXBzUmVzcG9uc2USLgoGZ3JvdXBzGAEgAygLMh4ua2V5aHViLmNvbnNvbGUudjEuVGVuYW50R3JvdXAiVAobQWRkVGVuYW50R3JvdXBNZW1iZXJSZXF1ZXN0EhoKCGdyb3VwX2lkGAEgASgJQgi6SAVyA7ABARIZCgd1c2VyX2lkGAIgASgJQgi6SAVyA7ABASIeChxBZGRUZW5hbnRHcm91cE1lbWJlclJlc3BvbnNlIlcKHlJlbW92ZVRlbmFudEdyb3VwTWVtYmVyUmVxdWVzdBIaCghncm91cF9pZBgBIAEoCUIIukgFcgOwAQESGQoHdXNlcl9pZBgCIAEoCUIIukgFcgOwAQEiIQofUmVtb3ZlVGVuYW50R3JvdXBNZW1iZXJSZXNwb25zZSI7Ch1MaXN0VGVuYW50R3JvdXBNZW1iZXJzUmVxdWVzdBIaCghncm91cF9pZBgBIAEoCUIIukgFcgOwAQEiVwoeTGlzdFRlbmFudEdyb3VwTWVtYmVyc1Jlc3BvbnNlEjUKB21lbWJlcnMYASADKAsyJC5rZXlodWIuY29uc29sZS52MS5UZW5hbnRHcm91cE1lbWJlcjL+BAoZQ29uc29sZVRlbmFudEdyb3VwU2VydmljZRJuChFDcmVhdGVUZW5hbnRHcm91cBIrLmtleWh1Yi5jb25zb2xlLnYxLkNyZWF0ZVRlbmFudEdyb3VwUmVxdWVzdBosLmtleWh1Yi5jb25zb2xlLnYxLkNyZWF0ZVRlbmFudEdyb3VwUmVzcG9uc2UScAoQTGlzdFRlbmFudEdyb3VwcxIqLmtleWh1Yi5jb25zb2xlLnYxLkxpc3RUZW5hbnRHcm91cHNSZXF1ZXN0Gisua2V5aHViLmNvbnNvbGUudjEuTGlzdFRlbmFudEdyb3Vwc1Jlc3BvbnNlIgOQAgESdwoUQWRkVGVuYW50R3JvdXBNZW1iZXISLi5rZXlodWIuY29uc29sZS52MS5BZGRUZW5hbnRHcm91cE1lbWJlclJlcXVlc3QaLy5rZXlodWIuY29uc29sZS52MS5BZGRUZW5hbnRHcm91cE1lbWJlclJlc3BvbnNlEoABChdSZW1vdmVUZW5hbnRHcm91cE1lbWJlchIxLmtleWh1Yi5jb25zb2xlLnYxLlJlbW92ZVRlbmFudEdyb3VwTWVtYmVyUmVxdWVzdBoyLmtleWh1Yi5jb25zb2xlLnYxLlJlbW92ZVRlbmFudEdyb3VwTWVtYmVyUmVzcG9uc2USggEKFkxpc3RUZW5hbnRHcm91cE1lbWJlcnMSMC5rZXlodWIuY29uc29sZS52MS5MaXN0VGVuYW50R3JvdXBNZW1iZXJzUmVxdWVzdBoxLmtleWh1Yi5jb25zb2xlLnYxLkxpc3RUZW5hbnRHcm91cE1lbWJlcnNSZXNwb25zZSIDkAIBQuQBChVjb20ua2V5aHViLmNvbnNvbGUudjFCEFRlbmFudEdyb3VwUHJvdG9QAVpTZ2l0aHViLmNvbS9zaGliYXlhbWEtY2x1Yi9rZXlodWIvaW50ZXJuYWwvaW50ZXJmYWNlL2dlbi9rZXlodWIvY29uc29sZS92MTtjb25zb2xldjGiAgNLQ1iqAhFLZXlodWIuQ29uc29sZS5WMcoCEUtleWh1YlxDb25zb2xlXFYx4gIdS2V5aHViXENvbnNvbGVcVjFcR1BCTWV0YWRhdGHqAhNLZXlodWI6OkNvbnNvbGU6OlYxYgZwcm90bzM", [file_buf_validate_validate, file_google_protobuf_timestamp]);
+
+/**
+ * @generated from message keyhub.console.v1.TenantGroup
+ */
+export type TenantGroup = Message<"keyhub.console.v1.TenantGroup"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string tenant_id = 2;
+   */
+  tenantId: string;
+
+  /**
+   * @generated from field: optional string parent_group_id = 3;
+   */
+  parentGroupId?: string | undefined;
+
+  /**
+   * @generated from field: string name = 4;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string description = 5;
+   */
+  description: string;
+
+  /**
+   * @generated from field: int32 member_count = 6;
+   */
+  memberCount: number;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message keyhub.console.v1.TenantGroup.
+ * Use `create(TenantGroupSchema)` to create a new message.
+ */
+export const TenantGroupSchema: GenMessage<TenantGroup> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_tenant_group, 0);
+
+/**
+ * @generated from message keyhub.console.v1.TenantGroupMember
+ */
+export type TenantGroupMember = Message<"keyhub.console.v1.TenantGroupMember"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string email = 3;
+   */
+  email: string;
+
+  /**
+   * @generated from field: string icon = 4;
+   */
+  icon: string;
+};
+
+/**
+ * Describes the message keyhub.console.v1.TenantGroupMember.
+ * Use `create(TenantGroupMemberSchema)` to create a new message.
+ */
+export const TenantGroupMemberSchema: GenMessage<TenantGroupMember> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_tenant_group, 1);
+
+/**
+ * @generated from message keyhub.console.v1.CreateTenantGroupRequest
+ */
+export type CreateTenantGroupRequest = Message<"keyhub.console.v1.CreateTenantGroupRequest"> & {
+  /**
+   * @generated from field: string tenant_id = 1;
+   */
+  tenantId: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string description = 3;
+   */
+  description: string;
+
+  /**
+   * @generated from field: optional string parent_group_id = 4;
+   */
+  parentGroupId?: string | undefined;
+};
+
+/**
+ * Describes the message keyhub.console.v1.CreateTenantGroupRequest.
+ * Use `create(CreateTenantGroupRequestSchema)` to create a new message.
+ */
+export const CreateTenantGroupRequestSchema: GenMessage<CreateTenantGroupRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_tenant_group, 2);
+
+/**
+ * @generated from message keyhub.console.v1.CreateTenantGroupResponse
+ */
+export type CreateTenantGroupResponse = Message<"keyhub.console.v1.CreateTenantGroupResponse"> & {
+  /**
+   * @generated from field: keyhub.console.v1.TenantGroup group = 1;
+   */
+  group?: TenantGroup | undefined;
+};
+
+/**
+ * Describes the message keyhub.console.v1.CreateTenantGroupResponse.
+ * Use `create(CreateTenantGroupResponseSchema)` to create a new message.
+ */
+export const CreateTenantGroupResponseSchema: GenMessage<CreateTenantGroupResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_tenant_group, 3);
+
+/**
+ * @generated from message keyhub.console.v1.ListTenantGroupsRequest
+ */
+export type ListTenantGroupsRequest = Message<"keyhub.console.v1.ListTenantGroupsRequest"> & {
+  /**
+   * @generated from field: string tenant_id = 1;
+   */
+  tenantId: string;
+};
+
+/**
+ * Describes the message keyhub.console.v1.ListTenantGroupsRequest.
+ * Use `create(ListTenantGroupsRequestSchema)` to create a new message.
+ */
+export const ListTenantGroupsRequestSchema: GenMessage<ListTenantGroupsRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_tenant_group, 4);
+
+/**
+ * @generated from message keyhub.console.v1.ListTenantGroupsResponse
+ */
+export type ListTenantGroupsResponse = Message<"keyhub.console.v1.ListTenantGroupsResponse"> & {
+  /**
+   * @generated from field: repeated keyhub.console.v1.TenantGroup groups = 1;
+   */
+  groups: TenantGroup[];
+};
+
+/**
+ * Describes the message keyhub.console.v1.ListTenantGroupsResponse.
+ * Use `create(ListTenantGroupsResponseSchema)` to create a new message.
+ */
+export const ListTenantGroupsResponseSchema: GenMessage<ListTenantGroupsResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_tenant_group, 5);
+
+/**
+ * @generated from message keyhub.console.v1.AddTenantGroupMemberRequest
+ */
+export type AddTenantGroupMemberRequest = Message<"keyhub.console.v1.AddTenantGroupMemberRequest"> & {
+  /**
+   * @generated from field: string group_id = 1;
+   */
+  groupId: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message keyhub.console.v1.AddTenantGroupMemberRequest.
+ * Use `create(AddTenantGroupMemberRequestSchema)` to create a new message.
+ */
+export const AddTenantGroupMemberRequestSchema: GenMessage<AddTenantGroupMemberRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_tenant_group, 6);
+
+/**
+ * @generated from message keyhub.console.v1.AddTenantGroupMemberResponse
+ */
+export type AddTenantGroupMemberResponse = Message<"keyhub.console.v1.AddTenantGroupMemberResponse"> & {
+};
+
+/**
+ * Describes the message keyhub.console.v1.AddTenantGroupMemberResponse.
+ * Use `create(AddTenantGroupMemberResponseSchema)` to create a new message.
+ */
+export const AddTenantGroupMemberResponseSchema: GenMessage<AddTenantGroupMemberResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_tenant_group, 7);
+
+/**
+ * @generated from message keyhub.console.v1.RemoveTenantGroupMemberRequest
+ */
+export type RemoveTenantGroupMemberRequest = Message<"keyhub.console.v1.RemoveTenantGroupMemberRequest"> & {
+  /**
+   * @generated from field: string group_id = 1;
+   */
+  groupId: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message keyhub.console.v1.RemoveTenantGroupMemberRequest.
+ * Use `create(RemoveTenantGroupMemberRequestSchema)` to create a new message.
+ */
+export const RemoveTenantGroupMemberRequestSchema: GenMessage<RemoveTenantGroupMemberRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_tenant_group, 8);
+
+/**
+ * @generated from message keyhub.console.v1.RemoveTenantGroupMemberResponse
+ */
+export type RemoveTenantGroupMemberResponse = Message<"keyhub.console.v1.RemoveTenantGroupMemberResponse"> & {
+};
+
+/**
+ * Describes the message keyhub.console.v1.RemoveTenantGroupMemberResponse.
+ * Use `create(RemoveTenantGroupMemberResponseSchema)` to create a new message.
+ */
+export const RemoveTenantGroupMemberResponseSchema: GenMessage<RemoveTenantGroupMemberResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_tenant_group, 9);
+
+/**
+ * @generated from message keyhub.console.v1.ListTenantGroupMembersRequest
+ */
+export type ListTenantGroupMembersRequest = Message<"keyhub.console.v1.ListTenantGroupMembersRequest"> & {
+  /**
+   * @generated from field: string group_id = 1;
+   */
+  groupId: string;
+};
+
+/**
+ * Describes the message keyhub.console.v1.ListTenantGroupMembersRequest.
+ * Use `create(ListTenantGroupMembersRequestSchema)` to create a new message.
+ */
+export const ListTenantGroupMembersRequestSchema: GenMessage<ListTenantGroupMembersRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_tenant_group, 10);
+
+/**
+ * @generated from message keyhub.console.v1.ListTenantGroupMembersResponse
+ */
+export type ListTenantGroupMembersResponse = Message<"keyhub.console.v1.ListTenantGroupMembersResponse"> & {
+  /**
+   * @generated from field: repeated keyhub.console.v1.TenantGroupMember members = 1;
+   */
+  members: TenantGroupMember[];
+};
+
+/**
+ * Describes the message keyhub.console.v1.ListTenantGroupMembersResponse.
+ * Use `create(ListTenantGroupMembersResponseSchema)` to create a new message.
+ */
+export const ListTenantGroupMembersResponseSchema: GenMessage<ListTenantGroupMembersResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_tenant_group, 11);
+
+/**
+ * テナント内のグループ（研究室やチームなど）を管理するサービス
+ * 部屋の割り当てと鍵の貸出は AssignRoomToTenant でグループに限定できる
+ *
+ * @generated from service keyhub.console.v1.ConsoleTenantGroupService
+ */
+export const ConsoleTenantGroupService: GenService<{
+  /**
+   * グループ作成（parent_group_id を指定すると子グループとして作成）
+   *
+   * @generated from rpc keyhub.console.v1.ConsoleTenantGroupService.CreateTenantGroup
+   */
+  createTenantGroup: {
+    methodKind: "unary";
+    input: typeof CreateTenantGroupRequestSchema;
+    output: typeof CreateTenantGroupResponseSchema;
+  },
+  /**
+   * テナントのグループ一覧取得
+   *
+   * @generated from rpc keyhub.console.v1.ConsoleTenantGroupService.ListTenantGroups
+   */
+  listTenantGroups: {
+    methodKind: "unary";
+    input: typeof ListTenantGroupsRequestSchema;
+    output: typeof ListTenantGroupsResponseSchema;
+  },
+  /**
+   * グループにメンバーを追加（テナントに参加しているユーザーのみ）
+   *
+   * @generated from rpc keyhub.console.v1.ConsoleTenantGroupService.AddTenantGroupMember
+   */
+  addTenantGroupMember: {
+    methodKind: "unary";
+    input: typeof AddTenantGroupMemberRequestSchema;
+    output: typeof AddTenantGroupMemberResponseSchema;
+  },
+  /**
+   * グループからメンバーを削除
+   *
+   * @generated from rpc keyhub.console.v1.ConsoleTenantGroupService.RemoveTenantGroupMember
+   */
+  removeTenantGroupMember: {
+    methodKind: "unary";
+    input: typeof RemoveTenantGroupMemberRequestSchema;
+    output: typeof RemoveTenantGroupMemberResponseSchema;
+  },
+  /**
+   * グループのメンバー一覧取得
+   *
+   * @generated from rpc keyhub.console.v1.ConsoleTenantGroupService.ListTenantGroupMembers
+   */
+  listTenantGroupMembers: {
+    methodKind: "unary";
+    input: typeof ListTenantGroupMembersRequestSchema;
+    output: typeof ListTenantGroupMembersResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_keyhub_console_v1_tenant_group, 0);
+
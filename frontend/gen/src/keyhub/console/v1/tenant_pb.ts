@@ -7,7 +7,7 @@ import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2
 import { file_buf_validate_validate } from "../../../buf/validate/validate_pb";
 import type { Timestamp } from "@bufbuild/protobuf/wkt";
 import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
-import type { Tenant, TenantType } from "./common_pb";
+import type { ListOrder, Tenant, TenantType } from "./common_pb";
 import { file_keyhub_console_v1_common } from "./common_pb";
 import type { Message } from "@bufbuild/protobuf";
 
@@ -15,7 +15,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file keyhub/console/v1/tenant.proto.
  */
 export const file_keyhub_console_v1_tenant: GenFile = /*@__PURE__*/
-  fileDesc("Ch5rZXlodWIvY29uc29sZS92MS90ZW5hbnQucHJvdG8SEWtleWh1Yi5jb25zb2xlLnYxItABChNDcmVhdGVUZW5hbnRSZXF1ZXN0EgwKBG5hbWUYASABKAkSEwoLZGVzY3JpcHRpb24YAiABKAkSMgoLdGVuYW50X3R5cGUYAyABKA4yHS5rZXlodWIuY29uc29sZS52MS5UZW5hbnRUeXBlEhEKCWpvaW5fY29kZRgEIAEoCRI0ChBqb2luX2NvZGVfZXhwaXJ5GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIZChFqb2luX2NvZGVfbWF4X3VzZRgGIAEoBSIsChRDcmVhdGVUZW5hbnRSZXNwb25zZRIUCgJpZBgBIAEoCUIIukgFcgOwAQEiFgoUR2V0QWxsVGVuYW50c1JlcXVlc3QiQwoVR2V0QWxsVGVuYW50c1Jlc3BvbnNlEioKB3RlbmFudHMYASADKAsyGS5rZXlodWIuY29uc29sZS52MS5UZW5hbnQiLAoUR2V0VGVuYW50QnlJZFJlcXVlc3QSFAoCaWQYASABKAlCCLpIBXIDsAEBIqYBChVHZXRUZW5hbnRCeUlkUmVzcG9uc2USKQoGdGVuYW50GAEgASgLMhkua2V5aHViLmNvbnNvbGUudjEuVGVuYW50EhEKCWpvaW5fY29kZRgCIAEoCRI0ChBqb2luX2NvZGVfZXhwaXJ5GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIZChFqb2luX2NvZGVfbWF4X3VzZRgEIAEoBSLmAQoTVXBkYXRlVGVuYW50UmVxdWVzdBIUCgJpZBgBIAEoCUIIukgFcgOwAQESDAoEbmFtZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRIyCgt0ZW5hbnRfdHlwZRgEIAEoDjIdLmtleWh1Yi5jb25zb2xlLnYxLlRlbmFudFR5cGUSEQoJam9pbl9jb2RlGAUgASgJEjQKEGpvaW5fY29kZV9leHBpcnkYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhkKEWpvaW5fY29kZV9tYXhfdXNlGAcgASgFIhYKFFVwZGF0ZVRlbmFudFJlc3BvbnNlMpoDCg5Db25zb2xlU2VydmljZRJfCgxDcmVhdGVUZW5hbnQSJi5rZXlodWIuY29uc29sZS52MS5DcmVhdGVUZW5hbnRSZXF1ZXN0Gicua2V5aHViLmNvbnNvbGUudjEuQ3JlYXRlVGVuYW50UmVzcG9uc2USYgoNR2V0QWxsVGVuYW50cxInLmtleWh1Yi5jb25zb2xlLnYxLkdldEFsbFRlbmFudHNSZXF1ZXN0Gigua2V5aHViLmNvbnNvbGUudjEuR2V0QWxsVGVuYW50c1Jlc3BvbnNlEmIKDUdldFRlbmFudEJ5SWQSJy5rZXlodWIuY29uc29sZS52MS5HZXRUZW5hbnRCeUlkUmVxdWVzdBooLmtleWh1Yi5jb25zb2xlLnYxLkdldFRlbmFudEJ5SWRSZXNwb25zZRJfCgxVcGRhdGVUZW5hbnQSJi5rZXlodWIuY29uc29sZS52MS5VcGRhdGVUZW5hbnRSZXF1ZXN0Gicua2V5aHViLmNvbnNvbGUudjEuVXBkYXRlVGVuYW50UmVzcG9uc2VC3wEKFWNvbS5rZXlodWIuY29uc29sZS52MUILVGVuYW50UHJvdG9QAVpTZ2l0aHViLmNvbS9zaGliYXlhbWEtY2x1Yi9rZXlodWIvaW50ZXJuYWwvaW50ZXJmYWNlL2dlbi9rZXlodWIvY29uc29sZS92MTtjb25zb2xldjGiAgNLQ1iqAhFLZXlodWIuQ29uc29sZS5WMcoCEUtleWh1YlxDb25zb2xlXFYx4gIdS2V5aHViXENvbnNvbGVcVjFcR1BCTWV0YWRhdGHqAhNLZXlodWI6OkNvbnNvbGU6OlYxYgZwcm90bzM", [file_buf_validate_validate, file_google_protobuf_timestamp, file_keyhub_console_v1_common]);
+  fileDesc("Ch5rZXlodWIvY29uc29sZS92MS90ZW5hbnQucHJvdG8SEWtleWh1Yi5jb25zb2xlLnYxIukBChNDcmVhdGVUZW5hbnRSZXF1ZXN0EgwKBG5hbWUYASABKAkSEwoLZGVzY3JpcHRpb24YAiABKAkSMgoLdGVuYW50X3R5cGUYAyABKA4yHS5rZXlodWIuY29uc29sZS52MS5UZW5hbnRUeXBlEhEKCWpvaW5fY29kZRgEIAEoCRI0ChBqb2luX2NvZGVfZXhwaXJ5GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIZChFqb2luX2NvZGVfbWF4X3VzZRgGIAEoBRIXCg90ZW5hbnRfdHlwZV9rZXkYByABKAkiLAoUQ3JlYXRlVGVuYW50UmVzcG9uc2USFAoCaWQYASABKAlCCLpIBXIDsAEBItgBChRHZXRBbGxUZW5hbnRzUmVxdWVzdBIdCglwYWdlX3NpemUYASABKAVCCrpIBxoFGMgBKAASEgoKcGFnZV90b2tlbhgCIAEoCRIrCgVvcmRlchgDIAEoDjIcLmtleWh1Yi5jb25zb2xlLnYxLkxpc3RPcmRlchIyCgt0ZW5hbnRfdHlwZRgEIAEoDjIdLmtleWh1Yi5jb25zb2xlLnYxLlRlbmFudFR5cGUSEwoLbmFtZV9wcmVmaXgYBSABKAkSFwoPdGVuYW50X3R5cGVfa2V5GAYgASgJIlwKFUdldEFsbFRlbmFudHNSZXNwb25zZRIqCgd0ZW5hbnRzGAEgAygLMhkua2V5aHViLmNvbnNvbGUudjEuVGVuYW50EhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSIsChRHZXRUZW5hbnRCeUlkUmVxdWVzdBIUCgJpZBgBIAEoCUIIukgFcgOwAQEipgEKFUdldFRlbmFudEJ5SWRSZXNwb25zZRIpCgZ0ZW5hbnQYASABKAsyGS5rZXlodWIuY29uc29sZS52MS5UZW5hbnQSEQoJam9pbl9jb2RlGAIgASgJEjQKEGpvaW5fY29kZV9leHBpcnkYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhkKEWpvaW5fY29kZV9tYXhfdXNlGAQgASgFIpwCChNVcGRhdGVUZW5hbnRSZXF1ZXN0EhQKAmlkGAEgASgJQgi6SAVyA7ABARIMCgRuYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEjIKC3RlbmFudF90eXBlGAQgASgOMh0ua2V5aHViLmNvbnNvbGUudjEuVGVuYW50VHlwZRIRCglqb2luX2NvZGUYBSABKAkSNAoQam9pbl9jb2RlX2V4cGlyeRgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASGQoRam9pbl9jb2RlX21heF91c2UYByABKAUSFwoPdGVuYW50X3R5cGVfa2V5GAggASgJEhsKB3ZlcnNpb24YCSABKANCCrpIB8gBASICIAAiJwoUVXBkYXRlVGVuYW50UmVzcG9uc2USDwoHdmVyc2lvbhgBIAEoAyIsChRBcmNoaXZlVGVuYW50UmVxdWVzdBIUCgJpZBgBIAEoCUIIukgFcgOwAQEiQgoVQXJjaGl2ZVRlbmFudFJlc3BvbnNlEikKBnRlbmFudBgBIAEoCzIZLmtleWh1Yi5jb25zb2xlLnYxLlRlbmFudCIrChNEZWxldGVUZW5hbnRSZXF1ZXN0EhQKAmlkGAEgASgJQgi6SAVyA7ABASIWChREZWxldGVUZW5hbnRSZXNwb25zZSK0AQoUVGVuYW50VHlwZURlZmluaXRpb24SCgoCaWQYASABKAkSCwoDa2V5GAIgASgJEg0KBWxhYmVsGAMgASgJEhAKCGJ1aWx0X2luGAQgASgIEjIKC3RlbmFudF90eXBlGAUgASgOMh0ua2V5aHViLmNvbnNvbGUudjEuVGVuYW50VHlwZRIuCgpjcmVhdGVkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCIYChZMaXN0VGVuYW50VHlwZXNSZXF1ZXN0IlgKF0xpc3RUZW5hbnRUeXBlc1Jlc3BvbnNlEj0KDHRlbmFudF90eXBlcxgBIAMoCzInLmtleWh1Yi5jb25zb2xlLnYxLlRlbmFudFR5cGVEZWZpbml0aW9uIl8KF0NyZWF0ZVRlbmFudFR5cGVSZXF1ZXN0EioKA2tleRgBIAEoCUIdukgachgyFl5bYS16XVthLXowLTlfXXswLDI5fSQSGAoFbGFiZWwYAiABKAlCCbpIBnIEEAEYHiJYChhDcmVhdGVUZW5hbnRUeXBlUmVzcG9uc2USPAoLdGVuYW50X3R5cGUYASABKAsyJy5rZXlodWIuY29uc29sZS52MS5UZW5hbnRUeXBlRGVmaW5pdGlvbiIvChdEZWxldGVUZW5hbnRUeXBlUmVxdWVzdBIUCgJpZBgBIAEoCUIIukgFcgOwAQEiGgoYRGVsZXRlVGVuYW50VHlwZVJlc3BvbnNlMqgHCg5Db25zb2xlU2VydmljZRJfCgxDcmVhdGVUZW5hbnQSJi5rZXlodWIuY29uc29sZS52MS5DcmVhdGVUZW5hbnRSZXF1ZXN0Gicua2V5aHViLmNvbnNvbGUudjEuQ3JlYXRlVGVuYW50UmVzcG9uc2USYgoNR2V0QWxsVGVuYW50cxInLmtleWh1Yi5jb25zb2xlLnYxLkdldEFsbFRlbmFudHNSZXF1ZXN0Gigua2V5aHViLmNvbnNvbGUudjEuR2V0QWxsVGVuYW50c1Jlc3BvbnNlEmIKDUdldFRlbmFudEJ5SWQSJy5rZXlodWIuY29uc29sZS52MS5HZXRUZW5hbnRCeUlkUmVxdWVzdBooLmtleWh1Yi5jb25zb2xlLnYxLkdldFRlbmFudEJ5SWRSZXNwb25zZRJfCgxVcGRhdGVUZW5hbnQSJi5rZXlodWIuY29uc29sZS52MS5VcGRhdGVUZW5hbnRSZXF1ZXN0Gicua2V5aHViLmNvbnNvbGUudjEuVXBkYXRlVGVuYW50UmVzcG9uc2USYgoNQXJjaGl2ZVRlbmFudBInLmtleWh1Yi5jb25zb2xlLnYxLkFyY2hpdmVUZW5hbnRSZXF1ZXN0Gigua2V5aHViLmNvbnNvbGUudjEuQXJjaGl2ZVRlbmFudFJlc3BvbnNlEl8KDERlbGV0ZVRlbmFudBImLmtleWh1Yi5jb25zb2xlLnYxLkRlbGV0ZVRlbmFudFJlcXVlc3QaJy5rZXlodWIuY29uc29sZS52MS5EZWxldGVUZW5hbnRSZXNwb25zZRJtCg9MaXN0VGVuYW50VHlwZXMSKS5rZXlodWIuY29uc29sZS52MS5MaXN0VGVuYW50VHlwZXNSZXF1ZXN0Gioua2V5aHViLmNvbnNvbGUudjEuTGlzdFRlbmFudFR5cGVzUmVzcG9uc2UiA5ACARJrChBDcmVhdGVUZW5hbnRUeXBlEioua2V5aHViLmNvbnNvbGUudjEuQ3JlYXRlVGVuYW50VHlwZVJlcXVlc3QaKy5rZXlodWIuY29uc29sZS52MS5DcmVhdGVUZW5hbnRUeXBlUmVzcG9uc2USawoQRGVsZXRlVGVuYW50VHlwZRIqLmtleWh1Yi5jb25zb2xlLnYxLkRlbGV0ZVRlbmFudFR5cGVSZXF1ZXN0Gisua2V5aHViLmNvbnNvbGUudjEuRGVsZXRlVGVuYW50VHlwZVJlc3BvbnNlQt8BChVjb20ua2V5aHViLmNvbnNvbGUudjFCC1RlbmFudFByb3RvUAFaU2dpdGh1Yi5jb20vc2hpYmF5YW1hLWNsdWIva2V5aHViL2ludGVybmFsL2ludGVyZmFjZS9nZW4va2V5aHViL2NvbnNvbGUvdjE7Y29uc29sZXYxogIDS0NYqgIRS2V5aHViLkNvbnNvbGUuVjHKAhFLZXlodWJcQ29uc29sZVxWMeICHUtleWh1YlxDb25zb2xlXFYxXEdQQk1ldGFkYXRh6gITS2V5aHViOjpDb25zb2xlOjpWMWIGcHJvdG8z", [file_buf_validate_validate, file_google_protobuf_timestamp, file_keyhub_console_v1_common]);
 
 /**
  * @generated from message keyhub.console.v1.CreateTenantRequest
@@ -50,6 +50,13 @@ export type CreateTenantRequest = Message<"keyhub.console.v1.CreateTenantRequest
    * @generated from field: int32 join_code_max_use = 6;
    */
   joinCodeMaxUse: number;
+
+  /**
+   * 組織が追加したテナントタイプのキー。指定した場合は tenant_type より優先する
+   *
+   * @generated from field: string tenant_type_key = 7;
+   */
+  tenantTypeKey: string;
 };
 
 /**
@@ -80,6 +87,45 @@ export const CreateTenantResponseSchema: GenMessage<CreateTenantResponse> = /*@_
  * @generated from message keyhub.console.v1.GetAllTenantsRequest
  */
 export type GetAllTenantsRequest = Message<"keyhub.console.v1.GetAllTenantsRequest"> & {
+  /**
+   * 省略時50件
+   *
+   * @generated from field: int32 page_size = 1;
+   */
+  pageSize: number;
+
+  /**
+   * 前回のレスポンスの next_page_token。条件と並び順は前回と同じにする
+   *
+   * @generated from field: string page_token = 2;
+   */
+  pageToken: string;
+
+  /**
+   * @generated from field: keyhub.console.v1.ListOrder order = 3;
+   */
+  order: ListOrder;
+
+  /**
+   * 以下の条件は指定したものだけで絞り込む
+   *
+   * @generated from field: keyhub.console.v1.TenantType tenant_type = 4;
+   */
+  tenantType: TenantType;
+
+  /**
+   * テナント名の前方一致
+   *
+   * @generated from field: string name_prefix = 5;
+   */
+  namePrefix: string;
+
+  /**
+   * テナントタイプのキー。指定した場合は tenant_type より優先する
+   *
+   * @generated from field: string tenant_type_key = 6;
+   */
+  tenantTypeKey: string;
 };
 
 /**
@@ -97,6 +143,13 @@ export type GetAllTenantsResponse = Message<"keyhub.console.v1.GetAllTenantsResp
    * @generated from field: repeated keyhub.console.v1.Tenant tenants = 1;
    */
   tenants: Tenant[];
+
+  /**
+   * 続きがない場合は空
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
 };
 
 /**
@@ -193,6 +246,20 @@ export type UpdateTenantRequest = Message<"keyhub.console.v1.UpdateTenantRequest
    * @generated from field: int32 join_code_max_use = 7;
    */
   joinCodeMaxUse: number;
+
+  /**
+   * 組織が追加したテナントタイプのキー。指定した場合は tenant_type より優先する
+   *
+   * @generated from field: string tenant_type_key = 8;
+   */
+  tenantTypeKey: string;
+
+  /**
+   * 取得時の Tenant.version。他の更新で版数が変わっていれば更新せず ABORTED を返す
+   *
+   * @generated from field: int64 version = 9;
+   */
+  version: bigint;
 };
 
 /**
@@ -206,6 +273,12 @@ export const UpdateTenantRequestSchema: GenMessage<UpdateTenantRequest> = /*@__P
  * @generated from message keyhub.console.v1.UpdateTenantResponse
  */
 export type UpdateTenantResponse = Message<"keyhub.console.v1.UpdateTenantResponse"> & {
+  /**
+   * 更新後の Tenant.version
+   *
+   * @generated from field: int64 version = 1;
+   */
+  version: bigint;
 };
 
 /**
@@ -215,6 +288,217 @@ export type UpdateTenantResponse = Message<"keyhub.console.v1.UpdateTenantRespon
 export const UpdateTenantResponseSchema: GenMessage<UpdateTenantResponse> = /*@__PURE__*/
   messageDesc(file_keyhub_console_v1_tenant, 7);
 
+/**
+ * @generated from message keyhub.console.v1.ArchiveTenantRequest
+ */
+export type ArchiveTenantRequest = Message<"keyhub.console.v1.ArchiveTenantRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message keyhub.console.v1.ArchiveTenantRequest.
+ * Use `create(ArchiveTenantRequestSchema)` to create a new message.
+ */
+export const ArchiveTenantRequestSchema: GenMessage<ArchiveTenantRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_tenant, 8);
+
+/**
+ * @generated from message keyhub.console.v1.ArchiveTenantResponse
+ */
+export type ArchiveTenantResponse = Message<"keyhub.console.v1.ArchiveTenantResponse"> & {
+  /**
+   * @generated from field: keyhub.console.v1.Tenant tenant = 1;
+   */
+  tenant?: Tenant | undefined;
+};
+
+/**
+ * Describes the message keyhub.console.v1.ArchiveTenantResponse.
+ * Use `create(ArchiveTenantResponseSchema)` to create a new message.
+ */
+export const ArchiveTenantResponseSchema: GenMessage<ArchiveTenantResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_tenant, 9);
+
+/**
+ * @generated from message keyhub.console.v1.DeleteTenantRequest
+ */
+export type DeleteTenantRequest = Message<"keyhub.console.v1.DeleteTenantRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message keyhub.console.v1.DeleteTenantRequest.
+ * Use `create(DeleteTenantRequestSchema)` to create a new message.
+ */
+export const DeleteTenantRequestSchema: GenMessage<DeleteTenantRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_tenant, 10);
+
+/**
+ * @generated from message keyhub.console.v1.DeleteTenantResponse
+ */
+export type DeleteTenantResponse = Message<"keyhub.console.v1.DeleteTenantResponse"> & {
+};
+
+/**
+ * Describes the message keyhub.console.v1.DeleteTenantResponse.
+ * Use `create(DeleteTenantResponseSchema)` to create a new message.
+ */
+export const DeleteTenantResponseSchema: GenMessage<DeleteTenantResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_tenant, 11);
+
+/**
+ * @generated from message keyhub.console.v1.TenantTypeDefinition
+ */
+export type TenantTypeDefinition = Message<"keyhub.console.v1.TenantTypeDefinition"> & {
+  /**
+   * 既定のタイプは空
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string key = 2;
+   */
+  key: string;
+
+  /**
+   * @generated from field: string label = 3;
+   */
+  label: string;
+
+  /**
+   * 全組織で使える既定のタイプ。削除できない
+   *
+   * @generated from field: bool built_in = 4;
+   */
+  builtIn: boolean;
+
+  /**
+   * 既定のタイプの列挙値。組織が追加したタイプは UNSPECIFIED
+   *
+   * @generated from field: keyhub.console.v1.TenantType tenant_type = 5;
+   */
+  tenantType: TenantType;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 6;
+   */
+  createdAt?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message keyhub.console.v1.TenantTypeDefinition.
+ * Use `create(TenantTypeDefinitionSchema)` to create a new message.
+ */
+export const TenantTypeDefinitionSchema: GenMessage<TenantTypeDefinition> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_tenant, 12);
+
+/**
+ * @generated from message keyhub.console.v1.ListTenantTypesRequest
+ */
+export type ListTenantTypesRequest = Message<"keyhub.console.v1.ListTenantTypesRequest"> & {
+};
+
+/**
+ * Describes the message keyhub.console.v1.ListTenantTypesRequest.
+ * Use `create(ListTenantTypesRequestSchema)` to create a new message.
+ */
+export const ListTenantTypesRequestSchema: GenMessage<ListTenantTypesRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_tenant, 13);
+
+/**
+ * @generated from message keyhub.console.v1.ListTenantTypesResponse
+ */
+export type ListTenantTypesResponse = Message<"keyhub.console.v1.ListTenantTypesResponse"> & {
+  /**
+   * @generated from field: repeated keyhub.console.v1.TenantTypeDefinition tenant_types = 1;
+   */
+  tenantTypes: TenantTypeDefinition[];
+};
+
+/**
+ * Describes the message keyhub.console.v1.ListTenantTypesResponse.
+ * Use `create(ListTenantTypesResponseSchema)` to create a new message.
+ */
+export const ListTenantTypesResponseSchema: GenMessage<ListTenantTypesResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_tenant, 14);
+
+/**
+ * @generated from message keyhub.console.v1.CreateTenantTypeRequest
+ */
+export type CreateTenantTypeRequest = Message<"keyhub.console.v1.CreateTenantTypeRequest"> & {
+  /**
+   * @generated from field: string key = 1;
+   */
+  key: string;
+
+  /**
+   * @generated from field: string label = 2;
+   */
+  label: string;
+};
+
+/**
+ * Describes the message keyhub.console.v1.CreateTenantTypeRequest.
+ * Use `create(CreateTenantTypeRequestSchema)` to create a new message.
+ */
+export const CreateTenantTypeRequestSchema: GenMessage<CreateTenantTypeRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_tenant, 15);
+
+/**
+ * @generated from message keyhub.console.v1.CreateTenantTypeResponse
+ */
+export type CreateTenantTypeResponse = Message<"keyhub.console.v1.CreateTenantTypeResponse"> & {
+  /**
+   * @generated from field: keyhub.console.v1.TenantTypeDefinition tenant_type = 1;
+   */
+  tenantType?: TenantTypeDefinition | undefined;
+};
+
+/**
+ * Describes the message keyhub.console.v1.CreateTenantTypeResponse.
+ * Use `create(CreateTenantTypeResponseSchema)` to create a new message.
+ */
+export const CreateTenantTypeResponseSchema: GenMessage<CreateTenantTypeResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_tenant, 16);
+
+/**
+ * @generated from message keyhub.console.v1.DeleteTenantTypeRequest
+ */
+export type DeleteTenantTypeRequest = Message<"keyhub.console.v1.DeleteTenantTypeRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message keyhub.console.v1.DeleteTenantTypeRequest.
+ * Use `create(DeleteTenantTypeRequestSchema)` to create a new message.
+ */
+export const DeleteTenantTypeRequestSchema: GenMessage<DeleteTenantTypeRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_tenant, 17);
+
+/**
+ * @generated from message keyhub.console.v1.DeleteTenantTypeResponse
+ */
+export type DeleteTenantTypeResponse = Message<"keyhub.console.v1.DeleteTenantTypeResponse"> & {
+};
+
+/**
+ * Describes the message keyhub.console.v1.DeleteTenantTypeResponse.
+ * Use `create(DeleteTenantTypeResponseSchema)` to create a new message.
+ */
+export const DeleteTenantTypeResponseSchema: GenMessage<DeleteTenantTypeResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_tenant, 18);
+
 /**
  * @generated from service keyhub.console.v1.ConsoleService
  */
@@ -259,6 +543,56 @@ export const ConsoleService: GenService<{
     input: typeof UpdateTenantRequestSchema;
     output: typeof UpdateTenantResponseSchema;
   },
+  /**
+   * Tenantをアーカイブ（閲覧のみにし、参加コードを無効化、部屋の割り当てを終了してメンバーに通知する）
+   *
+   * @generated from rpc keyhub.console.v1.ConsoleService.ArchiveTenant
+   */
+  archiveTenant: {
+    methodKind: "unary";
+    input: typeof ArchiveTenantRequestSchema;
+    output: typeof ArchiveTenantResponseSchema;
+  },
+  /**
+   * Tenant削除（貸し出し中の鍵がある場合は削除できない）
+   *
+   * @generated from rpc keyhub.console.v1.ConsoleService.DeleteTenant
+   */
+  deleteTenant: {
+    methodKind: "unary";
+    input: typeof DeleteTenantRequestSchema;
+    output: typeof DeleteTenantResponseSchema;
+  },
+  /**
+   * テナントタイプの一覧取得（既定のタイプに続けて組織が追加したタイプ）
+   *
+   * @generated from rpc keyhub.console.v1.ConsoleService.ListTenantTypes
+   */
+  listTenantTypes: {
+    methodKind: "unary";
+    input: typeof ListTenantTypesRequestSchema;
+    output: typeof ListTenantTypesResponseSchema;
+  },
+  /**
+   * 組織のテナントタイプを追加
+   *
+   * @generated from rpc keyhub.console.v1.ConsoleService.CreateTenantType
+   */
+  createTenantType: {
+    methodKind: "unary";
+    input: typeof CreateTenantTypeRequestSchema;
+    output: typeof CreateTenantTypeResponseSchema;
+  },
+  /**
+   * 組織が追加したテナントタイプを削除（そのタイプのテナントが残っている場合は削除できない）
+   *
+   * @generated from rpc keyhub.console.v1.ConsoleService.DeleteTenantType
+   */
+  deleteTenantType: {
+    methodKind: "unary";
+    input: typeof DeleteTenantTypeRequestSchema;
+    output: typeof DeleteTenantTypeResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_keyhub_console_v1_tenant, 0);
 
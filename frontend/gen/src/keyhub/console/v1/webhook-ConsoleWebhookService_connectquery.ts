@@ -0,0 +1,40 @@
+// @generated by protoc-gen-connect-query v2.2.0 with parameter "target=ts"
+// @generated from file keyhub/console/v1/webhook.proto (package keyhub.console.v1, syntax proto3)
+/* eslint-disable */
+
+import { ConsoleWebhookService } from "./webhook_pb";
+
+/**
+ * 購読登録（署名用のシークレットはこのレスポンスでのみ返す）
+ *
+ * @generated from rpc keyhub.console.v1.ConsoleWebhookService.CreateWebhookSubscription
+ */
+export const createWebhookSubscription = ConsoleWebhookService.method.createWebhookSubscription;
+
+/**
+ * 購読一覧取得
+ *
+ * @generated from rpc keyhub.console.v1.ConsoleWebhookService.ListWebhookSubscriptions
+ */
+export const listWebhookSubscriptions = ConsoleWebhookService.method.listWebhookSubscriptions;
+
+/**
+ * 購読削除（送信履歴も削除する）
+ *
+ * @generated from rpc keyhub.console.v1.ConsoleWebhookService.DeleteWebhookSubscription
+ */
+export const deleteWebhookSubscription = ConsoleWebhookService.method.deleteWebhookSubscription;
+
+/**
+ * 送信履歴取得（新しい順）
+ *
+ * @generated from rpc keyhub.console.v1.ConsoleWebhookService.ListWebhookDeliveries
+ */
+export const listWebhookDeliveries = ConsoleWebhookService.method.listWebhookDeliveries;
+
+/**
+ * テスト用のイベント（webhook.test）をすぐに送信し、その結果を返す
+ *
+ * @generated from rpc keyhub.console.v1.ConsoleWebhookService.SendTestWebhookEvent
+ */
+export const sendTestWebhookEvent = ConsoleWebhookService.method.sendTestWebhookEvent;
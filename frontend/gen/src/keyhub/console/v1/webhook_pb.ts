@@ -0,0 +1,354 @@
+// @generated by protoc-gen-es v2.12.0 with parameter "target=ts"
+// @generated from file keyhub/console/v1/webhook.proto (package keyhub.console.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_buf_validate_validate } from "../../../buf/validate/validate_pb";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file keyhub/console/v1/webhook.proto.
+ */
+export const file_keyhub_console_v1_webhook: GenFile = /*@__PURE__*/
+  fileDesc("Ch9rZXlodWIvY29uc29sZS92MS93ZWJob29rLnByb3RvEhFrZXlodWIuY29uc29sZS52MSKSAQoTV2ViaG9va1N1YnNjcmlwdGlvbhIUCgJpZBgBIAEoCUIIukgFcgOwAQESCwoDdXJsGAIgASgJEhMKC2V2ZW50X3R5cGVzGAMgAygJEhMKC2Rlc2NyaXB0aW9uGAQgASgJEi4KCmNyZWF0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIskBCg9XZWJob29rRGVsaXZlcnkSFAoCaWQYASABKAlCCLpIBXIDsAEBEhAKCGV2ZW50X2lkGAIgASgJEhIKCmV2ZW50X3R5cGUYAyABKAkSEwoLc3RhdHVzX2NvZGUYBCABKAUSEQoJc3VjY2VlZGVkGAUgASgIEg0KBWVycm9yGAYgASgJEhMKC2R1cmF0aW9uX21zGAcgASgDEi4KCmNyZWF0ZWRfYXQYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wInsKIENyZWF0ZVdlYmhvb2tTdWJzY3JpcHRpb25SZXF1ZXN0EhcKA3VybBgBIAEoCUIKukgHcgUQARiAEBIfCgtldmVudF90eXBlcxgCIAMoCUIKukgHkgEECAEYARIdCgtkZXNjcmlwdGlvbhgDIAEoCUIIukgFcgMYyAEicQohQ3JlYXRlV2ViaG9va1N1YnNjcmlwdGlvblJlc3BvbnNlEjwKDHN1YnNjcmlwdGlvbhgBIAEoCzImLmtleWh1Yi5jb25zb2xlLnYxLldlYmhvb2tTdWJzY3JpcHRpb24SDgoGc2VjcmV0GAIgASgJIiEKH0xpc3RXZWJob29rU3Vic2NyaXB0aW9uc1JlcXVlc3QiYQogTGlzdFdlYmhvb2tTdWJzY3JpcHRpb25zUmVzcG9uc2USPQoNc3Vic2NyaXB0aW9ucxgBIAMoCzImLmtleWh1Yi5jb25zb2xlLnYxLldlYmhvb2tTdWJzY3JpcHRpb24iOAogRGVsZXRlV2ViaG9va1N1YnNjcmlwdGlvblJlcXVlc3QSFAoCaWQYASABKAlCCLpIBXIDsAEBIiMKIURlbGV0ZVdlYmhvb2tTdWJzY3JpcHRpb25SZXNwb25zZSJgChxMaXN0V2ViaG9va0RlbGl2ZXJpZXNSZXF1ZXN0EiEKD3N1YnNjcmlwdGlvbl9pZBgBIAEoCUIIukgFcgOwAQESHQoJcGFnZV9zaXplGAIgASgFQgq6SAcaBRjIASgAIlcKHUxpc3RXZWJob29rRGVsaXZlcmllc1Jlc3BvbnNlEjYKCmRlbGl2ZXJpZXMYASADKAsyIi5rZXlodWIuY29uc29sZS52MS5XZWJob29rRGVsaXZlcnkiQAobU2VuZFRlc3RXZWJob29rRXZlbnRSZXF1ZXN0EiEKD3N1YnNjcmlwdGlvbl9pZBgBIAEoCUIIukgFcgOwAQEiVAocU2VuZFRlc3RXZWJob29rRXZlbnRSZXNwb25zZRI0CghkZWxpdmVyeRgBIAEoCzIiLmtleWh1Yi5jb25zb2xlLnYxLldlYmhvb2tEZWxpdmVyeTKuBQoVQ29uc29sZVdlYmhvb2tTZXJ2aWNlEoYBChlDcmVhdGVXZWJob29rU3Vic2NyaXB0aW9uEjMua2V5aHViLmNvbnNvbGUudjEuQ3JlYXRlV2ViaG9va1N1YnNjcmlwdGlvblJlcXVlc3QaNC5rZXlodWIuY29uc29sZS52MS5DcmVhdGVXZWJob29rU3Vic2NyaXB0aW9uUmVzcG9uc2USiAEKGExpc3RXZWJob29rU3Vic2NyaXB0aW9ucxIyLmtleWh1Yi5jb25zb2xlLnYxLkxpc3RXZWJob29rU3Vic2NyaXB0aW9uc1JlcXVlc3QaMy5rZXlodWIuY29uc29sZS52MS5MaXN0V2ViaG9va1N1YnNjcmlwdGlvbnNSZXNwb25zZSIDkAIBEoYBChlEZWxldGVXZWJob29rU3Vic2NyaXB0aW9uEjMua2V5aHViLmNvbnNvbGUudjEuRGVsZXRlV2ViaG9va1N1YnNjcmlwdGlvblJlcXVlc3QaNC5rZXlodWIuY29uc29sZS52MS5EZWxldGVXZWJob29rU3Vic2NyaXB0aW9uUmVzcG9uc2USfwoVTGlzdFdlYmhvb2tEZWxpdmVyaWVzEi8ua2V5aHViLmNvbnNvbGUudjEuTGlzdFdlYmhvb2tEZWxpdmVyaWVzUmVxdWVzdBowLmtleWh1Yi5jb25zb2xlLnYxLkxpc3RXZWJob29rRGVsaXZlcmllc1Jlc3BvbnNlIgOQAgESdwoUU2VuZFRlc3RXZWJob29rRXZlbnQSLi5rZXlodWIuY29uc29sZS52MS5TZW5kVGVzdFdlYmhvb2tFdmVudFJlcXVlc3QaLy5rZXlodWIuY29uc29sZS52MS5TZW5kVGVzdFdlYmhvb2tFdmVudFJlc3BvbnNlQuABChVjb20ua2V5aHViLmNvbnNvbGUudjFCDFdlYmhvb2tQcm90b1ABWlNnaXRodWIuY29tL3NoaWJheWFtYS1jbHViL2tleWh1Yi9pbnRlcm5hbC9pbnRlcmZhY2UvZ2VuL2tleWh1Yi9jb25zb2xlL3YxO2NvbnNvbGV2MaICA0tDWKoCEUtleWh1Yi5Db25zb2xlLlYxygIRS2V5aHViXENvbnNvbGVcVjHiAh1LZXlodWJcQ29uc29sZVxWMVxHUEJNZXRhZGF0YeoCE0tleWh1Yjo6Q29uc29sZTo6VjFiBnByb3RvMw", [file_buf_validate_validate, file_google_protobuf_timestamp]);
+
+/**
+ * @generated from message keyhub.console.v1.WebhookSubscription
+ */
+export type WebhookSubscription = Message<"keyhub.console.v1.WebhookSubscription"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string url = 2;
+   */
+  url: string;
+
+  /**
+   * @generated from field: repeated string event_types = 3;
+   */
+  eventTypes: string[];
+
+  /**
+   * @generated from field: string description = 4;
+   */
+  description: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 5;
+   */
+  createdAt?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message keyhub.console.v1.WebhookSubscription.
+ * Use `create(WebhookSubscriptionSchema)` to create a new message.
+ */
+export const WebhookSubscriptionSchema: GenMessage<WebhookSubscription> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_webhook, 0);
+
+/**
+ * @generated from message keyhub.console.v1.WebhookDelivery
+ */
+export type WebhookDelivery = Message<"keyhub.console.v1.WebhookDelivery"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string event_id = 2;
+   */
+  eventId: string;
+
+  /**
+   * @generated from field: string event_type = 3;
+   */
+  eventType: string;
+
+  /**
+   * 購読先が返したHTTPステータス。応答がなかった場合は0
+   *
+   * @generated from field: int32 status_code = 4;
+   */
+  statusCode: number;
+
+  /**
+   * @generated from field: bool succeeded = 5;
+   */
+  succeeded: boolean;
+
+  /**
+   * @generated from field: string error = 6;
+   */
+  error: string;
+
+  /**
+   * @generated from field: int64 duration_ms = 7;
+   */
+  durationMs: bigint;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 8;
+   */
+  createdAt?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message keyhub.console.v1.WebhookDelivery.
+ * Use `create(WebhookDeliverySchema)` to create a new message.
+ */
+export const WebhookDeliverySchema: GenMessage<WebhookDelivery> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_webhook, 1);
+
+/**
+ * @generated from message keyhub.console.v1.CreateWebhookSubscriptionRequest
+ */
+export type CreateWebhookSubscriptionRequest = Message<"keyhub.console.v1.CreateWebhookSubscriptionRequest"> & {
+  /**
+   * @generated from field: string url = 1;
+   */
+  url: string;
+
+  /**
+   * "tenant.created" | "tenant.archived" | "tenant.deleted" | "tenant.member_joined" | "room.assigned" | "key.created"
+   *
+   * @generated from field: repeated string event_types = 2;
+   */
+  eventTypes: string[];
+
+  /**
+   * @generated from field: string description = 3;
+   */
+  description: string;
+};
+
+/**
+ * Describes the message keyhub.console.v1.CreateWebhookSubscriptionRequest.
+ * Use `create(CreateWebhookSubscriptionRequestSchema)` to create a new message.
+ */
+export const CreateWebhookSubscriptionRequestSchema: GenMessage<CreateWebhookSubscriptionRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_webhook, 2);
+
+/**
+ * @generated from message keyhub.console.v1.CreateWebhookSubscriptionResponse
+ */
+export type CreateWebhookSubscriptionResponse = Message<"keyhub.console.v1.CreateWebhookSubscriptionResponse"> & {
+  /**
+   * @generated from field: keyhub.console.v1.WebhookSubscription subscription = 1;
+   */
+  subscription?: WebhookSubscription | undefined;
+
+  /**
+   * @generated from field: string secret = 2;
+   */
+  secret: string;
+};
+
+/**
+ * Describes the message keyhub.console.v1.CreateWebhookSubscriptionResponse.
+ * Use `create(CreateWebhookSubscriptionResponseSchema)` to create a new message.
+ */
+export const CreateWebhookSubscriptionResponseSchema: GenMessage<CreateWebhookSubscriptionResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_webhook, 3);
+
+/**
+ * @generated from message keyhub.console.v1.ListWebhookSubscriptionsRequest
+ */
+export type ListWebhookSubscriptionsRequest = Message<"keyhub.console.v1.ListWebhookSubscriptionsRequest"> & {
+};
+
+/**
+ * Describes the message keyhub.console.v1.ListWebhookSubscriptionsRequest.
+ * Use `create(ListWebhookSubscriptionsRequestSchema)` to create a new message.
+ */
+export const ListWebhookSubscriptionsRequestSchema: GenMessage<ListWebhookSubscriptionsRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_webhook, 4);
+
+/**
+ * @generated from message keyhub.console.v1.ListWebhookSubscriptionsResponse
+ */
+export type ListWebhookSubscriptionsResponse = Message<"keyhub.console.v1.ListWebhookSubscriptionsResponse"> & {
+  /**
+   * @generated from field: repeated keyhub.console.v1.WebhookSubscription subscriptions = 1;
+   */
+  subscriptions: WebhookSubscription[];
+};
+
+/**
+ * Describes the message keyhub.console.v1.ListWebhookSubscriptionsResponse.
+ * Use `create(ListWebhookSubscriptionsResponseSchema)` to create a new message.
+ */
+export const ListWebhookSubscriptionsResponseSchema: GenMessage<ListWebhookSubscriptionsResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_webhook, 5);
+
+/**
+ * @generated from message keyhub.console.v1.DeleteWebhookSubscriptionRequest
+ */
+export type DeleteWebhookSubscriptionRequest = Message<"keyhub.console.v1.DeleteWebhookSubscriptionRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message keyhub.console.v1.DeleteWebhookSubscriptionRequest.
+ * Use `create(DeleteWebhookSubscriptionRequestSchema)` to create a new message.
+ */
+export const DeleteWebhookSubscriptionRequestSchema: GenMessage<DeleteWebhookSubscriptionRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_webhook, 6);
+
+/**
+ * @generated from message keyhub.console.v1.DeleteWebhookSubscriptionResponse
+ */
+export type DeleteWebhookSubscriptionResponse = Message<"keyhub.console.v1.DeleteWebhookSubscriptionResponse"> & {
+};
+
+/**
+ * Describes the message keyhub.console.v1.DeleteWebhookSubscriptionResponse.
+ * Use `create(DeleteWebhookSubscriptionResponseSchema)` to create a new message.
+ */
+export const DeleteWebhookSubscriptionResponseSchema: GenMessage<DeleteWebhookSubscriptionResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_webhook, 7);
+
+/**
+ * @generated from message keyhub.console.v1.ListWebhookDeliveriesRequest
+ */
+export type ListWebhookDeliveriesRequest = Message<"keyhub.console.v1.ListWebhookDeliveriesRequest"> & {
+  /**
+   * @generated from field: string subscription_id = 1;
+   */
+  subscriptionId: string;
+
+  /**
+   * 省略時は50件、最大200件
+   *
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize: number;
+};
+
+/**
+ * Describes the message keyhub.console.v1.ListWebhookDeliveriesRequest.
+ * Use `create(ListWebhookDeliveriesRequestSchema)` to create a new message.
+ */
+export const ListWebhookDeliveriesRequestSchema: GenMessage<ListWebhookDeliveriesRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_webhook, 8);
+
+/**
+ * @generated from message keyhub.console.v1.ListWebhookDeliveriesResponse
+ */
+export type ListWebhookDeliveriesResponse = Message<"keyhub.console.v1.ListWebhookDeliveriesResponse"> & {
+  /**
+   * @generated from field: repeated keyhub.console.v1.WebhookDelivery deliveries = 1;
+   */
+  deliveries: WebhookDelivery[];
+};
+
+/**
+ * Describes the message keyhub.console.v1.ListWebhookDeliveriesResponse.
+ * Use `create(ListWebhookDeliveriesResponseSchema)` to create a new message.
+ */
+export const ListWebhookDeliveriesResponseSchema: GenMessage<ListWebhookDeliveriesResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_webhook, 9);
+
+/**
+ * @generated from message keyhub.console.v1.SendTestWebhookEventRequest
+ */
+export type SendTestWebhookEventRequest = Message<"keyhub.console.v1.SendTestWebhookEventRequest"> & {
+  /**
+   * @generated from field: string subscription_id = 1;
+   */
+  subscriptionId: string;
+};
+
+/**
+ * Describes the message keyhub.console.v1.SendTestWebhookEventRequest.
+ * Use `create(SendTestWebhookEventRequestSchema)` to create a new message.
+ */
+export const SendTestWebhookEventRequestSchema: GenMessage<SendTestWebhookEventRequest> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_webhook, 10);
+
+/**
+ * @generated from message keyhub.console.v1.SendTestWebhookEventResponse
+ */
+export type SendTestWebhookEventResponse = Message<"keyhub.console.v1.SendTestWebhookEventResponse"> & {
+  /**
+   * @generated from field: keyhub.console.v1.WebhookDelivery delivery = 1;
+   */
+  delivery?: WebhookDelivery | undefined;
+};
+
+/**
+ * Describes the message keyhub.console.v1.SendTestWebhookEventResponse.
+ * Use `create(SendTestWebhookEventResponseSchema)` to create a new message.
+ */
+export const SendTestWebhookEventResponseSchema: GenMessage<SendTestWebhookEventResponse> = /*@__PURE__*/
+  messageDesc(file_keyhub_console_v1_webhook, 11);
+
+/**
+ * 組織の外部システム（Slack・Discord・社内システムなど）へイベントを送るWebhookの管理
+ * 送信する本文には X-KeyHub-Signature ヘッダーでHMAC-SHA256の署名を付ける
+ *
+ * @generated from service keyhub.console.v1.ConsoleWebhookService
+ */
+export const ConsoleWebhookService: GenService<{
+  /**
+   * 購読登録（署名用のシークレットはこのレスポンスでのみ返す）
+   *
+   * @generated from rpc keyhub.console.v1.ConsoleWebhookService.CreateWebhookSubscription
+   */
+  createWebhookSubscription: {
+    methodKind: "unary";
+    input: typeof CreateWebhookSubscriptionRequestSchema;
+    output: typeof CreateWebhookSubscriptionResponseSchema;
+  },
+  /**
+   * 購読一覧取得
+   *
+   * @generated from rpc keyhub.console.v1.ConsoleWebhookService.ListWebhookSubscriptions
+   */
+  listWebhookSubscriptions: {
+    methodKind: "unary";
+    input: typeof ListWebhookSubscriptionsRequestSchema;
+    output: typeof ListWebhookSubscriptionsResponseSchema;
+  },
+  /**
+   * 購読削除（送信履歴も削除する）
+   *
+   * @generated from rpc keyhub.console.v1.ConsoleWebhookService.DeleteWebhookSubscription
+   */
+  deleteWebhookSubscription: {
+    methodKind: "unary";
+    input: typeof DeleteWebhookSubscriptionRequestSchema;
+    output: typeof DeleteWebhookSubscriptionResponseSchema;
+  },
+  /**
+   * 送信履歴取得（新しい順）
+   *
+   * @generated from rpc keyhub.console.v1.ConsoleWebhookService.ListWebhookDeliveries
+   */
+  listWebhookDeliveries: {
+    methodKind: "unary";
+    input: typeof ListWebhookDeliveriesRequestSchema;
+    output: typeof ListWebhookDeliveriesResponseSchema;
+  },
+  /**
+   * テスト用のイベント（webhook.test）をすぐに送信し、その結果を返す
+   *
+   * @generated from rpc keyhub.console.v1.ConsoleWebhookService.SendTestWebhookEvent
+   */
+  sendTestWebhookEvent: {
+    methodKind: "unary";
+    input: typeof SendTestWebhookEventRequestSchema;
+    output: typeof SendTestWebhookEventResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_keyhub_console_v1_webhook, 0);
+